@@ -11427,8 +11427,9 @@ const docTemplate = `{
                     "type": "string",
                     "enum": [
                         "AWS",
-                        "AZZURE",
-                        "GCP"
+                        "AZURE",
+                        "GCP",
+                        "OPENSTACK"
                     ]
                 },
                 "description": {
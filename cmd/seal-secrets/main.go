@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/openinfradev/tks-api/internal/database"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	kms "github.com/openinfradev/tks-api/pkg/kms-client"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// seal-secrets 는 저장된 secret 중 봉인되지 않았거나 현재 key 가 아닌 key 로 봉인된 것을 한 번 봉인하고 종료한다.
+// 업그레이드할 때 서버를 시작하기 전에 실행하고, master key 나 조직 암호화 키를 교체한 뒤에도 실행한다.
+// otp-encryption-key 로 암호화했던 TOTP secret 도 이 명령으로 봉인한다.
+func init() {
+	flag.String("dbhost", "localhost", "host of postgreSQL")
+	flag.String("dbname", "tks", "name of releation")
+	flag.String("dbport", "5432", "port of postgreSQL")
+	flag.String("dbuser", "postgres", "postgreSQL user")
+	flag.String("dbpassword", "password", "password for postgreSQL user")
+	flag.Int("migrate-db", 0, "If the values is true, enable db migration")
+	flag.String("kubeconfig-path", "", "path of kubeconfig. used development only!")
+	flag.String("aws-secret", "awsconfig-secret", "aws secret")
+	flag.String("secret-master-key", "", "key to seal secrets of organizations without an encryption key. required")
+	flag.String("secret-master-key-previous", "", "comma separated master keys used before secret-master-key")
+	flag.String("otp-encryption-key", "", "key TOTP secrets were encrypted with before they were sealed")
+
+	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
+	flag.Parse()
+
+	if err := viper.BindPFlags(pflag.CommandLine); err != nil {
+		log.Error(context.Background(), err)
+	}
+}
+
+func main() {
+	ctx := context.Background()
+	if viper.GetString("secret-master-key") == "" {
+		log.Fatal(ctx, "cannot seal secrets : secret-master-key is not configured")
+	}
+
+	db, err := database.InitDB()
+	if err != nil {
+		log.Fatal(ctx, "cannot connect gormDB")
+	}
+
+	repoFactory := repository.Repository{
+		Cluster:       repository.NewClusterRepository(db),
+		Organization:  repository.NewOrganizationRepository(db),
+		EncryptionKey: repository.NewEncryptionKeyRepository(db),
+	}
+	repoFactory.SecretSealer = repository.NewSecretSealerFromConfig(repoFactory.EncryptionKey, kms.NewWithAwsSecret)
+	repoFactory.Secret = repository.NewSecretRepository(db, repoFactory.SecretSealer)
+
+	sealed, failed, err := usecase.NewEncryptionKeyUsecase(repoFactory).SealSecrets(ctx)
+	if err != nil {
+		log.Fatal(ctx, "failed to seal secrets : ", err)
+	}
+	log.Infof(ctx, "sealed %d secrets. failed %d", sealed, failed)
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
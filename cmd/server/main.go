@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -17,6 +18,7 @@ import (
 	"github.com/openinfradev/tks-api/internal/mail"
 	"github.com/openinfradev/tks-api/internal/route"
 	argowf "github.com/openinfradev/tks-api/pkg/argo-client"
+	"github.com/openinfradev/tks-api/pkg/hook"
 	"github.com/openinfradev/tks-api/pkg/log"
 )
 
@@ -33,6 +35,8 @@ func init() {
 	flag.String("dbpassword", "password", "password for postgreSQL user")
 	flag.String("kubeconfig-path", "", "path of kubeconfig. used development only!")
 	flag.String("jwt-secret", "tks-api-secret", "secret value of jwt")
+	flag.String("secret-master-key", "", "key to seal secrets of organizations without an encryption key. required")
+	flag.String("secret-master-key-previous", "", "comma separated master keys used before secret-master-key. secrets sealed with them can still be opened until seal-secrets re-seals them")
 	flag.String("git-base-url", "https://github.com", "git base url")
 	flag.String("git-account", "decapod10", "git account of admin cluster")
 	flag.String("external-gitea-url", "http://ip-10-0-76-86.ap-northeast-2.compute.internal:30303", "gitea url for byoh agent download")
@@ -69,6 +73,45 @@ func init() {
 	// alerts
 	flag.String("alert-slack", "", "slack url for LMA alert")
 
+	flag.Int("lma-retention-days", 30, "retention days of LMA(thanos). chart data older than this is read from the database")
+	flag.Int("utilization-retention-days", 365, "retention days of daily downsampled cluster utilization")
+	flag.Duration("cluster-access-max-ttl", 24*time.Hour, "max ttl of delegated cluster access grants")
+	flag.Float64("storage-fill-threshold", 80, "fill rate(%) threshold to flag persistent volumes in the storage report")
+	flag.Int("chart-max-points", 500, "max points per series of dashboard charts. the query step is widened to fit")
+	flag.Int("chart-max-series", 50, "max series of dashboard charts. exceeding series are dropped with a warning")
+	flag.Int("chart-top-pods", 10, "number of pods ranked in the TOP_PODS dashboard chart")
+	flag.Duration("chart-cache-ttl", 30*time.Second, "ttl of cached dashboard chart queries. 0 disables the cache")
+	flag.Duration("dashboard-query-timeout", 20*time.Second, "timeout of each thanos query of dashboard charts and resources. 0 disables the timeout")
+	flag.Duration("chart-cache-stale-ttl", 5*time.Minute, "period after the ttl in which a stale chart is returned while it is refreshed in the background")
+	flag.Bool("cache-warmup-on-startup", true, "warm up the thanos url, cluster name and dashboard chart caches of organizations on startup")
+	flag.Int("stack-operation-limit", 10, "max concurrent stack creations/deletions of the platform. 0 means unlimited")
+	flag.Int("stack-operation-limit-per-organization", 2, "max concurrent stack creations/deletions per organization. 0 means unlimited")
+	flag.Int("app-operation-limit", 30, "max concurrent app deployments of the platform. 0 means unlimited")
+	flag.Int("app-operation-limit-per-organization", 5, "max concurrent app deployments per organization. 0 means unlimited")
+	flag.Duration("cluster-heartbeat-threshold", 5*time.Minute, "clusters not seen for longer than this are marked as UNREACHABLE")
+	flag.Int("kubernetes-eol-advisory-days", 90, "days before the end of support of the kubernetes version from which clusters are notified")
+	flag.String("kubernetes-eol-schedule", "", "end of support dates overriding the built-in schedule. e.g. 1.35=2027-02-28,1.36=2027-06-28")
+	flag.String("kubernetes-upgrade-url", "", "url of the upgrade procedure linked in kubernetes end of support advisories. {stackId} is replaced with the stack id")
+	flag.Int("stack-node-group-max-size", 100, "maximum number of nodes of a stack node group")
+	flag.Float64("stack-upgrade-max-usage", 80, "maximum average cpu and memory usage(%) of stack nodes allowed to start an upgrade")
+
+	flag.Int("api-rate-limit-per-minute", 0, "max api requests per minute of an organization. requests of the master organization are not limited. 0 means unlimited")
+	flag.Int("api-usage-retention-days", 30, "retention days of per-minute api request counts of organizations")
+
+	// audit retention
+	flag.Int("audit-retention-days", 365, "default retention days of audits. organizations without a retention policy use this value")
+	flag.String("audit-archive-s3-endpoint", "", "endpoint of S3 compatible storage to archive audits. empty means AWS S3")
+	flag.String("audit-archive-s3-region", "ap-northeast-2", "region of the audit archive bucket")
+	flag.String("audit-archive-s3-bucket", "", "bucket to archive audits. empty means audits are archived to the database")
+	flag.String("audit-archive-s3-access-key-id", "", "access key id of the audit archive storage")
+	flag.String("audit-archive-s3-secret-access-key", "", "secret access key of the audit archive storage")
+	flag.Bool("audit-archive-s3-path-style", false, "use path style addressing for the audit archive storage. required by most S3 compatible storages")
+
+	flag.String("hook-webhooks", "", "comma separated webhook hooks. <point>=<url> where point is one of pre-stack-create, post-user-create, pre-deploy")
+	flag.String("hook-webhook-secret", "", "secret to sign requests of webhook hooks")
+	flag.Duration("hook-webhook-timeout", 10*time.Second, "timeout of webhook hooks")
+	flag.Bool("hook-webhook-fail-open", false, "allow requests when a webhook hook can not be called. by default such requests are rejected")
+
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 	flag.Parse()
 
@@ -125,6 +168,15 @@ func main() {
 		log.Fatal(ctx, "cannot Initializing Default Rows in Database: ", err)
 	}
 
+	// Ensure secrets can be sealed and the TOTP secrets in database can be opened
+	if viper.GetString("secret-master-key") == "" {
+		log.Fatal(ctx, "cannot seal secrets : secret-master-key is not configured")
+	}
+	err = database.CheckLegacyOtpSecrets(db)
+	if err != nil {
+		log.Fatal(ctx, "cannot use 2FA : ", err)
+	}
+
 	// Initialize external client
 	var argoClient argowf.ArgoClient
 	if viper.GetString("argo-address") == "" || viper.GetInt("argo-port") == 0 {
@@ -155,6 +207,16 @@ func main() {
 		log.Fatal(ctx, "failed to initialize ses : ", err)
 	}
 
+	err = hook.Initialize(ctx, hook.Config{
+		Webhooks:        strings.FieldsFunc(viper.GetString("hook-webhooks"), func(r rune) bool { return r == ',' }),
+		WebhookSecret:   viper.GetString("hook-webhook-secret"),
+		WebhookTimeout:  viper.GetDuration("hook-webhook-timeout"),
+		WebhookFailOpen: viper.GetBool("hook-webhook-fail-open"),
+	})
+	if err != nil {
+		log.Fatal(ctx, "failed to initialize hooks : ", err)
+	}
+
 	route := route.SetupRouter(db, argoClient, keycloak, asset)
 
 	log.Info(ctx, "Starting server on ", viper.GetInt("port"))
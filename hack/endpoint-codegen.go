@@ -10,14 +10,23 @@ import (
 	"html/template"
 	"io/ioutil"
 	"log"
+	"reflect"
+	"strconv"
 	"strings"
+	"unicode"
 )
 
 const endpointFilePath = "./internal/delivery/api/endpoint.go"
 
+// 요청 DTO 는 <Endpoint>Request 라는 이름으로 아래 package 에 정의되어 있다.
+var requestDirs = []string{"./pkg/domain", "./pkg/domain/admin"}
+
 type endpointDecl struct {
-	Name  string
-	Group string
+	Name      string
+	Group     string
+	Verb      string
+	Resource  string
+	NameField string
 }
 
 const indexTemplateStr = ` // This is generated code. DO NOT EDIT.
@@ -41,6 +50,9 @@ const apiMapTemplateStr = `var ApiMap = map[Endpoint]EndpointInfo{
     {{.Name}}: {
 		Name: "{{.Name}}", 
 		Group: "{{.Group}}",
+		Verb: "{{.Verb}}",
+		Resource: "{{.Resource}}",
+		NameField: "{{.NameField}}",
 	},
 {{- end}}
 }
@@ -109,9 +121,12 @@ func main() {
 					}
 
 					for _, name := range vs.Names {
+						verb, resource := splitEndpointName(name.Name)
 						endpoints = append(endpoints, endpointDecl{
-							Name:  name.Name,
-							Group: currentGroup,
+							Name:     name.Name,
+							Group:    currentGroup,
+							Verb:     verb,
+							Resource: resource,
 						})
 					}
 				}
@@ -120,8 +135,10 @@ func main() {
 		return true
 	})
 
-	for _, ep := range endpoints {
-		log.Printf("Endpoint: %s, Group: %s\n", ep.Name, ep.Group)
+	nameFields := requestNameFields()
+	for i, ep := range endpoints {
+		endpoints[i].NameField = nameFields[strings.TrimPrefix(ep.Name, "Admin_")+"Request"]
+		log.Printf("Endpoint: %s, Group: %s, NameField: %s\n", ep.Name, ep.Group, endpoints[i].NameField)
 	}
 
 	// contents for index
@@ -190,3 +207,71 @@ func main() {
 
 	log.Println("Code generation is done.")
 }
+
+// splitEndpointName 은 endpoint 이름의 첫 단어를 동사로, 나머지를 리소스로 나눈다. (e.g. Admin_CreateUser -> Create, User)
+func splitEndpointName(name string) (verb string, resource string) {
+	name = strings.TrimPrefix(name, "Admin_")
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			return name[:i], name[i:]
+		}
+	}
+	return name, ""
+}
+
+// requestNameFields 는 요청 DTO 에서 감사 로그에 남길 이름 필드의 json key 를 찾는다.
+// `audit:"name"` tag 가 있는 필드를 우선하고, 없으면 json key 가 name 인 필드를 사용한다.
+func requestNameFields() map[string]string {
+	out := map[string]string{}
+	for _, dir := range requestDirs {
+		pkgs, err := parser.ParseDir(token.NewFileSet(), dir, nil, 0)
+		if err != nil {
+			log.Fatalf("failed to parse dir: %v", err)
+		}
+		for _, pkg := range pkgs {
+			ast.Inspect(pkg, func(n ast.Node) bool {
+				ts, ok := n.(*ast.TypeSpec)
+				if !ok || !strings.HasSuffix(ts.Name.Name, "Request") {
+					return true
+				}
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					return true
+				}
+				if _, exists := out[ts.Name.Name]; exists {
+					return true
+				}
+				if field := findNameField(st); field != "" {
+					out[ts.Name.Name] = field
+				}
+				return true
+			})
+		}
+	}
+	return out
+}
+
+func findNameField(st *ast.StructType) string {
+	named := ""
+	for _, field := range st.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		raw, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		tag := reflect.StructTag(raw)
+		jsonKey := strings.Split(tag.Get("json"), ",")[0]
+		if jsonKey == "" || jsonKey == "-" {
+			continue
+		}
+		if tag.Get("audit") == "name" {
+			return jsonKey
+		}
+		if jsonKey == "name" {
+			named = jsonKey
+		}
+	}
+	return named
+}
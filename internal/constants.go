@@ -8,8 +8,10 @@ const ContextKeyRequestID ContextKey = "REQUEST_ID"
 
 const (
 	PasswordExpiredDuration = 30 * 24 * time.Hour
+	PasswordMinLength       = 8
 	EmailCodeExpireTime     = 5 * time.Minute
 	API_VERSION             = "/1.0"
+	API_VERSION_V2          = "/2.0"
 	API_PREFIX              = "/api"
 	ADMINAPI_PREFIX         = "/admin"
 
@@ -64,6 +64,7 @@ func migrateSchema(db *gorm.DB) error {
 		&model.ExpiredTokenTime{},
 		&model.Role{},
 		&model.CloudAccount{},
+		&model.CloudAccountPricing{},
 		&model.StackTemplate{},
 		&model.Organization{},
 		&model.User{},
@@ -73,6 +74,7 @@ func migrateSchema(db *gorm.DB) error {
 		&model.Application{},
 		&model.AppServeApp{},
 		&model.AppServeAppTask{},
+		&model.AppServeAppConfig{},
 		&model.SystemNotification{},
 		&model.SystemNotificationAction{},
 		&model.SystemNotificationMetricParameter{},
@@ -90,9 +92,74 @@ func migrateSchema(db *gorm.DB) error {
 		&model.PolicyTemplate{},
 		&model.Policy{},
 		&model.Dashboard{},
+		&model.ClusterUtilization{},
+		&model.OrganizationOnboarding{},
+		&model.ClusterAccessRequest{},
+		&model.AlertIngestionToken{},
+		&model.AlertChannel{},
+		&model.AlertSilence{},
+		&model.EscalationPolicy{},
+		&model.AlertRoutingRule{},
+		&model.DeploymentApprovalPolicy{},
+		&model.DeploymentApproval{},
+		&model.StackDefault{},
+		&model.CostAllocationTagPolicy{},
+		&model.CloudHealthEvent{},
+		&model.LmaEndpoint{},
+		&model.ClusterHeartbeat{},
+		&model.EncryptionKey{},
+		&model.Operation{},
+		&model.NotificationDigestSetting{},
+		&model.NotificationDigestItem{},
+		&model.CustomChart{},
+		&model.ChartSnapshot{},
+		&model.UserSession{},
+		&model.MaintenanceWindow{},
+		&model.AuditArchive{},
+		&model.AuditRetentionPolicy{},
+		&model.AuditArchivalRun{},
+		&model.AuditSink{},
+		&model.AuditSinkDeadLetter{},
+		&model.PasswordPolicy{},
+		&model.PasswordHistory{},
+		&model.OrganizationBranding{},
+		&model.LmaAuth{},
+		&model.LmaDiscovery{},
+		&model.StackMonitoringEndpoint{},
+		&model.StackMonitoringProbe{},
+		&model.RoleReassignment{},
+		&model.RoleReassignmentResult{},
+		&model.NamingPolicy{},
+		&model.ClusterVersionAdvisory{},
+		&model.StackUpgrade{},
+		&model.StackWebhook{},
+		&model.StackLifecycleState{},
+		&model.OrganizationQuota{},
+		&model.HelmRepository{},
+		&model.CatalogChart{},
+		&model.CatalogApp{},
+		&model.GitProvider{},
+		&model.AppServeAppGitSource{},
+		&model.JobLease{},
+		&model.ApiUsage{},
 	); err != nil {
 		return err
 	}
+
+	// 클라우드 계정의 식별자는 provider 와 관계없이 account_id 에 저장한다. 이전 AWS 계정은 aws_account_id 를 옮긴다.
+	if err := db.Exec("UPDATE cloud_accounts SET account_id = aws_account_id WHERE (account_id IS NULL OR account_id = '') AND aws_account_id <> ''").Error; err != nil {
+		return err
+	}
+
+	// gorm.Model 의 created_at 에는 태그를 붙일 수 없으므로 audit 조회용 index 는 직접 생성한다.
+	for _, table := range []string{"audits", "audit_archives"} {
+		if err := db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_%s_organization_id_created_at ON %s (organization_id, created_at DESC)", table, table)).Error; err != nil {
+			return err
+		}
+		if err := db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_%s_created_at ON %s (created_at)", table, table)).Error; err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -144,3 +211,16 @@ func EnsureDefaultRows(db *gorm.DB) error {
 
 	return nil
 }
+
+// CheckLegacyOtpSecrets 는 otp-encryption-key 로 암호화한 TOTP secret 이 남아 있으면 에러를 반환한다.
+// 이 secret 은 seal-secrets 명령으로 다시 봉인해야 사용할 수 있다.
+func CheckLegacyOtpSecrets(db *gorm.DB) error {
+	count, err := repository.NewSecretRepository(db, nil).CountLegacyOtpSecrets(context.Background())
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%d totp secrets are encrypted with otp-encryption-key. run seal-secrets to seal them", count)
+	}
+	return nil
+}
@@ -4,6 +4,12 @@ type Endpoint int
 type EndpointInfo struct {
 	Name  string
 	Group string
+
+	// 감사 로그 메시지를 만드는 데 사용한다. Verb 와 Resource 는 endpoint 이름에서,
+	// NameField 는 요청 DTO(<Endpoint>Request) 의 `audit:"name"` tag 또는 name 필드에서 생성된다.
+	Verb      string
+	Resource  string
+	NameField string
 }
 
 // Comment below is special purpose for code generation.
@@ -19,6 +25,7 @@ const (
 	VerifyIdentityForLostId
 	VerifyIdentityForLostPassword
 	VerifyToken
+	GetPublicOrganizationBranding
 
 	// User
 	CreateUser
@@ -31,6 +38,10 @@ const (
 	CheckId
 	CheckEmail
 	GetPermissionsByAccountId
+	GetPasswordPolicy
+	UpdatePasswordPolicy
+	GetUserSessions
+	DeleteUserSession
 
 	// MyProfile
 	GetMyProfile
@@ -38,6 +49,11 @@ const (
 	UpdateMyPassword
 	RenewPasswordExpiredDate
 	DeleteMyProfile
+	GetMyNotificationDigestSettings
+	UpdateMyNotificationDigestSettings
+	EnrollMyOtp
+	ActivateMyOtp
+	DisableMyOtp
 
 	// Organization
 	Admin_CreateOrganization
@@ -47,6 +63,32 @@ const (
 	CheckOrganizationName
 	UpdateOrganization
 	UpdatePrimaryCluster
+	GetOrganizationOnboarding
+	GetOrganizationBranding
+	UpdateOrganizationBranding
+	GetNamingPolicies
+	UpdateNamingPolicy
+	GetOrganizationQuota
+	Admin_GetOrganizationQuota
+	Admin_UpdateOrganizationQuota
+	GetOrganizationUsage
+	GetLmaEndpoints
+	CreateLmaEndpoint
+	UpdateLmaEndpoint
+	DeleteLmaEndpoint
+	GetLmaAuth
+	UpdateLmaAuth
+	GetLmaDiscovery
+	UpdateLmaDiscovery
+	ExportManifests
+	ApplyManifests
+	GetEncryptionKeys
+	CreateEncryptionKey
+	RotateEncryptionKey
+	RevokeEncryptionKey
+	GetOperations
+	CancelOperation
+	GetTask
 
 	// Cluster
 	CreateCluster
@@ -59,6 +101,12 @@ const (
 	CreateBootstrapKubeconfig
 	GetBootstrapKubeconfig
 	GetNodes
+	SearchPods
+	GetClusterNamespaces
+	GetClusterWorkloads
+	GetClusterPods
+	GetPodLogs
+	GetClusterKubeconfig
 
 	//Appgroup
 	CreateAppgroup
@@ -71,18 +119,28 @@ const (
 	// AppServeApp
 	GetAppServeAppTasksByAppId
 	GetAppServeAppTaskDetail
-	CreateAppServeApp         // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
-	GetAppServeApps           // 프로젝트 관리/앱 서빙/조회
-	GetNumOfAppsOnStack       // 프로젝트 관리/앱 서빙/조회
-	GetAppServeApp            // 프로젝트 관리/앱 서빙/조회
-	GetAppServeAppLatestTask  // 프로젝트 관리/앱 서빙/조회
-	IsAppServeAppExist        // 프로젝트 관리/앱 서빙/조회 // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
-	IsAppServeAppNameExist    // 프로젝트 관리/앱 서빙/조회 // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
-	DeleteAppServeApp         // 프로젝트 관리/앱 서빙/삭제
-	UpdateAppServeApp         // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
-	UpdateAppServeAppStatus   // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
-	UpdateAppServeAppEndpoint // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
-	RollbackAppServeApp       // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
+	CreateAppServeApp          // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
+	GetAppServeApps            // 프로젝트 관리/앱 서빙/조회
+	GetNumOfAppsOnStack        // 프로젝트 관리/앱 서빙/조회
+	GetAppServeAppSummary      // 대시보드/대시보드/조회
+	GetAppServeApp             // 프로젝트 관리/앱 서빙/조회
+	GetAppServeAppLatestTask   // 프로젝트 관리/앱 서빙/조회
+	IsAppServeAppExist         // 프로젝트 관리/앱 서빙/조회 // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
+	IsAppServeAppNameExist     // 프로젝트 관리/앱 서빙/조회 // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
+	DeleteAppServeApp          // 프로젝트 관리/앱 서빙/삭제
+	UpdateAppServeApp          // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
+	UpdateAppServeAppStatus    // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
+	UpdateAppServeAppEndpoint  // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
+	RollbackAppServeApp        // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
+	GetAppServeAppMetrics      // 프로젝트 관리/앱 서빙/조회
+	GetAppServeAppConfigs      // 프로젝트 관리/앱 서빙/조회
+	GetAppServeAppConfig       // 프로젝트 관리/앱 서빙/조회
+	GetAppServeAppConfigDiff   // 프로젝트 관리/앱 서빙/조회
+	UpdateAppServeAppConfig    // 프로젝트 관리/앱 서빙/배포 // 프로젝트 관리/앱 서빙/빌드
+	GetAppServeAppGitSource    // 프로젝트 관리/앱 서빙/조회
+	UpdateAppServeAppGitSource // 프로젝트 관리/앱 서빙/빌드
+	DeleteAppServeAppGitSource // 프로젝트 관리/앱 서빙/빌드
+	BuildAppServeApp           // 프로젝트 관리/앱 서빙/빌드
 
 	// CloudAccount
 	GetCloudAccounts
@@ -93,7 +151,11 @@ const (
 	UpdateCloudAccount
 	DeleteCloudAccount
 	DeleteForceCloudAccount
+	GetCloudAccountPricing
+	UpdateCloudAccountPricing
+	DeleteCloudAccountPricing
 	GetResourceQuota
+	VerifyCloudAccount
 
 	// StackTemplate
 	Admin_GetStackTemplates
@@ -114,10 +176,21 @@ const (
 	CreateDashboard
 	GetDashboard
 	UpdateDashboard
-	GetChartsDashboard    // 대시보드/대시보드/조회
-	GetChartDashboard     // 대시보드/대시보드/조회
-	GetStacksDashboard    // 대시보드/대시보드/조회
-	GetResourcesDashboard // 대시보드/대시보드/조회
+	GetChartsDashboard          // 대시보드/대시보드/조회
+	GetChartDashboard           // 대시보드/대시보드/조회
+	StreamChartsDashboard       // 대시보드/대시보드/조회
+	GetStacksDashboard          // 대시보드/대시보드/조회
+	GetResourcesDashboard       // 대시보드/대시보드/조회
+	GetResourcesDashboardV2     // 대시보드/대시보드/조회
+	GetStackNodesDashboard      // 대시보드/대시보드/조회
+	GetStackChartsDashboard     // 대시보드/대시보드/조회
+	GetStackChartDashboard      // 대시보드/대시보드/조회
+	GetStoragesDashboard        // 대시보드/대시보드/조회
+	GetNetworkPoliciesDashboard // 대시보드/대시보드/조회
+	GetIdentityStatusDashboard  // 대시보드/대시보드/조회
+	GetHealthDashboard          // 대시보드/대시보드/조회
+	GetCostsDashboard           // 대시보드/대시보드/조회
+	GetClusterEventsDashboard   // 대시보드/대시보드/조회
 	GetPolicyStatusDashboard
 	GetPolicyUpdateDashboard
 	GetPolicyEnforcementDashboard
@@ -126,6 +199,17 @@ const (
 	GetPolicyStatisticsDashboard
 	GetWorkloadDashboard
 	GetPolicyViolationTop5Dashboard
+	GetPolicyEnforcementStatusDashboard // 대시보드/대시보드/조회
+	CreateCustomChartDashboard
+	GetCustomChartsDashboard
+	GetCustomChartDashboard
+	UpdateCustomChartDashboard
+	DeleteCustomChartDashboard
+	GetCustomChartDataDashboard
+	CreateChartSnapshotDashboard
+	GetChartSnapshotsDashboard
+	DeleteChartSnapshotDashboard
+	Admin_WarmupDashboardCaches
 
 	// SystemNotificationTemplate
 	Admin_CreateSystemNotificationTemplate
@@ -148,6 +232,42 @@ const (
 	UpdateSystemNotificationRule
 	MakeDefaultSystemNotificationRules
 
+	// AlertIngestionToken
+	CreateAlertIngestionToken
+	GetAlertIngestionTokens
+	RotateAlertIngestionToken
+	RevokeAlertIngestionToken
+
+	// AlertRoutingRule
+	CreateAlertRoutingRule
+	GetAlertRoutingRules
+	GetAlertRoutingRule
+	UpdateAlertRoutingRule
+	DeleteAlertRoutingRule
+	TestAlertRoutingRules
+
+	// AlertChannel
+	CreateAlertChannel
+	GetAlertChannels
+	GetAlertChannel
+	UpdateAlertChannel
+	DeleteAlertChannel
+	TestAlertChannel
+
+	// AlertSilence
+	CreateAlertSilence
+	GetAlertSilences
+	GetAlertSilence
+	UpdateAlertSilence
+	DeleteAlertSilence
+
+	// EscalationPolicy
+	CreateEscalationPolicy
+	GetEscalationPolicies
+	GetEscalationPolicy
+	UpdateEscalationPolicy
+	DeleteEscalationPolicy
+
 	// SystemNotification
 	CreateSystemNotification
 	GetSystemNotifications
@@ -161,17 +281,94 @@ const (
 	GetPolicyNotification
 
 	// Stack
-	GetStacks           // 스택관리/조회
-	CreateStack         // 스택관리/생성
-	CheckStackName      // 스택관리/조회
-	GetStack            // 스택관리/조회
-	UpdateStack         // 스택관리/수정
-	DeleteStack         // 스택관리/삭제
-	GetStackKubeConfig  // 스택관리/조회
-	GetStackStatus      // 스택관리/조회
-	SetFavoriteStack    // 스택관리/조회
-	DeleteFavoriteStack // 스택관리/조회
-	InstallStack        // 스택관리 / 조회
+	GetStacks             // 스택관리/조회
+	CreateStack           // 스택관리/생성
+	CheckStackName        // 스택관리/조회
+	GetStack              // 스택관리/조회
+	UpdateStack           // 스택관리/수정
+	DeleteStack           // 스택관리/삭제
+	GetStackKubeConfig    // 스택관리/조회
+	GetStackStatus        // 스택관리/조회
+	SetFavoriteStack      // 스택관리/조회
+	DeleteFavoriteStack   // 스택관리/조회
+	InstallStack          // 스택관리 / 조회
+	GetStackAddonVersions // 스택관리/조회
+	GetStackNodes         // 스택관리/조회
+	ScaleStackNodeGroup   // 스택관리/수정
+	UpgradeStack          // 스택관리/수정
+	GetStackUpgrades      // 스택관리/조회
+
+	// StackWebhook
+	CreateStackWebhook // 설정/일반/수정
+	GetStackWebhooks   // 설정/일반/조회
+	GetStackWebhook    // 설정/일반/조회
+	UpdateStackWebhook // 설정/일반/수정
+	DeleteStackWebhook // 설정/일반/수정
+
+	// CloudHealthEvent
+	GetCloudHealthEvents // 스택관리/조회
+
+	// StackDefault
+	GetStackDefault // 스택관리/조회
+	Admin_GetStackDefault
+	Admin_UpdateStackDefault
+	Admin_DeleteStackDefault
+
+	// CostAllocationTag
+	GetCostAllocationTagPolicies     // 스택관리/조회
+	CheckStackCostAllocationTagDrift // 스택관리/조회
+	Admin_GetCostAllocationTagPolicies
+	Admin_UpdateCostAllocationTagPolicies
+
+	// ClusterAccessRequest
+	CreateClusterAccessRequest  // 스택관리/조회
+	GetClusterAccessRequests    // 스택관리/조회
+	GetClusterAccessRequest     // 스택관리/조회
+	ApproveClusterAccessRequest // 스택관리/수정
+	RejectClusterAccessRequest  // 스택관리/수정
+	RevokeClusterAccessRequest  // 스택관리/수정
+
+	// DeploymentApproval
+	GetDeploymentApprovalPolicy    // 스택관리/조회
+	UpdateDeploymentApprovalPolicy // 스택관리/수정
+	DeleteDeploymentApprovalPolicy // 스택관리/수정
+	GetDeploymentApprovals         // 프로젝트 관리/앱 서빙/조회
+	GetDeploymentApproval          // 프로젝트 관리/앱 서빙/조회
+	ApproveDeployment              // 프로젝트 관리/앱 서빙/배포
+	RejectDeployment               // 프로젝트 관리/앱 서빙/배포
+
+	// MaintenanceWindow
+	CreateMaintenanceWindow           // 스택관리/수정
+	GetMaintenanceWindows             // 스택관리/조회
+	GetOrganizationMaintenanceWindows // 스택관리/조회
+	GetMaintenanceWindow              // 스택관리/조회
+	UpdateMaintenanceWindow           // 스택관리/수정
+	DeleteMaintenanceWindow           // 스택관리/수정
+
+	// StackMonitoringEndpoint
+	CreateStackMonitoringEndpoint // 스택관리/수정
+	GetStackMonitoringEndpoints   // 스택관리/조회
+	GetStackMonitoringEndpoint    // 스택관리/조회
+	UpdateStackMonitoringEndpoint // 스택관리/수정
+	DeleteStackMonitoringEndpoint // 스택관리/수정
+	GetAvailabilityDashboard      // 대시보드/대시보드/조회
+
+	// Catalog
+	Admin_GetHelmRepositories
+	Admin_CreateHelmRepository
+	Admin_DeleteHelmRepository
+	Admin_SyncHelmRepository
+	GetCatalogCharts // 스택관리/조회
+	GetCatalogChart  // 스택관리/조회
+	CreateCatalogApp // 스택관리/수정
+	GetCatalogApps   // 스택관리/조회
+
+	// GitProvider
+	GetGitProviders // 프로젝트 관리/앱 서빙/조회
+	GetGitProvider  // 프로젝트 관리/앱 서빙/조회
+	CreateGitProvider
+	UpdateGitProvider
+	DeleteGitProvider
 
 	// Project
 	CreateProject           // 프로젝트 관리/프로젝트/생성
@@ -203,6 +400,23 @@ const (
 	GetAudits
 	GetAudit
 	DeleteAudit
+	Admin_GetAuditStatistics
+	Admin_ArchiveAudits
+	Admin_GetAuditRetentionPolicies
+	Admin_UpdateAuditRetentionPolicy
+	Admin_CreateAuditArchivalRun
+	Admin_GetAuditArchivalRuns
+	Admin_GetAuditArchivalRun
+	GetOrganizationAudits
+	GetOrganizationTimeline
+	CreateAuditSink
+	GetAuditSinks
+	GetAuditSink
+	UpdateAuditSink
+	DeleteAuditSink
+	GetAuditSinkDeadLetters
+	RetryAuditSinkDeadLetter
+	DeleteAuditSinkDeadLetter
 
 	// Role
 	CreateTksRole
@@ -216,6 +430,9 @@ const (
 	AppendUsersToRole
 	GetUsersInRoleId
 	RemoveUsersFromRole
+	CreateRoleReassignment
+	GetRoleReassignments
+	GetRoleReassignment
 
 	// Permission
 	GetPermissionTemplates
@@ -6,934 +6,2900 @@ var ApiMap = map[Endpoint]EndpointInfo{
     Login: {
 		Name: "Login", 
 		Group: "Auth",
+		Verb: "Login",
+		Resource: "",
+		NameField: "",
 	},
     Logout: {
 		Name: "Logout", 
 		Group: "Auth",
+		Verb: "Logout",
+		Resource: "",
+		NameField: "",
 	},
     RefreshToken: {
 		Name: "RefreshToken", 
 		Group: "Auth",
+		Verb: "Refresh",
+		Resource: "Token",
+		NameField: "",
 	},
     FindId: {
 		Name: "FindId", 
 		Group: "Auth",
+		Verb: "Find",
+		Resource: "Id",
+		NameField: "",
 	},
     FindPassword: {
 		Name: "FindPassword", 
 		Group: "Auth",
+		Verb: "Find",
+		Resource: "Password",
+		NameField: "",
 	},
     VerifyIdentityForLostId: {
 		Name: "VerifyIdentityForLostId", 
 		Group: "Auth",
+		Verb: "Verify",
+		Resource: "IdentityForLostId",
+		NameField: "",
 	},
     VerifyIdentityForLostPassword: {
 		Name: "VerifyIdentityForLostPassword", 
 		Group: "Auth",
+		Verb: "Verify",
+		Resource: "IdentityForLostPassword",
+		NameField: "",
 	},
     VerifyToken: {
 		Name: "VerifyToken", 
 		Group: "Auth",
+		Verb: "Verify",
+		Resource: "Token",
+		NameField: "",
+	},
+    GetPublicOrganizationBranding: {
+		Name: "GetPublicOrganizationBranding", 
+		Group: "Auth",
+		Verb: "Get",
+		Resource: "PublicOrganizationBranding",
+		NameField: "",
 	},
     CreateUser: {
 		Name: "CreateUser", 
 		Group: "User",
+		Verb: "Create",
+		Resource: "User",
+		NameField: "accountId",
 	},
     ListUser: {
 		Name: "ListUser", 
 		Group: "User",
+		Verb: "List",
+		Resource: "User",
+		NameField: "",
 	},
     GetUser: {
 		Name: "GetUser", 
 		Group: "User",
+		Verb: "Get",
+		Resource: "User",
+		NameField: "",
 	},
     DeleteUser: {
 		Name: "DeleteUser", 
 		Group: "User",
+		Verb: "Delete",
+		Resource: "User",
+		NameField: "",
 	},
     UpdateUsers: {
 		Name: "UpdateUsers", 
 		Group: "User",
+		Verb: "Update",
+		Resource: "Users",
+		NameField: "",
 	},
     UpdateUser: {
 		Name: "UpdateUser", 
 		Group: "User",
+		Verb: "Update",
+		Resource: "User",
+		NameField: "name",
 	},
     ResetPassword: {
 		Name: "ResetPassword", 
 		Group: "User",
+		Verb: "Reset",
+		Resource: "Password",
+		NameField: "",
 	},
     CheckId: {
 		Name: "CheckId", 
 		Group: "User",
+		Verb: "Check",
+		Resource: "Id",
+		NameField: "",
 	},
     CheckEmail: {
 		Name: "CheckEmail", 
 		Group: "User",
+		Verb: "Check",
+		Resource: "Email",
+		NameField: "",
 	},
     GetPermissionsByAccountId: {
 		Name: "GetPermissionsByAccountId", 
 		Group: "User",
+		Verb: "Get",
+		Resource: "PermissionsByAccountId",
+		NameField: "",
+	},
+    GetPasswordPolicy: {
+		Name: "GetPasswordPolicy", 
+		Group: "User",
+		Verb: "Get",
+		Resource: "PasswordPolicy",
+		NameField: "",
+	},
+    UpdatePasswordPolicy: {
+		Name: "UpdatePasswordPolicy", 
+		Group: "User",
+		Verb: "Update",
+		Resource: "PasswordPolicy",
+		NameField: "",
+	},
+    GetUserSessions: {
+		Name: "GetUserSessions", 
+		Group: "User",
+		Verb: "Get",
+		Resource: "UserSessions",
+		NameField: "",
+	},
+    DeleteUserSession: {
+		Name: "DeleteUserSession", 
+		Group: "User",
+		Verb: "Delete",
+		Resource: "UserSession",
+		NameField: "",
 	},
     GetMyProfile: {
 		Name: "GetMyProfile", 
 		Group: "MyProfile",
+		Verb: "Get",
+		Resource: "MyProfile",
+		NameField: "",
 	},
     UpdateMyProfile: {
 		Name: "UpdateMyProfile", 
 		Group: "MyProfile",
+		Verb: "Update",
+		Resource: "MyProfile",
+		NameField: "name",
 	},
     UpdateMyPassword: {
 		Name: "UpdateMyPassword", 
 		Group: "MyProfile",
+		Verb: "Update",
+		Resource: "MyPassword",
+		NameField: "",
 	},
     RenewPasswordExpiredDate: {
 		Name: "RenewPasswordExpiredDate", 
 		Group: "MyProfile",
+		Verb: "Renew",
+		Resource: "PasswordExpiredDate",
+		NameField: "",
 	},
     DeleteMyProfile: {
 		Name: "DeleteMyProfile", 
 		Group: "MyProfile",
+		Verb: "Delete",
+		Resource: "MyProfile",
+		NameField: "",
+	},
+    GetMyNotificationDigestSettings: {
+		Name: "GetMyNotificationDigestSettings", 
+		Group: "MyProfile",
+		Verb: "Get",
+		Resource: "MyNotificationDigestSettings",
+		NameField: "",
+	},
+    UpdateMyNotificationDigestSettings: {
+		Name: "UpdateMyNotificationDigestSettings", 
+		Group: "MyProfile",
+		Verb: "Update",
+		Resource: "MyNotificationDigestSettings",
+		NameField: "",
+	},
+    EnrollMyOtp: {
+		Name: "EnrollMyOtp", 
+		Group: "MyProfile",
+		Verb: "Enroll",
+		Resource: "MyOtp",
+		NameField: "",
+	},
+    ActivateMyOtp: {
+		Name: "ActivateMyOtp", 
+		Group: "MyProfile",
+		Verb: "Activate",
+		Resource: "MyOtp",
+		NameField: "",
+	},
+    DisableMyOtp: {
+		Name: "DisableMyOtp", 
+		Group: "MyProfile",
+		Verb: "Disable",
+		Resource: "MyOtp",
+		NameField: "",
 	},
     Admin_CreateOrganization: {
 		Name: "Admin_CreateOrganization", 
 		Group: "Organization",
+		Verb: "Create",
+		Resource: "Organization",
+		NameField: "name",
 	},
     Admin_DeleteOrganization: {
 		Name: "Admin_DeleteOrganization", 
 		Group: "Organization",
+		Verb: "Delete",
+		Resource: "Organization",
+		NameField: "",
 	},
     GetOrganizations: {
 		Name: "GetOrganizations", 
 		Group: "Organization",
+		Verb: "Get",
+		Resource: "Organizations",
+		NameField: "",
 	},
     GetOrganization: {
 		Name: "GetOrganization", 
 		Group: "Organization",
+		Verb: "Get",
+		Resource: "Organization",
+		NameField: "",
 	},
     CheckOrganizationName: {
 		Name: "CheckOrganizationName", 
 		Group: "Organization",
+		Verb: "Check",
+		Resource: "OrganizationName",
+		NameField: "",
 	},
     UpdateOrganization: {
 		Name: "UpdateOrganization", 
 		Group: "Organization",
+		Verb: "Update",
+		Resource: "Organization",
+		NameField: "name",
 	},
     UpdatePrimaryCluster: {
 		Name: "UpdatePrimaryCluster", 
 		Group: "Organization",
+		Verb: "Update",
+		Resource: "PrimaryCluster",
+		NameField: "",
+	},
+    GetOrganizationOnboarding: {
+		Name: "GetOrganizationOnboarding", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "OrganizationOnboarding",
+		NameField: "",
+	},
+    GetOrganizationBranding: {
+		Name: "GetOrganizationBranding", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "OrganizationBranding",
+		NameField: "",
+	},
+    UpdateOrganizationBranding: {
+		Name: "UpdateOrganizationBranding", 
+		Group: "Organization",
+		Verb: "Update",
+		Resource: "OrganizationBranding",
+		NameField: "",
+	},
+    GetNamingPolicies: {
+		Name: "GetNamingPolicies", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "NamingPolicies",
+		NameField: "",
+	},
+    UpdateNamingPolicy: {
+		Name: "UpdateNamingPolicy", 
+		Group: "Organization",
+		Verb: "Update",
+		Resource: "NamingPolicy",
+		NameField: "",
+	},
+    GetOrganizationQuota: {
+		Name: "GetOrganizationQuota", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "OrganizationQuota",
+		NameField: "",
+	},
+    Admin_GetOrganizationQuota: {
+		Name: "Admin_GetOrganizationQuota", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "OrganizationQuota",
+		NameField: "",
+	},
+    Admin_UpdateOrganizationQuota: {
+		Name: "Admin_UpdateOrganizationQuota", 
+		Group: "Organization",
+		Verb: "Update",
+		Resource: "OrganizationQuota",
+		NameField: "",
+	},
+    GetOrganizationUsage: {
+		Name: "GetOrganizationUsage", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "OrganizationUsage",
+		NameField: "",
+	},
+    GetLmaEndpoints: {
+		Name: "GetLmaEndpoints", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "LmaEndpoints",
+		NameField: "",
+	},
+    CreateLmaEndpoint: {
+		Name: "CreateLmaEndpoint", 
+		Group: "Organization",
+		Verb: "Create",
+		Resource: "LmaEndpoint",
+		NameField: "url",
+	},
+    UpdateLmaEndpoint: {
+		Name: "UpdateLmaEndpoint", 
+		Group: "Organization",
+		Verb: "Update",
+		Resource: "LmaEndpoint",
+		NameField: "url",
+	},
+    DeleteLmaEndpoint: {
+		Name: "DeleteLmaEndpoint", 
+		Group: "Organization",
+		Verb: "Delete",
+		Resource: "LmaEndpoint",
+		NameField: "",
+	},
+    GetLmaAuth: {
+		Name: "GetLmaAuth", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "LmaAuth",
+		NameField: "",
+	},
+    UpdateLmaAuth: {
+		Name: "UpdateLmaAuth", 
+		Group: "Organization",
+		Verb: "Update",
+		Resource: "LmaAuth",
+		NameField: "",
+	},
+    GetLmaDiscovery: {
+		Name: "GetLmaDiscovery", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "LmaDiscovery",
+		NameField: "",
+	},
+    UpdateLmaDiscovery: {
+		Name: "UpdateLmaDiscovery", 
+		Group: "Organization",
+		Verb: "Update",
+		Resource: "LmaDiscovery",
+		NameField: "",
+	},
+    ExportManifests: {
+		Name: "ExportManifests", 
+		Group: "Organization",
+		Verb: "Export",
+		Resource: "Manifests",
+		NameField: "",
+	},
+    ApplyManifests: {
+		Name: "ApplyManifests", 
+		Group: "Organization",
+		Verb: "Apply",
+		Resource: "Manifests",
+		NameField: "",
+	},
+    GetEncryptionKeys: {
+		Name: "GetEncryptionKeys", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "EncryptionKeys",
+		NameField: "",
+	},
+    CreateEncryptionKey: {
+		Name: "CreateEncryptionKey", 
+		Group: "Organization",
+		Verb: "Create",
+		Resource: "EncryptionKey",
+		NameField: "keyArn",
+	},
+    RotateEncryptionKey: {
+		Name: "RotateEncryptionKey", 
+		Group: "Organization",
+		Verb: "Rotate",
+		Resource: "EncryptionKey",
+		NameField: "",
+	},
+    RevokeEncryptionKey: {
+		Name: "RevokeEncryptionKey", 
+		Group: "Organization",
+		Verb: "Revoke",
+		Resource: "EncryptionKey",
+		NameField: "",
+	},
+    GetOperations: {
+		Name: "GetOperations", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "Operations",
+		NameField: "",
+	},
+    CancelOperation: {
+		Name: "CancelOperation", 
+		Group: "Organization",
+		Verb: "Cancel",
+		Resource: "Operation",
+		NameField: "",
+	},
+    GetTask: {
+		Name: "GetTask", 
+		Group: "Organization",
+		Verb: "Get",
+		Resource: "Task",
+		NameField: "",
 	},
     CreateCluster: {
 		Name: "CreateCluster", 
 		Group: "Cluster",
+		Verb: "Create",
+		Resource: "Cluster",
+		NameField: "name",
 	},
     GetClusters: {
 		Name: "GetClusters", 
 		Group: "Cluster",
+		Verb: "Get",
+		Resource: "Clusters",
+		NameField: "",
 	},
     ImportCluster: {
 		Name: "ImportCluster", 
 		Group: "Cluster",
+		Verb: "Import",
+		Resource: "Cluster",
+		NameField: "name",
 	},
     GetCluster: {
 		Name: "GetCluster", 
 		Group: "Cluster",
+		Verb: "Get",
+		Resource: "Cluster",
+		NameField: "",
 	},
     DeleteCluster: {
 		Name: "DeleteCluster", 
 		Group: "Cluster",
+		Verb: "Delete",
+		Resource: "Cluster",
+		NameField: "",
 	},
     GetClusterSiteValues: {
 		Name: "GetClusterSiteValues", 
 		Group: "Cluster",
+		Verb: "Get",
+		Resource: "ClusterSiteValues",
+		NameField: "",
 	},
     InstallCluster: {
 		Name: "InstallCluster", 
 		Group: "Cluster",
+		Verb: "Install",
+		Resource: "Cluster",
+		NameField: "",
 	},
     CreateBootstrapKubeconfig: {
 		Name: "CreateBootstrapKubeconfig", 
 		Group: "Cluster",
+		Verb: "Create",
+		Resource: "BootstrapKubeconfig",
+		NameField: "",
 	},
     GetBootstrapKubeconfig: {
 		Name: "GetBootstrapKubeconfig", 
 		Group: "Cluster",
+		Verb: "Get",
+		Resource: "BootstrapKubeconfig",
+		NameField: "",
 	},
     GetNodes: {
 		Name: "GetNodes", 
 		Group: "Cluster",
+		Verb: "Get",
+		Resource: "Nodes",
+		NameField: "",
+	},
+    SearchPods: {
+		Name: "SearchPods", 
+		Group: "Cluster",
+		Verb: "Search",
+		Resource: "Pods",
+		NameField: "",
+	},
+    GetClusterNamespaces: {
+		Name: "GetClusterNamespaces", 
+		Group: "Cluster",
+		Verb: "Get",
+		Resource: "ClusterNamespaces",
+		NameField: "",
+	},
+    GetClusterWorkloads: {
+		Name: "GetClusterWorkloads", 
+		Group: "Cluster",
+		Verb: "Get",
+		Resource: "ClusterWorkloads",
+		NameField: "",
+	},
+    GetClusterPods: {
+		Name: "GetClusterPods", 
+		Group: "Cluster",
+		Verb: "Get",
+		Resource: "ClusterPods",
+		NameField: "",
+	},
+    GetPodLogs: {
+		Name: "GetPodLogs", 
+		Group: "Cluster",
+		Verb: "Get",
+		Resource: "PodLogs",
+		NameField: "",
+	},
+    GetClusterKubeconfig: {
+		Name: "GetClusterKubeconfig", 
+		Group: "Cluster",
+		Verb: "Get",
+		Resource: "ClusterKubeconfig",
+		NameField: "",
 	},
     CreateAppgroup: {
 		Name: "CreateAppgroup", 
 		Group: "Appgroup",
+		Verb: "Create",
+		Resource: "Appgroup",
+		NameField: "",
 	},
     GetAppgroups: {
 		Name: "GetAppgroups", 
 		Group: "Appgroup",
+		Verb: "Get",
+		Resource: "Appgroups",
+		NameField: "",
 	},
     GetAppgroup: {
 		Name: "GetAppgroup", 
 		Group: "Appgroup",
+		Verb: "Get",
+		Resource: "Appgroup",
+		NameField: "",
 	},
     DeleteAppgroup: {
 		Name: "DeleteAppgroup", 
 		Group: "Appgroup",
+		Verb: "Delete",
+		Resource: "Appgroup",
+		NameField: "",
 	},
     GetApplications: {
 		Name: "GetApplications", 
 		Group: "Appgroup",
+		Verb: "Get",
+		Resource: "Applications",
+		NameField: "",
 	},
     CreateApplication: {
 		Name: "CreateApplication", 
 		Group: "Appgroup",
+		Verb: "Create",
+		Resource: "Application",
+		NameField: "",
 	},
     GetAppServeAppTasksByAppId: {
 		Name: "GetAppServeAppTasksByAppId", 
 		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "AppServeAppTasksByAppId",
+		NameField: "",
 	},
     GetAppServeAppTaskDetail: {
 		Name: "GetAppServeAppTaskDetail", 
 		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "AppServeAppTaskDetail",
+		NameField: "",
 	},
     CreateAppServeApp: {
 		Name: "CreateAppServeApp", 
 		Group: "AppServeApp",
+		Verb: "Create",
+		Resource: "AppServeApp",
+		NameField: "name",
 	},
     GetAppServeApps: {
 		Name: "GetAppServeApps", 
 		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "AppServeApps",
+		NameField: "",
 	},
     GetNumOfAppsOnStack: {
 		Name: "GetNumOfAppsOnStack", 
 		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "NumOfAppsOnStack",
+		NameField: "",
+	},
+    GetAppServeAppSummary: {
+		Name: "GetAppServeAppSummary", 
+		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "AppServeAppSummary",
+		NameField: "",
 	},
     GetAppServeApp: {
 		Name: "GetAppServeApp", 
 		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "AppServeApp",
+		NameField: "",
 	},
     GetAppServeAppLatestTask: {
 		Name: "GetAppServeAppLatestTask", 
 		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "AppServeAppLatestTask",
+		NameField: "",
 	},
     IsAppServeAppExist: {
 		Name: "IsAppServeAppExist", 
 		Group: "AppServeApp",
+		Verb: "Is",
+		Resource: "AppServeAppExist",
+		NameField: "",
 	},
     IsAppServeAppNameExist: {
 		Name: "IsAppServeAppNameExist", 
 		Group: "AppServeApp",
+		Verb: "Is",
+		Resource: "AppServeAppNameExist",
+		NameField: "",
 	},
     DeleteAppServeApp: {
 		Name: "DeleteAppServeApp", 
 		Group: "AppServeApp",
+		Verb: "Delete",
+		Resource: "AppServeApp",
+		NameField: "",
 	},
     UpdateAppServeApp: {
 		Name: "UpdateAppServeApp", 
 		Group: "AppServeApp",
+		Verb: "Update",
+		Resource: "AppServeApp",
+		NameField: "",
 	},
     UpdateAppServeAppStatus: {
 		Name: "UpdateAppServeAppStatus", 
 		Group: "AppServeApp",
+		Verb: "Update",
+		Resource: "AppServeAppStatus",
+		NameField: "",
 	},
     UpdateAppServeAppEndpoint: {
 		Name: "UpdateAppServeAppEndpoint", 
 		Group: "AppServeApp",
+		Verb: "Update",
+		Resource: "AppServeAppEndpoint",
+		NameField: "",
 	},
     RollbackAppServeApp: {
 		Name: "RollbackAppServeApp", 
 		Group: "AppServeApp",
+		Verb: "Rollback",
+		Resource: "AppServeApp",
+		NameField: "",
+	},
+    GetAppServeAppMetrics: {
+		Name: "GetAppServeAppMetrics", 
+		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "AppServeAppMetrics",
+		NameField: "",
+	},
+    GetAppServeAppConfigs: {
+		Name: "GetAppServeAppConfigs", 
+		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "AppServeAppConfigs",
+		NameField: "",
+	},
+    GetAppServeAppConfig: {
+		Name: "GetAppServeAppConfig", 
+		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "AppServeAppConfig",
+		NameField: "",
+	},
+    GetAppServeAppConfigDiff: {
+		Name: "GetAppServeAppConfigDiff", 
+		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "AppServeAppConfigDiff",
+		NameField: "",
+	},
+    UpdateAppServeAppConfig: {
+		Name: "UpdateAppServeAppConfig", 
+		Group: "AppServeApp",
+		Verb: "Update",
+		Resource: "AppServeAppConfig",
+		NameField: "",
+	},
+    GetAppServeAppGitSource: {
+		Name: "GetAppServeAppGitSource", 
+		Group: "AppServeApp",
+		Verb: "Get",
+		Resource: "AppServeAppGitSource",
+		NameField: "",
+	},
+    UpdateAppServeAppGitSource: {
+		Name: "UpdateAppServeAppGitSource", 
+		Group: "AppServeApp",
+		Verb: "Update",
+		Resource: "AppServeAppGitSource",
+		NameField: "",
+	},
+    DeleteAppServeAppGitSource: {
+		Name: "DeleteAppServeAppGitSource", 
+		Group: "AppServeApp",
+		Verb: "Delete",
+		Resource: "AppServeAppGitSource",
+		NameField: "",
+	},
+    BuildAppServeApp: {
+		Name: "BuildAppServeApp", 
+		Group: "AppServeApp",
+		Verb: "Build",
+		Resource: "AppServeApp",
+		NameField: "",
 	},
     GetCloudAccounts: {
 		Name: "GetCloudAccounts", 
 		Group: "CloudAccount",
+		Verb: "Get",
+		Resource: "CloudAccounts",
+		NameField: "",
 	},
     CreateCloudAccount: {
 		Name: "CreateCloudAccount", 
 		Group: "CloudAccount",
+		Verb: "Create",
+		Resource: "CloudAccount",
+		NameField: "name",
 	},
     CheckCloudAccountName: {
 		Name: "CheckCloudAccountName", 
 		Group: "CloudAccount",
+		Verb: "Check",
+		Resource: "CloudAccountName",
+		NameField: "",
 	},
     CheckAwsAccountId: {
 		Name: "CheckAwsAccountId", 
 		Group: "CloudAccount",
+		Verb: "Check",
+		Resource: "AwsAccountId",
+		NameField: "",
 	},
     GetCloudAccount: {
 		Name: "GetCloudAccount", 
 		Group: "CloudAccount",
+		Verb: "Get",
+		Resource: "CloudAccount",
+		NameField: "",
 	},
     UpdateCloudAccount: {
 		Name: "UpdateCloudAccount", 
 		Group: "CloudAccount",
+		Verb: "Update",
+		Resource: "CloudAccount",
+		NameField: "",
 	},
     DeleteCloudAccount: {
 		Name: "DeleteCloudAccount", 
 		Group: "CloudAccount",
+		Verb: "Delete",
+		Resource: "CloudAccount",
+		NameField: "",
 	},
     DeleteForceCloudAccount: {
 		Name: "DeleteForceCloudAccount", 
 		Group: "CloudAccount",
+		Verb: "Delete",
+		Resource: "ForceCloudAccount",
+		NameField: "",
+	},
+    GetCloudAccountPricing: {
+		Name: "GetCloudAccountPricing", 
+		Group: "CloudAccount",
+		Verb: "Get",
+		Resource: "CloudAccountPricing",
+		NameField: "",
+	},
+    UpdateCloudAccountPricing: {
+		Name: "UpdateCloudAccountPricing", 
+		Group: "CloudAccount",
+		Verb: "Update",
+		Resource: "CloudAccountPricing",
+		NameField: "",
+	},
+    DeleteCloudAccountPricing: {
+		Name: "DeleteCloudAccountPricing", 
+		Group: "CloudAccount",
+		Verb: "Delete",
+		Resource: "CloudAccountPricing",
+		NameField: "",
 	},
     GetResourceQuota: {
 		Name: "GetResourceQuota", 
 		Group: "CloudAccount",
+		Verb: "Get",
+		Resource: "ResourceQuota",
+		NameField: "",
+	},
+    VerifyCloudAccount: {
+		Name: "VerifyCloudAccount", 
+		Group: "CloudAccount",
+		Verb: "Verify",
+		Resource: "CloudAccount",
+		NameField: "",
 	},
     Admin_GetStackTemplates: {
 		Name: "Admin_GetStackTemplates", 
 		Group: "StackTemplate",
+		Verb: "Get",
+		Resource: "StackTemplates",
+		NameField: "",
 	},
     Admin_GetStackTemplate: {
 		Name: "Admin_GetStackTemplate", 
 		Group: "StackTemplate",
+		Verb: "Get",
+		Resource: "StackTemplate",
+		NameField: "",
 	},
     Admin_GetStackTemplateServices: {
 		Name: "Admin_GetStackTemplateServices", 
 		Group: "StackTemplate",
+		Verb: "Get",
+		Resource: "StackTemplateServices",
+		NameField: "",
 	},
     Admin_GetStackTemplateTemplateIds: {
 		Name: "Admin_GetStackTemplateTemplateIds", 
 		Group: "StackTemplate",
+		Verb: "Get",
+		Resource: "StackTemplateTemplateIds",
+		NameField: "",
 	},
     Admin_CreateStackTemplate: {
 		Name: "Admin_CreateStackTemplate", 
 		Group: "StackTemplate",
+		Verb: "Create",
+		Resource: "StackTemplate",
+		NameField: "name",
 	},
     Admin_UpdateStackTemplate: {
 		Name: "Admin_UpdateStackTemplate", 
 		Group: "StackTemplate",
+		Verb: "Update",
+		Resource: "StackTemplate",
+		NameField: "",
 	},
     Admin_DeleteStackTemplate: {
 		Name: "Admin_DeleteStackTemplate", 
 		Group: "StackTemplate",
+		Verb: "Delete",
+		Resource: "StackTemplate",
+		NameField: "",
 	},
     Admin_UpdateStackTemplateOrganizations: {
 		Name: "Admin_UpdateStackTemplateOrganizations", 
 		Group: "StackTemplate",
+		Verb: "Update",
+		Resource: "StackTemplateOrganizations",
+		NameField: "",
 	},
     Admin_CheckStackTemplateName: {
 		Name: "Admin_CheckStackTemplateName", 
 		Group: "StackTemplate",
+		Verb: "Check",
+		Resource: "StackTemplateName",
+		NameField: "",
 	},
     GetOrganizationStackTemplates: {
 		Name: "GetOrganizationStackTemplates", 
 		Group: "StackTemplate",
+		Verb: "Get",
+		Resource: "OrganizationStackTemplates",
+		NameField: "",
 	},
     GetOrganizationStackTemplate: {
 		Name: "GetOrganizationStackTemplate", 
 		Group: "StackTemplate",
+		Verb: "Get",
+		Resource: "OrganizationStackTemplate",
+		NameField: "",
 	},
     AddOrganizationStackTemplates: {
 		Name: "AddOrganizationStackTemplates", 
 		Group: "StackTemplate",
+		Verb: "Add",
+		Resource: "OrganizationStackTemplates",
+		NameField: "",
 	},
     RemoveOrganizationStackTemplates: {
 		Name: "RemoveOrganizationStackTemplates", 
 		Group: "StackTemplate",
+		Verb: "Remove",
+		Resource: "OrganizationStackTemplates",
+		NameField: "",
 	},
     CreateDashboard: {
 		Name: "CreateDashboard", 
 		Group: "Dashboard",
+		Verb: "Create",
+		Resource: "Dashboard",
+		NameField: "dashboardKey",
 	},
     GetDashboard: {
 		Name: "GetDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "Dashboard",
+		NameField: "",
 	},
     UpdateDashboard: {
 		Name: "UpdateDashboard", 
 		Group: "Dashboard",
+		Verb: "Update",
+		Resource: "Dashboard",
+		NameField: "",
 	},
     GetChartsDashboard: {
 		Name: "GetChartsDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "ChartsDashboard",
+		NameField: "",
 	},
     GetChartDashboard: {
 		Name: "GetChartDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "ChartDashboard",
+		NameField: "",
+	},
+    StreamChartsDashboard: {
+		Name: "StreamChartsDashboard", 
+		Group: "Dashboard",
+		Verb: "Stream",
+		Resource: "ChartsDashboard",
+		NameField: "",
 	},
     GetStacksDashboard: {
 		Name: "GetStacksDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "StacksDashboard",
+		NameField: "",
 	},
     GetResourcesDashboard: {
 		Name: "GetResourcesDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "ResourcesDashboard",
+		NameField: "",
+	},
+    GetResourcesDashboardV2: {
+		Name: "GetResourcesDashboardV2", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "ResourcesDashboardV2",
+		NameField: "",
+	},
+    GetStackNodesDashboard: {
+		Name: "GetStackNodesDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "StackNodesDashboard",
+		NameField: "",
+	},
+    GetStackChartsDashboard: {
+		Name: "GetStackChartsDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "StackChartsDashboard",
+		NameField: "",
+	},
+    GetStackChartDashboard: {
+		Name: "GetStackChartDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "StackChartDashboard",
+		NameField: "",
+	},
+    GetStoragesDashboard: {
+		Name: "GetStoragesDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "StoragesDashboard",
+		NameField: "",
+	},
+    GetNetworkPoliciesDashboard: {
+		Name: "GetNetworkPoliciesDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "NetworkPoliciesDashboard",
+		NameField: "",
+	},
+    GetIdentityStatusDashboard: {
+		Name: "GetIdentityStatusDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "IdentityStatusDashboard",
+		NameField: "",
+	},
+    GetHealthDashboard: {
+		Name: "GetHealthDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "HealthDashboard",
+		NameField: "",
+	},
+    GetCostsDashboard: {
+		Name: "GetCostsDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "CostsDashboard",
+		NameField: "",
+	},
+    GetClusterEventsDashboard: {
+		Name: "GetClusterEventsDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "ClusterEventsDashboard",
+		NameField: "",
 	},
     GetPolicyStatusDashboard: {
 		Name: "GetPolicyStatusDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "PolicyStatusDashboard",
+		NameField: "",
 	},
     GetPolicyUpdateDashboard: {
 		Name: "GetPolicyUpdateDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "PolicyUpdateDashboard",
+		NameField: "",
 	},
     GetPolicyEnforcementDashboard: {
 		Name: "GetPolicyEnforcementDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "PolicyEnforcementDashboard",
+		NameField: "",
 	},
     GetPolicyViolationDashboard: {
 		Name: "GetPolicyViolationDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "PolicyViolationDashboard",
+		NameField: "",
 	},
     GetPolicyViolationLogDashboard: {
 		Name: "GetPolicyViolationLogDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "PolicyViolationLogDashboard",
+		NameField: "",
 	},
     GetPolicyStatisticsDashboard: {
 		Name: "GetPolicyStatisticsDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "PolicyStatisticsDashboard",
+		NameField: "",
 	},
     GetWorkloadDashboard: {
 		Name: "GetWorkloadDashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "WorkloadDashboard",
+		NameField: "",
 	},
     GetPolicyViolationTop5Dashboard: {
 		Name: "GetPolicyViolationTop5Dashboard", 
 		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "PolicyViolationTop5Dashboard",
+		NameField: "",
+	},
+    GetPolicyEnforcementStatusDashboard: {
+		Name: "GetPolicyEnforcementStatusDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "PolicyEnforcementStatusDashboard",
+		NameField: "",
+	},
+    CreateCustomChartDashboard: {
+		Name: "CreateCustomChartDashboard", 
+		Group: "Dashboard",
+		Verb: "Create",
+		Resource: "CustomChartDashboard",
+		NameField: "",
+	},
+    GetCustomChartsDashboard: {
+		Name: "GetCustomChartsDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "CustomChartsDashboard",
+		NameField: "",
+	},
+    GetCustomChartDashboard: {
+		Name: "GetCustomChartDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "CustomChartDashboard",
+		NameField: "",
+	},
+    UpdateCustomChartDashboard: {
+		Name: "UpdateCustomChartDashboard", 
+		Group: "Dashboard",
+		Verb: "Update",
+		Resource: "CustomChartDashboard",
+		NameField: "",
+	},
+    DeleteCustomChartDashboard: {
+		Name: "DeleteCustomChartDashboard", 
+		Group: "Dashboard",
+		Verb: "Delete",
+		Resource: "CustomChartDashboard",
+		NameField: "",
+	},
+    GetCustomChartDataDashboard: {
+		Name: "GetCustomChartDataDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "CustomChartDataDashboard",
+		NameField: "",
+	},
+    CreateChartSnapshotDashboard: {
+		Name: "CreateChartSnapshotDashboard", 
+		Group: "Dashboard",
+		Verb: "Create",
+		Resource: "ChartSnapshotDashboard",
+		NameField: "",
+	},
+    GetChartSnapshotsDashboard: {
+		Name: "GetChartSnapshotsDashboard", 
+		Group: "Dashboard",
+		Verb: "Get",
+		Resource: "ChartSnapshotsDashboard",
+		NameField: "",
+	},
+    DeleteChartSnapshotDashboard: {
+		Name: "DeleteChartSnapshotDashboard", 
+		Group: "Dashboard",
+		Verb: "Delete",
+		Resource: "ChartSnapshotDashboard",
+		NameField: "",
+	},
+    Admin_WarmupDashboardCaches: {
+		Name: "Admin_WarmupDashboardCaches", 
+		Group: "Dashboard",
+		Verb: "Warmup",
+		Resource: "DashboardCaches",
+		NameField: "",
 	},
     Admin_CreateSystemNotificationTemplate: {
 		Name: "Admin_CreateSystemNotificationTemplate", 
 		Group: "SystemNotificationTemplate",
+		Verb: "Create",
+		Resource: "SystemNotificationTemplate",
+		NameField: "name",
 	},
     Admin_UpdateSystemNotificationTemplate: {
 		Name: "Admin_UpdateSystemNotificationTemplate", 
 		Group: "SystemNotificationTemplate",
+		Verb: "Update",
+		Resource: "SystemNotificationTemplate",
+		NameField: "name",
 	},
     Admin_DeleteSystemNotificationTemplate: {
 		Name: "Admin_DeleteSystemNotificationTemplate", 
 		Group: "SystemNotificationTemplate",
+		Verb: "Delete",
+		Resource: "SystemNotificationTemplate",
+		NameField: "",
 	},
     Admin_GetSystemNotificationTemplates: {
 		Name: "Admin_GetSystemNotificationTemplates", 
 		Group: "SystemNotificationTemplate",
+		Verb: "Get",
+		Resource: "SystemNotificationTemplates",
+		NameField: "",
 	},
     Admin_GetSystemNotificationTemplate: {
 		Name: "Admin_GetSystemNotificationTemplate", 
 		Group: "SystemNotificationTemplate",
+		Verb: "Get",
+		Resource: "SystemNotificationTemplate",
+		NameField: "",
 	},
     Admin_CheckSystemNotificationTemplateName: {
 		Name: "Admin_CheckSystemNotificationTemplateName", 
 		Group: "SystemNotificationTemplate",
+		Verb: "Check",
+		Resource: "SystemNotificationTemplateName",
+		NameField: "",
 	},
     GetOrganizationSystemNotificationTemplates: {
 		Name: "GetOrganizationSystemNotificationTemplates", 
 		Group: "SystemNotificationTemplate",
+		Verb: "Get",
+		Resource: "OrganizationSystemNotificationTemplates",
+		NameField: "",
 	},
     GetOrganizationSystemNotificationTemplate: {
 		Name: "GetOrganizationSystemNotificationTemplate", 
 		Group: "SystemNotificationTemplate",
+		Verb: "Get",
+		Resource: "OrganizationSystemNotificationTemplate",
+		NameField: "",
 	},
     AddOrganizationSystemNotificationTemplates: {
 		Name: "AddOrganizationSystemNotificationTemplates", 
 		Group: "SystemNotificationTemplate",
+		Verb: "Add",
+		Resource: "OrganizationSystemNotificationTemplates",
+		NameField: "",
 	},
     RemoveOrganizationSystemNotificationTemplates: {
 		Name: "RemoveOrganizationSystemNotificationTemplates", 
 		Group: "SystemNotificationTemplate",
+		Verb: "Remove",
+		Resource: "OrganizationSystemNotificationTemplates",
+		NameField: "",
 	},
     CreateSystemNotificationRule: {
 		Name: "CreateSystemNotificationRule", 
 		Group: "SystemNotificationRule",
+		Verb: "Create",
+		Resource: "SystemNotificationRule",
+		NameField: "name",
 	},
     GetSystemNotificationRules: {
 		Name: "GetSystemNotificationRules", 
 		Group: "SystemNotificationRule",
+		Verb: "Get",
+		Resource: "SystemNotificationRules",
+		NameField: "",
 	},
     GetSystemNotificationRule: {
 		Name: "GetSystemNotificationRule", 
 		Group: "SystemNotificationRule",
+		Verb: "Get",
+		Resource: "SystemNotificationRule",
+		NameField: "",
 	},
     CheckSystemNotificationRuleName: {
 		Name: "CheckSystemNotificationRuleName", 
 		Group: "SystemNotificationRule",
+		Verb: "Check",
+		Resource: "SystemNotificationRuleName",
+		NameField: "",
 	},
     DeleteSystemNotificationRule: {
 		Name: "DeleteSystemNotificationRule", 
 		Group: "SystemNotificationRule",
+		Verb: "Delete",
+		Resource: "SystemNotificationRule",
+		NameField: "",
 	},
     UpdateSystemNotificationRule: {
 		Name: "UpdateSystemNotificationRule", 
 		Group: "SystemNotificationRule",
+		Verb: "Update",
+		Resource: "SystemNotificationRule",
+		NameField: "name",
 	},
     MakeDefaultSystemNotificationRules: {
 		Name: "MakeDefaultSystemNotificationRules", 
 		Group: "SystemNotificationRule",
+		Verb: "Make",
+		Resource: "DefaultSystemNotificationRules",
+		NameField: "",
+	},
+    CreateAlertIngestionToken: {
+		Name: "CreateAlertIngestionToken", 
+		Group: "AlertIngestionToken",
+		Verb: "Create",
+		Resource: "AlertIngestionToken",
+		NameField: "name",
+	},
+    GetAlertIngestionTokens: {
+		Name: "GetAlertIngestionTokens", 
+		Group: "AlertIngestionToken",
+		Verb: "Get",
+		Resource: "AlertIngestionTokens",
+		NameField: "",
+	},
+    RotateAlertIngestionToken: {
+		Name: "RotateAlertIngestionToken", 
+		Group: "AlertIngestionToken",
+		Verb: "Rotate",
+		Resource: "AlertIngestionToken",
+		NameField: "",
+	},
+    RevokeAlertIngestionToken: {
+		Name: "RevokeAlertIngestionToken", 
+		Group: "AlertIngestionToken",
+		Verb: "Revoke",
+		Resource: "AlertIngestionToken",
+		NameField: "",
+	},
+    CreateAlertRoutingRule: {
+		Name: "CreateAlertRoutingRule", 
+		Group: "AlertRoutingRule",
+		Verb: "Create",
+		Resource: "AlertRoutingRule",
+		NameField: "name",
+	},
+    GetAlertRoutingRules: {
+		Name: "GetAlertRoutingRules", 
+		Group: "AlertRoutingRule",
+		Verb: "Get",
+		Resource: "AlertRoutingRules",
+		NameField: "",
+	},
+    GetAlertRoutingRule: {
+		Name: "GetAlertRoutingRule", 
+		Group: "AlertRoutingRule",
+		Verb: "Get",
+		Resource: "AlertRoutingRule",
+		NameField: "",
+	},
+    UpdateAlertRoutingRule: {
+		Name: "UpdateAlertRoutingRule", 
+		Group: "AlertRoutingRule",
+		Verb: "Update",
+		Resource: "AlertRoutingRule",
+		NameField: "name",
+	},
+    DeleteAlertRoutingRule: {
+		Name: "DeleteAlertRoutingRule", 
+		Group: "AlertRoutingRule",
+		Verb: "Delete",
+		Resource: "AlertRoutingRule",
+		NameField: "",
+	},
+    TestAlertRoutingRules: {
+		Name: "TestAlertRoutingRules", 
+		Group: "AlertRoutingRule",
+		Verb: "Test",
+		Resource: "AlertRoutingRules",
+		NameField: "",
+	},
+    CreateAlertChannel: {
+		Name: "CreateAlertChannel", 
+		Group: "AlertChannel",
+		Verb: "Create",
+		Resource: "AlertChannel",
+		NameField: "name",
+	},
+    GetAlertChannels: {
+		Name: "GetAlertChannels", 
+		Group: "AlertChannel",
+		Verb: "Get",
+		Resource: "AlertChannels",
+		NameField: "",
+	},
+    GetAlertChannel: {
+		Name: "GetAlertChannel", 
+		Group: "AlertChannel",
+		Verb: "Get",
+		Resource: "AlertChannel",
+		NameField: "",
+	},
+    UpdateAlertChannel: {
+		Name: "UpdateAlertChannel", 
+		Group: "AlertChannel",
+		Verb: "Update",
+		Resource: "AlertChannel",
+		NameField: "name",
+	},
+    DeleteAlertChannel: {
+		Name: "DeleteAlertChannel", 
+		Group: "AlertChannel",
+		Verb: "Delete",
+		Resource: "AlertChannel",
+		NameField: "",
+	},
+    TestAlertChannel: {
+		Name: "TestAlertChannel", 
+		Group: "AlertChannel",
+		Verb: "Test",
+		Resource: "AlertChannel",
+		NameField: "",
+	},
+    CreateAlertSilence: {
+		Name: "CreateAlertSilence", 
+		Group: "AlertSilence",
+		Verb: "Create",
+		Resource: "AlertSilence",
+		NameField: "name",
+	},
+    GetAlertSilences: {
+		Name: "GetAlertSilences", 
+		Group: "AlertSilence",
+		Verb: "Get",
+		Resource: "AlertSilences",
+		NameField: "",
+	},
+    GetAlertSilence: {
+		Name: "GetAlertSilence", 
+		Group: "AlertSilence",
+		Verb: "Get",
+		Resource: "AlertSilence",
+		NameField: "",
+	},
+    UpdateAlertSilence: {
+		Name: "UpdateAlertSilence", 
+		Group: "AlertSilence",
+		Verb: "Update",
+		Resource: "AlertSilence",
+		NameField: "name",
+	},
+    DeleteAlertSilence: {
+		Name: "DeleteAlertSilence", 
+		Group: "AlertSilence",
+		Verb: "Delete",
+		Resource: "AlertSilence",
+		NameField: "",
+	},
+    CreateEscalationPolicy: {
+		Name: "CreateEscalationPolicy", 
+		Group: "EscalationPolicy",
+		Verb: "Create",
+		Resource: "EscalationPolicy",
+		NameField: "name",
+	},
+    GetEscalationPolicies: {
+		Name: "GetEscalationPolicies", 
+		Group: "EscalationPolicy",
+		Verb: "Get",
+		Resource: "EscalationPolicies",
+		NameField: "",
+	},
+    GetEscalationPolicy: {
+		Name: "GetEscalationPolicy", 
+		Group: "EscalationPolicy",
+		Verb: "Get",
+		Resource: "EscalationPolicy",
+		NameField: "",
+	},
+    UpdateEscalationPolicy: {
+		Name: "UpdateEscalationPolicy", 
+		Group: "EscalationPolicy",
+		Verb: "Update",
+		Resource: "EscalationPolicy",
+		NameField: "name",
+	},
+    DeleteEscalationPolicy: {
+		Name: "DeleteEscalationPolicy", 
+		Group: "EscalationPolicy",
+		Verb: "Delete",
+		Resource: "EscalationPolicy",
+		NameField: "",
 	},
     CreateSystemNotification: {
 		Name: "CreateSystemNotification", 
 		Group: "SystemNotification",
+		Verb: "Create",
+		Resource: "SystemNotification",
+		NameField: "",
 	},
     GetSystemNotifications: {
 		Name: "GetSystemNotifications", 
 		Group: "SystemNotification",
+		Verb: "Get",
+		Resource: "SystemNotifications",
+		NameField: "",
 	},
     GetSystemNotification: {
 		Name: "GetSystemNotification", 
 		Group: "SystemNotification",
+		Verb: "Get",
+		Resource: "SystemNotification",
+		NameField: "",
 	},
     DeleteSystemNotification: {
 		Name: "DeleteSystemNotification", 
 		Group: "SystemNotification",
+		Verb: "Delete",
+		Resource: "SystemNotification",
+		NameField: "",
 	},
     UpdateSystemNotification: {
 		Name: "UpdateSystemNotification", 
 		Group: "SystemNotification",
+		Verb: "Update",
+		Resource: "SystemNotification",
+		NameField: "",
 	},
     CreateSystemNotificationAction: {
 		Name: "CreateSystemNotificationAction", 
 		Group: "SystemNotification",
+		Verb: "Create",
+		Resource: "SystemNotificationAction",
+		NameField: "",
 	},
     GetPolicyNotifications: {
 		Name: "GetPolicyNotifications", 
 		Group: "PolicyNotification",
+		Verb: "Get",
+		Resource: "PolicyNotifications",
+		NameField: "",
 	},
     GetPolicyNotification: {
 		Name: "GetPolicyNotification", 
 		Group: "PolicyNotification",
+		Verb: "Get",
+		Resource: "PolicyNotification",
+		NameField: "",
 	},
     GetStacks: {
 		Name: "GetStacks", 
 		Group: "Stack",
+		Verb: "Get",
+		Resource: "Stacks",
+		NameField: "",
 	},
     CreateStack: {
 		Name: "CreateStack", 
 		Group: "Stack",
+		Verb: "Create",
+		Resource: "Stack",
+		NameField: "name",
 	},
     CheckStackName: {
 		Name: "CheckStackName", 
 		Group: "Stack",
+		Verb: "Check",
+		Resource: "StackName",
+		NameField: "",
 	},
     GetStack: {
 		Name: "GetStack", 
 		Group: "Stack",
+		Verb: "Get",
+		Resource: "Stack",
+		NameField: "",
 	},
     UpdateStack: {
 		Name: "UpdateStack", 
 		Group: "Stack",
+		Verb: "Update",
+		Resource: "Stack",
+		NameField: "",
 	},
     DeleteStack: {
 		Name: "DeleteStack", 
 		Group: "Stack",
+		Verb: "Delete",
+		Resource: "Stack",
+		NameField: "",
 	},
     GetStackKubeConfig: {
 		Name: "GetStackKubeConfig", 
 		Group: "Stack",
+		Verb: "Get",
+		Resource: "StackKubeConfig",
+		NameField: "",
 	},
     GetStackStatus: {
 		Name: "GetStackStatus", 
 		Group: "Stack",
+		Verb: "Get",
+		Resource: "StackStatus",
+		NameField: "",
 	},
     SetFavoriteStack: {
 		Name: "SetFavoriteStack", 
 		Group: "Stack",
+		Verb: "Set",
+		Resource: "FavoriteStack",
+		NameField: "",
 	},
     DeleteFavoriteStack: {
 		Name: "DeleteFavoriteStack", 
 		Group: "Stack",
+		Verb: "Delete",
+		Resource: "FavoriteStack",
+		NameField: "",
 	},
     InstallStack: {
 		Name: "InstallStack", 
 		Group: "Stack",
+		Verb: "Install",
+		Resource: "Stack",
+		NameField: "",
+	},
+    GetStackAddonVersions: {
+		Name: "GetStackAddonVersions", 
+		Group: "Stack",
+		Verb: "Get",
+		Resource: "StackAddonVersions",
+		NameField: "",
+	},
+    GetStackNodes: {
+		Name: "GetStackNodes", 
+		Group: "Stack",
+		Verb: "Get",
+		Resource: "StackNodes",
+		NameField: "",
+	},
+    ScaleStackNodeGroup: {
+		Name: "ScaleStackNodeGroup", 
+		Group: "Stack",
+		Verb: "Scale",
+		Resource: "StackNodeGroup",
+		NameField: "",
+	},
+    UpgradeStack: {
+		Name: "UpgradeStack", 
+		Group: "Stack",
+		Verb: "Upgrade",
+		Resource: "Stack",
+		NameField: "",
+	},
+    GetStackUpgrades: {
+		Name: "GetStackUpgrades", 
+		Group: "Stack",
+		Verb: "Get",
+		Resource: "StackUpgrades",
+		NameField: "",
+	},
+    CreateStackWebhook: {
+		Name: "CreateStackWebhook", 
+		Group: "StackWebhook",
+		Verb: "Create",
+		Resource: "StackWebhook",
+		NameField: "name",
+	},
+    GetStackWebhooks: {
+		Name: "GetStackWebhooks", 
+		Group: "StackWebhook",
+		Verb: "Get",
+		Resource: "StackWebhooks",
+		NameField: "",
+	},
+    GetStackWebhook: {
+		Name: "GetStackWebhook", 
+		Group: "StackWebhook",
+		Verb: "Get",
+		Resource: "StackWebhook",
+		NameField: "",
+	},
+    UpdateStackWebhook: {
+		Name: "UpdateStackWebhook", 
+		Group: "StackWebhook",
+		Verb: "Update",
+		Resource: "StackWebhook",
+		NameField: "name",
+	},
+    DeleteStackWebhook: {
+		Name: "DeleteStackWebhook", 
+		Group: "StackWebhook",
+		Verb: "Delete",
+		Resource: "StackWebhook",
+		NameField: "",
+	},
+    GetCloudHealthEvents: {
+		Name: "GetCloudHealthEvents", 
+		Group: "CloudHealthEvent",
+		Verb: "Get",
+		Resource: "CloudHealthEvents",
+		NameField: "",
+	},
+    GetStackDefault: {
+		Name: "GetStackDefault", 
+		Group: "StackDefault",
+		Verb: "Get",
+		Resource: "StackDefault",
+		NameField: "",
+	},
+    Admin_GetStackDefault: {
+		Name: "Admin_GetStackDefault", 
+		Group: "StackDefault",
+		Verb: "Get",
+		Resource: "StackDefault",
+		NameField: "",
+	},
+    Admin_UpdateStackDefault: {
+		Name: "Admin_UpdateStackDefault", 
+		Group: "StackDefault",
+		Verb: "Update",
+		Resource: "StackDefault",
+		NameField: "",
+	},
+    Admin_DeleteStackDefault: {
+		Name: "Admin_DeleteStackDefault", 
+		Group: "StackDefault",
+		Verb: "Delete",
+		Resource: "StackDefault",
+		NameField: "",
+	},
+    GetCostAllocationTagPolicies: {
+		Name: "GetCostAllocationTagPolicies", 
+		Group: "CostAllocationTag",
+		Verb: "Get",
+		Resource: "CostAllocationTagPolicies",
+		NameField: "",
+	},
+    CheckStackCostAllocationTagDrift: {
+		Name: "CheckStackCostAllocationTagDrift", 
+		Group: "CostAllocationTag",
+		Verb: "Check",
+		Resource: "StackCostAllocationTagDrift",
+		NameField: "",
+	},
+    Admin_GetCostAllocationTagPolicies: {
+		Name: "Admin_GetCostAllocationTagPolicies", 
+		Group: "CostAllocationTag",
+		Verb: "Get",
+		Resource: "CostAllocationTagPolicies",
+		NameField: "",
+	},
+    Admin_UpdateCostAllocationTagPolicies: {
+		Name: "Admin_UpdateCostAllocationTagPolicies", 
+		Group: "CostAllocationTag",
+		Verb: "Update",
+		Resource: "CostAllocationTagPolicies",
+		NameField: "",
+	},
+    CreateClusterAccessRequest: {
+		Name: "CreateClusterAccessRequest", 
+		Group: "ClusterAccessRequest",
+		Verb: "Create",
+		Resource: "ClusterAccessRequest",
+		NameField: "",
+	},
+    GetClusterAccessRequests: {
+		Name: "GetClusterAccessRequests", 
+		Group: "ClusterAccessRequest",
+		Verb: "Get",
+		Resource: "ClusterAccessRequests",
+		NameField: "",
+	},
+    GetClusterAccessRequest: {
+		Name: "GetClusterAccessRequest", 
+		Group: "ClusterAccessRequest",
+		Verb: "Get",
+		Resource: "ClusterAccessRequest",
+		NameField: "",
+	},
+    ApproveClusterAccessRequest: {
+		Name: "ApproveClusterAccessRequest", 
+		Group: "ClusterAccessRequest",
+		Verb: "Approve",
+		Resource: "ClusterAccessRequest",
+		NameField: "",
+	},
+    RejectClusterAccessRequest: {
+		Name: "RejectClusterAccessRequest", 
+		Group: "ClusterAccessRequest",
+		Verb: "Reject",
+		Resource: "ClusterAccessRequest",
+		NameField: "",
+	},
+    RevokeClusterAccessRequest: {
+		Name: "RevokeClusterAccessRequest", 
+		Group: "ClusterAccessRequest",
+		Verb: "Revoke",
+		Resource: "ClusterAccessRequest",
+		NameField: "",
+	},
+    GetDeploymentApprovalPolicy: {
+		Name: "GetDeploymentApprovalPolicy", 
+		Group: "DeploymentApproval",
+		Verb: "Get",
+		Resource: "DeploymentApprovalPolicy",
+		NameField: "",
+	},
+    UpdateDeploymentApprovalPolicy: {
+		Name: "UpdateDeploymentApprovalPolicy", 
+		Group: "DeploymentApproval",
+		Verb: "Update",
+		Resource: "DeploymentApprovalPolicy",
+		NameField: "",
+	},
+    DeleteDeploymentApprovalPolicy: {
+		Name: "DeleteDeploymentApprovalPolicy", 
+		Group: "DeploymentApproval",
+		Verb: "Delete",
+		Resource: "DeploymentApprovalPolicy",
+		NameField: "",
+	},
+    GetDeploymentApprovals: {
+		Name: "GetDeploymentApprovals", 
+		Group: "DeploymentApproval",
+		Verb: "Get",
+		Resource: "DeploymentApprovals",
+		NameField: "",
+	},
+    GetDeploymentApproval: {
+		Name: "GetDeploymentApproval", 
+		Group: "DeploymentApproval",
+		Verb: "Get",
+		Resource: "DeploymentApproval",
+		NameField: "",
+	},
+    ApproveDeployment: {
+		Name: "ApproveDeployment", 
+		Group: "DeploymentApproval",
+		Verb: "Approve",
+		Resource: "Deployment",
+		NameField: "",
+	},
+    RejectDeployment: {
+		Name: "RejectDeployment", 
+		Group: "DeploymentApproval",
+		Verb: "Reject",
+		Resource: "Deployment",
+		NameField: "",
+	},
+    CreateMaintenanceWindow: {
+		Name: "CreateMaintenanceWindow", 
+		Group: "MaintenanceWindow",
+		Verb: "Create",
+		Resource: "MaintenanceWindow",
+		NameField: "name",
+	},
+    GetMaintenanceWindows: {
+		Name: "GetMaintenanceWindows", 
+		Group: "MaintenanceWindow",
+		Verb: "Get",
+		Resource: "MaintenanceWindows",
+		NameField: "",
+	},
+    GetOrganizationMaintenanceWindows: {
+		Name: "GetOrganizationMaintenanceWindows", 
+		Group: "MaintenanceWindow",
+		Verb: "Get",
+		Resource: "OrganizationMaintenanceWindows",
+		NameField: "",
+	},
+    GetMaintenanceWindow: {
+		Name: "GetMaintenanceWindow", 
+		Group: "MaintenanceWindow",
+		Verb: "Get",
+		Resource: "MaintenanceWindow",
+		NameField: "",
+	},
+    UpdateMaintenanceWindow: {
+		Name: "UpdateMaintenanceWindow", 
+		Group: "MaintenanceWindow",
+		Verb: "Update",
+		Resource: "MaintenanceWindow",
+		NameField: "name",
+	},
+    DeleteMaintenanceWindow: {
+		Name: "DeleteMaintenanceWindow", 
+		Group: "MaintenanceWindow",
+		Verb: "Delete",
+		Resource: "MaintenanceWindow",
+		NameField: "",
+	},
+    CreateStackMonitoringEndpoint: {
+		Name: "CreateStackMonitoringEndpoint", 
+		Group: "StackMonitoringEndpoint",
+		Verb: "Create",
+		Resource: "StackMonitoringEndpoint",
+		NameField: "name",
+	},
+    GetStackMonitoringEndpoints: {
+		Name: "GetStackMonitoringEndpoints", 
+		Group: "StackMonitoringEndpoint",
+		Verb: "Get",
+		Resource: "StackMonitoringEndpoints",
+		NameField: "",
+	},
+    GetStackMonitoringEndpoint: {
+		Name: "GetStackMonitoringEndpoint", 
+		Group: "StackMonitoringEndpoint",
+		Verb: "Get",
+		Resource: "StackMonitoringEndpoint",
+		NameField: "",
+	},
+    UpdateStackMonitoringEndpoint: {
+		Name: "UpdateStackMonitoringEndpoint", 
+		Group: "StackMonitoringEndpoint",
+		Verb: "Update",
+		Resource: "StackMonitoringEndpoint",
+		NameField: "name",
+	},
+    DeleteStackMonitoringEndpoint: {
+		Name: "DeleteStackMonitoringEndpoint", 
+		Group: "StackMonitoringEndpoint",
+		Verb: "Delete",
+		Resource: "StackMonitoringEndpoint",
+		NameField: "",
+	},
+    GetAvailabilityDashboard: {
+		Name: "GetAvailabilityDashboard", 
+		Group: "StackMonitoringEndpoint",
+		Verb: "Get",
+		Resource: "AvailabilityDashboard",
+		NameField: "",
+	},
+    Admin_GetHelmRepositories: {
+		Name: "Admin_GetHelmRepositories", 
+		Group: "Catalog",
+		Verb: "Get",
+		Resource: "HelmRepositories",
+		NameField: "",
+	},
+    Admin_CreateHelmRepository: {
+		Name: "Admin_CreateHelmRepository", 
+		Group: "Catalog",
+		Verb: "Create",
+		Resource: "HelmRepository",
+		NameField: "",
+	},
+    Admin_DeleteHelmRepository: {
+		Name: "Admin_DeleteHelmRepository", 
+		Group: "Catalog",
+		Verb: "Delete",
+		Resource: "HelmRepository",
+		NameField: "",
+	},
+    Admin_SyncHelmRepository: {
+		Name: "Admin_SyncHelmRepository", 
+		Group: "Catalog",
+		Verb: "Sync",
+		Resource: "HelmRepository",
+		NameField: "",
+	},
+    GetCatalogCharts: {
+		Name: "GetCatalogCharts", 
+		Group: "Catalog",
+		Verb: "Get",
+		Resource: "CatalogCharts",
+		NameField: "",
+	},
+    GetCatalogChart: {
+		Name: "GetCatalogChart", 
+		Group: "Catalog",
+		Verb: "Get",
+		Resource: "CatalogChart",
+		NameField: "",
+	},
+    CreateCatalogApp: {
+		Name: "CreateCatalogApp", 
+		Group: "Catalog",
+		Verb: "Create",
+		Resource: "CatalogApp",
+		NameField: "",
+	},
+    GetCatalogApps: {
+		Name: "GetCatalogApps", 
+		Group: "Catalog",
+		Verb: "Get",
+		Resource: "CatalogApps",
+		NameField: "",
+	},
+    GetGitProviders: {
+		Name: "GetGitProviders", 
+		Group: "GitProvider",
+		Verb: "Get",
+		Resource: "GitProviders",
+		NameField: "",
+	},
+    GetGitProvider: {
+		Name: "GetGitProvider", 
+		Group: "GitProvider",
+		Verb: "Get",
+		Resource: "GitProvider",
+		NameField: "",
+	},
+    CreateGitProvider: {
+		Name: "CreateGitProvider", 
+		Group: "GitProvider",
+		Verb: "Create",
+		Resource: "GitProvider",
+		NameField: "",
+	},
+    UpdateGitProvider: {
+		Name: "UpdateGitProvider", 
+		Group: "GitProvider",
+		Verb: "Update",
+		Resource: "GitProvider",
+		NameField: "",
+	},
+    DeleteGitProvider: {
+		Name: "DeleteGitProvider", 
+		Group: "GitProvider",
+		Verb: "Delete",
+		Resource: "GitProvider",
+		NameField: "",
 	},
     CreateProject: {
 		Name: "CreateProject", 
 		Group: "Project",
+		Verb: "Create",
+		Resource: "Project",
+		NameField: "name",
 	},
     GetProjectRoles: {
 		Name: "GetProjectRoles", 
 		Group: "Project",
+		Verb: "Get",
+		Resource: "ProjectRoles",
+		NameField: "",
 	},
     GetProjectRole: {
 		Name: "GetProjectRole", 
 		Group: "Project",
+		Verb: "Get",
+		Resource: "ProjectRole",
+		NameField: "",
 	},
     GetProjects: {
 		Name: "GetProjects", 
 		Group: "Project",
+		Verb: "Get",
+		Resource: "Projects",
+		NameField: "",
 	},
     GetProject: {
 		Name: "GetProject", 
 		Group: "Project",
+		Verb: "Get",
+		Resource: "Project",
+		NameField: "",
 	},
     UpdateProject: {
 		Name: "UpdateProject", 
 		Group: "Project",
+		Verb: "Update",
+		Resource: "Project",
+		NameField: "",
 	},
     DeleteProject: {
 		Name: "DeleteProject", 
 		Group: "Project",
+		Verb: "Delete",
+		Resource: "Project",
+		NameField: "",
 	},
     AddProjectMember: {
 		Name: "AddProjectMember", 
 		Group: "Project",
+		Verb: "Add",
+		Resource: "ProjectMember",
+		NameField: "",
 	},
     GetProjectMember: {
 		Name: "GetProjectMember", 
 		Group: "Project",
+		Verb: "Get",
+		Resource: "ProjectMember",
+		NameField: "",
 	},
     GetProjectMembers: {
 		Name: "GetProjectMembers", 
 		Group: "Project",
+		Verb: "Get",
+		Resource: "ProjectMembers",
+		NameField: "",
 	},
     RemoveProjectMember: {
 		Name: "RemoveProjectMember", 
 		Group: "Project",
+		Verb: "Remove",
+		Resource: "ProjectMember",
+		NameField: "",
 	},
     UpdateProjectMemberRole: {
 		Name: "UpdateProjectMemberRole", 
 		Group: "Project",
+		Verb: "Update",
+		Resource: "ProjectMemberRole",
+		NameField: "",
 	},
     CreateProjectNamespace: {
 		Name: "CreateProjectNamespace", 
 		Group: "Project",
+		Verb: "Create",
+		Resource: "ProjectNamespace",
+		NameField: "namespace",
 	},
     GetProjectNamespaces: {
 		Name: "GetProjectNamespaces", 
 		Group: "Project",
+		Verb: "Get",
+		Resource: "ProjectNamespaces",
+		NameField: "",
 	},
     GetProjectNamespace: {
 		Name: "GetProjectNamespace", 
 		Group: "Project",
+		Verb: "Get",
+		Resource: "ProjectNamespace",
+		NameField: "",
 	},
     UpdateProjectNamespace: {
 		Name: "UpdateProjectNamespace", 
 		Group: "Project",
+		Verb: "Update",
+		Resource: "ProjectNamespace",
+		NameField: "",
 	},
     DeleteProjectNamespace: {
 		Name: "DeleteProjectNamespace", 
 		Group: "Project",
+		Verb: "Delete",
+		Resource: "ProjectNamespace",
+		NameField: "",
 	},
     SetFavoriteProject: {
 		Name: "SetFavoriteProject", 
 		Group: "Project",
+		Verb: "Set",
+		Resource: "FavoriteProject",
+		NameField: "",
 	},
     SetFavoriteProjectNamespace: {
 		Name: "SetFavoriteProjectNamespace", 
 		Group: "Project",
+		Verb: "Set",
+		Resource: "FavoriteProjectNamespace",
+		NameField: "",
 	},
     UnSetFavoriteProject: {
 		Name: "UnSetFavoriteProject", 
 		Group: "Project",
+		Verb: "Un",
+		Resource: "SetFavoriteProject",
+		NameField: "",
 	},
     UnSetFavoriteProjectNamespace: {
 		Name: "UnSetFavoriteProjectNamespace", 
 		Group: "Project",
+		Verb: "Un",
+		Resource: "SetFavoriteProjectNamespace",
+		NameField: "",
 	},
     GetProjectKubeconfig: {
 		Name: "GetProjectKubeconfig", 
 		Group: "Project",
+		Verb: "Get",
+		Resource: "ProjectKubeconfig",
+		NameField: "",
 	},
     GetProjectNamespaceK8sResources: {
 		Name: "GetProjectNamespaceK8sResources", 
 		Group: "Project",
+		Verb: "Get",
+		Resource: "ProjectNamespaceK8sResources",
+		NameField: "",
 	},
     GetProjectNamespaceKubeconfig: {
 		Name: "GetProjectNamespaceKubeconfig", 
 		Group: "Project",
+		Verb: "Get",
+		Resource: "ProjectNamespaceKubeconfig",
+		NameField: "",
 	},
     GetAudits: {
 		Name: "GetAudits", 
 		Group: "Audit",
+		Verb: "Get",
+		Resource: "Audits",
+		NameField: "",
 	},
     GetAudit: {
 		Name: "GetAudit", 
 		Group: "Audit",
+		Verb: "Get",
+		Resource: "Audit",
+		NameField: "",
 	},
     DeleteAudit: {
 		Name: "DeleteAudit", 
 		Group: "Audit",
+		Verb: "Delete",
+		Resource: "Audit",
+		NameField: "",
+	},
+    Admin_GetAuditStatistics: {
+		Name: "Admin_GetAuditStatistics", 
+		Group: "Audit",
+		Verb: "Get",
+		Resource: "AuditStatistics",
+		NameField: "",
+	},
+    Admin_ArchiveAudits: {
+		Name: "Admin_ArchiveAudits", 
+		Group: "Audit",
+		Verb: "Archive",
+		Resource: "Audits",
+		NameField: "",
+	},
+    Admin_GetAuditRetentionPolicies: {
+		Name: "Admin_GetAuditRetentionPolicies", 
+		Group: "Audit",
+		Verb: "Get",
+		Resource: "AuditRetentionPolicies",
+		NameField: "",
+	},
+    Admin_UpdateAuditRetentionPolicy: {
+		Name: "Admin_UpdateAuditRetentionPolicy", 
+		Group: "Audit",
+		Verb: "Update",
+		Resource: "AuditRetentionPolicy",
+		NameField: "",
+	},
+    Admin_CreateAuditArchivalRun: {
+		Name: "Admin_CreateAuditArchivalRun", 
+		Group: "Audit",
+		Verb: "Create",
+		Resource: "AuditArchivalRun",
+		NameField: "",
+	},
+    Admin_GetAuditArchivalRuns: {
+		Name: "Admin_GetAuditArchivalRuns", 
+		Group: "Audit",
+		Verb: "Get",
+		Resource: "AuditArchivalRuns",
+		NameField: "",
+	},
+    Admin_GetAuditArchivalRun: {
+		Name: "Admin_GetAuditArchivalRun", 
+		Group: "Audit",
+		Verb: "Get",
+		Resource: "AuditArchivalRun",
+		NameField: "",
+	},
+    GetOrganizationAudits: {
+		Name: "GetOrganizationAudits", 
+		Group: "Audit",
+		Verb: "Get",
+		Resource: "OrganizationAudits",
+		NameField: "",
+	},
+    GetOrganizationTimeline: {
+		Name: "GetOrganizationTimeline", 
+		Group: "Audit",
+		Verb: "Get",
+		Resource: "OrganizationTimeline",
+		NameField: "",
+	},
+    CreateAuditSink: {
+		Name: "CreateAuditSink", 
+		Group: "Audit",
+		Verb: "Create",
+		Resource: "AuditSink",
+		NameField: "name",
+	},
+    GetAuditSinks: {
+		Name: "GetAuditSinks", 
+		Group: "Audit",
+		Verb: "Get",
+		Resource: "AuditSinks",
+		NameField: "",
+	},
+    GetAuditSink: {
+		Name: "GetAuditSink", 
+		Group: "Audit",
+		Verb: "Get",
+		Resource: "AuditSink",
+		NameField: "",
+	},
+    UpdateAuditSink: {
+		Name: "UpdateAuditSink", 
+		Group: "Audit",
+		Verb: "Update",
+		Resource: "AuditSink",
+		NameField: "name",
+	},
+    DeleteAuditSink: {
+		Name: "DeleteAuditSink", 
+		Group: "Audit",
+		Verb: "Delete",
+		Resource: "AuditSink",
+		NameField: "",
+	},
+    GetAuditSinkDeadLetters: {
+		Name: "GetAuditSinkDeadLetters", 
+		Group: "Audit",
+		Verb: "Get",
+		Resource: "AuditSinkDeadLetters",
+		NameField: "",
+	},
+    RetryAuditSinkDeadLetter: {
+		Name: "RetryAuditSinkDeadLetter", 
+		Group: "Audit",
+		Verb: "Retry",
+		Resource: "AuditSinkDeadLetter",
+		NameField: "",
+	},
+    DeleteAuditSinkDeadLetter: {
+		Name: "DeleteAuditSinkDeadLetter", 
+		Group: "Audit",
+		Verb: "Delete",
+		Resource: "AuditSinkDeadLetter",
+		NameField: "",
 	},
     CreateTksRole: {
 		Name: "CreateTksRole", 
 		Group: "Role",
+		Verb: "Create",
+		Resource: "TksRole",
+		NameField: "name",
 	},
     ListTksRoles: {
 		Name: "ListTksRoles", 
 		Group: "Role",
+		Verb: "List",
+		Resource: "TksRoles",
+		NameField: "",
 	},
     GetTksRole: {
 		Name: "GetTksRole", 
 		Group: "Role",
+		Verb: "Get",
+		Resource: "TksRole",
+		NameField: "",
 	},
     DeleteTksRole: {
 		Name: "DeleteTksRole", 
 		Group: "Role",
+		Verb: "Delete",
+		Resource: "TksRole",
+		NameField: "",
 	},
     UpdateTksRole: {
 		Name: "UpdateTksRole", 
 		Group: "Role",
+		Verb: "Update",
+		Resource: "TksRole",
+		NameField: "name",
 	},
     GetPermissionsByRoleId: {
 		Name: "GetPermissionsByRoleId", 
 		Group: "Role",
+		Verb: "Get",
+		Resource: "PermissionsByRoleId",
+		NameField: "",
 	},
     UpdatePermissionsByRoleId: {
 		Name: "UpdatePermissionsByRoleId", 
 		Group: "Role",
+		Verb: "Update",
+		Resource: "PermissionsByRoleId",
+		NameField: "",
 	},
     IsRoleNameExisted: {
 		Name: "IsRoleNameExisted", 
 		Group: "Role",
+		Verb: "Is",
+		Resource: "RoleNameExisted",
+		NameField: "",
 	},
     AppendUsersToRole: {
 		Name: "AppendUsersToRole", 
 		Group: "Role",
+		Verb: "Append",
+		Resource: "UsersToRole",
+		NameField: "",
 	},
     GetUsersInRoleId: {
 		Name: "GetUsersInRoleId", 
 		Group: "Role",
+		Verb: "Get",
+		Resource: "UsersInRoleId",
+		NameField: "",
 	},
     RemoveUsersFromRole: {
 		Name: "RemoveUsersFromRole", 
 		Group: "Role",
+		Verb: "Remove",
+		Resource: "UsersFromRole",
+		NameField: "",
+	},
+    CreateRoleReassignment: {
+		Name: "CreateRoleReassignment", 
+		Group: "Role",
+		Verb: "Create",
+		Resource: "RoleReassignment",
+		NameField: "",
+	},
+    GetRoleReassignments: {
+		Name: "GetRoleReassignments", 
+		Group: "Role",
+		Verb: "Get",
+		Resource: "RoleReassignments",
+		NameField: "",
+	},
+    GetRoleReassignment: {
+		Name: "GetRoleReassignment", 
+		Group: "Role",
+		Verb: "Get",
+		Resource: "RoleReassignment",
+		NameField: "",
 	},
     GetPermissionTemplates: {
 		Name: "GetPermissionTemplates", 
 		Group: "Permission",
+		Verb: "Get",
+		Resource: "PermissionTemplates",
+		NameField: "",
 	},
     Admin_CreateUser: {
 		Name: "Admin_CreateUser", 
 		Group: "Admin_User",
+		Verb: "Create",
+		Resource: "User",
+		NameField: "accountId",
 	},
     Admin_ListUser: {
 		Name: "Admin_ListUser", 
 		Group: "Admin_User",
+		Verb: "List",
+		Resource: "User",
+		NameField: "",
 	},
     Admin_GetUser: {
 		Name: "Admin_GetUser", 
 		Group: "Admin_User",
+		Verb: "Get",
+		Resource: "User",
+		NameField: "",
 	},
     Admin_DeleteUser: {
 		Name: "Admin_DeleteUser", 
 		Group: "Admin_User",
+		Verb: "Delete",
+		Resource: "User",
+		NameField: "",
 	},
     Admin_UpdateUser: {
 		Name: "Admin_UpdateUser", 
 		Group: "Admin_User",
+		Verb: "Update",
+		Resource: "User",
+		NameField: "name",
 	},
     Admin_ListTksRoles: {
 		Name: "Admin_ListTksRoles", 
 		Group: "Admin Role",
+		Verb: "List",
+		Resource: "TksRoles",
+		NameField: "",
 	},
     Admin_GetTksRole: {
 		Name: "Admin_GetTksRole", 
 		Group: "Admin Role",
+		Verb: "Get",
+		Resource: "TksRole",
+		NameField: "",
 	},
     Admin_GetProjects: {
 		Name: "Admin_GetProjects", 
 		Group: "Admin Project",
+		Verb: "Get",
+		Resource: "Projects",
+		NameField: "",
 	},
     Admin_ListPolicyTemplate: {
 		Name: "Admin_ListPolicyTemplate", 
 		Group: "PolicyTemplate",
+		Verb: "List",
+		Resource: "PolicyTemplate",
+		NameField: "",
 	},
     Admin_CreatePolicyTemplate: {
 		Name: "Admin_CreatePolicyTemplate", 
 		Group: "PolicyTemplate",
+		Verb: "Create",
+		Resource: "PolicyTemplate",
+		NameField: "templateName",
 	},
     Admin_DeletePolicyTemplate: {
 		Name: "Admin_DeletePolicyTemplate", 
 		Group: "PolicyTemplate",
+		Verb: "Delete",
+		Resource: "PolicyTemplate",
+		NameField: "",
 	},
     Admin_GetPolicyTemplate: {
 		Name: "Admin_GetPolicyTemplate", 
 		Group: "PolicyTemplate",
+		Verb: "Get",
+		Resource: "PolicyTemplate",
+		NameField: "",
 	},
     Admin_UpdatePolicyTemplate: {
 		Name: "Admin_UpdatePolicyTemplate", 
 		Group: "PolicyTemplate",
+		Verb: "Update",
+		Resource: "PolicyTemplate",
+		NameField: "templateName",
 	},
     Admin_GetPolicyTemplateDeploy: {
 		Name: "Admin_GetPolicyTemplateDeploy", 
 		Group: "PolicyTemplate",
+		Verb: "Get",
+		Resource: "PolicyTemplateDeploy",
+		NameField: "",
 	},
     Admin_ListPolicyTemplateStatistics: {
 		Name: "Admin_ListPolicyTemplateStatistics", 
 		Group: "PolicyTemplate",
+		Verb: "List",
+		Resource: "PolicyTemplateStatistics",
+		NameField: "",
 	},
     Admin_ListPolicyTemplateVersions: {
 		Name: "Admin_ListPolicyTemplateVersions", 
 		Group: "PolicyTemplate",
+		Verb: "List",
+		Resource: "PolicyTemplateVersions",
+		NameField: "",
 	},
     Admin_CreatePolicyTemplateVersion: {
 		Name: "Admin_CreatePolicyTemplateVersion", 
 		Group: "PolicyTemplate",
+		Verb: "Create",
+		Resource: "PolicyTemplateVersion",
+		NameField: "",
 	},
     Admin_DeletePolicyTemplateVersion: {
 		Name: "Admin_DeletePolicyTemplateVersion", 
 		Group: "PolicyTemplate",
+		Verb: "Delete",
+		Resource: "PolicyTemplateVersion",
+		NameField: "",
 	},
     Admin_GetPolicyTemplateVersion: {
 		Name: "Admin_GetPolicyTemplateVersion", 
 		Group: "PolicyTemplate",
+		Verb: "Get",
+		Resource: "PolicyTemplateVersion",
+		NameField: "",
 	},
     Admin_ExistsPolicyTemplateKind: {
 		Name: "Admin_ExistsPolicyTemplateKind", 
 		Group: "PolicyTemplate",
+		Verb: "Exists",
+		Resource: "PolicyTemplateKind",
+		NameField: "",
 	},
     Admin_ExistsPolicyTemplateName: {
 		Name: "Admin_ExistsPolicyTemplateName", 
 		Group: "PolicyTemplate",
+		Verb: "Exists",
+		Resource: "PolicyTemplateName",
+		NameField: "",
 	},
     Admin_ExtractParameters: {
 		Name: "Admin_ExtractParameters", 
 		Group: "PolicyTemplate",
+		Verb: "Extract",
+		Resource: "Parameters",
+		NameField: "",
 	},
     Admin_AddPermittedPolicyTemplatesForOrganization: {
 		Name: "Admin_AddPermittedPolicyTemplatesForOrganization", 
 		Group: "PolicyTemplate",
+		Verb: "Add",
+		Resource: "PermittedPolicyTemplatesForOrganization",
+		NameField: "",
 	},
     Admin_DeletePermittedPolicyTemplatesForOrganization: {
 		Name: "Admin_DeletePermittedPolicyTemplatesForOrganization", 
 		Group: "PolicyTemplate",
+		Verb: "Delete",
+		Resource: "PermittedPolicyTemplatesForOrganization",
+		NameField: "",
 	},
     ListStackPolicyStatus: {
 		Name: "ListStackPolicyStatus", 
 		Group: "StackPolicyStatus",
+		Verb: "List",
+		Resource: "StackPolicyStatus",
+		NameField: "",
 	},
     GetStackPolicyTemplateStatus: {
 		Name: "GetStackPolicyTemplateStatus", 
 		Group: "StackPolicyStatus",
+		Verb: "Get",
+		Resource: "StackPolicyTemplateStatus",
+		NameField: "",
 	},
     UpdateStackPolicyTemplateStatus: {
 		Name: "UpdateStackPolicyTemplateStatus", 
 		Group: "StackPolicyStatus",
+		Verb: "Update",
+		Resource: "StackPolicyTemplateStatus",
+		NameField: "",
 	},
     GetMandatoryPolicies: {
 		Name: "GetMandatoryPolicies", 
 		Group: "Policy",
+		Verb: "Get",
+		Resource: "MandatoryPolicies",
+		NameField: "",
 	},
     SetMandatoryPolicies: {
 		Name: "SetMandatoryPolicies", 
 		Group: "Policy",
+		Verb: "Set",
+		Resource: "MandatoryPolicies",
+		NameField: "",
 	},
     GetPolicyStatistics: {
 		Name: "GetPolicyStatistics", 
 		Group: "Policy",
+		Verb: "Get",
+		Resource: "PolicyStatistics",
+		NameField: "",
 	},
     ListPolicy: {
 		Name: "ListPolicy", 
 		Group: "Policy",
+		Verb: "List",
+		Resource: "Policy",
+		NameField: "",
 	},
     CreatePolicy: {
 		Name: "CreatePolicy", 
 		Group: "Policy",
+		Verb: "Create",
+		Resource: "Policy",
+		NameField: "policyName",
 	},
     DeletePolicy: {
 		Name: "DeletePolicy", 
 		Group: "Policy",
+		Verb: "Delete",
+		Resource: "Policy",
+		NameField: "",
 	},
     GetPolicy: {
 		Name: "GetPolicy", 
 		Group: "Policy",
+		Verb: "Get",
+		Resource: "Policy",
+		NameField: "",
 	},
     UpdatePolicy: {
 		Name: "UpdatePolicy", 
 		Group: "Policy",
+		Verb: "Update",
+		Resource: "Policy",
+		NameField: "policyName",
 	},
     UpdatePolicyTargetClusters: {
 		Name: "UpdatePolicyTargetClusters", 
 		Group: "Policy",
+		Verb: "Update",
+		Resource: "PolicyTargetClusters",
+		NameField: "",
 	},
     ExistsPolicyName: {
 		Name: "ExistsPolicyName", 
 		Group: "Policy",
+		Verb: "Exists",
+		Resource: "PolicyName",
+		NameField: "",
 	},
     ExistsPolicyResourceName: {
 		Name: "ExistsPolicyResourceName", 
 		Group: "Policy",
+		Verb: "Exists",
+		Resource: "PolicyResourceName",
+		NameField: "",
 	},
     GetPolicyEdit: {
 		Name: "GetPolicyEdit", 
 		Group: "Policy",
+		Verb: "Get",
+		Resource: "PolicyEdit",
+		NameField: "",
 	},
     AddPoliciesForStack: {
 		Name: "AddPoliciesForStack", 
 		Group: "Policy",
+		Verb: "Add",
+		Resource: "PoliciesForStack",
+		NameField: "",
 	},
     DeletePoliciesForStack: {
 		Name: "DeletePoliciesForStack", 
 		Group: "Policy",
+		Verb: "Delete",
+		Resource: "PoliciesForStack",
+		NameField: "",
 	},
     StackPolicyStatistics: {
 		Name: "StackPolicyStatistics", 
 		Group: "Policy",
+		Verb: "Stack",
+		Resource: "PolicyStatistics",
+		NameField: "",
 	},
     ListPolicyTemplate: {
 		Name: "ListPolicyTemplate", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "List",
+		Resource: "PolicyTemplate",
+		NameField: "",
 	},
     CreatePolicyTemplate: {
 		Name: "CreatePolicyTemplate", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "Create",
+		Resource: "PolicyTemplate",
+		NameField: "templateName",
 	},
     DeletePolicyTemplate: {
 		Name: "DeletePolicyTemplate", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "Delete",
+		Resource: "PolicyTemplate",
+		NameField: "",
 	},
     GetPolicyTemplate: {
 		Name: "GetPolicyTemplate", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "Get",
+		Resource: "PolicyTemplate",
+		NameField: "",
 	},
     UpdatePolicyTemplate: {
 		Name: "UpdatePolicyTemplate", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "Update",
+		Resource: "PolicyTemplate",
+		NameField: "templateName",
 	},
     GetPolicyTemplateDeploy: {
 		Name: "GetPolicyTemplateDeploy", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "Get",
+		Resource: "PolicyTemplateDeploy",
+		NameField: "",
 	},
     ListPolicyTemplateStatistics: {
 		Name: "ListPolicyTemplateStatistics", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "List",
+		Resource: "PolicyTemplateStatistics",
+		NameField: "",
 	},
     ListPolicyTemplateVersions: {
 		Name: "ListPolicyTemplateVersions", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "List",
+		Resource: "PolicyTemplateVersions",
+		NameField: "",
 	},
     CreatePolicyTemplateVersion: {
 		Name: "CreatePolicyTemplateVersion", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "Create",
+		Resource: "PolicyTemplateVersion",
+		NameField: "",
 	},
     DeletePolicyTemplateVersion: {
 		Name: "DeletePolicyTemplateVersion", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "Delete",
+		Resource: "PolicyTemplateVersion",
+		NameField: "",
 	},
     GetPolicyTemplateVersion: {
 		Name: "GetPolicyTemplateVersion", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "Get",
+		Resource: "PolicyTemplateVersion",
+		NameField: "",
 	},
     ExistsPolicyTemplateKind: {
 		Name: "ExistsPolicyTemplateKind", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "Exists",
+		Resource: "PolicyTemplateKind",
+		NameField: "",
 	},
     ExistsPolicyTemplateName: {
 		Name: "ExistsPolicyTemplateName", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "Exists",
+		Resource: "PolicyTemplateName",
+		NameField: "",
 	},
     ExtractParameters: {
 		Name: "ExtractParameters", 
 		Group: "OrganizationPolicyTemplate",
+		Verb: "Extract",
+		Resource: "Parameters",
+		NameField: "",
 	},
     ListPolicyTemplateExample: {
 		Name: "ListPolicyTemplateExample", 
 		Group: "PolicyTemplateExample",
+		Verb: "List",
+		Resource: "PolicyTemplateExample",
+		NameField: "",
 	},
     GetPolicyTemplateExample: {
 		Name: "GetPolicyTemplateExample", 
 		Group: "PolicyTemplateExample",
+		Verb: "Get",
+		Resource: "PolicyTemplateExample",
+		NameField: "",
 	},
     UpdatePolicyTemplateExample: {
 		Name: "UpdatePolicyTemplateExample", 
 		Group: "PolicyTemplateExample",
+		Verb: "Update",
+		Resource: "PolicyTemplateExample",
+		NameField: "",
 	},
     DeletePolicyTemplateExample: {
 		Name: "DeletePolicyTemplateExample", 
 		Group: "PolicyTemplateExample",
+		Verb: "Delete",
+		Resource: "PolicyTemplateExample",
+		NameField: "",
 	},
     CompileRego: {
 		Name: "CompileRego", 
 		Group: "Utility",
+		Verb: "Compile",
+		Resource: "Rego",
+		NameField: "",
 	},
 }
 func (e Endpoint) String() string {
@@ -954,6 +2920,8 @@ func (e Endpoint) String() string {
 		return "VerifyIdentityForLostPassword"
 	case VerifyToken:
 		return "VerifyToken"
+	case GetPublicOrganizationBranding:
+		return "GetPublicOrganizationBranding"
 	case CreateUser:
 		return "CreateUser"
 	case ListUser:
@@ -974,6 +2942,14 @@ func (e Endpoint) String() string {
 		return "CheckEmail"
 	case GetPermissionsByAccountId:
 		return "GetPermissionsByAccountId"
+	case GetPasswordPolicy:
+		return "GetPasswordPolicy"
+	case UpdatePasswordPolicy:
+		return "UpdatePasswordPolicy"
+	case GetUserSessions:
+		return "GetUserSessions"
+	case DeleteUserSession:
+		return "DeleteUserSession"
 	case GetMyProfile:
 		return "GetMyProfile"
 	case UpdateMyProfile:
@@ -984,6 +2960,16 @@ func (e Endpoint) String() string {
 		return "RenewPasswordExpiredDate"
 	case DeleteMyProfile:
 		return "DeleteMyProfile"
+	case GetMyNotificationDigestSettings:
+		return "GetMyNotificationDigestSettings"
+	case UpdateMyNotificationDigestSettings:
+		return "UpdateMyNotificationDigestSettings"
+	case EnrollMyOtp:
+		return "EnrollMyOtp"
+	case ActivateMyOtp:
+		return "ActivateMyOtp"
+	case DisableMyOtp:
+		return "DisableMyOtp"
 	case Admin_CreateOrganization:
 		return "Admin_CreateOrganization"
 	case Admin_DeleteOrganization:
@@ -998,6 +2984,58 @@ func (e Endpoint) String() string {
 		return "UpdateOrganization"
 	case UpdatePrimaryCluster:
 		return "UpdatePrimaryCluster"
+	case GetOrganizationOnboarding:
+		return "GetOrganizationOnboarding"
+	case GetOrganizationBranding:
+		return "GetOrganizationBranding"
+	case UpdateOrganizationBranding:
+		return "UpdateOrganizationBranding"
+	case GetNamingPolicies:
+		return "GetNamingPolicies"
+	case UpdateNamingPolicy:
+		return "UpdateNamingPolicy"
+	case GetOrganizationQuota:
+		return "GetOrganizationQuota"
+	case Admin_GetOrganizationQuota:
+		return "Admin_GetOrganizationQuota"
+	case Admin_UpdateOrganizationQuota:
+		return "Admin_UpdateOrganizationQuota"
+	case GetOrganizationUsage:
+		return "GetOrganizationUsage"
+	case GetLmaEndpoints:
+		return "GetLmaEndpoints"
+	case CreateLmaEndpoint:
+		return "CreateLmaEndpoint"
+	case UpdateLmaEndpoint:
+		return "UpdateLmaEndpoint"
+	case DeleteLmaEndpoint:
+		return "DeleteLmaEndpoint"
+	case GetLmaAuth:
+		return "GetLmaAuth"
+	case UpdateLmaAuth:
+		return "UpdateLmaAuth"
+	case GetLmaDiscovery:
+		return "GetLmaDiscovery"
+	case UpdateLmaDiscovery:
+		return "UpdateLmaDiscovery"
+	case ExportManifests:
+		return "ExportManifests"
+	case ApplyManifests:
+		return "ApplyManifests"
+	case GetEncryptionKeys:
+		return "GetEncryptionKeys"
+	case CreateEncryptionKey:
+		return "CreateEncryptionKey"
+	case RotateEncryptionKey:
+		return "RotateEncryptionKey"
+	case RevokeEncryptionKey:
+		return "RevokeEncryptionKey"
+	case GetOperations:
+		return "GetOperations"
+	case CancelOperation:
+		return "CancelOperation"
+	case GetTask:
+		return "GetTask"
 	case CreateCluster:
 		return "CreateCluster"
 	case GetClusters:
@@ -1018,6 +3056,18 @@ func (e Endpoint) String() string {
 		return "GetBootstrapKubeconfig"
 	case GetNodes:
 		return "GetNodes"
+	case SearchPods:
+		return "SearchPods"
+	case GetClusterNamespaces:
+		return "GetClusterNamespaces"
+	case GetClusterWorkloads:
+		return "GetClusterWorkloads"
+	case GetClusterPods:
+		return "GetClusterPods"
+	case GetPodLogs:
+		return "GetPodLogs"
+	case GetClusterKubeconfig:
+		return "GetClusterKubeconfig"
 	case CreateAppgroup:
 		return "CreateAppgroup"
 	case GetAppgroups:
@@ -1040,6 +3090,8 @@ func (e Endpoint) String() string {
 		return "GetAppServeApps"
 	case GetNumOfAppsOnStack:
 		return "GetNumOfAppsOnStack"
+	case GetAppServeAppSummary:
+		return "GetAppServeAppSummary"
 	case GetAppServeApp:
 		return "GetAppServeApp"
 	case GetAppServeAppLatestTask:
@@ -1058,6 +3110,24 @@ func (e Endpoint) String() string {
 		return "UpdateAppServeAppEndpoint"
 	case RollbackAppServeApp:
 		return "RollbackAppServeApp"
+	case GetAppServeAppMetrics:
+		return "GetAppServeAppMetrics"
+	case GetAppServeAppConfigs:
+		return "GetAppServeAppConfigs"
+	case GetAppServeAppConfig:
+		return "GetAppServeAppConfig"
+	case GetAppServeAppConfigDiff:
+		return "GetAppServeAppConfigDiff"
+	case UpdateAppServeAppConfig:
+		return "UpdateAppServeAppConfig"
+	case GetAppServeAppGitSource:
+		return "GetAppServeAppGitSource"
+	case UpdateAppServeAppGitSource:
+		return "UpdateAppServeAppGitSource"
+	case DeleteAppServeAppGitSource:
+		return "DeleteAppServeAppGitSource"
+	case BuildAppServeApp:
+		return "BuildAppServeApp"
 	case GetCloudAccounts:
 		return "GetCloudAccounts"
 	case CreateCloudAccount:
@@ -1074,8 +3144,16 @@ func (e Endpoint) String() string {
 		return "DeleteCloudAccount"
 	case DeleteForceCloudAccount:
 		return "DeleteForceCloudAccount"
+	case GetCloudAccountPricing:
+		return "GetCloudAccountPricing"
+	case UpdateCloudAccountPricing:
+		return "UpdateCloudAccountPricing"
+	case DeleteCloudAccountPricing:
+		return "DeleteCloudAccountPricing"
 	case GetResourceQuota:
 		return "GetResourceQuota"
+	case VerifyCloudAccount:
+		return "VerifyCloudAccount"
 	case Admin_GetStackTemplates:
 		return "Admin_GetStackTemplates"
 	case Admin_GetStackTemplate:
@@ -1112,10 +3190,32 @@ func (e Endpoint) String() string {
 		return "GetChartsDashboard"
 	case GetChartDashboard:
 		return "GetChartDashboard"
+	case StreamChartsDashboard:
+		return "StreamChartsDashboard"
 	case GetStacksDashboard:
 		return "GetStacksDashboard"
 	case GetResourcesDashboard:
 		return "GetResourcesDashboard"
+	case GetResourcesDashboardV2:
+		return "GetResourcesDashboardV2"
+	case GetStackNodesDashboard:
+		return "GetStackNodesDashboard"
+	case GetStackChartsDashboard:
+		return "GetStackChartsDashboard"
+	case GetStackChartDashboard:
+		return "GetStackChartDashboard"
+	case GetStoragesDashboard:
+		return "GetStoragesDashboard"
+	case GetNetworkPoliciesDashboard:
+		return "GetNetworkPoliciesDashboard"
+	case GetIdentityStatusDashboard:
+		return "GetIdentityStatusDashboard"
+	case GetHealthDashboard:
+		return "GetHealthDashboard"
+	case GetCostsDashboard:
+		return "GetCostsDashboard"
+	case GetClusterEventsDashboard:
+		return "GetClusterEventsDashboard"
 	case GetPolicyStatusDashboard:
 		return "GetPolicyStatusDashboard"
 	case GetPolicyUpdateDashboard:
@@ -1132,6 +3232,28 @@ func (e Endpoint) String() string {
 		return "GetWorkloadDashboard"
 	case GetPolicyViolationTop5Dashboard:
 		return "GetPolicyViolationTop5Dashboard"
+	case GetPolicyEnforcementStatusDashboard:
+		return "GetPolicyEnforcementStatusDashboard"
+	case CreateCustomChartDashboard:
+		return "CreateCustomChartDashboard"
+	case GetCustomChartsDashboard:
+		return "GetCustomChartsDashboard"
+	case GetCustomChartDashboard:
+		return "GetCustomChartDashboard"
+	case UpdateCustomChartDashboard:
+		return "UpdateCustomChartDashboard"
+	case DeleteCustomChartDashboard:
+		return "DeleteCustomChartDashboard"
+	case GetCustomChartDataDashboard:
+		return "GetCustomChartDataDashboard"
+	case CreateChartSnapshotDashboard:
+		return "CreateChartSnapshotDashboard"
+	case GetChartSnapshotsDashboard:
+		return "GetChartSnapshotsDashboard"
+	case DeleteChartSnapshotDashboard:
+		return "DeleteChartSnapshotDashboard"
+	case Admin_WarmupDashboardCaches:
+		return "Admin_WarmupDashboardCaches"
 	case Admin_CreateSystemNotificationTemplate:
 		return "Admin_CreateSystemNotificationTemplate"
 	case Admin_UpdateSystemNotificationTemplate:
@@ -1166,6 +3288,58 @@ func (e Endpoint) String() string {
 		return "UpdateSystemNotificationRule"
 	case MakeDefaultSystemNotificationRules:
 		return "MakeDefaultSystemNotificationRules"
+	case CreateAlertIngestionToken:
+		return "CreateAlertIngestionToken"
+	case GetAlertIngestionTokens:
+		return "GetAlertIngestionTokens"
+	case RotateAlertIngestionToken:
+		return "RotateAlertIngestionToken"
+	case RevokeAlertIngestionToken:
+		return "RevokeAlertIngestionToken"
+	case CreateAlertRoutingRule:
+		return "CreateAlertRoutingRule"
+	case GetAlertRoutingRules:
+		return "GetAlertRoutingRules"
+	case GetAlertRoutingRule:
+		return "GetAlertRoutingRule"
+	case UpdateAlertRoutingRule:
+		return "UpdateAlertRoutingRule"
+	case DeleteAlertRoutingRule:
+		return "DeleteAlertRoutingRule"
+	case TestAlertRoutingRules:
+		return "TestAlertRoutingRules"
+	case CreateAlertChannel:
+		return "CreateAlertChannel"
+	case GetAlertChannels:
+		return "GetAlertChannels"
+	case GetAlertChannel:
+		return "GetAlertChannel"
+	case UpdateAlertChannel:
+		return "UpdateAlertChannel"
+	case DeleteAlertChannel:
+		return "DeleteAlertChannel"
+	case TestAlertChannel:
+		return "TestAlertChannel"
+	case CreateAlertSilence:
+		return "CreateAlertSilence"
+	case GetAlertSilences:
+		return "GetAlertSilences"
+	case GetAlertSilence:
+		return "GetAlertSilence"
+	case UpdateAlertSilence:
+		return "UpdateAlertSilence"
+	case DeleteAlertSilence:
+		return "DeleteAlertSilence"
+	case CreateEscalationPolicy:
+		return "CreateEscalationPolicy"
+	case GetEscalationPolicies:
+		return "GetEscalationPolicies"
+	case GetEscalationPolicy:
+		return "GetEscalationPolicy"
+	case UpdateEscalationPolicy:
+		return "UpdateEscalationPolicy"
+	case DeleteEscalationPolicy:
+		return "DeleteEscalationPolicy"
 	case CreateSystemNotification:
 		return "CreateSystemNotification"
 	case GetSystemNotifications:
@@ -1204,6 +3378,120 @@ func (e Endpoint) String() string {
 		return "DeleteFavoriteStack"
 	case InstallStack:
 		return "InstallStack"
+	case GetStackAddonVersions:
+		return "GetStackAddonVersions"
+	case GetStackNodes:
+		return "GetStackNodes"
+	case ScaleStackNodeGroup:
+		return "ScaleStackNodeGroup"
+	case UpgradeStack:
+		return "UpgradeStack"
+	case GetStackUpgrades:
+		return "GetStackUpgrades"
+	case CreateStackWebhook:
+		return "CreateStackWebhook"
+	case GetStackWebhooks:
+		return "GetStackWebhooks"
+	case GetStackWebhook:
+		return "GetStackWebhook"
+	case UpdateStackWebhook:
+		return "UpdateStackWebhook"
+	case DeleteStackWebhook:
+		return "DeleteStackWebhook"
+	case GetCloudHealthEvents:
+		return "GetCloudHealthEvents"
+	case GetStackDefault:
+		return "GetStackDefault"
+	case Admin_GetStackDefault:
+		return "Admin_GetStackDefault"
+	case Admin_UpdateStackDefault:
+		return "Admin_UpdateStackDefault"
+	case Admin_DeleteStackDefault:
+		return "Admin_DeleteStackDefault"
+	case GetCostAllocationTagPolicies:
+		return "GetCostAllocationTagPolicies"
+	case CheckStackCostAllocationTagDrift:
+		return "CheckStackCostAllocationTagDrift"
+	case Admin_GetCostAllocationTagPolicies:
+		return "Admin_GetCostAllocationTagPolicies"
+	case Admin_UpdateCostAllocationTagPolicies:
+		return "Admin_UpdateCostAllocationTagPolicies"
+	case CreateClusterAccessRequest:
+		return "CreateClusterAccessRequest"
+	case GetClusterAccessRequests:
+		return "GetClusterAccessRequests"
+	case GetClusterAccessRequest:
+		return "GetClusterAccessRequest"
+	case ApproveClusterAccessRequest:
+		return "ApproveClusterAccessRequest"
+	case RejectClusterAccessRequest:
+		return "RejectClusterAccessRequest"
+	case RevokeClusterAccessRequest:
+		return "RevokeClusterAccessRequest"
+	case GetDeploymentApprovalPolicy:
+		return "GetDeploymentApprovalPolicy"
+	case UpdateDeploymentApprovalPolicy:
+		return "UpdateDeploymentApprovalPolicy"
+	case DeleteDeploymentApprovalPolicy:
+		return "DeleteDeploymentApprovalPolicy"
+	case GetDeploymentApprovals:
+		return "GetDeploymentApprovals"
+	case GetDeploymentApproval:
+		return "GetDeploymentApproval"
+	case ApproveDeployment:
+		return "ApproveDeployment"
+	case RejectDeployment:
+		return "RejectDeployment"
+	case CreateMaintenanceWindow:
+		return "CreateMaintenanceWindow"
+	case GetMaintenanceWindows:
+		return "GetMaintenanceWindows"
+	case GetOrganizationMaintenanceWindows:
+		return "GetOrganizationMaintenanceWindows"
+	case GetMaintenanceWindow:
+		return "GetMaintenanceWindow"
+	case UpdateMaintenanceWindow:
+		return "UpdateMaintenanceWindow"
+	case DeleteMaintenanceWindow:
+		return "DeleteMaintenanceWindow"
+	case CreateStackMonitoringEndpoint:
+		return "CreateStackMonitoringEndpoint"
+	case GetStackMonitoringEndpoints:
+		return "GetStackMonitoringEndpoints"
+	case GetStackMonitoringEndpoint:
+		return "GetStackMonitoringEndpoint"
+	case UpdateStackMonitoringEndpoint:
+		return "UpdateStackMonitoringEndpoint"
+	case DeleteStackMonitoringEndpoint:
+		return "DeleteStackMonitoringEndpoint"
+	case GetAvailabilityDashboard:
+		return "GetAvailabilityDashboard"
+	case Admin_GetHelmRepositories:
+		return "Admin_GetHelmRepositories"
+	case Admin_CreateHelmRepository:
+		return "Admin_CreateHelmRepository"
+	case Admin_DeleteHelmRepository:
+		return "Admin_DeleteHelmRepository"
+	case Admin_SyncHelmRepository:
+		return "Admin_SyncHelmRepository"
+	case GetCatalogCharts:
+		return "GetCatalogCharts"
+	case GetCatalogChart:
+		return "GetCatalogChart"
+	case CreateCatalogApp:
+		return "CreateCatalogApp"
+	case GetCatalogApps:
+		return "GetCatalogApps"
+	case GetGitProviders:
+		return "GetGitProviders"
+	case GetGitProvider:
+		return "GetGitProvider"
+	case CreateGitProvider:
+		return "CreateGitProvider"
+	case UpdateGitProvider:
+		return "UpdateGitProvider"
+	case DeleteGitProvider:
+		return "DeleteGitProvider"
 	case CreateProject:
 		return "CreateProject"
 	case GetProjectRoles:
@@ -1258,6 +3546,40 @@ func (e Endpoint) String() string {
 		return "GetAudit"
 	case DeleteAudit:
 		return "DeleteAudit"
+	case Admin_GetAuditStatistics:
+		return "Admin_GetAuditStatistics"
+	case Admin_ArchiveAudits:
+		return "Admin_ArchiveAudits"
+	case Admin_GetAuditRetentionPolicies:
+		return "Admin_GetAuditRetentionPolicies"
+	case Admin_UpdateAuditRetentionPolicy:
+		return "Admin_UpdateAuditRetentionPolicy"
+	case Admin_CreateAuditArchivalRun:
+		return "Admin_CreateAuditArchivalRun"
+	case Admin_GetAuditArchivalRuns:
+		return "Admin_GetAuditArchivalRuns"
+	case Admin_GetAuditArchivalRun:
+		return "Admin_GetAuditArchivalRun"
+	case GetOrganizationAudits:
+		return "GetOrganizationAudits"
+	case GetOrganizationTimeline:
+		return "GetOrganizationTimeline"
+	case CreateAuditSink:
+		return "CreateAuditSink"
+	case GetAuditSinks:
+		return "GetAuditSinks"
+	case GetAuditSink:
+		return "GetAuditSink"
+	case UpdateAuditSink:
+		return "UpdateAuditSink"
+	case DeleteAuditSink:
+		return "DeleteAuditSink"
+	case GetAuditSinkDeadLetters:
+		return "GetAuditSinkDeadLetters"
+	case RetryAuditSinkDeadLetter:
+		return "RetryAuditSinkDeadLetter"
+	case DeleteAuditSinkDeadLetter:
+		return "DeleteAuditSinkDeadLetter"
 	case CreateTksRole:
 		return "CreateTksRole"
 	case ListTksRoles:
@@ -1280,6 +3602,12 @@ func (e Endpoint) String() string {
 		return "GetUsersInRoleId"
 	case RemoveUsersFromRole:
 		return "RemoveUsersFromRole"
+	case CreateRoleReassignment:
+		return "CreateRoleReassignment"
+	case GetRoleReassignments:
+		return "GetRoleReassignments"
+	case GetRoleReassignment:
+		return "GetRoleReassignment"
 	case GetPermissionTemplates:
 		return "GetPermissionTemplates"
 	case Admin_CreateUser:
@@ -1426,6 +3754,8 @@ func GetEndpoint(name string) Endpoint {
 		return VerifyIdentityForLostPassword
 	case "VerifyToken":
 		return VerifyToken
+	case "GetPublicOrganizationBranding":
+		return GetPublicOrganizationBranding
 	case "CreateUser":
 		return CreateUser
 	case "ListUser":
@@ -1446,6 +3776,14 @@ func GetEndpoint(name string) Endpoint {
 		return CheckEmail
 	case "GetPermissionsByAccountId":
 		return GetPermissionsByAccountId
+	case "GetPasswordPolicy":
+		return GetPasswordPolicy
+	case "UpdatePasswordPolicy":
+		return UpdatePasswordPolicy
+	case "GetUserSessions":
+		return GetUserSessions
+	case "DeleteUserSession":
+		return DeleteUserSession
 	case "GetMyProfile":
 		return GetMyProfile
 	case "UpdateMyProfile":
@@ -1456,6 +3794,16 @@ func GetEndpoint(name string) Endpoint {
 		return RenewPasswordExpiredDate
 	case "DeleteMyProfile":
 		return DeleteMyProfile
+	case "GetMyNotificationDigestSettings":
+		return GetMyNotificationDigestSettings
+	case "UpdateMyNotificationDigestSettings":
+		return UpdateMyNotificationDigestSettings
+	case "EnrollMyOtp":
+		return EnrollMyOtp
+	case "ActivateMyOtp":
+		return ActivateMyOtp
+	case "DisableMyOtp":
+		return DisableMyOtp
 	case "Admin_CreateOrganization":
 		return Admin_CreateOrganization
 	case "Admin_DeleteOrganization":
@@ -1470,6 +3818,58 @@ func GetEndpoint(name string) Endpoint {
 		return UpdateOrganization
 	case "UpdatePrimaryCluster":
 		return UpdatePrimaryCluster
+	case "GetOrganizationOnboarding":
+		return GetOrganizationOnboarding
+	case "GetOrganizationBranding":
+		return GetOrganizationBranding
+	case "UpdateOrganizationBranding":
+		return UpdateOrganizationBranding
+	case "GetNamingPolicies":
+		return GetNamingPolicies
+	case "UpdateNamingPolicy":
+		return UpdateNamingPolicy
+	case "GetOrganizationQuota":
+		return GetOrganizationQuota
+	case "Admin_GetOrganizationQuota":
+		return Admin_GetOrganizationQuota
+	case "Admin_UpdateOrganizationQuota":
+		return Admin_UpdateOrganizationQuota
+	case "GetOrganizationUsage":
+		return GetOrganizationUsage
+	case "GetLmaEndpoints":
+		return GetLmaEndpoints
+	case "CreateLmaEndpoint":
+		return CreateLmaEndpoint
+	case "UpdateLmaEndpoint":
+		return UpdateLmaEndpoint
+	case "DeleteLmaEndpoint":
+		return DeleteLmaEndpoint
+	case "GetLmaAuth":
+		return GetLmaAuth
+	case "UpdateLmaAuth":
+		return UpdateLmaAuth
+	case "GetLmaDiscovery":
+		return GetLmaDiscovery
+	case "UpdateLmaDiscovery":
+		return UpdateLmaDiscovery
+	case "ExportManifests":
+		return ExportManifests
+	case "ApplyManifests":
+		return ApplyManifests
+	case "GetEncryptionKeys":
+		return GetEncryptionKeys
+	case "CreateEncryptionKey":
+		return CreateEncryptionKey
+	case "RotateEncryptionKey":
+		return RotateEncryptionKey
+	case "RevokeEncryptionKey":
+		return RevokeEncryptionKey
+	case "GetOperations":
+		return GetOperations
+	case "CancelOperation":
+		return CancelOperation
+	case "GetTask":
+		return GetTask
 	case "CreateCluster":
 		return CreateCluster
 	case "GetClusters":
@@ -1490,6 +3890,18 @@ func GetEndpoint(name string) Endpoint {
 		return GetBootstrapKubeconfig
 	case "GetNodes":
 		return GetNodes
+	case "SearchPods":
+		return SearchPods
+	case "GetClusterNamespaces":
+		return GetClusterNamespaces
+	case "GetClusterWorkloads":
+		return GetClusterWorkloads
+	case "GetClusterPods":
+		return GetClusterPods
+	case "GetPodLogs":
+		return GetPodLogs
+	case "GetClusterKubeconfig":
+		return GetClusterKubeconfig
 	case "CreateAppgroup":
 		return CreateAppgroup
 	case "GetAppgroups":
@@ -1512,6 +3924,8 @@ func GetEndpoint(name string) Endpoint {
 		return GetAppServeApps
 	case "GetNumOfAppsOnStack":
 		return GetNumOfAppsOnStack
+	case "GetAppServeAppSummary":
+		return GetAppServeAppSummary
 	case "GetAppServeApp":
 		return GetAppServeApp
 	case "GetAppServeAppLatestTask":
@@ -1530,6 +3944,24 @@ func GetEndpoint(name string) Endpoint {
 		return UpdateAppServeAppEndpoint
 	case "RollbackAppServeApp":
 		return RollbackAppServeApp
+	case "GetAppServeAppMetrics":
+		return GetAppServeAppMetrics
+	case "GetAppServeAppConfigs":
+		return GetAppServeAppConfigs
+	case "GetAppServeAppConfig":
+		return GetAppServeAppConfig
+	case "GetAppServeAppConfigDiff":
+		return GetAppServeAppConfigDiff
+	case "UpdateAppServeAppConfig":
+		return UpdateAppServeAppConfig
+	case "GetAppServeAppGitSource":
+		return GetAppServeAppGitSource
+	case "UpdateAppServeAppGitSource":
+		return UpdateAppServeAppGitSource
+	case "DeleteAppServeAppGitSource":
+		return DeleteAppServeAppGitSource
+	case "BuildAppServeApp":
+		return BuildAppServeApp
 	case "GetCloudAccounts":
 		return GetCloudAccounts
 	case "CreateCloudAccount":
@@ -1546,8 +3978,16 @@ func GetEndpoint(name string) Endpoint {
 		return DeleteCloudAccount
 	case "DeleteForceCloudAccount":
 		return DeleteForceCloudAccount
+	case "GetCloudAccountPricing":
+		return GetCloudAccountPricing
+	case "UpdateCloudAccountPricing":
+		return UpdateCloudAccountPricing
+	case "DeleteCloudAccountPricing":
+		return DeleteCloudAccountPricing
 	case "GetResourceQuota":
 		return GetResourceQuota
+	case "VerifyCloudAccount":
+		return VerifyCloudAccount
 	case "Admin_GetStackTemplates":
 		return Admin_GetStackTemplates
 	case "Admin_GetStackTemplate":
@@ -1584,10 +4024,32 @@ func GetEndpoint(name string) Endpoint {
 		return GetChartsDashboard
 	case "GetChartDashboard":
 		return GetChartDashboard
+	case "StreamChartsDashboard":
+		return StreamChartsDashboard
 	case "GetStacksDashboard":
 		return GetStacksDashboard
 	case "GetResourcesDashboard":
 		return GetResourcesDashboard
+	case "GetResourcesDashboardV2":
+		return GetResourcesDashboardV2
+	case "GetStackNodesDashboard":
+		return GetStackNodesDashboard
+	case "GetStackChartsDashboard":
+		return GetStackChartsDashboard
+	case "GetStackChartDashboard":
+		return GetStackChartDashboard
+	case "GetStoragesDashboard":
+		return GetStoragesDashboard
+	case "GetNetworkPoliciesDashboard":
+		return GetNetworkPoliciesDashboard
+	case "GetIdentityStatusDashboard":
+		return GetIdentityStatusDashboard
+	case "GetHealthDashboard":
+		return GetHealthDashboard
+	case "GetCostsDashboard":
+		return GetCostsDashboard
+	case "GetClusterEventsDashboard":
+		return GetClusterEventsDashboard
 	case "GetPolicyStatusDashboard":
 		return GetPolicyStatusDashboard
 	case "GetPolicyUpdateDashboard":
@@ -1604,6 +4066,28 @@ func GetEndpoint(name string) Endpoint {
 		return GetWorkloadDashboard
 	case "GetPolicyViolationTop5Dashboard":
 		return GetPolicyViolationTop5Dashboard
+	case "GetPolicyEnforcementStatusDashboard":
+		return GetPolicyEnforcementStatusDashboard
+	case "CreateCustomChartDashboard":
+		return CreateCustomChartDashboard
+	case "GetCustomChartsDashboard":
+		return GetCustomChartsDashboard
+	case "GetCustomChartDashboard":
+		return GetCustomChartDashboard
+	case "UpdateCustomChartDashboard":
+		return UpdateCustomChartDashboard
+	case "DeleteCustomChartDashboard":
+		return DeleteCustomChartDashboard
+	case "GetCustomChartDataDashboard":
+		return GetCustomChartDataDashboard
+	case "CreateChartSnapshotDashboard":
+		return CreateChartSnapshotDashboard
+	case "GetChartSnapshotsDashboard":
+		return GetChartSnapshotsDashboard
+	case "DeleteChartSnapshotDashboard":
+		return DeleteChartSnapshotDashboard
+	case "Admin_WarmupDashboardCaches":
+		return Admin_WarmupDashboardCaches
 	case "Admin_CreateSystemNotificationTemplate":
 		return Admin_CreateSystemNotificationTemplate
 	case "Admin_UpdateSystemNotificationTemplate":
@@ -1638,6 +4122,58 @@ func GetEndpoint(name string) Endpoint {
 		return UpdateSystemNotificationRule
 	case "MakeDefaultSystemNotificationRules":
 		return MakeDefaultSystemNotificationRules
+	case "CreateAlertIngestionToken":
+		return CreateAlertIngestionToken
+	case "GetAlertIngestionTokens":
+		return GetAlertIngestionTokens
+	case "RotateAlertIngestionToken":
+		return RotateAlertIngestionToken
+	case "RevokeAlertIngestionToken":
+		return RevokeAlertIngestionToken
+	case "CreateAlertRoutingRule":
+		return CreateAlertRoutingRule
+	case "GetAlertRoutingRules":
+		return GetAlertRoutingRules
+	case "GetAlertRoutingRule":
+		return GetAlertRoutingRule
+	case "UpdateAlertRoutingRule":
+		return UpdateAlertRoutingRule
+	case "DeleteAlertRoutingRule":
+		return DeleteAlertRoutingRule
+	case "TestAlertRoutingRules":
+		return TestAlertRoutingRules
+	case "CreateAlertChannel":
+		return CreateAlertChannel
+	case "GetAlertChannels":
+		return GetAlertChannels
+	case "GetAlertChannel":
+		return GetAlertChannel
+	case "UpdateAlertChannel":
+		return UpdateAlertChannel
+	case "DeleteAlertChannel":
+		return DeleteAlertChannel
+	case "TestAlertChannel":
+		return TestAlertChannel
+	case "CreateAlertSilence":
+		return CreateAlertSilence
+	case "GetAlertSilences":
+		return GetAlertSilences
+	case "GetAlertSilence":
+		return GetAlertSilence
+	case "UpdateAlertSilence":
+		return UpdateAlertSilence
+	case "DeleteAlertSilence":
+		return DeleteAlertSilence
+	case "CreateEscalationPolicy":
+		return CreateEscalationPolicy
+	case "GetEscalationPolicies":
+		return GetEscalationPolicies
+	case "GetEscalationPolicy":
+		return GetEscalationPolicy
+	case "UpdateEscalationPolicy":
+		return UpdateEscalationPolicy
+	case "DeleteEscalationPolicy":
+		return DeleteEscalationPolicy
 	case "CreateSystemNotification":
 		return CreateSystemNotification
 	case "GetSystemNotifications":
@@ -1676,6 +4212,120 @@ func GetEndpoint(name string) Endpoint {
 		return DeleteFavoriteStack
 	case "InstallStack":
 		return InstallStack
+	case "GetStackAddonVersions":
+		return GetStackAddonVersions
+	case "GetStackNodes":
+		return GetStackNodes
+	case "ScaleStackNodeGroup":
+		return ScaleStackNodeGroup
+	case "UpgradeStack":
+		return UpgradeStack
+	case "GetStackUpgrades":
+		return GetStackUpgrades
+	case "CreateStackWebhook":
+		return CreateStackWebhook
+	case "GetStackWebhooks":
+		return GetStackWebhooks
+	case "GetStackWebhook":
+		return GetStackWebhook
+	case "UpdateStackWebhook":
+		return UpdateStackWebhook
+	case "DeleteStackWebhook":
+		return DeleteStackWebhook
+	case "GetCloudHealthEvents":
+		return GetCloudHealthEvents
+	case "GetStackDefault":
+		return GetStackDefault
+	case "Admin_GetStackDefault":
+		return Admin_GetStackDefault
+	case "Admin_UpdateStackDefault":
+		return Admin_UpdateStackDefault
+	case "Admin_DeleteStackDefault":
+		return Admin_DeleteStackDefault
+	case "GetCostAllocationTagPolicies":
+		return GetCostAllocationTagPolicies
+	case "CheckStackCostAllocationTagDrift":
+		return CheckStackCostAllocationTagDrift
+	case "Admin_GetCostAllocationTagPolicies":
+		return Admin_GetCostAllocationTagPolicies
+	case "Admin_UpdateCostAllocationTagPolicies":
+		return Admin_UpdateCostAllocationTagPolicies
+	case "CreateClusterAccessRequest":
+		return CreateClusterAccessRequest
+	case "GetClusterAccessRequests":
+		return GetClusterAccessRequests
+	case "GetClusterAccessRequest":
+		return GetClusterAccessRequest
+	case "ApproveClusterAccessRequest":
+		return ApproveClusterAccessRequest
+	case "RejectClusterAccessRequest":
+		return RejectClusterAccessRequest
+	case "RevokeClusterAccessRequest":
+		return RevokeClusterAccessRequest
+	case "GetDeploymentApprovalPolicy":
+		return GetDeploymentApprovalPolicy
+	case "UpdateDeploymentApprovalPolicy":
+		return UpdateDeploymentApprovalPolicy
+	case "DeleteDeploymentApprovalPolicy":
+		return DeleteDeploymentApprovalPolicy
+	case "GetDeploymentApprovals":
+		return GetDeploymentApprovals
+	case "GetDeploymentApproval":
+		return GetDeploymentApproval
+	case "ApproveDeployment":
+		return ApproveDeployment
+	case "RejectDeployment":
+		return RejectDeployment
+	case "CreateMaintenanceWindow":
+		return CreateMaintenanceWindow
+	case "GetMaintenanceWindows":
+		return GetMaintenanceWindows
+	case "GetOrganizationMaintenanceWindows":
+		return GetOrganizationMaintenanceWindows
+	case "GetMaintenanceWindow":
+		return GetMaintenanceWindow
+	case "UpdateMaintenanceWindow":
+		return UpdateMaintenanceWindow
+	case "DeleteMaintenanceWindow":
+		return DeleteMaintenanceWindow
+	case "CreateStackMonitoringEndpoint":
+		return CreateStackMonitoringEndpoint
+	case "GetStackMonitoringEndpoints":
+		return GetStackMonitoringEndpoints
+	case "GetStackMonitoringEndpoint":
+		return GetStackMonitoringEndpoint
+	case "UpdateStackMonitoringEndpoint":
+		return UpdateStackMonitoringEndpoint
+	case "DeleteStackMonitoringEndpoint":
+		return DeleteStackMonitoringEndpoint
+	case "GetAvailabilityDashboard":
+		return GetAvailabilityDashboard
+	case "Admin_GetHelmRepositories":
+		return Admin_GetHelmRepositories
+	case "Admin_CreateHelmRepository":
+		return Admin_CreateHelmRepository
+	case "Admin_DeleteHelmRepository":
+		return Admin_DeleteHelmRepository
+	case "Admin_SyncHelmRepository":
+		return Admin_SyncHelmRepository
+	case "GetCatalogCharts":
+		return GetCatalogCharts
+	case "GetCatalogChart":
+		return GetCatalogChart
+	case "CreateCatalogApp":
+		return CreateCatalogApp
+	case "GetCatalogApps":
+		return GetCatalogApps
+	case "GetGitProviders":
+		return GetGitProviders
+	case "GetGitProvider":
+		return GetGitProvider
+	case "CreateGitProvider":
+		return CreateGitProvider
+	case "UpdateGitProvider":
+		return UpdateGitProvider
+	case "DeleteGitProvider":
+		return DeleteGitProvider
 	case "CreateProject":
 		return CreateProject
 	case "GetProjectRoles":
@@ -1730,6 +4380,40 @@ func GetEndpoint(name string) Endpoint {
 		return GetAudit
 	case "DeleteAudit":
 		return DeleteAudit
+	case "Admin_GetAuditStatistics":
+		return Admin_GetAuditStatistics
+	case "Admin_ArchiveAudits":
+		return Admin_ArchiveAudits
+	case "Admin_GetAuditRetentionPolicies":
+		return Admin_GetAuditRetentionPolicies
+	case "Admin_UpdateAuditRetentionPolicy":
+		return Admin_UpdateAuditRetentionPolicy
+	case "Admin_CreateAuditArchivalRun":
+		return Admin_CreateAuditArchivalRun
+	case "Admin_GetAuditArchivalRuns":
+		return Admin_GetAuditArchivalRuns
+	case "Admin_GetAuditArchivalRun":
+		return Admin_GetAuditArchivalRun
+	case "GetOrganizationAudits":
+		return GetOrganizationAudits
+	case "GetOrganizationTimeline":
+		return GetOrganizationTimeline
+	case "CreateAuditSink":
+		return CreateAuditSink
+	case "GetAuditSinks":
+		return GetAuditSinks
+	case "GetAuditSink":
+		return GetAuditSink
+	case "UpdateAuditSink":
+		return UpdateAuditSink
+	case "DeleteAuditSink":
+		return DeleteAuditSink
+	case "GetAuditSinkDeadLetters":
+		return GetAuditSinkDeadLetters
+	case "RetryAuditSinkDeadLetter":
+		return RetryAuditSinkDeadLetter
+	case "DeleteAuditSinkDeadLetter":
+		return DeleteAuditSinkDeadLetter
 	case "CreateTksRole":
 		return CreateTksRole
 	case "ListTksRoles":
@@ -1752,6 +4436,12 @@ func GetEndpoint(name string) Endpoint {
 		return GetUsersInRoleId
 	case "RemoveUsersFromRole":
 		return RemoveUsersFromRole
+	case "CreateRoleReassignment":
+		return CreateRoleReassignment
+	case "GetRoleReassignments":
+		return GetRoleReassignments
+	case "GetRoleReassignment":
+		return GetRoleReassignment
 	case "GetPermissionTemplates":
 		return GetPermissionTemplates
 	case "Admin_CreateUser":
@@ -0,0 +1,257 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type AlertChannelHandler struct {
+	usecase usecase.IAlertChannelUsecase
+}
+
+func NewAlertChannelHandler(h usecase.Usecase) *AlertChannelHandler {
+	return &AlertChannelHandler{
+		usecase: h.AlertChannel,
+	}
+}
+
+// CreateAlertChannel godoc
+//
+//	@Tags			AlertChannels
+//	@Summary		Create alert channel
+//	@Description	Create the SMTP, Slack or webhook channel that receives alerts of the organization. Alert routing rules select the channels and alerts are sent asynchronously with retries.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string							true	"organizationId"
+//	@Param			body			body		domain.CreateAlertChannelRequest	true	"create alert channel request"
+//	@Success		200				{object}	domain.CreateAlertChannelResponse
+//	@Router			/organizations/{organizationId}/alert-channels [post]
+//	@Security		JWT
+func (h *AlertChannelHandler) CreateAlertChannel(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateAlertChannelRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.AlertChannel
+	if err := serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	alertChannelId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateAlertChannelResponse{ID: alertChannelId.String()})
+}
+
+// GetAlertChannels godoc
+//
+//	@Tags			AlertChannels
+//	@Summary		Get alert channels
+//	@Description	Get alert channels of the organization with the result of the last delivery. Secrets and SMTP passwords are not returned.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetAlertChannelsResponse
+//	@Router			/organizations/{organizationId}/alert-channels [get]
+//	@Security		JWT
+func (h *AlertChannelHandler) GetAlertChannels(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	alertChannels, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAlertChannelsResponse
+	out.AlertChannels = make([]domain.AlertChannelResponse, len(alertChannels))
+	for i, alertChannel := range alertChannels {
+		out.AlertChannels[i] = alertChannelResponse(r, alertChannel)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetAlertChannel godoc
+//
+//	@Tags			AlertChannels
+//	@Summary		Get alert channel
+//	@Description	Get alert channel
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			alertChannelId	path		string	true	"alertChannelId"
+//	@Success		200				{object}	domain.GetAlertChannelResponse
+//	@Router			/organizations/{organizationId}/alert-channels/{alertChannelId} [get]
+//	@Security		JWT
+func (h *AlertChannelHandler) GetAlertChannel(w http.ResponseWriter, r *http.Request) {
+	organizationId, alertChannelId, err := alertChannelVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	alertChannel, err := h.usecase.Get(r.Context(), organizationId, alertChannelId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.GetAlertChannelResponse{AlertChannel: alertChannelResponse(r, alertChannel)})
+}
+
+// UpdateAlertChannel godoc
+//
+//	@Tags			AlertChannels
+//	@Summary		Update alert channel
+//	@Description	Update alert channel. The type can not be changed and the secret and SMTP password are kept if they are empty.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string							true	"organizationId"
+//	@Param			alertChannelId	path	string							true	"alertChannelId"
+//	@Param			body			body	domain.UpdateAlertChannelRequest	true	"update alert channel request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/alert-channels/{alertChannelId} [put]
+//	@Security		JWT
+func (h *AlertChannelHandler) UpdateAlertChannel(w http.ResponseWriter, r *http.Request) {
+	organizationId, alertChannelId, err := alertChannelVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateAlertChannelRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.AlertChannel
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.ID = alertChannelId
+	dto.OrganizationId = organizationId
+
+	if err = h.usecase.Update(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteAlertChannel godoc
+//
+//	@Tags			AlertChannels
+//	@Summary		Delete alert channel
+//	@Description	Delete alert channel. The channel is removed from the alert routing rules.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			alertChannelId	path	string	true	"alertChannelId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/alert-channels/{alertChannelId} [delete]
+//	@Security		JWT
+func (h *AlertChannelHandler) DeleteAlertChannel(w http.ResponseWriter, r *http.Request) {
+	organizationId, alertChannelId, err := alertChannelVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.Delete(r.Context(), organizationId, alertChannelId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// TestAlertChannel godoc
+//
+//	@Tags			AlertChannels
+//	@Summary		Test alert channel
+//	@Description	Send a test alert to the channel immediately without retries and return the result
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			alertChannelId	path	string	true	"alertChannelId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/alert-channels/{alertChannelId}/test [post]
+//	@Security		JWT
+func (h *AlertChannelHandler) TestAlertChannel(w http.ResponseWriter, r *http.Request) {
+	organizationId, alertChannelId, err := alertChannelVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.Test(r.Context(), organizationId, alertChannelId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func alertChannelVars(r *http.Request) (organizationId string, alertChannelId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	alertChannelId, err = uuid.Parse(vars["alertChannelId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid alertChannelId"), "AC_INVALID_ALERT_CHANNEL_ID", "")
+	}
+	return organizationId, alertChannelId, nil
+}
+
+func alertChannelResponse(r *http.Request, alertChannel model.AlertChannel) (out domain.AlertChannelResponse) {
+	if err := serializer.Map(r.Context(), alertChannel, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+	out.SecretConfigured = alertChannel.Secret != ""
+	out.SmtpPasswordConfigured = alertChannel.SmtpPassword != ""
+	return out
+}
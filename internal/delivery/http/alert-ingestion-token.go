@@ -0,0 +1,196 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type AlertIngestionTokenHandler struct {
+	usecase usecase.IAlertIngestionTokenUsecase
+}
+
+func NewAlertIngestionTokenHandler(h usecase.Usecase) *AlertIngestionTokenHandler {
+	return &AlertIngestionTokenHandler{
+		usecase: h.AlertIngestionToken,
+	}
+}
+
+// CreateAlertIngestionToken godoc
+//
+//	@Tags			AlertIngestionTokens
+//	@Summary		Create alert ingestion token
+//	@Description	Create the token that alertmanager on the member clusters presents when posting alerts. The token is returned only once.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string									true	"organizationId"
+//	@Param			body			body		domain.CreateAlertIngestionTokenRequest	true	"create alert ingestion token request"
+//	@Success		200				{object}	domain.CreateAlertIngestionTokenResponse
+//	@Router			/organizations/{organizationId}/alert-ingestion-tokens [post]
+//	@Security		JWT
+func (h *AlertIngestionTokenHandler) CreateAlertIngestionToken(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateAlertIngestionTokenRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.AlertIngestionToken
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	tokenId, token, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.CreateAlertIngestionTokenResponse
+	out.ID = tokenId.String()
+	out.Token = token
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetAlertIngestionTokens godoc
+//
+//	@Tags			AlertIngestionTokens
+//	@Summary		Get alert ingestion tokens
+//	@Description	Get alert ingestion tokens of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetAlertIngestionTokensResponse
+//	@Router			/organizations/{organizationId}/alert-ingestion-tokens [get]
+//	@Security		JWT
+func (h *AlertIngestionTokenHandler) GetAlertIngestionTokens(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	tokens, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAlertIngestionTokensResponse
+	out.Tokens = make([]domain.AlertIngestionTokenResponse, len(tokens))
+	for i, token := range tokens {
+		if err := serializer.Map(r.Context(), token, &out.Tokens[i]); err != nil {
+			log.Info(r.Context(), err)
+			continue
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// RotateAlertIngestionToken godoc
+//
+//	@Tags			AlertIngestionTokens
+//	@Summary		Rotate alert ingestion token
+//	@Description	Issue a new token. The previous token is invalidated immediately.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			tokenId			path		string	true	"tokenId"
+//	@Success		200				{object}	domain.RotateAlertIngestionTokenResponse
+//	@Router			/organizations/{organizationId}/alert-ingestion-tokens/{tokenId}/rotate [post]
+//	@Security		JWT
+func (h *AlertIngestionTokenHandler) RotateAlertIngestionToken(w http.ResponseWriter, r *http.Request) {
+	organizationId, tokenId, err := h.pathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	token, err := h.usecase.Rotate(r.Context(), organizationId, tokenId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.RotateAlertIngestionTokenResponse
+	out.ID = tokenId.String()
+	out.Token = token
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// RevokeAlertIngestionToken godoc
+//
+//	@Tags			AlertIngestionTokens
+//	@Summary		Revoke alert ingestion token
+//	@Description	Revoke alert ingestion token
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			tokenId			path		string	true	"tokenId"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/alert-ingestion-tokens/{tokenId} [delete]
+//	@Security		JWT
+func (h *AlertIngestionTokenHandler) RevokeAlertIngestionToken(w http.ResponseWriter, r *http.Request) {
+	organizationId, tokenId, err := h.pathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.Revoke(r.Context(), organizationId, tokenId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func (h *AlertIngestionTokenHandler) pathParams(r *http.Request) (organizationId string, tokenId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	strId, ok := vars["tokenId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid tokenId"), "AIT_NOT_FOUND_TOKEN", "")
+	}
+	tokenId, err = uuid.Parse(strId)
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(err, "AIT_NOT_FOUND_TOKEN", "")
+	}
+	return organizationId, tokenId, nil
+}
@@ -0,0 +1,340 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type AlertRoutingRuleHandler struct {
+	usecase usecase.IAlertRoutingRuleUsecase
+}
+
+func NewAlertRoutingRuleHandler(h usecase.Usecase) *AlertRoutingRuleHandler {
+	return &AlertRoutingRuleHandler{
+		usecase: h.AlertRoutingRule,
+	}
+}
+
+// CreateAlertRoutingRule godoc
+//
+//	@Tags			AlertRoutingRules
+//	@Summary		Create alert routing rule
+//	@Description	Create the rule that determines which notification channels and escalation policy receive the alerts of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string									true	"organizationId"
+//	@Param			body			body		domain.CreateAlertRoutingRuleRequest	true	"create alert routing rule request"
+//	@Success		200				{object}	domain.CreateAlertRoutingRuleResponse
+//	@Router			/organizations/{organizationId}/alert-routing-rules [post]
+//	@Security		JWT
+func (h *AlertRoutingRuleHandler) CreateAlertRoutingRule(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateAlertRoutingRuleRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.AlertRoutingRule
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+	dto.EscalationPolicyId = parseEscalationPolicyId(input.EscalationPolicyId)
+
+	alertRoutingRuleId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.CreateAlertRoutingRuleResponse
+	out.ID = alertRoutingRuleId.String()
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetAlertRoutingRules godoc
+//
+//	@Tags			AlertRoutingRules
+//	@Summary		Get alert routing rules
+//	@Description	Get alert routing rules of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetAlertRoutingRulesResponse
+//	@Router			/organizations/{organizationId}/alert-routing-rules [get]
+//	@Security		JWT
+func (h *AlertRoutingRuleHandler) GetAlertRoutingRules(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	rules, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAlertRoutingRulesResponse
+	out.AlertRoutingRules = make([]domain.AlertRoutingRuleResponse, len(rules))
+	for i, rule := range rules {
+		out.AlertRoutingRules[i] = alertRoutingRuleResponse(r.Context(), rule)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetAlertRoutingRule godoc
+//
+//	@Tags			AlertRoutingRules
+//	@Summary		Get alert routing rule
+//	@Description	Get alert routing rule
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"organizationId"
+//	@Param			alertRoutingRuleId	path		string	true	"alertRoutingRuleId"
+//	@Success		200					{object}	domain.GetAlertRoutingRuleResponse
+//	@Router			/organizations/{organizationId}/alert-routing-rules/{alertRoutingRuleId} [get]
+//	@Security		JWT
+func (h *AlertRoutingRuleHandler) GetAlertRoutingRule(w http.ResponseWriter, r *http.Request) {
+	organizationId, alertRoutingRuleId, err := h.pathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	rule, err := h.usecase.Get(r.Context(), organizationId, alertRoutingRuleId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAlertRoutingRuleResponse
+	out.AlertRoutingRule = alertRoutingRuleResponse(r.Context(), rule)
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateAlertRoutingRule godoc
+//
+//	@Tags			AlertRoutingRules
+//	@Summary		Update alert routing rule
+//	@Description	Update alert routing rule
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string									true	"organizationId"
+//	@Param			alertRoutingRuleId	path		string									true	"alertRoutingRuleId"
+//	@Param			body				body		domain.UpdateAlertRoutingRuleRequest	true	"update alert routing rule request"
+//	@Success		200					{object}	nil
+//	@Router			/organizations/{organizationId}/alert-routing-rules/{alertRoutingRuleId} [put]
+//	@Security		JWT
+func (h *AlertRoutingRuleHandler) UpdateAlertRoutingRule(w http.ResponseWriter, r *http.Request) {
+	organizationId, alertRoutingRuleId, err := h.pathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateAlertRoutingRuleRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.AlertRoutingRule
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.ID = alertRoutingRuleId
+	dto.OrganizationId = organizationId
+	dto.EscalationPolicyId = parseEscalationPolicyId(input.EscalationPolicyId)
+
+	if err = h.usecase.Update(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteAlertRoutingRule godoc
+//
+//	@Tags			AlertRoutingRules
+//	@Summary		Delete alert routing rule
+//	@Description	Delete alert routing rule
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"organizationId"
+//	@Param			alertRoutingRuleId	path		string	true	"alertRoutingRuleId"
+//	@Success		200					{object}	nil
+//	@Router			/organizations/{organizationId}/alert-routing-rules/{alertRoutingRuleId} [delete]
+//	@Security		JWT
+func (h *AlertRoutingRuleHandler) DeleteAlertRoutingRule(w http.ResponseWriter, r *http.Request) {
+	organizationId, alertRoutingRuleId, err := h.pathParams(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.Delete(r.Context(), organizationId, alertRoutingRuleId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// TestAlertRoutingRules godoc
+//
+//	@Tags			AlertRoutingRules
+//	@Summary		Test alert routing rules
+//	@Description	Show which enabled rules a sample alert would match, in the order they are evaluated. No notification is sent.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.TestAlertRoutingRulesRequest	true	"sample alert"
+//	@Success		200				{object}	domain.TestAlertRoutingRulesResponse
+//	@Router			/organizations/{organizationId}/alert-routing-rules/test [post]
+//	@Security		JWT
+func (h *AlertRoutingRuleHandler) TestAlertRoutingRules(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.TestAlertRoutingRulesRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	rules, err := h.usecase.Test(r.Context(), organizationId, input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.TestAlertRoutingRulesResponse{
+		MatchedRules:        make([]domain.SimpleAlertRoutingRuleResponse, len(rules)),
+		Channels:            []domain.NotificationChannel{},
+		AlertChannels:       []domain.SimpleAlertChannelResponse{},
+		EscalationPolicyIds: []string{},
+	}
+	channels := map[domain.NotificationChannel]bool{}
+	alertChannelIds := map[uuid.UUID]bool{}
+	for i, rule := range rules {
+		if err := serializer.Map(r.Context(), rule, &out.MatchedRules[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+		for _, channel := range rule.Channels {
+			if !channels[channel] {
+				channels[channel] = true
+				out.Channels = append(out.Channels, channel)
+			}
+		}
+		for _, alertChannel := range rule.AlertChannels {
+			if !alertChannelIds[alertChannel.ID] {
+				alertChannelIds[alertChannel.ID] = true
+				out.AlertChannels = append(out.AlertChannels, simpleAlertChannelResponses(r.Context(), []model.AlertChannel{alertChannel})...)
+			}
+		}
+		if rule.EscalationPolicyId != nil {
+			out.EscalationPolicyIds = append(out.EscalationPolicyIds, rule.EscalationPolicyId.String())
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func (h *AlertRoutingRuleHandler) pathParams(r *http.Request) (organizationId string, alertRoutingRuleId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	strId, ok := vars["alertRoutingRuleId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid alertRoutingRuleId"), "ARR_NOT_FOUND_RULE", "")
+	}
+	alertRoutingRuleId, err = uuid.Parse(strId)
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(err, "ARR_NOT_FOUND_RULE", "")
+	}
+	return organizationId, alertRoutingRuleId, nil
+}
+
+func alertRoutingRuleResponse(ctx context.Context, rule model.AlertRoutingRule) (out domain.AlertRoutingRuleResponse) {
+	if err := serializer.Map(ctx, rule, &out); err != nil {
+		log.Info(ctx, err)
+	}
+	if rule.EscalationPolicyId != nil {
+		out.EscalationPolicyId = rule.EscalationPolicyId.String()
+	}
+	if out.Channels == nil {
+		out.Channels = []domain.NotificationChannel{}
+	}
+	out.AlertChannels = simpleAlertChannelResponses(ctx, rule.AlertChannels)
+	out.TargetUsers = make([]domain.SimpleUserResponse, len(rule.TargetUsers))
+	for i, targetUser := range rule.TargetUsers {
+		if err := serializer.Map(ctx, targetUser, &out.TargetUsers[i]); err != nil {
+			log.Info(ctx, err)
+		}
+	}
+	return out
+}
+
+func parseEscalationPolicyId(strId string) *uuid.UUID {
+	if strId == "" {
+		return nil
+	}
+	id, err := uuid.Parse(strId)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+func simpleAlertChannelResponses(ctx context.Context, alertChannels []model.AlertChannel) []domain.SimpleAlertChannelResponse {
+	out := make([]domain.SimpleAlertChannelResponse, len(alertChannels))
+	for i, alertChannel := range alertChannels {
+		if err := serializer.Map(ctx, alertChannel, &out[i]); err != nil {
+			log.Info(ctx, err)
+		}
+	}
+	return out
+}
@@ -0,0 +1,231 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type AlertSilenceHandler struct {
+	usecase usecase.IAlertSilenceUsecase
+}
+
+func NewAlertSilenceHandler(h usecase.Usecase) *AlertSilenceHandler {
+	return &AlertSilenceHandler{
+		usecase: h.AlertSilence,
+	}
+}
+
+// CreateAlertSilence godoc
+//
+//	@Tags			AlertSilences
+//	@Summary		Create alert silence
+//	@Description	Create alert silence. Alerts matching the silence during the period are recorded with the SILENCED status and are not notified.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.CreateAlertSilenceRequest	true	"create alert silence request"
+//	@Success		200				{object}	domain.CreateAlertSilenceResponse
+//	@Router			/organizations/{organizationId}/alert-silences [post]
+//	@Security		JWT
+func (h *AlertSilenceHandler) CreateAlertSilence(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateAlertSilenceRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.AlertSilence
+	if err := serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	alertSilenceId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateAlertSilenceResponse{ID: alertSilenceId.String()})
+}
+
+// GetAlertSilences godoc
+//
+//	@Tags			AlertSilences
+//	@Summary		Get alert silences
+//	@Description	Get alert silences of the organization. The state is one of PENDING, ACTIVE and EXPIRED.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetAlertSilencesResponse
+//	@Router			/organizations/{organizationId}/alert-silences [get]
+//	@Security		JWT
+func (h *AlertSilenceHandler) GetAlertSilences(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	alertSilences, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	now := time.Now()
+	var out domain.GetAlertSilencesResponse
+	out.AlertSilences = make([]domain.AlertSilenceResponse, len(alertSilences))
+	for i, alertSilence := range alertSilences {
+		out.AlertSilences[i] = alertSilenceResponse(r, alertSilence, now)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetAlertSilence godoc
+//
+//	@Tags			AlertSilences
+//	@Summary		Get alert silence
+//	@Description	Get alert silence
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			alertSilenceId	path		string	true	"alertSilenceId"
+//	@Success		200				{object}	domain.GetAlertSilenceResponse
+//	@Router			/organizations/{organizationId}/alert-silences/{alertSilenceId} [get]
+//	@Security		JWT
+func (h *AlertSilenceHandler) GetAlertSilence(w http.ResponseWriter, r *http.Request) {
+	organizationId, alertSilenceId, err := alertSilenceVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	alertSilence, err := h.usecase.Get(r.Context(), organizationId, alertSilenceId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.GetAlertSilenceResponse{AlertSilence: alertSilenceResponse(r, alertSilence, time.Now())})
+}
+
+// UpdateAlertSilence godoc
+//
+//	@Tags			AlertSilences
+//	@Summary		Update alert silence
+//	@Description	Update alert silence. Set endAt to the current time to expire the silence while keeping its history.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string								true	"organizationId"
+//	@Param			alertSilenceId	path	string								true	"alertSilenceId"
+//	@Param			body			body	domain.UpdateAlertSilenceRequest	true	"update alert silence request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/alert-silences/{alertSilenceId} [put]
+//	@Security		JWT
+func (h *AlertSilenceHandler) UpdateAlertSilence(w http.ResponseWriter, r *http.Request) {
+	organizationId, alertSilenceId, err := alertSilenceVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateAlertSilenceRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.AlertSilence
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.ID = alertSilenceId
+	dto.OrganizationId = organizationId
+
+	if err = h.usecase.Update(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteAlertSilence godoc
+//
+//	@Tags			AlertSilences
+//	@Summary		Delete alert silence
+//	@Description	Delete alert silence. Alerts already silenced keep the SILENCED status.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			alertSilenceId	path	string	true	"alertSilenceId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/alert-silences/{alertSilenceId} [delete]
+//	@Security		JWT
+func (h *AlertSilenceHandler) DeleteAlertSilence(w http.ResponseWriter, r *http.Request) {
+	organizationId, alertSilenceId, err := alertSilenceVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.Delete(r.Context(), organizationId, alertSilenceId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func alertSilenceVars(r *http.Request) (organizationId string, alertSilenceId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	alertSilenceId, err = uuid.Parse(vars["alertSilenceId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid alertSilenceId"), "ASL_INVALID_ALERT_SILENCE_ID", "")
+	}
+	return organizationId, alertSilenceId, nil
+}
+
+func alertSilenceResponse(r *http.Request, alertSilence model.AlertSilence, now time.Time) (out domain.AlertSilenceResponse) {
+	if err := serializer.Map(r.Context(), alertSilence, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+	out.State = alertSilence.State(now)
+	return out
+}
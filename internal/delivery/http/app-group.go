@@ -49,7 +49,7 @@ func (h *AppGroupHandler) CreateAppGroup(w http.ResponseWriter, r *http.Request)
 		log.Info(r.Context(), err)
 	}
 
-	appGroupId, err := h.usecase.Create(r.Context(), dto)
+	appGroupId, operation, err := h.usecase.Create(r.Context(), dto)
 	if err != nil {
 		ErrorJSON(w, r, err)
 		return
@@ -57,6 +57,7 @@ func (h *AppGroupHandler) CreateAppGroup(w http.ResponseWriter, r *http.Request)
 
 	var out domain.CreateAppGroupResponse
 	out.ID = appGroupId.String()
+	out.TaskId = operation.ID.String()
 
 	ResponseJSON(w, r, http.StatusOK, out)
 }
@@ -154,7 +155,7 @@ func (h *AppGroupHandler) GetAppGroup(w http.ResponseWriter, r *http.Request) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			object	body		string	true	"body"
-//	@Success		200		{object}	nil
+//	@Success		200		{object}	domain.DeleteAppGroupResponse
 //	@Router			/app-groups [delete]
 //	@Security		JWT
 func (h *AppGroupHandler) DeleteAppGroup(w http.ResponseWriter, r *http.Request) {
@@ -171,14 +172,14 @@ func (h *AppGroupHandler) DeleteAppGroup(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	err := h.usecase.Delete(r.Context(), appGroupId)
+	operation, err := h.usecase.Delete(r.Context(), appGroupId)
 	if err != nil {
 		log.Error(r.Context(), "Failed to delete appGroup err : ", err)
 		ErrorJSON(w, r, err)
 		return
 	}
 
-	ResponseJSON(w, r, http.StatusOK, nil)
+	ResponseJSON(w, r, http.StatusOK, domain.DeleteAppGroupResponse{TaskId: operation.ID.String()})
 }
 
 // GetApplications godoc
@@ -0,0 +1,174 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// GetAppServeAppConfigs godoc
+//
+//	@Tags			AppServeApps
+//	@Summary		Get config revisions of appServeApp
+//	@Description	Get env and secret config revisions of appServeApp. Values of secrets are not returned
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"Organization ID"
+//	@Param			projectId		path		string		true	"Project ID"
+//	@Param			appId			path		string		true	"App ID"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetAppServeAppConfigsResponse
+//	@Router			/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/configs [get]
+//	@Security		JWT
+func (h *AppServeAppHandler) GetAppServeAppConfigs(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	appId, ok := vars["appId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid appId"), "C_INVALID_ASA_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+
+	configs, err := h.usecase.GetAppServeAppConfigs(r.Context(), appId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAppServeAppConfigsResponse
+	out.Configs = configs
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetAppServeAppConfig godoc
+//
+//	@Tags			AppServeApps
+//	@Summary		Get config revision of appServeApp
+//	@Description	Get env and secret keys of the config revision of appServeApp. Values of secrets are not returned
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Param			projectId		path		string	true	"Project ID"
+//	@Param			appId			path		string	true	"App ID"
+//	@Param			revision		path		int		true	"Config revision"
+//	@Success		200				{object}	domain.GetAppServeAppConfigResponse
+//	@Router			/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/configs/{revision} [get]
+//	@Security		JWT
+func (h *AppServeAppHandler) GetAppServeAppConfig(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	appId, ok := vars["appId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid appId"), "C_INVALID_ASA_ID", ""))
+		return
+	}
+	revision, err := strconv.Atoi(vars["revision"])
+	if err != nil || revision <= 0 {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid revision"), "ASA_INVALID_CONFIG", ""))
+		return
+	}
+
+	config, err := h.usecase.GetAppServeAppConfig(r.Context(), appId, revision)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.GetAppServeAppConfigResponse{Config: config})
+}
+
+// UpdateAppServeAppConfig godoc
+//
+//	@Tags			AppServeApps
+//	@Summary		Update config of appServeApp
+//	@Description	Create a new config revision with the whole env and secrets of appServeApp. Secrets are sealed with the encryption key of the organization and synced to a Kubernetes Secret on the next deploy. An empty secret value keeps the value of the previous revision
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string									true	"Organization ID"
+//	@Param			projectId		path		string									true	"Project ID"
+//	@Param			appId			path		string									true	"App ID"
+//	@Param			body			body		domain.UpdateAppServeAppConfigRequest	true	"Update app config request"
+//	@Success		200				{object}	domain.UpdateAppServeAppConfigResponse
+//	@Router			/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/configs [put]
+//	@Security		JWT
+func (h *AppServeAppHandler) UpdateAppServeAppConfig(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	appId, ok := vars["appId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid appId"), "C_INVALID_ASA_ID", ""))
+		return
+	}
+
+	input := domain.UpdateAppServeAppConfigRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	revision, err := h.usecase.UpdateAppServeAppConfig(r.Context(), appId, input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.UpdateAppServeAppConfigResponse{Revision: revision})
+}
+
+// GetAppServeAppConfigDiff godoc
+//
+//	@Tags			AppServeApps
+//	@Summary		Get changes between config revisions of appServeApp
+//	@Description	Get env and secrets added, removed or modified from the revision 'from' to the revision 'to'. Only keys are returned for secrets. 'from' 0 compares with an empty config
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Param			projectId		path		string	true	"Project ID"
+//	@Param			appId			path		string	true	"App ID"
+//	@Param			from			query		int		true	"Config revision to compare from"
+//	@Param			to				query		int		true	"Config revision to compare to"
+//	@Success		200				{object}	domain.GetAppServeAppConfigDiffResponse
+//	@Router			/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/configs/diff [get]
+//	@Security		JWT
+func (h *AppServeAppHandler) GetAppServeAppConfigDiff(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	appId, ok := vars["appId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid appId"), "C_INVALID_ASA_ID", ""))
+		return
+	}
+
+	query := r.URL.Query()
+	from, err := strconv.Atoi(query.Get("from"))
+	if err != nil || from < 0 {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid from revision"), "ASA_INVALID_CONFIG", ""))
+		return
+	}
+	to, err := strconv.Atoi(query.Get("to"))
+	if err != nil || to <= 0 {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid to revision"), "ASA_INVALID_CONFIG", ""))
+		return
+	}
+
+	out, err := h.usecase.GetAppServeAppConfigDiff(r.Context(), appId, from, to)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
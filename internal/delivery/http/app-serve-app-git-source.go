@@ -0,0 +1,190 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// push event 의 payload 는 commit 목록을 포함하므로 여유 있게 받는다.
+const gitWebhookMaxPayloadSize = 5 << 20
+
+// GetAppServeAppGitSource godoc
+//
+//	@Tags			AppServeApps
+//	@Summary		Get git source of appServeApp
+//	@Description	Get the git repository and branch the app is built from, with the webhook url to register to the git provider
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Param			projectId		path		string	true	"Project ID"
+//	@Param			appId			path		string	true	"App ID"
+//	@Success		200				{object}	domain.GetAppServeAppGitSourceResponse
+//	@Router			/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/git-source [get]
+//	@Security		JWT
+func (h *AppServeAppHandler) GetAppServeAppGitSource(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	appId, ok := vars["appId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("invalid appId"), "C_INVALID_ASA_ID"))
+		return
+	}
+
+	source, err := h.usecase.GetAppServeAppGitSource(r.Context(), appId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.GetAppServeAppGitSourceResponse{GitSource: source})
+}
+
+// UpdateAppServeAppGitSource godoc
+//
+//	@Tags			AppServeApps
+//	@Summary		Set git source of appServeApp
+//	@Description	Set the git repository and branch to build the app from. The webhook secret is returned only when it is newly generated
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string										true	"Organization ID"
+//	@Param			projectId		path		string										true	"Project ID"
+//	@Param			appId			path		string										true	"App ID"
+//	@Param			body			body		domain.UpdateAppServeAppGitSourceRequest	true	"git source request"
+//	@Success		200				{object}	domain.UpdateAppServeAppGitSourceResponse
+//	@Router			/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/git-source [put]
+//	@Security		JWT
+func (h *AppServeAppHandler) UpdateAppServeAppGitSource(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	appId, ok := vars["appId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("invalid appId"), "C_INVALID_ASA_ID"))
+		return
+	}
+
+	input := domain.UpdateAppServeAppGitSourceRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out, err := h.usecase.UpdateAppServeAppGitSource(r.Context(), appId, input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// DeleteAppServeAppGitSource godoc
+//
+//	@Tags			AppServeApps
+//	@Summary		Delete git source of appServeApp
+//	@Description	Delete git source of the app. Webhooks for the app are rejected afterwards
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"Organization ID"
+//	@Param			projectId		path	string	true	"Project ID"
+//	@Param			appId			path	string	true	"App ID"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/git-source [delete]
+//	@Security		JWT
+func (h *AppServeAppHandler) DeleteAppServeAppGitSource(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	appId, ok := vars["appId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("invalid appId"), "C_INVALID_ASA_ID"))
+		return
+	}
+
+	if err := h.usecase.DeleteAppServeAppGitSource(r.Context(), appId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// BuildAppServeApp godoc
+//
+//	@Tags			AppServeApps
+//	@Summary		Build appServeApp from git source
+//	@Description	Build the app from a commit of the git source and deploy it as a new version. The latest commit of the branch is built if commit is empty
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string							true	"Organization ID"
+//	@Param			projectId		path		string							true	"Project ID"
+//	@Param			appId			path		string							true	"App ID"
+//	@Param			body			body		domain.BuildAppServeAppRequest	true	"build request"
+//	@Success		200				{object}	domain.BuildAppServeAppResponse
+//	@Router			/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/git-source/build [post]
+//	@Security		JWT
+func (h *AppServeAppHandler) BuildAppServeApp(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	appId, ok := vars["appId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("invalid appId"), "C_INVALID_ASA_ID"))
+		return
+	}
+
+	input := domain.BuildAppServeAppRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out, err := h.usecase.BuildAppServeApp(r.Context(), appId, input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// ReceiveGitWebhook godoc
+//
+//	@Tags			AppServeApps
+//	@Summary		Receive push webhook of git provider
+//	@Description	Receive a push webhook of GitHub or GitLab. The request is verified with X-Hub-Signature-256 or X-Gitlab-Token, and the app is rebuilt when auto deploy is enabled and the configured branch is pushed
+//	@Accept			json
+//	@Produce		json
+//	@Param			appId	path		string	true	"App ID"
+//	@Success		200		{object}	domain.GitWebhookResponse
+//	@Router			/git-webhooks/app-serve-apps/{appId} [post]
+func (h *AppServeAppHandler) ReceiveGitWebhook(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	appId, ok := vars["appId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("invalid appId"), "C_INVALID_ASA_ID"))
+		return
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, gitWebhookMaxPayloadSize))
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewError(err, "C_INVALID_REQUEST_FIELD"))
+		return
+	}
+
+	req := domain.GitWebhookRequest{
+		Event:     r.Header.Get("X-GitHub-Event"),
+		Signature: r.Header.Get("X-Hub-Signature-256"),
+		Token:     r.Header.Get("X-Gitlab-Token"),
+		Payload:   payload,
+	}
+	if req.Event == "" {
+		req.Event = r.Header.Get("X-Gitlab-Event")
+	}
+
+	out, err := h.usecase.ReceiveGitWebhook(r.Context(), appId, req)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
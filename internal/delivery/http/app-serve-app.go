@@ -24,48 +24,52 @@ import (
 
 var (
 	StatusResult = map[string]string{
-		"BUILDING":         "PROGRESS",
-		"BUILD_SUCCESS":    "DONE",
-		"BUILD_FAILED":     "FAILED",
-		"DEPLOYING":        "PROGRESS",
-		"DEPLOY_SUCCESS":   "DONE",
-		"DEPLOY_FAILED":    "FAILED",
-		"PROMOTE_WAIT":     "WAITING",
-		"PROMOTING":        "PROGRESS",
-		"PROMOTE_SUCCESS":  "DONE",
-		"PROMOTE_FAILED":   "FAILED",
-		"ABORTING":         "PROGRESS",
-		"ABORT_SUCCESS":    "DONE",
-		"ABORT_FAILED":     "FAILED",
-		"ROLLBACKING":      "PROGRESS",
-		"ROLLBACK_SUCCESS": "DONE",
-		"ROLLBACK_FAILED":  "FAILED",
-		"DELETING":         "PROGRESS",
-		"DELETE_SUCCESS":   "DONE",
-		"DELETE_FAILED":    "FAILED",
-		"WAITING":          "WAITING",
+		"BUILDING":          "PROGRESS",
+		"BUILD_SUCCESS":     "DONE",
+		"BUILD_FAILED":      "FAILED",
+		"DEPLOYING":         "PROGRESS",
+		"DEPLOY_SUCCESS":    "DONE",
+		"DEPLOY_FAILED":     "FAILED",
+		"PROMOTE_WAIT":      "WAITING",
+		"PROMOTING":         "PROGRESS",
+		"PROMOTE_SUCCESS":   "DONE",
+		"PROMOTE_FAILED":    "FAILED",
+		"ABORTING":          "PROGRESS",
+		"ABORT_SUCCESS":     "DONE",
+		"ABORT_FAILED":      "FAILED",
+		"ROLLBACKING":       "PROGRESS",
+		"ROLLBACK_SUCCESS":  "DONE",
+		"ROLLBACK_FAILED":   "FAILED",
+		"DELETING":          "PROGRESS",
+		"DELETE_SUCCESS":    "DONE",
+		"DELETE_FAILED":     "FAILED",
+		"WAITING":           "WAITING",
+		"APPROVAL_WAIT":     "WAITING",
+		"APPROVAL_REJECTED": "FAILED",
 	}
 	StatusStages = map[string]map[string]string{
-		"PREPARING":        {"build": "WAITING", "deploy": "WAITING", "promote": "WAITING"},
-		"BUILDING":         {"build": "BUILDING", "deploy": "WAITING", "promote": "WAITING"},
-		"BUILD_SUCCESS":    {"build": "BUILD_SUCCESS", "deploy": "WAITING", "promote": "WAITING"},
-		"BUILD_FAILED":     {"build": "BUILD_FAILED", "deploy": "WAITING", "promote": "WAITING"},
-		"DEPLOYING":        {"build": "BUILD_SUCCESS", "deploy": "DEPLOYING", "promote": "WAITING"},
-		"DEPLOY_SUCCESS":   {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "WAITING"},
-		"DEPLOY_FAILED":    {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_FAILED", "promote": "WAITING"},
-		"PROMOTE_WAIT":     {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "PROMOTE_WAIT"},
-		"PROMOTING":        {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "PROMOTING"},
-		"PROMOTE_SUCCESS":  {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "PROMOTE_SUCCESS"},
-		"PROMOTE_FAILED":   {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "PROMOTE_FAILED"},
-		"ABORTING":         {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "ABORTING"},
-		"ABORT_SUCCESS":    {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "ABORT_SUCCESS"},
-		"ABORT_FAILED":     {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "ABORT_FAILED"},
-		"ROLLBACKING":      {"rollback": "ROLLBACKING"},
-		"ROLLBACK_SUCCESS": {"rollback": "ROLLBACK_SUCCESS"},
-		"ROLLBACK_FAILED":  {"rollback": "ROLLBACK_FAILED"},
-		"DELETING":         {"delete": "DELETING"},
-		"DELETE_SUCCESS":   {"delete": "DELETE_SUCCESS"},
-		"DELETE_FAILED":    {"delete": "DELETE_FAILED"},
+		"APPROVAL_WAIT":     {"build": "WAITING", "deploy": "WAITING", "promote": "WAITING"},
+		"APPROVAL_REJECTED": {"build": "WAITING", "deploy": "WAITING", "promote": "WAITING"},
+		"PREPARING":         {"build": "WAITING", "deploy": "WAITING", "promote": "WAITING"},
+		"BUILDING":          {"build": "BUILDING", "deploy": "WAITING", "promote": "WAITING"},
+		"BUILD_SUCCESS":     {"build": "BUILD_SUCCESS", "deploy": "WAITING", "promote": "WAITING"},
+		"BUILD_FAILED":      {"build": "BUILD_FAILED", "deploy": "WAITING", "promote": "WAITING"},
+		"DEPLOYING":         {"build": "BUILD_SUCCESS", "deploy": "DEPLOYING", "promote": "WAITING"},
+		"DEPLOY_SUCCESS":    {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "WAITING"},
+		"DEPLOY_FAILED":     {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_FAILED", "promote": "WAITING"},
+		"PROMOTE_WAIT":      {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "PROMOTE_WAIT"},
+		"PROMOTING":         {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "PROMOTING"},
+		"PROMOTE_SUCCESS":   {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "PROMOTE_SUCCESS"},
+		"PROMOTE_FAILED":    {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "PROMOTE_FAILED"},
+		"ABORTING":          {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "ABORTING"},
+		"ABORT_SUCCESS":     {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "ABORT_SUCCESS"},
+		"ABORT_FAILED":      {"build": "BUILD_SUCCESS", "deploy": "DEPLOY_SUCCESS", "promote": "ABORT_FAILED"},
+		"ROLLBACKING":       {"rollback": "ROLLBACKING"},
+		"ROLLBACK_SUCCESS":  {"rollback": "ROLLBACK_SUCCESS"},
+		"ROLLBACK_FAILED":   {"rollback": "ROLLBACK_FAILED"},
+		"DELETING":          {"delete": "DELETING"},
+		"DELETE_SUCCESS":    {"delete": "DELETE_SUCCESS"},
+		"DELETE_FAILED":     {"delete": "DELETE_FAILED"},
 	}
 )
 
@@ -239,6 +243,10 @@ func (h *AppServeAppHandler) CreateAppServeApp(w http.ResponseWriter, r *http.Re
 //	@Param			organizationId	path		string		true	"Organization ID"
 //	@Param			projectId		path		string		true	"Project ID"
 //	@Param			showAll			query		boolean		false	"Show all apps including deleted apps"
+//	@Param			status			query		[]string	false	"status"
+//	@Param			stage			query		string		false	"stage (PREPARE, BUILD, DEPLOY, PROMOTE, ROLLBACK, DELETE)"
+//	@Param			targetClusterId	query		string		false	"target cluster id"
+//	@Param			search			query		string		false	"name keyword"
 //	@Param			pageSize		query		string		false	"pageSize"
 //	@Param			pageNumber		query		string		false	"pageNumber"
 //	@Param			soertColumn		query		string		false	"sortColumn"
@@ -278,8 +286,17 @@ func (h *AppServeAppHandler) GetAppServeApps(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	filter := domain.AppServeAppFilter{
+		Stage:           urlParams.Get("stage"),
+		TargetClusterId: urlParams.Get("targetClusterId"),
+		Search:          urlParams.Get("search"),
+	}
+	for _, status := range urlParams["status"] {
+		filter.Statuses = append(filter.Statuses, strings.Split(status, ",")...)
+	}
+
 	pg := pagination.NewPagination(&urlParams)
-	apps, err := h.usecase.GetAppServeApps(r.Context(), organizationId, projectId, showAll, pg)
+	apps, err := h.usecase.GetAppServeApps(r.Context(), organizationId, projectId, showAll, filter, pg)
 	if err != nil {
 		log.Error(r.Context(), "Failed to get Failed to get app-serve-apps ", err)
 		ErrorJSON(w, r, err)
@@ -422,6 +439,34 @@ func (h *AppServeAppHandler) GetNumOfAppsOnStack(w http.ResponseWriter, r *http.
 	ResponseJSON(w, r, http.StatusOK, numApps)
 }
 
+// GetAppServeAppSummary godoc
+//
+//	@Tags			AppServeApps
+//	@Summary		Get appServeApp summary
+//	@Description	Get the number of apps of the organization by status and stage
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Success		200				{object}	domain.GetAppServeAppSummaryResponse
+//	@Router			/organizations/{organizationId}/app-serve-apps/summary [get]
+//	@Security		JWT
+func (h *AppServeAppHandler) GetAppServeAppSummary(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	out, err := h.usecase.GetAppServeAppSummary(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
 // GetAppServeAppTasksByAppId godoc
 //
 //	@Tags			AppServeApps
@@ -836,6 +881,14 @@ func (h *AppServeAppHandler) UpdateAppServeApp(w http.ResponseWriter, r *http.Re
 	}
 	newVerStr := strconv.Itoa(verInt + 1)
 
+	// artifact 로 배포하면 이전 task 의 git 소스로 빌드하지 않는다.
+	if appReq.ArtifactUrl != "" {
+		task.SourceRepoUrl = ""
+		task.SourceBranch = ""
+		task.SourceCommit = ""
+		task.SourceSecretName = ""
+	}
+
 	task.Version = newVerStr
 	//task.AppServeAppId = app.ID
 	task.Status = "PREPARING"
@@ -1001,14 +1054,14 @@ func (h *AppServeAppHandler) DeleteAppServeApp(w http.ResponseWriter, r *http.Re
 //
 //	@Tags			AppServeApps
 //	@Summary		Rollback appServeApp
-//	@Description	Rollback appServeApp
+//	@Description	Rollback appServeApp to the helm revision of a previously deployed task. The rollback creates a new task and is tracked as an operation
 //	@Accept			json
 //	@Produce		json
 //	@Param			organizationId	path		string								true	"Organization ID"
 //	@Param			projectId		path		string								true	"Project ID"
 //	@Param			appId			path		string								true	"App ID"
 //	@Param			object			body		domain.RollbackAppServeAppRequest	true	"Request body to rollback app"
-//	@Success		200				{object}	string
+//	@Success		200				{object}	domain.RollbackAppServeAppResponse
 //	@Router			/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/rollback [post]
 //	@Security		JWT
 func (h *AppServeAppHandler) RollbackAppServeApp(w http.ResponseWriter, r *http.Request) {
@@ -1037,12 +1090,75 @@ func (h *AppServeAppHandler) RollbackAppServeApp(w http.ResponseWriter, r *http.
 		return
 	}
 
-	res, err := h.usecase.RollbackAppServeApp(r.Context(), appId, appReq.TaskId)
+	out, err := h.usecase.RollbackAppServeApp(r.Context(), appId, appReq.TaskId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
 
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetAppServeAppMetrics godoc
+//
+//	@Tags			AppServeApps
+//	@Summary		Get metrics of appServeApp
+//	@Description	Get CPU, memory, request rate and 5xx error rate series of the pods of appServeApp
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Param			projectId		path		string	true	"Project ID"
+//	@Param			appId			path		string	true	"App ID"
+//	@Param			duration		query		string	false	"duration (1h, 1d, 7d, 30d). default 1h"
+//	@Param			interval		query		string	false	"interval (1m, 5m, 10m, 30m, 1h, 1d). default 1m"
+//	@Success		200				{object}	domain.GetAppServeAppMetricsResponse
+//	@Router			/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/metrics [get]
+//	@Security		JWT
+func (h *AppServeAppHandler) GetAppServeAppMetrics(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	projectId, ok := vars["projectId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid projectId"), "C_INVALID_PROJECT_ID", ""))
+		return
+	}
+
+	appId, ok := vars["appId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid appId"), "C_INVALID_ASA_ID", ""))
+		return
+	}
+
+	prj, err := h.prjUsecase.GetProject(r.Context(), organizationId, projectId)
 	if err != nil {
-		ErrorJSON(w, r, httpErrors.NewBadRequestError(err, "", ""))
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("Error while checking project record: %s", err), "", ""))
+		return
+	} else if prj == nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("projectId not found: %s", projectId), "C_INVALID_PROJECT_ID", ""))
 		return
 	}
 
-	ResponseJSON(w, r, http.StatusOK, res)
+	query := r.URL.Query()
+	duration := query.Get("duration")
+	if duration == "" {
+		duration = "1h" // default
+	}
+
+	interval := query.Get("interval")
+	if interval == "" {
+		interval = "1m" // default
+	}
+
+	out, err := h.usecase.GetAppServeAppMetrics(r.Context(), appId, duration, interval)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
 }
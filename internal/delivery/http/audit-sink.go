@@ -0,0 +1,341 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type AuditSinkHandler struct {
+	usecase usecase.IAuditSinkUsecase
+}
+
+func NewAuditSinkHandler(h usecase.Usecase) *AuditSinkHandler {
+	return &AuditSinkHandler{
+		usecase: h.AuditSink,
+	}
+}
+
+// CreateAuditSink godoc
+//
+//	@Tags			AuditSinks
+//	@Summary		Create audit sink
+//	@Description	Forward audits of the organization to a webhook, syslog server or Kafka REST Proxy. Audits are forwarded asynchronously and stored as dead letters after retries fail.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string							true	"organizationId"
+//	@Param			body			body		domain.CreateAuditSinkRequest	true	"create audit sink request"
+//	@Success		200				{object}	domain.CreateAuditSinkResponse
+//	@Router			/organizations/{organizationId}/audit-sinks [post]
+//	@Security		JWT
+func (h *AuditSinkHandler) CreateAuditSink(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateAuditSinkRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.AuditSink
+	if err := serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	auditSinkId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateAuditSinkResponse{ID: auditSinkId.String()})
+}
+
+// GetAuditSinks godoc
+//
+//	@Tags			AuditSinks
+//	@Summary		Get audit sinks
+//	@Description	Get audit sinks of the organization. Secrets are not returned.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetAuditSinksResponse
+//	@Router			/organizations/{organizationId}/audit-sinks [get]
+//	@Security		JWT
+func (h *AuditSinkHandler) GetAuditSinks(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	auditSinks, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAuditSinksResponse
+	out.AuditSinks = make([]domain.AuditSinkResponse, len(auditSinks))
+	for i, auditSink := range auditSinks {
+		out.AuditSinks[i] = auditSinkResponse(r, auditSink)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetAuditSink godoc
+//
+//	@Tags			AuditSinks
+//	@Summary		Get audit sink
+//	@Description	Get audit sink
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			auditSinkId		path		string	true	"auditSinkId"
+//	@Success		200				{object}	domain.GetAuditSinkResponse
+//	@Router			/organizations/{organizationId}/audit-sinks/{auditSinkId} [get]
+//	@Security		JWT
+func (h *AuditSinkHandler) GetAuditSink(w http.ResponseWriter, r *http.Request) {
+	organizationId, auditSinkId, err := auditSinkVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	auditSink, err := h.usecase.Get(r.Context(), organizationId, auditSinkId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.GetAuditSinkResponse{AuditSink: auditSinkResponse(r, auditSink)})
+}
+
+// UpdateAuditSink godoc
+//
+//	@Tags			AuditSinks
+//	@Summary		Update audit sink
+//	@Description	Update audit sink. The type can not be changed and the secret is kept if it is empty.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string							true	"organizationId"
+//	@Param			auditSinkId		path	string							true	"auditSinkId"
+//	@Param			body			body	domain.UpdateAuditSinkRequest	true	"update audit sink request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/audit-sinks/{auditSinkId} [put]
+//	@Security		JWT
+func (h *AuditSinkHandler) UpdateAuditSink(w http.ResponseWriter, r *http.Request) {
+	organizationId, auditSinkId, err := auditSinkVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateAuditSinkRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.AuditSink
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.ID = auditSinkId
+	dto.OrganizationId = organizationId
+
+	if err = h.usecase.Update(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteAuditSink godoc
+//
+//	@Tags			AuditSinks
+//	@Summary		Delete audit sink
+//	@Description	Delete audit sink and its dead letters
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			auditSinkId		path	string	true	"auditSinkId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/audit-sinks/{auditSinkId} [delete]
+//	@Security		JWT
+func (h *AuditSinkHandler) DeleteAuditSink(w http.ResponseWriter, r *http.Request) {
+	organizationId, auditSinkId, err := auditSinkVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.Delete(r.Context(), organizationId, auditSinkId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// GetAuditSinkDeadLetters godoc
+//
+//	@Tags			AuditSinks
+//	@Summary		Get dead letters of audit sink
+//	@Description	Get audits that could not be forwarded to the audit sink after retries
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			auditSinkId		path		string		true	"auditSinkId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Success		200				{object}	domain.GetAuditSinkDeadLettersResponse
+//	@Router			/organizations/{organizationId}/audit-sinks/{auditSinkId}/dead-letters [get]
+//	@Security		JWT
+func (h *AuditSinkHandler) GetAuditSinkDeadLetters(w http.ResponseWriter, r *http.Request) {
+	organizationId, auditSinkId, err := auditSinkVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	deadLetters, err := h.usecase.FetchDeadLetters(r.Context(), organizationId, auditSinkId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAuditSinkDeadLettersResponse
+	out.DeadLetters = make([]domain.AuditSinkDeadLetterResponse, len(deadLetters))
+	for i, deadLetter := range deadLetters {
+		if err := serializer.Map(r.Context(), deadLetter, &out.DeadLetters[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// RetryAuditSinkDeadLetter godoc
+//
+//	@Tags			AuditSinks
+//	@Summary		Retry dead letter of audit sink
+//	@Description	Forward the dead letter again. The dead letter is deleted if it is forwarded.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			auditSinkId		path	string	true	"auditSinkId"
+//	@Param			deadLetterId	path	string	true	"deadLetterId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/audit-sinks/{auditSinkId}/dead-letters/{deadLetterId}/retry [post]
+//	@Security		JWT
+func (h *AuditSinkHandler) RetryAuditSinkDeadLetter(w http.ResponseWriter, r *http.Request) {
+	organizationId, auditSinkId, err := auditSinkVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	deadLetterId, err := uuid.Parse(mux.Vars(r)["deadLetterId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid deadLetterId"), "AU_INVALID_DEAD_LETTER_ID", ""))
+		return
+	}
+
+	if err = h.usecase.RetryDeadLetter(r.Context(), organizationId, auditSinkId, deadLetterId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteAuditSinkDeadLetter godoc
+//
+//	@Tags			AuditSinks
+//	@Summary		Delete dead letter of audit sink
+//	@Description	Delete dead letter of audit sink
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			auditSinkId		path	string	true	"auditSinkId"
+//	@Param			deadLetterId	path	string	true	"deadLetterId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/audit-sinks/{auditSinkId}/dead-letters/{deadLetterId} [delete]
+//	@Security		JWT
+func (h *AuditSinkHandler) DeleteAuditSinkDeadLetter(w http.ResponseWriter, r *http.Request) {
+	organizationId, auditSinkId, err := auditSinkVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	deadLetterId, err := uuid.Parse(mux.Vars(r)["deadLetterId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid deadLetterId"), "AU_INVALID_DEAD_LETTER_ID", ""))
+		return
+	}
+
+	if err = h.usecase.DeleteDeadLetter(r.Context(), organizationId, auditSinkId, deadLetterId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func auditSinkVars(r *http.Request) (organizationId string, auditSinkId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	auditSinkId, err = uuid.Parse(vars["auditSinkId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid auditSinkId"), "AU_INVALID_AUDIT_SINK_ID", "")
+	}
+	return organizationId, auditSinkId, nil
+}
+
+func auditSinkResponse(r *http.Request, auditSink model.AuditSink) (out domain.AuditSinkResponse) {
+	if err := serializer.Map(r.Context(), auditSink, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+	out.SecretConfigured = auditSink.Secret != ""
+	return out
+}
@@ -1,11 +1,16 @@
 package http
 
 import (
+	"bytes"
+	"encoding/csv"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
 	"github.com/openinfradev/tks-api/internal/serializer"
 	"github.com/openinfradev/tks-api/internal/usecase"
@@ -53,6 +58,7 @@ func (h *AuditHandler) CreateAudit(w http.ResponseWriter, r *http.Request) {
 //	@Param			sortOrder	query		string		false	"sortOrder"
 //	@Param			filter		query		[]string	false	"filters"
 //	@Param			or			query		[]string	false	"filters"
+//	@Param			cursor		query		string		false	"cursor (nextCursor of the previous page, empty for the first page)"
 //	@Success		200			{object}	domain.GetAuditsResponse
 //	@Router			/admin/audits [get]
 //	@Security		JWT
@@ -135,3 +141,396 @@ func (h *AuditHandler) GetAudit(w http.ResponseWriter, r *http.Request) {
 func (h *AuditHandler) DeleteAudit(w http.ResponseWriter, r *http.Request) {
 	ErrorJSON(w, r, fmt.Errorf("need implementation"))
 }
+
+// Admin_GetAuditStatistics godoc
+//
+//	@Tags			Audits
+//	@Summary		Get audit statistics
+//	@Description	Get the number of audits and archived audits for maintenance
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	domain.GetAuditStatisticsResponse
+//	@Router			/admin/audits/statistics [get]
+//	@Security		JWT
+func (h *AuditHandler) Admin_GetAuditStatistics(w http.ResponseWriter, r *http.Request) {
+	statistics, err := h.usecase.GetStatistics(r.Context())
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAuditStatisticsResponse
+	if err := serializer.Map(r.Context(), statistics, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// Admin_ArchiveAudits godoc
+//
+//	@Tags			Audits
+//	@Summary		Archive audits
+//	@Description	Move audits older than retention days to the archive table
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		domain.ArchiveAuditsRequest	true	"archive audits request"
+//	@Success		200		{object}	domain.ArchiveAuditsResponse
+//	@Router			/admin/audits/archive [post]
+//	@Security		JWT
+func (h *AuditHandler) Admin_ArchiveAudits(w http.ResponseWriter, r *http.Request) {
+	input := domain.ArchiveAuditsRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	archived, err := h.usecase.Archive(r.Context(), input.RetentionDays)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.ArchiveAuditsResponse
+	out.ArchivedCount = archived
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// Admin_GetAuditRetentionPolicies godoc
+//
+//	@Tags			Audits
+//	@Summary		Get audit retention policies
+//	@Description	Get audit retention policies of all organizations. Organizations without a policy are returned with the default policy.
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	domain.GetAuditRetentionPoliciesResponse
+//	@Router			/admin/audits/retention-policies [get]
+//	@Security		JWT
+func (h *AuditHandler) Admin_GetAuditRetentionPolicies(w http.ResponseWriter, r *http.Request) {
+	policies, err := h.usecase.GetRetentionPolicies(r.Context())
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAuditRetentionPoliciesResponse
+	out.Policies = make([]domain.AuditRetentionPolicyResponse, len(policies))
+	for i, policy := range policies {
+		if err := serializer.Map(r.Context(), policy, &out.Policies[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// Admin_UpdateAuditRetentionPolicy godoc
+//
+//	@Tags			Audits
+//	@Summary		Update audit retention policy
+//	@Description	Update audit retention policy of the organization. Audits older than retention days are archived to the object storage or purged.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string										true	"organizationId"
+//	@Param			body			body	domain.UpdateAuditRetentionPolicyRequest	true	"update audit retention policy request"
+//	@Success		200
+//	@Router			/admin/audits/retention-policies/{organizationId} [put]
+//	@Security		JWT
+func (h *AuditHandler) Admin_UpdateAuditRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdateAuditRetentionPolicyRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.AuditRetentionPolicy{
+		OrganizationId: organizationId,
+		RetentionDays:  input.RetentionDays,
+		Action:         input.Action,
+	}
+	if err := h.usecase.UpdateRetentionPolicy(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// Admin_CreateAuditArchivalRun godoc
+//
+//	@Tags			Audits
+//	@Summary		Create audit archival run
+//	@Description	Apply the audit retention policy now. The runs are processed in the background; check the status with the archival run API.
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		domain.CreateAuditArchivalRunRequest	true	"create audit archival run request"
+//	@Success		200		{object}	domain.CreateAuditArchivalRunResponse
+//	@Router			/admin/audits/archival-runs [post]
+//	@Security		JWT
+func (h *AuditHandler) Admin_CreateAuditArchivalRun(w http.ResponseWriter, r *http.Request) {
+	input := domain.CreateAuditArchivalRunRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	runs, err := h.usecase.StartArchivalRun(r.Context(), input.OrganizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.CreateAuditArchivalRunResponse
+	out.Runs = make([]domain.AuditArchivalRunResponse, len(runs))
+	for i, run := range runs {
+		if err := serializer.Map(r.Context(), run, &out.Runs[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// Admin_GetAuditArchivalRuns godoc
+//
+//	@Tags			Audits
+//	@Summary		Get audit archival runs
+//	@Description	Get audit archival runs. The most recent runs are returned first by default.
+//	@Accept			json
+//	@Produce		json
+//	@Param			pageSize	query		string		false	"pageSize"
+//	@Param			pageNumber	query		string		false	"pageNumber"
+//	@Param			soertColumn	query		string		false	"sortColumn"
+//	@Param			sortOrder	query		string		false	"sortOrder"
+//	@Param			filters		query		[]string	false	"filters"
+//	@Success		200			{object}	domain.GetAuditArchivalRunsResponse
+//	@Router			/admin/audits/archival-runs [get]
+//	@Security		JWT
+func (h *AuditHandler) Admin_GetAuditArchivalRuns(w http.ResponseWriter, r *http.Request) {
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	runs, err := h.usecase.GetArchivalRuns(r.Context(), pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAuditArchivalRunsResponse
+	out.Runs = make([]domain.AuditArchivalRunResponse, len(runs))
+	for i, run := range runs {
+		if err := serializer.Map(r.Context(), run, &out.Runs[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// Admin_GetAuditArchivalRun godoc
+//
+//	@Tags			Audits
+//	@Summary		Get audit archival run
+//	@Description	Get audit archival run
+//	@Accept			json
+//	@Produce		json
+//	@Param			runId	path		string	true	"runId"
+//	@Success		200		{object}	domain.GetAuditArchivalRunResponse
+//	@Router			/admin/audits/archival-runs/{runId} [get]
+//	@Security		JWT
+func (h *AuditHandler) Admin_GetAuditArchivalRun(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	runId, err := uuid.Parse(vars["runId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid runId"), "AU_INVALID_ARCHIVAL_RUN_ID", ""))
+		return
+	}
+
+	run, err := h.usecase.GetArchivalRun(r.Context(), runId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetAuditArchivalRunResponse
+	if err := serializer.Map(r.Context(), run, &out.Run); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetOrganizationAudits godoc
+//
+//	@Tags			Audits
+//	@Summary		Get audits of the organization
+//	@Description	Get audits of the organization. Set format=csv to download the matched audits as CSV. description is returned only to roles allowed to get an audit.
+//	@Accept			json
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			accountId		query		string		false	"account id of the user"
+//	@Param			group			query		string		false	"endpoint group"
+//	@Param			from			query		string		false	"start time (RFC3339, inclusive)"
+//	@Param			to				query		string		false	"end time (RFC3339, exclusive)"
+//	@Param			status			query		string		false	"success or failure"
+//	@Param			message			query		string		false	"text contained in the message"
+//	@Param			format			query		string		false	"json (default) or csv"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			cursor			query		string		false	"cursor (nextCursor of the previous page, empty for the first page)"
+//	@Success		200				{object}	domain.GetAuditsResponse
+//	@Router			/organizations/{organizationId}/audits [get]
+//	@Security		JWT
+func (h *AuditHandler) GetOrganizationAudits(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	filter := model.AuditFilter{
+		UserAccountId: urlParams.Get("accountId"),
+		Group:         urlParams.Get("group"),
+		Status:        urlParams.Get("status"),
+		Message:       urlParams.Get("message"),
+	}
+	for key, target := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		if v := urlParams.Get(key); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid %s", key), "C_INVALID_QUERY_PARAM", ""))
+				return
+			}
+			*target = &t
+		}
+	}
+
+	pg := pagination.NewPagination(&urlParams)
+	audits, err := h.usecase.FetchByOrganization(r.Context(), organizationId, filter, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if urlParams.Get("format") == "csv" {
+		writeAuditsCsv(w, r, organizationId, audits)
+		return
+	}
+
+	var out domain.GetAuditsResponse
+	out.Audits = make([]domain.AuditResponse, len(audits))
+	for i, audit := range audits {
+		if err := serializer.Map(r.Context(), audit, &out.Audits[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetOrganizationTimeline godoc
+//
+//	@Tags			Audits
+//	@Summary		Get timeline of the organization
+//	@Description	Get significant changes of the organization (stacks created or deleted, members and roles changed, policies deployed, organization settings changed) newest first. Repeated changes by the same user are condensed into one event. Without from and to, the current month is used.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			month			query		string	false	"month (YYYY-MM)"
+//	@Param			from			query		string	false	"start time (RFC3339, inclusive)"
+//	@Param			to				query		string	false	"end time (RFC3339, exclusive)"
+//	@Success		200				{object}	domain.GetOrganizationTimelineResponse
+//	@Router			/organizations/{organizationId}/timeline [get]
+//	@Security		JWT
+func (h *AuditHandler) GetOrganizationTimeline(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	to := now
+	if v := urlParams.Get("month"); v != "" {
+		month, err := time.ParseInLocation("2006-01", v, now.Location())
+		if err != nil {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid month"), "C_INVALID_QUERY_PARAM", ""))
+			return
+		}
+		from, to = month, month.AddDate(0, 1, 0)
+	}
+	for key, target := range map[string]*time.Time{"from": &from, "to": &to} {
+		if v := urlParams.Get(key); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid %s", key), "C_INVALID_QUERY_PARAM", ""))
+				return
+			}
+			*target = t
+		}
+	}
+	if !to.After(from) {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("to must be after from"), "C_INVALID_QUERY_PARAM", ""))
+		return
+	}
+
+	out, err := h.usecase.GetTimeline(r.Context(), organizationId, from, to)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func writeAuditsCsv(w http.ResponseWriter, r *http.Request, organizationId string, audits []model.Audit) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	records := [][]string{{"createdAt", "group", "message", "description", "statusCode", "userAccountId", "userName", "userRoles", "clientIP"}}
+	for _, audit := range audits {
+		records = append(records, []string{
+			audit.CreatedAt.Format(time.RFC3339),
+			audit.Group,
+			audit.Message,
+			audit.Description,
+			strconv.Itoa(audit.StatusCode),
+			audit.UserAccountId,
+			audit.UserName,
+			audit.UserRoles,
+			audit.ClientIP,
+		})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=audits-%s.csv", organizationId))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.Error(r.Context(), err)
+	}
+}
@@ -31,6 +31,7 @@ type AuthHandler struct {
 	usecase        usecase.IAuthUsecase
 	auditUsecase   usecase.IAuditUsecase
 	projectUsecase usecase.IProjectUsecase
+	sessionUsecase usecase.IUserSessionUsecase
 }
 
 func NewAuthHandler(h usecase.Usecase) IAuthHandler {
@@ -38,6 +39,7 @@ func NewAuthHandler(h usecase.Usecase) IAuthHandler {
 		usecase:        h.Auth,
 		auditUsecase:   h.Audit,
 		projectUsecase: h.Project,
+		sessionUsecase: h.UserSession,
 	}
 }
 
@@ -59,7 +61,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := h.usecase.Login(r.Context(), input.AccountId, input.Password, input.OrganizationId)
+	user, err := h.usecase.Login(r.Context(), input.AccountId, input.Password, input.OrganizationId, input.Otp)
 	if err != nil {
 		errorResponse, _ := httpErrors.ErrorResponse(err)
 		_, _ = h.auditUsecase.Create(r.Context(), model.Audit{
@@ -69,6 +71,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 			Description:    errorResponse.Text(),
 			ClientIP:       audit.GetClientIpAddress(w, r),
 			UserId:         nil,
+			StatusCode:     errorResponse.Status(),
 		})
 		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
 		ErrorJSON(w, r, err)
@@ -81,7 +84,17 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 			Description:    "",
 			ClientIP:       audit.GetClientIpAddress(w, r),
 			UserId:         &user.ID,
+			StatusCode:     http.StatusOK,
 		})
+		if _, err := h.sessionUsecase.Create(r.Context(), model.UserSession{
+			OrganizationId: input.OrganizationId,
+			UserId:         user.ID,
+			SessionId:      user.SessionId,
+			ClientIP:       audit.GetClientIpAddress(w, r),
+			UserAgent:      r.UserAgent(),
+		}); err != nil {
+			log.Error(r.Context(), err)
+		}
 	}
 
 	var cookies []*http.Cookie
@@ -166,8 +179,33 @@ func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	ResponseJSON(w, r, http.StatusOK, nil)
 }
 
+// RefreshToken godoc
+//
+//	@Tags			Auth
+//	@Summary		refresh token
+//	@Description	Exchange the refresh token for a new access token and a new refresh token. The used refresh token is revoked.
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		domain.RefreshTokenRequest	true	"refresh token"
+//	@Success		200		{object}	domain.RefreshTokenResponse
+//	@Router			/auth/refresh [post]
 func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
-	//TODO implement me
+	input := domain.RefreshTokenRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	token, err := h.usecase.RefreshToken(r.Context(), input.RefreshToken, input.OrganizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.RefreshTokenResponse{
+		Token:        token.Token,
+		RefreshToken: token.RefreshToken,
+	})
 }
 
 // FindId godoc
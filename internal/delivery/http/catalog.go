@@ -0,0 +1,357 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type CatalogHandler struct {
+	usecase usecase.ICatalogUsecase
+}
+
+func NewCatalogHandler(h usecase.Usecase) *CatalogHandler {
+	return &CatalogHandler{
+		usecase: h.Catalog,
+	}
+}
+
+// Admin_GetHelmRepositories godoc
+//
+//	@Tags			Catalog
+//	@Summary		Get helm repositories
+//	@Description	Get helm repositories registered to the catalog with the result of the last sync
+//	@Accept			json
+//	@Produce		json
+//	@Param			pageSize	query		string		false	"pageSize"
+//	@Param			pageNumber	query		string		false	"pageNumber"
+//	@Param			soertColumn	query		string		false	"sortColumn"
+//	@Param			sortOrder	query		string		false	"sortOrder"
+//	@Param			filters		query		[]string	false	"filters"
+//	@Success		200			{object}	domain.GetHelmRepositoriesResponse
+//	@Router			/admin/helm-repositories [get]
+//	@Security		JWT
+func (h *CatalogHandler) Admin_GetHelmRepositories(w http.ResponseWriter, r *http.Request) {
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	helmRepositories, err := h.usecase.FetchHelmRepositories(r.Context(), pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetHelmRepositoriesResponse
+	out.HelmRepositories = make([]domain.HelmRepositoryResponse, len(helmRepositories))
+	for i, helmRepository := range helmRepositories {
+		if err := serializer.Map(r.Context(), helmRepository, &out.HelmRepositories[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// Admin_CreateHelmRepository godoc
+//
+//	@Tags			Catalog
+//	@Summary		Create helm repository
+//	@Description	Register a public helm repository to the catalog and index its charts from index.yaml. The repository is kept even if the first sync fails
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		domain.CreateHelmRepositoryRequest	true	"create helm repository request"
+//	@Success		200		{object}	domain.CreateHelmRepositoryResponse
+//	@Router			/admin/helm-repositories [post]
+//	@Security		JWT
+func (h *CatalogHandler) Admin_CreateHelmRepository(w http.ResponseWriter, r *http.Request) {
+	input := domain.CreateHelmRepositoryRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.HelmRepository
+	if err := serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	helmRepositoryId, err := h.usecase.CreateHelmRepository(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateHelmRepositoryResponse{ID: helmRepositoryId.String()})
+}
+
+// Admin_DeleteHelmRepository godoc
+//
+//	@Tags			Catalog
+//	@Summary		Delete helm repository
+//	@Description	Delete helm repository and its charts from the catalog. Apps already deployed are not affected
+//	@Accept			json
+//	@Produce		json
+//	@Param			helmRepositoryId	path	string	true	"helmRepositoryId"
+//	@Success		200
+//	@Router			/admin/helm-repositories/{helmRepositoryId} [delete]
+//	@Security		JWT
+func (h *CatalogHandler) Admin_DeleteHelmRepository(w http.ResponseWriter, r *http.Request) {
+	helmRepositoryId, err := helmRepositoryVar(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.DeleteHelmRepository(r.Context(), helmRepositoryId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// Admin_SyncHelmRepository godoc
+//
+//	@Tags			Catalog
+//	@Summary		Sync helm repository
+//	@Description	Read index.yaml of the helm repository now and update charts of the catalog
+//	@Accept			json
+//	@Produce		json
+//	@Param			helmRepositoryId	path		string	true	"helmRepositoryId"
+//	@Success		200					{object}	domain.SyncHelmRepositoryResponse
+//	@Router			/admin/helm-repositories/{helmRepositoryId}/sync [post]
+//	@Security		JWT
+func (h *CatalogHandler) Admin_SyncHelmRepository(w http.ResponseWriter, r *http.Request) {
+	helmRepositoryId, err := helmRepositoryVar(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	helmRepository, err := h.usecase.SyncHelmRepository(r.Context(), helmRepositoryId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.SyncHelmRepositoryResponse
+	if err := serializer.Map(r.Context(), helmRepository, &out.HelmRepository); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetCatalogCharts godoc
+//
+//	@Tags			Catalog
+//	@Summary		Get catalog charts
+//	@Description	Get chart versions of all helm repositories in the catalog
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetCatalogChartsResponse
+//	@Router			/organizations/{organizationId}/catalog/charts [get]
+//	@Security		JWT
+func (h *CatalogHandler) GetCatalogCharts(w http.ResponseWriter, r *http.Request) {
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	charts, err := h.usecase.FetchCharts(r.Context(), pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetCatalogChartsResponse
+	out.Charts = make([]domain.CatalogChartResponse, len(charts))
+	for i, chart := range charts {
+		out.Charts[i] = catalogChartResponse(r, chart)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetCatalogChart godoc
+//
+//	@Tags			Catalog
+//	@Summary		Get catalog chart
+//	@Description	Get catalog chart with its values schema and other versions of the chart
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			catalogChartId	path		string	true	"catalogChartId"
+//	@Success		200				{object}	domain.GetCatalogChartResponse
+//	@Router			/organizations/{organizationId}/catalog/charts/{catalogChartId} [get]
+//	@Security		JWT
+func (h *CatalogHandler) GetCatalogChart(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	catalogChartId, err := uuid.Parse(vars["catalogChartId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid catalogChartId"), "CT_INVALID_CATALOG_CHART_ID", ""))
+		return
+	}
+
+	chart, versions, err := h.usecase.GetChart(r.Context(), catalogChartId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.GetCatalogChartResponse{
+		Chart:    catalogChartResponse(r, chart),
+		Versions: versions,
+	}
+	if len(chart.ValuesSchema) > 0 {
+		if err := json.Unmarshal(chart.ValuesSchema, &out.ValuesSchema); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// CreateCatalogApp godoc
+//
+//	@Tags			Catalog
+//	@Summary		Deploy catalog app
+//	@Description	Deploy the catalog chart to the stack. Values are validated with values.schema.json of the chart before the deploy workflow is submitted
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string							true	"organizationId"
+//	@Param			stackId			path		string							true	"stackId"
+//	@Param			body			body		domain.CreateCatalogAppRequest	true	"create catalog app request"
+//	@Success		200				{object}	domain.CreateCatalogAppResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/catalog-apps [post]
+//	@Security		JWT
+func (h *CatalogHandler) CreateCatalogApp(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := catalogAppVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.CreateCatalogAppRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	catalogChartId, err := uuid.Parse(input.CatalogChartId)
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid catalogChartId"), "CT_INVALID_CATALOG_CHART_ID", ""))
+		return
+	}
+
+	dto := model.CatalogApp{
+		OrganizationId: organizationId,
+		ClusterId:      domain.ClusterId(stackId),
+		ReleaseName:    input.ReleaseName,
+		Namespace:      input.Namespace,
+	}
+	app, err := h.usecase.CreateApp(r.Context(), dto, catalogChartId, input.Values)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateCatalogAppResponse{ID: app.ID.String(), OperationId: app.OperationId.String()})
+}
+
+// GetCatalogApps godoc
+//
+//	@Tags			Catalog
+//	@Summary		Get catalog apps
+//	@Description	Get catalog apps deployed to the stack with the status of their deploy operations
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			stackId			path		string		true	"stackId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetCatalogAppsResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/catalog-apps [get]
+//	@Security		JWT
+func (h *CatalogHandler) GetCatalogApps(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := catalogAppVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	apps, err := h.usecase.FetchApps(r.Context(), organizationId, stackId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetCatalogAppsResponse
+	out.CatalogApps = make([]domain.CatalogAppResponse, len(apps))
+	for i, app := range apps {
+		if err := serializer.Map(r.Context(), app, &out.CatalogApps[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+		out.CatalogApps[i].StackId = app.ClusterId.String()
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func helmRepositoryVar(r *http.Request) (uuid.UUID, error) {
+	vars := mux.Vars(r)
+	helmRepositoryId, err := uuid.Parse(vars["helmRepositoryId"])
+	if err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid helmRepositoryId"), "CT_INVALID_HELM_REPOSITORY_ID", "")
+	}
+	return helmRepositoryId, nil
+}
+
+func catalogAppVars(r *http.Request) (organizationId string, stackId domain.StackId, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", "", httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	strId, ok := vars["stackId"]
+	if !ok {
+		return "", "", httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "C_INVALID_STACK_ID", "")
+	}
+	return organizationId, domain.StackId(strId), nil
+}
+
+func catalogChartResponse(r *http.Request, chart model.CatalogChart) (out domain.CatalogChartResponse) {
+	if err := serializer.Map(r.Context(), chart, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+	out.HelmRepositoryName = chart.HelmRepository.Name
+	return out
+}
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// GetCloudAccountPricing godoc
+//
+//	@Tags			CloudAccounts
+//	@Summary		Get pricing of CloudAccount
+//	@Description	Get hourly unit prices of cpu core and memory used to estimate costs of stacks. An empty currency means the pricing is not registered.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			cloudAccountId	path		string	true	"cloudAccountId"
+//	@Success		200				{object}	domain.GetCloudAccountPricingResponse
+//	@Router			/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/pricing [get]
+//	@Security		JWT
+func (h *CloudAccountHandler) GetCloudAccountPricing(w http.ResponseWriter, r *http.Request) {
+	organizationId, cloudAccountId, err := cloudAccountPathVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	pricing, err := h.usecase.GetPricing(r.Context(), organizationId, cloudAccountId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetCloudAccountPricingResponse
+	if err := serializer.Map(r.Context(), pricing, &out.Pricing); err != nil {
+		log.Info(r.Context(), err)
+	}
+	out.Pricing.CloudAccountId = cloudAccountId.String()
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateCloudAccountPricing godoc
+//
+//	@Tags			CloudAccounts
+//	@Summary		Update pricing of CloudAccount
+//	@Description	Update hourly unit prices of cpu core and memory(GiB) of the cloud account
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string									true	"organizationId"
+//	@Param			cloudAccountId	path		string									true	"cloudAccountId"
+//	@Param			body			body		domain.UpdateCloudAccountPricingRequest	true	"Update pricing request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/pricing [put]
+//	@Security		JWT
+func (h *CloudAccountHandler) UpdateCloudAccountPricing(w http.ResponseWriter, r *http.Request) {
+	organizationId, cloudAccountId, err := cloudAccountPathVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateCloudAccountPricingRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.CloudAccountPricing
+	if err := serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+	dto.CloudAccountId = cloudAccountId
+
+	if err := h.usecase.UpdatePricing(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteCloudAccountPricing godoc
+//
+//	@Tags			CloudAccounts
+//	@Summary		Delete pricing of CloudAccount
+//	@Description	Delete pricing of the cloud account. Stacks of the cloud account are excluded from estimated costs.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			cloudAccountId	path		string	true	"cloudAccountId"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/pricing [delete]
+//	@Security		JWT
+func (h *CloudAccountHandler) DeleteCloudAccountPricing(w http.ResponseWriter, r *http.Request) {
+	organizationId, cloudAccountId, err := cloudAccountPathVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.DeletePricing(r.Context(), organizationId, cloudAccountId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func cloudAccountPathVars(r *http.Request) (organizationId string, cloudAccountId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	strId, ok := vars["cloudAccountId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid cloudAccountId"), "C_INVALID_CLOUD_ACCOUNT_ID", "")
+	}
+	cloudAccountId, err = uuid.Parse(strId)
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(errors.Wrap(err, "Failed to parse uuid"), "C_INVALID_CLOUD_ACCOUNT_ID", "")
+	}
+	return organizationId, cloudAccountId, nil
+}
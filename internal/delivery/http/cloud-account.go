@@ -32,7 +32,7 @@ func NewCloudAccountHandler(h usecase.Usecase) *CloudAccountHandler {
 //
 //	@Tags			CloudAccounts
 //	@Summary		Create CloudAccount
-//	@Description	Create CloudAccount
+//	@Description	Create CloudAccount. cloudService is one of AWS, AZURE, GCP and OPENSTACK
 //	@Accept			json
 //	@Produce		json
 //	@Param			organizationId	path		string								true	"organizationId"
@@ -439,3 +439,43 @@ func (h *CloudAccountHandler) GetResourceQuota(w http.ResponseWriter, r *http.Re
 
 	ResponseJSON(w, r, http.StatusOK, out)
 }
+
+// VerifyCloudAccount godoc
+//
+//	@Tags			CloudAccounts
+//	@Summary		Verify credential of cloudAccount
+//	@Description	Verify credential of cloudAccount with STS GetCallerIdentity and permission checks required to create stacks, and record the result as credentialStatus
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			cloudAccountId	path		string	true	"cloudAccountId"
+//	@Success		200				{object}	domain.VerifyCloudAccountResponse
+//	@Router			/organizations/{organizationId}/cloud-accounts/{cloudAccountId}:verify [post]
+//	@Security		JWT
+func (h *CloudAccountHandler) VerifyCloudAccount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	strId, ok := vars["cloudAccountId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid cloudAccountId"), "C_INVALID_CLOUD_ACCOUNT_ID", ""))
+		return
+	}
+
+	cloudAccountId, err := uuid.Parse(strId)
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(errors.Wrap(err, "Failed to parse uuid %s"), "C_INVALID_CLOUD_ACCOUNT_ID", ""))
+		return
+	}
+
+	out, err := h.usecase.Verify(r.Context(), organizationId, cloudAccountId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
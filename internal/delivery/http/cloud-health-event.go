@@ -0,0 +1,102 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type CloudHealthEventHandler struct {
+	usecase usecase.ICloudHealthEventUsecase
+}
+
+func NewCloudHealthEventHandler(h usecase.Usecase) *CloudHealthEventHandler {
+	return &CloudHealthEventHandler{
+		usecase: h.CloudHealthEvent,
+	}
+}
+
+// CreateCloudHealthEvent godoc
+//
+//	@Tags			CloudHealthEvents
+//	@Summary		Create cloud health event
+//	@Description	Receive an AWS Health event forwarded by EventBridge. The event is correlated to the stacks of the cloud account and raised as system notifications.
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		domain.CreateCloudHealthEventRequest	true	"AWS Health event"
+//	@Success		200		{object}	nil
+//	@Router			/system-api/cloud-health-events [post]
+//	@Security		JWT
+func (h *CloudHealthEventHandler) CreateCloudHealthEvent(w http.ResponseWriter, r *http.Request) {
+	// 외부로부터(EventBridge) 오는 데이터이므로, dto 변환없이 by-pass 처리한다.
+	input := domain.CreateCloudHealthEventRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	err = h.usecase.Ingest(r.Context(), input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// GetCloudHealthEvents godoc
+//
+//	@Tags			CloudHealthEvents
+//	@Summary		Get cloud health events
+//	@Description	Get cloud provider health events correlated to the stacks of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Param			cursor			query		string		false	"cursor (nextCursor of the previous page, empty for the first page)"
+//	@Success		200				{object}	domain.GetCloudHealthEventsResponse
+//	@Router			/organizations/{organizationId}/cloud-health-events [get]
+//	@Security		JWT
+func (h *CloudHealthEventHandler) GetCloudHealthEvents(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	events, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetCloudHealthEventsResponse
+	out.CloudHealthEvents = make([]domain.CloudHealthEventResponse, len(events))
+	for i, event := range events {
+		if err := serializer.Map(r.Context(), event, &out.CloudHealthEvents[i]); err != nil {
+			log.Info(r.Context(), err)
+			continue
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
@@ -0,0 +1,303 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type ClusterAccessHandler struct {
+	usecase usecase.IClusterAccessUsecase
+}
+
+func NewClusterAccessHandler(h usecase.Usecase) *ClusterAccessHandler {
+	return &ClusterAccessHandler{
+		usecase: h.ClusterAccess,
+	}
+}
+
+// CreateClusterAccessRequest godoc
+//
+//	@Tags			ClusterAccessRequests
+//	@Summary		Create cluster access request
+//	@Description	Request temporary elevated access(exec, kubeconfig) to the cluster of the stack
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string										true	"organizationId"
+//	@Param			stackId			path		string										true	"stackId"
+//	@Param			body			body		domain.CreateClusterAccessRequestRequest	true	"create cluster access request"
+//	@Success		200				{object}	domain.CreateClusterAccessRequestResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/access-requests [post]
+//	@Security		JWT
+func (h *ClusterAccessHandler) CreateClusterAccessRequest(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	stackId, ok := vars["stackId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID", ""))
+		return
+	}
+
+	input := domain.CreateClusterAccessRequestRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.ClusterAccessRequest
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+	dto.ClusterId = domain.ClusterId(stackId)
+
+	accessRequestId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.CreateClusterAccessRequestResponse
+	out.ID = accessRequestId.String()
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetClusterAccessRequests godoc
+//
+//	@Tags			ClusterAccessRequests
+//	@Summary		Get cluster access requests
+//	@Description	Get cluster access requests of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetClusterAccessRequestsResponse
+//	@Router			/organizations/{organizationId}/access-requests [get]
+//	@Security		JWT
+func (h *ClusterAccessHandler) GetClusterAccessRequests(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	for i, filter := range pg.GetFilters() {
+		if filter.Column == "status" {
+			for j, value := range filter.Values {
+				var s domain.ClusterAccessRequestStatus
+				pg.GetFilters()[i].Values[j] = strconv.Itoa(int(s.FromString(value)))
+			}
+		}
+		if filter.Column == "access_type" {
+			for j, value := range filter.Values {
+				var s domain.ClusterAccessType
+				pg.GetFilters()[i].Values[j] = strconv.Itoa(int(s.FromString(value)))
+			}
+		}
+	}
+
+	accessRequests, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetClusterAccessRequestsResponse
+	out.AccessRequests = make([]domain.ClusterAccessRequestResponse, len(accessRequests))
+	for i, accessRequest := range accessRequests {
+		if err := serializer.Map(r.Context(), accessRequest, &out.AccessRequests[i]); err != nil {
+			log.Info(r.Context(), err)
+			continue
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetClusterAccessRequest godoc
+//
+//	@Tags			ClusterAccessRequests
+//	@Summary		Get cluster access request
+//	@Description	Get cluster access request
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			accessRequestId	path		string	true	"accessRequestId"
+//	@Success		200				{object}	domain.GetClusterAccessRequestResponse
+//	@Router			/organizations/{organizationId}/access-requests/{accessRequestId} [get]
+//	@Security		JWT
+func (h *ClusterAccessHandler) GetClusterAccessRequest(w http.ResponseWriter, r *http.Request) {
+	organizationId, accessRequestId, err := accessRequestVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	accessRequest, err := h.usecase.Get(r.Context(), organizationId, accessRequestId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	h.responseAccessRequest(w, r, accessRequest)
+}
+
+// ApproveClusterAccessRequest godoc
+//
+//	@Tags			ClusterAccessRequests
+//	@Summary		Approve cluster access request
+//	@Description	Approve cluster access request with ttl. The grant is revoked automatically when it expires
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string										true	"organizationId"
+//	@Param			accessRequestId	path		string										true	"accessRequestId"
+//	@Param			body			body		domain.ApproveClusterAccessRequestRequest	true	"approve cluster access request"
+//	@Success		200				{object}	domain.GetClusterAccessRequestResponse
+//	@Router			/organizations/{organizationId}/access-requests/{accessRequestId}/approve [post]
+//	@Security		JWT
+func (h *ClusterAccessHandler) ApproveClusterAccessRequest(w http.ResponseWriter, r *http.Request) {
+	organizationId, accessRequestId, err := accessRequestVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.ApproveClusterAccessRequestRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ttl, err := time.ParseDuration(input.Ttl)
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(err, "CA_INVALID_TTL", ""))
+		return
+	}
+
+	accessRequest, err := h.usecase.Approve(r.Context(), organizationId, accessRequestId, ttl)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	h.responseAccessRequest(w, r, accessRequest)
+}
+
+// RejectClusterAccessRequest godoc
+//
+//	@Tags			ClusterAccessRequests
+//	@Summary		Reject cluster access request
+//	@Description	Reject cluster access request
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string										true	"organizationId"
+//	@Param			accessRequestId	path		string										true	"accessRequestId"
+//	@Param			body			body		domain.RejectClusterAccessRequestRequest	false	"reject cluster access request"
+//	@Success		200				{object}	domain.GetClusterAccessRequestResponse
+//	@Router			/organizations/{organizationId}/access-requests/{accessRequestId}/reject [post]
+//	@Security		JWT
+func (h *ClusterAccessHandler) RejectClusterAccessRequest(w http.ResponseWriter, r *http.Request) {
+	organizationId, accessRequestId, err := accessRequestVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.RejectClusterAccessRequestRequest{}
+	if r.ContentLength != 0 {
+		if err = UnmarshalRequestInput(r, &input); err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+	}
+
+	accessRequest, err := h.usecase.Reject(r.Context(), organizationId, accessRequestId, input.Reason)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	h.responseAccessRequest(w, r, accessRequest)
+}
+
+// RevokeClusterAccessRequest godoc
+//
+//	@Tags			ClusterAccessRequests
+//	@Summary		Revoke cluster access
+//	@Description	Revoke approved cluster access before it expires
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			accessRequestId	path		string	true	"accessRequestId"
+//	@Success		200				{object}	domain.GetClusterAccessRequestResponse
+//	@Router			/organizations/{organizationId}/access-requests/{accessRequestId}/revoke [post]
+//	@Security		JWT
+func (h *ClusterAccessHandler) RevokeClusterAccessRequest(w http.ResponseWriter, r *http.Request) {
+	organizationId, accessRequestId, err := accessRequestVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	accessRequest, err := h.usecase.Revoke(r.Context(), organizationId, accessRequestId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	h.responseAccessRequest(w, r, accessRequest)
+}
+
+func (h *ClusterAccessHandler) responseAccessRequest(w http.ResponseWriter, r *http.Request, accessRequest model.ClusterAccessRequest) {
+	var out domain.GetClusterAccessRequestResponse
+	if err := serializer.Map(r.Context(), accessRequest, &out.AccessRequest); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func accessRequestVars(r *http.Request) (organizationId string, accessRequestId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	strId, ok := vars["accessRequestId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid accessRequestId"), "", "")
+	}
+	accessRequestId, err = uuid.Parse(strId)
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(err, "", "")
+	}
+	return organizationId, accessRequestId, nil
+}
@@ -0,0 +1,57 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+type ClusterHeartbeatHandler struct {
+	usecase usecase.IClusterHeartbeatUsecase
+}
+
+func NewClusterHeartbeatHandler(h usecase.Usecase) *ClusterHeartbeatHandler {
+	return &ClusterHeartbeatHandler{
+		usecase: h.ClusterHeartbeat,
+	}
+}
+
+// CreateClusterHeartbeat godoc
+//
+//	@Tags			Clusters
+//	@Summary		Create cluster heartbeat
+//	@Description	Receive a heartbeat posted by the agent on the user cluster. Clusters silent beyond the threshold are marked as UNREACHABLE.
+//	@Accept			json
+//	@Produce		json
+//	@Param			clusterId	path		string								true	"clusterId"
+//	@Param			body		body		domain.CreateClusterHeartbeatRequest	true	"heartbeat"
+//	@Success		200			{object}	nil
+//	@Router			/system-api/clusters/{clusterId}/heartbeat [post]
+//	@Security		JWT
+func (h *ClusterHeartbeatHandler) CreateClusterHeartbeat(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	clusterId, ok := vars["clusterId"]
+	if !ok || !domain.ClusterId(clusterId).Validate() {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid clusterId"), "C_INVALID_CLUSTER_ID", ""))
+		return
+	}
+
+	input := domain.CreateClusterHeartbeatRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	err = h.usecase.Heartbeat(r.Context(), domain.ClusterId(clusterId), input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// GetClusterKubeconfig godoc
+//
+//	@Tags			Clusters
+//	@Summary		Get short-lived kubeconfig of cluster
+//	@Description	사용자별 serviceAccount 의 만료 시간이 있는 token 으로 kubeconfig 를 발급한다. role 은 view(기본) 또는 admin 이며 admin 은 조직 관리자만 발급할 수 있다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			clusterId		path		string	true	"clusterId"
+//	@Param			role			query		string	false	"view or admin (default view)"
+//	@Param			ttl				query		string	false	"token lifetime such as 30m, 8h (default 1h, min 10m, max 24h)"
+//	@Success		200				{object}	domain.GetClusterKubeconfigResponse
+//	@Router			/organizations/{organizationId}/clusters/{clusterId}/kubeconfig [get]
+//	@Security		JWT
+func (h *ClusterHandler) GetClusterKubeconfig(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID"))
+		return
+	}
+	clusterId, ok := vars["clusterId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid clusterId"), "C_INVALID_CLUSTER_ID"))
+		return
+	}
+
+	query := r.URL.Query()
+	var ttl time.Duration
+	if v := query.Get("ttl"); v != "" {
+		var err error
+		if ttl, err = time.ParseDuration(v); err != nil {
+			ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid ttl"), "CL_INVALID_KUBECONFIG_REQUEST"))
+			return
+		}
+	}
+
+	out, err := h.usecase.GetShortLivedKubeconfig(r.Context(), organizationId, domain.ClusterId(clusterId), query.Get("role"), ttl)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	ResponseJSON(w, r, http.StatusOK, out)
+}
@@ -0,0 +1,236 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// GetClusterNamespaces godoc
+//
+//	@Tags			Clusters
+//	@Summary		Get namespaces of cluster
+//	@Description	클러스터의 namespace 목록을 조회한다. 다음 페이지는 응답의 continue 를 전달하여 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			clusterId		path		string	true	"clusterId"
+//	@Param			labelSelector	query		string	false	"label selector"
+//	@Param			limit			query		int		false	"page size (default 100, max 500)"
+//	@Param			continue		query		string	false	"continue token of the previous page"
+//	@Success		200				{object}	domain.GetClusterNamespacesResponse
+//	@Router			/organizations/{organizationId}/clusters/{clusterId}/namespaces [get]
+//	@Security		JWT
+func (h *ClusterHandler) GetClusterNamespaces(w http.ResponseWriter, r *http.Request) {
+	organizationId, clusterId, req, err := clusterResourcesRequest(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out, err := h.usecase.GetNamespaces(r.Context(), organizationId, clusterId, req)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetClusterWorkloads godoc
+//
+//	@Tags			Clusters
+//	@Summary		Get workloads of cluster
+//	@Description	클러스터의 Deployment, StatefulSet, DaemonSet 을 replica 상태와 함께 조회한다. namespace 를 지정하지 않으면 모든 namespace 를 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			clusterId		path		string	true	"clusterId"
+//	@Param			kind			query		string	true	"Deployment, StatefulSet or DaemonSet"
+//	@Param			namespace		query		string	false	"namespace"
+//	@Param			labelSelector	query		string	false	"label selector"
+//	@Param			limit			query		int		false	"page size (default 100, max 500)"
+//	@Param			continue		query		string	false	"continue token of the previous page"
+//	@Success		200				{object}	domain.GetClusterWorkloadsResponse
+//	@Router			/organizations/{organizationId}/clusters/{clusterId}/workloads [get]
+//	@Security		JWT
+func (h *ClusterHandler) GetClusterWorkloads(w http.ResponseWriter, r *http.Request) {
+	organizationId, clusterId, req, err := clusterResourcesRequest(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out, err := h.usecase.GetWorkloads(r.Context(), organizationId, clusterId, r.URL.Query().Get("kind"), req)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetClusterPods godoc
+//
+//	@Tags			Clusters
+//	@Summary		Get pods of cluster
+//	@Description	클러스터의 pod 를 상태, restart 횟수와 함께 조회한다. namespace 를 지정하지 않으면 모든 namespace 를 조회한다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			clusterId		path		string	true	"clusterId"
+//	@Param			namespace		query		string	false	"namespace"
+//	@Param			labelSelector	query		string	false	"label selector"
+//	@Param			limit			query		int		false	"page size (default 100, max 500)"
+//	@Param			continue		query		string	false	"continue token of the previous page"
+//	@Success		200				{object}	domain.GetClusterPodsResponse
+//	@Router			/organizations/{organizationId}/clusters/{clusterId}/pods [get]
+//	@Security		JWT
+func (h *ClusterHandler) GetClusterPods(w http.ResponseWriter, r *http.Request) {
+	organizationId, clusterId, req, err := clusterResourcesRequest(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out, err := h.usecase.GetPods(r.Context(), organizationId, clusterId, req)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func clusterResourcesRequest(r *http.Request) (organizationId string, clusterId domain.ClusterId, req domain.ListClusterResourcesRequest, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", "", req, httpErrors.NewError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
+	}
+	strId, ok := vars["clusterId"]
+	if !ok {
+		return "", "", req, httpErrors.NewError(fmt.Errorf("Invalid clusterId"), "C_INVALID_CLUSTER_ID")
+	}
+
+	query := r.URL.Query()
+	req = domain.ListClusterResourcesRequest{
+		Namespace:     query.Get("namespace"),
+		LabelSelector: query.Get("labelSelector"),
+		Continue:      query.Get("continue"),
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return "", "", req, httpErrors.NewError(fmt.Errorf("Invalid limit"), "CL_INVALID_CLUSTER_RESOURCE_QUERY")
+		}
+		req.Limit = limit
+	}
+	return organizationId, domain.ClusterId(strId), req, nil
+}
+
+// GetPodLogs godoc
+//
+//	@Tags			Clusters
+//	@Summary		Get logs of pod
+//	@Description	pod 의 container 로그를 text 로 반환한다. follow 이면 연결을 끊을 때까지 chunked 응답으로 로그를 이어서 보낸다. tailLines 와 since 를 모두 지정하지 않으면 마지막 1000 줄을 반환한다.
+//	@Accept			json
+//	@Produce		plain
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			clusterId		path		string	true	"clusterId"
+//	@Param			namespace		path		string	true	"namespace"
+//	@Param			podName			path		string	true	"podName"
+//	@Param			container		query		string	false	"container (default container of the pod)"
+//	@Param			tailLines		query		int		false	"number of lines from the end (max 10000)"
+//	@Param			since			query		string	false	"relative time such as 10m, 1h"
+//	@Param			follow			query		bool	false	"stream logs"
+//	@Param			previous		query		bool	false	"logs of the previous terminated container"
+//	@Param			timestamps		query		bool	false	"prefix each line with timestamp"
+//	@Success		200				{string}	string
+//	@Router			/organizations/{organizationId}/clusters/{clusterId}/namespaces/{namespace}/pods/{podName}/logs [get]
+//	@Security		JWT
+func (h *ClusterHandler) GetPodLogs(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID"))
+		return
+	}
+	clusterId, ok := vars["clusterId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid clusterId"), "C_INVALID_CLUSTER_ID"))
+		return
+	}
+	namespace := vars["namespace"]
+	podName := vars["podName"]
+
+	query := r.URL.Query()
+	req := domain.GetPodLogsRequest{Container: query.Get("container")}
+	if v := query.Get("tailLines"); v != "" {
+		tailLines, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid tailLines"), "CL_INVALID_CLUSTER_RESOURCE_QUERY"))
+			return
+		}
+		req.TailLines = &tailLines
+	}
+	if v := query.Get("since"); v != "" {
+		since, err := time.ParseDuration(v)
+		if err != nil {
+			ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid since"), "CL_INVALID_CLUSTER_RESOURCE_QUERY"))
+			return
+		}
+		sinceSeconds := int64(math.Ceil(since.Seconds()))
+		req.SinceSeconds = &sinceSeconds
+	}
+	for name, value := range map[string]*bool{"follow": &req.Follow, "previous": &req.Previous, "timestamps": &req.Timestamps} {
+		if v := query.Get(name); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid %s", name), "CL_INVALID_CLUSTER_RESOURCE_QUERY"))
+				return
+			}
+			*value = b
+		}
+	}
+
+	stream, err := h.usecase.GetPodLogs(r.Context(), organizationId, domain.ClusterId(clusterId), namespace, podName, req)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	defer stream.Close()
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	// follow 인 경우 로그가 도착하는 대로 client 에 보낸다. client 가 연결을 끊으면 request context 가 끝나 stream 도 닫힌다.
+	flusher, _ := w.(http.Flusher)
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := stream.Read(buf)
+		if n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				return
+			}
+			if flusher != nil && req.Follow {
+				flusher.Flush()
+			}
+		}
+		if err != nil {
+			if err != io.EOF && r.Context().Err() == nil {
+				log.Warnf(r.Context(), "failed to read logs of pod %s/%s. err : %s", namespace, podName, err)
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// Admin_WarmupCaches godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Warm up dashboard caches
+//	@Description	Pre-populate the thanos url, cluster name and dashboard chart caches. If organizationId is empty, all created organizations are warmed up.
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		domain.WarmupDashboardCachesRequest	false	"warm up dashboard caches request"
+//	@Success		200		{object}	domain.WarmupDashboardCachesResponse
+//	@Router			/admin/dashboards/caches/warmup [post]
+//	@Security		JWT
+func (h *DashboardHandler) Admin_WarmupCaches(w http.ResponseWriter, r *http.Request) {
+	input := domain.WarmupDashboardCachesRequest{}
+	if r.ContentLength != 0 {
+		if err := UnmarshalRequestInput(r, &input); err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+	}
+
+	warmups, err := h.usecase.WarmupCaches(r.Context(), input.OrganizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.WarmupDashboardCachesResponse
+	out.Organizations = make([]domain.DashboardCacheWarmupResponse, len(warmups))
+	for i, warmup := range warmups {
+		if err := serializer.Map(r.Context(), warmup, &out.Organizations[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+		out.Organizations[i].Elapsed = warmup.Elapsed.Milliseconds()
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
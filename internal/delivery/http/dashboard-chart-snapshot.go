@@ -0,0 +1,202 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// CreateChartSnapshot godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Create chart snapshot
+//	@Description	Store the result of a chart query and issue an expiring share token. The shared snapshot can be read without authentication.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.CreateChartSnapshotRequest	true	"create chart snapshot request"
+//	@Success		200				{object}	domain.CreateChartSnapshotResponse
+//	@Router			/organizations/{organizationId}/dashboards/chart-snapshots [post]
+//	@Security		JWT
+func (h *DashboardHandler) CreateChartSnapshot(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateChartSnapshotRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	chart := domain.DashboardChart{
+		CustomChartId: input.CustomChartId,
+		Duration:      input.Duration,
+		Interval:      input.Interval,
+		Aggregation:   input.Aggregation,
+		Timezone:      input.Timezone,
+		Year:          "2023", // default
+		Month:         "4",    // default
+	}
+	if chart.Duration == "" {
+		chart.Duration = "1d" // default
+	}
+	if chart.Interval == "" {
+		chart.Interval = "1d" // default
+	}
+	if chart.Aggregation == "" {
+		chart.Aggregation = domain.ChartAggregation_AVG // default
+	}
+	if chart.Timezone != "" {
+		if _, err := time.LoadLocation(chart.Timezone); err != nil {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid timezone"), "D_INVALID_TIMEZONE", ""))
+			return
+		}
+	}
+	if chart.CustomChartId == "" {
+		chart.ChartType = new(domain.ChartType).FromString(input.ChartType)
+		if chart.ChartType == domain.ChartType_ERROR || chart.ChartType.IsClusterOnly() {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid chartType"), "D_INVALID_CHART_TYPE", ""))
+			return
+		}
+	}
+
+	chartSnapshotId, shareToken, expiredAt, err := h.usecase.CreateChartSnapshot(r.Context(), organizationId, chart, input.Title, time.Duration(input.ExpiresIn)*time.Hour)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateChartSnapshotResponse{
+		ID:         chartSnapshotId.String(),
+		ShareToken: shareToken,
+		ExpiredAt:  expiredAt,
+	})
+}
+
+// GetChartSnapshots godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get chart snapshots
+//	@Description	Get chart snapshots of the organization. The share tokens are not returned.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetChartSnapshotsResponse
+//	@Router			/organizations/{organizationId}/dashboards/chart-snapshots [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetChartSnapshots(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	chartSnapshots, err := h.usecase.GetChartSnapshots(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetChartSnapshotsResponse
+	out.ChartSnapshots = make([]domain.ChartSnapshotResponse, len(chartSnapshots))
+	for i, chartSnapshot := range chartSnapshots {
+		if err := serializer.Map(r.Context(), chartSnapshot, &out.ChartSnapshots[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// DeleteChartSnapshot godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Delete chart snapshot
+//	@Description	Delete chart snapshot. The share link is revoked immediately.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			chartSnapshotId	path	string	true	"chartSnapshotId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/dashboards/chart-snapshots/{chartSnapshotId} [delete]
+//	@Security		JWT
+func (h *DashboardHandler) DeleteChartSnapshot(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	chartSnapshotId, err := uuid.Parse(vars["chartSnapshotId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid chartSnapshotId"), "D_INVALID_CHART_SNAPSHOT_ID", ""))
+		return
+	}
+
+	if err := h.usecase.DeleteChartSnapshot(r.Context(), organizationId, chartSnapshotId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// GetSharedChartSnapshot godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get shared chart snapshot
+//	@Description	Get the stored chart of the share token without authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			shareToken	path		string	true	"shareToken"
+//	@Success		200			{object}	domain.GetSharedChartSnapshotResponse
+//	@Router			/shared/chart-snapshots/{shareToken} [get]
+func (h *DashboardHandler) GetSharedChartSnapshot(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	shareToken, ok := vars["shareToken"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewNotFoundError(fmt.Errorf("Invalid shareToken"), "D_NOT_FOUND_CHART_SNAPSHOT", ""))
+		return
+	}
+
+	chartSnapshot, chart, err := h.usecase.GetSharedChartSnapshot(r.Context(), shareToken)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.GetSharedChartSnapshotResponse{
+		Title:     chartSnapshot.Title,
+		CreatedAt: chartSnapshot.CreatedAt,
+		ExpiredAt: chartSnapshot.ExpiredAt,
+	}
+	if err := serializer.Map(r.Context(), chart, &out.Chart); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
@@ -0,0 +1,288 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// CreateCustomChart godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Create custom chart
+//	@Description	Create a dashboard chart rendered from a PromQL query of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string							true	"organizationId"
+//	@Param			body			body		domain.CreateCustomChartRequest	true	"create custom chart request"
+//	@Success		200				{object}	domain.CreateCustomChartResponse
+//	@Router			/organizations/{organizationId}/dashboards/custom-charts [post]
+//	@Security		JWT
+func (h *DashboardHandler) CreateCustomChart(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateCustomChartRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto, err := newCustomChart(organizationId, input.Name, input.Description, input.Query, input.Unit, input.Thresholds)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	customChartId, err := h.usecase.CreateCustomChart(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateCustomChartResponse{ID: customChartId.String()})
+}
+
+// GetCustomCharts godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get custom charts
+//	@Description	Get custom charts of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetCustomChartsResponse
+//	@Router			/organizations/{organizationId}/dashboards/custom-charts [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetCustomCharts(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	customCharts, err := h.usecase.GetCustomCharts(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetCustomChartsResponse
+	out.CustomCharts = make([]domain.CustomChartResponse, len(customCharts))
+	for i, customChart := range customCharts {
+		out.CustomCharts[i] = newCustomChartResponse(r, customChart)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetCustomChart godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get custom chart
+//	@Description	Get custom chart
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			customChartId	path		string	true	"customChartId"
+//	@Success		200				{object}	domain.GetCustomChartResponse
+//	@Router			/organizations/{organizationId}/dashboards/custom-charts/{customChartId} [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetCustomChart(w http.ResponseWriter, r *http.Request) {
+	organizationId, customChartId, err := getCustomChartVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	customChart, err := h.usecase.GetCustomChart(r.Context(), organizationId, customChartId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.GetCustomChartResponse{CustomChart: newCustomChartResponse(r, customChart)})
+}
+
+// UpdateCustomChart godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Update custom chart
+//	@Description	Update custom chart
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string							true	"organizationId"
+//	@Param			customChartId	path	string							true	"customChartId"
+//	@Param			body			body	domain.UpdateCustomChartRequest	true	"update custom chart request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/dashboards/custom-charts/{customChartId} [put]
+//	@Security		JWT
+func (h *DashboardHandler) UpdateCustomChart(w http.ResponseWriter, r *http.Request) {
+	organizationId, customChartId, err := getCustomChartVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateCustomChartRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto, err := newCustomChart(organizationId, input.Name, input.Description, input.Query, input.Unit, input.Thresholds)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	dto.ID = customChartId
+
+	if err := h.usecase.UpdateCustomChart(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteCustomChart godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Delete custom chart
+//	@Description	Delete custom chart
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			customChartId	path	string	true	"customChartId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/dashboards/custom-charts/{customChartId} [delete]
+//	@Security		JWT
+func (h *DashboardHandler) DeleteCustomChart(w http.ResponseWriter, r *http.Request) {
+	organizationId, customChartId, err := getCustomChartVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.DeleteCustomChart(r.Context(), organizationId, customChartId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// GetCustomChartData godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get custom chart data
+//	@Description	Get chart data of the custom chart in the same format as the built-in charts
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			customChartId	path		string	true	"customChartId"
+//	@Param			duration		query		string	true	"duration"
+//	@Param			interval		query		string	true	"interval"
+//	@Success		200				{object}	domain.DashboardChartResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/custom-charts/{customChartId} [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetCustomChartData(w http.ResponseWriter, r *http.Request) {
+	organizationId, customChartId, err := getCustomChartVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	query := r.URL.Query()
+	duration := query.Get("duration")
+	if duration == "" {
+		duration = "1d" // default
+	}
+
+	interval := query.Get("interval")
+	if interval == "" {
+		interval = "1d" // default
+	}
+
+	chart, err := h.usecase.GetCustomChartData(r.Context(), organizationId, customChartId, duration, interval)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.DashboardChartResponse
+	if err := serializer.Map(r.Context(), chart, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func getCustomChartVars(r *http.Request) (organizationId string, customChartId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	customChartId, err = uuid.Parse(vars["customChartId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid customChartId"), "D_INVALID_CUSTOM_CHART_ID", "")
+	}
+	return organizationId, customChartId, nil
+}
+
+func newCustomChart(organizationId string, name string, description string, query string, unit domain.ChartUnit, thresholds []domain.ChartThreshold) (model.CustomChart, error) {
+	if thresholds == nil {
+		thresholds = []domain.ChartThreshold{}
+	}
+	b, err := json.Marshal(thresholds)
+	if err != nil {
+		return model.CustomChart{}, httpErrors.NewBadRequestError(err, "C_INVALID_QUERY_PARAM", "")
+	}
+	return model.CustomChart{
+		OrganizationId: organizationId,
+		Name:           name,
+		Description:    description,
+		Query:          query,
+		Unit:           unit,
+		Thresholds:     b,
+	}, nil
+}
+
+func newCustomChartResponse(r *http.Request, customChart model.CustomChart) (out domain.CustomChartResponse) {
+	if err := serializer.Map(r.Context(), customChart, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+	out.Thresholds = []domain.ChartThreshold{}
+	if len(customChart.Thresholds) > 0 {
+		if err := json.Unmarshal(customChart.Thresholds, &out.Thresholds); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+	return
+}
@@ -1,12 +1,17 @@
 package http
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
@@ -16,6 +21,7 @@ import (
 	"github.com/openinfradev/tks-api/pkg/domain"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
 	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/spf13/viper"
 )
 
 type IDashboardHandler interface {
@@ -24,8 +30,19 @@ type IDashboardHandler interface {
 	UpdateDashboard(w http.ResponseWriter, r *http.Request)
 	GetCharts(w http.ResponseWriter, r *http.Request)
 	GetChart(w http.ResponseWriter, r *http.Request)
+	StreamCharts(w http.ResponseWriter, r *http.Request)
 	GetStacks(w http.ResponseWriter, r *http.Request)
 	GetResources(w http.ResponseWriter, r *http.Request)
+	GetResourcesV2(w http.ResponseWriter, r *http.Request)
+	GetStackNodes(w http.ResponseWriter, r *http.Request)
+	GetStackCharts(w http.ResponseWriter, r *http.Request)
+	GetStackChart(w http.ResponseWriter, r *http.Request)
+	GetStorages(w http.ResponseWriter, r *http.Request)
+	GetNetworkPolicies(w http.ResponseWriter, r *http.Request)
+	GetIdentityStatus(w http.ResponseWriter, r *http.Request)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	GetCosts(w http.ResponseWriter, r *http.Request)
+	GetClusterEvents(w http.ResponseWriter, r *http.Request)
 	GetPolicyStatus(w http.ResponseWriter, r *http.Request)
 	GetPolicyUpdate(w http.ResponseWriter, r *http.Request)
 	GetPolicyEnforcement(w http.ResponseWriter, r *http.Request)
@@ -34,6 +51,18 @@ type IDashboardHandler interface {
 	GetPolicyStatistics(w http.ResponseWriter, r *http.Request)
 	GetWorkload(w http.ResponseWriter, r *http.Request)
 	GetPolicyViolationTop5(w http.ResponseWriter, r *http.Request)
+	GetPolicyEnforcementStatus(w http.ResponseWriter, r *http.Request)
+	CreateCustomChart(w http.ResponseWriter, r *http.Request)
+	GetCustomCharts(w http.ResponseWriter, r *http.Request)
+	GetCustomChart(w http.ResponseWriter, r *http.Request)
+	UpdateCustomChart(w http.ResponseWriter, r *http.Request)
+	DeleteCustomChart(w http.ResponseWriter, r *http.Request)
+	GetCustomChartData(w http.ResponseWriter, r *http.Request)
+	CreateChartSnapshot(w http.ResponseWriter, r *http.Request)
+	GetChartSnapshots(w http.ResponseWriter, r *http.Request)
+	DeleteChartSnapshot(w http.ResponseWriter, r *http.Request)
+	GetSharedChartSnapshot(w http.ResponseWriter, r *http.Request)
+	Admin_WarmupCaches(w http.ResponseWriter, r *http.Request)
 }
 
 type DashboardHandler struct {
@@ -237,13 +266,15 @@ func (h *DashboardHandler) UpdateDashboard(w http.ResponseWriter, r *http.Reques
 //
 //	@Tags			Dashboard Widgets
 //	@Summary		Get charts data
-//	@Description	Get charts data
+//	@Description	Get charts data. CPU, MEMORY, POD and TRAFFIC charts include annotations of deployments, stack operations and critical alerts within the duration
 //	@Accept			json
 //	@Produce		json
 //	@Param			organizationId	path		string	true	"organizationId"
 //	@Param			chartType		query		string	false	"chartType"
 //	@Param			duration		query		string	true	"duration"
 //	@Param			interval		query		string	true	"interval"
+//	@Param			aggregation		query		string	false	"aggregation (avg, max, min, p95). default avg"
+//	@Param			timezone		query		string	false	"timezone (IANA name). default is the user's timezone or UTC"
 //	@Success		200				{object}	domain.GetDashboardChartsResponse
 //	@Router			/organizations/{organizationId}/dashboards/widgets/charts [get]
 //	@Security		JWT
@@ -275,7 +306,25 @@ func (h *DashboardHandler) GetCharts(w http.ResponseWriter, r *http.Request) {
 		month = "5" // default
 	}
 
-	charts, err := h.usecase.GetCharts(r.Context(), organizationId, domain.ChartType_ALL, duration, interval, year, month)
+	aggregation := domain.ChartAggregation(query.Get("aggregation"))
+	if aggregation == "" {
+		aggregation = domain.ChartAggregation_AVG // default
+	}
+	if !aggregation.Validate() {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid aggregation"), "D_INVALID_CHART_AGGREGATION", ""))
+		return
+	}
+
+	// 비어 있으면 usecase 에서 사용자의 timezone 을 사용한다.
+	timezone := query.Get("timezone")
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid timezone"), "D_INVALID_TIMEZONE", ""))
+			return
+		}
+	}
+
+	charts, err := h.usecase.GetCharts(r.Context(), organizationId, domain.ChartType_ALL, duration, interval, aggregation, year, month, timezone)
 	if err != nil {
 		ErrorJSON(w, r, err)
 		return
@@ -290,20 +339,22 @@ func (h *DashboardHandler) GetCharts(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	ResponseJSON(w, r, http.StatusOK, out)
+	ResponseJSONStream(w, r, http.StatusOK, "charts", out.Charts)
 }
 
 // GetChart godoc
 //
 //	@Tags			Dashboard Widgets
 //	@Summary		Get chart data
-//	@Description	Get chart data
+//	@Description	Get chart data. TOP_PODS returns the pods with the most restarts, CPU and memory usage in chartData.topPods. CPU, MEMORY, POD and TRAFFIC charts include annotations of deployments, stack operations and critical alerts within the duration
 //	@Accept			json
 //	@Produce		json
 //	@Param			organizationId	path		string	true	"organizationId"
 //	@Param			chartType		path		string	true	"chartType"
 //	@Param			duration		query		string	true	"duration"
 //	@Param			interval		query		string	true	"interval"
+//	@Param			aggregation		query		string	false	"aggregation (avg, max, min, p95). default avg"
+//	@Param			timezone		query		string	false	"timezone (IANA name). default is the user's timezone or UTC"
 //	@Success		200				{object}	domain.GetDashboardChartResponse
 //	@Router			/organizations/{organizationId}/dashboards/widgets/charts/{chartType} [get]
 //	@Security		JWT
@@ -321,7 +372,7 @@ func (h *DashboardHandler) GetChart(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	chartType := new(domain.ChartType).FromString(strType)
-	if chartType == domain.ChartType_ERROR {
+	if chartType == domain.ChartType_ERROR || chartType.IsClusterOnly() {
 		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid chartType"), "D_INVALID_CHART_TYPE", ""))
 		return
 	}
@@ -347,7 +398,25 @@ func (h *DashboardHandler) GetChart(w http.ResponseWriter, r *http.Request) {
 		month = "4" // default
 	}
 
-	charts, err := h.usecase.GetCharts(r.Context(), organizationId, chartType, duration, interval, year, month)
+	aggregation := domain.ChartAggregation(query.Get("aggregation"))
+	if aggregation == "" {
+		aggregation = domain.ChartAggregation_AVG // default
+	}
+	if !aggregation.Validate() {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid aggregation"), "D_INVALID_CHART_AGGREGATION", ""))
+		return
+	}
+
+	// 비어 있으면 usecase 에서 사용자의 timezone 을 사용한다.
+	timezone := query.Get("timezone")
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid timezone"), "D_INVALID_TIMEZONE", ""))
+			return
+		}
+	}
+
+	charts, err := h.usecase.GetCharts(r.Context(), organizationId, chartType, duration, interval, aggregation, year, month, timezone)
 	if err != nil {
 		if strings.Contains(err.Error(), "Invalid primary clusterId") {
 			ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "D_INVALID_PRIMARY_STACK", ""))
@@ -370,6 +439,137 @@ func (h *DashboardHandler) GetChart(w http.ResponseWriter, r *http.Request) {
 	ResponseJSON(w, r, http.StatusOK, out)
 }
 
+var chartStreamUpgrader = websocket.Upgrader{
+	// 브라우저는 토큰을 "bearer, <token>" subprotocol 로 전달하므로 bearer 를 응답한다.
+	Subprotocols: []string{"bearer"},
+	// 인증은 토큰으로 하므로 origin 은 확인하지 않는다.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+const (
+	chartStreamWriteTimeout = 10 * time.Second
+	chartStreamPingInterval = 30 * time.Second
+)
+
+// StreamCharts godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Stream chart data
+//	@Description	Upgrade to WebSocket and push chart data. The first message is a SNAPSHOT of the whole duration and the following UPDATE messages contain only the points added every interval.
+//	@Description	Browsers can pass the token with the "bearer, <token>" subprotocol.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			chartTypes		query		string	false	"comma separated chart types (CPU,MEMORY,POD,TRAFFIC). default all"
+//	@Param			duration		query		string	false	"duration. default 1h"
+//	@Param			interval		query		string	false	"interval (1m, 5m, 10m, 30m, 1h, 1d). default 1m"
+//	@Param			aggregation		query		string	false	"aggregation (avg, max, min, p95). default avg"
+//	@Success		101				{object}	domain.DashboardChartStreamMessage
+//	@Router			/organizations/{organizationId}/dashboard/stream [get]
+//	@Security		JWT
+func (h *DashboardHandler) StreamCharts(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	query := r.URL.Query()
+	chartTypes := []domain.ChartType{}
+	if v := query.Get("chartTypes"); v != "" {
+		for _, strType := range strings.Split(v, ",") {
+			chartType := new(domain.ChartType).FromString(strings.TrimSpace(strType))
+			if !usecase.IsStreamableChartType(chartType) {
+				ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid chartType %s", strType), "D_INVALID_CHART_TYPE", ""))
+				return
+			}
+			chartTypes = append(chartTypes, chartType)
+		}
+	}
+
+	duration := query.Get("duration")
+	if duration == "" {
+		duration = "1h" // default
+	}
+
+	interval := query.Get("interval")
+	if interval == "" {
+		interval = "1m" // default
+	}
+
+	aggregation := domain.ChartAggregation(query.Get("aggregation"))
+	if aggregation == "" {
+		aggregation = domain.ChartAggregation_AVG // default
+	}
+	if !aggregation.Validate() {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid aggregation"), "D_INVALID_CHART_AGGREGATION", ""))
+		return
+	}
+
+	// Upgrade 가 실패하면 응답은 upgrader 가 작성한다.
+	conn, err := chartStreamUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Error(r.Context(), err)
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// client 가 연결을 끊으면 stream 을 멈춘다. client 가 보낸 메시지는 사용하지 않는다.
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+	go func() {
+		ticker := time.NewTicker(chartStreamPingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(chartStreamWriteTimeout)); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	err = h.usecase.StreamCharts(ctx, organizationId, chartTypes, duration, interval, aggregation, func(incremental bool, charts []domain.DashboardChart) error {
+		out := domain.DashboardChartStreamMessage{Type: domain.DashboardChartStreamMessageType_SNAPSHOT}
+		if incremental {
+			out.Type = domain.DashboardChartStreamMessageType_UPDATE
+		}
+		out.Charts = make([]domain.DashboardChartResponse, len(charts))
+		for i, chart := range charts {
+			if err := serializer.Map(ctx, chart, &out.Charts[i]); err != nil {
+				log.Info(ctx, err)
+			}
+		}
+
+		if err := conn.SetWriteDeadline(time.Now().Add(chartStreamWriteTimeout)); err != nil {
+			return err
+		}
+		return conn.WriteJSON(out)
+	})
+	if err != nil {
+		log.Error(ctx, err)
+		_ = conn.SetWriteDeadline(time.Now().Add(chartStreamWriteTimeout))
+		_ = conn.WriteJSON(domain.DashboardChartStreamMessage{
+			Type:  domain.DashboardChartStreamMessageType_ERROR,
+			Error: err.Error(),
+		})
+	}
+}
+
 // GetStacks godoc
 //
 //	@Tags			Dashboard Widgets
@@ -377,7 +577,12 @@ func (h *DashboardHandler) GetChart(w http.ResponseWriter, r *http.Request) {
 //	@Description	Get stacks
 //	@Accept			json
 //	@Produce		json
-//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			sortColumn		query		string		false	"sortColumn (name, status, createdAt)"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters (status, cloudService)"
 //	@Success		200				{object}	domain.GetDashboardStacksResponse
 //	@Router			/organizations/{organizationId}/dashboards/widgets/stacks [get]
 //	@Security		JWT
@@ -389,7 +594,9 @@ func (h *DashboardHandler) GetStacks(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	stacks, err := h.usecase.GetStacks(r.Context(), organizationId)
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	stacks, err := h.usecase.GetStacks(r.Context(), organizationId, pg)
 	if err != nil {
 		if strings.Contains(err.Error(), "Invalid primary clusterId") {
 			ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "D_INVALID_PRIMARY_STACK", ""))
@@ -409,6 +616,56 @@ func (h *DashboardHandler) GetStacks(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetClusterEvents godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get cluster events
+//	@Description	Get warning events of all running clusters in the period. Repeated events of the same object and reason are merged and correlated with system notifications of the object.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			period			query		string	false	"period such as 30m, 6h (default 1h, max 24h)"
+//	@Param			pageSize		query		string	false	"pageSize"
+//	@Param			pageNumber		query		string	false	"pageNumber"
+//	@Success		200				{object}	domain.GetDashboardClusterEventsResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/cluster-events [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetClusterEvents(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	var period time.Duration
+	if v := urlParams.Get("period"); v != "" {
+		var err error
+		if period, err = time.ParseDuration(v); err != nil {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid period"), "D_INVALID_CLUSTER_EVENT_QUERY", ""))
+			return
+		}
+	}
+
+	pg := pagination.NewPagination(&urlParams)
+	out, err := h.usecase.GetClusterEvents(r.Context(), organizationId, period, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
 	ResponseJSON(w, r, http.StatusOK, out)
 }
 
@@ -440,14 +697,428 @@ func (h *DashboardHandler) GetResources(w http.ResponseWriter, r *http.Request)
 		ErrorJSON(w, r, err)
 		return
 	}
-	var out domain.GetDashboardResourcesResponse
-	if err := serializer.Map(r.Context(), resources, &out.Resources); err != nil {
+	ResponseJSON(w, r, http.StatusOK, domain.GetDashboardResourcesResponse{Resources: newDashboardResourceResponse(resources)})
+}
+
+// GetResourcesV2 godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get resources (v2)
+//	@Description	Get resources as raw numeric values with unit metadata. Served under /api/2.0 so it is not listed with the 1.0 routes.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetDashboardResourcesV2Response
+//	@Security		JWT
+func (h *DashboardHandler) GetResourcesV2(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	resources, err := h.usecase.GetResources(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.GetDashboardResourcesV2Response{
+		Resources: domain.DashboardResourceV2Response{
+			StackCount: domain.DashboardStackCountResponse{
+				Normal:   resources.NormalStackCount,
+				Abnormal: resources.AbnormalStackCount,
+				Total:    resources.NormalStackCount + resources.AbnormalStackCount,
+			},
+			CpuCores:     resources.CpuCores,
+			MemoryBytes:  resources.MemoryBytes,
+			StorageBytes: resources.StorageBytes,
+			MonthlyCosts: resources.MonthlyCosts,
+			Units: map[string]domain.ChartUnit{
+				"stackCount":   domain.ChartUnit_COUNT,
+				"cpuCores":     domain.ChartUnit_CORES,
+				"memoryBytes":  domain.ChartUnit_BYTES,
+				"storageBytes": domain.ChartUnit_BYTES,
+			},
+			Errors: resources.Errors,
+		},
+	})
+}
+
+// newDashboardResourceResponse 는 v1 응답의 표시용 문자열을 만든다. memory, storage 는 GiB 단위이다.
+func newDashboardResourceResponse(resources domain.DashboardResource) (out domain.DashboardResourceResponse) {
+	const gib = float64(1024 * 1024 * 1024)
+	out.Stack.Normal = strconv.Itoa(resources.NormalStackCount)
+	out.Stack.Abnormal = strconv.Itoa(resources.AbnormalStackCount)
+	out.Cpu = strconv.FormatInt(resources.CpuCores, 10)
+	out.Memory = fmt.Sprintf("%v", math.Round(float64(resources.MemoryBytes)/gib))
+	out.Storage = fmt.Sprintf("%v", math.Round(float64(resources.StorageBytes)/gib))
+	if len(resources.MonthlyCosts) > 0 {
+		out.Cost = make(map[string]string, len(resources.MonthlyCosts))
+		for currency, cost := range resources.MonthlyCosts {
+			out.Cost[currency] = strconv.FormatFloat(cost, 'f', 2, 64)
+		}
+	}
+	out.Errors = resources.Errors
+	return
+}
+
+// GetStackNodes godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get node metrics of stack
+//	@Description	Get cpu, memory and disk utilization per node of stack
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackId			path		string	true	"stackId"
+//	@Success		200				{object}	domain.GetDashboardStackNodesResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/stacks/{stackId}/nodes [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetStackNodes(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	strId, ok := vars["stackId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID", ""))
+		return
+	}
+
+	nodes, err := h.usecase.GetStackNodes(r.Context(), organizationId, domain.StackId(strId))
+	if err != nil {
+		if strings.Contains(err.Error(), "Invalid primary clusterId") {
+			ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "D_INVALID_PRIMARY_STACK", ""))
+			return
+		}
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetDashboardStackNodesResponse
+	out.Nodes = make([]domain.DashboardNodeResponse, len(nodes))
+	for i, node := range nodes {
+		if err := serializer.Map(r.Context(), node, &out.Nodes[i]); err != nil {
+			log.Info(r.Context(), err)
+			continue
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetStackCharts godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get node charts of stack
+//	@Description	Get cpu, memory, disk and network charts per node of stack
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackId			path		string	true	"stackId"
+//	@Param			duration		query		string	true	"duration"
+//	@Param			interval		query		string	true	"interval"
+//	@Success		200				{object}	domain.GetDashboardChartsResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/stacks/{stackId}/charts [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetStackCharts(w http.ResponseWriter, r *http.Request) {
+	charts, err := h.getStackCharts(r, domain.ChartType_ALL)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetDashboardChartsResponse
+	out.Charts = make([]domain.DashboardChartResponse, len(charts))
+	for i, chart := range charts {
+		if err := serializer.Map(r.Context(), chart, &out.Charts[i]); err != nil {
+			log.Info(r.Context(), err)
+			continue
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetStackChart godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get node chart of stack
+//	@Description	Get chart per node of stack. chartType is one of CPU, MEMORY, DISK and NETWORK
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackId			path		string	true	"stackId"
+//	@Param			chartType		path		string	true	"chartType"
+//	@Param			duration		query		string	true	"duration"
+//	@Param			interval		query		string	true	"interval"
+//	@Success		200				{object}	domain.GetDashboardChartResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/stacks/{stackId}/charts/{chartType} [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetStackChart(w http.ResponseWriter, r *http.Request) {
+	chartType := new(domain.ChartType).FromString(mux.Vars(r)["chartType"])
+	if !slices.Contains(domain.ClusterChartTypes, chartType) {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid chartType"), "D_INVALID_CHART_TYPE", ""))
+		return
+	}
+
+	charts, err := h.getStackCharts(r, chartType)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	if len(charts) < 1 {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("No data"), "D_NOT_FOUND_CHART", ""))
+		return
+	}
+
+	var out domain.GetDashboardChartResponse
+	if err := serializer.Map(r.Context(), charts[0], &out.Chart); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func (h *DashboardHandler) getStackCharts(r *http.Request, chartType domain.ChartType) ([]domain.DashboardChart, error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+
+	stackId, ok := vars["stackId"]
+	if !ok {
+		return nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID", "")
+	}
+
+	query := r.URL.Query()
+	duration := query.Get("duration")
+	if duration == "" {
+		duration = "1d" // default
+	}
+
+	interval := query.Get("interval")
+	if interval == "" {
+		interval = "1h" // default
+	}
+
+	return h.usecase.GetClusterCharts(r.Context(), organizationId, domain.ClusterId(stackId), chartType, duration, interval)
+}
+
+// GetStorages godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get storage report
+//	@Description	Get PVC usage per cluster with storage class and growth over the last 7 days
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackId			query		string	false	"stackId"
+//	@Param			threshold		query		string	false	"fill rate threshold (%)"
+//	@Success		200				{object}	domain.GetDashboardStoragesResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/storages [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetStorages(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	query := r.URL.Query()
+	stackId := query.Get("stackId")
+
+	threshold := viper.GetFloat64("storage-fill-threshold")
+	if strThreshold := query.Get("threshold"); strThreshold != "" {
+		v, err := strconv.ParseFloat(strThreshold, 64)
+		if err != nil || v <= 0 || v > 100 {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid threshold"), "", ""))
+			return
+		}
+		threshold = v
+	}
+
+	storages, err := h.usecase.GetStorages(r.Context(), organizationId, domain.StackId(stackId), threshold)
+	if err != nil {
+		if strings.Contains(err.Error(), "Invalid primary clusterId") {
+			ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "D_INVALID_PRIMARY_STACK", ""))
+			return
+		}
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetDashboardStoragesResponse
+	out.Threshold = threshold
+	out.Storages = make([]domain.DashboardStorageResponse, len(storages))
+	for i, storage := range storages {
+		if err := serializer.Map(r.Context(), storage, &out.Storages[i]); err != nil {
+			log.Info(r.Context(), err)
+			continue
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetNetworkPolicies godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get network policy overview
+//	@Description	Get NetworkPolicy coverage per namespace of stacks to spot unprotected namespaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackId			query		string	false	"stackId"
+//	@Success		200				{object}	domain.GetDashboardNetworkPoliciesResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/network-policies [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetNetworkPolicies(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	stackId := r.URL.Query().Get("stackId")
+
+	policies, err := h.usecase.GetNetworkPolicies(r.Context(), organizationId, domain.StackId(stackId))
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetDashboardNetworkPoliciesResponse
+	out.Namespaces = make([]domain.DashboardNetworkPolicyResponse, len(policies))
+	for i, policy := range policies {
+		if err := serializer.Map(r.Context(), policy, &out.Namespaces[i]); err != nil {
+			log.Info(r.Context(), err)
+			continue
+		}
+
+		out.Summary.Total++
+		switch policy.Status {
+		case domain.NetworkPolicyStatus_OPEN:
+			out.Summary.Open++
+		case domain.NetworkPolicyStatus_PARTIAL:
+			out.Summary.Partial++
+		case domain.NetworkPolicyStatus_RESTRICTED:
+			out.Summary.Restricted++
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetIdentityStatus godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get identity status
+//	@Description	Get keycloak reachability, token issuance latency, locked accounts and pending invitations of the organization. Failed checks are reported in the response instead of an error.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetDashboardIdentityStatusResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/identity-status [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetIdentityStatus(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	status, err := h.organizationUsecase.GetIdentityStatus(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetDashboardIdentityStatusResponse
+	if err := serializer.Map(r.Context(), status, &out); err != nil {
 		log.Info(r.Context(), err)
 	}
 
 	ResponseJSON(w, r, http.StatusOK, out)
 }
 
+// GetHealth godoc
+//
+//	@Tags			Dashboards
+//	@Summary		Get health of observability stack
+//	@Description	Check that the primary cluster is reachable, the thanos query service exists and a trivial query succeeds. Failed checks are reported per component instead of an error.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetDashboardHealthResponse
+//	@Router			/organizations/{organizationId}/dashboard/health [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	out, err := h.usecase.GetHealth(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetCosts godoc
+//
+//	@Tags			Dashboards
+//	@Summary		Get estimated costs
+//	@Description	Get estimated monthly cost per stack and per organization from cpu cores, memory and the pricing of cloud accounts. Stacks of cloud accounts without pricing are not priced.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetDashboardCostsResponse
+//	@Router			/organizations/{organizationId}/dashboard/costs [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetCosts(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	costs, err := h.usecase.GetCosts(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetDashboardCostsResponse
+	out.Stacks = make([]domain.DashboardStackCostResponse, len(costs.Stacks))
+	for i, stack := range costs.Stacks {
+		if err := serializer.Map(r.Context(), stack, &out.Stacks[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+	out.MonthlyCosts = costs.MonthlyCosts
+	if len(costs.Errors) > 0 {
+		out.Errors = costs.Errors
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
 // GetPolicyStatus godoc
 //
 //	@Tags			Dashboard Widgets
@@ -811,3 +1482,46 @@ func (h *DashboardHandler) GetPolicyViolationTop5(w http.ResponseWriter, r *http
 	out.UpdatedAt = time.Now()
 	ResponseJSON(w, r, http.StatusOK, out)
 }
+
+// GetPolicyEnforcementStatus godoc
+//
+//	@Tags			Dashboard Widgets
+//	@Summary		Get policy enforcement status per cluster
+//	@Description	Get gatekeeper and kyverno violation counts per policy and per cluster, with the violation trend of each cluster
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Param			duration		query		string	false	"duration"
+//	@Param			interval		query		string	false	"interval"
+//	@Success		200				{object}	domain.GetDashboardPolicyEnforcementStatusResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/policy-enforcement-status [get]
+//	@Security		JWT
+func (h *DashboardHandler) GetPolicyEnforcementStatus(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("%s: invalid organizationId", organizationId),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	query := r.URL.Query()
+	duration := query.Get("duration")
+	if duration == "" {
+		duration = "7d" // default
+	}
+
+	interval := query.Get("interval")
+	if interval == "" {
+		interval = "1d" // default
+	}
+
+	out, err := h.usecase.GetPolicyEnforcementStatus(r.Context(), organizationId, duration, interval)
+	if err != nil {
+		log.Error(r.Context(), "Failed to get policy enforcement status", err)
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
@@ -0,0 +1,327 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type DeploymentApprovalHandler struct {
+	usecase usecase.IDeploymentApprovalUsecase
+}
+
+func NewDeploymentApprovalHandler(h usecase.Usecase) *DeploymentApprovalHandler {
+	return &DeploymentApprovalHandler{
+		usecase: h.DeploymentApproval,
+	}
+}
+
+// GetDeploymentApprovalPolicy godoc
+//
+//	@Tags			DeploymentApprovals
+//	@Summary		Get deployment approval policy
+//	@Description	Get deployment approval policy of the stack
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackId			path		string	true	"stackId"
+//	@Success		200				{object}	domain.GetDeploymentApprovalPolicyResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/deployment-approval-policy [get]
+//	@Security		JWT
+func (h *DeploymentApprovalHandler) GetDeploymentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := deploymentApprovalPolicyVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	policy, err := h.usecase.GetPolicy(r.Context(), organizationId, stackId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetDeploymentApprovalPolicyResponse
+	if err := serializer.Map(r.Context(), policy, &out.Policy); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateDeploymentApprovalPolicy godoc
+//
+//	@Tags			DeploymentApprovals
+//	@Summary		Update deployment approval policy
+//	@Description	Mark the stack as a production target. App-serve deploy and promote to the stack wait for the approval of the nominated approvers.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string											true	"organizationId"
+//	@Param			stackId			path		string											true	"stackId"
+//	@Param			body			body		domain.UpdateDeploymentApprovalPolicyRequest	true	"update deployment approval policy request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/deployment-approval-policy [put]
+//	@Security		JWT
+func (h *DeploymentApprovalHandler) UpdateDeploymentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := deploymentApprovalPolicyVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateDeploymentApprovalPolicyRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.DeploymentApprovalPolicy
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+	dto.ClusterId = stackId
+
+	if err = h.usecase.UpdatePolicy(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteDeploymentApprovalPolicy godoc
+//
+//	@Tags			DeploymentApprovals
+//	@Summary		Delete deployment approval policy
+//	@Description	Delete deployment approval policy of the stack. Deploy to the stack no longer requires approval.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackId			path		string	true	"stackId"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/deployment-approval-policy [delete]
+//	@Security		JWT
+func (h *DeploymentApprovalHandler) DeleteDeploymentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := deploymentApprovalPolicyVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.DeletePolicy(r.Context(), organizationId, stackId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// GetDeploymentApprovals godoc
+//
+//	@Tags			DeploymentApprovals
+//	@Summary		Get deployment approvals
+//	@Description	Get deployment approvals of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetDeploymentApprovalsResponse
+//	@Router			/organizations/{organizationId}/deployment-approvals [get]
+//	@Security		JWT
+func (h *DeploymentApprovalHandler) GetDeploymentApprovals(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	for i, filter := range pg.GetFilters() {
+		if filter.Column == "status" {
+			for j, value := range filter.Values {
+				var s domain.DeploymentApprovalStatus
+				pg.GetFilters()[i].Values[j] = strconv.Itoa(int(s.FromString(value)))
+			}
+		}
+		if filter.Column == "action" {
+			for j, value := range filter.Values {
+				var s domain.DeploymentApprovalAction
+				pg.GetFilters()[i].Values[j] = strconv.Itoa(int(s.FromString(value)))
+			}
+		}
+	}
+
+	approvals, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetDeploymentApprovalsResponse
+	out.Approvals = make([]domain.DeploymentApprovalResponse, len(approvals))
+	for i, approval := range approvals {
+		if err := serializer.Map(r.Context(), approval, &out.Approvals[i]); err != nil {
+			log.Info(r.Context(), err)
+			continue
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetDeploymentApproval godoc
+//
+//	@Tags			DeploymentApprovals
+//	@Summary		Get deployment approval
+//	@Description	Get deployment approval
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			approvalId		path		string	true	"approvalId"
+//	@Success		200				{object}	domain.GetDeploymentApprovalResponse
+//	@Router			/organizations/{organizationId}/deployment-approvals/{approvalId} [get]
+//	@Security		JWT
+func (h *DeploymentApprovalHandler) GetDeploymentApproval(w http.ResponseWriter, r *http.Request) {
+	organizationId, approvalId, err := deploymentApprovalVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	approval, err := h.usecase.Get(r.Context(), organizationId, approvalId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	h.responseApproval(w, r, approval)
+}
+
+// ApproveDeployment godoc
+//
+//	@Tags			DeploymentApprovals
+//	@Summary		Approve deployment
+//	@Description	Approve the pending deploy or promote. The workflow starts after the approval is recorded.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string									true	"organizationId"
+//	@Param			approvalId		path		string									true	"approvalId"
+//	@Param			body			body		domain.DecideDeploymentApprovalRequest	false	"approve deployment request"
+//	@Success		200				{object}	domain.GetDeploymentApprovalResponse
+//	@Router			/organizations/{organizationId}/deployment-approvals/{approvalId}/approve [post]
+//	@Security		JWT
+func (h *DeploymentApprovalHandler) ApproveDeployment(w http.ResponseWriter, r *http.Request) {
+	organizationId, approvalId, input, err := h.decisionInput(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	approval, err := h.usecase.Approve(r.Context(), organizationId, approvalId, input.Comment)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	h.responseApproval(w, r, approval)
+}
+
+// RejectDeployment godoc
+//
+//	@Tags			DeploymentApprovals
+//	@Summary		Reject deployment
+//	@Description	Reject the pending deploy or promote
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string									true	"organizationId"
+//	@Param			approvalId		path		string									true	"approvalId"
+//	@Param			body			body		domain.DecideDeploymentApprovalRequest	false	"reject deployment request"
+//	@Success		200				{object}	domain.GetDeploymentApprovalResponse
+//	@Router			/organizations/{organizationId}/deployment-approvals/{approvalId}/reject [post]
+//	@Security		JWT
+func (h *DeploymentApprovalHandler) RejectDeployment(w http.ResponseWriter, r *http.Request) {
+	organizationId, approvalId, input, err := h.decisionInput(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	approval, err := h.usecase.Reject(r.Context(), organizationId, approvalId, input.Comment)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	h.responseApproval(w, r, approval)
+}
+
+func (h *DeploymentApprovalHandler) decisionInput(r *http.Request) (organizationId string, approvalId uuid.UUID, input domain.DecideDeploymentApprovalRequest, err error) {
+	organizationId, approvalId, err = deploymentApprovalVars(r)
+	if err != nil {
+		return
+	}
+	if r.ContentLength != 0 {
+		err = UnmarshalRequestInput(r, &input)
+	}
+	return
+}
+
+func (h *DeploymentApprovalHandler) responseApproval(w http.ResponseWriter, r *http.Request, approval model.DeploymentApproval) {
+	var out domain.GetDeploymentApprovalResponse
+	if err := serializer.Map(r.Context(), approval, &out.Approval); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func deploymentApprovalPolicyVars(r *http.Request) (organizationId string, stackId domain.ClusterId, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", "", httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	strId, ok := vars["stackId"]
+	if !ok {
+		return "", "", httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID", "")
+	}
+	return organizationId, domain.ClusterId(strId), nil
+}
+
+func deploymentApprovalVars(r *http.Request) (organizationId string, approvalId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	strId, ok := vars["approvalId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid approvalId"), "DA_NOT_FOUND_APPROVAL", "")
+	}
+	approvalId, err = uuid.Parse(strId)
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(err, "DA_NOT_FOUND_APPROVAL", "")
+	}
+	return organizationId, approvalId, nil
+}
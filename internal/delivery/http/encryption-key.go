@@ -0,0 +1,183 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type EncryptionKeyHandler struct {
+	usecase usecase.IEncryptionKeyUsecase
+}
+
+func NewEncryptionKeyHandler(h usecase.Usecase) *EncryptionKeyHandler {
+	return &EncryptionKeyHandler{
+		usecase: h.EncryptionKey,
+	}
+}
+
+// GetEncryptionKeys godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get encryption keys of organization
+//	@Description	Get the KMS keys registered by the organization to seal its secrets
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetEncryptionKeysResponse
+//	@Router			/organizations/{organizationId}/encryption-keys [get]
+//	@Security		JWT
+func (h *EncryptionKeyHandler) GetEncryptionKeys(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	encryptionKeys, err := h.usecase.Fetch(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetEncryptionKeysResponse
+	out.EncryptionKeys = make([]domain.EncryptionKeyResponse, len(encryptionKeys))
+	for i, encryptionKey := range encryptionKeys {
+		if err := serializer.Map(r.Context(), encryptionKey, &out.EncryptionKeys[i]); err != nil {
+			log.Info(r.Context(), err)
+			continue
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// CreateEncryptionKey godoc
+//
+//	@Tags			Organizations
+//	@Summary		Create encryption key
+//	@Description	Register the first KMS key of the organization. The key policy must allow TKS to call DescribeKey, GenerateDataKey and Decrypt.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.CreateEncryptionKeyRequest	true	"create encryption key request"
+//	@Success		200				{object}	domain.CreateEncryptionKeyResponse
+//	@Router			/organizations/{organizationId}/encryption-keys [post]
+//	@Security		JWT
+func (h *EncryptionKeyHandler) CreateEncryptionKey(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateEncryptionKeyRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.EncryptionKey
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	encryptionKeyId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateEncryptionKeyResponse{ID: encryptionKeyId.String()})
+}
+
+// RotateEncryptionKey godoc
+//
+//	@Tags			Organizations
+//	@Summary		Rotate encryption key
+//	@Description	Register a new KMS key as active, retire the current key and reseal the secrets of the organization with the new key
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.RotateEncryptionKeyRequest	true	"rotate encryption key request"
+//	@Success		200				{object}	domain.RotateEncryptionKeyResponse
+//	@Router			/organizations/{organizationId}/encryption-keys/rotate [post]
+//	@Security		JWT
+func (h *EncryptionKeyHandler) RotateEncryptionKey(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.RotateEncryptionKeyRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.EncryptionKey
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	encryptionKeyId, sealed, failed, err := h.usecase.Rotate(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.RotateEncryptionKeyResponse{
+		ID:            encryptionKeyId.String(),
+		SealedSecrets: sealed,
+		FailedSecrets: failed,
+	})
+}
+
+// RevokeEncryptionKey godoc
+//
+//	@Tags			Organizations
+//	@Summary		Revoke encryption key
+//	@Description	Revoke an encryption key. Secrets sealed with the revoked key can no longer be read.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			encryptionKeyId	path		string	true	"encryptionKeyId"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/encryption-keys/{encryptionKeyId}/revoke [post]
+//	@Security		JWT
+func (h *EncryptionKeyHandler) RevokeEncryptionKey(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	encryptionKeyId, err := uuid.Parse(vars["encryptionKeyId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid encryptionKeyId"), "O_INVALID_ENCRYPTION_KEY_ID", ""))
+		return
+	}
+
+	if err := h.usecase.Revoke(r.Context(), organizationId, encryptionKeyId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+type ErrorCodeHandler struct{}
+
+func NewErrorCodeHandler() *ErrorCodeHandler {
+	return &ErrorCodeHandler{}
+}
+
+// GetErrorCodes godoc
+//
+//	@Tags			ErrorCodes
+//	@Summary		Get error codes
+//	@Description	Get all error codes with their categories and default http status codes. Clients can branch on the stable code of error responses.
+//	@Accept			json
+//	@Produce		json
+//	@Param			category	query		string	false	"category"
+//	@Success		200			{object}	domain.GetErrorCodesResponse
+//	@Router			/error-codes [get]
+func (h *ErrorCodeHandler) GetErrorCodes(w http.ResponseWriter, r *http.Request) {
+	category := strings.ToUpper(r.URL.Query().Get("category"))
+
+	out := domain.GetErrorCodesResponse{
+		ErrorCodes: []domain.ErrorCodeResponse{},
+	}
+	for _, def := range httpErrors.ErrorDefinitions() {
+		if category != "" && string(def.Category) != category {
+			continue
+		}
+		out.ErrorCodes = append(out.ErrorCodes, domain.ErrorCodeResponse{
+			Code:     string(def.Code),
+			Category: string(def.Category),
+			Status:   def.Status,
+			Text:     def.Text,
+		})
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
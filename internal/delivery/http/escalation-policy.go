@@ -0,0 +1,231 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type EscalationPolicyHandler struct {
+	usecase usecase.IEscalationPolicyUsecase
+}
+
+func NewEscalationPolicyHandler(h usecase.Usecase) *EscalationPolicyHandler {
+	return &EscalationPolicyHandler{
+		usecase: h.EscalationPolicy,
+	}
+}
+
+// CreateEscalationPolicy godoc
+//
+//	@Tags			EscalationPolicies
+//	@Summary		Create escalation policy
+//	@Description	Create escalation policy. When an alert routed with the policy is not acknowledged within afterMinutes of each step, the targets of the step are notified again.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.CreateEscalationPolicyRequest	true	"create escalation policy request"
+//	@Success		200				{object}	domain.CreateEscalationPolicyResponse
+//	@Router			/organizations/{organizationId}/escalation-policies [post]
+//	@Security		JWT
+func (h *EscalationPolicyHandler) CreateEscalationPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID"))
+		return
+	}
+
+	input := domain.CreateEscalationPolicyRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.EscalationPolicy
+	if err := serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	escalationPolicyId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateEscalationPolicyResponse{ID: escalationPolicyId.String()})
+}
+
+// GetEscalationPolicies godoc
+//
+//	@Tags			EscalationPolicies
+//	@Summary		Get escalation policies
+//	@Description	Get escalation policies of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetEscalationPoliciesResponse
+//	@Router			/organizations/{organizationId}/escalation-policies [get]
+//	@Security		JWT
+func (h *EscalationPolicyHandler) GetEscalationPolicies(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID"))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	escalationPolicies, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetEscalationPoliciesResponse
+	out.EscalationPolicies = make([]domain.EscalationPolicyResponse, len(escalationPolicies))
+	for i, escalationPolicy := range escalationPolicies {
+		out.EscalationPolicies[i] = escalationPolicyResponse(r, escalationPolicy)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetEscalationPolicy godoc
+//
+//	@Tags			EscalationPolicies
+//	@Summary		Get escalation policy
+//	@Description	Get escalation policy
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			escalationPolicyId	path		string	true	"escalationPolicyId"
+//	@Success		200				{object}	domain.GetEscalationPolicyResponse
+//	@Router			/organizations/{organizationId}/escalation-policies/{escalationPolicyId} [get]
+//	@Security		JWT
+func (h *EscalationPolicyHandler) GetEscalationPolicy(w http.ResponseWriter, r *http.Request) {
+	organizationId, escalationPolicyId, err := escalationPolicyVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	escalationPolicy, err := h.usecase.Get(r.Context(), organizationId, escalationPolicyId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.GetEscalationPolicyResponse{EscalationPolicy: escalationPolicyResponse(r, escalationPolicy)})
+}
+
+// UpdateEscalationPolicy godoc
+//
+//	@Tags			EscalationPolicies
+//	@Summary		Update escalation policy
+//	@Description	Update escalation policy. Changed steps apply from the next escalation of alerts in progress.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string								true	"organizationId"
+//	@Param			escalationPolicyId	path	string								true	"escalationPolicyId"
+//	@Param			body			body	domain.UpdateEscalationPolicyRequest	true	"update escalation policy request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/escalation-policies/{escalationPolicyId} [put]
+//	@Security		JWT
+func (h *EscalationPolicyHandler) UpdateEscalationPolicy(w http.ResponseWriter, r *http.Request) {
+	organizationId, escalationPolicyId, err := escalationPolicyVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateEscalationPolicyRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.EscalationPolicy
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.ID = escalationPolicyId
+	dto.OrganizationId = organizationId
+
+	if err = h.usecase.Update(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteEscalationPolicy godoc
+//
+//	@Tags			EscalationPolicies
+//	@Summary		Delete escalation policy
+//	@Description	Delete escalation policy. The policy is detached from alert routing rules and escalations in progress are stopped.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			escalationPolicyId	path	string	true	"escalationPolicyId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/escalation-policies/{escalationPolicyId} [delete]
+//	@Security		JWT
+func (h *EscalationPolicyHandler) DeleteEscalationPolicy(w http.ResponseWriter, r *http.Request) {
+	organizationId, escalationPolicyId, err := escalationPolicyVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.Delete(r.Context(), organizationId, escalationPolicyId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func escalationPolicyVars(r *http.Request) (organizationId string, escalationPolicyId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
+	}
+	escalationPolicyId, err = uuid.Parse(vars["escalationPolicyId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewError(fmt.Errorf("Invalid escalationPolicyId"), "EP_INVALID_ESCALATION_POLICY_ID")
+	}
+	return organizationId, escalationPolicyId, nil
+}
+
+func escalationPolicyResponse(r *http.Request, escalationPolicy model.EscalationPolicy) (out domain.EscalationPolicyResponse) {
+	if err := serializer.Map(r.Context(), escalationPolicy, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+	if out.Severities == nil {
+		out.Severities = []string{}
+	}
+	return out
+}
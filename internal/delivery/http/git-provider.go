@@ -0,0 +1,227 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type GitProviderHandler struct {
+	usecase usecase.IGitProviderUsecase
+}
+
+func NewGitProviderHandler(h usecase.Usecase) *GitProviderHandler {
+	return &GitProviderHandler{
+		usecase: h.GitProvider,
+	}
+}
+
+// GetGitProviders godoc
+//
+//	@Tags			GitProviders
+//	@Summary		Get git providers
+//	@Description	Get GitHub and GitLab providers of the organization. Tokens are not returned
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetGitProvidersResponse
+//	@Router			/organizations/{organizationId}/git-providers [get]
+//	@Security		JWT
+func (h *GitProviderHandler) GetGitProviders(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID"))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	gitProviders, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetGitProvidersResponse
+	out.GitProviders = make([]domain.GitProviderResponse, len(gitProviders))
+	for i, gitProvider := range gitProviders {
+		if err := serializer.Map(r.Context(), gitProvider, &out.GitProviders[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetGitProvider godoc
+//
+//	@Tags			GitProviders
+//	@Summary		Get git provider
+//	@Description	Get git provider. The token is not returned
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			gitProviderId	path		string	true	"gitProviderId"
+//	@Success		200				{object}	domain.GetGitProviderResponse
+//	@Router			/organizations/{organizationId}/git-providers/{gitProviderId} [get]
+//	@Security		JWT
+func (h *GitProviderHandler) GetGitProvider(w http.ResponseWriter, r *http.Request) {
+	organizationId, gitProviderId, err := gitProviderVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	gitProvider, err := h.usecase.Get(r.Context(), organizationId, gitProviderId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetGitProviderResponse
+	if err := serializer.Map(r.Context(), gitProvider, &out.GitProvider); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// CreateGitProvider godoc
+//
+//	@Tags			GitProviders
+//	@Summary		Create git provider
+//	@Description	Register a GitHub or GitLab provider with an access token. The token is verified against the provider and sealed with the encryption key of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string							true	"organizationId"
+//	@Param			body			body		domain.CreateGitProviderRequest	true	"create git provider request"
+//	@Success		200				{object}	domain.CreateGitProviderResponse
+//	@Router			/organizations/{organizationId}/git-providers [post]
+//	@Security		JWT
+func (h *GitProviderHandler) CreateGitProvider(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID"))
+		return
+	}
+
+	input := domain.CreateGitProviderRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.GitProvider{
+		OrganizationId: organizationId,
+		Name:           input.Name,
+		Type:           input.Type,
+		Url:            input.Url,
+		Description:    input.Description,
+	}
+	gitProviderId, err := h.usecase.Create(r.Context(), dto, input.Token)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateGitProviderResponse{ID: gitProviderId.String()})
+}
+
+// UpdateGitProvider godoc
+//
+//	@Tags			GitProviders
+//	@Summary		Update git provider
+//	@Description	Update description or token of git provider. An empty token keeps the current token
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string							true	"organizationId"
+//	@Param			gitProviderId	path	string							true	"gitProviderId"
+//	@Param			body			body	domain.UpdateGitProviderRequest	true	"update git provider request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/git-providers/{gitProviderId} [put]
+//	@Security		JWT
+func (h *GitProviderHandler) UpdateGitProvider(w http.ResponseWriter, r *http.Request) {
+	organizationId, gitProviderId, err := gitProviderVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateGitProviderRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	dto := model.GitProvider{
+		ID:             gitProviderId,
+		OrganizationId: organizationId,
+		Description:    input.Description,
+	}
+	if err = h.usecase.Update(r.Context(), dto, input.Token); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteGitProvider godoc
+//
+//	@Tags			GitProviders
+//	@Summary		Delete git provider
+//	@Description	Delete git provider. A provider used as the git source of apps can not be deleted
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			gitProviderId	path	string	true	"gitProviderId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/git-providers/{gitProviderId} [delete]
+//	@Security		JWT
+func (h *GitProviderHandler) DeleteGitProvider(w http.ResponseWriter, r *http.Request) {
+	organizationId, gitProviderId, err := gitProviderVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.Delete(r.Context(), organizationId, gitProviderId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func gitProviderVars(r *http.Request) (organizationId string, gitProviderId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
+	}
+	gitProviderId, err = uuid.Parse(vars["gitProviderId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewError(fmt.Errorf("Invalid gitProviderId"), "GP_INVALID_GIT_PROVIDER_ID")
+	}
+	return organizationId, gitProviderId, nil
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 
@@ -17,14 +18,14 @@ import (
 
 // use a single instance of Validate, it caches struct info
 var (
-	validate *validator_.Validate
-	uni      *ut.UniversalTranslator
-	trans    ut.Translator
+	validate     *validator_.Validate
+	uni          *ut.UniversalTranslator
+	defaultTrans ut.Translator
 )
 
 func init() {
 	validate, uni = validator.NewValidator()
-	trans, _ = uni.GetTranslator("en")
+	defaultTrans = uni.GetFallback()
 }
 
 func ErrorJSON(w http.ResponseWriter, r *http.Request, err error) {
@@ -46,6 +47,38 @@ func ResponseJSON(w http.ResponseWriter, r *http.Request, httpStatus int, data i
 	}
 }
 
+// ResponseJSONStream writes {"<key>": [items...]} encoding one item at a time,
+// so that a large response is not marshaled into a single buffer.
+func ResponseJSONStream[T any](w http.ResponseWriter, r *http.Request, httpStatus int, key string, items []T) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(httpStatus)
+
+	flusher, _ := w.(http.Flusher)
+	enc := json.NewEncoder(w)
+	if _, err := fmt.Fprintf(w, "{%q:[", key); err != nil {
+		log.Error(r.Context(), err)
+		return
+	}
+	for i, item := range items {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				log.Error(r.Context(), err)
+				return
+			}
+		}
+		if err := enc.Encode(item); err != nil {
+			log.Error(r.Context(), err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+	if _, err := io.WriteString(w, "]}\n"); err != nil {
+		log.Error(r.Context(), err)
+	}
+}
+
 func UnmarshalRequestInput(r *http.Request, in any) error {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -53,37 +86,31 @@ func UnmarshalRequestInput(r *http.Request, in any) error {
 	}
 	r.Body = io.NopCloser(bytes.NewBuffer(body))
 
+	trans := validator.Translator(uni, r.Header.Get("Accept-Language"))
 	err = json.Unmarshal(body, &in)
 	if err != nil {
-		return err
-	}
-
-	err = validate.Struct(in)
-	if err != nil {
-		var valErrs validator_.ValidationErrors
-		if errors.As(err, &valErrs) {
-			for _, e := range valErrs {
-				return httpErrors.NewBadRequestError(err, "", e.Translate(trans))
-			}
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return httpErrors.NewValidationError(err, []httpErrors.FieldError{validator.TypeFieldError(trans, typeErr)})
 		}
+		return err
 	}
 
-	return nil
+	return validationError(validate.Struct(in), trans)
 }
 
 // Http Request가 아닌 경우에도 domain 객체 validate가 필요한 경우 호출
 // 예를 들어 정책의 match를 RawYaml로 전달받았을 경우 이를 domain.Match 객체로 unmarshalling 한 후 domain.Match를 이용해서 validate 가능
 func ValidateDomainObject(in any) error {
-	err := validate.Struct(in)
-	if err != nil {
-		var valErrs validator_.ValidationErrors
-		if errors.As(err, &valErrs) {
-			for _, e := range valErrs {
-				return httpErrors.NewBadRequestError(err, "", e.Translate(trans))
-			}
-		}
-	}
+	return validationError(validate.Struct(in), defaultTrans)
+}
 
+// validationError 는 모든 field 의 오류를 함께 반환하여 client 가 유효하지 않은 field 를 모두 표시할 수 있도록 한다.
+func validationError(err error, trans ut.Translator) error {
+	var valErrs validator_.ValidationErrors
+	if errors.As(err, &valErrs) && len(valErrs) > 0 {
+		return httpErrors.NewValidationError(err, validator.FieldErrors(uni, trans, valErrs))
+	}
 	return nil
 }
 
@@ -0,0 +1,321 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type LmaEndpointHandler struct {
+	usecase usecase.ILmaEndpointUsecase
+}
+
+func NewLmaEndpointHandler(h usecase.Usecase) *LmaEndpointHandler {
+	return &LmaEndpointHandler{
+		usecase: h.LmaEndpoint,
+	}
+}
+
+// GetLmaEndpoints godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get LMA endpoints of organization
+//	@Description	Get the LMA of the primary stack and the secondary LMA endpoints with their health. The active endpoint is used by dashboards.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetLmaEndpointsResponse
+//	@Router			/organizations/{organizationId}/lma-endpoints [get]
+//	@Security		JWT
+func (h *LmaEndpointHandler) GetLmaEndpoints(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	lmaEndpoints, err := h.usecase.Fetch(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetLmaEndpointsResponse
+	out.LmaEndpoints = make([]domain.LmaEndpointResponse, len(lmaEndpoints))
+	for i, lmaEndpoint := range lmaEndpoints {
+		if err := serializer.Map(r.Context(), lmaEndpoint, &out.LmaEndpoints[i]); err != nil {
+			log.Info(r.Context(), err)
+			continue
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// CreateLmaEndpoint godoc
+//
+//	@Tags			Organizations
+//	@Summary		Create LMA endpoint
+//	@Description	Add a secondary LMA(thanos) endpoint used when the LMA of the primary stack is unavailable
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string							true	"organizationId"
+//	@Param			body			body		domain.CreateLmaEndpointRequest	true	"create lma endpoint request"
+//	@Success		200				{object}	domain.CreateLmaEndpointResponse
+//	@Router			/organizations/{organizationId}/lma-endpoints [post]
+//	@Security		JWT
+func (h *LmaEndpointHandler) CreateLmaEndpoint(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateLmaEndpointRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.LmaEndpoint
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	lmaEndpointId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateLmaEndpointResponse{ID: lmaEndpointId.String()})
+}
+
+// UpdateLmaEndpoint godoc
+//
+//	@Tags			Organizations
+//	@Summary		Update LMA endpoint
+//	@Description	Update url, priority and description of a secondary LMA endpoint
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string							true	"organizationId"
+//	@Param			lmaEndpointId	path		string							true	"lmaEndpointId"
+//	@Param			body			body		domain.UpdateLmaEndpointRequest	true	"update lma endpoint request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/lma-endpoints/{lmaEndpointId} [put]
+//	@Security		JWT
+func (h *LmaEndpointHandler) UpdateLmaEndpoint(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	lmaEndpointId, err := uuid.Parse(vars["lmaEndpointId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid lmaEndpointId"), "O_INVALID_LMA_ENDPOINT_ID", ""))
+		return
+	}
+
+	input := domain.UpdateLmaEndpointRequest{}
+	err = UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.LmaEndpoint
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.ID = lmaEndpointId
+	dto.OrganizationId = organizationId
+
+	if err := h.usecase.Update(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteLmaEndpoint godoc
+//
+//	@Tags			Organizations
+//	@Summary		Delete LMA endpoint
+//	@Description	Delete a secondary LMA endpoint
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			lmaEndpointId	path		string	true	"lmaEndpointId"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/lma-endpoints/{lmaEndpointId} [delete]
+//	@Security		JWT
+func (h *LmaEndpointHandler) DeleteLmaEndpoint(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	lmaEndpointId, err := uuid.Parse(vars["lmaEndpointId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid lmaEndpointId"), "O_INVALID_LMA_ENDPOINT_ID", ""))
+		return
+	}
+
+	if err := h.usecase.Delete(r.Context(), organizationId, lmaEndpointId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// GetLmaAuth godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get LMA auth settings of organization
+//	@Description	Get the TLS and authentication settings used to connect to the LMA(thanos) of organization. Password and bearer token are not returned.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetLmaAuthResponse
+//	@Router			/organizations/{organizationId}/lma-auth [get]
+//	@Security		JWT
+func (h *LmaEndpointHandler) GetLmaAuth(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	lmaAuth, err := h.usecase.GetAuth(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetLmaAuthResponse
+	if err := serializer.Map(r.Context(), lmaAuth, &out.LmaAuth); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateLmaAuth godoc
+//
+//	@Tags			Organizations
+//	@Summary		Update LMA auth settings of organization
+//	@Description	Update the CA bundle, basic auth and bearer token used to connect to the LMA(thanos) of organization. Omitted password or bearer token keeps the current value, and an empty string clears it.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string						true	"organizationId"
+//	@Param			body			body		domain.UpdateLmaAuthRequest	true	"update lma auth request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/lma-auth [put]
+//	@Security		JWT
+func (h *LmaEndpointHandler) UpdateLmaAuth(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdateLmaAuthRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.UpdateAuth(r.Context(), organizationId, input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// GetLmaDiscovery godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get LMA discovery settings of organization
+//	@Description	Get how the LMA(thanos) address is discovered in the primary cluster of organization. AUTO is returned when not configured.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetLmaDiscoveryResponse
+//	@Router			/organizations/{organizationId}/lma-discovery [get]
+//	@Security		JWT
+func (h *LmaEndpointHandler) GetLmaDiscovery(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	lmaDiscovery, err := h.usecase.GetDiscovery(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetLmaDiscoveryResponse
+	if err := serializer.Map(r.Context(), lmaDiscovery, &out.LmaDiscovery); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateLmaDiscovery godoc
+//
+//	@Tags			Organizations
+//	@Summary		Update LMA discovery settings of organization
+//	@Description	Update how the LMA(thanos) address is discovered in the primary cluster of organization. AUTO tries LoadBalancer, Ingress, NodePort and ClusterIP through the API server proxy in order.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.UpdateLmaDiscoveryRequest	true	"update lma discovery request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/lma-discovery [put]
+//	@Security		JWT
+func (h *LmaEndpointHandler) UpdateLmaDiscovery(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdateLmaDiscoveryRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := h.usecase.UpdateDiscovery(r.Context(), organizationId, input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
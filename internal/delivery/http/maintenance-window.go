@@ -0,0 +1,297 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// 조회 기간을 지정하지 않으면 지금부터 30일 동안의 일정을 조회한다.
+const defaultMaintenanceWindowPeriod = 30 * 24 * time.Hour
+
+type MaintenanceWindowHandler struct {
+	usecase usecase.IMaintenanceWindowUsecase
+}
+
+func NewMaintenanceWindowHandler(h usecase.Usecase) *MaintenanceWindowHandler {
+	return &MaintenanceWindowHandler{
+		usecase: h.MaintenanceWindow,
+	}
+}
+
+// CreateMaintenanceWindow godoc
+//
+//	@Tags			MaintenanceWindows
+//	@Summary		Create maintenance window
+//	@Description	Schedule a maintenance window of the stack. While it is active, alert notifications of the stack are suppressed and only organization admins can deploy to the stack.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string									true	"organizationId"
+//	@Param			stackId			path		string									true	"stackId"
+//	@Param			body			body		domain.CreateMaintenanceWindowRequest	true	"create maintenance window request"
+//	@Success		200				{object}	domain.CreateMaintenanceWindowResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/maintenance-windows [post]
+//	@Security		JWT
+func (h *MaintenanceWindowHandler) CreateMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := deploymentApprovalPolicyVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.CreateMaintenanceWindowRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.MaintenanceWindow
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+	dto.ClusterId = stackId
+
+	maintenanceWindowId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateMaintenanceWindowResponse{ID: maintenanceWindowId.String()})
+}
+
+// GetMaintenanceWindows godoc
+//
+//	@Tags			MaintenanceWindows
+//	@Summary		Get maintenance windows of the stack
+//	@Description	Get maintenance windows of the stack overlapping the period. The period defaults to the next 30 days.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			stackId			path		string		true	"stackId"
+//	@Param			from			query		string		false	"start time (RFC3339)"
+//	@Param			to				query		string		false	"end time (RFC3339)"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Success		200				{object}	domain.GetMaintenanceWindowsResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/maintenance-windows [get]
+//	@Security		JWT
+func (h *MaintenanceWindowHandler) GetMaintenanceWindows(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := deploymentApprovalPolicyVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	h.fetchMaintenanceWindows(w, r, organizationId, stackId)
+}
+
+// GetOrganizationMaintenanceWindows godoc
+//
+//	@Tags			MaintenanceWindows
+//	@Summary		Get maintenance windows of the organization
+//	@Description	Get maintenance windows of all stacks in the organization overlapping the period. The period defaults to the next 30 days.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			from			query		string		false	"start time (RFC3339)"
+//	@Param			to				query		string		false	"end time (RFC3339)"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Success		200				{object}	domain.GetMaintenanceWindowsResponse
+//	@Router			/organizations/{organizationId}/maintenance-windows [get]
+//	@Security		JWT
+func (h *MaintenanceWindowHandler) GetOrganizationMaintenanceWindows(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	h.fetchMaintenanceWindows(w, r, organizationId, "")
+}
+
+// GetMaintenanceWindow godoc
+//
+//	@Tags			MaintenanceWindows
+//	@Summary		Get maintenance window
+//	@Description	Get maintenance window
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"organizationId"
+//	@Param			stackId				path		string	true	"stackId"
+//	@Param			maintenanceWindowId	path		string	true	"maintenanceWindowId"
+//	@Success		200					{object}	domain.GetMaintenanceWindowResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/maintenance-windows/{maintenanceWindowId} [get]
+//	@Security		JWT
+func (h *MaintenanceWindowHandler) GetMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	organizationId, maintenanceWindowId, err := maintenanceWindowVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	maintenanceWindow, err := h.usecase.Get(r.Context(), organizationId, maintenanceWindowId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetMaintenanceWindowResponse
+	if err := serializer.Map(r.Context(), maintenanceWindow, &out.MaintenanceWindow); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateMaintenanceWindow godoc
+//
+//	@Tags			MaintenanceWindows
+//	@Summary		Update maintenance window
+//	@Description	Update the period and description of the maintenance window
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string									true	"organizationId"
+//	@Param			stackId				path		string									true	"stackId"
+//	@Param			maintenanceWindowId	path		string									true	"maintenanceWindowId"
+//	@Param			body				body		domain.UpdateMaintenanceWindowRequest	true	"update maintenance window request"
+//	@Success		200					{object}	nil
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/maintenance-windows/{maintenanceWindowId} [put]
+//	@Security		JWT
+func (h *MaintenanceWindowHandler) UpdateMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	organizationId, maintenanceWindowId, err := maintenanceWindowVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateMaintenanceWindowRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.MaintenanceWindow
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.ID = maintenanceWindowId
+	dto.OrganizationId = organizationId
+
+	if err = h.usecase.Update(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteMaintenanceWindow godoc
+//
+//	@Tags			MaintenanceWindows
+//	@Summary		Delete maintenance window
+//	@Description	Delete maintenance window. Deleting an active window ends the maintenance immediately.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId		path		string	true	"organizationId"
+//	@Param			stackId				path		string	true	"stackId"
+//	@Param			maintenanceWindowId	path		string	true	"maintenanceWindowId"
+//	@Success		200					{object}	nil
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/maintenance-windows/{maintenanceWindowId} [delete]
+//	@Security		JWT
+func (h *MaintenanceWindowHandler) DeleteMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	organizationId, maintenanceWindowId, err := maintenanceWindowVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.Delete(r.Context(), organizationId, maintenanceWindowId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// fetchMaintenanceWindows 는 달력에 표시하기 쉽도록 sortColumn 을 지정하지 않으면 시작 시각 순으로 정렬한다.
+func (h *MaintenanceWindowHandler) fetchMaintenanceWindows(w http.ResponseWriter, r *http.Request, organizationId string, stackId domain.ClusterId) {
+	urlParams := r.URL.Query()
+	from, to, err := maintenanceWindowPeriod(urlParams)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	if urlParams.Get(pagination.SORT_COLUMN) == "" {
+		urlParams.Set(pagination.SORT_COLUMN, "start_at")
+		urlParams.Set(pagination.SORT_ORDER, "ASC")
+	}
+
+	pg := pagination.NewPagination(&urlParams)
+	maintenanceWindows, err := h.usecase.Fetch(r.Context(), organizationId, stackId, from, to, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetMaintenanceWindowsResponse
+	out.MaintenanceWindows = make([]domain.MaintenanceWindowResponse, len(maintenanceWindows))
+	for i, maintenanceWindow := range maintenanceWindows {
+		if err := serializer.Map(r.Context(), maintenanceWindow, &out.MaintenanceWindows[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func maintenanceWindowPeriod(urlParams url.Values) (from time.Time, to time.Time, err error) {
+	from = time.Now()
+	if v := urlParams.Get("from"); v != "" {
+		if from, err = time.Parse(time.RFC3339, v); err != nil {
+			return time.Time{}, time.Time{}, httpErrors.NewBadRequestError(fmt.Errorf("Invalid from"), "C_INVALID_QUERY_PARAM", "")
+		}
+	}
+	to = from.Add(defaultMaintenanceWindowPeriod)
+	if v := urlParams.Get("to"); v != "" {
+		if to, err = time.Parse(time.RFC3339, v); err != nil {
+			return time.Time{}, time.Time{}, httpErrors.NewBadRequestError(fmt.Errorf("Invalid to"), "C_INVALID_QUERY_PARAM", "")
+		}
+	}
+	return from, to, nil
+}
+
+func maintenanceWindowVars(r *http.Request) (organizationId string, maintenanceWindowId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	maintenanceWindowId, err = uuid.Parse(vars["maintenanceWindowId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid maintenanceWindowId"), "MW_INVALID_MAINTENANCE_WINDOW_ID", "")
+	}
+	return organizationId, maintenanceWindowId, nil
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"gopkg.in/yaml.v3"
+)
+
+type ManifestHandler struct {
+	usecase usecase.IManifestUsecase
+}
+
+func NewManifestHandler(h usecase.Usecase) *ManifestHandler {
+	return &ManifestHandler{
+		usecase: h.Manifest,
+	}
+}
+
+// ExportManifests godoc
+//
+//	@Tags			Organizations
+//	@Summary		Export manifests
+//	@Description	Export organization, roles, stack templates, policy templates and system notification rules as multi-document YAML manifests
+//	@Accept			json
+//	@Produce		application/x-yaml
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			kinds			query		string	false	"comma separated kinds (Organization,Role,StackTemplate,PolicyTemplate,SystemNotificationRule)"
+//	@Success		200				{string}	string	"manifests"
+//	@Router			/organizations/{organizationId}/manifests [get]
+//	@Security		JWT
+func (h *ManifestHandler) ExportManifests(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	kinds := make([]domain.ManifestKind, 0)
+	if v := r.URL.Query().Get("kinds"); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			kind := domain.ManifestKind(strings.TrimSpace(s))
+			if !kind.Validate() {
+				ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid kind %s", kind), "MF_INVALID_KIND", ""))
+				return
+			}
+			kinds = append(kinds, kind)
+		}
+	}
+
+	manifests, err := h.usecase.Export(r.Context(), organizationId, kinds)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	for _, manifest := range manifests {
+		if err := encoder.Encode(manifest); err != nil {
+			ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
+			return
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-yaml")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.Error(r.Context(), err)
+	}
+}
+
+// ApplyManifests godoc
+//
+//	@Tags			Organizations
+//	@Summary		Apply manifests
+//	@Description	Compare multi-document YAML manifests with current resources and create or update only the changed ones. Applying the same manifests again makes no changes. Each manifest is validated against the target organization first (id collisions, missing templates or users, version mismatches). Manifests with ERROR issues are not applied. Use dryRun to review what would be created before restoring an exported bundle.
+//	@Accept			application/x-yaml
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			dryRun			query		bool	false	"only report the changes without applying them"
+//	@Param			body			body		string	true	"manifests"
+//	@Success		200				{object}	domain.ApplyManifestsResponse
+//	@Router			/organizations/{organizationId}/manifests/apply [post]
+//	@Security		JWT
+func (h *ManifestHandler) ApplyManifests(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	manifests := make([]domain.Manifest, 0)
+	decoder := yaml.NewDecoder(r.Body)
+	for {
+		var manifest domain.Manifest
+		err := decoder.Decode(&manifest)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(err, "MF_INVALID_MANIFEST", ""))
+			return
+		}
+		// 빈 문서(--- 만 있는 경우)는 무시한다.
+		if manifest.Kind == "" && manifest.Metadata.Name == "" {
+			continue
+		}
+		manifests = append(manifests, manifest)
+	}
+
+	dryRun := helper.IsDryRun(r)
+	results, err := h.usecase.Apply(r.Context(), organizationId, manifests, dryRun)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.ApplyManifestsResponse{
+		DryRun:  dryRun,
+		Summary: domain.NewManifestApplySummary(results),
+		Results: results,
+	})
+}
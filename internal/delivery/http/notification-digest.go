@@ -0,0 +1,103 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+type NotificationDigestHandler struct {
+	usecase usecase.INotificationDigestUsecase
+}
+
+func NewNotificationDigestHandler(h usecase.Usecase) *NotificationDigestHandler {
+	return &NotificationDigestHandler{
+		usecase: h.NotificationDigest,
+	}
+}
+
+// GetMyNotificationDigestSettings godoc
+//
+//	@Tags			My-profile
+//	@Summary		Get my notification digest settings
+//	@Description	Get my notification digest settings per channel. Non-critical notifications are batched and sent as a summary unless the mode is IMMEDIATE.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetNotificationDigestSettingsResponse
+//	@Router			/organizations/{organizationId}/my-profile/notification-digest [get]
+//	@Security		JWT
+func (h *NotificationDigestHandler) GetMyNotificationDigestSettings(w http.ResponseWriter, r *http.Request) {
+	requestUserInfo, ok := request.UserFrom(r.Context())
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("user not found in request"), "A_INVALID_TOKEN", ""))
+		return
+	}
+
+	settings, err := h.usecase.GetSettings(r.Context(), requestUserInfo.GetUserId())
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetNotificationDigestSettingsResponse
+	out.Settings = make([]domain.NotificationDigestSettingResponse, len(settings))
+	for i, setting := range settings {
+		out.Settings[i] = domain.NotificationDigestSettingResponse{
+			Channel: setting.Channel,
+			Mode:    setting.Mode,
+		}
+		if !setting.UpdatedAt.IsZero() {
+			updatedAt := setting.UpdatedAt
+			out.Settings[i].UpdatedAt = &updatedAt
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateMyNotificationDigestSettings godoc
+//
+//	@Tags			My-profile
+//	@Summary		Update my notification digest settings
+//	@Description	Update my notification digest settings per channel. Critical notifications are always sent immediately.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string											true	"organizationId"
+//	@Param			body			body	domain.UpdateNotificationDigestSettingsRequest	true	"update notification digest settings request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/my-profile/notification-digest [put]
+//	@Security		JWT
+func (h *NotificationDigestHandler) UpdateMyNotificationDigestSettings(w http.ResponseWriter, r *http.Request) {
+	requestUserInfo, ok := request.UserFrom(r.Context())
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("user not found in request"), "A_INVALID_TOKEN", ""))
+		return
+	}
+
+	input := domain.UpdateNotificationDigestSettingsRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	settings := make([]model.NotificationDigestSetting, len(input.Settings))
+	for i, setting := range input.Settings {
+		settings[i] = model.NotificationDigestSetting{
+			Channel: setting.Channel,
+			Mode:    setting.Mode,
+		}
+	}
+
+	if err := h.usecase.UpdateSettings(r.Context(), requestUserInfo.GetOrganizationId(), requestUserInfo.GetUserId(), settings); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
@@ -0,0 +1,166 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type OperationHandler struct {
+	usecase usecase.IOperationUsecase
+}
+
+func NewOperationHandler(h usecase.Usecase) *OperationHandler {
+	return &OperationHandler{
+		usecase: h.Operation,
+	}
+}
+
+// GetOperations godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get operations
+//	@Description	Get stack creations/deletions and app deployments of the organization including the ones waiting for the concurrent operation limit
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetOperationsResponse
+//	@Router			/organizations/{organizationId}/operations [get]
+//	@Security		JWT
+func (h *OperationHandler) GetOperations(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	operations, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetOperationsResponse
+	out.Operations = make([]domain.OperationResponse, len(operations))
+	for i, operation := range operations {
+		if err := serializer.Map(r.Context(), operation, &out.Operations[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// CancelOperation godoc
+//
+//	@Tags			Organizations
+//	@Summary		Cancel operation
+//	@Description	Cancel a pending operation before its workflow is submitted
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			operationId		path		string	true	"operationId"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/operations/{operationId}/cancel [post]
+//	@Security		JWT
+func (h *OperationHandler) CancelOperation(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	operationId, err := uuid.Parse(vars["operationId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid operationId"), "OP_INVALID_OPERATION_ID", ""))
+		return
+	}
+
+	if err := h.usecase.Cancel(r.Context(), organizationId, operationId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// GetTask godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get task
+//	@Description	Get the progress of a long-running operation(stack creation/deletion/scaling/upgrade, app group installation/uninstallation, app deployment) by the taskId returned when it was requested. Steps and the last lines of the workflow logs are kept after the operation finishes.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			taskId			path		string	true	"taskId"
+//	@Success		200				{object}	domain.GetTaskResponse
+//	@Router			/organizations/{organizationId}/tasks/{taskId} [get]
+//	@Security		JWT
+func (h *OperationHandler) GetTask(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	taskId, err := uuid.Parse(vars["taskId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid taskId"), "OP_INVALID_OPERATION_ID", ""))
+		return
+	}
+
+	operation, err := h.usecase.GetTask(r.Context(), organizationId, taskId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetTaskResponse
+	if err := serializer.Map(r.Context(), operation, &out.Task); err != nil {
+		log.Info(r.Context(), err)
+	}
+	out.Task.Phase = operation.Status
+	out.Task.Steps = []domain.TaskStepResponse{}
+	if len(operation.Steps) > 0 {
+		if err := json.Unmarshal(operation.Steps, &out.Task.Steps); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+	out.Task.Logs = []string{}
+	if operation.LogExcerpt != "" {
+		out.Task.Logs = strings.Split(operation.LogExcerpt, "\n")
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// operationStatusCode 는 대기열에 들어간 operation 이면 202 를, 바로 제출된 operation 이면 200 을 반환한다.
+func operationStatusCode(operation model.Operation) int {
+	if operation.Status == domain.OperationStatus_PENDING {
+		return http.StatusAccepted
+	}
+	return http.StatusOK
+}
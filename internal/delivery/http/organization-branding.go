@@ -0,0 +1,118 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// GetOrganizationBranding godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get organization branding
+//	@Description	Get branding settings of the organization. Empty fields mean the default branding is used.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetOrganizationBrandingResponse
+//	@Router			/organizations/{organizationId}/settings/branding [get]
+//	@Security		JWT
+func (h *OrganizationHandler) GetOrganizationBranding(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	branding, err := h.usecase.GetBranding(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetOrganizationBrandingResponse
+	if err := serializer.Map(r.Context(), branding, &out.Branding); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateOrganizationBranding godoc
+//
+//	@Tags			Organizations
+//	@Summary		Update organization branding
+//	@Description	Update display name, logo, support contact and email footer used in emails and login/invitation pages of the organization.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string										true	"organizationId"
+//	@Param			body			body		domain.UpdateOrganizationBrandingRequest	true	"Update organization branding request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/settings/branding [put]
+//	@Security		JWT
+func (h *OrganizationHandler) UpdateOrganizationBranding(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdateOrganizationBrandingRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.OrganizationBranding
+	if err := serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	if err := h.usecase.UpdateBranding(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// GetPublicOrganizationBranding godoc
+//
+//	@Tags			Auth
+//	@Summary		Get organization branding for login and invitation pages
+//	@Description	Get branding of the organization without authentication. Empty fields mean the default branding is used.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.PublicOrganizationBrandingResponse
+//	@Router			/auth/organizations/{organizationId}/branding [get]
+func (h *OrganizationHandler) GetPublicOrganizationBranding(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	branding, err := h.usecase.GetBranding(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.PublicOrganizationBrandingResponse
+	if err := serializer.Map(r.Context(), branding, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// GetNamingPolicies godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get naming policies
+//	@Description	Get naming policies of stacks, apps and projects of the organization. An empty policy means names are not restricted.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetNamingPoliciesResponse
+//	@Router			/organizations/{organizationId}/settings/naming-policies [get]
+//	@Security		JWT
+func (h *OrganizationHandler) GetNamingPolicies(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policies, err := h.usecase.GetNamingPolicies(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetNamingPoliciesResponse
+	out.NamingPolicies = make([]domain.NamingPolicyResponse, len(policies))
+	for i, policy := range policies {
+		if err := serializer.Map(r.Context(), policy, &out.NamingPolicies[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateNamingPolicy godoc
+//
+//	@Tags			Organizations
+//	@Summary		Update naming policy
+//	@Description	Update naming policy of a resource type (STACK, APP or PROJECT). It is applied when the resource is created. Empty pattern, prefixes and reserved names remove the policy.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			resourceType	path		string								true	"STACK, APP or PROJECT"
+//	@Param			body			body		domain.UpdateNamingPolicyRequest	true	"Update naming policy request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/settings/naming-policies/{resourceType} [put]
+//	@Security		JWT
+func (h *OrganizationHandler) UpdateNamingPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	resourceType, ok := vars["resourceType"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid resourceType"), "O_INVALID_NAMING_POLICY", ""))
+		return
+	}
+
+	input := domain.UpdateNamingPolicyRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.NamingPolicy
+	if err := serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+	dto.ResourceType = resourceType
+
+	if err := h.usecase.UpdateNamingPolicy(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
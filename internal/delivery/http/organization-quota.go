@@ -0,0 +1,88 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// GetOrganizationQuota godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get organization quota
+//	@Description	Get quota of the organization with current usage. Zero means unlimited.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetOrganizationQuotaResponse
+//	@Router			/organizations/{organizationId}/quotas [get]
+//	@Router			/admin/organizations/{organizationId}/quotas [get]
+//	@Security		JWT
+func (h *OrganizationHandler) GetOrganizationQuota(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	quota, usage, err := h.usecase.GetQuota(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetOrganizationQuotaResponse
+	if err := serializer.Map(r.Context(), quota, &out.Quota); err != nil {
+		log.Info(r.Context(), err)
+	}
+	out.Quota.Usage = usage
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// Admin_UpdateOrganizationQuota godoc
+//
+//	@Tags			Organizations
+//	@Summary		Update organization quota
+//	@Description	Update quota of the organization. Zero means unlimited. A quota below current usage only blocks further creation.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string									true	"organizationId"
+//	@Param			body			body		domain.UpdateOrganizationQuotaRequest	true	"Update organization quota request"
+//	@Success		200				{object}	nil
+//	@Router			/admin/organizations/{organizationId}/quotas [put]
+//	@Security		JWT
+func (h *OrganizationHandler) Admin_UpdateOrganizationQuota(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdateOrganizationQuotaRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.OrganizationQuota
+	if err := serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	if err := h.usecase.UpdateQuota(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// GetOrganizationUsage godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get organization usage
+//	@Description	Get api requests and rate limited requests of the last 24 hours and delivery success rates of alert channels, audit sinks and stack webhooks of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetOrganizationUsageResponse
+//	@Router			/organizations/{organizationId}/usage [get]
+//	@Security		JWT
+func (h *OrganizationHandler) GetOrganizationUsage(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	usage, err := h.usecase.GetUsage(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.GetOrganizationUsageResponse{Usage: usage})
+}
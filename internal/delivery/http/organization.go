@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
@@ -271,10 +272,11 @@ func (h *OrganizationHandler) GetOrganization(w http.ResponseWriter, r *http.Req
 //
 //	@Tags			Organizations
 //	@Summary		Delete organization
-//	@Description	Delete organization
+//	@Description	Delete organization with its users, projects and alerts in order. Stacks, cloud accounts and running operations must be cleaned up first. With dryRun, returns what would be removed without deleting.
 //	@Accept			json
 //	@Produce		json
 //	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			dryRun			query		bool	false	"return deletion plan only"
 //	@Success		200				{object}	domain.DeleteOrganizationResponse
 //	@Router			/organizations/{organizationId} [delete]
 //	@Security		JWT
@@ -292,16 +294,17 @@ func (h *OrganizationHandler) Admin_DeleteOrganization(w http.ResponseWriter, r
 		return
 	}
 
-	err := h.userUsecase.DeleteAll(r.Context(), organizationId)
-	if err != nil {
-		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
-
-		ErrorJSON(w, r, err)
-		return
+	dryRun := false
+	if v := r.URL.Query().Get("dryRun"); v != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid dryRun"), "", ""))
+			return
+		}
 	}
 
-	// organization 삭제
-	err = h.usecase.Delete(r.Context(), organizationId, token)
+	// organization 삭제. 사용자, 프로젝트, 알림 등 조직의 리소스도 함께 삭제한다.
+	out, err := h.usecase.Delete(r.Context(), organizationId, token, dryRun)
 	if err != nil {
 		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
 		if _, status := httpErrors.ErrorResponse(err); status == http.StatusNotFound {
@@ -312,9 +315,6 @@ func (h *OrganizationHandler) Admin_DeleteOrganization(w http.ResponseWriter, r
 		return
 	}
 
-	out := domain.DeleteOrganizationResponse{
-		ID: organizationId,
-	}
 	ResponseJSON(w, r, http.StatusOK, out)
 }
 
@@ -362,6 +362,44 @@ func (h *OrganizationHandler) UpdateOrganization(w http.ResponseWriter, r *http.
 	ResponseJSON(w, r, http.StatusOK, out)
 }
 
+// GetOrganizationOnboarding godoc
+//
+//	@Tags			Organizations
+//	@Summary		Get onboarding status of organization
+//	@Description	Get completion of the required setup steps of organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetOrganizationOnboardingResponse
+//	@Router			/organizations/{organizationId}/onboarding [get]
+//	@Security		JWT
+func (h *OrganizationHandler) GetOrganizationOnboarding(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	steps, err := h.usecase.GetOnboarding(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetOrganizationOnboardingResponse
+	out.Steps = steps
+	out.Completed = true
+	for _, step := range steps {
+		if !step.Completed {
+			out.Completed = false
+			break
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
 // UpdatePrimaryCluster godoc
 //
 //	@Tags			Organizations
@@ -0,0 +1,107 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// EnrollMyOtp godoc
+//
+//	@Tags			My-profile
+//	@Summary		Enroll TOTP two-factor authentication
+//	@Description	Issue a new TOTP secret. Register the otpauth URI to an authenticator app and activate it with a code. Previous pending enrollment is replaced.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.EnrollMyOtpResponse
+//	@Router			/organizations/{organizationId}/my-profile/otp [post]
+//	@Security		JWT
+func (u UserHandler) EnrollMyOtp(w http.ResponseWriter, r *http.Request) {
+	requestUserInfo, ok := request.UserFrom(r.Context())
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("user not found in request"), "A_INVALID_TOKEN", ""))
+		return
+	}
+
+	secret, otpauthUri, err := u.usecase.EnrollOtp(r.Context(), requestUserInfo.GetUserId())
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out := domain.EnrollMyOtpResponse{
+		Secret:     secret,
+		OtpauthUri: otpauthUri,
+	}
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// ActivateMyOtp godoc
+//
+//	@Tags			My-profile
+//	@Summary		Activate TOTP two-factor authentication
+//	@Description	Activate the enrolled TOTP secret with a code from the authenticator app. The code is required on login afterwards.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string						true	"organizationId"
+//	@Param			body			body	domain.ActivateMyOtpRequest	true	"otp code"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/my-profile/otp [put]
+//	@Security		JWT
+func (u UserHandler) ActivateMyOtp(w http.ResponseWriter, r *http.Request) {
+	requestUserInfo, ok := request.UserFrom(r.Context())
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("user not found in request"), "A_INVALID_TOKEN", ""))
+		return
+	}
+
+	input := domain.ActivateMyOtpRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := u.usecase.ActivateOtp(r.Context(), requestUserInfo.GetUserId(), input.Code); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DisableMyOtp godoc
+//
+//	@Tags			My-profile
+//	@Summary		Disable TOTP two-factor authentication
+//	@Description	Disable TOTP two-factor authentication with a current code. It is not allowed when the organization requires two-factor authentication.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string						true	"organizationId"
+//	@Param			body			body	domain.DisableMyOtpRequest	true	"otp code"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/my-profile/otp [delete]
+//	@Security		JWT
+func (u UserHandler) DisableMyOtp(w http.ResponseWriter, r *http.Request) {
+	requestUserInfo, ok := request.UserFrom(r.Context())
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("user not found in request"), "A_INVALID_TOKEN", ""))
+		return
+	}
+
+	input := domain.DisableMyOtpRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err := u.usecase.DisableOtp(r.Context(), requestUserInfo.GetUserId(), input.Code); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
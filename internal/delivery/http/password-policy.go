@@ -0,0 +1,86 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// GetPasswordPolicy godoc
+//
+//	@Tags			Users
+//	@Summary		Get password policy
+//	@Description	Get password policy of the organization. The default policy is returned when the organization has not set one.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetPasswordPolicyResponse
+//	@Router			/organizations/{organizationId}/password-policy [get]
+//	@Security		JWT
+func (u UserHandler) GetPasswordPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policy, err := u.usecase.GetPasswordPolicy(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetPasswordPolicyResponse
+	if err := serializer.Map(r.Context(), policy, &out.PasswordPolicy); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdatePasswordPolicy godoc
+//
+//	@Tags			Users
+//	@Summary		Update password policy
+//	@Description	Update password policy of the organization. It is applied when users are created or change their password.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.UpdatePasswordPolicyRequest	true	"Update password policy request"
+//	@Success		200				{object}	nil
+//	@Router			/organizations/{organizationId}/password-policy [put]
+//	@Security		JWT
+func (u UserHandler) UpdatePasswordPolicy(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdatePasswordPolicyRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.PasswordPolicy
+	if err := serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	if err := u.usecase.UpdatePasswordPolicy(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// SearchPods godoc
+//
+//	@Tags			Clusters
+//	@Summary		Search pods across clusters
+//	@Description	조직의 모든 RUNNING 클러스터에서 이름(부분 일치) 또는 label selector 로 pod 를 검색한다. 조회에 실패한 클러스터는 clusters 의 error 에 사유를 담는다.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			name			query		string	false	"pod name (substring)"
+//	@Param			labelSelector	query		string	false	"label selector"
+//	@Param			namespace		query		string	false	"namespace"
+//	@Param			limit			query		int		false	"max number of pods (default 100, max 500)"
+//	@Success		200				{object}	domain.SearchPodsResponse
+//	@Router			/organizations/{organizationId}/pods [get]
+//	@Security		JWT
+func (h *ClusterHandler) SearchPods(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	query := r.URL.Query()
+	req := domain.SearchPodsRequest{
+		Name:          query.Get("name"),
+		LabelSelector: query.Get("labelSelector"),
+		Namespace:     query.Get("namespace"),
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid limit"), "CL_INVALID_POD_SEARCH_QUERY", ""))
+			return
+		}
+		req.Limit = limit
+	}
+
+	out, err := h.usecase.SearchPods(r.Context(), organizationId, req)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
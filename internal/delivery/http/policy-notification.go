@@ -39,6 +39,7 @@ func NewPolicyNotificationHandler(h usecase.Usecase) *PolicyNotificationHandler
 //	@Param			soertColumn		query		string		false	"sortColumn"
 //	@Param			sortOrder		query		string		false	"sortOrder"
 //	@Param			filters			query		[]string	false	"filters"
+//	@Param			cursor			query		string		false	"cursor (nextCursor of the previous page, empty for the first page)"
 //	@Success		200				{object}	domain.GetPolicyNotificationsResponse
 //	@Router			/organizations/{organizationId}/policy-notifications [get]
 //	@Security		JWT
@@ -111,7 +111,7 @@ func (p ProjectHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
 
 	projectId, err := p.usecase.CreateProject(r.Context(), project)
 	if err != nil {
-		ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
+		ErrorJSON(w, r, err)
 		return
 	}
 
@@ -471,10 +471,39 @@ func (p ProjectHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
 	ResponseJSON(w, r, http.StatusOK, domain.CommonProjectResponse{Result: "OK"})
 }
 
+// DeleteProject godoc
+//
+//	@Tags			Projects
+//	@Summary		Delete project
+//	@Description	Delete project. All namespaces of the project must be deleted first.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Param			projectId		path		string	true	"Project ID"
+//	@Success		200				{object}	domain.CommonProjectResponse
+//	@Router			/organizations/{organizationId}/projects/{projectId} [delete]
+//	@Security		JWT
 func (p ProjectHandler) DeleteProject(w http.ResponseWriter, r *http.Request) {
-	//ToDo: to donggyu. implement cleanup logic for k8s & keycloak
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("organizationId not found in path"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	projectId, ok := vars["projectId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid projectId"),
+			"C_INVALID_PROJECT_ID", ""))
+		return
+	}
 
-	//TODO implement me
+	if err := p.usecase.DeleteProject(r.Context(), organizationId, projectId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CommonProjectResponse{Result: "OK"})
 }
 
 // GetProjectRole godoc
@@ -1336,7 +1365,7 @@ func (p ProjectHandler) CreateProjectNamespace(w http.ResponseWriter, r *http.Re
 	// tasks for keycloak & k8s
 	// ToDo: Check if the namespace is already created
 	if err := p.usecase.EnsureNamespaceForCluster(r.Context(), organizationId, projectNamespaceReq.StackId, projectNamespaceReq.Namespace); err != nil {
-		ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
+		ErrorJSON(w, r, err)
 		return
 	}
 
@@ -1634,52 +1663,71 @@ func (p ProjectHandler) UpdateProjectNamespace(w http.ResponseWriter, r *http.Re
 //	@Router			/organizations/{organizationId}/projects/{projectId}/namespaces/{projectNamespace}/stacks/{stackId} [delete]
 //	@Security		JWT
 func (p ProjectHandler) DeleteProjectNamespace(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("organizationId not found in path"),
+			"C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	projectId, ok := vars["projectId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid projectId"),
+			"C_INVALID_PROJECT_ID", ""))
+		return
+	}
+	projectNamespace, ok := vars["projectNamespace"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid projectNamespace"),
+			"C_INVALID_PROJECT_NAMESPACE", ""))
+		return
+	}
+	stackId, ok := vars["stackId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid stackId"),
+			"C_INVALID_STACK_ID", ""))
+		return
+	}
 
-	//ToDo: from donggyu. uncomment lines below after implementing usecase.DeleteProjectNamespace.
-	// tasks for keycloak & k8s
-	/*if err := p.usecase.DeleteK8SNSRoleBinding(organizationId, projectId, stackId, projectNamespace); err != nil {
+	exist, err := p.usecase.IsProjectNamespaceExist(r.Context(), organizationId, projectId, stackId, projectNamespace)
+	if err != nil {
 		ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
 		return
 	}
-	if err := p.usecase.MayRemoveRequiredSetupForCluster(organizationId, projectId, stackId); err != nil {
+	if !exist {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("project namespace not found: %s", projectNamespace),
+			"C_INVALID_PROJECT_NAMESPACE", ""))
+		return
+	}
+
+	// 네임스페이스에 배포된 앱이 있으면 앱을 먼저 삭제해야 한다.
+	appCount, err := p.usecase.GetAppCount(r.Context(), organizationId, projectId, projectNamespace)
+	if err != nil {
 		ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
 		return
-	}*/
+	}
+	if appCount > 0 {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("project namespace %s has %d apps", projectNamespace, appCount),
+			"C_PROJECT_NAMESPACE_HAS_APPS", ""))
+		return
+	}
 
-	//TODO implement me
+	// tasks for keycloak & k8s
+	// k8s 네임스페이스는 사용자의 다른 리소스가 남아 있을 수 있으므로 삭제하지 않고, 프로젝트의 권한만 회수한다.
+	if err := p.usecase.DeleteK8SNSRoleBinding(r.Context(), organizationId, projectId, stackId, projectNamespace); err != nil {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
+		return
+	}
+	if err := p.usecase.MayRemoveRequiredSetupForCluster(r.Context(), organizationId, projectId, stackId); err != nil {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
+		return
+	}
 
-	//vars := mux.Vars(r)
-	//organizationId, ok := vars["organizationId"]
-	//if !ok {
-	//	ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("organizationId not found in path"),
-	//		"C_INVALID_ORGANIZATION_ID", ""))
-	//	return
-	//}
-	//projectId, ok := vars["projectId"]
-	//if !ok {
-	//	ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid projectId"),
-	//		"C_INVALID_PROJECT_ID", ""))
-	//	return
-	//}
-	//projectNamespace, ok := vars["projectNamespace"]
-	//if !ok {
-	//	ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid projectNamespace"),
-	//		"C_INVALID_PROJECT_NAMESPACE", ""))
-	//	return
-	//}
-	//stackId, ok := vars["stackId"]
-	//if !ok {
-	//	ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("invalid stackId"),
-	//		"C_INVALID_STACK_ID", ""))
-	//	return
-	//}
-	//
-	//if err := p.usecase.DeleteProjectNamespace(organizationId, projectId, projectNamespace, stackId); err != nil {
-	//	ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
-	//	return
-	//
-	//}
-	//ResponseJSON(w, r, http.StatusOK, model.CommonProjectResponse{Result: "OK"})
+	if err := p.usecase.DeleteProjectNamespace(r.Context(), organizationId, projectId, projectNamespace, stackId); err != nil {
+		ErrorJSON(w, r, httpErrors.NewInternalServerError(err, "", ""))
+		return
+	}
+	ResponseJSON(w, r, http.StatusOK, domain.CommonProjectResponse{Result: "OK"})
 }
 
 func (p ProjectHandler) SetFavoriteProject(w http.ResponseWriter, r *http.Request) {
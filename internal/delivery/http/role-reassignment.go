@@ -0,0 +1,152 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// CreateRoleReassignment godoc
+//
+//	@Tags			Roles
+//	@Summary		Reassign all users of role to another role
+//	@Description	Start a background job which moves all users of the source role to the target role, including keycloak group membership. Use the returned id to track the per-user results.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string									true	"Organization ID"
+//	@Param			body			body		domain.CreateRoleReassignmentRequest	true	"Create Role Reassignment Request"
+//	@Success		200				{object}	domain.CreateRoleReassignmentResponse
+//	@Router			/organizations/{organizationId}/role-reassignments [post]
+//	@Security		JWT
+func (h RoleHandler) CreateRoleReassignment(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateRoleReassignmentRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	reassignment, err := h.userUsecase.ReassignRole(r.Context(), organizationId, input.SourceRoleId, input.TargetRoleId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.CreateRoleReassignmentResponse
+	out.RoleReassignment = roleReassignmentResponse(r, reassignment)
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetRoleReassignments godoc
+//
+//	@Tags			Roles
+//	@Summary		Get role reassignments
+//	@Description	Get role reassignment jobs of organization without per-user results
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"Organization ID"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetRoleReassignmentsResponse
+//	@Router			/organizations/{organizationId}/role-reassignments [get]
+//	@Security		JWT
+func (h RoleHandler) GetRoleReassignments(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	reassignments, err := h.userUsecase.GetRoleReassignments(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetRoleReassignmentsResponse
+	out.RoleReassignments = make([]domain.RoleReassignmentResponse, len(reassignments))
+	for i, reassignment := range reassignments {
+		out.RoleReassignments[i] = roleReassignmentResponse(r, reassignment)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetRoleReassignment godoc
+//
+//	@Tags			Roles
+//	@Summary		Get role reassignment
+//	@Description	Get role reassignment job with per-user results
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID"
+//	@Param			reassignmentId	path		string	true	"Role Reassignment ID"
+//	@Success		200				{object}	domain.GetRoleReassignmentResponse
+//	@Router			/organizations/{organizationId}/role-reassignments/{reassignmentId} [get]
+//	@Security		JWT
+func (h RoleHandler) GetRoleReassignment(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	reassignmentId, err := uuid.Parse(vars["reassignmentId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid reassignmentId"), "U_INVALID_ROLE_REASSIGNMENT", ""))
+		return
+	}
+
+	reassignment, err := h.userUsecase.GetRoleReassignment(r.Context(), organizationId, reassignmentId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetRoleReassignmentResponse
+	out.RoleReassignment = roleReassignmentResponse(r, reassignment)
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func roleReassignmentResponse(r *http.Request, reassignment model.RoleReassignment) (out domain.RoleReassignmentResponse) {
+	if err := serializer.Map(r.Context(), reassignment, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+	for _, result := range reassignment.Results {
+		out.Results = append(out.Results, domain.RoleReassignmentResultResponse{
+			UserId:     result.UserId.String(),
+			AccountId:  result.AccountId,
+			Status:     result.Status,
+			Error:      result.Error,
+			FinishedAt: result.FinishedAt,
+		})
+	}
+	return out
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"context"
 	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
 	"github.com/openinfradev/tks-api/internal/serializer"
@@ -27,6 +28,10 @@ type IRoleHandler interface {
 	AppendUsersToRole(w http.ResponseWriter, r *http.Request)
 	RemoveUsersFromRole(w http.ResponseWriter, r *http.Request)
 
+	CreateRoleReassignment(w http.ResponseWriter, r *http.Request)
+	GetRoleReassignments(w http.ResponseWriter, r *http.Request)
+	GetRoleReassignment(w http.ResponseWriter, r *http.Request)
+
 	Admin_ListTksRoles(w http.ResponseWriter, r *http.Request)
 	Admin_GetTksRole(w http.ResponseWriter, r *http.Request)
 }
@@ -560,6 +565,7 @@ func (h RoleHandler) IsRoleNameExisted(w http.ResponseWriter, r *http.Request) {
 //	@Param			organizationId	path	string							true	"Organization ID"
 //	@Param			roleId			path	string							true	"Role ID"
 //	@Param			body			body	domain.AppendUsersToRoleRequest	true	"Append Users To Role Request"
+//	@Param			dryRun	query	bool	false	"validate only without committing"
 //	@Success		200
 //	@Router			/organizations/{organizationId}/roles/{roleId}/users [post]
 //	@Security		JWT
@@ -587,6 +593,8 @@ func (h RoleHandler) AppendUsersToRole(w http.ResponseWriter, r *http.Request) {
 		ErrorJSON(w, r, err)
 		return
 	}
+	dryRun := helper.IsDryRun(r)
+	changes := []string{}
 
 	for _, user := range input.Users {
 		originUser, err := h.userUsecase.Get(r.Context(), user)
@@ -603,12 +611,27 @@ func (h RoleHandler) AppendUsersToRole(w http.ResponseWriter, r *http.Request) {
 
 		originUser.Roles = append(originUser.Roles, *role)
 
+		if dryRun {
+			userChanges, err := h.userUsecase.UpdateByAccountIdByAdminDryRun(r.Context(), originUser)
+			if err != nil {
+				ErrorJSON(w, r, err)
+				return
+			}
+			changes = append(changes, userChanges...)
+			continue
+		}
+
 		if _, err := h.userUsecase.UpdateByAccountIdByAdmin(r.Context(), originUser); err != nil {
 			ErrorJSON(w, r, err)
 			return
 		}
 	}
 
+	if dryRun {
+		ResponseJSON(w, r, http.StatusOK, domain.DryRunResponse{DryRun: true, Changes: changes})
+		return
+	}
+
 	// response
 	ResponseJSON(w, r, http.StatusOK, nil)
 }
@@ -623,6 +646,7 @@ func (h RoleHandler) AppendUsersToRole(w http.ResponseWriter, r *http.Request) {
 //	@Param			organizationId	path	string								true	"Organization ID"
 //	@Param			roleId			path	string								true	"Role ID"
 //	@Param			body			body	domain.RemoveUsersFromRoleRequest	true	"Remove Users From Role Request"
+//	@Param			dryRun	query	bool	false	"validate only without committing"
 //	@Success		200
 //	@Router			/organizations/{organizationId}/roles/{roleId}/users [delete]
 //	@Security		JWT
@@ -650,6 +674,8 @@ func (h RoleHandler) RemoveUsersFromRole(w http.ResponseWriter, r *http.Request)
 		ErrorJSON(w, r, err)
 		return
 	}
+	dryRun := helper.IsDryRun(r)
+	changes := []string{}
 
 	for _, user := range input.Users {
 		originUser, err := h.userUsecase.Get(r.Context(), user)
@@ -671,12 +697,27 @@ func (h RoleHandler) RemoveUsersFromRole(w http.ResponseWriter, r *http.Request)
 			}
 		}
 
+		if dryRun {
+			userChanges, err := h.userUsecase.UpdateByAccountIdByAdminDryRun(r.Context(), originUser)
+			if err != nil {
+				ErrorJSON(w, r, err)
+				return
+			}
+			changes = append(changes, userChanges...)
+			continue
+		}
+
 		if _, err := h.userUsecase.UpdateByAccountIdByAdmin(r.Context(), originUser); err != nil {
 			ErrorJSON(w, r, err)
 			return
 		}
 	}
 
+	if dryRun {
+		ResponseJSON(w, r, http.StatusOK, domain.DryRunResponse{DryRun: true, Changes: changes})
+		return
+	}
+
 	// response
 	ResponseJSON(w, r, http.StatusOK, nil)
 }
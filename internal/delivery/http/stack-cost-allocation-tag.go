@@ -0,0 +1,132 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// GetCostAllocationTagPolicies godoc
+//
+//	@Tags			Stacks
+//	@Summary		Get cost allocation tag policies
+//	@Description	Get cost allocation tag policies of the organization. The tags are applied to every cloud resource a stack creates.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetCostAllocationTagPoliciesResponse
+//	@Router			/organizations/{organizationId}/cost-allocation-tag-policies [get]
+//	@Router			/admin/organizations/{organizationId}/cost-allocation-tag-policies [get]
+//	@Security		JWT
+func (h *StackHandler) GetCostAllocationTagPolicies(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	policies, err := h.usecase.GetCostAllocationTagPolicies(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetCostAllocationTagPoliciesResponse
+	out.Policies = make([]domain.CostAllocationTagPolicyResponse, len(policies))
+	for i, policy := range policies {
+		if err := serializer.Map(r.Context(), policy, &out.Policies[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+		if out.Policies[i].AllowedValues == nil {
+			out.Policies[i].AllowedValues = []string{}
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// Admin_UpdateCostAllocationTagPolicies godoc
+//
+//	@Tags			Stacks
+//	@Summary		Update cost allocation tag policies
+//	@Description	Replace cost allocation tag policies of the organization. Omitted tags of CreateStack are filled with the default values, and stacks missing required tags are rejected.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string											true	"organizationId"
+//	@Param			body			body		domain.UpdateCostAllocationTagPoliciesRequest	true	"Update cost allocation tag policies request"
+//	@Success		200				{object}	nil
+//	@Router			/admin/organizations/{organizationId}/cost-allocation-tag-policies [put]
+//	@Security		JWT
+func (h *StackHandler) Admin_UpdateCostAllocationTagPolicies(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdateCostAllocationTagPoliciesRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	policies := make([]model.CostAllocationTagPolicy, len(input.Policies))
+	for i, policy := range input.Policies {
+		policies[i] = model.CostAllocationTagPolicy{
+			Key:           policy.Key,
+			DefaultValue:  policy.DefaultValue,
+			AllowedValues: policy.AllowedValues,
+			Required:      policy.Required,
+			Description:   policy.Description,
+		}
+	}
+
+	if err := h.usecase.UpdateCostAllocationTagPolicies(r.Context(), organizationId, policies); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// CheckCostAllocationTagDrift godoc
+//
+//	@Tags			Stacks
+//	@Summary		Check cost allocation tag drift of stack
+//	@Description	Check that the cloud resources of the stack have the cost allocation tags required by the organization policies. Only AWS stacks are supported.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackId			path		string	true	"stackId"
+//	@Success		200				{object}	domain.CheckCostAllocationTagDriftResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/cost-allocation-tags/drift [get]
+//	@Security		JWT
+func (h *StackHandler) CheckCostAllocationTagDrift(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	strId, ok := vars["stackId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID", ""))
+		return
+	}
+
+	out, err := h.usecase.CheckCostAllocationTagDrift(r.Context(), organizationId, domain.StackId(strId))
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
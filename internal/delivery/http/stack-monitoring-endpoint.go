@@ -0,0 +1,251 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type StackMonitoringEndpointHandler struct {
+	usecase usecase.IStackMonitoringEndpointUsecase
+}
+
+func NewStackMonitoringEndpointHandler(h usecase.Usecase) *StackMonitoringEndpointHandler {
+	return &StackMonitoringEndpointHandler{
+		usecase: h.StackMonitoringEndpoint,
+	}
+}
+
+// CreateStackMonitoringEndpoint godoc
+//
+//	@Tags			Stacks
+//	@Summary		Create monitoring endpoint of the stack
+//	@Description	Register an external HTTP(S) url of the stack such as an ingress or a customer app. TKS probes it periodically, records the availability and creates an alert when it fails consecutively.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string										true	"organizationId"
+//	@Param			stackId			path		string										true	"stackId"
+//	@Param			body			body		domain.CreateStackMonitoringEndpointRequest	true	"create monitoring endpoint request"
+//	@Success		200				{object}	domain.CreateStackMonitoringEndpointResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/monitoring-endpoints [post]
+//	@Security		JWT
+func (h *StackMonitoringEndpointHandler) CreateStackMonitoringEndpoint(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := deploymentApprovalPolicyVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.CreateStackMonitoringEndpointRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.StackMonitoringEndpoint
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+	dto.ClusterId = stackId
+	dto.Enabled = input.Enabled == nil || *input.Enabled
+
+	monitoringEndpointId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateStackMonitoringEndpointResponse{ID: monitoringEndpointId.String()})
+}
+
+// GetStackMonitoringEndpoints godoc
+//
+//	@Tags			Stacks
+//	@Summary		Get monitoring endpoints of the stack
+//	@Description	Get monitoring endpoints of the stack with the last probe result and the availability of the last 24 hours, 7 days and 30 days
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackId			path		string	true	"stackId"
+//	@Success		200				{object}	domain.GetStackMonitoringEndpointsResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/monitoring-endpoints [get]
+//	@Security		JWT
+func (h *StackMonitoringEndpointHandler) GetStackMonitoringEndpoints(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackId, err := deploymentApprovalPolicyVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	endpoints, err := h.usecase.Fetch(r.Context(), organizationId, stackId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetStackMonitoringEndpointsResponse
+	out.MonitoringEndpoints = make([]domain.StackMonitoringEndpointResponse, len(endpoints))
+	for i, endpoint := range endpoints {
+		if err := serializer.Map(r.Context(), endpoint, &out.MonitoringEndpoints[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetStackMonitoringEndpoint godoc
+//
+//	@Tags			Stacks
+//	@Summary		Get monitoring endpoint of the stack
+//	@Description	Get monitoring endpoint of the stack
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId			path		string	true	"organizationId"
+//	@Param			stackId					path		string	true	"stackId"
+//	@Param			monitoringEndpointId	path		string	true	"monitoringEndpointId"
+//	@Success		200						{object}	domain.GetStackMonitoringEndpointResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/monitoring-endpoints/{monitoringEndpointId} [get]
+//	@Security		JWT
+func (h *StackMonitoringEndpointHandler) GetStackMonitoringEndpoint(w http.ResponseWriter, r *http.Request) {
+	organizationId, monitoringEndpointId, err := monitoringEndpointVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	endpoint, err := h.usecase.Get(r.Context(), organizationId, monitoringEndpointId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetStackMonitoringEndpointResponse
+	if err := serializer.Map(r.Context(), endpoint, &out.MonitoringEndpoint); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// UpdateStackMonitoringEndpoint godoc
+//
+//	@Tags			Stacks
+//	@Summary		Update monitoring endpoint of the stack
+//	@Description	Update monitoring endpoint. Changing the url or the expected response resets the status until the next probe.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId			path		string										true	"organizationId"
+//	@Param			stackId					path		string										true	"stackId"
+//	@Param			monitoringEndpointId	path		string										true	"monitoringEndpointId"
+//	@Param			body					body		domain.UpdateStackMonitoringEndpointRequest	true	"update monitoring endpoint request"
+//	@Success		200						{object}	nil
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/monitoring-endpoints/{monitoringEndpointId} [put]
+//	@Security		JWT
+func (h *StackMonitoringEndpointHandler) UpdateStackMonitoringEndpoint(w http.ResponseWriter, r *http.Request) {
+	organizationId, monitoringEndpointId, err := monitoringEndpointVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateStackMonitoringEndpointRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.StackMonitoringEndpoint
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.ID = monitoringEndpointId
+	dto.OrganizationId = organizationId
+	dto.Enabled = input.Enabled == nil || *input.Enabled
+
+	if err = h.usecase.Update(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteStackMonitoringEndpoint godoc
+//
+//	@Tags			Stacks
+//	@Summary		Delete monitoring endpoint of the stack
+//	@Description	Delete monitoring endpoint and its probe history
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId			path		string	true	"organizationId"
+//	@Param			stackId					path		string	true	"stackId"
+//	@Param			monitoringEndpointId	path		string	true	"monitoringEndpointId"
+//	@Success		200						{object}	nil
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/monitoring-endpoints/{monitoringEndpointId} [delete]
+//	@Security		JWT
+func (h *StackMonitoringEndpointHandler) DeleteStackMonitoringEndpoint(w http.ResponseWriter, r *http.Request) {
+	organizationId, monitoringEndpointId, err := monitoringEndpointVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.Delete(r.Context(), organizationId, monitoringEndpointId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// GetAvailabilityDashboard godoc
+//
+//	@Tags			Dashboards
+//	@Summary		Get availability of stacks
+//	@Description	Get the availability of the monitoring endpoints of each stack for the SLO dashboard. The availability is the ratio of successful probes.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			duration		query		string	false	"1d, 7d or 30d. defaults to 7d"
+//	@Success		200				{object}	domain.GetDashboardAvailabilityResponse
+//	@Router			/organizations/{organizationId}/dashboards/widgets/availability [get]
+//	@Security		JWT
+func (h *StackMonitoringEndpointHandler) GetAvailabilityDashboard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	out, err := h.usecase.GetDashboardAvailability(r.Context(), organizationId, r.URL.Query().Get("duration"))
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func monitoringEndpointVars(r *http.Request) (organizationId string, monitoringEndpointId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	monitoringEndpointId, err = uuid.Parse(vars["monitoringEndpointId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid monitoringEndpointId"), "S_INVALID_MONITORING_ENDPOINT_ID", "")
+	}
+	return organizationId, monitoringEndpointId, nil
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// GetStackNodes godoc
+//
+//	@Tags			Stacks
+//	@Summary		Get nodes of stack
+//	@Description	Get nodes of the stack cluster with kubelet version, taints, conditions and cpu, memory, disk usage from thanos
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackId			path		string	true	"stackId"
+//	@Success		200				{object}	domain.GetStackNodesResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/nodes [get]
+//	@Security		JWT
+func (h *StackHandler) GetStackNodes(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	stackId, ok := vars["stackId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID", ""))
+		return
+	}
+
+	out, err := h.usecase.GetNodes(r.Context(), organizationId, domain.StackId(stackId))
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// ScaleStackNodeGroup godoc
+//
+//	@Tags			Stacks
+//	@Summary		Scale node group of stack
+//	@Description	Change the desired node count of a stack node group (control-plane, infra, user) within the limits of the stack template. When the concurrent operation limit is reached, the scaling is queued and 202 is returned with the pending operation.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			stackId			path		string								true	"stackId"
+//	@Param			nodeGroup		path		string								true	"control-plane, infra or user"
+//	@Param			body			body		domain.ScaleStackNodeGroupRequest	true	"scale request"
+//	@Success		200				{object}	domain.ScaleStackNodeGroupResponse
+//	@Success		202				{object}	domain.ScaleStackNodeGroupResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/node-groups/{nodeGroup}:scale [post]
+//	@Security		JWT
+func (h *StackHandler) ScaleStackNodeGroup(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	stackId, ok := vars["stackId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID", ""))
+		return
+	}
+	nodeGroup, ok := vars["nodeGroup"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid nodeGroup"), "S_INVALID_NODE_GROUP", ""))
+		return
+	}
+
+	input := domain.ScaleStackNodeGroupRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	out, operation, err := h.usecase.ScaleNodeGroup(r.Context(), organizationId, domain.StackId(stackId), nodeGroup, input.DesiredSize)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, operationStatusCode(operation), out)
+}
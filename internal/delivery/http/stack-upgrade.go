@@ -0,0 +1,131 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// UpgradeStack godoc
+//
+//	@Tags			Stacks
+//	@Summary		Upgrade stack
+//	@Description	Upgrade the stack to a newer stack template. The upgrade is rejected when the stack has open critical alerts, the nodes have no capacity to spare, or the stack template is not compatible (different cloud service or kube type, downgrade or skipping a kubernetes minor version). When the concurrent operation limit is reached, the upgrade is queued and 202 is returned.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string						true	"organizationId"
+//	@Param			stackId			path		string						true	"stackId"
+//	@Param			body			body		domain.UpgradeStackRequest	true	"upgrade request"
+//	@Success		200				{object}	domain.UpgradeStackResponse
+//	@Success		202				{object}	domain.UpgradeStackResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/upgrade [post]
+//	@Security		JWT
+func (h *StackHandler) UpgradeStack(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	stackId, ok := vars["stackId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID", ""))
+		return
+	}
+
+	input := domain.UpgradeStackRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+	stackTemplateId, err := uuid.Parse(input.StackTemplateId)
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackTemplateId"), "S_INVALID_STACK_TEMPLATE", ""))
+		return
+	}
+
+	upgrade, operation, err := h.usecase.Upgrade(r.Context(), organizationId, domain.StackId(stackId), stackTemplateId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, operationStatusCode(operation), domain.UpgradeStackResponse{
+		Upgrade: newStackUpgradeResponse(r.Context(), upgrade),
+	})
+}
+
+// GetStackUpgrades godoc
+//
+//	@Tags			Stacks
+//	@Summary		Get upgrade history of stack
+//	@Description	Get upgrade history of the stack with the pre-flight check results
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			stackId			path		string		true	"stackId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Success		200				{object}	domain.GetStackUpgradesResponse
+//	@Router			/organizations/{organizationId}/stacks/{stackId}/upgrades [get]
+//	@Security		JWT
+func (h *StackHandler) GetStackUpgrades(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	stackId, ok := vars["stackId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	upgrades, err := h.usecase.FetchUpgrades(r.Context(), organizationId, domain.StackId(stackId), pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetStackUpgradesResponse
+	out.Upgrades = make([]domain.StackUpgradeResponse, len(upgrades))
+	for i, upgrade := range upgrades {
+		out.Upgrades[i] = newStackUpgradeResponse(r.Context(), upgrade)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+func newStackUpgradeResponse(ctx context.Context, upgrade model.StackUpgrade) (out domain.StackUpgradeResponse) {
+	if err := serializer.Map(ctx, upgrade, &out); err != nil {
+		log.Info(ctx, err)
+	}
+	out.StackId = upgrade.ClusterId.String()
+	out.TaskId = upgrade.OperationId.String()
+	out.Preflight = []domain.StackUpgradeCheck{}
+	if len(upgrade.Preflight) > 0 {
+		if err := json.Unmarshal(upgrade.Preflight, &out.Preflight); err != nil {
+			log.Info(ctx, err)
+		}
+	}
+	return
+}
@@ -0,0 +1,235 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type StackWebhookHandler struct {
+	usecase usecase.IStackWebhookUsecase
+}
+
+func NewStackWebhookHandler(h usecase.Usecase) *StackWebhookHandler {
+	return &StackWebhookHandler{
+		usecase: h.StackWebhook,
+	}
+}
+
+// CreateStackWebhook godoc
+//
+//	@Tags			StackWebhooks
+//	@Summary		Create stack webhook
+//	@Description	Post lifecycle events(cluster.created, cluster.running, cluster.deleted, cluster.status_changed and appgroup.*) of the organization's stacks to the url. If the secret is set, the HMAC-SHA256 signature of the body is sent in the X-TKS-Signature header. Empty events means all events.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.CreateStackWebhookRequest	true	"create stack webhook request"
+//	@Success		200				{object}	domain.CreateStackWebhookResponse
+//	@Router			/organizations/{organizationId}/stack-webhooks [post]
+//	@Security		JWT
+func (h *StackWebhookHandler) CreateStackWebhook(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.CreateStackWebhookRequest{}
+	if err := UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.StackWebhook
+	if err := serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+	dto.Events = input.Events
+
+	stackWebhookId, err := h.usecase.Create(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.CreateStackWebhookResponse{ID: stackWebhookId.String()})
+}
+
+// GetStackWebhooks godoc
+//
+//	@Tags			StackWebhooks
+//	@Summary		Get stack webhooks
+//	@Description	Get stack webhooks of the organization. Secrets are not returned.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Param			filters			query		[]string	false	"filters"
+//	@Success		200				{object}	domain.GetStackWebhooksResponse
+//	@Router			/organizations/{organizationId}/stack-webhooks [get]
+//	@Security		JWT
+func (h *StackWebhookHandler) GetStackWebhooks(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	stackWebhooks, err := h.usecase.Fetch(r.Context(), organizationId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetStackWebhooksResponse
+	out.StackWebhooks = make([]domain.StackWebhookResponse, len(stackWebhooks))
+	for i, stackWebhook := range stackWebhooks {
+		out.StackWebhooks[i] = stackWebhookResponse(r, stackWebhook)
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// GetStackWebhook godoc
+//
+//	@Tags			StackWebhooks
+//	@Summary		Get stack webhook
+//	@Description	Get stack webhook with the result of the last delivery
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Param			stackWebhookId	path		string	true	"stackWebhookId"
+//	@Success		200				{object}	domain.GetStackWebhookResponse
+//	@Router			/organizations/{organizationId}/stack-webhooks/{stackWebhookId} [get]
+//	@Security		JWT
+func (h *StackWebhookHandler) GetStackWebhook(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackWebhookId, err := stackWebhookVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	stackWebhook, err := h.usecase.Get(r.Context(), organizationId, stackWebhookId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.GetStackWebhookResponse{StackWebhook: stackWebhookResponse(r, stackWebhook)})
+}
+
+// UpdateStackWebhook godoc
+//
+//	@Tags			StackWebhooks
+//	@Summary		Update stack webhook
+//	@Description	Update stack webhook. The secret is kept if it is empty.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string								true	"organizationId"
+//	@Param			stackWebhookId	path	string								true	"stackWebhookId"
+//	@Param			body			body	domain.UpdateStackWebhookRequest	true	"update stack webhook request"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/stack-webhooks/{stackWebhookId} [put]
+//	@Security		JWT
+func (h *StackWebhookHandler) UpdateStackWebhook(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackWebhookId, err := stackWebhookVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	input := domain.UpdateStackWebhookRequest{}
+	if err = UnmarshalRequestInput(r, &input); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.StackWebhook
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.ID = stackWebhookId
+	dto.OrganizationId = organizationId
+	dto.Events = input.Events
+
+	if err = h.usecase.Update(r.Context(), dto); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// DeleteStackWebhook godoc
+//
+//	@Tags			StackWebhooks
+//	@Summary		Delete stack webhook
+//	@Description	Delete stack webhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			stackWebhookId	path	string	true	"stackWebhookId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/stack-webhooks/{stackWebhookId} [delete]
+//	@Security		JWT
+func (h *StackWebhookHandler) DeleteStackWebhook(w http.ResponseWriter, r *http.Request) {
+	organizationId, stackWebhookId, err := stackWebhookVars(r)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	if err = h.usecase.Delete(r.Context(), organizationId, stackWebhookId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+func stackWebhookVars(r *http.Request) (organizationId string, stackWebhookId uuid.UUID, err error) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+	}
+	stackWebhookId, err = uuid.Parse(vars["stackWebhookId"])
+	if err != nil {
+		return "", uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackWebhookId"), "S_INVALID_STACK_WEBHOOK_ID", "")
+	}
+	return organizationId, stackWebhookId, nil
+}
+
+func stackWebhookResponse(r *http.Request, stackWebhook model.StackWebhook) (out domain.StackWebhookResponse) {
+	if err := serializer.Map(r.Context(), stackWebhook, &out); err != nil {
+		log.Info(r.Context(), err)
+	}
+	out.SecretConfigured = stackWebhook.Secret != ""
+	out.Events = stackWebhook.Events
+	if out.Events == nil {
+		out.Events = []string{}
+	}
+	return out
+}
@@ -31,12 +31,13 @@ func NewStackHandler(h usecase.Usecase) *StackHandler {
 //
 //	@Tags			Stacks
 //	@Summary		Create Stack
-//	@Description	Create Stack
+//	@Description	Create Stack. When the concurrent operation limit is reached, the creation is queued and 202 is returned with the pending operation.
 //	@Accept			json
 //	@Produce		json
 //	@Param			organizationId	path		string						true	"organizationId"
 //	@Param			body			body		domain.CreateStackRequest	true	"create cloud setting request"
 //	@Success		200				{object}	domain.CreateStackResponse
+//	@Success		202				{object}	domain.CreateStackResponse
 //	@Router			/organizations/{organizationId}/stacks [post]
 //	@Security		JWT
 func (h *StackHandler) CreateStack(w http.ResponseWriter, r *http.Request) {
@@ -63,17 +64,20 @@ func (h *StackHandler) CreateStack(w http.ResponseWriter, r *http.Request) {
 	}
 
 	dto.OrganizationId = organizationId
-	stackId, err := h.usecase.Create(r.Context(), dto)
+	stackId, operation, err := h.usecase.Create(r.Context(), dto)
 	if err != nil {
 		ErrorJSON(w, r, err)
 		return
 	}
 
 	out := domain.CreateStackResponse{
-		ID: stackId.String(),
+		ID:              stackId.String(),
+		TaskId:          operation.ID.String(),
+		OperationId:     operation.ID.String(),
+		OperationStatus: operation.Status,
 	}
 
-	ResponseJSON(w, r, http.StatusOK, out)
+	ResponseJSON(w, r, operationStatusCode(operation), out)
 }
 
 func (h *StackHandler) InstallStack(w http.ResponseWriter, r *http.Request) {
@@ -148,6 +152,34 @@ func (h *StackHandler) GetStacks(w http.ResponseWriter, r *http.Request) {
 	ResponseJSON(w, r, http.StatusOK, out)
 }
 
+// GetStackAddonVersions godoc
+//
+//	@Tags			Stacks
+//	@Summary		Get addon versions of stacks
+//	@Description	Compare installed addon versions of all stacks in the organization with the latest versions in the stack template catalog
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetStackAddonVersionsResponse
+//	@Router			/organizations/{organizationId}/stacks/addon-versions [get]
+//	@Security		JWT
+func (h *StackHandler) GetStackAddonVersions(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	stacks, err := h.usecase.GetAddonVersions(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, domain.GetStackAddonVersionsResponse{Stacks: stacks})
+}
+
 // GetStack godoc
 //
 //	@Tags			Stacks
@@ -286,12 +318,13 @@ func (h *StackHandler) UpdateStack(w http.ResponseWriter, r *http.Request) {
 //
 //	@Tags			Stacks
 //	@Summary		Delete Stack
-//	@Description	Delete Stack
+//	@Description	Delete Stack. When the concurrent operation limit is reached, the deletion is queued and 202 is returned with the pending operation.
 //	@Accept			json
 //	@Produce		json
 //	@Param			organizationId	path		string	true	"organizationId"
 //	@Param			stackId			path		string	true	"stackId"
-//	@Success		200				{object}	nil
+//	@Success		200				{object}	domain.DeleteStackResponse
+//	@Success		202				{object}	domain.DeleteStackResponse
 //	@Router			/organizations/{organizationId}/stacks/{stackId} [delete]
 //	@Security		JWT
 func (h *StackHandler) DeleteStack(w http.ResponseWriter, r *http.Request) {
@@ -327,13 +360,17 @@ func (h *StackHandler) DeleteStack(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	err = h.usecase.Delete(r.Context(), dto)
+	operation, err := h.usecase.Delete(r.Context(), dto)
 	if err != nil {
 		ErrorJSON(w, r, err)
 		return
 	}
 
-	ResponseJSON(w, r, http.StatusOK, nil)
+	ResponseJSON(w, r, operationStatusCode(operation), domain.DeleteStackResponse{
+		TaskId:          operation.ID.String(),
+		OperationId:     operation.ID.String(),
+		OperationStatus: operation.Status,
+	})
 }
 
 // CheckStackName godoc
@@ -479,3 +516,107 @@ func (h *StackHandler) DeleteFavorite(w http.ResponseWriter, r *http.Request) {
 	}
 	ResponseJSON(w, r, http.StatusOK, nil)
 }
+
+// GetStackDefault godoc
+//
+//	@Tags			Stacks
+//	@Summary		Get stack default
+//	@Description	Get default stack parameters of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	domain.GetStackDefaultResponse
+//	@Router			/organizations/{organizationId}/stack-defaults [get]
+//	@Router			/admin/organizations/{organizationId}/stack-defaults [get]
+//	@Security		JWT
+func (h *StackHandler) GetStackDefault(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	stackDefault, err := h.usecase.GetStackDefault(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var out domain.GetStackDefaultResponse
+	if err := serializer.Map(r.Context(), stackDefault, &out.StackDefault); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// Admin_UpdateStackDefault godoc
+//
+//	@Tags			Stacks
+//	@Summary		Update stack default
+//	@Description	Update default stack parameters of the organization. Omitted fields of CreateStack are filled with them.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string								true	"organizationId"
+//	@Param			body			body		domain.UpdateStackDefaultRequest	true	"Update stack default request"
+//	@Success		200				{object}	nil
+//	@Router			/admin/organizations/{organizationId}/stack-defaults [put]
+//	@Security		JWT
+func (h *StackHandler) Admin_UpdateStackDefault(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	input := domain.UpdateStackDefaultRequest{}
+	err := UnmarshalRequestInput(r, &input)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	var dto model.StackDefault
+	if err = serializer.Map(r.Context(), input, &dto); err != nil {
+		log.Info(r.Context(), err)
+	}
+	dto.OrganizationId = organizationId
+
+	err = h.usecase.UpdateStackDefault(r.Context(), dto)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
+
+// Admin_DeleteStackDefault godoc
+//
+//	@Tags			Stacks
+//	@Summary		Delete stack default
+//	@Description	Delete default stack parameters of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"organizationId"
+//	@Success		200				{object}	nil
+//	@Router			/admin/organizations/{organizationId}/stack-defaults [delete]
+//	@Security		JWT
+func (h *StackHandler) Admin_DeleteStackDefault(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid organizationId"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+
+	err := h.usecase.DeleteStackDefault(r.Context(), organizationId)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
@@ -87,6 +87,7 @@ func (h *SystemNotificationHandler) CreateSystemNotification(w http.ResponseWrit
 //	@Param			soertColumn		query		string		false	"sortColumn"
 //	@Param			sortOrder		query		string		false	"sortOrder"
 //	@Param			filters			query		[]string	false	"filters"
+//	@Param			cursor			query		string		false	"cursor (nextCursor of the previous page, empty for the first page)"
 //	@Success		200				{object}	domain.GetSystemNotificationsResponse
 //	@Router			/organizations/{organizationId}/system-notifications [get]
 //	@Security		JWT
@@ -129,6 +130,12 @@ func (h *SystemNotificationHandler) GetSystemNotifications(w http.ResponseWriter
 			}
 		}
 		out.SystemNotifications[i].SystemNotificationActions = outSystemNotificationActions
+		if systemNotification.AlertSilenceId != nil {
+			out.SystemNotifications[i].AlertSilenceId = systemNotification.AlertSilenceId.String()
+		}
+		if systemNotification.EscalationPolicyId != nil {
+			out.SystemNotifications[i].EscalationPolicyId = systemNotification.EscalationPolicyId.String()
+		}
 		if len(outSystemNotificationActions) > 0 {
 			out.SystemNotifications[i].LastTaker = outSystemNotificationActions[0].Taker
 		}
@@ -185,6 +192,12 @@ func (h *SystemNotificationHandler) GetSystemNotification(w http.ResponseWriter,
 		}
 	}
 	out.SystemNotification.SystemNotificationActions = outSystemNotificationActions
+	if systemNotification.AlertSilenceId != nil {
+		out.SystemNotification.AlertSilenceId = systemNotification.AlertSilenceId.String()
+	}
+	if systemNotification.EscalationPolicyId != nil {
+		out.SystemNotification.EscalationPolicyId = systemNotification.EscalationPolicyId.String()
+	}
 
 	ResponseJSON(w, r, http.StatusOK, out)
 }
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// GetUserSessions godoc
+//
+//	@Tags			Users
+//	@Summary		Get user sessions
+//	@Description	Get active sessions of the user created by login
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string		true	"organizationId"
+//	@Param			accountId		path		string		true	"accountId"
+//	@Param			pageSize		query		string		false	"pageSize"
+//	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			soertColumn		query		string		false	"sortColumn"
+//	@Param			sortOrder		query		string		false	"sortOrder"
+//	@Success		200				{object}	domain.GetUserSessionsResponse
+//	@Router			/organizations/{organizationId}/users/{accountId}/sessions [get]
+//	@Security		JWT
+func (u UserHandler) GetUserSessions(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("organizationId not found in path"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	accountId, ok := vars["accountId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("accountId not found in path"), "C_INVALID_ACCOUNT_ID", ""))
+		return
+	}
+
+	urlParams := r.URL.Query()
+	pg := pagination.NewPagination(&urlParams)
+	sessions, err := u.sessionUsecase.Fetch(r.Context(), organizationId, accountId, pg)
+	if err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	currentSessionId, _ := request.SessionFrom(r.Context())
+	var out domain.GetUserSessionsResponse
+	out.Sessions = make([]domain.UserSessionResponse, len(sessions))
+	for i, session := range sessions {
+		if err := serializer.Map(r.Context(), session, &out.Sessions[i]); err != nil {
+			log.Info(r.Context(), err)
+		}
+		out.Sessions[i].Current = session.SessionId == currentSessionId
+	}
+
+	if out.Pagination, err = pg.Response(r.Context()); err != nil {
+		log.Info(r.Context(), err)
+	}
+
+	ResponseJSON(w, r, http.StatusOK, out)
+}
+
+// DeleteUserSession godoc
+//
+//	@Tags			Users
+//	@Summary		Revoke user session
+//	@Description	Revoke the session. The keycloak session is terminated and the tokens issued to the session can not be used.
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path	string	true	"organizationId"
+//	@Param			sessionId		path	string	true	"sessionId"
+//	@Success		200
+//	@Router			/organizations/{organizationId}/sessions/{sessionId} [delete]
+//	@Security		JWT
+func (u UserHandler) DeleteUserSession(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organizationId, ok := vars["organizationId"]
+	if !ok {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("organizationId not found in path"), "C_INVALID_ORGANIZATION_ID", ""))
+		return
+	}
+	sessionId, err := uuid.Parse(vars["sessionId"])
+	if err != nil {
+		ErrorJSON(w, r, httpErrors.NewBadRequestError(fmt.Errorf("Invalid sessionId"), "A_INVALID_SESSION_ID", ""))
+		return
+	}
+
+	if err := u.sessionUsecase.Revoke(r.Context(), organizationId, sessionId); err != nil {
+		ErrorJSON(w, r, err)
+		return
+	}
+
+	ResponseJSON(w, r, http.StatusOK, nil)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/gorilla/mux"
+	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
@@ -31,10 +32,17 @@ type IUserHandler interface {
 	UpdateMyPassword(w http.ResponseWriter, r *http.Request)
 	RenewPasswordExpiredDate(w http.ResponseWriter, r *http.Request)
 	DeleteMyProfile(w http.ResponseWriter, r *http.Request)
+	EnrollMyOtp(w http.ResponseWriter, r *http.Request)
+	ActivateMyOtp(w http.ResponseWriter, r *http.Request)
+	DisableMyOtp(w http.ResponseWriter, r *http.Request)
+	GetUserSessions(w http.ResponseWriter, r *http.Request)
+	DeleteUserSession(w http.ResponseWriter, r *http.Request)
 
 	CheckId(w http.ResponseWriter, r *http.Request)
 	CheckEmail(w http.ResponseWriter, r *http.Request)
 	GetPermissionsByAccountId(w http.ResponseWriter, r *http.Request)
+	GetPasswordPolicy(w http.ResponseWriter, r *http.Request)
+	UpdatePasswordPolicy(w http.ResponseWriter, r *http.Request)
 
 	// Admin
 	Admin_Create(w http.ResponseWriter, r *http.Request)
@@ -47,6 +55,7 @@ type UserHandler struct {
 	authUsecase       usecase.IAuthUsecase
 	roleUsecase       usecase.IRoleUsecase
 	permissionUsecase usecase.IPermissionUsecase
+	sessionUsecase    usecase.IUserSessionUsecase
 }
 
 func NewUserHandler(h usecase.Usecase) IUserHandler {
@@ -55,6 +64,7 @@ func NewUserHandler(h usecase.Usecase) IUserHandler {
 		authUsecase:       h.Auth,
 		roleUsecase:       h.Role,
 		permissionUsecase: h.Permission,
+		sessionUsecase:    h.UserSession,
 	}
 }
 
@@ -67,6 +77,7 @@ func NewUserHandler(h usecase.Usecase) IUserHandler {
 //	@Produce		json
 //	@Param			organizationId	path		string						true	"organizationId"
 //	@Param			body			body		domain.CreateUserRequest	true	"create user request"
+//	@Param			dryRun	query	bool	false	"validate only without committing"
 //	@Success		200				{object}	domain.CreateUserResponse	"create user response"
 //	@Router			/organizations/{organizationId}/users [post]
 //	@Security		JWT
@@ -105,6 +116,16 @@ func (u UserHandler) Create(w http.ResponseWriter, r *http.Request) {
 		user.Roles = append(user.Roles, *v)
 	}
 
+	if helper.IsDryRun(r) {
+		changes, err := u.usecase.CreateDryRun(ctx, &user)
+		if err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+		ResponseJSON(w, r, http.StatusOK, domain.DryRunResponse{DryRun: true, Changes: changes})
+		return
+	}
+
 	resUser, err := u.usecase.Create(ctx, &user)
 	if err != nil {
 		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
@@ -186,10 +207,12 @@ func (u UserHandler) Get(w http.ResponseWriter, r *http.Request) {
 //	@Param			organizationId	path		string		true	"organizationId"
 //	@Param			pageSize		query		string		false	"pageSize"
 //	@Param			pageNumber		query		string		false	"pageNumber"
+//	@Param			limit			query		string		false	"limit (alias of pageSize)"
+//	@Param			offset			query		string		false	"offset (rows to skip)"
 //	@Param			soertColumn		query		string		false	"sortColumn"
 //	@Param			sortOrder		query		string		false	"sortOrder"
 //	@Param			filters			query		[]string	false	"filters"
-//	@Success		200				{object}	[]domain.ListUserBody
+//	@Success		200				{object}	domain.ListUserResponse
 //	@Router			/organizations/{organizationId}/users [get]
 //	@Security		JWT
 func (u UserHandler) List(w http.ResponseWriter, r *http.Request) {
@@ -202,7 +225,7 @@ func (u UserHandler) List(w http.ResponseWriter, r *http.Request) {
 
 	urlParams := r.URL.Query()
 	pg := pagination.NewPagination(&urlParams)
-	users, err := u.usecase.ListWithPagination(r.Context(), organizationId, pg)
+	users, err := u.usecase.List(r.Context(), organizationId, pg)
 	if err != nil {
 		log.Errorf(r.Context(), "error is :%s(%T)", err.Error(), err)
 		ErrorJSON(w, r, err)
@@ -249,6 +272,7 @@ func (u UserHandler) convertUserRolesToSimpleRoleResponse(roles []model.Role) []
 //	@Produce		json
 //	@Param			organizationId	path		string	true	"organizationId"
 //	@Param			accountId		path		string	true	"accountId"
+//	@Param			dryRun	query	bool	false	"validate only without committing"
 //	@Success		200				{object}	domain.DeleteUserResponse
 //	@Router			/organizations/{organizationId}/users/{accountId} [delete]
 //	@Security		JWT
@@ -265,6 +289,16 @@ func (u UserHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if helper.IsDryRun(r) {
+		changes, err := u.usecase.DeleteByAccountIdDryRun(r.Context(), userId, organizationId)
+		if err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+		ResponseJSON(w, r, http.StatusOK, domain.DryRunResponse{DryRun: true, Changes: changes})
+		return
+	}
+
 	err := u.usecase.DeleteByAccountId(r.Context(), userId, organizationId)
 	if err != nil {
 		if _, status := httpErrors.ErrorResponse(err); status == http.StatusNotFound {
@@ -293,6 +327,7 @@ func (u UserHandler) Delete(w http.ResponseWriter, r *http.Request) {
 //	@Param			organizationId	path		string						true	"organizationId"
 //	@Param			accountId		path		string						true	"accountId"
 //	@Param			body			body		domain.UpdateUserRequest	true	"input"
+//	@Param			dryRun	query	bool	false	"validate only without committing"
 //	@Success		200				{object}	domain.UpdateUserResponse
 //	@Router			/organizations/{organizationId}/users/{accountId} [put]
 //	@Security		JWT
@@ -337,6 +372,16 @@ func (u UserHandler) Update(w http.ResponseWriter, r *http.Request) {
 		user.Roles = append(user.Roles, *v)
 	}
 
+	if helper.IsDryRun(r) {
+		changes, err := u.usecase.UpdateByAccountIdByAdminDryRun(ctx, &user)
+		if err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+		ResponseJSON(w, r, http.StatusOK, domain.DryRunResponse{DryRun: true, Changes: changes})
+		return
+	}
+
 	resUser, err := u.usecase.UpdateByAccountIdByAdmin(ctx, &user)
 	if err != nil {
 		if _, status := httpErrors.ErrorResponse(err); status == http.StatusNotFound {
@@ -368,6 +413,7 @@ func (u UserHandler) Update(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Param			organizationId	path	string						true	"organizationId"
 //	@Param			body			body	[]domain.UpdateUsersRequest	true	"input"
+//	@Param			dryRun	query	bool	false	"validate only without committing"
 //	@Success		200
 //	@Router			/organizations/{organizationId}/users [put]
 //	@Security		JWT
@@ -389,6 +435,8 @@ func (u UserHandler) UpdateUsers(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	dryRun := helper.IsDryRun(r)
+	changes := []string{}
 
 	users := make([]model.User, len(input.Users))
 	for i, user := range input.Users {
@@ -411,6 +459,16 @@ func (u UserHandler) UpdateUsers(w http.ResponseWriter, r *http.Request) {
 			users[i].Roles = append(users[i].Roles, *v)
 		}
 
+		if dryRun {
+			userChanges, err := u.usecase.UpdateByAccountIdByAdminDryRun(r.Context(), &users[i])
+			if err != nil {
+				ErrorJSON(w, r, err)
+				return
+			}
+			changes = append(changes, userChanges...)
+			continue
+		}
+
 		//ToDo: Implement transaction
 		_, err := u.usecase.UpdateByAccountIdByAdmin(r.Context(), &users[i])
 		if err != nil {
@@ -424,6 +482,10 @@ func (u UserHandler) UpdateUsers(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if dryRun {
+		ResponseJSON(w, r, http.StatusOK, domain.DryRunResponse{DryRun: true, Changes: changes})
+		return
+	}
 	ResponseJSON(w, r, http.StatusOK, nil)
 }
 
@@ -547,6 +609,9 @@ func (u UserHandler) UpdateMyProfile(w http.ResponseWriter, r *http.Request) {
 	user.Name = input.Name
 	user.Email = input.Email
 	user.Department = input.Department
+	if input.Timezone != "" {
+		user.Timezone = input.Timezone
+	}
 
 	resUser, err := u.usecase.Update(ctx, user)
 	if err != nil {
@@ -845,6 +910,7 @@ func convertModelToMergedPermissionSetResponse(ctx context.Context, permission *
 //	@Produce		json
 //	@Param			organizationId	path		string							true	"organizationId"
 //	@Param			body			body		domain.Admin_CreateUserRequest	true	"create user request"
+//	@Param			dryRun	query	bool	false	"validate only without committing"
 //	@Success		200				{object}	domain.Admin_CreateUserResponse	"create user response"
 //	@Router			/admin/organizations/{organizationId}/users [post]
 //	@Security		JWT
@@ -902,7 +968,17 @@ func (u UserHandler) Admin_Create(w http.ResponseWriter, r *http.Request) {
 		ID: organizationId,
 	}
 
-	user.Password = u.usecase.GenerateRandomPassword(r.Context())
+	user.Password = u.usecase.GenerateRandomPassword(r.Context(), organizationId)
+
+	if helper.IsDryRun(r) {
+		changes, err := u.usecase.CreateDryRun(r.Context(), &user)
+		if err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+		ResponseJSON(w, r, http.StatusOK, domain.DryRunResponse{DryRun: true, Changes: changes})
+		return
+	}
 
 	resUser, err := u.usecase.Create(r.Context(), &user)
 	if err != nil {
@@ -940,6 +1016,7 @@ func (u UserHandler) Admin_Create(w http.ResponseWriter, r *http.Request) {
 //	@Param			body			body	domain.DeleteUserRequest	true	"input"
 //	@Param			organizationId	path	string						true	"organizationId"
 //	@Param			accountId		path	string						true	"accountId"
+//	@Param			dryRun	query	bool	false	"validate only without committing"
 //	@Success		200
 //	@Router			/admin/organizations/{organizationId}/users/{accountId} [delete]
 //	@Security		JWT
@@ -981,6 +1058,16 @@ func (u UserHandler) Admin_Delete(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if helper.IsDryRun(r) {
+		changes, err := u.usecase.DeleteByAccountIdDryRun(r.Context(), userId, organizationId)
+		if err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+		ResponseJSON(w, r, http.StatusOK, domain.DryRunResponse{DryRun: true, Changes: changes})
+		return
+	}
+
 	err := u.usecase.DeleteByAccountId(r.Context(), userId, organizationId)
 	if err != nil {
 		if _, status := httpErrors.ErrorResponse(err); status == http.StatusNotFound {
@@ -1006,6 +1093,7 @@ func (u UserHandler) Admin_Delete(w http.ResponseWriter, r *http.Request) {
 //	@Param			organizationId	path		string							true	"organizationId"
 //	@Param			accountId		path		string							true	"accountId"
 //	@Param			body			body		domain.Admin_UpdateUserRequest	true	"input"
+//	@Param			dryRun	query	bool	false	"validate only without committing"
 //	@Success		200				{object}	domain.Admin_UpdateUserResponse
 //	@Router			/admin/organizations/{organizationId}/users/{accountId} [put]
 //	@Security		JWT
@@ -1063,6 +1151,16 @@ func (u UserHandler) Admin_Update(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
+	if helper.IsDryRun(r) {
+		changes, err := u.usecase.UpdateByAccountIdByAdminDryRun(ctx, &user)
+		if err != nil {
+			ErrorJSON(w, r, err)
+			return
+		}
+		ResponseJSON(w, r, http.StatusOK, domain.DryRunResponse{DryRun: true, Changes: changes})
+		return
+	}
+
 	resUser, err := u.usecase.UpdateByAccountIdByAdmin(ctx, &user)
 	if err != nil {
 		if _, status := httpErrors.ErrorResponse(err); status == http.StatusNotFound {
@@ -72,6 +72,10 @@ func (s *Settings) Scope(db *gorm.DB, request *goyave.Request, dest interface{})
 		return nil, paginator.DB
 	}
 
+	// offset 이 주어지면 page 경계로 내림하지 않고 offset 부터 조회한다.
+	if request.Has("offset") {
+		return paginator, paginator.DB.Offset(request.Integer("offset")).Limit(pageSize).Find(dest)
+	}
 	return paginator, paginator.Find()
 }
 
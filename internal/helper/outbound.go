@@ -0,0 +1,90 @@
+package helper
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// IsForbiddenOutboundIP 는 사용자가 등록한 주소(webhook, 모니터링 endpoint 등)로 나가는 요청이 내부망과
+// metadata 서버(169.254.169.254)에 접근하지 못하도록 loopback, link-local, private 주소를 거부한다.
+func IsForbiddenOutboundIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		sharedAddressSpace.Contains(ip)
+}
+
+// ValidateOutboundUrl 은 url 의 host 가 허용된 주소인지 확인한다.
+func ValidateOutboundUrl(ctx context.Context, rawUrl string) error {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid url %s", rawUrl)
+	}
+	return ValidateOutboundHost(ctx, u.Hostname())
+}
+
+// ValidateOutboundHost 는 host 의 모든 주소를 확인한다. 등록할 때 이름을 해석할 수 없으면 통과시키고
+// 호출할 때 OutboundDialer 가 다시 확인한다.
+func ValidateOutboundHost(ctx context.Context, host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("forbidden outbound address %s", host)
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if IsForbiddenOutboundIP(ip) {
+			return fmt.Errorf("forbidden outbound address %s", host)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if IsForbiddenOutboundIP(addr.IP) {
+			return fmt.Errorf("forbidden outbound address %s (%s)", host, addr.IP)
+		}
+	}
+	return nil
+}
+
+// OutboundDialer 는 이름을 해석한 뒤 실제로 연결할 주소를 확인하므로 DNS rebinding 으로도 우회할 수 없다.
+func OutboundDialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{Timeout: timeout, Control: outboundControl}
+}
+
+// NewOutboundHttpClient 는 proxy 를 거치면 연결 주소를 확인할 수 없으므로 proxy 를 사용하지 않는다.
+func NewOutboundHttpClient(timeout time.Duration, tlsConfig *tls.Config) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         OutboundDialer(timeout).DialContext,
+			TLSClientConfig:     tlsConfig,
+			TLSHandshakeTimeout: timeout,
+		},
+	}
+}
+
+func outboundControl(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid outbound address %s", address)
+	}
+	if IsForbiddenOutboundIP(ip) {
+		return fmt.Errorf("forbidden outbound address %s", address)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package helper_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+)
+
+func TestValidateOutboundUrl(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{"https://203.0.113.10/hook", false},
+		{"http://127.0.0.1:8080/hook", true},
+		{"http://localhost/hook", true},
+		{"http://169.254.169.254/latest/meta-data/", true},
+		{"http://10.0.0.1/hook", true},
+		{"http://192.168.0.10/hook", true},
+		{"http://[::1]/hook", true},
+		{"http://[fe80::1]/hook", true},
+		{"http://100.64.0.1/hook", true},
+		{"http://0.0.0.0/hook", true},
+	}
+	for _, tt := range tests {
+		err := helper.ValidateOutboundUrl(context.Background(), tt.url)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateOutboundUrl(%s) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+		}
+	}
+}
+
+func TestOutboundHttpClientRejectsLoopback(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		_ = http.Serve(listener, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	}()
+
+	client := helper.NewOutboundHttpClient(time.Second, nil)
+	res, err := client.Get("http://" + listener.Addr().String())
+	if err == nil {
+		res.Body.Close()
+		t.Fatal("expected loopback address to be rejected at dial time")
+	}
+}
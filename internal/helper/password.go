@@ -2,8 +2,11 @@ package helper
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"golang.org/x/crypto/bcrypt"
 	"math/big"
+	"unicode"
 )
 
 func HashPassword(password string) (string, error) {
@@ -29,6 +32,58 @@ func GenerateRandomString(length int) string {
 	return string(b)
 }
 
+// GenerateRandomPassword returns a random string which contains upper, lower, digit and special characters at least once
+func GenerateRandomPassword(length int) string {
+	classes := [][]rune{
+		[]rune("abcdefghijklmnopqrstuvwxyz"),
+		[]rune("ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+		[]rune("0123456789"),
+		[]rune("!@#$%^&*"),
+	}
+	if length < len(classes) {
+		length = len(classes)
+	}
+
+	var letters []rune
+	b := make([]rune, length)
+	for i, chars := range classes {
+		b[i] = randomRune(chars)
+		letters = append(letters, chars...)
+	}
+	for i := len(classes); i < length; i++ {
+		b[i] = randomRune(letters)
+	}
+
+	// 각 문자 종류가 항상 앞자리에 오지 않도록 섞는다.
+	for i := length - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			panic(err)
+		}
+		j := int(n.Int64())
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
+
+// CountCharacterClasses returns how many of upper, lower, digit and special character classes the password contains
+func CountCharacterClasses(password string) int {
+	var upper, lower, digit, special int
+	for _, c := range password {
+		switch {
+		case unicode.IsUpper(c):
+			upper = 1
+		case unicode.IsLower(c):
+			lower = 1
+		case unicode.IsDigit(c):
+			digit = 1
+		default:
+			special = 1
+		}
+	}
+	return upper + lower + digit + special
+}
+
 func randomRune(chars []rune) rune {
 	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
 	if err != nil {
@@ -36,3 +91,9 @@ func randomRune(chars []rune) rune {
 	}
 	return chars[n.Int64()]
 }
+
+// HashToken returns the sha256 hex digest of an opaque token to be stored instead of the token itself
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,51 @@
+package helper_test
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+)
+
+func TestTotpCode(t *testing.T) {
+	// RFC 6238 Appendix B 의 SHA1 test vector 의 하위 6자리
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		code, err := helper.TotpCode(secret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Errorf("TotpCode() error = %v", err)
+			return
+		}
+		if code != tt.code {
+			t.Errorf("TotpCode(%d) = %s, want %s", tt.unix, code, tt.code)
+		}
+	}
+}
+
+func TestValidateTotp(t *testing.T) {
+	secret, err := helper.GenerateTotpSecret()
+	if err != nil {
+		t.Errorf("GenerateTotpSecret() error = %v", err)
+		return
+	}
+	now := time.Now()
+	code, _ := helper.TotpCode(secret, now.Add(-30*time.Second))
+	if !helper.ValidateTotp(secret, code, now) {
+		t.Errorf("ValidateTotp() should accept the code of the previous period")
+	}
+	code, _ = helper.TotpCode(secret, now.Add(-90*time.Second))
+	if helper.ValidateTotp(secret, code, now) {
+		t.Errorf("ValidateTotp() should reject the expired code")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
@@ -102,3 +103,9 @@ func DeepCopy(src, dest interface{}) error {
 
 	return json.Unmarshal(bytes, dest)
 }
+
+// IsDryRun reports whether the request asks to validate only without committing(?dryRun=true)
+func IsDryRun(r *http.Request) bool {
+	dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dryRun"))
+	return dryRun
+}
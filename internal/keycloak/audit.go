@@ -0,0 +1,173 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const auditGroup = "Keycloak"
+
+// auditedKeycloak 은 TKS 가 keycloak 에 요청하는 관리 작업을 감사 로그로 남긴다.
+// 조회와 로그인은 그대로 위임하고, realm/사용자/그룹/role 을 변경하는 요청만 기록한다.
+// DeleteAll 처럼 API 요청 없이 실행되는 작업도 기록되도록 API 감사 미들웨어와 별도로 동작한다.
+type auditedKeycloak struct {
+	IKeycloak
+
+	auditRepo        repository.IAuditRepository
+	userRepo         repository.IUserRepository
+	organizationRepo repository.IOrganizationRepository
+}
+
+func NewAuditedKeycloak(kc IKeycloak, r repository.Repository) IKeycloak {
+	return &auditedKeycloak{
+		IKeycloak:        kc,
+		auditRepo:        r.Audit,
+		userRepo:         r.User,
+		organizationRepo: r.Organization,
+	}
+}
+
+func (k *auditedKeycloak) CreateRealm(ctx context.Context, organizationId string) (string, error) {
+	out, err := k.IKeycloak.CreateRealm(ctx, organizationId)
+	k.record(ctx, organizationId, fmt.Sprintf("realm [%s] 을 생성", organizationId), err)
+	return out, err
+}
+
+func (k *auditedKeycloak) DeleteRealm(ctx context.Context, organizationId string) error {
+	err := k.IKeycloak.DeleteRealm(ctx, organizationId)
+	k.record(ctx, organizationId, fmt.Sprintf("realm [%s] 을 삭제", organizationId), err)
+	return err
+}
+
+func (k *auditedKeycloak) UpdateRealm(ctx context.Context, organizationId string, organizationConfig model.Organization) error {
+	err := k.IKeycloak.UpdateRealm(ctx, organizationId, organizationConfig)
+	k.record(ctx, organizationId, fmt.Sprintf("realm [%s] 을 수정", organizationId), err)
+	return err
+}
+
+func (k *auditedKeycloak) CreateUser(ctx context.Context, organizationId string, user *gocloak.User) (string, error) {
+	out, err := k.IKeycloak.CreateUser(ctx, organizationId, user)
+	k.record(ctx, organizationId, fmt.Sprintf("사용자 [%s] 를 생성", gocloak.PString(user.Username)), err)
+	return out, err
+}
+
+func (k *auditedKeycloak) DeleteUser(ctx context.Context, organizationId string, userAccountId string) error {
+	err := k.IKeycloak.DeleteUser(ctx, organizationId, userAccountId)
+	k.record(ctx, organizationId, fmt.Sprintf("사용자 [%s] 를 삭제", userAccountId), err)
+	return err
+}
+
+// UpdateUser 는 비밀번호 변경에도 사용되므로, credential 이 포함된 요청은 구분하여 기록한다. credential 값은 남기지 않는다.
+func (k *auditedKeycloak) UpdateUser(ctx context.Context, organizationId string, user *gocloak.User) error {
+	err := k.IKeycloak.UpdateUser(ctx, organizationId, user)
+	action := fmt.Sprintf("사용자 [%s] 를 수정", gocloak.PString(user.Username))
+	if user.Credentials != nil && len(*user.Credentials) > 0 {
+		action = fmt.Sprintf("사용자 [%s] 의 credential 을 변경", gocloak.PString(user.Username))
+	}
+	k.record(ctx, organizationId, action, err)
+	return err
+}
+
+func (k *auditedKeycloak) JoinGroup(ctx context.Context, organizationId string, userId string, groupName string) error {
+	err := k.IKeycloak.JoinGroup(ctx, organizationId, userId, groupName)
+	k.record(ctx, organizationId, fmt.Sprintf("사용자 [%s] 를 그룹 [%s] 에 추가", userId, groupName), err)
+	return err
+}
+
+func (k *auditedKeycloak) LeaveGroup(ctx context.Context, organizationId string, userId string, groupName string) error {
+	err := k.IKeycloak.LeaveGroup(ctx, organizationId, userId, groupName)
+	k.record(ctx, organizationId, fmt.Sprintf("사용자 [%s] 를 그룹 [%s] 에서 제외", userId, groupName), err)
+	return err
+}
+
+func (k *auditedKeycloak) CreateGroup(ctx context.Context, organizationId string, groupName string) (string, error) {
+	out, err := k.IKeycloak.CreateGroup(ctx, organizationId, groupName)
+	k.record(ctx, organizationId, fmt.Sprintf("그룹 [%s] 을 생성", groupName), err)
+	return out, err
+}
+
+func (k *auditedKeycloak) DeleteGroup(ctx context.Context, organizationId string, groupName string) error {
+	err := k.IKeycloak.DeleteGroup(ctx, organizationId, groupName)
+	k.record(ctx, organizationId, fmt.Sprintf("그룹 [%s] 을 삭제", groupName), err)
+	return err
+}
+
+func (k *auditedKeycloak) UpdateGroup(ctx context.Context, organizationId string, oldGroupName string, newGroupName string) error {
+	err := k.IKeycloak.UpdateGroup(ctx, organizationId, oldGroupName, newGroupName)
+	k.record(ctx, organizationId, fmt.Sprintf("그룹 [%s] 의 이름을 [%s] 로 변경", oldGroupName, newGroupName), err)
+	return err
+}
+
+func (k *auditedKeycloak) EnsureClientRoleWithClientName(ctx context.Context, organizationId string, clientName string, roleName string) error {
+	err := k.IKeycloak.EnsureClientRoleWithClientName(ctx, organizationId, clientName, roleName)
+	k.record(ctx, organizationId, fmt.Sprintf("client [%s] 에 role [%s] 을 생성", clientName, roleName), err)
+	return err
+}
+
+func (k *auditedKeycloak) DeleteClientRoleWithClientName(ctx context.Context, organizationId string, clientName string, roleName string) error {
+	err := k.IKeycloak.DeleteClientRoleWithClientName(ctx, organizationId, clientName, roleName)
+	k.record(ctx, organizationId, fmt.Sprintf("client [%s] 의 role [%s] 을 삭제", clientName, roleName), err)
+	return err
+}
+
+func (k *auditedKeycloak) AssignClientRoleToUser(ctx context.Context, organizationId string, userId string, clientName string, roleName string) error {
+	err := k.IKeycloak.AssignClientRoleToUser(ctx, organizationId, userId, clientName, roleName)
+	k.record(ctx, organizationId, fmt.Sprintf("사용자 [%s] 에게 client [%s] 의 role [%s] 을 부여", userId, clientName, roleName), err)
+	return err
+}
+
+func (k *auditedKeycloak) UnassignClientRoleToUser(ctx context.Context, organizationId string, userId string, clientName string, roleName string) error {
+	err := k.IKeycloak.UnassignClientRoleToUser(ctx, organizationId, userId, clientName, roleName)
+	k.record(ctx, organizationId, fmt.Sprintf("사용자 [%s] 의 client [%s] role [%s] 을 회수", userId, clientName, roleName), err)
+	return err
+}
+
+func (k *auditedKeycloak) SetClientScopeRolesToOptionalToTksClient(ctx context.Context, organizationId string) error {
+	err := k.IKeycloak.SetClientScopeRolesToOptionalToTksClient(ctx, organizationId)
+	k.record(ctx, organizationId, "tks client 의 client scope 를 변경", err)
+	return err
+}
+
+// record 는 요청한 TKS 사용자를 함께 기록한다. 사용자 정보가 없는 내부 작업은 system 으로 남긴다.
+// 감사 로그 저장에 실패해도 keycloak 작업의 결과는 바꾸지 않는다.
+func (k *auditedKeycloak) record(ctx context.Context, organizationId string, action string, err error) {
+	dto := model.Audit{
+		OrganizationId: organizationId,
+		Group:          auditGroup,
+		UserAccountId:  "system",
+	}
+	if err != nil {
+		dto.Message = fmt.Sprintf("[Keycloak] %s하는데 실패하였습니다.", action)
+		dto.Description = err.Error()
+	} else {
+		dto.Message = fmt.Sprintf("[Keycloak] %s하였습니다.", action)
+	}
+
+	if organization, err := k.organizationRepo.Get(ctx, organizationId); err == nil {
+		dto.OrganizationName = organization.Name
+	}
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.UserId = &userId
+		if user, err := k.userRepo.GetByUuid(ctx, userId); err == nil {
+			dto.UserAccountId = user.AccountId
+			dto.UserName = user.Name
+			for i, role := range user.Roles {
+				if i > 0 {
+					dto.UserRoles = dto.UserRoles + ","
+				}
+				dto.UserRoles = dto.UserRoles + role.Name
+			}
+		}
+	}
+
+	if _, err := k.auditRepo.Create(ctx, dto); err != nil {
+		log.Error(ctx, err)
+	}
+}
@@ -15,4 +15,5 @@ const (
 	AccessTokenLifespan   = 60 * 60 * 24 // 1 day
 	SsoSessionIdleTimeout = 60 * 60 * 24 // 1 day
 	SsoSessionMaxLifespan = 60 * 60 * 24 // 1 day
+	maxRealmUsers         = 10000
 )
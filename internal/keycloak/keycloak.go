@@ -20,6 +20,7 @@ type IKeycloak interface {
 	LoginAdmin(ctx context.Context, accountId string, password string) (*model.User, error)
 	Login(ctx context.Context, accountId string, password string, organizationId string) (*model.User, error)
 	Logout(ctx context.Context, sessionId string, organizationId string) error
+	RefreshToken(ctx context.Context, refreshToken string, organizationId string) (*model.User, error)
 
 	CreateRealm(ctx context.Context, organizationId string) (string, error)
 	GetRealm(ctx context.Context, organizationId string) (*model.Organization, error)
@@ -45,6 +46,9 @@ type IKeycloak interface {
 
 	VerifyAccessToken(ctx context.Context, token string, organizationId string) (bool, error)
 	GetSessions(ctx context.Context, userId string, organizationId string) (*[]string, error)
+	CheckRealm(ctx context.Context, organizationId string) error
+	IssueAdminToken(ctx context.Context) error
+	GetUserStatus(ctx context.Context, organizationId string) (lockedAccountIds []string, pendingAccountIds []string, err error)
 	SetClientScopeRolesToOptionalToTksClient(ctx context.Context, organizationId string) error
 }
 type Keycloak struct {
@@ -125,7 +129,18 @@ func (k *Keycloak) Login(ctx context.Context, accountId string, password string,
 		log.Error(ctx, err)
 		return nil, err
 	}
-	return &model.User{Token: JWTToken.AccessToken}, nil
+	return &model.User{Token: JWTToken.AccessToken, RefreshToken: JWTToken.RefreshToken, SessionId: JWTToken.SessionState}, nil
+}
+
+// RefreshToken 은 refresh token 으로 새 access token 을 발급한다.
+// realm 의 revokeRefreshToken 설정에 따라 새 refresh token 이 발급되고, 사용한 refresh token 은 더 이상 사용할 수 없다.
+func (k *Keycloak) RefreshToken(ctx context.Context, refreshToken string, organizationId string) (*model.User, error) {
+	JWTToken, err := k.client.RefreshToken(context.Background(), refreshToken, DefaultClientID, k.config.ClientSecret, organizationId)
+	if err != nil {
+		log.Error(ctx, err)
+		return nil, err
+	}
+	return &model.User{Token: JWTToken.AccessToken, RefreshToken: JWTToken.RefreshToken, SessionId: JWTToken.SessionState}, nil
 }
 
 func New(config *Config) IKeycloak {
@@ -450,6 +465,57 @@ func (k *Keycloak) Logout(ctx context.Context, sessionId string, organizationId
 	return nil
 }
 
+// CheckRealm 은 realm 의 OIDC issuer 정보를 조회하여 keycloak 에서 realm 을 사용할 수 있는지 확인한다.
+func (k *Keycloak) CheckRealm(ctx context.Context, organizationId string) error {
+	if _, err := k.client.GetIssuer(ctx, organizationId); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IssueAdminToken 은 API 가 keycloak 을 호출할 때 사용하는 admin token 을 새로 발급해 본다.
+// 발급한 token 은 사용하지 않으며, token 발급 지연 시간을 측정하는 용도이다.
+func (k *Keycloak) IssueAdminToken(ctx context.Context) error {
+	if _, err := k.client.LoginAdmin(ctx, k.config.AdminId, k.config.AdminPassword, DefaultMasterRealm); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetUserStatus 는 realm 에서 잠긴 사용자와 최초 로그인 후 처리할 작업이 남은 사용자의 accountId 를 반환한다.
+// 비활성화된 사용자와, realm 에 brute force detection 이 설정된 경우 로그인 실패로 잠긴 사용자를 잠긴 사용자로 본다.
+func (k *Keycloak) GetUserStatus(ctx context.Context, organizationId string) (lockedAccountIds []string, pendingAccountIds []string, err error) {
+	token := k.adminCliToken
+	realm, err := k.client.GetRealm(ctx, token.AccessToken, organizationId)
+	if err != nil {
+		return nil, nil, err
+	}
+	users, err := k.client.GetUsers(ctx, token.AccessToken, organizationId, gocloak.GetUsersParams{Max: gocloak.IntP(maxRealmUsers)})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bruteForceProtected := realm.BruteForceProtected != nil && *realm.BruteForceProtected
+	for _, user := range users {
+		accountId := gocloak.PString(user.Username)
+		locked := user.Enabled != nil && !*user.Enabled
+		if !locked && bruteForceProtected {
+			status, err := k.client.GetUserBruteForceDetectionStatus(ctx, token.AccessToken, organizationId, gocloak.PString(user.ID))
+			if err != nil {
+				return nil, nil, err
+			}
+			locked = status.Disabled != nil && *status.Disabled
+		}
+		if locked {
+			lockedAccountIds = append(lockedAccountIds, accountId)
+		}
+		if user.RequiredActions != nil && len(*user.RequiredActions) > 0 {
+			pendingAccountIds = append(pendingAccountIds, accountId)
+		}
+	}
+	return lockedAccountIds, pendingAccountIds, nil
+}
+
 func (k *Keycloak) JoinGroup(ctx context.Context, organizationId string, userId string, groupName string) error {
 	token := k.adminCliToken
 	groups, err := k.client.GetGroups(context.Background(), token.AccessToken, organizationId, gocloak.GetGroupsParams{
@@ -925,6 +991,9 @@ func defaultRealmSetting(realmId string) gocloak.RealmRepresentation {
 		AccessTokenLifespan:   gocloak.IntP(AccessTokenLifespan),
 		SsoSessionIdleTimeout: gocloak.IntP(SsoSessionIdleTimeout),
 		SsoSessionMaxLifespan: gocloak.IntP(SsoSessionMaxLifespan),
+		// refresh token 은 한 번만 사용할 수 있도록 매번 새로 발급한다.
+		RevokeRefreshToken:   gocloak.BoolP(true),
+		RefreshTokenMaxReuse: gocloak.IntP(0),
 	}
 }
 
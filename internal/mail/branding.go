@@ -0,0 +1,58 @@
+package mail
+
+import (
+	"context"
+	"strings"
+)
+
+const (
+	defaultBrandingDisplayName = "SKT Enterprise"
+	defaultBrandingLogoUrl     = "https://tks-static.s3.ap-northeast-2.amazonaws.com/tks-logo.avif"
+	defaultSubjectPrefix       = "TKS"
+)
+
+// Branding 은 메일에 표시할 조직의 브랜드 정보이다. 비어 있는 항목은 기본값을 사용한다.
+type Branding struct {
+	DisplayName    string
+	LogoUrl        string
+	SupportContact string
+	EmailFooter    string
+}
+
+// brandingResolver 는 조직의 브랜드 설정을 조회한다. 설정되지 않았거나 조직을 알 수 없는 메일은 기본 브랜드를 사용한다.
+var brandingResolver func(ctx context.Context, organizationId string) Branding
+
+func SetBrandingResolver(resolver func(ctx context.Context, organizationId string) Branding) {
+	brandingResolver = resolver
+}
+
+func getBranding(ctx context.Context, organizationId string) Branding {
+	branding := Branding{}
+	if brandingResolver != nil && organizationId != "" {
+		branding = brandingResolver(ctx, organizationId)
+	}
+	if branding.DisplayName == "" {
+		branding.DisplayName = defaultBrandingDisplayName
+	}
+	if branding.LogoUrl == "" {
+		branding.LogoUrl = defaultBrandingLogoUrl
+	}
+	return branding
+}
+
+// subject 는 "[TKS] " 대신 조직이 지정한 표시 이름을 제목 앞에 붙인다.
+func (b Branding) subject(text string) string {
+	prefix := defaultSubjectPrefix
+	if b.DisplayName != defaultBrandingDisplayName {
+		prefix = b.DisplayName
+	}
+	return "[" + prefix + "] " + text
+}
+
+// FooterLines 는 템플릿에서 줄바꿈을 유지하여 출력하기 위해 footer 를 줄 단위로 나눈다.
+func (b Branding) FooterLines() []string {
+	if strings.TrimSpace(b.EmailFooter) == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(b.EmailFooter, "\r\n", "\n"), "\n")
+}
@@ -3,13 +3,15 @@ package mail
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"html/template"
 
 	"github.com/openinfradev/tks-api/pkg/log"
 )
 
-func MakeVerityIdentityMessage(ctx context.Context, to, code string) (*MessageInfo, error) {
-	subject := "[TKS] [인증번호:" + code + "] 인증번호가 발급되었습니다."
+func MakeVerityIdentityMessage(ctx context.Context, organizationId, to, code string) (*MessageInfo, error) {
+	branding := getBranding(ctx, organizationId)
+	subject := branding.subject("[인증번호:" + code + "] 인증번호가 발급되었습니다.")
 
 	tmpl, err := template.ParseFS(templateFS, "contents/authcode.html")
 	if err != nil {
@@ -17,7 +19,7 @@ func MakeVerityIdentityMessage(ctx context.Context, to, code string) (*MessageIn
 		return nil, err
 	}
 
-	data := map[string]string{"AuthCode": code}
+	data := map[string]interface{}{"AuthCode": code, "Branding": branding}
 
 	var tpl bytes.Buffer
 	if err := tmpl.Execute(&tpl, data); err != nil {
@@ -36,7 +38,8 @@ func MakeVerityIdentityMessage(ctx context.Context, to, code string) (*MessageIn
 }
 
 func MakeTemporaryPasswordMessage(ctx context.Context, to, organizationId, accountId, randomPassword string) (*MessageInfo, error) {
-	subject := "[TKS] 임시 비밀번호가 발급되었습니다."
+	branding := getBranding(ctx, organizationId)
+	subject := branding.subject("임시 비밀번호가 발급되었습니다.")
 
 	tmpl, err := template.ParseFS(templateFS, "contents/temporary_password.html")
 	if err != nil {
@@ -44,7 +47,7 @@ func MakeTemporaryPasswordMessage(ctx context.Context, to, organizationId, accou
 		return nil, err
 	}
 
-	data := map[string]string{"TemporaryPassword": randomPassword, "OrganizationId": organizationId, "AccountId": accountId}
+	data := map[string]interface{}{"TemporaryPassword": randomPassword, "OrganizationId": organizationId, "AccountId": accountId, "Branding": branding}
 
 	var tpl bytes.Buffer
 	if err := tmpl.Execute(&tpl, data); err != nil {
@@ -66,7 +69,8 @@ func MakeGeneratingOrganizationMessage(
 	ctx context.Context,
 	organizationId string, organizationName string,
 	to string, userAccountId string, randomPassword string) (*MessageInfo, error) {
-	subject := "[TKS] 조직이 생성되었습니다."
+	branding := getBranding(ctx, organizationId)
+	subject := branding.subject("조직이 생성되었습니다.")
 
 	tmpl, err := template.ParseFS(templateFS, "contents/organization_creation.html")
 	if err != nil {
@@ -74,12 +78,13 @@ func MakeGeneratingOrganizationMessage(
 		return nil, err
 	}
 
-	data := map[string]string{
+	data := map[string]interface{}{
 		"OrganizationId":   organizationId,
 		"Id":               userAccountId,
 		"Password":         randomPassword,
 		"OrganizationName": organizationName,
 		"AdminName":        userAccountId,
+		"Branding":         branding,
 	}
 
 	var tpl bytes.Buffer
@@ -105,10 +110,11 @@ func MakeSystemNotificationMessage(ctx context.Context, organizationId string, t
 		return nil, err
 	}
 
-	data := map[string]string{
+	data := map[string]interface{}{
 		"OrganizationId": organizationId,
 		"Title":          title,
 		"Content":        content,
+		"Branding":       getBranding(ctx, organizationId),
 	}
 
 	var tpl bytes.Buffer
@@ -126,3 +132,45 @@ func MakeSystemNotificationMessage(ctx context.Context, organizationId string, t
 
 	return m, nil
 }
+
+type NotificationDigestItem struct {
+	Severity  string
+	Title     string
+	Content   string
+	ClusterId string
+	CreatedAt string
+}
+
+func MakeNotificationDigestMessage(ctx context.Context, organizationId string, period string, items []NotificationDigestItem, to []string) (*MessageInfo, error) {
+	branding := getBranding(ctx, organizationId)
+	subject := branding.subject(fmt.Sprintf("시스템 알림 요약 (%d건)", len(items)))
+
+	tmpl, err := template.ParseFS(templateFS, "contents/notification_digest.html")
+	if err != nil {
+		log.Errorf(ctx, "failed to parse template, %v", err)
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"OrganizationId": organizationId,
+		"Title":          subject,
+		"Period":         period,
+		"Items":          items,
+		"Branding":       branding,
+	}
+
+	var tpl bytes.Buffer
+	if err := tmpl.Execute(&tpl, data); err != nil {
+		log.Errorf(ctx, "failed to execute template, %v", err)
+		return nil, err
+	}
+
+	m := &MessageInfo{
+		From:    from,
+		To:      to,
+		Subject: subject,
+		Body:    tpl.String(),
+	}
+
+	return m, nil
+}
@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/gorilla/mux"
+	internalApi "github.com/openinfradev/tks-api/internal/delivery/api"
+)
+
+var verbTexts = map[string]string{
+	"Create":   "생성",
+	"Update":   "수정",
+	"Delete":   "삭제",
+	"Add":      "추가",
+	"Append":   "추가",
+	"Remove":   "제거",
+	"Set":      "설정",
+	"Install":  "설치",
+	"Import":   "등록",
+	"Approve":  "승인",
+	"Reject":   "거절",
+	"Revoke":   "회수",
+	"Reset":    "초기화",
+	"Rotate":   "교체",
+	"Renew":    "갱신",
+	"Cancel":   "취소",
+	"Apply":    "적용",
+	"Rollback": "롤백",
+	"Disable":  "비활성화",
+	"Enroll":   "등록",
+	"Archive":  "보관",
+	"Retry":    "재시도",
+	"Test":     "테스트",
+	"Warmup":   "준비",
+}
+
+var methodTexts = map[string]string{
+	http.MethodPost:   "요청",
+	http.MethodPut:    "수정",
+	http.MethodPatch:  "수정",
+	http.MethodDelete: "삭제",
+}
+
+var pathVariable = regexp.MustCompile(`{([^}:]+)(:[^}]*)?}`)
+
+func isMutation(method string) bool {
+	_, ok := methodTexts[method]
+	return ok
+}
+
+// genericAudit 은 ApiMap 의 verb, resource 와 요청 DTO 의 이름 필드로 감사 메시지를 만든다.
+// 이름 필드가 없는 요청은 경로의 마지막 변수(e.g. stackId)를 대상으로 기록한다.
+func genericAudit(r *http.Request, endpoint internalApi.Endpoint) fnAudit {
+	info := internalApi.ApiMap[endpoint]
+	verb, ok := verbTexts[info.Verb]
+	if !ok {
+		verb = methodTexts[r.Method]
+	}
+	target := lastPathVariable(r)
+	request := fmt.Sprintf("%s %s", r.Method, r.URL.Path)
+
+	return func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		name := target
+		if info.NameField != "" {
+			input := map[string]interface{}{}
+			if err := json.Unmarshal(in, &input); err == nil {
+				if v, ok := input[info.NameField].(string); ok && v != "" {
+					name = v
+				}
+			}
+		}
+
+		subject := info.Resource
+		if subject == "" {
+			subject = info.Group
+		}
+		if name != "" {
+			subject = fmt.Sprintf("%s [%s]", subject, name)
+		}
+
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("%s 을(를) %s하였습니다.", subject, verb), request
+		} else {
+			return fmt.Sprintf("%s 을(를) %s하는데 실패하였습니다. ", subject, verb), errorText(ctx, out)
+		}
+	}
+}
+
+// lastPathVariable 은 경로에서 가장 구체적인 대상을 가리키는 마지막 변수의 값을 반환한다. organizationId 는 제외한다.
+func lastPathVariable(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return ""
+	}
+	tpl, err := route.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+
+	vars := mux.Vars(r)
+	matches := pathVariable.FindAllStringSubmatch(tpl, -1)
+	for i := len(matches) - 1; i >= 0; i-- {
+		key := matches[i][1]
+		if key == "organizationId" {
+			continue
+		}
+		return vars[key]
+	}
+	return ""
+}
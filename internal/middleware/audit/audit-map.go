@@ -5,6 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	internalApi "github.com/openinfradev/tks-api/internal/delivery/api"
 	"github.com/openinfradev/tks-api/pkg/domain"
@@ -20,7 +24,9 @@ var auditMap = map[internalApi.Endpoint]fnAudit{
 		if err := json.Unmarshal(in, &input); err != nil {
 			log.Error(ctx, err)
 		}
-		if isSuccess(statusCode) {
+		if statusCode == http.StatusAccepted {
+			return fmt.Sprintf("스택 [%s] 생성을 대기열에 등록하였습니다.", input.Name), ""
+		} else if isSuccess(statusCode) {
 			return fmt.Sprintf("스택 [%s]을 생성하였습니다.", input.Name), ""
 		} else {
 			return fmt.Sprintf("스택 [%s]을 생성하는데 실패하였습니다.", input.Name), errorText(ctx, out)
@@ -35,6 +41,18 @@ var auditMap = map[internalApi.Endpoint]fnAudit{
 		} else {
 			return fmt.Sprintf("프로젝트 [%s]을 생성하는데 실패하였습니다.", input.Name), errorText(ctx, out)
 		}
+	}, internalApi.DeleteProject: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "프로젝트를 삭제하였습니다.", ""
+		} else {
+			return "프로젝트를 삭제하는데 실패하였습니다.", errorText(ctx, out)
+		}
+	}, internalApi.DeleteProjectNamespace: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "프로젝트 네임스페이스를 삭제하였습니다.", ""
+		} else {
+			return "프로젝트 네임스페이스를 삭제하는데 실패하였습니다.", errorText(ctx, out)
+		}
 	}, internalApi.CreateCloudAccount: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
 		input := domain.CreateCloudAccountRequest{}
 		if err := json.Unmarshal(in, &input); err != nil {
@@ -65,6 +83,16 @@ var auditMap = map[internalApi.Endpoint]fnAudit{
 		} else {
 			return "클라우드어카운트를 강제 삭제하는데 실패하였습니다. ", errorText(ctx, out)
 		}
+	}, internalApi.VerifyCloudAccount: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.VerifyCloudAccountResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("클라우드어카운트를 검증하였습니다. 결과: %s", output.CredentialStatus), output.CredentialStatusDesc
+		} else {
+			return "클라우드어카운트를 검증하는데 실패하였습니다. ", errorText(ctx, out)
+		}
 	}, internalApi.CreateUser: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
 		input := domain.CreateUserRequest{}
 		if err := json.Unmarshal(in, &input); err != nil {
@@ -85,6 +113,12 @@ var auditMap = map[internalApi.Endpoint]fnAudit{
 		} else {
 			return "사용자를 삭제하는데 실패하였습니다. ", errorText(ctx, out)
 		}
+	}, internalApi.DeleteUserSession: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "사용자 세션을 폐기하였습니다.", ""
+		} else {
+			return "사용자 세션을 폐기하는데 실패하였습니다.", errorText(ctx, out)
+		}
 	}, internalApi.Admin_CreateOrganization: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
 		input := domain.CreateOrganizationRequest{}
 		if err := json.Unmarshal(in, &input); err != nil {
@@ -101,7 +135,10 @@ var auditMap = map[internalApi.Endpoint]fnAudit{
 			if err := json.Unmarshal(out, &output); err != nil {
 				log.Error(ctx, err)
 			}
-			return fmt.Sprintf("조직 [ID:%s]를 삭제하였습니다.", output.ID), ""
+			if output.DryRun {
+				return fmt.Sprintf("조직 [ID:%s]의 삭제 계획을 조회하였습니다.", output.ID), ""
+			}
+			return fmt.Sprintf("조직 [ID:%s]를 삭제하였습니다.", output.ID), fmt.Sprintf("taskId : %s", output.TaskId)
 		} else {
 			return "조직을 삭제하는데 실패하였습니다. ", errorText(ctx, out)
 		}
@@ -115,6 +152,43 @@ var auditMap = map[internalApi.Endpoint]fnAudit{
 		} else {
 			return fmt.Sprintf("앱서빙 [%s]을 생성하는데 실패하였습니다.", input.Name), errorText(ctx, out)
 		}
+	}, internalApi.RollbackAppServeApp: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.RollbackAppServeAppResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("앱서빙을 버전 [%s]로 롤백하였습니다.", output.RollbackVersion), fmt.Sprintf("taskId : %s, operationId : %s", output.TaskId, output.OperationId)
+		} else {
+			return "앱서빙을 롤백하는데 실패하였습니다.", errorText(ctx, out)
+		}
+	}, internalApi.UpdateAppServeAppConfig: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		// secret 의 값은 감사 로그에 남기지 않고 key 만 기록한다.
+		input := domain.UpdateAppServeAppConfigRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		envKeys := make([]string, 0, len(input.Env))
+		for key := range input.Env {
+			envKeys = append(envKeys, key)
+		}
+		secretKeys := make([]string, 0, len(input.Secrets))
+		for key := range input.Secrets {
+			secretKeys = append(secretKeys, key)
+		}
+		sort.Strings(envKeys)
+		sort.Strings(secretKeys)
+		keys := fmt.Sprintf("env : [%s], secrets : [%s]", strings.Join(envKeys, ", "), strings.Join(secretKeys, ", "))
+
+		if isSuccess(statusCode) {
+			output := domain.UpdateAppServeAppConfigResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("앱서빙 환경 설정 revision [%d]을 저장하였습니다.", output.Revision), keys
+		} else {
+			return "앱서빙 환경 설정을 저장하는데 실패하였습니다.", errorText(ctx, out)
+		}
 	}, internalApi.Admin_CreateStackTemplate: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
 		input := domain.CreateStackTemplateRequest{}
 		if err := json.Unmarshal(in, &input); err != nil {
@@ -165,6 +239,471 @@ var auditMap = map[internalApi.Endpoint]fnAudit{
 		} else {
 			return "시스템알림설정을 삭제하는데 실패하였습니다. ", errorText(ctx, out)
 		}
+	}, internalApi.CreateClusterAccessRequest: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.CreateClusterAccessRequestRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("클러스터 [%s] 접근을 요청하였습니다.", input.AccessType), input.Reason
+		} else {
+			return fmt.Sprintf("클러스터 [%s] 접근을 요청하는데 실패하였습니다.", input.AccessType), errorText(ctx, out)
+		}
+	}, internalApi.ApproveClusterAccessRequest: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.GetClusterAccessRequestResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("[%s]의 클러스터 [%s] [%s] 접근을 승인하였습니다.", output.AccessRequest.Requester.AccountId, output.AccessRequest.ClusterId, output.AccessRequest.AccessType),
+				fmt.Sprintf("expires at %s", output.AccessRequest.ExpiresAt)
+		} else {
+			return "클러스터 접근 요청을 승인하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.RejectClusterAccessRequest: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.GetClusterAccessRequestResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("[%s]의 클러스터 [%s] [%s] 접근 요청을 거절하였습니다.", output.AccessRequest.Requester.AccountId, output.AccessRequest.ClusterId, output.AccessRequest.AccessType), output.AccessRequest.StatusDesc
+		} else {
+			return "클러스터 접근 요청을 거절하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.RevokeClusterAccessRequest: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.GetClusterAccessRequestResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("[%s]의 클러스터 [%s] [%s] 접근 권한을 회수하였습니다.", output.AccessRequest.Requester.AccountId, output.AccessRequest.ClusterId, output.AccessRequest.AccessType), ""
+		} else {
+			return "클러스터 접근 권한을 회수하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.Admin_UpdateStackDefault: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.UpdateStackDefaultRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return "스택 기본값을 설정하였습니다.", fmt.Sprintf("region : %s, vpcCidr : %s", input.Region, input.VpcCidr)
+		} else {
+			return "스택 기본값을 설정하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.Admin_UpdateOrganizationQuota: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.UpdateOrganizationQuotaRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return "조직 할당량을 설정하였습니다.", fmt.Sprintf("stacks : %d, nodes : %d, cpu : %d, memory : %dGiB, users : %d", input.MaxStacks, input.MaxNodes, input.MaxCpu, input.MaxMemoryGib, input.MaxUsers)
+		} else {
+			return "조직 할당량을 설정하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.UpdatePasswordPolicy: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.UpdatePasswordPolicyRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return "비밀번호 정책을 설정하였습니다.", fmt.Sprintf("minLength : %d, minCharacterClasses : %d, historyCount : %d, expiryDays : %d", input.MinLength, input.MinCharacterClasses, input.HistoryCount, input.ExpiryDays)
+		} else {
+			return "비밀번호 정책을 설정하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.ActivateMyOtp: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "2단계 인증을 등록하였습니다.", ""
+		} else {
+			return "2단계 인증을 등록하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.DisableMyOtp: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "2단계 인증을 해제하였습니다.", ""
+		} else {
+			return "2단계 인증을 해제하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.Admin_UpdateCostAllocationTagPolicies: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.UpdateCostAllocationTagPoliciesRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		keys := make([]string, len(input.Policies))
+		for i, policy := range input.Policies {
+			keys[i] = policy.Key
+		}
+		if isSuccess(statusCode) {
+			return "비용 할당 태그 정책을 설정하였습니다.", fmt.Sprintf("keys : %s", strings.Join(keys, ", "))
+		} else {
+			return "비용 할당 태그 정책을 설정하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.CreateLmaEndpoint: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.CreateLmaEndpointRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("LMA endpoint [%s]를 추가하였습니다.", input.Url), ""
+		} else {
+			return fmt.Sprintf("LMA endpoint [%s]를 추가하는데 실패하였습니다.", input.Url), errorText(ctx, out)
+		}
+	}, internalApi.UpdateLmaEndpoint: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.UpdateLmaEndpointRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("LMA endpoint [%s]를 수정하였습니다.", input.Url), ""
+		} else {
+			return fmt.Sprintf("LMA endpoint [%s]를 수정하는데 실패하였습니다.", input.Url), errorText(ctx, out)
+		}
+	}, internalApi.DeleteLmaEndpoint: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "LMA endpoint 를 삭제하였습니다.", ""
+		} else {
+			return "LMA endpoint 를 삭제하는데 실패하였습니다.", errorText(ctx, out)
+		}
+	}, internalApi.UpdateLmaAuth: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "LMA 접속 설정을 수정하였습니다.", ""
+		} else {
+			return "LMA 접속 설정을 수정하는데 실패하였습니다.", errorText(ctx, out)
+		}
+	}, internalApi.CreateRoleReassignment: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.CreateRoleReassignmentResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("역할 [%s]의 사용자를 역할 [%s]로 일괄 변경하는 작업을 시작하였습니다.", output.RoleReassignment.SourceRoleName, output.RoleReassignment.TargetRoleName), ""
+		} else {
+			return "역할 일괄 변경 작업을 시작하는데 실패하였습니다.", errorText(ctx, out)
+		}
+	}, internalApi.UpdateLmaDiscovery: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.UpdateLmaDiscoveryRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("LMA 주소 탐색 방법을 [%s]로 수정하였습니다.", input.Method), ""
+		} else {
+			return "LMA 주소 탐색 설정을 수정하는데 실패하였습니다.", errorText(ctx, out)
+		}
+	}, internalApi.CreateEncryptionKey: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.CreateEncryptionKeyRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("암호화 키 [%s]를 등록하였습니다.", input.KeyArn), ""
+		} else {
+			return fmt.Sprintf("암호화 키 [%s]를 등록하는데 실패하였습니다.", input.KeyArn), errorText(ctx, out)
+		}
+	}, internalApi.RotateEncryptionKey: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.RotateEncryptionKeyRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			output := domain.RotateEncryptionKeyResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("암호화 키를 [%s]로 교체하였습니다.", input.KeyArn), fmt.Sprintf("sealed : %d, failed : %d", output.SealedSecrets, output.FailedSecrets)
+		} else {
+			return fmt.Sprintf("암호화 키를 [%s]로 교체하는데 실패하였습니다.", input.KeyArn), errorText(ctx, out)
+		}
+	}, internalApi.RevokeEncryptionKey: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "암호화 키를 폐기하였습니다.", ""
+		} else {
+			return "암호화 키를 폐기하는데 실패하였습니다.", errorText(ctx, out)
+		}
+	}, internalApi.CancelOperation: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "대기 중인 작업을 취소하였습니다.", ""
+		} else {
+			return "대기 중인 작업을 취소하는데 실패하였습니다.", errorText(ctx, out)
+		}
+	}, internalApi.CreateCustomChartDashboard: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.CreateCustomChartRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("사용자 정의 차트 [%s]를 생성하였습니다.", input.Name), ""
+		} else {
+			return fmt.Sprintf("사용자 정의 차트 [%s]를 생성하는데 실패하였습니다.", input.Name), errorText(ctx, out)
+		}
+	}, internalApi.CreateChartSnapshotDashboard: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.CreateChartSnapshotRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		chart := input.ChartType
+		if input.CustomChartId != "" {
+			chart = input.CustomChartId
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("차트 [%s]의 공유 스냅샷을 생성하였습니다.", chart), ""
+		} else {
+			return fmt.Sprintf("차트 [%s]의 공유 스냅샷을 생성하는데 실패하였습니다.", chart), errorText(ctx, out)
+		}
+	}, internalApi.DeleteChartSnapshotDashboard: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "차트 공유 스냅샷을 삭제하였습니다.", ""
+		} else {
+			return "차트 공유 스냅샷을 삭제하는데 실패하였습니다.", errorText(ctx, out)
+		}
+	}, internalApi.ApplyManifests: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.ApplyManifestsResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			changes := []string{}
+			for _, result := range output.Results {
+				if result.Action != domain.ManifestApplyAction_UNCHANGED {
+					changes = append(changes, fmt.Sprintf("%s/%s : %s", result.Kind, result.Name, result.Action))
+				}
+			}
+			return fmt.Sprintf("manifest %d 건을 적용하였습니다.", len(output.Results)), strings.Join(changes, ", ")
+		} else {
+			return "manifest 를 적용하는데 실패하였습니다.", errorText(ctx, out)
+		}
+	}, internalApi.Admin_DeleteStackDefault: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "스택 기본값을 삭제하였습니다.", ""
+		} else {
+			return "스택 기본값을 삭제하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.UpdateDeploymentApprovalPolicy: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.UpdateDeploymentApprovalPolicyRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return "배포 승인 정책을 설정하였습니다.", fmt.Sprintf("approvers : %s", strings.Join(input.ApproverIds, ","))
+		} else {
+			return "배포 승인 정책을 설정하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.DeleteDeploymentApprovalPolicy: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "배포 승인 정책을 삭제하였습니다.", ""
+		} else {
+			return "배포 승인 정책을 삭제하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.CreateMaintenanceWindow: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.CreateMaintenanceWindowRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("유지보수 일정 [%s]을 등록하였습니다.", input.Name), fmt.Sprintf("%s ~ %s", input.StartAt.Format(time.RFC3339), input.EndAt.Format(time.RFC3339))
+		} else {
+			return fmt.Sprintf("유지보수 일정 [%s]을 등록하는데 실패하였습니다. ", input.Name), errorText(ctx, out)
+		}
+	}, internalApi.UpdateMaintenanceWindow: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.UpdateMaintenanceWindowRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("유지보수 일정 [%s]을 수정하였습니다.", input.Name), fmt.Sprintf("%s ~ %s", input.StartAt.Format(time.RFC3339), input.EndAt.Format(time.RFC3339))
+		} else {
+			return fmt.Sprintf("유지보수 일정 [%s]을 수정하는데 실패하였습니다. ", input.Name), errorText(ctx, out)
+		}
+	}, internalApi.DeleteMaintenanceWindow: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "유지보수 일정을 삭제하였습니다.", ""
+		} else {
+			return "유지보수 일정을 삭제하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.CreateStackMonitoringEndpoint: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.CreateStackMonitoringEndpointRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("모니터링 endpoint [%s]를 등록하였습니다.", input.Name), input.Url
+		} else {
+			return fmt.Sprintf("모니터링 endpoint [%s]를 등록하는데 실패하였습니다. ", input.Name), errorText(ctx, out)
+		}
+	}, internalApi.UpdateStackMonitoringEndpoint: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.UpdateStackMonitoringEndpointRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("모니터링 endpoint [%s]를 수정하였습니다.", input.Name), input.Url
+		} else {
+			return fmt.Sprintf("모니터링 endpoint [%s]를 수정하는데 실패하였습니다. ", input.Name), errorText(ctx, out)
+		}
+	}, internalApi.DeleteStackMonitoringEndpoint: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "모니터링 endpoint 를 삭제하였습니다.", ""
+		} else {
+			return "모니터링 endpoint 를 삭제하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.Admin_CreateHelmRepository: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.CreateHelmRepositoryRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("Helm repository [%s]를 등록하였습니다.", input.Name), input.Url
+		} else {
+			return fmt.Sprintf("Helm repository [%s]를 등록하는데 실패하였습니다. ", input.Name), errorText(ctx, out)
+		}
+	}, internalApi.Admin_DeleteHelmRepository: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "Helm repository 를 삭제하였습니다.", ""
+		} else {
+			return "Helm repository 를 삭제하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.CreateCatalogApp: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.CreateCatalogAppRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			output := domain.CreateCatalogAppResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("catalog 앱 [%s/%s]을 배포하였습니다.", input.Namespace, input.ReleaseName), output.OperationId
+		} else {
+			return fmt.Sprintf("catalog 앱 [%s/%s]을 배포하는데 실패하였습니다. ", input.Namespace, input.ReleaseName), errorText(ctx, out)
+		}
+	}, internalApi.CreateGitProvider: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		// token 은 감사 로그에 남기지 않는다.
+		input := domain.CreateGitProviderRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("Git provider [%s]를 등록하였습니다.", input.Name), fmt.Sprintf("type : %s, url : %s", input.Type, input.Url)
+		} else {
+			return fmt.Sprintf("Git provider [%s]를 등록하는데 실패하였습니다. ", input.Name), errorText(ctx, out)
+		}
+	}, internalApi.DeleteGitProvider: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "Git provider 를 삭제하였습니다.", ""
+		} else {
+			return "Git provider 를 삭제하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.UpdateAppServeAppGitSource: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.UpdateAppServeAppGitSourceRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("앱서빙의 git 소스를 [%s:%s]로 설정하였습니다.", input.Repository, input.Branch), fmt.Sprintf("autoDeploy : %t, rotateWebhookSecret : %t", input.AutoDeploy, input.RotateWebhookSecret)
+		} else {
+			return "앱서빙의 git 소스를 설정하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.BuildAppServeApp: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.BuildAppServeAppResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("앱서빙을 commit [%s]로 빌드하였습니다.", output.Commit), fmt.Sprintf("version : %s", output.Version)
+		} else {
+			return "앱서빙을 빌드하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.ScaleStackNodeGroup: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.ScaleStackNodeGroupResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("스택 [%s]의 node group [%s] 노드 수를 %d에서 %d로 변경하였습니다.", output.StackName, output.NodeGroup, output.PreviousSize, output.DesiredSize), output.OperationId
+		} else {
+			return "스택의 node group 노드 수를 변경하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.UpgradeStack: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.UpgradeStackResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("스택 [%s]의 업그레이드를 시작하였습니다.", output.Upgrade.StackId),
+				fmt.Sprintf("kubernetes %s -> %s (%s)", output.Upgrade.FromKubeVersion, output.Upgrade.ToKubeVersion, output.Upgrade.ToStackTemplate.Name)
+		} else {
+			return "스택의 업그레이드를 시작하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.ApproveDeployment: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.GetDeploymentApprovalResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("앱 [%s]의 [%s]를 승인하였습니다.", output.Approval.AppServeApp.Name, output.Approval.Action), output.Approval.Comment
+		} else {
+			return "배포 요청을 승인하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.RejectDeployment: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.GetDeploymentApprovalResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("앱 [%s]의 [%s]를 거절하였습니다.", output.Approval.AppServeApp.Name, output.Approval.Action), output.Approval.Comment
+		} else {
+			return "배포 요청을 거절하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.CreateAlertIngestionToken: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.CreateAlertIngestionTokenRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("앨럿 수신 토큰 [%s]를 생성하였습니다.", input.Name), ""
+		} else {
+			return fmt.Sprintf("앨럿 수신 토큰 [%s]를 생성하는데 실패하였습니다.", input.Name), errorText(ctx, out)
+		}
+	}, internalApi.RotateAlertIngestionToken: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			output := domain.RotateAlertIngestionTokenResponse{}
+			if err := json.Unmarshal(out, &output); err != nil {
+				log.Error(ctx, err)
+			}
+			return fmt.Sprintf("앨럿 수신 토큰 [%s]를 재발급하였습니다.", output.ID), ""
+		} else {
+			return "앨럿 수신 토큰을 재발급하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.RevokeAlertIngestionToken: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "앨럿 수신 토큰을 폐기하였습니다.", ""
+		} else {
+			return "앨럿 수신 토큰을 폐기하는데 실패하였습니다. ", errorText(ctx, out)
+		}
+	}, internalApi.CreateAlertRoutingRule: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.CreateAlertRoutingRuleRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("앨럿 라우팅 규칙 [%s]를 생성하였습니다.", input.Name), ""
+		} else {
+			return fmt.Sprintf("앨럿 라우팅 규칙 [%s]를 생성하는데 실패하였습니다.", input.Name), errorText(ctx, out)
+		}
+	}, internalApi.UpdateAlertRoutingRule: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		input := domain.UpdateAlertRoutingRuleRequest{}
+		if err := json.Unmarshal(in, &input); err != nil {
+			log.Error(ctx, err)
+		}
+		if isSuccess(statusCode) {
+			return fmt.Sprintf("앨럿 라우팅 규칙 [%s]를 수정하였습니다.", input.Name), ""
+		} else {
+			return fmt.Sprintf("앨럿 라우팅 규칙 [%s]를 수정하는데 실패하였습니다.", input.Name), errorText(ctx, out)
+		}
+	}, internalApi.DeleteAlertRoutingRule: func(ctx context.Context, out []byte, in []byte, statusCode int) (message string, description string) {
+		if isSuccess(statusCode) {
+			return "앨럿 라우팅 규칙을 삭제하였습니다.", ""
+		} else {
+			return "앨럿 라우팅 규칙을 삭제하는데 실패하였습니다. ", errorText(ctx, out)
+		}
 	},
 }
 
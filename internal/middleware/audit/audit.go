@@ -8,6 +8,7 @@ import (
 
 	"github.com/gorilla/mux"
 	internalApi "github.com/openinfradev/tks-api/internal/delivery/api"
+	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/middleware/logging"
 	"github.com/openinfradev/tks-api/internal/model"
@@ -50,49 +51,59 @@ func (a *defaultAudit) WithAudit(endpoint internalApi.Endpoint, handler http.Han
 			organizationId = user.GetOrganizationId()
 		}
 
-		message, description := "", ""
-		if fn, ok := auditMap[endpoint]; ok {
-			// workarround pingtoken
-			if endpoint != internalApi.VerifyToken {
-				body, err := io.ReadAll(r.Body)
-				if err != nil {
-					log.Error(r.Context(), err)
-				}
-				message, description = fn(r.Context(), lrw.GetBody().Bytes(), body, statusCode)
-				r.Body = io.NopCloser(bytes.NewBuffer(body))
-
-				u, err := a.userRepo.GetByUuid(r.Context(), userId)
-				if err != nil {
-					log.Error(r.Context(), err)
-					return
-				}
-
-				userRoles := ""
-				for i, role := range u.Roles {
-					if i > 0 {
-						userRoles = userRoles + ","
-					}
-					userRoles = userRoles + role.Name
-				}
-
-				dto := model.Audit{
-					OrganizationId:   organizationId,
-					OrganizationName: u.Organization.Name,
-					Group:            internalApi.ApiMap[endpoint].Group,
-					Message:          message,
-					Description:      description,
-					ClientIP:         GetClientIpAddress(w, r),
-					UserId:           &u.ID,
-					UserAccountId:    u.AccountId,
-					UserName:         u.Name,
-					UserRoles:        userRoles,
-				}
-				if _, err := a.repo.Create(r.Context(), dto); err != nil {
-					log.Error(r.Context(), err)
-				}
+		// auditMap 에 없는 endpoint 는 endpoint metadata 로 메시지를 생성한다. 조회 요청은 override 가 있을 때만 기록한다.
+		fn, ok := auditMap[endpoint]
+		if !ok {
+			if !isMutation(r.Method) {
+				return
+			}
+			fn = genericAudit(r, endpoint)
+		}
+		// workarround pingtoken
+		if endpoint == internalApi.VerifyToken {
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			log.Error(r.Context(), err)
+		}
+		message, description := fn(r.Context(), lrw.GetBody().Bytes(), body, statusCode)
+		r.Body = io.NopCloser(bytes.NewBuffer(body))
+		if helper.IsDryRun(r) {
+			message = "[DRY-RUN] " + message
+		}
+
+		u, err := a.userRepo.GetByUuid(r.Context(), userId)
+		if err != nil {
+			log.Error(r.Context(), err)
+			return
+		}
+
+		userRoles := ""
+		for i, role := range u.Roles {
+			if i > 0 {
+				userRoles = userRoles + ","
 			}
+			userRoles = userRoles + role.Name
 		}
 
+		dto := model.Audit{
+			OrganizationId:   organizationId,
+			OrganizationName: u.Organization.Name,
+			Group:            internalApi.ApiMap[endpoint].Group,
+			Message:          message,
+			Description:      description,
+			ClientIP:         GetClientIpAddress(w, r),
+			UserId:           &u.ID,
+			UserAccountId:    u.AccountId,
+			UserName:         u.Name,
+			UserRoles:        userRoles,
+			StatusCode:       statusCode,
+		}
+		if _, err := a.repo.Create(r.Context(), dto); err != nil {
+			log.Error(r.Context(), err)
+		}
 	})
 }
 
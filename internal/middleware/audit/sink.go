@@ -0,0 +1,218 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/internal/serializer"
+	auditsink "github.com/openinfradev/tks-api/pkg/audit-sink"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const (
+	sinkQueueSize    = 1000
+	sinkWorkerCount  = 4
+	sinkMaxAttempts  = 3
+	sinkRetryBackoff = time.Second
+	// sink 설정을 변경하면 이 시간 안에 반영된다.
+	sinkCacheTTL = 30 * time.Second
+)
+
+// sinkForwarder 는 감사 로그를 저장한 뒤 조직에 설정된 sink 로 비동기 전달한다.
+// 전달은 요청 처리와 분리된 worker 에서 재시도하며, 재시도 후에도 실패하면 dead letter 로 남긴다.
+type sinkForwarder struct {
+	repository.IAuditRepository
+
+	sinkRepo   repository.IAuditSinkRepository
+	openSecret SecretOpener
+	queue      chan model.Audit
+
+	mu    sync.Mutex
+	cache map[string]cachedSinks
+}
+
+type cachedSinks struct {
+	sinks     []model.AuditSink
+	expiredAt time.Time
+}
+
+// SecretOpener 는 봉인된 sink 의 secret 을 복호화한다.
+type SecretOpener func(ctx context.Context, value []byte) ([]byte, error)
+
+// WithSinks 는 audit repository 를 감싸서 기록되는 모든 감사 로그를 sink 로 전달한다.
+// API 요청의 감사 로그뿐 아니라 keycloak 작업처럼 repository 로 직접 기록하는 감사 로그도 전달된다.
+func WithSinks(repo repository.Repository, openSecret SecretOpener) repository.IAuditRepository {
+	f := &sinkForwarder{
+		IAuditRepository: repo.Audit,
+		sinkRepo:         repo.AuditSink,
+		openSecret:       openSecret,
+		queue:            make(chan model.Audit, sinkQueueSize),
+		cache:            make(map[string]cachedSinks),
+	}
+	for i := 0; i < sinkWorkerCount; i++ {
+		go f.run()
+	}
+	return f
+}
+
+func (f *sinkForwarder) Create(ctx context.Context, dto model.Audit) (auditId uuid.UUID, err error) {
+	if dto.CreatedAt.IsZero() {
+		dto.CreatedAt = time.Now()
+	}
+	auditId, err = f.IAuditRepository.Create(ctx, dto)
+	if err != nil {
+		return auditId, err
+	}
+	dto.ID = auditId
+	f.enqueue(ctx, dto)
+	return auditId, nil
+}
+
+func (f *sinkForwarder) CreateInBatches(ctx context.Context, dtos []model.Audit, batchSize int) error {
+	if err := f.IAuditRepository.CreateInBatches(ctx, dtos, batchSize); err != nil {
+		return err
+	}
+	for _, dto := range dtos {
+		f.enqueue(ctx, dto)
+	}
+	return nil
+}
+
+// enqueue 는 요청을 지연시키지 않도록 queue 가 가득 차면 전달하지 않고 바로 dead letter 로 남긴다.
+func (f *sinkForwarder) enqueue(ctx context.Context, audit model.Audit) {
+	select {
+	case f.queue <- audit:
+	default:
+		log.Warnf(ctx, "audit sink queue is full. audit %s is stored as dead letter", audit.ID)
+		sinks, err := f.sinks(ctx, audit.OrganizationId)
+		if err != nil {
+			log.Error(ctx, err)
+			return
+		}
+		payload, err := auditPayload(ctx, audit)
+		if err != nil {
+			log.Error(ctx, err)
+			return
+		}
+		for _, sink := range sinks {
+			f.deadLetter(ctx, sink, audit, payload, 0, "audit sink queue is full")
+		}
+	}
+}
+
+func (f *sinkForwarder) run() {
+	for audit := range f.queue {
+		f.forward(context.Background(), audit)
+	}
+}
+
+func (f *sinkForwarder) forward(ctx context.Context, audit model.Audit) {
+	sinks, err := f.sinks(ctx, audit.OrganizationId)
+	if err != nil {
+		log.Error(ctx, err)
+		return
+	}
+	if len(sinks) == 0 {
+		return
+	}
+
+	payload, err := auditPayload(ctx, audit)
+	if err != nil {
+		log.Error(ctx, err)
+		return
+	}
+	for _, sink := range sinks {
+		secret, err := f.openSecret(ctx, []byte(sink.Secret))
+		if err != nil {
+			f.deadLetter(ctx, sink, audit, payload, 0, err.Error())
+			continue
+		}
+		sink.Secret = string(secret)
+		client, err := auditsink.New(sinkConfig(sink))
+		if err != nil {
+			f.deadLetter(ctx, sink, audit, payload, 0, err.Error())
+			continue
+		}
+
+		backoff := sinkRetryBackoff
+		for attempt := 1; ; attempt++ {
+			err = client.Send(ctx, payload)
+			if err == nil {
+				f.updateLastStatus(ctx, sink, "")
+				break
+			}
+			if attempt >= sinkMaxAttempts {
+				log.Warnf(ctx, "failed to forward audit %s to sink %s. err : %s", audit.ID, sink.ID, err)
+				f.deadLetter(ctx, sink, audit, payload, attempt, err.Error())
+				break
+			}
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+}
+
+func (f *sinkForwarder) deadLetter(ctx context.Context, sink model.AuditSink, audit model.Audit, payload []byte, attempts int, reason string) {
+	_, err := f.sinkRepo.CreateDeadLetter(ctx, model.AuditSinkDeadLetter{
+		OrganizationId: sink.OrganizationId,
+		AuditSinkId:    sink.ID,
+		AuditId:        audit.ID,
+		Payload:        payload,
+		Attempts:       attempts,
+		Error:          reason,
+	})
+	if err != nil {
+		log.Error(ctx, err)
+	}
+	f.updateLastStatus(ctx, sink, reason)
+}
+
+// updateLastStatus 는 전달 결과를 sink 의 전달 횟수에 기록한다. reason 이 비어 있으면 성공이다.
+func (f *sinkForwarder) updateLastStatus(ctx context.Context, sink model.AuditSink, reason string) {
+	status := domain.AuditSinkStatus_SUCCEEDED
+	if reason != "" {
+		status = domain.AuditSinkStatus_FAILED
+	}
+	if err := f.sinkRepo.UpdateLastStatus(ctx, sink.ID, status, reason, time.Now()); err != nil {
+		log.Error(ctx, err)
+	}
+}
+
+func (f *sinkForwarder) sinks(ctx context.Context, organizationId string) ([]model.AuditSink, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if cached, ok := f.cache[organizationId]; ok && time.Now().Before(cached.expiredAt) {
+		return cached.sinks, nil
+	}
+	sinks, err := f.sinkRepo.FetchEnabled(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	f.cache[organizationId] = cachedSinks{sinks: sinks, expiredAt: time.Now().Add(sinkCacheTTL)}
+	return sinks, nil
+}
+
+func sinkConfig(sink model.AuditSink) auditsink.Config {
+	return auditsink.Config{
+		Type:     sink.Type,
+		Endpoint: sink.Endpoint,
+		Topic:    sink.Topic,
+		Secret:   sink.Secret,
+	}
+}
+
+// auditPayload 는 감사 로그 조회 API 와 같은 형식으로 직렬화한다.
+func auditPayload(ctx context.Context, audit model.Audit) ([]byte, error) {
+	var out domain.AuditResponse
+	if err := serializer.Map(ctx, audit, &out); err != nil {
+		return nil, err
+	}
+	return json.Marshal(out)
+}
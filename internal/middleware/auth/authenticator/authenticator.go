@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"github.com/openinfradev/tks-api/internal/repository"
 	"net/http"
+	"sync"
+	"time"
 
+	"github.com/gorilla/websocket"
 	internalHttp "github.com/openinfradev/tks-api/internal/delivery/http"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/user"
@@ -19,10 +22,15 @@ type Request interface {
 	AuthenticateRequest(req *http.Request) (*Response, bool, error)
 }
 
+// session 의 마지막 활동 시각은 요청마다 저장하지 않고 이 주기로 갱신한다.
+const sessionActivityInterval = time.Minute
+
 type defaultAuthenticator struct {
 	kcAuth     Request
 	customAuth Request
 	repo       repository.Repository
+
+	sessionActivities sync.Map
 }
 
 func NewAuthenticator(kc Request, repo repository.Repository, c Request) *defaultAuthenticator {
@@ -35,6 +43,8 @@ func NewAuthenticator(kc Request, repo repository.Repository, c Request) *defaul
 
 func (a *defaultAuthenticator) WithAuthentication(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		setWebSocketBearerToken(r)
+
 		resp, ok, err := a.kcAuth.AuthenticateRequest(r)
 		if !ok {
 			log.Error(r.Context(), err)
@@ -64,15 +74,52 @@ func (a *defaultAuthenticator) WithAuthentication(handler http.Handler) http.Han
 			internalHttp.ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("token not found"), "", ""))
 			return
 		}
-		_, ok = request.SessionFrom(r.Context())
+		sessionId, ok := request.SessionFrom(r.Context())
 		if !ok {
 			internalHttp.ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("session not found"), "", ""))
 			return
 		}
+		a.touchSession(r, sessionId)
 		handler.ServeHTTP(w, r)
 	})
 }
 
+func (a *defaultAuthenticator) touchSession(r *http.Request, sessionId string) {
+	now := time.Now()
+	last, ok := a.sessionActivities.Load(sessionId)
+	if ok && now.Sub(last.(time.Time)) < sessionActivityInterval {
+		return
+	}
+	if !ok {
+		// 처음 보는 session 일 때 주기가 지난 항목을 정리하여 종료된 session 이 남지 않도록 한다.
+		a.sessionActivities.Range(func(key, value any) bool {
+			if now.Sub(value.(time.Time)) >= sessionActivityInterval {
+				a.sessionActivities.Delete(key)
+			}
+			return true
+		})
+	}
+	a.sessionActivities.Store(sessionId, now)
+	if err := a.repo.UserSession.UpdateLastActivity(r.Context(), sessionId, now); err != nil {
+		log.Error(r.Context(), err)
+	}
+}
+
+// setWebSocketBearerToken 은 브라우저의 WebSocket 이 헤더를 지정할 수 없으므로
+// Sec-WebSocket-Protocol 에 "bearer, <token>" 으로 전달된 토큰을 Authorization 헤더로 옮긴다.
+func setWebSocketBearerToken(r *http.Request) {
+	if r.Header.Get("Authorization") != "" || !websocket.IsWebSocketUpgrade(r) {
+		return
+	}
+	protocols := websocket.Subprotocols(r)
+	for i, protocol := range protocols {
+		if protocol == "bearer" && i+1 < len(protocols) {
+			r.Header.Set("Authorization", "Bearer "+protocols[i+1])
+			return
+		}
+	}
+}
+
 type Response struct {
 	User user.Info
 }
@@ -1,8 +1,13 @@
 package authorizer
 
 import (
-	"github.com/openinfradev/tks-api/internal/repository"
+	"fmt"
 	"net/http"
+
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
 )
 
 type Interface interface {
@@ -19,9 +24,11 @@ func NewDefaultAuthorization(repo repository.Repository) *defaultAuthorization {
 		repo: repo,
 	}
 	d.addFilters(PasswordFilter)
+	d.addFilters(OtpFilter)
 	//d.addFilters(RBACFilter)
 	//d.addFilters(RBACFilterWithEndpoint)
 	d.addFilters(AdminApiFilter)
+	d.addFilters(TenancyFilter)
 
 	return d
 }
@@ -43,3 +50,20 @@ func combineFilters(filters ...filterFunc) filterFunc {
 		return handler
 	}
 }
+
+// storedUserOf 는 요청 사용자를 한 번만 조회하고 context 에 담아 다음 filter 가 다시 조회하지 않도록 한다.
+func storedUserOf(r *http.Request, repo repository.Repository) (*http.Request, model.User, error) {
+	if storedUser, ok := request.StoredUserFrom(r.Context()); ok {
+		return r, storedUser, nil
+	}
+	requestUserInfo, ok := request.UserFrom(r.Context())
+	if !ok {
+		return r, model.User{}, httpErrors.NewInternalServerError(fmt.Errorf("user not found"), "", "")
+	}
+
+	storedUser, err := repo.User.GetByUuid(r.Context(), requestUserInfo.GetUserId())
+	if err != nil {
+		return r, model.User{}, err
+	}
+	return r.WithContext(request.WithStoredUser(r.Context(), storedUser)), storedUser, nil
+}
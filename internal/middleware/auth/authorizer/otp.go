@@ -0,0 +1,39 @@
+package authorizer
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/openinfradev/tks-api/internal"
+	internalHttp "github.com/openinfradev/tks-api/internal/delivery/http"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// OtpFilter 는 조직이 2단계 인증을 요구하는데 아직 등록하지 않은 사용자의 요청을
+// 2단계 인증 등록과 로그아웃을 제외하고 모두 거부한다.
+func OtpFilter(handler http.Handler, repo repository.Repository) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, storedUser, err := storedUserOf(r, repo)
+		if err != nil {
+			internalHttp.ErrorJSON(w, r, err)
+			return
+		}
+		requestUserInfo, _ := request.UserFrom(r.Context())
+		if storedUser.OtpEnabled || !storedUser.Organization.OtpRequired {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		otpUrl := internal.API_PREFIX + internal.API_VERSION + "/organizations/" + requestUserInfo.GetOrganizationId() + "/my-profile/otp"
+		logoutUrl := internal.API_PREFIX + internal.API_VERSION + "/auth/logout"
+		allowed := (r.URL.Path == otpUrl && (r.Method == http.MethodPost || r.Method == http.MethodPut)) ||
+			(r.URL.Path == logoutUrl && r.Method == http.MethodPost)
+		if !allowed {
+			internalHttp.ErrorJSON(w, r, httpErrors.NewForbiddenError(fmt.Errorf("otp enrollment required"), "A_OTP_ENROLLMENT_REQUIRED", ""))
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
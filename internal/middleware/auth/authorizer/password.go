@@ -14,23 +14,22 @@ import (
 
 func PasswordFilter(handler http.Handler, repo repository.Repository) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		requestUserInfo, ok := request.UserFrom(r.Context())
-		if !ok {
-			internalHttp.ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("user not found"), "", ""))
-			return
-		}
-
-		storedUser, err := repo.User.GetByUuid(r.Context(), requestUserInfo.GetUserId())
+		r, storedUser, err := storedUserOf(r, repo)
 		if err != nil {
 			internalHttp.ErrorJSON(w, r, err)
 			return
 		}
+		requestUserInfo, _ := request.UserFrom(r.Context())
 		//TODO: TKS control plane 동작을 위해, master 조직의 admin 계정은 비밀번호 변경 기간을 무시하도록 함.
 		if storedUser.Organization.ID == "master" && storedUser.AccountId == "admin" {
 			handler.ServeHTTP(w, r)
 			return
 		}
-		if helper.IsDurationExpired(storedUser.PasswordUpdatedAt, internal.PasswordExpiredDuration) {
+		expiredDuration := internal.PasswordExpiredDuration
+		if policy, err := repo.PasswordPolicy.Get(r.Context(), storedUser.Organization.ID); err == nil {
+			expiredDuration = policy.ExpiryDuration()
+		}
+		if expiredDuration > 0 && helper.IsDurationExpired(storedUser.PasswordUpdatedAt, expiredDuration) {
 			allowedUrl := []string{
 				internal.API_PREFIX + internal.API_VERSION + "/organizations/" + requestUserInfo.GetOrganizationId() + "/my-profile" + "/password",
 				internal.API_PREFIX + internal.API_VERSION + "/organizations/" + requestUserInfo.GetOrganizationId() + "/my-profile" + "/next-password-change",
@@ -0,0 +1,92 @@
+package authorizer
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	internalHttp "github.com/openinfradev/tks-api/internal/delivery/http"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/user"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// TenancyFilter 는 경로의 organizationId, projectId, appId 가 요청한 사용자가 접근할 수 있는 조직과 프로젝트인지 확인한다.
+// handler 에서 조직을 확인하지 않더라도 다른 조직의 리소스에 접근할 수 없도록, 확인할 수 없는 값은 모두 거부한다.
+// 모든 조직을 관리하는 master 조직의 사용자는 확인하지 않는다.
+func TenancyFilter(handler http.Handler, repo repository.Repository) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestUserInfo, ok := request.UserFrom(r.Context())
+		if !ok {
+			internalHttp.ErrorJSON(w, r, httpErrors.NewInternalServerError(fmt.Errorf("user not found"), "", ""))
+			return
+		}
+		if requestUserInfo.GetOrganizationId() == "master" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		vars := mux.Vars(r)
+		organizationId, ok := vars["organizationId"]
+		if ok && organizationId != requestUserInfo.GetOrganizationId() {
+			log.Warnf(r.Context(), "TenancyFilter: user %s of organization %s requested organization %s",
+				requestUserInfo.GetAccountId(), requestUserInfo.GetOrganizationId(), organizationId)
+			internalHttp.ErrorJSON(w, r, httpErrors.NewForbiddenError(fmt.Errorf("permission denied for organization %s", organizationId), "A_FORBIDDEN_ORGANIZATION", ""))
+			return
+		}
+
+		if projectId, ok := vars["projectId"]; ok {
+			if err := validateProjectTenancy(r, repo, requestUserInfo, requestUserInfo.GetOrganizationId(), projectId); err != nil {
+				internalHttp.ErrorJSON(w, r, err)
+				return
+			}
+			if appId, ok := vars["appId"]; ok {
+				if err := validateAppServeAppTenancy(r, repo, projectId, appId); err != nil {
+					internalHttp.ErrorJSON(w, r, err)
+					return
+				}
+			}
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// validateProjectTenancy 는 프로젝트가 사용자의 조직에 속하고, 사용자가 프로젝트 구성원이거나 조직 관리자인지 확인한다.
+func validateProjectTenancy(r *http.Request, repo repository.Repository, requestUserInfo user.Info, organizationId string, projectId string) error {
+	project, err := repo.Project.GetProjectById(r.Context(), organizationId, projectId)
+	if err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	if project == nil {
+		return httpErrors.NewForbiddenError(fmt.Errorf("permission denied for project %s", projectId), "A_FORBIDDEN_PROJECT", "")
+	}
+
+	if requestUserInfo.GetRoleOrganizationMapping()[organizationId] == user.AdminRole {
+		return nil
+	}
+	if _, ok := requestUserInfo.GetRoleProjectMapping()[projectId]; ok {
+		return nil
+	}
+	// 토큰 발급 이후에 구성원으로 추가된 경우를 위해 DB 의 구성원 정보도 확인한다.
+	member, err := repo.Project.GetProjectMemberByUserId(r.Context(), projectId, requestUserInfo.GetUserId().String())
+	if err != nil {
+		return httpErrors.NewInternalServerError(err, "", "")
+	}
+	if member != nil {
+		return nil
+	}
+	return httpErrors.NewForbiddenError(fmt.Errorf("permission denied for project %s", projectId), "A_FORBIDDEN_PROJECT", "")
+}
+
+// validateAppServeAppTenancy 는 경로의 앱이 경로의 프로젝트에 속하는지 확인한다.
+// 프로젝트 구성원이 자신의 프로젝트 경로로 다른 프로젝트의 앱에 접근하지 못하도록 한다.
+func validateAppServeAppTenancy(r *http.Request, repo repository.Repository, projectId string, appId string) error {
+	app, err := repo.AppServeApp.GetAppServeAppById(r.Context(), appId)
+	if err != nil || app == nil || app.ProjectId != projectId {
+		return httpErrors.NewForbiddenError(fmt.Errorf("permission denied for app %s", appId), "A_FORBIDDEN_PROJECT", "")
+	}
+	return nil
+}
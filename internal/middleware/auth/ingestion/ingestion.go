@@ -0,0 +1,55 @@
+package ingestion
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	internalHttp "github.com/openinfradev/tks-api/internal/delivery/http"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// Interface authenticates the alerts posted by alertmanager on the member clusters
+type Interface interface {
+	WithIngestionToken(handler http.Handler) http.Handler
+}
+
+type defaultIngestion struct {
+	repo repository.IAlertIngestionTokenRepository
+}
+
+func NewDefaultIngestion(repo repository.Repository) *defaultIngestion {
+	return &defaultIngestion{
+		repo: repo.AlertIngestionToken,
+	}
+}
+
+func (a *defaultIngestion) WithIngestionToken(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader := strings.TrimSpace(r.Header.Get("Authorization"))
+		parts := strings.SplitN(authHeader, " ", 2)
+		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" || strings.TrimSpace(parts[1]) == "" {
+			internalHttp.ErrorJSON(w, r, httpErrors.NewUnauthorizedError(fmt.Errorf("alert ingestion token is empty"), "AIT_INVALID_TOKEN", ""))
+			return
+		}
+
+		token, err := a.repo.GetByTokenHash(r.Context(), helper.HashToken(strings.TrimSpace(parts[1])))
+		if err != nil {
+			log.Error(r.Context(), err)
+			internalHttp.ErrorJSON(w, r, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid alert ingestion token"), "AIT_INVALID_TOKEN", ""))
+			return
+		}
+
+		if err := a.repo.UpdateLastUsedAt(r.Context(), token.ID, time.Now()); err != nil {
+			log.Error(r.Context(), err)
+		}
+
+		r = r.WithContext(request.WithIngestionOrganization(r.Context(), token.OrganizationId))
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,80 @@
+package ingestion_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/ingestion"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/testing/memrepo"
+)
+
+const testOrganizationId = "o1234test"
+
+func TestWithIngestionToken(t *testing.T) {
+	ctx := context.Background()
+	repo := memrepo.New()
+
+	const validToken = "tks-ingestion-valid-token"
+	tokenId, err := repo.AlertIngestionToken.Create(ctx, model.AlertIngestionToken{
+		OrganizationId: testOrganizationId,
+		TokenHash:      helper.HashToken(validToken),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ingestionOrganizationId string
+	handler := ingestion.NewDefaultIngestion(repo).WithIngestionToken(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ingestionOrganizationId, _ = request.IngestionOrganizationFrom(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{
+		{"missing token", "", http.StatusUnauthorized},
+		{"empty bearer", "Bearer ", http.StatusUnauthorized},
+		{"not bearer", "Basic " + validToken, http.StatusUnauthorized},
+		{"wrong token", "Bearer tks-ingestion-wrong-token", http.StatusUnauthorized},
+		{"valid token", "Bearer " + validToken, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingestionOrganizationId = ""
+			req := httptest.NewRequest(http.MethodPost, "/system-api/1.0/system-notifications", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && ingestionOrganizationId != testOrganizationId {
+				t.Errorf("ingestion organization = %q, want %q", ingestionOrganizationId, testOrganizationId)
+			}
+			if tt.wantStatus != http.StatusOK && ingestionOrganizationId != "" {
+				t.Errorf("handler called for rejected token")
+			}
+		})
+	}
+
+	if err := repo.AlertIngestionToken.Delete(ctx, tokenId); err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/system-api/1.0/system-notifications", nil)
+	req.Header.Set("Authorization", "Bearer "+validToken)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("revoked token status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
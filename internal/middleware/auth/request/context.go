@@ -5,6 +5,7 @@ import (
 
 	internalApi "github.com/openinfradev/tks-api/internal/delivery/api"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/user"
+	"github.com/openinfradev/tks-api/internal/model"
 )
 
 type key int
@@ -15,6 +16,8 @@ const (
 	sessionKey
 	endpointKey
 	auditKey
+	ingestionOrganizationKey
+	storedUserKey
 )
 
 func WithValue(parent context.Context, key, val interface{}) context.Context {
@@ -30,6 +33,16 @@ func UserFrom(ctx context.Context) (user.Info, bool) {
 	return user, ok
 }
 
+// WithStoredUser sets the stored user of the request so that authorization filters load it only once
+func WithStoredUser(parent context.Context, user model.User) context.Context {
+	return WithValue(parent, storedUserKey, user)
+}
+
+func StoredUserFrom(ctx context.Context) (model.User, bool) {
+	user, ok := ctx.Value(storedUserKey).(model.User)
+	return user, ok
+}
+
 func WithToken(parent context.Context, token string) context.Context {
 	return WithValue(parent, userToken, token)
 }
@@ -65,3 +78,13 @@ func AuditFrom(ctx context.Context) (string, bool) {
 	audit, ok := ctx.Value(auditKey).(string)
 	return audit, ok
 }
+
+// WithIngestionOrganization sets the organization that the alert ingestion token of the request belongs to
+func WithIngestionOrganization(parent context.Context, organizationId string) context.Context {
+	return WithValue(parent, ingestionOrganizationKey, organizationId)
+}
+
+func IngestionOrganizationFrom(ctx context.Context) (string, bool) {
+	organizationId, ok := ctx.Value(ingestionOrganizationKey).(string)
+	return organizationId, ok
+}
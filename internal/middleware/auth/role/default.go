@@ -48,6 +48,8 @@ var defaultPermissionOfAdmin = defaultPermission{
 		internalApi.ResetPassword,
 		internalApi.CheckId,
 		internalApi.CheckEmail,
+		internalApi.GetPasswordPolicy,
+		internalApi.UpdatePasswordPolicy,
 
 		// MyProfile
 		internalApi.GetMyProfile,
@@ -90,6 +92,15 @@ var defaultPermissionOfAdmin = defaultPermission{
 		internalApi.GetNumOfAppsOnStack,
 		internalApi.GetAppServeApp,
 		internalApi.GetAppServeAppLatestTask,
+		internalApi.GetAppServeAppMetrics,
+		internalApi.GetAppServeAppConfigs,
+		internalApi.GetAppServeAppConfig,
+		internalApi.GetAppServeAppConfigDiff,
+		internalApi.UpdateAppServeAppConfig,
+		internalApi.GetAppServeAppGitSource,
+		internalApi.UpdateAppServeAppGitSource,
+		internalApi.DeleteAppServeAppGitSource,
+		internalApi.BuildAppServeApp,
 		internalApi.IsAppServeAppExist,
 		internalApi.IsAppServeAppNameExist,
 		internalApi.DeleteAppServeApp,
@@ -114,6 +125,8 @@ var defaultPermissionOfAdmin = defaultPermission{
 		internalApi.GetChartDashboard,
 		internalApi.GetStacksDashboard,
 		internalApi.GetResourcesDashboard,
+		internalApi.GetResourcesDashboardV2,
+		internalApi.GetPolicyEnforcementStatusDashboard,
 
 		// Stack
 		internalApi.GetStacks,
@@ -128,6 +141,19 @@ var defaultPermissionOfAdmin = defaultPermission{
 		internalApi.DeleteFavoriteStack,
 		internalApi.InstallStack,
 
+		// Catalog
+		internalApi.GetCatalogCharts,
+		internalApi.GetCatalogChart,
+		internalApi.CreateCatalogApp,
+		internalApi.GetCatalogApps,
+
+		// GitProvider
+		internalApi.GetGitProviders,
+		internalApi.GetGitProvider,
+		internalApi.CreateGitProvider,
+		internalApi.UpdateGitProvider,
+		internalApi.DeleteGitProvider,
+
 		// Project
 		internalApi.CreateProject,
 		internalApi.GetProjects,
@@ -163,6 +189,7 @@ var defaultPermissionOfUser = defaultPermission{
 		internalApi.GetUser,
 		internalApi.CheckId,
 		internalApi.CheckEmail,
+		internalApi.GetPasswordPolicy,
 
 		// MyProfile
 		internalApi.GetMyProfile,
@@ -196,6 +223,15 @@ var defaultPermissionOfUser = defaultPermission{
 		internalApi.GetNumOfAppsOnStack,
 		internalApi.GetAppServeApp,
 		internalApi.GetAppServeAppLatestTask,
+		internalApi.GetAppServeAppMetrics,
+		internalApi.GetAppServeAppConfigs,
+		internalApi.GetAppServeAppConfig,
+		internalApi.GetAppServeAppConfigDiff,
+		internalApi.UpdateAppServeAppConfig,
+		internalApi.GetAppServeAppGitSource,
+		internalApi.UpdateAppServeAppGitSource,
+		internalApi.DeleteAppServeAppGitSource,
+		internalApi.BuildAppServeApp,
 		internalApi.IsAppServeAppExist,
 		internalApi.IsAppServeAppNameExist,
 		internalApi.DeleteAppServeApp,
@@ -218,6 +254,8 @@ var defaultPermissionOfUser = defaultPermission{
 		internalApi.GetChartDashboard,
 		internalApi.GetStacksDashboard,
 		internalApi.GetResourcesDashboard,
+		internalApi.GetResourcesDashboardV2,
+		internalApi.GetPolicyEnforcementStatusDashboard,
 
 		// SystemNotification
 		internalApi.CreateSystemNotification,
@@ -235,6 +273,15 @@ var defaultPermissionOfUser = defaultPermission{
 		internalApi.SetFavoriteStack,
 		internalApi.DeleteFavoriteStack,
 
+		// Catalog
+		internalApi.GetCatalogCharts,
+		internalApi.GetCatalogChart,
+		internalApi.GetCatalogApps,
+
+		// GitProvider
+		internalApi.GetGitProviders,
+		internalApi.GetGitProvider,
+
 		// Project
 		internalApi.CreateProject,
 		internalApi.GetProjects,
@@ -0,0 +1,38 @@
+package filter
+
+import (
+	internalApi "github.com/openinfradev/tks-api/internal/delivery/api"
+)
+
+type fieldRule struct {
+	path      string               // json path of the field. e.g. users[].email. csv responses use the last key as the column name
+	allowedBy internalApi.Endpoint // roles allowed this endpoint can see the field
+}
+
+var filterMap = map[internalApi.Endpoint][]fieldRule{
+	// cloud credential metadata
+	internalApi.GetCloudAccounts: {
+		{path: "cloudAccounts[].awsAccountId", allowedBy: internalApi.UpdateCloudAccount},
+		{path: "cloudAccounts[].resource", allowedBy: internalApi.UpdateCloudAccount},
+	},
+	internalApi.GetCloudAccount: {
+		{path: "cloudAccount.awsAccountId", allowedBy: internalApi.UpdateCloudAccount},
+		{path: "cloudAccount.resource", allowedBy: internalApi.UpdateCloudAccount},
+	},
+
+	// user emails
+	internalApi.ListUser: {
+		{path: "users[].email", allowedBy: internalApi.CreateUser},
+	},
+	internalApi.GetUser: {
+		{path: "user.email", allowedBy: internalApi.CreateUser},
+	},
+
+	// audit descriptions
+	internalApi.GetAudits: {
+		{path: "audits[].description", allowedBy: internalApi.GetAudit},
+	},
+	internalApi.GetOrganizationAudits: {
+		{path: "audits[].description", allowedBy: internalApi.GetAudit},
+	},
+}
@@ -0,0 +1,205 @@
+package filter
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	internalApi "github.com/openinfradev/tks-api/internal/delivery/api"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type Interface interface {
+	WithResponseFilter(endpoint internalApi.Endpoint, handler http.Handler) http.Handler
+}
+
+type defaultResponseFilter struct {
+	userRepo       repository.IUserRepository
+	permissionRepo repository.IPermissionRepository
+}
+
+func NewDefaultResponseFilter(repo repository.Repository) *defaultResponseFilter {
+	return &defaultResponseFilter{
+		userRepo:       repo.User,
+		permissionRepo: repo.Permission,
+	}
+}
+
+// WithResponseFilter removes the response fields of filterMap which the roles of the request user are not allowed to see.
+// Endpoints without rules are passed through without buffering. csv responses are filtered by column.
+func (f *defaultResponseFilter) WithResponseFilter(endpoint internalApi.Endpoint, handler http.Handler) http.Handler {
+	rules, ok := filterMap[endpoint]
+	if !ok {
+		return handler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		brw := newBufferedResponseWriter(w)
+		handler.ServeHTTP(brw, r)
+
+		body := brw.body.Bytes()
+		if brw.statusCode >= 200 && brw.statusCode < 300 {
+			if hiddenPaths := f.getHiddenPaths(r.Context(), rules); len(hiddenPaths) > 0 {
+				removeFn := removeFields
+				if strings.HasPrefix(w.Header().Get("Content-Type"), "text/csv") {
+					removeFn = removeCsvColumns
+				}
+				filtered, err := removeFn(body, hiddenPaths)
+				if err != nil {
+					log.Error(r.Context(), err)
+				} else {
+					body = filtered
+				}
+			}
+		}
+
+		w.WriteHeader(brw.statusCode)
+		if _, err := w.Write(body); err != nil {
+			log.Error(r.Context(), err)
+		}
+	})
+}
+
+func (f *defaultResponseFilter) getHiddenPaths(ctx context.Context, rules []fieldRule) (out []string) {
+	requestUser, ok := request.UserFrom(ctx)
+	if !ok {
+		for _, rule := range rules {
+			out = append(out, rule.path)
+		}
+		return out
+	}
+
+	// TODO: 추후 tks-admin role 수정 필요
+	if requestUser.GetRoleOrganizationMapping()[requestUser.GetOrganizationId()] == "tks-admin" {
+		return nil
+	}
+
+	// 권한을 확인할 수 없으면 필드를 숨긴다.
+	allowedEndpoints := make(map[string]bool)
+	user, err := f.userRepo.GetByUuid(ctx, requestUser.GetUserId())
+	if err != nil {
+		log.Error(ctx, err)
+	} else {
+		roleIds := make([]string, len(user.Roles))
+		for i, role := range user.Roles {
+			roleIds[i] = role.ID
+		}
+		endpoints, err := f.permissionRepo.ListAllowedEndpointNames(ctx, roleIds)
+		if err != nil {
+			log.Error(ctx, err)
+		}
+		for _, endpoint := range endpoints {
+			allowedEndpoints[endpoint] = true
+		}
+	}
+
+	for _, rule := range rules {
+		if !allowedEndpoints[internalApi.ApiMap[rule.allowedBy].Name] {
+			out = append(out, rule.path)
+		}
+	}
+	return out
+}
+
+// removeFields deletes the fields of the paths from the json body.
+// A path is dot separated keys and a key with "[]" suffix is applied to every element of the array.
+func removeFields(body []byte, paths []string) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		removeField(v, strings.Split(path, "."))
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func removeField(v interface{}, keys []string) {
+	obj, ok := v.(map[string]interface{})
+	if !ok || len(keys) == 0 {
+		return
+	}
+
+	key := strings.TrimSuffix(keys[0], "[]")
+	if len(keys) == 1 {
+		delete(obj, key)
+		return
+	}
+
+	if strings.HasSuffix(keys[0], "[]") {
+		items, _ := obj[key].([]interface{})
+		for _, item := range items {
+			removeField(item, keys[1:])
+		}
+		return
+	}
+	removeField(obj[key], keys[1:])
+}
+
+// removeCsvColumns deletes the columns named by the last key of the paths from the csv body with a header row.
+func removeCsvColumns(body []byte, paths []string) ([]byte, error) {
+	records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return body, nil
+	}
+
+	hidden := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		keys := strings.Split(path, ".")
+		hidden[strings.TrimSuffix(keys[len(keys)-1], "[]")] = true
+	}
+	columns := []int{}
+	for i, name := range records[0] {
+		if !hidden[name] {
+			columns = append(columns, i)
+		}
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	for _, record := range records {
+		row := make([]string, 0, len(columns))
+		for _, i := range columns {
+			row = append(row, record[i])
+		}
+		if err := writer.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+type bufferedResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func newBufferedResponseWriter(w http.ResponseWriter) *bufferedResponseWriter {
+	return &bufferedResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+}
+
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	w.statusCode = code
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
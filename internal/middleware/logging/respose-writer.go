@@ -1,10 +1,16 @@
 package logging
 
 import (
+	"bufio"
 	"bytes"
+	"fmt"
+	"net"
 	"net/http"
 )
 
+// 로그 스트림처럼 오래 이어지는 응답이 메모리에 쌓이지 않도록 로그와 감사에 사용할 body 는 앞부분만 보관한다.
+const maxBufferedBodyLen = 1 << 20
+
 type loggingResponseWriter struct {
 	http.ResponseWriter
 	statusCode int
@@ -22,7 +28,9 @@ func (lrw *loggingResponseWriter) WriteHeader(code int) {
 }
 
 func (lrw *loggingResponseWriter) Write(buf []byte) (int, error) {
-	lrw.body.Write(buf)
+	if remain := maxBufferedBodyLen - lrw.body.Len(); remain > 0 {
+		lrw.body.Write(buf[:min(len(buf), remain)])
+	}
 	return lrw.ResponseWriter.Write(buf)
 }
 
@@ -33,3 +41,19 @@ func (lrw *loggingResponseWriter) GetBody() *bytes.Buffer {
 func (lrw *loggingResponseWriter) GetStatusCode() int {
 	return lrw.statusCode
 }
+
+// Hijack 은 WebSocket 처럼 연결을 직접 다루는 handler 를 위해 감싼 ResponseWriter 의 Hijack 을 호출한다.
+func (lrw *loggingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := lrw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("the response writer does not support hijacking")
+	}
+	return hijacker.Hijack()
+}
+
+// Flush 는 chunked 응답을 바로 내보내는 handler 를 위해 감싼 ResponseWriter 의 Flush 를 호출한다.
+func (lrw *loggingResponseWriter) Flush() {
+	if flusher, ok := lrw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
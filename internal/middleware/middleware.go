@@ -8,6 +8,8 @@ import (
 	"github.com/openinfradev/tks-api/internal/middleware/auth/authenticator"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/authorizer"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/requestRecoder"
+	"github.com/openinfradev/tks-api/internal/middleware/filter"
+	"github.com/openinfradev/tks-api/internal/middleware/ratelimit"
 )
 
 type Middleware struct {
@@ -15,27 +17,35 @@ type Middleware struct {
 	authorizer     authorizer.Interface
 	requestRecoder requestRecoder.Interface
 	audit          audit.Interface
+	filter         filter.Interface
+	rateLimit      ratelimit.Interface
 }
 
 func NewMiddleware(authenticator authenticator.Interface,
 	authorizer authorizer.Interface,
 	requestRecoder requestRecoder.Interface,
-	audit audit.Interface) *Middleware {
+	audit audit.Interface,
+	filter filter.Interface,
+	rateLimit ratelimit.Interface) *Middleware {
 	ret := &Middleware{
 		authenticator:  authenticator,
 		authorizer:     authorizer,
 		requestRecoder: requestRecoder,
 		audit:          audit,
+		filter:         filter,
+		rateLimit:      rateLimit,
 	}
 	return ret
 }
 
 func (m *Middleware) Handle(endpoint internalApi.Endpoint, handle http.Handler) http.Handler {
 	// pre-handler
-	preHandler := m.authorizer.WithAuthorization(handle)
+	preHandler := m.filter.WithResponseFilter(endpoint, handle)
+	preHandler = m.authorizer.WithAuthorization(preHandler)
 	// TODO: this is a temporary solution. check if this is the right place to put audit middleware
 	preHandler = m.audit.WithAudit(endpoint, preHandler)
 	preHandler = m.requestRecoder.WithRequestRecoder(endpoint, preHandler)
+	preHandler = m.rateLimit.WithRateLimit(preHandler)
 	preHandler = m.authenticator.WithAuthentication(preHandler)
 
 	// post-handler
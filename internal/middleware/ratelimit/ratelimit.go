@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/spf13/viper"
+
+	internalHttp "github.com/openinfradev/tks-api/internal/delivery/http"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type Interface interface {
+	WithRateLimit(handler http.Handler) http.Handler
+}
+
+type defaultRateLimit struct {
+	repo repository.IApiUsageRepository
+}
+
+func NewDefaultRateLimit(repo repository.Repository) *defaultRateLimit {
+	return &defaultRateLimit{
+		repo: repo.ApiUsage,
+	}
+}
+
+// WithRateLimit 은 요청한 사용자의 조직별로 1분 동안의 API 요청 수를 기록하고, api-rate-limit-per-minute 를 넘는 요청은 거절한다.
+// 모든 조직을 관리하는 master 조직의 요청은 기록만 하고 거절하지 않는다. 요청 수를 기록하지 못하면 요청을 거절하지 않는다.
+func (a *defaultRateLimit) WithRateLimit(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestUserInfo, ok := request.UserFrom(r.Context())
+		if !ok {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		organizationId := requestUserInfo.GetOrganizationId()
+
+		now := time.Now()
+		startedAt := now.Truncate(time.Minute)
+		requests, err := a.repo.Increment(r.Context(), organizationId, startedAt)
+		if err != nil {
+			log.Error(r.Context(), err)
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		limit := viper.GetInt64("api-rate-limit-per-minute")
+		if limit <= 0 || requests <= limit || organizationId == "master" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		if err := a.repo.IncrementRateLimited(r.Context(), organizationId, startedAt); err != nil {
+			log.Error(r.Context(), err)
+		}
+		retryAfter := int(startedAt.Add(time.Minute).Sub(now).Seconds()) + 1
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		internalHttp.ErrorJSON(w, r, httpErrors.NewError(fmt.Errorf("organization %s exceeded %d requests per minute", organizationId, limit), "C_TOO_MANY_REQUESTS"))
+	})
+}
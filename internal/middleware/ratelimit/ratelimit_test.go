@@ -0,0 +1,58 @@
+package ratelimit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/user"
+	"github.com/openinfradev/tks-api/internal/middleware/ratelimit"
+	"github.com/openinfradev/tks-api/internal/testing/memrepo"
+)
+
+const testOrganizationId = "o1234test"
+
+func TestWithRateLimit(t *testing.T) {
+	viper.Set("api-rate-limit-per-minute", 2)
+	t.Cleanup(func() { viper.Set("api-rate-limit-per-minute", 0) })
+
+	repo := memrepo.New()
+	handler := ratelimit.NewDefaultRateLimit(repo).WithRateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func(organizationId string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/1.0/organizations/"+organizationId, nil)
+		req = req.WithContext(request.WithUser(req.Context(), &user.DefaultInfo{OrganizationId: organizationId}))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i, wantStatus := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		rec := serve(testOrganizationId)
+		if rec.Code != wantStatus {
+			t.Fatalf("request %d status = %d, want %d", i+1, rec.Code, wantStatus)
+		}
+		if wantStatus == http.StatusTooManyRequests && rec.Header().Get("Retry-After") == "" {
+			t.Errorf("rate limited response has no Retry-After header")
+		}
+	}
+	for i := 0; i < 3; i++ {
+		if rec := serve("master"); rec.Code != http.StatusOK {
+			t.Errorf("master request %d status = %d, want %d", i+1, rec.Code, http.StatusOK)
+		}
+	}
+
+	apiUsages, err := repo.ApiUsage.Fetch(context.Background(), testOrganizationId, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apiUsages) != 1 || apiUsages[0].Requests != 3 || apiUsages[0].RateLimited != 1 {
+		t.Errorf("api usages = %+v, want 3 requests and 1 rate limited", apiUsages)
+	}
+}
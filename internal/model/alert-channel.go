@@ -0,0 +1,53 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Models
+// AlertChannel 은 조직의 앨럿을 보낼 메일(SMTP), Slack, webhook 채널이다. 앨럿 라우팅 규칙에서 채널을 지정한다.
+// Secret 과 SmtpPassword 는 봉인하여 저장하며 응답에 포함하지 않는다.
+type AlertChannel struct {
+	ID             uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId string    `gorm:"type:varchar(36);index"`
+	Name           string
+	Type           string
+	Enabled        bool
+	Url            string
+	Secret         string
+	Recipient      datatypes.JSON
+	Recipients     []string `gorm:"-:all"`
+	SmtpHost       string
+	SmtpPort       int
+	SmtpUsername   string
+	SmtpPassword   string
+	SmtpFrom       string
+	LastStatus     string
+	LastError      string
+	LastSentAt     *time.Time
+	SucceededCount int64
+	FailedCount    int64
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	Creator        User       `gorm:"foreignKey:CreatorId"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (m *AlertChannel) BeforeSave(tx *gorm.DB) (err error) {
+	if m.Recipients != nil {
+		m.Recipient, err = json.Marshal(m.Recipients)
+	}
+	return err
+}
+
+func (m *AlertChannel) AfterFind(tx *gorm.DB) (err error) {
+	if len(m.Recipient) > 0 {
+		_ = json.Unmarshal(m.Recipient, &m.Recipients)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Models
+type AlertIngestionToken struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey"`
+	OrganizationId string    `gorm:"index"`
+	Name           string
+	TokenHash      string `gorm:"uniqueIndex"`
+	TokenPrefix    string
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	Creator        User       `gorm:"foreignKey:CreatorId"`
+	LastUsedAt     *time.Time
+	RotatedAt      *time.Time
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Models
+// AlertRoutingRule 은 조직에 수신된 앨럿을 받을 알림 채널과 에스컬레이션 정책을 정한다.
+type AlertRoutingRule struct {
+	gorm.Model
+
+	ID                 uuid.UUID `gorm:"primarykey"`
+	OrganizationId     string    `gorm:"index"`
+	Name               string
+	Description        string
+	Priority           int
+	Enabled            bool
+	Continue           bool
+	Condition          datatypes.JSON
+	Match              domain.AlertRoutingMatch `gorm:"-:all"`
+	Channel            datatypes.JSON
+	Channels           []domain.NotificationChannel `gorm:"-:all"`
+	AlertChannels      []AlertChannel               `gorm:"many2many:alert_routing_rule_channels;constraint:OnUpdate:RESTRICT,OnDelete:CASCADE"`
+	AlertChannelIds    []string                     `gorm:"-:all"`
+	EscalationPolicyId *uuid.UUID                   `gorm:"type:uuid"`
+	TargetUsers        []User                       `gorm:"many2many:alert_routing_rule_users;constraint:OnUpdate:RESTRICT,OnDelete:RESTRICT"`
+	TargetUserIds      []string                     `gorm:"-:all"`
+	CreatorId          *uuid.UUID                   `gorm:"type:uuid"`
+	Creator            *User                        `gorm:"foreignKey:CreatorId"`
+	UpdatorId          *uuid.UUID                   `gorm:"type:uuid"`
+	Updator            *User                        `gorm:"foreignKey:UpdatorId"`
+}
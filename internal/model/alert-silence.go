@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Models
+// AlertSilence 가 진행 중인 동안 조건과 일치하는 앨럿은 SILENCED 상태로 기록만 하고 알림을 보내지 않는다.
+type AlertSilence struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey"`
+	OrganizationId string    `gorm:"index"`
+	Name           string
+	Description    string
+	Condition      datatypes.JSON
+	Match          domain.AlertSilenceMatch `gorm:"-:all"`
+	StartAt        time.Time                `gorm:"index"`
+	EndAt          time.Time                `gorm:"index"`
+	CreatorId      *uuid.UUID               `gorm:"type:uuid"`
+	Creator        User                     `gorm:"foreignKey:CreatorId"`
+	UpdatorId      *uuid.UUID               `gorm:"type:uuid"`
+	Updator        User                     `gorm:"foreignKey:UpdatorId"`
+}
+
+func (m AlertSilence) State(now time.Time) string {
+	switch {
+	case now.Before(m.StartAt):
+		return domain.AlertSilenceState_PENDING
+	case now.Before(m.EndAt):
+		return domain.AlertSilenceState_ACTIVE
+	}
+	return domain.AlertSilenceState_EXPIRED
+}
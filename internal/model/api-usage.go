@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+)
+
+// Models
+// ApiUsage 는 조직이 1분 동안 호출한 API 요청 수이다. Requests 는 rate limit 으로 거절된 요청을 포함하고,
+// RateLimited 는 그 중 거절된 요청 수이다.
+type ApiUsage struct {
+	OrganizationId string    `gorm:"primarykey"`
+	StartedAt      time.Time `gorm:"primarykey"`
+	Requests       int64
+	RateLimited    int64
+	UpdatedAt      time.Time
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"github.com/google/uuid"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"time"
 )
@@ -49,13 +50,45 @@ type AppServeAppTask struct {
 	PvAccessMode      string     `json:"pvAccessMode"`
 	PvSize            string     `json:"pvSize"`
 	PvMountPath       string     `json:"pvMountPath"`
+	ConfigRevision    int        `gorm:"default:0" json:"configRevision"` // revision of app config deployed with this task. 0 means no config
+	SourceRepoUrl     string     `json:"sourceRepoUrl,omitempty"`         // git repository to build the app from instead of artifactUrl
+	SourceBranch      string     `json:"sourceBranch,omitempty"`          // branch of the git repository
+	SourceCommit      string     `json:"sourceCommit,omitempty"`          // commit of the git repository to build
+	SourceSecretName  string     `json:"sourceSecretName,omitempty"`      // secret holding the git token in the workflow namespace
 	AvailableRollback bool       `gorm:"-:all" json:"availableRollback"`
 	CreatedAt         time.Time  `gorm:"autoCreateTime:false" json:"createdAt"` // createdAt is  a creation timestamp for the application
 	UpdatedAt         *time.Time `gorm:"autoUpdateTime:false" json:"updatedAt"`
 	DeletedAt         *time.Time `json:"deletedAt"`
+
+	// metrics-based verification after deploy/promote
+	VerificationBakePeriod int        `gorm:"default:0" json:"verificationBakePeriod"`   // minutes to watch the new revision. 0 disables verification
+	AutoRollback           bool       `json:"autoRollback"`                              // rollback to the previous task when the verification is SUSPECT
+	VerificationStatus     string     `gorm:"index" json:"verificationStatus,omitempty"` // PENDING, VERIFIED, SUSPECT, UNKNOWN
+	VerificationDueAt      *time.Time `json:"verificationDueAt,omitempty"`
+	VerifiedAt             *time.Time `json:"verifiedAt,omitempty"`
+	BaselineErrorRate      float64    `json:"baselineErrorRate"`
+	ErrorRate              float64    `json:"errorRate"`
+	BaselineLatencyMs      float64    `json:"baselineLatencyMs"`
+	LatencyMs              float64    `json:"latencyMs"`
+	VerificationMessage    string     `json:"verificationMessage,omitempty"`
 }
 
 func (t *AppServeAppTask) BeforeCreate(tx *gorm.DB) (err error) {
 	t.ID = uuid.New().String()
 	return nil
 }
+
+// AppServeAppConfig 는 앱의 환경변수와 secret 의 revision 이다. 변경할 때마다 새 revision 을 만들고 기존 revision 은 수정하지 않는다.
+// Secrets 는 key, value 의 json 을 봉인한 값이며, 복호화한 값은 배포할 때 Kubernetes Secret 으로만 전달한다.
+type AppServeAppConfig struct {
+	ID            uuid.UUID      `gorm:"primarykey;type:uuid"`
+	AppServeAppId string         `gorm:"uniqueIndex:idx_app_serve_app_config_revision"`
+	Revision      int            `gorm:"uniqueIndex:idx_app_serve_app_config_revision"`
+	Env           datatypes.JSON // map[string]string
+	SecretKeys    datatypes.JSON // []string. 값을 복호화하지 않고 목록을 보여주기 위해 key 만 따로 저장한다.
+	Secrets       []byte
+	Description   string
+	CreatorId     *uuid.UUID `gorm:"type:uuid"`
+	Creator       User       `gorm:"foreignKey:CreatorId"`
+	CreatedAt     time.Time
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Models
+// AuditSink 는 조직의 감사 로그를 전달할 외부 시스템(SIEM 등)이다. Secret 은 봉인하여 저장하며 응답에 포함하지 않는다.
+type AuditSink struct {
+	ID             uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId string    `gorm:"type:varchar(36);index"`
+	Name           string
+	Type           string
+	Endpoint       string
+	Topic          string
+	Secret         string
+	Enabled        bool
+	LastStatus     string
+	LastError      string
+	LastSentAt     *time.Time
+	SucceededCount int64
+	FailedCount    int64
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	Creator        User       `gorm:"foreignKey:CreatorId"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// AuditSinkDeadLetter 는 재시도 후에도 전달하지 못한 감사 로그이다. 다시 전달하거나 삭제할 때까지 보관한다.
+type AuditSinkDeadLetter struct {
+	ID             uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId string    `gorm:"type:varchar(36);index"`
+	AuditSinkId    uuid.UUID `gorm:"type:uuid;index"`
+	AuditId        uuid.UUID `gorm:"type:uuid"`
+	Payload        []byte
+	Attempts       int
+	Error          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -10,14 +12,69 @@ type Audit struct {
 	gorm.Model
 
 	ID               uuid.UUID `gorm:"primarykey"`
-	OrganizationId   string
+	OrganizationId   string    `gorm:"index"`
 	OrganizationName string
-	Group            string
+	Group            string `gorm:"index"`
 	Message          string
 	Description      string
 	ClientIP         string
-	UserId           *uuid.UUID `gorm:"type:uuid"`
+	UserId           *uuid.UUID `gorm:"type:uuid;index"`
 	UserAccountId    string
 	UserName         string
 	UserRoles        string
+
+	// StatusCode 는 API 요청의 응답 코드이다. API 요청이 아닌 작업의 감사 로그는 0 이다.
+	StatusCode int
+}
+
+// AuditFilter 는 조직의 감사 로그 조회 조건이다. 비어 있는 조건은 적용하지 않는다.
+type AuditFilter struct {
+	UserAccountId string
+	Group         string
+	From          *time.Time
+	To            *time.Time
+	Status        string
+	Message       string
+}
+
+// AuditArchive keeps audits moved out of the audits table by the archival
+type AuditArchive struct {
+	Audit
+}
+
+func (AuditArchive) TableName() string {
+	return "audit_archives"
+}
+
+type AuditStatistics struct {
+	AuditCount      int64
+	ArchivedCount   int64
+	OldestCreatedAt *time.Time
+}
+
+// AuditRetentionPolicy 가 없는 조직은 audit-retention-days 설정과 archive 방식을 따른다.
+type AuditRetentionPolicy struct {
+	OrganizationId string `gorm:"primarykey"`
+	RetentionDays  int
+	Action         string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	IsDefault bool `gorm:"-:all"`
+}
+
+// AuditArchivalRun 은 조직 하나에 대해 보존 기간이 지난 감사 로그를 archive 하거나 삭제한 작업의 기록이다.
+type AuditArchivalRun struct {
+	ID             uuid.UUID `gorm:"primarykey"`
+	OrganizationId string    `gorm:"index"`
+	Trigger        string
+	Action         string
+	RetentionDays  int
+	Before         time.Time
+	Status         string `gorm:"index"`
+	ProcessedCount int64
+	Location       string
+	Error          string
+	StartedAt      time.Time `gorm:"index"`
+	FinishedAt     *time.Time
 }
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/datatypes"
+)
+
+// Models
+// HelmRepository 는 관리자가 catalog 에 등록한 Helm chart repository 이다. 인증이 필요 없는 공개 repository 만 지원한다.
+// 주기적으로 index.yaml 을 읽어 CatalogChart 를 갱신하고 마지막 동기화 결과를 Status 에 기록한다.
+type HelmRepository struct {
+	ID          uuid.UUID `gorm:"primarykey;type:uuid"`
+	Name        string    `gorm:"uniqueIndex"`
+	Url         string
+	Description string
+	Status      string
+	StatusDesc  string
+	ChartCount  int
+	SyncedAt    *time.Time
+	CreatorId   *uuid.UUID `gorm:"type:uuid"`
+	Creator     User       `gorm:"foreignKey:CreatorId"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CatalogChart 는 Helm repository 의 index 에 있는 chart 의 한 버전이다.
+// ValuesSchema 는 처음 조회하거나 배포할 때 chart archive 의 values.schema.json 을 읽어 저장한다.
+type CatalogChart struct {
+	ID               uuid.UUID      `gorm:"primarykey;type:uuid"`
+	HelmRepositoryId uuid.UUID      `gorm:"type:uuid;uniqueIndex:idx_catalog_chart_version"`
+	HelmRepository   HelmRepository `gorm:"foreignKey:HelmRepositoryId"`
+	Name             string         `gorm:"uniqueIndex:idx_catalog_chart_version"`
+	Version          string         `gorm:"uniqueIndex:idx_catalog_chart_version"`
+	AppVersion       string
+	Description      string
+	Icon             string
+	Url              string
+	Digest           string
+	Deprecated       bool
+	ReleasedAt       *time.Time
+	ValuesSchema     datatypes.JSON
+	SchemaLoaded     bool
+	SyncedAt         time.Time
+}
+
+// CatalogApp 은 조직 사용자가 catalog 의 chart 로 스택에 배포한 앱이다.
+// chart 는 repository 를 다시 동기화하면 사라질 수 있으므로 배포한 chart 의 정보를 함께 저장한다.
+// Status 는 배포 operation 의 상태로, 조회할 때 끝나지 않은 operation 의 상태를 반영한다.
+type CatalogApp struct {
+	ID                 uuid.UUID        `gorm:"primarykey;type:uuid"`
+	OrganizationId     string           `gorm:"type:varchar(36);index"`
+	ClusterId          domain.ClusterId `gorm:"index"`
+	ReleaseName        string
+	Namespace          string
+	HelmRepositoryName string
+	RepositoryUrl      string
+	ChartName          string
+	ChartVersion       string
+	Values             datatypes.JSON
+	OperationId        uuid.UUID `gorm:"type:uuid"`
+	Status             domain.OperationStatus
+	StatusDesc         string
+	CreatorId          *uuid.UUID `gorm:"type:uuid"`
+	Creator            User       `gorm:"foreignKey:CreatorId"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CloudAccountPricing is the unit price of the cloud account used to estimate the monthly cost of stacks
+type CloudAccountPricing struct {
+	CloudAccountId  uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId  string    `gorm:"index;type:varchar(36)"`
+	Currency        string
+	CpuCoreHourly   float64
+	MemoryGibHourly float64
+	UpdatorId       *uuid.UUID `gorm:"type:uuid"`
+	Updator         User       `gorm:"foreignKey:UpdatorId"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
@@ -1,33 +1,45 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/openinfradev/tks-api/pkg/domain"
 	"gorm.io/gorm"
 )
 
 // Models
+// CloudAccount 의 자격증명(AccessKeyId, SecretAccessKey, SessionToken, Azure, Gcp, Openstack)은 DB 에 저장하지 않는다.
+// 생성/삭제 workflow 의 parameter 로만 전달하고, 이후의 AWS 호출은 플랫폼 자격증명으로 계정의 role 을 assume 한다.
+// 조직의 secret 을 저장해야 한다면 EncryptionKeyUsecase.Seal 로 봉인한 값을 저장한다.
 type CloudAccount struct {
 	gorm.Model
 
-	ID              uuid.UUID `gorm:"primarykey"`
-	OrganizationId  string
-	Organization    Organization `gorm:"foreignKey:OrganizationId"`
-	Name            string       `gorm:"index"`
-	Description     string       `gorm:"index"`
-	Resource        string
-	CloudService    string
-	WorkflowId      string
-	Status          domain.CloudAccountStatus
-	StatusDesc      string
-	AwsAccountId    string
-	AccessKeyId     string `gorm:"-:all"`
-	SecretAccessKey string `gorm:"-:all"`
-	SessionToken    string `gorm:"-:all"`
-	Clusters        int    `gorm:"-:all"`
-	CreatedIAM      bool
-	CreatorId       *uuid.UUID `gorm:"type:uuid"`
-	Creator         User       `gorm:"foreignKey:CreatorId"`
-	UpdatorId       *uuid.UUID `gorm:"type:uuid"`
-	Updator         User       `gorm:"foreignKey:UpdatorId"`
+	ID                   uuid.UUID `gorm:"primarykey"`
+	OrganizationId       string
+	Organization         Organization `gorm:"foreignKey:OrganizationId"`
+	Name                 string       `gorm:"index"`
+	Description          string       `gorm:"index"`
+	Resource             string
+	CloudService         string
+	WorkflowId           string
+	Status               domain.CloudAccountStatus
+	StatusDesc           string
+	AccountId            string `gorm:"index"`
+	AwsAccountId         string
+	AccessKeyId          string                      `gorm:"-:all"`
+	SecretAccessKey      string                      `gorm:"-:all"`
+	SessionToken         string                      `gorm:"-:all"`
+	Azure                *domain.AzureCredential     `gorm:"-:all"`
+	Gcp                  *domain.GcpCredential       `gorm:"-:all"`
+	Openstack            *domain.OpenstackCredential `gorm:"-:all"`
+	Clusters             int                         `gorm:"-:all"`
+	CreatedIAM           bool
+	CredentialStatus     domain.CloudAccountCredentialStatus
+	CredentialStatusDesc string
+	CredentialCheckedAt  *time.Time
+	CreatorId            *uuid.UUID `gorm:"type:uuid"`
+	Creator              User       `gorm:"foreignKey:CreatorId"`
+	UpdatorId            *uuid.UUID `gorm:"type:uuid"`
+	Updator              User       `gorm:"foreignKey:UpdatorId"`
 }
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// Models
+// CloudHealthEvent 는 cloud provider 의 health event 와 그로 인해 생성된 알림을 클러스터 단위로 기록한다.
+type CloudHealthEvent struct {
+	gorm.Model
+
+	ID                   uuid.UUID        `gorm:"primarykey"`
+	OrganizationId       string           `gorm:"index"`
+	CloudAccountId       uuid.UUID        `gorm:"type:uuid"`
+	ClusterId            domain.ClusterId `gorm:"uniqueIndex:idx_cloud_health_events_event_arn_cluster_id"`
+	EventArn             string           `gorm:"uniqueIndex:idx_cloud_health_events_event_arn_cluster_id"`
+	Service              string
+	EventTypeCode        string
+	EventTypeCategory    string
+	Region               string
+	StatusCode           string
+	StartTime            *time.Time
+	EndTime              *time.Time
+	Description          string     `gorm:"-:all"`
+	Entities             []string   `gorm:"-:all"`
+	SystemNotificationId *uuid.UUID `gorm:"type:uuid"`
+}
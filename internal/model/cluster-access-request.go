@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// Models
+type ClusterAccessRequest struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey"`
+	OrganizationId string    `gorm:"index"`
+	ClusterId      domain.ClusterId
+	Cluster        Cluster `gorm:"foreignKey:ClusterId"`
+	AccessType     domain.ClusterAccessType
+	Reason         string
+	Status         domain.ClusterAccessRequestStatus `gorm:"index"`
+	StatusDesc     string
+	TtlSeconds     int
+	RequesterId    *uuid.UUID `gorm:"type:uuid"`
+	Requester      User       `gorm:"foreignKey:RequesterId"`
+	ApproverId     *uuid.UUID `gorm:"type:uuid"`
+	Approver       User       `gorm:"foreignKey:ApproverId"`
+	ApprovedAt     *time.Time
+	ExpiresAt      *time.Time
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Models
+// ClusterHeartbeat 는 클러스터별 마지막 연결 확인 시각과 연결 상태를 기록한다.
+// 클러스터의 agent 가 heartbeat 를 보내거나, agent 가 없으면 TKS 가 API server 를 직접 확인한다.
+type ClusterHeartbeat struct {
+	ClusterId      domain.ClusterId `gorm:"primarykey"`
+	OrganizationId string           `gorm:"index"`
+	Source         domain.ClusterHeartbeatSource
+	AgentVersion   string
+	LastSeenAt     time.Time
+	Status         domain.ClusterConnectivityStatus
+	StatusDesc     string
+	NotifiedAt     *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
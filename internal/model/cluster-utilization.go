@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// ClusterUtilization is a daily downsampled utilization of a cluster.
+// It keeps chart history beyond the retention of the LMA(thanos).
+type ClusterUtilization struct {
+	ID             uuid.UUID                `gorm:"primarykey;type:uuid"`
+	OrganizationId string                   `gorm:"type:varchar(36);index"`
+	ClusterId      domain.ClusterId         `gorm:"uniqueIndex:idx_cluster_utilization_daily"`
+	Metric         domain.UtilizationMetric `gorm:"uniqueIndex:idx_cluster_utilization_daily"`
+	Date           time.Time                `gorm:"uniqueIndex:idx_cluster_utilization_daily"`
+	Min            float64
+	Avg            float64
+	Max            float64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (c *ClusterUtilization) BeforeCreate(tx *gorm.DB) (err error) {
+	c.ID = uuid.New()
+	return nil
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Models
+// ClusterVersionAdvisory 는 클러스터의 kubernetes 버전 지원 종료 알림을 보낸 기록이다.
+// 같은 버전에 대해 지원 종료 임박, 지원 종료 알림을 각각 한 번만 보내며, 버전이 바뀌면 다시 알린다.
+type ClusterVersionAdvisory struct {
+	ClusterId        domain.ClusterId `gorm:"primarykey"`
+	OrganizationId   string           `gorm:"index"`
+	KubeVersion      string
+	EndOfSupportAt   time.Time
+	NotifiedSeverity string
+	NotifiedAt       *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
@@ -1,8 +1,11 @@
 package model
 
 import (
+	"encoding/json"
+
 	"github.com/google/uuid"
 	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -44,6 +47,27 @@ type Cluster struct {
 	UpdatorId              *uuid.UUID `gorm:"type:uuid"`
 	Updator                User       `gorm:"foreignKey:UpdatorId"`
 	Policies               []Policy   `gorm:"many2many:policy_target_clusters"`
+	Tag                    datatypes.JSON
+	Tags                   map[string]string `gorm:"-:all"`
+
+	TksCpNodeImage    string
+	TksInfraNodeImage string
+	TksUserNodeImage  string
+}
+
+func (m *Cluster) BeforeCreate(tx *gorm.DB) (err error) {
+	if m.Tags != nil {
+		m.Tag, err = json.Marshal(m.Tags)
+	}
+	return err
+}
+
+func (m *Cluster) AfterFind(tx *gorm.DB) (err error) {
+	if len(m.Tag) > 0 {
+		// 목록 조회가 실패하지 않도록 잘못된 tag 는 무시한다.
+		_ = json.Unmarshal(m.Tag, &m.Tags)
+	}
+	return nil
 }
 
 func (m *Cluster) SetDefaultConf() {
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// CostAllocationTagPolicy is the cost allocation tag that every cloud resource of the organization's stacks must have
+type CostAllocationTagPolicy struct {
+	OrganizationId string `gorm:"primarykey;type:varchar(36)"`
+	Key            string `gorm:"primarykey"`
+	DefaultValue   string
+	AllowedValue   datatypes.JSON
+	AllowedValues  []string `gorm:"-:all"`
+	Required       bool
+	Description    string
+	UpdatorId      *uuid.UUID `gorm:"type:uuid"`
+	Updator        User       `gorm:"foreignKey:UpdatorId"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -1,7 +1,11 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -19,3 +23,31 @@ func (d *Dashboard) BeforeCreate(tx *gorm.DB) (err error) {
 	d.ID = uuid.New()
 	return nil
 }
+
+// CustomChart 는 조직이 PromQL 로 정의한 대시보드 chart 이다.
+type CustomChart struct {
+	gorm.Model
+	ID             uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId string    `gorm:"type:varchar(36);index"`
+	Name           string
+	Description    string
+	Query          string
+	Unit           domain.ChartUnit
+	Thresholds     datatypes.JSON
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	Creator        User       `gorm:"foreignKey:CreatorId"`
+}
+
+// ChartSnapshot 은 공유 링크로 조회할 수 있도록 저장한 chart 조회 결과이다. 생성 이후에는 변경하지 않는다.
+type ChartSnapshot struct {
+	gorm.Model
+	ID             uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId string    `gorm:"type:varchar(36);index"`
+	ChartType      string
+	Title          string
+	Data           datatypes.JSON
+	TokenHash      string     `gorm:"uniqueIndex"`
+	ExpiredAt      time.Time  `gorm:"index"`
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	Creator        User       `gorm:"foreignKey:CreatorId"`
+}
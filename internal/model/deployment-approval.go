@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// Models
+// DeploymentApprovalPolicy 가 존재하는 클러스터는 production 대상으로 간주된다.
+type DeploymentApprovalPolicy struct {
+	ClusterId      domain.ClusterId `gorm:"primarykey"`
+	Cluster        Cluster          `gorm:"foreignKey:ClusterId"`
+	OrganizationId string           `gorm:"index"`
+	Approvers      []User           `gorm:"many2many:deployment_approval_policy_approvers;constraint:OnUpdate:RESTRICT,OnDelete:RESTRICT"`
+	ApproverIds    []string         `gorm:"-:all"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type DeploymentApproval struct {
+	gorm.Model
+
+	ID                uuid.UUID   `gorm:"primarykey"`
+	OrganizationId    string      `gorm:"index"`
+	AppServeAppId     string      `gorm:"index"`
+	AppServeApp       AppServeApp `gorm:"foreignKey:AppServeAppId"`
+	AppServeAppTaskId string
+	ClusterId         domain.ClusterId
+	Action            domain.DeploymentApprovalAction
+	Status            domain.DeploymentApprovalStatus `gorm:"index"`
+	Comment           string
+	RequesterId       *uuid.UUID `gorm:"type:uuid"`
+	Requester         User       `gorm:"foreignKey:RequesterId"`
+	ApproverId        *uuid.UUID `gorm:"type:uuid"`
+	Approver          User       `gorm:"foreignKey:ApproverId"`
+	DecidedAt         *time.Time
+}
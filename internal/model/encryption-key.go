@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// Models
+// EncryptionKey 는 조직이 등록한 KMS key 이다. 조직의 secret 은 ACTIVE key 로 봉인되고,
+// RETIRED key 는 재봉인 전까지 복호화에만 사용되며 REVOKED key 로 봉인된 secret 은 더 이상 복호화하지 않는다.
+type EncryptionKey struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId string    `gorm:"type:varchar(36);index"`
+	Provider       string    `gorm:"default:AWS_KMS"`
+	KeyArn         string
+	Status         domain.EncryptionKeyStatus
+	Description    string
+	RetiredAt      *time.Time
+	RevokedAt      *time.Time
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	Creator        User       `gorm:"foreignKey:CreatorId"`
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Models
+// EscalationPolicy 는 알림 라우팅 규칙과 일치한 앨럿이 정해진 시간 안에 확인되지 않으면 단계별로 다시 알릴 대상을 정한다.
+type EscalationPolicy struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey"`
+	OrganizationId string    `gorm:"index"`
+	Name           string
+	Description    string
+	Enabled        bool
+	Severity       datatypes.JSON
+	Severities     []string `gorm:"-:all"`
+	Step           datatypes.JSON
+	Steps          []domain.EscalationStep `gorm:"-:all"`
+	CreatorId      *uuid.UUID              `gorm:"type:uuid"`
+	Creator        User                    `gorm:"foreignKey:CreatorId"`
+	UpdatorId      *uuid.UUID              `gorm:"type:uuid"`
+	Updator        User                    `gorm:"foreignKey:UpdatorId"`
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Models
+// GitProvider 는 조직이 등록한 GitHub, GitLab 의 접속 정보이다. Url 은 web 주소(예: https://github.com)이다.
+// Token 은 봉인한 access token 이며 응답으로 반환하지 않는다.
+type GitProvider struct {
+	ID             uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId string    `gorm:"type:varchar(36);uniqueIndex:idx_git_provider_name"`
+	Name           string    `gorm:"uniqueIndex:idx_git_provider_name"`
+	Type           string
+	Url            string
+	Description    string
+	Token          []byte
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	Creator        User       `gorm:"foreignKey:CreatorId"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// AppServeAppGitSource 는 앱을 빌드할 git repository 와 branch 이다. Repository 는 "owner/repo" 형식의 경로이다.
+// WebhookSecret 은 push webhook 을 검증하는 값으로 봉인해 저장한다.
+// AutoDeploy 가 켜져 있으면 branch 에 push 될 때마다 앱을 다시 빌드하고 배포한다.
+type AppServeAppGitSource struct {
+	AppServeAppId   string      `gorm:"primarykey"`
+	OrganizationId  string      `gorm:"type:varchar(36);index"`
+	GitProviderId   uuid.UUID   `gorm:"type:uuid;index"`
+	GitProvider     GitProvider `gorm:"foreignKey:GitProviderId"`
+	Repository      string
+	Branch          string
+	AutoDeploy      bool
+	WebhookSecret   []byte
+	LastCommit      string
+	LastTriggeredAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
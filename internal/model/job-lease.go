@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+)
+
+// Models
+// JobLease 는 background job 을 실행할 replica 이다. Holder 만 ExpiresAt 까지 job 을 실행하고,
+// 만료되면 다른 replica 가 lease 를 가져간다.
+type JobLease struct {
+	Name      string `gorm:"primarykey"`
+	Holder    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Models
+// LmaEndpoint 는 primary cluster 의 LMA 를 사용할 수 없을 때 대신 조회할 조직의 보조 LMA(thanos) endpoint 이다.
+type LmaEndpoint struct {
+	gorm.Model
+
+	ID             uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId string    `gorm:"type:varchar(36);index"`
+	Url            string
+	Priority       int
+	Description    string
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	UpdatorId      *uuid.UUID `gorm:"type:uuid"`
+}
+
+// LmaAuth 는 조직의 LMA(thanos) 에 접속할 때 사용하는 TLS, 인증 설정이다. primary cluster 의 LMA 와 보조 endpoint 에 함께 적용된다.
+// Password, BearerToken 은 봉인하여 저장한다.
+type LmaAuth struct {
+	OrganizationId     string `gorm:"primarykey;type:varchar(36)"`
+	CaCert             string
+	InsecureSkipVerify bool
+	Username           string
+	Password           string
+	BearerToken        string
+	UpdatorId          *uuid.UUID `gorm:"type:uuid"`
+	Updator            User       `gorm:"foreignKey:UpdatorId"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// LmaDiscovery 는 primary cluster 에서 LMA(thanos) 주소를 찾는 방법이다. 설정하지 않은 조직은 AUTO 로 동작한다.
+type LmaDiscovery struct {
+	OrganizationId  string `gorm:"primarykey;type:varchar(36)"`
+	Method          string
+	Namespace       string
+	ServiceName     string
+	IngressName     string
+	NodeAddressType string
+	UpdatorId       *uuid.UUID `gorm:"type:uuid"`
+	Updator         User       `gorm:"foreignKey:UpdatorId"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// Models
+// MaintenanceWindow 가 진행 중인 클러스터는 알림을 발송하지 않고, 관리자가 아닌 사용자의 배포를 막는다.
+type MaintenanceWindow struct {
+	gorm.Model
+
+	ID             uuid.UUID        `gorm:"primarykey"`
+	OrganizationId string           `gorm:"index"`
+	ClusterId      domain.ClusterId `gorm:"index"`
+	Cluster        Cluster          `gorm:"foreignKey:ClusterId"`
+	Name           string
+	Description    string
+	StartAt        time.Time  `gorm:"index"`
+	EndAt          time.Time  `gorm:"index"`
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	Creator        User       `gorm:"foreignKey:CreatorId"`
+	UpdatorId      *uuid.UUID `gorm:"type:uuid"`
+	Updator        User       `gorm:"foreignKey:UpdatorId"`
+}
+
+func (m MaintenanceWindow) IsActive(now time.Time) bool {
+	return !now.Before(m.StartAt) && now.Before(m.EndAt)
+}
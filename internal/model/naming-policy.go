@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// NamingPolicy is the naming rules of an organization applied when stacks, apps or projects are created
+type NamingPolicy struct {
+	OrganizationId string `gorm:"primarykey;type:varchar(36)"`
+	ResourceType   string `gorm:"primarykey"`
+	Pattern        string
+	Prefix         datatypes.JSON
+	Prefixes       []string `gorm:"-:all"`
+	ReservedName   datatypes.JSON
+	ReservedNames  []string   `gorm:"-:all"`
+	UpdatorId      *uuid.UUID `gorm:"type:uuid"`
+	Updator        User       `gorm:"foreignKey:UpdatorId"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
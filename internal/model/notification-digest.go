@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Models
+// NotificationDigestSetting 은 사용자의 채널별 digest 설정이다. 설정이 없으면 IMMEDIATE 로 동작한다.
+type NotificationDigestSetting struct {
+	UserId         uuid.UUID                  `gorm:"primarykey;type:uuid"`
+	Channel        domain.NotificationChannel `gorm:"primarykey"`
+	OrganizationId string                     `gorm:"type:varchar(36);index"`
+	Mode           domain.NotificationDigestMode
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NotificationDigestItem 은 digest 로 보내기 위해 대기 중인 알림이다. 요약을 보낸 뒤 삭제한다.
+type NotificationDigestItem struct {
+	ID                   uuid.UUID                  `gorm:"primarykey;type:uuid"`
+	UserId               uuid.UUID                  `gorm:"type:uuid;index"`
+	User                 User                       `gorm:"foreignKey:UserId"`
+	Channel              domain.NotificationChannel `gorm:"index"`
+	OrganizationId       string                     `gorm:"type:varchar(36)"`
+	SystemNotificationId *uuid.UUID                 `gorm:"type:uuid"`
+	ClusterId            domain.ClusterId
+	Severity             string
+	Title                string
+	Content              string
+	CreatedAt            time.Time
+}
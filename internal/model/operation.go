@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Models
+// Operation 은 stack 생성/삭제, 앱 배포처럼 workflow 로 실행되는 작업이다.
+// 동시 실행 제한을 넘는 작업은 PENDING 으로 저장되었다가 실행 중인 작업이 끝나면 순서대로 제출된다.
+// workflow 가 끝나면 step 별 진행 상황과 로그 일부를 함께 저장해 workflow 가 정리된 후에도 task 이력으로 조회할 수 있다.
+type Operation struct {
+	gorm.Model
+
+	ID               uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId   string    `gorm:"type:varchar(36);index"`
+	Type             domain.OperationType
+	TargetId         string
+	TargetName       string
+	WorkflowTemplate string
+	Parameters       datatypes.JSON
+	WorkflowId       string
+	Status           domain.OperationStatus `gorm:"index"`
+	StatusDesc       string
+	SubmittedAt      *time.Time
+	FinishedAt       *time.Time
+	Progress         string
+	Steps            datatypes.JSON
+	LogExcerpt       string
+	CreatorId        *uuid.UUID `gorm:"type:uuid"`
+	Creator          User       `gorm:"foreignKey:CreatorId"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationBranding is the white-label settings of an organization used in emails and login/invitation pages
+type OrganizationBranding struct {
+	OrganizationId string `gorm:"primarykey;type:varchar(36)"`
+	DisplayName    string
+	LogoUrl        string
+	SupportContact string
+	EmailFooter    string
+	UpdatorId      *uuid.UUID `gorm:"type:uuid"`
+	Updator        User       `gorm:"foreignKey:UpdatorId"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
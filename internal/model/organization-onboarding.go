@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// OrganizationOnboarding is a completed setup step of an organization
+type OrganizationOnboarding struct {
+	OrganizationId string                `gorm:"primarykey;type:varchar(36)"`
+	Step           domain.OnboardingStep `gorm:"primarykey;type:varchar(36)"`
+	CompletedAt    time.Time
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationQuota is the resource limits of an organization applied when stacks or users are created.
+// A zero value means the resource is not limited.
+type OrganizationQuota struct {
+	OrganizationId string `gorm:"primarykey;type:varchar(36)"`
+	MaxStacks      int
+	MaxNodes       int
+	MaxCpu         int
+	MaxMemoryGib   int
+	MaxUsers       int
+	UpdatorId      *uuid.UUID `gorm:"type:uuid"`
+	Updator        User       `gorm:"foreignKey:UpdatorId"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
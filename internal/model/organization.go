@@ -28,4 +28,7 @@ type Organization struct {
 	ClusterCount                  int                          `gorm:"-:all"`
 	AdminId                       *uuid.UUID
 	Admin                         *User `gorm:"-:all"`
+
+	// OtpRequired 가 true 이면 조직의 사용자는 2단계 인증을 등록해야 한다.
+	OtpRequired bool
 }
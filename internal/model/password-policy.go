@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordPolicy is the password rules of an organization applied when a user sets a password
+type PasswordPolicy struct {
+	OrganizationId      string `gorm:"primarykey;type:varchar(36)"`
+	MinLength           int
+	MinCharacterClasses int
+	HistoryCount        int
+	ExpiryDays          int
+	UpdatorId           *uuid.UUID `gorm:"type:uuid"`
+	Updator             User       `gorm:"foreignKey:UpdatorId"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// ExpiryDuration returns zero when passwords of the organization never expire
+func (p PasswordPolicy) ExpiryDuration() time.Duration {
+	return time.Duration(p.ExpiryDays) * 24 * time.Hour
+}
+
+// PasswordHistory keeps the hashes of passwords previously used by a user
+type PasswordHistory struct {
+	ID           uuid.UUID `gorm:"primarykey;type:uuid"`
+	UserId       uuid.UUID `gorm:"type:uuid;index"`
+	PasswordHash string
+	CreatedAt    time.Time
+}
@@ -156,8 +156,26 @@ func newDashboard() *Permission {
 						Endpoints: endpointObjects(
 							api.GetChartsDashboard,
 							api.GetChartDashboard,
+							api.StreamChartsDashboard,
 							api.GetStacksDashboard,
 							api.GetResourcesDashboard,
+							api.GetResourcesDashboardV2,
+							api.GetStackNodesDashboard,
+							api.GetStackChartsDashboard,
+							api.GetStackChartDashboard,
+							api.GetStoragesDashboard,
+							api.GetNetworkPoliciesDashboard,
+							api.GetIdentityStatusDashboard,
+							api.GetHealthDashboard,
+							api.GetCostsDashboard,
+							api.GetClusterEventsDashboard,
+							api.GetAppServeAppSummary,
+							api.GetCustomChartsDashboard,
+							api.GetCustomChartDashboard,
+							api.GetCustomChartDataDashboard,
+							api.GetChartSnapshotsDashboard,
+							api.GetAvailabilityDashboard,
+							api.GetPolicyEnforcementStatusDashboard,
 						),
 					},
 					{
@@ -165,6 +183,13 @@ func newDashboard() *Permission {
 						Name:      "수정",
 						Key:       OperationUpdate,
 						IsAllowed: helper.BoolP(false),
+						Endpoints: endpointObjects(
+							api.CreateCustomChartDashboard,
+							api.UpdateCustomChartDashboard,
+							api.DeleteCustomChartDashboard,
+							api.CreateChartSnapshotDashboard,
+							api.DeleteChartSnapshotDashboard,
+						),
 					},
 				},
 			},
@@ -196,6 +221,13 @@ func newStack() *Permission {
 							api.CheckStackName,
 							api.GetStackStatus,
 							api.GetStackKubeConfig,
+							api.GetStackDefault,
+							api.GetCostAllocationTagPolicies,
+							api.CheckStackCostAllocationTagDrift,
+							api.GetStackAddonVersions,
+							api.GetStackNodes,
+							api.GetStackUpgrades,
+							api.GetCloudHealthEvents,
 
 							api.SetFavoriteStack,
 							api.DeleteFavoriteStack,
@@ -206,11 +238,39 @@ func newStack() *Permission {
 							api.GetClusterSiteValues,
 							api.GetBootstrapKubeconfig,
 							api.GetNodes,
+							api.SearchPods,
+							api.GetClusterNamespaces,
+							api.GetClusterWorkloads,
+							api.GetClusterPods,
+							api.GetPodLogs,
+							api.GetClusterKubeconfig,
 
 							// AppGroup
 							api.GetAppgroups,
 							api.GetAppgroup,
 							api.GetApplications,
+
+							// ClusterAccessRequest
+							api.CreateClusterAccessRequest,
+							api.GetClusterAccessRequests,
+							api.GetClusterAccessRequest,
+
+							// DeploymentApproval
+							api.GetDeploymentApprovalPolicy,
+
+							// MaintenanceWindow
+							api.GetMaintenanceWindows,
+							api.GetOrganizationMaintenanceWindows,
+							api.GetMaintenanceWindow,
+
+							// StackMonitoringEndpoint
+							api.GetStackMonitoringEndpoints,
+							api.GetStackMonitoringEndpoint,
+
+							// Catalog
+							api.GetCatalogCharts,
+							api.GetCatalogChart,
+							api.GetCatalogApps,
 						),
 					},
 					{
@@ -241,6 +301,30 @@ func newStack() *Permission {
 						IsAllowed: helper.BoolP(false),
 						Endpoints: endpointObjects(
 							api.UpdateStack,
+							api.ScaleStackNodeGroup,
+							api.UpgradeStack,
+
+							// ClusterAccessRequest
+							api.ApproveClusterAccessRequest,
+							api.RejectClusterAccessRequest,
+							api.RevokeClusterAccessRequest,
+
+							// DeploymentApproval
+							api.UpdateDeploymentApprovalPolicy,
+							api.DeleteDeploymentApprovalPolicy,
+
+							// MaintenanceWindow
+							api.CreateMaintenanceWindow,
+							api.UpdateMaintenanceWindow,
+							api.DeleteMaintenanceWindow,
+
+							// StackMonitoringEndpoint
+							api.CreateStackMonitoringEndpoint,
+							api.UpdateStackMonitoringEndpoint,
+							api.DeleteStackMonitoringEndpoint,
+
+							// Catalog
+							api.CreateCatalogApp,
 						),
 					},
 					{
@@ -646,6 +730,15 @@ func newProject() *Permission {
 							api.IsAppServeAppNameExist,
 							api.GetAppServeAppTaskDetail,
 							api.GetAppServeAppTasksByAppId,
+							api.GetAppServeAppMetrics,
+							api.GetAppServeAppConfigs,
+							api.GetAppServeAppConfig,
+							api.GetAppServeAppConfigDiff,
+							api.GetAppServeAppGitSource,
+							api.GetGitProviders,
+							api.GetGitProvider,
+							api.GetDeploymentApprovals,
+							api.GetDeploymentApproval,
 						),
 					},
 					{
@@ -661,6 +754,12 @@ func newProject() *Permission {
 							api.UpdateAppServeAppEndpoint,
 							api.UpdateAppServeAppStatus,
 							api.RollbackAppServeApp,
+							api.UpdateAppServeAppConfig,
+							api.UpdateAppServeAppGitSource,
+							api.DeleteAppServeAppGitSource,
+							api.BuildAppServeApp,
+							api.ApproveDeployment,
+							api.RejectDeployment,
 						),
 					},
 					{
@@ -676,6 +775,12 @@ func newProject() *Permission {
 							api.UpdateAppServeAppEndpoint,
 							api.UpdateAppServeAppStatus,
 							api.RollbackAppServeApp,
+							api.UpdateAppServeAppConfig,
+							api.UpdateAppServeAppGitSource,
+							api.DeleteAppServeAppGitSource,
+							api.BuildAppServeApp,
+							api.ApproveDeployment,
+							api.RejectDeployment,
 						),
 					},
 					{
@@ -711,12 +816,37 @@ func newConfiguration() *Permission {
 						Name:      "조회",
 						Key:       OperationRead,
 						IsAllowed: helper.BoolP(false),
+						Endpoints: endpointObjects(
+							api.GetOrganizationAudits,
+							api.GetOrganizationTimeline,
+							api.GetAuditSinks,
+							api.GetAuditSink,
+							api.GetAuditSinkDeadLetters,
+							api.GetStackWebhooks,
+							api.GetStackWebhook,
+							api.GetOrganizationBranding,
+							api.GetNamingPolicies,
+							api.GetOrganizationQuota,
+							api.GetOrganizationUsage,
+						),
 					},
 					{
 						ID:        uuid.New(),
 						Name:      "수정",
 						Key:       OperationUpdate,
 						IsAllowed: helper.BoolP(false),
+						Endpoints: endpointObjects(
+							api.CreateAuditSink,
+							api.UpdateAuditSink,
+							api.DeleteAuditSink,
+							api.RetryAuditSinkDeadLetter,
+							api.DeleteAuditSinkDeadLetter,
+							api.CreateStackWebhook,
+							api.UpdateStackWebhook,
+							api.DeleteStackWebhook,
+							api.UpdateOrganizationBranding,
+							api.UpdateNamingPolicy,
+						),
 					},
 				},
 			},
@@ -736,6 +866,7 @@ func newConfiguration() *Permission {
 							api.CheckCloudAccountName,
 							api.CheckAwsAccountId,
 							api.GetResourceQuota,
+							api.GetCloudAccountPricing,
 						),
 					},
 					{
@@ -754,6 +885,9 @@ func newConfiguration() *Permission {
 						IsAllowed: helper.BoolP(false),
 						Endpoints: endpointObjects(
 							api.UpdateCloudAccount,
+							api.UpdateCloudAccountPricing,
+							api.DeleteCloudAccountPricing,
+							api.VerifyCloudAccount,
 						),
 					},
 					{
@@ -802,6 +936,7 @@ func newConfiguration() *Permission {
 							api.GetUser,
 							api.CheckId,
 							api.CheckEmail,
+							api.GetUserSessions,
 						),
 					},
 					{
@@ -823,6 +958,8 @@ func newConfiguration() *Permission {
 						Endpoints: endpointObjects(
 							api.UpdateUser,
 							api.ResetPassword,
+							api.UpdatePasswordPolicy,
+							api.DeleteUserSession,
 						),
 					},
 					{
@@ -851,6 +988,8 @@ func newConfiguration() *Permission {
 							api.GetTksRole,
 							api.GetPermissionsByRoleId,
 							api.GetPermissionTemplates,
+							api.GetRoleReassignments,
+							api.GetRoleReassignment,
 						),
 					},
 					{
@@ -870,6 +1009,7 @@ func newConfiguration() *Permission {
 						Endpoints: endpointObjects(
 							api.UpdateTksRole,
 							api.UpdatePermissionsByRoleId,
+							api.CreateRoleReassignment,
 						),
 					},
 					{
@@ -896,6 +1036,16 @@ func newConfiguration() *Permission {
 						Endpoints: endpointObjects(
 							api.GetSystemNotificationRules,
 							api.GetSystemNotificationRule,
+							api.GetAlertIngestionTokens,
+							api.GetAlertRoutingRules,
+							api.GetAlertRoutingRule,
+							api.TestAlertRoutingRules,
+							api.GetAlertChannels,
+							api.GetAlertChannel,
+							api.GetAlertSilences,
+							api.GetAlertSilence,
+							api.GetEscalationPolicies,
+							api.GetEscalationPolicy,
 						),
 					},
 					{
@@ -905,6 +1055,11 @@ func newConfiguration() *Permission {
 						IsAllowed: helper.BoolP(false),
 						Endpoints: endpointObjects(
 							api.CreateSystemNotificationRule,
+							api.CreateAlertIngestionToken,
+							api.CreateAlertRoutingRule,
+							api.CreateAlertChannel,
+							api.CreateAlertSilence,
+							api.CreateEscalationPolicy,
 						),
 					},
 					{
@@ -914,6 +1069,12 @@ func newConfiguration() *Permission {
 						IsAllowed: helper.BoolP(false),
 						Endpoints: endpointObjects(
 							api.UpdateSystemNotificationRule,
+							api.RotateAlertIngestionToken,
+							api.UpdateAlertRoutingRule,
+							api.UpdateAlertChannel,
+							api.TestAlertChannel,
+							api.UpdateAlertSilence,
+							api.UpdateEscalationPolicy,
 						),
 					},
 					{
@@ -923,6 +1084,11 @@ func newConfiguration() *Permission {
 						IsAllowed: helper.BoolP(false),
 						Endpoints: endpointObjects(
 							api.DeleteSystemNotificationRule,
+							api.RevokeAlertIngestionToken,
+							api.DeleteAlertRoutingRule,
+							api.DeleteAlertChannel,
+							api.DeleteAlertSilence,
+							api.DeleteEscalationPolicy,
 						),
 					},
 				},
@@ -948,6 +1114,7 @@ func newCommon() *Permission {
 			api.VerifyIdentityForLostId,
 			api.VerifyIdentityForLostPassword,
 			api.VerifyToken,
+			api.GetPublicOrganizationBranding,
 
 			// Stack
 			api.SetFavoriteStack,
@@ -965,6 +1132,12 @@ func newCommon() *Permission {
 			api.UpdateMyPassword,
 			api.RenewPasswordExpiredDate,
 			api.DeleteMyProfile,
+			api.GetMyNotificationDigestSettings,
+			api.UpdateMyNotificationDigestSettings,
+			api.EnrollMyOtp,
+			api.ActivateMyOtp,
+			api.DisableMyOtp,
+			api.GetPasswordPolicy,
 
 			// StackTemplate
 			api.GetOrganizationStackTemplates,
@@ -993,6 +1166,37 @@ func newAdmin() *Permission {
 			api.GetOrganizations,
 			api.UpdatePrimaryCluster,
 			api.CheckOrganizationName,
+			api.GetOrganizationOnboarding,
+			api.GetOrganizationBranding,
+			api.UpdateOrganizationBranding,
+			api.GetNamingPolicies,
+			api.UpdateNamingPolicy,
+			api.GetOrganizationQuota,
+			api.Admin_GetOrganizationQuota,
+			api.Admin_UpdateOrganizationQuota,
+			api.GetOrganizationUsage,
+			api.GetLmaEndpoints,
+			api.CreateLmaEndpoint,
+			api.UpdateLmaEndpoint,
+			api.DeleteLmaEndpoint,
+			api.GetLmaAuth,
+			api.UpdateLmaAuth,
+			api.GetLmaDiscovery,
+			api.UpdateLmaDiscovery,
+			api.ExportManifests,
+			api.ApplyManifests,
+			api.GetEncryptionKeys,
+			api.CreateEncryptionKey,
+			api.RotateEncryptionKey,
+			api.RevokeEncryptionKey,
+			api.GetGitProviders,
+			api.GetGitProvider,
+			api.CreateGitProvider,
+			api.UpdateGitProvider,
+			api.DeleteGitProvider,
+			api.GetOperations,
+			api.CancelOperation,
+			api.GetTask,
 
 			// User
 			api.ResetPassword,
@@ -1009,6 +1213,15 @@ func newAdmin() *Permission {
 			api.Admin_UpdateStackTemplateOrganizations,
 			api.Admin_CheckStackTemplateName,
 
+			// StackDefault
+			api.Admin_GetStackDefault,
+			api.Admin_UpdateStackDefault,
+			api.Admin_DeleteStackDefault,
+
+			// CostAllocationTag
+			api.Admin_GetCostAllocationTagPolicies,
+			api.Admin_UpdateCostAllocationTagPolicies,
+
 			// Admin
 			api.Admin_GetUser,
 			api.Admin_ListUser,
@@ -1028,6 +1241,22 @@ func newAdmin() *Permission {
 			api.GetAudits,
 			api.GetAudit,
 			api.DeleteAudit,
+			api.Admin_GetAuditStatistics,
+			api.Admin_ArchiveAudits,
+			api.Admin_GetAuditRetentionPolicies,
+			api.Admin_UpdateAuditRetentionPolicy,
+			api.Admin_CreateAuditArchivalRun,
+			api.Admin_GetAuditArchivalRuns,
+			api.Admin_GetAuditArchivalRun,
+
+			// Dashboard
+			api.Admin_WarmupDashboardCaches,
+
+			// Catalog
+			api.Admin_GetHelmRepositories,
+			api.Admin_CreateHelmRepository,
+			api.Admin_DeleteHelmRepository,
+			api.Admin_SyncHelmRepository,
 
 			api.CreateSystemNotification,
 			api.DeleteSystemNotification,
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Models
+// RoleReassignment 는 조직에서 한 역할의 모든 사용자를 다른 역할로 옮기는 작업이다. 사용자별 결과는 RoleReassignmentResult 에 남긴다.
+// 역할이 삭제되어도 기록을 확인할 수 있도록 역할 이름을 함께 저장한다.
+type RoleReassignment struct {
+	ID             uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId string    `gorm:"type:varchar(36);index"`
+	SourceRoleId   string
+	SourceRoleName string
+	TargetRoleId   string
+	TargetRoleName string
+	Status         domain.RoleReassignmentStatus `gorm:"index"`
+	TotalCount     int
+	SucceededCount int
+	FailedCount    int
+	Error          string
+	CreatorId      *uuid.UUID `gorm:"type:uuid"`
+	Creator        User       `gorm:"foreignKey:CreatorId"`
+	StartedAt      time.Time  `gorm:"index"`
+	FinishedAt     *time.Time
+	Results        []RoleReassignmentResult `gorm:"foreignKey:ReassignmentId"`
+}
+
+type RoleReassignmentResult struct {
+	ID             uuid.UUID `gorm:"primarykey;type:uuid"`
+	ReassignmentId uuid.UUID `gorm:"type:uuid;index"`
+	UserId         uuid.UUID `gorm:"type:uuid"`
+	AccountId      string
+	Status         domain.RoleReassignmentResultStatus
+	Error          string
+	FinishedAt     time.Time
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// StackDefault is the organization-wide default parameters for creating stacks
+type StackDefault struct {
+	OrganizationId   string `gorm:"primarykey;type:varchar(36)"`
+	Region           string
+	TksCpNodeType    string
+	TksInfraNodeType string
+	TksUserNodeType  string
+	VpcCidr          string
+	PodCidr          string
+	ServiceCidr      string
+	Tag              datatypes.JSON
+	Tags             map[string]string `gorm:"-:all"`
+	UpdatorId        *uuid.UUID        `gorm:"type:uuid"`
+	Updator          User              `gorm:"foreignKey:UpdatorId"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// Models
+// StackMonitoringEndpoint 는 stack 의 ingress, 고객 앱 등 외부에서 접근하는 HTTP(S) 주소이다.
+// TKS 가 주기적으로 호출하여 가용성을 기록하고, 연속으로 실패하면 알림을 생성한다.
+type StackMonitoringEndpoint struct {
+	gorm.Model
+
+	ID                  uuid.UUID        `gorm:"primarykey"`
+	OrganizationId      string           `gorm:"index"`
+	ClusterId           domain.ClusterId `gorm:"index"`
+	Cluster             Cluster          `gorm:"foreignKey:ClusterId"`
+	Name                string
+	Url                 string
+	Method              string
+	ExpectedStatusCode  int
+	IntervalSeconds     int
+	TimeoutSeconds      int
+	FailureThreshold    int
+	InsecureSkipVerify  bool
+	Enabled             bool
+	Status              domain.MonitoringEndpointStatus
+	LastStatusCode      int
+	LastLatencyMs       int64
+	LastError           string
+	LastCheckedAt       *time.Time
+	ConsecutiveFailures int
+	NotifiedAt          *time.Time
+	CreatorId           *uuid.UUID `gorm:"type:uuid"`
+	Creator             User       `gorm:"foreignKey:CreatorId"`
+	UpdatorId           *uuid.UUID `gorm:"type:uuid"`
+	Updator             User       `gorm:"foreignKey:UpdatorId"`
+
+	Availability domain.MonitoringEndpointAvailability `gorm:"-:all"`
+}
+
+// StackMonitoringProbe 는 endpoint 호출 결과이다. 가용성 계산에 사용하고 보관 기간이 지나면 삭제한다.
+type StackMonitoringProbe struct {
+	ID                   uuid.UUID `gorm:"primarykey"`
+	MonitoringEndpointId uuid.UUID `gorm:"index:idx_stack_monitoring_probe_endpoint_checked_at,priority:1"`
+	CheckedAt            time.Time `gorm:"index:idx_stack_monitoring_probe_endpoint_checked_at,priority:2"`
+	Success              bool
+	StatusCode           int
+	LatencyMs            int64
+}
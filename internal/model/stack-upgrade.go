@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/datatypes"
+)
+
+// StackUpgrade 는 스택 업그레이드 이력이다. 상태는 업그레이드 operation 의 결과를 따라 갱신되고,
+// 업그레이드가 완료되면 클러스터의 스택 템플릿이 ToStackTemplateId 로 바뀐다.
+type StackUpgrade struct {
+	ID                  uuid.UUID        `gorm:"primarykey;type:uuid"`
+	OrganizationId      string           `gorm:"type:varchar(36);index"`
+	ClusterId           domain.ClusterId `gorm:"index"`
+	FromStackTemplateId uuid.UUID
+	FromStackTemplate   StackTemplate `gorm:"foreignKey:FromStackTemplateId"`
+	ToStackTemplateId   uuid.UUID
+	ToStackTemplate     StackTemplate `gorm:"foreignKey:ToStackTemplateId"`
+	FromKubeVersion     string
+	ToKubeVersion       string
+	OperationId         uuid.UUID              `gorm:"type:uuid"`
+	Status              domain.OperationStatus `gorm:"index"`
+	StatusDesc          string
+	// Preflight 는 업그레이드 요청 시점의 사전 점검 결과([]domain.StackUpgradeCheck)이다.
+	Preflight   datatypes.JSON
+	CreatorId   *uuid.UUID `gorm:"type:uuid"`
+	Creator     User       `gorm:"foreignKey:CreatorId"`
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
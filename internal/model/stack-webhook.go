@@ -0,0 +1,58 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Models
+// StackWebhook 은 조직의 스택(클러스터, 앱그룹) 상태 변경을 받을 외부 시스템(CMDB, Slack bot 등)이다.
+// Events 가 비어 있으면 모든 event 를 보낸다. Secret 은 봉인하여 저장하며 응답에 포함하지 않는다.
+type StackWebhook struct {
+	ID              uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId  string    `gorm:"type:varchar(36);index"`
+	Name            string
+	Url             string
+	Secret          string
+	Event           datatypes.JSON
+	Events          []string `gorm:"-:all"`
+	Enabled         bool
+	LastStatus      string
+	LastError       string
+	LastDeliveredAt *time.Time
+	SucceededCount  int64
+	FailedCount     int64
+	CreatorId       *uuid.UUID `gorm:"type:uuid"`
+	Creator         User       `gorm:"foreignKey:CreatorId"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (m *StackWebhook) BeforeSave(tx *gorm.DB) (err error) {
+	if m.Events != nil {
+		m.Event, err = json.Marshal(m.Events)
+	}
+	return err
+}
+
+func (m *StackWebhook) AfterFind(tx *gorm.DB) (err error) {
+	if len(m.Event) > 0 {
+		_ = json.Unmarshal(m.Event, &m.Events)
+	}
+	return nil
+}
+
+// StackLifecycleState 는 상태 변경을 감지하기 위해 마지막으로 확인한 클러스터, 앱그룹의 상태이다.
+type StackLifecycleState struct {
+	ResourceId     string `gorm:"primarykey"`
+	ResourceType   string
+	OrganizationId string `gorm:"type:varchar(36);index"`
+	ClusterId      string
+	Name           string
+	Status         string
+	UpdatedAt      time.Time
+}
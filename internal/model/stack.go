@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/openinfradev/tks-api/pkg/domain"
 	"gorm.io/gorm"
@@ -33,6 +35,7 @@ type Stack = struct {
 	PolicyIds       []string
 	Conf            StackConf
 	AppServeAppCnt  int
+	LastSeenAt      *time.Time
 }
 
 type StackConf struct {
@@ -45,4 +48,13 @@ type StackConf struct {
 	TksUserNode      int
 	TksUserNodeMax   int
 	TksUserNodeType  string
+	Region           string
+	VpcCidr          string
+	PodCidr          string
+	ServiceCidr      string
+	Tags             map[string]string
+
+	TksCpNodeImage    string
+	TksInfraNodeImage string
+	TksUserNodeImage  string
 }
@@ -39,6 +39,16 @@ type SystemNotification struct {
 	Read                      bool                                  `gorm:"-:all"`
 	Readers                   []User                                `gorm:"many2many:system_notification_users;constraint:OnUpdate:RESTRICT,OnDelete:RESTRICT"`
 	SystemNotificationRuleId  *uuid.UUID
+	AlertSilenceId            *uuid.UUID `gorm:"type:uuid"`
+	EscalationPolicyId        *uuid.UUID `gorm:"type:uuid"`
+	EscalationLevel           int
+	NextEscalationAt          *time.Time `gorm:"index"`
+}
+
+type SystemNotificationSeverityCount struct {
+	ClusterId domain.ClusterId
+	Severity  string
+	Count     int
 }
 
 type SystemNotificationAction struct {
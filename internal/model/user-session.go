@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Models
+// UserSession 은 TKS 로그인으로 생성된 keycloak session 이다. session 을 폐기하면 keycloak session 도 함께 종료한다.
+type UserSession struct {
+	ID             uuid.UUID `gorm:"primarykey;type:uuid"`
+	OrganizationId string    `gorm:"type:varchar(36);index"`
+	UserId         uuid.UUID `gorm:"type:uuid;index"`
+	User           User      `gorm:"foreignKey:UserId"`
+	SessionId      string    `gorm:"uniqueIndex"`
+	ClientIP       string
+	UserAgent      string
+	LastActivityAt time.Time `gorm:"index"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -28,6 +28,20 @@ type User struct {
 	Email       string `json:"email"`
 	Department  string `json:"department"`
 	Description string `json:"description"`
+	Timezone    string `json:"timezone"`
+
+	// OtpSecret 은 봉인된 TOTP secret 이며, 등록을 확인하기 전까지 OtpEnabled 는 false 이다.
+	OtpSecret             string `json:"-"`
+	OtpEnabled            bool   `json:"otpEnabled"`
+	OtpEnrollmentRequired bool   `gorm:"-:all" json:"otpEnrollmentRequired"`
+	// OtpLastCounter 는 마지막으로 사용한 TOTP 주기이다. 같은 주기 이전의 코드는 다시 사용할 수 없다.
+	OtpLastCounter    int64      `json:"-"`
+	OtpFailedAttempts int        `json:"-"`
+	OtpLockedUntil    *time.Time `json:"-"`
+
+	// 로그인 시 keycloak 이 발급한 refresh token 과 session id 이다. 저장하지 않는다.
+	RefreshToken string `gorm:"-:all" json:"refreshToken"`
+	SessionId    string `gorm:"-:all" json:"-"`
 }
 
 func (u *User) BeforeDelete(db *gorm.DB) (err error) {
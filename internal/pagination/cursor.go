@@ -0,0 +1,102 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/filter"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const CURSOR = "cursor"
+
+// Cursor 는 마지막으로 조회한 row 의 (created_at, id) 이다.
+// offset 대신 이 값보다 뒤의 row 를 조회하므로, 조회 중에 row 가 추가되어도 page 가 밀리거나 중복되지 않는다.
+type Cursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+func EncodeCursor(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeCursor(s string) (c Cursor, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	if err = json.Unmarshal(b, &c); err != nil {
+		return c, err
+	}
+	if c.CreatedAt.IsZero() || c.ID == "" {
+		return c, fmt.Errorf("empty cursor")
+	}
+	return c, nil
+}
+
+// FetchWithCursor 는 cursor 파라미터가 있으면 created_at, id 순서의 keyset pagination 으로 조회하고, 없으면 Fetch 와 같다.
+// dest 는 CreatedAt, ID field 를 가진 model 의 slice pointer 여야 한다.
+// cursor 조회에서는 전체 건수를 세지 않으며, 다음 page 가 있으면 NextCursor 를 채운다.
+func (p *Pagination) FetchWithCursor(db *gorm.DB, dest interface{}) *gorm.DB {
+	if !p.UseCursor {
+		_, res := p.Fetch(db, dest)
+		return res
+	}
+
+	desc := !strings.EqualFold(p.SortOrder, "ASC")
+	p.SortColumn = "created_at"
+	p.SortOrder = "DESC"
+	if !desc {
+		p.SortOrder = "ASC"
+	}
+	p.NextCursor = ""
+
+	createdAt := clause.Column{Table: clause.CurrentTable, Name: "created_at"}
+	id := clause.Column{Table: clause.CurrentTable, Name: "id"}
+	if p.Cursor != "" {
+		c, err := DecodeCursor(p.Cursor)
+		if err != nil {
+			_ = db.AddError(httpErrors.NewBadRequestError(fmt.Errorf("Invalid cursor. %s", err), "C_INVALID_CURSOR", ""))
+			return db
+		}
+		op := "<"
+		if !desc {
+			op = ">"
+		}
+		db = db.Where(clause.Expr{SQL: "(?, ?) " + op + " (?, ?)", Vars: []interface{}{createdAt, id, c.CreatedAt, c.ID}})
+	}
+
+	p.MakePaginationRequest()
+	p.PaginationRequest.Data["sort"] = []*filter.Sort{}
+	db = db.Order(clause.OrderByColumn{Column: createdAt, Desc: desc}).
+		Order(clause.OrderByColumn{Column: id, Desc: desc}).
+		Limit(p.GetLimit() + 1)
+
+	res := filter.ScopeUnpaginated(db, p.PaginationRequest, dest)
+	if res.Error != nil {
+		return res
+	}
+
+	// limit 보다 하나 더 조회하여 다음 page 가 있는지 확인한다.
+	rows := reflect.ValueOf(dest).Elem()
+	if rows.Len() > p.GetLimit() {
+		rows.Set(rows.Slice(0, p.GetLimit()))
+		last := rows.Index(rows.Len() - 1)
+		p.NextCursor = EncodeCursor(Cursor{
+			CreatedAt: last.FieldByName("CreatedAt").Interface().(time.Time),
+			ID:        fmt.Sprint(last.FieldByName("ID").Interface()),
+		})
+	}
+	p.Page = 0
+	p.TotalPages = 0
+	p.TotalRows = 0
+	return res
+}
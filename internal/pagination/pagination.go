@@ -22,6 +22,8 @@ const SORT_COLUMN = "sortColumn"
 const SORT_ORDER = "sortOrder"
 const PAGE_NUMBER = "pageNumber"
 const PAGE_SIZE = "pageSize"
+const LIMIT = "limit"
+const OFFSET = "offset"
 const FILTER = "filter"
 const FILTER_ARRAY = "filter[]"
 const OR = "or"
@@ -39,9 +41,15 @@ type Pagination struct {
 	CombinedFilter CombinedFilter // deprecated
 	TotalRows      int64
 	TotalPages     int
+	UseCursor      bool
+	Cursor         string
+	NextCursor     string
 
 	PaginationRequest *goyave.Request
 	Paginator         *database.Paginator
+
+	// offset 은 요청한 offset 이다. 설정되면 page 경계가 아니라 offset 부터 조회한다.
+	offset *int
 }
 
 type Filter struct {
@@ -58,6 +66,9 @@ type CombinedFilter struct {
 }
 
 func (p *Pagination) GetOffset() int {
+	if p.offset != nil {
+		return *p.offset
+	}
 	return (p.GetPage() - 1) * p.GetLimit()
 }
 
@@ -139,6 +150,9 @@ func (p *Pagination) MakePaginationRequest() {
 		"per_page": p.Limit,
 		"sort":     pgSorts,
 	}
+	if p.offset != nil {
+		p.PaginationRequest.Data["offset"] = *p.offset
+	}
 }
 
 func (p *Pagination) Fetch(db *gorm.DB, dest interface{}) (*database.Paginator, *gorm.DB) {
@@ -158,6 +172,7 @@ func (p *Pagination) Response(ctx context.Context) (out domain.PaginationRespons
 	if err := serializer.Map(ctx, *p, &out); err != nil {
 		return out, err
 	}
+	out.Offset = p.GetOffset()
 	out.Filters = make([]domain.FilterResponse, len(p.Filters))
 	for i, f := range p.Filters {
 		if err := serializer.Map(ctx, f, &out.Filters[i]); err != nil {
@@ -186,6 +201,7 @@ func NewPaginationWithFilter(column string, releation string, op string, values
 func NewPagination(urlParams *url.Values) *Pagination {
 	pg := newDefaultPagination()
 
+	offset := -1
 	if urlParams != nil {
 		for key, value := range *urlParams {
 			switch key {
@@ -201,12 +217,21 @@ func NewPagination(urlParams *url.Values) *Pagination {
 				if value[0] != "" {
 					pg.Page, _ = strconv.Atoi(value[0])
 				}
-			case PAGE_SIZE:
+			case PAGE_SIZE, LIMIT:
 				if value[0] != "" {
-					if limitNum, err := strconv.Atoi(value[0]); err == nil {
+					if limitNum, err := strconv.Atoi(value[0]); err == nil && limitNum > 0 {
 						pg.Limit = limitNum
 					}
 				}
+			case OFFSET:
+				if value[0] != "" {
+					if offsetNum, err := strconv.Atoi(value[0]); err == nil && offsetNum >= 0 {
+						offset = offsetNum
+					}
+				}
+			case CURSOR:
+				pg.UseCursor = true
+				pg.Cursor = value[0]
 			case COMBINED_FILTER: // deprecated
 				log.Error(context.TODO(), "DEPRECATED filter scheme. COMBINEND_FILTER")
 			case FILTER, FILTER_ARRAY, OR, OR_ARRAY:
@@ -248,6 +273,11 @@ func NewPagination(urlParams *url.Values) *Pagination {
 			}
 		}
 	}
+	// offset 은 그대로 조회에 사용하고, page 는 offset 이 포함된 page 로 응답한다.
+	if offset >= 0 {
+		pg.offset = &offset
+		pg.Page = offset/pg.GetLimit() + 1
+	}
 	pg.MakePaginationRequest()
 
 	return pg
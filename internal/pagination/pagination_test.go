@@ -0,0 +1,168 @@
+package pagination_test
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+func TestNewPaginationOffset(t *testing.T) {
+	tests := []struct {
+		query      string
+		wantOffset int
+		wantPage   int
+		wantData   bool
+	}{
+		{query: "limit=10&offset=5", wantOffset: 5, wantPage: 1, wantData: true},
+		{query: "limit=10&offset=20", wantOffset: 20, wantPage: 3, wantData: true},
+		{query: "limit=10&pageNumber=2", wantOffset: 10, wantPage: 2},
+	}
+	for _, tt := range tests {
+		urlParams, _ := url.ParseQuery(tt.query)
+		pg := pagination.NewPagination(&urlParams)
+
+		if got := pg.GetOffset(); got != tt.wantOffset {
+			t.Errorf("%s: GetOffset() = %d, want %d", tt.query, got, tt.wantOffset)
+		}
+		if pg.Page != tt.wantPage {
+			t.Errorf("%s: Page = %d, want %d", tt.query, pg.Page, tt.wantPage)
+		}
+		offset, ok := pg.PaginationRequest.Data["offset"]
+		if ok != tt.wantData || (ok && offset != tt.wantOffset) {
+			t.Errorf("%s: request offset = %v, want %d", tt.query, offset, tt.wantOffset)
+		}
+
+		out, err := pg.Response(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out.Offset != tt.wantOffset {
+			t.Errorf("%s: Response().Offset = %d, want %d", tt.query, out.Offset, tt.wantOffset)
+		}
+	}
+}
+
+type cursorRow struct {
+	ID        string
+	CreatedAt time.Time
+}
+
+// newCursorDB 는 DB 없이 FetchWithCursor 를 확인하기 위해, 생성된 keyset 조건과 limit 을 rows 에 적용해 결과를 채운다.
+func newCursorDB(t *testing.T, rows []cursorRow) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.Callback().Query().After("gorm:query").Register("test:cursor_rows", func(tx *gorm.DB) {
+		sql := tx.Statement.SQL.String()
+		desc := strings.Contains(sql, "DESC")
+		before := func(a cursorRow, b cursorRow) bool {
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt) != desc
+			}
+			return a.ID != b.ID && (a.ID < b.ID) != desc
+		}
+
+		out := []cursorRow{}
+		for _, row := range rows {
+			after := true
+			for i, v := range tx.Statement.Vars {
+				if createdAt, ok := v.(time.Time); ok {
+					after = before(cursorRow{ID: tx.Statement.Vars[i+1].(string), CreatedAt: createdAt}, row)
+				}
+			}
+			if after {
+				out = append(out, row)
+			}
+		}
+		sort.Slice(out, func(i, j int) bool { return before(out[i], out[j]) })
+		if limit := tx.Statement.Clauses["LIMIT"].Expression.(clause.Limit).Limit; limit != nil && len(out) > *limit {
+			out = out[:*limit]
+		}
+		tx.Statement.ReflectValue.Set(reflect.ValueOf(out))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func fetchAllWithCursor(t *testing.T, db *gorm.DB, query string) (pages [][]string) {
+	t.Helper()
+	cursor := ""
+	for {
+		urlParams, _ := url.ParseQuery(query)
+		urlParams.Set(pagination.CURSOR, cursor)
+		pg := pagination.NewPagination(&urlParams)
+
+		var out []cursorRow
+		if res := pg.FetchWithCursor(db, &out); res.Error != nil {
+			t.Fatalf("FetchWithCursor() error = %v", res.Error)
+		}
+		page := []string{}
+		for _, row := range out {
+			page = append(page, row.ID)
+		}
+		pages = append(pages, page)
+		if pg.NextCursor == "" {
+			return pages
+		}
+		if len(pages) > 10 {
+			t.Fatalf("cursor does not end, pages = %v", pages)
+		}
+		cursor = pg.NextCursor
+	}
+}
+
+func TestFetchWithCursor(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := []cursorRow{
+		{ID: "b", CreatedAt: t0},
+		{ID: "e", CreatedAt: t0.Add(2 * time.Minute)},
+		{ID: "a", CreatedAt: t0},
+		{ID: "d", CreatedAt: t0.Add(time.Minute)},
+		{ID: "c", CreatedAt: t0},
+	}
+
+	tests := []struct {
+		name  string
+		rows  []cursorRow
+		query string
+		want  [][]string
+	}{
+		{"empty page", nil, "limit=2", [][]string{{}}},
+		{"ties on created_at", rows, "limit=2", [][]string{{"e", "d"}, {"c", "b"}, {"a"}}},
+		{"ascending", rows, "limit=2&sortOrder=ASC", [][]string{{"a", "b"}, {"c", "d"}, {"e"}}},
+		{"last page is full", rows, "limit=5", [][]string{{"e", "d", "c", "b", "a"}}},
+		{"tie across pages", rows, "limit=3", [][]string{{"e", "d", "c"}, {"b", "a"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fetchAllWithCursor(t, newCursorDB(t, tt.rows), tt.query)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pages = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchWithCursorInvalidCursor(t *testing.T) {
+	urlParams, _ := url.ParseQuery("cursor=not-a-cursor")
+	pg := pagination.NewPagination(&urlParams)
+
+	var out []cursorRow
+	if res := pg.FetchWithCursor(newCursorDB(t, nil), &out); res.Error == nil {
+		t.Errorf("FetchWithCursor() with invalid cursor expected error")
+	}
+}
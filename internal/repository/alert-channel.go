@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IAlertChannelRepository interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertChannel, error)
+	FetchByIds(ctx context.Context, organizationId string, alertChannelIds []uuid.UUID) ([]model.AlertChannel, error)
+	Get(ctx context.Context, alertChannelId uuid.UUID) (model.AlertChannel, error)
+	Create(ctx context.Context, dto model.AlertChannel) (alertChannelId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.AlertChannel) error
+	UpdateLastStatus(ctx context.Context, alertChannelId uuid.UUID, status string, reason string, sentAt time.Time) error
+	Delete(ctx context.Context, alertChannelId uuid.UUID) error
+}
+
+type AlertChannelRepository struct {
+	db *gorm.DB
+}
+
+func NewAlertChannelRepository(db *gorm.DB) IAlertChannelRepository {
+	return &AlertChannelRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *AlertChannelRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.AlertChannel, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.AlertChannel{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AlertChannelRepository) FetchByIds(ctx context.Context, organizationId string, alertChannelIds []uuid.UUID) (out []model.AlertChannel, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ? AND id IN ?", organizationId, alertChannelIds).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AlertChannelRepository) Get(ctx context.Context, alertChannelId uuid.UUID) (out model.AlertChannel, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").First(&out, "id = ?", alertChannelId)
+	if res.Error != nil {
+		return model.AlertChannel{}, res.Error
+	}
+	return
+}
+
+func (r *AlertChannelRepository) Create(ctx context.Context, dto model.AlertChannel) (alertChannelId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *AlertChannelRepository) Update(ctx context.Context, dto model.AlertChannel) error {
+	recipient, err := json.Marshal(dto.Recipients)
+	if err != nil {
+		return err
+	}
+	res := r.db.WithContext(ctx).Model(&model.AlertChannel{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Name":         dto.Name,
+			"Enabled":      dto.Enabled,
+			"Url":          dto.Url,
+			"Secret":       dto.Secret,
+			"Recipient":    recipient,
+			"SmtpHost":     dto.SmtpHost,
+			"SmtpPort":     dto.SmtpPort,
+			"SmtpUsername": dto.SmtpUsername,
+			"SmtpPassword": dto.SmtpPassword,
+			"SmtpFrom":     dto.SmtpFrom,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AlertChannelRepository) UpdateLastStatus(ctx context.Context, alertChannelId uuid.UUID, status string, reason string, sentAt time.Time) error {
+	count := "failed_count"
+	if status == domain.AlertChannelStatus_SUCCEEDED {
+		count = "succeeded_count"
+	}
+	res := r.db.WithContext(ctx).Model(&model.AlertChannel{}).
+		Where("id = ?", alertChannelId).
+		Updates(map[string]interface{}{
+			"LastStatus": status,
+			"LastError":  reason,
+			"LastSentAt": sentAt,
+			count:        gorm.Expr(count + " + 1"),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+// Delete 는 채널을 지정한 앨럿 라우팅 규칙에서도 채널을 제외한다.
+func (r *AlertChannelRepository) Delete(ctx context.Context, alertChannelId uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM alert_routing_rule_channels WHERE alert_channel_id = ?", alertChannelId).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.AlertChannel{}, "id = ?", alertChannelId).Error
+	})
+}
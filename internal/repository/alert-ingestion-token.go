@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+type IAlertIngestionTokenRepository interface {
+	Get(ctx context.Context, tokenId uuid.UUID) (model.AlertIngestionToken, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (model.AlertIngestionToken, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertIngestionToken, error)
+	Create(ctx context.Context, dto model.AlertIngestionToken) (tokenId uuid.UUID, err error)
+	UpdateTokenHash(ctx context.Context, tokenId uuid.UUID, tokenHash string, tokenPrefix string) error
+	UpdateLastUsedAt(ctx context.Context, tokenId uuid.UUID, lastUsedAt time.Time) error
+	Delete(ctx context.Context, tokenId uuid.UUID) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type AlertIngestionTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewAlertIngestionTokenRepository(db *gorm.DB) IAlertIngestionTokenRepository {
+	return &AlertIngestionTokenRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *AlertIngestionTokenRepository) Get(ctx context.Context, tokenId uuid.UUID) (out model.AlertIngestionToken, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "id = ?", tokenId)
+	if res.Error != nil {
+		return model.AlertIngestionToken{}, res.Error
+	}
+	return
+}
+
+func (r *AlertIngestionTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (out model.AlertIngestionToken, err error) {
+	res := r.db.WithContext(ctx).First(&out, "token_hash = ?", tokenHash)
+	if res.Error != nil {
+		return model.AlertIngestionToken{}, res.Error
+	}
+	return
+}
+
+func (r *AlertIngestionTokenRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.AlertIngestionToken, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+	_, res := pg.Fetch(r.db.WithContext(ctx).Model(&model.AlertIngestionToken{}).
+		Preload(clause.Associations).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AlertIngestionTokenRepository) Create(ctx context.Context, dto model.AlertIngestionToken) (tokenId uuid.UUID, err error) {
+	token := model.AlertIngestionToken{
+		ID:             uuid.New(),
+		OrganizationId: dto.OrganizationId,
+		Name:           dto.Name,
+		TokenHash:      dto.TokenHash,
+		TokenPrefix:    dto.TokenPrefix,
+		CreatorId:      dto.CreatorId,
+	}
+	res := r.db.WithContext(ctx).Create(&token)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return token.ID, nil
+}
+
+func (r *AlertIngestionTokenRepository) UpdateTokenHash(ctx context.Context, tokenId uuid.UUID, tokenHash string, tokenPrefix string) error {
+	res := r.db.WithContext(ctx).Model(&model.AlertIngestionToken{}).
+		Where("id = ?", tokenId).
+		Updates(map[string]interface{}{
+			"TokenHash":   tokenHash,
+			"TokenPrefix": tokenPrefix,
+			"RotatedAt":   time.Now(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AlertIngestionTokenRepository) UpdateLastUsedAt(ctx context.Context, tokenId uuid.UUID, lastUsedAt time.Time) error {
+	res := r.db.WithContext(ctx).Model(&model.AlertIngestionToken{}).
+		Where("id = ?", tokenId).
+		Update("last_used_at", lastUsedAt)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AlertIngestionTokenRepository) Delete(ctx context.Context, tokenId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.AlertIngestionToken{}, "id = ?", tokenId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AlertIngestionTokenRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.AlertIngestionToken{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *AlertIngestionTokenRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.AlertIngestionToken{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+type IAlertRoutingRuleRepository interface {
+	Get(ctx context.Context, alertRoutingRuleId uuid.UUID) (model.AlertRoutingRule, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertRoutingRule, error)
+	FetchEnabled(ctx context.Context, organizationId string) ([]model.AlertRoutingRule, error)
+	Create(ctx context.Context, dto model.AlertRoutingRule) (alertRoutingRuleId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.AlertRoutingRule) error
+	Delete(ctx context.Context, alertRoutingRuleId uuid.UUID) error
+}
+
+type AlertRoutingRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewAlertRoutingRuleRepository(db *gorm.DB) IAlertRoutingRuleRepository {
+	return &AlertRoutingRuleRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *AlertRoutingRuleRepository) Get(ctx context.Context, alertRoutingRuleId uuid.UUID) (out model.AlertRoutingRule, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "id = ?", alertRoutingRuleId)
+	if res.Error != nil {
+		return model.AlertRoutingRule{}, res.Error
+	}
+	return
+}
+
+func (r *AlertRoutingRuleRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.AlertRoutingRule, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+	_, res := pg.Fetch(r.db.WithContext(ctx).Model(&model.AlertRoutingRule{}).
+		Preload(clause.Associations).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// FetchEnabled 는 활성화된 규칙을 확인할 순서(priority, 생성 순)로 반환한다.
+func (r *AlertRoutingRuleRepository) FetchEnabled(ctx context.Context, organizationId string) (out []model.AlertRoutingRule, err error) {
+	res := r.db.WithContext(ctx).
+		Preload("TargetUsers").
+		Preload("AlertChannels").
+		Where("organization_id = ? AND enabled = ?", organizationId, true).
+		Order("priority ASC").Order("created_at ASC").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AlertRoutingRuleRepository) Create(ctx context.Context, dto model.AlertRoutingRule) (alertRoutingRuleId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *AlertRoutingRuleRepository) Update(ctx context.Context, dto model.AlertRoutingRule) error {
+	var m model.AlertRoutingRule
+	res := r.db.WithContext(ctx).First(&m, "id = ?", dto.ID)
+	if res.Error != nil {
+		return res.Error
+	}
+
+	m.Name = dto.Name
+	m.Description = dto.Description
+	m.Priority = dto.Priority
+	m.Enabled = dto.Enabled
+	m.Continue = dto.Continue
+	m.Condition = dto.Condition
+	m.Channel = dto.Channel
+	m.EscalationPolicyId = dto.EscalationPolicyId
+	m.UpdatorId = dto.UpdatorId
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&m).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&m).Association("TargetUsers").Replace(dto.TargetUsers); err != nil {
+			return err
+		}
+		return tx.Model(&m).Association("AlertChannels").Replace(dto.AlertChannels)
+	})
+}
+
+func (r *AlertRoutingRuleRepository) Delete(ctx context.Context, alertRoutingRuleId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Select("TargetUsers", "AlertChannels").Delete(&model.AlertRoutingRule{ID: alertRoutingRuleId})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+type IAlertSilenceRepository interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertSilence, error)
+	FetchActive(ctx context.Context, organizationId string, now time.Time) ([]model.AlertSilence, error)
+	Get(ctx context.Context, alertSilenceId uuid.UUID) (model.AlertSilence, error)
+	Create(ctx context.Context, dto model.AlertSilence) (alertSilenceId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.AlertSilence) error
+	Delete(ctx context.Context, alertSilenceId uuid.UUID) error
+}
+
+type AlertSilenceRepository struct {
+	db *gorm.DB
+}
+
+func NewAlertSilenceRepository(db *gorm.DB) IAlertSilenceRepository {
+	return &AlertSilenceRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *AlertSilenceRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.AlertSilence, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Preload("Updator").Model(&model.AlertSilence{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// FetchActive 는 진행 중인 silence 를 먼저 만든 순서로 반환한다.
+func (r *AlertSilenceRepository) FetchActive(ctx context.Context, organizationId string, now time.Time) (out []model.AlertSilence, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ? AND start_at <= ? AND end_at > ?", organizationId, now, now).
+		Order("created_at").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AlertSilenceRepository) Get(ctx context.Context, alertSilenceId uuid.UUID) (out model.AlertSilence, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").Preload("Updator").First(&out, "id = ?", alertSilenceId)
+	if res.Error != nil {
+		return model.AlertSilence{}, res.Error
+	}
+	return
+}
+
+func (r *AlertSilenceRepository) Create(ctx context.Context, dto model.AlertSilence) (alertSilenceId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Omit("Creator", "Updator").Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *AlertSilenceRepository) Update(ctx context.Context, dto model.AlertSilence) error {
+	res := r.db.WithContext(ctx).Model(&model.AlertSilence{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Name":        dto.Name,
+			"Description": dto.Description,
+			"Condition":   dto.Condition,
+			"StartAt":     dto.StartAt,
+			"EndAt":       dto.EndAt,
+			"UpdatorId":   dto.UpdatorId,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AlertSilenceRepository) Delete(ctx context.Context, alertSilenceId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.AlertSilence{}, "id = ?", alertSilenceId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type IApiUsageRepository interface {
+	Increment(ctx context.Context, organizationId string, startedAt time.Time) (requests int64, err error)
+	IncrementRateLimited(ctx context.Context, organizationId string, startedAt time.Time) error
+	Fetch(ctx context.Context, organizationId string, since time.Time) ([]model.ApiUsage, error)
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type ApiUsageRepository struct {
+	db *gorm.DB
+}
+
+func NewApiUsageRepository(db *gorm.DB) IApiUsageRepository {
+	return &ApiUsageRepository{
+		db: db,
+	}
+}
+
+// Logics
+// Increment 는 startedAt 에 시작한 1분 동안의 요청 수를 1 늘리고 늘어난 요청 수를 반환한다.
+// 여러 replica 가 동시에 호출해도 요청 수를 한 row 에서 늘리므로 모든 replica 의 요청이 합산된다.
+func (r *ApiUsageRepository) Increment(ctx context.Context, organizationId string, startedAt time.Time) (requests int64, err error) {
+	usage := model.ApiUsage{
+		OrganizationId: organizationId,
+		StartedAt:      startedAt,
+		Requests:       1,
+	}
+	res := r.db.WithContext(ctx).Clauses(
+		clause.OnConflict{
+			Columns: []clause.Column{{Name: "organization_id"}, {Name: "started_at"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"requests":   gorm.Expr("api_usages.requests + 1"),
+				"updated_at": time.Now(),
+			}),
+		},
+		clause.Returning{Columns: []clause.Column{{Name: "requests"}}},
+	).Create(&usage)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return usage.Requests, nil
+}
+
+func (r *ApiUsageRepository) IncrementRateLimited(ctx context.Context, organizationId string, startedAt time.Time) error {
+	res := r.db.WithContext(ctx).Model(&model.ApiUsage{}).
+		Where("organization_id = ? AND started_at = ?", organizationId, startedAt).
+		Updates(map[string]interface{}{
+			"RateLimited": gorm.Expr("rate_limited + 1"),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *ApiUsageRepository) Fetch(ctx context.Context, organizationId string, since time.Time) (out []model.ApiUsage, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ? AND started_at >= ?", organizationId, since).
+		Order("started_at").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *ApiUsageRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Where("started_at < ?", before).Delete(&model.ApiUsage{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return res.RowsAffected, nil
+}
+
+func (r *ApiUsageRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.ApiUsage{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *ApiUsageRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.ApiUsage{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"gorm.io/gorm"
+)
+
+// CreateConfig 는 앱의 마지막 revision 다음 번호로 config 를 저장한다.
+// 동시에 저장한 요청이 같은 번호를 얻으면 (app_serve_app_id, revision) unique index 로 하나만 저장된다.
+func (r *AppServeAppRepository) CreateConfig(ctx context.Context, config model.AppServeAppConfig) (revision int, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.AppServeAppConfig{}).
+			Where("app_serve_app_id = ?", config.AppServeAppId).
+			Select("COALESCE(MAX(revision), 0)").
+			Scan(&revision).Error; err != nil {
+			return err
+		}
+
+		revision++
+		config.ID = uuid.New()
+		config.Revision = revision
+		return tx.Create(&config).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+func (r *AppServeAppRepository) FetchConfigs(ctx context.Context, appId string, pg *pagination.Pagination) (out []model.AppServeAppConfig, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.AppServeAppConfig{}).
+		Where("app_serve_app_id = ?", appId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AppServeAppRepository) GetConfig(ctx context.Context, appId string, revision int) (out model.AppServeAppConfig, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").
+		Where("app_serve_app_id = ? AND revision = ?", appId, revision).
+		First(&out)
+	if res.Error != nil {
+		return out, res.Error
+	}
+	return
+}
+
+func (r *AppServeAppRepository) GetLatestConfig(ctx context.Context, appId string) (out model.AppServeAppConfig, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").
+		Where("app_serve_app_id = ?", appId).
+		Order("revision DESC").
+		First(&out)
+	if res.Error != nil {
+		return out, res.Error
+	}
+	return
+}
+
+func (r *AppServeAppRepository) UpdateTaskConfigRevision(ctx context.Context, taskId string, revision int) error {
+	res := r.db.WithContext(ctx).Model(&model.AppServeAppTask{ID: taskId}).Update("config_revision", revision)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AppServeAppRepository) CountConfigsByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	appIds := r.db.Model(&model.AppServeApp{}).Select("id").Where("organization_id = ?", organizationId)
+	res := r.db.WithContext(ctx).Model(&model.AppServeAppConfig{}).
+		Where("app_serve_app_id IN (?)", appIds).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+// FlushConfigs 는 조직의 앱에 저장된 환경변수와 봉인된 secret 의 모든 revision 을 삭제한다.
+func (r *AppServeAppRepository) FlushConfigs(ctx context.Context, organizationId string) error {
+	appIds := r.db.Model(&model.AppServeApp{}).Select("id").Where("organization_id = ?", organizationId)
+	res := r.db.WithContext(ctx).Where("app_serve_app_id IN (?)", appIds).Delete(&model.AppServeAppConfig{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// FetchTasksToVerify returns the tasks whose bake period is over and not verified yet
+func (r *AppServeAppRepository) FetchTasksToVerify(ctx context.Context, now time.Time) (tasks []model.AppServeAppTask, err error) {
+	res := r.db.WithContext(ctx).
+		Where("verification_status = ? AND verification_due_at <= ?", domain.AppServeAppVerification_PENDING, now).
+		Find(&tasks)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return tasks, nil
+}
+
+// GetPreviousSuccessfulTask returns the latest task deployed successfully before the given time
+func (r *AppServeAppRepository) GetPreviousSuccessfulTask(ctx context.Context, appId string, before time.Time) (*model.AppServeAppTask, error) {
+	var task model.AppServeAppTask
+	res := r.db.WithContext(ctx).
+		Where("app_serve_app_id = ? AND created_at < ?", appId, before).
+		Where("status IN ?", []string{"DEPLOY_SUCCESS", "PROMOTE_SUCCESS", "ROLLBACK_SUCCESS"}).
+		Order("created_at desc").
+		First(&task)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return &task, nil
+}
+
+func (r *AppServeAppRepository) UpdateTaskVerification(ctx context.Context, task model.AppServeAppTask) error {
+	res := r.db.WithContext(ctx).Model(&model.AppServeAppTask{ID: task.ID}).
+		Select("VerificationStatus", "VerificationDueAt", "VerifiedAt", "BaselineErrorRate", "ErrorRate",
+			"BaselineLatencyMs", "LatencyMs", "VerificationMessage").
+		Updates(task)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -15,7 +15,7 @@ import (
 
 type IAppServeAppRepository interface {
 	CreateAppServeApp(ctx context.Context, app *model.AppServeApp) (appId string, err error)
-	GetAppServeApps(ctx context.Context, organizationId string, projectId string, showAll bool, pg *pagination.Pagination) ([]model.AppServeApp, error)
+	GetAppServeApps(ctx context.Context, organizationId string, projectId string, showAll bool, pg *pagination.Pagination, filters ...FilterFunc) ([]model.AppServeApp, error)
 	GetAppServeAppById(ctx context.Context, appId string) (*model.AppServeApp, error)
 
 	GetAppServeAppTasksByAppId(ctx context.Context, appId string, pg *pagination.Pagination) ([]model.AppServeAppTask, error)
@@ -23,6 +23,7 @@ type IAppServeAppRepository interface {
 	GetAppServeAppLatestTask(ctx context.Context, appId string) (*model.AppServeAppTask, error)
 
 	GetNumOfAppsOnStack(ctx context.Context, organizationId string, clusterId string) (int64, error)
+	CountAppServeAppsByStatus(ctx context.Context, organizationId string) ([]domain.AppServeAppStatusCount, error)
 
 	IsAppServeAppExist(ctx context.Context, appId string) (int64, error)
 	IsAppServeAppNameExist(ctx context.Context, orgId string, appName string) (int64, error)
@@ -30,6 +31,20 @@ type IAppServeAppRepository interface {
 	UpdateStatus(ctx context.Context, appId string, taskId string, status string, output string) error
 	UpdateEndpoint(ctx context.Context, appId string, taskId string, endpoint string, previewEndpoint string, helmRevision int32) error
 	GetTaskCountById(ctx context.Context, appId string) (int64, error)
+	FetchTasksToVerify(ctx context.Context, now time.Time) ([]model.AppServeAppTask, error)
+	GetPreviousSuccessfulTask(ctx context.Context, appId string, before time.Time) (*model.AppServeAppTask, error)
+	UpdateTaskVerification(ctx context.Context, task model.AppServeAppTask) error
+	CreateConfig(ctx context.Context, config model.AppServeAppConfig) (revision int, err error)
+	FetchConfigs(ctx context.Context, appId string, pg *pagination.Pagination) ([]model.AppServeAppConfig, error)
+	GetConfig(ctx context.Context, appId string, revision int) (model.AppServeAppConfig, error)
+	GetLatestConfig(ctx context.Context, appId string) (model.AppServeAppConfig, error)
+	CountConfigsByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	FlushConfigs(ctx context.Context, organizationId string) error
+	UpdateTaskConfigRevision(ctx context.Context, taskId string, revision int) error
+
+	StatusFilter(statuses []string) FilterFunc
+	TargetClusterFilter(clusterId string) FilterFunc
+	NameSearchFilter(keyword string) FilterFunc
 }
 
 type AppServeAppRepository struct {
@@ -66,15 +81,19 @@ func (r *AppServeAppRepository) CreateTask(ctx context.Context, task *model.AppS
 	return task.ID, nil
 }
 
-func (r *AppServeAppRepository) GetAppServeApps(ctx context.Context, organizationId string, projectId string, showAll bool, pg *pagination.Pagination) (apps []model.AppServeApp, err error) {
+func (r *AppServeAppRepository) GetAppServeApps(ctx context.Context, organizationId string, projectId string, showAll bool, pg *pagination.Pagination, filters ...FilterFunc) (apps []model.AppServeApp, err error) {
 	var clusters []model.Cluster
 	if pg == nil {
 		pg = pagination.NewPagination(nil)
 	}
 
 	// TODO: should return different records based on showAll param
-	_, res := pg.Fetch(r.db.WithContext(ctx).Model(&model.AppServeApp{}).
-		Where("app_serve_apps.project_id = ? AND status <> 'DELETE_SUCCESS'", projectId), &apps)
+	db := r.db.WithContext(ctx).Model(&model.AppServeApp{}).
+		Where("app_serve_apps.project_id = ? AND status <> 'DELETE_SUCCESS'", projectId)
+	for _, f := range filters {
+		db = f(db)
+	}
+	_, res := pg.Fetch(db, &apps)
 	if res.Error != nil {
 		return nil, fmt.Errorf("error while finding appServeApps with projectId: %s", projectId)
 	}
@@ -186,6 +205,37 @@ func (r *AppServeAppRepository) GetNumOfAppsOnStack(ctx context.Context, organiz
 	return res.RowsAffected, nil
 }
 
+func (r *AppServeAppRepository) CountAppServeAppsByStatus(ctx context.Context, organizationId string) (out []domain.AppServeAppStatusCount, err error) {
+	res := r.db.WithContext(ctx).Model(&model.AppServeApp{}).
+		Select("status, count(*) as count").
+		Where("organization_id = ? AND status <> 'DELETE_SUCCESS'", organizationId).
+		Group("status").
+		Order("status").
+		Scan(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return out, nil
+}
+
+func (r *AppServeAppRepository) StatusFilter(statuses []string) FilterFunc {
+	return func(app *gorm.DB) *gorm.DB {
+		return app.Where("app_serve_apps.status IN ?", statuses)
+	}
+}
+
+func (r *AppServeAppRepository) TargetClusterFilter(clusterId string) FilterFunc {
+	return func(app *gorm.DB) *gorm.DB {
+		return app.Where("app_serve_apps.target_cluster_id = ?", clusterId)
+	}
+}
+
+func (r *AppServeAppRepository) NameSearchFilter(keyword string) FilterFunc {
+	return func(app *gorm.DB) *gorm.DB {
+		return app.Where("app_serve_apps.name ilike ?", "%"+keyword+"%")
+	}
+}
+
 func (r *AppServeAppRepository) IsAppServeAppExist(ctx context.Context, appId string) (int64, error) {
 	var result int64
 
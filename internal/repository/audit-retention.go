@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+type IAuditRetentionRepository interface {
+	GetPolicy(ctx context.Context, organizationId string) (model.AuditRetentionPolicy, error)
+	FetchPolicies(ctx context.Context) ([]model.AuditRetentionPolicy, error)
+	UpsertPolicy(ctx context.Context, dto model.AuditRetentionPolicy) error
+	GetRun(ctx context.Context, runId uuid.UUID) (model.AuditArchivalRun, error)
+	FetchRuns(ctx context.Context, pg *pagination.Pagination) ([]model.AuditArchivalRun, error)
+	CreateRun(ctx context.Context, dto model.AuditArchivalRun) (runId uuid.UUID, err error)
+	UpdateRun(ctx context.Context, dto model.AuditArchivalRun) error
+}
+
+type AuditRetentionRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRetentionRepository(db *gorm.DB) IAuditRetentionRepository {
+	return &AuditRetentionRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *AuditRetentionRepository) GetPolicy(ctx context.Context, organizationId string) (out model.AuditRetentionPolicy, err error) {
+	res := r.db.WithContext(ctx).First(&out, "organization_id = ?", organizationId)
+	if res.Error != nil {
+		return model.AuditRetentionPolicy{}, res.Error
+	}
+	return
+}
+
+func (r *AuditRetentionRepository) FetchPolicies(ctx context.Context) (out []model.AuditRetentionPolicy, err error) {
+	res := r.db.WithContext(ctx).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AuditRetentionRepository) UpsertPolicy(ctx context.Context, dto model.AuditRetentionPolicy) error {
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"retention_days", "action", "updated_at"}),
+	}).Create(&dto)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AuditRetentionRepository) GetRun(ctx context.Context, runId uuid.UUID) (out model.AuditArchivalRun, err error) {
+	res := r.db.WithContext(ctx).First(&out, "id = ?", runId)
+	if res.Error != nil {
+		return model.AuditArchivalRun{}, res.Error
+	}
+	return
+}
+
+func (r *AuditRetentionRepository) FetchRuns(ctx context.Context, pg *pagination.Pagination) (out []model.AuditArchivalRun, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+	// archival run 에는 created_at 이 없으므로 기본 정렬은 시작 시각 순이다.
+	if pg.SortColumn == "created_at" {
+		pg.SortColumn = "started_at"
+		pg.MakePaginationRequest()
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Model(&model.AuditArchivalRun{}), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AuditRetentionRepository) CreateRun(ctx context.Context, dto model.AuditArchivalRun) (runId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *AuditRetentionRepository) UpdateRun(ctx context.Context, dto model.AuditArchivalRun) error {
+	res := r.db.WithContext(ctx).Model(&model.AuditArchivalRun{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Status":         dto.Status,
+			"ProcessedCount": dto.ProcessedCount,
+			"Location":       dto.Location,
+			"Error":          dto.Error,
+			"FinishedAt":     dto.FinishedAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
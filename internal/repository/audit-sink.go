@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IAuditSinkRepository interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AuditSink, error)
+	FetchEnabled(ctx context.Context, organizationId string) ([]model.AuditSink, error)
+	Get(ctx context.Context, auditSinkId uuid.UUID) (model.AuditSink, error)
+	Create(ctx context.Context, dto model.AuditSink) (auditSinkId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.AuditSink) error
+	UpdateLastStatus(ctx context.Context, auditSinkId uuid.UUID, status string, reason string, sentAt time.Time) error
+	Delete(ctx context.Context, auditSinkId uuid.UUID) error
+	FetchDeadLetters(ctx context.Context, auditSinkId uuid.UUID, pg *pagination.Pagination) ([]model.AuditSinkDeadLetter, error)
+	CountDeadLetters(ctx context.Context, auditSinkId uuid.UUID) (int64, error)
+	GetDeadLetter(ctx context.Context, deadLetterId uuid.UUID) (model.AuditSinkDeadLetter, error)
+	CreateDeadLetter(ctx context.Context, dto model.AuditSinkDeadLetter) (deadLetterId uuid.UUID, err error)
+	UpdateDeadLetter(ctx context.Context, dto model.AuditSinkDeadLetter) error
+	DeleteDeadLetter(ctx context.Context, deadLetterId uuid.UUID) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type AuditSinkRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditSinkRepository(db *gorm.DB) IAuditSinkRepository {
+	return &AuditSinkRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *AuditSinkRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.AuditSink, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.AuditSink{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AuditSinkRepository) FetchEnabled(ctx context.Context, organizationId string) (out []model.AuditSink, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ? AND enabled = ?", organizationId, true).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AuditSinkRepository) Get(ctx context.Context, auditSinkId uuid.UUID) (out model.AuditSink, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").First(&out, "id = ?", auditSinkId)
+	if res.Error != nil {
+		return model.AuditSink{}, res.Error
+	}
+	return
+}
+
+func (r *AuditSinkRepository) Create(ctx context.Context, dto model.AuditSink) (auditSinkId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *AuditSinkRepository) Update(ctx context.Context, dto model.AuditSink) error {
+	res := r.db.WithContext(ctx).Model(&model.AuditSink{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Name":     dto.Name,
+			"Endpoint": dto.Endpoint,
+			"Topic":    dto.Topic,
+			"Secret":   dto.Secret,
+			"Enabled":  dto.Enabled,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AuditSinkRepository) UpdateLastStatus(ctx context.Context, auditSinkId uuid.UUID, status string, reason string, sentAt time.Time) error {
+	count := "failed_count"
+	if status == domain.AuditSinkStatus_SUCCEEDED {
+		count = "succeeded_count"
+	}
+	res := r.db.WithContext(ctx).Model(&model.AuditSink{}).
+		Where("id = ?", auditSinkId).
+		Updates(map[string]interface{}{
+			"LastStatus": status,
+			"LastError":  reason,
+			"LastSentAt": sentAt,
+			count:        gorm.Expr(count + " + 1"),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+// Delete 는 전달하지 못한 감사 로그도 함께 삭제한다.
+func (r *AuditSinkRepository) Delete(ctx context.Context, auditSinkId uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.AuditSinkDeadLetter{}, "audit_sink_id = ?", auditSinkId).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.AuditSink{}, "id = ?", auditSinkId).Error
+	})
+}
+
+// FetchDeadLetters 는 목록 조회 시 payload 는 제외한다.
+func (r *AuditSinkRepository) FetchDeadLetters(ctx context.Context, auditSinkId uuid.UUID, pg *pagination.Pagination) (out []model.AuditSinkDeadLetter, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Model(&model.AuditSinkDeadLetter{}).
+		Omit("payload").
+		Where("audit_sink_id = ?", auditSinkId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AuditSinkRepository) CountDeadLetters(ctx context.Context, auditSinkId uuid.UUID) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.AuditSinkDeadLetter{}).
+		Where("audit_sink_id = ?", auditSinkId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *AuditSinkRepository) GetDeadLetter(ctx context.Context, deadLetterId uuid.UUID) (out model.AuditSinkDeadLetter, err error) {
+	res := r.db.WithContext(ctx).First(&out, "id = ?", deadLetterId)
+	if res.Error != nil {
+		return model.AuditSinkDeadLetter{}, res.Error
+	}
+	return
+}
+
+func (r *AuditSinkRepository) CreateDeadLetter(ctx context.Context, dto model.AuditSinkDeadLetter) (deadLetterId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *AuditSinkRepository) UpdateDeadLetter(ctx context.Context, dto model.AuditSinkDeadLetter) error {
+	res := r.db.WithContext(ctx).Model(&model.AuditSinkDeadLetter{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Attempts": dto.Attempts,
+			"Error":    dto.Error,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AuditSinkRepository) DeleteDeadLetter(ctx context.Context, deadLetterId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.AuditSinkDeadLetter{}, "id = ?", deadLetterId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *AuditSinkRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.AuditSink{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *AuditSinkRepository) Flush(ctx context.Context, organizationId string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, m := range []interface{}{&model.AuditSink{}, &model.AuditSinkDeadLetter{}} {
+			if err := tx.Where("organization_id = ?", organizationId).Delete(m).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
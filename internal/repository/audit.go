@@ -3,20 +3,31 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
 )
 
 // Interfaces
 type IAuditRepository interface {
 	Get(ctx context.Context, auditId uuid.UUID) (model.Audit, error)
 	Fetch(ctx context.Context, pg *pagination.Pagination) ([]model.Audit, error)
+	FetchByOrganization(ctx context.Context, organizationId string, filter model.AuditFilter, pg *pagination.Pagination) ([]model.Audit, error)
 	Create(ctx context.Context, dto model.Audit) (auditId uuid.UUID, err error)
+	CreateInBatches(ctx context.Context, dtos []model.Audit, batchSize int) error
 	Delete(ctx context.Context, auditId uuid.UUID) (err error)
+	Archive(ctx context.Context, organizationId string, before time.Time, batchSize int) (archived int64, err error)
+	FetchBefore(ctx context.Context, organizationId string, before time.Time, limit int) ([]model.Audit, error)
+	FetchSucceededByGroups(ctx context.Context, organizationId string, groups []string, from time.Time, to time.Time, limit int) ([]model.Audit, error)
+	DeleteByIds(ctx context.Context, auditIds []uuid.UUID) error
+	Purge(ctx context.Context, organizationId string, before time.Time, batchSize int) (purged int64, err error)
+	GetStatistics(ctx context.Context) (model.AuditStatistics, error)
 }
 
 type AuditRepository struct {
@@ -45,7 +56,46 @@ func (r *AuditRepository) Fetch(ctx context.Context, pg *pagination.Pagination)
 
 	db := r.db.WithContext(ctx).Model(&model.Audit{})
 
-	_, res := pg.Fetch(db, &out)
+	res := pg.FetchWithCursor(db, &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// FetchByOrganization 은 조직의 감사 로그를 조건에 맞게 조회한다.
+// 응답 코드가 없는 이전 감사 로그는 메시지로 실패 여부를 판단한다.
+func (r *AuditRepository) FetchByOrganization(ctx context.Context, organizationId string, filter model.AuditFilter, pg *pagination.Pagination) (out []model.Audit, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	db := r.db.WithContext(ctx).Model(&model.Audit{}).
+		Where("organization_id = ?", organizationId)
+	if filter.UserAccountId != "" {
+		db = db.Where("user_account_id = ?", filter.UserAccountId)
+	}
+	if filter.Group != "" {
+		db = db.Where("\"group\" = ?", filter.Group)
+	}
+	if filter.From != nil {
+		db = db.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		db = db.Where("created_at < ?", *filter.To)
+	}
+	failed := "(status_code >= 400 OR (status_code = 0 AND message LIKE ?))"
+	switch filter.Status {
+	case domain.AuditStatus_SUCCESS:
+		db = db.Where("NOT "+failed, "%실패%")
+	case domain.AuditStatus_FAILURE:
+		db = db.Where(failed, "%실패%")
+	}
+	if filter.Message != "" {
+		db = db.Where("message ilike ?", "%"+filter.Message+"%")
+	}
+
+	res := pg.FetchWithCursor(db, &out)
 	if res.Error != nil {
 		return nil, res.Error
 	}
@@ -61,6 +111,141 @@ func (r *AuditRepository) Create(ctx context.Context, dto model.Audit) (auditId
 	return dto.ID, nil
 }
 
+func (r *AuditRepository) CreateInBatches(ctx context.Context, dtos []model.Audit, batchSize int) error {
+	if len(dtos) == 0 {
+		return nil
+	}
+	for i := range dtos {
+		if dtos[i].ID == uuid.Nil {
+			dtos[i].ID = uuid.New()
+		}
+	}
+	res := r.db.WithContext(ctx).CreateInBatches(&dtos, batchSize)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
 func (r *AuditRepository) Delete(ctx context.Context, auditId uuid.UUID) (err error) {
 	return fmt.Errorf("to be implemented")
 }
+
+// Archive moves audits created before the given time to the audit_archives table.
+// Each batch is moved in its own transaction so that the audits table is not locked for long.
+// An empty organizationId archives the audits of all organizations.
+func (r *AuditRepository) Archive(ctx context.Context, organizationId string, before time.Time, batchSize int) (archived int64, err error) {
+	for {
+		var audits []model.Audit
+		audits, err = r.FetchBefore(ctx, organizationId, before, batchSize)
+		if err != nil {
+			return archived, err
+		}
+		if len(audits) == 0 {
+			return archived, nil
+		}
+
+		archives := make([]model.AuditArchive, len(audits))
+		auditIds := make([]uuid.UUID, len(audits))
+		for i, audit := range audits {
+			archives[i] = model.AuditArchive{Audit: audit}
+			auditIds[i] = audit.ID
+		}
+
+		err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&archives).Error; err != nil {
+				return err
+			}
+			return tx.Unscoped().Where("id IN ?", auditIds).Delete(&model.Audit{}).Error
+		})
+		if err != nil {
+			return archived, err
+		}
+		archived += int64(len(audits))
+
+		if len(audits) < batchSize {
+			return archived, nil
+		}
+	}
+}
+
+// FetchBefore 는 오래된 감사 로그부터 limit 개를 조회한다. organizationId 가 비어 있으면 모든 조직의 감사 로그를 조회한다.
+func (r *AuditRepository) FetchBefore(ctx context.Context, organizationId string, before time.Time, limit int) (out []model.Audit, err error) {
+	db := r.db.WithContext(ctx).Unscoped().
+		Where("created_at < ?", before)
+	if organizationId != "" {
+		db = db.Where("organization_id = ?", organizationId)
+	}
+	res := db.Order("created_at ASC").
+		Limit(limit).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// FetchSucceededByGroups 는 기간 중 성공한 요청의 감사 로그를 최근 것부터 limit 개 조회한다. dry-run 요청은 제외한다.
+// 응답 코드가 없는 이전 감사 로그는 메시지로 실패 여부를 판단한다.
+func (r *AuditRepository) FetchSucceededByGroups(ctx context.Context, organizationId string, groups []string, from time.Time, to time.Time, limit int) (out []model.Audit, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ? AND \"group\" IN ? AND created_at >= ? AND created_at < ?", organizationId, groups, from, to).
+		Where("NOT (status_code >= 400 OR (status_code = 0 AND message LIKE ?))", "%실패%").
+		Where("message NOT LIKE ?", "[DRY-RUN]%").
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *AuditRepository) DeleteByIds(ctx context.Context, auditIds []uuid.UUID) error {
+	if len(auditIds) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Unscoped().Where("id IN ?", auditIds).Delete(&model.Audit{}).Error
+}
+
+// Purge 는 보관하지 않고 삭제한다. Archive 와 마찬가지로 batch 단위로 삭제하여 audits 테이블의 lock 을 짧게 유지한다.
+func (r *AuditRepository) Purge(ctx context.Context, organizationId string, before time.Time, batchSize int) (purged int64, err error) {
+	for {
+		db := r.db.WithContext(ctx).Unscoped().Model(&model.Audit{}).
+			Select("id").
+			Where("created_at < ?", before)
+		if organizationId != "" {
+			db = db.Where("organization_id = ?", organizationId)
+		}
+		res := r.db.WithContext(ctx).Unscoped().
+			Where("id IN (?)", db.Order("created_at ASC").Limit(batchSize)).
+			Delete(&model.Audit{})
+		if res.Error != nil {
+			return purged, res.Error
+		}
+		purged += res.RowsAffected
+
+		if res.RowsAffected < int64(batchSize) {
+			return purged, nil
+		}
+	}
+}
+
+func (r *AuditRepository) GetStatistics(ctx context.Context) (out model.AuditStatistics, err error) {
+	if err = r.db.WithContext(ctx).Model(&model.Audit{}).Count(&out.AuditCount).Error; err != nil {
+		return out, err
+	}
+	if err = r.db.WithContext(ctx).Model(&model.AuditArchive{}).Count(&out.ArchivedCount).Error; err != nil {
+		return out, err
+	}
+
+	var oldest model.Audit
+	res := r.db.WithContext(ctx).Order("created_at ASC").Limit(1).Find(&oldest)
+	if res.Error != nil {
+		return out, res.Error
+	}
+	if res.RowsAffected > 0 {
+		out.OldestCreatedAt = &oldest.CreatedAt
+	}
+	return out, nil
+}
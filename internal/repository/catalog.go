@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type ICatalogRepository interface {
+	FetchHelmRepositories(ctx context.Context, pg *pagination.Pagination) ([]model.HelmRepository, error)
+	GetHelmRepository(ctx context.Context, helmRepositoryId uuid.UUID) (model.HelmRepository, error)
+	GetHelmRepositoryByName(ctx context.Context, name string) (model.HelmRepository, error)
+	CreateHelmRepository(ctx context.Context, dto model.HelmRepository) (helmRepositoryId uuid.UUID, err error)
+	UpdateHelmRepositoryStatus(ctx context.Context, helmRepositoryId uuid.UUID, status string, statusDesc string, chartCount int, syncedAt *time.Time) error
+	DeleteHelmRepository(ctx context.Context, helmRepositoryId uuid.UUID) error
+	SyncCharts(ctx context.Context, helmRepositoryId uuid.UUID, charts []model.CatalogChart, syncedAt time.Time) error
+	FetchCharts(ctx context.Context, pg *pagination.Pagination) ([]model.CatalogChart, error)
+	FetchChartVersions(ctx context.Context, helmRepositoryId uuid.UUID, name string) ([]model.CatalogChart, error)
+	GetChart(ctx context.Context, catalogChartId uuid.UUID) (model.CatalogChart, error)
+	UpdateChartValuesSchema(ctx context.Context, catalogChartId uuid.UUID, valuesSchema []byte) error
+	FetchApps(ctx context.Context, organizationId string, clusterId domain.ClusterId, pg *pagination.Pagination) ([]model.CatalogApp, error)
+	FetchAppsByRelease(ctx context.Context, clusterId domain.ClusterId, namespace string, releaseName string) ([]model.CatalogApp, error)
+	CreateApp(ctx context.Context, dto model.CatalogApp) error
+	UpdateAppStatus(ctx context.Context, catalogAppId uuid.UUID, status domain.OperationStatus, statusDesc string) error
+	CountAppsByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	FlushApps(ctx context.Context, organizationId string) error
+}
+
+type CatalogRepository struct {
+	db *gorm.DB
+}
+
+func NewCatalogRepository(db *gorm.DB) ICatalogRepository {
+	return &CatalogRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *CatalogRepository) FetchHelmRepositories(ctx context.Context, pg *pagination.Pagination) (out []model.HelmRepository, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.HelmRepository{}), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *CatalogRepository) GetHelmRepository(ctx context.Context, helmRepositoryId uuid.UUID) (out model.HelmRepository, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").First(&out, "id = ?", helmRepositoryId)
+	if res.Error != nil {
+		return model.HelmRepository{}, res.Error
+	}
+	return
+}
+
+func (r *CatalogRepository) GetHelmRepositoryByName(ctx context.Context, name string) (out model.HelmRepository, err error) {
+	res := r.db.WithContext(ctx).First(&out, "name = ?", name)
+	if res.Error != nil {
+		return model.HelmRepository{}, res.Error
+	}
+	return
+}
+
+func (r *CatalogRepository) CreateHelmRepository(ctx context.Context, dto model.HelmRepository) (helmRepositoryId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *CatalogRepository) UpdateHelmRepositoryStatus(ctx context.Context, helmRepositoryId uuid.UUID, status string, statusDesc string, chartCount int, syncedAt *time.Time) error {
+	res := r.db.WithContext(ctx).Model(&model.HelmRepository{}).
+		Where("id = ?", helmRepositoryId).
+		Updates(map[string]interface{}{
+			"Status":     status,
+			"StatusDesc": statusDesc,
+			"ChartCount": chartCount,
+			"SyncedAt":   syncedAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+// DeleteHelmRepository 는 repository 의 chart 도 함께 삭제한다. 이미 배포한 앱은 chart 정보를 따로 가지고 있으므로 남겨 둔다.
+func (r *CatalogRepository) DeleteHelmRepository(ctx context.Context, helmRepositoryId uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.CatalogChart{}, "helm_repository_id = ?", helmRepositoryId).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.HelmRepository{}, "id = ?", helmRepositoryId).Error
+	})
+}
+
+// SyncCharts 는 index 의 chart 를 저장하고 index 에서 사라진 chart 를 삭제한다.
+// 이미 있는 버전은 metadata 만 갱신하고 읽어 둔 values schema 는 유지한다.
+func (r *CatalogRepository) SyncCharts(ctx context.Context, helmRepositoryId uuid.UUID, charts []model.CatalogChart, syncedAt time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(charts) > 0 {
+			res := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "helm_repository_id"}, {Name: "name"}, {Name: "version"}},
+				DoUpdates: clause.AssignmentColumns([]string{"app_version", "description", "icon", "url", "digest", "deprecated", "released_at", "synced_at"}),
+			}).CreateInBatches(&charts, 500)
+			if res.Error != nil {
+				return res.Error
+			}
+		}
+		return tx.Delete(&model.CatalogChart{}, "helm_repository_id = ? AND synced_at < ?", helmRepositoryId, syncedAt).Error
+	})
+}
+
+func (r *CatalogRepository) FetchCharts(ctx context.Context, pg *pagination.Pagination) (out []model.CatalogChart, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("HelmRepository").Model(&model.CatalogChart{}).
+		Omit("values_schema"), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *CatalogRepository) FetchChartVersions(ctx context.Context, helmRepositoryId uuid.UUID, name string) (out []model.CatalogChart, err error) {
+	res := r.db.WithContext(ctx).
+		Omit("values_schema").
+		Where("helm_repository_id = ? AND name = ?", helmRepositoryId, name).
+		Order("released_at DESC").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *CatalogRepository) GetChart(ctx context.Context, catalogChartId uuid.UUID) (out model.CatalogChart, err error) {
+	res := r.db.WithContext(ctx).Preload("HelmRepository").First(&out, "id = ?", catalogChartId)
+	if res.Error != nil {
+		return model.CatalogChart{}, res.Error
+	}
+	return
+}
+
+func (r *CatalogRepository) UpdateChartValuesSchema(ctx context.Context, catalogChartId uuid.UUID, valuesSchema []byte) error {
+	res := r.db.WithContext(ctx).Model(&model.CatalogChart{}).
+		Where("id = ?", catalogChartId).
+		Updates(map[string]interface{}{
+			"ValuesSchema": valuesSchema,
+			"SchemaLoaded": true,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *CatalogRepository) FetchApps(ctx context.Context, organizationId string, clusterId domain.ClusterId, pg *pagination.Pagination) (out []model.CatalogApp, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.CatalogApp{}).
+		Where("organization_id = ? AND cluster_id = ?", organizationId, clusterId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *CatalogRepository) FetchAppsByRelease(ctx context.Context, clusterId domain.ClusterId, namespace string, releaseName string) (out []model.CatalogApp, err error) {
+	res := r.db.WithContext(ctx).
+		Where("cluster_id = ? AND namespace = ? AND release_name = ?", clusterId, namespace, releaseName).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *CatalogRepository) CreateApp(ctx context.Context, dto model.CatalogApp) error {
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *CatalogRepository) UpdateAppStatus(ctx context.Context, catalogAppId uuid.UUID, status domain.OperationStatus, statusDesc string) error {
+	res := r.db.WithContext(ctx).Model(&model.CatalogApp{}).
+		Where("id = ?", catalogAppId).
+		Updates(map[string]interface{}{
+			"Status":     status,
+			"StatusDesc": statusDesc,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *CatalogRepository) CountAppsByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.CatalogApp{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *CatalogRepository) FlushApps(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.CatalogApp{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
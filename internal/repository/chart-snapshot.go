@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+// IChartSnapshotRepository 는 chart snapshot 저장소이다. 기본 구현은 DB 에 저장하며, 다른 저장소를 사용하려면 이 interface 를 구현한다.
+type IChartSnapshotRepository interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.ChartSnapshot, error)
+	Get(ctx context.Context, chartSnapshotId uuid.UUID) (model.ChartSnapshot, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (model.ChartSnapshot, error)
+	Create(ctx context.Context, dto model.ChartSnapshot) (chartSnapshotId uuid.UUID, err error)
+	Delete(ctx context.Context, chartSnapshotId uuid.UUID) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type ChartSnapshotRepository struct {
+	db *gorm.DB
+}
+
+func NewChartSnapshotRepository(db *gorm.DB) IChartSnapshotRepository {
+	return &ChartSnapshotRepository{
+		db: db,
+	}
+}
+
+// Logics
+// Fetch 는 목록 조회 시 chart 데이터는 제외한다.
+func (r *ChartSnapshotRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.ChartSnapshot, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.ChartSnapshot{}).
+		Omit("data").
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *ChartSnapshotRepository) Get(ctx context.Context, chartSnapshotId uuid.UUID) (out model.ChartSnapshot, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").First(&out, "id = ?", chartSnapshotId)
+	if res.Error != nil {
+		return model.ChartSnapshot{}, res.Error
+	}
+	return
+}
+
+func (r *ChartSnapshotRepository) GetByTokenHash(ctx context.Context, tokenHash string) (out model.ChartSnapshot, err error) {
+	res := r.db.WithContext(ctx).First(&out, "token_hash = ?", tokenHash)
+	if res.Error != nil {
+		return model.ChartSnapshot{}, res.Error
+	}
+	return
+}
+
+func (r *ChartSnapshotRepository) Create(ctx context.Context, dto model.ChartSnapshot) (chartSnapshotId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *ChartSnapshotRepository) Delete(ctx context.Context, chartSnapshotId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Unscoped().Delete(&model.ChartSnapshot{}, "id = ?", chartSnapshotId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *ChartSnapshotRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Unscoped().Delete(&model.ChartSnapshot{}, "expired_at < ?", now)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return res.RowsAffected, nil
+}
+
+func (r *ChartSnapshotRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.ChartSnapshot{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *ChartSnapshotRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.ChartSnapshot{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
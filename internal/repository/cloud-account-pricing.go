@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type ICloudAccountPricingRepository interface {
+	Fetch(ctx context.Context, organizationId string) ([]model.CloudAccountPricing, error)
+	Get(ctx context.Context, cloudAccountId uuid.UUID) (model.CloudAccountPricing, error)
+	Upsert(ctx context.Context, dto model.CloudAccountPricing) error
+	Delete(ctx context.Context, cloudAccountId uuid.UUID) error
+}
+
+type CloudAccountPricingRepository struct {
+	db *gorm.DB
+}
+
+func NewCloudAccountPricingRepository(db *gorm.DB) ICloudAccountPricingRepository {
+	return &CloudAccountPricingRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *CloudAccountPricingRepository) Fetch(ctx context.Context, organizationId string) (out []model.CloudAccountPricing, err error) {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *CloudAccountPricingRepository) Get(ctx context.Context, cloudAccountId uuid.UUID) (out model.CloudAccountPricing, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "cloud_account_id = ?", cloudAccountId)
+	if res.Error != nil {
+		return model.CloudAccountPricing{}, res.Error
+	}
+	return
+}
+
+func (r *CloudAccountPricingRepository) Upsert(ctx context.Context, dto model.CloudAccountPricing) error {
+	pricing := model.CloudAccountPricing{
+		CloudAccountId:  dto.CloudAccountId,
+		OrganizationId:  dto.OrganizationId,
+		Currency:        dto.Currency,
+		CpuCoreHourly:   dto.CpuCoreHourly,
+		MemoryGibHourly: dto.MemoryGibHourly,
+		UpdatorId:       dto.UpdatorId,
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cloud_account_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"currency", "cpu_core_hourly", "memory_gib_hourly", "updator_id", "updated_at"}),
+	}).Omit(clause.Associations).Create(&pricing)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *CloudAccountPricingRepository) Delete(ctx context.Context, cloudAccountId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.CloudAccountPricing{}, "cloud_account_id = ?", cloudAccountId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
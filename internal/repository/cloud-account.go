@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -18,11 +19,13 @@ type ICloudAccountRepository interface {
 	Get(ctx context.Context, cloudAccountId uuid.UUID) (model.CloudAccount, error)
 	GetByName(ctx context.Context, organizationId string, name string) (model.CloudAccount, error)
 	GetByAwsAccountId(ctx context.Context, awsAccountId string) (model.CloudAccount, error)
+	GetByAccountId(ctx context.Context, cloudService string, accountId string) (model.CloudAccount, error)
 	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.CloudAccount, error)
 	Create(ctx context.Context, dto model.CloudAccount) (cloudAccountId uuid.UUID, err error)
 	Update(ctx context.Context, dto model.CloudAccount) (err error)
 	Delete(ctx context.Context, cloudAccountId uuid.UUID) (err error)
 	InitWorkflow(ctx context.Context, cloudAccountId uuid.UUID, workflowId string, status domain.CloudAccountStatus) (err error)
+	UpdateCredentialStatus(ctx context.Context, cloudAccountId uuid.UUID, status domain.CloudAccountCredentialStatus, statusDesc string, checkedAt time.Time) (err error)
 }
 
 type CloudAccountRepository struct {
@@ -60,6 +63,14 @@ func (r *CloudAccountRepository) GetByAwsAccountId(ctx context.Context, awsAccou
 	return
 }
 
+func (r *CloudAccountRepository) GetByAccountId(ctx context.Context, cloudService string, accountId string) (out model.CloudAccount, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "cloud_service = ? AND account_id = ? AND status != ?", cloudService, accountId, domain.CloudAccountStatus_DELETED)
+	if res.Error != nil {
+		return model.CloudAccount{}, res.Error
+	}
+	return
+}
+
 func (r *CloudAccountRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.CloudAccount, err error) {
 	if pg == nil {
 		pg = pagination.NewPagination(nil)
@@ -81,6 +92,7 @@ func (r *CloudAccountRepository) Create(ctx context.Context, dto model.CloudAcco
 		Description:    dto.Description,
 		CloudService:   dto.CloudService,
 		Resource:       dto.Resource,
+		AccountId:      dto.AccountId,
 		AwsAccountId:   dto.AwsAccountId,
 		CreatedIAM:     false,
 		Status:         domain.CloudAccountStatus_PENDING,
@@ -121,3 +133,13 @@ func (r *CloudAccountRepository) InitWorkflow(ctx context.Context, cloudAccountI
 
 	return nil
 }
+
+func (r *CloudAccountRepository) UpdateCredentialStatus(ctx context.Context, cloudAccountId uuid.UUID, status domain.CloudAccountCredentialStatus, statusDesc string, checkedAt time.Time) error {
+	res := r.db.WithContext(ctx).Model(&model.CloudAccount{}).
+		Where("id = ?", cloudAccountId).
+		Updates(map[string]interface{}{"CredentialStatus": status, "CredentialStatusDesc": statusDesc, "CredentialCheckedAt": checkedAt})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
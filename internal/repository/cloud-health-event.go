@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type ICloudHealthEventRepository interface {
+	GetByEventArn(ctx context.Context, eventArn string, clusterId domain.ClusterId) (model.CloudHealthEvent, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.CloudHealthEvent, error)
+	Create(ctx context.Context, dto model.CloudHealthEvent) (eventId uuid.UUID, err error)
+	UpdateStatus(ctx context.Context, eventId uuid.UUID, statusCode string, endTime *time.Time) error
+}
+
+type CloudHealthEventRepository struct {
+	db *gorm.DB
+}
+
+func NewCloudHealthEventRepository(db *gorm.DB) ICloudHealthEventRepository {
+	return &CloudHealthEventRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *CloudHealthEventRepository) GetByEventArn(ctx context.Context, eventArn string, clusterId domain.ClusterId) (out model.CloudHealthEvent, err error) {
+	res := r.db.WithContext(ctx).First(&out, "event_arn = ? AND cluster_id = ?", eventArn, clusterId)
+	if res.Error != nil {
+		return model.CloudHealthEvent{}, res.Error
+	}
+	return
+}
+
+func (r *CloudHealthEventRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.CloudHealthEvent, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+	res := pg.FetchWithCursor(r.db.WithContext(ctx).Model(&model.CloudHealthEvent{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *CloudHealthEventRepository) Create(ctx context.Context, dto model.CloudHealthEvent) (eventId uuid.UUID, err error) {
+	event := model.CloudHealthEvent{
+		ID:                   uuid.New(),
+		OrganizationId:       dto.OrganizationId,
+		CloudAccountId:       dto.CloudAccountId,
+		ClusterId:            dto.ClusterId,
+		EventArn:             dto.EventArn,
+		Service:              dto.Service,
+		EventTypeCode:        dto.EventTypeCode,
+		EventTypeCategory:    dto.EventTypeCategory,
+		Region:               dto.Region,
+		StatusCode:           dto.StatusCode,
+		StartTime:            dto.StartTime,
+		EndTime:              dto.EndTime,
+		SystemNotificationId: dto.SystemNotificationId,
+	}
+	res := r.db.WithContext(ctx).Create(&event)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return event.ID, nil
+}
+
+func (r *CloudHealthEventRepository) UpdateStatus(ctx context.Context, eventId uuid.UUID, statusCode string, endTime *time.Time) error {
+	res := r.db.WithContext(ctx).Model(&model.CloudHealthEvent{}).
+		Where("id = ?", eventId).
+		Updates(map[string]interface{}{"StatusCode": statusCode, "EndTime": endTime})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IClusterAccessRequestRepository interface {
+	Get(ctx context.Context, accessRequestId uuid.UUID) (model.ClusterAccessRequest, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.ClusterAccessRequest, error)
+	FetchExpired(ctx context.Context, now time.Time) ([]model.ClusterAccessRequest, error)
+	Create(ctx context.Context, dto model.ClusterAccessRequest) (accessRequestId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.ClusterAccessRequest) (err error)
+}
+
+type ClusterAccessRequestRepository struct {
+	db *gorm.DB
+}
+
+func NewClusterAccessRequestRepository(db *gorm.DB) IClusterAccessRequestRepository {
+	return &ClusterAccessRequestRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *ClusterAccessRequestRepository) Get(ctx context.Context, accessRequestId uuid.UUID) (out model.ClusterAccessRequest, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "id = ?", accessRequestId)
+	if res.Error != nil {
+		return model.ClusterAccessRequest{}, res.Error
+	}
+	return
+}
+
+func (r *ClusterAccessRequestRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.ClusterAccessRequest, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+	_, res := pg.Fetch(r.db.WithContext(ctx).Model(&model.ClusterAccessRequest{}).
+		Preload(clause.Associations).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *ClusterAccessRequestRepository) FetchExpired(ctx context.Context, now time.Time) (out []model.ClusterAccessRequest, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).
+		Where("status = ? AND expires_at <= ?", domain.ClusterAccessRequestStatus_APPROVED, now).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *ClusterAccessRequestRepository) Create(ctx context.Context, dto model.ClusterAccessRequest) (accessRequestId uuid.UUID, err error) {
+	accessRequest := model.ClusterAccessRequest{
+		ID:             uuid.New(),
+		OrganizationId: dto.OrganizationId,
+		ClusterId:      dto.ClusterId,
+		AccessType:     dto.AccessType,
+		Reason:         dto.Reason,
+		Status:         domain.ClusterAccessRequestStatus_PENDING,
+		RequesterId:    dto.RequesterId,
+	}
+	res := r.db.WithContext(ctx).Create(&accessRequest)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return accessRequest.ID, nil
+}
+
+func (r *ClusterAccessRequestRepository) Update(ctx context.Context, dto model.ClusterAccessRequest) (err error) {
+	res := r.db.WithContext(ctx).Model(&model.ClusterAccessRequest{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Status":     dto.Status,
+			"StatusDesc": dto.StatusDesc,
+			"TtlSeconds": dto.TtlSeconds,
+			"ApproverId": dto.ApproverId,
+			"ApprovedAt": dto.ApprovedAt,
+			"ExpiresAt":  dto.ExpiresAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IClusterHeartbeatRepository interface {
+	Fetch(ctx context.Context) ([]model.ClusterHeartbeat, error)
+	FetchByOrganizationId(ctx context.Context, organizationId string) ([]model.ClusterHeartbeat, error)
+	Get(ctx context.Context, clusterId domain.ClusterId) (model.ClusterHeartbeat, error)
+	Save(ctx context.Context, dto model.ClusterHeartbeat) error
+	Delete(ctx context.Context, clusterId domain.ClusterId) error
+}
+
+type ClusterHeartbeatRepository struct {
+	db *gorm.DB
+}
+
+func NewClusterHeartbeatRepository(db *gorm.DB) IClusterHeartbeatRepository {
+	return &ClusterHeartbeatRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *ClusterHeartbeatRepository) Fetch(ctx context.Context) (out []model.ClusterHeartbeat, err error) {
+	res := r.db.WithContext(ctx).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *ClusterHeartbeatRepository) FetchByOrganizationId(ctx context.Context, organizationId string) (out []model.ClusterHeartbeat, err error) {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *ClusterHeartbeatRepository) Get(ctx context.Context, clusterId domain.ClusterId) (out model.ClusterHeartbeat, err error) {
+	res := r.db.WithContext(ctx).First(&out, "cluster_id = ?", clusterId)
+	if res.Error != nil {
+		return model.ClusterHeartbeat{}, res.Error
+	}
+	return
+}
+
+// Save 는 클러스터의 heartbeat 를 생성하거나 갱신한다.
+func (r *ClusterHeartbeatRepository) Save(ctx context.Context, dto model.ClusterHeartbeat) error {
+	res := r.db.WithContext(ctx).Save(&dto)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *ClusterHeartbeatRepository) Delete(ctx context.Context, clusterId domain.ClusterId) error {
+	res := r.db.WithContext(ctx).Delete(&model.ClusterHeartbeat{}, "cluster_id = ?", clusterId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Interfaces
+type IClusterUtilizationRepository interface {
+	Upsert(ctx context.Context, dtos []model.ClusterUtilization) error
+	Fetch(ctx context.Context, organizationId string, metric domain.UtilizationMetric, start time.Time, end time.Time) ([]model.ClusterUtilization, error)
+	DeleteBefore(ctx context.Context, date time.Time) error
+}
+
+type ClusterUtilizationRepository struct {
+	db *gorm.DB
+}
+
+func NewClusterUtilizationRepository(db *gorm.DB) IClusterUtilizationRepository {
+	return &ClusterUtilizationRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *ClusterUtilizationRepository) Upsert(ctx context.Context, dtos []model.ClusterUtilization) error {
+	if len(dtos) == 0 {
+		return nil
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cluster_id"}, {Name: "metric"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"min", "avg", "max", "updated_at"}),
+	}).Create(&dtos)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *ClusterUtilizationRepository) Fetch(ctx context.Context, organizationId string, metric domain.UtilizationMetric, start time.Time, end time.Time) (out []model.ClusterUtilization, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ? AND metric = ? AND date >= ? AND date < ?", organizationId, metric, start, end).
+		Order("date ASC").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *ClusterUtilizationRepository) DeleteBefore(ctx context.Context, date time.Time) error {
+	res := r.db.WithContext(ctx).Where("date < ?", date).Delete(&model.ClusterUtilization{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
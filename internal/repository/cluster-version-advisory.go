@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IClusterVersionAdvisoryRepository interface {
+	Get(ctx context.Context, clusterId domain.ClusterId) (model.ClusterVersionAdvisory, error)
+	Save(ctx context.Context, dto model.ClusterVersionAdvisory) error
+	Delete(ctx context.Context, clusterId domain.ClusterId) error
+}
+
+type ClusterVersionAdvisoryRepository struct {
+	db *gorm.DB
+}
+
+func NewClusterVersionAdvisoryRepository(db *gorm.DB) IClusterVersionAdvisoryRepository {
+	return &ClusterVersionAdvisoryRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *ClusterVersionAdvisoryRepository) Get(ctx context.Context, clusterId domain.ClusterId) (out model.ClusterVersionAdvisory, err error) {
+	res := r.db.WithContext(ctx).First(&out, "cluster_id = ?", clusterId)
+	if res.Error != nil {
+		return model.ClusterVersionAdvisory{}, res.Error
+	}
+	return
+}
+
+// Save 는 클러스터의 알림 기록을 생성하거나 갱신한다.
+func (r *ClusterVersionAdvisoryRepository) Save(ctx context.Context, dto model.ClusterVersionAdvisory) error {
+	res := r.db.WithContext(ctx).Save(&dto)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *ClusterVersionAdvisoryRepository) Delete(ctx context.Context, clusterId domain.ClusterId) error {
+	res := r.db.WithContext(ctx).Delete(&model.ClusterVersionAdvisory{}, "cluster_id = ?", clusterId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
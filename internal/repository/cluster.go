@@ -25,6 +25,8 @@ type IClusterRepository interface {
 	GetByName(ctx context.Context, organizationId string, name string) (model.Cluster, error)
 	Create(ctx context.Context, dto model.Cluster) (clusterId domain.ClusterId, err error)
 	Update(ctx context.Context, dto model.Cluster) (err error)
+	UpdateNodeConf(ctx context.Context, dto model.Cluster) (err error)
+	UpdateStackTemplate(ctx context.Context, clusterId domain.ClusterId, stackTemplateId uuid.UUID) (err error)
 	Delete(ctx context.Context, id domain.ClusterId) error
 
 	InitWorkflow(ctx context.Context, clusterId domain.ClusterId, workflowId string, status domain.ClusterStatus) error
@@ -143,6 +145,10 @@ func (r *ClusterRepository) Create(ctx context.Context, dto model.Cluster) (clus
 		TksUserNode:            dto.TksUserNode,
 		TksUserNodeMax:         dto.TksUserNodeMax,
 		TksUserNodeType:        dto.TksUserNodeType,
+		Tags:                   dto.Tags,
+		TksCpNodeImage:         dto.TksCpNodeImage,
+		TksInfraNodeImage:      dto.TksInfraNodeImage,
+		TksUserNodeImage:       dto.TksUserNodeImage,
 	}
 	if dto.ID != "" {
 		cluster.ID = dto.ID
@@ -175,6 +181,35 @@ func (r *ClusterRepository) Update(ctx context.Context, dto model.Cluster) error
 	return nil
 }
 
+// UpdateNodeConf 는 node group 별 노드 수만 갱신한다.
+func (r *ClusterRepository) UpdateNodeConf(ctx context.Context, dto model.Cluster) error {
+	res := r.db.WithContext(ctx).Model(&model.Cluster{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"TksCpNode":       dto.TksCpNode,
+			"TksCpNodeMax":    dto.TksCpNodeMax,
+			"TksInfraNode":    dto.TksInfraNode,
+			"TksInfraNodeMax": dto.TksInfraNodeMax,
+			"TksUserNode":     dto.TksUserNode,
+			"TksUserNodeMax":  dto.TksUserNodeMax,
+			"UpdatorId":       dto.UpdatorId,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *ClusterRepository) UpdateStackTemplate(ctx context.Context, clusterId domain.ClusterId, stackTemplateId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Model(&model.Cluster{}).
+		Where("id = ?", clusterId).
+		Update("StackTemplateId", stackTemplateId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
 func (r *ClusterRepository) InitWorkflow(ctx context.Context, clusterId domain.ClusterId, workflowId string, status domain.ClusterStatus) error {
 	res := r.db.WithContext(ctx).Model(&model.Cluster{}).
 		Where("ID = ?", clusterId).
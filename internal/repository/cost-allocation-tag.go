@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type ICostAllocationTagRepository interface {
+	Fetch(ctx context.Context, organizationId string) ([]model.CostAllocationTagPolicy, error)
+	Replace(ctx context.Context, organizationId string, policies []model.CostAllocationTagPolicy) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type CostAllocationTagRepository struct {
+	db *gorm.DB
+}
+
+func NewCostAllocationTagRepository(db *gorm.DB) ICostAllocationTagRepository {
+	return &CostAllocationTagRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *CostAllocationTagRepository) Fetch(ctx context.Context, organizationId string) (out []model.CostAllocationTagPolicy, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).
+		Where("organization_id = ?", organizationId).
+		Order("key").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// Replace 는 조직의 tag 정책을 요청한 정책으로 모두 교체한다.
+func (r *CostAllocationTagRepository) Replace(ctx context.Context, organizationId string, policies []model.CostAllocationTagPolicy) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.CostAllocationTagPolicy{}, "organization_id = ?", organizationId).Error; err != nil {
+			return err
+		}
+		if len(policies) == 0 {
+			return nil
+		}
+		return tx.Omit(clause.Associations).Create(&policies).Error
+	})
+}
+
+func (r *CostAllocationTagRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.CostAllocationTagPolicy{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *CostAllocationTagRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.CostAllocationTagPolicy{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
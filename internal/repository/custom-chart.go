@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+type ICustomChartRepository interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.CustomChart, error)
+	Get(ctx context.Context, customChartId uuid.UUID) (model.CustomChart, error)
+	GetByName(ctx context.Context, organizationId string, name string) (model.CustomChart, error)
+	Create(ctx context.Context, dto model.CustomChart) (customChartId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.CustomChart) error
+	Delete(ctx context.Context, customChartId uuid.UUID) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type CustomChartRepository struct {
+	db *gorm.DB
+}
+
+func NewCustomChartRepository(db *gorm.DB) ICustomChartRepository {
+	return &CustomChartRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *CustomChartRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.CustomChart, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.CustomChart{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *CustomChartRepository) Get(ctx context.Context, customChartId uuid.UUID) (out model.CustomChart, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").First(&out, "id = ?", customChartId)
+	if res.Error != nil {
+		return model.CustomChart{}, res.Error
+	}
+	return
+}
+
+func (r *CustomChartRepository) GetByName(ctx context.Context, organizationId string, name string) (out model.CustomChart, err error) {
+	res := r.db.WithContext(ctx).First(&out, "organization_id = ? AND name = ?", organizationId, name)
+	if res.Error != nil {
+		return model.CustomChart{}, res.Error
+	}
+	return
+}
+
+func (r *CustomChartRepository) Create(ctx context.Context, dto model.CustomChart) (customChartId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *CustomChartRepository) Update(ctx context.Context, dto model.CustomChart) error {
+	res := r.db.WithContext(ctx).Model(&model.CustomChart{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Name":        dto.Name,
+			"Description": dto.Description,
+			"Query":       dto.Query,
+			"Unit":        dto.Unit,
+			"Thresholds":  dto.Thresholds,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *CustomChartRepository) Delete(ctx context.Context, customChartId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.CustomChart{}, "id = ?", customChartId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *CustomChartRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.CustomChart{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *CustomChartRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.CustomChart{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
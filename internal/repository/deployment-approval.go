@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IDeploymentApprovalRepository interface {
+	GetPolicy(ctx context.Context, clusterId domain.ClusterId) (model.DeploymentApprovalPolicy, error)
+	UpsertPolicy(ctx context.Context, dto model.DeploymentApprovalPolicy) error
+	DeletePolicy(ctx context.Context, clusterId domain.ClusterId) error
+
+	Get(ctx context.Context, approvalId uuid.UUID) (model.DeploymentApproval, error)
+	GetPending(ctx context.Context, appId string) (model.DeploymentApproval, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.DeploymentApproval, error)
+	Create(ctx context.Context, dto model.DeploymentApproval) (approvalId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.DeploymentApproval) error
+}
+
+type DeploymentApprovalRepository struct {
+	db *gorm.DB
+}
+
+func NewDeploymentApprovalRepository(db *gorm.DB) IDeploymentApprovalRepository {
+	return &DeploymentApprovalRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *DeploymentApprovalRepository) GetPolicy(ctx context.Context, clusterId domain.ClusterId) (out model.DeploymentApprovalPolicy, err error) {
+	res := r.db.WithContext(ctx).Preload("Approvers").First(&out, "cluster_id = ?", clusterId)
+	if res.Error != nil {
+		return model.DeploymentApprovalPolicy{}, res.Error
+	}
+	return
+}
+
+func (r *DeploymentApprovalRepository) UpsertPolicy(ctx context.Context, dto model.DeploymentApprovalPolicy) error {
+	policy := model.DeploymentApprovalPolicy{
+		ClusterId:      dto.ClusterId,
+		OrganizationId: dto.OrganizationId,
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Omit(clause.Associations).Save(&policy)
+		if res.Error != nil {
+			return res.Error
+		}
+		return tx.Model(&policy).Association("Approvers").Replace(dto.Approvers)
+	})
+}
+
+func (r *DeploymentApprovalRepository) DeletePolicy(ctx context.Context, clusterId domain.ClusterId) error {
+	policy := model.DeploymentApprovalPolicy{ClusterId: clusterId}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&policy).Association("Approvers").Clear(); err != nil {
+			return err
+		}
+		res := tx.Delete(&policy)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+func (r *DeploymentApprovalRepository) Get(ctx context.Context, approvalId uuid.UUID) (out model.DeploymentApproval, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "id = ?", approvalId)
+	if res.Error != nil {
+		return model.DeploymentApproval{}, res.Error
+	}
+	return
+}
+
+func (r *DeploymentApprovalRepository) GetPending(ctx context.Context, appId string) (out model.DeploymentApproval, err error) {
+	res := r.db.WithContext(ctx).
+		First(&out, "app_serve_app_id = ? AND status = ?", appId, domain.DeploymentApprovalStatus_PENDING)
+	if res.Error != nil {
+		return model.DeploymentApproval{}, res.Error
+	}
+	return
+}
+
+func (r *DeploymentApprovalRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.DeploymentApproval, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+	_, res := pg.Fetch(r.db.WithContext(ctx).Model(&model.DeploymentApproval{}).
+		Preload(clause.Associations).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *DeploymentApprovalRepository) Create(ctx context.Context, dto model.DeploymentApproval) (approvalId uuid.UUID, err error) {
+	approval := model.DeploymentApproval{
+		ID:                uuid.New(),
+		OrganizationId:    dto.OrganizationId,
+		AppServeAppId:     dto.AppServeAppId,
+		AppServeAppTaskId: dto.AppServeAppTaskId,
+		ClusterId:         dto.ClusterId,
+		Action:            dto.Action,
+		Status:            domain.DeploymentApprovalStatus_PENDING,
+		RequesterId:       dto.RequesterId,
+	}
+	res := r.db.WithContext(ctx).Omit(clause.Associations).Create(&approval)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return approval.ID, nil
+}
+
+func (r *DeploymentApprovalRepository) Update(ctx context.Context, dto model.DeploymentApproval) error {
+	res := r.db.WithContext(ctx).Model(&model.DeploymentApproval{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Status":     dto.Status,
+			"Comment":    dto.Comment,
+			"ApproverId": dto.ApproverId,
+			"DecidedAt":  dto.DecidedAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IEncryptionKeyRepository interface {
+	Fetch(ctx context.Context, organizationId string) ([]model.EncryptionKey, error)
+	FetchActive(ctx context.Context) ([]model.EncryptionKey, error)
+	Get(ctx context.Context, encryptionKeyId uuid.UUID) (model.EncryptionKey, error)
+	GetActive(ctx context.Context, organizationId string) (model.EncryptionKey, error)
+	Create(ctx context.Context, dto model.EncryptionKey) (encryptionKeyId uuid.UUID, err error)
+	UpdateStatus(ctx context.Context, encryptionKeyId uuid.UUID, status domain.EncryptionKeyStatus) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type EncryptionKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewEncryptionKeyRepository(db *gorm.DB) IEncryptionKeyRepository {
+	return &EncryptionKeyRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *EncryptionKeyRepository) Fetch(ctx context.Context, organizationId string) (out []model.EncryptionKey, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").
+		Where("organization_id = ?", organizationId).
+		Order("created_at DESC").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *EncryptionKeyRepository) FetchActive(ctx context.Context) (out []model.EncryptionKey, err error) {
+	res := r.db.WithContext(ctx).Where("status = ?", domain.EncryptionKeyStatus_ACTIVE).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *EncryptionKeyRepository) Get(ctx context.Context, encryptionKeyId uuid.UUID) (out model.EncryptionKey, err error) {
+	res := r.db.WithContext(ctx).First(&out, "id = ?", encryptionKeyId)
+	if res.Error != nil {
+		return model.EncryptionKey{}, res.Error
+	}
+	return
+}
+
+func (r *EncryptionKeyRepository) GetActive(ctx context.Context, organizationId string) (out model.EncryptionKey, err error) {
+	res := r.db.WithContext(ctx).First(&out, "organization_id = ? AND status = ?", organizationId, domain.EncryptionKeyStatus_ACTIVE)
+	if res.Error != nil {
+		return model.EncryptionKey{}, res.Error
+	}
+	return
+}
+
+func (r *EncryptionKeyRepository) Create(ctx context.Context, dto model.EncryptionKey) (encryptionKeyId uuid.UUID, err error) {
+	encryptionKey := model.EncryptionKey{
+		ID:             uuid.New(),
+		OrganizationId: dto.OrganizationId,
+		Provider:       dto.Provider,
+		KeyArn:         dto.KeyArn,
+		Status:         domain.EncryptionKeyStatus_ACTIVE,
+		Description:    dto.Description,
+		CreatorId:      dto.CreatorId,
+	}
+	res := r.db.WithContext(ctx).Create(&encryptionKey)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return encryptionKey.ID, nil
+}
+
+func (r *EncryptionKeyRepository) UpdateStatus(ctx context.Context, encryptionKeyId uuid.UUID, status domain.EncryptionKeyStatus) error {
+	updates := map[string]interface{}{"Status": status}
+	switch status {
+	case domain.EncryptionKeyStatus_RETIRED:
+		updates["RetiredAt"] = time.Now()
+	case domain.EncryptionKeyStatus_REVOKED:
+		updates["RevokedAt"] = time.Now()
+	}
+
+	res := r.db.WithContext(ctx).Model(&model.EncryptionKey{}).
+		Where("id = ?", encryptionKeyId).
+		Updates(updates)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *EncryptionKeyRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.EncryptionKey{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *EncryptionKeyRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.EncryptionKey{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
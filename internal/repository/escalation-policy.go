@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+type IEscalationPolicyRepository interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.EscalationPolicy, error)
+	Get(ctx context.Context, escalationPolicyId uuid.UUID) (model.EscalationPolicy, error)
+	Create(ctx context.Context, dto model.EscalationPolicy) (escalationPolicyId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.EscalationPolicy) error
+	Delete(ctx context.Context, escalationPolicyId uuid.UUID) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type EscalationPolicyRepository struct {
+	db *gorm.DB
+}
+
+func NewEscalationPolicyRepository(db *gorm.DB) IEscalationPolicyRepository {
+	return &EscalationPolicyRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *EscalationPolicyRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.EscalationPolicy, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Preload("Updator").Model(&model.EscalationPolicy{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *EscalationPolicyRepository) Get(ctx context.Context, escalationPolicyId uuid.UUID) (out model.EscalationPolicy, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").Preload("Updator").First(&out, "id = ?", escalationPolicyId)
+	if res.Error != nil {
+		return model.EscalationPolicy{}, res.Error
+	}
+	return
+}
+
+func (r *EscalationPolicyRepository) Create(ctx context.Context, dto model.EscalationPolicy) (escalationPolicyId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Omit("Creator", "Updator").Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *EscalationPolicyRepository) Update(ctx context.Context, dto model.EscalationPolicy) error {
+	res := r.db.WithContext(ctx).Model(&model.EscalationPolicy{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Name":        dto.Name,
+			"Description": dto.Description,
+			"Enabled":     dto.Enabled,
+			"Severity":    dto.Severity,
+			"Step":        dto.Step,
+			"UpdatorId":   dto.UpdatorId,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+// Delete 는 정책을 사용하는 라우팅 규칙에서 정책을 해제하고, 진행 중인 에스컬레이션을 중단한다.
+func (r *EscalationPolicyRepository) Delete(ctx context.Context, escalationPolicyId uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.AlertRoutingRule{}).
+			Where("escalation_policy_id = ?", escalationPolicyId).
+			Update("escalation_policy_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.SystemNotification{}).
+			Where("escalation_policy_id = ? AND next_escalation_at IS NOT NULL", escalationPolicyId).
+			Update("next_escalation_at", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.EscalationPolicy{}, "id = ?", escalationPolicyId).Error
+	})
+}
+
+func (r *EscalationPolicyRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.EscalationPolicy{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *EscalationPolicyRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.EscalationPolicy{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+type IGitProviderRepository interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.GitProvider, error)
+	Get(ctx context.Context, gitProviderId uuid.UUID) (model.GitProvider, error)
+	GetByName(ctx context.Context, organizationId string, name string) (model.GitProvider, error)
+	Create(ctx context.Context, dto model.GitProvider) (gitProviderId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.GitProvider) error
+	Delete(ctx context.Context, gitProviderId uuid.UUID) error
+	CountSources(ctx context.Context, gitProviderId uuid.UUID) (int64, error)
+	GetSource(ctx context.Context, appId string) (model.AppServeAppGitSource, error)
+	SaveSource(ctx context.Context, dto model.AppServeAppGitSource) error
+	DeleteSource(ctx context.Context, appId string) error
+	UpdateSourceTriggered(ctx context.Context, appId string, commit string, triggeredAt time.Time) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type GitProviderRepository struct {
+	db *gorm.DB
+}
+
+func NewGitProviderRepository(db *gorm.DB) IGitProviderRepository {
+	return &GitProviderRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *GitProviderRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.GitProvider, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.GitProvider{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *GitProviderRepository) Get(ctx context.Context, gitProviderId uuid.UUID) (out model.GitProvider, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").First(&out, "id = ?", gitProviderId)
+	if res.Error != nil {
+		return model.GitProvider{}, res.Error
+	}
+	return
+}
+
+func (r *GitProviderRepository) GetByName(ctx context.Context, organizationId string, name string) (out model.GitProvider, err error) {
+	res := r.db.WithContext(ctx).First(&out, "organization_id = ? AND name = ?", organizationId, name)
+	if res.Error != nil {
+		return model.GitProvider{}, res.Error
+	}
+	return
+}
+
+func (r *GitProviderRepository) Create(ctx context.Context, dto model.GitProvider) (gitProviderId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *GitProviderRepository) Update(ctx context.Context, dto model.GitProvider) error {
+	res := r.db.WithContext(ctx).Model(&model.GitProvider{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Description": dto.Description,
+			"Token":       dto.Token,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *GitProviderRepository) Delete(ctx context.Context, gitProviderId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.GitProvider{}, "id = ?", gitProviderId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *GitProviderRepository) CountSources(ctx context.Context, gitProviderId uuid.UUID) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.AppServeAppGitSource{}).
+		Where("git_provider_id = ?", gitProviderId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *GitProviderRepository) GetSource(ctx context.Context, appId string) (out model.AppServeAppGitSource, err error) {
+	res := r.db.WithContext(ctx).Preload("GitProvider").First(&out, "app_serve_app_id = ?", appId)
+	if res.Error != nil {
+		return model.AppServeAppGitSource{}, res.Error
+	}
+	return
+}
+
+func (r *GitProviderRepository) SaveSource(ctx context.Context, dto model.AppServeAppGitSource) error {
+	res := r.db.WithContext(ctx).Omit("GitProvider").Save(&dto)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *GitProviderRepository) DeleteSource(ctx context.Context, appId string) error {
+	res := r.db.WithContext(ctx).Delete(&model.AppServeAppGitSource{}, "app_serve_app_id = ?", appId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *GitProviderRepository) UpdateSourceTriggered(ctx context.Context, appId string, commit string, triggeredAt time.Time) error {
+	res := r.db.WithContext(ctx).Model(&model.AppServeAppGitSource{}).
+		Where("app_serve_app_id = ?", appId).
+		Updates(map[string]interface{}{
+			"LastCommit":      commit,
+			"LastTriggeredAt": triggeredAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *GitProviderRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.GitProvider{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *GitProviderRepository) Flush(ctx context.Context, organizationId string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, m := range []interface{}{&model.GitProvider{}, &model.AppServeAppGitSource{}} {
+			if err := tx.Where("organization_id = ?", organizationId).Delete(m).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
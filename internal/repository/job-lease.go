@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type IJobLeaseRepository interface {
+	Acquire(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error)
+}
+
+type JobLeaseRepository struct {
+	db *gorm.DB
+}
+
+func NewJobLeaseRepository(db *gorm.DB) IJobLeaseRepository {
+	return &JobLeaseRepository{
+		db: db,
+	}
+}
+
+// Logics
+// Acquire 는 lease 가 없거나 만료되었거나 holder 가 이미 가지고 있으면 ttl 동안 holder 에게 lease 를 준다.
+// 여러 replica 가 동시에 호출해도 하나만 true 를 받는다.
+func (r *JobLeaseRepository) Acquire(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&model.JobLease{
+		Name:      name,
+		Holder:    holder,
+		ExpiresAt: now.Add(ttl),
+	})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 1 {
+		return true, nil
+	}
+
+	res = r.db.WithContext(ctx).Model(&model.JobLease{}).
+		Where("name = ? AND (expires_at < ? OR holder = ?)", name, now, holder).
+		Updates(map[string]interface{}{
+			"Holder":    holder,
+			"ExpiresAt": now.Add(ttl),
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
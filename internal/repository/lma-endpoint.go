@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type ILmaEndpointRepository interface {
+	Fetch(ctx context.Context, organizationId string) ([]model.LmaEndpoint, error)
+	Get(ctx context.Context, lmaEndpointId uuid.UUID) (model.LmaEndpoint, error)
+	Create(ctx context.Context, dto model.LmaEndpoint) (lmaEndpointId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.LmaEndpoint) error
+	Delete(ctx context.Context, lmaEndpointId uuid.UUID) error
+	GetAuth(ctx context.Context, organizationId string) (model.LmaAuth, error)
+	UpsertAuth(ctx context.Context, dto model.LmaAuth) error
+	GetDiscovery(ctx context.Context, organizationId string) (model.LmaDiscovery, error)
+	UpsertDiscovery(ctx context.Context, dto model.LmaDiscovery) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type LmaEndpointRepository struct {
+	db *gorm.DB
+}
+
+func NewLmaEndpointRepository(db *gorm.DB) ILmaEndpointRepository {
+	return &LmaEndpointRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *LmaEndpointRepository) Fetch(ctx context.Context, organizationId string) (out []model.LmaEndpoint, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ?", organizationId).
+		Order("priority ASC, created_at ASC").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *LmaEndpointRepository) Get(ctx context.Context, lmaEndpointId uuid.UUID) (out model.LmaEndpoint, err error) {
+	res := r.db.WithContext(ctx).First(&out, "id = ?", lmaEndpointId)
+	if res.Error != nil {
+		return model.LmaEndpoint{}, res.Error
+	}
+	return
+}
+
+func (r *LmaEndpointRepository) Create(ctx context.Context, dto model.LmaEndpoint) (lmaEndpointId uuid.UUID, err error) {
+	lmaEndpoint := model.LmaEndpoint{
+		ID:             uuid.New(),
+		OrganizationId: dto.OrganizationId,
+		Url:            dto.Url,
+		Priority:       dto.Priority,
+		Description:    dto.Description,
+		CreatorId:      dto.CreatorId,
+	}
+	res := r.db.WithContext(ctx).Create(&lmaEndpoint)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return lmaEndpoint.ID, nil
+}
+
+func (r *LmaEndpointRepository) Update(ctx context.Context, dto model.LmaEndpoint) error {
+	res := r.db.WithContext(ctx).Model(&model.LmaEndpoint{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{"Url": dto.Url, "Priority": dto.Priority, "Description": dto.Description, "UpdatorId": dto.UpdatorId})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *LmaEndpointRepository) Delete(ctx context.Context, lmaEndpointId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.LmaEndpoint{}, "id = ?", lmaEndpointId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *LmaEndpointRepository) GetAuth(ctx context.Context, organizationId string) (out model.LmaAuth, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "organization_id = ?", organizationId)
+	if res.Error != nil {
+		return model.LmaAuth{}, res.Error
+	}
+	return
+}
+
+func (r *LmaEndpointRepository) UpsertAuth(ctx context.Context, dto model.LmaAuth) error {
+	lmaAuth := model.LmaAuth{
+		OrganizationId:     dto.OrganizationId,
+		CaCert:             dto.CaCert,
+		InsecureSkipVerify: dto.InsecureSkipVerify,
+		Username:           dto.Username,
+		Password:           dto.Password,
+		BearerToken:        dto.BearerToken,
+		UpdatorId:          dto.UpdatorId,
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"ca_cert", "insecure_skip_verify", "username", "password", "bearer_token", "updator_id", "updated_at"}),
+	}).Omit(clause.Associations).Create(&lmaAuth)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *LmaEndpointRepository) GetDiscovery(ctx context.Context, organizationId string) (out model.LmaDiscovery, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "organization_id = ?", organizationId)
+	if res.Error != nil {
+		return model.LmaDiscovery{}, res.Error
+	}
+	return
+}
+
+func (r *LmaEndpointRepository) UpsertDiscovery(ctx context.Context, dto model.LmaDiscovery) error {
+	lmaDiscovery := model.LmaDiscovery{
+		OrganizationId:  dto.OrganizationId,
+		Method:          dto.Method,
+		Namespace:       dto.Namespace,
+		ServiceName:     dto.ServiceName,
+		IngressName:     dto.IngressName,
+		NodeAddressType: dto.NodeAddressType,
+		UpdatorId:       dto.UpdatorId,
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"method", "namespace", "service_name", "ingress_name", "node_address_type", "updator_id", "updated_at"}),
+	}).Omit(clause.Associations).Create(&lmaDiscovery)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *LmaEndpointRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.LmaEndpoint{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *LmaEndpointRepository) Flush(ctx context.Context, organizationId string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, m := range []interface{}{&model.LmaEndpoint{}, &model.LmaAuth{}, &model.LmaDiscovery{}} {
+			if err := tx.Where("organization_id = ?", organizationId).Delete(m).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IMaintenanceWindowRepository interface {
+	Fetch(ctx context.Context, organizationId string, clusterId domain.ClusterId, from time.Time, to time.Time, pg *pagination.Pagination) ([]model.MaintenanceWindow, error)
+	FetchActive(ctx context.Context, organizationId string, now time.Time) ([]model.MaintenanceWindow, error)
+	GetActive(ctx context.Context, clusterId domain.ClusterId, now time.Time) (model.MaintenanceWindow, error)
+	Get(ctx context.Context, maintenanceWindowId uuid.UUID) (model.MaintenanceWindow, error)
+	Create(ctx context.Context, dto model.MaintenanceWindow) (maintenanceWindowId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.MaintenanceWindow) error
+	Delete(ctx context.Context, maintenanceWindowId uuid.UUID) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type MaintenanceWindowRepository struct {
+	db *gorm.DB
+}
+
+func NewMaintenanceWindowRepository(db *gorm.DB) IMaintenanceWindowRepository {
+	return &MaintenanceWindowRepository{
+		db: db,
+	}
+}
+
+// Logics
+// Fetch 는 [from, to) 구간과 겹치는 maintenance window 를 조회한다. clusterId 가 비어 있으면 조직의 모든 클러스터를 조회한다.
+func (r *MaintenanceWindowRepository) Fetch(ctx context.Context, organizationId string, clusterId domain.ClusterId, from time.Time, to time.Time, pg *pagination.Pagination) (out []model.MaintenanceWindow, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	db := r.db.WithContext(ctx).Preload("Cluster").Preload("Creator").Preload("Updator").Model(&model.MaintenanceWindow{}).
+		Where("organization_id = ? AND start_at < ? AND end_at > ?", organizationId, to, from)
+	if clusterId != "" {
+		db = db.Where("cluster_id = ?", clusterId)
+	}
+
+	_, res := pg.Fetch(db, &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *MaintenanceWindowRepository) FetchActive(ctx context.Context, organizationId string, now time.Time) (out []model.MaintenanceWindow, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ? AND start_at <= ? AND end_at > ?", organizationId, now, now).
+		Order("end_at DESC").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// GetActive 는 진행 중인 maintenance window 가 여러 개이면 가장 늦게 끝나는 것을 반환한다.
+func (r *MaintenanceWindowRepository) GetActive(ctx context.Context, clusterId domain.ClusterId, now time.Time) (out model.MaintenanceWindow, err error) {
+	res := r.db.WithContext(ctx).
+		Where("cluster_id = ? AND start_at <= ? AND end_at > ?", clusterId, now, now).
+		Order("end_at DESC").
+		First(&out)
+	if res.Error != nil {
+		return model.MaintenanceWindow{}, res.Error
+	}
+	return
+}
+
+func (r *MaintenanceWindowRepository) Get(ctx context.Context, maintenanceWindowId uuid.UUID) (out model.MaintenanceWindow, err error) {
+	res := r.db.WithContext(ctx).Preload("Cluster").Preload("Creator").Preload("Updator").First(&out, "id = ?", maintenanceWindowId)
+	if res.Error != nil {
+		return model.MaintenanceWindow{}, res.Error
+	}
+	return
+}
+
+func (r *MaintenanceWindowRepository) Create(ctx context.Context, dto model.MaintenanceWindow) (maintenanceWindowId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Omit("Cluster", "Creator", "Updator").Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *MaintenanceWindowRepository) Update(ctx context.Context, dto model.MaintenanceWindow) error {
+	res := r.db.WithContext(ctx).Model(&model.MaintenanceWindow{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Name":        dto.Name,
+			"Description": dto.Description,
+			"StartAt":     dto.StartAt,
+			"EndAt":       dto.EndAt,
+			"UpdatorId":   dto.UpdatorId,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *MaintenanceWindowRepository) Delete(ctx context.Context, maintenanceWindowId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.MaintenanceWindow{}, "id = ?", maintenanceWindowId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *MaintenanceWindowRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.MaintenanceWindow{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *MaintenanceWindowRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.MaintenanceWindow{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
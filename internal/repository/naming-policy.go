@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type INamingPolicyRepository interface {
+	Fetch(ctx context.Context, organizationId string) ([]model.NamingPolicy, error)
+	Get(ctx context.Context, organizationId string, resourceType string) (model.NamingPolicy, error)
+	Upsert(ctx context.Context, dto model.NamingPolicy) error
+	Delete(ctx context.Context, organizationId string, resourceType string) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type NamingPolicyRepository struct {
+	db *gorm.DB
+}
+
+func NewNamingPolicyRepository(db *gorm.DB) INamingPolicyRepository {
+	return &NamingPolicyRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *NamingPolicyRepository) Fetch(ctx context.Context, organizationId string) (out []model.NamingPolicy, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).Where("organization_id = ?", organizationId).Order("resource_type").Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *NamingPolicyRepository) Get(ctx context.Context, organizationId string, resourceType string) (out model.NamingPolicy, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "organization_id = ? AND resource_type = ?", organizationId, resourceType)
+	if res.Error != nil {
+		return model.NamingPolicy{}, res.Error
+	}
+	return
+}
+
+func (r *NamingPolicyRepository) Upsert(ctx context.Context, dto model.NamingPolicy) error {
+	namingPolicy := model.NamingPolicy{
+		OrganizationId: dto.OrganizationId,
+		ResourceType:   dto.ResourceType,
+		Pattern:        dto.Pattern,
+		Prefix:         dto.Prefix,
+		ReservedName:   dto.ReservedName,
+		UpdatorId:      dto.UpdatorId,
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}, {Name: "resource_type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"pattern", "prefix", "reserved_name", "updator_id", "updated_at"}),
+	}).Omit(clause.Associations).Create(&namingPolicy)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *NamingPolicyRepository) Delete(ctx context.Context, organizationId string, resourceType string) error {
+	res := r.db.WithContext(ctx).Delete(&model.NamingPolicy{}, "organization_id = ? AND resource_type = ?", organizationId, resourceType)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *NamingPolicyRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.NamingPolicy{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *NamingPolicyRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.NamingPolicy{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type INotificationDigestRepository interface {
+	FetchSettings(ctx context.Context, userId uuid.UUID) ([]model.NotificationDigestSetting, error)
+	FetchSettingsByUsers(ctx context.Context, userIds []uuid.UUID, channel domain.NotificationChannel) ([]model.NotificationDigestSetting, error)
+	SaveSettings(ctx context.Context, settings []model.NotificationDigestSetting) error
+	CreateItems(ctx context.Context, items []model.NotificationDigestItem) error
+	FetchItems(ctx context.Context) ([]model.NotificationDigestItem, error)
+	DeleteItems(ctx context.Context, itemIds []uuid.UUID) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type NotificationDigestRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationDigestRepository(db *gorm.DB) INotificationDigestRepository {
+	return &NotificationDigestRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *NotificationDigestRepository) FetchSettings(ctx context.Context, userId uuid.UUID) (out []model.NotificationDigestSetting, err error) {
+	res := r.db.WithContext(ctx).Where("user_id = ?", userId).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *NotificationDigestRepository) FetchSettingsByUsers(ctx context.Context, userIds []uuid.UUID, channel domain.NotificationChannel) (out []model.NotificationDigestSetting, err error) {
+	if len(userIds) == 0 {
+		return
+	}
+	res := r.db.WithContext(ctx).Where("user_id IN ? AND channel = ?", userIds, channel).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *NotificationDigestRepository) SaveSettings(ctx context.Context, settings []model.NotificationDigestSetting) error {
+	if len(settings) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "channel"}},
+		DoUpdates: clause.AssignmentColumns([]string{"mode", "organization_id", "updated_at"}),
+	}).Create(&settings).Error
+}
+
+func (r *NotificationDigestRepository) CreateItems(ctx context.Context, items []model.NotificationDigestItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	for i := range items {
+		items[i].ID = uuid.New()
+	}
+	return r.db.WithContext(ctx).Create(&items).Error
+}
+
+func (r *NotificationDigestRepository) FetchItems(ctx context.Context) (out []model.NotificationDigestItem, err error) {
+	res := r.db.WithContext(ctx).Preload("User").Order("created_at ASC").Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *NotificationDigestRepository) DeleteItems(ctx context.Context, itemIds []uuid.UUID) error {
+	if len(itemIds) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Delete(&model.NotificationDigestItem{}, "id IN ?", itemIds).Error
+}
+
+func (r *NotificationDigestRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.NotificationDigestSetting{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *NotificationDigestRepository) Flush(ctx context.Context, organizationId string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, m := range []interface{}{&model.NotificationDigestSetting{}, &model.NotificationDigestItem{}} {
+			if err := tx.Where("organization_id = ?", organizationId).Delete(m).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
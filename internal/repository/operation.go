@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IOperationRepository interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.Operation, error)
+	FetchByStatus(ctx context.Context, status domain.OperationStatus) ([]model.Operation, error)
+	FetchByPeriod(ctx context.Context, organizationId string, start time.Time, end time.Time) ([]model.Operation, error)
+	Get(ctx context.Context, operationId uuid.UUID) (model.Operation, error)
+	Create(ctx context.Context, dto model.Operation) (operationId uuid.UUID, err error)
+	UpdateStatus(ctx context.Context, dto model.Operation) error
+}
+
+type OperationRepository struct {
+	db *gorm.DB
+}
+
+func NewOperationRepository(db *gorm.DB) IOperationRepository {
+	return &OperationRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *OperationRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.Operation, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.Operation{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// FetchByStatus 는 상태별 operation 을 생성 순서대로 반환한다.
+func (r *OperationRepository) FetchByStatus(ctx context.Context, status domain.OperationStatus) (out []model.Operation, err error) {
+	res := r.db.WithContext(ctx).
+		Where("status = ?", status).
+		Order("created_at ASC").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// FetchByPeriod 는 기간 중에 요청된 조직의 operation 을 요청 순서대로 반환한다.
+func (r *OperationRepository) FetchByPeriod(ctx context.Context, organizationId string, start time.Time, end time.Time) (out []model.Operation, err error) {
+	res := r.db.WithContext(ctx).
+		Where("organization_id = ? AND created_at BETWEEN ? AND ?", organizationId, start, end).
+		Order("created_at ASC").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *OperationRepository) Get(ctx context.Context, operationId uuid.UUID) (out model.Operation, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").First(&out, "id = ?", operationId)
+	if res.Error != nil {
+		return model.Operation{}, res.Error
+	}
+	return
+}
+
+func (r *OperationRepository) Create(ctx context.Context, dto model.Operation) (operationId uuid.UUID, err error) {
+	operation := model.Operation{
+		ID:               uuid.New(),
+		OrganizationId:   dto.OrganizationId,
+		Type:             dto.Type,
+		TargetId:         dto.TargetId,
+		TargetName:       dto.TargetName,
+		WorkflowTemplate: dto.WorkflowTemplate,
+		Parameters:       dto.Parameters,
+		WorkflowId:       dto.WorkflowId,
+		Status:           dto.Status,
+		StatusDesc:       dto.StatusDesc,
+		SubmittedAt:      dto.SubmittedAt,
+		CreatorId:        dto.CreatorId,
+	}
+	res := r.db.WithContext(ctx).Create(&operation)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return operation.ID, nil
+}
+
+func (r *OperationRepository) UpdateStatus(ctx context.Context, dto model.Operation) error {
+	res := r.db.WithContext(ctx).Model(&model.Operation{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"WorkflowId":  dto.WorkflowId,
+			"Status":      dto.Status,
+			"StatusDesc":  dto.StatusDesc,
+			"SubmittedAt": dto.SubmittedAt,
+			"FinishedAt":  dto.FinishedAt,
+			"Progress":    dto.Progress,
+			"Steps":       dto.Steps,
+			"LogExcerpt":  dto.LogExcerpt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
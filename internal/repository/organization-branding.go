@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type IOrganizationBrandingRepository interface {
+	Get(ctx context.Context, organizationId string) (model.OrganizationBranding, error)
+	Upsert(ctx context.Context, dto model.OrganizationBranding) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type OrganizationBrandingRepository struct {
+	db *gorm.DB
+}
+
+func NewOrganizationBrandingRepository(db *gorm.DB) IOrganizationBrandingRepository {
+	return &OrganizationBrandingRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *OrganizationBrandingRepository) Get(ctx context.Context, organizationId string) (out model.OrganizationBranding, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "organization_id = ?", organizationId)
+	if res.Error != nil {
+		return model.OrganizationBranding{}, res.Error
+	}
+	return
+}
+
+func (r *OrganizationBrandingRepository) Upsert(ctx context.Context, dto model.OrganizationBranding) error {
+	branding := model.OrganizationBranding{
+		OrganizationId: dto.OrganizationId,
+		DisplayName:    dto.DisplayName,
+		LogoUrl:        dto.LogoUrl,
+		SupportContact: dto.SupportContact,
+		EmailFooter:    dto.EmailFooter,
+		UpdatorId:      dto.UpdatorId,
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"display_name", "logo_url", "support_contact", "email_footer", "updator_id", "updated_at"}),
+	}).Omit(clause.Associations).Create(&branding)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *OrganizationBrandingRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.OrganizationBranding{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *OrganizationBrandingRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.OrganizationBranding{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
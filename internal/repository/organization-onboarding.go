@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Interfaces
+type IOrganizationOnboardingRepository interface {
+	Fetch(ctx context.Context, organizationId string) ([]model.OrganizationOnboarding, error)
+	Complete(ctx context.Context, organizationId string, step domain.OnboardingStep) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type OrganizationOnboardingRepository struct {
+	db *gorm.DB
+}
+
+func NewOrganizationOnboardingRepository(db *gorm.DB) IOrganizationOnboardingRepository {
+	return &OrganizationOnboardingRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *OrganizationOnboardingRepository) Fetch(ctx context.Context, organizationId string) (out []model.OrganizationOnboarding, err error) {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// Complete marks the step as completed. The first completion time is kept.
+func (r *OrganizationOnboardingRepository) Complete(ctx context.Context, organizationId string, step domain.OnboardingStep) error {
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.OrganizationOnboarding{
+			OrganizationId: organizationId,
+			Step:           step,
+			CompletedAt:    time.Now(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *OrganizationOnboardingRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.OrganizationOnboarding{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *OrganizationOnboardingRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.OrganizationOnboarding{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type IOrganizationQuotaRepository interface {
+	Get(ctx context.Context, organizationId string) (model.OrganizationQuota, error)
+	Upsert(ctx context.Context, dto model.OrganizationQuota) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type OrganizationQuotaRepository struct {
+	db *gorm.DB
+}
+
+func NewOrganizationQuotaRepository(db *gorm.DB) IOrganizationQuotaRepository {
+	return &OrganizationQuotaRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *OrganizationQuotaRepository) Get(ctx context.Context, organizationId string) (out model.OrganizationQuota, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "organization_id = ?", organizationId)
+	if res.Error != nil {
+		return model.OrganizationQuota{}, res.Error
+	}
+	return
+}
+
+func (r *OrganizationQuotaRepository) Upsert(ctx context.Context, dto model.OrganizationQuota) error {
+	quota := model.OrganizationQuota{
+		OrganizationId: dto.OrganizationId,
+		MaxStacks:      dto.MaxStacks,
+		MaxNodes:       dto.MaxNodes,
+		MaxCpu:         dto.MaxCpu,
+		MaxMemoryGib:   dto.MaxMemoryGib,
+		MaxUsers:       dto.MaxUsers,
+		UpdatorId:      dto.UpdatorId,
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"max_stacks", "max_nodes", "max_cpu", "max_memory_gib", "max_users", "updator_id", "updated_at"}),
+	}).Omit(clause.Associations).Create(&quota)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *OrganizationQuotaRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.OrganizationQuota{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *OrganizationQuotaRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.OrganizationQuota{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -117,8 +117,9 @@ func (r *OrganizationRepository) Update(ctx context.Context, organizationId stri
 	res := r.db.WithContext(ctx).Model(&model.Organization{}).
 		Where("id = ?", organizationId).
 		Updates(map[string]interface{}{
-			"name":        in.Name,
-			"description": in.Description,
+			"name":         in.Name,
+			"description":  in.Description,
+			"otp_required": in.OtpRequired,
 		})
 
 	if res.Error != nil {
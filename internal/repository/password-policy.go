@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type IPasswordPolicyRepository interface {
+	Get(ctx context.Context, organizationId string) (model.PasswordPolicy, error)
+	Upsert(ctx context.Context, dto model.PasswordPolicy) error
+	FetchHistories(ctx context.Context, userId uuid.UUID, limit int) ([]model.PasswordHistory, error)
+	CreateHistory(ctx context.Context, dto model.PasswordHistory, keep int) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type PasswordPolicyRepository struct {
+	db *gorm.DB
+}
+
+func NewPasswordPolicyRepository(db *gorm.DB) IPasswordPolicyRepository {
+	return &PasswordPolicyRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *PasswordPolicyRepository) Get(ctx context.Context, organizationId string) (out model.PasswordPolicy, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "organization_id = ?", organizationId)
+	if res.Error != nil {
+		return model.PasswordPolicy{}, res.Error
+	}
+	return
+}
+
+func (r *PasswordPolicyRepository) Upsert(ctx context.Context, dto model.PasswordPolicy) error {
+	passwordPolicy := model.PasswordPolicy{
+		OrganizationId:      dto.OrganizationId,
+		MinLength:           dto.MinLength,
+		MinCharacterClasses: dto.MinCharacterClasses,
+		HistoryCount:        dto.HistoryCount,
+		ExpiryDays:          dto.ExpiryDays,
+		UpdatorId:           dto.UpdatorId,
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"min_length", "min_character_classes", "history_count", "expiry_days", "updator_id", "updated_at"}),
+	}).Omit(clause.Associations).Create(&passwordPolicy)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *PasswordPolicyRepository) FetchHistories(ctx context.Context, userId uuid.UUID, limit int) (out []model.PasswordHistory, err error) {
+	res := r.db.WithContext(ctx).Where("user_id = ?", userId).Order("created_at DESC").Limit(limit).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// CreateHistory 는 사용자의 비밀번호 hash 를 저장하고, 최근 keep 개를 제외한 이전 기록은 삭제한다.
+func (r *PasswordPolicyRepository) CreateHistory(ctx context.Context, dto model.PasswordHistory, keep int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dto.ID = uuid.New()
+		if err := tx.Create(&dto).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ? AND id NOT IN (?)", dto.UserId,
+			tx.Model(&model.PasswordHistory{}).Select("id").Where("user_id = ?", dto.UserId).Order("created_at DESC").Limit(keep)).
+			Delete(&model.PasswordHistory{}).Error
+	})
+}
+
+func (r *PasswordPolicyRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.PasswordPolicy{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+// Flush 는 조직의 비밀번호 정책과 조직 사용자의 비밀번호 이력을 삭제한다. 사용자를 삭제하기 전에 호출해야 한다.
+func (r *PasswordPolicyRepository) Flush(ctx context.Context, organizationId string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		userIds := r.db.Model(&model.User{}).Select("id").Where("organization_id = ?", organizationId)
+		if err := tx.Where("user_id IN (?)", userIds).Delete(&model.PasswordHistory{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("organization_id = ?", organizationId).Delete(&model.PasswordPolicy{}).Error
+	})
+}
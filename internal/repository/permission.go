@@ -14,6 +14,7 @@ type IPermissionRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*model.Permission, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	Update(ctx context.Context, permission *model.Permission) error
+	ListAllowedEndpointNames(ctx context.Context, roleIds []string) ([]string, error)
 }
 
 type PermissionRepository struct {
@@ -82,3 +83,21 @@ func (r PermissionRepository) Update(ctx context.Context, p *model.Permission) e
 	// update on is_allowed
 	return r.db.WithContext(ctx).Model(&model.Permission{}).Where("id = ?", p.ID).Updates(map[string]interface{}{"is_allowed": p.IsAllowed}).Error
 }
+
+func (r PermissionRepository) ListAllowedEndpointNames(ctx context.Context, roleIds []string) ([]string, error) {
+	var names []string
+	if len(roleIds) == 0 {
+		return names, nil
+	}
+
+	err := r.db.WithContext(ctx).Model(&model.Permission{}).
+		Distinct("permission_endpoints.endpoint_name").
+		Joins("JOIN permission_endpoints ON permission_endpoints.permission_id = permissions.id").
+		Where("permissions.role_id IN ? AND permissions.is_allowed = ?", roleIds, true).
+		Pluck("permission_endpoints.endpoint_name", &names).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
@@ -19,10 +19,12 @@ type IProjectRepository interface {
 	GetProjects(ctx context.Context, organizationId string, userId uuid.UUID, projectName string, pg *pagination.Pagination) ([]domain.ProjectResponse, error)
 	GetProjectsByUserId(ctx context.Context, organizationId string, userId uuid.UUID, projectName string, pg *pagination.Pagination) ([]domain.ProjectResponse, error)
 	GetAllProjects(ctx context.Context, organizationId string, projectName string, pg *pagination.Pagination) (pr []domain.ProjectResponse, err error)
+	GetProjectsByOrganizationId(ctx context.Context, organizationId string) ([]model.Project, error)
 	GetProjectById(ctx context.Context, organizationId string, projectId string) (*model.Project, error)
 	GetProjectByIdAndLeader(ctx context.Context, organizationId string, projectId string) (*model.Project, error)
 	GetProjectByName(ctx context.Context, organizationId string, projectName string) (*model.Project, error)
 	UpdateProject(ctx context.Context, p *model.Project) error
+	DeleteProject(ctx context.Context, organizationId string, projectId string) error
 	GetAllProjectRoles(ctx context.Context) ([]model.ProjectRole, error)
 	GetProjectRoleByName(ctx context.Context, name string) (*model.ProjectRole, error)
 	GetProjectRoleById(ctx context.Context, id string) (*model.ProjectRole, error)
@@ -289,6 +291,16 @@ func (r *ProjectRepository) GetAllProjects(ctx context.Context, organizationId s
 	return pr, nil
 }
 
+func (r *ProjectRepository) GetProjectsByOrganizationId(ctx context.Context, organizationId string) (ps []model.Project, err error) {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Order("created_at ASC").Find(&ps)
+	if res.Error != nil {
+		log.Error(ctx, res.Error)
+		return nil, res.Error
+	}
+
+	return ps, nil
+}
+
 func (r *ProjectRepository) GetProjectById(ctx context.Context, organizationId string, projectId string) (p *model.Project, err error) {
 	res := r.db.WithContext(ctx).Limit(1).Where("organization_id = ? and id = ?", organizationId, projectId).First(&p)
 	if res.Error != nil {
@@ -350,6 +362,16 @@ func (r *ProjectRepository) UpdateProject(ctx context.Context, p *model.Project)
 	return nil
 }
 
+func (r *ProjectRepository) DeleteProject(ctx context.Context, organizationId string, projectId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ? and id = ?", organizationId, projectId).
+		Delete(&model.Project{})
+	if res.Error != nil {
+		return res.Error
+	}
+
+	return nil
+}
+
 func (r *ProjectRepository) GetProjectRoleById(ctx context.Context, id string) (*model.ProjectRole, error) {
 	var pr = &model.ProjectRole{ID: id}
 	res := r.db.WithContext(ctx).First(pr)
@@ -637,7 +659,7 @@ func (r *ProjectRepository) DeleteProjectNamespace(ctx context.Context, organiza
 func (r *ProjectRepository) GetAppCountByProjectId(ctx context.Context, organizationId string, projectId string) (appCount int, err error) {
 	res := r.db.WithContext(ctx).Select("count(*) as app_count").
 		Table("app_serve_apps").
-		Where("organization_id = ? and project_Id = ?", organizationId, projectId).
+		Where("organization_id = ? and project_Id = ? and status <> 'DELETE_SUCCESS'", organizationId, projectId).
 		Find(&appCount)
 	if res.Error != nil {
 		log.Error(ctx, res.Error)
@@ -650,7 +672,7 @@ func (r *ProjectRepository) GetAppCountByProjectId(ctx context.Context, organiza
 func (r *ProjectRepository) GetAppCountByNamespace(ctx context.Context, organizationId string, projectId string, namespace string) (appCount int, err error) {
 	res := r.db.WithContext(ctx).Select("count(*) as app_count").
 		Table("app_serve_apps").
-		Where("organization_id = ? and project_Id = ? and namespace = ?", organizationId, projectId, namespace).
+		Where("organization_id = ? and project_Id = ? and namespace = ? and status <> 'DELETE_SUCCESS'", organizationId, projectId, namespace).
 		Find(&appCount)
 	if res.Error != nil {
 		log.Error(ctx, res.Error)
@@ -14,6 +14,7 @@ type Repository struct {
 	AppGroup                   IAppGroupRepository
 	AppServeApp                IAppServeAppRepository
 	CloudAccount               ICloudAccountRepository
+	CloudAccountPricing        ICloudAccountPricingRepository
 	StackTemplate              IStackTemplateRepository
 	Role                       IRoleRepository
 	Permission                 IPermissionRepository
@@ -26,4 +27,42 @@ type Repository struct {
 	SystemNotificationTemplate ISystemNotificationTemplateRepository
 	SystemNotificationRule     ISystemNotificationRuleRepository
 	Dashboard                  IDashboardRepository
+	ClusterUtilization         IClusterUtilizationRepository
+	OrganizationOnboarding     IOrganizationOnboardingRepository
+	ClusterAccessRequest       IClusterAccessRequestRepository
+	AlertIngestionToken        IAlertIngestionTokenRepository
+	AlertRoutingRule           IAlertRoutingRuleRepository
+	AlertChannel               IAlertChannelRepository
+	AlertSilence               IAlertSilenceRepository
+	EscalationPolicy           IEscalationPolicyRepository
+	DeploymentApproval         IDeploymentApprovalRepository
+	StackDefault               IStackDefaultRepository
+	CostAllocationTag          ICostAllocationTagRepository
+	CloudHealthEvent           ICloudHealthEventRepository
+	LmaEndpoint                ILmaEndpointRepository
+	ClusterHeartbeat           IClusterHeartbeatRepository
+	EncryptionKey              IEncryptionKeyRepository
+	SecretSealer               ISecretSealer
+	Secret                     ISecretRepository
+	Operation                  IOperationRepository
+	NotificationDigest         INotificationDigestRepository
+	CustomChart                ICustomChartRepository
+	ChartSnapshot              IChartSnapshotRepository
+	UserSession                IUserSessionRepository
+	MaintenanceWindow          IMaintenanceWindowRepository
+	AuditRetention             IAuditRetentionRepository
+	AuditSink                  IAuditSinkRepository
+	PasswordPolicy             IPasswordPolicyRepository
+	OrganizationBranding       IOrganizationBrandingRepository
+	StackMonitoringEndpoint    IStackMonitoringEndpointRepository
+	RoleReassignment           IRoleReassignmentRepository
+	NamingPolicy               INamingPolicyRepository
+	ClusterVersionAdvisory     IClusterVersionAdvisoryRepository
+	StackUpgrade               IStackUpgradeRepository
+	StackWebhook               IStackWebhookRepository
+	OrganizationQuota          IOrganizationQuotaRepository
+	Catalog                    ICatalogRepository
+	GitProvider                IGitProviderRepository
+	JobLease                   IJobLeaseRepository
+	ApiUsage                   IApiUsageRepository
 }
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IRoleReassignmentRepository interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.RoleReassignment, error)
+	Get(ctx context.Context, reassignmentId uuid.UUID) (model.RoleReassignment, error)
+	CountRunning(ctx context.Context, organizationId string) (int64, error)
+	Create(ctx context.Context, dto model.RoleReassignment) (reassignmentId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.RoleReassignment) error
+	CreateResult(ctx context.Context, dto model.RoleReassignmentResult) error
+}
+
+type RoleReassignmentRepository struct {
+	db *gorm.DB
+}
+
+func NewRoleReassignmentRepository(db *gorm.DB) IRoleReassignmentRepository {
+	return &RoleReassignmentRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *RoleReassignmentRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.RoleReassignment, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+	// 작업에는 created_at 이 없으므로 기본 정렬은 시작 시각 순이다.
+	if pg.SortColumn == "created_at" {
+		pg.SortColumn = "started_at"
+		pg.MakePaginationRequest()
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.RoleReassignment{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *RoleReassignmentRepository) Get(ctx context.Context, reassignmentId uuid.UUID) (out model.RoleReassignment, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").
+		Preload("Results", func(db *gorm.DB) *gorm.DB {
+			return db.Order("finished_at ASC")
+		}).
+		First(&out, "id = ?", reassignmentId)
+	if res.Error != nil {
+		return model.RoleReassignment{}, res.Error
+	}
+	return
+}
+
+func (r *RoleReassignmentRepository) CountRunning(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.RoleReassignment{}).
+		Where("organization_id = ? AND status = ?", organizationId, domain.RoleReassignmentStatus_RUNNING).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *RoleReassignmentRepository) Create(ctx context.Context, dto model.RoleReassignment) (reassignmentId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Omit("Creator", "Results").Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *RoleReassignmentRepository) Update(ctx context.Context, dto model.RoleReassignment) error {
+	res := r.db.WithContext(ctx).Model(&model.RoleReassignment{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Status":         dto.Status,
+			"TotalCount":     dto.TotalCount,
+			"SucceededCount": dto.SucceededCount,
+			"FailedCount":    dto.FailedCount,
+			"Error":          dto.Error,
+			"FinishedAt":     dto.FinishedAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *RoleReassignmentRepository) CreateResult(ctx context.Context, dto model.RoleReassignmentResult) error {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+	kms "github.com/openinfradev/tks-api/pkg/kms-client"
+)
+
+// sealedSecretPrefix 로 시작하는 secret 값은 봉인된 값이다.
+// 봉인 형식 : prefix + base64(json(sealedSecret)). 값은 data key(AES-256-GCM) 로 암호화하고, data key 는 조직의 KMS key 나
+// TKS master key 로 암호화해서 함께 저장한다.
+const sealedSecretPrefix = "tks-sealed:v1:"
+
+var (
+	ErrSecretMasterKeyNotConfigured = errors.New("secret-master-key is not configured")
+	ErrEncryptionKeyRevoked         = errors.New("encryption key is revoked")
+)
+
+type sealedSecret struct {
+	KeyId          uuid.UUID `json:"keyId"`
+	MasterKeyId    string    `json:"masterKeyId,omitempty"`
+	OrganizationId string    `json:"organizationId,omitempty"`
+	DataKey        []byte    `json:"dataKey"`
+	Nonce          []byte    `json:"nonce"`
+	Ciphertext     []byte    `json:"ciphertext"`
+}
+
+// Interfaces
+type ISecretSealer interface {
+	Seal(ctx context.Context, organizationId string, plaintext []byte) ([]byte, error)
+	Open(ctx context.Context, value []byte) ([]byte, error)
+	Reseal(ctx context.Context, organizationId string, value []byte) (resealed []byte, changed bool, err error)
+	Forget(encryptionKeyId uuid.UUID)
+}
+
+// SecretSealer 는 조직에 ACTIVE 암호화 키가 있으면 KMS key 로, 없으면 master key 로 secret 을 봉인한다.
+// masterKeys 의 첫 번째 key 로 봉인하고, 나머지는 master key 를 교체하기 전에 봉인한 값을 복호화하는 데 사용한다.
+type SecretSealer struct {
+	encryptionKeyRepo IEncryptionKeyRepository
+	kmsClient         func(ctx context.Context) (kms.KmsClient, error)
+	masterKeyId       string
+	masterKeys        map[string][]byte
+
+	// 복호화한 data key 를 재사용하여 kubeconfig 조회 시마다 KMS 를 호출하지 않는다.
+	mu       sync.RWMutex
+	dataKeys map[string][]byte
+}
+
+func NewSecretSealer(encryptionKeyRepo IEncryptionKeyRepository, kmsClient func(ctx context.Context) (kms.KmsClient, error), masterKeys ...string) ISecretSealer {
+	s := &SecretSealer{
+		encryptionKeyRepo: encryptionKeyRepo,
+		kmsClient:         kmsClient,
+		masterKeys:        make(map[string][]byte),
+		dataKeys:          make(map[string][]byte),
+	}
+	for _, masterKey := range masterKeys {
+		masterKey = strings.TrimSpace(masterKey)
+		if masterKey == "" {
+			continue
+		}
+		key := sha256.Sum256([]byte(masterKey))
+		id := masterKeyIdOf(key[:])
+		if s.masterKeyId == "" {
+			s.masterKeyId = id
+		}
+		s.masterKeys[id] = key[:]
+	}
+	return s
+}
+
+// NewSecretSealerFromConfig 는 secret-master-key 와 secret-master-key-previous(쉼표로 구분) 설정의 master key 를 사용한다.
+func NewSecretSealerFromConfig(encryptionKeyRepo IEncryptionKeyRepository, kmsClient func(ctx context.Context) (kms.KmsClient, error)) ISecretSealer {
+	masterKeys := append([]string{viper.GetString("secret-master-key")}, strings.Split(viper.GetString("secret-master-key-previous"), ",")...)
+	return NewSecretSealer(encryptionKeyRepo, kmsClient, masterKeys...)
+}
+
+// Seal 은 조직의 ACTIVE key 로 값을 봉인한다. ACTIVE key 가 없는 조직은 master key 로 봉인한다.
+func (s *SecretSealer) Seal(ctx context.Context, organizationId string, plaintext []byte) ([]byte, error) {
+	key, err := s.encryptionKeyRepo.GetActive(ctx, organizationId)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		return s.sealWithMasterKey(organizationId, plaintext)
+	}
+
+	client, err := s.kmsClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, encryptedDataKey, err := client.GenerateDataKey(ctx, key.KeyArn)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to generate data key")
+	}
+	return seal(sealedSecret{KeyId: key.ID, DataKey: encryptedDataKey}, dataKey, organizationId, plaintext)
+}
+
+// Open 은 봉인된 값을 복호화한다. 봉인되지 않은 값은 그대로 반환한다.
+func (s *SecretSealer) Open(ctx context.Context, value []byte) ([]byte, error) {
+	sealed, ok, err := parseSealedSecret(value)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return value, nil
+	}
+
+	var dataKey []byte
+	var organizationId string
+	if sealed.MasterKeyId != "" {
+		if dataKey, err = s.openDataKeyWithMasterKey(sealed); err != nil {
+			return nil, err
+		}
+		organizationId = sealed.OrganizationId
+	} else {
+		key, err := s.encryptionKeyRepo.Get(ctx, sealed.KeyId)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("Failed to get encryption key %s", sealed.KeyId))
+		}
+		if key.Status == domain.EncryptionKeyStatus_REVOKED {
+			return nil, errors.Wrap(ErrEncryptionKeyRevoked, key.ID.String())
+		}
+		if dataKey, err = s.decryptDataKey(ctx, key.ID, key.KeyArn, sealed.DataKey); err != nil {
+			return nil, err
+		}
+		organizationId = key.OrganizationId
+	}
+
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, sealed.Nonce, sealed.Ciphertext, []byte(organizationId))
+}
+
+// Reseal 은 봉인되지 않았거나 조직의 현재 key 가 아닌 key 로 봉인된 값을 다시 봉인한다.
+func (s *SecretSealer) Reseal(ctx context.Context, organizationId string, value []byte) (resealed []byte, changed bool, err error) {
+	if len(value) == 0 {
+		return value, false, nil
+	}
+
+	sealed, ok, err := parseSealedSecret(value)
+	if err != nil {
+		return nil, false, err
+	}
+	if ok {
+		key, err := s.encryptionKeyRepo.GetActive(ctx, organizationId)
+		switch {
+		case err == nil:
+			if sealed.KeyId == key.ID {
+				return value, false, nil
+			}
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			if sealed.MasterKeyId != "" && sealed.MasterKeyId == s.masterKeyId {
+				return value, false, nil
+			}
+		default:
+			return nil, false, err
+		}
+	}
+
+	plaintext, err := s.Open(ctx, value)
+	if err != nil {
+		return nil, false, err
+	}
+	if resealed, err = s.Seal(ctx, organizationId, plaintext); err != nil {
+		return nil, false, err
+	}
+	return resealed, true, nil
+}
+
+// Forget 은 폐기된 key 의 data key 를 cache 에서 지운다.
+func (s *SecretSealer) Forget(encryptionKeyId uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for cacheKey := range s.dataKeys {
+		if strings.HasPrefix(cacheKey, encryptionKeyId.String()) {
+			delete(s.dataKeys, cacheKey)
+		}
+	}
+}
+
+func (s *SecretSealer) sealWithMasterKey(organizationId string, plaintext []byte) ([]byte, error) {
+	if s.masterKeyId == "" {
+		return nil, ErrSecretMasterKeyNotConfigured
+	}
+
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(s.masterKeys[s.masterKeyId])
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return seal(sealedSecret{
+		MasterKeyId:    s.masterKeyId,
+		OrganizationId: organizationId,
+		DataKey:        gcm.Seal(nonce, nonce, dataKey, nil),
+	}, dataKey, organizationId, plaintext)
+}
+
+func (s *SecretSealer) openDataKeyWithMasterKey(sealed sealedSecret) ([]byte, error) {
+	masterKey, ok := s.masterKeys[sealed.MasterKeyId]
+	if !ok {
+		return nil, fmt.Errorf("unknown master key %s", sealed.MasterKeyId)
+	}
+	gcm, err := newGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed.DataKey) < gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid sealed secret")
+	}
+	return gcm.Open(nil, sealed.DataKey[:gcm.NonceSize()], sealed.DataKey[gcm.NonceSize():], nil)
+}
+
+func (s *SecretSealer) decryptDataKey(ctx context.Context, keyId uuid.UUID, keyArn string, encryptedDataKey []byte) ([]byte, error) {
+	cacheKey := keyId.String() + ":" + base64.StdEncoding.EncodeToString(encryptedDataKey)
+	s.mu.RLock()
+	dataKey, ok := s.dataKeys[cacheKey]
+	s.mu.RUnlock()
+	if ok {
+		return dataKey, nil
+	}
+
+	client, err := s.kmsClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err = client.Decrypt(ctx, keyArn, encryptedDataKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to decrypt data key")
+	}
+
+	s.mu.Lock()
+	s.dataKeys[cacheKey] = dataKey
+	s.mu.Unlock()
+	return dataKey, nil
+}
+
+// IsSealed 는 값이 봉인된 형식인지 반환한다.
+func IsSealed(value []byte) bool {
+	return bytes.HasPrefix(value, []byte(sealedSecretPrefix))
+}
+
+func seal(sealed sealedSecret, dataKey []byte, organizationId string, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	sealed.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(sealed.Nonce); err != nil {
+		return nil, err
+	}
+	sealed.Ciphertext = gcm.Seal(nil, sealed.Nonce, plaintext, []byte(organizationId))
+
+	b, err := json.Marshal(sealed)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(sealedSecretPrefix + base64.StdEncoding.EncodeToString(b)), nil
+}
+
+func parseSealedSecret(value []byte) (sealed sealedSecret, ok bool, err error) {
+	if !IsSealed(value) {
+		return sealed, false, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(string(value[len(sealedSecretPrefix):]))
+	if err != nil {
+		return sealed, false, errors.Wrap(err, "invalid sealed secret")
+	}
+	if err := json.Unmarshal(b, &sealed); err != nil {
+		return sealed, false, errors.Wrap(err, "invalid sealed secret")
+	}
+	return sealed, true, nil
+}
+
+func masterKeyIdOf(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,211 @@
+package repository_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/internal/testing/memrepo"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	kms "github.com/openinfradev/tks-api/pkg/kms-client"
+)
+
+const testOrganizationId = "o-sealer"
+
+// fakeKms 는 data key 를 발급한 key arn 으로만 복호화한다.
+type fakeKms struct {
+	mu       sync.Mutex
+	dataKeys map[string][]byte
+}
+
+func newFakeKms() *fakeKms {
+	return &fakeKms{dataKeys: map[string][]byte{}}
+}
+
+func (k *fakeKms) client(ctx context.Context) (kms.KmsClient, error) {
+	return k, nil
+}
+
+func (k *fakeKms) DescribeKey(ctx context.Context, keyArn string) (kms.KeyMetadata, error) {
+	return kms.KeyMetadata{Arn: keyArn, Enabled: true, KeyState: kms.KeyState_ENABLED, KeyUsage: kms.KeyUsage_ENCRYPT}, nil
+}
+
+func (k *fakeKms) GenerateDataKey(ctx context.Context, keyArn string) ([]byte, []byte, error) {
+	dataKey := make([]byte, 32)
+	id := make([]byte, 16)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, err
+	}
+	if _, err := rand.Read(id); err != nil {
+		return nil, nil, err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.dataKeys[keyArn+string(id)] = dataKey
+	return dataKey, id, nil
+}
+
+func (k *fakeKms) Decrypt(ctx context.Context, keyArn string, ciphertextBlob []byte) ([]byte, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	dataKey, ok := k.dataKeys[keyArn+string(ciphertextBlob)]
+	if !ok {
+		return nil, fmt.Errorf("the data key was not generated by %s", keyArn)
+	}
+	return dataKey, nil
+}
+
+func createEncryptionKey(t *testing.T, encryptionKeys repository.IEncryptionKeyRepository, keyArn string) model.EncryptionKey {
+	t.Helper()
+	ctx := context.Background()
+	id, err := encryptionKeys.Create(ctx, model.EncryptionKey{OrganizationId: testOrganizationId, KeyArn: keyArn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := encryptionKeys.Get(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func mustSeal(t *testing.T, sealer repository.ISecretSealer, plaintext string) []byte {
+	t.Helper()
+	sealed, err := sealer.Seal(context.Background(), testOrganizationId, []byte(plaintext))
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+	if !repository.IsSealed(sealed) || bytes.Contains(sealed, []byte(plaintext)) {
+		t.Fatalf("Seal() = %s, want a sealed value without the plaintext", sealed)
+	}
+	return sealed
+}
+
+func wantOpen(t *testing.T, sealer repository.ISecretSealer, value []byte, want string) {
+	t.Helper()
+	opened, err := sealer.Open(context.Background(), value)
+	if err != nil || string(opened) != want {
+		t.Errorf("Open() = %s, %v, want %s", opened, err, want)
+	}
+}
+
+func TestSecretSealerRoundTrip(t *testing.T) {
+	fake := newFakeKms()
+
+	t.Run("master key", func(t *testing.T) {
+		sealer := repository.NewSecretSealer(memrepo.NewEncryptionKeyRepository(), fake.client, "master-1")
+		wantOpen(t, sealer, mustSeal(t, sealer, "s3cr3t"), "s3cr3t")
+	})
+
+	t.Run("organization key", func(t *testing.T) {
+		encryptionKeys := memrepo.NewEncryptionKeyRepository()
+		createEncryptionKey(t, encryptionKeys, "arn:aws:kms:ap-northeast-2:111111111111:key/1")
+		sealer := repository.NewSecretSealer(encryptionKeys, fake.client)
+		wantOpen(t, sealer, mustSeal(t, sealer, "s3cr3t"), "s3cr3t")
+	})
+
+	t.Run("without master key", func(t *testing.T) {
+		sealer := repository.NewSecretSealer(memrepo.NewEncryptionKeyRepository(), fake.client)
+		if _, err := sealer.Seal(context.Background(), testOrganizationId, []byte("s3cr3t")); !errors.Is(err, repository.ErrSecretMasterKeyNotConfigured) {
+			t.Errorf("Seal() error = %v, want ErrSecretMasterKeyNotConfigured", err)
+		}
+	})
+}
+
+func TestSecretSealerWrongKey(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeKms()
+
+	sealed := mustSeal(t, repository.NewSecretSealer(memrepo.NewEncryptionKeyRepository(), fake.client, "master-1"), "s3cr3t")
+	if _, err := repository.NewSecretSealer(memrepo.NewEncryptionKeyRepository(), fake.client, "master-2").Open(ctx, sealed); err == nil {
+		t.Errorf("Open() with another master key should fail")
+	}
+
+	encryptionKeys := memrepo.NewEncryptionKeyRepository()
+	key := createEncryptionKey(t, encryptionKeys, "arn:aws:kms:ap-northeast-2:111111111111:key/1")
+	sealer := repository.NewSecretSealer(encryptionKeys, fake.client)
+	sealed = mustSeal(t, sealer, "s3cr3t")
+	if err := encryptionKeys.UpdateStatus(ctx, key.ID, domain.EncryptionKeyStatus_REVOKED); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sealer.Open(ctx, sealed); !errors.Is(err, repository.ErrEncryptionKeyRevoked) {
+		t.Errorf("Open() error = %v, want ErrEncryptionKeyRevoked", err)
+	}
+}
+
+func TestSecretSealerPlaintextPassThrough(t *testing.T) {
+	ctx := context.Background()
+	sealer := repository.NewSecretSealer(memrepo.NewEncryptionKeyRepository(), newFakeKms().client, "master-1")
+
+	wantOpen(t, sealer, []byte("plain"), "plain")
+
+	resealed, changed, err := sealer.Reseal(ctx, testOrganizationId, []byte("plain"))
+	if err != nil || !changed || !repository.IsSealed(resealed) {
+		t.Fatalf("Reseal() = %s, %v, %v, want the plaintext sealed", resealed, changed, err)
+	}
+	wantOpen(t, sealer, resealed, "plain")
+
+	if _, changed, err := sealer.Reseal(ctx, testOrganizationId, nil); err != nil || changed {
+		t.Errorf("Reseal() of an empty value = %v, %v, want unchanged", changed, err)
+	}
+}
+
+func TestSecretSealerRotate(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeKms()
+
+	t.Run("master key", func(t *testing.T) {
+		encryptionKeys := memrepo.NewEncryptionKeyRepository()
+		sealed := mustSeal(t, repository.NewSecretSealer(encryptionKeys, fake.client, "master-1"), "s3cr3t")
+
+		rotated := repository.NewSecretSealer(encryptionKeys, fake.client, "master-2", "master-1")
+		wantOpen(t, rotated, sealed, "s3cr3t")
+		resealed, changed, err := rotated.Reseal(ctx, testOrganizationId, sealed)
+		if err != nil || !changed {
+			t.Fatalf("Reseal() = %v, %v, want resealed with the new master key", changed, err)
+		}
+		if _, changed, _ := rotated.Reseal(ctx, testOrganizationId, resealed); changed {
+			t.Errorf("Reseal() of a value sealed with the current master key should not change it")
+		}
+		wantOpen(t, repository.NewSecretSealer(encryptionKeys, fake.client, "master-2"), resealed, "s3cr3t")
+	})
+
+	t.Run("organization key", func(t *testing.T) {
+		encryptionKeys := memrepo.NewEncryptionKeyRepository()
+		sealer := repository.NewSecretSealer(encryptionKeys, fake.client, "master-1")
+		sealed := mustSeal(t, sealer, "s3cr3t")
+
+		// 첫 key 를 등록하면 master key 로 봉인한 값을 조직 key 로 다시 봉인한다.
+		first := createEncryptionKey(t, encryptionKeys, "arn:aws:kms:ap-northeast-2:111111111111:key/1")
+		sealed, changed, err := sealer.Reseal(ctx, testOrganizationId, sealed)
+		if err != nil || !changed {
+			t.Fatalf("Reseal() = %v, %v, want resealed with the organization key", changed, err)
+		}
+
+		if err := encryptionKeys.UpdateStatus(ctx, first.ID, domain.EncryptionKeyStatus_RETIRED); err != nil {
+			t.Fatal(err)
+		}
+		createEncryptionKey(t, encryptionKeys, "arn:aws:kms:ap-northeast-2:111111111111:key/2")
+		wantOpen(t, sealer, sealed, "s3cr3t")
+
+		resealed, changed, err := sealer.Reseal(ctx, testOrganizationId, sealed)
+		if err != nil || !changed {
+			t.Fatalf("Reseal() = %v, %v, want resealed with the rotated key", changed, err)
+		}
+		if err := encryptionKeys.UpdateStatus(ctx, first.ID, domain.EncryptionKeyStatus_REVOKED); err != nil {
+			t.Fatal(err)
+		}
+		sealer.Forget(first.ID)
+		if _, err := sealer.Open(ctx, sealed); !errors.Is(err, repository.ErrEncryptionKeyRevoked) {
+			t.Errorf("Open() of a value sealed with the revoked key error = %v, want ErrEncryptionKeyRevoked", err)
+		}
+		wantOpen(t, sealer, resealed, "s3cr3t")
+	})
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// sealedColumn 은 봉인하여 저장하는 column 이다. where 는 조직의 행을 찾는 조건이다.
+// legacy 는 SecretSealer 이전의 형식으로 저장된 값을 복호화한다. 없으면 봉인되지 않은 값을 평문으로 본다.
+type sealedColumn struct {
+	table   string
+	key     string
+	where   string
+	columns []string
+	legacy  func(value []byte) ([]byte, error)
+}
+
+var sealedColumns = []sealedColumn{
+	{table: "alert_channels", key: "id", where: "organization_id = ?", columns: []string{"secret", "smtp_password"}},
+	{table: "audit_sinks", key: "id", where: "organization_id = ?", columns: []string{"secret"}},
+	{table: "stack_webhooks", key: "id", where: "organization_id = ?", columns: []string{"secret"}},
+	{table: "lma_auths", key: "organization_id", where: "organization_id = ?", columns: []string{"password", "bearer_token"}},
+	{table: "git_providers", key: "id", where: "organization_id = ?", columns: []string{"token"}},
+	{table: "app_serve_app_git_sources", key: "app_serve_app_id", where: "organization_id = ?", columns: []string{"webhook_secret"}},
+	{table: "app_serve_app_configs", key: "id", where: "app_serve_app_id IN (SELECT id FROM app_serve_apps WHERE organization_id = ?)", columns: []string{"secrets"}},
+	{table: "users", key: "id", where: "organization_id = ?", columns: []string{"otp_secret"}, legacy: openLegacyTotpSecret},
+}
+
+// Interfaces
+type ISecretRepository interface {
+	Reseal(ctx context.Context, organizationId string) (sealed int, failed int, err error)
+	CountLegacyOtpSecrets(ctx context.Context) (int64, error)
+}
+
+type SecretRepository struct {
+	db     *gorm.DB
+	sealer ISecretSealer
+}
+
+func NewSecretRepository(db *gorm.DB, sealer ISecretSealer) ISecretRepository {
+	return &SecretRepository{
+		db:     db,
+		sealer: sealer,
+	}
+}
+
+// Logics
+// Reseal 은 조직의 secret column 중 봉인되지 않았거나 현재 key 가 아닌 key 로 봉인된 값을 다시 봉인한다.
+func (r *SecretRepository) Reseal(ctx context.Context, organizationId string) (sealed int, failed int, err error) {
+	for _, c := range sealedColumns {
+		var rows []map[string]interface{}
+		res := r.db.WithContext(ctx).Table(c.table).
+			Select(append([]string{c.key}, c.columns...)).
+			Where(c.where, organizationId).
+			Find(&rows)
+		if res.Error != nil {
+			return sealed, failed, res.Error
+		}
+
+		for _, row := range rows {
+			updates := map[string]interface{}{}
+			for _, column := range c.columns {
+				value, err := r.reseal(ctx, c, organizationId, row[column])
+				if err != nil {
+					log.Errorf(ctx, "failed to seal %s of %s %v. err : %s", column, c.table, row[c.key], err)
+					updates = nil
+					break
+				}
+				if value != nil {
+					updates[column] = value
+				}
+			}
+			if updates == nil {
+				failed++
+				continue
+			}
+			if len(updates) == 0 {
+				continue
+			}
+
+			res := r.db.WithContext(ctx).Table(c.table).Where(c.key+" = ?", row[c.key]).Updates(updates)
+			if res.Error != nil {
+				log.Error(ctx, res.Error)
+				failed++
+				continue
+			}
+			sealed++
+		}
+	}
+	return sealed, failed, nil
+}
+
+// CountLegacyOtpSecrets 는 SecretSealer 로 봉인하지 않은 TOTP secret 의 수를 반환한다.
+func (r *SecretRepository) CountLegacyOtpSecrets(ctx context.Context) (count int64, err error) {
+	res := r.db.WithContext(ctx).Table("users").
+		Where("otp_secret <> '' AND otp_secret NOT LIKE ?", sealedSecretPrefix+"%").
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+// reseal 은 다시 봉인한 값을 column 의 type(text, bytea) 그대로 반환한다. 바꿀 필요가 없으면 nil 을 반환한다.
+func (r *SecretRepository) reseal(ctx context.Context, c sealedColumn, organizationId string, column interface{}) (interface{}, error) {
+	var value []byte
+	switch v := column.(type) {
+	case string:
+		value = []byte(v)
+	case []byte:
+		value = v
+	default:
+		return nil, nil
+	}
+
+	if c.legacy != nil && len(value) > 0 && !IsSealed(value) {
+		plaintext, err := c.legacy(value)
+		if err != nil {
+			return nil, err
+		}
+		value = plaintext
+	}
+
+	resealed, changed, err := r.sealer.Reseal(ctx, organizationId, value)
+	if err != nil || !changed {
+		return nil, err
+	}
+	if _, ok := column.(string); ok {
+		return string(resealed), nil
+	}
+	return resealed, nil
+}
+
+// openLegacyTotpSecret 은 otp-encryption-key 로 암호화했던 TOTP secret(base64(nonce + ciphertext)) 을 복호화한다.
+func openLegacyTotpSecret(value []byte) ([]byte, error) {
+	if viper.GetString("otp-encryption-key") == "" {
+		return nil, fmt.Errorf("otp-encryption-key is required to seal totp secrets encrypted with it")
+	}
+	key := sha256.Sum256([]byte(viper.GetString("otp-encryption-key")))
+	gcm, err := newGCM(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := base64.StdEncoding.DecodeString(string(value))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid encrypted totp secret")
+	}
+	return gcm.Open(nil, b[:gcm.NonceSize()], b[gcm.NonceSize():], nil)
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+)
+
+// Interfaces
+type IStackDefaultRepository interface {
+	Get(ctx context.Context, organizationId string) (model.StackDefault, error)
+	Upsert(ctx context.Context, dto model.StackDefault) error
+	Delete(ctx context.Context, organizationId string) error
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type StackDefaultRepository struct {
+	db *gorm.DB
+}
+
+func NewStackDefaultRepository(db *gorm.DB) IStackDefaultRepository {
+	return &StackDefaultRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *StackDefaultRepository) Get(ctx context.Context, organizationId string) (out model.StackDefault, err error) {
+	res := r.db.WithContext(ctx).Preload(clause.Associations).First(&out, "organization_id = ?", organizationId)
+	if res.Error != nil {
+		return model.StackDefault{}, res.Error
+	}
+	return
+}
+
+func (r *StackDefaultRepository) Upsert(ctx context.Context, dto model.StackDefault) error {
+	stackDefault := model.StackDefault{
+		OrganizationId:   dto.OrganizationId,
+		Region:           dto.Region,
+		TksCpNodeType:    dto.TksCpNodeType,
+		TksInfraNodeType: dto.TksInfraNodeType,
+		TksUserNodeType:  dto.TksUserNodeType,
+		VpcCidr:          dto.VpcCidr,
+		PodCidr:          dto.PodCidr,
+		ServiceCidr:      dto.ServiceCidr,
+		Tag:              dto.Tag,
+		UpdatorId:        dto.UpdatorId,
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"region", "tks_cp_node_type", "tks_infra_node_type", "tks_user_node_type", "vpc_cidr", "pod_cidr", "service_cidr", "tag", "updator_id", "updated_at"}),
+	}).Omit(clause.Associations).Create(&stackDefault)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *StackDefaultRepository) Delete(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Delete(&model.StackDefault{}, "organization_id = ?", organizationId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *StackDefaultRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.StackDefault{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *StackDefaultRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.StackDefault{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
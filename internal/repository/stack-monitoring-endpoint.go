@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IStackMonitoringEndpointRepository interface {
+	Fetch(ctx context.Context, organizationId string, clusterId domain.ClusterId) ([]model.StackMonitoringEndpoint, error)
+	FetchEnabled(ctx context.Context) ([]model.StackMonitoringEndpoint, error)
+	Get(ctx context.Context, monitoringEndpointId uuid.UUID) (model.StackMonitoringEndpoint, error)
+	Create(ctx context.Context, dto model.StackMonitoringEndpoint) (monitoringEndpointId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.StackMonitoringEndpoint) error
+	UpdateStatus(ctx context.Context, dto model.StackMonitoringEndpoint) error
+	Delete(ctx context.Context, monitoringEndpointId uuid.UUID) error
+	CreateProbe(ctx context.Context, dto model.StackMonitoringProbe) error
+	GetAvailability(ctx context.Context, monitoringEndpointIds []uuid.UUID, since time.Time) (map[uuid.UUID]ProbeCount, error)
+	DeleteProbesBefore(ctx context.Context, before time.Time) error
+}
+
+// ProbeCount 는 endpoint 별 probe 횟수와 성공 횟수이다.
+type ProbeCount struct {
+	Total   int64
+	Success int64
+}
+
+type StackMonitoringEndpointRepository struct {
+	db *gorm.DB
+}
+
+func NewStackMonitoringEndpointRepository(db *gorm.DB) IStackMonitoringEndpointRepository {
+	return &StackMonitoringEndpointRepository{
+		db: db,
+	}
+}
+
+// Logics
+// Fetch 는 clusterId 가 비어 있으면 조직의 모든 stack 의 endpoint 를 조회한다.
+func (r *StackMonitoringEndpointRepository) Fetch(ctx context.Context, organizationId string, clusterId domain.ClusterId) (out []model.StackMonitoringEndpoint, err error) {
+	db := r.db.WithContext(ctx).Preload("Cluster").Preload("Creator").Preload("Updator").
+		Where("organization_id = ?", organizationId)
+	if clusterId != "" {
+		db = db.Where("cluster_id = ?", clusterId)
+	}
+
+	res := db.Order("name ASC").Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *StackMonitoringEndpointRepository) FetchEnabled(ctx context.Context) (out []model.StackMonitoringEndpoint, err error) {
+	res := r.db.WithContext(ctx).Preload("Cluster").Where("enabled = ?", true).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *StackMonitoringEndpointRepository) Get(ctx context.Context, monitoringEndpointId uuid.UUID) (out model.StackMonitoringEndpoint, err error) {
+	res := r.db.WithContext(ctx).Preload("Cluster").Preload("Creator").Preload("Updator").First(&out, "id = ?", monitoringEndpointId)
+	if res.Error != nil {
+		return model.StackMonitoringEndpoint{}, res.Error
+	}
+	return
+}
+
+func (r *StackMonitoringEndpointRepository) Create(ctx context.Context, dto model.StackMonitoringEndpoint) (monitoringEndpointId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Omit("Cluster", "Creator", "Updator").Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+// Update 는 설정만 변경한다. 주소가 바뀌면 이전 상태는 의미가 없으므로 상태를 초기화한다.
+func (r *StackMonitoringEndpointRepository) Update(ctx context.Context, dto model.StackMonitoringEndpoint) error {
+	values := map[string]interface{}{
+		"Name":               dto.Name,
+		"Url":                dto.Url,
+		"Method":             dto.Method,
+		"ExpectedStatusCode": dto.ExpectedStatusCode,
+		"IntervalSeconds":    dto.IntervalSeconds,
+		"TimeoutSeconds":     dto.TimeoutSeconds,
+		"FailureThreshold":   dto.FailureThreshold,
+		"InsecureSkipVerify": dto.InsecureSkipVerify,
+		"Enabled":            dto.Enabled,
+		"UpdatorId":          dto.UpdatorId,
+	}
+	if dto.Status == domain.MonitoringEndpointStatus_UNKNOWN {
+		values["Status"] = dto.Status
+		values["ConsecutiveFailures"] = 0
+		values["LastError"] = ""
+		values["NotifiedAt"] = nil
+	}
+
+	res := r.db.WithContext(ctx).Model(&model.StackMonitoringEndpoint{}).
+		Where("id = ?", dto.ID).
+		Updates(values)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *StackMonitoringEndpointRepository) UpdateStatus(ctx context.Context, dto model.StackMonitoringEndpoint) error {
+	res := r.db.WithContext(ctx).Model(&model.StackMonitoringEndpoint{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Status":              dto.Status,
+			"LastStatusCode":      dto.LastStatusCode,
+			"LastLatencyMs":       dto.LastLatencyMs,
+			"LastError":           dto.LastError,
+			"LastCheckedAt":       dto.LastCheckedAt,
+			"ConsecutiveFailures": dto.ConsecutiveFailures,
+			"NotifiedAt":          dto.NotifiedAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *StackMonitoringEndpointRepository) Delete(ctx context.Context, monitoringEndpointId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.StackMonitoringEndpoint{}, "id = ?", monitoringEndpointId)
+	if res.Error != nil {
+		return res.Error
+	}
+	res = r.db.WithContext(ctx).Delete(&model.StackMonitoringProbe{}, "monitoring_endpoint_id = ?", monitoringEndpointId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *StackMonitoringEndpointRepository) CreateProbe(ctx context.Context, dto model.StackMonitoringProbe) error {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *StackMonitoringEndpointRepository) GetAvailability(ctx context.Context, monitoringEndpointIds []uuid.UUID, since time.Time) (out map[uuid.UUID]ProbeCount, err error) {
+	out = make(map[uuid.UUID]ProbeCount)
+	if len(monitoringEndpointIds) == 0 {
+		return out, nil
+	}
+
+	var rows []struct {
+		MonitoringEndpointId uuid.UUID
+		Total                int64
+		Success              int64
+	}
+	res := r.db.WithContext(ctx).Model(&model.StackMonitoringProbe{}).
+		Select("monitoring_endpoint_id, count(*) AS total, sum(CASE WHEN success THEN 1 ELSE 0 END) AS success").
+		Where("monitoring_endpoint_id IN ? AND checked_at >= ?", monitoringEndpointIds, since).
+		Group("monitoring_endpoint_id").
+		Scan(&rows)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	for _, row := range rows {
+		out[row.MonitoringEndpointId] = ProbeCount{Total: row.Total, Success: row.Success}
+	}
+	return out, nil
+}
+
+func (r *StackMonitoringEndpointRepository) DeleteProbesBefore(ctx context.Context, before time.Time) error {
+	res := r.db.WithContext(ctx).Delete(&model.StackMonitoringProbe{}, "checked_at < ?", before)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IStackUpgradeRepository interface {
+	Fetch(ctx context.Context, clusterId domain.ClusterId, pg *pagination.Pagination) ([]model.StackUpgrade, error)
+	FetchActive(ctx context.Context) ([]model.StackUpgrade, error)
+	GetActive(ctx context.Context, clusterId domain.ClusterId) (model.StackUpgrade, error)
+	Create(ctx context.Context, dto model.StackUpgrade) (stackUpgradeId uuid.UUID, err error)
+	UpdateStatus(ctx context.Context, dto model.StackUpgrade) error
+}
+
+type StackUpgradeRepository struct {
+	db *gorm.DB
+}
+
+func NewStackUpgradeRepository(db *gorm.DB) IStackUpgradeRepository {
+	return &StackUpgradeRepository{
+		db: db,
+	}
+}
+
+var activeStackUpgradeStatuses = []domain.OperationStatus{domain.OperationStatus_PENDING, domain.OperationStatus_RUNNING}
+
+// Logics
+func (r *StackUpgradeRepository) Fetch(ctx context.Context, clusterId domain.ClusterId, pg *pagination.Pagination) (out []model.StackUpgrade, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("FromStackTemplate").Preload("ToStackTemplate").Preload("Creator").
+		Model(&model.StackUpgrade{}).
+		Where("cluster_id = ?", clusterId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// FetchActive 는 아직 끝나지 않은 업그레이드를 요청 순서대로 반환한다.
+func (r *StackUpgradeRepository) FetchActive(ctx context.Context) (out []model.StackUpgrade, err error) {
+	res := r.db.WithContext(ctx).
+		Where("status IN ?", activeStackUpgradeStatuses).
+		Order("created_at ASC").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *StackUpgradeRepository) GetActive(ctx context.Context, clusterId domain.ClusterId) (out model.StackUpgrade, err error) {
+	res := r.db.WithContext(ctx).
+		Where("cluster_id = ? AND status IN ?", clusterId, activeStackUpgradeStatuses).
+		First(&out)
+	if res.Error != nil {
+		return model.StackUpgrade{}, res.Error
+	}
+	return
+}
+
+func (r *StackUpgradeRepository) Create(ctx context.Context, dto model.StackUpgrade) (stackUpgradeId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *StackUpgradeRepository) UpdateStatus(ctx context.Context, dto model.StackUpgrade) error {
+	res := r.db.WithContext(ctx).Model(&model.StackUpgrade{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Status":      dto.Status,
+			"StatusDesc":  dto.StatusDesc,
+			"CompletedAt": dto.CompletedAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
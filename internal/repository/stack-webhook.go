@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+// Interfaces
+type IStackWebhookRepository interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.StackWebhook, error)
+	FetchEnabled(ctx context.Context) ([]model.StackWebhook, error)
+	Get(ctx context.Context, stackWebhookId uuid.UUID) (model.StackWebhook, error)
+	Create(ctx context.Context, dto model.StackWebhook) (stackWebhookId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.StackWebhook) error
+	UpdateLastStatus(ctx context.Context, stackWebhookId uuid.UUID, status string, reason string, deliveredAt time.Time) error
+	Delete(ctx context.Context, stackWebhookId uuid.UUID) error
+	FetchStates(ctx context.Context) ([]model.StackLifecycleState, error)
+	SaveState(ctx context.Context, dto model.StackLifecycleState) error
+	DeleteState(ctx context.Context, resourceId string) error
+}
+
+type StackWebhookRepository struct {
+	db *gorm.DB
+}
+
+func NewStackWebhookRepository(db *gorm.DB) IStackWebhookRepository {
+	return &StackWebhookRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *StackWebhookRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.StackWebhook, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Preload("Creator").Model(&model.StackWebhook{}).
+		Where("organization_id = ?", organizationId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *StackWebhookRepository) FetchEnabled(ctx context.Context) (out []model.StackWebhook, err error) {
+	res := r.db.WithContext(ctx).
+		Where("enabled = ?", true).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *StackWebhookRepository) Get(ctx context.Context, stackWebhookId uuid.UUID) (out model.StackWebhook, err error) {
+	res := r.db.WithContext(ctx).Preload("Creator").First(&out, "id = ?", stackWebhookId)
+	if res.Error != nil {
+		return model.StackWebhook{}, res.Error
+	}
+	return
+}
+
+func (r *StackWebhookRepository) Create(ctx context.Context, dto model.StackWebhook) (stackWebhookId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *StackWebhookRepository) Update(ctx context.Context, dto model.StackWebhook) error {
+	event, err := json.Marshal(dto.Events)
+	if err != nil {
+		return err
+	}
+	res := r.db.WithContext(ctx).Model(&model.StackWebhook{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"Name":    dto.Name,
+			"Url":     dto.Url,
+			"Secret":  dto.Secret,
+			"Event":   event,
+			"Enabled": dto.Enabled,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *StackWebhookRepository) UpdateLastStatus(ctx context.Context, stackWebhookId uuid.UUID, status string, reason string, deliveredAt time.Time) error {
+	count := "failed_count"
+	if status == domain.StackWebhookStatus_SUCCEEDED {
+		count = "succeeded_count"
+	}
+	res := r.db.WithContext(ctx).Model(&model.StackWebhook{}).
+		Where("id = ?", stackWebhookId).
+		Updates(map[string]interface{}{
+			"LastStatus":      status,
+			"LastError":       reason,
+			"LastDeliveredAt": deliveredAt,
+			count:             gorm.Expr(count + " + 1"),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *StackWebhookRepository) Delete(ctx context.Context, stackWebhookId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.StackWebhook{}, "id = ?", stackWebhookId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *StackWebhookRepository) FetchStates(ctx context.Context) (out []model.StackLifecycleState, err error) {
+	res := r.db.WithContext(ctx).Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *StackWebhookRepository) SaveState(ctx context.Context, dto model.StackLifecycleState) error {
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(&dto)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *StackWebhookRepository) DeleteState(ctx context.Context, resourceId string) error {
+	res := r.db.WithContext(ctx).Delete(&model.StackLifecycleState{}, "resource_id = ?", resourceId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -23,11 +23,19 @@ type ISystemNotificationRepository interface {
 	FetchSystemNotifications(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.SystemNotification, error)
 	FetchPolicyNotifications(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.SystemNotification, error)
 	FetchPodRestart(ctx context.Context, organizationId string, start time.Time, end time.Time) ([]model.SystemNotification, error)
+	FetchBySeverity(ctx context.Context, organizationId string, severity string, start time.Time, end time.Time) ([]model.SystemNotification, error)
+	FetchByPeriod(ctx context.Context, organizationId string, start time.Time, end time.Time) ([]model.SystemNotification, error)
+	FetchOpenSeverityCounts(ctx context.Context, organizationId string) ([]model.SystemNotificationSeverityCount, error)
+	FetchOpenByClusterId(ctx context.Context, clusterId domain.ClusterId) ([]model.SystemNotification, error)
 	Create(ctx context.Context, dto model.SystemNotification) (systemNotificationId uuid.UUID, err error)
 	Update(ctx context.Context, dto model.SystemNotification) (err error)
 	Delete(ctx context.Context, dto model.SystemNotification) (err error)
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
 	CreateSystemNotificationAction(ctx context.Context, dto model.SystemNotificationAction) (systemNotificationActionId uuid.UUID, err error)
 	UpdateRead(ctx context.Context, systemNotificationId uuid.UUID, user model.User) (err error)
+	FetchEscalationDue(ctx context.Context, now time.Time) ([]model.SystemNotification, error)
+	UpdateEscalation(ctx context.Context, dto model.SystemNotification) (err error)
 }
 
 type SystemNotificationRepository struct {
@@ -90,7 +98,7 @@ func (r *SystemNotificationRepository) FetchSystemNotifications(ctx context.Cont
 		}
 	}
 
-	_, res := pg.Fetch(db, &out)
+	res := pg.FetchWithCursor(db, &out)
 
 	if res.Error != nil {
 		return nil, res.Error
@@ -124,7 +132,7 @@ func (r *SystemNotificationRepository) FetchPolicyNotifications(ctx context.Cont
 		}
 	}
 
-	_, res := pg.Fetch(db, &out)
+	res := pg.FetchWithCursor(db, &out)
 
 	if res.Error != nil {
 		return nil, res.Error
@@ -142,10 +150,60 @@ func (r *SystemNotificationRepository) FetchPodRestart(ctx context.Context, orga
 	return
 }
 
+// FetchBySeverity 는 기간 중에 발생한 severity 의 시스템 알림을 발생 순서대로 반환한다. silence 된 알림은 제외한다.
+func (r *SystemNotificationRepository) FetchBySeverity(ctx context.Context, organizationId string, severity string, start time.Time, end time.Time) (out []model.SystemNotification, err error) {
+	res := r.db.WithContext(ctx).Order("created_at ASC").
+		Where("organization_id = ? AND notification_type = 'SYSTEM_NOTIFICATION' AND LOWER(severity) = LOWER(?) AND status <> ? AND created_at BETWEEN ? AND ?",
+			organizationId, severity, domain.SystemNotificationActionStatus_SILENCED, start, end).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// FetchByPeriod 는 기간 중에 발생한 시스템 알림을 최근 것부터 반환한다. 정책 알림은 제외한다.
+func (r *SystemNotificationRepository) FetchByPeriod(ctx context.Context, organizationId string, start time.Time, end time.Time) (out []model.SystemNotification, err error) {
+	res := r.db.WithContext(ctx).Order("created_at DESC").
+		Where("organization_id = ? AND notification_type = 'SYSTEM_NOTIFICATION' AND created_at BETWEEN ? AND ?", organizationId, start, end).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationRepository) FetchOpenSeverityCounts(ctx context.Context, organizationId string) (out []model.SystemNotificationSeverityCount, err error) {
+	res := r.db.WithContext(ctx).Model(&model.SystemNotification{}).
+		Select("cluster_id, severity, count(*) as count").
+		Where("organization_id = ? AND notification_type = 'SYSTEM_NOTIFICATION' AND status IN ?", organizationId,
+			[]domain.SystemNotificationActionStatus{domain.SystemNotificationActionStatus_CREATED, domain.SystemNotificationActionStatus_INPROGRESS, domain.SystemNotificationActionStatus_ESCALATED}).
+		Group("cluster_id, severity").
+		Scan(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+// FetchOpenByClusterId 는 클러스터의 처리되지 않은 시스템 알림을 반환한다.
+func (r *SystemNotificationRepository) FetchOpenByClusterId(ctx context.Context, clusterId domain.ClusterId) (out []model.SystemNotification, err error) {
+	res := r.db.WithContext(ctx).
+		Where("cluster_id = ? AND notification_type = 'SYSTEM_NOTIFICATION' AND status IN ?", clusterId,
+			[]domain.SystemNotificationActionStatus{domain.SystemNotificationActionStatus_CREATED, domain.SystemNotificationActionStatus_INPROGRESS, domain.SystemNotificationActionStatus_ESCALATED}).
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
 func (r *SystemNotificationRepository) Create(ctx context.Context, dto model.SystemNotification) (systemNotificationId uuid.UUID, err error) {
 
 	dto.ID = uuid.New()
-	dto.Status = domain.SystemNotificationActionStatus_CREATED
+	if dto.Status != domain.SystemNotificationActionStatus_SILENCED {
+		dto.Status = domain.SystemNotificationActionStatus_CREATED
+	}
 	res := r.db.WithContext(ctx).Create(&dto)
 	if res.Error != nil {
 		return uuid.Nil, res.Error
@@ -173,6 +231,24 @@ func (r *SystemNotificationRepository) Delete(ctx context.Context, dto model.Sys
 	return nil
 }
 
+func (r *SystemNotificationRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.SystemNotification{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.SystemNotification{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
 func (r *SystemNotificationRepository) CreateSystemNotificationAction(ctx context.Context, dto model.SystemNotificationAction) (systemNotificationActionId uuid.UUID, err error) {
 	systemNotification := model.SystemNotificationAction{
 		ID:                   uuid.New(),
@@ -185,9 +261,13 @@ func (r *SystemNotificationRepository) CreateSystemNotificationAction(ctx contex
 	if res.Error != nil {
 		return uuid.Nil, res.Error
 	}
+	// 조치를 시작하거나 종료한 앨럿은 더 이상 에스컬레이션하지 않는다.
 	res = r.db.WithContext(ctx).Model(&model.SystemNotification{}).
 		Where("id = ?", dto.SystemNotificationId).
-		Update("status", dto.Status)
+		Updates(map[string]interface{}{
+			"status":             dto.Status,
+			"next_escalation_at": nil,
+		})
 	if res.Error != nil {
 		return uuid.Nil, res.Error
 	}
@@ -209,3 +289,31 @@ func (r *SystemNotificationRepository) UpdateRead(ctx context.Context, systemNot
 	}
 	return nil
 }
+
+// FetchEscalationDue 는 확인되지 않은 채 다음 에스컬레이션 시각이 지난 앨럿을 반환한다.
+func (r *SystemNotificationRepository) FetchEscalationDue(ctx context.Context, now time.Time) (out []model.SystemNotification, err error) {
+	res := r.db.WithContext(ctx).
+		Where("next_escalation_at <= ? AND status IN ?", now,
+			[]domain.SystemNotificationActionStatus{domain.SystemNotificationActionStatus_CREATED, domain.SystemNotificationActionStatus_ESCALATED}).
+		Order("next_escalation_at").
+		Find(&out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *SystemNotificationRepository) UpdateEscalation(ctx context.Context, dto model.SystemNotification) (err error) {
+	res := r.db.WithContext(ctx).Model(&model.SystemNotification{}).
+		Where("id = ?", dto.ID).
+		Updates(map[string]interface{}{
+			"EscalationPolicyId": dto.EscalationPolicyId,
+			"EscalationLevel":    dto.EscalationLevel,
+			"NextEscalationAt":   dto.NextEscalationAt,
+			"Status":             dto.Status,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+)
+
+// Interfaces
+type IUserSessionRepository interface {
+	Fetch(ctx context.Context, userId uuid.UUID, pg *pagination.Pagination) ([]model.UserSession, error)
+	Get(ctx context.Context, userSessionId uuid.UUID) (model.UserSession, error)
+	Create(ctx context.Context, dto model.UserSession) (userSessionId uuid.UUID, err error)
+	UpdateLastActivity(ctx context.Context, sessionId string, lastActivityAt time.Time) error
+	Delete(ctx context.Context, userSessionId uuid.UUID) error
+	DeleteBySessionId(ctx context.Context, sessionId string) error
+	DeleteInactive(ctx context.Context, before time.Time) (int64, error)
+	CountByOrganizationId(ctx context.Context, organizationId string) (int64, error)
+	Flush(ctx context.Context, organizationId string) error
+}
+
+type UserSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewUserSessionRepository(db *gorm.DB) IUserSessionRepository {
+	return &UserSessionRepository{
+		db: db,
+	}
+}
+
+// Logics
+func (r *UserSessionRepository) Fetch(ctx context.Context, userId uuid.UUID, pg *pagination.Pagination) (out []model.UserSession, err error) {
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+
+	_, res := pg.Fetch(r.db.WithContext(ctx).Model(&model.UserSession{}).
+		Where("user_id = ?", userId), &out)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return
+}
+
+func (r *UserSessionRepository) Get(ctx context.Context, userSessionId uuid.UUID) (out model.UserSession, err error) {
+	res := r.db.WithContext(ctx).First(&out, "id = ?", userSessionId)
+	if res.Error != nil {
+		return model.UserSession{}, res.Error
+	}
+	return
+}
+
+func (r *UserSessionRepository) Create(ctx context.Context, dto model.UserSession) (userSessionId uuid.UUID, err error) {
+	dto.ID = uuid.New()
+	res := r.db.WithContext(ctx).Create(&dto)
+	if res.Error != nil {
+		return uuid.Nil, res.Error
+	}
+	return dto.ID, nil
+}
+
+func (r *UserSessionRepository) UpdateLastActivity(ctx context.Context, sessionId string, lastActivityAt time.Time) error {
+	res := r.db.WithContext(ctx).Model(&model.UserSession{}).
+		Where("session_id = ?", sessionId).
+		Update("last_activity_at", lastActivityAt)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *UserSessionRepository) Delete(ctx context.Context, userSessionId uuid.UUID) error {
+	res := r.db.WithContext(ctx).Delete(&model.UserSession{}, "id = ?", userSessionId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *UserSessionRepository) DeleteBySessionId(ctx context.Context, sessionId string) error {
+	res := r.db.WithContext(ctx).Delete(&model.UserSession{}, "session_id = ?", sessionId)
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
+
+func (r *UserSessionRepository) DeleteInactive(ctx context.Context, before time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Delete(&model.UserSession{}, "last_activity_at < ?", before)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return res.RowsAffected, nil
+}
+
+func (r *UserSessionRepository) CountByOrganizationId(ctx context.Context, organizationId string) (count int64, err error) {
+	res := r.db.WithContext(ctx).Model(&model.UserSession{}).
+		Where("organization_id = ?", organizationId).
+		Count(&count)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return
+}
+
+func (r *UserSessionRepository) Flush(ctx context.Context, organizationId string) error {
+	res := r.db.WithContext(ctx).Where("organization_id = ?", organizationId).Delete(&model.UserSession{})
+	if res.Error != nil {
+		return res.Error
+	}
+	return nil
+}
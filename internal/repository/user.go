@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
@@ -22,6 +24,9 @@ type IUserRepository interface {
 	GetByUuid(ctx context.Context, userId uuid.UUID) (model.User, error)
 	Update(ctx context.Context, user *model.User) (*model.User, error)
 	UpdatePasswordAt(ctx context.Context, userId uuid.UUID, organizationId string, isTemporary bool) error
+	UpdateOtp(ctx context.Context, userId uuid.UUID, otpSecret string, otpEnabled bool) error
+	UseOtpCounter(ctx context.Context, userId uuid.UUID, counter int64) (bool, error)
+	IncreaseOtpFailedAttempts(ctx context.Context, userId uuid.UUID, maxAttempts int, lockedUntil time.Time) error
 	DeleteWithUuid(ctx context.Context, uuid uuid.UUID) error
 	Flush(ctx context.Context, organizationId string) error
 
@@ -114,12 +119,23 @@ func (r *UserRepository) List(ctx context.Context, filters ...FilterFunc) (*[]mo
 	return &out, nil
 }
 
+// 사용자 목록에서 정렬할 수 있는 column 이다.
+var userSortColumns = []string{"account_id", "name", "email", "department", "created_at", "updated_at", "password_updated_at"}
+
 func (r *UserRepository) ListWithPagination(ctx context.Context, pg *pagination.Pagination, organizationId string) (*[]model.User, error) {
 	var users []model.User
 
 	if pg == nil {
 		pg = pagination.NewPagination(nil)
 	}
+	sortColumn := helper.ToSnakeCase(pg.SortColumn)
+	if !slices.Contains(userSortColumns, sortColumn) {
+		sortColumn = "created_at"
+	}
+	if sortColumn != pg.SortColumn {
+		pg.SortColumn = sortColumn
+		pg.MakePaginationRequest()
+	}
 
 	db := r.db.WithContext(ctx).Preload(clause.Associations).Model(&model.User{}).
 		Where("users.organization_id = ?", organizationId)
@@ -194,11 +210,12 @@ func (r *UserRepository) ListUsersByRole(ctx context.Context, organizationId str
 
 func (r *UserRepository) Update(ctx context.Context, user *model.User) (*model.User, error) {
 	res := r.db.WithContext(ctx).Model(&model.User{}).Where("id = ?", user.ID).
-		Select("Name", "Email", "Department", "Description").Updates(model.User{
+		Select("Name", "Email", "Department", "Description", "Timezone").Updates(model.User{
 		Name:        user.Name,
 		Email:       user.Email,
 		Department:  user.Department,
 		Description: user.Description,
+		Timezone:    user.Timezone,
 	})
 
 	if res.Error != nil {
@@ -241,6 +258,50 @@ func (r *UserRepository) UpdatePasswordAt(ctx context.Context, userId uuid.UUID,
 	return nil
 }
 
+// UseOtpCounter 는 마지막으로 사용한 주기보다 뒤의 코드일 때만 주기를 기록하고 실패 횟수를 초기화한다.
+// 동시에 같은 코드로 요청해도 한 요청만 true 를 반환한다.
+func (r *UserRepository) UseOtpCounter(ctx context.Context, userId uuid.UUID, counter int64) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&model.User{}).
+		Where("id = ? AND otp_last_counter < ?", userId, counter).
+		Updates(map[string]interface{}{
+			"otp_last_counter":    counter,
+			"otp_failed_attempts": 0,
+			"otp_locked_until":    nil,
+		})
+	if res.Error != nil {
+		log.Errorf(ctx, "error is :%s(%T)", res.Error.Error(), res.Error)
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// IncreaseOtpFailedAttempts 는 실패 횟수를 늘리고, maxAttempts 에 도달하면 lockedUntil 까지 잠근 뒤 횟수를 초기화한다.
+func (r *UserRepository) IncreaseOtpFailedAttempts(ctx context.Context, userId uuid.UUID, maxAttempts int, lockedUntil time.Time) error {
+	res := r.db.WithContext(ctx).Model(&model.User{}).Where("id = ?", userId).
+		Updates(map[string]interface{}{
+			"otp_failed_attempts": gorm.Expr("CASE WHEN otp_failed_attempts + 1 >= ? THEN 0 ELSE otp_failed_attempts + 1 END", maxAttempts),
+			"otp_locked_until":    gorm.Expr("CASE WHEN otp_failed_attempts + 1 >= ? THEN ? ELSE otp_locked_until END", maxAttempts, lockedUntil),
+		})
+	if res.Error != nil {
+		log.Errorf(ctx, "error is :%s(%T)", res.Error.Error(), res.Error)
+		return res.Error
+	}
+	return nil
+}
+
+func (r *UserRepository) UpdateOtp(ctx context.Context, userId uuid.UUID, otpSecret string, otpEnabled bool) error {
+	res := r.db.WithContext(ctx).Model(&model.User{}).Where("id = ?", userId).
+		Select("otp_secret", "otp_enabled").Updates(model.User{OtpSecret: otpSecret, OtpEnabled: otpEnabled})
+	if res.Error != nil {
+		log.Errorf(ctx, "error is :%s(%T)", res.Error.Error(), res.Error)
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return httpErrors.NewNotFoundError(httpErrors.NotFound, "", "")
+	}
+	return nil
+}
+
 func (r *UserRepository) DeleteWithUuid(ctx context.Context, uuid uuid.UUID) error {
 	var user model.User
 	if err := r.db.WithContext(ctx).Model(&model.User{}).Preload("Organization").Preload("Roles").Find(&user, "id = ?", uuid).Error; err != nil {
@@ -0,0 +1,63 @@
+package route
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// jobLeaseHolder identifies this replica in job leases.
+var jobLeaseHolder = func() string {
+	hostname, _ := os.Hostname()
+	return hostname + "-" + uuid.NewString()[:8]
+}()
+
+// runPeriodically runs the job every interval until ctx is done.
+// The job runs only in the replica holding the lease of the job, so it runs once per interval however many replicas there are.
+// The lease lasts for the interval and the holder renews it on every run. Another replica takes it over when the holder stops.
+func runPeriodically(ctx context.Context, leases repository.IJobLeaseRepository, name string, interval time.Duration, job func(ctx context.Context) error) {
+	tick(ctx, interval, func() {
+		acquired, err := leases.Acquire(ctx, name, jobLeaseHolder, interval)
+		if err != nil {
+			log.Errorf(ctx, "[JOB] failed to acquire the lease of %s. err: %s", name, err)
+			return
+		}
+		if acquired {
+			runOnce(ctx, name, job)
+		}
+	})
+}
+
+// runPeriodicallyOnEveryReplica runs the job every interval in every replica. It is used for the jobs which update the local state of the replica.
+func runPeriodicallyOnEveryReplica(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) error) {
+	tick(ctx, interval, func() {
+		runOnce(ctx, name, job)
+	})
+}
+
+// runOnce runs the job once. It is used for the jobs on startup.
+func runOnce(ctx context.Context, name string, job func(ctx context.Context) error) {
+	log.Infof(ctx, "[JOB] start %s", name)
+	if err := job(ctx); err != nil {
+		log.Errorf(ctx, "[JOB] failed %s. err: %s", name, err)
+	}
+}
+
+func tick(ctx context.Context, interval time.Duration, f func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f()
+		}
+	}
+}
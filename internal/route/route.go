@@ -1,28 +1,36 @@
 package route
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	internalApi "github.com/openinfradev/tks-api/internal/delivery/api"
 	"github.com/openinfradev/tks-api/internal/middleware/audit"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/requestRecoder"
+	"github.com/openinfradev/tks-api/internal/middleware/filter"
 	"github.com/openinfradev/tks-api/internal/middleware/logging"
+	"github.com/openinfradev/tks-api/internal/middleware/ratelimit"
 
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/openinfradev/tks-api/internal"
 	delivery "github.com/openinfradev/tks-api/internal/delivery/http"
 	"github.com/openinfradev/tks-api/internal/keycloak"
+	"github.com/openinfradev/tks-api/internal/mail"
 	internalMiddleware "github.com/openinfradev/tks-api/internal/middleware"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/authenticator"
 	authCustom "github.com/openinfradev/tks-api/internal/middleware/auth/authenticator/custom"
 	authKeycloak "github.com/openinfradev/tks-api/internal/middleware/auth/authenticator/keycloak"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/authorizer"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/ingestion"
 	"github.com/openinfradev/tks-api/internal/repository"
 	"github.com/openinfradev/tks-api/internal/usecase"
 	argowf "github.com/openinfradev/tks-api/pkg/argo-client"
+	kms "github.com/openinfradev/tks-api/pkg/kms-client"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
 	gcache "github.com/patrickmn/go-cache"
+	"github.com/spf13/viper"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"gorm.io/gorm"
 )
@@ -30,6 +38,7 @@ import (
 var (
 	API_PREFIX      = internal.API_PREFIX
 	API_VERSION     = internal.API_VERSION
+	API_VERSION_V2  = internal.API_VERSION_V2
 	ADMINAPI_PREFIX = internal.ADMINAPI_PREFIX
 
 	SYSTEM_API_VERSION = internal.SYSTEM_API_VERSION
@@ -49,6 +58,7 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 		AppGroup:                   repository.NewAppGroupRepository(db),
 		AppServeApp:                repository.NewAppServeAppRepository(db),
 		CloudAccount:               repository.NewCloudAccountRepository(db),
+		CloudAccountPricing:        repository.NewCloudAccountPricingRepository(db),
 		StackTemplate:              repository.NewStackTemplateRepository(db),
 		SystemNotification:         repository.NewSystemNotificationRepository(db),
 		SystemNotificationTemplate: repository.NewSystemNotificationTemplateRepository(db),
@@ -61,35 +71,195 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 		PolicyTemplate:             repository.NewPolicyTemplateRepository(db),
 		Policy:                     repository.NewPolicyRepository(db),
 		Dashboard:                  repository.NewDashboardRepository(db),
+		ClusterUtilization:         repository.NewClusterUtilizationRepository(db),
+		OrganizationOnboarding:     repository.NewOrganizationOnboardingRepository(db),
+		ClusterAccessRequest:       repository.NewClusterAccessRequestRepository(db),
+		AlertIngestionToken:        repository.NewAlertIngestionTokenRepository(db),
+		AlertRoutingRule:           repository.NewAlertRoutingRuleRepository(db),
+		AlertChannel:               repository.NewAlertChannelRepository(db),
+		AlertSilence:               repository.NewAlertSilenceRepository(db),
+		EscalationPolicy:           repository.NewEscalationPolicyRepository(db),
+		DeploymentApproval:         repository.NewDeploymentApprovalRepository(db),
+		StackDefault:               repository.NewStackDefaultRepository(db),
+		CostAllocationTag:          repository.NewCostAllocationTagRepository(db),
+		CloudHealthEvent:           repository.NewCloudHealthEventRepository(db),
+		LmaEndpoint:                repository.NewLmaEndpointRepository(db),
+		ClusterHeartbeat:           repository.NewClusterHeartbeatRepository(db),
+		EncryptionKey:              repository.NewEncryptionKeyRepository(db),
+		Operation:                  repository.NewOperationRepository(db),
+		NotificationDigest:         repository.NewNotificationDigestRepository(db),
+		CustomChart:                repository.NewCustomChartRepository(db),
+		ChartSnapshot:              repository.NewChartSnapshotRepository(db),
+		UserSession:                repository.NewUserSessionRepository(db),
+		MaintenanceWindow:          repository.NewMaintenanceWindowRepository(db),
+		AuditRetention:             repository.NewAuditRetentionRepository(db),
+		AuditSink:                  repository.NewAuditSinkRepository(db),
+		PasswordPolicy:             repository.NewPasswordPolicyRepository(db),
+		OrganizationBranding:       repository.NewOrganizationBrandingRepository(db),
+		StackMonitoringEndpoint:    repository.NewStackMonitoringEndpointRepository(db),
+		RoleReassignment:           repository.NewRoleReassignmentRepository(db),
+		NamingPolicy:               repository.NewNamingPolicyRepository(db),
+		ClusterVersionAdvisory:     repository.NewClusterVersionAdvisoryRepository(db),
+		StackUpgrade:               repository.NewStackUpgradeRepository(db),
+		StackWebhook:               repository.NewStackWebhookRepository(db),
+		OrganizationQuota:          repository.NewOrganizationQuotaRepository(db),
+		Catalog:                    repository.NewCatalogRepository(db),
+		GitProvider:                repository.NewGitProviderRepository(db),
+		JobLease:                   repository.NewJobLeaseRepository(db),
+		ApiUsage:                   repository.NewApiUsageRepository(db),
 	}
+	// 조직 암호화 키가 없는 조직의 secret 은 master key 로 봉인한다.
+	repoFactory.SecretSealer = repository.NewSecretSealerFromConfig(repoFactory.EncryptionKey, kms.NewWithAwsSecret)
+	repoFactory.Secret = repository.NewSecretRepository(db, repoFactory.SecretSealer)
+
+	encryptionKey := usecase.NewEncryptionKeyUsecase(repoFactory)
+
+	// 기록되는 모든 감사 로그는 조직에 설정된 외부 sink 로도 전달한다.
+	repoFactory.Audit = audit.WithSinks(repoFactory, encryptionKey.Open)
+
+	// keycloak 관리 작업은 요청 경로와 관계없이 감사 로그로 남긴다.
+	kc = keycloak.NewAuditedKeycloak(kc, repoFactory)
+
+	thanosClients := usecase.NewThanosClientFactory(repoFactory, cache, encryptionKey)
+	cacheInvalidator := usecase.NewCacheInvalidator(cache)
+	operations := usecase.NewOperationUsecase(repoFactory, argoClient)
+	notificationDigest := usecase.NewNotificationDigestUsecase(repoFactory)
+	alertChannel := usecase.NewAlertChannelUsecase(repoFactory, encryptionKey)
+	escalationPolicy := usecase.NewEscalationPolicyUsecase(repoFactory, alertChannel)
+	alertRouting := usecase.NewAlertRoutingRuleUsecase(repoFactory, notificationDigest, alertChannel, escalationPolicy)
 
 	usecaseFactory := usecase.Usecase{
 		Auth:                       usecase.NewAuthUsecase(repoFactory, kc),
 		User:                       usecase.NewUserUsecase(repoFactory, kc),
-		Cluster:                    usecase.NewClusterUsecase(repoFactory, argoClient, cache),
-		Organization:               usecase.NewOrganizationUsecase(repoFactory, argoClient, kc),
-		AppGroup:                   usecase.NewAppGroupUsecase(repoFactory, argoClient),
-		AppServeApp:                usecase.NewAppServeAppUsecase(repoFactory, argoClient),
+		Cluster:                    usecase.NewClusterUsecase(repoFactory, argoClient, cacheInvalidator),
+		Organization:               usecase.NewOrganizationUsecase(repoFactory, argoClient, kc, cacheInvalidator),
+		AppGroup:                   usecase.NewAppGroupUsecase(repoFactory, argoClient, operations),
+		AppServeApp:                usecase.NewAppServeAppUsecase(repoFactory, argoClient, operations, thanosClients, encryptionKey),
 		CloudAccount:               usecase.NewCloudAccountUsecase(repoFactory, argoClient),
 		StackTemplate:              usecase.NewStackTemplateUsecase(repoFactory),
-		Dashboard:                  usecase.NewDashboardUsecase(repoFactory, cache),
-		SystemNotification:         usecase.NewSystemNotificationUsecase(repoFactory),
+		Dashboard:                  usecase.NewDashboardUsecase(repoFactory, cache, thanosClients),
+		SystemNotification:         usecase.NewSystemNotificationUsecase(repoFactory, notificationDigest, alertRouting),
 		SystemNotificationTemplate: usecase.NewSystemNotificationTemplateUsecase(repoFactory),
 		SystemNotificationRule:     usecase.NewSystemNotificationRuleUsecase(repoFactory),
-		Stack:                      usecase.NewStackUsecase(repoFactory, argoClient, usecase.NewDashboardUsecase(repoFactory, cache)),
+		Stack:                      usecase.NewStackUsecase(repoFactory, argoClient, usecase.NewDashboardUsecase(repoFactory, cache, thanosClients), cacheInvalidator, operations),
 		Project:                    usecase.NewProjectUsecase(repoFactory, kc, argoClient),
 		Audit:                      usecase.NewAuditUsecase(repoFactory),
 		Role:                       usecase.NewRoleUsecase(repoFactory, kc),
 		Permission:                 usecase.NewPermissionUsecase(repoFactory),
 		PolicyTemplate:             usecase.NewPolicyTemplateUsecase(repoFactory),
 		Policy:                     usecase.NewPolicyUsecase(repoFactory),
+		ClusterAccess:              usecase.NewClusterAccessUsecase(repoFactory),
+		AlertIngestionToken:        usecase.NewAlertIngestionTokenUsecase(repoFactory),
+		AlertRoutingRule:           alertRouting,
+		AlertChannel:               alertChannel,
+		AlertSilence:               usecase.NewAlertSilenceUsecase(repoFactory),
+		EscalationPolicy:           escalationPolicy,
+		DeploymentApproval:         usecase.NewDeploymentApprovalUsecase(repoFactory, argoClient, operations, encryptionKey),
+		CloudHealthEvent:           usecase.NewCloudHealthEventUsecase(repoFactory),
+		LmaEndpoint:                usecase.NewLmaEndpointUsecase(repoFactory, thanosClients, cacheInvalidator, encryptionKey),
+		ClusterHeartbeat:           usecase.NewClusterHeartbeatUsecase(repoFactory),
+		ClusterVersionAdvisory:     usecase.NewClusterVersionAdvisoryUsecase(repoFactory),
+		EncryptionKey:              encryptionKey,
+		Operation:                  operations,
+		NotificationDigest:         notificationDigest,
+		UserSession:                usecase.NewUserSessionUsecase(repoFactory, kc),
+		MaintenanceWindow:          usecase.NewMaintenanceWindowUsecase(repoFactory),
+		AuditSink:                  usecase.NewAuditSinkUsecase(repoFactory, encryptionKey),
+		StackMonitoringEndpoint:    usecase.NewStackMonitoringEndpointUsecase(repoFactory),
+		StackWebhook:               usecase.NewStackWebhookUsecase(repoFactory, encryptionKey),
+		Catalog:                    usecase.NewCatalogUsecase(repoFactory, operations),
+		GitProvider:                usecase.NewGitProviderUsecase(repoFactory, encryptionKey),
+	}
+	// manifest 는 다른 usecase 를 사용하여 리소스를 반영한다.
+	usecaseFactory.Manifest = usecase.NewManifestUsecase(repoFactory, usecaseFactory)
+	// 봉인된 kubeconfig 는 조회 시 복호화한다.
+	kubernetes.SetSecretDecoder(usecaseFactory.EncryptionKey.Open)
+	mail.SetBrandingResolver(usecaseFactory.Organization.GetMailBranding)
+
+	// background jobs
+	go runPeriodically(context.Background(), repoFactory.JobLease, "downsample-utilization", 24*time.Hour, func(ctx context.Context) error {
+		return usecaseFactory.Dashboard.DownsampleUtilization(ctx, time.Now().AddDate(0, 0, -1))
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "expire-cluster-access", time.Minute, func(ctx context.Context) error {
+		return usecaseFactory.ClusterAccess.ExpireGrants(ctx)
+	})
+	// 폴링 주기보다 넓은 구간을 조회하고 중복 event 는 usecase 에서 무시한다.
+	go runPeriodically(context.Background(), repoFactory.JobLease, "poll-cloud-health-events", 10*time.Minute, func(ctx context.Context) error {
+		return usecaseFactory.CloudHealthEvent.Poll(ctx, time.Now().Add(-30*time.Minute))
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "check-cloud-account-credentials", time.Hour, func(ctx context.Context) error {
+		return usecaseFactory.CloudAccount.CheckCredentials(ctx)
+	})
+	// 확인 결과는 replica 의 cache 에 저장하므로 모든 replica 에서 실행한다.
+	go runPeriodicallyOnEveryReplica(context.Background(), "check-lma-endpoints", time.Minute, func(ctx context.Context) error {
+		return usecaseFactory.LmaEndpoint.CheckHealth(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "check-cluster-heartbeats", time.Minute, func(ctx context.Context) error {
+		return usecaseFactory.ClusterHeartbeat.Check(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "check-kubernetes-eol", 6*time.Hour, func(ctx context.Context) error {
+		return usecaseFactory.ClusterVersionAdvisory.Check(ctx)
+	})
+	// 사용자 kubeconfig 는 workflow 가 저장하므로 저장된 뒤에 봉인한다.
+	go runPeriodically(context.Background(), repoFactory.JobLease, "seal-kubeconfigs", 10*time.Minute, func(ctx context.Context) error {
+		return usecaseFactory.EncryptionKey.SealKubeconfigs(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "dispatch-operations", 30*time.Second, func(ctx context.Context) error {
+		return usecaseFactory.Operation.Dispatch(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "sync-stack-upgrades", time.Minute, func(ctx context.Context) error {
+		return usecaseFactory.Stack.SyncUpgrades(ctx)
+	})
+	// 클러스터, 앱그룹의 상태는 workflow 가 갱신하므로 주기적으로 비교해서 lifecycle event 를 보낸다.
+	go runPeriodically(context.Background(), repoFactory.JobLease, "dispatch-stack-lifecycle-events", 30*time.Second, func(ctx context.Context) error {
+		return usecaseFactory.StackWebhook.Dispatch(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "send-notification-digests", 5*time.Minute, func(ctx context.Context) error {
+		return usecaseFactory.NotificationDigest.SendDigests(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "escalate-alerts", time.Minute, func(ctx context.Context) error {
+		return usecaseFactory.EscalationPolicy.Escalate(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "verify-app-deployments", time.Minute, func(ctx context.Context) error {
+		return usecaseFactory.AppServeApp.VerifyDeployments(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "purge-chart-snapshots", time.Hour, func(ctx context.Context) error {
+		return usecaseFactory.Dashboard.PurgeExpiredChartSnapshots(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "probe-stack-monitoring-endpoints", 15*time.Second, func(ctx context.Context) error {
+		return usecaseFactory.StackMonitoringEndpoint.Probe(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "purge-stack-monitoring-probes", time.Hour, func(ctx context.Context) error {
+		return usecaseFactory.StackMonitoringEndpoint.PurgeProbes(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "purge-user-sessions", time.Hour, func(ctx context.Context) error {
+		return usecaseFactory.UserSession.PurgeInactive(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "purge-api-usages", time.Hour, func(ctx context.Context) error {
+		return usecaseFactory.Organization.PurgeApiUsages(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "apply-audit-retention", 24*time.Hour, func(ctx context.Context) error {
+		return usecaseFactory.Audit.ApplyRetention(ctx)
+	})
+	go runPeriodically(context.Background(), repoFactory.JobLease, "sync-helm-repositories", time.Hour, func(ctx context.Context) error {
+		return usecaseFactory.Catalog.SyncHelmRepositories(ctx)
+	})
+	// 배포 직후 첫 대시보드 조회가 cold start 가 되지 않도록 캐시를 미리 채운다.
+	if viper.GetBool("cache-warmup-on-startup") {
+		go runOnce(context.Background(), "warmup-dashboard-caches", func(ctx context.Context) error {
+			_, err := usecaseFactory.Dashboard.WarmupCaches(ctx, "")
+			return err
+		})
 	}
 
 	customMiddleware := internalMiddleware.NewMiddleware(
 		authenticator.NewAuthenticator(authKeycloak.NewKeycloakAuthenticator(kc), repoFactory, authCustom.NewCustomAuthenticator(repoFactory)),
 		authorizer.NewDefaultAuthorization(repoFactory),
 		requestRecoder.NewDefaultRequestRecoder(),
-		audit.NewDefaultAudit(repoFactory))
+		audit.NewDefaultAudit(repoFactory),
+		filter.NewDefaultResponseFilter(repoFactory),
+		ratelimit.NewDefaultRateLimit(repoFactory))
+	ingestionMiddleware := ingestion.NewDefaultIngestion(repoFactory)
 
 	r.Use(logging.LoggingMiddleware)
 
@@ -99,7 +269,8 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	authHandler := delivery.NewAuthHandler(usecaseFactory)
 	r.HandleFunc(API_PREFIX+API_VERSION+"/auth/login", authHandler.Login).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/auth/logout", customMiddleware.Handle(internalApi.Logout, http.HandlerFunc(authHandler.Logout))).Methods(http.MethodPost)
-	r.Handle(API_PREFIX+API_VERSION+"/auth/refresh", customMiddleware.Handle(internalApi.RefreshToken, http.HandlerFunc(authHandler.RefreshToken))).Methods(http.MethodPost)
+	// access token 이 만료된 뒤에 호출하므로 refresh token 으로만 인증한다.
+	r.HandleFunc(API_PREFIX+API_VERSION+"/auth/refresh", authHandler.RefreshToken).Methods(http.MethodPost)
 	r.HandleFunc(API_PREFIX+API_VERSION+"/auth/find-id/verification", authHandler.FindId).Methods(http.MethodPost)
 	r.HandleFunc(API_PREFIX+API_VERSION+"/auth/find-password/verification", authHandler.FindPassword).Methods(http.MethodPost)
 	r.HandleFunc(API_PREFIX+API_VERSION+"/auth/find-id/code", authHandler.VerifyIdentityForLostId).Methods(http.MethodPost)
@@ -108,6 +279,9 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	//r.HandleFunc(API_PREFIX+API_VERSION+"/cookie-test", authHandler.CookieTest).Methods(http.MethodPost)
 	//r.HandleFunc(API_PREFIX+API_VERSION+"/auth/callback", authHandler.CookieTestCallback).Methods(http.MethodGet)
 
+	errorCodeHandler := delivery.NewErrorCodeHandler()
+	r.HandleFunc(API_PREFIX+API_VERSION+"/error-codes", errorCodeHandler.GetErrorCodes).Methods(http.MethodGet)
+
 	userHandler := delivery.NewUserHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users", customMiddleware.Handle(internalApi.CreateUser, http.HandlerFunc(userHandler.Create))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users", customMiddleware.Handle(internalApi.ListUser, http.HandlerFunc(userHandler.List))).Methods(http.MethodGet)
@@ -115,15 +289,26 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users", customMiddleware.Handle(internalApi.UpdateUsers, http.HandlerFunc(userHandler.UpdateUsers))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/{accountId}", customMiddleware.Handle(internalApi.UpdateUser, http.HandlerFunc(userHandler.Update))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/{accountId}/reset-password", customMiddleware.Handle(internalApi.ResetPassword, http.HandlerFunc(userHandler.ResetPassword))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/{accountId}/sessions", customMiddleware.Handle(internalApi.GetUserSessions, http.HandlerFunc(userHandler.GetUserSessions))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/sessions/{sessionId}", customMiddleware.Handle(internalApi.DeleteUserSession, http.HandlerFunc(userHandler.DeleteUserSession))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/{accountId}", customMiddleware.Handle(internalApi.DeleteUser, http.HandlerFunc(userHandler.Delete))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/account-id/{accountId}/existence", customMiddleware.Handle(internalApi.CheckId, http.HandlerFunc(userHandler.CheckId))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/email/{email}/existence", customMiddleware.Handle(internalApi.CheckEmail, http.HandlerFunc(userHandler.CheckEmail))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/password-policy", customMiddleware.Handle(internalApi.GetPasswordPolicy, http.HandlerFunc(userHandler.GetPasswordPolicy))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/password-policy", customMiddleware.Handle(internalApi.UpdatePasswordPolicy, http.HandlerFunc(userHandler.UpdatePasswordPolicy))).Methods(http.MethodPut)
 
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile", customMiddleware.Handle(internalApi.GetMyProfile, http.HandlerFunc(userHandler.GetMyProfile))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile", customMiddleware.Handle(internalApi.UpdateMyProfile, http.HandlerFunc(userHandler.UpdateMyProfile))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/password", customMiddleware.Handle(internalApi.UpdateMyPassword, http.HandlerFunc(userHandler.UpdateMyPassword))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/next-password-change", customMiddleware.Handle(internalApi.RenewPasswordExpiredDate, http.HandlerFunc(userHandler.RenewPasswordExpiredDate))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile", customMiddleware.Handle(internalApi.DeleteMyProfile, http.HandlerFunc(userHandler.DeleteMyProfile))).Methods(http.MethodDelete)
+
+	notificationDigestHandler := delivery.NewNotificationDigestHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/notification-digest", customMiddleware.Handle(internalApi.GetMyNotificationDigestSettings, http.HandlerFunc(notificationDigestHandler.GetMyNotificationDigestSettings))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/notification-digest", customMiddleware.Handle(internalApi.UpdateMyNotificationDigestSettings, http.HandlerFunc(notificationDigestHandler.UpdateMyNotificationDigestSettings))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/otp", customMiddleware.Handle(internalApi.EnrollMyOtp, http.HandlerFunc(userHandler.EnrollMyOtp))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/otp", customMiddleware.Handle(internalApi.ActivateMyOtp, http.HandlerFunc(userHandler.ActivateMyOtp))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/my-profile/otp", customMiddleware.Handle(internalApi.DisableMyOtp, http.HandlerFunc(userHandler.DisableMyOtp))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/users/{accountId}/permissions", customMiddleware.Handle(internalApi.GetPermissionsByAccountId, http.HandlerFunc(userHandler.GetPermissionsByAccountId))).Methods(http.MethodGet)
 
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/users", customMiddleware.Handle(internalApi.Admin_CreateUser, http.HandlerFunc(userHandler.Admin_Create))).Methods(http.MethodPost)
@@ -136,6 +321,41 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations", customMiddleware.Handle(internalApi.GetOrganizations, http.HandlerFunc(organizationHandler.GetOrganizations))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}", customMiddleware.Handle(internalApi.GetOrganization, http.HandlerFunc(organizationHandler.GetOrganization))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}", customMiddleware.Handle(internalApi.UpdateOrganization, http.HandlerFunc(organizationHandler.UpdateOrganization))).Methods(http.MethodPut)
+	lmaEndpointHandler := delivery.NewLmaEndpointHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/lma-endpoints", customMiddleware.Handle(internalApi.GetLmaEndpoints, http.HandlerFunc(lmaEndpointHandler.GetLmaEndpoints))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/lma-endpoints", customMiddleware.Handle(internalApi.CreateLmaEndpoint, http.HandlerFunc(lmaEndpointHandler.CreateLmaEndpoint))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/lma-endpoints/{lmaEndpointId}", customMiddleware.Handle(internalApi.UpdateLmaEndpoint, http.HandlerFunc(lmaEndpointHandler.UpdateLmaEndpoint))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/lma-endpoints/{lmaEndpointId}", customMiddleware.Handle(internalApi.DeleteLmaEndpoint, http.HandlerFunc(lmaEndpointHandler.DeleteLmaEndpoint))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/lma-auth", customMiddleware.Handle(internalApi.GetLmaAuth, http.HandlerFunc(lmaEndpointHandler.GetLmaAuth))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/lma-auth", customMiddleware.Handle(internalApi.UpdateLmaAuth, http.HandlerFunc(lmaEndpointHandler.UpdateLmaAuth))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/lma-discovery", customMiddleware.Handle(internalApi.GetLmaDiscovery, http.HandlerFunc(lmaEndpointHandler.GetLmaDiscovery))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/lma-discovery", customMiddleware.Handle(internalApi.UpdateLmaDiscovery, http.HandlerFunc(lmaEndpointHandler.UpdateLmaDiscovery))).Methods(http.MethodPut)
+
+	encryptionKeyHandler := delivery.NewEncryptionKeyHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/encryption-keys", customMiddleware.Handle(internalApi.GetEncryptionKeys, http.HandlerFunc(encryptionKeyHandler.GetEncryptionKeys))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/encryption-keys", customMiddleware.Handle(internalApi.CreateEncryptionKey, http.HandlerFunc(encryptionKeyHandler.CreateEncryptionKey))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/encryption-keys/rotate", customMiddleware.Handle(internalApi.RotateEncryptionKey, http.HandlerFunc(encryptionKeyHandler.RotateEncryptionKey))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/encryption-keys/{encryptionKeyId}/revoke", customMiddleware.Handle(internalApi.RevokeEncryptionKey, http.HandlerFunc(encryptionKeyHandler.RevokeEncryptionKey))).Methods(http.MethodPost)
+
+	operationHandler := delivery.NewOperationHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/operations", customMiddleware.Handle(internalApi.GetOperations, http.HandlerFunc(operationHandler.GetOperations))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/operations/{operationId}/cancel", customMiddleware.Handle(internalApi.CancelOperation, http.HandlerFunc(operationHandler.CancelOperation))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/tasks/{taskId}", customMiddleware.Handle(internalApi.GetTask, http.HandlerFunc(operationHandler.GetTask))).Methods(http.MethodGet)
+
+	manifestHandler := delivery.NewManifestHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/manifests", customMiddleware.Handle(internalApi.ExportManifests, http.HandlerFunc(manifestHandler.ExportManifests))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/manifests/apply", customMiddleware.Handle(internalApi.ApplyManifests, http.HandlerFunc(manifestHandler.ApplyManifests))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/onboarding", customMiddleware.Handle(internalApi.GetOrganizationOnboarding, http.HandlerFunc(organizationHandler.GetOrganizationOnboarding))).Methods(http.MethodGet)
+	// 로그인, 초대 페이지는 인증 전에 표시하므로 브랜드 정보는 인증 없이 조회한다.
+	r.HandleFunc(API_PREFIX+API_VERSION+"/auth/organizations/{organizationId}/branding", organizationHandler.GetPublicOrganizationBranding).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/settings/branding", customMiddleware.Handle(internalApi.GetOrganizationBranding, http.HandlerFunc(organizationHandler.GetOrganizationBranding))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/settings/branding", customMiddleware.Handle(internalApi.UpdateOrganizationBranding, http.HandlerFunc(organizationHandler.UpdateOrganizationBranding))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/settings/naming-policies", customMiddleware.Handle(internalApi.GetNamingPolicies, http.HandlerFunc(organizationHandler.GetNamingPolicies))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/settings/naming-policies/{resourceType}", customMiddleware.Handle(internalApi.UpdateNamingPolicy, http.HandlerFunc(organizationHandler.UpdateNamingPolicy))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/quotas", customMiddleware.Handle(internalApi.GetOrganizationQuota, http.HandlerFunc(organizationHandler.GetOrganizationQuota))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/quotas", customMiddleware.Handle(internalApi.Admin_GetOrganizationQuota, http.HandlerFunc(organizationHandler.GetOrganizationQuota))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/quotas", customMiddleware.Handle(internalApi.Admin_UpdateOrganizationQuota, http.HandlerFunc(organizationHandler.Admin_UpdateOrganizationQuota))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/usage", customMiddleware.Handle(internalApi.GetOrganizationUsage, http.HandlerFunc(organizationHandler.GetOrganizationUsage))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/primary-cluster", customMiddleware.Handle(internalApi.UpdatePrimaryCluster, http.HandlerFunc(organizationHandler.UpdatePrimaryCluster))).Methods(http.MethodPatch)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/name/{name}/existence", customMiddleware.Handle(internalApi.CheckOrganizationName, http.HandlerFunc(organizationHandler.CheckOrganizationName))).Methods(http.MethodGet)
 
@@ -150,6 +370,12 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/clusters/{clusterId}/bootstrap-kubeconfig", customMiddleware.Handle(internalApi.CreateBootstrapKubeconfig, http.HandlerFunc(clusterHandler.CreateBootstrapKubeconfig))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/clusters/{clusterId}/bootstrap-kubeconfig", customMiddleware.Handle(internalApi.GetBootstrapKubeconfig, http.HandlerFunc(clusterHandler.GetBootstrapKubeconfig))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/clusters/{clusterId}/nodes", customMiddleware.Handle(internalApi.GetNodes, http.HandlerFunc(clusterHandler.GetNodes))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/pods", customMiddleware.Handle(internalApi.SearchPods, http.HandlerFunc(clusterHandler.SearchPods))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/clusters/{clusterId}/namespaces", customMiddleware.Handle(internalApi.GetClusterNamespaces, http.HandlerFunc(clusterHandler.GetClusterNamespaces))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/clusters/{clusterId}/workloads", customMiddleware.Handle(internalApi.GetClusterWorkloads, http.HandlerFunc(clusterHandler.GetClusterWorkloads))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/clusters/{clusterId}/pods", customMiddleware.Handle(internalApi.GetClusterPods, http.HandlerFunc(clusterHandler.GetClusterPods))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/clusters/{clusterId}/namespaces/{namespace}/pods/{podName}/logs", customMiddleware.Handle(internalApi.GetPodLogs, http.HandlerFunc(clusterHandler.GetPodLogs))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/clusters/{clusterId}/kubeconfig", customMiddleware.Handle(internalApi.GetClusterKubeconfig, http.HandlerFunc(clusterHandler.GetClusterKubeconfig))).Methods(http.MethodGet)
 
 	appGroupHandler := delivery.NewAppGroupHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/app-groups", customMiddleware.Handle(internalApi.CreateAppgroup, http.HandlerFunc(appGroupHandler.CreateAppGroup))).Methods(http.MethodPost)
@@ -162,6 +388,7 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	appServeAppHandler := delivery.NewAppServeAppHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps", customMiddleware.Handle(internalApi.CreateAppServeApp, http.HandlerFunc(appServeAppHandler.CreateAppServeApp))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps", customMiddleware.Handle(internalApi.GetAppServeApps, http.HandlerFunc(appServeAppHandler.GetAppServeApps))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/app-serve-apps/summary", customMiddleware.Handle(internalApi.GetAppServeAppSummary, http.HandlerFunc(appServeAppHandler.GetAppServeAppSummary))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/count", customMiddleware.Handle(internalApi.GetNumOfAppsOnStack, http.HandlerFunc(appServeAppHandler.GetNumOfAppsOnStack))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/tasks", customMiddleware.Handle(internalApi.GetAppServeAppTasksByAppId, http.HandlerFunc(appServeAppHandler.GetAppServeAppTasksByAppId))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/tasks/{taskId}", customMiddleware.Handle(internalApi.GetAppServeAppTaskDetail, http.HandlerFunc(appServeAppHandler.GetAppServeAppTaskDetail))).Methods(http.MethodGet)
@@ -173,6 +400,17 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/status", customMiddleware.Handle(internalApi.UpdateAppServeAppStatus, http.HandlerFunc(appServeAppHandler.UpdateAppServeAppStatus))).Methods(http.MethodPatch)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/endpoint", customMiddleware.Handle(internalApi.UpdateAppServeAppEndpoint, http.HandlerFunc(appServeAppHandler.UpdateAppServeAppEndpoint))).Methods(http.MethodPatch)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/rollback", customMiddleware.Handle(internalApi.RollbackAppServeApp, http.HandlerFunc(appServeAppHandler.RollbackAppServeApp))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/metrics", customMiddleware.Handle(internalApi.GetAppServeAppMetrics, http.HandlerFunc(appServeAppHandler.GetAppServeAppMetrics))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/configs", customMiddleware.Handle(internalApi.GetAppServeAppConfigs, http.HandlerFunc(appServeAppHandler.GetAppServeAppConfigs))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/configs", customMiddleware.Handle(internalApi.UpdateAppServeAppConfig, http.HandlerFunc(appServeAppHandler.UpdateAppServeAppConfig))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/configs/diff", customMiddleware.Handle(internalApi.GetAppServeAppConfigDiff, http.HandlerFunc(appServeAppHandler.GetAppServeAppConfigDiff))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/configs/{revision}", customMiddleware.Handle(internalApi.GetAppServeAppConfig, http.HandlerFunc(appServeAppHandler.GetAppServeAppConfig))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/git-source", customMiddleware.Handle(internalApi.GetAppServeAppGitSource, http.HandlerFunc(appServeAppHandler.GetAppServeAppGitSource))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/git-source", customMiddleware.Handle(internalApi.UpdateAppServeAppGitSource, http.HandlerFunc(appServeAppHandler.UpdateAppServeAppGitSource))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/git-source", customMiddleware.Handle(internalApi.DeleteAppServeAppGitSource, http.HandlerFunc(appServeAppHandler.DeleteAppServeAppGitSource))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/app-serve-apps/{appId}/git-source/build", customMiddleware.Handle(internalApi.BuildAppServeApp, http.HandlerFunc(appServeAppHandler.BuildAppServeApp))).Methods(http.MethodPost)
+	// provider 의 push webhook 은 인증 없이 받고 앱의 webhook secret 으로 검증한다.
+	r.HandleFunc(SYSTEM_API_PREFIX+SYSTEM_API_VERSION+"/git-webhooks/app-serve-apps/{appId}", appServeAppHandler.ReceiveGitWebhook).Methods(http.MethodPost)
 
 	cloudAccountHandler := delivery.NewCloudAccountHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts", customMiddleware.Handle(internalApi.GetCloudAccounts, http.HandlerFunc(cloudAccountHandler.GetCloudAccounts))).Methods(http.MethodGet)
@@ -183,7 +421,11 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}", customMiddleware.Handle(internalApi.UpdateCloudAccount, http.HandlerFunc(cloudAccountHandler.UpdateCloudAccount))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}", customMiddleware.Handle(internalApi.DeleteCloudAccount, http.HandlerFunc(cloudAccountHandler.DeleteCloudAccount))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/error", customMiddleware.Handle(internalApi.DeleteForceCloudAccount, http.HandlerFunc(cloudAccountHandler.DeleteForceCloudAccount))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/pricing", customMiddleware.Handle(internalApi.GetCloudAccountPricing, http.HandlerFunc(cloudAccountHandler.GetCloudAccountPricing))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/pricing", customMiddleware.Handle(internalApi.UpdateCloudAccountPricing, http.HandlerFunc(cloudAccountHandler.UpdateCloudAccountPricing))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/pricing", customMiddleware.Handle(internalApi.DeleteCloudAccountPricing, http.HandlerFunc(cloudAccountHandler.DeleteCloudAccountPricing))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}/quotas", customMiddleware.Handle(internalApi.GetResourceQuota, http.HandlerFunc(cloudAccountHandler.GetResourceQuota))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-accounts/{cloudAccountId}:verify", customMiddleware.Handle(internalApi.VerifyCloudAccount, http.HandlerFunc(cloudAccountHandler.VerifyCloudAccount))).Methods(http.MethodPost)
 
 	stackTemplateHandler := delivery.NewStackTemplateHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/stack-templates", customMiddleware.Handle(internalApi.Admin_GetStackTemplates, http.HandlerFunc(stackTemplateHandler.GetStackTemplates))).Methods(http.MethodGet)
@@ -203,8 +445,19 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	dashboardHandler := delivery.NewDashboardHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/charts", customMiddleware.Handle(internalApi.GetChartsDashboard, http.HandlerFunc(dashboardHandler.GetCharts))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/charts/{chartType}", customMiddleware.Handle(internalApi.GetChartDashboard, http.HandlerFunc(dashboardHandler.GetChart))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboard/stream", customMiddleware.Handle(internalApi.StreamChartsDashboard, http.HandlerFunc(dashboardHandler.StreamCharts))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboard/health", customMiddleware.Handle(internalApi.GetHealthDashboard, http.HandlerFunc(dashboardHandler.GetHealth))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboard/costs", customMiddleware.Handle(internalApi.GetCostsDashboard, http.HandlerFunc(dashboardHandler.GetCosts))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/stacks", customMiddleware.Handle(internalApi.GetStacksDashboard, http.HandlerFunc(dashboardHandler.GetStacks))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/cluster-events", customMiddleware.Handle(internalApi.GetClusterEventsDashboard, http.HandlerFunc(dashboardHandler.GetClusterEvents))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/stacks/{stackId}/nodes", customMiddleware.Handle(internalApi.GetStackNodesDashboard, http.HandlerFunc(dashboardHandler.GetStackNodes))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/stacks/{stackId}/charts", customMiddleware.Handle(internalApi.GetStackChartsDashboard, http.HandlerFunc(dashboardHandler.GetStackCharts))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/stacks/{stackId}/charts/{chartType}", customMiddleware.Handle(internalApi.GetStackChartDashboard, http.HandlerFunc(dashboardHandler.GetStackChart))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/storages", customMiddleware.Handle(internalApi.GetStoragesDashboard, http.HandlerFunc(dashboardHandler.GetStorages))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/network-policies", customMiddleware.Handle(internalApi.GetNetworkPoliciesDashboard, http.HandlerFunc(dashboardHandler.GetNetworkPolicies))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/identity-status", customMiddleware.Handle(internalApi.GetIdentityStatusDashboard, http.HandlerFunc(dashboardHandler.GetIdentityStatus))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/resources", customMiddleware.Handle(internalApi.GetResourcesDashboard, http.HandlerFunc(dashboardHandler.GetResources))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION_V2+"/organizations/{organizationId}/dashboards/widgets/resources", customMiddleware.Handle(internalApi.GetResourcesDashboardV2, http.HandlerFunc(dashboardHandler.GetResourcesV2))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/policy-status", customMiddleware.Handle(internalApi.GetPolicyStatusDashboard, http.HandlerFunc(dashboardHandler.GetPolicyStatus))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/policy-update", customMiddleware.Handle(internalApi.GetPolicyUpdateDashboard, http.HandlerFunc(dashboardHandler.GetPolicyUpdate))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/policy-enforcement", customMiddleware.Handle(internalApi.GetPolicyEnforcementDashboard, http.HandlerFunc(dashboardHandler.GetPolicyEnforcement))).Methods(http.MethodGet)
@@ -213,6 +466,19 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/policy-statistics", customMiddleware.Handle(internalApi.GetPolicyStatisticsDashboard, http.HandlerFunc(dashboardHandler.GetPolicyStatistics))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/workload", customMiddleware.Handle(internalApi.GetWorkloadDashboard, http.HandlerFunc(dashboardHandler.GetWorkload))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/policy-violation-top5", customMiddleware.Handle(internalApi.GetPolicyViolationTop5Dashboard, http.HandlerFunc(dashboardHandler.GetPolicyViolationTop5))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/policy-enforcement-status", customMiddleware.Handle(internalApi.GetPolicyEnforcementStatusDashboard, http.HandlerFunc(dashboardHandler.GetPolicyEnforcementStatus))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/custom-charts/{customChartId}", customMiddleware.Handle(internalApi.GetCustomChartDataDashboard, http.HandlerFunc(dashboardHandler.GetCustomChartData))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/custom-charts", customMiddleware.Handle(internalApi.CreateCustomChartDashboard, http.HandlerFunc(dashboardHandler.CreateCustomChart))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/custom-charts", customMiddleware.Handle(internalApi.GetCustomChartsDashboard, http.HandlerFunc(dashboardHandler.GetCustomCharts))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/custom-charts/{customChartId}", customMiddleware.Handle(internalApi.GetCustomChartDashboard, http.HandlerFunc(dashboardHandler.GetCustomChart))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/custom-charts/{customChartId}", customMiddleware.Handle(internalApi.UpdateCustomChartDashboard, http.HandlerFunc(dashboardHandler.UpdateCustomChart))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/custom-charts/{customChartId}", customMiddleware.Handle(internalApi.DeleteCustomChartDashboard, http.HandlerFunc(dashboardHandler.DeleteCustomChart))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/chart-snapshots", customMiddleware.Handle(internalApi.CreateChartSnapshotDashboard, http.HandlerFunc(dashboardHandler.CreateChartSnapshot))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/chart-snapshots", customMiddleware.Handle(internalApi.GetChartSnapshotsDashboard, http.HandlerFunc(dashboardHandler.GetChartSnapshots))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/chart-snapshots/{chartSnapshotId}", customMiddleware.Handle(internalApi.DeleteChartSnapshotDashboard, http.HandlerFunc(dashboardHandler.DeleteChartSnapshot))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/admin/dashboards/caches/warmup", customMiddleware.Handle(internalApi.Admin_WarmupDashboardCaches, http.HandlerFunc(dashboardHandler.Admin_WarmupCaches))).Methods(http.MethodPost)
+	// 공유 링크는 인증 없이 저장된 snapshot 만 조회한다.
+	r.HandleFunc(API_PREFIX+API_VERSION+"/shared/chart-snapshots/{shareToken}", dashboardHandler.GetSharedChartSnapshot).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards", customMiddleware.Handle(internalApi.CreateDashboard, http.HandlerFunc(dashboardHandler.CreateDashboard))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/{dashboardKey}", customMiddleware.Handle(internalApi.GetDashboard, http.HandlerFunc(dashboardHandler.GetDashboard))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/{dashboardKey}", customMiddleware.Handle(internalApi.UpdateDashboard, http.HandlerFunc(dashboardHandler.UpdateDashboard))).Methods(http.MethodPut)
@@ -238,8 +504,51 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules/{systemNotificationRuleId}", customMiddleware.Handle(internalApi.UpdateSystemNotificationRule, http.HandlerFunc(systemNotificationRuleHandler.UpdateSystemNotificationRule))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notification-rules/{systemNotificationRuleId}", customMiddleware.Handle(internalApi.DeleteSystemNotificationRule, http.HandlerFunc(systemNotificationRuleHandler.DeleteSystemNotificationRule))).Methods(http.MethodDelete)
 
+	alertIngestionTokenHandler := delivery.NewAlertIngestionTokenHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-ingestion-tokens", customMiddleware.Handle(internalApi.CreateAlertIngestionToken, http.HandlerFunc(alertIngestionTokenHandler.CreateAlertIngestionToken))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-ingestion-tokens", customMiddleware.Handle(internalApi.GetAlertIngestionTokens, http.HandlerFunc(alertIngestionTokenHandler.GetAlertIngestionTokens))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-ingestion-tokens/{tokenId}/rotate", customMiddleware.Handle(internalApi.RotateAlertIngestionToken, http.HandlerFunc(alertIngestionTokenHandler.RotateAlertIngestionToken))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-ingestion-tokens/{tokenId}", customMiddleware.Handle(internalApi.RevokeAlertIngestionToken, http.HandlerFunc(alertIngestionTokenHandler.RevokeAlertIngestionToken))).Methods(http.MethodDelete)
+
+	alertRoutingRuleHandler := delivery.NewAlertRoutingRuleHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-routing-rules", customMiddleware.Handle(internalApi.CreateAlertRoutingRule, http.HandlerFunc(alertRoutingRuleHandler.CreateAlertRoutingRule))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-routing-rules", customMiddleware.Handle(internalApi.GetAlertRoutingRules, http.HandlerFunc(alertRoutingRuleHandler.GetAlertRoutingRules))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-routing-rules/test", customMiddleware.Handle(internalApi.TestAlertRoutingRules, http.HandlerFunc(alertRoutingRuleHandler.TestAlertRoutingRules))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-routing-rules/{alertRoutingRuleId}", customMiddleware.Handle(internalApi.GetAlertRoutingRule, http.HandlerFunc(alertRoutingRuleHandler.GetAlertRoutingRule))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-routing-rules/{alertRoutingRuleId}", customMiddleware.Handle(internalApi.UpdateAlertRoutingRule, http.HandlerFunc(alertRoutingRuleHandler.UpdateAlertRoutingRule))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-routing-rules/{alertRoutingRuleId}", customMiddleware.Handle(internalApi.DeleteAlertRoutingRule, http.HandlerFunc(alertRoutingRuleHandler.DeleteAlertRoutingRule))).Methods(http.MethodDelete)
+
+	alertChannelHandler := delivery.NewAlertChannelHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-channels", customMiddleware.Handle(internalApi.CreateAlertChannel, http.HandlerFunc(alertChannelHandler.CreateAlertChannel))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-channels", customMiddleware.Handle(internalApi.GetAlertChannels, http.HandlerFunc(alertChannelHandler.GetAlertChannels))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-channels/{alertChannelId}", customMiddleware.Handle(internalApi.GetAlertChannel, http.HandlerFunc(alertChannelHandler.GetAlertChannel))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-channels/{alertChannelId}", customMiddleware.Handle(internalApi.UpdateAlertChannel, http.HandlerFunc(alertChannelHandler.UpdateAlertChannel))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-channels/{alertChannelId}", customMiddleware.Handle(internalApi.DeleteAlertChannel, http.HandlerFunc(alertChannelHandler.DeleteAlertChannel))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-channels/{alertChannelId}/test", customMiddleware.Handle(internalApi.TestAlertChannel, http.HandlerFunc(alertChannelHandler.TestAlertChannel))).Methods(http.MethodPost)
+
+	alertSilenceHandler := delivery.NewAlertSilenceHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-silences", customMiddleware.Handle(internalApi.CreateAlertSilence, http.HandlerFunc(alertSilenceHandler.CreateAlertSilence))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-silences", customMiddleware.Handle(internalApi.GetAlertSilences, http.HandlerFunc(alertSilenceHandler.GetAlertSilences))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-silences/{alertSilenceId}", customMiddleware.Handle(internalApi.GetAlertSilence, http.HandlerFunc(alertSilenceHandler.GetAlertSilence))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-silences/{alertSilenceId}", customMiddleware.Handle(internalApi.UpdateAlertSilence, http.HandlerFunc(alertSilenceHandler.UpdateAlertSilence))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/alert-silences/{alertSilenceId}", customMiddleware.Handle(internalApi.DeleteAlertSilence, http.HandlerFunc(alertSilenceHandler.DeleteAlertSilence))).Methods(http.MethodDelete)
+
+	escalationPolicyHandler := delivery.NewEscalationPolicyHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/escalation-policies", customMiddleware.Handle(internalApi.CreateEscalationPolicy, http.HandlerFunc(escalationPolicyHandler.CreateEscalationPolicy))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/escalation-policies", customMiddleware.Handle(internalApi.GetEscalationPolicies, http.HandlerFunc(escalationPolicyHandler.GetEscalationPolicies))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/escalation-policies/{escalationPolicyId}", customMiddleware.Handle(internalApi.GetEscalationPolicy, http.HandlerFunc(escalationPolicyHandler.GetEscalationPolicy))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/escalation-policies/{escalationPolicyId}", customMiddleware.Handle(internalApi.UpdateEscalationPolicy, http.HandlerFunc(escalationPolicyHandler.UpdateEscalationPolicy))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/escalation-policies/{escalationPolicyId}", customMiddleware.Handle(internalApi.DeleteEscalationPolicy, http.HandlerFunc(escalationPolicyHandler.DeleteEscalationPolicy))).Methods(http.MethodDelete)
+
+	cloudHealthEventHandler := delivery.NewCloudHealthEventHandler(usecaseFactory)
+	clusterHeartbeatHandler := delivery.NewClusterHeartbeatHandler(usecaseFactory)
+	r.Handle(SYSTEM_API_PREFIX+SYSTEM_API_VERSION+"/clusters/{clusterId}/heartbeat", ingestionMiddleware.WithIngestionToken(http.HandlerFunc(clusterHeartbeatHandler.CreateClusterHeartbeat))).Methods(http.MethodPost)
+
+	r.Handle(SYSTEM_API_PREFIX+SYSTEM_API_VERSION+"/cloud-health-events", ingestionMiddleware.WithIngestionToken(http.HandlerFunc(cloudHealthEventHandler.CreateCloudHealthEvent))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cloud-health-events", customMiddleware.Handle(internalApi.GetCloudHealthEvents, http.HandlerFunc(cloudHealthEventHandler.GetCloudHealthEvents))).Methods(http.MethodGet)
+
 	systemNotificationHandler := delivery.NewSystemNotificationHandler(usecaseFactory)
-	r.HandleFunc(SYSTEM_API_PREFIX+SYSTEM_API_VERSION+"/system-notifications", systemNotificationHandler.CreateSystemNotification).Methods(http.MethodPost)
+	r.Handle(SYSTEM_API_PREFIX+SYSTEM_API_VERSION+"/system-notifications", ingestionMiddleware.WithIngestionToken(http.HandlerFunc(systemNotificationHandler.CreateSystemNotification))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notifications", customMiddleware.Handle(internalApi.GetSystemNotifications, http.HandlerFunc(systemNotificationHandler.GetSystemNotifications))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notifications/{systemNotificationId}", customMiddleware.Handle(internalApi.GetSystemNotification, http.HandlerFunc(systemNotificationHandler.GetSystemNotification))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/system-notifications/{systemNotificationId}", customMiddleware.Handle(internalApi.DeleteSystemNotification, http.HandlerFunc(systemNotificationHandler.DeleteSystemNotification))).Methods(http.MethodDelete)
@@ -254,16 +563,80 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	stackHandler := delivery.NewStackHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks", customMiddleware.Handle(internalApi.GetStacks, http.HandlerFunc(stackHandler.GetStacks))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks", customMiddleware.Handle(internalApi.CreateStack, http.HandlerFunc(stackHandler.CreateStack))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/addon-versions", customMiddleware.Handle(internalApi.GetStackAddonVersions, http.HandlerFunc(stackHandler.GetStackAddonVersions))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/name/{name}/existence", customMiddleware.Handle(internalApi.CheckStackName, http.HandlerFunc(stackHandler.CheckStackName))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}", customMiddleware.Handle(internalApi.GetStack, http.HandlerFunc(stackHandler.GetStack))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}", customMiddleware.Handle(internalApi.UpdateStack, http.HandlerFunc(stackHandler.UpdateStack))).Methods(http.MethodPut)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}", customMiddleware.Handle(internalApi.DeleteStack, http.HandlerFunc(stackHandler.DeleteStack))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/kube-config", customMiddleware.Handle(internalApi.GetStackKubeConfig, http.HandlerFunc(stackHandler.GetStackKubeConfig))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/status", customMiddleware.Handle(internalApi.GetStackStatus, http.HandlerFunc(stackHandler.GetStackStatus))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/nodes", customMiddleware.Handle(internalApi.GetStackNodes, http.HandlerFunc(stackHandler.GetStackNodes))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/node-groups/{nodeGroup}:scale", customMiddleware.Handle(internalApi.ScaleStackNodeGroup, http.HandlerFunc(stackHandler.ScaleStackNodeGroup))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/upgrade", customMiddleware.Handle(internalApi.UpgradeStack, http.HandlerFunc(stackHandler.UpgradeStack))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/upgrades", customMiddleware.Handle(internalApi.GetStackUpgrades, http.HandlerFunc(stackHandler.GetStackUpgrades))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/favorite", customMiddleware.Handle(internalApi.SetFavoriteStack, http.HandlerFunc(stackHandler.SetFavorite))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/favorite", customMiddleware.Handle(internalApi.DeleteFavoriteStack, http.HandlerFunc(stackHandler.DeleteFavorite))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/cost-allocation-tags/drift", customMiddleware.Handle(internalApi.CheckStackCostAllocationTagDrift, http.HandlerFunc(stackHandler.CheckCostAllocationTagDrift))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/install", customMiddleware.Handle(internalApi.InstallStack, http.HandlerFunc(stackHandler.InstallStack))).Methods(http.MethodPost)
 
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stack-defaults", customMiddleware.Handle(internalApi.GetStackDefault, http.HandlerFunc(stackHandler.GetStackDefault))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/stack-defaults", customMiddleware.Handle(internalApi.Admin_GetStackDefault, http.HandlerFunc(stackHandler.GetStackDefault))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/stack-defaults", customMiddleware.Handle(internalApi.Admin_UpdateStackDefault, http.HandlerFunc(stackHandler.Admin_UpdateStackDefault))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/stack-defaults", customMiddleware.Handle(internalApi.Admin_DeleteStackDefault, http.HandlerFunc(stackHandler.Admin_DeleteStackDefault))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/cost-allocation-tag-policies", customMiddleware.Handle(internalApi.GetCostAllocationTagPolicies, http.HandlerFunc(stackHandler.GetCostAllocationTagPolicies))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/cost-allocation-tag-policies", customMiddleware.Handle(internalApi.Admin_GetCostAllocationTagPolicies, http.HandlerFunc(stackHandler.GetCostAllocationTagPolicies))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/cost-allocation-tag-policies", customMiddleware.Handle(internalApi.Admin_UpdateCostAllocationTagPolicies, http.HandlerFunc(stackHandler.Admin_UpdateCostAllocationTagPolicies))).Methods(http.MethodPut)
+
+	clusterAccessHandler := delivery.NewClusterAccessHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/access-requests", customMiddleware.Handle(internalApi.CreateClusterAccessRequest, http.HandlerFunc(clusterAccessHandler.CreateClusterAccessRequest))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/access-requests", customMiddleware.Handle(internalApi.GetClusterAccessRequests, http.HandlerFunc(clusterAccessHandler.GetClusterAccessRequests))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/access-requests/{accessRequestId}", customMiddleware.Handle(internalApi.GetClusterAccessRequest, http.HandlerFunc(clusterAccessHandler.GetClusterAccessRequest))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/access-requests/{accessRequestId}/approve", customMiddleware.Handle(internalApi.ApproveClusterAccessRequest, http.HandlerFunc(clusterAccessHandler.ApproveClusterAccessRequest))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/access-requests/{accessRequestId}/reject", customMiddleware.Handle(internalApi.RejectClusterAccessRequest, http.HandlerFunc(clusterAccessHandler.RejectClusterAccessRequest))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/access-requests/{accessRequestId}/revoke", customMiddleware.Handle(internalApi.RevokeClusterAccessRequest, http.HandlerFunc(clusterAccessHandler.RevokeClusterAccessRequest))).Methods(http.MethodPost)
+
+	deploymentApprovalHandler := delivery.NewDeploymentApprovalHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/deployment-approval-policy", customMiddleware.Handle(internalApi.GetDeploymentApprovalPolicy, http.HandlerFunc(deploymentApprovalHandler.GetDeploymentApprovalPolicy))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/deployment-approval-policy", customMiddleware.Handle(internalApi.UpdateDeploymentApprovalPolicy, http.HandlerFunc(deploymentApprovalHandler.UpdateDeploymentApprovalPolicy))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/deployment-approval-policy", customMiddleware.Handle(internalApi.DeleteDeploymentApprovalPolicy, http.HandlerFunc(deploymentApprovalHandler.DeleteDeploymentApprovalPolicy))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/deployment-approvals", customMiddleware.Handle(internalApi.GetDeploymentApprovals, http.HandlerFunc(deploymentApprovalHandler.GetDeploymentApprovals))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/deployment-approvals/{approvalId}", customMiddleware.Handle(internalApi.GetDeploymentApproval, http.HandlerFunc(deploymentApprovalHandler.GetDeploymentApproval))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/deployment-approvals/{approvalId}/approve", customMiddleware.Handle(internalApi.ApproveDeployment, http.HandlerFunc(deploymentApprovalHandler.ApproveDeployment))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/deployment-approvals/{approvalId}/reject", customMiddleware.Handle(internalApi.RejectDeployment, http.HandlerFunc(deploymentApprovalHandler.RejectDeployment))).Methods(http.MethodPost)
+
+	maintenanceWindowHandler := delivery.NewMaintenanceWindowHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/maintenance-windows", customMiddleware.Handle(internalApi.CreateMaintenanceWindow, http.HandlerFunc(maintenanceWindowHandler.CreateMaintenanceWindow))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/maintenance-windows", customMiddleware.Handle(internalApi.GetMaintenanceWindows, http.HandlerFunc(maintenanceWindowHandler.GetMaintenanceWindows))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/maintenance-windows/{maintenanceWindowId}", customMiddleware.Handle(internalApi.GetMaintenanceWindow, http.HandlerFunc(maintenanceWindowHandler.GetMaintenanceWindow))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/maintenance-windows/{maintenanceWindowId}", customMiddleware.Handle(internalApi.UpdateMaintenanceWindow, http.HandlerFunc(maintenanceWindowHandler.UpdateMaintenanceWindow))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/maintenance-windows/{maintenanceWindowId}", customMiddleware.Handle(internalApi.DeleteMaintenanceWindow, http.HandlerFunc(maintenanceWindowHandler.DeleteMaintenanceWindow))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/maintenance-windows", customMiddleware.Handle(internalApi.GetOrganizationMaintenanceWindows, http.HandlerFunc(maintenanceWindowHandler.GetOrganizationMaintenanceWindows))).Methods(http.MethodGet)
+
+	stackMonitoringEndpointHandler := delivery.NewStackMonitoringEndpointHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/monitoring-endpoints", customMiddleware.Handle(internalApi.CreateStackMonitoringEndpoint, http.HandlerFunc(stackMonitoringEndpointHandler.CreateStackMonitoringEndpoint))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/monitoring-endpoints", customMiddleware.Handle(internalApi.GetStackMonitoringEndpoints, http.HandlerFunc(stackMonitoringEndpointHandler.GetStackMonitoringEndpoints))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/monitoring-endpoints/{monitoringEndpointId}", customMiddleware.Handle(internalApi.GetStackMonitoringEndpoint, http.HandlerFunc(stackMonitoringEndpointHandler.GetStackMonitoringEndpoint))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/monitoring-endpoints/{monitoringEndpointId}", customMiddleware.Handle(internalApi.UpdateStackMonitoringEndpoint, http.HandlerFunc(stackMonitoringEndpointHandler.UpdateStackMonitoringEndpoint))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/monitoring-endpoints/{monitoringEndpointId}", customMiddleware.Handle(internalApi.DeleteStackMonitoringEndpoint, http.HandlerFunc(stackMonitoringEndpointHandler.DeleteStackMonitoringEndpoint))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/dashboards/widgets/availability", customMiddleware.Handle(internalApi.GetAvailabilityDashboard, http.HandlerFunc(stackMonitoringEndpointHandler.GetAvailabilityDashboard))).Methods(http.MethodGet)
+
+	catalogHandler := delivery.NewCatalogHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/helm-repositories", customMiddleware.Handle(internalApi.Admin_GetHelmRepositories, http.HandlerFunc(catalogHandler.Admin_GetHelmRepositories))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/helm-repositories", customMiddleware.Handle(internalApi.Admin_CreateHelmRepository, http.HandlerFunc(catalogHandler.Admin_CreateHelmRepository))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/helm-repositories/{helmRepositoryId}", customMiddleware.Handle(internalApi.Admin_DeleteHelmRepository, http.HandlerFunc(catalogHandler.Admin_DeleteHelmRepository))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/helm-repositories/{helmRepositoryId}/sync", customMiddleware.Handle(internalApi.Admin_SyncHelmRepository, http.HandlerFunc(catalogHandler.Admin_SyncHelmRepository))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/catalog/charts", customMiddleware.Handle(internalApi.GetCatalogCharts, http.HandlerFunc(catalogHandler.GetCatalogCharts))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/catalog/charts/{catalogChartId}", customMiddleware.Handle(internalApi.GetCatalogChart, http.HandlerFunc(catalogHandler.GetCatalogChart))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/catalog-apps", customMiddleware.Handle(internalApi.CreateCatalogApp, http.HandlerFunc(catalogHandler.CreateCatalogApp))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stacks/{stackId}/catalog-apps", customMiddleware.Handle(internalApi.GetCatalogApps, http.HandlerFunc(catalogHandler.GetCatalogApps))).Methods(http.MethodGet)
+
+	gitProviderHandler := delivery.NewGitProviderHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/git-providers", customMiddleware.Handle(internalApi.GetGitProviders, http.HandlerFunc(gitProviderHandler.GetGitProviders))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/git-providers", customMiddleware.Handle(internalApi.CreateGitProvider, http.HandlerFunc(gitProviderHandler.CreateGitProvider))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/git-providers/{gitProviderId}", customMiddleware.Handle(internalApi.GetGitProvider, http.HandlerFunc(gitProviderHandler.GetGitProvider))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/git-providers/{gitProviderId}", customMiddleware.Handle(internalApi.UpdateGitProvider, http.HandlerFunc(gitProviderHandler.UpdateGitProvider))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/git-providers/{gitProviderId}", customMiddleware.Handle(internalApi.DeleteGitProvider, http.HandlerFunc(gitProviderHandler.DeleteGitProvider))).Methods(http.MethodDelete)
+
 	projectHandler := delivery.NewProjectHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects", customMiddleware.Handle(internalApi.CreateProject, http.HandlerFunc(projectHandler.CreateProject))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects", customMiddleware.Handle(internalApi.GetProjects, http.HandlerFunc(projectHandler.GetProjects))).Methods(http.MethodGet)
@@ -272,7 +645,7 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/existence", customMiddleware.Handle(internalApi.GetProjectNamespace, http.HandlerFunc(projectHandler.IsProjectNameExist))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}", customMiddleware.Handle(internalApi.GetProject, http.HandlerFunc(projectHandler.GetProject))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}", customMiddleware.Handle(internalApi.UpdateProject, http.HandlerFunc(projectHandler.UpdateProject))).Methods(http.MethodPut)
-	//r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}", customMiddleware.Handle(internalApi.DeleteProject, http.HandlerFunc(projectHandler.DeleteProject))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}", customMiddleware.Handle(internalApi.DeleteProject, http.HandlerFunc(projectHandler.DeleteProject))).Methods(http.MethodDelete)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/members", customMiddleware.Handle(internalApi.AddProjectMember, http.HandlerFunc(projectHandler.AddProjectMember))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/members/count", customMiddleware.Handle(internalApi.GetProjectMembers, http.HandlerFunc(projectHandler.GetProjectMemberCount))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/projects/{projectId}/members/{projectMemberId}", customMiddleware.Handle(internalApi.GetProjectMember, http.HandlerFunc(projectHandler.GetProjectMember))).Methods(http.MethodGet)
@@ -294,8 +667,34 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 
 	auditHandler := delivery.NewAuditHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/admin/audits", customMiddleware.Handle(internalApi.GetAudits, http.HandlerFunc(auditHandler.GetAudits))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/admin/audits/statistics", customMiddleware.Handle(internalApi.Admin_GetAuditStatistics, http.HandlerFunc(auditHandler.Admin_GetAuditStatistics))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/admin/audits/archive", customMiddleware.Handle(internalApi.Admin_ArchiveAudits, http.HandlerFunc(auditHandler.Admin_ArchiveAudits))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/admin/audits/retention-policies", customMiddleware.Handle(internalApi.Admin_GetAuditRetentionPolicies, http.HandlerFunc(auditHandler.Admin_GetAuditRetentionPolicies))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/admin/audits/retention-policies/{organizationId}", customMiddleware.Handle(internalApi.Admin_UpdateAuditRetentionPolicy, http.HandlerFunc(auditHandler.Admin_UpdateAuditRetentionPolicy))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/admin/audits/archival-runs", customMiddleware.Handle(internalApi.Admin_CreateAuditArchivalRun, http.HandlerFunc(auditHandler.Admin_CreateAuditArchivalRun))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/admin/audits/archival-runs", customMiddleware.Handle(internalApi.Admin_GetAuditArchivalRuns, http.HandlerFunc(auditHandler.Admin_GetAuditArchivalRuns))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/admin/audits/archival-runs/{runId}", customMiddleware.Handle(internalApi.Admin_GetAuditArchivalRun, http.HandlerFunc(auditHandler.Admin_GetAuditArchivalRun))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/admin/audits/{auditId}", customMiddleware.Handle(internalApi.GetAudit, http.HandlerFunc(auditHandler.GetAudit))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/admin/audits/{auditId}", customMiddleware.Handle(internalApi.DeleteAudit, http.HandlerFunc(auditHandler.DeleteAudit))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/audits", customMiddleware.Handle(internalApi.GetOrganizationAudits, http.HandlerFunc(auditHandler.GetOrganizationAudits))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/timeline", customMiddleware.Handle(internalApi.GetOrganizationTimeline, http.HandlerFunc(auditHandler.GetOrganizationTimeline))).Methods(http.MethodGet)
+
+	auditSinkHandler := delivery.NewAuditSinkHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/audit-sinks", customMiddleware.Handle(internalApi.CreateAuditSink, http.HandlerFunc(auditSinkHandler.CreateAuditSink))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/audit-sinks", customMiddleware.Handle(internalApi.GetAuditSinks, http.HandlerFunc(auditSinkHandler.GetAuditSinks))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/audit-sinks/{auditSinkId}", customMiddleware.Handle(internalApi.GetAuditSink, http.HandlerFunc(auditSinkHandler.GetAuditSink))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/audit-sinks/{auditSinkId}", customMiddleware.Handle(internalApi.UpdateAuditSink, http.HandlerFunc(auditSinkHandler.UpdateAuditSink))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/audit-sinks/{auditSinkId}", customMiddleware.Handle(internalApi.DeleteAuditSink, http.HandlerFunc(auditSinkHandler.DeleteAuditSink))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/audit-sinks/{auditSinkId}/dead-letters", customMiddleware.Handle(internalApi.GetAuditSinkDeadLetters, http.HandlerFunc(auditSinkHandler.GetAuditSinkDeadLetters))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/audit-sinks/{auditSinkId}/dead-letters/{deadLetterId}/retry", customMiddleware.Handle(internalApi.RetryAuditSinkDeadLetter, http.HandlerFunc(auditSinkHandler.RetryAuditSinkDeadLetter))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/audit-sinks/{auditSinkId}/dead-letters/{deadLetterId}", customMiddleware.Handle(internalApi.DeleteAuditSinkDeadLetter, http.HandlerFunc(auditSinkHandler.DeleteAuditSinkDeadLetter))).Methods(http.MethodDelete)
+
+	stackWebhookHandler := delivery.NewStackWebhookHandler(usecaseFactory)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stack-webhooks", customMiddleware.Handle(internalApi.CreateStackWebhook, http.HandlerFunc(stackWebhookHandler.CreateStackWebhook))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stack-webhooks", customMiddleware.Handle(internalApi.GetStackWebhooks, http.HandlerFunc(stackWebhookHandler.GetStackWebhooks))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stack-webhooks/{stackWebhookId}", customMiddleware.Handle(internalApi.GetStackWebhook, http.HandlerFunc(stackWebhookHandler.GetStackWebhook))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stack-webhooks/{stackWebhookId}", customMiddleware.Handle(internalApi.UpdateStackWebhook, http.HandlerFunc(stackWebhookHandler.UpdateStackWebhook))).Methods(http.MethodPut)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/stack-webhooks/{stackWebhookId}", customMiddleware.Handle(internalApi.DeleteStackWebhook, http.HandlerFunc(stackWebhookHandler.DeleteStackWebhook))).Methods(http.MethodDelete)
 
 	roleHandler := delivery.NewRoleHandler(usecaseFactory)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/roles", customMiddleware.Handle(internalApi.CreateTksRole, http.HandlerFunc(roleHandler.CreateTksRole))).Methods(http.MethodPost)
@@ -309,6 +708,9 @@ func SetupRouter(db *gorm.DB, argoClient argowf.ArgoClient, kc keycloak.IKeycloa
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/roles/{roleId}/users", customMiddleware.Handle(internalApi.AppendUsersToRole, http.HandlerFunc(roleHandler.AppendUsersToRole))).Methods(http.MethodPost)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/roles/{roleId}/users", customMiddleware.Handle(internalApi.GetUsersInRoleId, http.HandlerFunc(roleHandler.GetUsersInRoleId))).Methods(http.MethodGet)
 	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/roles/{roleId}/users", customMiddleware.Handle(internalApi.RemoveUsersFromRole, http.HandlerFunc(roleHandler.RemoveUsersFromRole))).Methods(http.MethodDelete)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/role-reassignments", customMiddleware.Handle(internalApi.CreateRoleReassignment, http.HandlerFunc(roleHandler.CreateRoleReassignment))).Methods(http.MethodPost)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/role-reassignments", customMiddleware.Handle(internalApi.GetRoleReassignments, http.HandlerFunc(roleHandler.GetRoleReassignments))).Methods(http.MethodGet)
+	r.Handle(API_PREFIX+API_VERSION+"/organizations/{organizationId}/role-reassignments/{reassignmentId}", customMiddleware.Handle(internalApi.GetRoleReassignment, http.HandlerFunc(roleHandler.GetRoleReassignment))).Methods(http.MethodGet)
 
 	// Admin
 	r.Handle(API_PREFIX+API_VERSION+ADMINAPI_PREFIX+"/organizations/{organizationId}/roles", customMiddleware.Handle(internalApi.Admin_ListTksRoles, http.HandlerFunc(roleHandler.Admin_ListTksRoles))).Methods(http.MethodGet)
@@ -0,0 +1,246 @@
+// Package fakekeycloak provides an in-memory keycloak to run usecases without a keycloak server.
+//
+// Keycloak embeds keycloak.IKeycloak, so calling a method that is not implemented here panics.
+package fakekeycloak
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/keycloak"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+type user struct {
+	gocloak.User
+	password string
+	groups   map[string]bool
+}
+
+type Keycloak struct {
+	keycloak.IKeycloak
+
+	mu       sync.RWMutex
+	realms   map[string]map[string]*user // organizationId -> userId -> user
+	sessions map[string]string           // sessionId -> organizationId
+}
+
+func New() *Keycloak {
+	return &Keycloak{
+		realms:   map[string]map[string]*user{},
+		sessions: map[string]string{},
+	}
+}
+
+func (k *Keycloak) CreateRealm(ctx context.Context, organizationId string) (string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if _, ok := k.realms[organizationId]; !ok {
+		k.realms[organizationId] = map[string]*user{}
+	}
+	return organizationId, nil
+}
+
+func (k *Keycloak) DeleteRealm(ctx context.Context, organizationId string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	delete(k.realms, organizationId)
+	return nil
+}
+
+func (k *Keycloak) CreateUser(ctx context.Context, organizationId string, in *gocloak.User) (string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	realm, ok := k.realms[organizationId]
+	if !ok {
+		realm = map[string]*user{}
+		k.realms[organizationId] = realm
+	}
+	for _, u := range realm {
+		if gocloak.PString(u.Username) == gocloak.PString(in.Username) {
+			return "", httpErrors.NewConflictError(fmt.Errorf("user %s already exists", gocloak.PString(in.Username)), "", "")
+		}
+	}
+
+	u := &user{User: *in, groups: map[string]bool{}}
+	u.ID = gocloak.StringP(uuid.NewString())
+	u.password = credentialPassword(in.Credentials)
+	if in.Groups != nil {
+		for _, group := range *in.Groups {
+			u.groups[group] = true
+		}
+	}
+	realm[*u.ID] = u
+	return *u.ID, nil
+}
+
+func (k *Keycloak) GetUser(ctx context.Context, organizationId string, accountId string) (*gocloak.User, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	u := k.findByAccountId(organizationId, accountId)
+	if u == nil {
+		return nil, httpErrors.NewNotFoundError(fmt.Errorf("user %s not found", accountId), "", "")
+	}
+	out := u.User
+	groups := u.groupNames()
+	out.Groups = &groups
+	return &out, nil
+}
+
+func (k *Keycloak) GetUsers(ctx context.Context, organizationId string) ([]*gocloak.User, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	out := []*gocloak.User{}
+	for _, u := range k.realms[organizationId] {
+		user := u.User
+		out = append(out, &user)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return gocloak.PString(out[i].Username) < gocloak.PString(out[j].Username)
+	})
+	return out, nil
+}
+
+func (k *Keycloak) UpdateUser(ctx context.Context, organizationId string, in *gocloak.User) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	u, ok := k.realms[organizationId][gocloak.PString(in.ID)]
+	if !ok {
+		return httpErrors.NewNotFoundError(fmt.Errorf("user %s not found", gocloak.PString(in.ID)), "", "")
+	}
+	if in.Email != nil {
+		u.Email = in.Email
+	}
+	if in.FirstName != nil {
+		u.FirstName = in.FirstName
+	}
+	if in.Credentials != nil {
+		u.password = credentialPassword(in.Credentials)
+	}
+	return nil
+}
+
+func (k *Keycloak) DeleteUser(ctx context.Context, organizationId string, accountId string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	u := k.findByAccountId(organizationId, accountId)
+	if u == nil {
+		return httpErrors.NewNotFoundError(fmt.Errorf("user %s not found", accountId), "", "")
+	}
+	delete(k.realms[organizationId], *u.ID)
+	return nil
+}
+
+func (k *Keycloak) JoinGroup(ctx context.Context, organizationId string, userId string, groupName string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	u, ok := k.realms[organizationId][userId]
+	if !ok {
+		return httpErrors.NewNotFoundError(fmt.Errorf("user %s not found", userId), "", "")
+	}
+	u.groups[groupName] = true
+	return nil
+}
+
+func (k *Keycloak) LeaveGroup(ctx context.Context, organizationId string, userId string, groupName string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	u, ok := k.realms[organizationId][userId]
+	if !ok {
+		return httpErrors.NewNotFoundError(fmt.Errorf("user %s not found", userId), "", "")
+	}
+	delete(u.groups, groupName)
+	return nil
+}
+
+func (k *Keycloak) Login(ctx context.Context, accountId string, password string, organizationId string) (*model.User, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	u := k.findByAccountId(organizationId, accountId)
+	if u == nil || u.password != password {
+		return nil, fmt.Errorf("401 Unauthorized: invalid_grant: Invalid user credentials")
+	}
+	sessionId := uuid.NewString()
+	k.sessions[sessionId] = organizationId
+	return &model.User{Token: "fake-token-" + *u.ID, SessionId: sessionId}, nil
+}
+
+func (k *Keycloak) Logout(ctx context.Context, sessionId string, organizationId string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	delete(k.sessions, sessionId)
+	return nil
+}
+
+// Sessions returns the number of open sessions of the organization, for assertions.
+func (k *Keycloak) Sessions(organizationId string) int {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	n := 0
+	for _, o := range k.sessions {
+		if o == organizationId {
+			n++
+		}
+	}
+	return n
+}
+
+// Groups returns the groups of the user, for assertions.
+func (k *Keycloak) Groups(organizationId string, accountId string) []string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	u := k.findByAccountId(organizationId, accountId)
+	if u == nil {
+		return nil
+	}
+	return u.groupNames()
+}
+
+func (k *Keycloak) findByAccountId(organizationId string, accountId string) *user {
+	for _, u := range k.realms[organizationId] {
+		if gocloak.PString(u.Username) == accountId {
+			return u
+		}
+	}
+	return nil
+}
+
+func (u *user) groupNames() []string {
+	out := []string{}
+	for group := range u.groups {
+		out = append(out, group)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func credentialPassword(credentials *[]gocloak.CredentialRepresentation) string {
+	if credentials == nil {
+		return ""
+	}
+	for _, credential := range *credentials {
+		if gocloak.PString(credential.Type) == "password" {
+			return gocloak.PString(credential.Value)
+		}
+	}
+	return ""
+}
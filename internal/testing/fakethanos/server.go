@@ -0,0 +1,151 @@
+// Package fakethanos provides a fake thanos query server answering with canned metric fixtures.
+package fakethanos
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
+)
+
+// Fixture builds the response of a query. start, end and step are 0 for instant queries.
+type Fixture func(start int, end int, step int) thanos.Metric
+
+type route struct {
+	match      string
+	fixture    Fixture
+	statusCode int
+}
+
+type Server struct {
+	*httptest.Server
+
+	mu      sync.RWMutex
+	routes  []route
+	queries []string
+}
+
+func NewServer() *Server {
+	s := &Server{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/query", s.handle)
+	mux.HandleFunc("/api/v1/query_range", s.handle)
+	mux.HandleFunc("/-/ready", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Add answers the queries containing match with the fixture. Routes are matched in the order added.
+func (s *Server) Add(match string, fixture Fixture) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.routes = append(s.routes, route{match: match, fixture: fixture, statusCode: http.StatusOK})
+}
+
+// Fail answers the queries containing match with the status code.
+func (s *Server) Fail(match string, statusCode int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.routes = append(s.routes, route{match: match, statusCode: statusCode})
+}
+
+// Queries returns the queries received so far.
+func (s *Server) Queries() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]string{}, s.queries...)
+}
+
+// ThanosClient returns a thanos client connected to the server.
+func (s *Server) ThanosClient(ctx context.Context, organizationId string) (thanos.ThanosClient, error) {
+	address, port := helper.SplitAddress(ctx, s.URL)
+	return thanos.New(address, port, false, "")
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("query")
+	start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+	end, _ := strconv.Atoi(r.URL.Query().Get("end"))
+	step, _ := strconv.Atoi(r.URL.Query().Get("step"))
+
+	s.mu.Lock()
+	s.queries = append(s.queries, query)
+	routes := s.routes
+	s.mu.Unlock()
+
+	out := thanos.Metric{Status: "success", Data: thanos.MetricData{Result: []thanos.MetricDataResult{}}}
+	for _, route := range routes {
+		if !strings.Contains(query, route.match) {
+			continue
+		}
+		if route.statusCode != http.StatusOK {
+			w.WriteHeader(route.statusCode)
+			return
+		}
+		out = route.fixture(start, end, step)
+		break
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+// Metric always answers with the metric.
+func Metric(metric thanos.Metric) Fixture {
+	return func(start int, end int, step int) thanos.Metric {
+		return metric
+	}
+}
+
+// Vector answers with a sample per cluster(taco_cluster label).
+func Vector(values map[string]float64) Fixture {
+	return func(start int, end int, step int) thanos.Metric {
+		out := thanos.Metric{Status: "success", Data: thanos.MetricData{ResultType: "vector"}}
+		for _, clusterId := range sortedKeys(values) {
+			out.Data.Result = append(out.Data.Result, thanos.MetricDataResult{
+				Metric: thanos.MetricDataResultMetric{TacoCluster: clusterId},
+				Value:  []interface{}{float64(end), strconv.FormatFloat(values[clusterId], 'f', -1, 64)},
+			})
+		}
+		return out
+	}
+}
+
+// Matrix answers with a constant value per cluster(taco_cluster label) at every step of the range.
+func Matrix(values map[string]float64) Fixture {
+	return func(start int, end int, step int) thanos.Metric {
+		out := thanos.Metric{Status: "success", Data: thanos.MetricData{ResultType: "matrix"}}
+		if step <= 0 {
+			step = 1
+		}
+		for _, clusterId := range sortedKeys(values) {
+			result := thanos.MetricDataResult{Metric: thanos.MetricDataResultMetric{TacoCluster: clusterId}}
+			for ts := start; ts <= end; ts += step {
+				result.Values = append(result.Values, []interface{}{float64(ts), strconv.FormatFloat(values[clusterId], 'f', -1, 64)})
+			}
+			out.Data.Result = append(out.Data.Result, result)
+		}
+		return out
+	}
+}
+
+func sortedKeys(values map[string]float64) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,58 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type AlertChannelRepository struct {
+	repository.IAlertChannelRepository
+
+	mu            sync.RWMutex
+	alertChannels []model.AlertChannel
+}
+
+func NewAlertChannelRepository() *AlertChannelRepository {
+	return &AlertChannelRepository{}
+}
+
+func (r *AlertChannelRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertChannel, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.AlertChannel{}
+	for _, alertChannel := range r.alertChannels {
+		if alertChannel.OrganizationId == organizationId {
+			out = append(out, alertChannel)
+		}
+	}
+	return out, nil
+}
+
+func (r *AlertChannelRepository) Create(ctx context.Context, dto model.AlertChannel) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.alertChannels = append(r.alertChannels, dto)
+	return dto.ID, nil
+}
+
+func (r *AlertChannelRepository) Delete(ctx context.Context, alertChannelId uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, alertChannel := range r.alertChannels {
+		if alertChannel.ID == alertChannelId {
+			r.alertChannels = append(r.alertChannels[:i], r.alertChannels[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type AlertIngestionTokenRepository struct {
+	repository.IAlertIngestionTokenRepository
+
+	mu     sync.RWMutex
+	tokens map[uuid.UUID]model.AlertIngestionToken
+}
+
+func NewAlertIngestionTokenRepository() *AlertIngestionTokenRepository {
+	return &AlertIngestionTokenRepository{tokens: map[uuid.UUID]model.AlertIngestionToken{}}
+}
+
+func (r *AlertIngestionTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (model.AlertIngestionToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			return token, nil
+		}
+	}
+	return model.AlertIngestionToken{}, gorm.ErrRecordNotFound
+}
+
+func (r *AlertIngestionTokenRepository) Create(ctx context.Context, dto model.AlertIngestionToken) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.tokens[dto.ID] = dto
+	return dto.ID, nil
+}
+
+func (r *AlertIngestionTokenRepository) UpdateLastUsedAt(ctx context.Context, tokenId uuid.UUID, lastUsedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.tokens[tokenId]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	token.LastUsedAt = &lastUsedAt
+	r.tokens[tokenId] = token
+	return nil
+}
+
+func (r *AlertIngestionTokenRepository) Delete(ctx context.Context, tokenId uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.tokens, tokenId)
+	return nil
+}
+
+func (r *AlertIngestionTokenRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, token := range r.tokens {
+		if token.OrganizationId == organizationId {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *AlertIngestionTokenRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, token := range r.tokens {
+		if token.OrganizationId == organizationId {
+			delete(r.tokens, id)
+		}
+	}
+	return nil
+}
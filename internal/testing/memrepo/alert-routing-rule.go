@@ -0,0 +1,58 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type AlertRoutingRuleRepository struct {
+	repository.IAlertRoutingRuleRepository
+
+	mu                sync.RWMutex
+	alertRoutingRules []model.AlertRoutingRule
+}
+
+func NewAlertRoutingRuleRepository() *AlertRoutingRuleRepository {
+	return &AlertRoutingRuleRepository{}
+}
+
+func (r *AlertRoutingRuleRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertRoutingRule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.AlertRoutingRule{}
+	for _, alertRoutingRule := range r.alertRoutingRules {
+		if alertRoutingRule.OrganizationId == organizationId {
+			out = append(out, alertRoutingRule)
+		}
+	}
+	return out, nil
+}
+
+func (r *AlertRoutingRuleRepository) Create(ctx context.Context, dto model.AlertRoutingRule) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.alertRoutingRules = append(r.alertRoutingRules, dto)
+	return dto.ID, nil
+}
+
+func (r *AlertRoutingRuleRepository) Delete(ctx context.Context, alertRoutingRuleId uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, alertRoutingRule := range r.alertRoutingRules {
+		if alertRoutingRule.ID == alertRoutingRuleId {
+			r.alertRoutingRules = append(r.alertRoutingRules[:i], r.alertRoutingRules[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type AlertSilenceRepository struct {
+	repository.IAlertSilenceRepository
+
+	mu            sync.RWMutex
+	alertSilences []model.AlertSilence
+}
+
+func NewAlertSilenceRepository() *AlertSilenceRepository {
+	return &AlertSilenceRepository{}
+}
+
+func (r *AlertSilenceRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertSilence, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.AlertSilence{}
+	for _, alertSilence := range r.alertSilences {
+		if alertSilence.OrganizationId == organizationId {
+			out = append(out, alertSilence)
+		}
+	}
+	return out, nil
+}
+
+func (r *AlertSilenceRepository) Create(ctx context.Context, dto model.AlertSilence) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.alertSilences = append(r.alertSilences, dto)
+	return dto.ID, nil
+}
+
+func (r *AlertSilenceRepository) Delete(ctx context.Context, alertSilenceId uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, alertSilence := range r.alertSilences {
+		if alertSilence.ID == alertSilenceId {
+			r.alertSilences = append(r.alertSilences[:i], r.alertSilences[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
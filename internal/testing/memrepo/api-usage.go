@@ -0,0 +1,105 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type apiUsageKey struct {
+	organizationId string
+	startedAt      time.Time
+}
+
+type ApiUsageRepository struct {
+	repository.IApiUsageRepository
+
+	mu        sync.RWMutex
+	apiUsages map[apiUsageKey]model.ApiUsage
+}
+
+func NewApiUsageRepository() *ApiUsageRepository {
+	return &ApiUsageRepository{apiUsages: map[apiUsageKey]model.ApiUsage{}}
+}
+
+func (r *ApiUsageRepository) Increment(ctx context.Context, organizationId string, startedAt time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := apiUsageKey{organizationId, startedAt.UTC()}
+	apiUsage := r.apiUsages[key]
+	apiUsage.OrganizationId = organizationId
+	apiUsage.StartedAt = startedAt
+	apiUsage.Requests++
+	r.apiUsages[key] = apiUsage
+	return apiUsage.Requests, nil
+}
+
+func (r *ApiUsageRepository) IncrementRateLimited(ctx context.Context, organizationId string, startedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := apiUsageKey{organizationId, startedAt.UTC()}
+	if apiUsage, ok := r.apiUsages[key]; ok {
+		apiUsage.RateLimited++
+		r.apiUsages[key] = apiUsage
+	}
+	return nil
+}
+
+func (r *ApiUsageRepository) Fetch(ctx context.Context, organizationId string, since time.Time) ([]model.ApiUsage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.ApiUsage{}
+	for _, apiUsage := range r.apiUsages {
+		if apiUsage.OrganizationId == organizationId && !apiUsage.StartedAt.Before(since) {
+			out = append(out, apiUsage)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].StartedAt.Before(out[j].StartedAt) })
+	return out, nil
+}
+
+func (r *ApiUsageRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for key, apiUsage := range r.apiUsages {
+		if apiUsage.StartedAt.Before(before) {
+			delete(r.apiUsages, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (r *ApiUsageRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, apiUsage := range r.apiUsages {
+		if apiUsage.OrganizationId == organizationId {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *ApiUsageRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, apiUsage := range r.apiUsages {
+		if apiUsage.OrganizationId == organizationId {
+			delete(r.apiUsages, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+type AppGroupRepository struct {
+	repository.IAppGroupRepository
+
+	mu        sync.RWMutex
+	appGroups []model.AppGroup
+}
+
+func NewAppGroupRepository() *AppGroupRepository {
+	return &AppGroupRepository{}
+}
+
+func (r *AppGroupRepository) Fetch(ctx context.Context, clusterId domain.ClusterId, pg *pagination.Pagination) ([]model.AppGroup, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.AppGroup{}
+	for _, appGroup := range r.appGroups {
+		if appGroup.ClusterId == clusterId {
+			out = append(out, appGroup)
+		}
+	}
+	return out, nil
+}
+
+func (r *AppGroupRepository) Create(ctx context.Context, dto model.AppGroup) (domain.AppGroupId, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if dto.ID == "" {
+		dto.ID = domain.AppGroupId(uuid.NewString()[:9])
+	}
+	r.appGroups = append(r.appGroups, dto)
+	return dto.ID, nil
+}
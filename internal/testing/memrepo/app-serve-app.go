@@ -0,0 +1,74 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type AppServeAppRepository struct {
+	repository.IAppServeAppRepository
+
+	mu      sync.RWMutex
+	apps    map[string]model.AppServeApp
+	configs []model.AppServeAppConfig
+}
+
+func NewAppServeAppRepository() *AppServeAppRepository {
+	return &AppServeAppRepository{apps: map[string]model.AppServeApp{}}
+}
+
+func (r *AppServeAppRepository) CreateAppServeApp(ctx context.Context, app *model.AppServeApp) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	app.ID = uuid.NewString()
+	r.apps[app.ID] = *app
+	return app.ID, nil
+}
+
+func (r *AppServeAppRepository) CreateConfig(ctx context.Context, config model.AppServeAppConfig) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	config.ID = uuid.New()
+	config.Revision = 1
+	for _, c := range r.configs {
+		if c.AppServeAppId == config.AppServeAppId && c.Revision >= config.Revision {
+			config.Revision = c.Revision + 1
+		}
+	}
+	r.configs = append(r.configs, config)
+	return config.Revision, nil
+}
+
+func (r *AppServeAppRepository) CountConfigsByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, config := range r.configs {
+		if r.apps[config.AppServeAppId].OrganizationId == organizationId {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *AppServeAppRepository) FlushConfigs(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	configs := []model.AppServeAppConfig{}
+	for _, config := range r.configs {
+		if r.apps[config.AppServeAppId].OrganizationId != organizationId {
+			configs = append(configs, config)
+		}
+	}
+	r.configs = configs
+	return nil
+}
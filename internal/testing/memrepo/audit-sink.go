@@ -0,0 +1,77 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type AuditSinkRepository struct {
+	repository.IAuditSinkRepository
+
+	mu         sync.RWMutex
+	auditSinks []model.AuditSink
+}
+
+func NewAuditSinkRepository() *AuditSinkRepository {
+	return &AuditSinkRepository{}
+}
+
+func (r *AuditSinkRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AuditSink, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.AuditSink{}
+	for _, auditSink := range r.auditSinks {
+		if auditSink.OrganizationId == organizationId {
+			out = append(out, auditSink)
+		}
+	}
+	return out, nil
+}
+
+func (r *AuditSinkRepository) Create(ctx context.Context, dto model.AuditSink) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.auditSinks = append(r.auditSinks, dto)
+	return dto.ID, nil
+}
+
+// CountDeadLetters 는 감사 로그를 저장하지 않으므로 항상 0 이다.
+func (r *AuditSinkRepository) CountDeadLetters(ctx context.Context, auditSinkId uuid.UUID) (int64, error) {
+	return 0, nil
+}
+
+func (r *AuditSinkRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, auditSink := range r.auditSinks {
+		if auditSink.OrganizationId == organizationId {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *AuditSinkRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := r.auditSinks[:0]
+	for _, auditSink := range r.auditSinks {
+		if auditSink.OrganizationId != organizationId {
+			out = append(out, auditSink)
+		}
+	}
+	r.auditSinks = out
+	return nil
+}
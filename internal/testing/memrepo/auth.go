@@ -0,0 +1,48 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type AuthRepository struct {
+	repository.IAuthRepository
+
+	mu           sync.RWMutex
+	expiredTimes map[string]model.ExpiredTokenTime
+}
+
+func NewAuthRepository() *AuthRepository {
+	return &AuthRepository{
+		expiredTimes: map[string]model.ExpiredTokenTime{},
+	}
+}
+
+func (r *AuthRepository) GetExpiredTimeOnToken(ctx context.Context, organizationId string, userId string) (*model.ExpiredTokenTime, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	expiredTokenTime, ok := r.expiredTimes[organizationId+"/"+userId]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &expiredTokenTime, nil
+}
+
+func (r *AuthRepository) UpdateExpiredTimeOnToken(ctx context.Context, organizationId string, userId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expiredTimes[organizationId+"/"+userId] = model.ExpiredTokenTime{
+		OrganizationId: organizationId,
+		SubjectId:      userId,
+		ExpiredTime:    time.Now(),
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package memrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type CatalogRepository struct {
+	repository.ICatalogRepository
+
+	records *organizationRecords
+}
+
+func NewCatalogRepository() *CatalogRepository {
+	return &CatalogRepository{records: newOrganizationRecords()}
+}
+
+func (r *CatalogRepository) CreateApp(ctx context.Context, dto model.CatalogApp) error {
+	r.records.put(uuid.NewString(), dto.OrganizationId)
+	return nil
+}
+
+func (r *CatalogRepository) CountAppsByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	return r.records.count(organizationId), nil
+}
+
+func (r *CatalogRepository) FlushApps(ctx context.Context, organizationId string) error {
+	r.records.flush(organizationId)
+	return nil
+}
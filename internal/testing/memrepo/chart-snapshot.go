@@ -0,0 +1,35 @@
+package memrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type ChartSnapshotRepository struct {
+	repository.IChartSnapshotRepository
+
+	records *organizationRecords
+}
+
+func NewChartSnapshotRepository() *ChartSnapshotRepository {
+	return &ChartSnapshotRepository{records: newOrganizationRecords()}
+}
+
+func (r *ChartSnapshotRepository) Create(ctx context.Context, dto model.ChartSnapshot) (uuid.UUID, error) {
+	id := uuid.New()
+	r.records.put(id.String(), dto.OrganizationId)
+	return id, nil
+}
+
+func (r *ChartSnapshotRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	return r.records.count(organizationId), nil
+}
+
+func (r *ChartSnapshotRepository) Flush(ctx context.Context, organizationId string) error {
+	r.records.flush(organizationId)
+	return nil
+}
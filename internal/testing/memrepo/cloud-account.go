@@ -0,0 +1,58 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type CloudAccountRepository struct {
+	repository.ICloudAccountRepository
+
+	mu            sync.RWMutex
+	cloudAccounts []model.CloudAccount
+}
+
+func NewCloudAccountRepository() *CloudAccountRepository {
+	return &CloudAccountRepository{}
+}
+
+func (r *CloudAccountRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.CloudAccount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.CloudAccount{}
+	for _, cloudAccount := range r.cloudAccounts {
+		if cloudAccount.OrganizationId == organizationId {
+			out = append(out, cloudAccount)
+		}
+	}
+	return out, nil
+}
+
+func (r *CloudAccountRepository) Create(ctx context.Context, dto model.CloudAccount) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.cloudAccounts = append(r.cloudAccounts, dto)
+	return dto.ID, nil
+}
+
+func (r *CloudAccountRepository) Delete(ctx context.Context, cloudAccountId uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, cloudAccount := range r.cloudAccounts {
+		if cloudAccount.ID == cloudAccountId {
+			r.cloudAccounts = append(r.cloudAccounts[:i], r.cloudAccounts[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
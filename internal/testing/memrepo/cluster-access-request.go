@@ -0,0 +1,56 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type ClusterAccessRequestRepository struct {
+	repository.IClusterAccessRequestRepository
+
+	mu             sync.RWMutex
+	accessRequests map[uuid.UUID]model.ClusterAccessRequest
+}
+
+func NewClusterAccessRequestRepository() *ClusterAccessRequestRepository {
+	return &ClusterAccessRequestRepository{
+		accessRequests: map[uuid.UUID]model.ClusterAccessRequest{},
+	}
+}
+
+func (r *ClusterAccessRequestRepository) Get(ctx context.Context, accessRequestId uuid.UUID) (model.ClusterAccessRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	accessRequest, ok := r.accessRequests[accessRequestId]
+	if !ok {
+		return model.ClusterAccessRequest{}, gorm.ErrRecordNotFound
+	}
+	return accessRequest, nil
+}
+
+func (r *ClusterAccessRequestRepository) Create(ctx context.Context, dto model.ClusterAccessRequest) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.accessRequests[dto.ID] = dto
+	return dto.ID, nil
+}
+
+func (r *ClusterAccessRequestRepository) Update(ctx context.Context, dto model.ClusterAccessRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.accessRequests[dto.ID]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	r.accessRequests[dto.ID] = dto
+	return nil
+}
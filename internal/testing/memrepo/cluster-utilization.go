@@ -0,0 +1,70 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+type ClusterUtilizationRepository struct {
+	repository.IClusterUtilizationRepository
+
+	mu           sync.RWMutex
+	utilizations []model.ClusterUtilization
+}
+
+func NewClusterUtilizationRepository() *ClusterUtilizationRepository {
+	return &ClusterUtilizationRepository{}
+}
+
+func (r *ClusterUtilizationRepository) Upsert(ctx context.Context, dtos []model.ClusterUtilization) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, dto := range dtos {
+		updated := false
+		for i, utilization := range r.utilizations {
+			if utilization.ClusterId == dto.ClusterId && utilization.Metric == dto.Metric && utilization.Date.Equal(dto.Date) {
+				r.utilizations[i] = dto
+				updated = true
+				break
+			}
+		}
+		if !updated {
+			r.utilizations = append(r.utilizations, dto)
+		}
+	}
+	return nil
+}
+
+func (r *ClusterUtilizationRepository) Fetch(ctx context.Context, organizationId string, metric domain.UtilizationMetric, start time.Time, end time.Time) ([]model.ClusterUtilization, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.ClusterUtilization{}
+	for _, utilization := range r.utilizations {
+		if utilization.OrganizationId == organizationId && utilization.Metric == metric &&
+			!utilization.Date.Before(start) && utilization.Date.Before(end) {
+			out = append(out, utilization)
+		}
+	}
+	return out, nil
+}
+
+func (r *ClusterUtilizationRepository) DeleteBefore(ctx context.Context, date time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := []model.ClusterUtilization{}
+	for _, utilization := range r.utilizations {
+		if !utilization.Date.Before(date) {
+			out = append(out, utilization)
+		}
+	}
+	r.utilizations = out
+	return nil
+}
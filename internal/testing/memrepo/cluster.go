@@ -0,0 +1,84 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+type ClusterRepository struct {
+	repository.IClusterRepository
+
+	mu       sync.RWMutex
+	clusters map[domain.ClusterId]model.Cluster
+}
+
+func NewClusterRepository() *ClusterRepository {
+	return &ClusterRepository{
+		clusters: map[domain.ClusterId]model.Cluster{},
+	}
+}
+
+func (r *ClusterRepository) Fetch(ctx context.Context, pg *pagination.Pagination) ([]model.Cluster, error) {
+	return r.find(func(cluster model.Cluster) bool { return true }), nil
+}
+
+func (r *ClusterRepository) FetchByOrganizationId(ctx context.Context, organizationId string, userId uuid.UUID, pg *pagination.Pagination) ([]model.Cluster, error) {
+	return r.find(func(cluster model.Cluster) bool {
+		return cluster.OrganizationId == organizationId && cluster.Status != domain.ClusterStatus_DELETED
+	}), nil
+}
+
+func (r *ClusterRepository) Get(ctx context.Context, id domain.ClusterId) (model.Cluster, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cluster, ok := r.clusters[id]
+	if !ok {
+		return model.Cluster{}, gorm.ErrRecordNotFound
+	}
+	return cluster, nil
+}
+
+func (r *ClusterRepository) Create(ctx context.Context, dto model.Cluster) (domain.ClusterId, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if dto.ID == "" {
+		dto.ID = domain.ClusterId(uuid.NewString()[:9])
+	}
+	r.clusters[dto.ID] = dto
+	return dto.ID, nil
+}
+
+func (r *ClusterRepository) Delete(ctx context.Context, id domain.ClusterId) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.clusters, id)
+	return nil
+}
+
+func (r *ClusterRepository) find(match func(cluster model.Cluster) bool) []model.Cluster {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.Cluster{}
+	for _, cluster := range r.clusters {
+		if match(cluster) {
+			out = append(out, cluster)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
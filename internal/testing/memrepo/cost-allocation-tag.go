@@ -0,0 +1,35 @@
+package memrepo
+
+import (
+	"context"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type CostAllocationTagRepository struct {
+	repository.ICostAllocationTagRepository
+
+	records *organizationRecords
+}
+
+func NewCostAllocationTagRepository() *CostAllocationTagRepository {
+	return &CostAllocationTagRepository{records: newOrganizationRecords()}
+}
+
+func (r *CostAllocationTagRepository) Replace(ctx context.Context, organizationId string, policies []model.CostAllocationTagPolicy) error {
+	r.records.flush(organizationId)
+	for _, policy := range policies {
+		r.records.put(organizationId+"/"+policy.Key, organizationId)
+	}
+	return nil
+}
+
+func (r *CostAllocationTagRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	return r.records.count(organizationId), nil
+}
+
+func (r *CostAllocationTagRepository) Flush(ctx context.Context, organizationId string) error {
+	r.records.flush(organizationId)
+	return nil
+}
@@ -0,0 +1,35 @@
+package memrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type CustomChartRepository struct {
+	repository.ICustomChartRepository
+
+	records *organizationRecords
+}
+
+func NewCustomChartRepository() *CustomChartRepository {
+	return &CustomChartRepository{records: newOrganizationRecords()}
+}
+
+func (r *CustomChartRepository) Create(ctx context.Context, dto model.CustomChart) (uuid.UUID, error) {
+	id := uuid.New()
+	r.records.put(id.String(), dto.OrganizationId)
+	return id, nil
+}
+
+func (r *CustomChartRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	return r.records.count(organizationId), nil
+}
+
+func (r *CustomChartRepository) Flush(ctx context.Context, organizationId string) error {
+	r.records.flush(organizationId)
+	return nil
+}
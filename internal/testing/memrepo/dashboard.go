@@ -0,0 +1,71 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type DashboardRepository struct {
+	repository.IDashboardRepository
+
+	mu         sync.RWMutex
+	dashboards map[uuid.UUID]model.Dashboard
+}
+
+func NewDashboardRepository() *DashboardRepository {
+	return &DashboardRepository{
+		dashboards: map[uuid.UUID]model.Dashboard{},
+	}
+}
+
+func (r *DashboardRepository) CreateDashboard(ctx context.Context, d *model.Dashboard) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d.ID = uuid.New()
+	r.dashboards[d.ID] = *d
+	return d.ID.String(), nil
+}
+
+// GetDashboardById returns nil without error when the dashboard is not found, same as the database repository.
+func (r *DashboardRepository) GetDashboardById(ctx context.Context, organizationId string, dashboardId string) (*model.Dashboard, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, d := range r.dashboards {
+		if d.OrganizationId == organizationId && d.ID.String() == dashboardId {
+			return &d, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *DashboardRepository) GetDashboardByUserId(ctx context.Context, organizationId string, userId string, dashboardKey string) (*model.Dashboard, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, d := range r.dashboards {
+		if d.OrganizationId == organizationId && d.UserId.String() == userId && d.Key == dashboardKey {
+			return &d, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *DashboardRepository) UpdateDashboard(ctx context.Context, d *model.Dashboard) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.dashboards[d.ID]
+	if !ok {
+		return nil
+	}
+	stored.Content = d.Content
+	r.dashboards[d.ID] = stored
+	return nil
+}
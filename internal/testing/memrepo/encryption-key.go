@@ -0,0 +1,95 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+type EncryptionKeyRepository struct {
+	repository.IEncryptionKeyRepository
+
+	mu             sync.RWMutex
+	encryptionKeys map[uuid.UUID]model.EncryptionKey
+}
+
+func NewEncryptionKeyRepository() *EncryptionKeyRepository {
+	return &EncryptionKeyRepository{encryptionKeys: map[uuid.UUID]model.EncryptionKey{}}
+}
+
+func (r *EncryptionKeyRepository) Get(ctx context.Context, encryptionKeyId uuid.UUID) (model.EncryptionKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	encryptionKey, ok := r.encryptionKeys[encryptionKeyId]
+	if !ok {
+		return model.EncryptionKey{}, gorm.ErrRecordNotFound
+	}
+	return encryptionKey, nil
+}
+
+func (r *EncryptionKeyRepository) GetActive(ctx context.Context, organizationId string) (model.EncryptionKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, encryptionKey := range r.encryptionKeys {
+		if encryptionKey.OrganizationId == organizationId && encryptionKey.Status == domain.EncryptionKeyStatus_ACTIVE {
+			return encryptionKey, nil
+		}
+	}
+	return model.EncryptionKey{}, gorm.ErrRecordNotFound
+}
+
+func (r *EncryptionKeyRepository) Create(ctx context.Context, dto model.EncryptionKey) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	dto.Status = domain.EncryptionKeyStatus_ACTIVE
+	r.encryptionKeys[dto.ID] = dto
+	return dto.ID, nil
+}
+
+func (r *EncryptionKeyRepository) UpdateStatus(ctx context.Context, encryptionKeyId uuid.UUID, status domain.EncryptionKeyStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	encryptionKey, ok := r.encryptionKeys[encryptionKeyId]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	encryptionKey.Status = status
+	r.encryptionKeys[encryptionKeyId] = encryptionKey
+	return nil
+}
+
+func (r *EncryptionKeyRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, encryptionKey := range r.encryptionKeys {
+		if encryptionKey.OrganizationId == organizationId {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *EncryptionKeyRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, encryptionKey := range r.encryptionKeys {
+		if encryptionKey.OrganizationId == organizationId {
+			delete(r.encryptionKeys, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package memrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type EscalationPolicyRepository struct {
+	repository.IEscalationPolicyRepository
+
+	records *organizationRecords
+}
+
+func NewEscalationPolicyRepository() *EscalationPolicyRepository {
+	return &EscalationPolicyRepository{records: newOrganizationRecords()}
+}
+
+func (r *EscalationPolicyRepository) Create(ctx context.Context, dto model.EscalationPolicy) (uuid.UUID, error) {
+	id := uuid.New()
+	r.records.put(id.String(), dto.OrganizationId)
+	return id, nil
+}
+
+func (r *EscalationPolicyRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	return r.records.count(organizationId), nil
+}
+
+func (r *EscalationPolicyRepository) Flush(ctx context.Context, organizationId string) error {
+	r.records.flush(organizationId)
+	return nil
+}
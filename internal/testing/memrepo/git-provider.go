@@ -0,0 +1,35 @@
+package memrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type GitProviderRepository struct {
+	repository.IGitProviderRepository
+
+	records *organizationRecords
+}
+
+func NewGitProviderRepository() *GitProviderRepository {
+	return &GitProviderRepository{records: newOrganizationRecords()}
+}
+
+func (r *GitProviderRepository) Create(ctx context.Context, dto model.GitProvider) (uuid.UUID, error) {
+	id := uuid.New()
+	r.records.put(id.String(), dto.OrganizationId)
+	return id, nil
+}
+
+func (r *GitProviderRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	return r.records.count(organizationId), nil
+}
+
+func (r *GitProviderRepository) Flush(ctx context.Context, organizationId string) error {
+	r.records.flush(organizationId)
+	return nil
+}
@@ -0,0 +1,116 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type LmaEndpointRepository struct {
+	repository.ILmaEndpointRepository
+
+	mu           sync.RWMutex
+	lmaEndpoints []model.LmaEndpoint
+	lmaAuths     map[string]model.LmaAuth
+	discoveries  map[string]model.LmaDiscovery
+}
+
+func NewLmaEndpointRepository() *LmaEndpointRepository {
+	return &LmaEndpointRepository{lmaAuths: map[string]model.LmaAuth{}, discoveries: map[string]model.LmaDiscovery{}}
+}
+
+func (r *LmaEndpointRepository) Fetch(ctx context.Context, organizationId string) ([]model.LmaEndpoint, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.LmaEndpoint{}
+	for _, lmaEndpoint := range r.lmaEndpoints {
+		if lmaEndpoint.OrganizationId == organizationId {
+			out = append(out, lmaEndpoint)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Priority < out[j].Priority
+	})
+	return out, nil
+}
+
+func (r *LmaEndpointRepository) Create(ctx context.Context, dto model.LmaEndpoint) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.lmaEndpoints = append(r.lmaEndpoints, dto)
+	return dto.ID, nil
+}
+
+func (r *LmaEndpointRepository) GetAuth(ctx context.Context, organizationId string) (model.LmaAuth, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if lmaAuth, ok := r.lmaAuths[organizationId]; ok {
+		return lmaAuth, nil
+	}
+	return model.LmaAuth{}, gorm.ErrRecordNotFound
+}
+
+func (r *LmaEndpointRepository) UpsertAuth(ctx context.Context, dto model.LmaAuth) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lmaAuths[dto.OrganizationId] = dto
+	return nil
+}
+
+func (r *LmaEndpointRepository) GetDiscovery(ctx context.Context, organizationId string) (model.LmaDiscovery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if lmaDiscovery, ok := r.discoveries[organizationId]; ok {
+		return lmaDiscovery, nil
+	}
+	return model.LmaDiscovery{}, gorm.ErrRecordNotFound
+}
+
+func (r *LmaEndpointRepository) UpsertDiscovery(ctx context.Context, dto model.LmaDiscovery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.discoveries[dto.OrganizationId] = dto
+	return nil
+}
+
+func (r *LmaEndpointRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, lmaEndpoint := range r.lmaEndpoints {
+		if lmaEndpoint.OrganizationId == organizationId {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *LmaEndpointRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lmaEndpoints := []model.LmaEndpoint{}
+	for _, lmaEndpoint := range r.lmaEndpoints {
+		if lmaEndpoint.OrganizationId != organizationId {
+			lmaEndpoints = append(lmaEndpoints, lmaEndpoint)
+		}
+	}
+	r.lmaEndpoints = lmaEndpoints
+	delete(r.lmaAuths, organizationId)
+	delete(r.discoveries, organizationId)
+	return nil
+}
@@ -0,0 +1,86 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+type MaintenanceWindowRepository struct {
+	repository.IMaintenanceWindowRepository
+
+	mu                 sync.RWMutex
+	maintenanceWindows []model.MaintenanceWindow
+}
+
+func NewMaintenanceWindowRepository() *MaintenanceWindowRepository {
+	return &MaintenanceWindowRepository{}
+}
+
+func (r *MaintenanceWindowRepository) FetchActive(ctx context.Context, organizationId string, now time.Time) ([]model.MaintenanceWindow, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.MaintenanceWindow{}
+	for _, maintenanceWindow := range r.maintenanceWindows {
+		if maintenanceWindow.OrganizationId == organizationId && maintenanceWindow.IsActive(now) {
+			out = append(out, maintenanceWindow)
+		}
+	}
+	return out, nil
+}
+
+func (r *MaintenanceWindowRepository) GetActive(ctx context.Context, clusterId domain.ClusterId, now time.Time) (model.MaintenanceWindow, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, maintenanceWindow := range r.maintenanceWindows {
+		if maintenanceWindow.ClusterId == clusterId && maintenanceWindow.IsActive(now) {
+			return maintenanceWindow, nil
+		}
+	}
+	return model.MaintenanceWindow{}, gorm.ErrRecordNotFound
+}
+
+func (r *MaintenanceWindowRepository) Create(ctx context.Context, dto model.MaintenanceWindow) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.maintenanceWindows = append(r.maintenanceWindows, dto)
+	return dto.ID, nil
+}
+
+func (r *MaintenanceWindowRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, maintenanceWindow := range r.maintenanceWindows {
+		if maintenanceWindow.OrganizationId == organizationId {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *MaintenanceWindowRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	maintenanceWindows := []model.MaintenanceWindow{}
+	for _, maintenanceWindow := range r.maintenanceWindows {
+		if maintenanceWindow.OrganizationId != organizationId {
+			maintenanceWindows = append(maintenanceWindows, maintenanceWindow)
+		}
+	}
+	r.maintenanceWindows = maintenanceWindows
+	return nil
+}
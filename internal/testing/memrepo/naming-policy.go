@@ -0,0 +1,32 @@
+package memrepo
+
+import (
+	"context"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type NamingPolicyRepository struct {
+	repository.INamingPolicyRepository
+
+	records *organizationRecords
+}
+
+func NewNamingPolicyRepository() *NamingPolicyRepository {
+	return &NamingPolicyRepository{records: newOrganizationRecords()}
+}
+
+func (r *NamingPolicyRepository) Upsert(ctx context.Context, dto model.NamingPolicy) error {
+	r.records.put(dto.OrganizationId+"/"+dto.ResourceType, dto.OrganizationId)
+	return nil
+}
+
+func (r *NamingPolicyRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	return r.records.count(organizationId), nil
+}
+
+func (r *NamingPolicyRepository) Flush(ctx context.Context, organizationId string) error {
+	r.records.flush(organizationId)
+	return nil
+}
@@ -0,0 +1,34 @@
+package memrepo
+
+import (
+	"context"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type NotificationDigestRepository struct {
+	repository.INotificationDigestRepository
+
+	records *organizationRecords
+}
+
+func NewNotificationDigestRepository() *NotificationDigestRepository {
+	return &NotificationDigestRepository{records: newOrganizationRecords()}
+}
+
+func (r *NotificationDigestRepository) SaveSettings(ctx context.Context, settings []model.NotificationDigestSetting) error {
+	for _, setting := range settings {
+		r.records.put(setting.UserId.String()+"/"+string(setting.Channel), setting.OrganizationId)
+	}
+	return nil
+}
+
+func (r *NotificationDigestRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	return r.records.count(organizationId), nil
+}
+
+func (r *NotificationDigestRepository) Flush(ctx context.Context, organizationId string) error {
+	r.records.flush(organizationId)
+	return nil
+}
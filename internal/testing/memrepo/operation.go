@@ -0,0 +1,78 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type OperationRepository struct {
+	repository.IOperationRepository
+
+	mu         sync.RWMutex
+	operations []model.Operation
+}
+
+func NewOperationRepository() *OperationRepository {
+	return &OperationRepository{}
+}
+
+func (r *OperationRepository) FetchByPeriod(ctx context.Context, organizationId string, start time.Time, end time.Time) ([]model.Operation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.Operation{}
+	for _, operation := range r.operations {
+		if operation.OrganizationId == organizationId && !operation.CreatedAt.Before(start) && !operation.CreatedAt.After(end) {
+			out = append(out, operation)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out, nil
+}
+
+func (r *OperationRepository) Create(ctx context.Context, dto model.Operation) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	if dto.CreatedAt.IsZero() {
+		dto.CreatedAt = time.Now()
+	}
+	r.operations = append(r.operations, dto)
+	return dto.ID, nil
+}
+
+func (r *OperationRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.Operation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.Operation{}
+	for _, operation := range r.operations {
+		if operation.OrganizationId == organizationId {
+			out = append(out, operation)
+		}
+	}
+	return out, nil
+}
+
+func (r *OperationRepository) UpdateStatus(ctx context.Context, dto model.Operation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, operation := range r.operations {
+		if operation.ID == dto.ID {
+			r.operations[i] = dto
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
@@ -0,0 +1,32 @@
+package memrepo
+
+import (
+	"context"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type OrganizationBrandingRepository struct {
+	repository.IOrganizationBrandingRepository
+
+	records *organizationRecords
+}
+
+func NewOrganizationBrandingRepository() *OrganizationBrandingRepository {
+	return &OrganizationBrandingRepository{records: newOrganizationRecords()}
+}
+
+func (r *OrganizationBrandingRepository) Upsert(ctx context.Context, dto model.OrganizationBranding) error {
+	r.records.put(dto.OrganizationId, dto.OrganizationId)
+	return nil
+}
+
+func (r *OrganizationBrandingRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	return r.records.count(organizationId), nil
+}
+
+func (r *OrganizationBrandingRepository) Flush(ctx context.Context, organizationId string) error {
+	r.records.flush(organizationId)
+	return nil
+}
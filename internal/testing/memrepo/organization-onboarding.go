@@ -0,0 +1,63 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+type OrganizationOnboardingRepository struct {
+	repository.IOrganizationOnboardingRepository
+
+	mu    sync.RWMutex
+	steps map[string][]model.OrganizationOnboarding
+}
+
+func NewOrganizationOnboardingRepository() *OrganizationOnboardingRepository {
+	return &OrganizationOnboardingRepository{
+		steps: map[string][]model.OrganizationOnboarding{},
+	}
+}
+
+func (r *OrganizationOnboardingRepository) Fetch(ctx context.Context, organizationId string) ([]model.OrganizationOnboarding, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]model.OrganizationOnboarding{}, r.steps[organizationId]...), nil
+}
+
+func (r *OrganizationOnboardingRepository) Complete(ctx context.Context, organizationId string, step domain.OnboardingStep) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, completed := range r.steps[organizationId] {
+		if completed.Step == step {
+			return nil
+		}
+	}
+	r.steps[organizationId] = append(r.steps[organizationId], model.OrganizationOnboarding{
+		OrganizationId: organizationId,
+		Step:           step,
+		CompletedAt:    time.Now(),
+	})
+	return nil
+}
+
+func (r *OrganizationOnboardingRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.steps[organizationId])), nil
+}
+
+func (r *OrganizationOnboardingRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.steps, organizationId)
+	return nil
+}
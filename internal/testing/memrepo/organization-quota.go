@@ -0,0 +1,63 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type OrganizationQuotaRepository struct {
+	repository.IOrganizationQuotaRepository
+
+	mu     sync.RWMutex
+	quotas map[string]model.OrganizationQuota
+}
+
+func NewOrganizationQuotaRepository() *OrganizationQuotaRepository {
+	return &OrganizationQuotaRepository{
+		quotas: map[string]model.OrganizationQuota{},
+	}
+}
+
+func (r *OrganizationQuotaRepository) Get(ctx context.Context, organizationId string) (model.OrganizationQuota, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	quota, ok := r.quotas[organizationId]
+	if !ok {
+		return model.OrganizationQuota{}, gorm.ErrRecordNotFound
+	}
+	return quota, nil
+}
+
+func (r *OrganizationQuotaRepository) Upsert(ctx context.Context, dto model.OrganizationQuota) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.UpdatedAt = time.Now()
+	r.quotas[dto.OrganizationId] = dto
+	return nil
+}
+
+func (r *OrganizationQuotaRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.quotas[organizationId]; ok {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+func (r *OrganizationQuotaRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.quotas, organizationId)
+	return nil
+}
@@ -0,0 +1,47 @@
+package memrepo
+
+import (
+	"sync"
+)
+
+// organizationRecords only remembers which organization each record belongs to.
+// It backs repositories whose tests only need to seed records and count or flush them by organization.
+type organizationRecords struct {
+	mu      sync.RWMutex
+	records map[string]string
+}
+
+func newOrganizationRecords() *organizationRecords {
+	return &organizationRecords{records: map[string]string{}}
+}
+
+func (r *organizationRecords) put(key string, organizationId string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.records[key] = organizationId
+}
+
+func (r *organizationRecords) count(organizationId string) int64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, id := range r.records {
+		if id == organizationId {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *organizationRecords) flush(organizationId string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, id := range r.records {
+		if id == organizationId {
+			delete(r.records, key)
+		}
+	}
+}
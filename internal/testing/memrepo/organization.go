@@ -0,0 +1,105 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type OrganizationRepository struct {
+	repository.IOrganizationRepository
+
+	mu            sync.RWMutex
+	organizations map[string]model.Organization
+}
+
+func NewOrganizationRepository() *OrganizationRepository {
+	return &OrganizationRepository{
+		organizations: map[string]model.Organization{},
+	}
+}
+
+func (r *OrganizationRepository) Create(ctx context.Context, dto *model.Organization) (model.Organization, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.organizations[dto.ID] = *dto
+	return *dto, nil
+}
+
+func (r *OrganizationRepository) Fetch(ctx context.Context, pg *pagination.Pagination) (*[]model.Organization, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.Organization{}
+	for _, organization := range r.organizations {
+		out = append(out, organization)
+	}
+	return &out, nil
+}
+
+func (r *OrganizationRepository) Get(ctx context.Context, organizationId string) (model.Organization, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	organization, ok := r.organizations[organizationId]
+	if !ok {
+		return model.Organization{}, gorm.ErrRecordNotFound
+	}
+	return organization, nil
+}
+
+func (r *OrganizationRepository) Update(ctx context.Context, organizationId string, in model.Organization) (model.Organization, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	organization, ok := r.organizations[organizationId]
+	if !ok {
+		return model.Organization{}, gorm.ErrRecordNotFound
+	}
+	organization.Name = in.Name
+	organization.Description = in.Description
+	organization.Phone = in.Phone
+	r.organizations[organizationId] = organization
+	return organization, nil
+}
+
+func (r *OrganizationRepository) UpdatePrimaryClusterId(ctx context.Context, organizationId string, primaryClusterId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	organization, ok := r.organizations[organizationId]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	organization.PrimaryClusterId = primaryClusterId
+	r.organizations[organizationId] = organization
+	return nil
+}
+
+func (r *OrganizationRepository) UpdateAdminId(ctx context.Context, organizationId string, adminId uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	organization, ok := r.organizations[organizationId]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	organization.AdminId = &adminId
+	r.organizations[organizationId] = organization
+	return nil
+}
+
+func (r *OrganizationRepository) Delete(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.organizations, organizationId)
+	return nil
+}
@@ -0,0 +1,86 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type PasswordPolicyRepository struct {
+	repository.IPasswordPolicyRepository
+
+	mu        sync.RWMutex
+	policies  map[string]model.PasswordPolicy
+	histories map[uuid.UUID][]model.PasswordHistory
+}
+
+func NewPasswordPolicyRepository() *PasswordPolicyRepository {
+	return &PasswordPolicyRepository{
+		policies:  map[string]model.PasswordPolicy{},
+		histories: map[uuid.UUID][]model.PasswordHistory{},
+	}
+}
+
+func (r *PasswordPolicyRepository) Get(ctx context.Context, organizationId string) (model.PasswordPolicy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	policy, ok := r.policies[organizationId]
+	if !ok {
+		return model.PasswordPolicy{}, gorm.ErrRecordNotFound
+	}
+	return policy, nil
+}
+
+func (r *PasswordPolicyRepository) Upsert(ctx context.Context, dto model.PasswordPolicy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.UpdatedAt = time.Now()
+	r.policies[dto.OrganizationId] = dto
+	return nil
+}
+
+func (r *PasswordPolicyRepository) FetchHistories(ctx context.Context, userId uuid.UUID, limit int) ([]model.PasswordHistory, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	histories := r.histories[userId]
+	return append([]model.PasswordHistory{}, histories[:min(limit, len(histories))]...), nil
+}
+
+func (r *PasswordPolicyRepository) CreateHistory(ctx context.Context, dto model.PasswordHistory, keep int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	dto.CreatedAt = time.Now()
+	histories := append([]model.PasswordHistory{dto}, r.histories[dto.UserId]...)
+	r.histories[dto.UserId] = histories[:min(keep, len(histories))]
+	return nil
+}
+
+func (r *PasswordPolicyRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.policies[organizationId]; ok {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Flush only deletes the policy because histories are kept by user without the organization.
+func (r *PasswordPolicyRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.policies, organizationId)
+	return nil
+}
@@ -0,0 +1,33 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type ProjectRepository struct {
+	repository.IProjectRepository
+
+	mu       sync.RWMutex
+	projects []model.Project
+}
+
+func NewProjectRepository() *ProjectRepository {
+	return &ProjectRepository{}
+}
+
+func (r *ProjectRepository) GetProjectsByOrganizationId(ctx context.Context, organizationId string) ([]model.Project, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.Project{}
+	for _, project := range r.projects {
+		if project.OrganizationId == organizationId {
+			out = append(out, project)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,91 @@
+// Package memrepo provides in-memory repositories to run usecases without a database.
+//
+// Each repository embeds its interface, so calling a method that is not implemented here panics.
+// Implement the method here when a new test needs it.
+package memrepo
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+func New() repository.Repository {
+	organizations := NewOrganizationRepository()
+	clusters := NewClusterRepository()
+	encryptionKeys := NewEncryptionKeyRepository()
+	return repository.Repository{
+		Auth:                   NewAuthRepository(),
+		User:                   NewUserRepository(organizations),
+		Organization:           organizations,
+		Cluster:                clusters,
+		AppGroup:               NewAppGroupRepository(),
+		SystemNotification:     NewSystemNotificationRepository(),
+		Dashboard:              NewDashboardRepository(),
+		ClusterUtilization:     NewClusterUtilizationRepository(),
+		OrganizationOnboarding: NewOrganizationOnboardingRepository(),
+		LmaEndpoint:            NewLmaEndpointRepository(),
+		PasswordPolicy:         NewPasswordPolicyRepository(),
+		MaintenanceWindow:      NewMaintenanceWindowRepository(),
+		ClusterAccessRequest:   NewClusterAccessRequestRepository(),
+		Operation:              NewOperationRepository(),
+		Role:                   NewRoleRepository(),
+		RoleReassignment:       NewRoleReassignmentRepository(),
+		OrganizationQuota:      NewOrganizationQuotaRepository(),
+		CloudAccount:           NewCloudAccountRepository(),
+		AlertSilence:           NewAlertSilenceRepository(),
+		AlertRoutingRule:       NewAlertRoutingRuleRepository(),
+		AlertChannel:           NewAlertChannelRepository(),
+		StackWebhook:           NewStackWebhookRepository(),
+		Project:                NewProjectRepository(),
+		AppServeApp:            NewAppServeAppRepository(),
+		ChartSnapshot:          NewChartSnapshotRepository(),
+		CustomChart:            NewCustomChartRepository(),
+		AlertIngestionToken:    NewAlertIngestionTokenRepository(),
+		EscalationPolicy:       NewEscalationPolicyRepository(),
+		NotificationDigest:     NewNotificationDigestRepository(),
+		GitProvider:            NewGitProviderRepository(),
+		Catalog:                NewCatalogRepository(),
+		AuditSink:              NewAuditSinkRepository(),
+		EncryptionKey:          encryptionKeys,
+		SecretSealer:           repository.NewSecretSealer(encryptionKeys, nil, testMasterKey),
+		CostAllocationTag:      NewCostAllocationTagRepository(),
+		UserSession:            NewUserSessionRepository(),
+		NamingPolicy:           NewNamingPolicyRepository(),
+		OrganizationBranding:   NewOrganizationBrandingRepository(),
+		StackDefault:           NewStackDefaultRepository(),
+		ApiUsage:               NewApiUsageRepository(),
+	}
+}
+
+// FilterFunc of repositories are applied to a bare gorm.DB which only carries the filter values in its settings.
+func filterValues(filters ...repository.FilterFunc) map[string]string {
+	db := &gorm.DB{Config: &gorm.Config{}, Statement: &gorm.Statement{}}
+	for _, f := range filters {
+		db = f(db)
+	}
+
+	out := map[string]string{}
+	for _, key := range []string{filterAccountId, filterOrganization, filterEmail, filterName} {
+		if v, ok := db.Get(key); ok {
+			out[key] = v.(string)
+		}
+	}
+	return out
+}
+
+func setFilter(key string, value string) repository.FilterFunc {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Set(key, value)
+	}
+}
+
+// testMasterKey seals secrets of organizations without an encryption key. Tests do not call KMS.
+const testMasterKey = "memrepo-master-key"
+
+const (
+	filterAccountId    = "memrepo:account_id"
+	filterOrganization = "memrepo:organization_id"
+	filterEmail        = "memrepo:email"
+	filterName         = "memrepo:name"
+)
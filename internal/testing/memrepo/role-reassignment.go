@@ -0,0 +1,81 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+type RoleReassignmentRepository struct {
+	repository.IRoleReassignmentRepository
+
+	mu            sync.RWMutex
+	reassignments map[uuid.UUID]model.RoleReassignment
+	results       []model.RoleReassignmentResult
+}
+
+func NewRoleReassignmentRepository() *RoleReassignmentRepository {
+	return &RoleReassignmentRepository{reassignments: map[uuid.UUID]model.RoleReassignment{}}
+}
+
+func (r *RoleReassignmentRepository) Get(ctx context.Context, reassignmentId uuid.UUID) (model.RoleReassignment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reassignment, ok := r.reassignments[reassignmentId]
+	if !ok {
+		return model.RoleReassignment{}, gorm.ErrRecordNotFound
+	}
+	reassignment.Results = nil
+	for _, result := range r.results {
+		if result.ReassignmentId == reassignmentId {
+			reassignment.Results = append(reassignment.Results, result)
+		}
+	}
+	return reassignment, nil
+}
+
+func (r *RoleReassignmentRepository) CountRunning(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, reassignment := range r.reassignments {
+		if reassignment.OrganizationId == organizationId && reassignment.Status == domain.RoleReassignmentStatus_RUNNING {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *RoleReassignmentRepository) Create(ctx context.Context, dto model.RoleReassignment) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.reassignments[dto.ID] = dto
+	return dto.ID, nil
+}
+
+func (r *RoleReassignmentRepository) Update(ctx context.Context, dto model.RoleReassignment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reassignments[dto.ID] = dto
+	return nil
+}
+
+func (r *RoleReassignmentRepository) CreateResult(ctx context.Context, dto model.RoleReassignmentResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.results = append(r.results, dto)
+	return nil
+}
@@ -0,0 +1,64 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type RoleRepository struct {
+	repository.IRoleRepository
+
+	mu    sync.RWMutex
+	roles map[string]model.Role
+}
+
+func NewRoleRepository() *RoleRepository {
+	return &RoleRepository{roles: map[string]model.Role{}}
+}
+
+func (r *RoleRepository) Create(ctx context.Context, roleObj *model.Role) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.roles[roleObj.ID] = *roleObj
+	return roleObj.ID, nil
+}
+
+func (r *RoleRepository) GetTksRole(ctx context.Context, organizationId string, id string) (*model.Role, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	role, ok := r.roles[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &role, nil
+}
+
+func (r *RoleRepository) ListTksRoles(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]*model.Role, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []*model.Role{}
+	for _, role := range r.roles {
+		if role.OrganizationID == organizationId {
+			role := role
+			out = append(out, &role)
+		}
+	}
+	return out, nil
+}
+
+func (r *RoleRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.roles, id)
+	return nil
+}
@@ -0,0 +1,32 @@
+package memrepo
+
+import (
+	"context"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type StackDefaultRepository struct {
+	repository.IStackDefaultRepository
+
+	records *organizationRecords
+}
+
+func NewStackDefaultRepository() *StackDefaultRepository {
+	return &StackDefaultRepository{records: newOrganizationRecords()}
+}
+
+func (r *StackDefaultRepository) Upsert(ctx context.Context, dto model.StackDefault) error {
+	r.records.put(dto.OrganizationId, dto.OrganizationId)
+	return nil
+}
+
+func (r *StackDefaultRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	return r.records.count(organizationId), nil
+}
+
+func (r *StackDefaultRepository) Flush(ctx context.Context, organizationId string) error {
+	r.records.flush(organizationId)
+	return nil
+}
@@ -0,0 +1,58 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type StackWebhookRepository struct {
+	repository.IStackWebhookRepository
+
+	mu            sync.RWMutex
+	stackWebhooks []model.StackWebhook
+}
+
+func NewStackWebhookRepository() *StackWebhookRepository {
+	return &StackWebhookRepository{}
+}
+
+func (r *StackWebhookRepository) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.StackWebhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.StackWebhook{}
+	for _, stackWebhook := range r.stackWebhooks {
+		if stackWebhook.OrganizationId == organizationId {
+			out = append(out, stackWebhook)
+		}
+	}
+	return out, nil
+}
+
+func (r *StackWebhookRepository) Create(ctx context.Context, dto model.StackWebhook) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.stackWebhooks = append(r.stackWebhooks, dto)
+	return dto.ID, nil
+}
+
+func (r *StackWebhookRepository) Delete(ctx context.Context, stackWebhookId uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, stackWebhook := range r.stackWebhooks {
+		if stackWebhook.ID == stackWebhookId {
+			r.stackWebhooks = append(r.stackWebhooks[:i], r.stackWebhooks[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+type SystemNotificationRepository struct {
+	repository.ISystemNotificationRepository
+
+	mu                  sync.RWMutex
+	systemNotifications []model.SystemNotification
+}
+
+func NewSystemNotificationRepository() *SystemNotificationRepository {
+	return &SystemNotificationRepository{}
+}
+
+func (r *SystemNotificationRepository) FetchPodRestart(ctx context.Context, organizationId string, start time.Time, end time.Time) ([]model.SystemNotification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.SystemNotification{}
+	for _, systemNotification := range r.systemNotifications {
+		if systemNotification.OrganizationId == organizationId && systemNotification.Name == "pod-restart-frequently" &&
+			!systemNotification.CreatedAt.Before(start) && !systemNotification.CreatedAt.After(end) {
+			out = append(out, systemNotification)
+		}
+	}
+	return out, nil
+}
+
+func (r *SystemNotificationRepository) FetchBySeverity(ctx context.Context, organizationId string, severity string, start time.Time, end time.Time) ([]model.SystemNotification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.SystemNotification{}
+	for _, systemNotification := range r.systemNotifications {
+		if systemNotification.OrganizationId == organizationId && systemNotification.NotificationType == "SYSTEM_NOTIFICATION" &&
+			strings.EqualFold(systemNotification.Severity, severity) && systemNotification.Status != domain.SystemNotificationActionStatus_SILENCED &&
+			!systemNotification.CreatedAt.Before(start) && !systemNotification.CreatedAt.After(end) {
+			out = append(out, systemNotification)
+		}
+	}
+	return out, nil
+}
+
+func (r *SystemNotificationRepository) FetchByPeriod(ctx context.Context, organizationId string, start time.Time, end time.Time) ([]model.SystemNotification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.SystemNotification{}
+	for _, systemNotification := range r.systemNotifications {
+		if systemNotification.OrganizationId == organizationId && systemNotification.NotificationType == "SYSTEM_NOTIFICATION" &&
+			!systemNotification.CreatedAt.Before(start) && !systemNotification.CreatedAt.After(end) {
+			out = append(out, systemNotification)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	return out, nil
+}
+
+func (r *SystemNotificationRepository) FetchOpenSeverityCounts(ctx context.Context, organizationId string) ([]model.SystemNotificationSeverityCount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := map[model.SystemNotificationSeverityCount]int{}
+	for _, systemNotification := range r.systemNotifications {
+		if systemNotification.OrganizationId != organizationId || systemNotification.NotificationType != "SYSTEM_NOTIFICATION" {
+			continue
+		}
+		if systemNotification.Status != domain.SystemNotificationActionStatus_CREATED && systemNotification.Status != domain.SystemNotificationActionStatus_INPROGRESS &&
+			systemNotification.Status != domain.SystemNotificationActionStatus_ESCALATED {
+			continue
+		}
+		counts[model.SystemNotificationSeverityCount{ClusterId: systemNotification.ClusterId, Severity: systemNotification.Severity}]++
+	}
+
+	out := []model.SystemNotificationSeverityCount{}
+	for key, count := range counts {
+		key.Count = count
+		out = append(out, key)
+	}
+	return out, nil
+}
+
+func (r *SystemNotificationRepository) Create(ctx context.Context, dto model.SystemNotification) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	if dto.Status != domain.SystemNotificationActionStatus_SILENCED {
+		dto.Status = domain.SystemNotificationActionStatus_CREATED
+	}
+	if dto.NotificationType == "" {
+		dto.NotificationType = "SYSTEM_NOTIFICATION"
+	}
+	if dto.CreatedAt.IsZero() {
+		dto.CreatedAt = time.Now()
+	}
+	r.systemNotifications = append(r.systemNotifications, dto)
+	return dto.ID, nil
+}
+
+func (r *SystemNotificationRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, systemNotification := range r.systemNotifications {
+		if systemNotification.OrganizationId == organizationId {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *SystemNotificationRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	systemNotifications := []model.SystemNotification{}
+	for _, systemNotification := range r.systemNotifications {
+		if systemNotification.OrganizationId != organizationId {
+			systemNotifications = append(systemNotifications, systemNotification)
+		}
+	}
+	r.systemNotifications = systemNotifications
+	return nil
+}
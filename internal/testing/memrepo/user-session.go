@@ -0,0 +1,90 @@
+package memrepo
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+)
+
+type UserSessionRepository struct {
+	repository.IUserSessionRepository
+
+	mu           sync.RWMutex
+	userSessions map[uuid.UUID]model.UserSession
+}
+
+func NewUserSessionRepository() *UserSessionRepository {
+	return &UserSessionRepository{userSessions: map[uuid.UUID]model.UserSession{}}
+}
+
+func (r *UserSessionRepository) Fetch(ctx context.Context, userId uuid.UUID, pg *pagination.Pagination) ([]model.UserSession, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.UserSession{}
+	for _, userSession := range r.userSessions {
+		if userSession.UserId == userId {
+			out = append(out, userSession)
+		}
+	}
+	return out, nil
+}
+
+func (r *UserSessionRepository) Get(ctx context.Context, userSessionId uuid.UUID) (model.UserSession, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	userSession, ok := r.userSessions[userSessionId]
+	if !ok {
+		return model.UserSession{}, gorm.ErrRecordNotFound
+	}
+	return userSession, nil
+}
+
+func (r *UserSessionRepository) Create(ctx context.Context, dto model.UserSession) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dto.ID = uuid.New()
+	r.userSessions[dto.ID] = dto
+	return dto.ID, nil
+}
+
+func (r *UserSessionRepository) Delete(ctx context.Context, userSessionId uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.userSessions, userSessionId)
+	return nil
+}
+
+func (r *UserSessionRepository) CountByOrganizationId(ctx context.Context, organizationId string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, userSession := range r.userSessions {
+		if userSession.OrganizationId == organizationId {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *UserSessionRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, userSession := range r.userSessions {
+		if userSession.OrganizationId == organizationId {
+			delete(r.userSessions, id)
+		}
+	}
+	return nil
+}
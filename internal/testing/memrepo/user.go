@@ -0,0 +1,262 @@
+package memrepo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+type UserRepository struct {
+	repository.IUserRepository
+
+	mu            sync.RWMutex
+	users         map[uuid.UUID]model.User
+	organizations *OrganizationRepository
+}
+
+func NewUserRepository(organizations *OrganizationRepository) *UserRepository {
+	return &UserRepository{
+		users:         map[uuid.UUID]model.User{},
+		organizations: organizations,
+	}
+}
+
+func (r *UserRepository) Create(ctx context.Context, user *model.User) (*model.User, error) {
+	r.mu.Lock()
+	if user.ID == uuid.Nil {
+		user.ID = uuid.New()
+	}
+	if user.OrganizationId == "" {
+		user.OrganizationId = user.Organization.ID
+	}
+	user.PasswordUpdatedAt = time.Now()
+	r.users[user.ID] = *user
+	r.mu.Unlock()
+
+	out, err := r.GetByUuid(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (r *UserRepository) List(ctx context.Context, filters ...repository.FilterFunc) (*[]model.User, error) {
+	values := filterValues(filters...)
+	out := r.find(func(user model.User) bool {
+		for key, value := range values {
+			switch key {
+			case filterAccountId:
+				if user.AccountId != value {
+					return false
+				}
+			case filterOrganization:
+				if user.OrganizationId != value {
+					return false
+				}
+			case filterEmail:
+				if user.Email != value {
+					return false
+				}
+			case filterName:
+				if user.Name != value {
+					return false
+				}
+			}
+		}
+		return true
+	})
+	if len(out) == 0 {
+		return nil, httpErrors.NewNotFoundError(httpErrors.NotFound, "", "")
+	}
+	return &out, nil
+}
+
+func (r *UserRepository) ListWithPagination(ctx context.Context, pg *pagination.Pagination, organizationId string) (*[]model.User, error) {
+	out := r.find(func(user model.User) bool {
+		return user.OrganizationId == organizationId
+	})
+	return &out, nil
+}
+
+func (r *UserRepository) ListUsersByRole(ctx context.Context, organizationId string, roleId string, pg *pagination.Pagination) (*[]model.User, error) {
+	out := r.find(func(user model.User) bool {
+		if user.OrganizationId != organizationId {
+			return false
+		}
+		for _, role := range user.Roles {
+			if role.ID == roleId {
+				return true
+			}
+		}
+		return false
+	})
+	return &out, nil
+}
+
+func (r *UserRepository) Get(ctx context.Context, accountId string, organizationId string) (model.User, error) {
+	out := r.find(func(user model.User) bool {
+		return user.AccountId == accountId && user.OrganizationId == organizationId
+	})
+	if len(out) == 0 {
+		return model.User{}, httpErrors.NewNotFoundError(httpErrors.NotFound, "", "")
+	}
+	return out[0], nil
+}
+
+func (r *UserRepository) GetByUuid(ctx context.Context, userId uuid.UUID) (model.User, error) {
+	out := r.find(func(user model.User) bool {
+		return user.ID == userId
+	})
+	if len(out) == 0 {
+		return model.User{}, httpErrors.NewNotFoundError(httpErrors.NotFound, "", "")
+	}
+	return out[0], nil
+}
+
+func (r *UserRepository) Update(ctx context.Context, user *model.User) (*model.User, error) {
+	r.mu.Lock()
+	stored, ok := r.users[user.ID]
+	if ok {
+		stored.Name = user.Name
+		stored.Email = user.Email
+		stored.Department = user.Department
+		stored.Description = user.Description
+		stored.Roles = user.Roles
+		r.users[user.ID] = stored
+	}
+	r.mu.Unlock()
+
+	out, err := r.GetByUuid(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (r *UserRepository) UpdatePasswordAt(ctx context.Context, userId uuid.UUID, organizationId string, isTemporary bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[userId]
+	if !ok || user.OrganizationId != organizationId {
+		return httpErrors.NewNotFoundError(httpErrors.NotFound, "", "")
+	}
+	if isTemporary {
+		user.PasswordUpdatedAt = time.Time{}
+	} else {
+		user.PasswordUpdatedAt = time.Now()
+	}
+	r.users[userId] = user
+	return nil
+}
+
+func (r *UserRepository) UpdateOtp(ctx context.Context, userId uuid.UUID, otpSecret string, otpEnabled bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[userId]
+	if !ok {
+		return httpErrors.NewNotFoundError(httpErrors.NotFound, "", "")
+	}
+	user.OtpSecret = otpSecret
+	user.OtpEnabled = otpEnabled
+	r.users[userId] = user
+	return nil
+}
+
+func (r *UserRepository) UseOtpCounter(ctx context.Context, userId uuid.UUID, counter int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[userId]
+	if !ok || user.OtpLastCounter >= counter {
+		return false, nil
+	}
+	user.OtpLastCounter = counter
+	user.OtpFailedAttempts = 0
+	user.OtpLockedUntil = nil
+	r.users[userId] = user
+	return true, nil
+}
+
+func (r *UserRepository) IncreaseOtpFailedAttempts(ctx context.Context, userId uuid.UUID, maxAttempts int, lockedUntil time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[userId]
+	if !ok {
+		return httpErrors.NewNotFoundError(httpErrors.NotFound, "", "")
+	}
+	user.OtpFailedAttempts++
+	if user.OtpFailedAttempts >= maxAttempts {
+		user.OtpFailedAttempts = 0
+		user.OtpLockedUntil = &lockedUntil
+	}
+	r.users[userId] = user
+	return nil
+}
+
+func (r *UserRepository) DeleteWithUuid(ctx context.Context, userId uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.users, userId)
+	return nil
+}
+
+func (r *UserRepository) Flush(ctx context.Context, organizationId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, user := range r.users {
+		if user.OrganizationId == organizationId {
+			delete(r.users, id)
+		}
+	}
+	return nil
+}
+
+func (r *UserRepository) AccountIdFilter(accountId string) repository.FilterFunc {
+	return setFilter(filterAccountId, accountId)
+}
+
+func (r *UserRepository) OrganizationFilter(organization string) repository.FilterFunc {
+	return setFilter(filterOrganization, organization)
+}
+
+func (r *UserRepository) EmailFilter(email string) repository.FilterFunc {
+	return setFilter(filterEmail, email)
+}
+
+func (r *UserRepository) NameFilter(name string) repository.FilterFunc {
+	return setFilter(filterName, name)
+}
+
+// find returns the users matched with the organization preloaded, in order of account id.
+func (r *UserRepository) find(match func(user model.User) bool) []model.User {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := []model.User{}
+	for _, user := range r.users {
+		if !match(user) {
+			continue
+		}
+		if organization, err := r.organizations.Get(context.Background(), user.OrganizationId); err == nil {
+			user.Organization = organization
+		}
+		out = append(out, user)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].AccountId < out[j].AccountId
+	})
+	return out
+}
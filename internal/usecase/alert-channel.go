@@ -0,0 +1,287 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/mail"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	alertchannel "github.com/openinfradev/tks-api/pkg/alert-channel"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	alertDispatchQueueSize    = 1000
+	alertDispatchWorkerCount  = 4
+	alertDispatchMaxAttempts  = 3
+	alertDispatchRetryBackoff = time.Second
+)
+
+type IAlertChannelUsecase interface {
+	Create(ctx context.Context, dto model.AlertChannel) (alertChannelId uuid.UUID, err error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertChannel, error)
+	Get(ctx context.Context, organizationId string, alertChannelId uuid.UUID) (model.AlertChannel, error)
+	Update(ctx context.Context, dto model.AlertChannel) error
+	Delete(ctx context.Context, organizationId string, alertChannelId uuid.UUID) error
+	Test(ctx context.Context, organizationId string, alertChannelId uuid.UUID) error
+	Dispatch(ctx context.Context, notification model.SystemNotification, alertChannels []model.AlertChannel)
+}
+
+type AlertChannelUsecase struct {
+	repo          repository.IAlertChannelRepository
+	encryptionKey IEncryptionKeyUsecase
+	queue         chan alertDispatch
+}
+
+type alertDispatch struct {
+	alertChannel model.AlertChannel
+	message      alertchannel.Message
+}
+
+// NewAlertChannelUsecase 는 앨럿을 채널로 보내는 worker 를 함께 시작한다.
+func NewAlertChannelUsecase(r repository.Repository, encryptionKey IEncryptionKeyUsecase) IAlertChannelUsecase {
+	u := &AlertChannelUsecase{
+		repo:          r.AlertChannel,
+		encryptionKey: encryptionKey,
+		queue:         make(chan alertDispatch, alertDispatchQueueSize),
+	}
+	for i := 0; i < alertDispatchWorkerCount; i++ {
+		go u.run()
+	}
+	return u
+}
+
+// Create 는 secret, smtpPassword 를 봉인해 저장한다.
+func (u *AlertChannelUsecase) Create(ctx context.Context, dto model.AlertChannel) (alertChannelId uuid.UUID, err error) {
+	if err := validateAlertChannel(ctx, dto); err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "AC_INVALID_ALERT_CHANNEL")
+	}
+	if err := u.sealSecrets(ctx, &dto); err != nil {
+		return uuid.Nil, err
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.CreatorId = &userId
+	}
+
+	alertChannelId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return alertChannelId, nil
+}
+
+func (u *AlertChannelUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertChannel, error) {
+	return u.repo.Fetch(ctx, organizationId, pg)
+}
+
+func (u *AlertChannelUsecase) Get(ctx context.Context, organizationId string, alertChannelId uuid.UUID) (model.AlertChannel, error) {
+	alertChannel, err := u.repo.Get(ctx, alertChannelId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.AlertChannel{}, httpErrors.NewError(err, "AC_NOT_FOUND_ALERT_CHANNEL")
+		}
+		return model.AlertChannel{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if alertChannel.OrganizationId != organizationId {
+		return model.AlertChannel{}, httpErrors.NewError(fmt.Errorf("not found alert channel in organization"), "AC_NOT_FOUND_ALERT_CHANNEL")
+	}
+	return alertChannel, nil
+}
+
+// Update 는 채널의 type 을 변경하지 않는다. secret, smtpPassword 가 비어 있으면 기존 값을 유지한다.
+func (u *AlertChannelUsecase) Update(ctx context.Context, dto model.AlertChannel) error {
+	alertChannel, err := u.Get(ctx, dto.OrganizationId, dto.ID)
+	if err != nil {
+		return err
+	}
+	dto.Type = alertChannel.Type
+	if err := u.sealSecrets(ctx, &dto); err != nil {
+		return err
+	}
+	if dto.Secret == "" {
+		dto.Secret = alertChannel.Secret
+	}
+	if dto.SmtpPassword == "" {
+		dto.SmtpPassword = alertChannel.SmtpPassword
+	}
+	if err := validateAlertChannel(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "AC_INVALID_ALERT_CHANNEL")
+	}
+
+	if err := u.repo.Update(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *AlertChannelUsecase) Delete(ctx context.Context, organizationId string, alertChannelId uuid.UUID) error {
+	if _, err := u.Get(ctx, organizationId, alertChannelId); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, alertChannelId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// Test 는 예시 앨럿을 재시도 없이 바로 보내고 결과를 반환한다. 비활성화된 채널도 확인할 수 있다.
+func (u *AlertChannelUsecase) Test(ctx context.Context, organizationId string, alertChannelId uuid.UUID) error {
+	alertChannel, err := u.Get(ctx, organizationId, alertChannelId)
+	if err != nil {
+		return err
+	}
+
+	message := alertchannel.Message{
+		OrganizationId: organizationId,
+		AlertName:      "TestAlert",
+		Severity:       "info",
+		Title:          "TKS 앨럿 채널 테스트",
+		Content:        fmt.Sprintf("앨럿 채널 [%s] 의 설정을 확인하기 위한 테스트 메시지입니다.", alertChannel.Name),
+		CreatedAt:      time.Now(),
+	}
+	err = u.send(ctx, alertChannel, message)
+	u.updateLastStatus(ctx, alertChannel, err)
+	if err != nil {
+		return httpErrors.NewError(err, "AC_FAILED_TO_SEND")
+	}
+	return nil
+}
+
+// Dispatch 는 앨럿 수신을 지연시키지 않도록 채널 전송을 worker 에 맡긴다. queue 가 가득 차면 전송하지 않고 실패로 기록한다.
+func (u *AlertChannelUsecase) Dispatch(ctx context.Context, notification model.SystemNotification, alertChannels []model.AlertChannel) {
+	message := alertChannelMessage(notification)
+	for _, alertChannel := range alertChannels {
+		if !alertChannel.Enabled {
+			continue
+		}
+		select {
+		case u.queue <- alertDispatch{alertChannel: alertChannel, message: message}:
+		default:
+			log.Warnf(ctx, "alert dispatch queue is full. systemNotification %s is not sent to alert channel %s", notification.ID, alertChannel.ID)
+			u.updateLastStatus(ctx, alertChannel, fmt.Errorf("alert dispatch queue is full"))
+		}
+	}
+}
+
+func (u *AlertChannelUsecase) run() {
+	for dispatch := range u.queue {
+		u.deliver(context.Background(), dispatch)
+	}
+}
+
+func (u *AlertChannelUsecase) deliver(ctx context.Context, dispatch alertDispatch) {
+	var err error
+	backoff := alertDispatchRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err = u.send(ctx, dispatch.alertChannel, dispatch.message)
+		if err == nil || attempt >= alertDispatchMaxAttempts {
+			break
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	if err != nil {
+		log.Warnf(ctx, "failed to send alert %s to alert channel %s. err : %s", dispatch.message.AlertName, dispatch.alertChannel.ID, err)
+	}
+	u.updateLastStatus(ctx, dispatch.alertChannel, err)
+}
+
+// send 는 SMTP 채널에 메일 서버를 지정하지 않으면 시스템 메일 서버로 보낸다.
+func (u *AlertChannelUsecase) send(ctx context.Context, alertChannel model.AlertChannel, message alertchannel.Message) (err error) {
+	if alertChannel.Type == alertchannel.Type_SMTP && alertChannel.SmtpHost == "" {
+		mailMessage, err := mail.MakeSystemNotificationMessage(ctx, message.OrganizationId, message.Subject(), message.Content, alertChannel.Recipients)
+		if err != nil {
+			return err
+		}
+		return mail.New(mailMessage).SendMail(ctx)
+	}
+
+	if alertChannel.Secret, err = openOrganizationSecret(ctx, u.encryptionKey, alertChannel.Secret); err != nil {
+		return err
+	}
+	if alertChannel.SmtpPassword, err = openOrganizationSecret(ctx, u.encryptionKey, alertChannel.SmtpPassword); err != nil {
+		return err
+	}
+	sender, err := alertchannel.New(alertChannelConfig(alertChannel))
+	if err != nil {
+		return err
+	}
+	return sender.Send(ctx, message)
+}
+
+func (u *AlertChannelUsecase) sealSecrets(ctx context.Context, alertChannel *model.AlertChannel) (err error) {
+	if alertChannel.Secret, err = sealOrganizationSecret(ctx, u.encryptionKey, alertChannel.OrganizationId, alertChannel.Secret); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if alertChannel.SmtpPassword, err = sealOrganizationSecret(ctx, u.encryptionKey, alertChannel.OrganizationId, alertChannel.SmtpPassword); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *AlertChannelUsecase) updateLastStatus(ctx context.Context, alertChannel model.AlertChannel, sendErr error) {
+	status, reason := domain.AlertChannelStatus_SUCCEEDED, ""
+	if sendErr != nil {
+		status, reason = domain.AlertChannelStatus_FAILED, sendErr.Error()
+	}
+	if err := u.repo.UpdateLastStatus(ctx, alertChannel.ID, status, reason, time.Now()); err != nil {
+		log.Error(ctx, err)
+	}
+}
+
+func validateAlertChannel(ctx context.Context, alertChannel model.AlertChannel) error {
+	if alertChannel.Type == alertchannel.Type_SMTP && alertChannel.SmtpHost == "" {
+		return alertchannel.ValidateRecipients(alertChannel.Recipients)
+	}
+	if _, err := alertchannel.New(alertChannelConfig(alertChannel)); err != nil {
+		return err
+	}
+	if alertChannel.Type == alertchannel.Type_SMTP {
+		return helper.ValidateOutboundHost(ctx, alertChannel.SmtpHost)
+	}
+	return helper.ValidateOutboundUrl(ctx, alertChannel.Url)
+}
+
+func alertChannelConfig(alertChannel model.AlertChannel) alertchannel.Config {
+	return alertchannel.Config{
+		Type:         alertChannel.Type,
+		Url:          alertChannel.Url,
+		Secret:       alertChannel.Secret,
+		Recipients:   alertChannel.Recipients,
+		SmtpHost:     alertChannel.SmtpHost,
+		SmtpPort:     alertChannel.SmtpPort,
+		SmtpUsername: alertChannel.SmtpUsername,
+		SmtpPassword: alertChannel.SmtpPassword,
+		SmtpFrom:     alertChannel.SmtpFrom,
+	}
+}
+
+func alertChannelMessage(notification model.SystemNotification) alertchannel.Message {
+	createdAt := notification.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return alertchannel.Message{
+		OrganizationId: notification.OrganizationId,
+		ClusterId:      notification.ClusterId.String(),
+		AlertName:      notification.Name,
+		Severity:       notification.Severity,
+		Title:          notification.MessageTitle,
+		Content:        notification.MessageContent,
+		Summary:        notification.Summary,
+		Status:         notification.Status.String(),
+		CreatedAt:      createdAt,
+	}
+}
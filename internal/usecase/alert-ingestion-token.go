@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	alertIngestionTokenPrefix = "tks_alert_"
+	alertIngestionTokenLength = 40
+)
+
+type IAlertIngestionTokenUsecase interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertIngestionToken, error)
+	Create(ctx context.Context, dto model.AlertIngestionToken) (tokenId uuid.UUID, token string, err error)
+	Rotate(ctx context.Context, organizationId string, tokenId uuid.UUID) (token string, err error)
+	Revoke(ctx context.Context, organizationId string, tokenId uuid.UUID) error
+}
+
+type AlertIngestionTokenUsecase struct {
+	repo             repository.IAlertIngestionTokenRepository
+	organizationRepo repository.IOrganizationRepository
+}
+
+func NewAlertIngestionTokenUsecase(r repository.Repository) IAlertIngestionTokenUsecase {
+	return &AlertIngestionTokenUsecase{
+		repo:             r.AlertIngestionToken,
+		organizationRepo: r.Organization,
+	}
+}
+
+func (u *AlertIngestionTokenUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertIngestionToken, error) {
+	tokens, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return tokens, nil
+}
+
+func (u *AlertIngestionTokenUsecase) Create(ctx context.Context, dto model.AlertIngestionToken) (tokenId uuid.UUID, token string, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, "", httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	userId := user.GetUserId()
+	dto.CreatorId = &userId
+
+	if _, err = u.organizationRepo.Get(ctx, dto.OrganizationId); err != nil {
+		return uuid.Nil, "", httpErrors.NewNotFoundError(err, "", "")
+	}
+
+	token = generateAlertIngestionToken()
+	dto.TokenHash = helper.HashToken(token)
+	dto.TokenPrefix = alertIngestionTokenDisplayPrefix(token)
+
+	tokenId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, "", httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	log.Info(ctx, "newly created alert ingestion token : ", tokenId)
+
+	return tokenId, token, nil
+}
+
+// Rotate 는 기존 token 을 즉시 무효화하고 새로운 token 을 발급한다.
+func (u *AlertIngestionTokenUsecase) Rotate(ctx context.Context, organizationId string, tokenId uuid.UUID) (token string, err error) {
+	if _, err = u.get(ctx, organizationId, tokenId); err != nil {
+		return "", err
+	}
+
+	token = generateAlertIngestionToken()
+	if err = u.repo.UpdateTokenHash(ctx, tokenId, helper.HashToken(token), alertIngestionTokenDisplayPrefix(token)); err != nil {
+		return "", httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return token, nil
+}
+
+func (u *AlertIngestionTokenUsecase) Revoke(ctx context.Context, organizationId string, tokenId uuid.UUID) error {
+	if _, err := u.get(ctx, organizationId, tokenId); err != nil {
+		return err
+	}
+
+	if err := u.repo.Delete(ctx, tokenId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *AlertIngestionTokenUsecase) get(ctx context.Context, organizationId string, tokenId uuid.UUID) (out model.AlertIngestionToken, err error) {
+	out, err = u.repo.Get(ctx, tokenId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewError(err, "AIT_NOT_FOUND_TOKEN")
+		}
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if out.OrganizationId != organizationId {
+		return model.AlertIngestionToken{}, httpErrors.NewError(fmt.Errorf("not found token in organization"), "AIT_NOT_FOUND_TOKEN")
+	}
+	return
+}
+
+func generateAlertIngestionToken() string {
+	return alertIngestionTokenPrefix + helper.GenerateRandomString(alertIngestionTokenLength)
+}
+
+// alertIngestionTokenDisplayPrefix returns the part of the token shown to identify it in the list
+func alertIngestionTokenDisplayPrefix(token string) string {
+	return token[:len(alertIngestionTokenPrefix)+4]
+}
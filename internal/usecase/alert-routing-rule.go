@@ -0,0 +1,379 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IAlertRoutingRuleUsecase interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertRoutingRule, error)
+	Get(ctx context.Context, organizationId string, alertRoutingRuleId uuid.UUID) (model.AlertRoutingRule, error)
+	Create(ctx context.Context, dto model.AlertRoutingRule) (alertRoutingRuleId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.AlertRoutingRule) error
+	Delete(ctx context.Context, organizationId string, alertRoutingRuleId uuid.UUID) error
+	Test(ctx context.Context, organizationId string, sample domain.TestAlertRoutingRulesRequest) ([]model.AlertRoutingRule, error)
+	Route(ctx context.Context, notification model.SystemNotification, namespace string) error
+}
+
+type AlertRoutingRuleUsecase struct {
+	repo                 repository.IAlertRoutingRuleRepository
+	organizationRepo     repository.IOrganizationRepository
+	clusterRepo          repository.IClusterRepository
+	userRepo             repository.IUserRepository
+	alertChannelRepo     repository.IAlertChannelRepository
+	escalationPolicyRepo repository.IEscalationPolicyRepository
+	notificationDigest   INotificationDigestUsecase
+	alertChannel         IAlertChannelUsecase
+	escalationPolicy     IEscalationPolicyUsecase
+}
+
+func NewAlertRoutingRuleUsecase(r repository.Repository, notificationDigest INotificationDigestUsecase, alertChannel IAlertChannelUsecase, escalationPolicy IEscalationPolicyUsecase) IAlertRoutingRuleUsecase {
+	return &AlertRoutingRuleUsecase{
+		repo:                 r.AlertRoutingRule,
+		organizationRepo:     r.Organization,
+		clusterRepo:          r.Cluster,
+		userRepo:             r.User,
+		alertChannelRepo:     r.AlertChannel,
+		escalationPolicyRepo: r.EscalationPolicy,
+		notificationDigest:   notificationDigest,
+		alertChannel:         alertChannel,
+		escalationPolicy:     escalationPolicy,
+	}
+}
+
+func (u *AlertRoutingRuleUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertRoutingRule, error) {
+	rules, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	for i := range rules {
+		decodeAlertRoutingRule(ctx, &rules[i])
+	}
+	return rules, nil
+}
+
+func (u *AlertRoutingRuleUsecase) Get(ctx context.Context, organizationId string, alertRoutingRuleId uuid.UUID) (out model.AlertRoutingRule, err error) {
+	out, err = u.repo.Get(ctx, alertRoutingRuleId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewError(err, "ARR_NOT_FOUND_RULE")
+		}
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if out.OrganizationId != organizationId {
+		return model.AlertRoutingRule{}, httpErrors.NewError(fmt.Errorf("not found alertRoutingRule in organization"), "ARR_NOT_FOUND_RULE")
+	}
+	decodeAlertRoutingRule(ctx, &out)
+	return
+}
+
+func (u *AlertRoutingRuleUsecase) Create(ctx context.Context, dto model.AlertRoutingRule) (alertRoutingRuleId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	userId := user.GetUserId()
+	dto.CreatorId = &userId
+	dto.UpdatorId = &userId
+
+	if _, err = u.organizationRepo.Get(ctx, dto.OrganizationId); err != nil {
+		return uuid.Nil, httpErrors.NewNotFoundError(err, "", "")
+	}
+	if err = u.prepare(ctx, &dto); err != nil {
+		return uuid.Nil, err
+	}
+
+	alertRoutingRuleId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	log.Info(ctx, "newly created alert routing rule : ", alertRoutingRuleId)
+
+	return alertRoutingRuleId, nil
+}
+
+func (u *AlertRoutingRuleUsecase) Update(ctx context.Context, dto model.AlertRoutingRule) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	userId := user.GetUserId()
+	dto.UpdatorId = &userId
+
+	if _, err := u.Get(ctx, dto.OrganizationId, dto.ID); err != nil {
+		return err
+	}
+	if err := u.prepare(ctx, &dto); err != nil {
+		return err
+	}
+
+	if err := u.repo.Update(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *AlertRoutingRuleUsecase) Delete(ctx context.Context, organizationId string, alertRoutingRuleId uuid.UUID) error {
+	if _, err := u.Get(ctx, organizationId, alertRoutingRuleId); err != nil {
+		return err
+	}
+
+	if err := u.repo.Delete(ctx, alertRoutingRuleId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// Test 는 예시 앨럿이 일치하는 규칙을 확인하는 순서대로 반환한다. 알림은 보내지 않는다.
+func (u *AlertRoutingRuleUsecase) Test(ctx context.Context, organizationId string, sample domain.TestAlertRoutingRulesRequest) ([]model.AlertRoutingRule, error) {
+	clusterTags := map[string]string{}
+	if sample.ClusterId != "" {
+		cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(sample.ClusterId))
+		if err != nil || cluster.OrganizationId != organizationId {
+			return nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid clusterId"), "C_INVALID_CLUSTER_ID", "")
+		}
+		for key, value := range cluster.Tags {
+			clusterTags[key] = value
+		}
+	}
+	for key, value := range sample.ClusterTags {
+		clusterTags[key] = value
+	}
+
+	rules, err := u.repo.FetchEnabled(ctx, organizationId)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return routeAlert(ctx, rules, alertRoutingSample{
+		clusterId:   sample.ClusterId,
+		clusterTags: clusterTags,
+		namespace:   sample.Namespace,
+		severity:    sample.Severity,
+		alertName:   sample.AlertName,
+	}), nil
+}
+
+// Route 는 수신한 앨럿과 일치하는 규칙의 채널로 알림을 보낸다. 앨럿 채널로는 비동기로 보낸다.
+// 일치한 규칙의 에스컬레이션 정책 중 앨럿에 적용되는 첫 번째 정책으로 에스컬레이션을 예약한다.
+func (u *AlertRoutingRuleUsecase) Route(ctx context.Context, notification model.SystemNotification, namespace string) error {
+	rules, err := u.repo.FetchEnabled(ctx, notification.OrganizationId)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	clusterTags := map[string]string{}
+	if cluster, err := u.clusterRepo.Get(ctx, notification.ClusterId); err == nil {
+		clusterTags = cluster.Tags
+	}
+
+	matched := routeAlert(ctx, rules, alertRoutingSample{
+		clusterId:   notification.ClusterId.String(),
+		clusterTags: clusterTags,
+		namespace:   namespace,
+		severity:    notification.Severity,
+		alertName:   notification.Name,
+	})
+
+	emailUsers := []model.User{}
+	userIds := map[uuid.UUID]bool{}
+	alertChannels := []model.AlertChannel{}
+	alertChannelIds := map[uuid.UUID]bool{}
+	escalationPolicyIds := []uuid.UUID{}
+	for _, rule := range matched {
+		log.Infof(ctx, "systemNotification %s matched alert routing rule %s", notification.ID, rule.ID)
+		if rule.EscalationPolicyId != nil {
+			escalationPolicyIds = append(escalationPolicyIds, *rule.EscalationPolicyId)
+		}
+		for _, channel := range rule.Channels {
+			if channel != domain.NotificationChannel_EMAIL {
+				continue
+			}
+			for _, user := range rule.TargetUsers {
+				if !userIds[user.ID] {
+					userIds[user.ID] = true
+					emailUsers = append(emailUsers, user)
+				}
+			}
+		}
+		for _, alertChannel := range rule.AlertChannels {
+			if !alertChannelIds[alertChannel.ID] {
+				alertChannelIds[alertChannel.ID] = true
+				alertChannels = append(alertChannels, alertChannel)
+			}
+		}
+	}
+
+	if len(escalationPolicyIds) > 0 {
+		if err := u.escalationPolicy.Start(ctx, notification, escalationPolicyIds); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	if len(alertChannels) > 0 {
+		u.alertChannel.Dispatch(ctx, notification, alertChannels)
+	}
+	if len(emailUsers) > 0 {
+		return u.notificationDigest.NotifyByEmail(ctx, notification, emailUsers)
+	}
+	return nil
+}
+
+// prepare 는 조건을 검증하고 저장할 형식으로 변환한다.
+func (u *AlertRoutingRuleUsecase) prepare(ctx context.Context, dto *model.AlertRoutingRule) error {
+	patterns := append(append([]string{}, dto.Match.Namespaces...), dto.Match.AlertNames...)
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return httpErrors.NewBadRequestError(fmt.Errorf("invalid pattern %s", pattern), "ARR_INVALID_MATCH", "")
+		}
+	}
+
+	for _, clusterId := range dto.Match.ClusterIds {
+		cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(clusterId))
+		if err != nil || cluster.OrganizationId != dto.OrganizationId {
+			return httpErrors.NewBadRequestError(fmt.Errorf("Invalid clusterId %s", clusterId), "C_INVALID_CLUSTER_ID", "")
+		}
+	}
+
+	// 알림 채널
+	dto.AlertChannels = make([]model.AlertChannel, 0)
+	if len(dto.AlertChannelIds) > 0 {
+		alertChannelIds := make([]uuid.UUID, 0, len(dto.AlertChannelIds))
+		for _, strId := range dto.AlertChannelIds {
+			alertChannelId, err := uuid.Parse(strId)
+			if err != nil {
+				return httpErrors.NewBadRequestError(err, "AC_INVALID_ALERT_CHANNEL_ID", "")
+			}
+			alertChannelIds = append(alertChannelIds, alertChannelId)
+		}
+		alertChannels, err := u.alertChannelRepo.FetchByIds(ctx, dto.OrganizationId, alertChannelIds)
+		if err != nil {
+			return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+		if len(alertChannels) != len(alertChannelIds) {
+			return httpErrors.NewError(fmt.Errorf("not found alert channel in organization"), "AC_NOT_FOUND_ALERT_CHANNEL")
+		}
+		dto.AlertChannels = alertChannels
+	}
+	if len(dto.Channels) == 0 && len(dto.AlertChannels) == 0 {
+		return httpErrors.NewBadRequestError(fmt.Errorf("channels or alertChannelIds is required"), "ARR_INVALID_CHANNEL", "")
+	}
+
+	if dto.EscalationPolicyId != nil {
+		escalationPolicy, err := u.escalationPolicyRepo.Get(ctx, *dto.EscalationPolicyId)
+		if err != nil || escalationPolicy.OrganizationId != dto.OrganizationId {
+			return httpErrors.NewError(fmt.Errorf("not found escalation policy in organization"), "EP_NOT_FOUND_ESCALATION_POLICY")
+		}
+	}
+
+	dto.Condition = []byte(helper.ModelToJson(dto.Match))
+	dto.Channel = []byte(helper.ModelToJson(dto.Channels))
+
+	// Users
+	dto.TargetUsers = make([]model.User, 0)
+	for _, strId := range dto.TargetUserIds {
+		userId, err := uuid.Parse(strId)
+		if err == nil {
+			user, err := u.userRepo.GetByUuid(ctx, userId)
+			if err == nil && user.OrganizationId == dto.OrganizationId {
+				dto.TargetUsers = append(dto.TargetUsers, user)
+			}
+		}
+	}
+	return nil
+}
+
+func decodeAlertRoutingRule(ctx context.Context, rule *model.AlertRoutingRule) {
+	if len(rule.Condition) > 0 {
+		if err := json.Unmarshal(rule.Condition, &rule.Match); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	if len(rule.Channel) > 0 {
+		if err := json.Unmarshal(rule.Channel, &rule.Channels); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+}
+
+type alertRoutingSample struct {
+	clusterId   string
+	clusterTags map[string]string
+	namespace   string
+	severity    string
+	alertName   string
+}
+
+// routeAlert 는 priority 순으로 정렬된 규칙 중 sample 과 일치하는 규칙을 반환한다.
+// continue 가 false 인 규칙과 일치하면 이후 규칙은 확인하지 않는다.
+func routeAlert(ctx context.Context, rules []model.AlertRoutingRule, sample alertRoutingSample) []model.AlertRoutingRule {
+	out := []model.AlertRoutingRule{}
+	for _, rule := range rules {
+		decodeAlertRoutingRule(ctx, &rule)
+		if !matchAlertRoutingRule(rule.Match, sample) {
+			continue
+		}
+		out = append(out, rule)
+		if !rule.Continue {
+			break
+		}
+	}
+	return out
+}
+
+func matchAlertRoutingRule(match domain.AlertRoutingMatch, sample alertRoutingSample) bool {
+	if len(match.ClusterIds) > 0 && !slices.Contains(match.ClusterIds, sample.clusterId) {
+		return false
+	}
+	for key, value := range match.ClusterTags {
+		tag, ok := sample.clusterTags[key]
+		if !ok || (value != "*" && value != tag) {
+			return false
+		}
+	}
+	if len(match.Namespaces) > 0 && !matchAnyPattern(match.Namespaces, sample.namespace) {
+		return false
+	}
+	if len(match.AlertNames) > 0 && !matchAnyPattern(match.AlertNames, sample.alertName) {
+		return false
+	}
+	if len(match.Severities) > 0 {
+		found := false
+		for _, severity := range match.Severities {
+			if strings.EqualFold(severity, sample.severity) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAnyPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IAlertSilenceUsecase interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertSilence, error)
+	Get(ctx context.Context, organizationId string, alertSilenceId uuid.UUID) (model.AlertSilence, error)
+	Create(ctx context.Context, dto model.AlertSilence) (alertSilenceId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.AlertSilence) error
+	Delete(ctx context.Context, organizationId string, alertSilenceId uuid.UUID) error
+}
+
+type AlertSilenceUsecase struct {
+	repo             repository.IAlertSilenceRepository
+	organizationRepo repository.IOrganizationRepository
+	clusterRepo      repository.IClusterRepository
+}
+
+func NewAlertSilenceUsecase(r repository.Repository) IAlertSilenceUsecase {
+	return &AlertSilenceUsecase{
+		repo:             r.AlertSilence,
+		organizationRepo: r.Organization,
+		clusterRepo:      r.Cluster,
+	}
+}
+
+func (u *AlertSilenceUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AlertSilence, error) {
+	alertSilences, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	for i := range alertSilences {
+		decodeAlertSilence(ctx, &alertSilences[i])
+	}
+	return alertSilences, nil
+}
+
+func (u *AlertSilenceUsecase) Get(ctx context.Context, organizationId string, alertSilenceId uuid.UUID) (out model.AlertSilence, err error) {
+	out, err = u.repo.Get(ctx, alertSilenceId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewError(err, "ASL_NOT_FOUND_ALERT_SILENCE")
+		}
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if out.OrganizationId != organizationId {
+		return model.AlertSilence{}, httpErrors.NewError(fmt.Errorf("not found alertSilence in organization"), "ASL_NOT_FOUND_ALERT_SILENCE")
+	}
+	decodeAlertSilence(ctx, &out)
+	return
+}
+
+func (u *AlertSilenceUsecase) Create(ctx context.Context, dto model.AlertSilence) (alertSilenceId uuid.UUID, err error) {
+	if _, err = u.organizationRepo.Get(ctx, dto.OrganizationId); err != nil {
+		return uuid.Nil, httpErrors.NewNotFoundError(err, "", "")
+	}
+	if err = u.prepare(ctx, &dto); err != nil {
+		return uuid.Nil, err
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.CreatorId = &userId
+		dto.UpdatorId = &userId
+	}
+
+	alertSilenceId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	log.Info(ctx, "newly created alert silence : ", alertSilenceId)
+
+	return alertSilenceId, nil
+}
+
+// Update 로 endAt 을 현재 시각으로 바꾸면 silence 를 이력은 남긴 채 바로 종료할 수 있다.
+func (u *AlertSilenceUsecase) Update(ctx context.Context, dto model.AlertSilence) error {
+	if _, err := u.Get(ctx, dto.OrganizationId, dto.ID); err != nil {
+		return err
+	}
+	if err := u.prepare(ctx, &dto); err != nil {
+		return err
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.UpdatorId = &userId
+	}
+
+	if err := u.repo.Update(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *AlertSilenceUsecase) Delete(ctx context.Context, organizationId string, alertSilenceId uuid.UUID) error {
+	if _, err := u.Get(ctx, organizationId, alertSilenceId); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, alertSilenceId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// prepare 는 조건과 기간을 검증하고 저장할 형식으로 변환한다.
+func (u *AlertSilenceUsecase) prepare(ctx context.Context, dto *model.AlertSilence) error {
+	if !dto.EndAt.After(dto.StartAt) {
+		return httpErrors.NewBadRequestError(fmt.Errorf("endAt must be after startAt"), "ASL_INVALID_PERIOD", "")
+	}
+	if dto.Match.IsEmpty() {
+		return httpErrors.NewBadRequestError(fmt.Errorf("at least one matcher is required"), "ASL_INVALID_MATCH", "")
+	}
+
+	patterns := append(append([]string{}, dto.Match.Namespaces...), dto.Match.AlertNames...)
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return httpErrors.NewBadRequestError(fmt.Errorf("invalid pattern %s", pattern), "ASL_INVALID_MATCH", "")
+		}
+	}
+
+	for _, clusterId := range dto.Match.ClusterIds {
+		cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(clusterId))
+		if err != nil || cluster.OrganizationId != dto.OrganizationId {
+			return httpErrors.NewBadRequestError(fmt.Errorf("Invalid clusterId %s", clusterId), "C_INVALID_CLUSTER_ID", "")
+		}
+	}
+
+	dto.Condition = []byte(helper.ModelToJson(dto.Match))
+	return nil
+}
+
+func decodeAlertSilence(ctx context.Context, alertSilence *model.AlertSilence) {
+	if len(alertSilence.Condition) > 0 {
+		if err := json.Unmarshal(alertSilence.Condition, &alertSilence.Match); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+}
+
+// activeAlertSilence 는 앨럿과 일치하는 진행 중인 silence 중 먼저 만든 것을 반환한다. 일치하는 silence 가 없으면 nil 을 반환한다.
+func activeAlertSilence(ctx context.Context, repo repository.IAlertSilenceRepository, organizationId string, sample alertRoutingSample) (*model.AlertSilence, error) {
+	alertSilences, err := repo.FetchActive(ctx, organizationId, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get active alert silences")
+	}
+	for _, alertSilence := range alertSilences {
+		decodeAlertSilence(ctx, &alertSilence)
+		if matchAlertSilence(alertSilence.Match, sample) {
+			return &alertSilence, nil
+		}
+	}
+	return nil, nil
+}
+
+func matchAlertSilence(match domain.AlertSilenceMatch, sample alertRoutingSample) bool {
+	if match.IsEmpty() {
+		return false
+	}
+	if len(match.ClusterIds) > 0 && !slices.Contains(match.ClusterIds, sample.clusterId) {
+		return false
+	}
+	if len(match.Namespaces) > 0 && !matchAnyPattern(match.Namespaces, sample.namespace) {
+		return false
+	}
+	if len(match.AlertNames) > 0 && !matchAnyPattern(match.AlertNames, sample.alertName) {
+		return false
+	}
+	if len(match.Severities) > 0 && !slices.ContainsFunc(match.Severities, func(severity string) bool {
+		return strings.EqualFold(severity, sample.severity)
+	}) {
+		return false
+	}
+	return true
+}
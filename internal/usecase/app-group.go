@@ -19,9 +19,9 @@ import (
 
 type IAppGroupUsecase interface {
 	Fetch(ctx context.Context, clusterId domain.ClusterId, pg *pagination.Pagination) ([]model.AppGroup, error)
-	Create(ctx context.Context, dto model.AppGroup) (id domain.AppGroupId, err error)
+	Create(ctx context.Context, dto model.AppGroup) (id domain.AppGroupId, operation model.Operation, err error)
 	Get(ctx context.Context, id domain.AppGroupId) (out model.AppGroup, err error)
-	Delete(ctx context.Context, id domain.AppGroupId) (err error)
+	Delete(ctx context.Context, id domain.AppGroupId) (operation model.Operation, err error)
 	GetApplications(ctx context.Context, id domain.AppGroupId, applicationType domain.ApplicationType) (out []model.Application, err error)
 	UpdateApplication(ctx context.Context, dto model.Application) (err error)
 }
@@ -31,14 +31,16 @@ type AppGroupUsecase struct {
 	clusterRepo      repository.IClusterRepository
 	cloudAccountRepo repository.ICloudAccountRepository
 	argo             argowf.ArgoClient
+	operations       IOperationUsecase
 }
 
-func NewAppGroupUsecase(r repository.Repository, argoClient argowf.ArgoClient) IAppGroupUsecase {
+func NewAppGroupUsecase(r repository.Repository, argoClient argowf.ArgoClient, operations IOperationUsecase) IAppGroupUsecase {
 	return &AppGroupUsecase{
 		repo:             r.AppGroup,
 		clusterRepo:      r.Cluster,
 		cloudAccountRepo: r.CloudAccount,
 		argo:             argoClient,
+		operations:       operations,
 	}
 }
 
@@ -50,29 +52,29 @@ func (u *AppGroupUsecase) Fetch(ctx context.Context, clusterId domain.ClusterId,
 	return
 }
 
-func (u *AppGroupUsecase) Create(ctx context.Context, dto model.AppGroup) (id domain.AppGroupId, err error) {
+func (u *AppGroupUsecase) Create(ctx context.Context, dto model.AppGroup) (id domain.AppGroupId, operation model.Operation, err error) {
 	user, ok := request.UserFrom(ctx)
 	if !ok {
-		return "", httpErrors.NewUnauthorizedError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN", "")
+		return "", operation, httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
 	}
 	userId := user.GetUserId()
 	dto.CreatorId = &userId
 
 	cluster, err := u.clusterRepo.Get(ctx, dto.ClusterId)
 	if err != nil {
-		return "", httpErrors.NewBadRequestError(err, "AG_NOT_FOUND_CLUSTER", "")
+		return "", operation, httpErrors.NewError(err, "AG_NOT_FOUND_CLUSTER")
 	}
 
 	resAppGroups, err := u.repo.Fetch(ctx, dto.ClusterId, nil)
 	if err != nil {
-		return "", httpErrors.NewBadRequestError(err, "AG_NOT_FOUND_APPGROUP", "")
+		return "", operation, httpErrors.NewError(err, "AG_NOT_FOUND_APPGROUP")
 	}
 
 	for _, resAppGroup := range resAppGroups {
 		if resAppGroup.AppGroupType == dto.AppGroupType {
 			if resAppGroup.Status == domain.AppGroupStatus_INSTALLING ||
 				resAppGroup.Status == domain.AppGroupStatus_DELETING {
-				return "", fmt.Errorf("In progress appgroup status [%s]", resAppGroup.Status.String())
+				return "", operation, fmt.Errorf("In progress appgroup status [%s]", resAppGroup.Status.String())
 			}
 			dto.ID = resAppGroup.ID
 		}
@@ -85,7 +87,7 @@ func (u *AppGroupUsecase) Create(ctx context.Context, dto model.AppGroup) (id do
 		tksObjectStore = "s3"
 		cloudAccounts, err := u.cloudAccountRepo.Fetch(ctx, cluster.OrganizationId, nil)
 		if err != nil {
-			return "", httpErrors.NewBadRequestError(fmt.Errorf("Failed to get cloudAccounts"), "", "")
+			return "", operation, httpErrors.NewBadRequestError(fmt.Errorf("Failed to get cloudAccounts"), "", "")
 		}
 		tksCloudAccountId = cluster.CloudAccount.ID.String()
 
@@ -102,7 +104,7 @@ func (u *AppGroupUsecase) Create(ctx context.Context, dto model.AppGroup) (id do
 			}
 		}
 		if !isExist {
-			return "", httpErrors.NewBadRequestError(fmt.Errorf("Not found cloudAccountId[%s] in organization[%s]", cluster.CloudAccountId, cluster.OrganizationId), "", "")
+			return "", operation, httpErrors.NewBadRequestError(fmt.Errorf("Not found cloudAccountId[%s] in organization[%s]", cluster.CloudAccountId, cluster.OrganizationId), "", "")
 		}
 	}
 
@@ -112,7 +114,7 @@ func (u *AppGroupUsecase) Create(ctx context.Context, dto model.AppGroup) (id do
 		err = u.repo.Update(ctx, dto)
 	}
 	if err != nil {
-		return "", httpErrors.NewInternalServerError(err, "AG_FAILED_TO_CREATE_APPGROUP", "")
+		return "", operation, httpErrors.NewError(err, "AG_FAILED_TO_CREATE_APPGROUP")
 	}
 
 	workflowTemplate := ""
@@ -143,20 +145,27 @@ func (u *AppGroupUsecase) Create(ctx context.Context, dto model.AppGroup) (id do
 
 	default:
 		log.Error(ctx, "invalid appGroup type ", dto.AppGroupType.String())
-		return "", errors.Wrap(err, fmt.Sprintf("Invalid appGroup type. %s", dto.AppGroupType.String()))
+		return "", operation, errors.Wrap(err, fmt.Sprintf("Invalid appGroup type. %s", dto.AppGroupType.String()))
 	}
 
-	workflowId, err := u.argo.SumbitWorkflowFromWftpl(ctx, workflowTemplate, opts)
+	// 앱그룹 operation 은 동시 실행 제한을 받지 않으므로 항상 바로 제출된다.
+	operation, err = u.operations.Submit(ctx, model.Operation{
+		OrganizationId:   cluster.OrganizationId,
+		Type:             domain.OperationType_APPGROUP_CREATE,
+		TargetId:         dto.ID.String(),
+		TargetName:       dto.Name,
+		WorkflowTemplate: workflowTemplate,
+	}, opts.Parameters)
 	if err != nil {
 		log.Error(ctx, "failed to submit argo workflow template. err : ", err)
-		return "", httpErrors.NewInternalServerError(err, "AG_FAILED_TO_CALL_WORKFLOW", "")
+		return "", operation, httpErrors.NewError(err, "AG_FAILED_TO_CALL_WORKFLOW")
 	}
 
-	if err := u.repo.InitWorkflow(ctx, dto.ID, workflowId, domain.AppGroupStatus_INSTALLING); err != nil {
-		return "", errors.Wrap(err, "Failed to initialize appGroup status")
+	if err := u.repo.InitWorkflow(ctx, dto.ID, operation.WorkflowId, domain.AppGroupStatus_INSTALLING); err != nil {
+		return "", operation, errors.Wrap(err, "Failed to initialize appGroup status")
 	}
 
-	return dto.ID, nil
+	return dto.ID, operation, nil
 }
 
 func (u *AppGroupUsecase) Get(ctx context.Context, id domain.AppGroupId) (out model.AppGroup, err error) {
@@ -167,14 +176,14 @@ func (u *AppGroupUsecase) Get(ctx context.Context, id domain.AppGroupId) (out mo
 	return appGroup, nil
 }
 
-func (u *AppGroupUsecase) Delete(ctx context.Context, id domain.AppGroupId) (err error) {
+func (u *AppGroupUsecase) Delete(ctx context.Context, id domain.AppGroupId) (operation model.Operation, err error) {
 	appGroup, err := u.repo.Get(ctx, id)
 	if err != nil {
-		return fmt.Errorf("No appGroup for deletiing : %s", id)
+		return operation, fmt.Errorf("No appGroup for deletiing : %s", id)
 	}
 	cluster, err := u.clusterRepo.Get(ctx, appGroup.ClusterId)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "AG_NOT_FOUND_CLUSTER", "")
+		return operation, httpErrors.NewError(err, "AG_NOT_FOUND_CLUSTER")
 	}
 	organizationId := cluster.OrganizationId
 
@@ -185,7 +194,7 @@ func (u *AppGroupUsecase) Delete(ctx context.Context, id domain.AppGroupId) (err
 		tksObjectStore = "s3"
 		cloudAccounts, err := u.cloudAccountRepo.Fetch(ctx, cluster.OrganizationId, nil)
 		if err != nil {
-			return httpErrors.NewBadRequestError(fmt.Errorf("Failed to get cloudAccounts"), "", "")
+			return operation, httpErrors.NewBadRequestError(fmt.Errorf("Failed to get cloudAccounts"), "", "")
 		}
 		tksCloudAccountId = cluster.CloudAccount.ID.String()
 		isExist := false
@@ -201,7 +210,7 @@ func (u *AppGroupUsecase) Delete(ctx context.Context, id domain.AppGroupId) (err
 			}
 		}
 		if !isExist {
-			return httpErrors.NewBadRequestError(fmt.Errorf("Not found cloudAccountId[%s] in organization[%s]", cluster.CloudAccountId, cluster.OrganizationId), "", "")
+			return operation, httpErrors.NewBadRequestError(fmt.Errorf("Not found cloudAccountId[%s] in organization[%s]", cluster.CloudAccountId, cluster.OrganizationId), "", "")
 		}
 	}
 
@@ -219,7 +228,7 @@ func (u *AppGroupUsecase) Delete(ctx context.Context, id domain.AppGroupId) (err
 		appGroupName = "service-mesh"
 
 	default:
-		return fmt.Errorf("Invalid appGroup type %s", appGroup.AppGroupType)
+		return operation, fmt.Errorf("Invalid appGroup type %s", appGroup.AppGroupType)
 	}
 
 	opts := argowf.SubmitOptions{}
@@ -235,15 +244,21 @@ func (u *AppGroupUsecase) Delete(ctx context.Context, id domain.AppGroupId) (err
 		"object_store=" + tksObjectStore,
 	}
 
-	workflowId, err := u.argo.SumbitWorkflowFromWftpl(ctx, workflowTemplate, opts)
+	operation, err = u.operations.Submit(ctx, model.Operation{
+		OrganizationId:   organizationId,
+		Type:             domain.OperationType_APPGROUP_DELETE,
+		TargetId:         id.String(),
+		TargetName:       appGroup.Name,
+		WorkflowTemplate: workflowTemplate,
+	}, opts.Parameters)
 	if err != nil {
-		return fmt.Errorf("Failed to call argo workflow : %s", err)
+		return operation, fmt.Errorf("Failed to call argo workflow : %s", err)
 	}
 
-	log.Debug(ctx, "submited workflow name : ", workflowId)
+	log.Debug(ctx, "submited workflow name : ", operation.WorkflowId)
 
-	if err := u.repo.InitWorkflow(ctx, id, workflowId, domain.AppGroupStatus_DELETING); err != nil {
-		return fmt.Errorf("Failed to initialize appGroup status. err : %s", err)
+	if err := u.repo.InitWorkflow(ctx, id, operation.WorkflowId, domain.AppGroupStatus_DELETING); err != nil {
+		return operation, fmt.Errorf("Failed to initialize appGroup status. err : %s", err)
 	}
 
 	/*
@@ -253,7 +268,7 @@ func (u *AppGroupUsecase) Delete(ctx context.Context, id domain.AppGroupId) (err
 		}
 	*/
 
-	return nil
+	return operation, nil
 }
 
 func (u *AppGroupUsecase) GetApplications(ctx context.Context, id domain.AppGroupId, applicationType domain.ApplicationType) (out []model.Application, err error) {
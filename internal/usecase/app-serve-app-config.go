@@ -0,0 +1,334 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// 환경변수 이름으로 사용할 수 있는 key 이다. Kubernetes Secret 의 key 로도 그대로 사용한다.
+var appServeAppConfigKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func (u *AppServeAppUsecase) GetAppServeAppConfigs(ctx context.Context, appId string, pg *pagination.Pagination) (out []domain.AppServeAppConfigResponse, err error) {
+	if _, err = u.repo.GetAppServeAppById(ctx, appId); err != nil {
+		return nil, httpErrors.NewError(err, "D_NO_ASA")
+	}
+
+	configs, err := u.repo.FetchConfigs(ctx, appId, pg)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	out = make([]domain.AppServeAppConfigResponse, len(configs))
+	for i, config := range configs {
+		out[i] = appServeAppConfigResponse(ctx, config)
+	}
+	return out, nil
+}
+
+func (u *AppServeAppUsecase) GetAppServeAppConfig(ctx context.Context, appId string, revision int) (out domain.AppServeAppConfigResponse, err error) {
+	config, err := u.getConfig(ctx, appId, revision)
+	if err != nil {
+		return out, err
+	}
+	return appServeAppConfigResponse(ctx, config), nil
+}
+
+// UpdateAppServeAppConfig 는 앱의 환경변수와 secret 으로 새 revision 을 만든다. 실행 중인 앱에는 다음 배포부터 반영된다.
+// secret 은 조직 암호화 키로, 키가 없는 조직은 master key 로 봉인한다.
+func (u *AppServeAppUsecase) UpdateAppServeAppConfig(ctx context.Context, appId string, dto domain.UpdateAppServeAppConfigRequest) (revision int, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return 0, httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+	userId := user.GetUserId()
+
+	app, err := u.repo.GetAppServeAppById(ctx, appId)
+	if err != nil {
+		return 0, httpErrors.NewError(err, "D_NO_ASA")
+	}
+
+	env := dto.Env
+	if env == nil {
+		env = map[string]string{}
+	}
+	for key := range env {
+		if !appServeAppConfigKeyPattern.MatchString(key) {
+			return 0, httpErrors.NewBadRequestError(fmt.Errorf("invalid env key %s", key), "ASA_INVALID_CONFIG", "")
+		}
+	}
+
+	// 값이 비어 있는 secret 은 이전 revision 의 값을 사용하므로 이전 revision 을 복호화한다.
+	var previous map[string]string
+	secrets := map[string]string{}
+	for key, value := range dto.Secrets {
+		if !appServeAppConfigKeyPattern.MatchString(key) {
+			return 0, httpErrors.NewBadRequestError(fmt.Errorf("invalid secret key %s", key), "ASA_INVALID_CONFIG", "")
+		}
+		if _, ok := env[key]; ok {
+			return 0, httpErrors.NewBadRequestError(fmt.Errorf("%s is defined in both env and secrets", key), "ASA_INVALID_CONFIG", "")
+		}
+		if value == "" {
+			if previous == nil {
+				if previous, err = u.latestSecrets(ctx, appId); err != nil {
+					return 0, err
+				}
+			}
+			prev, ok := previous[key]
+			if !ok {
+				return 0, httpErrors.NewBadRequestError(fmt.Errorf("value of new secret %s is empty", key), "ASA_INVALID_CONFIG", "")
+			}
+			value = prev
+		}
+		secrets[key] = value
+	}
+
+	config := model.AppServeAppConfig{
+		AppServeAppId: appId,
+		Description:   dto.Description,
+		CreatorId:     &userId,
+	}
+	if config.Env, err = json.Marshal(env); err != nil {
+		return 0, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if config.SecretKeys, err = json.Marshal(sortedKeys(secrets)); err != nil {
+		return 0, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if len(secrets) > 0 {
+		b, err := json.Marshal(secrets)
+		if err != nil {
+			return 0, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+		if config.Secrets, err = u.encryptionKey.Seal(ctx, app.OrganizationId, b); err != nil {
+			return 0, err
+		}
+	}
+
+	revision, err = u.repo.CreateConfig(ctx, config)
+	if err != nil {
+		return 0, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	log.Infof(ctx, "created config revision %d of app %s", revision, appId)
+	return revision, nil
+}
+
+// GetAppServeAppConfigDiff 는 from revision 에서 to revision 으로 바뀐 환경변수와 secret 을 반환한다.
+// secret 은 두 revision 을 복호화해 비교하지만 값은 반환하지 않는다. from 이 0 이면 빈 config 와 비교한다.
+func (u *AppServeAppUsecase) GetAppServeAppConfigDiff(ctx context.Context, appId string, fromRevision int, toRevision int) (out domain.GetAppServeAppConfigDiffResponse, err error) {
+	out = domain.GetAppServeAppConfigDiffResponse{FromRevision: fromRevision, ToRevision: toRevision, Changes: []domain.AppServeAppConfigChange{}}
+
+	fromEnv, fromSecrets := map[string]string{}, map[string]string{}
+	if fromRevision > 0 {
+		from, err := u.getConfig(ctx, appId, fromRevision)
+		if err != nil {
+			return out, err
+		}
+		if fromEnv, fromSecrets, err = openAppServeAppConfig(ctx, u.encryptionKey, from); err != nil {
+			return out, err
+		}
+	}
+	to, err := u.getConfig(ctx, appId, toRevision)
+	if err != nil {
+		return out, err
+	}
+	toEnv, toSecrets, err := openAppServeAppConfig(ctx, u.encryptionKey, to)
+	if err != nil {
+		return out, err
+	}
+
+	for _, change := range diffConfigValues(fromEnv, toEnv) {
+		change.Kind = domain.AppServeAppConfigKind_ENV
+		out.Changes = append(out.Changes, change)
+	}
+	for _, change := range diffConfigValues(fromSecrets, toSecrets) {
+		change.Kind = domain.AppServeAppConfigKind_SECRET
+		change.OldValue = ""
+		change.NewValue = ""
+		out.Changes = append(out.Changes, change)
+	}
+	return out, nil
+}
+
+func (u *AppServeAppUsecase) getConfig(ctx context.Context, appId string, revision int) (model.AppServeAppConfig, error) {
+	config, err := u.repo.GetConfig(ctx, appId, revision)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return config, httpErrors.NewNotFoundError(fmt.Errorf("not found config revision %d of app %s", revision, appId), "ASA_NOT_FOUND_CONFIG", "")
+		}
+		return config, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return config, nil
+}
+
+func (u *AppServeAppUsecase) latestSecrets(ctx context.Context, appId string) (map[string]string, error) {
+	config, err := u.repo.GetLatestConfig(ctx, appId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return map[string]string{}, nil
+		}
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	_, secrets, err := openAppServeAppConfig(ctx, u.encryptionKey, config)
+	return secrets, err
+}
+
+func openAppServeAppConfig(ctx context.Context, encryptionKey IEncryptionKeyUsecase, config model.AppServeAppConfig) (env map[string]string, secrets map[string]string, err error) {
+	env, secrets = map[string]string{}, map[string]string{}
+	if len(config.Env) > 0 {
+		if err = json.Unmarshal(config.Env, &env); err != nil {
+			return nil, nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+	}
+	if len(config.Secrets) > 0 {
+		b, err := encryptionKey.Open(ctx, config.Secrets)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = json.Unmarshal(b, &secrets); err != nil {
+			return nil, nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+	}
+	return env, secrets, nil
+}
+
+// appServeAppSecretName 은 앱의 secret 을 동기화하는 Kubernetes Secret 의 이름이다.
+func appServeAppSecretName(app *model.AppServeApp) string {
+	return app.Name + "-tks-env"
+}
+
+// syncAppServeAppConfig 는 배포하기 전에 앱의 마지막 config 를 배포에 반영한다. secret 은 대상 클러스터의 Kubernetes Secret 으로
+// 동기화하고, 환경변수는 task 의 extraEnv 와 합친다. 같은 key 는 task 의 extraEnv 가 우선한다.
+// config 가 없으면 task 의 extraEnv 를 그대로 사용한다. helm rollback 은 이 과정을 거치지 않으므로 Secret 은 마지막 config 로 남는다.
+func syncAppServeAppConfig(ctx context.Context, encryptionKey IEncryptionKeyUsecase, repo repository.IAppServeAppRepository, app *model.AppServeApp, task *model.AppServeAppTask) (extraEnv string, secretName string, err error) {
+	config, err := repo.GetLatestConfig(ctx, app.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return task.ExtraEnv, "", nil
+		}
+		return "", "", err
+	}
+	env, secrets, err := openAppServeAppConfig(ctx, encryptionKey, config)
+	if err != nil {
+		return "", "", errors.Wrap(err, fmt.Sprintf("failed to open config revision %d", config.Revision))
+	}
+
+	if len(secrets) > 0 {
+		kubeconfig, err := kubernetes.GetKubeConfig(ctx, app.TargetClusterId, kubernetes.KubeconfigForAdmin)
+		if err != nil {
+			return "", "", err
+		}
+		data := make(map[string][]byte, len(secrets))
+		for key, value := range secrets {
+			data[key] = []byte(value)
+		}
+		labels := map[string]string{
+			"app.kubernetes.io/managed-by": "tks",
+			"tks.io/asa-id":                app.ID,
+		}
+		secretName = appServeAppSecretName(app)
+		if err = kubernetes.EnsureSecret(ctx, kubeconfig, app.Namespace, secretName, labels, data); err != nil {
+			return "", "", errors.Wrap(err, "failed to sync app secret")
+		}
+	}
+
+	if task.ExtraEnv != "" {
+		taskEnv := map[string]string{}
+		if err = json.Unmarshal([]byte(task.ExtraEnv), &taskEnv); err != nil {
+			return "", "", errors.Wrap(err, "Failed to process extraEnv param.")
+		}
+		for key, value := range taskEnv {
+			env[key] = value
+		}
+	}
+	if len(env) > 0 {
+		b, err := json.Marshal(env)
+		if err != nil {
+			return "", "", err
+		}
+		extraEnv = string(b)
+	}
+
+	if err = repo.UpdateTaskConfigRevision(ctx, task.ID, config.Revision); err != nil {
+		return "", "", err
+	}
+	task.ConfigRevision = config.Revision
+	return extraEnv, secretName, nil
+}
+
+func appServeAppConfigResponse(ctx context.Context, config model.AppServeAppConfig) domain.AppServeAppConfigResponse {
+	out := domain.AppServeAppConfigResponse{
+		ID:            config.ID.String(),
+		AppServeAppId: config.AppServeAppId,
+		Revision:      config.Revision,
+		Env:           map[string]string{},
+		SecretKeys:    []string{},
+		Description:   config.Description,
+		CreatedAt:     config.CreatedAt,
+	}
+	if len(config.Env) > 0 {
+		if err := json.Unmarshal(config.Env, &out.Env); err != nil {
+			log.Info(ctx, err)
+		}
+	}
+	if len(config.SecretKeys) > 0 {
+		if err := json.Unmarshal(config.SecretKeys, &out.SecretKeys); err != nil {
+			log.Info(ctx, err)
+		}
+	}
+	if config.CreatorId != nil {
+		out.Creator = domain.SimpleUserResponse{
+			ID:         config.Creator.ID.String(),
+			AccountId:  config.Creator.AccountId,
+			Name:       config.Creator.Name,
+			Department: config.Creator.Department,
+			Email:      config.Creator.Email,
+		}
+	}
+	return out
+}
+
+// diffConfigValues 는 key 순서로 추가, 삭제, 변경된 항목을 반환한다.
+func diffConfigValues(from map[string]string, to map[string]string) (out []domain.AppServeAppConfigChange) {
+	keys := sortedKeys(from)
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		oldValue, inFrom := from[key]
+		newValue, inTo := to[key]
+		switch {
+		case !inFrom:
+			out = append(out, domain.AppServeAppConfigChange{Key: key, Change: domain.AppServeAppConfigChange_ADDED, NewValue: newValue})
+		case !inTo:
+			out = append(out, domain.AppServeAppConfigChange{Key: key, Change: domain.AppServeAppConfigChange_REMOVED, OldValue: oldValue})
+		case oldValue != newValue:
+			out = append(out, domain.AppServeAppConfigChange{Key: key, Change: domain.AppServeAppConfigChange_MODIFIED, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	return out
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,275 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/hook"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+const gitWebhookSecretLength = 40
+
+// GitHub 은 "owner/repo", GitLab 은 "group/subgroup/project" 형식의 repository 경로이다.
+var gitRepositoryPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+(/[A-Za-z0-9_.-]+)+$`)
+
+func (u *AppServeAppUsecase) GetAppServeAppGitSource(ctx context.Context, appId string) (out domain.AppServeAppGitSourceResponse, err error) {
+	source, err := u.getGitSource(ctx, appId)
+	if err != nil {
+		return out, err
+	}
+	return appServeAppGitSourceResponse(source), nil
+}
+
+// UpdateAppServeAppGitSource 는 앱을 빌드할 repository 와 branch 를 설정한다. provider 의 token 으로 branch 가 있는지 먼저 확인한다.
+// webhook secret 은 처음 설정하거나 rotate 를 요청했을 때만 새로 만들어 응답으로 한 번 반환한다.
+func (u *AppServeAppUsecase) UpdateAppServeAppGitSource(ctx context.Context, appId string, dto domain.UpdateAppServeAppGitSourceRequest) (out domain.UpdateAppServeAppGitSourceResponse, err error) {
+	app, err := u.repo.GetAppServeAppById(ctx, appId)
+	if err != nil {
+		return out, httpErrors.NewError(err, "D_NO_ASA")
+	}
+	if app.Type == "deploy" {
+		return out, httpErrors.NewError(fmt.Errorf("the app %s only deploys images", app.Name), "ASA_NOT_BUILDABLE_APP")
+	}
+
+	gitProviderId, err := uuid.Parse(dto.GitProviderId)
+	if err != nil {
+		return out, httpErrors.NewError(fmt.Errorf("invalid gitProviderId"), "GP_INVALID_GIT_PROVIDER_ID")
+	}
+	gitProvider, err := getGitProvider(ctx, u.gitProviderRepo, app.OrganizationId, gitProviderId)
+	if err != nil {
+		return out, err
+	}
+
+	repository := strings.Trim(strings.TrimSuffix(dto.Repository, ".git"), "/")
+	if !gitRepositoryPattern.MatchString(repository) {
+		return out, httpErrors.NewError(fmt.Errorf("invalid repository %s", dto.Repository), "C_INVALID_REQUEST_FIELD")
+	}
+
+	token, err := openGitToken(ctx, u.encryptionKey, gitProvider)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if _, err = gitBranchCommit(ctx, gitProvider, token, repository, dto.Branch); err != nil {
+		return out, gitProviderError(err, "GP_NOT_FOUND_BRANCH")
+	}
+
+	source, err := u.gitProviderRepo.GetSource(ctx, appId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if len(source.WebhookSecret) == 0 || dto.RotateWebhookSecret {
+		out.WebhookSecret = helper.GenerateRandomString(gitWebhookSecretLength)
+		if source.WebhookSecret, err = u.encryptionKey.Seal(ctx, app.OrganizationId, []byte(out.WebhookSecret)); err != nil {
+			return out, err
+		}
+	}
+	source.AppServeAppId = app.ID
+	source.OrganizationId = app.OrganizationId
+	source.GitProviderId = gitProvider.ID
+	source.Repository = repository
+	source.Branch = dto.Branch
+	source.AutoDeploy = dto.AutoDeploy
+
+	if err = u.gitProviderRepo.SaveSource(ctx, source); err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	source.GitProvider = gitProvider
+	out.GitSource = appServeAppGitSourceResponse(source)
+	return out, nil
+}
+
+func (u *AppServeAppUsecase) DeleteAppServeAppGitSource(ctx context.Context, appId string) error {
+	if _, err := u.getGitSource(ctx, appId); err != nil {
+		return err
+	}
+	if err := u.gitProviderRepo.DeleteSource(ctx, appId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// BuildAppServeApp 은 설정한 repository 의 commit 으로 앱을 다시 빌드하고 배포한다.
+func (u *AppServeAppUsecase) BuildAppServeApp(ctx context.Context, appId string, dto domain.BuildAppServeAppRequest) (out domain.BuildAppServeAppResponse, err error) {
+	source, err := u.getGitSource(ctx, appId)
+	if err != nil {
+		return out, err
+	}
+
+	branch := dto.Branch
+	if branch == "" {
+		branch = source.Branch
+	}
+	return u.buildFromGitSource(ctx, source, branch, dto.Commit)
+}
+
+// ReceiveGitWebhook 은 provider 의 push webhook 을 검증하고, 설정한 branch 에 push 되었으면 앱을 다시 빌드하고 배포한다.
+// 빌드하지 않는 event 는 오류 없이 무시한 이유를 반환한다.
+func (u *AppServeAppUsecase) ReceiveGitWebhook(ctx context.Context, appId string, req domain.GitWebhookRequest) (out domain.GitWebhookResponse, err error) {
+	source, err := u.getGitSource(ctx, appId)
+	if err != nil {
+		return out, err
+	}
+	secret, err := u.encryptionKey.Open(ctx, source.WebhookSecret)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if !verifyGitWebhook(source.GitProvider.Type, string(secret), req) {
+		return out, httpErrors.NewError(fmt.Errorf("invalid webhook signature"), "ASA_INVALID_WEBHOOK_SIGNATURE")
+	}
+
+	if req.Event != "push" && req.Event != "Push Hook" {
+		out.Message = fmt.Sprintf("ignored %s event", req.Event)
+		return out, nil
+	}
+
+	var payload struct {
+		Ref         string `json:"ref"`
+		After       string `json:"after"`
+		CheckoutSha string `json:"checkout_sha"`
+	}
+	if err = json.Unmarshal(req.Payload, &payload); err != nil {
+		return out, httpErrors.NewError(err, "C_INVALID_REQUEST_FIELD")
+	}
+
+	branch := strings.TrimPrefix(payload.Ref, "refs/heads/")
+	if branch == payload.Ref || branch != source.Branch {
+		out.Message = fmt.Sprintf("ignored push to %s", payload.Ref)
+		return out, nil
+	}
+	commit := payload.After
+	if payload.CheckoutSha != "" {
+		commit = payload.CheckoutSha
+	}
+	// branch 를 삭제한 push 는 after 가 0 으로만 채워져 있다.
+	if strings.Trim(commit, "0") == "" {
+		out.Message = "ignored deletion of branch"
+		return out, nil
+	}
+	if !source.AutoDeploy {
+		out.Message = "auto deploy is disabled"
+		return out, nil
+	}
+	// provider 가 같은 event 를 다시 보내도 한 번만 빌드한다.
+	if commit == source.LastCommit {
+		out.Message = fmt.Sprintf("commit %s is already built", commit)
+		return out, nil
+	}
+
+	log.Infof(ctx, "received push of commit %s to %s for app %s", commit, branch, appId)
+	res, err := u.buildFromGitSource(ctx, source, branch, commit)
+	if err != nil {
+		return out, err
+	}
+	return domain.GitWebhookResponse{Triggered: true, Message: res.Message}, nil
+}
+
+func (u *AppServeAppUsecase) getGitSource(ctx context.Context, appId string) (model.AppServeAppGitSource, error) {
+	source, err := u.gitProviderRepo.GetSource(ctx, appId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return source, httpErrors.NewError(err, "ASA_NOT_FOUND_GIT_SOURCE")
+		}
+		return source, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return source, nil
+}
+
+// buildFromGitSource 는 최신 task 를 복사하고 artifact 대신 repository 의 commit 을 빌드하는 task 로 앱을 업데이트한다.
+// 승인 정책, 점검 시간 등은 일반 업데이트와 같이 적용된다.
+func (u *AppServeAppUsecase) buildFromGitSource(ctx context.Context, source model.AppServeAppGitSource, branch string, commit string) (out domain.BuildAppServeAppResponse, err error) {
+	token, err := openGitToken(ctx, u.encryptionKey, source.GitProvider)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if commit == "" {
+		if commit, err = gitBranchCommit(ctx, source.GitProvider, token, source.Repository, branch); err != nil {
+			return out, gitProviderError(err, "GP_NOT_FOUND_BRANCH")
+		}
+	}
+	secretName, err := ensureGitProviderSecret(ctx, source.GitProvider, token)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	latestTask, err := u.repo.GetAppServeAppLatestTask(ctx, source.AppServeAppId)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	version, err := strconv.Atoi(latestTask.Version)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	task := *latestTask
+	task.Version = strconv.Itoa(version + 1)
+	task.Status = "PREPARING"
+	task.RollbackVersion = ""
+	task.Output = ""
+	task.CreatedAt = time.Now()
+	task.UpdatedAt = nil
+	task.ArtifactUrl = ""
+	task.ExecutablePath = ""
+	task.SourceRepoUrl = gitCloneUrl(source.GitProvider, source.Repository)
+	task.SourceBranch = branch
+	task.SourceCommit = commit
+	task.SourceSecretName = secretName
+
+	message, err := u.UpdateAppServeApp(ctx, source.AppServeAppId, &task)
+	if err != nil {
+		if _, ok := err.(httpErrors.IRestError); !ok {
+			err = httpErrors.NewError(err, "ASA_FAILED_UPDATE_APP")
+		}
+		return out, err
+	}
+
+	if err = u.gitProviderRepo.UpdateSourceTriggered(ctx, source.AppServeAppId, commit, time.Now()); err != nil {
+		log.Warnf(ctx, "failed to update last commit of app %s. err: %s", source.AppServeAppId, err)
+	}
+	return domain.BuildAppServeAppResponse{Version: task.Version, Commit: commit, Message: message}, nil
+}
+
+// verifyGitWebhook 은 GitHub 의 X-Hub-Signature-256 HMAC 서명 또는 GitLab 의 X-Gitlab-Token 을 webhook secret 과 비교한다.
+func verifyGitWebhook(gitProviderType string, secret string, req domain.GitWebhookRequest) bool {
+	if gitProviderType == domain.GitProviderType_GITLAB {
+		return req.Token != "" && subtle.ConstantTimeCompare([]byte(req.Token), []byte(secret)) == 1
+	}
+	return req.Signature != "" && hmac.Equal([]byte(req.Signature), []byte(hook.Sign(secret, req.Payload)))
+}
+
+func appServeAppGitSourceWebhookUrl(appId string) string {
+	return viper.GetString("external-address") + internal.SYSTEM_API_PREFIX + internal.SYSTEM_API_VERSION + "/git-webhooks/app-serve-apps/" + appId
+}
+
+func appServeAppGitSourceResponse(source model.AppServeAppGitSource) domain.AppServeAppGitSourceResponse {
+	return domain.AppServeAppGitSourceResponse{
+		AppServeAppId:   source.AppServeAppId,
+		GitProviderId:   source.GitProviderId.String(),
+		GitProviderName: source.GitProvider.Name,
+		GitProviderType: source.GitProvider.Type,
+		Repository:      source.Repository,
+		Branch:          source.Branch,
+		AutoDeploy:      source.AutoDeploy,
+		WebhookUrl:      appServeAppGitSourceWebhookUrl(source.AppServeAppId),
+		LastCommit:      source.LastCommit,
+		LastTriggeredAt: source.LastTriggeredAt,
+		UpdatedAt:       source.UpdatedAt,
+	}
+}
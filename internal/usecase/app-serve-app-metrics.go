@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
+	"github.com/spf13/viper"
+)
+
+// appServeAppMetricQueries 는 앱의 pod 전체에 대한 metric 별 range query 이다.
+// 첫 번째 %s 는 앱의 pod 조건이고 두 번째 %s 는 interval 이다.
+// 요청 수와 에러율은 spring boot actuator(micrometer) 의 http_server_requests_seconds 를 사용한다.
+var appServeAppMetricQueries = []struct {
+	metric string
+	unit   domain.ChartUnit
+	query  string
+}{
+	{
+		metric: domain.AppServeAppMetric_CPU,
+		unit:   domain.ChartUnit_CORES,
+		query:  "sum(rate(container_cpu_usage_seconds_total{container!=\"\",%s}[%s]))",
+	},
+	{
+		metric: domain.AppServeAppMetric_MEMORY,
+		unit:   domain.ChartUnit_BYTES,
+		query:  "sum(avg_over_time(container_memory_working_set_bytes{container!=\"\",%s}[%s]))",
+	},
+	{
+		metric: domain.AppServeAppMetric_REQUEST_RATE,
+		unit:   domain.ChartUnit_RPS,
+		query:  "sum(rate(http_server_requests_seconds_count{%s}[%s]))",
+	},
+	{
+		metric: domain.AppServeAppMetric_ERROR_RATE,
+		unit:   domain.ChartUnit_PERCENT,
+		query:  "sum(rate(http_server_requests_seconds_count{status=~\"5..\",%[1]s}[%[2]s])) / sum(rate(http_server_requests_seconds_count{%[1]s}[%[2]s])) * 100",
+	},
+}
+
+// GetAppServeAppMetrics 는 앱의 pod 들이 사용하는 CPU, memory 와 요청 수, 5xx 에러율의 추이를 반환한다.
+// 앱 사용자가 클러스터에 접근하지 않고도 성능을 확인할 수 있도록 조직의 thanos 에서 앱의 namespace 와 pod 이름으로 조회한다.
+// metric 하나의 조회가 실패해도 나머지는 반환하고, 실패한 metric 에 오류를 담는다.
+func (u *AppServeAppUsecase) GetAppServeAppMetrics(ctx context.Context, appId string, duration string, interval string) (out domain.GetAppServeAppMetricsResponse, err error) {
+	app, err := u.repo.GetAppServeAppById(ctx, appId)
+	if err != nil {
+		return out, httpErrors.NewError(err, "D_NO_ASA")
+	}
+
+	thanosClient, err := u.thanosClients.Get(ctx, app.OrganizationId)
+	if err != nil {
+		return out, httpErrors.NewError(err, "ASA_FAILED_FETCH_METRICS")
+	}
+
+	now := time.Now()
+	durationSec, intervalSec := getDurationAndIntervalSec(duration, interval)
+	intervalSec = getLimitedIntervalSec(durationSec, intervalSec, viper.GetInt("chart-max-points"))
+	start := alignToInterval(int(now.Unix())-durationSec, intervalSec, now.Location())
+
+	xAxisData := []string{}
+	for x := start; x <= int(now.Unix()); x += intervalSec {
+		xAxisData = append(xAxisData, strconv.Itoa(x))
+	}
+
+	out = domain.GetAppServeAppMetricsResponse{
+		AppServeAppId: appId,
+		Duration:      duration,
+		Interval:      interval,
+		XAxis:         &domain.Axis{Data: xAxisData},
+	}
+
+	selector := fmt.Sprintf(`taco_cluster="%s",namespace="%s",pod=~"%s-.*"`, app.TargetClusterId, app.Namespace, app.Name)
+	failed := 0
+	for _, q := range appServeAppMetricQueries {
+		series, err := getAppServeAppMetricSeries(ctx, thanosClient, q.metric, q.unit, fmt.Sprintf(q.query, selector, fmt.Sprintf("%ds", intervalSec)), start, int(now.Unix()), intervalSec, xAxisData)
+		if err != nil {
+			failed++
+			series = domain.AppServeAppMetricSeries{Metric: q.metric, Format: domain.ChartFormat{Unit: q.unit}, Data: []string{}, Error: err.Error()}
+		}
+		out.Metrics = append(out.Metrics, series)
+	}
+	if failed == len(appServeAppMetricQueries) {
+		return out, httpErrors.NewError(fmt.Errorf("failed to get metrics of app %s. err : %s", appId, out.Metrics[0].Error), "ASA_FAILED_FETCH_METRICS")
+	}
+	return out, nil
+}
+
+// getAppServeAppMetricSeries 는 query 의 결과를 xAxisData 의 시각에 맞춘다. 요청이 없는 구간처럼 값이 없는 시각은 비워 둔다.
+func getAppServeAppMetricSeries(ctx context.Context, thanosClient thanos.ThanosClient, metric string, unit domain.ChartUnit, query string,
+	start int, end int, intervalSec int, xAxisData []string) (out domain.AppServeAppMetricSeries, err error) {
+	out = domain.AppServeAppMetricSeries{Metric: metric}
+
+	result, err := thanosClient.FetchRange(ctx, query, start, end, intervalSec)
+	if err != nil {
+		return out, err
+	}
+
+	values := make([]float64, len(xAxisData))
+	for i, x := range xAxisData {
+		values[i] = math.NaN()
+		if len(result.Data.Result) == 0 {
+			continue
+		}
+		if y, ok := getChartYValue(result.Data.Result[0].Values, x); ok && !math.IsInf(y, 0) {
+			values[i] = y
+		}
+	}
+
+	format, divisor := getChartFormat(unit, [][]float64{values})
+	out.Format = format
+	out.Data = formatChartValues(values, divisor, format.Precision)
+	return out, nil
+}
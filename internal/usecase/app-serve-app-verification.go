@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/log"
+	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
+)
+
+const (
+	// 새 revision 의 5xx 비율이 이전 revision 보다 1%p 이상 높으면 SUSPECT 이다.
+	verificationErrorRateTolerance = 0.01
+	// 이전 revision 이 없으면 5xx 비율이 5% 를 넘을 때 SUSPECT 이다.
+	verificationMaxErrorRate = 0.05
+	// 새 revision 의 평균 응답 시간이 이전 revision 의 1.5 배를 넘으면 SUSPECT 이다.
+	verificationLatencyFactor = 1.5
+)
+
+// scheduleVerification 은 bake period 가 설정된 task 의 배포가 끝나면 검증을 예약한다.
+// promote 로 트래픽이 전환되면 다시 bake period 동안 지켜본다.
+func (u *AppServeAppUsecase) scheduleVerification(ctx context.Context, taskId string) {
+	task, err := u.repo.GetAppServeAppTaskById(ctx, taskId)
+	if err != nil {
+		log.Error(ctx, err)
+		return
+	}
+	if task.VerificationBakePeriod <= 0 {
+		return
+	}
+
+	dueAt := time.Now().Add(time.Duration(task.VerificationBakePeriod) * time.Minute)
+	task.VerificationStatus = domain.AppServeAppVerification_PENDING
+	task.VerificationDueAt = &dueAt
+	task.VerifiedAt = nil
+	task.VerificationMessage = ""
+	if err := u.repo.UpdateTaskVerification(ctx, *task); err != nil {
+		log.Error(ctx, err)
+	}
+}
+
+func resetVerification(task *model.AppServeAppTask) {
+	task.VerificationStatus = ""
+	task.VerificationDueAt = nil
+	task.VerifiedAt = nil
+	task.BaselineErrorRate = 0
+	task.ErrorRate = 0
+	task.BaselineLatencyMs = 0
+	task.LatencyMs = 0
+	task.VerificationMessage = ""
+}
+
+// VerifyDeployments 는 bake period 가 끝난 task 의 에러율과 응답 시간을 배포 이전 구간과 비교하여 VERIFIED 또는 SUSPECT 로 표시한다.
+// SUSPECT 인 task 에 autoRollback 이 설정되어 있으면 이전에 성공한 task 로 rollback 한다.
+func (u *AppServeAppUsecase) VerifyDeployments(ctx context.Context) error {
+	tasks, err := u.repo.FetchTasksToVerify(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		app, err := u.repo.GetAppServeAppById(ctx, task.AppServeAppId)
+		if err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		if err := u.verifyTask(ctx, app, &task); err != nil {
+			log.Error(ctx, err)
+			task.VerificationStatus = domain.AppServeAppVerification_UNKNOWN
+			task.VerificationMessage = err.Error()
+		}
+
+		now := time.Now()
+		task.VerifiedAt = &now
+		if err := u.repo.UpdateTaskVerification(ctx, task); err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+
+		if task.VerificationStatus == domain.AppServeAppVerification_SUSPECT && task.AutoRollback {
+			u.rollbackSuspectTask(ctx, app, task)
+		}
+	}
+	return nil
+}
+
+func (u *AppServeAppUsecase) verifyTask(ctx context.Context, app *model.AppServeApp, task *model.AppServeAppTask) error {
+	thanosClient, err := u.thanosClients.Get(ctx, app.OrganizationId)
+	if err != nil {
+		return err
+	}
+
+	// 검증이 늦게 실행되어도 배포 직후의 bake period 와 그 이전의 같은 길이 구간을 비교한다.
+	bakeSec := task.VerificationBakePeriod * 60
+	endOffset := int(time.Since(*task.VerificationDueAt).Seconds())
+	if endOffset < 0 {
+		endOffset = 0
+	}
+	selector := fmt.Sprintf(`taco_cluster="%s",namespace="%s",pod=~"%s-.*"`, app.TargetClusterId, app.Namespace, app.Name)
+
+	errorRate, latencyMs, ok, err := getRequestMetrics(ctx, thanosClient, selector, bakeSec, endOffset)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		task.VerificationStatus = domain.AppServeAppVerification_UNKNOWN
+		task.VerificationMessage = "no requests were served by the new revision during the bake period"
+		return nil
+	}
+	task.ErrorRate = errorRate
+	task.LatencyMs = latencyMs
+
+	baselineErrorRate, baselineLatencyMs, baselineOk, err := getRequestMetrics(ctx, thanosClient, selector, bakeSec, endOffset+bakeSec)
+	if err != nil {
+		return err
+	}
+	task.BaselineErrorRate = baselineErrorRate
+	task.BaselineLatencyMs = baselineLatencyMs
+
+	task.VerificationStatus, task.VerificationMessage = judgeVerification(errorRate, latencyMs, baselineErrorRate, baselineLatencyMs, baselineOk)
+	return nil
+}
+
+func judgeVerification(errorRate float64, latencyMs float64, baselineErrorRate float64, baselineLatencyMs float64, baselineOk bool) (status string, message string) {
+	if !baselineOk {
+		if errorRate > verificationMaxErrorRate {
+			return domain.AppServeAppVerification_SUSPECT, fmt.Sprintf("error rate %.2f%% is higher than %.2f%%", errorRate*100, verificationMaxErrorRate*100)
+		}
+		return domain.AppServeAppVerification_VERIFIED, "no previous revision to compare. error rate is acceptable"
+	}
+
+	if errorRate-baselineErrorRate > verificationErrorRateTolerance {
+		return domain.AppServeAppVerification_SUSPECT, fmt.Sprintf("error rate increased from %.2f%% to %.2f%%", baselineErrorRate*100, errorRate*100)
+	}
+	if baselineLatencyMs > 0 && latencyMs > baselineLatencyMs*verificationLatencyFactor {
+		return domain.AppServeAppVerification_SUSPECT, fmt.Sprintf("latency increased from %.1fms to %.1fms", baselineLatencyMs, latencyMs)
+	}
+	return domain.AppServeAppVerification_VERIFIED, ""
+}
+
+// getRequestMetrics 는 offsetSec 이전에 끝나는 windowSec 구간의 5xx 비율과 평균 응답 시간(ms)을 반환한다.
+// spring boot actuator(micrometer) 의 http_server_requests_seconds 를 사용하며, 요청이 없으면 ok 는 false 이다.
+func getRequestMetrics(ctx context.Context, thanosClient thanos.ThanosClient, selector string, windowSec int, offsetSec int) (errorRate float64, latencyMs float64, ok bool, err error) {
+	rangeSelector := fmt.Sprintf("[%ds]", windowSec)
+	if offsetSec > 0 {
+		rangeSelector += fmt.Sprintf(" offset %ds", offsetSec)
+	}
+
+	total, ok, err := getScalarMetric(ctx, thanosClient,
+		fmt.Sprintf("sum(rate(http_server_requests_seconds_count{%s}%s))", selector, rangeSelector))
+	if err != nil || !ok || total == 0 {
+		return 0, 0, false, err
+	}
+
+	errors, _, err := getScalarMetric(ctx, thanosClient,
+		fmt.Sprintf(`sum(rate(http_server_requests_seconds_count{%s,status=~"5.."}%s))`, selector, rangeSelector))
+	if err != nil {
+		return 0, 0, false, err
+	}
+	seconds, _, err := getScalarMetric(ctx, thanosClient,
+		fmt.Sprintf("sum(rate(http_server_requests_seconds_sum{%s}%s))", selector, rangeSelector))
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	return errors / total, seconds / total * 1000, true, nil
+}
+
+// getScalarMetric 은 결과가 하나인 instant query 의 값을 반환한다. 결과가 없으면 ok 는 false 이다.
+func getScalarMetric(ctx context.Context, thanosClient thanos.ThanosClient, query string) (value float64, ok bool, err error) {
+	result, err := thanosClient.Get(ctx, query)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(result.Data.Result) == 0 {
+		return 0, false, nil
+	}
+	value, ok = getMetricValue(result.Data.Result[0].Value)
+	if !ok || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, false, nil
+	}
+	return value, true, nil
+}
+
+// rollbackSuspectTask 는 SUSPECT 인 task 가 여전히 최신 task 일 때만 이전에 성공한 task 로 rollback 한다.
+func (u *AppServeAppUsecase) rollbackSuspectTask(ctx context.Context, app *model.AppServeApp, task model.AppServeAppTask) {
+	latestTask, err := u.repo.GetAppServeAppLatestTask(ctx, app.ID)
+	if err != nil {
+		log.Error(ctx, err)
+		return
+	}
+	if latestTask.ID != task.ID {
+		log.Infof(ctx, "skip auto rollback of app %s. task %s is not the latest task", app.ID, task.ID)
+		return
+	}
+
+	previousTask, err := u.repo.GetPreviousSuccessfulTask(ctx, app.ID, task.CreatedAt)
+	if err != nil {
+		log.Infof(ctx, "skip auto rollback of app %s. no previous successful task", app.ID)
+		return
+	}
+
+	if _, err := u.RollbackAppServeApp(ctx, app.ID, previousTask.ID); err != nil {
+		log.Error(ctx, err)
+		return
+	}
+	task.VerificationMessage = fmt.Sprintf("%s. rolled back to version %s", task.VerificationMessage, previousTask.Version)
+	if err := u.repo.UpdateTaskVerification(ctx, task); err != nil {
+		log.Error(ctx, err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"strconv"
 	"strings"
@@ -11,8 +12,11 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
+	"gorm.io/gorm"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/openinfradev/tks-api/internal/mail"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
 	"github.com/openinfradev/tks-api/internal/repository"
@@ -25,7 +29,8 @@ import (
 
 type IAppServeAppUsecase interface {
 	CreateAppServeApp(ctx context.Context, app *model.AppServeApp, task *model.AppServeAppTask) (appId string, taskId string, err error)
-	GetAppServeApps(ctx context.Context, organizationId string, projectId string, showAll bool, pg *pagination.Pagination) ([]model.AppServeApp, error)
+	GetAppServeApps(ctx context.Context, organizationId string, projectId string, showAll bool, filter domain.AppServeAppFilter, pg *pagination.Pagination) ([]model.AppServeApp, error)
+	GetAppServeAppSummary(ctx context.Context, organizationId string) (domain.GetAppServeAppSummaryResponse, error)
 	GetAppServeAppById(ctx context.Context, appId string) (*model.AppServeApp, error)
 	GetAppServeAppTasks(ctx context.Context, appId string, pg *pagination.Pagination) ([]model.AppServeAppTask, error)
 	GetAppServeAppTaskById(ctx context.Context, taskId string) (*model.AppServeAppTask, error)
@@ -40,22 +45,47 @@ type IAppServeAppUsecase interface {
 	UpdateAppServeAppEndpoint(ctx context.Context, appId string, taskId string, endpoint string, previewEndpoint string, helmRevision int32) (string, error)
 	PromoteAppServeApp(ctx context.Context, appId string) (ret string, err error)
 	AbortAppServeApp(ctx context.Context, appId string) (ret string, err error)
-	RollbackAppServeApp(ctx context.Context, appId string, taskId string) (ret string, err error)
+	RollbackAppServeApp(ctx context.Context, appId string, taskId string) (out domain.RollbackAppServeAppResponse, err error)
+	GetAppServeAppMetrics(ctx context.Context, appId string, duration string, interval string) (domain.GetAppServeAppMetricsResponse, error)
+	GetAppServeAppConfigs(ctx context.Context, appId string, pg *pagination.Pagination) ([]domain.AppServeAppConfigResponse, error)
+	GetAppServeAppConfig(ctx context.Context, appId string, revision int) (domain.AppServeAppConfigResponse, error)
+	UpdateAppServeAppConfig(ctx context.Context, appId string, dto domain.UpdateAppServeAppConfigRequest) (revision int, err error)
+	GetAppServeAppConfigDiff(ctx context.Context, appId string, fromRevision int, toRevision int) (domain.GetAppServeAppConfigDiffResponse, error)
+	GetAppServeAppGitSource(ctx context.Context, appId string) (domain.AppServeAppGitSourceResponse, error)
+	UpdateAppServeAppGitSource(ctx context.Context, appId string, dto domain.UpdateAppServeAppGitSourceRequest) (domain.UpdateAppServeAppGitSourceResponse, error)
+	DeleteAppServeAppGitSource(ctx context.Context, appId string) error
+	BuildAppServeApp(ctx context.Context, appId string, dto domain.BuildAppServeAppRequest) (domain.BuildAppServeAppResponse, error)
+	ReceiveGitWebhook(ctx context.Context, appId string, req domain.GitWebhookRequest) (domain.GitWebhookResponse, error)
+	VerifyDeployments(ctx context.Context) error
 }
 
 type AppServeAppUsecase struct {
 	repo             repository.IAppServeAppRepository
 	organizationRepo repository.IOrganizationRepository
 	appGroupRepo     repository.IAppGroupRepository
+	approvalRepo     repository.IDeploymentApprovalRepository
+	maintenanceRepo  repository.IMaintenanceWindowRepository
+	namingPolicyRepo repository.INamingPolicyRepository
+	gitProviderRepo  repository.IGitProviderRepository
 	argo             argowf.ArgoClient
+	operations       IOperationUsecase
+	thanosClients    ThanosClientFactory
+	encryptionKey    IEncryptionKeyUsecase
 }
 
-func NewAppServeAppUsecase(r repository.Repository, argoClient argowf.ArgoClient) IAppServeAppUsecase {
+func NewAppServeAppUsecase(r repository.Repository, argoClient argowf.ArgoClient, operations IOperationUsecase, thanosClients ThanosClientFactory, encryptionKey IEncryptionKeyUsecase) IAppServeAppUsecase {
 	return &AppServeAppUsecase{
 		repo:             r.AppServeApp,
 		organizationRepo: r.Organization,
 		appGroupRepo:     r.AppGroup,
+		approvalRepo:     r.DeploymentApproval,
+		maintenanceRepo:  r.MaintenanceWindow,
+		namingPolicyRepo: r.NamingPolicy,
+		gitProviderRepo:  r.GitProvider,
 		argo:             argoClient,
+		operations:       operations,
+		thanosClients:    thanosClients,
+		encryptionKey:    encryptionKey,
 	}
 }
 
@@ -63,6 +93,9 @@ func (u *AppServeAppUsecase) CreateAppServeApp(ctx context.Context, app *model.A
 	if app == nil {
 		return "", "", fmt.Errorf("invalid app obj")
 	}
+	if err := validateResourceName(ctx, u.namingPolicyRepo, app.OrganizationId, domain.NamingPolicyResourceType_APP, app.Name); err != nil {
+		return "", "", err
+	}
 
 	// For type 'build' and 'all', imageUrl and executablePath
 	// are constructed based on pre-defined rule
@@ -100,29 +133,26 @@ func (u *AppServeAppUsecase) CreateAppServeApp(ctx context.Context, app *model.A
 		}
 	}
 
-	extEnv := task.ExtraEnv
-	if extEnv != "" {
-		/* Preprocess extraEnv param */
-		log.Debug(ctx, "extraEnv received: ", extEnv)
+	if err := u.runPreDeployHook(ctx, app, task); err != nil {
+		return "", "", err
+	}
 
-		tempMap := map[string]string{}
-		err := json.Unmarshal([]byte(extEnv), &tempMap)
-		if err != nil {
-			log.Error(ctx, err)
-			return "", "", errors.Wrap(err, "Failed to process extraEnv param.")
-		}
-		log.Debugf(ctx, "extraEnv marshalled: %v", tempMap)
+	extEnv, err := transformExtraEnv(ctx, task.ExtraEnv)
+	if err != nil {
+		return "", "", err
+	}
 
-		newExtEnv := map[string]string{}
-		for key, val := range tempMap {
-			newkey := "\"" + key + "\""
-			newval := "\"" + val + "\""
-			newExtEnv[newkey] = newval
-		}
+	if err := checkMaintenanceWindow(ctx, u.maintenanceRepo, domain.ClusterId(app.TargetClusterId)); err != nil {
+		return "", "", err
+	}
 
-		mJson, _ := json.Marshal(newExtEnv)
-		extEnv = string(mJson)
-		log.Debug(ctx, "After transform, extraEnv: ", extEnv)
+	policy, err := u.approvalPolicy(ctx, app.TargetClusterId)
+	if err != nil {
+		return "", "", err
+	}
+	if policy != nil {
+		app.Status = "APPROVAL_WAIT"
+		task.Status = "APPROVAL_WAIT"
 	}
 
 	appId, err := u.repo.CreateAppServeApp(ctx, app)
@@ -139,63 +169,75 @@ func (u *AppServeAppUsecase) CreateAppServeApp(ctx context.Context, app *model.A
 
 	fmt.Printf("appId = %s, taskId = %s", appId, taskId)
 
-	// TODO: Validate PV params
-
-	// Call argo workflow
-	workflow := "serve-java-app"
-
-	opts := argowf.SubmitOptions{}
-	opts.Parameters = []string{
-		"type=" + app.Type,
-		"strategy=" + task.Strategy,
-		"app_type=" + app.AppType,
-		"organization_id=" + app.OrganizationId,
-		"project_id=" + app.ProjectId,
-		"target_cluster_id=" + app.TargetClusterId,
-		"app_name=" + app.Name,
-		"namespace=" + app.Namespace,
-		"asa_id=" + appId,
-		"asa_task_id=" + taskId,
-		"artifact_url=" + task.ArtifactUrl,
-		"image_url=" + task.ImageUrl,
-		"port=" + task.Port,
-		"profile=" + task.Profile,
-		"extra_env=" + extEnv,
-		"app_config=" + task.AppConfig,
-		"app_secret=" + task.AppSecret,
-		"resource_spec=" + task.ResourceSpec,
-		"executable_path=" + task.ExecutablePath,
-		"git_repo_url=" + viper.GetString("git-repository-url"),
-		"harbor_pw_secret=" + viper.GetString("harbor-pw-secret"),
-		"pv_enabled=" + strconv.FormatBool(task.PvEnabled),
-		"pv_storage_class=" + task.PvStorageClass,
-		"pv_access_mode=" + task.PvAccessMode,
-		"pv_size=" + task.PvSize,
-		"pv_mount_path=" + task.PvMountPath,
-		"tks_api_url=" + viper.GetString("external-address"),
+	// production 클러스터는 승인 이후에 workflow 를 시작한다.
+	if policy != nil {
+		if err = u.requestApproval(ctx, policy, app, taskId, domain.DeploymentApprovalAction_DEPLOY); err != nil {
+			return "", "", err
+		}
+		return appId, app.Name, nil
 	}
 
-	log.Info(ctx, "Submitting workflow: ", workflow)
+	// TODO: Validate PV params
 
-	workflowId, err := u.argo.SumbitWorkflowFromWftpl(ctx, workflow, opts)
-	if err != nil {
-		log.Error(ctx, err)
-		return "", "", errors.Wrap(err, fmt.Sprintf("failed to submit workflow. %s", workflow))
+	if err = submitServeWorkflow(ctx, u.operations, u.encryptionKey, u.repo, app, task, extEnv); err != nil {
+		return "", "", errors.Wrap(err, "failed to submit workflow. serve-java-app")
 	}
-	log.Info(ctx, "Successfully submitted workflow: ", workflowId)
 
 	return appId, app.Name, nil
 }
 
-func (u *AppServeAppUsecase) GetAppServeApps(ctx context.Context, organizationId string, projectId string, showAll bool, pg *pagination.Pagination) ([]model.AppServeApp, error) {
-	apps, err := u.repo.GetAppServeApps(ctx, organizationId, projectId, showAll, pg)
+func (u *AppServeAppUsecase) GetAppServeApps(ctx context.Context, organizationId string, projectId string, showAll bool, filter domain.AppServeAppFilter, pg *pagination.Pagination) ([]model.AppServeApp, error) {
+	var filters []repository.FilterFunc
+	if len(filter.Statuses) > 0 {
+		filters = append(filters, u.repo.StatusFilter(filter.Statuses))
+	}
+	if filter.Stage != "" {
+		statuses, ok := domain.AppServeAppStageStatuses[strings.ToUpper(filter.Stage)]
+		if !ok {
+			return nil, httpErrors.NewError(fmt.Errorf("invalid stage %s", filter.Stage), "ASA_INVALID_STAGE")
+		}
+		filters = append(filters, u.repo.StatusFilter(statuses))
+	}
+	if filter.TargetClusterId != "" {
+		filters = append(filters, u.repo.TargetClusterFilter(filter.TargetClusterId))
+	}
+	if filter.Search != "" {
+		filters = append(filters, u.repo.NameSearchFilter(filter.Search))
+	}
+
+	apps, err := u.repo.GetAppServeApps(ctx, organizationId, projectId, showAll, pg, filters...)
 	if err != nil {
 		log.Debugf(ctx, "Apps: [%v]", apps)
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	return apps, nil
 }
 
+func (u *AppServeAppUsecase) GetAppServeAppSummary(ctx context.Context, organizationId string) (out domain.GetAppServeAppSummaryResponse, err error) {
+	statuses, err := u.repo.CountAppServeAppsByStatus(ctx, organizationId)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	stages := make(map[string]int64)
+	for _, status := range statuses {
+		out.Total += status.Count
+		stages[domain.AppServeAppStage(status.Status)] += status.Count
+	}
+	out.Statuses = statuses
+
+	out.Stages = make([]domain.AppServeAppStageCount, 0, len(stages))
+	for stage, count := range stages {
+		out.Stages = append(out.Stages, domain.AppServeAppStageCount{Stage: stage, Count: count})
+	}
+	sort.Slice(out.Stages, func(i, j int) bool {
+		return out.Stages[i].Stage < out.Stages[j].Stage
+	})
+
+	return out, nil
+}
+
 func (u *AppServeAppUsecase) GetAppServeAppById(ctx context.Context, appId string) (*model.AppServeApp, error) {
 	asa, err := u.repo.GetAppServeAppById(ctx, appId)
 	if err != nil {
@@ -207,7 +249,7 @@ func (u *AppServeAppUsecase) GetAppServeAppById(ctx context.Context, appId strin
 	************************/
 	organization, err := u.organizationRepo.Get(ctx, asa.OrganizationId)
 	if err != nil {
-		return asa, httpErrors.NewInternalServerError(errors.Wrap(err, fmt.Sprintf("Failed to get organization for app %s", asa.Name)), "S_FAILED_FETCH_ORGANIZATION", "")
+		return asa, httpErrors.NewError(errors.Wrap(err, fmt.Sprintf("Failed to get organization for app %s", asa.Name)), "S_FAILED_FETCH_ORGANIZATION")
 	}
 
 	// Get app groups in primary clustser
@@ -328,6 +370,9 @@ func (u *AppServeAppUsecase) UpdateAppServeAppStatus(ctx context.Context, appId
 		log.Info(ctx, "taskId = ", taskId)
 		return "", fmt.Errorf("failed to update app status. Err: %s", err)
 	}
+	if status == "DEPLOY_SUCCESS" || status == "PROMOTE_SUCCESS" {
+		u.scheduleVerification(ctx, taskId)
+	}
 	return fmt.Sprintf("The appId '%s' status is being updated.", appId), nil
 }
 
@@ -432,6 +477,11 @@ func (u *AppServeAppUsecase) DeleteAppServeApp(ctx context.Context, appId string
 	}
 	log.Info(ctx, "Successfully submitted workflow: ", workflowId)
 
+	// 삭제 중인 앱은 push webhook 으로 다시 빌드하지 않는다.
+	if err = u.gitProviderRepo.DeleteSource(ctx, appId); err != nil {
+		log.Warnf(ctx, "failed to delete git source of app %s. err: %s", appId, err)
+	}
+
 	return fmt.Sprintf("The app %s is being deleted. "+
 		"Confirm result by checking the app status after a while.", app.Name), nil
 }
@@ -450,6 +500,9 @@ func (u *AppServeAppUsecase) UpdateAppServeApp(ctx context.Context, appId string
 	if app.Status == "PROMOTE_WAIT" || app.Status == "PROMOTING" || app.Status == "ABORTING" {
 		return "승인대기 또는 프로모트 작업 중에는 업그레이드를 수행할 수 없습니다", fmt.Errorf("Update not possible. The app is waiting for promote or in the middle of promote process.")
 	}
+	if app.Status == "APPROVAL_WAIT" {
+		return "배포 승인 대기 중에는 업그레이드를 수행할 수 없습니다", fmt.Errorf("Update not possible. The app is waiting for deployment approval.")
+	}
 
 	log.Info(ctx, "Starting normal update process..")
 
@@ -467,8 +520,8 @@ func (u *AppServeAppUsecase) UpdateAppServeApp(ctx context.Context, appId string
 		imageUrl := viper.GetString("image-registry-url") + "/" + app.Name + "-" + app.TargetClusterId + ":" + appTask.Version
 		appTask.ImageUrl = imageUrl
 
-		// Construct executable_path
-		if app.AppType == "springboot" {
+		// Construct executable_path. git 소스로 빌드하면 workflow 가 빌드 결과로 정한다.
+		if app.AppType == "springboot" && appTask.SourceRepoUrl == "" {
 			artiUrl := appTask.ArtifactUrl
 			tempArr := strings.Split(artiUrl, "/")
 			exeFilename := tempArr[len(tempArr)-1]
@@ -478,31 +531,27 @@ func (u *AppServeAppUsecase) UpdateAppServeApp(ctx context.Context, appId string
 		}
 	}
 
-	extEnv := appTask.ExtraEnv
-	if extEnv != "" {
-		/* Preprocess extraEnv param */
-		log.Debug(ctx, "extraEnv received: ", extEnv)
+	if err := u.runPreDeployHook(ctx, app, appTask); err != nil {
+		return "", err
+	}
 
-		tempMap := map[string]string{}
-		err = json.Unmarshal([]byte(extEnv), &tempMap)
-		if err != nil {
-			log.Error(ctx, err)
-			return "", errors.Wrap(err, "Failed to process extraEnv param.")
-		}
-		log.Debugf(ctx, "extraEnv marshalled: %v", tempMap)
+	extEnv, err := transformExtraEnv(ctx, appTask.ExtraEnv)
+	if err != nil {
+		return "", err
+	}
 
-		newExtEnv := map[string]string{}
-		for key, val := range tempMap {
-			newkey := "\"" + key + "\""
-			newval := "\"" + val + "\""
-			newExtEnv[newkey] = newval
-		}
+	if err := checkMaintenanceWindow(ctx, u.maintenanceRepo, domain.ClusterId(app.TargetClusterId)); err != nil {
+		return "", err
+	}
 
-		mJson, _ := json.Marshal(newExtEnv)
-		extEnv = string(mJson)
-		log.Debug(ctx, "After transform, extraEnv: ", extEnv)
+	policy, err := u.approvalPolicy(ctx, app.TargetClusterId)
+	if err != nil {
+		return "", err
 	}
 
+	// 이전 task 에서 복사된 검증 결과는 새 task 에 남기지 않는다.
+	resetVerification(appTask)
+
 	// TODO: Check if appId is necessary here.
 	taskId, err := u.repo.CreateTask(ctx, appTask, appId)
 	if err != nil {
@@ -510,6 +559,18 @@ func (u *AppServeAppUsecase) UpdateAppServeApp(ctx context.Context, appId string
 		return "", fmt.Errorf("failed to update app-serve application. Err: %s", err)
 	}
 
+	// production 클러스터는 승인 이후에 workflow 를 시작한다.
+	if policy != nil {
+		err = u.repo.UpdateStatus(ctx, app.ID, taskId, "APPROVAL_WAIT", "")
+		if err != nil {
+			return "", fmt.Errorf("failed to update app status on UpdateAppServeApp. Err: %s", err)
+		}
+		if err = u.requestApproval(ctx, policy, app, taskId, domain.DeploymentApprovalAction_DEPLOY); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("The app '%s' is waiting for deployment approval.", app.Name), nil
+	}
+
 	// Sync new task status to the parent app
 	log.Info(ctx, "Updating app status to 'PREPARING'..")
 
@@ -520,47 +581,9 @@ func (u *AppServeAppUsecase) UpdateAppServeApp(ctx context.Context, appId string
 		return "", fmt.Errorf("failed to update app status on UpdateAppServeApp. Err: %s", err)
 	}
 
-	// Call argo workflow
-	workflow := "serve-java-app"
-
-	log.Info(ctx, "Submitting workflow: ", workflow)
-
-	workflowId, err := u.argo.SumbitWorkflowFromWftpl(ctx, workflow, argowf.SubmitOptions{
-		Parameters: []string{
-			"type=" + app.Type,
-			"strategy=" + appTask.Strategy,
-			"app_type=" + app.AppType,
-			"organization_id=" + app.OrganizationId,
-			"project_id=" + app.ProjectId,
-			"target_cluster_id=" + app.TargetClusterId,
-			"app_name=" + app.Name,
-			"namespace=" + app.Namespace,
-			"asa_id=" + app.ID,
-			"asa_task_id=" + taskId,
-			"artifact_url=" + appTask.ArtifactUrl,
-			"image_url=" + appTask.ImageUrl,
-			"port=" + appTask.Port,
-			"profile=" + appTask.Profile,
-			"extra_env=" + extEnv,
-			"app_config=" + appTask.AppConfig,
-			"app_secret=" + appTask.AppSecret,
-			"resource_spec=" + appTask.ResourceSpec,
-			"executable_path=" + appTask.ExecutablePath,
-			"git_repo_url=" + viper.GetString("git-repository-url"),
-			"harbor_pw_secret=" + viper.GetString("harbor-pw-secret"),
-			"pv_enabled=" + strconv.FormatBool(appTask.PvEnabled),
-			"pv_storage_class=" + appTask.PvStorageClass,
-			"pv_access_mode=" + appTask.PvAccessMode,
-			"pv_size=" + appTask.PvSize,
-			"pv_mount_path=" + appTask.PvMountPath,
-			"tks_api_url=" + viper.GetString("external-address"),
-		},
-	})
-	if err != nil {
-		log.Error(ctx, "Failed to submit workflow. Err:", err)
+	if err = submitServeWorkflow(ctx, u.operations, u.encryptionKey, u.repo, app, appTask, extEnv); err != nil {
 		return "", fmt.Errorf("failed to submit workflow. Err: %s", err)
 	}
-	log.Info(ctx, "Successfully submitted workflow: ", workflowId)
 
 	var message string
 	if appTask.Strategy == "rolling-update" {
@@ -593,6 +616,24 @@ func (u *AppServeAppUsecase) PromoteAppServeApp(ctx context.Context, appId strin
 	log.Debug(ctx, "latestTaskId = ", latestTaskId)
 	log.Debug(ctx, "strategy = ", strategy)
 
+	if err := checkMaintenanceWindow(ctx, u.maintenanceRepo, domain.ClusterId(app.TargetClusterId)); err != nil {
+		return "", err
+	}
+
+	policy, err := u.approvalPolicy(ctx, app.TargetClusterId)
+	if err != nil {
+		return "", err
+	}
+	if policy != nil {
+		if _, err = u.approvalRepo.GetPending(ctx, appId); err == nil {
+			return "", httpErrors.NewError(fmt.Errorf("the app is already waiting for approval"), "DA_ALREADY_PENDING")
+		}
+		if err = u.requestApproval(ctx, policy, app, latestTaskId, domain.DeploymentApprovalAction_PROMOTE); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("The app '%s' is waiting for promote approval.", app.Name), nil
+	}
+
 	log.Info(ctx, "Updating app status to 'PROMOTING'..")
 
 	err = u.repo.UpdateStatus(ctx, appId, latestTaskId, "PROMOTING", "")
@@ -602,29 +643,9 @@ func (u *AppServeAppUsecase) PromoteAppServeApp(ctx context.Context, appId strin
 		return "", fmt.Errorf("failed to update app status on PromoteAppServeApp. Err: %s", err)
 	}
 
-	// Call argo workflow
-	workflow := "promote-java-app"
-
-	log.Info(ctx, "Submitting workflow: ", workflow)
-
-	workflowId, err := u.argo.SumbitWorkflowFromWftpl(ctx, workflow, argowf.SubmitOptions{
-		Parameters: []string{
-			"organization_id=" + app.OrganizationId,
-			"project_id=" + app.ProjectId,
-			"target_cluster_id=" + app.TargetClusterId,
-			"app_name=" + app.Name,
-			"namespace=" + app.Namespace,
-			"asa_id=" + app.ID,
-			"asa_task_id=" + latestTaskId,
-			"strategy=" + strategy,
-			"tks_api_url=" + viper.GetString("external-address"),
-		},
-	})
-	if err != nil {
-		log.Error(ctx, "failed to submit workflow. Err:", err)
+	if err = submitPromoteWorkflow(ctx, u.argo, app, latestTaskId, strategy); err != nil {
 		return "", fmt.Errorf("failed to submit workflow. Err: %s", err)
 	}
-	log.Info(ctx, "Successfully submitted workflow: ", workflowId)
 
 	return fmt.Sprintf("The app '%s' is being promoted. "+
 		"Confirm result by checking the app status after a while.", app.Name), nil
@@ -684,28 +705,41 @@ func (u *AppServeAppUsecase) AbortAppServeApp(ctx context.Context, appId string)
 		"Confirm result by checking the app status after a while.", app.Name), nil
 }
 
-func (u *AppServeAppUsecase) RollbackAppServeApp(ctx context.Context, appId string, taskId string) (ret string, err error) {
+// RollbackAppServeApp 은 이전에 배포에 성공한 task 를 복사한 새 task 로 그 task 의 helm revision 을 다시 배포한다.
+// 각 task 에 배포한 이미지, 설정, helm revision 이 남아 있으므로 task 목록이 곧 revision 이력이다.
+// rollback workflow 는 배포와 같은 동시 실행 제한을 받으며 operation 으로 진행 상황을 조회할 수 있다.
+func (u *AppServeAppUsecase) RollbackAppServeApp(ctx context.Context, appId string, taskId string) (out domain.RollbackAppServeAppResponse, err error) {
 	log.Info(ctx, "Starting rollback process..")
 
 	app, err := u.repo.GetAppServeAppById(ctx, appId)
 	if err != nil {
-		return "", err
+		return out, err
+	}
+	if app == nil {
+		return out, httpErrors.NewNotFoundError(fmt.Errorf("not found app %s", appId), "D_NO_ASA", "")
 	}
 
 	// Find target(dest) task
 	task, err := u.repo.GetAppServeAppTaskById(ctx, taskId)
-	if err != nil {
-		return "", err
+	if err != nil || task == nil {
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("not found task %s", taskId), "C_INVALID_ASA_TASK_ID", "")
 	}
 
 	if task.AppServeAppId != appId {
-		return "", fmt.Errorf("Rollback target task doesn't belong to current app. It belongs to: %s", task.AppServeAppId)
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("Rollback target task doesn't belong to current app. It belongs to: %s", task.AppServeAppId), "C_INVALID_ASA_TASK_ID", "")
 	}
 
 	// Find latest task for version info
 	latestTask, err := u.repo.GetAppServeAppLatestTask(ctx, appId)
 	if err != nil {
-		return "", err
+		return out, err
+	}
+	if err := validateRollbackTarget(*task, *latestTask); err != nil {
+		return out, httpErrors.NewBadRequestError(err, "ASA_INVALID_ROLLBACK_TARGET", "")
+	}
+
+	if err := checkMaintenanceWindow(ctx, u.maintenanceRepo, domain.ClusterId(app.TargetClusterId)); err != nil {
+		return out, err
 	}
 
 	// Save target version
@@ -714,7 +748,7 @@ func (u *AppServeAppUsecase) RollbackAppServeApp(ctx context.Context, appId stri
 
 	verInt, err := strconv.Atoi(latestTask.Version)
 	if err != nil {
-		return "", errors.Wrap(err, "Failed to convert version to integer.")
+		return out, errors.Wrap(err, "Failed to convert version to integer.")
 	}
 	newVerStr := strconv.Itoa(verInt + 1)
 
@@ -727,12 +761,15 @@ func (u *AppServeAppUsecase) RollbackAppServeApp(ctx context.Context, appId stri
 	task.UpdatedAt = nil
 	task.HelmRevision = 0
 	task.RollbackVersion = targetVer
+	// rollback 결과를 다시 자동 rollback 하지 않는다.
+	resetVerification(task)
+	task.AutoRollback = false
 
 	// Creates new task record from the target task
 	newTaskId, err := u.repo.CreateTask(ctx, task, "")
 	if err != nil {
 		log.Info(ctx, "taskId = ", newTaskId)
-		return "", fmt.Errorf("failed to rollback app-serve application. Err: %s", err)
+		return out, fmt.Errorf("failed to rollback app-serve application. Err: %s", err)
 	}
 
 	log.Info(ctx, "Updating app status to 'ROLLBACKING'..")
@@ -741,7 +778,7 @@ func (u *AppServeAppUsecase) RollbackAppServeApp(ctx context.Context, appId stri
 	if err != nil {
 		log.Debug(ctx, "appId = ", appId)
 		log.Debug(ctx, "taskId = ", newTaskId)
-		return "", fmt.Errorf("failed to update app status on RollbackAppServeApp. Err: %s", err)
+		return out, fmt.Errorf("failed to update app status on RollbackAppServeApp. Err: %s", err)
 	}
 
 	// Call argo workflow
@@ -749,7 +786,225 @@ func (u *AppServeAppUsecase) RollbackAppServeApp(ctx context.Context, appId stri
 
 	log.Info(ctx, "Submitting workflow: ", workflow)
 
-	workflowId, err := u.argo.SumbitWorkflowFromWftpl(ctx, workflow, argowf.SubmitOptions{
+	operation, err := u.operations.Submit(ctx, model.Operation{
+		OrganizationId:   app.OrganizationId,
+		Type:             domain.OperationType_APP_ROLLBACK,
+		TargetId:         app.ID,
+		TargetName:       app.Name,
+		WorkflowTemplate: workflow,
+	}, []string{
+		"organization_id=" + app.OrganizationId,
+		"project_id=" + app.ProjectId,
+		"target_cluster_id=" + app.TargetClusterId,
+		"app_name=" + app.Name,
+		"namespace=" + app.Namespace,
+		"asa_id=" + app.ID,
+		"asa_task_id=" + newTaskId,
+		"helm_revision=" + strconv.Itoa(int(targetRev)),
+		"tks_api_url=" + viper.GetString("external-address"),
+	})
+	if err != nil {
+		log.Error(ctx, "Failed to submit workflow. Err:", err)
+		return out, fmt.Errorf("failed to submit workflow. Err: %s", err)
+	}
+	log.Info(ctx, "Successfully submitted workflow: ", operation.WorkflowId)
+
+	out = domain.RollbackAppServeAppResponse{
+		TaskId:          newTaskId,
+		Version:         newVerStr,
+		RollbackVersion: targetVer,
+		OperationId:     operation.ID.String(),
+		OperationStatus: operation.Status,
+	}
+	if operation.Status == domain.OperationStatus_PENDING {
+		log.Info(ctx, "Workflow is pending. operation: ", operation.ID)
+		if err := u.repo.UpdateStatus(ctx, appId, newTaskId, "PENDING", ""); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+// validateRollbackTarget 은 이미 실행 중인 최신 task 나 배포에 성공하지 않아 helm revision 이 없는 task 로는 rollback 하지 않는다.
+func validateRollbackTarget(task model.AppServeAppTask, latestTask model.AppServeAppTask) error {
+	if task.ID == latestTask.ID {
+		return fmt.Errorf("task %s is the latest task", task.ID)
+	}
+	if task.Status != "DEPLOY_SUCCESS" && task.Status != "PROMOTE_SUCCESS" && task.Status != "ROLLBACK_SUCCESS" {
+		return fmt.Errorf("task %s is not deployed successfully. status: %s", task.ID, task.Status)
+	}
+	if task.HelmRevision == 0 {
+		return fmt.Errorf("task %s has no helm revision", task.ID)
+	}
+	return nil
+}
+
+// approvalPolicy returns the deployment approval policy of the cluster. It returns nil if the cluster doesn't require approval.
+func (u *AppServeAppUsecase) approvalPolicy(ctx context.Context, clusterId string) (*model.DeploymentApprovalPolicy, error) {
+	policy, err := u.approvalRepo.GetPolicy(ctx, domain.ClusterId(clusterId))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return &policy, nil
+}
+
+func (u *AppServeAppUsecase) requestApproval(ctx context.Context, policy *model.DeploymentApprovalPolicy, app *model.AppServeApp, taskId string,
+	action domain.DeploymentApprovalAction) error {
+	dto := model.DeploymentApproval{
+		OrganizationId:    app.OrganizationId,
+		AppServeAppId:     app.ID,
+		AppServeAppTaskId: taskId,
+		ClusterId:         policy.ClusterId,
+		Action:            action,
+	}
+	if user, ok := request.UserFrom(ctx); ok {
+		userId := user.GetUserId()
+		dto.RequesterId = &userId
+	}
+
+	approvalId, err := u.approvalRepo.Create(ctx, dto)
+	if err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	log.Info(ctx, "newly created deployment approval : ", approvalId)
+
+	notifyDeploymentApproval(ctx, app.OrganizationId,
+		fmt.Sprintf("[TKS] 앱 [%s] %s 승인 요청", app.Name, action),
+		fmt.Sprintf("production 클러스터 [%s]에 대한 앱 [%s]의 %s 승인이 요청되었습니다. (approvalId: %s)", policy.ClusterId, app.Name, action, approvalId),
+		policy.Approvers)
+	return nil
+}
+
+func notifyDeploymentApproval(ctx context.Context, organizationId string, title string, content string, users []model.User) {
+	to := []string{}
+	for _, user := range users {
+		if user.Email != "" {
+			to = append(to, user.Email)
+		}
+	}
+	if len(to) == 0 {
+		return
+	}
+
+	message, err := mail.MakeSystemNotificationMessage(ctx, organizationId, title, content, to)
+	if err != nil {
+		log.Error(ctx, fmt.Sprintf("Failed to make email content. err : %s", err.Error()))
+		return
+	}
+	if err := mail.New(message).SendMail(ctx); err != nil {
+		log.Error(ctx, fmt.Sprintf("Failed to send email to %s. err : %s", to, err.Error()))
+	}
+}
+
+func transformExtraEnv(ctx context.Context, extEnv string) (string, error) {
+	if extEnv == "" {
+		return extEnv, nil
+	}
+
+	/* Preprocess extraEnv param */
+	log.Debug(ctx, "extraEnv received: ", extEnv)
+
+	tempMap := map[string]string{}
+	err := json.Unmarshal([]byte(extEnv), &tempMap)
+	if err != nil {
+		log.Error(ctx, err)
+		return "", errors.Wrap(err, "Failed to process extraEnv param.")
+	}
+	log.Debugf(ctx, "extraEnv marshalled: %v", tempMap)
+
+	newExtEnv := map[string]string{}
+	for key, val := range tempMap {
+		newkey := "\"" + key + "\""
+		newval := "\"" + val + "\""
+		newExtEnv[newkey] = newval
+	}
+
+	mJson, _ := json.Marshal(newExtEnv)
+	extEnv = string(mJson)
+	log.Debug(ctx, "After transform, extraEnv: ", extEnv)
+	return extEnv, nil
+}
+
+// submitServeWorkflow 는 앱 배포 workflow 를 제출한다.
+// 앱의 config 가 있으면 secret 을 동기화하고 환경변수를 extEnv 에 합친 뒤 제출한다.
+// 동시 실행 제한을 넘어 대기열에 들어간 경우 앱의 상태를 PENDING 으로 바꾼다.
+func submitServeWorkflow(ctx context.Context, operations IOperationUsecase, encryptionKey IEncryptionKeyUsecase, repo repository.IAppServeAppRepository, app *model.AppServeApp, task *model.AppServeAppTask, extEnv string) error {
+	extraEnv, secretName, err := syncAppServeAppConfig(ctx, encryptionKey, repo, app, task)
+	if err != nil {
+		return err
+	}
+	if task.ConfigRevision > 0 {
+		if extEnv, err = transformExtraEnv(ctx, extraEnv); err != nil {
+			return err
+		}
+	}
+
+	// Call argo workflow
+	workflow := "serve-java-app"
+
+	log.Info(ctx, "Submitting workflow: ", workflow)
+
+	operation, err := operations.Submit(ctx, model.Operation{
+		OrganizationId:   app.OrganizationId,
+		Type:             domain.OperationType_APP_DEPLOY,
+		TargetId:         app.ID,
+		TargetName:       app.Name,
+		WorkflowTemplate: workflow,
+	}, []string{
+		"type=" + app.Type,
+		"strategy=" + task.Strategy,
+		"app_type=" + app.AppType,
+		"organization_id=" + app.OrganizationId,
+		"project_id=" + app.ProjectId,
+		"target_cluster_id=" + app.TargetClusterId,
+		"app_name=" + app.Name,
+		"namespace=" + app.Namespace,
+		"asa_id=" + app.ID,
+		"asa_task_id=" + task.ID,
+		"artifact_url=" + task.ArtifactUrl,
+		"image_url=" + task.ImageUrl,
+		"port=" + task.Port,
+		"profile=" + task.Profile,
+		"extra_env=" + extEnv,
+		"env_secret_name=" + secretName,
+		"app_config=" + task.AppConfig,
+		"app_secret=" + task.AppSecret,
+		"resource_spec=" + task.ResourceSpec,
+		"executable_path=" + task.ExecutablePath,
+		"git_repo_url=" + viper.GetString("git-repository-url"),
+		"source_repo_url=" + task.SourceRepoUrl,
+		"source_branch=" + task.SourceBranch,
+		"source_commit=" + task.SourceCommit,
+		"source_secret_name=" + task.SourceSecretName,
+		"harbor_pw_secret=" + viper.GetString("harbor-pw-secret"),
+		"pv_enabled=" + strconv.FormatBool(task.PvEnabled),
+		"pv_storage_class=" + task.PvStorageClass,
+		"pv_access_mode=" + task.PvAccessMode,
+		"pv_size=" + task.PvSize,
+		"pv_mount_path=" + task.PvMountPath,
+		"tks_api_url=" + viper.GetString("external-address"),
+	})
+	if err != nil {
+		return err
+	}
+
+	if operation.Status == domain.OperationStatus_PENDING {
+		log.Info(ctx, "Workflow is pending. operation: ", operation.ID)
+		return repo.UpdateStatus(ctx, app.ID, task.ID, "PENDING", "")
+	}
+	return nil
+}
+
+func submitPromoteWorkflow(ctx context.Context, argo argowf.ArgoClient, app *model.AppServeApp, taskId string, strategy string) error {
+	// Call argo workflow
+	workflow := "promote-java-app"
+
+	log.Info(ctx, "Submitting workflow: ", workflow)
+
+	workflowId, err := argo.SumbitWorkflowFromWftpl(ctx, workflow, argowf.SubmitOptions{
 		Parameters: []string{
 			"organization_id=" + app.OrganizationId,
 			"project_id=" + app.ProjectId,
@@ -757,16 +1012,15 @@ func (u *AppServeAppUsecase) RollbackAppServeApp(ctx context.Context, appId stri
 			"app_name=" + app.Name,
 			"namespace=" + app.Namespace,
 			"asa_id=" + app.ID,
-			"asa_task_id=" + newTaskId,
-			"helm_revision=" + strconv.Itoa(int(targetRev)),
+			"asa_task_id=" + taskId,
+			"strategy=" + strategy,
 			"tks_api_url=" + viper.GetString("external-address"),
 		},
 	})
 	if err != nil {
-		log.Error(ctx, "Failed to submit workflow. Err:", err)
-		return "", fmt.Errorf("failed to submit workflow. Err: %s", err)
+		log.Error(ctx, "failed to submit workflow. Err:", err)
+		return err
 	}
 	log.Info(ctx, "Successfully submitted workflow: ", workflowId)
-
-	return fmt.Sprintf("Rollback app Request '%v' is successfully submitted", taskId), nil
+	return nil
 }
@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	s3 "github.com/openinfradev/tks-api/pkg/s3-client"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+// object storage 를 설정하지 않으면 audit_archives 테이블에 보관한다.
+const auditArchiveDatabaseLocation = "database"
+
+// 스케줄러와 관리자 요청이 같은 감사 로그를 중복으로 보관하지 않도록 archival run 은 한 번에 하나씩 처리한다.
+var auditArchivalMutex sync.Mutex
+
+func newAuditArchiveStorage() s3.S3Client {
+	if viper.GetString("audit-archive-s3-bucket") == "" {
+		return nil
+	}
+	client, err := s3.New(s3.Config{
+		Endpoint:        viper.GetString("audit-archive-s3-endpoint"),
+		Region:          viper.GetString("audit-archive-s3-region"),
+		Bucket:          viper.GetString("audit-archive-s3-bucket"),
+		AccessKeyId:     viper.GetString("audit-archive-s3-access-key-id"),
+		SecretAccessKey: viper.GetString("audit-archive-s3-secret-access-key"),
+		UsePathStyle:    viper.GetBool("audit-archive-s3-path-style"),
+	})
+	if err != nil {
+		log.Errorf(context.Background(), "failed to create audit archive storage. audits are archived to the database. err : %s", err)
+		return nil
+	}
+	return client
+}
+
+func defaultAuditRetentionPolicy(organizationId string) model.AuditRetentionPolicy {
+	return model.AuditRetentionPolicy{
+		OrganizationId: organizationId,
+		RetentionDays:  viper.GetInt("audit-retention-days"),
+		Action:         domain.AuditRetentionAction_ARCHIVE,
+		IsDefault:      true,
+	}
+}
+
+func (u *AuditUsecase) getRetentionPolicy(ctx context.Context, organizationId string) (model.AuditRetentionPolicy, error) {
+	policy, err := u.retentionRepo.GetPolicy(ctx, organizationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return defaultAuditRetentionPolicy(organizationId), nil
+		}
+		return model.AuditRetentionPolicy{}, err
+	}
+	return policy, nil
+}
+
+// GetRetentionPolicies 는 정책을 설정하지 않은 조직도 기본 정책으로 포함하여 반환한다.
+func (u *AuditUsecase) GetRetentionPolicies(ctx context.Context) ([]model.AuditRetentionPolicy, error) {
+	organizations, err := u.organizationRepo.Fetch(ctx, nil)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	policies, err := u.retentionRepo.FetchPolicies(ctx)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	policyMap := make(map[string]model.AuditRetentionPolicy, len(policies))
+	for _, policy := range policies {
+		policyMap[policy.OrganizationId] = policy
+	}
+
+	out := make([]model.AuditRetentionPolicy, 0, len(*organizations))
+	for _, organization := range *organizations {
+		policy, ok := policyMap[organization.ID]
+		if !ok {
+			policy = defaultAuditRetentionPolicy(organization.ID)
+		}
+		out = append(out, policy)
+	}
+	return out, nil
+}
+
+func (u *AuditUsecase) UpdateRetentionPolicy(ctx context.Context, dto model.AuditRetentionPolicy) error {
+	if _, err := u.organizationRepo.Get(ctx, dto.OrganizationId); err != nil {
+		return httpErrors.NewError(err, "AU_NOT_FOUND_ORGANIZATION")
+	}
+	if err := u.retentionRepo.UpsertPolicy(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// ApplyRetention 은 스케줄러에서 호출하며, 모든 조직의 보존 정책을 차례로 적용한다.
+// 한 조직에서 실패해도 나머지 조직은 계속 처리하고, 실패 내용은 archival run 에 남긴다.
+func (u *AuditUsecase) ApplyRetention(ctx context.Context) error {
+	runs, err := u.createArchivalRuns(ctx, "", domain.AuditArchivalRunTrigger_SCHEDULER)
+	if err != nil {
+		return err
+	}
+	u.processArchivalRuns(ctx, runs)
+	return nil
+}
+
+// StartArchivalRun 은 archival run 을 기록한 뒤 바로 반환하고, 실제 처리는 background 에서 진행한다.
+// organizationId 가 비어 있으면 모든 조직에 대해 실행한다.
+func (u *AuditUsecase) StartArchivalRun(ctx context.Context, organizationId string) ([]model.AuditArchivalRun, error) {
+	if organizationId != "" {
+		if _, err := u.organizationRepo.Get(ctx, organizationId); err != nil {
+			return nil, httpErrors.NewError(err, "AU_NOT_FOUND_ORGANIZATION")
+		}
+	}
+
+	runs, err := u.createArchivalRuns(ctx, organizationId, domain.AuditArchivalRunTrigger_MANUAL)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	go u.processArchivalRuns(context.Background(), runs)
+	return runs, nil
+}
+
+func (u *AuditUsecase) GetArchivalRuns(ctx context.Context, pg *pagination.Pagination) ([]model.AuditArchivalRun, error) {
+	runs, err := u.retentionRepo.FetchRuns(ctx, pg)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return runs, nil
+}
+
+func (u *AuditUsecase) GetArchivalRun(ctx context.Context, runId uuid.UUID) (model.AuditArchivalRun, error) {
+	run, err := u.retentionRepo.GetRun(ctx, runId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.AuditArchivalRun{}, httpErrors.NewError(err, "AU_NOT_FOUND_ARCHIVAL_RUN")
+		}
+		return model.AuditArchivalRun{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return run, nil
+}
+
+func (u *AuditUsecase) createArchivalRuns(ctx context.Context, organizationId string, trigger string) ([]model.AuditArchivalRun, error) {
+	organizationIds := []string{organizationId}
+	if organizationId == "" {
+		organizations, err := u.organizationRepo.Fetch(ctx, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to get organizations")
+		}
+		organizationIds = make([]string, len(*organizations))
+		for i, organization := range *organizations {
+			organizationIds[i] = organization.ID
+		}
+	}
+
+	now := time.Now()
+	runs := make([]model.AuditArchivalRun, 0, len(organizationIds))
+	for _, organizationId := range organizationIds {
+		policy, err := u.getRetentionPolicy(ctx, organizationId)
+		if err != nil {
+			return nil, err
+		}
+		run := model.AuditArchivalRun{
+			OrganizationId: organizationId,
+			Trigger:        trigger,
+			Action:         policy.Action,
+			RetentionDays:  policy.RetentionDays,
+			Before:         now.AddDate(0, 0, -policy.RetentionDays),
+			Status:         domain.AuditArchivalRunStatus_RUNNING,
+			StartedAt:      now,
+		}
+		if run.ID, err = u.retentionRepo.CreateRun(ctx, run); err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+func (u *AuditUsecase) processArchivalRuns(ctx context.Context, runs []model.AuditArchivalRun) {
+	auditArchivalMutex.Lock()
+	defer auditArchivalMutex.Unlock()
+
+	for _, run := range runs {
+		var err error
+		switch run.Action {
+		case domain.AuditRetentionAction_PURGE:
+			run.ProcessedCount, err = u.repo.Purge(ctx, run.OrganizationId, run.Before, AUDIT_ARCHIVE_BATCH_SIZE)
+		default:
+			err = u.archive(ctx, &run)
+		}
+
+		finishedAt := time.Now()
+		run.FinishedAt = &finishedAt
+		run.Status = domain.AuditArchivalRunStatus_SUCCEEDED
+		if err != nil {
+			log.Errorf(ctx, "failed to apply audit retention of organization %s. err : %s", run.OrganizationId, err)
+			run.Status = domain.AuditArchivalRunStatus_FAILED
+			run.Error = err.Error()
+		} else if run.ProcessedCount > 0 {
+			log.Infof(ctx, "%s %d audits of organization %s", run.Action, run.ProcessedCount, run.OrganizationId)
+		}
+		if err := u.retentionRepo.UpdateRun(ctx, run); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+}
+
+// archive 는 감사 로그를 batch 단위로 gzip 압축한 JSON lines 파일로 업로드한 뒤 삭제한다.
+// 업로드에 성공한 batch 만 삭제하므로, 중간에 실패하면 남은 감사 로그는 다음 실행에서 다시 처리된다.
+func (u *AuditUsecase) archive(ctx context.Context, run *model.AuditArchivalRun) (err error) {
+	if u.archiveStorage == nil {
+		run.Location = auditArchiveDatabaseLocation
+		run.ProcessedCount, err = u.repo.Archive(ctx, run.OrganizationId, run.Before, AUDIT_ARCHIVE_BATCH_SIZE)
+		return err
+	}
+
+	prefix := fmt.Sprintf("audits/%s/%s/", run.OrganizationId, run.ID)
+	run.Location = u.archiveStorage.Location(prefix)
+	for batch := 1; ; batch++ {
+		audits, err := u.repo.FetchBefore(ctx, run.OrganizationId, run.Before, AUDIT_ARCHIVE_BATCH_SIZE)
+		if err != nil {
+			return err
+		}
+		if len(audits) == 0 {
+			return nil
+		}
+
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		encoder := json.NewEncoder(zw)
+		auditIds := make([]uuid.UUID, len(audits))
+		for i, audit := range audits {
+			if err := encoder.Encode(audit); err != nil {
+				return err
+			}
+			auditIds[i] = audit.ID
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s%06d.jsonl.gz", prefix, batch)
+		if err := u.archiveStorage.PutObject(ctx, key, buf.Bytes(), "application/gzip"); err != nil {
+			return errors.Wrapf(err, "Failed to upload %s", key)
+		}
+		if err := u.repo.DeleteByIds(ctx, auditIds); err != nil {
+			return err
+		}
+		run.ProcessedCount += int64(len(audits))
+
+		if len(audits) < AUDIT_ARCHIVE_BATCH_SIZE {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	auditsink "github.com/openinfradev/tks-api/pkg/audit-sink"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IAuditSinkUsecase interface {
+	Create(ctx context.Context, dto model.AuditSink) (auditSinkId uuid.UUID, err error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AuditSink, error)
+	Get(ctx context.Context, organizationId string, auditSinkId uuid.UUID) (model.AuditSink, error)
+	Update(ctx context.Context, dto model.AuditSink) error
+	Delete(ctx context.Context, organizationId string, auditSinkId uuid.UUID) error
+	FetchDeadLetters(ctx context.Context, organizationId string, auditSinkId uuid.UUID, pg *pagination.Pagination) ([]model.AuditSinkDeadLetter, error)
+	RetryDeadLetter(ctx context.Context, organizationId string, auditSinkId uuid.UUID, deadLetterId uuid.UUID) error
+	DeleteDeadLetter(ctx context.Context, organizationId string, auditSinkId uuid.UUID, deadLetterId uuid.UUID) error
+}
+
+type AuditSinkUsecase struct {
+	repo          repository.IAuditSinkRepository
+	encryptionKey IEncryptionKeyUsecase
+}
+
+func NewAuditSinkUsecase(r repository.Repository, encryptionKey IEncryptionKeyUsecase) IAuditSinkUsecase {
+	return &AuditSinkUsecase{
+		repo:          r.AuditSink,
+		encryptionKey: encryptionKey,
+	}
+}
+
+func (u *AuditSinkUsecase) Create(ctx context.Context, dto model.AuditSink) (auditSinkId uuid.UUID, err error) {
+	if err := validateAuditSink(ctx, dto); err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "AU_INVALID_AUDIT_SINK")
+	}
+	if dto.Secret, err = sealOrganizationSecret(ctx, u.encryptionKey, dto.OrganizationId, dto.Secret); err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.CreatorId = &userId
+	}
+
+	auditSinkId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return auditSinkId, nil
+}
+
+func (u *AuditSinkUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.AuditSink, error) {
+	return u.repo.Fetch(ctx, organizationId, pg)
+}
+
+func (u *AuditSinkUsecase) Get(ctx context.Context, organizationId string, auditSinkId uuid.UUID) (model.AuditSink, error) {
+	auditSink, err := u.repo.Get(ctx, auditSinkId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.AuditSink{}, httpErrors.NewError(err, "AU_NOT_FOUND_AUDIT_SINK")
+		}
+		return model.AuditSink{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if auditSink.OrganizationId != organizationId {
+		return model.AuditSink{}, httpErrors.NewError(fmt.Errorf("not found audit sink in organization"), "AU_NOT_FOUND_AUDIT_SINK")
+	}
+	return auditSink, nil
+}
+
+// Update 는 sink 의 type 을 변경하지 않는다. secret 이 비어 있으면 기존 secret 을 유지한다.
+func (u *AuditSinkUsecase) Update(ctx context.Context, dto model.AuditSink) error {
+	auditSink, err := u.Get(ctx, dto.OrganizationId, dto.ID)
+	if err != nil {
+		return err
+	}
+	dto.Type = auditSink.Type
+	if dto.Secret, err = sealOrganizationSecret(ctx, u.encryptionKey, dto.OrganizationId, dto.Secret); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if dto.Secret == "" {
+		dto.Secret = auditSink.Secret
+	}
+	if err := validateAuditSink(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "AU_INVALID_AUDIT_SINK")
+	}
+
+	if err := u.repo.Update(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *AuditSinkUsecase) Delete(ctx context.Context, organizationId string, auditSinkId uuid.UUID) error {
+	if _, err := u.Get(ctx, organizationId, auditSinkId); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, auditSinkId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *AuditSinkUsecase) FetchDeadLetters(ctx context.Context, organizationId string, auditSinkId uuid.UUID, pg *pagination.Pagination) ([]model.AuditSinkDeadLetter, error) {
+	if _, err := u.Get(ctx, organizationId, auditSinkId); err != nil {
+		return nil, err
+	}
+	return u.repo.FetchDeadLetters(ctx, auditSinkId, pg)
+}
+
+// RetryDeadLetter 는 dead letter 를 한 번 다시 전달한다. 성공하면 dead letter 를 삭제하고, 실패하면 시도 횟수와 오류를 갱신한다.
+func (u *AuditSinkUsecase) RetryDeadLetter(ctx context.Context, organizationId string, auditSinkId uuid.UUID, deadLetterId uuid.UUID) error {
+	auditSink, err := u.Get(ctx, organizationId, auditSinkId)
+	if err != nil {
+		return err
+	}
+	deadLetter, err := u.getDeadLetter(ctx, auditSinkId, deadLetterId)
+	if err != nil {
+		return err
+	}
+
+	if auditSink.Secret, err = openOrganizationSecret(ctx, u.encryptionKey, auditSink.Secret); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	client, err := auditsink.New(auditSinkConfig(auditSink))
+	if err != nil {
+		return httpErrors.NewError(err, "AU_INVALID_AUDIT_SINK")
+	}
+	if err := client.Send(ctx, deadLetter.Payload); err != nil {
+		if err := u.repo.UpdateLastStatus(ctx, auditSinkId, domain.AuditSinkStatus_FAILED, err.Error(), time.Now()); err != nil {
+			log.Error(ctx, err)
+		}
+		deadLetter.Attempts++
+		deadLetter.Error = err.Error()
+		if err := u.repo.UpdateDeadLetter(ctx, deadLetter); err != nil {
+			return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+		return httpErrors.NewError(err, "AU_FAILED_TO_FORWARD")
+	}
+
+	if err := u.repo.UpdateLastStatus(ctx, auditSinkId, domain.AuditSinkStatus_SUCCEEDED, "", time.Now()); err != nil {
+		log.Error(ctx, err)
+	}
+	if err := u.repo.DeleteDeadLetter(ctx, deadLetterId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *AuditSinkUsecase) DeleteDeadLetter(ctx context.Context, organizationId string, auditSinkId uuid.UUID, deadLetterId uuid.UUID) error {
+	if _, err := u.Get(ctx, organizationId, auditSinkId); err != nil {
+		return err
+	}
+	if _, err := u.getDeadLetter(ctx, auditSinkId, deadLetterId); err != nil {
+		return err
+	}
+	if err := u.repo.DeleteDeadLetter(ctx, deadLetterId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *AuditSinkUsecase) getDeadLetter(ctx context.Context, auditSinkId uuid.UUID, deadLetterId uuid.UUID) (model.AuditSinkDeadLetter, error) {
+	deadLetter, err := u.repo.GetDeadLetter(ctx, deadLetterId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.AuditSinkDeadLetter{}, httpErrors.NewError(err, "AU_NOT_FOUND_DEAD_LETTER")
+		}
+		return model.AuditSinkDeadLetter{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if deadLetter.AuditSinkId != auditSinkId {
+		return model.AuditSinkDeadLetter{}, httpErrors.NewError(fmt.Errorf("not found dead letter in audit sink"), "AU_NOT_FOUND_DEAD_LETTER")
+	}
+	return deadLetter, nil
+}
+
+func auditSinkConfig(auditSink model.AuditSink) auditsink.Config {
+	return auditsink.Config{
+		Type:     auditSink.Type,
+		Endpoint: auditSink.Endpoint,
+		Topic:    auditSink.Topic,
+		Secret:   auditSink.Secret,
+	}
+}
+
+func validateAuditSink(ctx context.Context, auditSink model.AuditSink) error {
+	if _, err := auditsink.New(auditSinkConfig(auditSink)); err != nil {
+		return err
+	}
+	return helper.ValidateOutboundUrl(ctx, auditSink.Endpoint)
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// timeline 에 사용하는 감사 로그의 최대 개수이다. 넘는 경우 최근 감사 로그만 사용한다.
+const maxTimelineAudits = 1000
+
+// timelineAuditCategories 는 timeline 에 표시할 감사 로그의 endpoint group 이다.
+// 스택 생성/삭제는 요청이 아닌 완료 시점을 표시하기 위해 operation 으로 조회하므로 Stack group 은 포함하지 않는다.
+var timelineAuditCategories = map[string]domain.OrganizationTimelineCategory{
+	"User":                       domain.OrganizationTimelineCategory_MEMBER,
+	"Admin_User":                 domain.OrganizationTimelineCategory_MEMBER,
+	"Role":                       domain.OrganizationTimelineCategory_MEMBER,
+	"Permission":                 domain.OrganizationTimelineCategory_MEMBER,
+	"Policy":                     domain.OrganizationTimelineCategory_POLICY,
+	"OrganizationPolicyTemplate": domain.OrganizationTimelineCategory_POLICY,
+	"Organization":               domain.OrganizationTimelineCategory_ORGANIZATION,
+	"StackDefault":               domain.OrganizationTimelineCategory_ORGANIZATION,
+}
+
+// GetTimeline 은 기간 중 조직의 주요 변경을 최근 것부터 반환한다.
+// 멤버/역할, 정책, 조직 설정 변경은 성공한 감사 로그에서, 스택 생성/삭제는 끝난 operation 에서 가져온다.
+func (u *AuditUsecase) GetTimeline(ctx context.Context, organizationId string, from time.Time, to time.Time) (out domain.GetOrganizationTimelineResponse, err error) {
+	if _, err = u.organizationRepo.Get(ctx, organizationId); err != nil {
+		return out, httpErrors.NewNotFoundError(err, "", "")
+	}
+	out.From, out.To = from, to
+
+	groups := make([]string, 0, len(timelineAuditCategories))
+	for group := range timelineAuditCategories {
+		groups = append(groups, group)
+	}
+	audits, err := u.repo.FetchSucceededByGroups(ctx, organizationId, groups, from, to, maxTimelineAudits)
+	if err != nil {
+		return out, httpErrors.NewInternalServerError(err, "", "")
+	}
+	out.Truncated = len(audits) == maxTimelineAudits
+
+	events := []domain.OrganizationTimelineEvent{}
+	for _, audit := range audits {
+		events = append(events, domain.OrganizationTimelineEvent{
+			Category:      timelineAuditCategories[audit.Group],
+			Message:       audit.Message,
+			UserAccountId: audit.UserAccountId,
+			UserName:      audit.UserName,
+			FirstAt:       audit.CreatedAt,
+			LastAt:        audit.CreatedAt,
+		})
+	}
+
+	operations, err := u.operationRepo.FetchByPeriod(ctx, organizationId, from, to)
+	if err != nil {
+		return out, httpErrors.NewInternalServerError(err, "", "")
+	}
+	users := map[uuid.UUID]model.User{}
+	for _, operation := range operations {
+		message := operationTimelineMessage(operation)
+		if message == "" {
+			continue
+		}
+		event := domain.OrganizationTimelineEvent{
+			Category: domain.OrganizationTimelineCategory_STACK,
+			Message:  message,
+			FirstAt:  operation.UpdatedAt,
+			LastAt:   operation.UpdatedAt,
+		}
+		if operation.CreatorId != nil {
+			user, ok := users[*operation.CreatorId]
+			if !ok {
+				if user, err = u.userRepo.GetByUuid(ctx, *operation.CreatorId); err != nil {
+					log.Info(ctx, err)
+				}
+				users[*operation.CreatorId] = user
+			}
+			event.UserAccountId, event.UserName = user.AccountId, user.Name
+		}
+		events = append(events, event)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastAt.After(events[j].LastAt)
+	})
+	out.Events = condenseTimelineEvents(events)
+
+	out.Summary = map[domain.OrganizationTimelineCategory]int{}
+	for _, event := range out.Events {
+		out.Summary[event.Category] += event.Count
+	}
+	return out, nil
+}
+
+// operationTimelineMessage 는 끝난 스택 생성/삭제만 timeline 에 표시한다. 진행 중이거나 취소된 operation 은 빈 문자열을 반환한다.
+func operationTimelineMessage(operation model.Operation) string {
+	var action string
+	switch operation.Type {
+	case domain.OperationType_STACK_CREATE:
+		action = "생성"
+	case domain.OperationType_STACK_DELETE:
+		action = "삭제"
+	default:
+		return ""
+	}
+
+	switch operation.Status {
+	case domain.OperationStatus_COMPLETED:
+		return fmt.Sprintf("스택 [%s] %s을 완료하였습니다.", operation.TargetName, action)
+	case domain.OperationStatus_FAILED:
+		return fmt.Sprintf("스택 [%s] %s에 실패하였습니다.", operation.TargetName, action)
+	}
+	return ""
+}
+
+// condenseTimelineEvents 는 최근 것부터 정렬된 이벤트에서 같은 사용자가 연달아 수행한 같은 변경을 하나로 묶는다.
+func condenseTimelineEvents(events []domain.OrganizationTimelineEvent) []domain.OrganizationTimelineEvent {
+	out := []domain.OrganizationTimelineEvent{}
+	for _, event := range events {
+		if n := len(out); n > 0 {
+			last := &out[n-1]
+			if last.Category == event.Category && last.Message == event.Message && last.UserAccountId == event.UserAccountId {
+				last.Count++
+				last.FirstAt = event.FirstAt
+				continue
+			}
+		}
+		event.Count = 1
+		out = append(out, event)
+	}
+	return out
+}
@@ -2,31 +2,55 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
 	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	s3 "github.com/openinfradev/tks-api/pkg/s3-client"
 )
 
 type IAuditUsecase interface {
 	Get(ctx context.Context, auditId uuid.UUID) (model.Audit, error)
 	Fetch(ctx context.Context, pg *pagination.Pagination) ([]model.Audit, error)
+	FetchByOrganization(ctx context.Context, organizationId string, filter model.AuditFilter, pg *pagination.Pagination) ([]model.Audit, error)
 	Create(ctx context.Context, dto model.Audit) (auditId uuid.UUID, err error)
 	Delete(ctx context.Context, dto model.Audit) error
+	Archive(ctx context.Context, retentionDays int) (archived int64, err error)
+	GetStatistics(ctx context.Context) (model.AuditStatistics, error)
+	GetRetentionPolicies(ctx context.Context) ([]model.AuditRetentionPolicy, error)
+	UpdateRetentionPolicy(ctx context.Context, dto model.AuditRetentionPolicy) error
+	ApplyRetention(ctx context.Context) error
+	StartArchivalRun(ctx context.Context, organizationId string) ([]model.AuditArchivalRun, error)
+	GetArchivalRuns(ctx context.Context, pg *pagination.Pagination) ([]model.AuditArchivalRun, error)
+	GetArchivalRun(ctx context.Context, runId uuid.UUID) (model.AuditArchivalRun, error)
+	GetTimeline(ctx context.Context, organizationId string, from time.Time, to time.Time) (domain.GetOrganizationTimelineResponse, error)
 }
 
+const AUDIT_ARCHIVE_BATCH_SIZE = 1000
+
 type AuditUsecase struct {
-	repo     repository.IAuditRepository
-	userRepo repository.IUserRepository
+	repo             repository.IAuditRepository
+	userRepo         repository.IUserRepository
+	organizationRepo repository.IOrganizationRepository
+	retentionRepo    repository.IAuditRetentionRepository
+	operationRepo    repository.IOperationRepository
+	archiveStorage   s3.S3Client
 }
 
 func NewAuditUsecase(r repository.Repository) IAuditUsecase {
 	return &AuditUsecase{
-		repo:     r.Audit,
-		userRepo: r.User,
+		repo:             r.Audit,
+		userRepo:         r.User,
+		organizationRepo: r.Organization,
+		retentionRepo:    r.AuditRetention,
+		operationRepo:    r.Operation,
+		archiveStorage:   newAuditArchiveStorage(),
 	}
 }
 
@@ -59,7 +83,7 @@ func (u *AuditUsecase) Create(ctx context.Context, dto model.Audit) (auditId uui
 
 	auditId, err = u.repo.Create(ctx, dto)
 	if err != nil {
-		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	return auditId, nil
 }
@@ -80,6 +104,21 @@ func (u *AuditUsecase) Fetch(ctx context.Context, pg *pagination.Pagination) (au
 	return
 }
 
+func (u *AuditUsecase) FetchByOrganization(ctx context.Context, organizationId string, filter model.AuditFilter, pg *pagination.Pagination) (audits []model.Audit, err error) {
+	if filter.Status != "" && filter.Status != domain.AuditStatus_SUCCESS && filter.Status != domain.AuditStatus_FAILURE {
+		return nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid status %s", filter.Status), "C_INVALID_QUERY_PARAM", "")
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, httpErrors.NewBadRequestError(fmt.Errorf("from must be before to"), "C_INVALID_QUERY_PARAM", "")
+	}
+
+	audits, err = u.repo.FetchByOrganization(ctx, organizationId, filter, pg)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return
+}
+
 func (u *AuditUsecase) Delete(ctx context.Context, dto model.Audit) (err error) {
 	err = u.repo.Delete(ctx, dto.ID)
 	if err != nil {
@@ -87,3 +126,20 @@ func (u *AuditUsecase) Delete(ctx context.Context, dto model.Audit) (err error)
 	}
 	return nil
 }
+
+func (u *AuditUsecase) Archive(ctx context.Context, retentionDays int) (archived int64, err error) {
+	before := time.Now().AddDate(0, 0, -retentionDays)
+	archived, err = u.repo.Archive(ctx, "", before, AUDIT_ARCHIVE_BATCH_SIZE)
+	if err != nil {
+		return archived, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return archived, nil
+}
+
+func (u *AuditUsecase) GetStatistics(ctx context.Context) (out model.AuditStatistics, err error) {
+	out, err = u.repo.GetStatistics(ctx)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return
+}
@@ -29,8 +29,9 @@ import (
 )
 
 type IAuthUsecase interface {
-	Login(ctx context.Context, accountId string, password string, organizationId string) (model.User, error)
+	Login(ctx context.Context, accountId string, password string, organizationId string, otp string) (model.User, error)
 	Logout(ctx context.Context, sessionId string, organizationId string) error
+	RefreshToken(ctx context.Context, refreshToken string, organizationId string) (model.User, error)
 	FindId(ctx context.Context, code string, email string, userName string, organizationId string) (string, error)
 	FindPassword(ctx context.Context, code string, accountId string, email string, userName string, organizationId string) error
 	VerifyIdentity(ctx context.Context, accountId string, email string, userName string, organizationId string) error
@@ -47,30 +48,36 @@ const (
 )
 
 type AuthUsecase struct {
-	kc                     keycloak.IKeycloak
-	userRepository         repository.IUserRepository
-	authRepository         repository.IAuthRepository
-	clusterRepository      repository.IClusterRepository
-	appgroupRepository     repository.IAppGroupRepository
-	organizationRepository repository.IOrganizationRepository
+	kc                       keycloak.IKeycloak
+	userRepository           repository.IUserRepository
+	authRepository           repository.IAuthRepository
+	clusterRepository        repository.IClusterRepository
+	appgroupRepository       repository.IAppGroupRepository
+	organizationRepository   repository.IOrganizationRepository
+	passwordPolicyRepository repository.IPasswordPolicyRepository
+	userSessionRepository    repository.IUserSessionRepository
+	secretSealer             repository.ISecretSealer
 }
 
 func NewAuthUsecase(r repository.Repository, kc keycloak.IKeycloak) IAuthUsecase {
 	return &AuthUsecase{
-		kc:                     kc,
-		userRepository:         r.User,
-		authRepository:         r.Auth,
-		clusterRepository:      r.Cluster,
-		appgroupRepository:     r.AppGroup,
-		organizationRepository: r.Organization,
+		kc:                       kc,
+		userRepository:           r.User,
+		authRepository:           r.Auth,
+		clusterRepository:        r.Cluster,
+		appgroupRepository:       r.AppGroup,
+		organizationRepository:   r.Organization,
+		passwordPolicyRepository: r.PasswordPolicy,
+		userSessionRepository:    r.UserSession,
+		secretSealer:             r.SecretSealer,
 	}
 }
 
-func (u *AuthUsecase) Login(ctx context.Context, accountId string, password string, organizationId string) (model.User, error) {
+func (u *AuthUsecase) Login(ctx context.Context, accountId string, password string, organizationId string, otp string) (model.User, error) {
 	// Authentication with DB
 	user, err := u.userRepository.Get(ctx, accountId, organizationId)
 	if err != nil {
-		return model.User{}, httpErrors.NewBadRequestError(err, "A_INVALID_ID", "")
+		return model.User{}, httpErrors.NewError(err, "A_INVALID_ID")
 	}
 
 	var accountToken *model.User
@@ -79,17 +86,36 @@ func (u *AuthUsecase) Login(ctx context.Context, accountId string, password stri
 		apiErr, ok := err.(*gocloak.APIError)
 		if ok {
 			if apiErr.Code == 401 {
-				return model.User{}, httpErrors.NewBadRequestError(fmt.Errorf("Mismatch password"), "A_INVALID_PASSWORD", "")
+				return model.User{}, httpErrors.NewError(fmt.Errorf("Mismatch password"), "A_INVALID_PASSWORD")
 			}
 		}
-		return model.User{}, httpErrors.NewInternalServerError(err, "", "")
+		return model.User{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	// 비밀번호를 확인한 뒤 2단계 인증 코드를 확인한다. 코드가 틀리면 keycloak 이 만든 세션을 닫는다.
+	// 조직이 2단계 인증을 요구하지만 등록하지 않은 사용자는 OtpFilter 가 등록 외의 요청을 거부한다.
+	if user.OtpEnabled {
+		if err = verifyUserOtp(ctx, u.userRepository, u.secretSealer, user, otp); err != nil {
+			if err := u.kc.Logout(ctx, accountToken.SessionId, organizationId); err != nil {
+				log.Error(ctx, err)
+			}
+			return model.User{}, err
+		}
+	} else if user.Organization.OtpRequired {
+		user.OtpEnrollmentRequired = true
 	}
 
 	// Insert token
 	user.Token = accountToken.Token
+	user.RefreshToken = accountToken.RefreshToken
+	user.SessionId = accountToken.SessionId
 
 	if !(organizationId == "master" && accountId == "admin") {
-		user.PasswordExpired = helper.IsDurationExpired(user.PasswordUpdatedAt, internal.PasswordExpiredDuration)
+		expiredDuration := internal.PasswordExpiredDuration
+		if policy, err := u.passwordPolicyRepository.Get(ctx, organizationId); err == nil {
+			expiredDuration = policy.ExpiryDuration()
+		}
+		user.PasswordExpired = expiredDuration > 0 && helper.IsDurationExpired(user.PasswordUpdatedAt, expiredDuration)
 	}
 
 	return user, nil
@@ -101,30 +127,51 @@ func (u *AuthUsecase) Logout(ctx context.Context, sessionId string, organization
 	if err != nil {
 		return err
 	}
+	if err := u.userSessionRepository.DeleteBySessionId(ctx, sessionId); err != nil {
+		log.Error(ctx, err)
+	}
 	return nil
 }
 
+// RefreshToken 은 refresh token 을 새 access token 과 refresh token 으로 교환한다.
+// 사용한 refresh token 은 keycloak 에서 폐기되므로, 탈취된 refresh token 이 재사용되면 실패한다.
+func (u *AuthUsecase) RefreshToken(ctx context.Context, refreshToken string, organizationId string) (model.User, error) {
+	token, err := u.kc.RefreshToken(ctx, refreshToken, organizationId)
+	if err != nil {
+		apiErr, ok := err.(*gocloak.APIError)
+		if ok && (apiErr.Code == http.StatusBadRequest || apiErr.Code == http.StatusUnauthorized) {
+			return model.User{}, httpErrors.NewError(err, "A_INVALID_REFRESH_TOKEN")
+		}
+		return model.User{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	if err := u.userSessionRepository.UpdateLastActivity(ctx, token.SessionId, time.Now()); err != nil {
+		log.Error(ctx, err)
+	}
+	return *token, nil
+}
+
 func (u *AuthUsecase) FindId(ctx context.Context, code string, email string, userName string, organizationId string) (string, error) {
 	users, err := u.userRepository.List(ctx, u.userRepository.OrganizationFilter(organizationId),
 		u.userRepository.NameFilter(userName), u.userRepository.EmailFilter(email))
 	if err != nil && users == nil {
-		return "", httpErrors.NewBadRequestError(err, "A_INVALID_ID", "")
+		return "", httpErrors.NewError(err, "A_INVALID_ID")
 	}
 	if err != nil {
-		return "", httpErrors.NewInternalServerError(err, "", "")
+		return "", httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	emailCode, err := u.authRepository.GetEmailCode(ctx, (*users)[0].ID)
 	if err != nil {
-		return "", httpErrors.NewInternalServerError(err, "", "")
+		return "", httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	if !u.isExpiredEmailCode(emailCode) {
-		return "", httpErrors.NewBadRequestError(fmt.Errorf("expired code"), "A_EXPIRED_CODE", "")
+		return "", httpErrors.NewError(fmt.Errorf("expired code"), "A_EXPIRED_CODE")
 	}
 	if emailCode.Code != code {
-		return "", httpErrors.NewBadRequestError(fmt.Errorf("invalid code"), "A_INVALID_CODE", "")
+		return "", httpErrors.NewError(fmt.Errorf("invalid code"), "A_INVALID_CODE")
 	}
 	if err := u.authRepository.DeleteEmailCode(ctx, (*users)[0].ID); err != nil {
-		return "", httpErrors.NewInternalServerError(err, "", "")
+		return "", httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	return (*users)[0].AccountId, nil
@@ -135,27 +182,27 @@ func (u *AuthUsecase) FindPassword(ctx context.Context, code string, accountId s
 		u.userRepository.AccountIdFilter(accountId), u.userRepository.NameFilter(userName),
 		u.userRepository.EmailFilter(email))
 	if err != nil && users == nil {
-		return httpErrors.NewBadRequestError(err, "A_INVALID_ID", "")
+		return httpErrors.NewError(err, "A_INVALID_ID")
 	}
 	if err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	user := (*users)[0]
 	emailCode, err := u.authRepository.GetEmailCode(ctx, user.ID)
 	if err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	if !u.isExpiredEmailCode(emailCode) {
-		return httpErrors.NewBadRequestError(fmt.Errorf("expired code"), "A_EXPIRED_CODE", "")
+		return httpErrors.NewError(fmt.Errorf("expired code"), "A_EXPIRED_CODE")
 	}
 	if emailCode.Code != code {
-		return httpErrors.NewBadRequestError(fmt.Errorf("invalid code"), "A_INVALID_CODE", "")
+		return httpErrors.NewError(fmt.Errorf("invalid code"), "A_INVALID_CODE")
 	}
 	randomPassword := helper.GenerateRandomString(passwordLength)
 
 	originUser, err := u.kc.GetUser(ctx, organizationId, accountId)
 	if err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	originUser.Credentials = &[]gocloak.CredentialRepresentation{
 		{
@@ -165,27 +212,27 @@ func (u *AuthUsecase) FindPassword(ctx context.Context, code string, accountId s
 		},
 	}
 	if err = u.kc.UpdateUser(ctx, organizationId, originUser); err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	if err = u.userRepository.UpdatePasswordAt(ctx, user.ID, organizationId, true); err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	message, err := mail.MakeTemporaryPasswordMessage(ctx, email, organizationId, accountId, randomPassword)
 	if err != nil {
 		log.Errorf(ctx, "mail.MakeVerityIdentityMessage error. %v", err)
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	mailer := mail.New(message)
 
 	if err := mailer.SendMail(ctx); err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	if err = u.authRepository.DeleteEmailCode(ctx, user.ID); err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	return nil
@@ -204,38 +251,38 @@ func (u *AuthUsecase) VerifyIdentity(ctx context.Context, accountId string, emai
 			u.userRepository.EmailFilter(email))
 	}
 	if err != nil && users == nil {
-		return httpErrors.NewBadRequestError(err, "A_INVALID_ID", "")
+		return httpErrors.NewError(err, "A_INVALID_ID")
 	}
 	if err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	code, err := helper.GenerateEmailCode(ctx)
 	if err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	_, err = u.authRepository.GetEmailCode(ctx, (*users)[0].ID)
 	if err != nil {
 		if err := u.authRepository.CreateEmailCode(ctx, (*users)[0].ID, code); err != nil {
-			return httpErrors.NewInternalServerError(err, "", "")
+			return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 		}
 	} else {
 		if err := u.authRepository.UpdateEmailCode(ctx, (*users)[0].ID, code); err != nil {
-			return httpErrors.NewInternalServerError(err, "", "")
+			return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 		}
 	}
 
-	message, err := mail.MakeVerityIdentityMessage(ctx, email, code)
+	message, err := mail.MakeVerityIdentityMessage(ctx, organizationId, email, code)
 	if err != nil {
 		log.Errorf(ctx, "mail.MakeVerityIdentityMessage error. %v", err)
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	mailer := mail.New(message)
 
 	if err := mailer.SendMail(ctx); err != nil {
 		log.Errorf(ctx, "mailer.SendMail error. %v", err)
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	return nil
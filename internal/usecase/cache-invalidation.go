@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const (
+	cacheKeyClusterName = "CACHE_KEY_CLUSTER_NAME_FROM_ID"
+)
+
+type CacheEventKind string
+
+const (
+	CacheEvent_ORGANIZATION_UPDATED    CacheEventKind = "ORGANIZATION_UPDATED"
+	CacheEvent_ORGANIZATION_DELETED    CacheEventKind = "ORGANIZATION_DELETED"
+	CacheEvent_PRIMARY_CLUSTER_CHANGED CacheEventKind = "PRIMARY_CLUSTER_CHANGED"
+	CacheEvent_CLUSTER_UPDATED         CacheEventKind = "CLUSTER_UPDATED"
+	CacheEvent_CLUSTER_DELETED         CacheEventKind = "CLUSTER_DELETED"
+	CacheEvent_LMA_ENDPOINT_CHANGED    CacheEventKind = "LMA_ENDPOINT_CHANGED"
+)
+
+// CacheEvent 는 캐시 무효화가 필요한 리소스 변경을 나타낸다.
+type CacheEvent struct {
+	Kind           CacheEventKind
+	OrganizationId string
+	ClusterId      string
+}
+
+// CacheStore 는 무효화 대상 캐시 저장소이다.
+// 메모리 캐시(*gcache.Cache)는 그대로 사용할 수 있고, Redis 등 외부 저장소는 이 인터페이스를 구현하여 등록한다.
+type CacheStore interface {
+	Delete(key string)
+}
+
+// CacheInvalidationHook 은 이벤트에 대해 삭제해야 할 캐시 키 목록을 반환한다.
+type CacheInvalidationHook func(event CacheEvent) []string
+
+type ICacheInvalidator interface {
+	AddStore(store CacheStore)
+	AddHook(hook CacheInvalidationHook)
+	Invalidate(ctx context.Context, event CacheEvent)
+}
+
+type CacheInvalidator struct {
+	mu     sync.RWMutex
+	stores []CacheStore
+	hooks  []CacheInvalidationHook
+}
+
+func NewCacheInvalidator(stores ...CacheStore) ICacheInvalidator {
+	return &CacheInvalidator{
+		stores: stores,
+		hooks: []CacheInvalidationHook{
+			thanosCacheHook,
+			clusterNameCacheHook,
+			chartCacheHook,
+		},
+	}
+}
+
+func (c *CacheInvalidator) AddStore(store CacheStore) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stores = append(c.stores, store)
+}
+
+func (c *CacheInvalidator) AddHook(hook CacheInvalidationHook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hooks = append(c.hooks, hook)
+}
+
+func (c *CacheInvalidator) Invalidate(ctx context.Context, event CacheEvent) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, hook := range c.hooks {
+		for _, key := range hook(event) {
+			for _, store := range c.stores {
+				store.Delete(key)
+			}
+			log.Debugf(ctx, "invalidated cache. event : %s, key : %s", event.Kind, key)
+		}
+	}
+}
+
+// thanosCacheHook 은 조직의 primary cluster, LMA endpoint 가 바뀌거나 조직 내 cluster 가 변경되면 thanos url, client 캐시를 삭제한다.
+func thanosCacheHook(event CacheEvent) []string {
+	if event.OrganizationId == "" {
+		return nil
+	}
+	return []string{
+		cacheKeyThanosClient + event.OrganizationId,
+		cacheKeyThanosUrl + event.OrganizationId,
+		cacheKeyLmaEndpointHealth + event.OrganizationId,
+	}
+}
+
+// clusterNameCacheHook 은 cluster 가 변경(이름 변경 포함)되거나 삭제되면 cluster 이름 캐시를 삭제한다.
+func clusterNameCacheHook(event CacheEvent) []string {
+	if event.ClusterId == "" {
+		return nil
+	}
+	switch event.Kind {
+	case CacheEvent_CLUSTER_UPDATED, CacheEvent_CLUSTER_DELETED:
+		return []string{cacheKeyClusterName + event.ClusterId}
+	}
+	return nil
+}
+
+// chartCacheHook 은 조직이나 조직 내 cluster, LMA endpoint 가 변경되면 조직의 chart 캐시 세대를 삭제하여 캐시된 chart 를 모두 무효화한다.
+func chartCacheHook(event CacheEvent) []string {
+	if event.OrganizationId == "" {
+		return nil
+	}
+	return []string{chartGenerationCacheKey(event.OrganizationId)}
+}
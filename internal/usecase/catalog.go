@@ -0,0 +1,514 @@
+package usecase
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+)
+
+const (
+	helmRepositoryTimeout   = 30 * time.Second
+	helmIndexMaxSize        = 64 << 20
+	helmChartArchiveMaxSize = 16 << 20
+)
+
+// release 이름과 namespace 는 쿠버네티스 리소스 이름으로 사용되므로 DNS label 형식이어야 한다.
+var catalogReleaseNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+type ICatalogUsecase interface {
+	FetchHelmRepositories(ctx context.Context, pg *pagination.Pagination) ([]model.HelmRepository, error)
+	CreateHelmRepository(ctx context.Context, dto model.HelmRepository) (helmRepositoryId uuid.UUID, err error)
+	DeleteHelmRepository(ctx context.Context, helmRepositoryId uuid.UUID) error
+	SyncHelmRepository(ctx context.Context, helmRepositoryId uuid.UUID) (model.HelmRepository, error)
+	SyncHelmRepositories(ctx context.Context) error
+	FetchCharts(ctx context.Context, pg *pagination.Pagination) ([]model.CatalogChart, error)
+	GetChart(ctx context.Context, catalogChartId uuid.UUID) (chart model.CatalogChart, versions []string, err error)
+	CreateApp(ctx context.Context, dto model.CatalogApp, catalogChartId uuid.UUID, values map[string]interface{}) (model.CatalogApp, error)
+	FetchApps(ctx context.Context, organizationId string, stackId domain.StackId, pg *pagination.Pagination) ([]model.CatalogApp, error)
+}
+
+type CatalogUsecase struct {
+	repo            repository.ICatalogRepository
+	clusterRepo     repository.IClusterRepository
+	operationRepo   repository.IOperationRepository
+	maintenanceRepo repository.IMaintenanceWindowRepository
+	operations      IOperationUsecase
+	client          *http.Client
+}
+
+func NewCatalogUsecase(r repository.Repository, operations IOperationUsecase) ICatalogUsecase {
+	return &CatalogUsecase{
+		repo:            r.Catalog,
+		clusterRepo:     r.Cluster,
+		operationRepo:   r.Operation,
+		maintenanceRepo: r.MaintenanceWindow,
+		operations:      operations,
+		client:          &http.Client{Timeout: helmRepositoryTimeout},
+	}
+}
+
+// helmIndex 는 Helm repository 의 index.yaml 중 catalog 에 필요한 항목이다.
+type helmIndex struct {
+	Entries map[string][]struct {
+		Name        string   `yaml:"name"`
+		Version     string   `yaml:"version"`
+		AppVersion  string   `yaml:"appVersion"`
+		Description string   `yaml:"description"`
+		Icon        string   `yaml:"icon"`
+		Urls        []string `yaml:"urls"`
+		Digest      string   `yaml:"digest"`
+		Deprecated  bool     `yaml:"deprecated"`
+		Created     string   `yaml:"created"`
+	} `yaml:"entries"`
+}
+
+func (u *CatalogUsecase) FetchHelmRepositories(ctx context.Context, pg *pagination.Pagination) ([]model.HelmRepository, error) {
+	return u.repo.FetchHelmRepositories(ctx, pg)
+}
+
+// CreateHelmRepository 는 repository 를 등록하고 바로 index 를 동기화한다.
+// 동기화에 실패해도 등록은 유지하고 실패 사유를 repository 의 상태로 남긴다.
+func (u *CatalogUsecase) CreateHelmRepository(ctx context.Context, dto model.HelmRepository) (helmRepositoryId uuid.UUID, err error) {
+	repositoryUrl, err := url.Parse(dto.Url)
+	if err != nil || (repositoryUrl.Scheme != "http" && repositoryUrl.Scheme != "https") || repositoryUrl.Host == "" {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid helm repository url %s", dto.Url), "C_INVALID_REQUEST_FIELD", "")
+	}
+	if _, err := u.repo.GetHelmRepositoryByName(ctx, dto.Name); err == nil {
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("helm repository %s already exists", dto.Name), "CT_CREATE_ALREADY_EXISTED_NAME")
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.CreatorId = &userId
+	}
+	dto.Url = strings.TrimSuffix(dto.Url, "/")
+	dto.Status = domain.HelmRepositoryStatus_PENDING
+
+	helmRepositoryId, err = u.repo.CreateHelmRepository(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	dto.ID = helmRepositoryId
+	if _, err := u.sync(ctx, dto); err != nil {
+		log.Warnf(ctx, "failed to sync helm repository %s. err: %s", dto.Name, err)
+	}
+	return helmRepositoryId, nil
+}
+
+func (u *CatalogUsecase) DeleteHelmRepository(ctx context.Context, helmRepositoryId uuid.UUID) error {
+	if _, err := u.getHelmRepository(ctx, helmRepositoryId); err != nil {
+		return err
+	}
+	if err := u.repo.DeleteHelmRepository(ctx, helmRepositoryId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *CatalogUsecase) SyncHelmRepository(ctx context.Context, helmRepositoryId uuid.UUID) (model.HelmRepository, error) {
+	helmRepository, err := u.getHelmRepository(ctx, helmRepositoryId)
+	if err != nil {
+		return model.HelmRepository{}, err
+	}
+	if helmRepository, err = u.sync(ctx, helmRepository); err != nil {
+		return helmRepository, httpErrors.NewError(err, "CT_FAILED_SYNC_HELM_REPOSITORY")
+	}
+	return helmRepository, nil
+}
+
+// SyncHelmRepositories 는 등록된 모든 repository 의 index 를 주기적으로 다시 읽어 새로 올라온 chart 버전을 catalog 에 반영한다.
+func (u *CatalogUsecase) SyncHelmRepositories(ctx context.Context) error {
+	helmRepositories, err := u.repo.FetchHelmRepositories(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, helmRepository := range helmRepositories {
+		if _, err := u.sync(ctx, helmRepository); err != nil {
+			log.Errorf(ctx, "failed to sync helm repository %s. err: %s", helmRepository.Name, err)
+		}
+	}
+	return nil
+}
+
+func (u *CatalogUsecase) FetchCharts(ctx context.Context, pg *pagination.Pagination) ([]model.CatalogChart, error) {
+	return u.repo.FetchCharts(ctx, pg)
+}
+
+// GetChart 는 chart 의 values schema 와 같은 chart 의 다른 버전을 함께 반환한다.
+func (u *CatalogUsecase) GetChart(ctx context.Context, catalogChartId uuid.UUID) (chart model.CatalogChart, versions []string, err error) {
+	chart, err = u.getChart(ctx, catalogChartId)
+	if err != nil {
+		return chart, nil, err
+	}
+	if err = u.loadValuesSchema(ctx, &chart); err != nil {
+		return chart, nil, httpErrors.NewError(err, "CT_FAILED_FETCH_VALUES_SCHEMA")
+	}
+
+	charts, err := u.repo.FetchChartVersions(ctx, chart.HelmRepositoryId, chart.Name)
+	if err != nil {
+		return chart, nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	versions = make([]string, len(charts))
+	for i, c := range charts {
+		versions[i] = c.Version
+	}
+	return chart, versions, nil
+}
+
+// CreateApp 은 values 를 chart 의 values.schema.json 으로 검증한 뒤 스택에 chart 를 설치하는 workflow 를 제출한다.
+// schema 가 없는 chart 는 values 를 검증하지 않는다.
+func (u *CatalogUsecase) CreateApp(ctx context.Context, dto model.CatalogApp, catalogChartId uuid.UUID, values map[string]interface{}) (out model.CatalogApp, err error) {
+	cluster, err := u.clusterRepo.Get(ctx, dto.ClusterId)
+	if err != nil {
+		return out, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
+	}
+	if cluster.OrganizationId != dto.OrganizationId {
+		return out, httpErrors.NewError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID")
+	}
+	if cluster.Status != domain.ClusterStatus_RUNNING {
+		return out, httpErrors.NewError(fmt.Errorf("stack %s is %s", dto.ClusterId, cluster.Status), "S_NOT_RUNNING_STACK")
+	}
+	if !catalogReleaseNamePattern.MatchString(dto.ReleaseName) || !catalogReleaseNamePattern.MatchString(dto.Namespace) {
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("invalid release name %s or namespace %s", dto.ReleaseName, dto.Namespace), "CT_INVALID_RELEASE", "")
+	}
+
+	chart, err := u.getChart(ctx, catalogChartId)
+	if err != nil {
+		return out, err
+	}
+	if err = u.loadValuesSchema(ctx, &chart); err != nil {
+		return out, httpErrors.NewError(err, "CT_FAILED_FETCH_VALUES_SCHEMA")
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	if err = validateCatalogValues(chart.ValuesSchema, values); err != nil {
+		return out, httpErrors.NewBadRequestError(err, "CT_INVALID_VALUES", "")
+	}
+
+	if err = u.checkRelease(ctx, dto); err != nil {
+		return out, err
+	}
+	if err = checkMaintenanceWindow(ctx, u.maintenanceRepo, dto.ClusterId); err != nil {
+		return out, err
+	}
+
+	valuesJson, err := json.Marshal(values)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	dto.ID = uuid.New()
+	dto.HelmRepositoryName = chart.HelmRepository.Name
+	dto.RepositoryUrl = chart.HelmRepository.Url
+	dto.ChartName = chart.Name
+	dto.ChartVersion = chart.Version
+	dto.Values = valuesJson
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.CreatorId = &userId
+	}
+
+	operation, err := u.operations.Submit(ctx, model.Operation{
+		OrganizationId:   dto.OrganizationId,
+		Type:             domain.OperationType_CATALOG_APP_DEPLOY,
+		TargetId:         dto.ID.String(),
+		TargetName:       dto.ReleaseName,
+		WorkflowTemplate: "tks-install-catalog-app",
+	}, []string{
+		fmt.Sprintf("tks_api_url=%s", viper.GetString("external-address")),
+		"organization_id=" + dto.OrganizationId,
+		"cluster_id=" + dto.ClusterId.String(),
+		"catalog_app_id=" + dto.ID.String(),
+		"repo_url=" + dto.RepositoryUrl,
+		"chart_name=" + dto.ChartName,
+		"chart_version=" + dto.ChartVersion,
+		"release_name=" + dto.ReleaseName,
+		"namespace=" + dto.Namespace,
+		"values=" + string(valuesJson),
+	})
+	if err != nil {
+		return out, err
+	}
+
+	dto.OperationId = operation.ID
+	dto.Status = operation.Status
+	dto.StatusDesc = operation.StatusDesc
+	if err = u.repo.CreateApp(ctx, dto); err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	log.Infof(ctx, "submitted deployment of chart %s-%s to stack %s as release %s/%s", dto.ChartName, dto.ChartVersion, dto.ClusterId, dto.Namespace, dto.ReleaseName)
+
+	return dto, nil
+}
+
+// FetchApps 는 끝나지 않은 배포의 상태를 operation 에서 다시 읽어 반영한다.
+func (u *CatalogUsecase) FetchApps(ctx context.Context, organizationId string, stackId domain.StackId, pg *pagination.Pagination) ([]model.CatalogApp, error) {
+	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(stackId))
+	if err != nil || cluster.OrganizationId != organizationId {
+		return nil, httpErrors.NewNotFoundError(fmt.Errorf("not found stack %s", stackId), "S_INVALID_STACK_ID", "")
+	}
+
+	apps, err := u.repo.FetchApps(ctx, organizationId, cluster.ID, pg)
+	if err != nil {
+		return nil, err
+	}
+	for i, app := range apps {
+		if app.Status != domain.OperationStatus_PENDING && app.Status != domain.OperationStatus_RUNNING {
+			continue
+		}
+		operation, err := u.operationRepo.Get(ctx, app.OperationId)
+		if err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		if operation.Status == app.Status {
+			continue
+		}
+		if err := u.repo.UpdateAppStatus(ctx, app.ID, operation.Status, operation.StatusDesc); err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		apps[i].Status = operation.Status
+		apps[i].StatusDesc = operation.StatusDesc
+	}
+	return apps, nil
+}
+
+func (u *CatalogUsecase) getHelmRepository(ctx context.Context, helmRepositoryId uuid.UUID) (model.HelmRepository, error) {
+	helmRepository, err := u.repo.GetHelmRepository(ctx, helmRepositoryId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.HelmRepository{}, httpErrors.NewError(err, "CT_NOT_FOUND_HELM_REPOSITORY")
+		}
+		return model.HelmRepository{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return helmRepository, nil
+}
+
+func (u *CatalogUsecase) getChart(ctx context.Context, catalogChartId uuid.UUID) (model.CatalogChart, error) {
+	chart, err := u.repo.GetChart(ctx, catalogChartId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.CatalogChart{}, httpErrors.NewError(err, "CT_NOT_FOUND_CATALOG_CHART")
+		}
+		return model.CatalogChart{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return chart, nil
+}
+
+// checkRelease 는 스택의 namespace 에 같은 이름의 release 를 배포했거나 배포 중인지 확인한다. 실패하거나 취소된 배포는 다시 할 수 있다.
+func (u *CatalogUsecase) checkRelease(ctx context.Context, dto model.CatalogApp) error {
+	apps, err := u.repo.FetchAppsByRelease(ctx, dto.ClusterId, dto.Namespace, dto.ReleaseName)
+	if err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	for _, app := range apps {
+		status := app.Status
+		if operation, err := u.operationRepo.Get(ctx, app.OperationId); err == nil {
+			status = operation.Status
+		}
+		if status != domain.OperationStatus_FAILED && status != domain.OperationStatus_CANCELED {
+			return httpErrors.NewError(fmt.Errorf("release %s/%s already exists in stack %s", dto.Namespace, dto.ReleaseName, dto.ClusterId), "CT_ALREADY_EXISTED_RELEASE")
+		}
+	}
+	return nil
+}
+
+// sync 는 repository 의 index.yaml 을 읽어 chart 를 갱신하고 결과를 repository 의 상태로 기록한다.
+func (u *CatalogUsecase) sync(ctx context.Context, helmRepository model.HelmRepository) (model.HelmRepository, error) {
+	// DB 에 저장되는 시각과 비교해 이전 chart 를 지우므로 DB 의 정밀도에 맞춘다.
+	syncedAt := time.Now().Truncate(time.Microsecond)
+
+	charts, err := u.fetchIndex(ctx, helmRepository, syncedAt)
+	if err == nil {
+		err = u.repo.SyncCharts(ctx, helmRepository.ID, charts, syncedAt)
+	}
+	if err != nil {
+		helmRepository.Status = domain.HelmRepositoryStatus_FAILED
+		helmRepository.StatusDesc = err.Error()
+	} else {
+		helmRepository.Status = domain.HelmRepositoryStatus_SYNCED
+		helmRepository.StatusDesc = ""
+		helmRepository.ChartCount = len(charts)
+		helmRepository.SyncedAt = &syncedAt
+	}
+
+	if updateErr := u.repo.UpdateHelmRepositoryStatus(ctx, helmRepository.ID, helmRepository.Status, helmRepository.StatusDesc, helmRepository.ChartCount, helmRepository.SyncedAt); updateErr != nil {
+		log.Error(ctx, updateErr)
+	}
+	return helmRepository, err
+}
+
+func (u *CatalogUsecase) fetchIndex(ctx context.Context, helmRepository model.HelmRepository, syncedAt time.Time) ([]model.CatalogChart, error) {
+	body, err := u.download(ctx, helmRepository.Url+"/index.yaml", helmIndexMaxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var index helmIndex
+	if err := yaml.Unmarshal(body, &index); err != nil {
+		return nil, errors.Wrap(err, "invalid index.yaml")
+	}
+
+	base, err := url.Parse(helmRepository.Url + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	// 같은 chart 버전이 index 에 여러 번 있으면 처음 것만 사용한다.
+	seen := map[string]bool{}
+	charts := []model.CatalogChart{}
+	for name, entries := range index.Entries {
+		for _, entry := range entries {
+			if entry.Version == "" || len(entry.Urls) == 0 || seen[name+"/"+entry.Version] {
+				continue
+			}
+			seen[name+"/"+entry.Version] = true
+
+			chartUrl, err := base.Parse(entry.Urls[0])
+			if err != nil {
+				continue
+			}
+			chart := model.CatalogChart{
+				ID:               uuid.New(),
+				HelmRepositoryId: helmRepository.ID,
+				Name:             name,
+				Version:          entry.Version,
+				AppVersion:       entry.AppVersion,
+				Description:      entry.Description,
+				Icon:             entry.Icon,
+				Url:              chartUrl.String(),
+				Digest:           entry.Digest,
+				Deprecated:       entry.Deprecated,
+				SyncedAt:         syncedAt,
+			}
+			if created, err := time.Parse(time.RFC3339Nano, entry.Created); err == nil {
+				chart.ReleasedAt = &created
+			}
+			charts = append(charts, chart)
+		}
+	}
+	return charts, nil
+}
+
+// loadValuesSchema 는 chart archive 의 values.schema.json 을 처음 한 번만 읽어 저장한다.
+func (u *CatalogUsecase) loadValuesSchema(ctx context.Context, chart *model.CatalogChart) error {
+	if chart.SchemaLoaded {
+		return nil
+	}
+
+	archive, err := u.download(ctx, chart.Url, helmChartArchiveMaxSize)
+	if err != nil {
+		return err
+	}
+	schema, err := readValuesSchema(archive)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("invalid chart archive %s", chart.Url))
+	}
+	if schema != nil && !json.Valid(schema) {
+		return fmt.Errorf("values.schema.json of chart %s-%s is not json", chart.Name, chart.Version)
+	}
+
+	if err := u.repo.UpdateChartValuesSchema(ctx, chart.ID, schema); err != nil {
+		return err
+	}
+	chart.ValuesSchema = schema
+	chart.SchemaLoaded = true
+	return nil
+}
+
+func (u *CatalogUsecase) download(ctx context.Context, target string, maxSize int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := u.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s. status: %d", target, res.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > maxSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", target, maxSize)
+	}
+	return body, nil
+}
+
+// readValuesSchema 는 chart archive 의 최상위 chart 디렉토리에 있는 values.schema.json 을 반환한다.
+// subchart 의 schema 는 사용하지 않고, schema 가 없으면 nil 을 반환한다.
+func readValuesSchema(archive []byte) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		dir, file := path.Split(path.Clean(header.Name))
+		if file != "values.schema.json" || strings.Count(dir, "/") != 1 {
+			continue
+		}
+		return io.ReadAll(tr)
+	}
+}
+
+func validateCatalogValues(schema []byte, values map[string]interface{}) error {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(schema), gojsonschema.NewGoLoader(values))
+	if err != nil {
+		return errors.Wrap(err, "invalid values schema")
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	reasons := make([]string, len(result.Errors()))
+	for i, e := range result.Errors() {
+		reasons[i] = e.String()
+	}
+	return fmt.Errorf("values do not match the values schema: %s", strings.Join(reasons, ", "))
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// GetPricing 은 단가가 등록되지 않은 클라우드 계정이면 비어 있는 단가를 반환한다. 이 경우 대시보드 비용에서 제외된다.
+func (u *CloudAccountUsecase) GetPricing(ctx context.Context, organizationId string, cloudAccountId uuid.UUID) (out model.CloudAccountPricing, err error) {
+	if err = u.checkCloudAccountOrganization(ctx, organizationId, cloudAccountId); err != nil {
+		return out, err
+	}
+
+	out, err = u.pricingRepo.Get(ctx, cloudAccountId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.CloudAccountPricing{CloudAccountId: cloudAccountId, OrganizationId: organizationId}, nil
+		}
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return out, nil
+}
+
+func (u *CloudAccountUsecase) UpdatePricing(ctx context.Context, dto model.CloudAccountPricing) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	userId := user.GetUserId()
+
+	if err := u.checkCloudAccountOrganization(ctx, dto.OrganizationId, dto.CloudAccountId); err != nil {
+		return err
+	}
+
+	dto.Currency = strings.ToUpper(dto.Currency)
+	dto.UpdatorId = &userId
+	if err := u.pricingRepo.Upsert(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *CloudAccountUsecase) DeletePricing(ctx context.Context, organizationId string, cloudAccountId uuid.UUID) error {
+	if err := u.checkCloudAccountOrganization(ctx, organizationId, cloudAccountId); err != nil {
+		return err
+	}
+
+	if err := u.pricingRepo.Delete(ctx, cloudAccountId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *CloudAccountUsecase) checkCloudAccountOrganization(ctx context.Context, organizationId string, cloudAccountId uuid.UUID) error {
+	cloudAccount, err := u.repo.Get(ctx, cloudAccountId)
+	if err != nil || cloudAccount.OrganizationId != organizationId {
+		return httpErrors.NewNotFoundError(fmt.Errorf("cloud account %s is not found in organization %s", cloudAccountId, organizationId), "C_INVALID_CLOUD_ACCOUNT_ID", "")
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const cloudAccountVerificationRegion = "ap-northeast-2"
+
+// 자격증명이 만료되었거나 더 이상 유효하지 않을 때 AWS 가 반환하는 오류 코드
+var expiredCredentialErrorCodes = []string{
+	"ExpiredToken", "ExpiredTokenException", "RequestExpired", "InvalidClientTokenId", "SignatureDoesNotMatch", "AuthFailure",
+}
+
+// 권한이 없을 때 AWS 가 반환하는 오류 코드. AssumeRole 이 거부된 경우도 포함한다.
+var permissionDeniedErrorCodes = []string{
+	"UnauthorizedOperation", "AccessDenied", "AccessDeniedException", "UnauthorizedException",
+}
+
+// cloudAccountPermissionCheck 는 스택 생성에 필요한 권한 하나를 확인한다.
+// 권한이 있으면 nil 을, 없으면 AWS 가 반환한 오류를 그대로 반환한다.
+type cloudAccountPermissionCheck struct {
+	action string
+	check  func(ctx context.Context, cfg aws.Config) error
+}
+
+// IAM policy simulator 를 사용할 수 없으므로 변경 API 는 EC2 DryRun 으로, 나머지는 조회 API 를 직접 호출해 권한을 확인한다.
+// DryRun 은 권한이 있으면 DryRunOperation 오류를 반환하고 실제 자원은 만들지 않는다.
+var cloudAccountPermissionChecks = []cloudAccountPermissionCheck{
+	{"ec2:CreateVpc", func(ctx context.Context, cfg aws.Config) error {
+		_, err := ec2.NewFromConfig(cfg).CreateVpc(ctx, &ec2.CreateVpcInput{CidrBlock: aws.String("10.0.0.0/16"), DryRun: aws.Bool(true)})
+		return ignoreDryRunOperation(err)
+	}},
+	{"ec2:AllocateAddress", func(ctx context.Context, cfg aws.Config) error {
+		_, err := ec2.NewFromConfig(cfg).AllocateAddress(ctx, &ec2.AllocateAddressInput{DryRun: aws.Bool(true)})
+		return ignoreDryRunOperation(err)
+	}},
+	{"ec2:DescribeInstances", func(ctx context.Context, cfg aws.Config) error {
+		_, err := ec2.NewFromConfig(cfg).DescribeInstances(ctx, &ec2.DescribeInstancesInput{DryRun: aws.Bool(true)})
+		return ignoreDryRunOperation(err)
+	}},
+	{"eks:ListClusters", func(ctx context.Context, cfg aws.Config) error {
+		_, err := eks.NewFromConfig(cfg).ListClusters(ctx, &eks.ListClustersInput{MaxResults: aws.Int32(1)})
+		return err
+	}},
+	{"elasticloadbalancing:DescribeLoadBalancers", func(ctx context.Context, cfg aws.Config) error {
+		_, err := elasticloadbalancingv2.NewFromConfig(cfg).DescribeLoadBalancers(ctx, &elasticloadbalancingv2.DescribeLoadBalancersInput{PageSize: aws.Int32(1)})
+		return err
+	}},
+	{"servicequotas:ListServiceQuotas", func(ctx context.Context, cfg aws.Config) error {
+		_, err := servicequotas.NewFromConfig(cfg).ListServiceQuotas(ctx, &servicequotas.ListServiceQuotasInput{ServiceCode: aws.String("ec2"), MaxResults: aws.Int32(1)})
+		return err
+	}},
+}
+
+// Verify 는 클라우드 계정의 자격증명이 유효한지 STS GetCallerIdentity 로 확인하고, 스택 생성에 필요한 권한을 확인한 뒤
+// 결과를 계정의 CredentialStatus 에 기록한다. 네트워크 오류처럼 판단할 수 없는 실패는 기록하지 않고 오류를 반환한다.
+func (u *CloudAccountUsecase) Verify(ctx context.Context, organizationId string, cloudAccountId uuid.UUID) (out domain.VerifyCloudAccountResponse, err error) {
+	if err = u.checkCloudAccountOrganization(ctx, organizationId, cloudAccountId); err != nil {
+		return out, err
+	}
+	cloudAccount, err := u.repo.Get(ctx, cloudAccountId)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if cloudAccount.CloudService != domain.CloudService_AWS || cloudAccount.Status != domain.CloudAccountStatus_CREATED {
+		return out, httpErrors.NewError(fmt.Errorf("cloud account %s is not verifiable. status: %s", cloudAccountId, cloudAccount.Status), "CA_NOT_VERIFIABLE_CLOUD_ACCOUNT")
+	}
+
+	out, err = verifyCloudAccountCredential(ctx, cloudAccount)
+	if err != nil {
+		return out, httpErrors.NewError(err, "CA_FAILED_VERIFY_CLOUD_ACCOUNT")
+	}
+	if err = u.repo.UpdateCredentialStatus(ctx, cloudAccountId, domain.CloudAccountCredentialStatus_UNVERIFIED.FromString(out.CredentialStatus), out.CredentialStatusDesc, out.CredentialCheckedAt); err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return out, nil
+}
+
+// CheckCredentials 는 생성이 완료된 모든 AWS 클라우드 계정의 자격증명을 주기적으로 다시 검증해
+// 만료되었거나 권한을 잃은 계정을 CredentialStatus 로 표시한다.
+func (u *CloudAccountUsecase) CheckCredentials(ctx context.Context) error {
+	organizations, err := u.organizationRepo.Fetch(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, organization := range *organizations {
+		cloudAccounts, err := u.repo.Fetch(ctx, organization.ID, nil)
+		if err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		for _, cloudAccount := range cloudAccounts {
+			if cloudAccount.CloudService != domain.CloudService_AWS || cloudAccount.Status != domain.CloudAccountStatus_CREATED {
+				continue
+			}
+			out, err := verifyCloudAccountCredential(ctx, cloudAccount)
+			if err != nil {
+				log.Errorf(ctx, "failed to verify credential of cloud account %s. err: %s", cloudAccount.ID, err)
+				continue
+			}
+			status := domain.CloudAccountCredentialStatus_UNVERIFIED.FromString(out.CredentialStatus)
+			if status != cloudAccount.CredentialStatus && status != domain.CloudAccountCredentialStatus_VALID {
+				log.Warnf(ctx, "credential of cloud account %s changed to %s. %s", cloudAccount.ID, status, out.CredentialStatusDesc)
+			}
+			if err := u.repo.UpdateCredentialStatus(ctx, cloudAccount.ID, status, out.CredentialStatusDesc, out.CredentialCheckedAt); err != nil {
+				log.Error(ctx, err)
+			}
+		}
+	}
+	return nil
+}
+
+func verifyCloudAccountCredential(ctx context.Context, cloudAccount model.CloudAccount) (out domain.VerifyCloudAccountResponse, err error) {
+	cfg, err := awsConfigOf(ctx, cloudAccount)
+	if err != nil {
+		return out, err
+	}
+	cfg.Region = cloudAccountVerificationRegion
+	out.CredentialCheckedAt = time.Now()
+	out.Checks = make([]domain.CloudAccountCredentialCheckResponse, 0)
+
+	identity, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		if !isAwsErrorCode(err, expiredCredentialErrorCodes...) && !isAwsErrorCode(err, permissionDeniedErrorCodes...) {
+			return out, err
+		}
+		out.Checks = append(out.Checks, domain.CloudAccountCredentialCheckResponse{Action: "sts:GetCallerIdentity", Allowed: false, Message: err.Error()})
+		out.CredentialStatus = domain.CloudAccountCredentialStatus_EXPIRED.String()
+		out.CredentialStatusDesc = "자격증명이 만료되었거나 유효하지 않습니다."
+		return out, nil
+	}
+	out.Checks = append(out.Checks, domain.CloudAccountCredentialCheckResponse{Action: "sts:GetCallerIdentity", Allowed: true})
+	out.CallerArn = aws.ToString(identity.Arn)
+	if aws.ToString(identity.Account) != cloudAccount.AwsAccountId {
+		out.CredentialStatus = domain.CloudAccountCredentialStatus_EXPIRED.String()
+		out.CredentialStatusDesc = fmt.Sprintf("자격증명이 다른 AWS 계정(%s)의 것입니다.", aws.ToString(identity.Account))
+		return out, nil
+	}
+
+	var denied []string
+	for _, permission := range cloudAccountPermissionChecks {
+		err := permission.check(ctx, cfg)
+		switch {
+		case err == nil:
+			out.Checks = append(out.Checks, domain.CloudAccountCredentialCheckResponse{Action: permission.action, Allowed: true})
+		case isAwsErrorCode(err, permissionDeniedErrorCodes...):
+			out.Checks = append(out.Checks, domain.CloudAccountCredentialCheckResponse{Action: permission.action, Allowed: false, Message: err.Error()})
+			denied = append(denied, permission.action)
+		default:
+			return out, errors.Wrap(err, permission.action)
+		}
+	}
+
+	if len(denied) > 0 {
+		out.CredentialStatus = domain.CloudAccountCredentialStatus_PERMISSION_DENIED.String()
+		out.CredentialStatusDesc = fmt.Sprintf("필요한 권한이 없습니다: %s", strings.Join(denied, ", "))
+		return out, nil
+	}
+	out.CredentialStatus = domain.CloudAccountCredentialStatus_VALID.String()
+	return out, nil
+}
+
+func ignoreDryRunOperation(err error) error {
+	if isAwsErrorCode(err, "DryRunOperation") {
+		return nil
+	}
+	return err
+}
+
+func isAwsErrorCode(err error, codes ...string) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, code := range codes {
+		if apiErr.ErrorCode() == code {
+			return true
+		}
+	}
+	return false
+}
@@ -5,16 +5,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
-	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
-	"github.com/aws/aws-sdk-go-v2/service/ec2"
-	"github.com/aws/aws-sdk-go-v2/service/eks"
-	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
-	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
-	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
-	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/google/uuid"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
@@ -23,7 +13,6 @@ import (
 	argowf "github.com/openinfradev/tks-api/pkg/argo-client"
 	"github.com/openinfradev/tks-api/pkg/domain"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
-	"github.com/openinfradev/tks-api/pkg/kubernetes"
 	"github.com/openinfradev/tks-api/pkg/log"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -41,19 +30,30 @@ type ICloudAccountUsecase interface {
 	Update(ctx context.Context, dto model.CloudAccount) error
 	Delete(ctx context.Context, dto model.CloudAccount) (model.CloudAccount, error)
 	DeleteForce(ctx context.Context, cloudAccountId uuid.UUID) (model.CloudAccount, error)
+	GetPricing(ctx context.Context, organizationId string, cloudAccountId uuid.UUID) (model.CloudAccountPricing, error)
+	UpdatePricing(ctx context.Context, dto model.CloudAccountPricing) error
+	DeletePricing(ctx context.Context, organizationId string, cloudAccountId uuid.UUID) error
+	Verify(ctx context.Context, organizationId string, cloudAccountId uuid.UUID) (domain.VerifyCloudAccountResponse, error)
+	CheckCredentials(ctx context.Context) error
 }
 
 type CloudAccountUsecase struct {
-	repo        repository.ICloudAccountRepository
-	clusterRepo repository.IClusterRepository
-	argo        argowf.ArgoClient
+	repo             repository.ICloudAccountRepository
+	clusterRepo      repository.IClusterRepository
+	organizationRepo repository.IOrganizationRepository
+	onboardingRepo   repository.IOrganizationOnboardingRepository
+	pricingRepo      repository.ICloudAccountPricingRepository
+	argo             argowf.ArgoClient
 }
 
 func NewCloudAccountUsecase(r repository.Repository, argoClient argowf.ArgoClient) ICloudAccountUsecase {
 	return &CloudAccountUsecase{
-		repo:        r.CloudAccount,
-		clusterRepo: r.Cluster,
-		argo:        argoClient,
+		repo:             r.CloudAccount,
+		clusterRepo:      r.Cluster,
+		organizationRepo: r.Organization,
+		onboardingRepo:   r.OrganizationOnboarding,
+		pricingRepo:      r.CloudAccountPricing,
+		argo:             argoClient,
 	}
 }
 
@@ -67,23 +67,38 @@ func (u *CloudAccountUsecase) Create(ctx context.Context, dto model.CloudAccount
 	dto.Resource = "TODO server result or additional information"
 	dto.CreatorId = &userId
 
+	provider, err := cloudProviderOf(dto.CloudService)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err := provider.ValidateAccount(&dto); err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(err, "CA_INVALID_CLOUD_ACCOUNT_CREDENTIAL", "")
+	}
+
 	_, err = u.GetByName(ctx, dto.OrganizationId, dto.Name)
 	if err == nil {
 		return uuid.Nil, httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "", "조직내에 동일한 이름의 클라우드 어카운트가 존재합니다.")
 	}
-	_, err = u.GetByAwsAccountId(ctx, dto.AwsAccountId)
+	_, err = u.repo.GetByAccountId(ctx, dto.CloudService, dto.AccountId)
 	if err == nil {
-		return uuid.Nil, httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "", "사용 중인 AwsAccountId 입니다. 관리자에게 문의하세요.")
+		return uuid.Nil, httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "", "사용 중인 계정 ID 입니다. 관리자에게 문의하세요.")
 	}
 
 	cloudAccountId, err = u.repo.Create(ctx, dto)
 	if err != nil {
-		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	log.Info(ctx, "newly created CloudAccount ID:", cloudAccountId)
 
+	if err := u.onboardingRepo.Complete(ctx, dto.OrganizationId, domain.OnboardingStep_CLOUD_ACCOUNT); err != nil {
+		log.Error(ctx, err)
+	}
+
+	// 준비할 workflow 가 없는 provider 는 바로 사용할 수 있다.
+	dto.ID = cloudAccountId
+	templateName, parameters := provider.CreateWorkflow(dto)
 	// FOR TEST. ADD MAGIC KEYWORD
-	if strings.Contains(dto.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
+	if strings.Contains(dto.Name, domain.CLOUD_ACCOUNT_INCLUSTER) || templateName == "" {
 		if err := u.repo.InitWorkflow(ctx, cloudAccountId, "", domain.CloudAccountStatus_CREATED); err != nil {
 			return uuid.Nil, errors.Wrap(err, "Failed to initialize status")
 		}
@@ -92,16 +107,9 @@ func (u *CloudAccountUsecase) Create(ctx context.Context, dto model.CloudAccount
 
 	workflowId, err := u.argo.SumbitWorkflowFromWftpl(
 		ctx,
-		"tks-create-aws-cloud-account",
+		templateName,
 		argowf.SubmitOptions{
-			Parameters: []string{
-				"aws_region=" + "ap-northeast-2",
-				"tks_cloud_account_id=" + cloudAccountId.String(),
-				"aws_account_id=" + dto.AwsAccountId,
-				"aws_access_key_id=" + dto.AccessKeyId,
-				"aws_secret_access_key=" + dto.SecretAccessKey,
-				"aws_session_token=" + dto.SessionToken,
-			},
+			Parameters: parameters,
 		})
 	if err != nil {
 		log.Error(ctx, "failed to submit argo workflow template. err : ", err)
@@ -127,7 +135,7 @@ func (u *CloudAccountUsecase) Update(ctx context.Context, dto model.CloudAccount
 	dto.UpdatorId = &userId
 	err := u.repo.Update(ctx, dto)
 	if err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	return nil
 }
@@ -196,18 +204,26 @@ func (u *CloudAccountUsecase) Delete(ctx context.Context, dto model.CloudAccount
 		return cloudAccount, fmt.Errorf("사용 중인 클러스터가 있어 삭제할 수 없습니다.")
 	}
 
+	// 지원하지 않는 클라우드 서비스로 이전에 만든 계정은 정리할 workflow 가 없으므로 바로 삭제한다.
+	templateName, parameters := "", []string{}
+	if provider, err := cloudProviderOf(cloudAccount.CloudService); err == nil {
+		templateName, parameters, err = provider.DeleteWorkflow(cloudAccount, dto)
+		if err != nil {
+			return cloudAccount, httpErrors.NewBadRequestError(err, "CA_INVALID_CLOUD_ACCOUNT_CREDENTIAL", "")
+		}
+	}
+	if templateName == "" {
+		if err := u.repo.Delete(ctx, dto.ID); err != nil {
+			return cloudAccount, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+		return cloudAccount, nil
+	}
+
 	workflowId, err := u.argo.SumbitWorkflowFromWftpl(
 		ctx,
-		"tks-delete-aws-cloud-account",
+		templateName,
 		argowf.SubmitOptions{
-			Parameters: []string{
-				"aws_region=" + "ap-northeast-2",
-				"tks_cloud_account_id=" + dto.ID.String(),
-				"aws_account_id=" + cloudAccount.AwsAccountId,
-				"aws_access_key_id=" + dto.AccessKeyId,
-				"aws_secret_access_key=" + dto.SecretAccessKey,
-				"aws_session_token=" + dto.SessionToken,
-			},
+			Parameters: parameters,
 		})
 	if err != nil {
 		log.Error(ctx, "failed to submit argo workflow template. err : ", err)
@@ -251,197 +267,11 @@ func (u *CloudAccountUsecase) GetResourceQuota(ctx context.Context, cloudAccount
 		return false, out, err
 	}
 
-	awsAccessKeyId, awsSecretAccessKey, _ := kubernetes.GetAwsSecret(ctx)
-	if err != nil || awsAccessKeyId == "" || awsSecretAccessKey == "" {
-		log.Error(ctx, err)
-		return false, out, httpErrors.NewInternalServerError(fmt.Errorf("Invalid aws secret."), "", "")
-	}
-
-	cfg, err := config.LoadDefaultConfig(ctx,
-		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
-			Value: aws.Credentials{
-				AccessKeyID: awsAccessKeyId, SecretAccessKey: awsSecretAccessKey,
-			},
-		}))
+	provider, err := cloudProviderOf(cloudAccount.CloudService)
 	if err != nil {
-		log.Error(ctx, err)
-	}
-
-	stsSvc := sts.NewFromConfig(cfg)
-
-	if !strings.Contains(cloudAccount.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
-		log.Info(ctx, "Use assume role. awsAccountId : ", cloudAccount.AwsAccountId)
-		creds := stscreds.NewAssumeRoleProvider(stsSvc, "arn:aws:iam::"+cloudAccount.AwsAccountId+":role/controllers.cluster-api-provider-aws.sigs.k8s.io")
-		cfg.Credentials = aws.NewCredentialsCache(creds)
-	}
-	client := servicequotas.NewFromConfig(cfg)
-
-	quotaMap := map[string]string{
-		"L-69A177A2": "elasticloadbalancing", // NLB
-		"L-E9E9831D": "elasticloadbalancing", // Classic
-		"L-A4707A72": "vpc",                  // IGW
-		"L-1194D53C": "eks",                  // Cluster
-		"L-0263D0A3": "ec2",                  // Elastic IP
-	}
-
-	// current usage
-	type CurrentUsage struct {
-		NLB     int
-		CLB     int
-		IGW     int
-		Cluster int
-		EIP     int
-	}
-
-	out.Quotas = make([]domain.ResourceQuotaAttr, 0)
-
-	// get current usage
-	currentUsage := CurrentUsage{}
-	{
-		c := elasticloadbalancingv2.NewFromConfig(cfg)
-		pageSize := int32(100)
-		res, err := c.DescribeLoadBalancers(ctx, &elasticloadbalancingv2.DescribeLoadBalancersInput{
-			PageSize: &pageSize,
-		}, func(o *elasticloadbalancingv2.Options) {
-			o.Region = "ap-northeast-2"
-		})
-		if err != nil {
-			return false, out, err
-		}
-
-		for _, elb := range res.LoadBalancers {
-			switch elb.Type {
-			case "network":
-				currentUsage.NLB += 1
-			}
-		}
-	}
-
-	{
-		c := elasticloadbalancing.NewFromConfig(cfg)
-		pageSize := int32(100)
-		res, err := c.DescribeLoadBalancers(ctx, &elasticloadbalancing.DescribeLoadBalancersInput{
-			PageSize: &pageSize,
-		}, func(o *elasticloadbalancing.Options) {
-			o.Region = "ap-northeast-2"
-		})
-		if err != nil {
-			return false, out, err
-		}
-		currentUsage.CLB = len(res.LoadBalancerDescriptions)
-	}
-
-	{
-		c := ec2.NewFromConfig(cfg)
-		res, err := c.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{}, func(o *ec2.Options) {
-			o.Region = "ap-northeast-2"
-		})
-		if err != nil {
-			return false, out, err
-		}
-		currentUsage.IGW = len(res.InternetGateways)
-	}
-
-	{
-		c := eks.NewFromConfig(cfg)
-		res, err := c.ListClusters(ctx, &eks.ListClustersInput{}, func(o *eks.Options) {
-			o.Region = "ap-northeast-2"
-		})
-		if err != nil {
-			return false, out, err
-		}
-		currentUsage.Cluster = len(res.Clusters)
-	}
-
-	{
-		c := ec2.NewFromConfig(cfg)
-		res, err := c.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{}, func(o *ec2.Options) {
-			o.Region = "ap-northeast-2"
-		})
-		if err != nil {
-			log.Error(ctx, err)
-			return false, out, err
-		}
-		currentUsage.EIP = len(res.Addresses)
-	}
-
-	for key, val := range quotaMap {
-		res, err := getServiceQuota(client, key, val)
-		if err != nil {
-			return false, out, err
-		}
-		log.Debugf(ctx, "%s %s %v", *res.Quota.QuotaName, *res.Quota.QuotaCode, *res.Quota.Value)
-
-		quotaValue := int(*res.Quota.Value)
-
-		// stack 1개 생성하는데 필요한 quota
-		// Classic 1
-		// Network 5
-		// IGW 1
-		// EIP 3
-		// Cluster 1
-		switch key {
-		case "L-69A177A2": // NLB
-			log.Infof(ctx, "NLB : usage %d, quota %d", currentUsage.NLB, quotaValue)
-			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
-				Type:     "NLB",
-				Usage:    currentUsage.NLB,
-				Quota:    quotaValue,
-				Required: 5,
-			})
-			if quotaValue >= currentUsage.NLB+5 {
-				available = true
-			}
-		case "L-E9E9831D": // Classic
-			log.Infof(ctx, "CLB : usage %d, quota %d", currentUsage.CLB, quotaValue)
-			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
-				Type:     "CLB",
-				Usage:    currentUsage.CLB,
-				Quota:    quotaValue,
-				Required: 1,
-			})
-			if quotaValue >= currentUsage.CLB+1 {
-				available = true
-			}
-		case "L-A4707A72": // IGW
-			log.Infof(ctx, "IGW : usage %d, quota %d", currentUsage.IGW, quotaValue)
-			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
-				Type:     "IGW",
-				Usage:    currentUsage.IGW,
-				Quota:    quotaValue,
-				Required: 1,
-			})
-			if quotaValue >= currentUsage.IGW+1 {
-				available = true
-			}
-		case "L-1194D53C": // Cluster
-			log.Infof(ctx, "Cluster : usage %d, quota %d", currentUsage.Cluster, quotaValue)
-			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
-				Type:     "EKS",
-				Usage:    currentUsage.Cluster,
-				Quota:    quotaValue,
-				Required: 1,
-			})
-			if quotaValue >= currentUsage.Cluster+1 {
-				available = true
-			}
-		case "L-0263D0A3": // Elastic IP
-			log.Infof(ctx, "Elastic IP : usage %d, quota %d", currentUsage.EIP, quotaValue)
-			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
-				Type:     "EIP",
-				Usage:    currentUsage.EIP,
-				Quota:    quotaValue,
-				Required: 3,
-			})
-			if quotaValue >= currentUsage.EIP+3 {
-				available = true
-			}
-		}
-
+		return false, out, err
 	}
-
-	//return fmt.Errorf("Always return err")
-	return available, out, nil
+	return provider.GetResourceQuota(ctx, cloudAccount)
 }
 
 func (u *CloudAccountUsecase) getClusterCnt(ctx context.Context, cloudAccountId uuid.UUID) (cnt int) {
@@ -461,16 +291,3 @@ func (u *CloudAccountUsecase) getClusterCnt(ctx context.Context, cloudAccountId
 
 	return cnt
 }
-
-func getServiceQuota(client *servicequotas.Client, quotaCode string, serviceCode string) (res *servicequotas.GetServiceQuotaOutput, err error) {
-	res, err = client.GetServiceQuota(context.TODO(), &servicequotas.GetServiceQuotaInput{
-		QuotaCode:   &quotaCode,
-		ServiceCode: &serviceCode,
-	}, func(o *servicequotas.Options) {
-		o.Region = "ap-northeast-2"
-	})
-	if err != nil {
-		return nil, err
-	}
-	return
-}
@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	health "github.com/openinfradev/tks-api/pkg/aws-health-client"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const CLOUD_HEALTH_NOTIFICATION_PREFIX = "aws-health-"
+
+type ICloudHealthEventUsecase interface {
+	Ingest(ctx context.Context, input domain.CreateCloudHealthEventRequest) error
+	Poll(ctx context.Context, since time.Time) error
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.CloudHealthEvent, error)
+}
+
+type CloudHealthEventUsecase struct {
+	repo                   repository.ICloudHealthEventRepository
+	cloudAccountRepo       repository.ICloudAccountRepository
+	clusterRepo            repository.IClusterRepository
+	organizationRepo       repository.IOrganizationRepository
+	systemNotificationRepo repository.ISystemNotificationRepository
+}
+
+func NewCloudHealthEventUsecase(r repository.Repository) ICloudHealthEventUsecase {
+	return &CloudHealthEventUsecase{
+		repo:                   r.CloudHealthEvent,
+		cloudAccountRepo:       r.CloudAccount,
+		clusterRepo:            r.Cluster,
+		organizationRepo:       r.Organization,
+		systemNotificationRepo: r.SystemNotification,
+	}
+}
+
+// Ingest 는 EventBridge 로부터 전달된 AWS Health event 를 ingestion token 의 조직 기준으로 처리한다.
+func (u *CloudHealthEventUsecase) Ingest(ctx context.Context, input domain.CreateCloudHealthEventRequest) error {
+	organizationId, ok := request.IngestionOrganizationFrom(ctx)
+	if !ok {
+		return httpErrors.NewError(fmt.Errorf("Invalid ingestion token"), "A_INVALID_TOKEN")
+	}
+
+	cloudAccount, err := u.getCloudAccount(ctx, organizationId, input.Account)
+	if err != nil {
+		return err
+	}
+
+	description := ""
+	for _, d := range input.Detail.EventDescription {
+		if d.Language == "" || strings.HasPrefix(d.Language, "en") {
+			description = d.LatestDescription
+			break
+		}
+	}
+	entities := append([]string{}, input.Resources...)
+	for _, entity := range input.Detail.AffectedEntities {
+		entities = append(entities, entity.EntityValue)
+	}
+	region := input.Detail.EventRegion
+	if region == "" {
+		region = input.Region
+	}
+
+	event := model.CloudHealthEvent{
+		OrganizationId:    organizationId,
+		CloudAccountId:    cloudAccount.ID,
+		EventArn:          input.Detail.EventArn,
+		Service:           input.Detail.Service,
+		EventTypeCode:     input.Detail.EventTypeCode,
+		EventTypeCategory: input.Detail.EventTypeCategory,
+		Region:            region,
+		StatusCode:        input.Detail.StatusCode,
+		StartTime:         parseHealthTime(input.Detail.StartTime),
+		EndTime:           parseHealthTime(input.Detail.EndTime),
+		Description:       description,
+		Entities:          entities,
+	}
+	if err = u.raise(ctx, cloudAccount, event); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// Poll 은 webhook 을 구성하지 않은 조직을 위해 등록된 AWS 클라우드 계정의 health event 를 직접 조회한다.
+func (u *CloudHealthEventUsecase) Poll(ctx context.Context, since time.Time) error {
+	organizations, err := u.organizationRepo.Fetch(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, organization := range *organizations {
+		cloudAccounts, err := u.cloudAccountRepo.Fetch(ctx, organization.ID, nil)
+		if err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		for _, cloudAccount := range cloudAccounts {
+			if cloudAccount.CloudService != domain.CloudService_AWS || cloudAccount.Status != domain.CloudAccountStatus_CREATED {
+				continue
+			}
+			if err := u.pollCloudAccount(ctx, cloudAccount, since); err != nil {
+				log.Errorf(ctx, "failed to poll health events of cloud account %s. err: %s", cloudAccount.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (u *CloudHealthEventUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.CloudHealthEvent, error) {
+	events, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return events, nil
+}
+
+func (u *CloudHealthEventUsecase) pollCloudAccount(ctx context.Context, cloudAccount model.CloudAccount, since time.Time) error {
+	cfg, err := awsConfigOf(ctx, cloudAccount)
+	if err != nil {
+		return err
+	}
+	client := health.New(cfg)
+
+	events, err := client.DescribeEvents(ctx, health.EventFilter{
+		LastUpdatedTimes: []health.DateTimeRange{{From: float64(since.Unix())}},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		entities := []string{}
+		affected, err := client.DescribeAffectedEntities(ctx, e.Arn)
+		if err != nil {
+			log.Error(ctx, err)
+		}
+		for _, entity := range affected {
+			entities = append(entities, entity.EntityValue)
+		}
+		description, err := client.DescribeEventDescription(ctx, e.Arn)
+		if err != nil {
+			log.Error(ctx, err)
+		}
+
+		event := model.CloudHealthEvent{
+			OrganizationId:    cloudAccount.OrganizationId,
+			CloudAccountId:    cloudAccount.ID,
+			EventArn:          e.Arn,
+			Service:           e.Service,
+			EventTypeCode:     e.EventTypeCode,
+			EventTypeCategory: e.EventTypeCategory,
+			Region:            e.Region,
+			StatusCode:        e.StatusCode,
+			StartTime:         epochToTime(e.StartTime),
+			EndTime:           epochToTime(e.EndTime),
+			Description:       description,
+			Entities:          entities,
+		}
+		if err := u.raise(ctx, cloudAccount, event); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	return nil
+}
+
+// raise 는 event 를 클라우드 계정의 스택과 연관시켜 스택별로 TKS 알림을 생성한다.
+// affected entity 에 클러스터 ID 가 포함된 스택이 없으면 계정의 모든 스택이 영향을 받는 것으로 간주한다.
+func (u *CloudHealthEventUsecase) raise(ctx context.Context, cloudAccount model.CloudAccount, event model.CloudHealthEvent) error {
+	clusters, err := u.clusterRepo.FetchByCloudAccountId(ctx, cloudAccount.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	targets := make([]model.Cluster, 0)
+	all := make([]model.Cluster, 0)
+	for _, cluster := range clusters {
+		if cluster.Status == domain.ClusterStatus_DELETED {
+			continue
+		}
+		all = append(all, cluster)
+		for _, entity := range event.Entities {
+			if strings.Contains(entity, cluster.ID.String()) {
+				targets = append(targets, cluster)
+				break
+			}
+		}
+	}
+	if len(targets) == 0 {
+		targets = all
+	}
+	if len(targets) == 0 {
+		log.Infof(ctx, "no stacks are related to the health event %s", event.EventArn)
+		return nil
+	}
+
+	for _, cluster := range targets {
+		exist, err := u.repo.GetByEventArn(ctx, event.EventArn, cluster.ID)
+		if err == nil {
+			if exist.StatusCode != event.StatusCode {
+				if err := u.repo.UpdateStatus(ctx, exist.ID, event.StatusCode, event.EndTime); err != nil {
+					log.Error(ctx, err)
+				}
+			}
+			continue
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error(ctx, err)
+			continue
+		}
+
+		rawData, err := json.Marshal(event)
+		if err != nil {
+			rawData = []byte{}
+		}
+		systemNotificationId, err := u.systemNotificationRepo.Create(ctx, model.SystemNotification{
+			OrganizationId:        cloudAccount.OrganizationId,
+			Name:                  CLOUD_HEALTH_NOTIFICATION_PREFIX + strings.ToLower(event.EventTypeCode),
+			NotificationType:      "SYSTEM_NOTIFICATION",
+			Severity:              healthEventSeverity(event.EventTypeCategory),
+			ClusterId:             cluster.ID,
+			MessageTitle:          fmt.Sprintf("[%s] %s (%s)", event.Service, event.EventTypeCode, event.Region),
+			MessageContent:        event.Description,
+			MessageActionProposal: fmt.Sprintf("클라우드 계정 [%s]의 AWS Health Dashboard 에서 이벤트를 확인하세요.", cloudAccount.Name),
+			Summary:               event.EventArn,
+			RawData:               rawData,
+		})
+		if err != nil {
+			log.Error(ctx, "Failed to create systemNotification ", err)
+			continue
+		}
+
+		event.ClusterId = cluster.ID
+		event.SystemNotificationId = &systemNotificationId
+		if _, err := u.repo.Create(ctx, event); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	return nil
+}
+
+func (u *CloudHealthEventUsecase) getCloudAccount(ctx context.Context, organizationId string, awsAccountId string) (model.CloudAccount, error) {
+	cloudAccounts, err := u.cloudAccountRepo.Fetch(ctx, organizationId, nil)
+	if err != nil {
+		return model.CloudAccount{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	for _, cloudAccount := range cloudAccounts {
+		if cloudAccount.AwsAccountId == awsAccountId {
+			return cloudAccount, nil
+		}
+	}
+	return model.CloudAccount{}, httpErrors.NewError(fmt.Errorf("not found cloud account of aws account %s", awsAccountId), "CHE_NOT_FOUND_CLOUD_ACCOUNT")
+}
+
+func awsConfigOf(ctx context.Context, cloudAccount model.CloudAccount) (aws.Config, error) {
+	awsAccessKeyId, awsSecretAccessKey, err := kubernetes.GetAwsSecret(ctx)
+	if err != nil || awsAccessKeyId == "" || awsSecretAccessKey == "" {
+		return aws.Config{}, fmt.Errorf("Invalid aws secret.")
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx,
+		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
+			Value: aws.Credentials{
+				AccessKeyID: awsAccessKeyId, SecretAccessKey: awsSecretAccessKey,
+			},
+		}))
+	if err != nil {
+		return aws.Config{}, err
+	}
+
+	if !strings.Contains(cloudAccount.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
+		stsSvc := sts.NewFromConfig(cfg)
+		creds := stscreds.NewAssumeRoleProvider(stsSvc, "arn:aws:iam::"+cloudAccount.AwsAccountId+":role/controllers.cluster-api-provider-aws.sigs.k8s.io")
+		cfg.Credentials = aws.NewCredentialsCache(creds)
+	}
+	return cfg, nil
+}
+
+func healthEventSeverity(category string) string {
+	if category == "issue" {
+		return "critical"
+	}
+	return "warning"
+}
+
+func parseHealthTime(s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+	// EventBridge 는 "Thu, 01 Dec 2022 00:00:00 GMT" 형식을 사용한다.
+	for _, layout := range []string{time.RFC1123, time.RFC3339} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+func epochToTime(sec float64) *time.Time {
+	if sec == 0 {
+		return nil
+	}
+	t := time.Unix(int64(sec), 0)
+	return &t
+}
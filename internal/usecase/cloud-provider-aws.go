@@ -0,0 +1,366 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+var awsAccountIdPattern = regexp.MustCompile(`^[0-9]{12}$`)
+
+type awsCloudProvider struct{}
+
+// ValidateAccount 는 이전 요청처럼 AwsAccountId 만 입력한 경우에도 AccountId 로 옮겨 처리한다.
+// AWS 계정 식별자는 스택과 비용 조회에서 계속 AwsAccountId 로 사용하므로 두 항목을 같은 값으로 유지한다.
+func (p awsCloudProvider) ValidateAccount(dto *model.CloudAccount) error {
+	if dto.AccountId == "" {
+		dto.AccountId = dto.AwsAccountId
+	}
+	if !awsAccountIdPattern.MatchString(dto.AccountId) {
+		return fmt.Errorf("aws account id must be 12 digits")
+	}
+	if dto.AwsAccountId != "" && dto.AwsAccountId != dto.AccountId {
+		return fmt.Errorf("awsAccountId and accountId are different")
+	}
+	dto.AwsAccountId = dto.AccountId
+
+	if strings.Contains(dto.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
+		return nil
+	}
+	if len(dto.AccessKeyId) < 16 || len(dto.SecretAccessKey) < 16 {
+		return fmt.Errorf("accessKeyId and secretAccessKey are required")
+	}
+	return nil
+}
+
+func (p awsCloudProvider) CreateWorkflow(dto model.CloudAccount) (string, []string) {
+	return "tks-create-aws-cloud-account", []string{
+		"aws_region=" + "ap-northeast-2",
+		"tks_cloud_account_id=" + dto.ID.String(),
+		"aws_account_id=" + dto.AwsAccountId,
+		"aws_access_key_id=" + dto.AccessKeyId,
+		"aws_secret_access_key=" + dto.SecretAccessKey,
+		"aws_session_token=" + dto.SessionToken,
+	}
+}
+
+func (p awsCloudProvider) DeleteWorkflow(cloudAccount model.CloudAccount, dto model.CloudAccount) (string, []string, error) {
+	if dto.AccessKeyId == "" || dto.SecretAccessKey == "" {
+		return "", nil, fmt.Errorf("accessKeyId and secretAccessKey are required to delete aws cloud account")
+	}
+	return "tks-delete-aws-cloud-account", []string{
+		"aws_region=" + "ap-northeast-2",
+		"tks_cloud_account_id=" + cloudAccount.ID.String(),
+		"aws_account_id=" + cloudAccount.AwsAccountId,
+		"aws_access_key_id=" + dto.AccessKeyId,
+		"aws_secret_access_key=" + dto.SecretAccessKey,
+		"aws_session_token=" + dto.SessionToken,
+	}, nil
+}
+
+func (p awsCloudProvider) GetResourceQuota(ctx context.Context, cloudAccount model.CloudAccount) (available bool, out domain.ResourceQuota, err error) {
+	awsAccessKeyId, awsSecretAccessKey, _ := kubernetes.GetAwsSecret(ctx)
+	if err != nil || awsAccessKeyId == "" || awsSecretAccessKey == "" {
+		log.Error(ctx, err)
+		return false, out, httpErrors.NewError(fmt.Errorf("Invalid aws secret."), "C_INTERNAL_ERROR")
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx,
+		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
+			Value: aws.Credentials{
+				AccessKeyID: awsAccessKeyId, SecretAccessKey: awsSecretAccessKey,
+			},
+		}))
+	if err != nil {
+		log.Error(ctx, err)
+	}
+
+	stsSvc := sts.NewFromConfig(cfg)
+
+	if !strings.Contains(cloudAccount.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
+		log.Info(ctx, "Use assume role. awsAccountId : ", cloudAccount.AwsAccountId)
+		creds := stscreds.NewAssumeRoleProvider(stsSvc, "arn:aws:iam::"+cloudAccount.AwsAccountId+":role/controllers.cluster-api-provider-aws.sigs.k8s.io")
+		cfg.Credentials = aws.NewCredentialsCache(creds)
+	}
+	client := servicequotas.NewFromConfig(cfg)
+
+	quotaMap := map[string]string{
+		"L-69A177A2": "elasticloadbalancing", // NLB
+		"L-E9E9831D": "elasticloadbalancing", // Classic
+		"L-A4707A72": "vpc",                  // IGW
+		"L-1194D53C": "eks",                  // Cluster
+		"L-0263D0A3": "ec2",                  // Elastic IP
+	}
+
+	// current usage
+	type CurrentUsage struct {
+		NLB     int
+		CLB     int
+		IGW     int
+		Cluster int
+		EIP     int
+	}
+
+	out.Quotas = make([]domain.ResourceQuotaAttr, 0)
+
+	// get current usage
+	currentUsage := CurrentUsage{}
+	{
+		c := elasticloadbalancingv2.NewFromConfig(cfg)
+		pageSize := int32(100)
+		res, err := c.DescribeLoadBalancers(ctx, &elasticloadbalancingv2.DescribeLoadBalancersInput{
+			PageSize: &pageSize,
+		}, func(o *elasticloadbalancingv2.Options) {
+			o.Region = "ap-northeast-2"
+		})
+		if err != nil {
+			return false, out, err
+		}
+
+		for _, elb := range res.LoadBalancers {
+			switch elb.Type {
+			case "network":
+				currentUsage.NLB += 1
+			}
+		}
+	}
+
+	{
+		c := elasticloadbalancing.NewFromConfig(cfg)
+		pageSize := int32(100)
+		res, err := c.DescribeLoadBalancers(ctx, &elasticloadbalancing.DescribeLoadBalancersInput{
+			PageSize: &pageSize,
+		}, func(o *elasticloadbalancing.Options) {
+			o.Region = "ap-northeast-2"
+		})
+		if err != nil {
+			return false, out, err
+		}
+		currentUsage.CLB = len(res.LoadBalancerDescriptions)
+	}
+
+	{
+		c := ec2.NewFromConfig(cfg)
+		res, err := c.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{}, func(o *ec2.Options) {
+			o.Region = "ap-northeast-2"
+		})
+		if err != nil {
+			return false, out, err
+		}
+		currentUsage.IGW = len(res.InternetGateways)
+	}
+
+	{
+		c := eks.NewFromConfig(cfg)
+		res, err := c.ListClusters(ctx, &eks.ListClustersInput{}, func(o *eks.Options) {
+			o.Region = "ap-northeast-2"
+		})
+		if err != nil {
+			return false, out, err
+		}
+		currentUsage.Cluster = len(res.Clusters)
+	}
+
+	{
+		c := ec2.NewFromConfig(cfg)
+		res, err := c.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{}, func(o *ec2.Options) {
+			o.Region = "ap-northeast-2"
+		})
+		if err != nil {
+			log.Error(ctx, err)
+			return false, out, err
+		}
+		currentUsage.EIP = len(res.Addresses)
+	}
+
+	for key, val := range quotaMap {
+		res, err := getServiceQuota(client, key, val)
+		if err != nil {
+			return false, out, err
+		}
+		log.Debugf(ctx, "%s %s %v", *res.Quota.QuotaName, *res.Quota.QuotaCode, *res.Quota.Value)
+
+		quotaValue := int(*res.Quota.Value)
+
+		// stack 1개 생성하는데 필요한 quota
+		// Classic 1
+		// Network 5
+		// IGW 1
+		// EIP 3
+		// Cluster 1
+		switch key {
+		case "L-69A177A2": // NLB
+			log.Infof(ctx, "NLB : usage %d, quota %d", currentUsage.NLB, quotaValue)
+			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
+				Type:     "NLB",
+				Usage:    currentUsage.NLB,
+				Quota:    quotaValue,
+				Required: 5,
+			})
+			if quotaValue >= currentUsage.NLB+5 {
+				available = true
+			}
+		case "L-E9E9831D": // Classic
+			log.Infof(ctx, "CLB : usage %d, quota %d", currentUsage.CLB, quotaValue)
+			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
+				Type:     "CLB",
+				Usage:    currentUsage.CLB,
+				Quota:    quotaValue,
+				Required: 1,
+			})
+			if quotaValue >= currentUsage.CLB+1 {
+				available = true
+			}
+		case "L-A4707A72": // IGW
+			log.Infof(ctx, "IGW : usage %d, quota %d", currentUsage.IGW, quotaValue)
+			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
+				Type:     "IGW",
+				Usage:    currentUsage.IGW,
+				Quota:    quotaValue,
+				Required: 1,
+			})
+			if quotaValue >= currentUsage.IGW+1 {
+				available = true
+			}
+		case "L-1194D53C": // Cluster
+			log.Infof(ctx, "Cluster : usage %d, quota %d", currentUsage.Cluster, quotaValue)
+			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
+				Type:     "EKS",
+				Usage:    currentUsage.Cluster,
+				Quota:    quotaValue,
+				Required: 1,
+			})
+			if quotaValue >= currentUsage.Cluster+1 {
+				available = true
+			}
+		case "L-0263D0A3": // Elastic IP
+			log.Infof(ctx, "Elastic IP : usage %d, quota %d", currentUsage.EIP, quotaValue)
+			out.Quotas = append(out.Quotas, domain.ResourceQuotaAttr{
+				Type:     "EIP",
+				Usage:    currentUsage.EIP,
+				Quota:    quotaValue,
+				Required: 3,
+			})
+			if quotaValue >= currentUsage.EIP+3 {
+				available = true
+			}
+		}
+
+	}
+
+	//return fmt.Errorf("Always return err")
+	return available, out, nil
+}
+
+func getServiceQuota(client *servicequotas.Client, quotaCode string, serviceCode string) (res *servicequotas.GetServiceQuotaOutput, err error) {
+	res, err = client.GetServiceQuota(context.TODO(), &servicequotas.GetServiceQuotaInput{
+		QuotaCode:   &quotaCode,
+		ServiceCode: &serviceCode,
+	}, func(o *servicequotas.Options) {
+		o.Region = "ap-northeast-2"
+	})
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// 스택 하나가 만드는 VPC 와 AZ 마다 NAT gateway 에 붙는 Elastic IP 의 수
+const (
+	stackRequiredVpcs = 1
+	stackRequiredEips = domain.MAX_AZ_NUM
+)
+
+// CheckStackCapacity 는 region 의 VPC, Elastic IP, On-Demand 표준 instance vCPU quota 로 요청한 스택을 만들 수 있는지 확인한다.
+// vCPU 사용량은 실행 중인 모든 instance 의 vCPU 를 더한 값이므로 표준 외 instance family 가 많으면 실제보다 크게 계산된다.
+func (p awsCloudProvider) CheckStackCapacity(ctx context.Context, cloudAccount model.CloudAccount, region string, requested domain.OrganizationQuotaUsage) ([]string, error) {
+	cfg, err := awsConfigOf(ctx, cloudAccount)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Region = region
+
+	quotas := servicequotas.NewFromConfig(cfg)
+	quotaValue := func(serviceCode string, quotaCode string) (int, error) {
+		res, err := quotas.GetServiceQuota(ctx, &servicequotas.GetServiceQuotaInput{
+			ServiceCode: aws.String(serviceCode),
+			QuotaCode:   aws.String(quotaCode),
+		})
+		if err != nil {
+			return 0, err
+		}
+		return int(aws.ToFloat64(res.Quota.Value)), nil
+	}
+
+	client := ec2.NewFromConfig(cfg)
+	vpcs, err := client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{})
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{})
+	if err != nil {
+		return nil, err
+	}
+	usedCpu := 0
+	paginator := ec2.NewDescribeInstancesPaginator(client, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("instance-state-name"), Values: []string{"pending", "running"}},
+		},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.CpuOptions != nil {
+					usedCpu += int(aws.ToInt32(instance.CpuOptions.CoreCount) * aws.ToInt32(instance.CpuOptions.ThreadsPerCore))
+				}
+			}
+		}
+	}
+
+	vpcQuota, err := quotaValue("vpc", "L-F678F1CE")
+	if err != nil {
+		return nil, err
+	}
+	eipQuota, err := quotaValue("ec2", "L-0263D0A3")
+	if err != nil {
+		return nil, err
+	}
+	cpuQuota, err := quotaValue("ec2", "L-1216C47A")
+	if err != nil {
+		return nil, err
+	}
+
+	var shortages []string
+	for _, check := range []string{
+		quotaCheck("vpc", vpcQuota, len(vpcs.Vpcs), stackRequiredVpcs),
+		quotaCheck("elastic ip", eipQuota, len(addresses.Addresses), stackRequiredEips),
+		quotaCheck("on-demand vCPU", cpuQuota, usedCpu, requested.Cpu),
+	} {
+		if check != "" {
+			shortages = append(shortages, check)
+		}
+	}
+	return shortages, nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+type azureCloudProvider struct{}
+
+// ValidateAccount 는 AccountId 를 Azure 구독 ID 로, Azure 의 tenantId, clientId 를 service principal 로 확인한다.
+func (p azureCloudProvider) ValidateAccount(dto *model.CloudAccount) error {
+	if _, err := uuid.Parse(dto.AccountId); err != nil {
+		return fmt.Errorf("azure subscription id must be uuid")
+	}
+	if dto.Azure == nil {
+		return fmt.Errorf("azure credential is required")
+	}
+	if _, err := uuid.Parse(dto.Azure.TenantId); err != nil {
+		return fmt.Errorf("azure tenant id must be uuid")
+	}
+	if _, err := uuid.Parse(dto.Azure.ClientId); err != nil {
+		return fmt.Errorf("azure client id must be uuid")
+	}
+	if dto.Azure.ClientSecret == "" {
+		return fmt.Errorf("azure client secret is required")
+	}
+	return nil
+}
+
+func (p azureCloudProvider) CreateWorkflow(dto model.CloudAccount) (string, []string) {
+	return "tks-create-azure-cloud-account", []string{
+		"tks_cloud_account_id=" + dto.ID.String(),
+		"azure_subscription_id=" + dto.AccountId,
+		"azure_tenant_id=" + dto.Azure.TenantId,
+		"azure_client_id=" + dto.Azure.ClientId,
+		"azure_client_secret=" + dto.Azure.ClientSecret,
+	}
+}
+
+func (p azureCloudProvider) DeleteWorkflow(cloudAccount model.CloudAccount, dto model.CloudAccount) (string, []string, error) {
+	return "tks-delete-azure-cloud-account", []string{
+		"tks_cloud_account_id=" + cloudAccount.ID.String(),
+		"azure_subscription_id=" + cloudAccount.AccountId,
+	}, nil
+}
+
+func (p azureCloudProvider) GetResourceQuota(ctx context.Context, cloudAccount model.CloudAccount) (bool, domain.ResourceQuota, error) {
+	return unsupportedResourceQuota(cloudAccount.CloudService)
+}
+
+func (p azureCloudProvider) CheckStackCapacity(ctx context.Context, cloudAccount model.CloudAccount, region string, requested domain.OrganizationQuotaUsage) ([]string, error) {
+	return nil, nil
+}
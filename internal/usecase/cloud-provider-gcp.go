@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+var gcpProjectIdPattern = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+
+type gcpCloudProvider struct{}
+
+// ValidateAccount 는 AccountId 를 GCP 프로젝트 ID 로 확인하고, service account key 가 같은 프로젝트의 것인지 확인한다.
+func (p gcpCloudProvider) ValidateAccount(dto *model.CloudAccount) error {
+	if !gcpProjectIdPattern.MatchString(dto.AccountId) {
+		return fmt.Errorf("invalid gcp project id %s", dto.AccountId)
+	}
+	if dto.Gcp == nil || dto.Gcp.ServiceAccountKey == "" {
+		return fmt.Errorf("gcp service account key is required")
+	}
+
+	var key struct {
+		Type        string `json:"type"`
+		ProjectId   string `json:"project_id"`
+		PrivateKey  string `json:"private_key"`
+		ClientEmail string `json:"client_email"`
+	}
+	if err := json.Unmarshal([]byte(dto.Gcp.ServiceAccountKey), &key); err != nil {
+		return fmt.Errorf("gcp service account key must be json")
+	}
+	if key.Type != "service_account" || key.PrivateKey == "" || key.ClientEmail == "" {
+		return fmt.Errorf("invalid gcp service account key")
+	}
+	if key.ProjectId != dto.AccountId {
+		return fmt.Errorf("gcp service account key belongs to project %s", key.ProjectId)
+	}
+	return nil
+}
+
+// CreateWorkflow 는 여러 줄의 service account key 를 workflow parameter 로 전달할 수 있도록 base64 로 인코딩한다.
+func (p gcpCloudProvider) CreateWorkflow(dto model.CloudAccount) (string, []string) {
+	return "tks-create-gcp-cloud-account", []string{
+		"tks_cloud_account_id=" + dto.ID.String(),
+		"gcp_project_id=" + dto.AccountId,
+		"gcp_service_account_key=" + base64.StdEncoding.EncodeToString([]byte(dto.Gcp.ServiceAccountKey)),
+	}
+}
+
+func (p gcpCloudProvider) DeleteWorkflow(cloudAccount model.CloudAccount, dto model.CloudAccount) (string, []string, error) {
+	return "tks-delete-gcp-cloud-account", []string{
+		"tks_cloud_account_id=" + cloudAccount.ID.String(),
+		"gcp_project_id=" + cloudAccount.AccountId,
+	}, nil
+}
+
+func (p gcpCloudProvider) GetResourceQuota(ctx context.Context, cloudAccount model.CloudAccount) (bool, domain.ResourceQuota, error) {
+	return unsupportedResourceQuota(cloudAccount.CloudService)
+}
+
+func (p gcpCloudProvider) CheckStackCapacity(ctx context.Context, cloudAccount model.CloudAccount, region string, requested domain.OrganizationQuotaUsage) ([]string, error) {
+	return nil, nil
+}
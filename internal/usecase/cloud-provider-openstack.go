@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+type openstackCloudProvider struct{}
+
+// ValidateAccount 는 AccountId 를 Keystone 프로젝트 ID 로, Openstack 의 authUrl 과 사용자 정보를 Keystone 인증 정보로 확인한다.
+func (p openstackCloudProvider) ValidateAccount(dto *model.CloudAccount) error {
+	if dto.AccountId == "" {
+		return fmt.Errorf("openstack project id is required")
+	}
+	if dto.Openstack == nil {
+		return fmt.Errorf("openstack credential is required")
+	}
+	u, err := url.Parse(dto.Openstack.AuthUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid openstack auth url %s", dto.Openstack.AuthUrl)
+	}
+	if dto.Openstack.Username == "" || dto.Openstack.Password == "" {
+		return fmt.Errorf("openstack username and password are required")
+	}
+	if dto.Openstack.UserDomainName == "" {
+		dto.Openstack.UserDomainName = "Default"
+	}
+	return nil
+}
+
+func (p openstackCloudProvider) CreateWorkflow(dto model.CloudAccount) (string, []string) {
+	return "tks-create-openstack-cloud-account", []string{
+		"tks_cloud_account_id=" + dto.ID.String(),
+		"openstack_project_id=" + dto.AccountId,
+		"openstack_auth_url=" + dto.Openstack.AuthUrl,
+		"openstack_region=" + dto.Openstack.Region,
+		"openstack_user_domain_name=" + dto.Openstack.UserDomainName,
+		"openstack_username=" + dto.Openstack.Username,
+		"openstack_password=" + dto.Openstack.Password,
+	}
+}
+
+func (p openstackCloudProvider) DeleteWorkflow(cloudAccount model.CloudAccount, dto model.CloudAccount) (string, []string, error) {
+	return "tks-delete-openstack-cloud-account", []string{
+		"tks_cloud_account_id=" + cloudAccount.ID.String(),
+		"openstack_project_id=" + cloudAccount.AccountId,
+	}, nil
+}
+
+func (p openstackCloudProvider) GetResourceQuota(ctx context.Context, cloudAccount model.CloudAccount) (bool, domain.ResourceQuota, error) {
+	return unsupportedResourceQuota(cloudAccount.CloudService)
+}
+
+func (p openstackCloudProvider) CheckStackCapacity(ctx context.Context, cloudAccount model.CloudAccount, region string, requested domain.OrganizationQuotaUsage) ([]string, error) {
+	return nil, nil
+}
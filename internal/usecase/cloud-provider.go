@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// cloudProvider 는 클라우드 서비스마다 다른 클라우드 계정의 식별자와 자격증명 형식, 계정을 준비하고 정리하는 workflow,
+// 자원 quota 조회를 담당한다. 자격증명은 DB 에 저장하지 않고 workflow parameter 로만 전달한다.
+// AWS 외의 provider 는 준비 workflow 가 자격증명을 admin cluster 의 secret 으로 보관하고, 정리 workflow 가 secret 을 지운다.
+type cloudProvider interface {
+	// ValidateAccount 는 계정 식별자와 자격증명이 provider 의 형식에 맞는지 확인하고 필요한 항목을 정규화한다.
+	ValidateAccount(dto *model.CloudAccount) error
+	// CreateWorkflow 는 계정을 준비하는 workflow template 과 parameter 이다. 준비할 것이 없으면 빈 이름을 반환한다.
+	CreateWorkflow(dto model.CloudAccount) (templateName string, parameters []string)
+	// DeleteWorkflow 는 계정을 정리하는 workflow template 과 parameter 이다. 정리할 것이 없으면 빈 이름을 반환한다.
+	DeleteWorkflow(cloudAccount model.CloudAccount, dto model.CloudAccount) (templateName string, parameters []string, err error)
+	GetResourceQuota(ctx context.Context, cloudAccount model.CloudAccount) (available bool, out domain.ResourceQuota, err error)
+	// CheckStackCapacity 는 region 에 요청한 스택을 만들 만큼 quota 가 남아 있는지 확인하고 부족한 항목을 설명하는 문장을 반환한다.
+	CheckStackCapacity(ctx context.Context, cloudAccount model.CloudAccount, region string, requested domain.OrganizationQuotaUsage) (shortages []string, err error)
+}
+
+func cloudProviderOf(cloudService string) (cloudProvider, error) {
+	switch cloudService {
+	case domain.CloudService_AWS:
+		return awsCloudProvider{}, nil
+	case domain.CloudService_AZURE:
+		return azureCloudProvider{}, nil
+	case domain.CloudService_GCP:
+		return gcpCloudProvider{}, nil
+	case domain.CloudService_OPENSTACK:
+		return openstackCloudProvider{}, nil
+	default:
+		return nil, httpErrors.NewBadRequestError(fmt.Errorf("unsupported cloud service %s", cloudService), "CA_UNSUPPORTED_CLOUD_SERVICE", "")
+	}
+}
+
+// checkCloudResourceQuota 는 스택 생성 workflow 를 제출하기 전에 클라우드 계정의 quota 로 요청한 스택을 만들 수 있는지 확인한다.
+// workflow 가 한참 뒤에 실패하는 대신 부족한 항목을 바로 알려 주기 위한 것이므로 quota 를 조회하지 못하면 생성을 막지 않는다.
+func checkCloudResourceQuota(ctx context.Context, cloudAccount model.CloudAccount, region string, requested domain.OrganizationQuotaUsage) error {
+	provider, err := cloudProviderOf(cloudAccount.CloudService)
+	if err != nil {
+		return err
+	}
+	if region == "" {
+		region = "ap-northeast-2"
+	}
+
+	shortages, err := provider.CheckStackCapacity(ctx, cloudAccount, region, requested)
+	if err != nil {
+		log.Warnf(ctx, "failed to check resource quota of cloud account %s. err: %s", cloudAccount.ID, err)
+		return nil
+	}
+	if len(shortages) > 0 {
+		return httpErrors.NewError(fmt.Errorf("not enough quota of cloud account %s in region %s: %s", cloudAccount.Name, region, strings.Join(shortages, ", ")), "S_NOT_ENOUGH_QUOTA")
+	}
+	return nil
+}
+
+// AWS 외의 provider 는 자격증명을 저장하지 않아 API 서버가 직접 조회할 수 없으므로 자원 quota 조회를 지원하지 않는다.
+// 스택 생성 전 확인도 같은 이유로 건너뛰고 workflow 의 결과에 맡긴다.
+func unsupportedResourceQuota(cloudService string) (bool, domain.ResourceQuota, error) {
+	return false, domain.ResourceQuota{}, httpErrors.NewBadRequestError(fmt.Errorf("resource quota of %s cloud account is not supported", cloudService), "CA_NOT_SUPPORTED_RESOURCE_QUOTA", "")
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/user"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+type IClusterAccessUsecase interface {
+	Get(ctx context.Context, organizationId string, accessRequestId uuid.UUID) (model.ClusterAccessRequest, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.ClusterAccessRequest, error)
+	Create(ctx context.Context, dto model.ClusterAccessRequest) (accessRequestId uuid.UUID, err error)
+	Approve(ctx context.Context, organizationId string, accessRequestId uuid.UUID, ttl time.Duration) (model.ClusterAccessRequest, error)
+	Reject(ctx context.Context, organizationId string, accessRequestId uuid.UUID, reason string) (model.ClusterAccessRequest, error)
+	Revoke(ctx context.Context, organizationId string, accessRequestId uuid.UUID) (model.ClusterAccessRequest, error)
+	ExpireGrants(ctx context.Context) error
+}
+
+type ClusterAccessUsecase struct {
+	repo        repository.IClusterAccessRequestRepository
+	clusterRepo repository.IClusterRepository
+	auditRepo   repository.IAuditRepository
+}
+
+func NewClusterAccessUsecase(r repository.Repository) IClusterAccessUsecase {
+	return &ClusterAccessUsecase{
+		repo:        r.ClusterAccessRequest,
+		clusterRepo: r.Cluster,
+		auditRepo:   r.Audit,
+	}
+}
+
+func (u *ClusterAccessUsecase) Get(ctx context.Context, organizationId string, accessRequestId uuid.UUID) (out model.ClusterAccessRequest, err error) {
+	out, err = u.repo.Get(ctx, accessRequestId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewError(err, "CA_NOT_FOUND_ACCESS_REQUEST")
+		}
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if out.OrganizationId != organizationId {
+		return model.ClusterAccessRequest{}, httpErrors.NewError(fmt.Errorf("not found access request in organization"), "CA_NOT_FOUND_ACCESS_REQUEST")
+	}
+	return
+}
+
+func (u *ClusterAccessUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.ClusterAccessRequest, error) {
+	accessRequests, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return accessRequests, nil
+}
+
+func (u *ClusterAccessUsecase) Create(ctx context.Context, dto model.ClusterAccessRequest) (accessRequestId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	userId := user.GetUserId()
+	dto.RequesterId = &userId
+
+	if dto.AccessType == domain.ClusterAccessType_ERROR {
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("invalid access type"), "CA_INVALID_ACCESS_TYPE")
+	}
+
+	cluster, err := u.clusterRepo.Get(ctx, dto.ClusterId)
+	if err != nil || cluster.OrganizationId != dto.OrganizationId {
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("not found cluster %s", dto.ClusterId), "CA_NOT_FOUND_CLUSTER")
+	}
+	if cluster.Status != domain.ClusterStatus_RUNNING {
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("cluster is not running"), "CA_INVALID_CLUSTER_STATUS")
+	}
+
+	accessRequestId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return accessRequestId, nil
+}
+
+func (u *ClusterAccessUsecase) Approve(ctx context.Context, organizationId string, accessRequestId uuid.UUID, ttl time.Duration) (out model.ClusterAccessRequest, err error) {
+	requestUser, ok := request.UserFrom(ctx)
+	if !ok {
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	if err = checkAccessApprover(requestUser, organizationId); err != nil {
+		return out, err
+	}
+	approverId := requestUser.GetUserId()
+
+	out, err = u.Get(ctx, organizationId, accessRequestId)
+	if err != nil {
+		return out, err
+	}
+	if out.Status != domain.ClusterAccessRequestStatus_PENDING {
+		return out, httpErrors.NewError(fmt.Errorf("access request is not pending"), "CA_INVALID_ACCESS_REQUEST_STATUS")
+	}
+	if out.RequesterId != nil && *out.RequesterId == approverId {
+		return out, httpErrors.NewError(fmt.Errorf("requester can not approve own request"), "CA_SELF_APPROVAL")
+	}
+
+	if ttl <= 0 {
+		return out, httpErrors.NewError(fmt.Errorf("invalid ttl %s", ttl), "CA_INVALID_TTL")
+	}
+	if maxTtl := viper.GetDuration("cluster-access-max-ttl"); maxTtl > 0 && ttl > maxTtl {
+		return out, httpErrors.NewError(fmt.Errorf("ttl %s exceeds max ttl %s", ttl, maxTtl), "CA_INVALID_TTL")
+	}
+
+	kubeconfig, err := kubernetes.GetKubeConfig(ctx, string(out.ClusterId), kubernetes.KubeconfigForAdmin)
+	if err != nil {
+		return out, httpErrors.NewError(errors.Wrap(err, "Failed to get kubeconfig"), "C_INTERNAL_ERROR")
+	}
+	if err = kubernetes.EnsureDelegatedAccessBinding(ctx, kubeconfig, accessBindingName(out.ID), out.Requester.AccountId, accessClusterRole(out.AccessType)); err != nil {
+		return out, httpErrors.NewError(errors.Wrap(err, "Failed to grant access"), "CA_FAILED_GRANT_ACCESS")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	out.Status = domain.ClusterAccessRequestStatus_APPROVED
+	out.TtlSeconds = int(ttl.Seconds())
+	out.ApproverId = &approverId
+	out.ApprovedAt = &now
+	out.ExpiresAt = &expiresAt
+	if err = u.repo.Update(ctx, out); err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	return u.repo.Get(ctx, accessRequestId)
+}
+
+func (u *ClusterAccessUsecase) Reject(ctx context.Context, organizationId string, accessRequestId uuid.UUID, reason string) (out model.ClusterAccessRequest, err error) {
+	requestUser, ok := request.UserFrom(ctx)
+	if !ok {
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	if err = checkAccessApprover(requestUser, organizationId); err != nil {
+		return out, err
+	}
+	approverId := requestUser.GetUserId()
+
+	out, err = u.Get(ctx, organizationId, accessRequestId)
+	if err != nil {
+		return out, err
+	}
+	if out.Status != domain.ClusterAccessRequestStatus_PENDING {
+		return out, httpErrors.NewError(fmt.Errorf("access request is not pending"), "CA_INVALID_ACCESS_REQUEST_STATUS")
+	}
+
+	out.Status = domain.ClusterAccessRequestStatus_REJECTED
+	out.StatusDesc = reason
+	out.ApproverId = &approverId
+	if err = u.repo.Update(ctx, out); err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	return u.repo.Get(ctx, accessRequestId)
+}
+
+// checkAccessApprover 는 임시 접근 권한을 부여하는 승인과 거절을 조직 관리자에게만 허용한다.
+func checkAccessApprover(requestUser user.Info, organizationId string) error {
+	if requestUser.GetRoleOrganizationMapping()[organizationId] != user.AdminRole {
+		return httpErrors.NewError(fmt.Errorf("only organization admin can approve or reject access requests"), "CA_FORBIDDEN_APPROVAL")
+	}
+	return nil
+}
+
+func (u *ClusterAccessUsecase) Revoke(ctx context.Context, organizationId string, accessRequestId uuid.UUID) (out model.ClusterAccessRequest, err error) {
+	out, err = u.Get(ctx, organizationId, accessRequestId)
+	if err != nil {
+		return out, err
+	}
+	if out.Status != domain.ClusterAccessRequestStatus_APPROVED {
+		return out, httpErrors.NewError(fmt.Errorf("access request is not approved"), "CA_INVALID_ACCESS_REQUEST_STATUS")
+	}
+
+	if err = u.removeGrant(ctx, out); err != nil {
+		return out, httpErrors.NewError(err, "CA_FAILED_REVOKE_ACCESS")
+	}
+
+	now := time.Now()
+	out.Status = domain.ClusterAccessRequestStatus_REVOKED
+	out.ExpiresAt = &now
+	if err = u.repo.Update(ctx, out); err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	return u.repo.Get(ctx, accessRequestId)
+}
+
+// ExpireGrants 는 만료된 임시 접근 권한을 회수하고 감사 로그를 남긴다.
+func (u *ClusterAccessUsecase) ExpireGrants(ctx context.Context) error {
+	accessRequests, err := u.repo.FetchExpired(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, accessRequest := range accessRequests {
+		if err := u.removeGrant(ctx, accessRequest); err != nil {
+			// 다음 주기에 재시도한다.
+			log.Error(ctx, err)
+			continue
+		}
+
+		accessRequest.Status = domain.ClusterAccessRequestStatus_EXPIRED
+		accessRequest.StatusDesc = "expired"
+		if err := u.repo.Update(ctx, accessRequest); err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+
+		audit := model.Audit{
+			OrganizationId: accessRequest.OrganizationId,
+			Group:          "ClusterAccessRequest",
+			Message: fmt.Sprintf("클러스터 [%s]에 대한 [%s] 접근 권한이 만료되어 회수되었습니다.",
+				accessRequest.ClusterId, accessRequest.AccessType),
+			Description:   fmt.Sprintf("access request %s expired at %s", accessRequest.ID, accessRequest.ExpiresAt),
+			UserId:        accessRequest.RequesterId,
+			UserAccountId: accessRequest.Requester.AccountId,
+			UserName:      accessRequest.Requester.Name,
+		}
+		if _, err := u.auditRepo.Create(ctx, audit); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	return nil
+}
+
+func (u *ClusterAccessUsecase) removeGrant(ctx context.Context, accessRequest model.ClusterAccessRequest) error {
+	kubeconfig, err := kubernetes.GetKubeConfig(ctx, string(accessRequest.ClusterId), kubernetes.KubeconfigForAdmin)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get kubeconfig")
+	}
+	return kubernetes.RemoveDelegatedAccessBinding(ctx, kubeconfig, accessBindingName(accessRequest.ID))
+}
+
+func accessBindingName(accessRequestId uuid.UUID) string {
+	return "tks-access-" + accessRequestId.String()
+}
+
+func accessClusterRole(accessType domain.ClusterAccessType) string {
+	if accessType == domain.ClusterAccessType_KUBECONFIG {
+		return kubernetes.DelegatedAdminClusterRole
+	}
+	return kubernetes.DelegatedExecClusterRole
+}
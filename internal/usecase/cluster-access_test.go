@@ -0,0 +1,70 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/user"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/testing/memrepo"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+func withRequestUser(ctx context.Context, userId uuid.UUID, role string) context.Context {
+	return request.WithUser(ctx, &user.DefaultInfo{
+		UserId:                  userId,
+		OrganizationId:          testOrganizationId,
+		RoleOrganizationMapping: map[string]string{testOrganizationId: role},
+	})
+}
+
+func TestClusterAccessApproveRequiresOrganizationAdmin(t *testing.T) {
+	ctx := context.Background()
+	repo := memrepo.New()
+	u := usecase.NewClusterAccessUsecase(repo)
+
+	requesterId := uuid.New()
+	accessRequestId, err := repo.ClusterAccessRequest.Create(ctx, model.ClusterAccessRequest{
+		OrganizationId: testOrganizationId,
+		ClusterId:      "c1234test",
+		AccessType:     domain.ClusterAccessType_EXEC,
+		Status:         domain.ClusterAccessRequestStatus_PENDING,
+		RequesterId:    &requesterId,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memberCtx := withRequestUser(ctx, uuid.New(), testUserRole.Name)
+	var restErr httpErrors.IRestError
+	if _, err := u.Approve(memberCtx, testOrganizationId, accessRequestId, time.Hour); !errors.As(err, &restErr) || restErr.Status() != http.StatusForbidden {
+		t.Errorf("Approve() by member error = %v, want forbidden", err)
+	}
+	if _, err := u.Reject(memberCtx, testOrganizationId, accessRequestId, "no"); !errors.As(err, &restErr) || restErr.Status() != http.StatusForbidden {
+		t.Errorf("Reject() by member error = %v, want forbidden", err)
+	}
+
+	accessRequest, err := repo.ClusterAccessRequest.Get(ctx, accessRequestId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accessRequest.Status != domain.ClusterAccessRequestStatus_PENDING || accessRequest.ApproverId != nil {
+		t.Errorf("access request = %s approved by %v, want PENDING without approver", accessRequest.Status, accessRequest.ApproverId)
+	}
+
+	adminCtx := withRequestUser(ctx, uuid.New(), user.AdminRole)
+	if _, err := u.Reject(adminCtx, testOrganizationId, accessRequestId, "no"); err != nil {
+		t.Fatalf("Reject() by admin error = %v", err)
+	}
+	if accessRequest, _ = repo.ClusterAccessRequest.Get(ctx, accessRequestId); accessRequest.Status != domain.ClusterAccessRequestStatus_REJECTED {
+		t.Errorf("access request status = %s, want REJECTED", accessRequest.Status)
+	}
+}
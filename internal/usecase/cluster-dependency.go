@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/pkg/errors"
+)
+
+// primaryClusterDependents 는 조직의 primary cluster 를 사용하는 기능이다.
+var primaryClusterDependents = []string{
+	"dashboard(thanos)",
+	"system notification(grafana link)",
+	"alert routing",
+	"policy dashboard",
+}
+
+// checkPrimaryClusterDependencies 는 삭제하려는 cluster 가 조직의 primary cluster 이고 조직에 다른 cluster 가 남아 있으면 삭제를 막는다.
+// 조직의 마지막 cluster 는 primary cluster 를 옮길 곳이 없으므로 삭제할 수 있다.
+func (u *ClusterUsecase) checkPrimaryClusterDependencies(ctx context.Context, cluster model.Cluster) error {
+	organization, err := u.organizationRepo.Get(ctx, cluster.OrganizationId)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get organization")
+	}
+	if organization.PrimaryClusterId != cluster.ID.String() {
+		return nil
+	}
+
+	clusters, err := u.repo.FetchByOrganizationId(ctx, cluster.OrganizationId, uuid.Nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get clusters")
+	}
+
+	remains := false
+	candidates := []string{}
+	for _, cl := range clusters {
+		if cl.ID == cluster.ID {
+			continue
+		}
+		switch cl.Status {
+		case domain.ClusterStatus_RUNNING:
+			candidates = append(candidates, cl.ID.String())
+			remains = true
+		case domain.ClusterStatus_INSTALLING, domain.ClusterStatus_DELETING:
+			remains = true
+		}
+	}
+	if !remains {
+		return nil
+	}
+
+	message := fmt.Sprintf("cluster %s is the primary cluster of organization %s. dependent subsystems : %s.",
+		cluster.ID, cluster.OrganizationId, strings.Join(primaryClusterDependents, ", "))
+	if len(candidates) > 0 {
+		message += fmt.Sprintf(" reassign the primary cluster to one of [%s] before deleting.", strings.Join(candidates, ", "))
+	} else {
+		message += " wait until the other clusters are installed or deleted."
+	}
+	return httpErrors.NewError(fmt.Errorf("%s", message), "CL_PRIMARY_CLUSTER_IN_USE")
+}
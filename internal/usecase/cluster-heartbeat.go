@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+const (
+	CLUSTER_UNREACHABLE_NOTIFICATION_NAME = "cluster-unreachable"
+
+	defaultClusterHeartbeatThreshold = 5 * time.Minute
+	clusterPingTimeout               = 10 * time.Second
+)
+
+type IClusterHeartbeatUsecase interface {
+	Heartbeat(ctx context.Context, clusterId domain.ClusterId, input domain.CreateClusterHeartbeatRequest) error
+	Check(ctx context.Context) error
+}
+
+type ClusterHeartbeatUsecase struct {
+	repo                   repository.IClusterHeartbeatRepository
+	clusterRepo            repository.IClusterRepository
+	systemNotificationRepo repository.ISystemNotificationRepository
+}
+
+func NewClusterHeartbeatUsecase(r repository.Repository) IClusterHeartbeatUsecase {
+	return &ClusterHeartbeatUsecase{
+		repo:                   r.ClusterHeartbeat,
+		clusterRepo:            r.Cluster,
+		systemNotificationRepo: r.SystemNotification,
+	}
+}
+
+// Heartbeat 는 클러스터의 agent 가 보낸 heartbeat 를 ingestion token 의 조직 기준으로 기록한다.
+func (u *ClusterHeartbeatUsecase) Heartbeat(ctx context.Context, clusterId domain.ClusterId, input domain.CreateClusterHeartbeatRequest) error {
+	organizationId, ok := request.IngestionOrganizationFrom(ctx)
+	if !ok {
+		return httpErrors.NewError(fmt.Errorf("Invalid ingestion token"), "A_INVALID_TOKEN")
+	}
+
+	cluster, err := u.clusterRepo.Get(ctx, clusterId)
+	if err != nil || cluster.OrganizationId != organizationId {
+		return httpErrors.NewNotFoundError(fmt.Errorf("Not found cluster %s", clusterId), "C_INVALID_CLUSTER_ID", "")
+	}
+
+	heartbeat, err := u.get(ctx, cluster)
+	if err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	heartbeat.Source = domain.ClusterHeartbeatSource_AGENT
+	heartbeat.AgentVersion = input.AgentVersion
+	heartbeat.LastSeenAt = time.Now()
+	u.setReachable(ctx, &heartbeat)
+
+	if err := u.repo.Save(ctx, heartbeat); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// Check 는 RUNNING 상태인 클러스터의 마지막 heartbeat 를 확인한다.
+// agent 의 heartbeat 가 threshold 의 절반 이상 없으면 API server 를 직접 확인하고,
+// threshold 를 넘도록 연결되지 않은 클러스터는 UNREACHABLE 로 표시하고 알림을 생성한다.
+func (u *ClusterHeartbeatUsecase) Check(ctx context.Context) error {
+	threshold := viper.GetDuration("cluster-heartbeat-threshold")
+	if threshold <= 0 {
+		threshold = defaultClusterHeartbeatThreshold
+	}
+
+	clusters, err := u.clusterRepo.Fetch(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, cluster := range clusters {
+		if cluster.Status == domain.ClusterStatus_DELETED {
+			if err := u.repo.Delete(ctx, cluster.ID); err != nil {
+				log.Error(ctx, err)
+			}
+			continue
+		}
+		if cluster.Status != domain.ClusterStatus_RUNNING {
+			continue
+		}
+
+		heartbeat, err := u.get(ctx, cluster)
+		if err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+
+		if now.Sub(heartbeat.LastSeenAt) > threshold/2 {
+			if err := pingCluster(ctx, cluster.ID); err != nil {
+				log.Warnf(ctx, "failed to ping cluster %s. err : %s", cluster.ID, err)
+			} else {
+				heartbeat.Source = domain.ClusterHeartbeatSource_PING
+				heartbeat.LastSeenAt = now
+			}
+		}
+
+		if now.Sub(heartbeat.LastSeenAt) > threshold {
+			u.setUnreachable(ctx, cluster, &heartbeat)
+		} else {
+			u.setReachable(ctx, &heartbeat)
+		}
+
+		if err := u.repo.Save(ctx, heartbeat); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	return nil
+}
+
+// get 은 클러스터의 heartbeat 를 반환한다. 처음 확인하는 클러스터는 마지막 변경 시각부터 연결된 것으로 간주한다.
+func (u *ClusterHeartbeatUsecase) get(ctx context.Context, cluster model.Cluster) (model.ClusterHeartbeat, error) {
+	heartbeat, err := u.repo.Get(ctx, cluster.ID)
+	if err == nil {
+		return heartbeat, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return heartbeat, err
+	}
+	return model.ClusterHeartbeat{
+		ClusterId:      cluster.ID,
+		OrganizationId: cluster.OrganizationId,
+		LastSeenAt:     cluster.UpdatedAt,
+		Status:         domain.ClusterConnectivityStatus_REACHABLE,
+	}, nil
+}
+
+func (u *ClusterHeartbeatUsecase) setReachable(ctx context.Context, heartbeat *model.ClusterHeartbeat) {
+	if heartbeat.Status == domain.ClusterConnectivityStatus_UNREACHABLE {
+		log.Infof(ctx, "cluster %s is reachable again", heartbeat.ClusterId)
+	}
+	heartbeat.Status = domain.ClusterConnectivityStatus_REACHABLE
+	heartbeat.StatusDesc = ""
+	heartbeat.NotifiedAt = nil
+}
+
+// setUnreachable 은 UNREACHABLE 로 바뀌는 시점에 한 번만 알림을 생성한다.
+func (u *ClusterHeartbeatUsecase) setUnreachable(ctx context.Context, cluster model.Cluster, heartbeat *model.ClusterHeartbeat) {
+	heartbeat.Status = domain.ClusterConnectivityStatus_UNREACHABLE
+	heartbeat.StatusDesc = fmt.Sprintf("last seen at %s", heartbeat.LastSeenAt.Format(time.RFC3339))
+	if heartbeat.NotifiedAt != nil {
+		return
+	}
+
+	_, err := u.systemNotificationRepo.Create(ctx, model.SystemNotification{
+		OrganizationId:        cluster.OrganizationId,
+		Name:                  CLUSTER_UNREACHABLE_NOTIFICATION_NAME,
+		NotificationType:      "SYSTEM_NOTIFICATION",
+		Severity:              "critical",
+		ClusterId:             cluster.ID,
+		MessageTitle:          fmt.Sprintf("클러스터 [%s]에 연결할 수 없습니다.", cluster.Name),
+		MessageContent:        fmt.Sprintf("클러스터 [%s]의 마지막 연결 확인 시각은 %s 입니다.", cluster.Name, heartbeat.LastSeenAt.Format(time.RFC3339)),
+		MessageActionProposal: "클러스터의 API server 와 네트워크 상태, agent 동작 여부를 확인하세요.",
+		Summary:               heartbeat.StatusDesc,
+	})
+	if err != nil {
+		log.Error(ctx, "Failed to create systemNotification ", err)
+		return
+	}
+	now := time.Now()
+	heartbeat.NotifiedAt = &now
+}
+
+func pingCluster(ctx context.Context, clusterId domain.ClusterId) error {
+	clientset, err := kubernetes.GetClientFromClusterId(ctx, clusterId.String())
+	if err != nil {
+		return err
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, clusterPingTimeout)
+	defer cancel()
+	return clientset.Discovery().RESTClient().Get().AbsPath("/readyz").Do(pingCtx).Error()
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/user"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const (
+	defaultKubeconfigTTL = time.Hour
+	minKubeconfigTTL     = 10 * time.Minute
+	maxKubeconfigTTL     = 24 * time.Hour
+)
+
+// kubeconfigClusterRoles 는 kubeconfig role 에 바인딩할 kubernetes 기본 ClusterRole 이다.
+var kubeconfigClusterRoles = map[string]string{
+	domain.ClusterKubeconfigRole_VIEW:  "view",
+	domain.ClusterKubeconfigRole_ADMIN: "cluster-admin",
+}
+
+// GetShortLivedKubeconfig 는 장기 admin 인증서 대신 사용자별 serviceAccount 의 ttl 동안만 유효한 token 으로 kubeconfig 를 발급한다.
+// admin role 은 조직 관리자만 발급할 수 있다.
+func (u *ClusterUsecase) GetShortLivedKubeconfig(ctx context.Context, organizationId string, clusterId domain.ClusterId, role string, ttl time.Duration) (out domain.GetClusterKubeconfigResponse, err error) {
+	if role == "" {
+		role = domain.ClusterKubeconfigRole_VIEW
+	}
+	clusterRole, ok := kubeconfigClusterRoles[role]
+	if !ok {
+		return out, httpErrors.NewError(fmt.Errorf("invalid role %s", role), "CL_INVALID_KUBECONFIG_REQUEST")
+	}
+	if ttl == 0 {
+		ttl = defaultKubeconfigTTL
+	}
+	if ttl < minKubeconfigTTL || ttl > maxKubeconfigTTL {
+		return out, httpErrors.NewError(fmt.Errorf("ttl must be between %s and %s", minKubeconfigTTL, maxKubeconfigTTL), "CL_INVALID_KUBECONFIG_REQUEST")
+	}
+
+	requestUser, ok := request.UserFrom(ctx)
+	if !ok {
+		return out, httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+	if role == domain.ClusterKubeconfigRole_ADMIN {
+		organizationRole := requestUser.GetRoleOrganizationMapping()[requestUser.GetOrganizationId()]
+		if organizationRole != user.AdminRole && organizationRole != user.TksAdminRole {
+			return out, httpErrors.NewError(fmt.Errorf("user %s is not an organization admin", requestUser.GetAccountId()), "CL_FORBIDDEN_KUBECONFIG_ROLE")
+		}
+	}
+
+	cluster, err := u.getOrganizationCluster(ctx, organizationId, clusterId)
+	if err != nil {
+		return out, err
+	}
+
+	adminKubeconfig, err := kubernetes.GetKubeConfig(ctx, clusterId.String(), kubernetes.KubeconfigForAdmin)
+	if err != nil {
+		return out, httpErrors.NewError(err, "CL_FAILED_CREATE_KUBECONFIG")
+	}
+
+	serviceAccountName := fmt.Sprintf("tks-kubeconfig-%s-%s", role, requestUser.GetUserId())
+	kubeconfig, expiresAt, err := kubernetes.CreateShortLivedKubeconfig(ctx, adminKubeconfig, cluster.ID.String(), serviceAccountName, clusterRole, ttl)
+	if err != nil {
+		return out, httpErrors.NewError(err, "CL_FAILED_CREATE_KUBECONFIG")
+	}
+	log.Infof(ctx, "issued %s kubeconfig of cluster %s to %s until %s", role, clusterId, requestUser.GetAccountId(), expiresAt.Format(time.RFC3339))
+
+	out.Kubeconfig = string(kubeconfig)
+	out.Role = role
+	out.ExpiresAt = expiresAt
+	return out, nil
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+const (
+	KUBERNETES_EOL_NOTIFICATION_NAME = "kubernetes-version-eol"
+
+	defaultKubernetesEolAdvisoryDays = 90
+)
+
+// defaultKubernetesEndOfSupport 는 upstream kubernetes 의 minor 버전별 지원 종료일이다.
+// 새 버전이나 달라진 일정은 kubernetes-eol-schedule 설정으로 덮어쓴다.
+var defaultKubernetesEndOfSupport = map[string]string{
+	"1.22": "2022-10-28",
+	"1.23": "2023-02-28",
+	"1.24": "2023-07-28",
+	"1.25": "2023-10-28",
+	"1.26": "2024-02-28",
+	"1.27": "2024-06-28",
+	"1.28": "2024-10-28",
+	"1.29": "2025-02-28",
+	"1.30": "2025-06-28",
+	"1.31": "2025-10-28",
+	"1.32": "2026-02-28",
+	"1.33": "2026-06-28",
+	"1.34": "2026-10-27",
+}
+
+type IClusterVersionAdvisoryUsecase interface {
+	Check(ctx context.Context) error
+}
+
+type ClusterVersionAdvisoryUsecase struct {
+	repo                   repository.IClusterVersionAdvisoryRepository
+	clusterRepo            repository.IClusterRepository
+	systemNotificationRepo repository.ISystemNotificationRepository
+}
+
+func NewClusterVersionAdvisoryUsecase(r repository.Repository) IClusterVersionAdvisoryUsecase {
+	return &ClusterVersionAdvisoryUsecase{
+		repo:                   r.ClusterVersionAdvisory,
+		clusterRepo:            r.Cluster,
+		systemNotificationRepo: r.SystemNotification,
+	}
+}
+
+// Check 는 RUNNING 상태인 클러스터의 kubernetes 버전을 지원 종료 일정과 비교한다.
+// 지원 종료가 kubernetes-eol-advisory-days 이내이면 warning, 지났으면 critical 알림을 버전마다 한 번씩 생성한다.
+func (u *ClusterVersionAdvisoryUsecase) Check(ctx context.Context) error {
+	clusters, err := u.clusterRepo.Fetch(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, cluster := range clusters {
+		if cluster.Status == domain.ClusterStatus_DELETED {
+			if err := u.repo.Delete(ctx, cluster.ID); err != nil {
+				log.Error(ctx, err)
+			}
+			continue
+		}
+		if cluster.Status != domain.ClusterStatus_RUNNING {
+			continue
+		}
+
+		advisory := stackVersionAdvisory(cluster.ID, cluster.StackTemplate.KubeVersion, now)
+		if advisory == nil {
+			continue
+		}
+		severity := "warning"
+		if advisory.Expired {
+			severity = "critical"
+		}
+
+		record, err := u.repo.Get(ctx, cluster.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error(ctx, err)
+			continue
+		}
+		if record.KubeVersion == advisory.KubeVersion && record.NotifiedSeverity == severity {
+			continue
+		}
+
+		if err := u.notify(ctx, cluster, *advisory, severity); err != nil {
+			log.Error(ctx, "Failed to create systemNotification ", err)
+			continue
+		}
+		notifiedAt := time.Now()
+		record = model.ClusterVersionAdvisory{
+			ClusterId:        cluster.ID,
+			OrganizationId:   cluster.OrganizationId,
+			KubeVersion:      advisory.KubeVersion,
+			EndOfSupportAt:   advisory.EndOfSupportAt,
+			NotifiedSeverity: severity,
+			NotifiedAt:       &notifiedAt,
+		}
+		if err := u.repo.Save(ctx, record); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	return nil
+}
+
+func (u *ClusterVersionAdvisoryUsecase) notify(ctx context.Context, cluster model.Cluster, advisory domain.StackVersionAdvisory, severity string) error {
+	endOfSupport := advisory.EndOfSupportAt.Format("2006-01-02")
+	title := fmt.Sprintf("클러스터 [%s]의 kubernetes %s 지원이 %d일 후 종료됩니다.", cluster.Name, advisory.KubeVersion, advisory.DaysLeft)
+	if advisory.Expired {
+		title = fmt.Sprintf("클러스터 [%s]의 kubernetes %s 지원이 종료되었습니다.", cluster.Name, advisory.KubeVersion)
+	}
+	proposal := "지원되는 kubernetes 버전의 스택 템플릿으로 클러스터를 업그레이드하세요."
+	if advisory.UpgradeUrl != "" {
+		proposal = fmt.Sprintf("%s 업그레이드 절차 : %s", proposal, advisory.UpgradeUrl)
+	}
+
+	_, err := u.systemNotificationRepo.Create(ctx, model.SystemNotification{
+		OrganizationId:        cluster.OrganizationId,
+		Name:                  KUBERNETES_EOL_NOTIFICATION_NAME,
+		NotificationType:      "SYSTEM_NOTIFICATION",
+		Severity:              severity,
+		ClusterId:             cluster.ID,
+		MessageTitle:          title,
+		MessageContent:        fmt.Sprintf("kubernetes %s 의 upstream 지원 종료일은 %s 입니다. 지원 종료 후에는 보안 패치가 제공되지 않습니다.", advisory.KubeVersion, endOfSupport),
+		MessageActionProposal: proposal,
+		Summary:               fmt.Sprintf("kubernetes %s end of support at %s", advisory.KubeVersion, endOfSupport),
+	})
+	return err
+}
+
+// stackVersionAdvisory 는 지원 종료가 advisory 기간 이내이거나 지난 경우에만 값을 반환한다.
+// 지원 종료일을 알 수 없는 버전은 nil 을 반환한다.
+func stackVersionAdvisory(clusterId domain.ClusterId, kubeVersion string, now time.Time) *domain.StackVersionAdvisory {
+	minor := kubeMinorVersion(kubeVersion)
+	endOfSupportAt, ok := kubernetesEndOfSupport(minor)
+	if !ok {
+		return nil
+	}
+
+	days := viper.GetInt("kubernetes-eol-advisory-days")
+	if days <= 0 {
+		days = defaultKubernetesEolAdvisoryDays
+	}
+	if now.AddDate(0, 0, days).Before(endOfSupportAt) {
+		return nil
+	}
+
+	return &domain.StackVersionAdvisory{
+		KubeVersion:    minor,
+		EndOfSupportAt: endOfSupportAt,
+		DaysLeft:       int(math.Max(0, math.Ceil(endOfSupportAt.Sub(now).Hours()/24))),
+		Expired:        !now.Before(endOfSupportAt),
+		UpgradeUrl:     strings.ReplaceAll(viper.GetString("kubernetes-upgrade-url"), "{stackId}", clusterId.String()),
+	}
+}
+
+// kubernetesEndOfSupport 는 kubernetes-eol-schedule 설정("1.35=2027-02-28,...")을 기본 일정보다 우선한다.
+func kubernetesEndOfSupport(minor string) (time.Time, bool) {
+	if minor == "" {
+		return time.Time{}, false
+	}
+
+	date, ok := defaultKubernetesEndOfSupport[minor]
+	for _, entry := range strings.Split(viper.GetString("kubernetes-eol-schedule"), ",") {
+		version, value, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if found && strings.TrimPrefix(strings.TrimSpace(version), "v") == minor {
+			date, ok = strings.TrimSpace(value), true
+		}
+	}
+	if !ok {
+		return time.Time{}, false
+	}
+
+	endOfSupportAt, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		log.Warnf(context.Background(), "invalid end of support date %s of kubernetes %s", date, minor)
+		return time.Time{}, false
+	}
+	return endOfSupportAt, true
+}
@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	defaultClusterResourceLimit = 100
+	maxClusterResourceLimit     = 500
+)
+
+// GetNamespaces, GetWorkloads, GetPods 는 조직의 RUNNING cluster 의 자원을 읽기 전용으로 조회한다.
+func (u *ClusterUsecase) GetNamespaces(ctx context.Context, organizationId string, clusterId domain.ClusterId, req domain.ListClusterResourcesRequest) (out domain.GetClusterNamespacesResponse, err error) {
+	_, clientset, opts, err := u.getClusterResourceClient(ctx, organizationId, clusterId, req)
+	if err != nil {
+		return out, err
+	}
+
+	namespaces, err := clientset.CoreV1().Namespaces().List(ctx, opts)
+	if err != nil {
+		return out, httpErrors.NewError(err, "CL_FAILED_FETCH_CLUSTER_RESOURCES")
+	}
+
+	out.Namespaces = make([]domain.ClusterNamespaceResponse, len(namespaces.Items))
+	for i, namespace := range namespaces.Items {
+		out.Namespaces[i] = domain.ClusterNamespaceResponse{
+			Name:      namespace.Name,
+			Status:    string(namespace.Status.Phase),
+			Labels:    namespace.Labels,
+			CreatedAt: namespace.CreationTimestamp.Time,
+		}
+	}
+	out.Continue = namespaces.Continue
+	return out, nil
+}
+
+// GetWorkloads 는 kind(Deployment, StatefulSet, DaemonSet) 의 workload 를 조회한다.
+func (u *ClusterUsecase) GetWorkloads(ctx context.Context, organizationId string, clusterId domain.ClusterId, kind string, req domain.ListClusterResourcesRequest) (out domain.GetClusterWorkloadsResponse, err error) {
+	_, clientset, opts, err := u.getClusterResourceClient(ctx, organizationId, clusterId, req)
+	if err != nil {
+		return out, err
+	}
+
+	out.Workloads = []domain.ClusterWorkloadResponse{}
+	switch strings.ToLower(kind) {
+	case strings.ToLower(domain.ClusterWorkloadKind_DEPLOYMENT):
+		deployments, err := clientset.AppsV1().Deployments(req.Namespace).List(ctx, opts)
+		if err != nil {
+			return out, httpErrors.NewError(err, "CL_FAILED_FETCH_CLUSTER_RESOURCES")
+		}
+		for _, deployment := range deployments.Items {
+			out.Workloads = append(out.Workloads, newDeploymentWorkload(deployment))
+		}
+		out.Continue = deployments.Continue
+	case strings.ToLower(domain.ClusterWorkloadKind_STATEFULSET):
+		statefulSets, err := clientset.AppsV1().StatefulSets(req.Namespace).List(ctx, opts)
+		if err != nil {
+			return out, httpErrors.NewError(err, "CL_FAILED_FETCH_CLUSTER_RESOURCES")
+		}
+		for _, statefulSet := range statefulSets.Items {
+			out.Workloads = append(out.Workloads, newStatefulSetWorkload(statefulSet))
+		}
+		out.Continue = statefulSets.Continue
+	case strings.ToLower(domain.ClusterWorkloadKind_DAEMONSET):
+		daemonSets, err := clientset.AppsV1().DaemonSets(req.Namespace).List(ctx, opts)
+		if err != nil {
+			return out, httpErrors.NewError(err, "CL_FAILED_FETCH_CLUSTER_RESOURCES")
+		}
+		for _, daemonSet := range daemonSets.Items {
+			out.Workloads = append(out.Workloads, newDaemonSetWorkload(daemonSet))
+		}
+		out.Continue = daemonSets.Continue
+	default:
+		return out, httpErrors.NewError(fmt.Errorf("kind must be one of %s, %s, %s", domain.ClusterWorkloadKind_DEPLOYMENT, domain.ClusterWorkloadKind_STATEFULSET, domain.ClusterWorkloadKind_DAEMONSET), "CL_INVALID_CLUSTER_RESOURCE_QUERY")
+	}
+	return out, nil
+}
+
+func (u *ClusterUsecase) GetPods(ctx context.Context, organizationId string, clusterId domain.ClusterId, req domain.ListClusterResourcesRequest) (out domain.GetClusterPodsResponse, err error) {
+	cluster, clientset, opts, err := u.getClusterResourceClient(ctx, organizationId, clusterId, req)
+	if err != nil {
+		return out, err
+	}
+
+	pods, err := clientset.CoreV1().Pods(req.Namespace).List(ctx, opts)
+	if err != nil {
+		return out, httpErrors.NewError(err, "CL_FAILED_FETCH_CLUSTER_RESOURCES")
+	}
+
+	out.Pods = make([]domain.PodSearchResult, len(pods.Items))
+	for i, pod := range pods.Items {
+		out.Pods[i] = newPodSearchResult(cluster, pod)
+	}
+	out.Continue = pods.Continue
+	return out, nil
+}
+
+func (u *ClusterUsecase) getOrganizationCluster(ctx context.Context, organizationId string, clusterId domain.ClusterId) (model.Cluster, error) {
+	cluster, err := u.repo.Get(ctx, clusterId)
+	if err != nil {
+		return cluster, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
+	}
+	if cluster.OrganizationId != organizationId {
+		return cluster, httpErrors.NewError(fmt.Errorf("cluster %s is not found in organization %s", clusterId, organizationId), "S_FAILED_FETCH_CLUSTER")
+	}
+	if cluster.Status != domain.ClusterStatus_RUNNING {
+		return cluster, httpErrors.NewError(fmt.Errorf("cluster %s is %s", clusterId, cluster.Status), "S_NOT_RUNNING_STACK")
+	}
+	return cluster, nil
+}
+
+// getClusterResourceClient 는 cluster 가 조직의 RUNNING cluster 인지 확인하고 조회 조건을 kubernetes ListOptions 로 바꾼다.
+func (u *ClusterUsecase) getClusterResourceClient(ctx context.Context, organizationId string, clusterId domain.ClusterId, req domain.ListClusterResourcesRequest) (cluster model.Cluster, clientset *k8s.Clientset, opts metav1.ListOptions, err error) {
+	opts = metav1.ListOptions{LabelSelector: req.LabelSelector, Limit: req.Limit, Continue: req.Continue}
+	if req.LabelSelector != "" {
+		if _, err := labels.Parse(req.LabelSelector); err != nil {
+			return cluster, nil, opts, httpErrors.NewError(err, "CL_INVALID_CLUSTER_RESOURCE_QUERY")
+		}
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = defaultClusterResourceLimit
+	}
+	if opts.Limit > maxClusterResourceLimit {
+		opts.Limit = maxClusterResourceLimit
+	}
+
+	if cluster, err = u.getOrganizationCluster(ctx, organizationId, clusterId); err != nil {
+		return cluster, nil, opts, err
+	}
+	if clientset, err = kubernetes.GetCachedClientFromClusterId(ctx, clusterId.String()); err != nil {
+		return cluster, nil, opts, httpErrors.NewError(err, "CL_FAILED_FETCH_CLUSTER_RESOURCES")
+	}
+	return cluster, clientset, opts, nil
+}
+
+func newDeploymentWorkload(deployment appsv1.Deployment) domain.ClusterWorkloadResponse {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	out := newClusterWorkload(domain.ClusterWorkloadKind_DEPLOYMENT, deployment.ObjectMeta, deployment.Spec.Template.Spec)
+	out.Desired = desired
+	out.Ready = deployment.Status.ReadyReplicas
+	out.Updated = deployment.Status.UpdatedReplicas
+	out.Available = deployment.Status.AvailableReplicas
+	out.Status = getWorkloadStatus(desired, out.Ready, out.Updated, deployment.Status.ObservedGeneration < deployment.Generation)
+	return out
+}
+
+func newStatefulSetWorkload(statefulSet appsv1.StatefulSet) domain.ClusterWorkloadResponse {
+	desired := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		desired = *statefulSet.Spec.Replicas
+	}
+	out := newClusterWorkload(domain.ClusterWorkloadKind_STATEFULSET, statefulSet.ObjectMeta, statefulSet.Spec.Template.Spec)
+	out.Desired = desired
+	out.Ready = statefulSet.Status.ReadyReplicas
+	out.Updated = statefulSet.Status.UpdatedReplicas
+	out.Available = statefulSet.Status.AvailableReplicas
+	out.Status = getWorkloadStatus(desired, out.Ready, out.Updated, statefulSet.Status.ObservedGeneration < statefulSet.Generation)
+	return out
+}
+
+func newDaemonSetWorkload(daemonSet appsv1.DaemonSet) domain.ClusterWorkloadResponse {
+	out := newClusterWorkload(domain.ClusterWorkloadKind_DAEMONSET, daemonSet.ObjectMeta, daemonSet.Spec.Template.Spec)
+	out.Desired = daemonSet.Status.DesiredNumberScheduled
+	out.Ready = daemonSet.Status.NumberReady
+	out.Updated = daemonSet.Status.UpdatedNumberScheduled
+	out.Available = daemonSet.Status.NumberAvailable
+	out.Status = getWorkloadStatus(out.Desired, out.Ready, out.Updated, daemonSet.Status.ObservedGeneration < daemonSet.Generation)
+	return out
+}
+
+func newClusterWorkload(kind string, meta metav1.ObjectMeta, spec corev1.PodSpec) domain.ClusterWorkloadResponse {
+	out := domain.ClusterWorkloadResponse{
+		Kind:      kind,
+		Namespace: meta.Namespace,
+		Name:      meta.Name,
+		Images:    []string{},
+		Labels:    meta.Labels,
+		CreatedAt: meta.CreationTimestamp.Time,
+	}
+	for _, container := range spec.Containers {
+		out.Images = append(out.Images, container.Image)
+	}
+	return out
+}
+
+func getWorkloadStatus(desired int32, ready int32, updated int32, observing bool) string {
+	switch {
+	case observing || updated < desired:
+		return "Progressing"
+	case ready >= desired:
+		return "Ready"
+	}
+	return "NotReady"
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -20,7 +21,6 @@ import (
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
 	"github.com/openinfradev/tks-api/pkg/kubernetes"
 	"github.com/openinfradev/tks-api/pkg/log"
-	gcache "github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	byoh "github.com/vmware-tanzu/cluster-api-provider-bringyourownhost/apis/infrastructure/v1beta1"
@@ -43,6 +43,12 @@ type IClusterUsecase interface {
 	CreateBootstrapKubeconfig(ctx context.Context, clusterId domain.ClusterId) (out domain.BootstrapKubeconfig, err error)
 	GetBootstrapKubeconfig(ctx context.Context, clusterId domain.ClusterId) (out domain.BootstrapKubeconfig, err error)
 	GetNodes(ctx context.Context, clusterId domain.ClusterId) (out []domain.ClusterNode, err error)
+	SearchPods(ctx context.Context, organizationId string, req domain.SearchPodsRequest) (out domain.SearchPodsResponse, err error)
+	GetNamespaces(ctx context.Context, organizationId string, clusterId domain.ClusterId, req domain.ListClusterResourcesRequest) (domain.GetClusterNamespacesResponse, error)
+	GetWorkloads(ctx context.Context, organizationId string, clusterId domain.ClusterId, kind string, req domain.ListClusterResourcesRequest) (domain.GetClusterWorkloadsResponse, error)
+	GetPods(ctx context.Context, organizationId string, clusterId domain.ClusterId, req domain.ListClusterResourcesRequest) (domain.GetClusterPodsResponse, error)
+	GetPodLogs(ctx context.Context, organizationId string, clusterId domain.ClusterId, namespace string, podName string, req domain.GetPodLogsRequest) (io.ReadCloser, error)
+	GetShortLivedKubeconfig(ctx context.Context, organizationId string, clusterId domain.ClusterId, role string, ttl time.Duration) (domain.GetClusterKubeconfigResponse, error)
 }
 
 type ClusterUsecase struct {
@@ -52,10 +58,10 @@ type ClusterUsecase struct {
 	stackTemplateRepo repository.IStackTemplateRepository
 	organizationRepo  repository.IOrganizationRepository
 	argo              argowf.ArgoClient
-	cache             *gcache.Cache
+	cacheInvalidator  ICacheInvalidator
 }
 
-func NewClusterUsecase(r repository.Repository, argoClient argowf.ArgoClient, cache *gcache.Cache) IClusterUsecase {
+func NewClusterUsecase(r repository.Repository, argoClient argowf.ArgoClient, cacheInvalidator ICacheInvalidator) IClusterUsecase {
 	return &ClusterUsecase{
 		repo:              r.Cluster,
 		appGroupRepo:      r.AppGroup,
@@ -63,7 +69,7 @@ func NewClusterUsecase(r repository.Repository, argoClient argowf.ArgoClient, ca
 		stackTemplateRepo: r.StackTemplate,
 		organizationRepo:  r.Organization,
 		argo:              argoClient,
-		cache:             cache,
+		cacheInvalidator:  cacheInvalidator,
 	}
 }
 
@@ -332,10 +338,10 @@ func (u *ClusterUsecase) Install(ctx context.Context, clusterId domain.ClusterId
 
 	cluster, err := u.repo.Get(ctx, clusterId)
 	if err != nil {
-		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid clusterId"), "C_INVALID_CLUSTER_ID", "")
+		return httpErrors.NewError(fmt.Errorf("Invalid clusterId"), "C_INVALID_CLUSTER_ID")
 	}
 	if cluster.CloudService != domain.CloudService_BYOH {
-		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid cloudService"), "C_INVALID_CLOUD_SERVICE", "")
+		return httpErrors.NewError(fmt.Errorf("Invalid cloudService"), "C_INVALID_CLOUD_SERVICE")
 	}
 
 	stackTemplate, err := u.stackTemplateRepo.Get(ctx, cluster.StackTemplateId)
@@ -396,6 +402,11 @@ func (u *ClusterUsecase) Delete(ctx context.Context, clusterId domain.ClusterId)
 		return fmt.Errorf("The cluster can not be deleted. cluster status : %s", cluster.Status)
 	}
 
+	// primary cluster 를 지우면 대시보드와 앨럿 라우팅이 동작하지 않으므로 다른 cluster 로 옮긴 뒤 삭제해야 한다.
+	if err := u.checkPrimaryClusterDependencies(ctx, cluster); err != nil {
+		return err
+	}
+
 	resAppGroups, err := u.appGroupRepo.Fetch(ctx, clusterId, nil)
 	if err != nil {
 		return errors.Wrap(err, "Failed to get appgroup")
@@ -413,7 +424,7 @@ func (u *ClusterUsecase) Delete(ctx context.Context, clusterId domain.ClusterId)
 	if cluster.CloudService != domain.CloudService_BYOH {
 		cloudAccount, err := u.cloudAccountRepo.Get(ctx, cluster.CloudAccount.ID)
 		if err != nil {
-			return httpErrors.NewInternalServerError(fmt.Errorf("Failed to get cloudAccount"), "", "")
+			return httpErrors.NewError(fmt.Errorf("Failed to get cloudAccount"), "C_INTERNAL_ERROR")
 		}
 		tksCloudAccountId = cluster.CloudAccount.ID.String()
 		if strings.Contains(cloudAccount.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
@@ -444,6 +455,7 @@ func (u *ClusterUsecase) Delete(ctx context.Context, clusterId domain.ClusterId)
 	if err := u.repo.InitWorkflow(ctx, clusterId, workflowId, domain.ClusterStatus_DELETING); err != nil {
 		return errors.Wrap(err, "Failed to initialize status")
 	}
+	u.cacheInvalidator.Invalidate(ctx, CacheEvent{Kind: CacheEvent_CLUSTER_DELETED, OrganizationId: cluster.OrganizationId, ClusterId: clusterId.String()})
 
 	return nil
 }
@@ -501,7 +513,7 @@ func (u *ClusterUsecase) CreateBootstrapKubeconfig(ctx context.Context, clusterI
 	})
 	if err != nil {
 		log.Error(ctx, err)
-		return out, httpErrors.NewInternalServerError(err, "S_FAILED_TO_CALL_WORKFLOW", "")
+		return out, httpErrors.NewError(err, "S_FAILED_TO_CALL_WORKFLOW")
 	}
 	log.Debug(ctx, "Submitted workflow: ", workflowId)
 
@@ -606,7 +618,7 @@ func (u *ClusterUsecase) GetNodes(ctx context.Context, clusterId domain.ClusterI
 	cluster, err := u.repo.Get(ctx, clusterId)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return out, httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_CLUSTER", "")
+			return out, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
 		}
 		return out, err
 	}
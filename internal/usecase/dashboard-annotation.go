@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// chart 하나에 표시할 annotation 의 최대 개수이다. 넘는 경우 최근 이벤트만 표시한다.
+const maxChartAnnotations = 200
+
+// isAnnotatedChartType 은 시간 축이 있어 이벤트를 겹쳐 표시할 수 있는 chart 인지 확인한다.
+func isAnnotatedChartType(chartType string) bool {
+	switch chartType {
+	case domain.ChartType_CPU.String(), domain.ChartType_MEMORY.String(), domain.ChartType_POD.String(), domain.ChartType_TRAFFIC.String():
+		return true
+	}
+	return false
+}
+
+// attachChartAnnotations 는 chart 조회 기간의 배포, 스택 작업, critical 알림을 chart 에 추가한다.
+// annotation 은 캐시하지 않으므로 chart 가 캐시되어 있어도 최근 이벤트가 바로 표시된다. 조회에 실패하면 경고만 남긴다.
+func (u *DashboardUsecase) attachChartAnnotations(ctx context.Context, organizationId string, charts []domain.DashboardChart, duration string, interval string) {
+	var annotations []domain.ChartAnnotation
+	var warning string
+	loaded := false
+
+	for i := range charts {
+		if !isAnnotatedChartType(charts[i].ChartType.String()) || charts[i].Error != "" {
+			continue
+		}
+		if !loaded {
+			loaded = true
+			durationSec, _ := getDurationAndIntervalSec(duration, interval)
+			end := time.Now()
+			var err error
+			annotations, err = u.getChartAnnotations(ctx, organizationId, end.Add(-time.Duration(durationSec)*time.Second), end)
+			if err != nil {
+				log.Error(ctx, err)
+				warning = "failed to get annotations"
+			} else if len(annotations) > maxChartAnnotations {
+				warning = fmt.Sprintf("annotations are limited to the latest %d of %d", maxChartAnnotations, len(annotations))
+				annotations = annotations[len(annotations)-maxChartAnnotations:]
+			}
+		}
+
+		charts[i].Annotations = annotations
+		if warning != "" {
+			// 캐시된 chart 의 warnings 를 수정하지 않도록 복사한다.
+			charts[i].Warnings = append(append([]string{}, charts[i].Warnings...), warning)
+		}
+	}
+}
+
+func (u *DashboardUsecase) getChartAnnotations(ctx context.Context, organizationId string, start time.Time, end time.Time) ([]domain.ChartAnnotation, error) {
+	out := []domain.ChartAnnotation{}
+
+	operations, err := u.operationRepo.FetchByPeriod(ctx, organizationId, start, end)
+	if err != nil {
+		return nil, err
+	}
+	for _, operation := range operations {
+		out = append(out, operationAnnotation(operation))
+	}
+
+	systemNotifications, err := u.systemNotificationRepo.FetchBySeverity(ctx, organizationId, "critical", start, end)
+	if err != nil {
+		return nil, err
+	}
+	for _, systemNotification := range systemNotifications {
+		out = append(out, domain.ChartAnnotation{
+			Type:        domain.ChartAnnotationType_ALERT,
+			Timestamp:   systemNotification.CreatedAt.Unix(),
+			ClusterId:   systemNotification.ClusterId.String(),
+			Title:       systemNotification.MessageTitle,
+			Description: systemNotification.MessageContent,
+			Severity:    systemNotification.Severity,
+			Status:      string(systemNotification.Status),
+			ReferenceId: systemNotification.ID.String(),
+		})
+	}
+
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Timestamp < out[j].Timestamp })
+	return out, nil
+}
+
+func operationAnnotation(operation model.Operation) domain.ChartAnnotation {
+	annotation := domain.ChartAnnotation{
+		Type:        domain.ChartAnnotationType_STACK_OPERATION,
+		Timestamp:   operation.CreatedAt.Unix(),
+		Title:       operation.TargetName,
+		Description: string(operation.Type),
+		Status:      string(operation.Status),
+		ReferenceId: operation.ID.String(),
+	}
+
+	switch operation.Type {
+	case domain.OperationType_APP_DEPLOY, domain.OperationType_APP_ROLLBACK:
+		annotation.Type = domain.ChartAnnotationType_DEPLOYMENT
+		annotation.ClusterId = operationParameter(operation, "target_cluster_id")
+	case domain.OperationType_STACK_DELETE, domain.OperationType_STACK_SCALE, domain.OperationType_STACK_UPGRADE:
+		annotation.ClusterId = operation.TargetId
+	case domain.OperationType_CATALOG_APP_DEPLOY:
+		annotation.Type = domain.ChartAnnotationType_DEPLOYMENT
+		annotation.ClusterId = operationParameter(operation, "cluster_id")
+	case domain.OperationType_APPGROUP_CREATE, domain.OperationType_APPGROUP_DELETE:
+		annotation.ClusterId = operationParameter(operation, "cluster_id")
+	}
+	return annotation
+}
+
+// operationParameter 는 workflow 에 전달한 "key=value" 형식의 parameter 값을 반환한다.
+func operationParameter(operation model.Operation, key string) string {
+	parameters := []string{}
+	if err := json.Unmarshal(operation.Parameters, &parameters); err != nil {
+		return ""
+	}
+	for _, parameter := range parameters {
+		if value, found := strings.CutPrefix(parameter, key+"="); found {
+			return value
+		}
+	}
+	return ""
+}
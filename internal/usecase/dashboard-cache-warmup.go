@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	cacheWarmupConcurrency = 4
+	cacheWarmupTimeout     = time.Minute
+
+	// 대시보드가 조건 없이 처음 조회할 때의 기본 조회 조건이다.
+	cacheWarmupChartDuration = "1d"
+	cacheWarmupChartInterval = "1d"
+)
+
+// 시작 시 warmup 과 관리자 요청이 동시에 같은 조회를 반복하지 않도록 warmup 은 한 번에 하나씩 처리한다.
+var cacheWarmupMutex sync.Mutex
+
+// WarmupCaches 는 배포 직후 첫 대시보드 조회가 느리지 않도록 thanos url, cluster 이름, chart 캐시를 미리 채운다.
+// organizationId 가 비어 있으면 생성이 완료된 모든 조직에 대해 실행한다.
+// 조직별 실패는 결과에 담아 반환하고 나머지 조직은 계속 처리한다.
+func (u *DashboardUsecase) WarmupCaches(ctx context.Context, organizationId string) ([]domain.DashboardCacheWarmup, error) {
+	var organizationIds []string
+	if organizationId != "" {
+		if _, err := u.organizationRepo.Get(ctx, organizationId); err != nil {
+			return nil, httpErrors.NewNotFoundError(err, "", "")
+		}
+		organizationIds = []string{organizationId}
+	} else {
+		organizations, err := u.organizationRepo.Fetch(ctx, nil)
+		if err != nil {
+			return nil, httpErrors.NewError(errors.Wrap(err, "Failed to get organizations"), "C_INTERNAL_ERROR")
+		}
+		for _, organization := range *organizations {
+			if organization.Status == domain.OrganizationStatus_CREATED {
+				organizationIds = append(organizationIds, organization.ID)
+			}
+		}
+	}
+
+	cacheWarmupMutex.Lock()
+	defer cacheWarmupMutex.Unlock()
+
+	start := time.Now()
+	out := make([]domain.DashboardCacheWarmup, len(organizationIds))
+	sem := make(chan struct{}, cacheWarmupConcurrency)
+	var wg sync.WaitGroup
+	for i, organizationId := range organizationIds {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, organizationId string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			out[i] = u.warmupOrganizationCaches(ctx, organizationId)
+		}(i, organizationId)
+	}
+	wg.Wait()
+
+	log.Infof(ctx, "warmed up dashboard caches of %d organizations in %s", len(out), time.Since(start))
+	return out, nil
+}
+
+func (u *DashboardUsecase) warmupOrganizationCaches(ctx context.Context, organizationId string) (out domain.DashboardCacheWarmup) {
+	start := time.Now()
+	out.OrganizationId = organizationId
+	ctx, cancel := context.WithTimeout(ctx, cacheWarmupTimeout)
+	defer func() {
+		cancel()
+		out.Elapsed = time.Since(start)
+		for _, e := range out.Errors {
+			log.Warnf(ctx, "failed to warm up dashboard caches of organization %s. err : %s", organizationId, e)
+		}
+	}()
+
+	// thanos client 를 만들면서 thanos url 과 LMA endpoint 상태도 캐시된다.
+	if _, err := u.thanosClients.Get(ctx, organizationId); err != nil {
+		out.Errors = append(out.Errors, fmt.Sprintf("thanos: %s", err))
+	} else {
+		out.ThanosReady = true
+	}
+
+	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
+	if err != nil {
+		out.Errors = append(out.Errors, fmt.Sprintf("clusters: %s", err))
+	}
+	for _, cluster := range clusters {
+		if _, err := u.getClusterNameFromId(ctx, cluster.ID.String()); err != nil {
+			out.Errors = append(out.Errors, fmt.Sprintf("cluster %s: %s", cluster.ID, err))
+			continue
+		}
+		out.Clusters++
+	}
+
+	// chart 는 thanos 를 조회하므로 thanos client 를 만들지 못하면 건너뛴다.
+	if !out.ThanosReady {
+		return
+	}
+	for _, timezone := range u.warmupTimezones(ctx, organizationId) {
+		location, _ := time.LoadLocation(timezone)
+		now := time.Now().In(location)
+		year, month := strconv.Itoa(now.Year()), strconv.Itoa(int(now.Month()))
+
+		charts, err := u.GetCharts(ctx, organizationId, domain.ChartType_ALL, cacheWarmupChartDuration, cacheWarmupChartInterval, domain.ChartAggregation_AVG, year, month, timezone)
+		if err != nil {
+			out.Errors = append(out.Errors, fmt.Sprintf("charts(%s): %s", timezone, err))
+			continue
+		}
+		for _, chart := range charts {
+			if chart.Error != "" {
+				out.Errors = append(out.Errors, fmt.Sprintf("chart %s(%s): %s", chart.Name, timezone, chart.Error))
+				continue
+			}
+			out.Charts++
+		}
+	}
+	return
+}
+
+// warmupTimezones 는 chart 캐시가 timezone 별로 저장되므로 기본 timezone 과 조직 사용자들의 timezone 을 반환한다.
+func (u *DashboardUsecase) warmupTimezones(ctx context.Context, organizationId string) []string {
+	timezones := map[string]struct{}{defaultChartTimezone: {}}
+	users, err := u.userRepo.List(ctx, u.userRepo.OrganizationFilter(organizationId))
+	if err != nil {
+		log.Warnf(ctx, "failed to get users of organization %s. err : %s", organizationId, err)
+	} else {
+		for _, user := range *users {
+			if user.Timezone == "" {
+				continue
+			}
+			if _, err := time.LoadLocation(user.Timezone); err == nil {
+				timezones[user.Timezone] = struct{}{}
+			}
+		}
+	}
+
+	out := make([]string, 0, len(timezones))
+	for timezone := range timezones {
+		out = append(out, timezone)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/log"
+	gcache "github.com/patrickmn/go-cache"
+	"github.com/spf13/viper"
+)
+
+const (
+	cacheKeyChart           = "CACHE_KEY_CHART"
+	cacheKeyChartGeneration = "CACHE_KEY_CHART_GENERATION"
+
+	defaultChartCacheTTL      = 30 * time.Second
+	defaultChartCacheStaleTTL = 5 * time.Minute
+)
+
+type chartCacheEntry struct {
+	chart     domain.DashboardChart
+	fetchedAt time.Time
+}
+
+// getCachedChart 는 조직, chart 종류, 조회 조건 별로 캐시된 chart 를 반환한다.
+// ttl 이내의 chart 는 그대로 반환하고, ttl 이 지났지만 stale ttl 이내인 chart 는 먼저 반환한 뒤 백그라운드에서 갱신한다.
+// 조회에 실패한 결과는 캐시하지 않는다.
+func (u *DashboardUsecase) getCachedChart(ctx context.Context, organizationId string, chartType string, duration string, interval string, aggregation domain.ChartAggregation, year string, month string, timezone string) (domain.DashboardChart, error) {
+	ttl, staleTTL := chartCacheTTL()
+	if ttl <= 0 {
+		return u.getChartFromPrometheus(ctx, organizationId, chartType, duration, interval, aggregation, year, month, timezone)
+	}
+
+	key := u.chartCacheKey(organizationId, chartType, duration, interval, aggregation, year, month, timezone)
+	if value, found := u.cache.Get(key); found {
+		entry := value.(chartCacheEntry)
+		age := time.Since(entry.fetchedAt)
+		if age < ttl {
+			return entry.chart, nil
+		}
+		if age < ttl+staleTTL {
+			u.refreshChart(ctx, key, organizationId, chartType, duration, interval, aggregation, year, month, timezone)
+			return entry.chart, nil
+		}
+	}
+
+	chart, err := u.getChartFromPrometheus(ctx, organizationId, chartType, duration, interval, aggregation, year, month, timezone)
+	if err != nil {
+		return chart, err
+	}
+	u.cache.Set(key, chartCacheEntry{chart: chart, fetchedAt: time.Now()}, ttl+staleTTL)
+	return chart, nil
+}
+
+// refreshChart 는 stale chart 를 백그라운드에서 갱신한다. 같은 키에 대해서는 동시에 하나만 실행한다.
+func (u *DashboardUsecase) refreshChart(ctx context.Context, key string, organizationId string, chartType string, duration string, interval string, aggregation domain.ChartAggregation, year string, month string, timezone string) {
+	if _, loaded := u.chartRefreshing.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer u.chartRefreshing.Delete(key)
+
+		chart, err := u.getChartFromPrometheus(ctx, organizationId, chartType, duration, interval, aggregation, year, month, timezone)
+		if err != nil {
+			log.Warnf(ctx, "failed to refresh chart %s. err : %s", key, err)
+			return
+		}
+		ttl, staleTTL := chartCacheTTL()
+		u.cache.Set(key, chartCacheEntry{chart: chart, fetchedAt: time.Now()}, ttl+staleTTL)
+	}()
+}
+
+// chartCacheKey 는 조직의 chart 캐시 세대를 포함한 키를 만든다.
+// 세대 키가 무효화되면 새 세대가 만들어지므로 이전 세대의 chart 는 더 이상 조회되지 않고 만료된다.
+func (u *DashboardUsecase) chartCacheKey(organizationId string, chartType string, duration string, interval string, aggregation domain.ChartAggregation, year string, month string, timezone string) string {
+	key := chartGenerationCacheKey(organizationId)
+	generation, found := u.cache.Get(key)
+	if !found {
+		// 동시에 만들어진 경우 먼저 저장된 세대를 사용한다.
+		_ = u.cache.Add(key, strconv.FormatInt(time.Now().UnixNano(), 36), gcache.NoExpiration)
+		generation, _ = u.cache.Get(key)
+	}
+
+	return cacheKeyChart + strings.Join([]string{
+		organizationId, fmt.Sprint(generation), chartType, duration, interval, string(aggregation), year, month, timezone,
+	}, ":")
+}
+
+func chartCacheTTL() (ttl time.Duration, staleTTL time.Duration) {
+	ttl, staleTTL = defaultChartCacheTTL, defaultChartCacheStaleTTL
+	if viper.IsSet("chart-cache-ttl") {
+		ttl = viper.GetDuration("chart-cache-ttl")
+	}
+	if viper.IsSet("chart-cache-stale-ttl") {
+		staleTTL = viper.GetDuration("chart-cache-stale-ttl")
+	}
+	if staleTTL < 0 {
+		staleTTL = 0
+	}
+	return
+}
+
+func chartGenerationCacheKey(organizationId string) string {
+	return cacheKeyChartGeneration + organizationId
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	chartSnapshotTokenPrefix   = "tks_chart_"
+	chartSnapshotTokenLength   = 40
+	defaultChartSnapshotExpiry = 24 * time.Hour
+	maxChartSnapshotExpiry     = 30 * 24 * time.Hour
+)
+
+// CreateChartSnapshot 은 chart 를 조회한 결과를 저장하고 공유 토큰을 발급한다.
+// 공유 링크로는 저장된 결과만 조회할 수 있으므로, 조직의 thanos 에 대한 접근 권한 없이도 chart 를 볼 수 있다.
+// 토큰은 hash 로만 저장하므로 생성할 때 한 번만 반환한다.
+func (u *DashboardUsecase) CreateChartSnapshot(ctx context.Context, organizationId string, chart domain.DashboardChart, title string, expiresIn time.Duration) (chartSnapshotId uuid.UUID, shareToken string, expiredAt time.Time, err error) {
+	if expiresIn <= 0 {
+		expiresIn = defaultChartSnapshotExpiry
+	}
+	if expiresIn > maxChartSnapshotExpiry {
+		return uuid.Nil, "", time.Time{}, httpErrors.NewBadRequestError(fmt.Errorf("expiresIn must be less than %s", maxChartSnapshotExpiry), "C_INVALID_QUERY_PARAM", "")
+	}
+
+	var data domain.DashboardChart
+	if chart.CustomChartId != "" {
+		customChartId, err := uuid.Parse(chart.CustomChartId)
+		if err != nil {
+			return uuid.Nil, "", time.Time{}, httpErrors.NewBadRequestError(err, "D_INVALID_CUSTOM_CHART_ID", "")
+		}
+		if data, err = u.GetCustomChartData(ctx, organizationId, customChartId, chart.Duration, chart.Interval); err != nil {
+			return uuid.Nil, "", time.Time{}, err
+		}
+	} else {
+		charts, err := u.GetCharts(ctx, organizationId, chart.ChartType, chart.Duration, chart.Interval, chart.Aggregation, chart.Year, chart.Month, chart.Timezone)
+		if err != nil {
+			return uuid.Nil, "", time.Time{}, err
+		}
+		if len(charts) < 1 {
+			return uuid.Nil, "", time.Time{}, httpErrors.NewInternalServerError(fmt.Errorf("Not found chart"), "D_NOT_FOUND_CHART", "")
+		}
+		data = charts[0]
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return uuid.Nil, "", time.Time{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	if title == "" {
+		title = data.Name
+	}
+	shareToken = chartSnapshotTokenPrefix + helper.GenerateRandomString(chartSnapshotTokenLength)
+	dto := model.ChartSnapshot{
+		OrganizationId: organizationId,
+		ChartType:      data.ChartType.String(),
+		Title:          title,
+		Data:           b,
+		TokenHash:      helper.HashToken(shareToken),
+		ExpiredAt:      time.Now().Add(expiresIn),
+	}
+	if user, ok := request.UserFrom(ctx); ok {
+		userId := user.GetUserId()
+		dto.CreatorId = &userId
+	}
+
+	chartSnapshotId, err = u.chartSnapshotRepo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, "", time.Time{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return chartSnapshotId, shareToken, dto.ExpiredAt, nil
+}
+
+func (u *DashboardUsecase) GetChartSnapshots(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.ChartSnapshot, error) {
+	return u.chartSnapshotRepo.Fetch(ctx, organizationId, pg)
+}
+
+// DeleteChartSnapshot 은 만료 전에 공유 링크를 폐기할 때 사용한다.
+func (u *DashboardUsecase) DeleteChartSnapshot(ctx context.Context, organizationId string, chartSnapshotId uuid.UUID) error {
+	chartSnapshot, err := u.chartSnapshotRepo.Get(ctx, chartSnapshotId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return httpErrors.NewError(err, "D_NOT_FOUND_CHART_SNAPSHOT")
+		}
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if chartSnapshot.OrganizationId != organizationId {
+		return httpErrors.NewError(fmt.Errorf("Not found chart snapshot"), "D_NOT_FOUND_CHART_SNAPSHOT")
+	}
+
+	if err := u.chartSnapshotRepo.Delete(ctx, chartSnapshotId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// GetSharedChartSnapshot 은 인증 없이 공유 토큰으로 snapshot 을 조회한다. 만료된 snapshot 은 존재하지 않는 것으로 처리한다.
+func (u *DashboardUsecase) GetSharedChartSnapshot(ctx context.Context, shareToken string) (model.ChartSnapshot, domain.DashboardChart, error) {
+	chartSnapshot, err := u.chartSnapshotRepo.GetByTokenHash(ctx, helper.HashToken(shareToken))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.ChartSnapshot{}, domain.DashboardChart{}, httpErrors.NewError(err, "D_NOT_FOUND_CHART_SNAPSHOT")
+		}
+		return model.ChartSnapshot{}, domain.DashboardChart{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if time.Now().After(chartSnapshot.ExpiredAt) {
+		return model.ChartSnapshot{}, domain.DashboardChart{}, httpErrors.NewError(fmt.Errorf("expired chart snapshot"), "D_NOT_FOUND_CHART_SNAPSHOT")
+	}
+
+	var chart domain.DashboardChart
+	if err := json.Unmarshal(chartSnapshot.Data, &chart); err != nil {
+		return model.ChartSnapshot{}, domain.DashboardChart{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return chartSnapshot, chart, nil
+}
+
+func (u *DashboardUsecase) PurgeExpiredChartSnapshots(ctx context.Context) error {
+	deleted, err := u.chartSnapshotRepo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		log.Infof(ctx, "purged %d expired chart snapshots", deleted)
+	}
+	return nil
+}
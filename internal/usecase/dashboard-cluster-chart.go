@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
+	"github.com/spf13/viper"
+)
+
+var clusterChartUnits = map[domain.ChartType]domain.ChartUnit{
+	domain.ChartType_CPU:     domain.ChartUnit_PERCENT,
+	domain.ChartType_MEMORY:  domain.ChartUnit_PERCENT,
+	domain.ChartType_DISK:    domain.ChartUnit_PERCENT,
+	domain.ChartType_NETWORK: domain.ChartUnit_RATE,
+}
+
+// GetClusterCharts 는 하나의 stack 에 대해 노드별 cpu, memory, disk, network chart 를 반환한다.
+// chartType 이 ALL 이면 drill-down 에서 제공하는 모든 chart 를 반환한다.
+func (u *DashboardUsecase) GetClusterCharts(ctx context.Context, organizationId string, clusterId domain.ClusterId, chartType domain.ChartType, duration string, interval string) (out []domain.DashboardChart, err error) {
+	cluster, err := u.clusterRepo.Get(ctx, clusterId)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
+	}
+	if cluster.OrganizationId != organizationId {
+		return nil, httpErrors.NewError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID")
+	}
+
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeNames := u.getNodeNamesByAddress(ctx, clusterId.String())
+	for _, t := range domain.ClusterChartTypes {
+		if chartType != domain.ChartType_ALL && chartType != t {
+			continue
+		}
+
+		chart, err := u.getClusterChartFromPrometheus(ctx, thanosClient, organizationId, clusterId.String(), t, duration, interval, nodeNames)
+		if err != nil {
+			if chartType != domain.ChartType_ALL {
+				return nil, err
+			}
+			log.Error(ctx, err)
+			chart = newErrorChart(organizationId, t, duration, interval, err)
+			chart.ClusterId = clusterId.String()
+		}
+		out = append(out, chart)
+	}
+
+	return out, nil
+}
+
+func (u *DashboardUsecase) getClusterChartFromPrometheus(ctx context.Context, thanosClient thanos.ThanosClient, organizationId string, clusterId string, chartType domain.ChartType, duration string, interval string, nodeNames map[string]string) (domain.DashboardChart, error) {
+	query, multiplier := getClusterChartQuery(chartType, clusterId, interval)
+	if query == "" {
+		return domain.DashboardChart{}, fmt.Errorf("No data")
+	}
+
+	now := int(time.Now().Unix())
+	durationSec, intervalSec := getDurationAndIntervalSec(duration, interval)
+	maxPoints := viper.GetInt("chart-max-points")
+	intervalSec = getLimitedIntervalSec(durationSec, intervalSec, maxPoints)
+
+	result, err := thanosClient.FetchRange(ctx, query, now-durationSec, now, intervalSec)
+	if err != nil {
+		return domain.DashboardChart{}, err
+	}
+
+	warnings := []string{}
+	xAxisData := []string{}
+	for _, val := range result.Data.Result {
+		for _, vals := range val.Values {
+			x := strconv.Itoa(int(math.Round(vals.([]interface{})[0].(float64))))
+			if !slices.Contains(xAxisData, x) {
+				xAxisData = append(xAxisData, x)
+			}
+		}
+	}
+	sort.Slice(xAxisData, func(i, j int) bool {
+		a, _ := strconv.Atoi(xAxisData[i])
+		b, _ := strconv.Atoi(xAxisData[j])
+		return a < b
+	})
+	if maxPoints > 0 && len(xAxisData) > maxPoints {
+		warnings = append(warnings, fmt.Sprintf("points are downsampled to %d of %d", maxPoints, len(xAxisData)))
+		xAxisData = downsampleAxis(xAxisData, maxPoints)
+	}
+
+	// 노드 이름 순으로 정렬하여 series 순서가 조회마다 바뀌지 않도록 한다.
+	results := result.Data.Result
+	names := make([]string, len(results))
+	for i, val := range results {
+		names[i] = val.Metric.Instance
+		if nodeName, ok := nodeNames[strings.Split(val.Metric.Instance, ":")[0]]; ok {
+			names[i] = nodeName
+		}
+	}
+	order := make([]int, len(results))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return names[order[i]] < names[order[j]]
+	})
+
+	maxSeries := viper.GetInt("chart-max-series")
+	seriesNames := []string{}
+	seriesValues := [][]float64{}
+	for _, idx := range order {
+		if maxSeries > 0 && len(seriesValues) >= maxSeries {
+			break
+		}
+		yAxisData := make([]float64, len(xAxisData))
+		for i, xAxis := range xAxisData {
+			y, ok := getChartYValue(results[idx].Values, xAxis)
+			if !ok {
+				y = math.NaN()
+			} else {
+				y = y * multiplier
+			}
+			yAxisData[i] = y
+		}
+		seriesNames = append(seriesNames, names[idx])
+		seriesValues = append(seriesValues, yAxisData)
+	}
+	if maxSeries > 0 && len(results) > maxSeries {
+		warnings = append(warnings, fmt.Sprintf("series are limited to %d of %d", maxSeries, len(results)))
+	}
+
+	chartData := domain.ChartData{}
+	format, divisor := getChartFormat(clusterChartUnits[chartType], seriesValues)
+	for i, values := range seriesValues {
+		chartData.Series = append(chartData.Series, domain.Unit{
+			Name: seriesNames[i],
+			Data: formatChartValues(values, divisor, format.Precision),
+		})
+	}
+	chartData.XAxis = &domain.Axis{}
+	chartData.XAxis.Data = xAxisData
+
+	return domain.DashboardChart{
+		ChartType:      chartType,
+		OrganizationId: organizationId,
+		ClusterId:      clusterId,
+		Name:           chartType.String(),
+		Description:    "노드별 " + chartType.String() + " 통계 데이터",
+		Duration:       duration,
+		Interval:       interval,
+		ChartData:      chartData,
+		Format:         format,
+		Warnings:       warnings,
+		UpdatedAt:      time.Now(),
+	}, nil
+}
+
+// getClusterChartQuery 는 taco_cluster label 로 stack 을 한정한 노드(instance)별 query 와 값에 곱할 배수를 반환한다.
+func getClusterChartQuery(chartType domain.ChartType, clusterId string, interval string) (string, float64) {
+	switch chartType {
+	case domain.ChartType_CPU:
+		return fmt.Sprintf("avg by (instance) (1-irate(node_cpu_seconds_total{mode=\"idle\", taco_cluster=\"%s\"}[%s]))", clusterId, interval), 100
+	case domain.ChartType_MEMORY:
+		return fmt.Sprintf("1 - sum by (instance) (node_memory_MemAvailable_bytes{taco_cluster=\"%s\"}) / sum by (instance) (node_memory_MemTotal_bytes{taco_cluster=\"%s\"})", clusterId, clusterId), 100
+	case domain.ChartType_DISK:
+		return fmt.Sprintf("1 - sum by (instance) (node_filesystem_avail_bytes{taco_cluster=\"%s\", mountpoint=\"/\"}) / sum by (instance) (node_filesystem_size_bytes{taco_cluster=\"%s\", mountpoint=\"/\"})", clusterId, clusterId), 100
+	case domain.ChartType_NETWORK:
+		return fmt.Sprintf("sum by (instance) (irate(node_network_receive_bytes_total{taco_cluster=\"%s\", device!=\"lo\"}[%s])) + sum by (instance) (irate(node_network_transmit_bytes_total{taco_cluster=\"%s\", device!=\"lo\"}[%s]))", clusterId, interval, clusterId, interval), 1
+	}
+	return "", 1
+}
@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultClusterEventPeriod = time.Hour
+	maxClusterEventPeriod     = 24 * time.Hour
+	// cluster 마다 조회하는 event 의 최대 개수이다. 넘는 event 는 버린다.
+	maxClusterEventsPerCluster = 1000
+	// event 와 알림의 발생 시각 차이가 이 값 이내이면 같은 장애로 본다.
+	clusterEventAlertWindow = 15 * time.Minute
+)
+
+// GetClusterEvents 는 조직의 RUNNING cluster 에서 기간 중 발생한 Warning event 를 모아 최근 것부터 반환한다.
+// 같은 대상에서 같은 사유로 반복된 event 는 하나로 묶고, 같은 대상에서 비슷한 시각에 발생한 시스템 알림을 연결한다.
+// 조회에 실패한 cluster 는 Clusters 의 Error 에 사유를 담고 나머지 결과를 반환한다.
+func (u *DashboardUsecase) GetClusterEvents(ctx context.Context, organizationId string, period time.Duration, pg *pagination.Pagination) (out domain.GetDashboardClusterEventsResponse, err error) {
+	if period <= 0 {
+		period = defaultClusterEventPeriod
+	}
+	if period > maxClusterEventPeriod {
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("period must be less than %s", maxClusterEventPeriod), "D_INVALID_CLUSTER_EVENT_QUERY", "")
+	}
+
+	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
+	if err != nil {
+		return out, httpErrors.NewInternalServerError(err, "", "")
+	}
+	targets := []model.Cluster{}
+	for _, cluster := range clusters {
+		if cluster.Status == domain.ClusterStatus_RUNNING {
+			targets = append(targets, cluster)
+		}
+	}
+
+	end := time.Now()
+	start := end.Add(-period)
+	results := make([][]domain.DashboardClusterEvent, len(targets))
+	out.Clusters = make([]domain.DashboardClusterEventSource, len(targets))
+	queries := make([]func(ctx context.Context), len(targets))
+	for i, cluster := range targets {
+		i, cluster := i, cluster
+		out.Clusters[i] = domain.DashboardClusterEventSource{ClusterId: cluster.ID.String(), ClusterName: cluster.Name}
+		queries[i] = func(ctx context.Context) {
+			events, err := fetchClusterWarningEvents(ctx, cluster, start)
+			if err != nil {
+				log.Warnf(ctx, "failed to fetch events of cluster %s. err : %s", cluster.ID, err)
+				out.Clusters[i].Error = err.Error()
+				return
+			}
+			results[i] = events
+			out.Clusters[i].Count = len(events)
+		}
+	}
+	runDashboardQueries(ctx, queries...)
+
+	events := []domain.DashboardClusterEvent{}
+	for _, result := range results {
+		events = append(events, result...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastAt.After(events[j].LastAt)
+	})
+
+	// 알림 조회에 실패해도 event 는 반환한다.
+	notifications, err := u.systemNotificationRepo.FetchByPeriod(ctx, organizationId, start.Add(-clusterEventAlertWindow), end)
+	if err != nil {
+		log.Error(ctx, err)
+	}
+	correlateClusterEventAlerts(events, notifications)
+
+	if pg == nil {
+		pg = pagination.NewPagination(nil)
+	}
+	pg.TotalRows = int64(len(events))
+	pg.TotalPages = int(math.Ceil(float64(len(events)) / float64(pg.GetLimit())))
+	out.Events = []domain.DashboardClusterEvent{}
+	if offset := pg.GetOffset(); offset < len(events) {
+		out.Events = events[offset:min(offset+pg.GetLimit(), len(events))]
+	}
+	return out, nil
+}
+
+// fetchClusterWarningEvents 는 since 이후의 Warning event 를 대상, 사유 별로 묶어 반환한다.
+func fetchClusterWarningEvents(ctx context.Context, cluster model.Cluster, since time.Time) ([]domain.DashboardClusterEvent, error) {
+	clientset, err := kubernetes.GetCachedClientFromClusterId(ctx, cluster.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	list, err := clientset.CoreV1().Events("").List(ctx, metav1.ListOptions{
+		FieldSelector: "type=" + corev1.EventTypeWarning,
+		Limit:         maxClusterEventsPerCluster,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := []domain.DashboardClusterEvent{}
+	index := map[string]int{}
+	for _, event := range list.Items {
+		firstAt, lastAt, count := clusterEventTimes(event)
+		if lastAt.Before(since) {
+			continue
+		}
+
+		object := event.InvolvedObject
+		key := strings.Join([]string{object.Namespace, object.Kind, object.Name, event.Reason}, "/")
+		if i, ok := index[key]; ok {
+			merged := &out[i]
+			merged.Count += count
+			if firstAt.Before(merged.FirstAt) {
+				merged.FirstAt = firstAt
+			}
+			if lastAt.After(merged.LastAt) {
+				merged.LastAt = lastAt
+				merged.Message = event.Message
+			}
+			continue
+		}
+
+		source := event.Source.Component
+		if source == "" {
+			source = event.ReportingController
+		}
+		index[key] = len(out)
+		out = append(out, domain.DashboardClusterEvent{
+			ClusterId:   cluster.ID.String(),
+			ClusterName: cluster.Name,
+			Namespace:   object.Namespace,
+			Kind:        object.Kind,
+			Name:        object.Name,
+			Reason:      event.Reason,
+			Message:     event.Message,
+			Source:      source,
+			Count:       count,
+			FirstAt:     firstAt,
+			LastAt:      lastAt,
+			Alerts:      []domain.DashboardClusterEventAlert{},
+		})
+	}
+	return out, nil
+}
+
+// clusterEventTimes 는 events.k8s.io 로 기록된 event 의 series 와 core/v1 event 의 timestamp 를 모두 처리한다.
+func clusterEventTimes(event corev1.Event) (firstAt time.Time, lastAt time.Time, count int32) {
+	firstAt, lastAt, count = event.FirstTimestamp.Time, event.LastTimestamp.Time, event.Count
+	if firstAt.IsZero() {
+		firstAt = event.EventTime.Time
+	}
+	if firstAt.IsZero() {
+		firstAt = event.CreationTimestamp.Time
+	}
+	if event.Series != nil {
+		lastAt = event.Series.LastObservedTime.Time
+		count = event.Series.Count
+	}
+	if lastAt.IsZero() {
+		lastAt = firstAt
+	}
+	if count <= 0 {
+		count = 1
+	}
+	return
+}
+
+// correlateClusterEventAlerts 는 같은 cluster 에서 event 의 대상(pod, node, namespace)에 대해 event 발생 기간 전후로 생성된 알림을 연결한다.
+func correlateClusterEventAlerts(events []domain.DashboardClusterEvent, notifications []model.SystemNotification) {
+	type alertTarget struct {
+		namespace string
+		pod       string
+	}
+	targets := make([]alertTarget, len(notifications))
+	for i, notification := range notifications {
+		var rawData domain.SystemNotificationRequest
+		if len(notification.RawData) > 0 && json.Unmarshal(notification.RawData, &rawData) == nil {
+			targets[i] = alertTarget{namespace: rawData.Labels.Namespace, pod: rawData.Labels.Pod}
+		}
+	}
+
+	for i := range events {
+		event := &events[i]
+		for j, notification := range notifications {
+			if notification.ClusterId.String() != event.ClusterId {
+				continue
+			}
+			if notification.CreatedAt.Before(event.FirstAt.Add(-clusterEventAlertWindow)) || notification.CreatedAt.After(event.LastAt.Add(clusterEventAlertWindow)) {
+				continue
+			}
+
+			target := targets[j]
+			matched := false
+			switch event.Kind {
+			case "Node":
+				matched = notification.Node != "" && notification.Node == event.Name
+			case "Pod":
+				matched = target.namespace == event.Namespace && target.pod == event.Name
+			default:
+				// Deployment, ReplicaSet 등은 pod 이름이 workload 이름으로 시작한다.
+				matched = target.namespace == event.Namespace && target.pod != "" && strings.HasPrefix(target.pod, event.Name)
+			}
+			if !matched {
+				continue
+			}
+			event.Alerts = append(event.Alerts, domain.DashboardClusterEventAlert{
+				ID:           notification.ID.String(),
+				Name:         notification.Name,
+				Severity:     notification.Severity,
+				Status:       notification.Status.String(),
+				MessageTitle: notification.MessageTitle,
+				CreatedAt:    notification.CreatedAt,
+			})
+		}
+	}
+}
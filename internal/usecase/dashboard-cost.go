@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
+)
+
+const bytesPerGib = float64(1024 * 1024 * 1024)
+
+var (
+	machineCpuCoresQuery    = "sum by (taco_cluster) (machine_cpu_cores)"
+	machineMemoryBytesQuery = "sum by (taco_cluster) (machine_memory_bytes)"
+)
+
+// GetCosts 는 스택의 현재 cpu core, memory 용량에 클라우드 계정의 단가를 곱해 예상 월 비용을 계산한다.
+// 단가가 등록되지 않은 클라우드 계정의 스택은 Priced 가 false 이며 합계에서 제외한다.
+func (u *DashboardUsecase) GetCosts(ctx context.Context, organizationId string) (out domain.DashboardCost, err error) {
+	if _, err = u.organizationRepo.Get(ctx, organizationId); err != nil {
+		return out, httpErrors.NewNotFoundError(err, "", "")
+	}
+	out.Errors = make(map[string]string)
+
+	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
+	if err != nil {
+		return out, httpErrors.NewInternalServerError(err, "", "")
+	}
+
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return out, err
+	}
+
+	var cpu, memory []thanos.MetricDataResult
+	errs := make([]error, 2)
+	runDashboardQueries(ctx,
+		func(ctx context.Context) {
+			result, err := thanosClient.Get(ctx, machineCpuCoresQuery)
+			if err != nil {
+				errs[0] = err
+				return
+			}
+			cpu = result.Data.Result
+		},
+		func(ctx context.Context) {
+			result, err := thanosClient.Get(ctx, machineMemoryBytesQuery)
+			if err != nil {
+				errs[1] = err
+				return
+			}
+			memory = result.Data.Result
+		},
+	)
+	for i, widget := range []string{"cpu", "memory"} {
+		if errs[i] != nil {
+			out.Errors[widget] = errs[i].Error()
+		}
+	}
+
+	out.Stacks, out.MonthlyCosts, err = u.estimateStackCosts(ctx, organizationId, clusters, cpu, memory)
+	if err != nil {
+		return out, httpErrors.NewInternalServerError(err, "", "")
+	}
+	return out, nil
+}
+
+// estimateStackCosts 는 taco_cluster 별 cpu, memory 조회 결과로 RUNNING 스택의 비용과 통화별 합계를 계산한다.
+func (u *DashboardUsecase) estimateStackCosts(ctx context.Context, organizationId string, clusters []model.Cluster, cpu []thanos.MetricDataResult, memory []thanos.MetricDataResult) ([]domain.DashboardStackCost, map[string]float64, error) {
+	pricings, err := u.cloudAccountPricingRepo.Fetch(ctx, organizationId)
+	if err != nil {
+		return nil, nil, err
+	}
+	pricingByAccount := make(map[uuid.UUID]model.CloudAccountPricing, len(pricings))
+	for _, pricing := range pricings {
+		pricingByAccount[pricing.CloudAccountId] = pricing
+	}
+
+	cpuByCluster := metricValuesByCluster(cpu)
+	memoryByCluster := metricValuesByCluster(memory)
+
+	stacks := []domain.DashboardStackCost{}
+	totals := map[string]float64{}
+	for _, cluster := range clusters {
+		if cluster.Status != domain.ClusterStatus_RUNNING {
+			continue
+		}
+		stack := domain.DashboardStackCost{
+			StackId:     domain.StackId(cluster.ID),
+			StackName:   cluster.Name,
+			CpuCores:    cpuByCluster[cluster.ID.String()],
+			MemoryBytes: memoryByCluster[cluster.ID.String()],
+		}
+		if cluster.CloudAccountId != nil {
+			stack.CloudAccountId = cluster.CloudAccountId.String()
+			stack.CloudAccountName = cluster.CloudAccount.Name
+			if pricing, ok := pricingByAccount[*cluster.CloudAccountId]; ok {
+				hourly := stack.CpuCores*pricing.CpuCoreHourly + stack.MemoryBytes/bytesPerGib*pricing.MemoryGibHourly
+				stack.Currency = pricing.Currency
+				stack.MonthlyCost = roundCost(hourly * domain.CostHoursPerMonth)
+				stack.Priced = true
+				totals[pricing.Currency] += stack.MonthlyCost
+			}
+		}
+		stacks = append(stacks, stack)
+	}
+	for currency, total := range totals {
+		totals[currency] = roundCost(total)
+	}
+	return stacks, totals, nil
+}
+
+func metricValuesByCluster(result []thanos.MetricDataResult) map[string]float64 {
+	out := make(map[string]float64, len(result))
+	for _, val := range result {
+		if v, ok := getMetricValue(val.Value); ok && v > 0 {
+			out[val.Metric.TacoCluster] += v
+		}
+	}
+	return out
+}
+
+func roundCost(cost float64) float64 {
+	return math.Round(cost*100) / 100
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// 사용자 정의 chart 는 chart 캐시와 getChartFromPrometheus 에서 "CUSTOM:<customChartId>" 로 구분한다.
+const customChartTypePrefix = "CUSTOM:"
+
+func (u *DashboardUsecase) CreateCustomChart(ctx context.Context, dto model.CustomChart) (customChartId uuid.UUID, err error) {
+	if _, err = u.organizationRepo.Get(ctx, dto.OrganizationId); err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INVALID_ORGANIZATION_ID")
+	}
+	if _, err = u.customChartRepo.GetByName(ctx, dto.OrganizationId, dto.Name); err == nil {
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("duplicate custom chart name"), "D_CREATE_ALREADY_EXISTED_NAME")
+	}
+	if err = u.validateCustomChartQuery(ctx, dto.OrganizationId, dto.Query); err != nil {
+		return uuid.Nil, err
+	}
+
+	if user, ok := request.UserFrom(ctx); ok {
+		userId := user.GetUserId()
+		dto.CreatorId = &userId
+	}
+
+	customChartId, err = u.customChartRepo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return customChartId, nil
+}
+
+func (u *DashboardUsecase) GetCustomCharts(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.CustomChart, error) {
+	return u.customChartRepo.Fetch(ctx, organizationId, pg)
+}
+
+func (u *DashboardUsecase) GetCustomChart(ctx context.Context, organizationId string, customChartId uuid.UUID) (out model.CustomChart, err error) {
+	out, err = u.customChartRepo.Get(ctx, customChartId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewError(err, "D_NOT_FOUND_CUSTOM_CHART")
+		}
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if out.OrganizationId != organizationId {
+		return model.CustomChart{}, httpErrors.NewError(fmt.Errorf("Not found custom chart"), "D_NOT_FOUND_CUSTOM_CHART")
+	}
+	return out, nil
+}
+
+func (u *DashboardUsecase) UpdateCustomChart(ctx context.Context, dto model.CustomChart) error {
+	customChart, err := u.GetCustomChart(ctx, dto.OrganizationId, dto.ID)
+	if err != nil {
+		return err
+	}
+	if customChart.Name != dto.Name {
+		if _, err = u.customChartRepo.GetByName(ctx, dto.OrganizationId, dto.Name); err == nil {
+			return httpErrors.NewError(fmt.Errorf("duplicate custom chart name"), "D_CREATE_ALREADY_EXISTED_NAME")
+		}
+	}
+	if customChart.Query != dto.Query {
+		if err = u.validateCustomChartQuery(ctx, dto.OrganizationId, dto.Query); err != nil {
+			return err
+		}
+	}
+
+	if err = u.customChartRepo.Update(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	// 이전 query 로 조회한 chart 가 캐시에서 반환되지 않도록 조직의 chart 캐시 세대를 바꾼다.
+	u.cache.Delete(chartGenerationCacheKey(dto.OrganizationId))
+	return nil
+}
+
+func (u *DashboardUsecase) DeleteCustomChart(ctx context.Context, organizationId string, customChartId uuid.UUID) error {
+	if _, err := u.GetCustomChart(ctx, organizationId, customChartId); err != nil {
+		return err
+	}
+	if err := u.customChartRepo.Delete(ctx, customChartId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	u.cache.Delete(chartGenerationCacheKey(organizationId))
+	return nil
+}
+
+// GetCustomChartData 는 사용자 정의 chart 를 기본 chart 와 같은 ChartData 형식으로 조회한다.
+func (u *DashboardUsecase) GetCustomChartData(ctx context.Context, organizationId string, customChartId uuid.UUID, duration string, interval string) (domain.DashboardChart, error) {
+	if _, err := u.GetCustomChart(ctx, organizationId, customChartId); err != nil {
+		return domain.DashboardChart{}, err
+	}
+	timezone, err := u.getChartTimezone(ctx, "")
+	if err != nil {
+		timezone = defaultChartTimezone
+	}
+	return u.getCachedChart(ctx, organizationId, customChartType(customChartId), duration, interval, "", "", "", timezone)
+}
+
+// validateCustomChartQuery 는 조직의 thanos 에 instant query 를 실행하여 PromQL 이 유효한지 확인한다.
+func (u *DashboardUsecase) validateCustomChartQuery(ctx context.Context, organizationId string, query string) error {
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return httpErrors.NewError(err, "D_INVALID_PRIMARY_STACK")
+	}
+	if _, err := thanosClient.Get(ctx, query); err != nil {
+		log.Info(ctx, err)
+		return httpErrors.NewError(fmt.Errorf("invalid query. %s", err), "D_INVALID_CUSTOM_CHART_QUERY")
+	}
+	return nil
+}
+
+func customChartType(customChartId uuid.UUID) string {
+	return customChartTypePrefix + customChartId.String()
+}
+
+func parseCustomChartType(chartType string) (uuid.UUID, bool) {
+	if !strings.HasPrefix(chartType, customChartTypePrefix) {
+		return uuid.Nil, false
+	}
+	customChartId, err := uuid.Parse(strings.TrimPrefix(chartType, customChartTypePrefix))
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return customChartId, true
+}
+
+func getCustomChartThresholds(customChart model.CustomChart) []domain.ChartThreshold {
+	thresholds := []domain.ChartThreshold{}
+	if len(customChart.Thresholds) == 0 {
+		return thresholds
+	}
+	if err := json.Unmarshal(customChart.Thresholds, &thresholds); err != nil {
+		return []domain.ChartThreshold{}
+	}
+	return thresholds
+}
+
+// getCustomChartSeriesName 은 taco_cluster label 이 없는 사용자 정의 query 결과의 series 이름을 만든다.
+func getCustomChartSeriesName(metric thanos.MetricDataResultMetric, index int) string {
+	names := []string{}
+	for _, v := range []string{metric.Name, metric.Namespace, metric.Instance, metric.Pvc} {
+		if v != "" {
+			names = append(names, v)
+		}
+	}
+	if len(names) == 0 {
+		return fmt.Sprintf("series-%d", index+1)
+	}
+	return strings.Join(names, "/")
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+// GetHealth 는 대시보드가 비어 보일 때 원인을 찾을 수 있도록 primary cluster 접속, thanos service, thanos 조회를 차례로 점검한다.
+// thanos 조회는 보조 LMA endpoint 로도 가능하므로 primary cluster 점검이 실패해도 수행한다.
+func (u *DashboardUsecase) GetHealth(ctx context.Context, organizationId string) (out domain.GetDashboardHealthResponse, err error) {
+	organization, err := u.organizationRepo.Get(ctx, organizationId)
+	if err != nil {
+		return out, httpErrors.NewNotFoundError(err, "", "")
+	}
+	out.CheckedAt = time.Now()
+
+	var clientset *k8s.Clientset
+	cluster := checkDashboardHealth(ctx, domain.DashboardHealthComponent_PRIMARY_CLUSTER, func(ctx context.Context) (string, error) {
+		if organization.PrimaryClusterId == "" {
+			return "", fmt.Errorf("primary cluster is not set")
+		}
+		primaryCluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(organization.PrimaryClusterId))
+		if err != nil {
+			return "", fmt.Errorf("primary cluster %s is not found", organization.PrimaryClusterId)
+		}
+		if primaryCluster.Status != domain.ClusterStatus_RUNNING {
+			return "", fmt.Errorf("primary cluster %s is %s", primaryCluster.ID, primaryCluster.Status)
+		}
+		if clientset, err = kubernetes.GetClientFromClusterId(ctx, organization.PrimaryClusterId); err != nil {
+			return "", fmt.Errorf("failed to load kubeconfig of primary cluster. %s", err)
+		}
+		if _, err := clientset.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Raw(); err != nil {
+			clientset = nil
+			return "", fmt.Errorf("primary cluster %s is not reachable. %s", primaryCluster.ID, err)
+		}
+		return fmt.Sprintf("primary cluster %s is reachable", primaryCluster.ID), nil
+	})
+
+	service := domain.DashboardHealthComponent{
+		Name:    domain.DashboardHealthComponent_THANOS_SERVICE,
+		Status:  domain.DashboardHealthStatus_SKIPPED,
+		Message: "primary cluster is not reachable",
+	}
+	if clientset != nil {
+		service = checkDashboardHealth(ctx, domain.DashboardHealthComponent_THANOS_SERVICE, func(ctx context.Context) (string, error) {
+			discovery, err := getLmaDiscovery(ctx, u.lmaEndpointRepo, organizationId)
+			if err != nil {
+				return "", err
+			}
+			thanosService, err := getThanosService(ctx, clientset, discovery)
+			if err != nil {
+				return "", fmt.Errorf("thanos query service is not found in namespace %s. %s", discovery.Namespace, err)
+			}
+			return fmt.Sprintf("%s/%s (%s)", thanosService.Namespace, thanosService.Name, thanosService.Spec.Type), nil
+		})
+	}
+
+	query := checkDashboardHealth(ctx, domain.DashboardHealthComponent_THANOS_QUERY, func(ctx context.Context) (string, error) {
+		thanosClient, err := u.thanosClients.Get(ctx, organizationId)
+		if err != nil {
+			return "", fmt.Errorf("failed to find lma endpoint. %s", err)
+		}
+		result, err := thanosClient.Get(ctx, "vector(1)")
+		if err != nil {
+			return "", fmt.Errorf("failed to query thanos. %s", err)
+		}
+		if result.Status != "success" || len(result.Data.Result) == 0 {
+			return "", fmt.Errorf("thanos returned no result. status [%s]", result.Status)
+		}
+		for _, endpoint := range u.thanosClients.Health(organizationId) {
+			if endpoint.Active {
+				return fmt.Sprintf("query succeeded on %s", endpoint.Url), nil
+			}
+		}
+		return "query succeeded", nil
+	})
+
+	out.Components = []domain.DashboardHealthComponent{cluster, service, query}
+	out.Status = domain.DashboardHealthStatus_HEALTHY
+	switch {
+	case query.Status != domain.DashboardHealthStatus_HEALTHY:
+		out.Status = domain.DashboardHealthStatus_UNHEALTHY
+	case cluster.Status != domain.DashboardHealthStatus_HEALTHY || service.Status != domain.DashboardHealthStatus_HEALTHY:
+		out.Status = domain.DashboardHealthStatus_DEGRADED
+	}
+	return out, nil
+}
+
+// checkDashboardHealth 는 점검 하나를 제한 시간 안에 수행하고 결과와 소요 시간을 기록한다.
+func checkDashboardHealth(ctx context.Context, name string, check func(ctx context.Context) (string, error)) domain.DashboardHealthComponent {
+	ctx, cancel := context.WithTimeout(ctx, lmaEndpointHealthTimeout)
+	defer cancel()
+
+	start := time.Now()
+	message, err := check(ctx)
+	out := domain.DashboardHealthComponent{
+		Name:      name,
+		Status:    domain.DashboardHealthStatus_HEALTHY,
+		Message:   message,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		out.Status = domain.DashboardHealthStatus_UNHEALTHY
+		out.Message = err.Error()
+	}
+	return out
+}
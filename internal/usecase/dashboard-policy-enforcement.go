@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
+	"github.com/spf13/viper"
+)
+
+var policyEngines = []string{domain.PolicyEngine_GATEKEEPER, domain.PolicyEngine_KYVERNO}
+
+// getPolicyEnforcementQueries 는 정책 엔진별로 정책, 조치, 클러스터별 위반 수 query 와 클러스터별 위반 수 추이 range query 를 반환한다.
+// gatekeeper 의 위반 수는 audit 결과인 gauge 이므로 현재 값을 사용하고, 추이는 interval 동안의 최대값을 사용한다.
+// kyverno 의 정책 검사 결과는 누적 counter 이므로 조회 기간과 interval 동안 실패한 검사 수를 사용한다.
+func getPolicyEnforcementQueries(engine string, selector string, durationSec int, intervalSec int) (violation string, trend string) {
+	switch engine {
+	case domain.PolicyEngine_GATEKEEPER:
+		violation = fmt.Sprintf("sum by (taco_cluster, kind, name, violation_enforcement) (opa_scorecard_constraint_violations{%s})", selector)
+		trend = fmt.Sprintf("sum by (taco_cluster) (max_over_time(opa_scorecard_constraint_violations{%s}[%ds]))", selector, intervalSec)
+	case domain.PolicyEngine_KYVERNO:
+		selector = "rule_result=\"fail\"," + selector
+		violation = fmt.Sprintf("sum by (taco_cluster, policy_name, policy_validation_mode) (increase(kyverno_policy_results_total{%s}[%ds]))", selector, durationSec)
+		trend = fmt.Sprintf("sum by (taco_cluster) (increase(kyverno_policy_results_total{%s}[%ds]))", selector, intervalSec)
+	}
+	return violation, trend
+}
+
+// GetPolicyEnforcementStatus 는 조직의 클러스터들에 대해 gatekeeper, kyverno 의 정책별, 클러스터별 위반 수와 클러스터별 위반 수 추이를 반환한다.
+// 위반 수와 추이는 따로 조회하므로 한 조회가 실패해도 나머지 결과는 반환하고, 실패한 조회는 warnings 에 담는다.
+func (u *DashboardUsecase) GetPolicyEnforcementStatus(ctx context.Context, organizationId string, duration string, interval string) (out domain.GetDashboardPolicyEnforcementStatusResponse, err error) {
+	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
+	if err != nil {
+		return out, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTERS")
+	}
+
+	now := time.Now()
+	durationSec, intervalSec := getDurationAndIntervalSec(duration, interval)
+	intervalSec = getLimitedIntervalSec(durationSec, intervalSec, viper.GetInt("chart-max-points"))
+	start := alignToInterval(int(now.Unix())-durationSec, intervalSec, now.Location())
+
+	xAxisData := []string{}
+	for x := start; x <= int(now.Unix()); x += intervalSec {
+		xAxisData = append(xAxisData, strconv.Itoa(x))
+	}
+
+	out = domain.GetDashboardPolicyEnforcementStatusResponse{
+		OrganizationId: organizationId,
+		Duration:       duration,
+		Interval:       interval,
+		XAxis:          &domain.Axis{Data: xAxisData},
+		Clusters:       []domain.DashboardPolicyEnforcementCluster{},
+		Policies:       []domain.DashboardPolicyEnforcementPolicy{},
+		UpdatedAt:      now,
+	}
+	if len(clusters) == 0 {
+		return out, nil
+	}
+
+	clusterIds := make([]string, len(clusters))
+	clusterIndex := make(map[string]int, len(clusters))
+	for i, cluster := range clusters {
+		clusterIds[i] = cluster.ID.String()
+		clusterIndex[cluster.ID.String()] = i
+		out.Clusters = append(out.Clusters, domain.DashboardPolicyEnforcementCluster{
+			ClusterId:   cluster.ID.String(),
+			ClusterName: cluster.Name,
+			Trend:       make([]int, len(xAxisData)),
+		})
+	}
+
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return out, httpErrors.NewError(err, "D_FAILED_FETCH_POLICY_VIOLATIONS")
+	}
+
+	selector := fmt.Sprintf("taco_cluster=~\"%s\"", strings.Join(clusterIds, "|"))
+	policies := make(map[string]*domain.DashboardPolicyEnforcementPolicy)
+	failed := 0
+	for _, engine := range policyEngines {
+		violationQuery, trendQuery := getPolicyEnforcementQueries(engine, selector, durationSec, intervalSec)
+		violations, err := thanosClient.Get(ctx, violationQuery)
+		if err != nil {
+			log.Error(ctx, err)
+			failed++
+			out.Warnings = append(out.Warnings, fmt.Sprintf("failed to get %s violations. err : %s", engine, err))
+		}
+
+		for _, res := range violations.Data.Result {
+			i, ok := clusterIndex[res.Metric.TacoCluster]
+			if !ok {
+				continue
+			}
+			count := getPolicyViolationCount(res.Value)
+			if count == 0 {
+				continue
+			}
+
+			kind, name, action := getPolicyEnforcementLabels(engine, res.Metric)
+			key := strings.Join([]string{engine, kind, name, action}, "/")
+			policy, ok := policies[key]
+			if !ok {
+				policy = &domain.DashboardPolicyEnforcementPolicy{Engine: engine, Kind: kind, Name: name, EnforcementAction: action}
+				policies[key] = policy
+			}
+			addPolicyViolationClusterCount(policy, out.Clusters[i], count)
+
+			out.Clusters[i].Total += count
+			switch action {
+			case domain.PolicyEnforcementAction_WARN:
+				out.Clusters[i].Warn += count
+			case domain.PolicyEnforcementAction_DRYRUN:
+				out.Clusters[i].Dryrun += count
+			default:
+				out.Clusters[i].Deny += count
+			}
+		}
+
+		trend, err := thanosClient.FetchRange(ctx, trendQuery, start, int(now.Unix()), intervalSec)
+		if err != nil {
+			log.Error(ctx, err)
+			failed++
+			out.Warnings = append(out.Warnings, fmt.Sprintf("failed to get %s violation trend. err : %s", engine, err))
+			continue
+		}
+		for _, res := range trend.Data.Result {
+			i, ok := clusterIndex[res.Metric.TacoCluster]
+			if !ok {
+				continue
+			}
+			for j, x := range xAxisData {
+				if y, ok := getChartYValue(res.Values, x); ok && !math.IsNaN(y) && !math.IsInf(y, 0) {
+					out.Clusters[i].Trend[j] += int(math.Round(y))
+				}
+			}
+		}
+	}
+	if failed == 2*len(policyEngines) {
+		return out, httpErrors.NewError(fmt.Errorf("failed to get policy violations of organization %s. err : %s", organizationId, out.Warnings[0]), "D_FAILED_FETCH_POLICY_VIOLATIONS")
+	}
+
+	for _, policy := range policies {
+		sort.Slice(policy.Clusters, func(i, j int) bool {
+			if policy.Clusters[i].Count != policy.Clusters[j].Count {
+				return policy.Clusters[i].Count > policy.Clusters[j].Count
+			}
+			return policy.Clusters[i].ClusterName < policy.Clusters[j].ClusterName
+		})
+		out.Policies = append(out.Policies, *policy)
+	}
+	sort.Slice(out.Policies, func(i, j int) bool {
+		a, b := out.Policies[i], out.Policies[j]
+		if a.Total != b.Total {
+			return a.Total > b.Total
+		}
+		return strings.Join([]string{a.Engine, a.Kind, a.Name, a.EnforcementAction}, "/") <
+			strings.Join([]string{b.Engine, b.Kind, b.Name, b.EnforcementAction}, "/")
+	})
+
+	return out, nil
+}
+
+// getPolicyEnforcementLabels 는 정책 엔진별 label 을 정책 종류, 이름, 조치로 맞춘다.
+// gatekeeper 의 violation_enforcement 가 비어 있으면 기본 조치인 deny 이다.
+func getPolicyEnforcementLabels(engine string, metric thanos.MetricDataResultMetric) (kind string, name string, action string) {
+	if engine == domain.PolicyEngine_KYVERNO {
+		action = domain.PolicyEnforcementAction_DRYRUN
+		if strings.EqualFold(metric.PolicyValidationMode, "enforce") {
+			action = domain.PolicyEnforcementAction_DENY
+		}
+		return "", metric.PolicyName, action
+	}
+
+	action = metric.ViolationEnforcement
+	if action == "" {
+		action = domain.PolicyEnforcementAction_DENY
+	}
+	return metric.Kind, metric.ConstraintName, action
+}
+
+func getPolicyViolationCount(value []interface{}) int {
+	f, ok := getMetricValue(value)
+	if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0
+	}
+	return int(math.Round(f))
+}
+
+// addPolicyViolationClusterCount 는 deny 와 기본 조치처럼 같은 정책으로 묶이는 결과를 클러스터 하나로 합친다.
+func addPolicyViolationClusterCount(policy *domain.DashboardPolicyEnforcementPolicy, cluster domain.DashboardPolicyEnforcementCluster, count int) {
+	policy.Total += count
+	for i := range policy.Clusters {
+		if policy.Clusters[i].ClusterId == cluster.ClusterId {
+			policy.Clusters[i].Count += count
+			return
+		}
+	}
+	policy.Clusters = append(policy.Clusters, domain.DashboardPolicyEnforcementClusterCount{
+		ClusterId:   cluster.ClusterId,
+		ClusterName: cluster.ClusterName,
+		Count:       count,
+	})
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	dashboardQueryConcurrency    = 4
+	defaultDashboardQueryTimeout = 20 * time.Second
+)
+
+// runDashboardQueries 는 서로 독립적인 대시보드 조회를 최대 dashboardQueryConcurrency 개씩 동시에 실행하고 모두 끝날 때까지 기다린다.
+// 각 조회는 timeout 이 지나면 취소되므로 느린 조회 하나가 대시보드 전체 응답을 지연시키지 않는다.
+// 결과와 오류는 각 조회가 직접 기록한다.
+func runDashboardQueries(ctx context.Context, queries ...func(ctx context.Context)) {
+	timeout := dashboardQueryTimeout()
+	sem := make(chan struct{}, dashboardQueryConcurrency)
+	wg := sync.WaitGroup{}
+	for _, query := range queries {
+		wg.Add(1)
+		go func(query func(ctx context.Context)) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			ctx := ctx
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			query(ctx)
+		}(query)
+	}
+	wg.Wait()
+}
+
+func dashboardQueryTimeout() time.Duration {
+	if viper.IsSet("dashboard-query-timeout") {
+		return viper.GetDuration("dashboard-query-timeout")
+	}
+	return defaultDashboardQueryTimeout
+}
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// ChartStreamFunc 는 stream 으로 전달할 chart 를 받는다. incremental 이 false 이면 전체 구간의 chart 이고,
+// true 이면 직전 전송 이후에 추가된 구간의 chart 이다.
+type ChartStreamFunc func(incremental bool, charts []domain.DashboardChart) error
+
+var streamableChartTypes = []domain.ChartType{
+	domain.ChartType_CPU,
+	domain.ChartType_MEMORY,
+	domain.ChartType_POD,
+	domain.ChartType_TRAFFIC,
+}
+
+func IsStreamableChartType(chartType domain.ChartType) bool {
+	for _, t := range streamableChartTypes {
+		if t == chartType {
+			return true
+		}
+	}
+	return false
+}
+
+// StreamCharts 는 처음에 duration 구간 전체의 chart 를 전달하고, 이후 interval 마다 마지막으로 전달한 시점 이후의 데이터만 조회하여 전달한다.
+// 추가 데이터는 처음 전달한 chart 와 같은 단위(prefix)로 변환된다. ctx 가 취소되거나 send 가 실패하면 종료한다.
+func (u *DashboardUsecase) StreamCharts(ctx context.Context, organizationId string, chartTypes []domain.ChartType, duration string, interval string, aggregation domain.ChartAggregation, send ChartStreamFunc) error {
+	if _, err := u.organizationRepo.Get(ctx, organizationId); err != nil {
+		return errors.Wrap(err, "invalid organization")
+	}
+	if len(chartTypes) == 0 {
+		chartTypes = streamableChartTypes
+	}
+	for _, chartType := range chartTypes {
+		if !IsStreamableChartType(chartType) {
+			return fmt.Errorf("chart type %s is not streamable", chartType)
+		}
+	}
+
+	timezone, err := u.getChartTimezone(ctx, "")
+	if err != nil {
+		timezone = defaultChartTimezone
+	}
+
+	charts := make([]domain.DashboardChart, 0, len(chartTypes))
+	lastTimes := map[domain.ChartType]int{}
+	formats := map[domain.ChartType]domain.ChartFormat{}
+	for _, chartType := range chartTypes {
+		chart, err := u.getCachedChart(ctx, organizationId, chartType.String(), duration, interval, aggregation, "", "", timezone)
+		if err != nil {
+			log.Error(ctx, err)
+			chart = newErrorChart(organizationId, chartType, duration, interval, err)
+		}
+		lastTimes[chartType] = lastChartTime(chart)
+		formats[chartType] = chart.Format
+		if formats[chartType].Unit == "" {
+			formats[chartType] = domain.ChartFormat{Unit: chartUnits[chartType.String()], Precision: 2}
+		}
+		charts = append(charts, chart)
+	}
+	if err := send(false, charts); err != nil {
+		return err
+	}
+
+	_, intervalSec := getDurationAndIntervalSec(duration, interval)
+	ticker := time.NewTicker(time.Duration(intervalSec) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		updates := make([]domain.DashboardChart, 0, len(chartTypes))
+		for _, chartType := range chartTypes {
+			chart, err := u.getChartUpdate(ctx, organizationId, chartType, interval, intervalSec, aggregation, lastTimes[chartType], formats[chartType])
+			if err != nil {
+				log.Error(ctx, err)
+				updates = append(updates, newErrorChart(organizationId, chartType, duration, interval, err))
+				continue
+			}
+			if chart.ChartData.XAxis == nil || len(chart.ChartData.XAxis.Data) == 0 {
+				continue
+			}
+			chart.Duration = duration
+			lastTimes[chartType] = lastChartTime(chart)
+			updates = append(updates, chart)
+		}
+		if len(updates) == 0 {
+			continue
+		}
+		if err := send(true, updates); err != nil {
+			return err
+		}
+	}
+}
+
+// getChartUpdate 는 since 이후의 데이터만 조회하여 format 의 단위로 변환한 chart 를 반환한다.
+func (u *DashboardUsecase) getChartUpdate(ctx context.Context, organizationId string, chartType domain.ChartType, interval string, intervalSec int, aggregation domain.ChartAggregation, since int, format domain.ChartFormat) (res domain.DashboardChart, err error) {
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return res, err
+	}
+
+	if aggregation == "" {
+		aggregation = domain.ChartAggregation_AVG
+	}
+	query, aggregation := getChartQuery(chartType.String(), interval, aggregation)
+
+	now := int(time.Now().Unix())
+	start := since + intervalSec
+	if since == 0 {
+		start = now - intervalSec
+	}
+	if start > now {
+		return res, nil
+	}
+
+	result, err := thanosClient.FetchRange(ctx, query, start, now, intervalSec)
+	if err != nil {
+		return res, err
+	}
+
+	xAxisData := []string{}
+	seen := map[string]bool{}
+	for _, val := range result.Data.Result {
+		for _, vals := range val.Values {
+			x := strconv.Itoa(int(math.Round(vals.([]interface{})[0].(float64))))
+			if !seen[x] {
+				seen[x] = true
+				xAxisData = append(xAxisData, x)
+			}
+		}
+	}
+	sort.Slice(xAxisData, func(i, j int) bool {
+		a, _ := strconv.Atoi(xAxisData[i])
+		b, _ := strconv.Atoi(xAxisData[j])
+		return a < b
+	})
+
+	multiplier := 1.0
+	if chartType == domain.ChartType_CPU || chartType == domain.ChartType_MEMORY {
+		multiplier = 100
+	}
+	divisor := getChartDivisor(format)
+
+	chartData := domain.ChartData{XAxis: &domain.Axis{Data: xAxisData}}
+	for _, val := range result.Data.Result {
+		yAxisData := make([]float64, len(xAxisData))
+		for i, xAxis := range xAxisData {
+			y, ok := getChartYValue(val.Values, xAxis)
+			if !ok {
+				y = math.NaN()
+			}
+			yAxisData[i] = y * multiplier
+		}
+
+		clusterName, err := u.getClusterNameFromId(ctx, val.Metric.TacoCluster)
+		if err != nil {
+			clusterName = val.Metric.TacoCluster
+		}
+		chartData.Series = append(chartData.Series, domain.Unit{
+			Name: clusterName,
+			Data: formatChartValues(yAxisData, divisor, format.Precision),
+		})
+	}
+
+	return domain.DashboardChart{
+		ChartType:      chartType,
+		OrganizationId: organizationId,
+		Name:           chartType.String(),
+		Description:    chartType.String() + " 통계 데이터",
+		Interval:       interval,
+		Aggregation:    aggregation,
+		ChartData:      chartData,
+		Format:         format,
+		UpdatedAt:      time.Now(),
+	}, nil
+}
+
+func newErrorChart(organizationId string, chartType domain.ChartType, duration string, interval string, err error) domain.DashboardChart {
+	return domain.DashboardChart{
+		ChartType:      chartType,
+		OrganizationId: organizationId,
+		Name:           chartType.String(),
+		Duration:       duration,
+		Interval:       interval,
+		Error:          err.Error(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+// lastChartTime 은 chart 의 마지막 x 축 시각(unix time)을 반환한다.
+func lastChartTime(chart domain.DashboardChart) int {
+	if chart.ChartData.XAxis == nil || len(chart.ChartData.XAxis.Data) == 0 {
+		return 0
+	}
+	last, _ := strconv.Atoi(chart.ChartData.XAxis.Data[len(chart.ChartData.XAxis.Data)-1])
+	return last
+}
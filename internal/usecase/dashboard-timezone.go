@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const defaultChartTimezone = "UTC"
+
+// getChartTimezone 는 chart 의 날짜 구간을 나눌 timezone 을 반환한다.
+// 요청에 timezone 이 없으면 사용자가 설정한 timezone 을, 그것도 없으면 UTC 를 사용한다.
+func (u *DashboardUsecase) getChartTimezone(ctx context.Context, timezone string) (string, error) {
+	if timezone == "" {
+		if user, ok := request.UserFrom(ctx); ok && u.userRepo != nil {
+			storedUser, err := u.userRepo.GetByUuid(ctx, user.GetUserId())
+			if err != nil {
+				log.Info(ctx, err)
+			} else {
+				timezone = storedUser.Timezone
+			}
+		}
+	}
+	if timezone == "" {
+		return defaultChartTimezone, nil
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return "", httpErrors.NewBadRequestError(fmt.Errorf("invalid timezone %s", timezone), "D_INVALID_TIMEZONE", "")
+	}
+	return timezone, nil
+}
+
+// alignToInterval 은 start 를 loc 기준으로 interval 경계에 맞춘다.
+// 예를 들어 1d interval 은 UTC 자정이 아닌 loc 의 자정부터 구간이 시작된다.
+func alignToInterval(start int, intervalSec int, loc *time.Location) int {
+	if intervalSec <= 0 {
+		return start
+	}
+	_, offset := time.Unix(int64(start), 0).In(loc).Zone()
+	return start - (((start+offset)%intervalSec)+intervalSec)%intervalSec
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
+	"github.com/spf13/viper"
+)
+
+const defaultTopPods = 10
+
+// topPodQueries 는 metric 별로 순위를 정하는 instant query 와 pod 별 추이를 조회하는 range query 이다.
+// 순위 query 의 %s 는 조회 기간이고, 추이 query 의 %s 는 pod 조건과 interval 이다.
+var topPodQueries = []struct {
+	metric string
+	unit   domain.ChartUnit
+	rank   string
+	series string
+}{
+	{
+		metric: domain.TopPodMetric_RESTART,
+		unit:   domain.ChartUnit_COUNT,
+		rank:   "topk(%d, sum by (taco_cluster, namespace, pod) (increase(kube_pod_container_status_restarts_total[%s])) > 0)",
+		series: "sum by (taco_cluster, namespace, pod) (changes(kube_pod_container_status_restarts_total{%s}[%s]))",
+	},
+	{
+		metric: domain.TopPodMetric_CPU,
+		unit:   domain.ChartUnit_CORES,
+		rank:   "topk(%d, sum by (taco_cluster, namespace, pod) (rate(container_cpu_usage_seconds_total{container!=\"\",pod!=\"\"}[%s])))",
+		series: "sum by (taco_cluster, namespace, pod) (rate(container_cpu_usage_seconds_total{container!=\"\",%s}[%s]))",
+	},
+	{
+		metric: domain.TopPodMetric_MEMORY,
+		unit:   domain.ChartUnit_BYTES,
+		rank:   "topk(%d, sum by (taco_cluster, namespace, pod) (avg_over_time(container_memory_working_set_bytes{container!=\"\",pod!=\"\"}[%s])))",
+		series: "sum by (taco_cluster, namespace, pod) (avg_over_time(container_memory_working_set_bytes{container!=\"\",%s}[%s]))",
+	},
+}
+
+type topPodKey struct {
+	clusterId string
+	namespace string
+	pod       string
+}
+
+// getTopPodsChart 는 조회 기간 동안 재기동, CPU, memory 가 많은 pod 순위를 반환한다.
+// metric 하나의 조회가 실패해도 나머지 순위는 반환하고, 실패한 순위에 오류를 담는다.
+func (u *DashboardUsecase) getTopPodsChart(ctx context.Context, thanosClient thanos.ThanosClient, organizationId string, duration string, interval string, timezone string, now time.Time) (domain.DashboardChart, error) {
+	limit := viper.GetInt("chart-top-pods")
+	if limit <= 0 {
+		limit = defaultTopPods
+	}
+
+	durationSec, intervalSec := getDurationAndIntervalSec(duration, interval)
+	intervalSec = getLimitedIntervalSec(durationSec, intervalSec, viper.GetInt("chart-max-points"))
+	start := alignToInterval(int(now.Unix())-durationSec, intervalSec, now.Location())
+
+	xAxisData := []string{}
+	for x := start; x <= int(now.Unix()); x += intervalSec {
+		xAxisData = append(xAxisData, strconv.Itoa(x))
+	}
+
+	chartData := domain.ChartData{XAxis: &domain.Axis{Data: xAxisData}}
+	failed := 0
+	for _, q := range topPodQueries {
+		ranking, err := u.getTopPodRanking(ctx, thanosClient, q.metric, q.unit, fmt.Sprintf(q.rank, limit, duration), q.series, interval, start, int(now.Unix()), intervalSec, xAxisData)
+		if err != nil {
+			failed++
+			ranking = domain.TopPodRanking{Metric: q.metric, Format: domain.ChartFormat{Unit: q.unit}, Pods: []domain.TopPod{}, Error: err.Error()}
+		}
+		chartData.TopPods = append(chartData.TopPods, ranking)
+	}
+	if failed == len(topPodQueries) {
+		return domain.DashboardChart{}, fmt.Errorf("failed to get top pods. err : %s", chartData.TopPods[0].Error)
+	}
+
+	return domain.DashboardChart{
+		ChartType:      domain.ChartType_TOP_PODS,
+		OrganizationId: organizationId,
+		Name:           "TOP_PODS",
+		Description:    fmt.Sprintf("재기동, CPU, 메모리 사용량 상위 %d 개 Pod", limit),
+		Duration:       duration,
+		Interval:       interval,
+		Timezone:       timezone,
+		ChartData:      chartData,
+		UpdatedAt:      time.Now(),
+	}, nil
+}
+
+func (u *DashboardUsecase) getTopPodRanking(ctx context.Context, thanosClient thanos.ThanosClient, metric string, unit domain.ChartUnit, rankQuery string, seriesQuery string, interval string,
+	start int, end int, intervalSec int, xAxisData []string) (out domain.TopPodRanking, err error) {
+	out = domain.TopPodRanking{Metric: metric, Pods: []domain.TopPod{}}
+
+	ranked, err := thanosClient.Get(ctx, rankQuery)
+	if err != nil {
+		return out, err
+	}
+
+	type rankedPod struct {
+		key   topPodKey
+		value float64
+	}
+	pods := []rankedPod{}
+	podNames := []string{}
+	for _, result := range ranked.Data.Result {
+		value, ok := getMetricValue(result.Value)
+		if !ok || result.Metric.Pod == "" {
+			continue
+		}
+		pods = append(pods, rankedPod{
+			key:   topPodKey{clusterId: result.Metric.TacoCluster, namespace: result.Metric.Namespace, pod: result.Metric.Pod},
+			value: value,
+		})
+		podNames = append(podNames, regexp.QuoteMeta(result.Metric.Pod))
+	}
+	sort.SliceStable(pods, func(i, j int) bool { return pods[i].value > pods[j].value })
+
+	// 순위에 든 pod 의 추이만 조회한다. 다른 cluster, namespace 의 같은 이름 pod 는 결과에서 제외한다.
+	series := map[topPodKey][]float64{}
+	if len(pods) > 0 {
+		selector := fmt.Sprintf("pod=~\"%s\"", strings.Join(podNames, "|"))
+		result, err := thanosClient.FetchRange(ctx, fmt.Sprintf(seriesQuery, selector, interval), start, end, intervalSec)
+		if err != nil {
+			return out, err
+		}
+		for _, val := range result.Data.Result {
+			values := make([]float64, len(xAxisData))
+			for i, x := range xAxisData {
+				y, ok := getChartYValue(val.Values, x)
+				if !ok {
+					y = math.NaN()
+				}
+				values[i] = y
+			}
+			series[topPodKey{clusterId: val.Metric.TacoCluster, namespace: val.Metric.Namespace, pod: val.Metric.Pod}] = values
+		}
+	}
+
+	// 순위 값과 추이가 같은 단위로 표시되도록 함께 scale 을 정한다.
+	seriesValues := make([][]float64, 0, len(pods)+1)
+	rankValues := make([]float64, len(pods))
+	for i, pod := range pods {
+		rankValues[i] = pod.value
+		seriesValues = append(seriesValues, series[pod.key])
+	}
+	seriesValues = append(seriesValues, rankValues)
+	format, divisor := getChartFormat(unit, seriesValues)
+	out.Format = format
+
+	formattedRanks := formatChartValues(rankValues, divisor, format.Precision)
+	for i, pod := range pods {
+		clusterName, err := u.getClusterNameFromId(ctx, pod.key.clusterId)
+		if err != nil || clusterName == "" {
+			clusterName = pod.key.clusterId
+		}
+		data := series[pod.key]
+		if data == nil {
+			data = make([]float64, len(xAxisData))
+			for j := range data {
+				data[j] = math.NaN()
+			}
+		}
+		out.Pods = append(out.Pods, domain.TopPod{
+			ClusterId:   pod.key.clusterId,
+			ClusterName: clusterName,
+			Namespace:   pod.key.namespace,
+			Pod:         pod.key.pod,
+			Value:       formattedRanks[i],
+			Data:        formatChartValues(data, divisor, format.Precision),
+		})
+	}
+	return out, nil
+}
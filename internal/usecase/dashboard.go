@@ -7,11 +7,13 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
 	policytemplate "github.com/openinfradev/tks-api/internal/policy-template"
 	"github.com/openinfradev/tks-api/internal/repository"
 	"github.com/openinfradev/tks-api/internal/serializer"
@@ -22,8 +24,10 @@ import (
 	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
 	gcache "github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 	"github.com/thoas/go-funk"
 
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/strings/slices"
 )
@@ -32,39 +36,80 @@ type IDashboardUsecase interface {
 	CreateDashboard(ctx context.Context, dashboard *model.Dashboard) (string, error)
 	GetDashboard(ctx context.Context, organizationId string, userId string, dashboardKey string) (*model.Dashboard, error)
 	UpdateDashboard(ctx context.Context, dashboard *model.Dashboard) error
-	GetCharts(ctx context.Context, organizationId string, chartType domain.ChartType, duration string, interval string, year string, month string) (res []domain.DashboardChart, err error)
-	GetStacks(ctx context.Context, organizationId string) (out []domain.DashboardStack, err error)
+	GetCharts(ctx context.Context, organizationId string, chartType domain.ChartType, duration string, interval string, aggregation domain.ChartAggregation, year string, month string, timezone string) (res []domain.DashboardChart, err error)
+	StreamCharts(ctx context.Context, organizationId string, chartTypes []domain.ChartType, duration string, interval string, aggregation domain.ChartAggregation, send ChartStreamFunc) error
+	GetStacks(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []domain.DashboardStack, err error)
 	GetResources(ctx context.Context, organizationId string) (out domain.DashboardResource, err error)
+	GetStackNodes(ctx context.Context, organizationId string, stackId domain.StackId) (out []domain.DashboardNode, err error)
+	GetClusterCharts(ctx context.Context, organizationId string, clusterId domain.ClusterId, chartType domain.ChartType, duration string, interval string) ([]domain.DashboardChart, error)
+	GetStorages(ctx context.Context, organizationId string, stackId domain.StackId, threshold float64) (out []domain.DashboardStorage, err error)
+	GetNetworkPolicies(ctx context.Context, organizationId string, stackId domain.StackId) (out []domain.DashboardNetworkPolicy, err error)
 	GetPolicyUpdate(ctx context.Context, policyTemplates []policytemplate.TKSPolicyTemplate, policies []policytemplate.TKSPolicy) (domain.DashboardPolicyUpdate, error)
 	GetPolicyEnforcement(ctx context.Context, organizationId string, primaryClusterId string) (*domain.BarChartData, error)
 	GetPolicyViolation(ctx context.Context, organizationId string, duration string, interval string) (*domain.BarChartData, error)
 	GetPolicyViolationLog(ctx context.Context, organizationId string) (*domain.GetDashboardPolicyViolationLogResponse, error)
 	GetWorkload(ctx context.Context, organizationId string) (*domain.GetDashboardWorkloadResponse, error)
 	GetPolicyViolationTop5(ctx context.Context, organizationId string, duration string, interval string) (*domain.BarChartData, error)
+	GetPolicyEnforcementStatus(ctx context.Context, organizationId string, duration string, interval string) (domain.GetDashboardPolicyEnforcementStatusResponse, error)
 	GetThanosClient(ctx context.Context, organizationId string) (thanos.ThanosClient, error)
+	DownsampleUtilization(ctx context.Context, date time.Time) error
+	CreateCustomChart(ctx context.Context, dto model.CustomChart) (customChartId uuid.UUID, err error)
+	GetCustomCharts(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.CustomChart, error)
+	GetCustomChart(ctx context.Context, organizationId string, customChartId uuid.UUID) (model.CustomChart, error)
+	UpdateCustomChart(ctx context.Context, dto model.CustomChart) error
+	DeleteCustomChart(ctx context.Context, organizationId string, customChartId uuid.UUID) error
+	GetCustomChartData(ctx context.Context, organizationId string, customChartId uuid.UUID, duration string, interval string) (domain.DashboardChart, error)
+	GetHealth(ctx context.Context, organizationId string) (domain.GetDashboardHealthResponse, error)
+	GetCosts(ctx context.Context, organizationId string) (domain.DashboardCost, error)
+	GetClusterEvents(ctx context.Context, organizationId string, period time.Duration, pg *pagination.Pagination) (domain.GetDashboardClusterEventsResponse, error)
+	CreateChartSnapshot(ctx context.Context, organizationId string, chart domain.DashboardChart, title string, expiresIn time.Duration) (chartSnapshotId uuid.UUID, shareToken string, expiredAt time.Time, err error)
+	GetChartSnapshots(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.ChartSnapshot, error)
+	DeleteChartSnapshot(ctx context.Context, organizationId string, chartSnapshotId uuid.UUID) error
+	GetSharedChartSnapshot(ctx context.Context, shareToken string) (model.ChartSnapshot, domain.DashboardChart, error)
+	PurgeExpiredChartSnapshots(ctx context.Context) error
+	WarmupCaches(ctx context.Context, organizationId string) ([]domain.DashboardCacheWarmup, error)
 }
 
 type DashboardUsecase struct {
-	dashboardRepo          repository.IDashboardRepository
-	organizationRepo       repository.IOrganizationRepository
-	clusterRepo            repository.IClusterRepository
-	appGroupRepo           repository.IAppGroupRepository
-	systemNotificationRepo repository.ISystemNotificationRepository
-	policyTemplateRepo     repository.IPolicyTemplateRepository
-	policyRepo             repository.IPolicyRepository
-	cache                  *gcache.Cache
+	dashboardRepo           repository.IDashboardRepository
+	organizationRepo        repository.IOrganizationRepository
+	clusterRepo             repository.IClusterRepository
+	appGroupRepo            repository.IAppGroupRepository
+	systemNotificationRepo  repository.ISystemNotificationRepository
+	policyTemplateRepo      repository.IPolicyTemplateRepository
+	policyRepo              repository.IPolicyRepository
+	clusterUtilizationRepo  repository.IClusterUtilizationRepository
+	customChartRepo         repository.ICustomChartRepository
+	chartSnapshotRepo       repository.IChartSnapshotRepository
+	maintenanceRepo         repository.IMaintenanceWindowRepository
+	operationRepo           repository.IOperationRepository
+	userRepo                repository.IUserRepository
+	lmaEndpointRepo         repository.ILmaEndpointRepository
+	cloudAccountPricingRepo repository.ICloudAccountPricingRepository
+	cache                   *gcache.Cache
+	thanosClients           ThanosClientFactory
+	chartRefreshing         sync.Map
 }
 
-func NewDashboardUsecase(r repository.Repository, cache *gcache.Cache) IDashboardUsecase {
+func NewDashboardUsecase(r repository.Repository, cache *gcache.Cache, thanosClients ThanosClientFactory) IDashboardUsecase {
 	return &DashboardUsecase{
-		dashboardRepo:          r.Dashboard,
-		organizationRepo:       r.Organization,
-		clusterRepo:            r.Cluster,
-		appGroupRepo:           r.AppGroup,
-		systemNotificationRepo: r.SystemNotification,
-		policyTemplateRepo:     r.PolicyTemplate,
-		policyRepo:             r.Policy,
-		cache:                  cache,
+		dashboardRepo:           r.Dashboard,
+		organizationRepo:        r.Organization,
+		clusterRepo:             r.Cluster,
+		appGroupRepo:            r.AppGroup,
+		systemNotificationRepo:  r.SystemNotification,
+		policyTemplateRepo:      r.PolicyTemplate,
+		policyRepo:              r.Policy,
+		clusterUtilizationRepo:  r.ClusterUtilization,
+		customChartRepo:         r.CustomChart,
+		chartSnapshotRepo:       r.ChartSnapshot,
+		maintenanceRepo:         r.MaintenanceWindow,
+		operationRepo:           r.Operation,
+		userRepo:                r.User,
+		lmaEndpointRepo:         r.LmaEndpoint,
+		cloudAccountPricingRepo: r.CloudAccountPricing,
+		cache:                   cache,
+		thanosClients:           thanosClients,
 	}
 }
 
@@ -92,43 +137,62 @@ func (u *DashboardUsecase) UpdateDashboard(ctx context.Context, dashboard *model
 	return nil
 }
 
-func (u *DashboardUsecase) GetCharts(ctx context.Context, organizationId string, chartType domain.ChartType, duration string, interval string, year string, month string) (out []domain.DashboardChart, err error) {
+func (u *DashboardUsecase) GetCharts(ctx context.Context, organizationId string, chartType domain.ChartType, duration string, interval string, aggregation domain.ChartAggregation, year string, month string, timezone string) (out []domain.DashboardChart, err error) {
 	_, err = u.organizationRepo.Get(ctx, organizationId)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid organization")
 	}
 
+	timezone, err = u.getChartTimezone(ctx, timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	strTypes := make([]string, 0)
 	for _, strType := range chartType.All() {
 		if chartType != domain.ChartType_ALL && chartType.String() != strType {
 			continue
 		}
+		strTypes = append(strTypes, strType)
+	}
 
-		chart, err := u.getChartFromPrometheus(ctx, organizationId, strType, duration, interval, year, month)
-		if err != nil {
-			return nil, err
+	// chart 별 조회는 서로 독립적이므로 동시에 실행하고, 결과는 chart 종류 순서대로 반환한다.
+	out = make([]domain.DashboardChart, len(strTypes))
+	errs := make([]error, len(strTypes))
+	queries := make([]func(ctx context.Context), len(strTypes))
+	for i, strType := range strTypes {
+		i, strType := i, strType
+		queries[i] = func(ctx context.Context) {
+			out[i], errs[i] = u.getCachedChart(ctx, organizationId, strType, duration, interval, aggregation, year, month, timezone)
 		}
+	}
+	runDashboardQueries(ctx, queries...)
 
-		out = append(out, chart)
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if chartType != domain.ChartType_ALL {
+			return nil, err
+		}
+		// 하나의 chart 실패로 전체 대시보드가 비지 않도록 오류를 chart 에 담아 반환한다.
+		log.Error(ctx, err)
+		out[i] = newErrorChart(organizationId, new(domain.ChartType).FromString(strTypes[i]), duration, interval, err)
 	}
+	u.attachChartAnnotations(ctx, organizationId, out, duration, interval)
 
 	return
 }
 
-func (u *DashboardUsecase) GetStacks(ctx context.Context, organizationId string) (out []domain.DashboardStack, err error) {
+func (u *DashboardUsecase) GetStacks(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []domain.DashboardStack, err error) {
 	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
 	if err != nil {
 		return out, err
 	}
 
-	thanosUrl, err := u.getThanosUrl(ctx, organizationId)
-	if err != nil {
-		log.Error(ctx, err)
-		return out, httpErrors.NewInternalServerError(err, "D_INVALID_PRIMARY_STACK", "")
-	}
-	address, port := helper.SplitAddress(ctx, thanosUrl)
-	thanosClient, err := thanos.New(address, port, false, "")
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
 	if err != nil {
-		return out, errors.Wrap(err, "failed to create thanos client")
+		return out, err
 	}
 	stackMemoryDisk, err := thanosClient.Get(ctx, "sum by (__name__, taco_cluster) ({__name__=~\"node_memory_MemFree_bytes|machine_memory_bytes|kubelet_volume_stats_used_bytes|kubelet_volume_stats_capacity_bytes\"})")
 	if err != nil {
@@ -140,6 +204,16 @@ func (u *DashboardUsecase) GetStacks(ctx context.Context, organizationId string)
 		return out, err
 	}
 
+	alertCounts, err := u.systemNotificationRepo.FetchOpenSeverityCounts(ctx, organizationId)
+	if err != nil {
+		log.Error(ctx, err)
+	}
+
+	maintenanceWindows, err := u.maintenanceRepo.FetchActive(ctx, organizationId, time.Now())
+	if err != nil {
+		log.Error(ctx, err)
+	}
+
 	for _, cluster := range clusters {
 		appGroups, err := u.appGroupRepo.Fetch(ctx, cluster.ID, nil)
 		if err != nil {
@@ -167,185 +241,562 @@ func (u *DashboardUsecase) GetStacks(ctx context.Context, organizationId string)
 		dashboardStack.Cpu = cpu
 		dashboardStack.Memory = memory
 		dashboardStack.Storage = disk
+		dashboardStack.Alerts = getStackAlerts(alertCounts, cluster.ID)
+		dashboardStack.VersionAdvisory = stackVersionAdvisory(cluster.ID, cluster.StackTemplate.KubeVersion, time.Now())
+		for _, maintenanceWindow := range maintenanceWindows {
+			if maintenanceWindow.ClusterId == cluster.ID {
+				dashboardStack.UnderMaintenance = true
+				dashboardStack.MaintenanceEndAt = &maintenanceWindow.EndAt
+				break
+			}
+		}
 
 		out = append(out, dashboardStack)
 	}
 
-	sort.Slice(out, func(i, j int) bool {
-		return out[i].Status == domain.StackStatus_RUNNING.String()
+	if pg == nil {
+		sortDashboardStacks(out, "status", "ASC")
+		return out, nil
+	}
+
+	out = filterDashboardStacks(out, pg)
+	sortDashboardStacks(out, pg.GetSortColumn(), pg.GetSortOrder())
+
+	// paginate in memory. stack status and resources are not stored in db.
+	pg.TotalRows = int64(len(out))
+	pg.TotalPages = int(math.Ceil(float64(len(out)) / float64(pg.GetLimit())))
+	offset := pg.GetOffset()
+	if offset >= len(out) {
+		return []domain.DashboardStack{}, nil
+	}
+	end := offset + pg.GetLimit()
+	if end > len(out) {
+		end = len(out)
+	}
+
+	return out[offset:end], nil
+}
+
+func filterDashboardStacks(stacks []domain.DashboardStack, pg *pagination.Pagination) (out []domain.DashboardStack) {
+	statusFilter := pg.GetFilter("status")
+	providerFilter := pg.GetFilter("cloud_service")
+	if providerFilter == nil {
+		providerFilter = pg.GetFilter("provider")
+	}
+
+	out = make([]domain.DashboardStack, 0, len(stacks))
+	for _, stack := range stacks {
+		if statusFilter != nil && !containsFold(statusFilter.Values, stack.Status) {
+			continue
+		}
+		if providerFilter != nil && !containsFold(providerFilter.Values, stack.CloudService) {
+			continue
+		}
+		out = append(out, stack)
+	}
+	return out
+}
+
+// sortDashboardStacks sorts by name, status or createdAt.
+// RUNNING stacks come first when sorting by status.
+func sortDashboardStacks(stacks []domain.DashboardStack, sortColumn string, sortOrder string) {
+	desc := strings.EqualFold(sortOrder, "DESC")
+
+	less := func(i, j int) bool {
+		return stacks[i].CreatedAt.Before(stacks[j].CreatedAt)
+	}
+	switch helper.ToSnakeCase(sortColumn) {
+	case "name":
+		less = func(i, j int) bool {
+			return stacks[i].Name < stacks[j].Name
+		}
+	case "status":
+		running := domain.StackStatus_RUNNING.String()
+		less = func(i, j int) bool {
+			iRunning, jRunning := stacks[i].Status == running, stacks[j].Status == running
+			if iRunning != jRunning {
+				return iRunning
+			}
+			if stacks[i].Status != stacks[j].Status {
+				return stacks[i].Status < stacks[j].Status
+			}
+			return stacks[i].Name < stacks[j].Name
+		}
+	}
+
+	sort.SliceStable(stacks, func(i, j int) bool {
+		if desc {
+			return less(j, i)
+		}
+		return less(i, j)
 	})
+}
 
-	return
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
 }
 
 func (u *DashboardUsecase) GetResources(ctx context.Context, organizationId string) (out domain.DashboardResource, err error) {
-	thanosUrl, err := u.getThanosUrl(ctx, organizationId)
-	if err != nil {
+	// 위젯 별로 조회하고, 실패한 위젯은 Errors 에 담아 나머지 결과는 그대로 반환한다.
+	out.Errors = make(map[string]string)
+	setError := func(widget string, err error) {
 		log.Error(ctx, err)
-		return out, httpErrors.NewInternalServerError(err, "D_INVALID_PRIMARY_STACK", "")
-	}
-	address, port := helper.SplitAddress(ctx, thanosUrl)
-	thanosClient, err := thanos.New(address, port, false, "")
-	if err != nil {
-		return out, errors.Wrap(err, "failed to create thanos client")
+		out.Errors[widget] = err.Error()
 	}
 
 	// Stack
 	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
 	if err != nil {
-		log.Error(ctx, err)
-		return out, err
-	}
-
-	filteredClusters := funk.Filter(clusters, func(x model.Cluster) bool {
-		return x.Status == domain.ClusterStatus_RUNNING
-	})
+		setError("stack", err)
+	} else {
+		filteredClusters := funk.Filter(clusters, func(x model.Cluster) bool {
+			return x.Status == domain.ClusterStatus_RUNNING
+		})
 
-	var normal, abnormal int
-	if filteredClusters != nil {
-		for _, cluster := range filteredClusters.([]model.Cluster) {
-			clientSet, err := kubernetes.GetClientFromClusterId(ctx, cluster.ID.String())
-			if err != nil {
-				return out, errors.Wrap(err, "Failed to get client set for user cluster")
-			}
-			// get cluster info
-			clusterInfo, err := clientSet.CoreV1().Services("kube-system").List(context.TODO(), metav1.ListOptions{LabelSelector: "kubernetes.io/cluster-service"})
-			if err != nil {
-				abnormal++
-				log.Debugf(ctx, "Failed to get cluster info: %v\n", err)
-				continue
-			}
-			if clusterInfo != nil && len(clusterInfo.Items) > 0 {
-				if clusterInfo.Items[0].ObjectMeta.Labels["kubernetes.io/cluster-service"] == "true" {
-					normal++
-				} else {
+		var normal, abnormal int
+		if filteredClusters != nil {
+			for _, cluster := range filteredClusters.([]model.Cluster) {
+				clientSet, err := kubernetes.GetClientFromClusterId(ctx, cluster.ID.String())
+				if err != nil {
 					abnormal++
+					log.Error(ctx, errors.Wrap(err, "Failed to get client set for user cluster"))
+					continue
+				}
+				// get cluster info
+				clusterInfo, err := clientSet.CoreV1().Services("kube-system").List(context.TODO(), metav1.ListOptions{LabelSelector: "kubernetes.io/cluster-service"})
+				if err != nil {
+					abnormal++
+					log.Debugf(ctx, "Failed to get cluster info: %v\n", err)
+					continue
+				}
+				if clusterInfo != nil && len(clusterInfo.Items) > 0 {
+					if clusterInfo.Items[0].ObjectMeta.Labels["kubernetes.io/cluster-service"] == "true" {
+						normal++
+					} else {
+						abnormal++
+					}
 				}
 			}
 		}
+		out.NormalStackCount = normal
+		out.AbnormalStackCount = abnormal
+	}
+
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		for _, widget := range []string{"cpu", "memory", "storage", "cost"} {
+			setError(widget, err)
+		}
+		return out, nil
 	}
-	out.Stack.Normal = strconv.Itoa(normal)
-	out.Stack.Abnormal = strconv.Itoa(abnormal)
 
-	// CPU
+	// CPU, Memory, Storage 는 동시에 조회한다.
 	/*
 		{"data":{"result":[{"metric":{"taco_cluster":"cmsai5k5l"},"value":[1683608185.65,"32"]},{"metric":{"taco_cluster":"crjfh12oc"},"value":[1683608185.65,"12"]}],"vector":""},"status":"success"}
 	*/
-	result, err := thanosClient.Get(ctx, "sum by (taco_cluster) (machine_cpu_cores)")
+	widgets := []struct {
+		name  string
+		query string
+		value *int64
+	}{
+		{"cpu", machineCpuCoresQuery, &out.CpuCores},
+		{"memory", machineMemoryBytesQuery, &out.MemoryBytes},
+		{"storage", "sum by (taco_cluster) (kubelet_volume_stats_capacity_bytes)", &out.StorageBytes},
+	}
+	errs := make([]error, len(widgets))
+	results := make([][]thanos.MetricDataResult, len(widgets))
+	queries := make([]func(ctx context.Context), len(widgets))
+	for i, widget := range widgets {
+		i, widget := i, widget
+		queries[i] = func(ctx context.Context) {
+			result, err := thanosClient.Get(ctx, widget.query)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i] = result.Data.Result
+			*widget.value = sumMetricValues(result.Data.Result)
+		}
+	}
+	runDashboardQueries(ctx, queries...)
+
+	for i, err := range errs {
+		if err != nil {
+			setError(widgets[i].name, err)
+		}
+	}
+
+	// 비용은 cpu, memory 조회 결과와 클라우드 계정 단가로 계산한다.
+	if _, failed := out.Errors["stack"]; failed {
+		setError("cost", fmt.Errorf("failed to fetch stacks"))
+	} else if errs[0] != nil || errs[1] != nil {
+		setError("cost", fmt.Errorf("failed to query cpu or memory"))
+	} else if _, out.MonthlyCosts, err = u.estimateStackCosts(ctx, organizationId, clusters, results[0], results[1]); err != nil {
+		setError("cost", err)
+	}
+
+	return out, nil
+}
+
+// sumMetricValues 는 instant query 결과의 양수 값을 모두 더한다.
+func sumMetricValues(result []thanos.MetricDataResult) (sum int64) {
+	for _, val := range result {
+		v, ok := getMetricValue(val.Value)
+		if !ok || v <= 0 {
+			continue
+		}
+		sum += int64(math.Round(v))
+	}
+	return sum
+}
+
+func (u *DashboardUsecase) GetStackNodes(ctx context.Context, organizationId string, stackId domain.StackId) (out []domain.DashboardNode, err error) {
+	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(stackId))
+	if err != nil {
+		return out, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
+	}
+	if cluster.OrganizationId != organizationId {
+		return out, httpErrors.NewError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID")
+	}
+
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return out, err
+	}
+
+	clusterId := cluster.ID.String()
+	cpuResult, err := thanosClient.Get(ctx, fmt.Sprintf("avg by (instance) (1-irate(node_cpu_seconds_total{mode=\"idle\", taco_cluster=\"%s\"}[5m])) * 100", clusterId))
+	if err != nil {
+		return out, err
+	}
+	memoryResult, err := thanosClient.Get(ctx, fmt.Sprintf("(1 - sum by (instance) (node_memory_MemAvailable_bytes{taco_cluster=\"%s\"}) / sum by (instance) (node_memory_MemTotal_bytes{taco_cluster=\"%s\"})) * 100", clusterId, clusterId))
+	if err != nil {
+		return out, err
+	}
+	diskResult, err := thanosClient.Get(ctx, fmt.Sprintf("(1 - sum by (instance) (node_filesystem_avail_bytes{taco_cluster=\"%s\", mountpoint=\"/\"}) / sum by (instance) (node_filesystem_size_bytes{taco_cluster=\"%s\", mountpoint=\"/\"})) * 100", clusterId, clusterId))
+	if err != nil {
+		return out, err
+	}
+
+	nodeNames := u.getNodeNamesByAddress(ctx, clusterId)
+
+	nodes := make(map[string]*domain.DashboardNode)
+	instances := make([]string, 0)
+	getNode := func(instance string) *domain.DashboardNode {
+		if node, ok := nodes[instance]; ok {
+			return node
+		}
+		name := instance
+		if nodeName, ok := nodeNames[strings.Split(instance, ":")[0]]; ok {
+			name = nodeName
+		}
+		nodes[instance] = &domain.DashboardNode{Name: name, Instance: instance}
+		instances = append(instances, instance)
+		return nodes[instance]
+	}
+
+	for _, val := range cpuResult.Data.Result {
+		getNode(val.Metric.Instance).Cpu = getMetricPercentage(val.Value)
+	}
+	for _, val := range memoryResult.Data.Result {
+		getNode(val.Metric.Instance).Memory = getMetricPercentage(val.Value)
+	}
+	for _, val := range diskResult.Data.Result {
+		getNode(val.Metric.Instance).Disk = getMetricPercentage(val.Value)
+	}
+
+	for _, instance := range instances {
+		out = append(out, *nodes[instance])
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+
+	return out, nil
+}
+
+// getNodeNamesByAddress returns node names by internal ip address of the cluster
+func (u *DashboardUsecase) getNodeNamesByAddress(ctx context.Context, clusterId string) map[string]string {
+	out := make(map[string]string)
+
+	clientset, err := kubernetes.GetClientFromClusterId(ctx, clusterId)
+	if err != nil {
+		log.Error(ctx, err)
+		return out
+	}
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		log.Error(ctx, err)
+		return out
+	}
+	for _, node := range nodes.Items {
+		for _, address := range node.Status.Addresses {
+			out[address.Address] = node.Name
+		}
+	}
+	return out
+}
+
+func (u *DashboardUsecase) GetStorages(ctx context.Context, organizationId string, stackId domain.StackId, threshold float64) (out []domain.DashboardStorage, err error) {
+	if threshold <= 0 {
+		threshold = viper.GetFloat64("storage-fill-threshold")
+	}
+
+	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
+	if err != nil {
 		return out, err
 	}
-	cpu := 0
-	for _, val := range result.Data.Result {
-		cpuVal, err := strconv.Atoi(val.Value[1].(string))
+
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return out, err
+	}
+
+	type pvcKey struct {
+		clusterId string
+		namespace string
+		name      string
+	}
+	fetch := func(query string) (map[pvcKey]int64, error) {
+		result, err := thanosClient.Get(ctx, query)
 		if err != nil {
-			continue
+			return nil, err
 		}
-		if cpuVal > 0 {
-			cpu = cpu + cpuVal
+		values := make(map[pvcKey]int64)
+		for _, val := range result.Data.Result {
+			if v, ok := getMetricValue(val.Value); ok {
+				values[pvcKey{val.Metric.TacoCluster, val.Metric.Namespace, val.Metric.Pvc}] = int64(v)
+			}
 		}
+		return values, nil
 	}
-	out.Cpu = strconv.Itoa(cpu)
 
-	// Memory
-	result, err = thanosClient.Get(ctx, "sum by (taco_cluster) (machine_memory_bytes)")
+	const byPvc = "sum by (taco_cluster, namespace, persistentvolumeclaim)"
+	used, err := fetch(byPvc + " (kubelet_volume_stats_used_bytes)")
 	if err != nil {
-		log.Error(ctx, err)
 		return out, err
 	}
-	memory := float64(0)
-	for _, val := range result.Data.Result {
-		memoryVal, err := strconv.Atoi(val.Value[1].(string))
+	capacity, err := fetch(byPvc + " (kubelet_volume_stats_capacity_bytes)")
+	if err != nil {
+		return out, err
+	}
+	growth, err := fetch(byPvc + " (kubelet_volume_stats_used_bytes) - " + byPvc + " (kubelet_volume_stats_used_bytes offset 7d)")
+	if err != nil {
+		return out, err
+	}
+
+	for _, cluster := range clusters {
+		if stackId != "" && cluster.ID != domain.ClusterId(stackId) {
+			continue
+		}
+		if cluster.Status != domain.ClusterStatus_RUNNING {
+			continue
+		}
+
+		clientset, err := kubernetes.GetClientFromClusterId(ctx, cluster.ID.String())
 		if err != nil {
+			log.Error(ctx, err)
 			continue
 		}
-		if memoryVal > 0 {
-			memory_ := float64(memoryVal) / float64(1024) / float64(1024) / float64(1024)
-			memory = memory + memory_
+		pvcs, err := clientset.CoreV1().PersistentVolumeClaims("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+
+		for _, pvc := range pvcs.Items {
+			key := pvcKey{cluster.ID.String(), pvc.Namespace, pvc.Name}
+			storage := domain.DashboardStorage{
+				ClusterId:   cluster.ID,
+				ClusterName: cluster.Name,
+				Namespace:   pvc.Namespace,
+				Name:        pvc.Name,
+				Status:      string(pvc.Status.Phase),
+				Capacity:    capacity[key],
+				Used:        used[key],
+				Growth:      growth[key],
+			}
+			if pvc.Spec.StorageClassName != nil {
+				storage.StorageClass = *pvc.Spec.StorageClassName
+			}
+			// 마운트되지 않은 볼륨은 kubelet 메트릭이 없으므로 PVC 의 용량을 사용한다.
+			if q, ok := pvc.Status.Capacity["storage"]; ok && storage.Capacity == 0 {
+				storage.Capacity = q.Value()
+			}
+			if storage.Capacity > 0 {
+				storage.FillRate = math.Round(float64(storage.Used)/float64(storage.Capacity)*10000) / 100
+			}
+			if previous := storage.Used - storage.Growth; previous > 0 {
+				storage.GrowthRate = math.Round(float64(storage.Growth)/float64(previous)*10000) / 100
+			}
+			storage.OverThreshold = storage.FillRate >= threshold
+
+			out = append(out, storage)
 		}
 	}
-	out.Memory = fmt.Sprintf("%v", math.Round(memory))
 
-	// Storage
-	result, err = thanosClient.Get(ctx, "sum by (taco_cluster) (kubelet_volume_stats_capacity_bytes)")
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].FillRate > out[j].FillRate
+	})
+
+	return out, nil
+}
+
+func (u *DashboardUsecase) GetNetworkPolicies(ctx context.Context, organizationId string, stackId domain.StackId) (out []domain.DashboardNetworkPolicy, err error) {
+	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
 	if err != nil {
-		log.Error(ctx, err)
 		return out, err
 	}
-	storage := float64(0)
-	for _, val := range result.Data.Result {
-		storageVal, err := strconv.Atoi(val.Value[1].(string))
+
+	for _, cluster := range clusters {
+		if stackId != "" && cluster.ID != domain.ClusterId(stackId) {
+			continue
+		}
+		if cluster.Status != domain.ClusterStatus_RUNNING {
+			continue
+		}
+
+		clientset, err := kubernetes.GetClientFromClusterId(ctx, cluster.ID.String())
+		if err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		namespaces, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		policies, err := clientset.NetworkingV1().NetworkPolicies("").List(ctx, metav1.ListOptions{})
 		if err != nil {
+			log.Error(ctx, err)
 			continue
 		}
-		if storageVal > 0 {
-			storage_ := float64(storageVal) / float64(1024) / float64(1024) / float64(1024)
-			storage = storage + storage_
+
+		policiesByNamespace := make(map[string][]networkingv1.NetworkPolicy)
+		for _, policy := range policies.Items {
+			policiesByNamespace[policy.Namespace] = append(policiesByNamespace[policy.Namespace], policy)
+		}
+
+		for _, ns := range namespaces.Items {
+			nsPolicies := policiesByNamespace[ns.Name]
+			item := domain.DashboardNetworkPolicy{
+				ClusterId:   cluster.ID,
+				ClusterName: cluster.Name,
+				Namespace:   ns.Name,
+				Status:      getNetworkPolicyStatus(nsPolicies),
+				PolicyCount: len(nsPolicies),
+				Policies:    make([]string, 0, len(nsPolicies)),
+			}
+			for _, policy := range nsPolicies {
+				item.Policies = append(item.Policies, policy.Name)
+			}
+			out = append(out, item)
 		}
 	}
-	out.Storage = fmt.Sprintf("%v", math.Round(storage))
 
-	return
+	// 보호되지 않은 네임스페이스를 먼저 보여준다.
+	order := map[string]int{
+		domain.NetworkPolicyStatus_OPEN:       0,
+		domain.NetworkPolicyStatus_PARTIAL:    1,
+		domain.NetworkPolicyStatus_RESTRICTED: 2,
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if order[out[i].Status] != order[out[j].Status] {
+			return order[out[i].Status] < order[out[j].Status]
+		}
+		if out[i].ClusterName != out[j].ClusterName {
+			return out[i].ClusterName < out[j].ClusterName
+		}
+		return out[i].Namespace < out[j].Namespace
+	})
+
+	return out, nil
 }
 
-func (u *DashboardUsecase) getChartFromPrometheus(ctx context.Context, organizationId string, chartType string, duration string, interval string, year string, month string) (res domain.DashboardChart, err error) {
-	thanosUrl, err := u.getThanosUrl(ctx, organizationId)
-	if err != nil {
-		log.Error(ctx, err)
-		return res, httpErrors.NewInternalServerError(err, "D_INVALID_PRIMARY_STACK", "")
+// getNetworkPolicyStatus 는 네임스페이스의 NetworkPolicy 목록으로 ingress 제한 상태를 판단한다.
+// podSelector 가 비어 있는(모든 Pod 를 선택하는) ingress 정책이 있으면 RESTRICTED 로 본다.
+func getNetworkPolicyStatus(policies []networkingv1.NetworkPolicy) string {
+	if len(policies) == 0 {
+		return domain.NetworkPolicyStatus_OPEN
+	}
+	for _, policy := range policies {
+		selector := policy.Spec.PodSelector
+		if len(selector.MatchLabels) > 0 || len(selector.MatchExpressions) > 0 {
+			continue
+		}
+		// policyTypes 가 없으면 ingress 정책으로 간주된다.
+		if len(policy.Spec.PolicyTypes) == 0 {
+			return domain.NetworkPolicyStatus_RESTRICTED
+		}
+		for _, policyType := range policy.Spec.PolicyTypes {
+			if policyType == networkingv1.PolicyTypeIngress {
+				return domain.NetworkPolicyStatus_RESTRICTED
+			}
+		}
+	}
+	return domain.NetworkPolicyStatus_PARTIAL
+}
+
+func getMetricValue(value []interface{}) (float64, bool) {
+	if len(value) < 2 {
+		return 0, false
+	}
+	str, ok := value[1].(string)
+	if !ok {
+		return 0, false
 	}
-	address, port := helper.SplitAddress(ctx, thanosUrl)
-	thanosClient, err := thanos.New(address, port, false, "")
+	f, err := strconv.ParseFloat(str, 64)
 	if err != nil {
-		return res, errors.Wrap(err, "failed to create thanos client")
+		return 0, false
 	}
+	return f, true
+}
 
-	now := time.Now()
-	chartData := domain.ChartData{}
+func getMetricPercentage(value []interface{}) string {
+	f, ok := getMetricValue(value)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%0.2f%%", f)
+}
 
-	durationSec := 60 * 60 * 24
-	switch duration {
-	case "1h":
-		durationSec = 60 * 60
-	case "1d":
-		durationSec = 60 * 60 * 24
-	case "7d":
-		durationSec = 60 * 60 * 24 * 7
-	case "30d":
-		durationSec = 60 * 60 * 24 * 30
+func (u *DashboardUsecase) getChartFromPrometheus(ctx context.Context, organizationId string, chartType string, duration string, interval string, aggregation domain.ChartAggregation, year string, month string, timezone string) (res domain.DashboardChart, err error) {
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return res, err
 	}
 
-	intervalSec := 60 * 60 // default 1h
-	switch interval {
-	case "1h":
-		intervalSec = 60 * 60
-	case "1d":
-		intervalSec = 60 * 60 * 24
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return res, err
 	}
+	now := time.Now().In(loc)
+	chartData := domain.ChartData{}
+
+	durationSec, intervalSec := getDurationAndIntervalSec(duration, interval)
 
 	query := ""
+	if aggregation == "" {
+		aggregation = domain.ChartAggregation_AVG
+	}
+	unit := chartUnits[chartType]
+	var customChart *model.CustomChart
 
 	switch chartType {
-	case domain.ChartType_CPU.String():
-		//query := "sum (avg(1-rate(node_cpu_seconds_total{mode=\"idle\"}[1h])) by (taco_cluster))"
-		query = "avg by (taco_cluster) (1-irate(node_cpu_seconds_total{mode=\"idle\"}[" + interval + "]))"
-
-	case domain.ChartType_MEMORY.String():
-		query = "avg by (taco_cluster) (sum(node_memory_MemTotal_bytes - node_memory_MemAvailable_bytes) by (taco_cluster) / sum(node_memory_MemTotal_bytes) by (taco_cluster))"
-
-	case domain.ChartType_POD.String():
-		query = "sum by (taco_cluster) (changes(kube_pod_container_status_restarts_total{namespace!=\"kube-system\"}[" + interval + "]))"
-
-	case domain.ChartType_TRAFFIC.String():
-		query = "avg by (taco_cluster) (irate(container_network_receive_bytes_total[" + interval + "]))"
+	case domain.ChartType_CPU.String(), domain.ChartType_MEMORY.String(), domain.ChartType_POD.String(), domain.ChartType_TRAFFIC.String():
+		query, aggregation = getChartQuery(chartType, interval, aggregation)
 
 	case domain.ChartType_POD_CALENDAR.String():
 		// 입력받은 년,월 을 date 형식으로
 		yearInt, _ := strconv.Atoi(year)
 		monthInt, _ := strconv.Atoi(month)
-		startDate := time.Date(yearInt, time.Month(monthInt), 1, 0, 0, 0, 0, time.UTC)
-		endDate := time.Date(yearInt, time.Month(monthInt+1), 1, 0, 0, 0, 0, time.UTC)
+		startDate := time.Date(yearInt, time.Month(monthInt), 1, 0, 0, 0, 0, loc)
+		endDate := time.Date(yearInt, time.Month(monthInt+1), 1, 0, 0, 0, 0, loc)
 
 		if now.Year() < yearInt {
 			return res, fmt.Errorf("Invalid year")
@@ -363,7 +814,7 @@ func (u *DashboardUsecase) getChartFromPrometheus(ctx context.Context, organizat
 			return res, err
 		}
 
-		log.Info(ctx, organization.CreatedAt.Format("2006-01-02"))
+		log.Info(ctx, organization.CreatedAt.In(loc).Format("2006-01-02"))
 
 		podCounts := []domain.PodCount{}
 		for day := rangeDate(startDate, endDate); ; {
@@ -375,9 +826,9 @@ func (u *DashboardUsecase) getChartFromPrometheus(ctx context.Context, organizat
 			baseDate := d.Format("2006-01-02")
 			cntPodRestart := 0
 
-			if baseDate <= now.Format("2006-01-02") && baseDate >= organization.CreatedAt.Format("2006-01-02") {
+			if baseDate <= now.Format("2006-01-02") && baseDate >= organization.CreatedAt.In(loc).Format("2006-01-02") {
 				for _, systemNotification := range systemNotifications {
-					strDate := systemNotification.CreatedAt.Format("2006-01-02")
+					strDate := systemNotification.CreatedAt.In(loc).Format("2006-01-02")
 
 					if strDate == baseDate {
 						cntPodRestart += 1
@@ -401,20 +852,65 @@ func (u *DashboardUsecase) getChartFromPrometheus(ctx context.Context, organizat
 			Description:    "Pod 재기동 수 / 총 Pod 수",
 			Year:           year,
 			Month:          month,
+			Timezone:       timezone,
 			ChartData:      chartData,
+			Format:         domain.ChartFormat{Unit: domain.ChartUnit_COUNT},
 			UpdatedAt:      time.Now(),
 		}, nil
+	case domain.ChartType_TOP_PODS.String():
+		return u.getTopPodsChart(ctx, thanosClient, organizationId, duration, interval, timezone, now)
 	default:
-		return domain.DashboardChart{}, fmt.Errorf("No data")
+		customChartId, ok := parseCustomChartType(chartType)
+		if !ok {
+			return domain.DashboardChart{}, fmt.Errorf("No data")
+		}
+		c, err := u.customChartRepo.Get(ctx, customChartId)
+		if err != nil || c.OrganizationId != organizationId {
+			return domain.DashboardChart{}, fmt.Errorf("Not found custom chart %s", customChartId)
+		}
+		// 사용자 정의 query 는 그대로 실행하므로 집계 방식을 적용하지 않는다.
+		customChart = &c
+		query, aggregation, unit = c.Query, "", c.Unit
+	}
+
+	// thanos 보관 기간 이전의 데이터는 DB 에 저장된 일별 사용률로 대체한다.
+	start := int(now.Unix()) - durationSec
+	liveStart := start
+	if retentionSec := viper.GetInt("lma-retention-days") * 60 * 60 * 24; retentionSec > 0 && int(now.Unix())-retentionSec > start {
+		liveStart = int(now.Unix()) - retentionSec
+	}
+
+	history := map[string]map[string]float64{}
+	if liveStart > start && (chartType == domain.ChartType_CPU.String() || chartType == domain.ChartType_MEMORY.String()) {
+		history, err = u.getUtilizationHistory(ctx, organizationId, domain.UtilizationMetric(chartType), aggregation, time.Unix(int64(start), 0), time.Unix(int64(liveStart), 0))
+		if err != nil {
+			log.Error(ctx, err)
+		}
 	}
 
-	result, err := thanosClient.FetchRange(ctx, query, int(now.Unix())-durationSec, int(now.Unix()), intervalSec)
+	// 시리즈 당 포인트 수가 chart-max-points 를 넘지 않도록 step 을 늘린다.
+	maxPoints := viper.GetInt("chart-max-points")
+	intervalSec = getLimitedIntervalSec(int(now.Unix())-liveStart, intervalSec, maxPoints)
+	liveStart = alignToInterval(liveStart, intervalSec, loc)
+
+	result, err := thanosClient.FetchRange(ctx, query, liveStart, int(now.Unix()), intervalSec)
 	if err != nil {
 		return res, err
 	}
 
+	warnings := []string{}
+	maxSeries := viper.GetInt("chart-max-series")
+	droppedSeries := 0
+
 	// 모든 x축 부터 계산
 	xAxisData := []string{}
+	for _, values := range history {
+		for x := range values {
+			if !slices.Contains(xAxisData, x) {
+				xAxisData = append(xAxisData, x)
+			}
+		}
+	}
 	for _, val := range result.Data.Result {
 		for _, vals := range val.Values {
 			x := int(math.Round(vals.([]interface{})[0].(float64)))
@@ -429,33 +925,107 @@ func (u *DashboardUsecase) getChartFromPrometheus(ctx context.Context, organizat
 		b, _ := strconv.Atoi(xAxisData[j])
 		return a < b
 	})
+	if maxPoints > 0 && len(xAxisData) > maxPoints {
+		warnings = append(warnings, fmt.Sprintf("points are downsampled to %d of %d", maxPoints, len(xAxisData)))
+		xAxisData = downsampleAxis(xAxisData, maxPoints)
+	}
 
-	// cluster 별 y축 계산
-	for _, val := range result.Data.Result {
-		yAxisData := []string{}
+	// 조회 결과가 비율(0~1)인 chart 는 백분율로 변환한다.
+	multiplier := 1.0
+	if chartType == domain.ChartType_CPU.String() || chartType == domain.ChartType_MEMORY.String() {
+		multiplier = 100
+	}
 
-		for _, xAxis := range xAxisData {
-			percentage := false
-			if chartType == domain.ChartType_CPU.String() || chartType == domain.ChartType_MEMORY.String() {
-				percentage = true
+	// cluster 별 y축 계산
+	seriesNames := []string{}
+	seriesValues := [][]float64{}
+	for i, val := range result.Data.Result {
+		if maxSeries > 0 && len(seriesValues) >= maxSeries {
+			delete(history, val.Metric.TacoCluster)
+			droppedSeries++
+			continue
+		}
+		yAxisData := make([]float64, len(xAxisData))
+		for i, xAxis := range xAxisData {
+			y, ok := getChartYValue(val.Values, xAxis)
+			if ok {
+				y = y * multiplier
+			} else if y, ok = history[val.Metric.TacoCluster][xAxis]; !ok {
+				y = math.NaN()
 			}
-			yAxisData = append(yAxisData, u.getChartYValue(val.Values, xAxis, percentage))
+			yAxisData[i] = y
 		}
+		delete(history, val.Metric.TacoCluster)
 
 		clusterName, err := u.getClusterNameFromId(ctx, val.Metric.TacoCluster)
 		if err != nil {
 			clusterName = val.Metric.TacoCluster
 		}
+		if clusterName == "" && customChart != nil {
+			clusterName = getCustomChartSeriesName(val.Metric, i)
+		}
 
+		seriesNames = append(seriesNames, clusterName)
+		seriesValues = append(seriesValues, yAxisData)
+	}
+
+	// thanos 에는 없고 DB 에만 남아있는 cluster
+	for clusterId, values := range history {
+		if maxSeries > 0 && len(seriesValues) >= maxSeries {
+			droppedSeries++
+			continue
+		}
+		yAxisData := make([]float64, len(xAxisData))
+		for i, xAxis := range xAxisData {
+			y, ok := values[xAxis]
+			if !ok {
+				y = math.NaN()
+			}
+			yAxisData[i] = y
+		}
+
+		clusterName, err := u.getClusterNameFromId(ctx, clusterId)
+		if err != nil {
+			clusterName = clusterId
+		}
+
+		seriesNames = append(seriesNames, clusterName)
+		seriesValues = append(seriesValues, yAxisData)
+	}
+
+	// 모든 series 가 같은 단위로 표시되도록 최대값 기준으로 scale 을 정한다.
+	format, divisor := getChartFormat(unit, seriesValues)
+	for i, values := range seriesValues {
 		chartData.Series = append(chartData.Series, domain.Unit{
-			Name: clusterName,
-			Data: yAxisData,
+			Name: seriesNames[i],
+			Data: formatChartValues(values, divisor, format.Precision),
 		})
+	}
 
+	if droppedSeries > 0 {
+		warnings = append(warnings, fmt.Sprintf("series are limited to %d of %d", maxSeries, maxSeries+droppedSeries))
 	}
 	chartData.XAxis = &domain.Axis{}
 	chartData.XAxis.Data = xAxisData
 
+	if customChart != nil {
+		return domain.DashboardChart{
+			ChartType:      domain.ChartType_CUSTOM,
+			OrganizationId: organizationId,
+			Name:           customChart.Name,
+			Description:    customChart.Description,
+			Duration:       duration,
+			Interval:       interval,
+			Timezone:       timezone,
+			ChartData:      chartData,
+			Format:         format,
+			CustomChartId:  customChart.ID.String(),
+			Thresholds:     getCustomChartThresholds(*customChart),
+			Warnings:       warnings,
+			UpdatedAt:      time.Now(),
+		}, nil
+	}
+
 	return domain.DashboardChart{
 		ChartType:      new(domain.ChartType).FromString(chartType),
 		OrganizationId: organizationId,
@@ -463,89 +1033,275 @@ func (u *DashboardUsecase) getChartFromPrometheus(ctx context.Context, organizat
 		Description:    chartType + " 통계 데이터",
 		Duration:       duration,
 		Interval:       interval,
+		Aggregation:    aggregation,
+		Timezone:       timezone,
 		ChartData:      chartData,
+		Format:         format,
+		Warnings:       warnings,
 		UpdatedAt:      time.Now(),
 	}, nil
 
 }
 
-func (u *DashboardUsecase) getThanosUrl(ctx context.Context, organizationId string) (out string, err error) {
-	const prefix = "CACHE_KEY_THANOS_URL"
-	value, found := u.cache.Get(prefix + organizationId)
-	if found {
-		return value.(string), nil
+// getLimitedIntervalSec widens intervalSec so that durationSec is covered by at most maxPoints points
+func getLimitedIntervalSec(durationSec int, intervalSec int, maxPoints int) int {
+	if maxPoints <= 0 || intervalSec <= 0 || durationSec/intervalSec <= maxPoints {
+		return intervalSec
 	}
+	return int(math.Ceil(float64(durationSec) / float64(maxPoints)))
+}
 
-	organization, err := u.organizationRepo.Get(ctx, organizationId)
+// downsampleAxis picks maxPoints evenly spaced values from the sorted axis, keeping the latest one
+func downsampleAxis(axis []string, maxPoints int) []string {
+	if maxPoints <= 0 || len(axis) <= maxPoints {
+		return axis
+	}
+	out := make([]string, 0, maxPoints)
+	last := len(axis) - 1
+	for i := maxPoints - 1; i >= 0; i-- {
+		out = append(out, axis[last-int(math.Round(float64(i)*float64(last)/float64(maxPoints-1)))])
+	}
+	return out
+}
+
+// getUtilizationHistory returns daily utilization by clusterId and x axis(unix time).
+// The daily max is used for p95 since percentiles are not downsampled.
+func (u *DashboardUsecase) getUtilizationHistory(ctx context.Context, organizationId string, metric domain.UtilizationMetric, aggregation domain.ChartAggregation, start time.Time, end time.Time) (map[string]map[string]float64, error) {
+	utilizations, err := u.clusterUtilizationRepo.Fetch(ctx, organizationId, metric, start, end)
 	if err != nil {
-		return out, errors.Wrap(err, "Failed to get organization")
+		return nil, err
 	}
 
-	//organization.PrimaryClusterId = "cmnl6zqmb"
-	if organization.PrimaryClusterId == "" {
-		return out, fmt.Errorf("Invalid primary clusterId")
+	out := make(map[string]map[string]float64)
+	for _, utilization := range utilizations {
+		clusterId := utilization.ClusterId.String()
+		if _, ok := out[clusterId]; !ok {
+			out[clusterId] = make(map[string]float64)
+		}
+		value := utilization.Avg
+		switch aggregation {
+		case domain.ChartAggregation_MAX, domain.ChartAggregation_P95:
+			value = utilization.Max
+		case domain.ChartAggregation_MIN:
+			value = utilization.Min
+		}
+		out[clusterId][strconv.FormatInt(utilization.Date.Unix(), 10)] = value
 	}
+	return out, nil
+}
 
-	clientset_admin, err := kubernetes.GetClientAdminCluster(ctx)
-	if err != nil {
-		return out, errors.Wrap(err, "Failed to get client set for user cluster")
+// aggregateByCluster wraps the expression with the aggregation by taco_cluster
+func aggregateByCluster(aggregation domain.ChartAggregation, expr string) string {
+	if aggregation == domain.ChartAggregation_P95 {
+		return "quantile by (taco_cluster) (0.95, " + expr + ")"
 	}
+	return string(aggregation) + " by (taco_cluster) (" + expr + ")"
+}
+
+var utilizationQueries = map[domain.UtilizationMetric]string{
+	domain.UtilizationMetric_CPU:     "avg by (taco_cluster) (instance:node_cpu:ratio*100)",
+	domain.UtilizationMetric_MEMORY:  "sum by (taco_cluster) (node_memory_MemTotal_bytes - node_memory_MemAvailable_bytes) / sum by (taco_cluster) (node_memory_MemTotal_bytes) * 100",
+	domain.UtilizationMetric_STORAGE: "sum by (taco_cluster) (kubelet_volume_stats_used_bytes) / sum by (taco_cluster) (kubelet_volume_stats_capacity_bytes) * 100",
+}
 
-	// tks-endpoint-secret 이 있다면 그 secret 내의 endpoint 를 사용한다.
-	secrets, err := clientset_admin.CoreV1().Secrets(organization.PrimaryClusterId).Get(context.TODO(), "tks-endpoint-secret", metav1.GetOptions{})
+// DownsampleUtilization stores daily min/avg/max utilization of all clusters for the given date.
+func (u *DashboardUsecase) DownsampleUtilization(ctx context.Context, date time.Time) error {
+	organizations, err := u.organizationRepo.Fetch(ctx, nil)
 	if err != nil {
-		log.Info(ctx, "cannot found tks-endpoint-secret. so use LoadBalancer...")
+		return errors.Wrap(err, "Failed to get organizations")
+	}
 
-		clientset_user, err := kubernetes.GetClientFromClusterId(ctx, organization.PrimaryClusterId)
-		if err != nil {
-			return out, errors.Wrap(err, "Failed to get client set for user cluster")
+	for _, organization := range *organizations {
+		if organization.PrimaryClusterId == "" {
+			continue
 		}
+		if err := u.downsampleOrganizationUtilization(ctx, organization.ID, date); err != nil {
+			log.Errorf(ctx, "Failed to downsample utilization. organizationId: %s, err: %s", organization.ID, err)
+		}
+	}
 
-		service, err := clientset_user.CoreV1().Services("lma").Get(context.TODO(), "thanos-query-frontend", metav1.GetOptions{})
-		if err != nil {
-			service, err = clientset_user.CoreV1().Services("lma").Get(context.TODO(), "thanos-query", metav1.GetOptions{})
+	if retentionDays := viper.GetInt("utilization-retention-days"); retentionDays > 0 {
+		if err := u.clusterUtilizationRepo.DeleteBefore(ctx, date.AddDate(0, 0, -retentionDays)); err != nil {
+			return errors.Wrap(err, "Failed to delete expired utilizations")
+		}
+	}
+	return nil
+}
+
+func (u *DashboardUsecase) downsampleOrganizationUtilization(ctx context.Context, organizationId string, date time.Time) error {
+	thanosClient, err := u.GetThanosClient(ctx, organizationId)
+	if err != nil {
+		return err
+	}
+
+	y, m, d := date.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	end := int(day.AddDate(0, 0, 1).Unix())
+
+	type key struct {
+		clusterId string
+		metric    domain.UtilizationMetric
+	}
+	utilizations := make(map[key]*model.ClusterUtilization)
+
+	for metric, query := range utilizationQueries {
+		for _, fn := range []string{"min", "avg", "max"} {
+			q := fmt.Sprintf("%s_over_time((%s)[1d:5m])", fn, query)
+			result, err := thanosClient.FetchRange(ctx, q, end, end, 60*60*24)
 			if err != nil {
-				return out, errors.Wrap(err, "Failed to get services.")
+				return err
 			}
-		}
 
-		// LoadBalaner 일경우, aws address 형태의 경우만 가정한다.
-		if service.Spec.Type != "LoadBalancer" {
-			return out, fmt.Errorf("Service type is not LoadBalancer. [%s] ", service.Spec.Type)
-		}
+			for _, val := range result.Data.Result {
+				value, ok := getLastMetricValue(val.Values)
+				if !ok {
+					continue
+				}
 
-		lbs := service.Status.LoadBalancer.Ingress
-		ports := service.Spec.Ports
-		if len(lbs) > 0 && len(ports) > 0 {
-			out = ports[0].TargetPort.StrVal + "://" + lbs[0].Hostname + ":" + strconv.Itoa(int(ports[0].Port))
-			u.cache.Set(prefix+organizationId, out, gcache.DefaultExpiration)
-			return out, nil
+				k := key{clusterId: val.Metric.TacoCluster, metric: metric}
+				if _, ok := utilizations[k]; !ok {
+					utilizations[k] = &model.ClusterUtilization{
+						OrganizationId: organizationId,
+						ClusterId:      domain.ClusterId(val.Metric.TacoCluster),
+						Metric:         metric,
+						Date:           day,
+					}
+				}
+				switch fn {
+				case "min":
+					utilizations[k].Min = value
+				case "avg":
+					utilizations[k].Avg = value
+				case "max":
+					utilizations[k].Max = value
+				}
+			}
 		}
-	} else {
-		out = "http://" + string(secrets.Data["thanos"])
-		log.Info(ctx, "thanosUrl : ", out)
-		u.cache.Set(prefix+organizationId, out, gcache.DefaultExpiration)
-		return out, nil
 	}
 
-	return
+	out := make([]model.ClusterUtilization, 0, len(utilizations))
+	for _, utilization := range utilizations {
+		out = append(out, *utilization)
+	}
+	return u.clusterUtilizationRepo.Upsert(ctx, out)
+}
+
+func getLastMetricValue(values []interface{}) (float64, bool) {
+	if len(values) == 0 {
+		return 0, false
+	}
+	pair, ok := values[len(values)-1].([]interface{})
+	if !ok {
+		return 0, false
+	}
+	return getMetricValue(pair)
 }
 
-func (u *DashboardUsecase) getChartYValue(values []interface{}, xData string, percentage bool) (out string) {
+func getChartYValue(values []interface{}, xData string) (float64, bool) {
 	for _, vals := range values {
 		x := int(math.Round(vals.([]interface{})[0].(float64)))
-		y, err := strconv.ParseFloat(vals.([]interface{})[1].(string), 32)
-		if err != nil {
-			return ""
-		}
 		if strconv.Itoa(x) == xData {
-			if percentage {
-				y = y * 100
+			return getMetricValue(vals.([]interface{}))
+		}
+	}
+	return 0, false
+}
+
+// getChartQuery 는 chart 종류별 range query 와 실제 적용되는 집계 방식을 반환한다.
+func getChartQuery(chartType string, interval string, aggregation domain.ChartAggregation) (string, domain.ChartAggregation) {
+	switch chartType {
+	case domain.ChartType_CPU.String():
+		//query := "sum (avg(1-rate(node_cpu_seconds_total{mode=\"idle\"}[1h])) by (taco_cluster))"
+		return aggregateByCluster(aggregation, "1-irate(node_cpu_seconds_total{mode=\"idle\"}["+interval+"])"), aggregation
+
+	case domain.ChartType_MEMORY.String():
+		// 평균은 클러스터 전체 사용률을, 그 외의 집계는 노드별 사용률을 기준으로 한다.
+		if aggregation == domain.ChartAggregation_AVG {
+			return "avg by (taco_cluster) (sum(node_memory_MemTotal_bytes - node_memory_MemAvailable_bytes) by (taco_cluster) / sum(node_memory_MemTotal_bytes) by (taco_cluster))", aggregation
+		}
+		return aggregateByCluster(aggregation, "(node_memory_MemTotal_bytes - node_memory_MemAvailable_bytes) / node_memory_MemTotal_bytes"), aggregation
+
+	case domain.ChartType_POD.String():
+		// 재기동 수는 합계만 의미가 있으므로 집계 방식을 적용하지 않는다.
+		return "sum by (taco_cluster) (changes(kube_pod_container_status_restarts_total{namespace!=\"kube-system\"}[" + interval + "]))", ""
+
+	case domain.ChartType_TRAFFIC.String():
+		return aggregateByCluster(aggregation, "irate(container_network_receive_bytes_total["+interval+"])"), aggregation
+	}
+	return "", aggregation
+}
+
+var chartUnits = map[string]domain.ChartUnit{
+	domain.ChartType_CPU.String():          domain.ChartUnit_PERCENT,
+	domain.ChartType_MEMORY.String():       domain.ChartUnit_PERCENT,
+	domain.ChartType_POD.String():          domain.ChartUnit_COUNT,
+	domain.ChartType_TRAFFIC.String():      domain.ChartUnit_RATE,
+	domain.ChartType_POD_CALENDAR.String(): domain.ChartUnit_COUNT,
+}
+
+// getChartFormat returns the format of the chart and the divisor to apply to its values.
+// The prefix is chosen by the largest value so that every series shares the same scale.
+func getChartFormat(unit domain.ChartUnit, series [][]float64) (domain.ChartFormat, float64) {
+	format := domain.ChartFormat{Unit: unit, Precision: 2}
+
+	base, prefixes := getChartPrefixes(unit)
+	if len(prefixes) == 0 {
+		return format, 1
+	}
+	if unit == domain.ChartUnit_COUNT {
+		format.Precision = 0
+	}
+
+	max := 0.0
+	for _, values := range series {
+		for _, v := range values {
+			if !math.IsNaN(v) && math.Abs(v) > max {
+				max = math.Abs(v)
 			}
-			return fmt.Sprintf("%f", y)
 		}
 	}
-	return ""
+
+	divisor := 1.0
+	for i := 1; i < len(prefixes) && max >= divisor*base; i++ {
+		divisor = divisor * base
+		format.Prefix = prefixes[i]
+		format.Precision = 2
+	}
+	return format, divisor
+}
+
+func getChartPrefixes(unit domain.ChartUnit) (base float64, prefixes []string) {
+	switch unit {
+	case domain.ChartUnit_BYTES, domain.ChartUnit_RATE:
+		return 1024, []string{"", "Ki", "Mi", "Gi", "Ti", "Pi"}
+	case domain.ChartUnit_COUNT:
+		return 1000, []string{"", "k", "M", "G", "T", "P"}
+	}
+	return 1, nil
+}
+
+// getChartDivisor returns the divisor of the values formatted with the prefix of the format.
+func getChartDivisor(format domain.ChartFormat) float64 {
+	base, prefixes := getChartPrefixes(format.Unit)
+	divisor := 1.0
+	for _, prefix := range prefixes {
+		if prefix == format.Prefix {
+			return divisor
+		}
+		divisor = divisor * base
+	}
+	return 1
+}
+
+func formatChartValues(values []float64, divisor float64, precision int) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		out[i] = strconv.FormatFloat(v/divisor, 'f', precision, 64)
+	}
+	return out
 }
 
 func (u *DashboardUsecase) getStackMemoryDisk(result []thanos.MetricDataResult, clusterId string) (memory string, disk string) {
@@ -597,9 +1353,23 @@ func (u *DashboardUsecase) getStackCpu(result []thanos.MetricDataResult, cluster
 	return
 }
 
+func getStackAlerts(counts []model.SystemNotificationSeverityCount, clusterId domain.ClusterId) (out domain.DashboardStackAlert) {
+	for _, c := range counts {
+		if c.ClusterId != clusterId {
+			continue
+		}
+		switch strings.ToLower(c.Severity) {
+		case "critical":
+			out.Critical += c.Count
+		case "warning":
+			out.Warning += c.Count
+		}
+	}
+	return
+}
+
 func (u *DashboardUsecase) getClusterNameFromId(ctx context.Context, clusterId string) (clusterName string, err error) {
-	const prefix = "CACHE_KEY_CLUSTER_NAME_FROM_ID"
-	value, found := u.cache.Get(prefix + clusterId)
+	value, found := u.cache.Get(cacheKeyClusterName + clusterId)
 	if found {
 		return value.(string), nil
 	}
@@ -610,7 +1380,7 @@ func (u *DashboardUsecase) getClusterNameFromId(ctx context.Context, clusterId s
 	}
 	clusterName = cluster.Name
 
-	u.cache.Set(prefix+clusterId, clusterName, gcache.DefaultExpiration)
+	u.cache.Set(cacheKeyClusterName+clusterId, clusterName, gcache.DefaultExpiration)
 	return
 }
 
@@ -1031,17 +1801,7 @@ func (u *DashboardUsecase) GetPolicyViolationTop5(ctx context.Context, organizat
 }
 
 func (u *DashboardUsecase) GetThanosClient(ctx context.Context, organizationId string) (thanos.ThanosClient, error) {
-	thanosUrl, err := u.getThanosUrl(ctx, organizationId)
-	if err != nil {
-		log.Error(ctx, err)
-		return nil, httpErrors.NewInternalServerError(err, "D_INVALID_PRIMARY_STACK", "")
-	}
-	address, port := helper.SplitAddress(ctx, thanosUrl)
-	client, err := thanos.New(address, port, false, "")
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create thanos client")
-	}
-	return client, nil
+	return u.thanosClients.Get(ctx, organizationId)
 }
 
 func (u *DashboardUsecase) GetFlatClusterIds(ctx context.Context, organizationId string) (string, error) {
@@ -1060,9 +1820,9 @@ func (u *DashboardUsecase) GetFlatClusterIds(ctx context.Context, organizationId
 
 func rangeDate(start, end time.Time) func() time.Time {
 	y, m, d := start.Date()
-	start = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	start = time.Date(y, m, d, 0, 0, 0, 0, start.Location())
 	y, m, d = end.Date()
-	end = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	end = time.Date(y, m, d, 0, 0, 0, 0, end.Location())
 
 	return func() time.Time {
 		if start.After(end) {
@@ -1085,10 +1845,24 @@ func getDurationAndIntervalSec(duration string, interval string) (int, int) {
 		durationSec = 60 * 60 * 24 * 7
 	case "30d":
 		durationSec = 60 * 60 * 24 * 30
+	case "90d":
+		durationSec = 60 * 60 * 24 * 90
+	case "180d":
+		durationSec = 60 * 60 * 24 * 180
+	case "365d":
+		durationSec = 60 * 60 * 24 * 365
 	}
 
 	intervalSec := 60 * 60 // default 1h
 	switch interval {
+	case "1m":
+		intervalSec = 60
+	case "5m":
+		intervalSec = 60 * 5
+	case "10m":
+		intervalSec = 60 * 10
+	case "30m":
+		intervalSec = 60 * 30
 	case "1h":
 		intervalSec = 60 * 60
 	case "1d":
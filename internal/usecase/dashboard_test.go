@@ -0,0 +1,479 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	gcache "github.com/patrickmn/go-cache"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/internal/testing/fakethanos"
+	"github.com/openinfradev/tks-api/internal/testing/memrepo"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
+)
+
+const testOrganizationId = "o1234test"
+
+func newDashboardFixture(t *testing.T) (repository.Repository, *fakethanos.Server) {
+	t.Helper()
+	ctx := context.Background()
+
+	repo := memrepo.New()
+	if _, err := repo.Organization.Create(ctx, &model.Organization{ID: testOrganizationId, Name: "test", PrimaryClusterId: "c1"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, cluster := range []model.Cluster{
+		{ID: "c1", Name: "primary", OrganizationId: testOrganizationId, Status: domain.ClusterStatus_RUNNING},
+		{ID: "c2", Name: "secondary", OrganizationId: testOrganizationId, Status: domain.ClusterStatus_RUNNING},
+	} {
+		if _, err := repo.Cluster.Create(ctx, cluster); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	srv := fakethanos.NewServer()
+	t.Cleanup(srv.Close)
+	srv.Add("node_cpu_seconds_total", fakethanos.Matrix(map[string]float64{"c1": 0.5, "c2": 0.25}))
+	srv.Add("node_memory_MemTotal_bytes", fakethanos.Matrix(map[string]float64{"c1": 0.4}))
+	srv.Add("container_network_receive_bytes_total", fakethanos.Matrix(map[string]float64{"c1": 2048}))
+	srv.Add("instance:node_cpu:ratio", fakethanos.Vector(map[string]float64{"c1": 30, "c2": 10}))
+
+	return repo, srv
+}
+
+func TestDashboardGetCharts(t *testing.T) {
+	ctx := context.Background()
+	repo, srv := newDashboardFixture(t)
+	u := usecase.NewDashboardUsecase(repo, gcache.New(time.Minute, time.Minute), usecase.ThanosClientFunc(srv.ThanosClient))
+
+	tests := []struct {
+		name           string
+		organizationId string
+		chartType      domain.ChartType
+		wantErr        bool
+		wantUnit       domain.ChartUnit
+		wantPrefix     string
+		wantSeries     map[string]float64
+	}{
+		{
+			name:           "cpu is converted to percent",
+			organizationId: testOrganizationId,
+			chartType:      domain.ChartType_CPU,
+			wantUnit:       domain.ChartUnit_PERCENT,
+			wantSeries:     map[string]float64{"primary": 50, "secondary": 25},
+		},
+		{
+			name:           "memory",
+			organizationId: testOrganizationId,
+			chartType:      domain.ChartType_MEMORY,
+			wantUnit:       domain.ChartUnit_PERCENT,
+			wantSeries:     map[string]float64{"primary": 40},
+		},
+		{
+			name:           "traffic is scaled with binary prefix",
+			organizationId: testOrganizationId,
+			chartType:      domain.ChartType_TRAFFIC,
+			wantUnit:       domain.ChartUnit_RATE,
+			wantPrefix:     "Ki",
+			wantSeries:     map[string]float64{"primary": 2},
+		},
+		{
+			name:           "unknown organization",
+			organizationId: "unknown",
+			chartType:      domain.ChartType_CPU,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			charts, err := u.GetCharts(ctx, tt.organizationId, tt.chartType, "1d", "1h", domain.ChartAggregation_AVG, "", "", "")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("GetCharts() expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetCharts() error = %v", err)
+			}
+			if len(charts) != 1 {
+				t.Fatalf("GetCharts() returned %d charts, want 1", len(charts))
+			}
+
+			chart := charts[0]
+			if chart.Format.Unit != tt.wantUnit || chart.Format.Prefix != tt.wantPrefix {
+				t.Errorf("format = %+v, want unit %s prefix %q", chart.Format, tt.wantUnit, tt.wantPrefix)
+			}
+			if len(chart.ChartData.Series) != len(tt.wantSeries) {
+				t.Fatalf("series = %+v, want %v", chart.ChartData.Series, tt.wantSeries)
+			}
+			for _, series := range chart.ChartData.Series {
+				want, ok := tt.wantSeries[series.Name]
+				if !ok {
+					t.Errorf("unexpected series %s", series.Name)
+					continue
+				}
+				if len(series.Data) != len(chart.ChartData.XAxis.Data) {
+					t.Errorf("series %s has %d points, x axis has %d", series.Name, len(series.Data), len(chart.ChartData.XAxis.Data))
+				}
+				for _, v := range series.Data {
+					if got, _ := strconv.ParseFloat(v, 64); got != want {
+						t.Errorf("series %s value = %s, want %v", series.Name, v, want)
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDashboardGetChartsPartialFailure(t *testing.T) {
+	ctx := context.Background()
+	repo, srv := newDashboardFixture(t)
+	srv.Fail("kube_pod_container_status_restarts_total", http.StatusInternalServerError)
+	u := usecase.NewDashboardUsecase(repo, gcache.New(time.Minute, time.Minute), usecase.ThanosClientFunc(srv.ThanosClient))
+
+	charts, err := u.GetCharts(ctx, testOrganizationId, domain.ChartType_ALL, "1d", "1h", domain.ChartAggregation_AVG, "2024", "1", "")
+	if err != nil {
+		t.Fatalf("GetCharts() error = %v", err)
+	}
+	errs := map[domain.ChartType]string{}
+	for _, chart := range charts {
+		errs[chart.ChartType] = chart.Error
+	}
+	if errs[domain.ChartType_POD] == "" {
+		t.Errorf("chart POD expected error")
+	}
+	for _, chartType := range []domain.ChartType{domain.ChartType_CPU, domain.ChartType_MEMORY, domain.ChartType_TRAFFIC} {
+		if errs[chartType] != "" {
+			t.Errorf("chart %s error = %q", chartType, errs[chartType])
+		}
+	}
+}
+
+func TestDashboardGetChartsWithHistory(t *testing.T) {
+	ctx := context.Background()
+	repo, srv := newDashboardFixture(t)
+	u := usecase.NewDashboardUsecase(repo, gcache.New(time.Minute, time.Minute), usecase.ThanosClientFunc(srv.ThanosClient))
+
+	viper.Set("lma-retention-days", 1)
+	t.Cleanup(func() { viper.Set("lma-retention-days", 0) })
+
+	date := time.Now().AddDate(0, 0, -3).Truncate(24 * time.Hour)
+	if err := repo.ClusterUtilization.Upsert(ctx, []model.ClusterUtilization{
+		{OrganizationId: testOrganizationId, ClusterId: "c1", Metric: domain.UtilizationMetric_CPU, Date: date, Avg: 42},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	charts, err := u.GetCharts(ctx, testOrganizationId, domain.ChartType_CPU, "7d", "1d", domain.ChartAggregation_AVG, "", "", "")
+	if err != nil {
+		t.Fatalf("GetCharts() error = %v", err)
+	}
+
+	chart := charts[0]
+	x := strconv.FormatInt(date.Unix(), 10)
+	for i, axis := range chart.ChartData.XAxis.Data {
+		if axis != x {
+			continue
+		}
+		for _, series := range chart.ChartData.Series {
+			if series.Name == "primary" && series.Data[i] != "42.00" {
+				t.Errorf("history value = %s, want 42.00", series.Data[i])
+			}
+		}
+		return
+	}
+	t.Errorf("x axis %v does not contain history date %s", chart.ChartData.XAxis.Data, x)
+}
+
+func TestDashboardGetChartsAnnotations(t *testing.T) {
+	ctx := context.Background()
+	repo, srv := newDashboardFixture(t)
+	u := usecase.NewDashboardUsecase(repo, gcache.New(time.Minute, time.Minute), usecase.ThanosClientFunc(srv.ThanosClient))
+
+	now := time.Now()
+	for _, operation := range []model.Operation{
+		{OrganizationId: testOrganizationId, Type: domain.OperationType_APP_DEPLOY, TargetName: "app", Parameters: []byte(`["target_cluster_id=c2"]`), Model: gorm.Model{CreatedAt: now.Add(-time.Hour)}},
+		{OrganizationId: testOrganizationId, Type: domain.OperationType_STACK_DELETE, TargetId: "c1", TargetName: "primary", Model: gorm.Model{CreatedAt: now.Add(-2 * time.Hour)}},
+		{OrganizationId: testOrganizationId, Type: domain.OperationType_STACK_CREATE, TargetName: "old", Model: gorm.Model{CreatedAt: now.AddDate(0, 0, -2)}},
+	} {
+		if _, err := repo.Operation.Create(ctx, operation); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, systemNotification := range []model.SystemNotification{
+		{OrganizationId: testOrganizationId, ClusterId: "c1", Severity: "critical", MessageTitle: "node down"},
+		{OrganizationId: testOrganizationId, ClusterId: "c1", Severity: "warning", MessageTitle: "disk"},
+	} {
+		if _, err := repo.SystemNotification.Create(ctx, systemNotification); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	charts, err := u.GetCharts(ctx, testOrganizationId, domain.ChartType_ALL, "1d", "1h", domain.ChartAggregation_AVG, "2024", "1", "")
+	if err != nil {
+		t.Fatalf("GetCharts() error = %v", err)
+	}
+
+	for _, chart := range charts {
+		if chart.ChartType != domain.ChartType_CPU && chart.ChartType != domain.ChartType_MEMORY {
+			if chart.ChartType == domain.ChartType_POD_CALENDAR && chart.Annotations != nil {
+				t.Errorf("%s has annotations", chart.ChartType)
+			}
+			continue
+		}
+
+		got := []string{}
+		for _, annotation := range chart.Annotations {
+			got = append(got, string(annotation.Type)+":"+annotation.ClusterId+":"+annotation.Title)
+		}
+		want := []string{"STACK_OPERATION:c1:primary", "DEPLOYMENT:c2:app", "ALERT:c1:node down"}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("%s annotations = %v, want %v", chart.ChartType, got, want)
+		}
+	}
+}
+
+func TestDashboardGetChartsAggregation(t *testing.T) {
+	tests := []struct {
+		aggregation domain.ChartAggregation
+		want        string
+	}{
+		{domain.ChartAggregation_MAX, "max by (taco_cluster) (1-irate(node_cpu_seconds_total"},
+		{domain.ChartAggregation_MIN, "min by (taco_cluster) (1-irate(node_cpu_seconds_total"},
+		{domain.ChartAggregation_P95, "quantile by (taco_cluster) (0.95, 1-irate(node_cpu_seconds_total"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.aggregation), func(t *testing.T) {
+			ctx := context.Background()
+			repo, srv := newDashboardFixture(t)
+			u := usecase.NewDashboardUsecase(repo, gcache.New(time.Minute, time.Minute), usecase.ThanosClientFunc(srv.ThanosClient))
+
+			charts, err := u.GetCharts(ctx, testOrganizationId, domain.ChartType_CPU, "1d", "1h", tt.aggregation, "", "", "")
+			if err != nil {
+				t.Fatalf("GetCharts() error = %v", err)
+			}
+			if charts[0].Aggregation != tt.aggregation {
+				t.Errorf("aggregation = %s, want %s", charts[0].Aggregation, tt.aggregation)
+			}
+			for _, query := range srv.Queries() {
+				if strings.HasPrefix(query, tt.want) {
+					return
+				}
+			}
+			t.Errorf("queries %v do not start with %q", srv.Queries(), tt.want)
+		})
+	}
+}
+
+func TestDashboardGetStacks(t *testing.T) {
+	ctx := context.Background()
+	repo, srv := newDashboardFixture(t)
+	u := usecase.NewDashboardUsecase(repo, gcache.New(time.Minute, time.Minute), usecase.ThanosClientFunc(srv.ThanosClient))
+
+	if _, err := repo.SystemNotification.Create(ctx, model.SystemNotification{
+		OrganizationId: testOrganizationId, ClusterId: "c1", Severity: "CRITICAL",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for _, maintenanceWindow := range []model.MaintenanceWindow{
+		{OrganizationId: testOrganizationId, ClusterId: "c2", StartAt: time.Now().Add(-time.Hour), EndAt: time.Now().Add(time.Hour)},
+		{OrganizationId: testOrganizationId, ClusterId: "c1", StartAt: time.Now().Add(time.Hour), EndAt: time.Now().Add(2 * time.Hour)},
+	} {
+		if _, err := repo.MaintenanceWindow.Create(ctx, maintenanceWindow); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stacks, err := u.GetStacks(ctx, testOrganizationId, nil)
+	if err != nil {
+		t.Fatalf("GetStacks() error = %v", err)
+	}
+
+	want := map[domain.StackId]struct {
+		cpu              string
+		critical         int
+		underMaintenance bool
+	}{
+		"c1": {cpu: "30.00%", critical: 1},
+		"c2": {cpu: "10.00%", critical: 0, underMaintenance: true},
+	}
+	if len(stacks) != len(want) {
+		t.Fatalf("GetStacks() returned %d stacks, want %d", len(stacks), len(want))
+	}
+	for _, stack := range stacks {
+		w, ok := want[stack.ID]
+		if !ok {
+			t.Errorf("unexpected stack %s", stack.ID)
+			continue
+		}
+		if stack.Cpu != w.cpu {
+			t.Errorf("stack %s cpu = %s, want %s", stack.ID, stack.Cpu, w.cpu)
+		}
+		if stack.Alerts.Critical != w.critical {
+			t.Errorf("stack %s critical alerts = %d, want %d", stack.ID, stack.Alerts.Critical, w.critical)
+		}
+		if stack.UnderMaintenance != w.underMaintenance || (stack.MaintenanceEndAt != nil) != w.underMaintenance {
+			t.Errorf("stack %s underMaintenance = %v (endAt %v), want %v", stack.ID, stack.UnderMaintenance, stack.MaintenanceEndAt, w.underMaintenance)
+		}
+	}
+}
+
+func TestDashboardWithoutPrimaryStack(t *testing.T) {
+	ctx := context.Background()
+	repo, _ := newDashboardFixture(t)
+	if err := repo.Organization.UpdatePrimaryClusterId(ctx, testOrganizationId, ""); err != nil {
+		t.Fatal(err)
+	}
+	cache := gcache.New(time.Minute, time.Minute)
+	u := usecase.NewDashboardUsecase(repo, cache, usecase.NewThanosClientFactory(repo, cache, usecase.NewEncryptionKeyUsecase(repo)))
+
+	if _, err := u.GetStacks(ctx, testOrganizationId, nil); err == nil {
+		t.Errorf("GetStacks() expected error without primary stack")
+	}
+}
+
+func TestDashboardFailoverToLmaEndpoint(t *testing.T) {
+	ctx := context.Background()
+	repo, srv := newDashboardFixture(t)
+	if err := repo.Organization.UpdatePrimaryClusterId(ctx, testOrganizationId, ""); err != nil {
+		t.Fatal(err)
+	}
+	down := fakethanos.NewServer()
+	down.Close()
+	for i, url := range []string{down.URL, srv.URL} {
+		if _, err := repo.LmaEndpoint.Create(ctx, model.LmaEndpoint{OrganizationId: testOrganizationId, Url: url, Priority: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cache := gcache.New(time.Minute, time.Minute)
+	thanosClients := usecase.NewThanosClientFactory(repo, cache, usecase.NewEncryptionKeyUsecase(repo))
+	u := usecase.NewDashboardUsecase(repo, cache, thanosClients)
+
+	stacks, err := u.GetStacks(ctx, testOrganizationId, nil)
+	if err != nil {
+		t.Fatalf("GetStacks() error = %v", err)
+	}
+	if len(stacks) != 2 {
+		t.Errorf("GetStacks() returned %d stacks, want 2", len(stacks))
+	}
+
+	health := thanosClients.Health(testOrganizationId)
+	if len(health) != 3 {
+		t.Fatalf("Health() returned %d endpoints, want 3", len(health))
+	}
+	want := []struct {
+		status domain.LmaEndpointStatus
+		active bool
+	}{
+		{domain.LmaEndpointStatus_UNHEALTHY, false},
+		{domain.LmaEndpointStatus_UNHEALTHY, false},
+		{domain.LmaEndpointStatus_HEALTHY, true},
+	}
+	for i, w := range want {
+		if health[i].Status != w.status || health[i].Active != w.active {
+			t.Errorf("endpoint %d status = %s, active = %t, want %s, %t", i, health[i].Status, health[i].Active, w.status, w.active)
+		}
+	}
+}
+
+func TestDashboardStreamChartsSnapshot(t *testing.T) {
+	ctx := context.Background()
+	repo, srv := newDashboardFixture(t)
+	u := usecase.NewDashboardUsecase(repo, gcache.New(time.Minute, time.Minute), usecase.ThanosClientFunc(srv.ThanosClient))
+
+	stop := errors.New("stop")
+	calls := 0
+	err := u.StreamCharts(ctx, testOrganizationId, []domain.ChartType{domain.ChartType_CPU, domain.ChartType_TRAFFIC}, "1h", "1m", domain.ChartAggregation_AVG,
+		func(incremental bool, charts []domain.DashboardChart) error {
+			calls++
+			if incremental {
+				t.Errorf("first message must be a snapshot")
+			}
+			if len(charts) != 2 {
+				t.Errorf("snapshot has %d charts, want 2", len(charts))
+			}
+			for _, chart := range charts {
+				if chart.Error != "" {
+					t.Errorf("chart %s error = %q", chart.ChartType, chart.Error)
+				}
+			}
+			return stop
+		})
+	if !errors.Is(err, stop) {
+		t.Fatalf("StreamCharts() error = %v, want %v", err, stop)
+	}
+	if calls != 1 {
+		t.Errorf("send called %d times, want 1", calls)
+	}
+
+	err = u.StreamCharts(ctx, testOrganizationId, []domain.ChartType{domain.ChartType_POD_CALENDAR}, "1h", "1m", domain.ChartAggregation_AVG,
+		func(bool, []domain.DashboardChart) error { return nil })
+	if err == nil {
+		t.Errorf("StreamCharts() expected error for POD_CALENDAR")
+	}
+}
+
+func TestDashboardGetPolicyEnforcementStatus(t *testing.T) {
+	ctx := context.Background()
+	repo, srv := newDashboardFixture(t)
+	srv.Add("max_over_time(opa_scorecard_constraint_violations", fakethanos.Matrix(map[string]float64{"c1": 3, "c2": 1}))
+	srv.Add("opa_scorecard_constraint_violations", fakethanos.Metric(thanos.Metric{Status: "success", Data: thanos.MetricData{Result: []thanos.MetricDataResult{
+		{Metric: thanos.MetricDataResultMetric{TacoCluster: "c1", Kind: "K8sRequiredLabels", ConstraintName: "must-have-owner"}, Value: []interface{}{0, "2"}},
+		{Metric: thanos.MetricDataResultMetric{TacoCluster: "c1", Kind: "K8sRequiredLabels", ConstraintName: "must-have-owner", ViolationEnforcement: "deny"}, Value: []interface{}{0, "1"}},
+		{Metric: thanos.MetricDataResultMetric{TacoCluster: "c2", Kind: "K8sRequiredLabels", ConstraintName: "must-have-owner", ViolationEnforcement: "deny"}, Value: []interface{}{0, "1"}},
+		{Metric: thanos.MetricDataResultMetric{TacoCluster: "c2", Kind: "K8sAllowedRepos", ConstraintName: "allowed-repos", ViolationEnforcement: "warn"}, Value: []interface{}{0, "0"}},
+		{Metric: thanos.MetricDataResultMetric{TacoCluster: "unknown", Kind: "K8sAllowedRepos", ConstraintName: "allowed-repos", ViolationEnforcement: "warn"}, Value: []interface{}{0, "5"}},
+	}}}))
+	srv.Fail("kyverno_policy_results_total", http.StatusInternalServerError)
+	u := usecase.NewDashboardUsecase(repo, gcache.New(time.Minute, time.Minute), usecase.ThanosClientFunc(srv.ThanosClient))
+
+	out, err := u.GetPolicyEnforcementStatus(ctx, testOrganizationId, "1d", "1h")
+	if err != nil {
+		t.Fatalf("GetPolicyEnforcementStatus() error = %v", err)
+	}
+	if len(out.Warnings) != 2 {
+		t.Errorf("warnings = %v, want kyverno violations and trend", out.Warnings)
+	}
+
+	if len(out.Policies) != 1 {
+		t.Fatalf("policies = %+v, want 1", out.Policies)
+	}
+	policy := out.Policies[0]
+	if policy.Engine != domain.PolicyEngine_GATEKEEPER || policy.Name != "must-have-owner" || policy.EnforcementAction != domain.PolicyEnforcementAction_DENY || policy.Total != 4 {
+		t.Errorf("policy = %+v", policy)
+	}
+	if len(policy.Clusters) != 2 || policy.Clusters[0].ClusterId != "c1" || policy.Clusters[0].Count != 3 {
+		t.Errorf("policy clusters = %+v", policy.Clusters)
+	}
+
+	for _, cluster := range out.Clusters {
+		want := map[string]int{"c1": 3, "c2": 1}[cluster.ClusterId]
+		if cluster.Total != want || cluster.Deny != want {
+			t.Errorf("cluster %s total = %d, deny = %d, want %d", cluster.ClusterId, cluster.Total, cluster.Deny, want)
+		}
+		if len(cluster.Trend) != len(out.XAxis.Data) || cluster.Trend[len(cluster.Trend)-1] != want {
+			t.Errorf("cluster %s trend = %v", cluster.ClusterId, cluster.Trend)
+		}
+	}
+
+	repo, srv = newDashboardFixture(t)
+	srv.Fail("opa_scorecard_constraint_violations", http.StatusInternalServerError)
+	srv.Fail("kyverno_policy_results_total", http.StatusInternalServerError)
+	u = usecase.NewDashboardUsecase(repo, gcache.New(time.Minute, time.Minute), usecase.ThanosClientFunc(srv.ThanosClient))
+	if _, err := u.GetPolicyEnforcementStatus(ctx, testOrganizationId, "1d", "1h"); err == nil {
+		t.Errorf("GetPolicyEnforcementStatus() expected error when all policy engines fail")
+	}
+}
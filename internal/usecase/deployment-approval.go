@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	argowf "github.com/openinfradev/tks-api/pkg/argo-client"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IDeploymentApprovalUsecase interface {
+	GetPolicy(ctx context.Context, organizationId string, clusterId domain.ClusterId) (model.DeploymentApprovalPolicy, error)
+	UpdatePolicy(ctx context.Context, dto model.DeploymentApprovalPolicy) error
+	DeletePolicy(ctx context.Context, organizationId string, clusterId domain.ClusterId) error
+	Get(ctx context.Context, organizationId string, approvalId uuid.UUID) (model.DeploymentApproval, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.DeploymentApproval, error)
+	Approve(ctx context.Context, organizationId string, approvalId uuid.UUID, comment string) (model.DeploymentApproval, error)
+	Reject(ctx context.Context, organizationId string, approvalId uuid.UUID, comment string) (model.DeploymentApproval, error)
+}
+
+type DeploymentApprovalUsecase struct {
+	repo            repository.IDeploymentApprovalRepository
+	clusterRepo     repository.IClusterRepository
+	userRepo        repository.IUserRepository
+	appServeAppRepo repository.IAppServeAppRepository
+	maintenanceRepo repository.IMaintenanceWindowRepository
+	argo            argowf.ArgoClient
+	operations      IOperationUsecase
+	encryptionKey   IEncryptionKeyUsecase
+}
+
+func NewDeploymentApprovalUsecase(r repository.Repository, argoClient argowf.ArgoClient, operations IOperationUsecase, encryptionKey IEncryptionKeyUsecase) IDeploymentApprovalUsecase {
+	return &DeploymentApprovalUsecase{
+		repo:            r.DeploymentApproval,
+		clusterRepo:     r.Cluster,
+		userRepo:        r.User,
+		appServeAppRepo: r.AppServeApp,
+		maintenanceRepo: r.MaintenanceWindow,
+		argo:            argoClient,
+		operations:      operations,
+		encryptionKey:   encryptionKey,
+	}
+}
+
+func (u *DeploymentApprovalUsecase) GetPolicy(ctx context.Context, organizationId string, clusterId domain.ClusterId) (out model.DeploymentApprovalPolicy, err error) {
+	out, err = u.repo.GetPolicy(ctx, clusterId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewError(err, "DA_NOT_FOUND_POLICY")
+		}
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if out.OrganizationId != organizationId {
+		return model.DeploymentApprovalPolicy{}, httpErrors.NewError(fmt.Errorf("not found policy in organization"), "DA_NOT_FOUND_POLICY")
+	}
+	return
+}
+
+func (u *DeploymentApprovalUsecase) UpdatePolicy(ctx context.Context, dto model.DeploymentApprovalPolicy) error {
+	cluster, err := u.clusterRepo.Get(ctx, dto.ClusterId)
+	if err != nil || cluster.OrganizationId != dto.OrganizationId {
+		return httpErrors.NewError(fmt.Errorf("not found cluster %s", dto.ClusterId), "DA_NOT_FOUND_CLUSTER")
+	}
+
+	dto.Approvers = make([]model.User, 0)
+	for _, strId := range dto.ApproverIds {
+		userId, err := uuid.Parse(strId)
+		if err != nil {
+			return httpErrors.NewError(err, "DA_INVALID_APPROVER")
+		}
+		user, err := u.userRepo.GetByUuid(ctx, userId)
+		if err != nil || user.OrganizationId != dto.OrganizationId {
+			return httpErrors.NewError(fmt.Errorf("not found approver %s", strId), "DA_INVALID_APPROVER")
+		}
+		dto.Approvers = append(dto.Approvers, user)
+	}
+	if len(dto.Approvers) == 0 {
+		return httpErrors.NewError(fmt.Errorf("approvers are required"), "DA_INVALID_APPROVER")
+	}
+
+	if err = u.repo.UpsertPolicy(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *DeploymentApprovalUsecase) DeletePolicy(ctx context.Context, organizationId string, clusterId domain.ClusterId) error {
+	if _, err := u.GetPolicy(ctx, organizationId, clusterId); err != nil {
+		return err
+	}
+
+	if err := u.repo.DeletePolicy(ctx, clusterId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *DeploymentApprovalUsecase) Get(ctx context.Context, organizationId string, approvalId uuid.UUID) (out model.DeploymentApproval, err error) {
+	out, err = u.repo.Get(ctx, approvalId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewError(err, "DA_NOT_FOUND_APPROVAL")
+		}
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if out.OrganizationId != organizationId {
+		return model.DeploymentApproval{}, httpErrors.NewError(fmt.Errorf("not found approval in organization"), "DA_NOT_FOUND_APPROVAL")
+	}
+	return
+}
+
+func (u *DeploymentApprovalUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.DeploymentApproval, error) {
+	approvals, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return approvals, nil
+}
+
+// Approve 는 승인 결과를 기록한 뒤 대기 중이던 workflow 를 시작한다.
+func (u *DeploymentApprovalUsecase) Approve(ctx context.Context, organizationId string, approvalId uuid.UUID, comment string) (out model.DeploymentApproval, err error) {
+	out, approverId, err := u.getDecidable(ctx, organizationId, approvalId)
+	if err != nil {
+		return out, err
+	}
+
+	app, err := u.appServeAppRepo.GetAppServeAppById(ctx, out.AppServeAppId)
+	if err != nil || app == nil {
+		return out, httpErrors.NewError(fmt.Errorf("not found app %s", out.AppServeAppId), "D_NO_ASA")
+	}
+	task, err := u.appServeAppRepo.GetAppServeAppTaskById(ctx, out.AppServeAppTaskId)
+	if err != nil || task == nil {
+		return out, httpErrors.NewError(fmt.Errorf("not found task %s", out.AppServeAppTaskId), "D_NO_ASA")
+	}
+
+	switch out.Action {
+	case domain.DeploymentApprovalAction_DEPLOY:
+		if app.Status != "APPROVAL_WAIT" {
+			return out, httpErrors.NewError(fmt.Errorf("the app is not waiting for approval"), "DA_INVALID_APP_STATUS")
+		}
+	case domain.DeploymentApprovalAction_PROMOTE:
+		if app.Status != "PROMOTE_WAIT" && app.Status != "PROMOTE_FAILED" {
+			return out, httpErrors.NewError(fmt.Errorf("the app is not waiting for promote"), "DA_INVALID_APP_STATUS")
+		}
+	}
+
+	// 승인 요청 이후에 유지보수가 시작되었을 수 있으므로 승인 시점에 다시 확인한다.
+	if err = checkMaintenanceWindow(ctx, u.maintenanceRepo, out.ClusterId); err != nil {
+		return out, err
+	}
+
+	if err = u.decide(ctx, &out, domain.DeploymentApprovalStatus_APPROVED, approverId, comment); err != nil {
+		return out, err
+	}
+
+	switch out.Action {
+	case domain.DeploymentApprovalAction_DEPLOY:
+		extEnv, err := transformExtraEnv(ctx, task.ExtraEnv)
+		if err != nil {
+			return out, httpErrors.NewBadRequestError(err, "", "")
+		}
+		if err = u.appServeAppRepo.UpdateStatus(ctx, app.ID, task.ID, "PREPARING", ""); err != nil {
+			return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+		if err = submitServeWorkflow(ctx, u.operations, u.encryptionKey, u.appServeAppRepo, app, task, extEnv); err != nil {
+			return out, httpErrors.NewError(err, "DA_FAILED_TO_CALL_WORKFLOW")
+		}
+	case domain.DeploymentApprovalAction_PROMOTE:
+		if err = u.appServeAppRepo.UpdateStatus(ctx, app.ID, task.ID, "PROMOTING", ""); err != nil {
+			return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+		if err = submitPromoteWorkflow(ctx, u.argo, app, task.ID, task.Strategy); err != nil {
+			return out, httpErrors.NewError(err, "DA_FAILED_TO_CALL_WORKFLOW")
+		}
+	}
+
+	return u.repo.Get(ctx, approvalId)
+}
+
+func (u *DeploymentApprovalUsecase) Reject(ctx context.Context, organizationId string, approvalId uuid.UUID, comment string) (out model.DeploymentApproval, err error) {
+	out, approverId, err := u.getDecidable(ctx, organizationId, approvalId)
+	if err != nil {
+		return out, err
+	}
+
+	if err = u.decide(ctx, &out, domain.DeploymentApprovalStatus_REJECTED, approverId, comment); err != nil {
+		return out, err
+	}
+
+	// 거절된 promote 는 PROMOTE_WAIT 상태로 남아 abort 하거나 다시 승인을 요청할 수 있다.
+	if out.Action == domain.DeploymentApprovalAction_DEPLOY {
+		if err = u.appServeAppRepo.UpdateStatus(ctx, out.AppServeAppId, out.AppServeAppTaskId, "APPROVAL_REJECTED", comment); err != nil {
+			return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+	}
+
+	return u.repo.Get(ctx, approvalId)
+}
+
+// getDecidable returns the pending approval if the current user is one of the nominated approvers of the cluster
+func (u *DeploymentApprovalUsecase) getDecidable(ctx context.Context, organizationId string, approvalId uuid.UUID) (out model.DeploymentApproval, approverId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return out, uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	approverId = user.GetUserId()
+
+	out, err = u.Get(ctx, organizationId, approvalId)
+	if err != nil {
+		return out, uuid.Nil, err
+	}
+	if out.Status != domain.DeploymentApprovalStatus_PENDING {
+		return out, uuid.Nil, httpErrors.NewError(fmt.Errorf("approval is not pending"), "DA_INVALID_APPROVAL_STATUS")
+	}
+	if out.RequesterId != nil && *out.RequesterId == approverId {
+		return out, uuid.Nil, httpErrors.NewError(fmt.Errorf("requester can not approve own request"), "DA_SELF_APPROVAL")
+	}
+
+	policy, err := u.GetPolicy(ctx, organizationId, out.ClusterId)
+	if err != nil {
+		return out, uuid.Nil, err
+	}
+	for _, approver := range policy.Approvers {
+		if approver.ID == approverId {
+			return out, approverId, nil
+		}
+	}
+	return out, uuid.Nil, httpErrors.NewError(fmt.Errorf("not a nominated approver"), "DA_NOT_APPROVER")
+}
+
+func (u *DeploymentApprovalUsecase) decide(ctx context.Context, approval *model.DeploymentApproval, status domain.DeploymentApprovalStatus, approverId uuid.UUID, comment string) error {
+	now := time.Now()
+	approval.Status = status
+	approval.Comment = comment
+	approval.ApproverId = &approverId
+	approval.DecidedAt = &now
+	if err := u.repo.Update(ctx, *approval); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	log.Infof(ctx, "deployment approval %s is %s", approval.ID, status)
+
+	notifyDeploymentApproval(ctx, approval.OrganizationId,
+		fmt.Sprintf("[TKS] 앱 [%s] %s 승인 결과", approval.AppServeApp.Name, approval.Action),
+		fmt.Sprintf("앱 [%s]의 %s 요청이 %s 되었습니다. %s", approval.AppServeApp.Name, approval.Action, status, comment),
+		[]model.User{approval.Requester})
+	return nil
+}
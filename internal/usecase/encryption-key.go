@@ -0,0 +1,296 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	kms "github.com/openinfradev/tks-api/pkg/kms-client"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IEncryptionKeyUsecase interface {
+	Fetch(ctx context.Context, organizationId string) ([]model.EncryptionKey, error)
+	Create(ctx context.Context, dto model.EncryptionKey) (encryptionKeyId uuid.UUID, err error)
+	Rotate(ctx context.Context, dto model.EncryptionKey) (encryptionKeyId uuid.UUID, sealed int, failed int, err error)
+	Revoke(ctx context.Context, organizationId string, encryptionKeyId uuid.UUID) error
+	Seal(ctx context.Context, organizationId string, plaintext []byte) ([]byte, error)
+	Open(ctx context.Context, value []byte) ([]byte, error)
+	SealSecrets(ctx context.Context) (sealed int, failed int, err error)
+	SealKubeconfigs(ctx context.Context) error
+}
+
+type EncryptionKeyUsecase struct {
+	repo             repository.IEncryptionKeyRepository
+	clusterRepo      repository.IClusterRepository
+	organizationRepo repository.IOrganizationRepository
+	secretRepo       repository.ISecretRepository
+	sealer           repository.ISecretSealer
+	kmsClient        func(ctx context.Context) (kms.KmsClient, error)
+}
+
+func NewEncryptionKeyUsecase(r repository.Repository) IEncryptionKeyUsecase {
+	return &EncryptionKeyUsecase{
+		repo:             r.EncryptionKey,
+		clusterRepo:      r.Cluster,
+		organizationRepo: r.Organization,
+		secretRepo:       r.Secret,
+		sealer:           r.SecretSealer,
+		kmsClient:        kms.NewWithAwsSecret,
+	}
+}
+
+func (u *EncryptionKeyUsecase) Fetch(ctx context.Context, organizationId string) ([]model.EncryptionKey, error) {
+	keys, err := u.repo.Fetch(ctx, organizationId)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return keys, nil
+}
+
+// Create 는 조직의 첫 암호화 키를 등록하고, master key 로 봉인했던 조직의 secret 을 새 key 로 다시 봉인한다.
+// 이미 ACTIVE key 가 있으면 Rotate 를 사용한다.
+func (u *EncryptionKeyUsecase) Create(ctx context.Context, dto model.EncryptionKey) (encryptionKeyId uuid.UUID, err error) {
+	if _, err := u.repo.GetActive(ctx, dto.OrganizationId); err == nil {
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("active encryption key already exists"), "O_ALREADY_EXISTED_ENCRYPTION_KEY")
+	}
+	if err := u.validate(ctx, dto.KeyArn); err != nil {
+		return uuid.Nil, err
+	}
+
+	encryptionKeyId, err = u.create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if _, failed := u.sealSecretsOf(ctx, dto.OrganizationId); failed > 0 {
+		log.Warnf(ctx, "failed to seal %d secrets of organization %s", failed, dto.OrganizationId)
+	}
+	return encryptionKeyId, nil
+}
+
+// Rotate 는 새 key 를 ACTIVE 로 등록하고 기존 key 는 RETIRED 로 바꾼 뒤, 조직의 secret 을 새 key 로 다시 봉인한다.
+// 재봉인에 실패한 secret 은 RETIRED key 로 계속 복호화되며, seal-secrets 명령으로 다시 봉인한다.
+func (u *EncryptionKeyUsecase) Rotate(ctx context.Context, dto model.EncryptionKey) (encryptionKeyId uuid.UUID, sealed int, failed int, err error) {
+	if err = u.validate(ctx, dto.KeyArn); err != nil {
+		return uuid.Nil, 0, 0, err
+	}
+
+	if current, err := u.repo.GetActive(ctx, dto.OrganizationId); err == nil {
+		if current.KeyArn == dto.KeyArn {
+			return uuid.Nil, 0, 0, httpErrors.NewError(fmt.Errorf("the key is already active"), "O_INVALID_ENCRYPTION_KEY")
+		}
+		if err := u.repo.UpdateStatus(ctx, current.ID, domain.EncryptionKeyStatus_RETIRED); err != nil {
+			return uuid.Nil, 0, 0, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+	}
+
+	encryptionKeyId, err = u.create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, 0, 0, err
+	}
+	sealed, failed = u.sealSecretsOf(ctx, dto.OrganizationId)
+	return encryptionKeyId, sealed, failed, nil
+}
+
+// Revoke 는 key 를 폐기한다. 폐기된 key 로 봉인된 secret 은 더 이상 복호화하지 않는다.
+func (u *EncryptionKeyUsecase) Revoke(ctx context.Context, organizationId string, encryptionKeyId uuid.UUID) error {
+	key, err := u.get(ctx, organizationId, encryptionKeyId)
+	if err != nil {
+		return err
+	}
+	if key.Status == domain.EncryptionKeyStatus_REVOKED {
+		return nil
+	}
+
+	if err := u.repo.UpdateStatus(ctx, key.ID, domain.EncryptionKeyStatus_REVOKED); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	u.sealer.Forget(key.ID)
+
+	log.Infof(ctx, "revoked encryption key %s of organization %s", key.ID, organizationId)
+	return nil
+}
+
+// Seal 은 조직의 ACTIVE key 로, key 가 없는 조직은 master key 로 값을 봉인한다.
+func (u *EncryptionKeyUsecase) Seal(ctx context.Context, organizationId string, plaintext []byte) ([]byte, error) {
+	sealed, err := u.sealer.Seal(ctx, organizationId, plaintext)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return sealed, nil
+}
+
+// Open 은 봉인된 값을 복호화한다. 봉인되지 않은 값은 그대로 반환한다.
+func (u *EncryptionKeyUsecase) Open(ctx context.Context, value []byte) ([]byte, error) {
+	plaintext, err := u.sealer.Open(ctx, value)
+	if err != nil {
+		if errors.Is(err, repository.ErrEncryptionKeyRevoked) {
+			return nil, httpErrors.NewError(err, "O_ENCRYPTION_KEY_REVOKED")
+		}
+		return nil, err
+	}
+	return plaintext, nil
+}
+
+// SealSecrets 는 모든 조직의 secret 중 봉인되지 않았거나 현재 key 가 아닌 key 로 봉인된 것을 다시 봉인한다.
+// 기존 데이터를 봉인하는 seal-secrets 명령에서 실행하며, key 를 교체하면서 재봉인에 실패한 secret 도 이 작업으로 봉인된다.
+func (u *EncryptionKeyUsecase) SealSecrets(ctx context.Context) (sealed int, failed int, err error) {
+	organizations, err := u.organizationRepo.Fetch(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, organization := range *organizations {
+		s, f := u.sealSecretsOf(ctx, organization.ID)
+		if f > 0 {
+			log.Warnf(ctx, "failed to seal %d secrets of organization %s", f, organization.ID)
+		}
+		sealed += s
+		failed += f
+	}
+	return sealed, failed, nil
+}
+
+// SealKubeconfigs 는 workflow 가 저장한 사용자 kubeconfig 중 봉인되지 않은 것을 봉인한다.
+// 관리자 kubeconfig 는 workflow, cluster-api 에서도 직접 사용하므로 봉인하지 않는다.
+func (u *EncryptionKeyUsecase) SealKubeconfigs(ctx context.Context) error {
+	clusters, err := u.clusterRepo.Fetch(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, cluster := range clusters {
+		if cluster.Status != domain.ClusterStatus_RUNNING {
+			continue
+		}
+		if _, err := u.sealKubeconfig(ctx, cluster.OrganizationId, cluster.ID.String()); err != nil {
+			log.Errorf(ctx, "failed to seal kubeconfig of cluster %s. err : %s", cluster.ID, err)
+		}
+	}
+	return nil
+}
+
+func (u *EncryptionKeyUsecase) sealSecretsOf(ctx context.Context, organizationId string) (sealed int, failed int) {
+	sealed, failed, err := u.secretRepo.Reseal(ctx, organizationId)
+	if err != nil {
+		log.Error(ctx, err)
+	}
+
+	clusters, err := u.clusterRepo.Fetch(ctx, nil)
+	if err != nil {
+		log.Error(ctx, err)
+		return sealed, failed
+	}
+	for _, cluster := range clusters {
+		if cluster.OrganizationId != organizationId || cluster.Status != domain.ClusterStatus_RUNNING {
+			continue
+		}
+
+		changed, err := u.sealKubeconfig(ctx, organizationId, cluster.ID.String())
+		if err != nil {
+			log.Errorf(ctx, "failed to seal kubeconfig of cluster %s. err : %s", cluster.ID, err)
+			failed++
+			continue
+		}
+		if changed {
+			sealed++
+		}
+	}
+	return sealed, failed
+}
+
+func (u *EncryptionKeyUsecase) sealKubeconfig(ctx context.Context, organizationId string, clusterId string) (changed bool, err error) {
+	raw, err := kubernetes.GetRawKubeConfig(ctx, clusterId, kubernetes.KubeconfigForUser)
+	if err != nil {
+		return false, err
+	}
+	value, changed, err := u.sealer.Reseal(ctx, organizationId, raw)
+	if err != nil || !changed {
+		return false, err
+	}
+	if err := kubernetes.UpdateKubeConfig(ctx, clusterId, kubernetes.KubeconfigForUser, value); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (u *EncryptionKeyUsecase) create(ctx context.Context, dto model.EncryptionKey) (uuid.UUID, error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	userId := user.GetUserId()
+	dto.CreatorId = &userId
+	dto.Provider = domain.EncryptionKeyProvider_AWS_KMS
+
+	encryptionKeyId, err := u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	log.Info(ctx, "newly created encryption key : ", encryptionKeyId)
+	return encryptionKeyId, nil
+}
+
+// validate 는 key 가 사용 가능한 상태인지, TKS 가 key 로 data key 를 생성할 권한이 있는지 확인한다.
+func (u *EncryptionKeyUsecase) validate(ctx context.Context, keyArn string) error {
+	if _, err := kms.RegionFromKeyArn(keyArn); err != nil {
+		return httpErrors.NewError(err, "O_INVALID_ENCRYPTION_KEY")
+	}
+
+	client, err := u.kmsClient(ctx)
+	if err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	metadata, err := client.DescribeKey(ctx, keyArn)
+	if err != nil {
+		return httpErrors.NewError(err, "O_INVALID_ENCRYPTION_KEY")
+	}
+	if !metadata.Enabled || metadata.KeyState != kms.KeyState_ENABLED || metadata.KeyUsage != kms.KeyUsage_ENCRYPT {
+		return httpErrors.NewError(fmt.Errorf("the key must be an enabled ENCRYPT_DECRYPT key. state : %s, usage : %s", metadata.KeyState, metadata.KeyUsage), "O_INVALID_ENCRYPTION_KEY")
+	}
+	if _, _, err := client.GenerateDataKey(ctx, keyArn); err != nil {
+		return httpErrors.NewError(err, "O_INVALID_ENCRYPTION_KEY")
+	}
+	return nil
+}
+
+func (u *EncryptionKeyUsecase) get(ctx context.Context, organizationId string, encryptionKeyId uuid.UUID) (model.EncryptionKey, error) {
+	key, err := u.repo.Get(ctx, encryptionKeyId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return key, httpErrors.NewError(err, "O_NOT_FOUND_ENCRYPTION_KEY")
+		}
+		return key, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if key.OrganizationId != organizationId {
+		return key, httpErrors.NewError(fmt.Errorf("Not found encryption key"), "O_NOT_FOUND_ENCRYPTION_KEY")
+	}
+	return key, nil
+}
+
+// sealOrganizationSecret 은 값을 봉인한다. 빈 값은 봉인하지 않는다.
+func sealOrganizationSecret(ctx context.Context, encryptionKey IEncryptionKeyUsecase, organizationId string, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	sealed, err := encryptionKey.Seal(ctx, organizationId, []byte(value))
+	if err != nil {
+		return "", err
+	}
+	return string(sealed), nil
+}
+
+func openOrganizationSecret(ctx context.Context, encryptionKey IEncryptionKeyUsecase, value string) (string, error) {
+	plaintext, err := encryptionKey.Open(ctx, []byte(value))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
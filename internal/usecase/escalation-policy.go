@@ -0,0 +1,362 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/mail"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IEscalationPolicyUsecase interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.EscalationPolicy, error)
+	Get(ctx context.Context, organizationId string, escalationPolicyId uuid.UUID) (model.EscalationPolicy, error)
+	Create(ctx context.Context, dto model.EscalationPolicy) (escalationPolicyId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.EscalationPolicy) error
+	Delete(ctx context.Context, organizationId string, escalationPolicyId uuid.UUID) error
+	Start(ctx context.Context, notification model.SystemNotification, escalationPolicyIds []uuid.UUID) error
+	Escalate(ctx context.Context) error
+}
+
+type EscalationPolicyUsecase struct {
+	repo                   repository.IEscalationPolicyRepository
+	organizationRepo       repository.IOrganizationRepository
+	userRepo               repository.IUserRepository
+	roleRepo               repository.IRoleRepository
+	alertChannelRepo       repository.IAlertChannelRepository
+	systemNotificationRepo repository.ISystemNotificationRepository
+	alertChannel           IAlertChannelUsecase
+}
+
+func NewEscalationPolicyUsecase(r repository.Repository, alertChannel IAlertChannelUsecase) IEscalationPolicyUsecase {
+	return &EscalationPolicyUsecase{
+		repo:                   r.EscalationPolicy,
+		organizationRepo:       r.Organization,
+		userRepo:               r.User,
+		roleRepo:               r.Role,
+		alertChannelRepo:       r.AlertChannel,
+		systemNotificationRepo: r.SystemNotification,
+		alertChannel:           alertChannel,
+	}
+}
+
+func (u *EscalationPolicyUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.EscalationPolicy, error) {
+	escalationPolicies, err := u.repo.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	for i := range escalationPolicies {
+		decodeEscalationPolicy(ctx, &escalationPolicies[i])
+	}
+	return escalationPolicies, nil
+}
+
+func (u *EscalationPolicyUsecase) Get(ctx context.Context, organizationId string, escalationPolicyId uuid.UUID) (out model.EscalationPolicy, err error) {
+	out, err = u.repo.Get(ctx, escalationPolicyId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewError(err, "EP_NOT_FOUND_ESCALATION_POLICY")
+		}
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if out.OrganizationId != organizationId {
+		return model.EscalationPolicy{}, httpErrors.NewError(fmt.Errorf("not found escalationPolicy in organization"), "EP_NOT_FOUND_ESCALATION_POLICY")
+	}
+	decodeEscalationPolicy(ctx, &out)
+	return
+}
+
+func (u *EscalationPolicyUsecase) Create(ctx context.Context, dto model.EscalationPolicy) (escalationPolicyId uuid.UUID, err error) {
+	if _, err = u.organizationRepo.Get(ctx, dto.OrganizationId); err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "EP_NOT_FOUND_ORGANIZATION")
+	}
+	if err = u.prepare(ctx, &dto); err != nil {
+		return uuid.Nil, err
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.CreatorId = &userId
+		dto.UpdatorId = &userId
+	}
+
+	escalationPolicyId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	log.Info(ctx, "newly created escalation policy : ", escalationPolicyId)
+
+	return escalationPolicyId, nil
+}
+
+// Update 로 바뀐 단계는 이후 에스컬레이션부터 적용된다. 이미 예약된 다음 에스컬레이션 시각은 바꾸지 않는다.
+func (u *EscalationPolicyUsecase) Update(ctx context.Context, dto model.EscalationPolicy) error {
+	if _, err := u.Get(ctx, dto.OrganizationId, dto.ID); err != nil {
+		return err
+	}
+	if err := u.prepare(ctx, &dto); err != nil {
+		return err
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.UpdatorId = &userId
+	}
+
+	if err := u.repo.Update(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// Delete 는 정책을 사용하는 라우팅 규칙에서 정책을 해제하고 진행 중인 에스컬레이션을 중단한다.
+func (u *EscalationPolicyUsecase) Delete(ctx context.Context, organizationId string, escalationPolicyId uuid.UUID) error {
+	if _, err := u.Get(ctx, organizationId, escalationPolicyId); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, escalationPolicyId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// Start 는 라우팅 규칙 순서대로 전달된 정책 중 앨럿에 적용되는 첫 번째 정책으로 에스컬레이션을 예약한다.
+func (u *EscalationPolicyUsecase) Start(ctx context.Context, notification model.SystemNotification, escalationPolicyIds []uuid.UUID) error {
+	for _, escalationPolicyId := range escalationPolicyIds {
+		escalationPolicy, err := u.repo.Get(ctx, escalationPolicyId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return err
+		}
+		decodeEscalationPolicy(ctx, &escalationPolicy)
+		if !escalationPolicy.Enabled || escalationPolicy.OrganizationId != notification.OrganizationId ||
+			len(escalationPolicy.Steps) == 0 || !matchSeverity(escalationPolicy.Severities, notification.Severity) {
+			continue
+		}
+
+		nextEscalationAt := escalationStepAt(notification, escalationPolicy.Steps[0])
+		notification.EscalationPolicyId = &escalationPolicy.ID
+		notification.EscalationLevel = 0
+		notification.NextEscalationAt = &nextEscalationAt
+		if err := u.systemNotificationRepo.UpdateEscalation(ctx, notification); err != nil {
+			return err
+		}
+		log.Infof(ctx, "systemNotification %s will be escalated by policy %s at %s", notification.ID, escalationPolicy.ID, nextEscalationAt)
+		return nil
+	}
+	return nil
+}
+
+// Escalate 는 확인되지 않은 채 다음 단계 시각이 지난 앨럿을 단계의 대상에게 다시 알리고 ESCALATED 상태로 바꾼다.
+// 마지막 단계까지 알린 앨럿은 더 이상 예약하지 않는다.
+func (u *EscalationPolicyUsecase) Escalate(ctx context.Context) error {
+	notifications, err := u.systemNotificationRepo.FetchEscalationDue(ctx, time.Now())
+	if err != nil {
+		return errors.Wrap(err, "Failed to get system notifications to escalate")
+	}
+
+	escalationPolicies := map[uuid.UUID]*model.EscalationPolicy{}
+	for _, notification := range notifications {
+		if notification.EscalationPolicyId == nil {
+			continue
+		}
+		escalationPolicy, ok := escalationPolicies[*notification.EscalationPolicyId]
+		if !ok {
+			if p, err := u.repo.Get(ctx, *notification.EscalationPolicyId); err == nil {
+				decodeEscalationPolicy(ctx, &p)
+				escalationPolicy = &p
+			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error(ctx, err)
+				continue
+			}
+			escalationPolicies[*notification.EscalationPolicyId] = escalationPolicy
+		}
+
+		// 정책이 삭제되었거나 비활성화되었으면 에스컬레이션을 중단한다.
+		if escalationPolicy == nil || !escalationPolicy.Enabled || notification.EscalationLevel >= len(escalationPolicy.Steps) {
+			notification.NextEscalationAt = nil
+			if err := u.systemNotificationRepo.UpdateEscalation(ctx, notification); err != nil {
+				log.Error(ctx, err)
+			}
+			continue
+		}
+
+		step := escalationPolicy.Steps[notification.EscalationLevel]
+		notification.EscalationLevel++
+		if err := u.notify(ctx, notification, step); err != nil {
+			log.Errorf(ctx, "failed to escalate systemNotification %s. err : %s", notification.ID, err)
+		}
+
+		notification.Status = domain.SystemNotificationActionStatus_ESCALATED
+		notification.NextEscalationAt = nil
+		if notification.EscalationLevel < len(escalationPolicy.Steps) {
+			nextEscalationAt := escalationStepAt(notification, escalationPolicy.Steps[notification.EscalationLevel])
+			notification.NextEscalationAt = &nextEscalationAt
+		}
+		if err := u.systemNotificationRepo.UpdateEscalation(ctx, notification); err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		log.Infof(ctx, "escalated systemNotification %s to level %d", notification.ID, notification.EscalationLevel)
+	}
+	return nil
+}
+
+// notify 는 단계의 사용자와 역할 사용자에게는 digest 설정과 관계없이 바로 메일을 보내고, 앨럿 채널로는 비동기로 보낸다.
+func (u *EscalationPolicyUsecase) notify(ctx context.Context, notification model.SystemNotification, step domain.EscalationStep) error {
+	notification.MessageTitle = fmt.Sprintf("[에스컬레이션 %d단계] %s", notification.EscalationLevel, notification.MessageTitle)
+
+	if len(step.AlertChannelIds) > 0 {
+		alertChannelIds := make([]uuid.UUID, 0, len(step.AlertChannelIds))
+		for _, strId := range step.AlertChannelIds {
+			if alertChannelId, err := uuid.Parse(strId); err == nil {
+				alertChannelIds = append(alertChannelIds, alertChannelId)
+			}
+		}
+		alertChannels, err := u.alertChannelRepo.FetchByIds(ctx, notification.OrganizationId, alertChannelIds)
+		if err != nil {
+			log.Error(ctx, err)
+		} else if len(alertChannels) > 0 {
+			u.alertChannel.Dispatch(ctx, notification, alertChannels)
+		}
+	}
+
+	emails := []string{}
+	addEmail := func(user model.User) {
+		if user.OrganizationId == notification.OrganizationId && user.Email != "" && !slices.Contains(emails, user.Email) {
+			emails = append(emails, user.Email)
+		}
+	}
+	for _, strId := range step.TargetUserIds {
+		userId, err := uuid.Parse(strId)
+		if err != nil {
+			continue
+		}
+		if user, err := u.userRepo.GetByUuid(ctx, userId); err == nil {
+			addEmail(user)
+		}
+	}
+	for _, roleId := range step.TargetRoleIds {
+		users, err := u.userRepo.ListUsersByRole(ctx, notification.OrganizationId, roleId, nil)
+		if err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		for _, user := range *users {
+			addEmail(user)
+		}
+	}
+	if len(emails) == 0 {
+		return nil
+	}
+
+	message, err := mail.MakeSystemNotificationMessage(ctx, notification.OrganizationId, notification.MessageTitle, notification.MessageContent, emails)
+	if err != nil {
+		return fmt.Errorf("Failed to make email content. err : %s", err.Error())
+	}
+	if err := mail.New(message).SendMail(ctx); err != nil {
+		return fmt.Errorf("Failed to send email to %s. err : %s", emails, err.Error())
+	}
+	return nil
+}
+
+// prepare 는 단계와 대상을 검증하고 저장할 형식으로 변환한다.
+func (u *EscalationPolicyUsecase) prepare(ctx context.Context, dto *model.EscalationPolicy) error {
+	afterMinutes := 0
+	for i, step := range dto.Steps {
+		if step.IsEmpty() {
+			return httpErrors.NewError(fmt.Errorf("step %d has no target", i+1), "EP_INVALID_STEP")
+		}
+		if step.AfterMinutes <= afterMinutes {
+			return httpErrors.NewError(fmt.Errorf("afterMinutes of step %d must be greater than the previous step", i+1), "EP_INVALID_STEP")
+		}
+		afterMinutes = step.AfterMinutes
+
+		if len(step.AlertChannelIds) > 0 {
+			alertChannelIds := make([]uuid.UUID, 0, len(step.AlertChannelIds))
+			for _, strId := range step.AlertChannelIds {
+				alertChannelId, err := uuid.Parse(strId)
+				if err != nil {
+					return httpErrors.NewError(err, "AC_INVALID_ALERT_CHANNEL_ID")
+				}
+				alertChannelIds = append(alertChannelIds, alertChannelId)
+			}
+			alertChannels, err := u.alertChannelRepo.FetchByIds(ctx, dto.OrganizationId, alertChannelIds)
+			if err != nil {
+				return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+			}
+			if len(alertChannels) != len(alertChannelIds) {
+				return httpErrors.NewError(fmt.Errorf("not found alert channel in organization"), "AC_NOT_FOUND_ALERT_CHANNEL")
+			}
+		}
+
+		for _, strId := range step.TargetUserIds {
+			userId, err := uuid.Parse(strId)
+			if err != nil {
+				return httpErrors.NewError(fmt.Errorf("Invalid userId %s", strId), "C_INVALID_ACCOUNT_ID")
+			}
+			user, err := u.userRepo.GetByUuid(ctx, userId)
+			if err != nil || user.OrganizationId != dto.OrganizationId {
+				return httpErrors.NewError(fmt.Errorf("Invalid userId %s", strId), "C_INVALID_ACCOUNT_ID")
+			}
+		}
+
+		for _, roleId := range step.TargetRoleIds {
+			role, err := u.roleRepo.GetTksRole(ctx, dto.OrganizationId, roleId)
+			if err != nil || role.OrganizationID != dto.OrganizationId {
+				return httpErrors.NewError(fmt.Errorf("Invalid roleId %s", roleId), "EP_INVALID_STEP")
+			}
+		}
+	}
+
+	if dto.Severities == nil {
+		dto.Severities = []string{}
+	}
+	dto.Severity = []byte(helper.ModelToJson(dto.Severities))
+	dto.Step = []byte(helper.ModelToJson(dto.Steps))
+	return nil
+}
+
+func decodeEscalationPolicy(ctx context.Context, escalationPolicy *model.EscalationPolicy) {
+	if len(escalationPolicy.Severity) > 0 {
+		if err := json.Unmarshal(escalationPolicy.Severity, &escalationPolicy.Severities); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	if len(escalationPolicy.Step) > 0 {
+		if err := json.Unmarshal(escalationPolicy.Step, &escalationPolicy.Steps); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+}
+
+// escalationStepAt 은 단계의 대기 시간을 앨럿 발생 시각부터 계산한다.
+func escalationStepAt(notification model.SystemNotification, step domain.EscalationStep) time.Time {
+	createdAt := notification.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return createdAt.Add(time.Duration(step.AfterMinutes) * time.Minute)
+}
+
+func matchSeverity(severities []string, severity string) bool {
+	return len(severities) == 0 || slices.ContainsFunc(severities, func(s string) bool {
+		return strings.EqualFold(s, severity)
+	})
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	gitProviderTimeout     = 10 * time.Second
+	gitProviderMaxBodySize = 1 << 20
+	gitHubUrl              = "https://github.com"
+	gitLabUrl              = "https://gitlab.com"
+	// workflow 는 admin 클러스터의 argo namespace 에서 실행되므로 git token 도 같은 namespace 의 Secret 으로 전달한다.
+	gitProviderSecretNamespace = "argo"
+)
+
+var gitProviderClient = &http.Client{Timeout: gitProviderTimeout}
+
+type IGitProviderUsecase interface {
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.GitProvider, error)
+	Get(ctx context.Context, organizationId string, gitProviderId uuid.UUID) (model.GitProvider, error)
+	Create(ctx context.Context, dto model.GitProvider, token string) (gitProviderId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.GitProvider, token string) error
+	Delete(ctx context.Context, organizationId string, gitProviderId uuid.UUID) error
+}
+
+type GitProviderUsecase struct {
+	repo          repository.IGitProviderRepository
+	encryptionKey IEncryptionKeyUsecase
+}
+
+func NewGitProviderUsecase(r repository.Repository, encryptionKey IEncryptionKeyUsecase) IGitProviderUsecase {
+	return &GitProviderUsecase{
+		repo:          r.GitProvider,
+		encryptionKey: encryptionKey,
+	}
+}
+
+func (u *GitProviderUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.GitProvider, error) {
+	return u.repo.Fetch(ctx, organizationId, pg)
+}
+
+func (u *GitProviderUsecase) Get(ctx context.Context, organizationId string, gitProviderId uuid.UUID) (model.GitProvider, error) {
+	return getGitProvider(ctx, u.repo, organizationId, gitProviderId)
+}
+
+// Create 는 token 으로 provider 에 인증해 본 뒤 token 을 봉인해 저장한다.
+func (u *GitProviderUsecase) Create(ctx context.Context, dto model.GitProvider, token string) (gitProviderId uuid.UUID, err error) {
+	if dto.Url == "" {
+		dto.Url = gitHubUrl
+		if dto.Type == domain.GitProviderType_GITLAB {
+			dto.Url = gitLabUrl
+		}
+	}
+	providerUrl, err := url.Parse(dto.Url)
+	if err != nil || (providerUrl.Scheme != "http" && providerUrl.Scheme != "https") || providerUrl.Host == "" {
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("invalid git provider url %s", dto.Url), "C_INVALID_REQUEST_FIELD")
+	}
+	dto.Url = strings.TrimSuffix(dto.Url, "/")
+
+	if _, err := u.repo.GetByName(ctx, dto.OrganizationId, dto.Name); err == nil {
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("git provider %s already exists", dto.Name), "GP_CREATE_ALREADY_EXISTED_NAME")
+	}
+	if err = verifyGitToken(ctx, dto, token); err != nil {
+		return uuid.Nil, gitProviderError(err, "GP_INVALID_TOKEN")
+	}
+	if dto.Token, err = u.encryptionKey.Seal(ctx, dto.OrganizationId, []byte(token)); err != nil {
+		return uuid.Nil, err
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.CreatorId = &userId
+	}
+
+	gitProviderId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return gitProviderId, nil
+}
+
+// Update 는 설명과 token 을 수정한다. token 이 비어 있으면 기존 token 을 유지한다.
+func (u *GitProviderUsecase) Update(ctx context.Context, dto model.GitProvider, token string) error {
+	gitProvider, err := getGitProvider(ctx, u.repo, dto.OrganizationId, dto.ID)
+	if err != nil {
+		return err
+	}
+
+	gitProvider.Description = dto.Description
+	if token != "" {
+		if err = verifyGitToken(ctx, gitProvider, token); err != nil {
+			return gitProviderError(err, "GP_INVALID_TOKEN")
+		}
+		if gitProvider.Token, err = u.encryptionKey.Seal(ctx, gitProvider.OrganizationId, []byte(token)); err != nil {
+			return err
+		}
+	}
+
+	if err = u.repo.Update(ctx, gitProvider); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// Delete 는 앱의 빌드 소스로 사용 중인 provider 는 삭제하지 않는다.
+func (u *GitProviderUsecase) Delete(ctx context.Context, organizationId string, gitProviderId uuid.UUID) error {
+	if _, err := getGitProvider(ctx, u.repo, organizationId, gitProviderId); err != nil {
+		return err
+	}
+
+	count, err := u.repo.CountSources(ctx, gitProviderId)
+	if err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if count > 0 {
+		return httpErrors.NewError(fmt.Errorf("git provider is used by %d apps", count), "GP_IN_USE")
+	}
+
+	if err = u.repo.Delete(ctx, gitProviderId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if err = kubernetes.DeleteAdminClusterSecret(ctx, gitProviderSecretNamespace, gitProviderSecretName(gitProviderId)); err != nil {
+		log.Warnf(ctx, "failed to delete secret of git provider %s. err: %s", gitProviderId, err)
+	}
+	return nil
+}
+
+func getGitProvider(ctx context.Context, repo repository.IGitProviderRepository, organizationId string, gitProviderId uuid.UUID) (model.GitProvider, error) {
+	gitProvider, err := repo.Get(ctx, gitProviderId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.GitProvider{}, httpErrors.NewError(err, "GP_NOT_FOUND_GIT_PROVIDER")
+		}
+		return model.GitProvider{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if gitProvider.OrganizationId != organizationId {
+		return model.GitProvider{}, httpErrors.NewError(fmt.Errorf("git provider %s is not in organization %s", gitProviderId, organizationId), "GP_NOT_FOUND_GIT_PROVIDER")
+	}
+	return gitProvider, nil
+}
+
+// gitApiError 는 provider API 가 200 이외의 상태 코드로 응답한 경우이다.
+type gitApiError struct {
+	StatusCode int
+}
+
+func (e *gitApiError) Error() string {
+	return fmt.Sprintf("git provider responded with status %d", e.StatusCode)
+}
+
+// gitProviderError 는 provider API 오류를 응답 오류로 바꾼다. 인증에 실패하면 token 오류로, 찾을 수 없으면 notFoundCode 로 응답한다.
+func gitProviderError(err error, notFoundCode httpErrors.ErrorCode) error {
+	var apiErr *gitApiError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return httpErrors.NewError(err, "GP_INVALID_TOKEN")
+		case http.StatusNotFound:
+			return httpErrors.NewError(err, notFoundCode)
+		}
+	}
+	return httpErrors.NewError(err, "GP_FAILED_CALL_PROVIDER")
+}
+
+// gitApiUrl 은 provider 의 REST API 주소이다. GitHub Enterprise 는 /api/v3, GitLab 은 /api/v4 를 사용한다.
+func gitApiUrl(gitProvider model.GitProvider) string {
+	if gitProvider.Type == domain.GitProviderType_GITLAB {
+		return gitProvider.Url + "/api/v4"
+	}
+	if gitProvider.Url == gitHubUrl {
+		return "https://api.github.com"
+	}
+	return gitProvider.Url + "/api/v3"
+}
+
+func gitCloneUrl(gitProvider model.GitProvider, repository string) string {
+	return gitProvider.Url + "/" + repository + ".git"
+}
+
+func gitApiGet(ctx context.Context, gitProvider model.GitProvider, token string, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gitApiUrl(gitProvider)+path, nil)
+	if err != nil {
+		return err
+	}
+	if gitProvider.Type == domain.GitProviderType_GITLAB {
+		req.Header.Set("PRIVATE-TOKEN", token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Accept", "application/vnd.github+json")
+	}
+
+	res, err := gitProviderClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return &gitApiError{StatusCode: res.StatusCode}
+	}
+	return json.NewDecoder(io.LimitReader(res.Body, gitProviderMaxBodySize)).Decode(out)
+}
+
+func verifyGitToken(ctx context.Context, gitProvider model.GitProvider, token string) error {
+	var user struct{}
+	return gitApiGet(ctx, gitProvider, token, "/user", &user)
+}
+
+// gitBranchCommit 은 branch 의 최신 commit 을 조회한다. repository 나 branch 가 없으면 404 gitApiError 를 반환한다.
+func gitBranchCommit(ctx context.Context, gitProvider model.GitProvider, token string, repository string, branch string) (string, error) {
+	if gitProvider.Type == domain.GitProviderType_GITLAB {
+		var out struct {
+			Commit struct {
+				Id string `json:"id"`
+			} `json:"commit"`
+		}
+		path := "/projects/" + url.PathEscape(repository) + "/repository/branches/" + url.PathEscape(branch)
+		if err := gitApiGet(ctx, gitProvider, token, path, &out); err != nil {
+			return "", err
+		}
+		return out.Commit.Id, nil
+	}
+
+	var out struct {
+		Commit struct {
+			Sha string `json:"sha"`
+		} `json:"commit"`
+	}
+	if err := gitApiGet(ctx, gitProvider, token, "/repos/"+repository+"/branches/"+url.PathEscape(branch), &out); err != nil {
+		return "", err
+	}
+	return out.Commit.Sha, nil
+}
+
+func openGitToken(ctx context.Context, encryptionKey IEncryptionKeyUsecase, gitProvider model.GitProvider) (string, error) {
+	token, err := encryptionKey.Open(ctx, gitProvider.Token)
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("failed to open token of git provider %s", gitProvider.ID))
+	}
+	return string(token), nil
+}
+
+func gitProviderSecretName(gitProviderId uuid.UUID) string {
+	return "tks-git-" + gitProviderId.String()
+}
+
+// ensureGitProviderSecret 은 workflow 가 repository 를 clone 할 때 사용할 token 을 Secret 으로 저장한다.
+// token 은 operation 의 parameter 로 DB 에 남지 않도록 workflow 에는 Secret 이름만 전달한다.
+func ensureGitProviderSecret(ctx context.Context, gitProvider model.GitProvider, token string) (secretName string, err error) {
+	username := "x-access-token"
+	if gitProvider.Type == domain.GitProviderType_GITLAB {
+		username = "oauth2"
+	}
+	labels := map[string]string{
+		"app.kubernetes.io/managed-by": "tks",
+		"tks.io/organization-id":       gitProvider.OrganizationId,
+	}
+	secretName = gitProviderSecretName(gitProvider.ID)
+	err = kubernetes.EnsureAdminClusterSecret(ctx, gitProviderSecretNamespace, secretName, labels, map[string][]byte{
+		"username": []byte(username),
+		"token":    []byte(token),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sync git token")
+	}
+	return secretName, nil
+}
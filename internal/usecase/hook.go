@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/hook"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/pkg/errors"
+)
+
+// appDeployHookPayload 는 pre-deploy hook 에 전달하는 배포 요청이다.
+type appDeployHookPayload struct {
+	App  *model.AppServeApp     `json:"app"`
+	Task *model.AppServeAppTask `json:"task"`
+}
+
+// runHook 은 point 에 등록된 hook 을 실행한다. hook 이 요청을 거부하면 거부 사유를 응답 메시지로 사용한다.
+func runHook(ctx context.Context, point hook.Point, organizationId string, payload interface{}) error {
+	event := hook.Event{Point: point, OrganizationId: organizationId, Payload: payload}
+	if user, ok := request.UserFrom(ctx); ok {
+		event.UserId = user.GetUserId().String()
+	}
+
+	err := hook.Run(ctx, event)
+	var rejected *hook.RejectedError
+	if errors.As(err, &rejected) {
+		code := httpErrors.ErrorCode("C_REJECTED_BY_HOOK")
+		return httpErrors.NewRestError(code.GetStatus(), err, code, rejected.Reason)
+	}
+	return err
+}
+
+// runPreDeployHook 은 배포 workflow 를 시작하거나 승인을 요청하기 전에 pre-deploy hook 을 실행한다.
+// hook 은 배포할 이미지, 환경 변수 등을 바꿀 수 있지만 앱의 조직, 프로젝트, 대상 클러스터는 바꿀 수 없다.
+func (u *AppServeAppUsecase) runPreDeployHook(ctx context.Context, app *model.AppServeApp, task *model.AppServeAppTask) error {
+	organizationId, projectId, targetClusterId := app.OrganizationId, app.ProjectId, app.TargetClusterId
+	if err := runHook(ctx, hook.PreDeploy, organizationId, &appDeployHookPayload{App: app, Task: task}); err != nil {
+		return err
+	}
+	app.OrganizationId, app.ProjectId, app.TargetClusterId = organizationId, projectId, targetClusterId
+	return nil
+}
@@ -0,0 +1,336 @@
+package usecase
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+type ILmaEndpointUsecase interface {
+	Fetch(ctx context.Context, organizationId string) ([]domain.LmaEndpoint, error)
+	Create(ctx context.Context, dto model.LmaEndpoint) (lmaEndpointId uuid.UUID, err error)
+	Update(ctx context.Context, dto model.LmaEndpoint) error
+	Delete(ctx context.Context, organizationId string, lmaEndpointId uuid.UUID) error
+	CheckHealth(ctx context.Context) error
+	GetAuth(ctx context.Context, organizationId string) (domain.LmaAuth, error)
+	UpdateAuth(ctx context.Context, organizationId string, input domain.UpdateLmaAuthRequest) error
+	GetDiscovery(ctx context.Context, organizationId string) (domain.LmaDiscovery, error)
+	UpdateDiscovery(ctx context.Context, organizationId string, input domain.UpdateLmaDiscoveryRequest) error
+}
+
+type LmaEndpointUsecase struct {
+	repo             repository.ILmaEndpointRepository
+	organizationRepo repository.IOrganizationRepository
+	thanosClients    ThanosClientFactory
+	cacheInvalidator ICacheInvalidator
+	encryptionKey    IEncryptionKeyUsecase
+}
+
+func NewLmaEndpointUsecase(r repository.Repository, thanosClients ThanosClientFactory, cacheInvalidator ICacheInvalidator, encryptionKey IEncryptionKeyUsecase) ILmaEndpointUsecase {
+	return &LmaEndpointUsecase{
+		repo:             r.LmaEndpoint,
+		organizationRepo: r.Organization,
+		thanosClients:    thanosClients,
+		cacheInvalidator: cacheInvalidator,
+		encryptionKey:    encryptionKey,
+	}
+}
+
+// Fetch 는 primary cluster 의 LMA 와 보조 LMA endpoint 목록을 마지막 health check 결과와 함께 반환한다.
+func (u *LmaEndpointUsecase) Fetch(ctx context.Context, organizationId string) (out []domain.LmaEndpoint, err error) {
+	endpoints, err := u.repo.Fetch(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	primary := domain.LmaEndpoint{Primary: true, Status: domain.LmaEndpointStatus_UNKNOWN}
+	healthByUrl := make(map[string]LmaEndpointHealth)
+	for _, health := range u.thanosClients.Health(organizationId) {
+		if health.Primary {
+			setLmaEndpointHealth(&primary, health)
+			primary.Url = health.Url
+			primary.DiscoveryMethod = health.DiscoveryMethod
+			continue
+		}
+		healthByUrl[health.Url] = health
+	}
+
+	out = append(out, primary)
+	for _, endpoint := range endpoints {
+		lmaEndpoint := domain.LmaEndpoint{
+			ID:          endpoint.ID.String(),
+			Url:         endpoint.Url,
+			Priority:    endpoint.Priority,
+			Description: endpoint.Description,
+			Status:      domain.LmaEndpointStatus_UNKNOWN,
+		}
+		if health, ok := healthByUrl[endpoint.Url]; ok {
+			setLmaEndpointHealth(&lmaEndpoint, health)
+		}
+		out = append(out, lmaEndpoint)
+	}
+	return out, nil
+}
+
+func (u *LmaEndpointUsecase) Create(ctx context.Context, dto model.LmaEndpoint) (lmaEndpointId uuid.UUID, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+
+	if _, err = u.organizationRepo.Get(ctx, dto.OrganizationId); err != nil {
+		return uuid.Nil, httpErrors.NewNotFoundError(err, "", "")
+	}
+
+	userId := user.GetUserId()
+	dto.CreatorId = &userId
+	lmaEndpointId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	u.cacheInvalidator.Invalidate(ctx, CacheEvent{Kind: CacheEvent_LMA_ENDPOINT_CHANGED, OrganizationId: dto.OrganizationId})
+
+	return lmaEndpointId, nil
+}
+
+func (u *LmaEndpointUsecase) Update(ctx context.Context, dto model.LmaEndpoint) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+
+	if _, err := u.get(ctx, dto.OrganizationId, dto.ID); err != nil {
+		return err
+	}
+
+	userId := user.GetUserId()
+	dto.UpdatorId = &userId
+	if err := u.repo.Update(ctx, dto); err != nil {
+		return err
+	}
+	u.cacheInvalidator.Invalidate(ctx, CacheEvent{Kind: CacheEvent_LMA_ENDPOINT_CHANGED, OrganizationId: dto.OrganizationId})
+
+	return nil
+}
+
+func (u *LmaEndpointUsecase) Delete(ctx context.Context, organizationId string, lmaEndpointId uuid.UUID) error {
+	if _, err := u.get(ctx, organizationId, lmaEndpointId); err != nil {
+		return err
+	}
+
+	if err := u.repo.Delete(ctx, lmaEndpointId); err != nil {
+		return err
+	}
+	u.cacheInvalidator.Invalidate(ctx, CacheEvent{Kind: CacheEvent_LMA_ENDPOINT_CHANGED, OrganizationId: organizationId})
+
+	return nil
+}
+
+// CheckHealth 는 모든 조직의 LMA endpoint 를 점검하고, primary cluster 의 LMA 가 비정상이면 보조 endpoint 로 전환한다.
+func (u *LmaEndpointUsecase) CheckHealth(ctx context.Context) error {
+	organizations, err := u.organizationRepo.Fetch(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get organizations")
+	}
+
+	for _, organization := range *organizations {
+		if _, err := u.thanosClients.CheckHealth(ctx, organization.ID); err != nil {
+			log.Debugf(ctx, "failed to check lma endpoints of organization %s. err : %s", organization.ID, err)
+		}
+	}
+	return nil
+}
+
+// GetAuth 는 조직의 LMA 접속 설정을 반환한다. password, bearer token 은 설정 여부만 반환한다.
+func (u *LmaEndpointUsecase) GetAuth(ctx context.Context, organizationId string) (out domain.LmaAuth, err error) {
+	if _, err = u.organizationRepo.Get(ctx, organizationId); err != nil {
+		return out, httpErrors.NewNotFoundError(err, "", "")
+	}
+
+	out.OrganizationId = organizationId
+	lmaAuth, err := u.repo.GetAuth(ctx, organizationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, nil
+		}
+		return out, err
+	}
+
+	out.CaCert = lmaAuth.CaCert
+	out.InsecureSkipVerify = lmaAuth.InsecureSkipVerify
+	out.Username = lmaAuth.Username
+	out.HasPassword = lmaAuth.Password != ""
+	out.HasBearerToken = lmaAuth.BearerToken != ""
+	if lmaAuth.UpdatorId != nil {
+		out.Updator = domain.SimpleUserResponse{
+			ID:        lmaAuth.Updator.ID.String(),
+			AccountId: lmaAuth.Updator.AccountId,
+			Name:      lmaAuth.Updator.Name,
+		}
+	}
+	out.UpdatedAt = &lmaAuth.UpdatedAt
+	return out, nil
+}
+
+// UpdateAuth 는 조직의 LMA 접속 설정을 변경한다. Password, BearerToken 이 nil 이면 기존 값을 유지하고 빈 문자열이면 삭제한다.
+func (u *LmaEndpointUsecase) UpdateAuth(ctx context.Context, organizationId string, input domain.UpdateLmaAuthRequest) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+
+	if _, err := u.organizationRepo.Get(ctx, organizationId); err != nil {
+		return httpErrors.NewNotFoundError(err, "", "")
+	}
+
+	if input.CaCert != "" {
+		if ok := x509.NewCertPool().AppendCertsFromPEM([]byte(input.CaCert)); !ok {
+			return httpErrors.NewBadRequestError(fmt.Errorf("Invalid ca certificate"), "O_INVALID_LMA_AUTH", "")
+		}
+	}
+	if input.Username == "" && input.Password != nil && *input.Password != "" {
+		return httpErrors.NewBadRequestError(fmt.Errorf("Username is required for password"), "O_INVALID_LMA_AUTH", "")
+	}
+
+	lmaAuth, err := u.repo.GetAuth(ctx, organizationId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	lmaAuth.OrganizationId = organizationId
+	lmaAuth.CaCert = input.CaCert
+	lmaAuth.InsecureSkipVerify = input.InsecureSkipVerify
+	lmaAuth.Username = input.Username
+	if input.Password != nil {
+		if lmaAuth.Password, err = sealOrganizationSecret(ctx, u.encryptionKey, organizationId, *input.Password); err != nil {
+			return err
+		}
+	}
+	if input.BearerToken != nil {
+		if lmaAuth.BearerToken, err = sealOrganizationSecret(ctx, u.encryptionKey, organizationId, *input.BearerToken); err != nil {
+			return err
+		}
+	}
+	if lmaAuth.Username == "" {
+		lmaAuth.Password = ""
+	}
+	if lmaAuth.Password != "" && lmaAuth.BearerToken != "" {
+		return httpErrors.NewBadRequestError(fmt.Errorf("Only one of password and bearer token can be set"), "O_INVALID_LMA_AUTH", "")
+	}
+
+	userId := user.GetUserId()
+	lmaAuth.UpdatorId = &userId
+	lmaAuth.Updator = model.User{}
+	if err := u.repo.UpsertAuth(ctx, lmaAuth); err != nil {
+		return err
+	}
+	u.cacheInvalidator.Invalidate(ctx, CacheEvent{Kind: CacheEvent_LMA_ENDPOINT_CHANGED, OrganizationId: organizationId})
+
+	return nil
+}
+
+// GetDiscovery 는 조직의 LMA 주소 탐색 설정을 반환한다. 설정하지 않은 조직은 기본값(AUTO)을 반환한다.
+func (u *LmaEndpointUsecase) GetDiscovery(ctx context.Context, organizationId string) (out domain.LmaDiscovery, err error) {
+	if _, err = u.organizationRepo.Get(ctx, organizationId); err != nil {
+		return out, httpErrors.NewNotFoundError(err, "", "")
+	}
+
+	out.OrganizationId = organizationId
+	out.Method = domain.LmaDiscoveryMethod_AUTO
+	out.Namespace = defaultLmaNamespace
+	lmaDiscovery, err := u.repo.GetDiscovery(ctx, organizationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, nil
+		}
+		return out, err
+	}
+
+	out.Method = domain.LmaDiscoveryMethod(lmaDiscovery.Method)
+	out.Namespace = lmaDiscovery.Namespace
+	out.ServiceName = lmaDiscovery.ServiceName
+	out.IngressName = lmaDiscovery.IngressName
+	out.NodeAddressType = lmaDiscovery.NodeAddressType
+	if lmaDiscovery.UpdatorId != nil {
+		out.Updator = domain.SimpleUserResponse{
+			ID:        lmaDiscovery.Updator.ID.String(),
+			AccountId: lmaDiscovery.Updator.AccountId,
+			Name:      lmaDiscovery.Updator.Name,
+		}
+	}
+	out.UpdatedAt = &lmaDiscovery.UpdatedAt
+	return out, nil
+}
+
+// UpdateDiscovery 는 조직의 LMA 주소 탐색 설정을 변경한다. Namespace 를 생략하면 lma namespace 를 사용한다.
+func (u *LmaEndpointUsecase) UpdateDiscovery(ctx context.Context, organizationId string, input domain.UpdateLmaDiscoveryRequest) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+
+	if _, err := u.organizationRepo.Get(ctx, organizationId); err != nil {
+		return httpErrors.NewNotFoundError(err, "", "")
+	}
+
+	if input.Namespace == "" {
+		input.Namespace = defaultLmaNamespace
+	}
+	for _, name := range []string{input.Namespace, input.ServiceName} {
+		if name == "" {
+			continue
+		}
+		if errMsgs := validation.IsDNS1123Label(name); len(errMsgs) > 0 {
+			return httpErrors.NewBadRequestError(fmt.Errorf("Invalid name %s. %v", name, errMsgs), "O_INVALID_LMA_DISCOVERY", "")
+		}
+	}
+	if input.IngressName != "" {
+		if errMsgs := validation.IsDNS1123Subdomain(input.IngressName); len(errMsgs) > 0 {
+			return httpErrors.NewBadRequestError(fmt.Errorf("Invalid ingress name %s. %v", input.IngressName, errMsgs), "O_INVALID_LMA_DISCOVERY", "")
+		}
+	}
+
+	userId := user.GetUserId()
+	err := u.repo.UpsertDiscovery(ctx, model.LmaDiscovery{
+		OrganizationId:  organizationId,
+		Method:          string(input.Method),
+		Namespace:       input.Namespace,
+		ServiceName:     input.ServiceName,
+		IngressName:     input.IngressName,
+		NodeAddressType: input.NodeAddressType,
+		UpdatorId:       &userId,
+	})
+	if err != nil {
+		return err
+	}
+	u.cacheInvalidator.Invalidate(ctx, CacheEvent{Kind: CacheEvent_LMA_ENDPOINT_CHANGED, OrganizationId: organizationId})
+
+	return nil
+}
+
+func (u *LmaEndpointUsecase) get(ctx context.Context, organizationId string, lmaEndpointId uuid.UUID) (model.LmaEndpoint, error) {
+	lmaEndpoint, err := u.repo.Get(ctx, lmaEndpointId)
+	if err != nil || lmaEndpoint.OrganizationId != organizationId {
+		return model.LmaEndpoint{}, httpErrors.NewError(fmt.Errorf("Not found lma endpoint"), "O_NOT_FOUND_LMA_ENDPOINT")
+	}
+	return lmaEndpoint, nil
+}
+
+func setLmaEndpointHealth(lmaEndpoint *domain.LmaEndpoint, health LmaEndpointHealth) {
+	checkedAt := health.CheckedAt
+	lmaEndpoint.Active = health.Active
+	lmaEndpoint.Status = health.Status
+	lmaEndpoint.LastError = health.LastError
+	lmaEndpoint.LastCheckedAt = &checkedAt
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/user"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IMaintenanceWindowUsecase interface {
+	Create(ctx context.Context, dto model.MaintenanceWindow) (maintenanceWindowId uuid.UUID, err error)
+	Fetch(ctx context.Context, organizationId string, clusterId domain.ClusterId, from time.Time, to time.Time, pg *pagination.Pagination) ([]model.MaintenanceWindow, error)
+	Get(ctx context.Context, organizationId string, maintenanceWindowId uuid.UUID) (model.MaintenanceWindow, error)
+	Update(ctx context.Context, dto model.MaintenanceWindow) error
+	Delete(ctx context.Context, organizationId string, maintenanceWindowId uuid.UUID) error
+}
+
+type MaintenanceWindowUsecase struct {
+	repo        repository.IMaintenanceWindowRepository
+	clusterRepo repository.IClusterRepository
+}
+
+func NewMaintenanceWindowUsecase(r repository.Repository) IMaintenanceWindowUsecase {
+	return &MaintenanceWindowUsecase{
+		repo:        r.MaintenanceWindow,
+		clusterRepo: r.Cluster,
+	}
+}
+
+func (u *MaintenanceWindowUsecase) Create(ctx context.Context, dto model.MaintenanceWindow) (maintenanceWindowId uuid.UUID, err error) {
+	cluster, err := u.clusterRepo.Get(ctx, dto.ClusterId)
+	if err != nil || cluster.OrganizationId != dto.OrganizationId {
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("not found cluster %s", dto.ClusterId), "MW_NOT_FOUND_CLUSTER")
+	}
+	if !dto.EndAt.After(dto.StartAt) {
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("endAt must be after startAt"), "MW_INVALID_PERIOD")
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.CreatorId = &userId
+	}
+
+	maintenanceWindowId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return maintenanceWindowId, nil
+}
+
+// Fetch 는 달력에 표시할 수 있도록 [from, to) 구간과 겹치는 일정을 모두 반환한다.
+func (u *MaintenanceWindowUsecase) Fetch(ctx context.Context, organizationId string, clusterId domain.ClusterId, from time.Time, to time.Time, pg *pagination.Pagination) ([]model.MaintenanceWindow, error) {
+	if !to.After(from) {
+		return nil, httpErrors.NewBadRequestError(fmt.Errorf("to must be after from"), "C_INVALID_QUERY_PARAM", "")
+	}
+	return u.repo.Fetch(ctx, organizationId, clusterId, from, to, pg)
+}
+
+func (u *MaintenanceWindowUsecase) Get(ctx context.Context, organizationId string, maintenanceWindowId uuid.UUID) (model.MaintenanceWindow, error) {
+	maintenanceWindow, err := u.repo.Get(ctx, maintenanceWindowId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.MaintenanceWindow{}, httpErrors.NewError(err, "MW_NOT_FOUND_MAINTENANCE_WINDOW")
+		}
+		return model.MaintenanceWindow{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if maintenanceWindow.OrganizationId != organizationId {
+		return model.MaintenanceWindow{}, httpErrors.NewError(fmt.Errorf("not found maintenance window in organization"), "MW_NOT_FOUND_MAINTENANCE_WINDOW")
+	}
+	return maintenanceWindow, nil
+}
+
+// Update 는 일정과 설명만 변경한다. 대상 클러스터를 바꾸려면 삭제 후 다시 등록한다.
+func (u *MaintenanceWindowUsecase) Update(ctx context.Context, dto model.MaintenanceWindow) error {
+	if _, err := u.Get(ctx, dto.OrganizationId, dto.ID); err != nil {
+		return err
+	}
+	if !dto.EndAt.After(dto.StartAt) {
+		return httpErrors.NewError(fmt.Errorf("endAt must be after startAt"), "MW_INVALID_PERIOD")
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.UpdatorId = &userId
+	}
+
+	if err := u.repo.Update(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *MaintenanceWindowUsecase) Delete(ctx context.Context, organizationId string, maintenanceWindowId uuid.UUID) error {
+	if _, err := u.Get(ctx, organizationId, maintenanceWindowId); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, maintenanceWindowId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// activeMaintenanceWindow 는 클러스터에 진행 중인 maintenance window 를 반환한다. 유지보수 중이 아니면 nil 을 반환한다.
+func activeMaintenanceWindow(ctx context.Context, repo repository.IMaintenanceWindowRepository, clusterId domain.ClusterId) (*model.MaintenanceWindow, error) {
+	maintenanceWindow, err := repo.GetActive(ctx, clusterId, time.Now())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &maintenanceWindow, nil
+}
+
+// checkMaintenanceWindow 는 유지보수 중인 클러스터에 대한 배포를 조직 관리자에게만 허용한다.
+func checkMaintenanceWindow(ctx context.Context, repo repository.IMaintenanceWindowRepository, clusterId domain.ClusterId) error {
+	maintenanceWindow, err := activeMaintenanceWindow(ctx, repo, clusterId)
+	if err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if maintenanceWindow == nil {
+		return nil
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		if requestUser.GetRoleOrganizationMapping()[requestUser.GetOrganizationId()] == user.AdminRole {
+			return nil
+		}
+	}
+	return httpErrors.NewError(fmt.Errorf("cluster %s is under maintenance until %s", clusterId, maintenanceWindow.EndAt.Format(time.RFC3339)), "MW_UNDER_MAINTENANCE")
+}
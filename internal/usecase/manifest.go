@@ -0,0 +1,760 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+)
+
+type IManifestUsecase interface {
+	Export(ctx context.Context, organizationId string, kinds []domain.ManifestKind) ([]domain.Manifest, error)
+	Apply(ctx context.Context, organizationId string, manifests []domain.Manifest, dryRun bool) ([]domain.ManifestApplyResult, error)
+}
+
+// ManifestUsecase 는 TKS 리소스를 manifest 로 내보내고, manifest 를 현재 상태와 비교하여 반영한다.
+// 실제 생성/수정은 각 리소스의 usecase 를 그대로 사용하므로 검증 로직이 중복되지 않는다.
+type ManifestUsecase struct {
+	userRepo                          repository.IUserRepository
+	organizationUsecase               IOrganizationUsecase
+	roleUsecase                       IRoleUsecase
+	permissionUsecase                 IPermissionUsecase
+	stackTemplateUsecase              IStackTemplateUsecase
+	policyTemplateUsecase             IPolicyTemplateUsecase
+	systemNotificationRuleUsecase     ISystemNotificationRuleUsecase
+	systemNotificationTemplateUsecase ISystemNotificationTemplateUsecase
+}
+
+func NewManifestUsecase(r repository.Repository, u Usecase) IManifestUsecase {
+	return &ManifestUsecase{
+		userRepo:                          r.User,
+		organizationUsecase:               u.Organization,
+		roleUsecase:                       u.Role,
+		permissionUsecase:                 u.Permission,
+		stackTemplateUsecase:              u.StackTemplate,
+		policyTemplateUsecase:             u.PolicyTemplate,
+		systemNotificationRuleUsecase:     u.SystemNotificationRule,
+		systemNotificationTemplateUsecase: u.SystemNotificationTemplate,
+	}
+}
+
+// manifestResource 는 kind 별로 현재 상태 조회와 생성/수정 방법을 정의한다.
+type manifestResource struct {
+	// current 는 이름별 현재 spec 을 반환한다.
+	current func(ctx context.Context, organizationId string) (map[string]interface{}, error)
+	create  func(ctx context.Context, organizationId string, name string, m domain.Manifest) error
+	update  func(ctx context.Context, organizationId string, name string, m domain.Manifest) error
+	// validate 는 manifest 를 대상 환경과 비교하여 반영 전에 알 수 있는 문제를 반환한다. current 는 현재 spec 이며 없으면 nil 이다.
+	validate func(ctx context.Context, organizationId string, name string, m domain.Manifest, current interface{}) []domain.ManifestIssue
+}
+
+func (u *ManifestUsecase) resources() map[domain.ManifestKind]manifestResource {
+	return map[domain.ManifestKind]manifestResource{
+		domain.ManifestKind_ORGANIZATION: {
+			current:  u.currentOrganizations,
+			update:   u.updateOrganization,
+			validate: u.validateOrganization,
+		},
+		domain.ManifestKind_ROLE: {
+			current: u.currentRoles,
+			create:  u.createRole,
+			update:  u.updateRole,
+		},
+		domain.ManifestKind_STACK_TEMPLATE: {
+			current:  u.currentStackTemplates,
+			create:   u.createStackTemplate,
+			update:   u.updateStackTemplate,
+			validate: u.validateStackTemplate,
+		},
+		domain.ManifestKind_POLICY_TEMPLATE: {
+			current:  u.currentPolicyTemplates,
+			create:   u.createPolicyTemplate,
+			update:   u.updatePolicyTemplate,
+			validate: u.validatePolicyTemplate,
+		},
+		domain.ManifestKind_SYSTEM_NOTIFICATION_RULE: {
+			current:  u.currentSystemNotificationRules,
+			create:   u.createSystemNotificationRule,
+			update:   u.updateSystemNotificationRule,
+			validate: u.validateSystemNotificationRule,
+		},
+	}
+}
+
+func (u *ManifestUsecase) Export(ctx context.Context, organizationId string, kinds []domain.ManifestKind) (out []domain.Manifest, err error) {
+	if len(kinds) == 0 {
+		kinds = domain.ManifestKinds
+	}
+
+	resources := u.resources()
+	out = make([]domain.Manifest, 0)
+	for _, kind := range domain.ManifestKinds {
+		if !containsManifestKind(kinds, kind) {
+			continue
+		}
+
+		specs, err := resources[kind].current(ctx, organizationId)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("Failed to export %s", kind))
+		}
+
+		names := make([]string, 0, len(specs))
+		for name := range specs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			m, err := domain.NewManifest(kind, name, specs[name])
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// Apply 는 manifest 를 kind 순서대로 현재 상태와 비교하여 변경된 항목만 생성/수정한다.
+// spec 에 명시된 필드만 비교하므로 동일한 manifest 를 여러 번 적용해도 결과는 같다.
+// 개별 manifest 의 실패는 결과에 기록하고 나머지 manifest 는 계속 처리한다.
+func (u *ManifestUsecase) Apply(ctx context.Context, organizationId string, manifests []domain.Manifest, dryRun bool) (out []domain.ManifestApplyResult, err error) {
+	for _, m := range manifests {
+		if m.ApiVersion != domain.ManifestApiVersion {
+			return nil, httpErrors.NewError(fmt.Errorf("invalid apiVersion %s", m.ApiVersion), "MF_INVALID_MANIFEST")
+		}
+		if !m.Kind.Validate() {
+			return nil, httpErrors.NewError(fmt.Errorf("invalid kind %s", m.Kind), "MF_INVALID_MANIFEST")
+		}
+		if m.Metadata.Name == "" {
+			return nil, httpErrors.NewError(fmt.Errorf("metadata.name is required"), "MF_INVALID_MANIFEST")
+		}
+	}
+
+	resources := u.resources()
+	out = make([]domain.ManifestApplyResult, 0, len(manifests))
+	for _, kind := range domain.ManifestKinds {
+		var currents map[string]interface{}
+		seen := make(map[string]bool)
+		for _, m := range manifests {
+			if m.Kind != kind {
+				continue
+			}
+			// 같은 이름의 manifest 가 여러 개이면 어느 것을 반영할지 알 수 없으므로 두 번째부터 실패로 처리한다.
+			if seen[m.Metadata.Name] {
+				out = append(out, domain.ManifestApplyResult{
+					Kind:   m.Kind,
+					Name:   m.Metadata.Name,
+					Action: domain.ManifestApplyAction_FAILED,
+					Issues: []domain.ManifestIssue{manifestError(domain.ManifestIssueType_ID_COLLISION, "duplicated %s %s in manifests", m.Kind, m.Metadata.Name)},
+					Error:  fmt.Sprintf("duplicated %s %s in manifests", m.Kind, m.Metadata.Name),
+				})
+				continue
+			}
+			seen[m.Metadata.Name] = true
+			if currents == nil {
+				if currents, err = resources[kind].current(ctx, organizationId); err != nil {
+					return nil, errors.Wrap(err, fmt.Sprintf("Failed to fetch %s", kind))
+				}
+			}
+
+			result := u.apply(ctx, organizationId, resources[kind], currents, m, dryRun)
+			if result.Action == domain.ManifestApplyAction_FAILED {
+				log.Errorf(ctx, "failed to apply manifest. kind : %s, name : %s, err : %s", m.Kind, m.Metadata.Name, result.Error)
+			}
+			out = append(out, result)
+		}
+	}
+	return out, nil
+}
+
+func (u *ManifestUsecase) apply(ctx context.Context, organizationId string, resource manifestResource,
+	currents map[string]interface{}, m domain.Manifest, dryRun bool) domain.ManifestApplyResult {
+	result := domain.ManifestApplyResult{Kind: m.Kind, Name: m.Metadata.Name}
+	fail := func(err error) domain.ManifestApplyResult {
+		result.Action = domain.ManifestApplyAction_FAILED
+		result.Error = err.Error()
+		return result
+	}
+
+	desired, err := normalizeSpec(m.Spec)
+	if err != nil {
+		return fail(err)
+	}
+
+	current, exists := currents[m.Metadata.Name]
+	// dry-run 과 실제 반영이 같은 결과를 내도록 검증은 항상 수행한다.
+	if resource.validate != nil {
+		result.Issues = resource.validate(ctx, organizationId, m.Metadata.Name, domain.Manifest{Kind: m.Kind, Metadata: m.Metadata, Spec: desired}, current)
+		for _, issue := range result.Issues {
+			if issue.Severity == domain.ManifestIssueSeverity_ERROR {
+				return fail(errors.New(issue.Message))
+			}
+		}
+	}
+
+	if !exists {
+		if resource.create == nil {
+			return fail(fmt.Errorf("%s %s not found", m.Kind, m.Metadata.Name))
+		}
+		result.Action = domain.ManifestApplyAction_CREATED
+		if !dryRun {
+			if err := resource.create(ctx, organizationId, m.Metadata.Name, domain.Manifest{Kind: m.Kind, Metadata: m.Metadata, Spec: desired}); err != nil {
+				return fail(err)
+			}
+		}
+		return result
+	}
+
+	currentSpec, err := normalizeSpec(current)
+	if err != nil {
+		return fail(err)
+	}
+
+	// 명시된 필드만 비교하고, 명시되지 않은 필드는 현재 값을 유지한다.
+	merged := currentSpec
+	for key, value := range desired {
+		if !reflect.DeepEqual(currentSpec[key], value) {
+			result.Changes = append(result.Changes, "spec."+key)
+		}
+		merged[key] = value
+	}
+	sort.Strings(result.Changes)
+
+	if len(result.Changes) == 0 {
+		result.Action = domain.ManifestApplyAction_UNCHANGED
+		return result
+	}
+
+	result.Action = domain.ManifestApplyAction_UPDATED
+	if !dryRun {
+		if err := resource.update(ctx, organizationId, m.Metadata.Name, domain.Manifest{Kind: m.Kind, Metadata: m.Metadata, Spec: merged}); err != nil {
+			return fail(err)
+		}
+	}
+	return result
+}
+
+// Organization
+func (u *ManifestUsecase) currentOrganizations(ctx context.Context, organizationId string) (map[string]interface{}, error) {
+	organization, err := u.organizationUsecase.Get(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		organization.ID: domain.OrganizationManifestSpec{
+			Name:        organization.Name,
+			Description: organization.Description,
+		},
+	}, nil
+}
+
+func (u *ManifestUsecase) updateOrganization(ctx context.Context, organizationId string, name string, m domain.Manifest) error {
+	var spec domain.OrganizationManifestSpec
+	if err := m.DecodeSpec(&spec); err != nil {
+		return err
+	}
+	_, err := u.organizationUsecase.Update(ctx, organizationId, model.Organization{
+		Name:        spec.Name,
+		Description: spec.Description,
+	})
+	return err
+}
+
+// validateOrganization 은 다른 조직에서 내보낸 manifest 인지 확인한다. 조직은 manifest 로 생성할 수 없다.
+func (u *ManifestUsecase) validateOrganization(ctx context.Context, organizationId string, name string, m domain.Manifest, current interface{}) (out []domain.ManifestIssue) {
+	if name != organizationId {
+		out = append(out, manifestError(domain.ManifestIssueType_ORGANIZATION_MISMATCH,
+			"organization %s in manifests does not match target organization %s", name, organizationId))
+	}
+	return out
+}
+
+// Role
+func (u *ManifestUsecase) currentRoles(ctx context.Context, organizationId string) (map[string]interface{}, error) {
+	roles, err := u.roleUsecase.ListTksRoles(ctx, organizationId, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]interface{})
+	for _, role := range roles {
+		out[role.Name] = domain.RoleManifestSpec{Description: role.Description}
+	}
+	return out, nil
+}
+
+func (u *ManifestUsecase) createRole(ctx context.Context, organizationId string, name string, m domain.Manifest) error {
+	var spec domain.RoleManifestSpec
+	if err := m.DecodeSpec(&spec); err != nil {
+		return err
+	}
+	roleId, err := u.roleUsecase.CreateTksRole(ctx, &model.Role{
+		OrganizationID: organizationId,
+		Name:           name,
+		Description:    spec.Description,
+		Type:           string(domain.RoleTypeTks),
+	})
+	if err != nil {
+		return err
+	}
+
+	defaultPermissionSet := model.NewDefaultPermissionSet()
+	u.permissionUsecase.SetRoleIdToPermissionSet(ctx, roleId, defaultPermissionSet)
+	return u.permissionUsecase.CreatePermissionSet(ctx, defaultPermissionSet)
+}
+
+func (u *ManifestUsecase) updateRole(ctx context.Context, organizationId string, name string, m domain.Manifest) error {
+	var spec domain.RoleManifestSpec
+	if err := m.DecodeSpec(&spec); err != nil {
+		return err
+	}
+	roles, err := u.roleUsecase.ListTksRoles(ctx, organizationId, nil)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if role.Name == name {
+			role.Description = spec.Description
+			return u.roleUsecase.UpdateTksRole(ctx, role)
+		}
+	}
+	return fmt.Errorf("role %s not found", name)
+}
+
+// StackTemplate
+func (u *ManifestUsecase) currentStackTemplates(ctx context.Context, organizationId string) (map[string]interface{}, error) {
+	stackTemplates, err := u.stackTemplateUsecase.FetchWithOrganization(ctx, organizationId, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]interface{})
+	for _, stackTemplate := range stackTemplates {
+		var services []struct {
+			Type string `json:"type"`
+		}
+		serviceIds := make([]string, 0)
+		if err := json.Unmarshal(stackTemplate.Services, &services); err == nil {
+			for _, service := range services {
+				serviceIds = append(serviceIds, service.Type)
+			}
+		}
+
+		out[stackTemplate.Name] = domain.StackTemplateManifestSpec{
+			Description:  stackTemplate.Description,
+			Version:      stackTemplate.Version,
+			CloudService: stackTemplate.CloudService,
+			Platform:     stackTemplate.Platform,
+			TemplateType: stackTemplate.TemplateType,
+			Template:     stackTemplate.Template,
+			KubeVersion:  stackTemplate.KubeVersion,
+			KubeType:     stackTemplate.KubeType,
+			ServiceIds:   serviceIds,
+		}
+	}
+	return out, nil
+}
+
+func (u *ManifestUsecase) createStackTemplate(ctx context.Context, organizationId string, name string, m domain.Manifest) error {
+	var spec domain.StackTemplateManifestSpec
+	if err := m.DecodeSpec(&spec); err != nil {
+		return err
+	}
+
+	// 이름이 같은 템플릿이 다른 조직에 이미 있으면 현재 조직에 추가한다.
+	if stackTemplate, err := u.stackTemplateUsecase.GetByName(ctx, name); err == nil {
+		return u.stackTemplateUsecase.AddOrganizationStackTemplates(ctx, organizationId, []string{stackTemplate.ID.String()})
+	}
+
+	dto := stackTemplateFromManifestSpec(spec)
+	dto.Name = name
+	dto.OrganizationIds = []string{organizationId}
+	_, err := u.stackTemplateUsecase.Create(ctx, dto)
+	return err
+}
+
+func (u *ManifestUsecase) updateStackTemplate(ctx context.Context, organizationId string, name string, m domain.Manifest) error {
+	var spec domain.StackTemplateManifestSpec
+	if err := m.DecodeSpec(&spec); err != nil {
+		return err
+	}
+
+	current, err := u.stackTemplateUsecase.GetByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	// Update 는 조직 매핑을 OrganizationIds 로 교체하므로 기존 매핑을 유지한다.
+	current, err = u.stackTemplateUsecase.Get(ctx, current.ID)
+	if err != nil {
+		return err
+	}
+
+	dto := stackTemplateFromManifestSpec(spec)
+	dto.ID = current.ID
+	dto.Name = name
+	dto.OrganizationIds = make([]string, 0, len(current.Organizations))
+	for _, organization := range current.Organizations {
+		dto.OrganizationIds = append(dto.OrganizationIds, organization.ID)
+	}
+	if user, err := userIdFromContext(ctx); err == nil {
+		dto.UpdatorId = &user
+	}
+	return u.stackTemplateUsecase.Update(ctx, dto)
+}
+
+func (u *ManifestUsecase) validateStackTemplate(ctx context.Context, organizationId string, name string, m domain.Manifest, current interface{}) (out []domain.ManifestIssue) {
+	var spec domain.StackTemplateManifestSpec
+	if err := m.DecodeSpec(&spec); err != nil {
+		return append(out, manifestError(domain.ManifestIssueType_INVALID_SPEC, "invalid spec. %s", err))
+	}
+	for _, serviceId := range spec.ServiceIds {
+		if serviceId != "LMA" && serviceId != "SERVICE_MESH" {
+			out = append(out, manifestError(domain.ManifestIssueType_INVALID_SPEC, "unknown service %s", serviceId))
+		}
+	}
+
+	if current != nil {
+		if c, ok := current.(domain.StackTemplateManifestSpec); ok && spec.Version != "" && c.Version != spec.Version {
+			out = append(out, manifestWarning(domain.ManifestIssueType_VERSION_MISMATCH,
+				"version of stack template will be changed from %s to %s", c.Version, spec.Version))
+		}
+		return out
+	}
+
+	// 다른 조직에 같은 이름의 템플릿이 있으면 새로 만들지 않고 공유하므로 manifest 의 spec 은 반영되지 않는다.
+	if existing, err := u.stackTemplateUsecase.GetByName(ctx, name); err == nil {
+		out = append(out, manifestWarning(domain.ManifestIssueType_ID_COLLISION,
+			"stack template %s already exists in another organization. the existing template will be shared instead of creating it", name))
+		if spec.Version != "" && existing.Version != spec.Version {
+			out = append(out, manifestWarning(domain.ManifestIssueType_VERSION_MISMATCH,
+				"version of the existing stack template is %s, but %s in manifests", existing.Version, spec.Version))
+		}
+		return out
+	}
+	if spec.Template == "" {
+		out = append(out, manifestError(domain.ManifestIssueType_MISSING_REFERENCE, "spec.template is required to create stack template"))
+	}
+	return out
+}
+
+func stackTemplateFromManifestSpec(spec domain.StackTemplateManifestSpec) model.StackTemplate {
+	return model.StackTemplate{
+		Description:  spec.Description,
+		Version:      spec.Version,
+		CloudService: spec.CloudService,
+		Platform:     spec.Platform,
+		TemplateType: spec.TemplateType,
+		Template:     spec.Template,
+		KubeVersion:  spec.KubeVersion,
+		KubeType:     spec.KubeType,
+		ServiceIds:   spec.ServiceIds,
+	}
+}
+
+// PolicyTemplate
+func (u *ManifestUsecase) currentPolicyTemplates(ctx context.Context, organizationId string) (map[string]interface{}, error) {
+	policyTemplates, err := u.policyTemplateUsecase.Fetch(ctx, &organizationId, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]interface{})
+	for _, policyTemplate := range policyTemplates {
+		out[policyTemplate.TemplateName] = domain.PolicyTemplateManifestSpec{
+			Type:             policyTemplate.Type,
+			Kind:             policyTemplate.Kind,
+			Description:      policyTemplate.Description,
+			Severity:         policyTemplate.Severity,
+			Deprecated:       policyTemplate.Deprecated,
+			Version:          policyTemplate.Version,
+			ParametersSchema: policyTemplate.ParametersSchema,
+			Rego:             policyTemplate.Rego,
+			Libs:             policyTemplate.Libs,
+		}
+	}
+	return out, nil
+}
+
+func (u *ManifestUsecase) createPolicyTemplate(ctx context.Context, organizationId string, name string, m domain.Manifest) error {
+	var spec domain.PolicyTemplateManifestSpec
+	if err := m.DecodeSpec(&spec); err != nil {
+		return err
+	}
+
+	// manifest 로는 조직 템플릿만 생성한다. TKS 템플릿은 관리자 API 로 생성한다.
+	_, err := u.policyTemplateUsecase.Create(ctx, model.PolicyTemplate{
+		TemplateName:     name,
+		Type:             "organization",
+		OrganizationId:   &organizationId,
+		Kind:             spec.Kind,
+		Description:      spec.Description,
+		Severity:         spec.Severity,
+		Deprecated:       spec.Deprecated,
+		ParametersSchema: spec.ParametersSchema,
+		Rego:             spec.Rego,
+		Libs:             spec.Libs,
+	})
+	return err
+}
+
+func (u *ManifestUsecase) updatePolicyTemplate(ctx context.Context, organizationId string, name string, m domain.Manifest) error {
+	var spec domain.PolicyTemplateManifestSpec
+	if err := m.DecodeSpec(&spec); err != nil {
+		return err
+	}
+
+	policyTemplates, err := u.policyTemplateUsecase.Fetch(ctx, &organizationId, nil)
+	if err != nil {
+		return err
+	}
+	var current *model.PolicyTemplate
+	for i := range policyTemplates {
+		if policyTemplates[i].TemplateName == name {
+			current = &policyTemplates[i]
+			break
+		}
+	}
+	if current == nil {
+		return fmt.Errorf("policy template %s not found", name)
+	}
+
+	err = u.policyTemplateUsecase.Update(ctx, &organizationId, current.ID, nil, &spec.Description, &spec.Severity, &spec.Deprecated, nil)
+	if err != nil {
+		return err
+	}
+
+	// 버전이 바뀌었거나 rego, 파라미터가 바뀌면 새 버전으로 등록한다. 기존 버전은 수정하지 않는다.
+	if spec.Version != current.Version {
+		_, err = u.policyTemplateUsecase.CreatePolicyTemplateVersion(ctx, &organizationId, current.ID, spec.Version, spec.ParametersSchema, spec.Rego, spec.Libs)
+		return err
+	}
+	if spec.Rego != current.Rego || !reflect.DeepEqual(spec.Libs, current.Libs) ||
+		!reflect.DeepEqual(spec.ParametersSchema, current.ParametersSchema) {
+		return httpErrors.NewError(fmt.Errorf("spec.version must be changed to update rego, libs or parametersSchema"), "MF_INVALID_MANIFEST")
+	}
+	return nil
+}
+
+func (u *ManifestUsecase) validatePolicyTemplate(ctx context.Context, organizationId string, name string, m domain.Manifest, current interface{}) (out []domain.ManifestIssue) {
+	var spec domain.PolicyTemplateManifestSpec
+	if err := m.DecodeSpec(&spec); err != nil {
+		return append(out, manifestError(domain.ManifestIssueType_INVALID_SPEC, "invalid spec. %s", err))
+	}
+
+	if current != nil {
+		c, ok := current.(domain.PolicyTemplateManifestSpec)
+		if !ok {
+			return out
+		}
+		if spec.Version == c.Version {
+			// 비어 있는 목록과 생략된 항목을 같게 보도록 json 표현으로 비교한다.
+			currentSpec, err := normalizeSpec(c)
+			if err != nil {
+				return out
+			}
+			for _, key := range []string{"rego", "libs", "parametersSchema"} {
+				if value, ok := m.Spec[key]; ok && !reflect.DeepEqual(currentSpec[key], value) {
+					out = append(out, manifestError(domain.ManifestIssueType_VERSION_MISMATCH,
+						"spec.version must be changed to update rego, libs or parametersSchema of policy template"))
+					break
+				}
+			}
+		} else if spec.Version != "" {
+			out = append(out, manifestWarning(domain.ManifestIssueType_VERSION_MISMATCH,
+				"new version %s of policy template will be added. current version is %s", spec.Version, c.Version))
+		}
+		return out
+	}
+
+	// 조회되지 않는 같은 이름, kind 의 템플릿은 다른 조직의 템플릿이다.
+	if exists, err := u.policyTemplateUsecase.IsPolicyTemplateNameExist(ctx, nil, name); err == nil && exists {
+		out = append(out, manifestError(domain.ManifestIssueType_ID_COLLISION, "policy template %s already exists in another organization", name))
+	}
+	if spec.Kind != "" {
+		if exists, err := u.policyTemplateUsecase.IsPolicyTemplateKindExist(ctx, &organizationId, spec.Kind); err == nil && exists {
+			out = append(out, manifestError(domain.ManifestIssueType_ID_COLLISION, "policy template kind %s already exists", spec.Kind))
+		}
+	}
+	return out
+}
+
+// SystemNotificationRule
+func (u *ManifestUsecase) currentSystemNotificationRules(ctx context.Context, organizationId string) (map[string]interface{}, error) {
+	rules, err := u.systemNotificationRuleUsecase.Fetch(ctx, organizationId, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]interface{})
+	for _, rule := range rules {
+		condition := domain.SystemNotificationConditionManifestSpec{
+			Severity:     rule.SystemNotificationCondition.Severity,
+			Duration:     rule.SystemNotificationCondition.Duration,
+			EnableEmail:  rule.SystemNotificationCondition.EnableEmail,
+			EnablePortal: rule.SystemNotificationCondition.EnablePortal,
+		}
+		if len(rule.SystemNotificationCondition.Parameter) > 0 {
+			if err := json.Unmarshal(rule.SystemNotificationCondition.Parameter, &condition.Parameters); err != nil {
+				log.Error(ctx, err)
+			}
+		}
+
+		targetUsers := make([]string, 0, len(rule.TargetUsers))
+		for _, user := range rule.TargetUsers {
+			targetUsers = append(targetUsers, user.AccountId)
+		}
+		sort.Strings(targetUsers)
+
+		out[rule.Name] = domain.SystemNotificationRuleManifestSpec{
+			Description:                rule.Description,
+			SystemNotificationTemplate: rule.SystemNotificationTemplate.Name,
+			Condition:                  &condition,
+			TargetUsers:                targetUsers,
+			MessageTitle:               rule.MessageTitle,
+			MessageContent:             rule.MessageContent,
+			MessageActionProposal:      rule.MessageActionProposal,
+		}
+	}
+	return out, nil
+}
+
+func (u *ManifestUsecase) createSystemNotificationRule(ctx context.Context, organizationId string, name string, m domain.Manifest) error {
+	dto, err := u.systemNotificationRuleFromManifest(ctx, organizationId, name, m)
+	if err != nil {
+		return err
+	}
+	_, err = u.systemNotificationRuleUsecase.Create(ctx, dto)
+	return err
+}
+
+func (u *ManifestUsecase) updateSystemNotificationRule(ctx context.Context, organizationId string, name string, m domain.Manifest) error {
+	current, err := u.systemNotificationRuleUsecase.GetByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if current.OrganizationId != organizationId {
+		return fmt.Errorf("system notification rule %s belongs to another organization", name)
+	}
+
+	dto, err := u.systemNotificationRuleFromManifest(ctx, organizationId, name, m)
+	if err != nil {
+		return err
+	}
+	dto.ID = current.ID
+	if user, err := userIdFromContext(ctx); err == nil {
+		dto.UpdatorId = &user
+	}
+	return u.systemNotificationRuleUsecase.Update(ctx, dto)
+}
+
+func (u *ManifestUsecase) validateSystemNotificationRule(ctx context.Context, organizationId string, name string, m domain.Manifest, current interface{}) (out []domain.ManifestIssue) {
+	var spec domain.SystemNotificationRuleManifestSpec
+	if err := m.DecodeSpec(&spec); err != nil {
+		return append(out, manifestError(domain.ManifestIssueType_INVALID_SPEC, "invalid spec. %s", err))
+	}
+
+	if current == nil {
+		if rule, err := u.systemNotificationRuleUsecase.GetByName(ctx, name); err == nil && rule.OrganizationId != organizationId {
+			out = append(out, manifestError(domain.ManifestIssueType_ID_COLLISION, "system notification rule %s already exists in another organization", name))
+		}
+	}
+	if _, err := u.systemNotificationTemplateUsecase.GetByName(ctx, spec.SystemNotificationTemplate); err != nil {
+		out = append(out, manifestError(domain.ManifestIssueType_MISSING_REFERENCE, "system notification template %s not found", spec.SystemNotificationTemplate))
+	}
+	for _, accountId := range spec.TargetUsers {
+		if _, err := u.userRepo.Get(ctx, accountId, organizationId); err != nil {
+			out = append(out, manifestError(domain.ManifestIssueType_MISSING_REFERENCE, "target user %s not found", accountId))
+		}
+	}
+	return out
+}
+
+func (u *ManifestUsecase) systemNotificationRuleFromManifest(ctx context.Context, organizationId string, name string, m domain.Manifest) (out model.SystemNotificationRule, err error) {
+	var spec domain.SystemNotificationRuleManifestSpec
+	if err := m.DecodeSpec(&spec); err != nil {
+		return out, err
+	}
+
+	template, err := u.systemNotificationTemplateUsecase.GetByName(ctx, spec.SystemNotificationTemplate)
+	if err != nil {
+		return out, errors.Wrap(err, fmt.Sprintf("invalid systemNotificationTemplate %s", spec.SystemNotificationTemplate))
+	}
+
+	targetUserIds := make([]string, 0, len(spec.TargetUsers))
+	for _, accountId := range spec.TargetUsers {
+		user, err := u.userRepo.Get(ctx, accountId, organizationId)
+		if err != nil {
+			return out, errors.Wrap(err, fmt.Sprintf("invalid targetUser %s", accountId))
+		}
+		targetUserIds = append(targetUserIds, user.ID.String())
+	}
+
+	out = model.SystemNotificationRule{
+		Name:                         name,
+		Description:                  spec.Description,
+		OrganizationId:               organizationId,
+		SystemNotificationTemplateId: template.ID,
+		TargetUserIds:                targetUserIds,
+		MessageTitle:                 spec.MessageTitle,
+		MessageContent:               spec.MessageContent,
+		MessageActionProposal:        spec.MessageActionProposal,
+	}
+	if spec.Condition != nil {
+		out.SystemNotificationCondition = model.SystemNotificationCondition{
+			Severity:     spec.Condition.Severity,
+			Duration:     spec.Condition.Duration,
+			Parameters:   spec.Condition.Parameters,
+			EnableEmail:  spec.Condition.EnableEmail,
+			EnablePortal: spec.Condition.EnablePortal,
+		}
+	}
+	return out, nil
+}
+
+// normalizeSpec 은 yaml, 구조체 등 서로 다른 표현을 비교할 수 있도록 json 표현으로 통일한다.
+func normalizeSpec(spec interface{}) (out map[string]interface{}, err error) {
+	out = make(map[string]interface{})
+	if spec == nil {
+		return out, nil
+	}
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func manifestError(issueType string, format string, args ...interface{}) domain.ManifestIssue {
+	return domain.ManifestIssue{Severity: domain.ManifestIssueSeverity_ERROR, Type: issueType, Message: fmt.Sprintf(format, args...)}
+}
+
+func manifestWarning(issueType string, format string, args ...interface{}) domain.ManifestIssue {
+	return domain.ManifestIssue{Severity: domain.ManifestIssueSeverity_WARNING, Type: issueType, Message: fmt.Sprintf(format, args...)}
+}
+
+func containsManifestKind(kinds []domain.ManifestKind, kind domain.ManifestKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func userIdFromContext(ctx context.Context) (uuid.UUID, error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return uuid.Nil, fmt.Errorf("invalid token")
+	}
+	return user.GetUserId(), nil
+}
@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/mail"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+type INotificationDigestUsecase interface {
+	GetSettings(ctx context.Context, userId uuid.UUID) ([]model.NotificationDigestSetting, error)
+	UpdateSettings(ctx context.Context, organizationId string, userId uuid.UUID, settings []model.NotificationDigestSetting) error
+	NotifyByEmail(ctx context.Context, notification model.SystemNotification, users []model.User) error
+	SendDigests(ctx context.Context) error
+}
+
+type NotificationDigestUsecase struct {
+	repo repository.INotificationDigestRepository
+}
+
+func NewNotificationDigestUsecase(r repository.Repository) INotificationDigestUsecase {
+	return &NotificationDigestUsecase{
+		repo: r.NotificationDigest,
+	}
+}
+
+// GetSettings 는 모든 채널의 설정을 반환한다. 저장된 설정이 없는 채널은 IMMEDIATE 이다.
+func (u *NotificationDigestUsecase) GetSettings(ctx context.Context, userId uuid.UUID) (out []model.NotificationDigestSetting, err error) {
+	settings, err := u.repo.FetchSettings(ctx, userId)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	out = make([]model.NotificationDigestSetting, 0, len(domain.NotificationChannels))
+	for _, channel := range domain.NotificationChannels {
+		setting := model.NotificationDigestSetting{
+			UserId:  userId,
+			Channel: channel,
+			Mode:    domain.NotificationDigestMode_IMMEDIATE,
+		}
+		for _, s := range settings {
+			if s.Channel == channel {
+				setting = s
+				break
+			}
+		}
+		out = append(out, setting)
+	}
+	return out, nil
+}
+
+func (u *NotificationDigestUsecase) UpdateSettings(ctx context.Context, organizationId string, userId uuid.UUID, settings []model.NotificationDigestSetting) error {
+	for i := range settings {
+		settings[i].UserId = userId
+		settings[i].OrganizationId = organizationId
+	}
+	if err := u.repo.SaveSettings(ctx, settings); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// NotifyByEmail 은 critical 알림과 IMMEDIATE 사용자에게는 바로 메일을 보내고,
+// HOURLY, DAILY 사용자에게는 digest 로 보내기 위해 저장한다.
+func (u *NotificationDigestUsecase) NotifyByEmail(ctx context.Context, notification model.SystemNotification, users []model.User) error {
+	immediate := []string{}
+	items := []model.NotificationDigestItem{}
+
+	modes := map[uuid.UUID]domain.NotificationDigestMode{}
+	if !isCriticalSeverity(notification.Severity) {
+		userIds := make([]uuid.UUID, len(users))
+		for i, user := range users {
+			userIds[i] = user.ID
+		}
+		settings, err := u.repo.FetchSettingsByUsers(ctx, userIds, domain.NotificationChannel_EMAIL)
+		if err != nil {
+			// 설정을 가져오지 못해도 알림은 누락되지 않도록 바로 보낸다.
+			log.Error(ctx, err)
+		}
+		for _, setting := range settings {
+			modes[setting.UserId] = setting.Mode
+		}
+	}
+
+	for _, user := range users {
+		if user.Email == "" {
+			continue
+		}
+		if modes[user.ID].Period() == 0 {
+			immediate = append(immediate, user.Email)
+			continue
+		}
+
+		var systemNotificationId *uuid.UUID
+		if notification.ID != uuid.Nil {
+			systemNotificationId = &notification.ID
+		}
+		items = append(items, model.NotificationDigestItem{
+			UserId:               user.ID,
+			Channel:              domain.NotificationChannel_EMAIL,
+			OrganizationId:       notification.OrganizationId,
+			SystemNotificationId: systemNotificationId,
+			ClusterId:            notification.ClusterId,
+			Severity:             notification.Severity,
+			Title:                notification.MessageTitle,
+			Content:              notification.MessageContent,
+		})
+	}
+
+	if err := u.repo.CreateItems(ctx, items); err != nil {
+		return err
+	}
+
+	if len(immediate) == 0 {
+		return nil
+	}
+	message, err := mail.MakeSystemNotificationMessage(ctx, notification.OrganizationId, notification.MessageTitle, notification.MessageContent, immediate)
+	if err != nil {
+		return fmt.Errorf("Failed to make email content. err : %s", err.Error())
+	}
+	if err := mail.New(message).SendMail(ctx); err != nil {
+		return fmt.Errorf("Failed to send email to %s. err : %s", immediate, err.Error())
+	}
+	return nil
+}
+
+// SendDigests 는 사용자, 채널 별로 가장 오래된 알림이 digest 기간을 넘긴 경우 모아둔 알림을 요약하여 보낸다.
+// 그 사이 설정이 IMMEDIATE 로 바뀐 사용자에게는 모아둔 알림을 바로 보낸다.
+func (u *NotificationDigestUsecase) SendDigests(ctx context.Context) error {
+	items, err := u.repo.FetchItems(ctx)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	type digestKey struct {
+		userId  uuid.UUID
+		channel domain.NotificationChannel
+	}
+	keys := []digestKey{}
+	groups := map[digestKey][]model.NotificationDigestItem{}
+	userIds := map[domain.NotificationChannel][]uuid.UUID{}
+	for _, item := range items {
+		key := digestKey{userId: item.UserId, channel: item.Channel}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+			userIds[item.Channel] = append(userIds[item.Channel], item.UserId)
+		}
+		groups[key] = append(groups[key], item)
+	}
+
+	modes := map[digestKey]domain.NotificationDigestMode{}
+	for channel, ids := range userIds {
+		settings, err := u.repo.FetchSettingsByUsers(ctx, ids, channel)
+		if err != nil {
+			return err
+		}
+		for _, setting := range settings {
+			modes[digestKey{userId: setting.UserId, channel: setting.Channel}] = setting.Mode
+		}
+	}
+
+	for _, key := range keys {
+		group := groups[key]
+		mode := modes[key]
+		if time.Since(group[0].CreatedAt) < mode.Period() {
+			continue
+		}
+
+		if err := u.sendDigest(ctx, key.channel, mode, group); err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+
+		itemIds := make([]uuid.UUID, len(group))
+		for i, item := range group {
+			itemIds[i] = item.ID
+		}
+		if err := u.repo.DeleteItems(ctx, itemIds); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	return nil
+}
+
+func (u *NotificationDigestUsecase) sendDigest(ctx context.Context, channel domain.NotificationChannel, mode domain.NotificationDigestMode, items []model.NotificationDigestItem) error {
+	user := items[0].User
+	if channel != domain.NotificationChannel_EMAIL || user.Email == "" {
+		log.Warnf(ctx, "discard %d digest items of user %s. channel %s is not available", len(items), items[0].UserId, channel)
+		return nil
+	}
+
+	mailItems := make([]mail.NotificationDigestItem, len(items))
+	for i, item := range items {
+		mailItems[i] = mail.NotificationDigestItem{
+			Severity:  item.Severity,
+			Title:     item.Title,
+			Content:   item.Content,
+			ClusterId: item.ClusterId.String(),
+			CreatedAt: item.CreatedAt.Format("2006-01-02 15:04:05"),
+		}
+	}
+
+	message, err := mail.MakeNotificationDigestMessage(ctx, items[0].OrganizationId, digestPeriodText(mode), mailItems, []string{user.Email})
+	if err != nil {
+		return fmt.Errorf("Failed to make email content. err : %s", err.Error())
+	}
+	if err := mail.New(message).SendMail(ctx); err != nil {
+		return fmt.Errorf("Failed to send digest email to %s. err : %s", user.Email, err.Error())
+	}
+	return nil
+}
+
+func isCriticalSeverity(severity string) bool {
+	return strings.EqualFold(severity, "critical")
+}
+
+func digestPeriodText(mode domain.NotificationDigestMode) string {
+	switch mode {
+	case domain.NotificationDigestMode_HOURLY:
+		return "지난 1시간"
+	case domain.NotificationDigestMode_DAILY:
+		return "지난 1일"
+	}
+	return "최근"
+}
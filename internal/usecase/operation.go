@@ -0,0 +1,369 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	argowf "github.com/openinfradev/tks-api/pkg/argo-client"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+// taskLogExcerptLines 는 task 에 남기는 workflow 로그의 줄 수이다.
+const taskLogExcerptLines = 50
+
+type IOperationUsecase interface {
+	Submit(ctx context.Context, dto model.Operation, parameters []string) (model.Operation, error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.Operation, error)
+	GetTask(ctx context.Context, organizationId string, operationId uuid.UUID) (model.Operation, error)
+	Cancel(ctx context.Context, organizationId string, operationId uuid.UUID) error
+	Dispatch(ctx context.Context) error
+}
+
+type OperationUsecase struct {
+	mu   sync.Mutex
+	repo repository.IOperationRepository
+	argo argowf.ArgoClient
+}
+
+func NewOperationUsecase(r repository.Repository, argoClient argowf.ArgoClient) IOperationUsecase {
+	return &OperationUsecase{
+		repo: r.Operation,
+		argo: argoClient,
+	}
+}
+
+// Submit 은 동시 실행 제한 이내이면 workflow 를 바로 제출하고, 제한을 넘으면 PENDING 으로 저장한다.
+// 같은 조직에 먼저 대기 중인 operation 이 있으면 순서를 지키기 위해 함께 대기한다.
+func (u *OperationUsecase) Submit(ctx context.Context, dto model.Operation, parameters []string) (out model.Operation, err error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	dto.Parameters, err = json.Marshal(parameters)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if user, ok := request.UserFrom(ctx); ok {
+		userId := user.GetUserId()
+		dto.CreatorId = &userId
+	}
+
+	running, pending, err := u.fetchActive(ctx)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	queued := false
+	for _, operation := range pending {
+		if operation.OrganizationId == dto.OrganizationId && operation.Type.Category() == dto.Type.Category() {
+			queued = true
+			break
+		}
+	}
+
+	if queued || !newOperationCounter(running).available(dto) {
+		dto.Status = domain.OperationStatus_PENDING
+		dto.StatusDesc = "waiting for running operations to finish"
+		if dto.ID, err = u.repo.Create(ctx, dto); err != nil {
+			return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+		log.Infof(ctx, "operation %s(%s) of organization %s is pending", dto.Type, dto.TargetName, dto.OrganizationId)
+		return dto, nil
+	}
+
+	if err = u.submitWorkflow(ctx, &dto, parameters); err != nil {
+		return out, err
+	}
+	if dto.ID, err = u.repo.Create(ctx, dto); err != nil {
+		log.Error(ctx, err)
+	}
+	return dto, nil
+}
+
+func (u *OperationUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.Operation, error) {
+	return u.repo.Fetch(ctx, organizationId, pg)
+}
+
+// GetTask 는 operation 의 진행 상황을 반환한다.
+// 실행 중인 operation 은 workflow 의 step 과 로그를 조회해서 채우고, 끝났거나 workflow 가 없는 operation 은 저장된 내용을 그대로 반환한다.
+func (u *OperationUsecase) GetTask(ctx context.Context, organizationId string, operationId uuid.UUID) (model.Operation, error) {
+	operation, err := u.get(ctx, organizationId, operationId)
+	if err != nil {
+		return model.Operation{}, err
+	}
+	if operation.Status != domain.OperationStatus_RUNNING || operation.WorkflowId == "" {
+		return operation, nil
+	}
+
+	workflow, err := u.argo.GetWorkflow(ctx, "argo", operation.WorkflowId)
+	if err != nil {
+		log.Warnf(ctx, "failed to get workflow %s of operation %s. err : %s", operation.WorkflowId, operation.ID, err)
+		return operation, nil
+	}
+	u.recordProgress(ctx, &operation, workflow)
+	return operation, nil
+}
+
+// Cancel 은 아직 제출되지 않은 operation 만 취소할 수 있다.
+func (u *OperationUsecase) Cancel(ctx context.Context, organizationId string, operationId uuid.UUID) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	operation, err := u.get(ctx, organizationId, operationId)
+	if err != nil {
+		return err
+	}
+	if operation.Status != domain.OperationStatus_PENDING {
+		return httpErrors.NewError(fmt.Errorf("operation is not pending. status [%s]", operation.Status), "OP_NOT_PENDING_OPERATION")
+	}
+
+	now := time.Now()
+	operation.Status = domain.OperationStatus_CANCELED
+	operation.StatusDesc = "canceled before submission"
+	operation.FinishedAt = &now
+	if err := u.repo.UpdateStatus(ctx, operation); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// Dispatch 는 실행 중인 operation 의 workflow 상태를 반영한 뒤, 비어있는 자리만큼 대기 중인 operation 을 순서대로 제출한다.
+func (u *OperationUsecase) Dispatch(ctx context.Context) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	running, pending, err := u.fetchActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	stillRunning := make([]model.Operation, 0, len(running))
+	for _, operation := range running {
+		// 조직 삭제처럼 workflow 없이 API 서버가 직접 실행하는 operation 은 실행한 쪽에서 상태를 갱신한다.
+		if operation.WorkflowId == "" {
+			stillRunning = append(stillRunning, operation)
+			continue
+		}
+		workflow, err := u.argo.GetWorkflow(ctx, "argo", operation.WorkflowId)
+		if err != nil {
+			log.Warnf(ctx, "failed to get workflow %s of operation %s. err : %s", operation.WorkflowId, operation.ID, err)
+			stillRunning = append(stillRunning, operation)
+			continue
+		}
+
+		switch workflow.Status.Phase {
+		case "Succeeded":
+			operation.Status = domain.OperationStatus_COMPLETED
+			operation.StatusDesc = ""
+		case "Failed", "Error":
+			operation.Status = domain.OperationStatus_FAILED
+			operation.StatusDesc = workflow.Status.Message
+		default:
+			stillRunning = append(stillRunning, operation)
+			continue
+		}
+		// workflow 는 일정 시간이 지나면 정리되므로 끝났을 때의 진행 상황을 이력으로 저장한다.
+		u.recordProgress(ctx, &operation, workflow)
+		operation.FinishedAt = workflow.Status.FinishedAt
+		if operation.FinishedAt == nil {
+			now := time.Now()
+			operation.FinishedAt = &now
+		}
+		if err := u.repo.UpdateStatus(ctx, operation); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+
+	counter := newOperationCounter(stillRunning)
+	for _, operation := range pending {
+		if !counter.available(operation) {
+			continue
+		}
+
+		parameters := []string{}
+		if err := json.Unmarshal(operation.Parameters, &parameters); err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		if err := u.submitWorkflow(ctx, &operation, parameters); err != nil {
+			now := time.Now()
+			operation.Status = domain.OperationStatus_FAILED
+			operation.StatusDesc = err.Error()
+			operation.FinishedAt = &now
+		} else {
+			counter.add(operation)
+		}
+		if err := u.repo.UpdateStatus(ctx, operation); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	return nil
+}
+
+func (u *OperationUsecase) get(ctx context.Context, organizationId string, operationId uuid.UUID) (model.Operation, error) {
+	operation, err := u.repo.Get(ctx, operationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.Operation{}, httpErrors.NewError(err, "OP_NOT_FOUND_OPERATION")
+		}
+		return model.Operation{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if operation.OrganizationId != organizationId {
+		return model.Operation{}, httpErrors.NewError(fmt.Errorf("Not found operation"), "OP_NOT_FOUND_OPERATION")
+	}
+	return operation, nil
+}
+
+// recordProgress 는 workflow 의 진행률, Pod 로 실행된 step 들과 로그의 마지막 부분을 operation 에 채운다.
+func (u *OperationUsecase) recordProgress(ctx context.Context, operation *model.Operation, workflow *argowf.Workflow) {
+	steps := make([]domain.TaskStepResponse, 0, len(workflow.Status.Nodes))
+	for _, node := range workflow.Status.Nodes {
+		if node.Type != "Pod" {
+			continue
+		}
+		steps = append(steps, domain.TaskStepResponse{
+			Name:       node.DisplayName,
+			Template:   node.TemplateName,
+			Phase:      node.Phase,
+			Message:    node.Message,
+			StartedAt:  node.StartedAt,
+			FinishedAt: node.FinishedAt,
+		})
+	}
+	sort.SliceStable(steps, func(i, j int) bool {
+		if steps[i].StartedAt == nil || steps[j].StartedAt == nil {
+			return steps[j].StartedAt == nil && steps[i].StartedAt != nil
+		}
+		return steps[i].StartedAt.Before(*steps[j].StartedAt)
+	})
+
+	var err error
+	operation.Progress = workflow.Status.Progress
+	if operation.Steps, err = json.Marshal(steps); err != nil {
+		log.Error(ctx, err)
+	}
+
+	logs, err := u.argo.GetWorkflowLog(ctx, "argo", "main", operation.WorkflowId)
+	if err != nil {
+		log.Warnf(ctx, "failed to get logs of workflow %s. err : %s", operation.WorkflowId, err)
+		return
+	}
+	operation.LogExcerpt = workflowLogExcerpt(logs, taskLogExcerptLines)
+}
+
+// workflowLogExcerpt 는 argo 의 workflow 로그 응답(줄마다 {"result":{"content":...,"podName":...}})에서 마지막 lines 줄을 꺼낸다.
+func workflowLogExcerpt(logs string, lines int) string {
+	var out []string
+	for _, line := range strings.Split(logs, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		entry := struct {
+			Result struct {
+				Content string `json:"content"`
+				PodName string `json:"podName"`
+			} `json:"result"`
+		}{}
+		if err := json.Unmarshal([]byte(line), &entry); err == nil {
+			line = entry.Result.PodName + ": " + entry.Result.Content
+		}
+		out = append(out, line)
+	}
+	if len(out) > lines {
+		out = out[len(out)-lines:]
+	}
+	return strings.Join(out, "\n")
+}
+
+func (u *OperationUsecase) fetchActive(ctx context.Context) (running []model.Operation, pending []model.Operation, err error) {
+	running, err = u.repo.FetchByStatus(ctx, domain.OperationStatus_RUNNING)
+	if err != nil {
+		return nil, nil, err
+	}
+	pending, err = u.repo.FetchByStatus(ctx, domain.OperationStatus_PENDING)
+	if err != nil {
+		return nil, nil, err
+	}
+	return
+}
+
+func (u *OperationUsecase) submitWorkflow(ctx context.Context, operation *model.Operation, parameters []string) error {
+	workflowId, err := u.argo.SumbitWorkflowFromWftpl(ctx, operation.WorkflowTemplate, argowf.SubmitOptions{
+		Parameters: parameters,
+	})
+	if err != nil {
+		log.Error(ctx, "Failed to submit workflow. Err:", err)
+		return httpErrors.NewError(err, "S_FAILED_TO_CALL_WORKFLOW")
+	}
+	log.Info(ctx, "Successfully submitted workflow: ", workflowId)
+
+	now := time.Now()
+	operation.WorkflowId = workflowId
+	operation.Status = domain.OperationStatus_RUNNING
+	operation.StatusDesc = ""
+	operation.SubmittedAt = &now
+	return nil
+}
+
+// operationCounter 는 분류별로 플랫폼 전체와 조직별 실행 중인 operation 수를 센다.
+type operationCounter struct {
+	total          map[domain.OperationCategory]int
+	byOrganization map[domain.OperationCategory]map[string]int
+}
+
+func newOperationCounter(running []model.Operation) *operationCounter {
+	c := &operationCounter{
+		total:          map[domain.OperationCategory]int{},
+		byOrganization: map[domain.OperationCategory]map[string]int{},
+	}
+	for _, operation := range running {
+		c.add(operation)
+	}
+	return c
+}
+
+func (c *operationCounter) add(operation model.Operation) {
+	category := operation.Type.Category()
+	c.total[category]++
+	if c.byOrganization[category] == nil {
+		c.byOrganization[category] = map[string]int{}
+	}
+	c.byOrganization[category][operation.OrganizationId]++
+}
+
+// available 은 operation 을 지금 실행해도 제한을 넘지 않는지 확인한다. 제한이 0 이하이면 제한하지 않는다.
+func (c *operationCounter) available(operation model.Operation) bool {
+	category := operation.Type.Category()
+	limit, limitPerOrganization := operationLimits(category)
+	if limit > 0 && c.total[category] >= limit {
+		return false
+	}
+	if limitPerOrganization > 0 && c.byOrganization[category][operation.OrganizationId] >= limitPerOrganization {
+		return false
+	}
+	return true
+}
+
+func operationLimits(category domain.OperationCategory) (limit int, limitPerOrganization int) {
+	switch category {
+	case domain.OperationCategory_STACK:
+		return viper.GetInt("stack-operation-limit"), viper.GetInt("stack-operation-limit-per-organization")
+	case domain.OperationCategory_APP:
+		return viper.GetInt("app-operation-limit"), viper.GetInt("app-operation-limit-per-organization")
+	}
+	return 0, 0
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openinfradev/tks-api/internal/mail"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// GetBranding 은 조직의 브랜드 설정을 반환한다. 설정하지 않은 조직은 비어 있는 설정을 반환하며, 이 경우 기본 브랜드를 사용한다.
+func (u *OrganizationUsecase) GetBranding(ctx context.Context, organizationId string) (model.OrganizationBranding, error) {
+	branding, err := u.brandingRepo.Get(ctx, organizationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.OrganizationBranding{OrganizationId: organizationId}, nil
+		}
+		return model.OrganizationBranding{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return branding, nil
+}
+
+func (u *OrganizationUsecase) UpdateBranding(ctx context.Context, dto model.OrganizationBranding) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+	userId := user.GetUserId()
+
+	if _, err := u.repo.Get(ctx, dto.OrganizationId); err != nil {
+		return httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_ORGANIZATION", "")
+	}
+
+	dto.DisplayName = strings.TrimSpace(dto.DisplayName)
+	dto.SupportContact = strings.TrimSpace(dto.SupportContact)
+	dto.EmailFooter = strings.TrimSpace(dto.EmailFooter)
+	dto.UpdatorId = &userId
+	if err := u.brandingRepo.Upsert(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// GetMailBranding 은 메일 생성 시 사용할 조직의 브랜드이다. 조회에 실패해도 메일은 기본 브랜드로 보낸다.
+func (u *OrganizationUsecase) GetMailBranding(ctx context.Context, organizationId string) mail.Branding {
+	branding, err := u.brandingRepo.Get(ctx, organizationId)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error(ctx, err)
+		}
+		return mail.Branding{}
+	}
+	return mail.Branding{
+		DisplayName:    branding.DisplayName,
+		LogoUrl:        branding.LogoUrl,
+		SupportContact: branding.SupportContact,
+		EmailFooter:    branding.EmailFooter,
+	}
+}
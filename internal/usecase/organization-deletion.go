@@ -0,0 +1,408 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// organizationDeletionStep 은 조직 삭제 계획의 한 단계이다. BLOCK 단계는 run 이 없다.
+type organizationDeletionStep struct {
+	domain.OrganizationDeletionStepResponse
+	run func(ctx context.Context) error
+}
+
+// Delete 는 조직에 속한 리소스를 의존하는 순서대로 삭제한 뒤 조직을 삭제한다.
+// 실행 중인 operation, 스택, AWS 클라우드 계정처럼 외부 자원을 정리해야 하는 리소스가 남아 있으면 아무것도 삭제하지 않는다.
+// dryRun 이면 삭제 계획만 반환하고, 아니면 단계별 진행 상황을 ORGANIZATION_DELETE operation 으로 기록한다.
+// 감사 로그와 operation 이력은 조직을 삭제한 후에도 조회할 수 있도록 남긴다.
+func (u *OrganizationUsecase) Delete(ctx context.Context, organizationId string, accessToken string, dryRun bool) (out domain.DeleteOrganizationResponse, err error) {
+	organization, err := u.Get(ctx, organizationId)
+	if err != nil {
+		return out, err
+	}
+
+	steps, err := u.planDeletion(ctx, organization)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	out.ID = organizationId
+	out.DryRun = dryRun
+	out.Steps = make([]domain.OrganizationDeletionStepResponse, len(steps))
+	blockers := []string{}
+	for i, step := range steps {
+		out.Steps[i] = step.OrganizationDeletionStepResponse
+		if step.Action == domain.OrganizationDeletionAction_BLOCK && step.Count > 0 {
+			blockers = append(blockers, fmt.Sprintf("%s %d", step.Resource, step.Count))
+		}
+	}
+	if dryRun {
+		return out, nil
+	}
+	if len(blockers) > 0 {
+		return out, httpErrors.NewError(fmt.Errorf("organization has resources to clean up first: %s", strings.Join(blockers, ", ")), "O_DELETION_BLOCKED")
+	}
+
+	now := time.Now()
+	operation := model.Operation{
+		OrganizationId: organizationId,
+		Type:           domain.OperationType_ORGANIZATION_DELETE,
+		TargetId:       organizationId,
+		TargetName:     organization.Name,
+		Status:         domain.OperationStatus_RUNNING,
+		SubmittedAt:    &now,
+	}
+	if user, ok := request.UserFrom(ctx); ok {
+		userId := user.GetUserId()
+		operation.CreatorId = &userId
+	}
+	if operation.ID, err = u.operationRepo.Create(ctx, operation); err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	out.TaskId = operation.ID.String()
+
+	taskSteps := make([]domain.TaskStepResponse, 0, len(steps))
+	for i, step := range steps {
+		startedAt := time.Now()
+		var stepErr error
+		if step.run != nil {
+			stepErr = step.run(ctx)
+		}
+		finishedAt := time.Now()
+
+		taskStep := domain.TaskStepResponse{
+			Name:       step.Resource,
+			Template:   string(step.Action),
+			Phase:      "Succeeded",
+			StartedAt:  &startedAt,
+			FinishedAt: &finishedAt,
+		}
+		operation.Progress = fmt.Sprintf("%d/%d", i+1, len(steps))
+		if stepErr != nil {
+			taskStep.Phase = "Failed"
+			taskStep.Message = stepErr.Error()
+			operation.Status = domain.OperationStatus_FAILED
+			operation.StatusDesc = fmt.Sprintf("failed to delete %s", step.Resource)
+			operation.FinishedAt = &finishedAt
+		}
+		taskSteps = append(taskSteps, taskStep)
+		u.recordDeletionProgress(ctx, &operation, taskSteps)
+
+		if stepErr != nil {
+			log.Errorf(ctx, "failed to delete %s of organization %s. err : %s", step.Resource, organizationId, stepErr)
+			return out, httpErrors.NewError(errors.Wrapf(stepErr, "failed to delete %s", step.Resource), "C_INTERNAL_ERROR")
+		}
+	}
+
+	now = time.Now()
+	operation.Status = domain.OperationStatus_COMPLETED
+	operation.FinishedAt = &now
+	u.recordDeletionProgress(ctx, &operation, taskSteps)
+	u.cacheInvalidator.Invalidate(ctx, CacheEvent{Kind: CacheEvent_ORGANIZATION_DELETED, OrganizationId: organizationId})
+
+	return out, nil
+}
+
+func (u *OrganizationUsecase) recordDeletionProgress(ctx context.Context, operation *model.Operation, steps []domain.TaskStepResponse) {
+	var err error
+	if operation.Steps, err = json.Marshal(steps); err != nil {
+		log.Error(ctx, err)
+	}
+	if err := u.operationRepo.UpdateStatus(ctx, *operation); err != nil {
+		log.Error(ctx, err)
+	}
+}
+
+// planDeletion 은 조직의 리소스를 삭제할 순서대로 나열한다.
+// 다른 리소스가 참조하는 리소스가 나중에 삭제되도록 알림, 조직 설정과 자격증명, 프로젝트, 사용자, 역할, keycloak realm, 조직 순서로 삭제한다.
+func (u *OrganizationUsecase) planDeletion(ctx context.Context, organization model.Organization) (steps []organizationDeletionStep, err error) {
+	organizationId := organization.ID
+
+	operations, err := u.operationRepo.Fetch(ctx, organizationId, nil)
+	if err != nil {
+		return nil, err
+	}
+	activeOperations := []string{}
+	for _, operation := range operations {
+		if operation.Status == domain.OperationStatus_PENDING || operation.Status == domain.OperationStatus_RUNNING {
+			activeOperations = append(activeOperations, fmt.Sprintf("%s(%s)", operation.Type, operation.TargetName))
+		}
+	}
+	steps = append(steps, blockDeletionStep("operations", activeOperations, "실행 중이거나 대기 중인 작업이 끝나거나 취소된 후에 삭제할 수 있습니다."))
+
+	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	clusterNames := make([]string, len(clusters))
+	for i, cluster := range clusters {
+		clusterNames[i] = cluster.Name
+	}
+	steps = append(steps, blockDeletionStep("stacks", clusterNames, "스택의 인프라를 정리해야 하므로 스택을 먼저 삭제하세요."))
+
+	cloudAccounts, err := u.cloudAccountRepo.Fetch(ctx, organizationId, nil)
+	if err != nil {
+		return nil, err
+	}
+	awsAccounts, inclusterAccounts := []string{}, []string{}
+	inclusterAccountIds := []uuid.UUID{}
+	for _, cloudAccount := range cloudAccounts {
+		if strings.Contains(cloudAccount.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
+			inclusterAccounts = append(inclusterAccounts, cloudAccount.Name)
+			inclusterAccountIds = append(inclusterAccountIds, cloudAccount.ID)
+		} else {
+			awsAccounts = append(awsAccounts, cloudAccount.Name)
+		}
+	}
+	steps = append(steps, blockDeletionStep("cloud-accounts", awsAccounts, "AWS 자원을 정리하려면 인증 정보가 필요하므로 클라우드 계정을 먼저 삭제하세요."))
+	steps = append(steps, deleteDeletionStep("incluster-cloud-accounts", inclusterAccounts, func(ctx context.Context) error {
+		for _, cloudAccountId := range inclusterAccountIds {
+			if err := u.cloudAccountRepo.Delete(ctx, cloudAccountId); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	alertCount, err := u.systemNotificationRepo.CountByOrganizationId(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	alertStep := deleteDeletionStep("alerts", nil, func(ctx context.Context) error {
+		return u.systemNotificationRepo.Flush(ctx, organizationId)
+	})
+	alertStep.Count = int(alertCount)
+	steps = append(steps, alertStep)
+
+	silences, err := u.alertSilenceRepo.Fetch(ctx, organizationId, nil)
+	if err != nil {
+		return nil, err
+	}
+	silenceNames := make([]string, len(silences))
+	for i, silence := range silences {
+		silenceNames[i] = silence.Name
+	}
+	steps = append(steps, deleteDeletionStep("alert-silences", silenceNames, func(ctx context.Context) error {
+		for _, silence := range silences {
+			if err := u.alertSilenceRepo.Delete(ctx, silence.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	routingRules, err := u.alertRoutingRuleRepo.Fetch(ctx, organizationId, nil)
+	if err != nil {
+		return nil, err
+	}
+	routingRuleNames := make([]string, len(routingRules))
+	for i, routingRule := range routingRules {
+		routingRuleNames[i] = routingRule.Name
+	}
+	steps = append(steps, deleteDeletionStep("alert-routing-rules", routingRuleNames, func(ctx context.Context) error {
+		for _, routingRule := range routingRules {
+			if err := u.alertRoutingRuleRepo.Delete(ctx, routingRule.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	channels, err := u.alertChannelRepo.Fetch(ctx, organizationId, nil)
+	if err != nil {
+		return nil, err
+	}
+	channelNames := make([]string, len(channels))
+	for i, channel := range channels {
+		channelNames[i] = channel.Name
+	}
+	steps = append(steps, deleteDeletionStep("alert-channels", channelNames, func(ctx context.Context) error {
+		for _, channel := range channels {
+			if err := u.alertChannelRepo.Delete(ctx, channel.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	webhooks, err := u.stackWebhookRepo.Fetch(ctx, organizationId, nil)
+	if err != nil {
+		return nil, err
+	}
+	webhookNames := make([]string, len(webhooks))
+	for i, webhook := range webhooks {
+		webhookNames[i] = webhook.Name
+	}
+	steps = append(steps, deleteDeletionStep("stack-webhooks", webhookNames, func(ctx context.Context) error {
+		for _, webhook := range webhooks {
+			if err := u.stackWebhookRepo.Delete(ctx, webhook.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	// 조직 단위로 저장한 설정, 자격증명, 공유 링크는 정리할 외부 자원이 없으므로 DB 에서 한 번에 삭제한다.
+	// 공유 차트 링크와 알림 수집 토큰은 조직을 확인하지 않고 인증에 사용하므로 조직과 함께 반드시 삭제해야 한다.
+	// 비밀번호 이력은 사용자로 찾으므로 사용자보다 먼저 삭제한다.
+	records := []struct {
+		resource string
+		count    func(ctx context.Context, organizationId string) (int64, error)
+		flush    func(ctx context.Context, organizationId string) error
+	}{
+		{"chart-snapshots", u.chartSnapshotRepo.CountByOrganizationId, u.chartSnapshotRepo.Flush},
+		{"custom-charts", u.customChartRepo.CountByOrganizationId, u.customChartRepo.Flush},
+		{"alert-ingestion-tokens", u.alertIngestionTokenRepo.CountByOrganizationId, u.alertIngestionTokenRepo.Flush},
+		{"escalation-policies", u.escalationPolicyRepo.CountByOrganizationId, u.escalationPolicyRepo.Flush},
+		{"notification-digests", u.notificationDigestRepo.CountByOrganizationId, u.notificationDigestRepo.Flush},
+		{"maintenance-windows", u.maintenanceWindowRepo.CountByOrganizationId, u.maintenanceWindowRepo.Flush},
+		{"app-serve-app-configs", u.appServeAppRepo.CountConfigsByOrganizationId, u.appServeAppRepo.FlushConfigs},
+		{"git-providers", u.gitProviderRepo.CountByOrganizationId, u.gitProviderRepo.Flush},
+		{"catalog-apps", u.catalogRepo.CountAppsByOrganizationId, u.catalogRepo.FlushApps},
+		{"lma-endpoints", u.lmaEndpointRepo.CountByOrganizationId, u.lmaEndpointRepo.Flush},
+		{"audit-sinks", u.auditSinkRepo.CountByOrganizationId, u.auditSinkRepo.Flush},
+		{"encryption-keys", u.encryptionKeyRepo.CountByOrganizationId, u.encryptionKeyRepo.Flush},
+		{"cost-allocation-tag-policies", u.costAllocationTagRepo.CountByOrganizationId, u.costAllocationTagRepo.Flush},
+		{"stack-defaults", u.stackDefaultRepo.CountByOrganizationId, u.stackDefaultRepo.Flush},
+		{"naming-policies", u.namingPolicyRepo.CountByOrganizationId, u.namingPolicyRepo.Flush},
+		{"password-policies", u.passwordPolicyRepo.CountByOrganizationId, u.passwordPolicyRepo.Flush},
+		{"user-sessions", u.userSessionRepo.CountByOrganizationId, u.userSessionRepo.Flush},
+		{"api-usages", u.apiUsageRepo.CountByOrganizationId, u.apiUsageRepo.Flush},
+		{"organization-branding", u.brandingRepo.CountByOrganizationId, u.brandingRepo.Flush},
+		{"organization-quotas", u.quotaRepo.CountByOrganizationId, u.quotaRepo.Flush},
+		{"organization-onboarding", u.onboardingRepo.CountByOrganizationId, u.onboardingRepo.Flush},
+	}
+	for _, record := range records {
+		count, err := record.count(ctx, organizationId)
+		if err != nil {
+			return nil, err
+		}
+		flush := record.flush
+		step := deleteDeletionStep(record.resource, nil, func(ctx context.Context) error {
+			return flush(ctx, organizationId)
+		})
+		step.Count = int(count)
+		steps = append(steps, step)
+	}
+
+	// 스택이 모두 삭제되었으므로 프로젝트 네임스페이스는 DB 에만 남아 있다. keycloak 의 프로젝트 역할은 realm 과 함께 삭제된다.
+	projects, err := u.projectRepo.GetProjectsByOrganizationId(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	projectNames := make([]string, len(projects))
+	for i, project := range projects {
+		projectNames[i] = project.Name
+	}
+	steps = append(steps, deleteDeletionStep("projects", projectNames, func(ctx context.Context) error {
+		for _, project := range projects {
+			if err := u.deleteProjectRecords(ctx, organizationId, project.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	// 사용자 목록은 사용자가 없으면 NotFound 를 반환한다.
+	accountIds := []string{}
+	users, err := u.userRepo.List(ctx, u.userRepo.OrganizationFilter(organizationId))
+	if err != nil {
+		if _, status := httpErrors.ErrorResponse(err); status != http.StatusNotFound {
+			return nil, err
+		}
+	} else {
+		for _, user := range *users {
+			accountIds = append(accountIds, user.AccountId)
+		}
+	}
+	steps = append(steps, deleteDeletionStep("users", accountIds, func(ctx context.Context) error {
+		return u.userRepo.Flush(ctx, organizationId)
+	}))
+
+	steps = append(steps, deleteDeletionStep("identity-realm", []string{organizationId}, func(ctx context.Context) error {
+		return u.kc.DeleteRealm(ctx, organizationId)
+	}))
+
+	roles, err := u.roleRepo.ListTksRoles(ctx, organizationId, nil)
+	if err != nil {
+		return nil, err
+	}
+	roleNames := make([]string, len(roles))
+	for i, role := range roles {
+		roleNames[i] = role.Name
+	}
+	steps = append(steps, deleteDeletionStep("roles", roleNames, func(ctx context.Context) error {
+		for _, role := range roles {
+			if err := u.roleRepo.Delete(ctx, role.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	steps = append(steps, deleteDeletionStep("organization", []string{organization.Name}, func(ctx context.Context) error {
+		return u.repo.Delete(ctx, organizationId)
+	}))
+
+	return steps, nil
+}
+
+func (u *OrganizationUsecase) deleteProjectRecords(ctx context.Context, organizationId string, projectId string) error {
+	namespaces, err := u.projectRepo.GetProjectNamespaces(ctx, organizationId, projectId, nil)
+	if err != nil {
+		return err
+	}
+	for _, namespace := range namespaces {
+		if err := u.projectRepo.DeleteProjectNamespace(ctx, organizationId, projectId, namespace.Namespace, namespace.StackId); err != nil {
+			return err
+		}
+	}
+
+	members, err := u.projectRepo.GetProjectMembersByProjectId(ctx, projectId, nil)
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		if err := u.projectRepo.RemoveProjectMember(ctx, member.ID); err != nil {
+			return err
+		}
+	}
+
+	return u.projectRepo.DeleteProject(ctx, organizationId, projectId)
+}
+
+func blockDeletionStep(resource string, items []string, reason string) organizationDeletionStep {
+	return organizationDeletionStep{
+		OrganizationDeletionStepResponse: domain.OrganizationDeletionStepResponse{
+			Resource: resource,
+			Action:   domain.OrganizationDeletionAction_BLOCK,
+			Count:    len(items),
+			Items:    items,
+			Reason:   reason,
+		},
+	}
+}
+
+func deleteDeletionStep(resource string, items []string, run func(ctx context.Context) error) organizationDeletionStep {
+	return organizationDeletionStep{
+		OrganizationDeletionStepResponse: domain.OrganizationDeletionStepResponse{
+			Resource: resource,
+			Action:   domain.OrganizationDeletionAction_DELETE,
+			Count:    len(items),
+			Items:    items,
+		},
+		run: run,
+	}
+}
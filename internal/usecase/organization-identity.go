@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const (
+	identityCheckTimeout      = 5 * time.Second
+	identitySlowTokenIssuance = time.Second
+)
+
+// GetIdentityStatus 는 조직의 keycloak realm 상태를 점검한다.
+// keycloak 장애가 API 의 500 에러로만 보이지 않도록, 점검에 실패해도 에러 대신 실패 내용을 담은 상태를 반환한다.
+// 초대 후 아직 로그인하지 않은 사용자는 keycloak 의 required action 이 남은 사용자와 임시 비밀번호를 변경하지 않은 사용자로 판단한다.
+func (u *OrganizationUsecase) GetIdentityStatus(ctx context.Context, organizationId string) (out domain.DashboardIdentityStatus, err error) {
+	if _, err := u.repo.Get(ctx, organizationId); err != nil {
+		return out, httpErrors.NewNotFoundError(err, "", "")
+	}
+
+	out.CheckedAt = time.Now()
+	out.LockedAccountIds = []string{}
+	out.PendingInvitationIds = []string{}
+	checkCtx, cancel := context.WithTimeout(ctx, identityCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := u.kc.CheckRealm(checkCtx, organizationId); err != nil {
+		log.Warnf(ctx, "failed to reach keycloak realm %s. err : %s", organizationId, err)
+		out.Errors = append(out.Errors, fmt.Sprintf("realm: %s", err))
+	} else {
+		out.Reachable = true
+	}
+	out.RealmLatency = time.Since(start).Milliseconds()
+
+	start = time.Now()
+	if err := u.kc.IssueAdminToken(checkCtx); err != nil {
+		log.Warnf(ctx, "failed to issue keycloak token. err : %s", err)
+		out.Errors = append(out.Errors, fmt.Sprintf("token: %s", err))
+	} else {
+		out.TokenIssued = true
+	}
+	tokenIssuanceLatency := time.Since(start)
+	out.TokenIssuanceLatency = tokenIssuanceLatency.Milliseconds()
+
+	userStatusChecked := false
+	if out.Reachable {
+		lockedAccountIds, pendingAccountIds, err := u.kc.GetUserStatus(checkCtx, organizationId)
+		if err != nil {
+			log.Warnf(ctx, "failed to get keycloak users of realm %s. err : %s", organizationId, err)
+			out.Errors = append(out.Errors, fmt.Sprintf("users: %s", err))
+		} else {
+			userStatusChecked = true
+			out.LockedAccountIds = append(out.LockedAccountIds, lockedAccountIds...)
+			out.PendingInvitationIds = u.pendingInvitations(ctx, organizationId, pendingAccountIds)
+		}
+	}
+	out.LockedAccounts = len(out.LockedAccountIds)
+	out.PendingInvitations = len(out.PendingInvitationIds)
+
+	switch {
+	case !out.Reachable || !out.TokenIssued:
+		out.Status = domain.IdentityStatus_DOWN
+	case !userStatusChecked || tokenIssuanceLatency > identitySlowTokenIssuance:
+		out.Status = domain.IdentityStatus_DEGRADED
+	default:
+		out.Status = domain.IdentityStatus_HEALTHY
+	}
+	return out, nil
+}
+
+func (u *OrganizationUsecase) pendingInvitations(ctx context.Context, organizationId string, pendingAccountIds []string) []string {
+	accountIds := make(map[string]struct{}, len(pendingAccountIds))
+	for _, accountId := range pendingAccountIds {
+		accountIds[accountId] = struct{}{}
+	}
+
+	// 임시 비밀번호를 발급하면 password_updated_at 을 초기화한다.
+	users, err := u.userRepo.List(ctx, u.userRepo.OrganizationFilter(organizationId))
+	if err != nil {
+		log.Warnf(ctx, "failed to get users of organization %s. err : %s", organizationId, err)
+	} else {
+		for _, user := range *users {
+			if user.PasswordUpdatedAt.IsZero() {
+				accountIds[user.AccountId] = struct{}{}
+			}
+		}
+	}
+
+	out := make([]string, 0, len(accountIds))
+	for accountId := range accountIds {
+		out = append(out, accountId)
+	}
+	sort.Strings(out)
+	return out
+}
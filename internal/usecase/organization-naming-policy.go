@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var namingPolicyResourceTypes = []string{
+	domain.NamingPolicyResourceType_STACK,
+	domain.NamingPolicyResourceType_APP,
+	domain.NamingPolicyResourceType_PROJECT,
+}
+
+// 이름 규칙을 설정하지 않은 자원은 비어 있는 규칙을 반환하며, 이 경우 이름을 제한하지 않는다.
+func (u *OrganizationUsecase) GetNamingPolicies(ctx context.Context, organizationId string) ([]model.NamingPolicy, error) {
+	policies, err := u.namingPolicyRepo.Fetch(ctx, organizationId)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	out := make([]model.NamingPolicy, len(namingPolicyResourceTypes))
+	for i, resourceType := range namingPolicyResourceTypes {
+		out[i] = model.NamingPolicy{OrganizationId: organizationId, ResourceType: resourceType}
+		for _, policy := range policies {
+			if policy.ResourceType == resourceType {
+				out[i] = policy
+			}
+		}
+		if err := unmarshalNamingPolicy(&out[i]); err != nil {
+			return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+	}
+	return out, nil
+}
+
+// UpdateNamingPolicy 는 규칙이 모두 비어 있으면 저장된 규칙을 삭제한다. 이미 만든 자원의 이름은 다시 검사하지 않는다.
+func (u *OrganizationUsecase) UpdateNamingPolicy(ctx context.Context, dto model.NamingPolicy) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+	userId := user.GetUserId()
+
+	if _, err := u.repo.Get(ctx, dto.OrganizationId); err != nil {
+		return httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_ORGANIZATION", "")
+	}
+	if !helper.Contains(namingPolicyResourceTypes, dto.ResourceType) {
+		return httpErrors.NewError(fmt.Errorf("resourceType must be one of %s", strings.Join(namingPolicyResourceTypes, ", ")), "O_INVALID_NAMING_POLICY")
+	}
+
+	dto.Pattern = strings.TrimSpace(dto.Pattern)
+	if dto.Pattern != "" {
+		if _, err := compileNamingPattern(dto.Pattern); err != nil {
+			return httpErrors.NewError(fmt.Errorf("invalid pattern. %s", err), "O_INVALID_NAMING_POLICY")
+		}
+	}
+	// 이름은 namespace, DNS 이름으로도 사용되므로 prefix 도 DNS label 에 쓸 수 있는 문자만 허용한다.
+	prefixes := []string{}
+	for _, prefix := range dto.Prefixes {
+		prefix = strings.TrimSpace(prefix)
+		if errs := validation.IsDNS1123Label(strings.TrimSuffix(prefix, "-")); len(errs) > 0 {
+			return httpErrors.NewError(fmt.Errorf("invalid prefix [%s]. %s", prefix, strings.Join(errs, ", ")), "O_INVALID_NAMING_POLICY")
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	reservedNames := []string{}
+	for _, name := range dto.ReservedNames {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" && !helper.Contains(reservedNames, name) {
+			reservedNames = append(reservedNames, name)
+		}
+	}
+
+	if dto.Pattern == "" && len(prefixes) == 0 && len(reservedNames) == 0 {
+		if err := u.namingPolicyRepo.Delete(ctx, dto.OrganizationId, dto.ResourceType); err != nil {
+			return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+		return nil
+	}
+
+	dto.Prefix = []byte(helper.ModelToJson(prefixes))
+	dto.ReservedName = []byte(helper.ModelToJson(reservedNames))
+	dto.UpdatorId = &userId
+	if err := u.namingPolicyRepo.Upsert(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// compileNamingPattern 은 pattern 이 이름의 일부가 아닌 전체와 비교되도록 한다.
+func compileNamingPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+func unmarshalNamingPolicy(policy *model.NamingPolicy) error {
+	policy.Prefixes, policy.ReservedNames = []string{}, []string{}
+	if len(policy.Prefix) > 0 {
+		if err := json.Unmarshal(policy.Prefix, &policy.Prefixes); err != nil {
+			return err
+		}
+	}
+	if len(policy.ReservedName) > 0 {
+		if err := json.Unmarshal(policy.ReservedName, &policy.ReservedNames); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateResourceName 은 스택, 앱, 프로젝트를 만들기 전에 이름이 조직의 이름 규칙을 만족하는지 확인한다.
+// 오류 메시지에 어긋난 규칙을 포함하여 사용자가 이름을 고칠 수 있도록 한다.
+func validateResourceName(ctx context.Context, repo repository.INamingPolicyRepository, organizationId string, resourceType string, name string) error {
+	policy, err := repo.Get(ctx, organizationId, resourceType)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if err := unmarshalNamingPolicy(&policy); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	kind := strings.ToLower(resourceType)
+	if helper.Contains(policy.ReservedNames, strings.ToLower(name)) {
+		return httpErrors.NewError(fmt.Errorf("%s name [%s] is reserved in the organization", kind, name), "O_NAMING_POLICY_VIOLATION")
+	}
+	if len(policy.Prefixes) > 0 {
+		matched := false
+		for _, prefix := range policy.Prefixes {
+			if strings.HasPrefix(name, prefix) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return httpErrors.NewError(fmt.Errorf("%s name [%s] must start with one of [%s]", kind, name, strings.Join(policy.Prefixes, ", ")), "O_NAMING_POLICY_VIOLATION")
+		}
+	}
+	if policy.Pattern != "" {
+		re, err := compileNamingPattern(policy.Pattern)
+		if err != nil {
+			return httpErrors.NewError(errors.Wrap(err, "invalid naming policy pattern"), "C_INTERNAL_ERROR")
+		}
+		if !re.MatchString(name) {
+			return httpErrors.NewError(fmt.Errorf("%s name [%s] must match the pattern %s", kind, name, policy.Pattern), "O_NAMING_POLICY_VIOLATION")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// GetQuota 는 조직의 quota 와 현재 사용량을 반환한다. quota 를 설정하지 않은 조직은 모두 0(제한 없음)이다.
+func (u *OrganizationUsecase) GetQuota(ctx context.Context, organizationId string) (model.OrganizationQuota, domain.OrganizationQuotaUsage, error) {
+	if _, err := u.repo.Get(ctx, organizationId); err != nil {
+		return model.OrganizationQuota{}, domain.OrganizationQuotaUsage{}, httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_ORGANIZATION", "")
+	}
+
+	quota, err := u.quotaRepo.Get(ctx, organizationId)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.OrganizationQuota{}, domain.OrganizationQuotaUsage{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+		quota = model.OrganizationQuota{OrganizationId: organizationId}
+	}
+
+	var usage domain.OrganizationQuotaUsage
+	if usage, err = stackQuotaUsage(ctx, u.clusterRepo, organizationId); err != nil {
+		return model.OrganizationQuota{}, domain.OrganizationQuotaUsage{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if usage.Users, err = userQuotaUsage(ctx, u.userRepo, organizationId); err != nil {
+		return model.OrganizationQuota{}, domain.OrganizationQuotaUsage{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return quota, usage, nil
+}
+
+// UpdateQuota 는 이미 사용 중인 자원을 줄이지 않는다. 사용량보다 작게 설정하면 이후의 생성만 막는다.
+func (u *OrganizationUsecase) UpdateQuota(ctx context.Context, dto model.OrganizationQuota) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+	userId := user.GetUserId()
+
+	if _, err := u.repo.Get(ctx, dto.OrganizationId); err != nil {
+		return httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_ORGANIZATION", "")
+	}
+
+	dto.UpdatorId = &userId
+	if err := u.quotaRepo.Upsert(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// checkStackQuota 는 스택을 만들거나 노드를 늘리기 전에 요청한 자원을 더해도 조직의 quota 를 넘지 않는지 확인한다.
+// 오류 메시지에 넘은 항목의 사용량과 quota 를 포함하여 사용자가 무엇을 줄여야 하는지 알 수 있도록 한다.
+// cpu, memory quota 가 있으면 요청한 노드의 instanceTypes 는 vCPU 와 memory 를 계산할 수 있어야 한다.
+func checkStackQuota(ctx context.Context, repo repository.IOrganizationQuotaRepository, clusterRepo repository.IClusterRepository, organizationId string, requested domain.OrganizationQuotaUsage, instanceTypes ...string) error {
+	quota, err := repo.Get(ctx, organizationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if quota.MaxStacks == 0 && quota.MaxNodes == 0 && quota.MaxCpu == 0 && quota.MaxMemoryGib == 0 {
+		return nil
+	}
+	if quota.MaxCpu > 0 || quota.MaxMemoryGib > 0 {
+		for _, instanceType := range instanceTypes {
+			if _, _, ok := instanceTypeResources(instanceType); !ok {
+				return httpErrors.NewBadRequestError(fmt.Errorf("cpu and memory of instance type %s are unknown. it can not be used while the organization has cpu or memory quota", instanceType), "O_UNKNOWN_INSTANCE_TYPE", "")
+			}
+		}
+	}
+
+	usage, err := stackQuotaUsage(ctx, clusterRepo, organizationId)
+	if err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return quotaExceededError(
+		quotaCheck("stacks", quota.MaxStacks, usage.Stacks, requested.Stacks),
+		quotaCheck("nodes", quota.MaxNodes, usage.Nodes, requested.Nodes),
+		quotaCheck("cpu", quota.MaxCpu, usage.Cpu, requested.Cpu),
+		quotaCheck("memory(GiB)", quota.MaxMemoryGib, usage.MemoryGib, requested.MemoryGib),
+	)
+}
+
+// checkUserQuota 는 사용자를 만들기 전에 조직의 사용자 수 quota 를 넘지 않는지 확인한다.
+func checkUserQuota(ctx context.Context, repo repository.IOrganizationQuotaRepository, userRepo repository.IUserRepository, organizationId string, requested int) error {
+	quota, err := repo.Get(ctx, organizationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if quota.MaxUsers == 0 {
+		return nil
+	}
+
+	users, err := userQuotaUsage(ctx, userRepo, organizationId)
+	if err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return quotaExceededError(quotaCheck("users", quota.MaxUsers, users, requested))
+}
+
+// quotaCheck 는 quota 를 넘는 항목이면 사용량과 quota 를 설명하는 문장을, 아니면 빈 문자열을 반환한다.
+func quotaCheck(name string, limit int, used int, requested int) string {
+	if limit > 0 && requested > 0 && used+requested > limit {
+		return fmt.Sprintf("%s (using %d, requested %d, quota %d)", name, used, requested, limit)
+	}
+	return ""
+}
+
+func quotaExceededError(checks ...string) error {
+	var exceeded []string
+	for _, check := range checks {
+		if check != "" {
+			exceeded = append(exceeded, check)
+		}
+	}
+	if len(exceeded) == 0 {
+		return nil
+	}
+	return httpErrors.NewError(fmt.Errorf("organization quota exceeded: %s", strings.Join(exceeded, ", ")), "O_QUOTA_EXCEEDED")
+}
+
+// stackQuotaUsage 는 삭제되지 않은 스택의 수와 노드 수, 노드의 cpu, memory 합계를 계산한다.
+func stackQuotaUsage(ctx context.Context, clusterRepo repository.IClusterRepository, organizationId string) (out domain.OrganizationQuotaUsage, err error) {
+	clusters, err := clusterRepo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
+	if err != nil {
+		return out, err
+	}
+	for _, cluster := range clusters {
+		usage := clusterQuotaUsage(cluster)
+		out.Stacks += usage.Stacks
+		out.Nodes += usage.Nodes
+		out.Cpu += usage.Cpu
+		out.MemoryGib += usage.MemoryGib
+	}
+	return out, nil
+}
+
+// clusterQuotaUsage 는 스택 하나가 사용하는 자원이다. 만들기 전의 스택도 같은 방법으로 계산해 quota 와 비교한다.
+func clusterQuotaUsage(cluster model.Cluster) domain.OrganizationQuotaUsage {
+	out := domain.OrganizationQuotaUsage{Stacks: 1}
+	for _, nodeGroup := range []struct {
+		count        int
+		instanceType string
+	}{
+		{cluster.TksCpNode, cluster.TksCpNodeType},
+		{cluster.TksInfraNode, cluster.TksInfraNodeType},
+		{cluster.TksUserNode, cluster.TksUserNodeType},
+	} {
+		cpu, memoryGib, _ := instanceTypeResources(nodeGroup.instanceType)
+		out.Nodes += nodeGroup.count
+		out.Cpu += nodeGroup.count * cpu
+		out.MemoryGib += nodeGroup.count * memoryGib
+	}
+	return out
+}
+
+// clusterInstanceTypes 는 스택에서 노드가 있는 node group 의 instance type 이다.
+func clusterInstanceTypes(cluster model.Cluster) (out []string) {
+	for _, nodeGroup := range []struct {
+		count        int
+		instanceType string
+	}{
+		{cluster.TksCpNode, cluster.TksCpNodeType},
+		{cluster.TksInfraNode, cluster.TksInfraNodeType},
+		{cluster.TksUserNode, cluster.TksUserNodeType},
+	} {
+		if nodeGroup.count > 0 {
+			out = append(out, nodeGroup.instanceType)
+		}
+	}
+	return out
+}
+
+// userQuotaUsage 는 조직의 사용자 수이다. 사용자 목록은 비어 있으면 not found 를 반환하므로 0 으로 계산한다.
+func userQuotaUsage(ctx context.Context, userRepo repository.IUserRepository, organizationId string) (int, error) {
+	users, err := userRepo.List(ctx, userRepo.OrganizationFilter(organizationId))
+	if err != nil {
+		if _, status := httpErrors.ErrorResponse(err); status == http.StatusNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return len(*users), nil
+}
+
+// instanceTypeResources 는 AWS instance type 이름(family.size)으로 vCPU 수와 memory(GiB)를 계산한다.
+// large 는 vCPU 2 개, xlarge 는 4 개, Nxlarge 는 4N 개이고, memory 는 family 의 vCPU 당 memory 를 곱한다.
+// vCPU 당 memory 를 알 수 없는 family 나 metal 같은 크기는 ok 가 false 이다.
+func instanceTypeResources(instanceType string) (cpu int, memoryGib int, ok bool) {
+	family, size, found := strings.Cut(instanceType, ".")
+	if !found || family == "" {
+		return 0, 0, false
+	}
+
+	var memoryPerCpu int
+	switch family[0] {
+	case 'c':
+		memoryPerCpu = 2
+	case 'm', 't':
+		memoryPerCpu = 4
+	case 'r':
+		memoryPerCpu = 8
+	case 'x':
+		memoryPerCpu = 16
+	default:
+		return 0, 0, false
+	}
+
+	// nano, micro, small 은 burstable instance 에만 있는 크기이다.
+	if family[0] == 't' {
+		switch size {
+		case "nano":
+			return 2, 0, true
+		case "micro":
+			return 2, 1, true
+		case "small":
+			return 2, 2, true
+		}
+	}
+
+	switch size {
+	case "medium":
+		if family[0] == 't' {
+			return 2, 4, true
+		}
+		return 1, memoryPerCpu, true
+	case "large":
+		return 2, 2 * memoryPerCpu, true
+	case "xlarge":
+		return 4, 4 * memoryPerCpu, true
+	}
+	if multiplier, err := strconv.Atoi(strings.TrimSuffix(size, "xlarge")); err == nil && multiplier > 0 && strings.HasSuffix(size, "xlarge") {
+		return 4 * multiplier, 4 * multiplier * memoryPerCpu, true
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// GetUsage 는 조직의 최근 24시간 API 요청 수와 rate limit 으로 거절된 요청 수, 앨럿 채널, 감사 로그 sink, 스택 webhook 의 누적 전달 결과를 반환한다.
+// 요청이 거절되거나 전달이 반복해서 실패하는 원인을 조직 관리자가 직접 확인할 수 있도록 한다.
+func (u *OrganizationUsecase) GetUsage(ctx context.Context, organizationId string) (domain.OrganizationUsageResponse, error) {
+	if _, err := u.repo.Get(ctx, organizationId); err != nil {
+		return domain.OrganizationUsageResponse{}, httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_ORGANIZATION", "")
+	}
+
+	since := time.Now().Truncate(time.Hour).Add(-23 * time.Hour)
+	apiUsages, err := u.apiUsageRepo.Fetch(ctx, organizationId, since)
+	if err != nil {
+		return domain.OrganizationUsageResponse{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	webhooks := make([]domain.WebhookUsage, 0)
+
+	alertChannels, err := u.alertChannelRepo.Fetch(ctx, organizationId, nil)
+	if err != nil {
+		return domain.OrganizationUsageResponse{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	for _, alertChannel := range alertChannels {
+		webhooks = append(webhooks, domain.WebhookUsage{
+			Kind:           domain.WebhookKind_ALERT_CHANNEL,
+			ID:             alertChannel.ID.String(),
+			Name:           alertChannel.Name,
+			Enabled:        alertChannel.Enabled,
+			SucceededCount: alertChannel.SucceededCount,
+			FailedCount:    alertChannel.FailedCount,
+			LastStatus:     alertChannel.LastStatus,
+			LastError:      alertChannel.LastError,
+			LastSentAt:     alertChannel.LastSentAt,
+		})
+	}
+
+	auditSinks, err := u.auditSinkRepo.Fetch(ctx, organizationId, nil)
+	if err != nil {
+		return domain.OrganizationUsageResponse{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	for _, auditSink := range auditSinks {
+		deadLetters, err := u.auditSinkRepo.CountDeadLetters(ctx, auditSink.ID)
+		if err != nil {
+			return domain.OrganizationUsageResponse{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+		webhooks = append(webhooks, domain.WebhookUsage{
+			Kind:           domain.WebhookKind_AUDIT_SINK,
+			ID:             auditSink.ID.String(),
+			Name:           auditSink.Name,
+			Enabled:        auditSink.Enabled,
+			SucceededCount: auditSink.SucceededCount,
+			FailedCount:    auditSink.FailedCount,
+			DeadLetters:    deadLetters,
+			LastStatus:     auditSink.LastStatus,
+			LastError:      auditSink.LastError,
+			LastSentAt:     auditSink.LastSentAt,
+		})
+	}
+
+	stackWebhooks, err := u.stackWebhookRepo.Fetch(ctx, organizationId, nil)
+	if err != nil {
+		return domain.OrganizationUsageResponse{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	for _, stackWebhook := range stackWebhooks {
+		webhooks = append(webhooks, domain.WebhookUsage{
+			Kind:           domain.WebhookKind_STACK_WEBHOOK,
+			ID:             stackWebhook.ID.String(),
+			Name:           stackWebhook.Name,
+			Enabled:        stackWebhook.Enabled,
+			SucceededCount: stackWebhook.SucceededCount,
+			FailedCount:    stackWebhook.FailedCount,
+			LastStatus:     stackWebhook.LastStatus,
+			LastError:      stackWebhook.LastError,
+			LastSentAt:     stackWebhook.LastDeliveredAt,
+		})
+	}
+
+	out := webhookUsage(webhooks)
+	out.Api = apiUsage(since, apiUsages)
+	return out, nil
+}
+
+// PurgeApiUsages 는 api-usage-retention-days 가 지난 API 요청 수를 삭제한다.
+func (u *OrganizationUsecase) PurgeApiUsages(ctx context.Context) error {
+	deleted, err := u.apiUsageRepo.DeleteBefore(ctx, time.Now().AddDate(0, 0, -viper.GetInt("api-usage-retention-days")))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		log.Infof(ctx, "purged %d api usages", deleted)
+	}
+	return nil
+}
+
+// apiUsage 는 1분 단위의 요청 수를 since 부터 24시간의 1시간 단위로 합산한다.
+func apiUsage(since time.Time, apiUsages []model.ApiUsage) domain.ApiUsage {
+	out := domain.ApiUsage{
+		RateLimitPerMinute: viper.GetInt64("api-rate-limit-per-minute"),
+		Hourly:             make([]domain.ApiUsageHourly, 24),
+	}
+	for i := range out.Hourly {
+		out.Hourly[i].StartedAt = since.Add(time.Duration(i) * time.Hour)
+	}
+	for _, apiUsage := range apiUsages {
+		i := int(apiUsage.StartedAt.Sub(since) / time.Hour)
+		if i < 0 || i >= len(out.Hourly) {
+			continue
+		}
+		out.Hourly[i].Requests += apiUsage.Requests
+		out.Hourly[i].RateLimited += apiUsage.RateLimited
+		out.Requests += apiUsage.Requests
+		out.RateLimited += apiUsage.RateLimited
+		if apiUsage.Requests > out.PeakRequestsPerMinute {
+			out.PeakRequestsPerMinute = apiUsage.Requests
+		}
+		if apiUsage.RateLimited > 0 && (out.LastRateLimitedAt == nil || apiUsage.StartedAt.After(*out.LastRateLimitedAt)) {
+			startedAt := apiUsage.StartedAt
+			out.LastRateLimitedAt = &startedAt
+		}
+	}
+	return out
+}
+
+// webhookUsage 는 webhook 별 성공률과 종류별 합계를 계산한다.
+func webhookUsage(webhooks []domain.WebhookUsage) domain.OrganizationUsageResponse {
+	summary := []domain.WebhookUsageSummary{
+		{Kind: domain.WebhookKind_ALERT_CHANNEL},
+		{Kind: domain.WebhookKind_AUDIT_SINK},
+		{Kind: domain.WebhookKind_STACK_WEBHOOK},
+	}
+	for i := range webhooks {
+		webhooks[i].SuccessRate = successRate(webhooks[i].SucceededCount, webhooks[i].FailedCount)
+		for j := range summary {
+			if summary[j].Kind == webhooks[i].Kind {
+				summary[j].SucceededCount += webhooks[i].SucceededCount
+				summary[j].FailedCount += webhooks[i].FailedCount
+			}
+		}
+	}
+	for j := range summary {
+		summary[j].SuccessRate = successRate(summary[j].SucceededCount, summary[j].FailedCount)
+	}
+	return domain.OrganizationUsageResponse{Webhooks: webhooks, Summary: summary}
+}
+
+func successRate(succeeded int64, failed int64) *float64 {
+	if succeeded+failed == 0 {
+		return nil
+	}
+	rate := float64(succeeded) / float64(succeeded+failed)
+	return &rate
+}
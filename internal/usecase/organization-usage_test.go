@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/testing/fakekeycloak"
+	"github.com/openinfradev/tks-api/internal/testing/memrepo"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+func TestOrganizationGetUsage(t *testing.T) {
+	ctx := context.Background()
+	const otherOrganizationId = "o5678othr"
+	viper.Set("api-rate-limit-per-minute", 100)
+	t.Cleanup(func() { viper.Set("api-rate-limit-per-minute", 0) })
+
+	repo := memrepo.New()
+	if _, err := repo.Organization.Create(ctx, &model.Organization{ID: testOrganizationId, Name: testOrganizationId}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.AlertChannel.Create(ctx, model.AlertChannel{OrganizationId: testOrganizationId, Name: "slack", SucceededCount: 3, FailedCount: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.AuditSink.Create(ctx, model.AuditSink{OrganizationId: testOrganizationId, Name: "siem"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.StackWebhook.Create(ctx, model.StackWebhook{OrganizationId: testOrganizationId, Name: "ci", SucceededCount: 1, FailedCount: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now().Truncate(time.Minute)
+	increment := func(organizationId string, startedAt time.Time, requests int, rateLimited int) {
+		t.Helper()
+		for i := 0; i < requests; i++ {
+			if _, err := repo.ApiUsage.Increment(ctx, organizationId, startedAt); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for i := 0; i < rateLimited; i++ {
+			if err := repo.ApiUsage.IncrementRateLimited(ctx, organizationId, startedAt); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	increment(testOrganizationId, now, 3, 1)
+	increment(testOrganizationId, now.Truncate(time.Hour).Add(-2*time.Hour), 5, 0)
+	increment(testOrganizationId, now.Add(-25*time.Hour), 7, 7)
+	increment(otherOrganizationId, now, 11, 11)
+
+	u := usecase.NewOrganizationUsecase(repo, nil, fakekeycloak.New(), usecase.NewCacheInvalidator())
+	usage, err := u.GetUsage(ctx, testOrganizationId)
+	if err != nil {
+		t.Fatalf("GetUsage() error = %v", err)
+	}
+
+	api := usage.Api
+	if api.RateLimitPerMinute != 100 || api.Requests != 8 || api.RateLimited != 1 || api.PeakRequestsPerMinute != 5 {
+		t.Errorf("api usage = %d limit, %d requests, %d rate limited, %d peak, want 100, 8, 1, 5",
+			api.RateLimitPerMinute, api.Requests, api.RateLimited, api.PeakRequestsPerMinute)
+	}
+	if api.LastRateLimitedAt == nil || !api.LastRateLimitedAt.Equal(now) {
+		t.Errorf("lastRateLimitedAt = %v, want %v", api.LastRateLimitedAt, now)
+	}
+	if len(api.Hourly) != 24 {
+		t.Fatalf("hourly = %d buckets, want 24", len(api.Hourly))
+	}
+	if last := api.Hourly[23]; !last.StartedAt.Equal(now.Truncate(time.Hour)) || last.Requests != 3 || last.RateLimited != 1 {
+		t.Errorf("last hour = %+v, want 3 requests and 1 rate limited from %v", last, now.Truncate(time.Hour))
+	}
+	if hour := api.Hourly[21]; hour.Requests != 5 || hour.RateLimited != 0 {
+		t.Errorf("2 hours ago = %+v, want 5 requests", hour)
+	}
+
+	successRates := map[string]*float64{}
+	for _, summary := range usage.Summary {
+		successRates[summary.Kind] = summary.SuccessRate
+	}
+	if rate := successRates[domain.WebhookKind_ALERT_CHANNEL]; rate == nil || *rate != 0.75 {
+		t.Errorf("alert channel success rate = %v, want 0.75", rate)
+	}
+	if rate := successRates[domain.WebhookKind_STACK_WEBHOOK]; rate == nil || *rate != 0.5 {
+		t.Errorf("stack webhook success rate = %v, want 0.5", rate)
+	}
+	if rate := successRates[domain.WebhookKind_AUDIT_SINK]; rate != nil {
+		t.Errorf("audit sink success rate = %v, want nil without deliveries", *rate)
+	}
+	if len(usage.Webhooks) != 3 {
+		t.Errorf("webhooks = %d, want 3", len(usage.Webhooks))
+	}
+}
+
+func TestOrganizationGetUsageWithoutRequests(t *testing.T) {
+	ctx := context.Background()
+	repo := memrepo.New()
+	if _, err := repo.Organization.Create(ctx, &model.Organization{ID: testOrganizationId, Name: testOrganizationId}); err != nil {
+		t.Fatal(err)
+	}
+
+	u := usecase.NewOrganizationUsecase(repo, nil, fakekeycloak.New(), usecase.NewCacheInvalidator())
+	usage, err := u.GetUsage(ctx, testOrganizationId)
+	if err != nil {
+		t.Fatalf("GetUsage() error = %v", err)
+	}
+	if usage.Api.RateLimitPerMinute != 0 || usage.Api.Requests != 0 || usage.Api.LastRateLimitedAt != nil || len(usage.Api.Hourly) != 24 {
+		t.Errorf("api usage = %+v, want no requests in 24 hourly buckets without a rate limit", usage.Api)
+	}
+
+	if _, err := u.GetUsage(ctx, "o0000none"); err == nil {
+		t.Errorf("GetUsage() of an unknown organization should fail")
+	}
+}
+
+func TestOrganizationPurgeApiUsages(t *testing.T) {
+	ctx := context.Background()
+	viper.Set("api-usage-retention-days", 1)
+	t.Cleanup(func() { viper.Set("api-usage-retention-days", 0) })
+
+	repo := memrepo.New()
+	now := time.Now().Truncate(time.Minute)
+	for _, startedAt := range []time.Time{now, now.AddDate(0, 0, -2)} {
+		if _, err := repo.ApiUsage.Increment(ctx, testOrganizationId, startedAt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	u := usecase.NewOrganizationUsecase(repo, nil, fakekeycloak.New(), usecase.NewCacheInvalidator())
+	if err := u.PurgeApiUsages(ctx); err != nil {
+		t.Fatalf("PurgeApiUsages() error = %v", err)
+	}
+	apiUsages, err := repo.ApiUsage.Fetch(ctx, testOrganizationId, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apiUsages) != 1 || !apiUsages[0].StartedAt.Equal(now) {
+		t.Errorf("api usages after purge = %+v, want only the usage of %v", apiUsages, now)
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/keycloak"
+	"github.com/openinfradev/tks-api/internal/mail"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
@@ -27,7 +29,18 @@ type IOrganizationUsecase interface {
 	Update(ctx context.Context, organizationId string, dto model.Organization) (model.Organization, error)
 	UpdatePrimaryClusterId(ctx context.Context, organizationId string, clusterId string) (err error)
 	ChangeAdminId(ctx context.Context, organizationId string, adminId uuid.UUID) error
-	Delete(ctx context.Context, organizationId string, accessToken string) error
+	Delete(ctx context.Context, organizationId string, accessToken string, dryRun bool) (domain.DeleteOrganizationResponse, error)
+	GetOnboarding(ctx context.Context, organizationId string) ([]domain.OnboardingStepResponse, error)
+	GetIdentityStatus(ctx context.Context, organizationId string) (domain.DashboardIdentityStatus, error)
+	GetBranding(ctx context.Context, organizationId string) (model.OrganizationBranding, error)
+	UpdateBranding(ctx context.Context, dto model.OrganizationBranding) error
+	GetMailBranding(ctx context.Context, organizationId string) mail.Branding
+	GetNamingPolicies(ctx context.Context, organizationId string) ([]model.NamingPolicy, error)
+	UpdateNamingPolicy(ctx context.Context, dto model.NamingPolicy) error
+	GetQuota(ctx context.Context, organizationId string) (model.OrganizationQuota, domain.OrganizationQuotaUsage, error)
+	UpdateQuota(ctx context.Context, dto model.OrganizationQuota) error
+	GetUsage(ctx context.Context, organizationId string) (domain.OrganizationUsageResponse, error)
+	PurgeApiUsages(ctx context.Context) error
 }
 
 type OrganizationUsecase struct {
@@ -38,11 +51,42 @@ type OrganizationUsecase struct {
 	stackTemplateRepo              repository.IStackTemplateRepository
 	systemNotificationRuleRepo     repository.ISystemNotificationRuleRepository
 	systemNotificationTemplateRepo repository.ISystemNotificationTemplateRepository
+	appGroupRepo                   repository.IAppGroupRepository
+	onboardingRepo                 repository.IOrganizationOnboardingRepository
+	brandingRepo                   repository.IOrganizationBrandingRepository
+	namingPolicyRepo               repository.INamingPolicyRepository
+	quotaRepo                      repository.IOrganizationQuotaRepository
+	operationRepo                  repository.IOperationRepository
+	cloudAccountRepo               repository.ICloudAccountRepository
+	systemNotificationRepo         repository.ISystemNotificationRepository
+	alertSilenceRepo               repository.IAlertSilenceRepository
+	alertRoutingRuleRepo           repository.IAlertRoutingRuleRepository
+	alertChannelRepo               repository.IAlertChannelRepository
+	stackWebhookRepo               repository.IStackWebhookRepository
+	projectRepo                    repository.IProjectRepository
+	chartSnapshotRepo              repository.IChartSnapshotRepository
+	customChartRepo                repository.ICustomChartRepository
+	alertIngestionTokenRepo        repository.IAlertIngestionTokenRepository
+	escalationPolicyRepo           repository.IEscalationPolicyRepository
+	notificationDigestRepo         repository.INotificationDigestRepository
+	maintenanceWindowRepo          repository.IMaintenanceWindowRepository
+	appServeAppRepo                repository.IAppServeAppRepository
+	gitProviderRepo                repository.IGitProviderRepository
+	catalogRepo                    repository.ICatalogRepository
+	lmaEndpointRepo                repository.ILmaEndpointRepository
+	auditSinkRepo                  repository.IAuditSinkRepository
+	encryptionKeyRepo              repository.IEncryptionKeyRepository
+	costAllocationTagRepo          repository.ICostAllocationTagRepository
+	userSessionRepo                repository.IUserSessionRepository
+	passwordPolicyRepo             repository.IPasswordPolicyRepository
+	stackDefaultRepo               repository.IStackDefaultRepository
+	apiUsageRepo                   repository.IApiUsageRepository
 	argo                           argowf.ArgoClient
 	kc                             keycloak.IKeycloak
+	cacheInvalidator               ICacheInvalidator
 }
 
-func NewOrganizationUsecase(r repository.Repository, argoClient argowf.ArgoClient, kc keycloak.IKeycloak) IOrganizationUsecase {
+func NewOrganizationUsecase(r repository.Repository, argoClient argowf.ArgoClient, kc keycloak.IKeycloak, cacheInvalidator ICacheInvalidator) IOrganizationUsecase {
 	return &OrganizationUsecase{
 		repo:                           r.Organization,
 		userRepo:                       r.User,
@@ -51,8 +95,39 @@ func NewOrganizationUsecase(r repository.Repository, argoClient argowf.ArgoClien
 		stackTemplateRepo:              r.StackTemplate,
 		systemNotificationRuleRepo:     r.SystemNotificationRule,
 		systemNotificationTemplateRepo: r.SystemNotificationTemplate,
+		appGroupRepo:                   r.AppGroup,
+		onboardingRepo:                 r.OrganizationOnboarding,
+		brandingRepo:                   r.OrganizationBranding,
+		namingPolicyRepo:               r.NamingPolicy,
+		quotaRepo:                      r.OrganizationQuota,
+		operationRepo:                  r.Operation,
+		cloudAccountRepo:               r.CloudAccount,
+		systemNotificationRepo:         r.SystemNotification,
+		alertSilenceRepo:               r.AlertSilence,
+		alertRoutingRuleRepo:           r.AlertRoutingRule,
+		alertChannelRepo:               r.AlertChannel,
+		stackWebhookRepo:               r.StackWebhook,
+		projectRepo:                    r.Project,
+		chartSnapshotRepo:              r.ChartSnapshot,
+		customChartRepo:                r.CustomChart,
+		alertIngestionTokenRepo:        r.AlertIngestionToken,
+		escalationPolicyRepo:           r.EscalationPolicy,
+		notificationDigestRepo:         r.NotificationDigest,
+		maintenanceWindowRepo:          r.MaintenanceWindow,
+		appServeAppRepo:                r.AppServeApp,
+		gitProviderRepo:                r.GitProvider,
+		catalogRepo:                    r.Catalog,
+		lmaEndpointRepo:                r.LmaEndpoint,
+		auditSinkRepo:                  r.AuditSink,
+		encryptionKeyRepo:              r.EncryptionKey,
+		costAllocationTagRepo:          r.CostAllocationTag,
+		userSessionRepo:                r.UserSession,
+		passwordPolicyRepo:             r.PasswordPolicy,
+		stackDefaultRepo:               r.StackDefault,
+		apiUsageRepo:                   r.ApiUsage,
 		argo:                           argoClient,
 		kc:                             kc,
+		cacheInvalidator:               cacheInvalidator,
 	}
 }
 
@@ -146,37 +221,6 @@ func (u *OrganizationUsecase) Get(ctx context.Context, organizationId string) (o
 
 }
 
-func (u *OrganizationUsecase) Delete(ctx context.Context, organizationId string, accessToken string) (err error) {
-	_, err = u.Get(ctx, organizationId)
-	if err != nil {
-		return err
-	}
-
-	// Delete realm in keycloak
-	if err := u.kc.DeleteRealm(ctx, organizationId); err != nil {
-		return err
-	}
-
-	// delete roles in DB
-	roles, err := u.roleRepo.ListTksRoles(ctx, organizationId, nil)
-	if err != nil {
-		return err
-	}
-	for _, role := range roles {
-		if err := u.roleRepo.Delete(ctx, role.ID); err != nil {
-			return err
-		}
-	}
-
-	// delete organization in DB
-	err = u.repo.Delete(ctx, organizationId)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (u *OrganizationUsecase) Update(ctx context.Context, organizationId string, in model.Organization) (model.Organization, error) {
 	_, err := u.Get(ctx, organizationId)
 	if err != nil {
@@ -187,6 +231,7 @@ func (u *OrganizationUsecase) Update(ctx context.Context, organizationId string,
 	if err != nil {
 		return model.Organization{}, err
 	}
+	u.cacheInvalidator.Invalidate(ctx, CacheEvent{Kind: CacheEvent_ORGANIZATION_UPDATED, OrganizationId: organizationId})
 
 	return res, nil
 }
@@ -205,9 +250,60 @@ func (u *OrganizationUsecase) UpdatePrimaryClusterId(ctx context.Context, organi
 	if err != nil {
 		return err
 	}
+	u.cacheInvalidator.Invalidate(ctx, CacheEvent{Kind: CacheEvent_PRIMARY_CLUSTER_CHANGED, OrganizationId: organizationId})
+
+	if clusterId != "" {
+		if err := u.onboardingRepo.Complete(ctx, organizationId, domain.OnboardingStep_PRIMARY_STACK); err != nil {
+			log.Error(ctx, err)
+		}
+	}
 	return nil
 }
 
+func (u *OrganizationUsecase) GetOnboarding(ctx context.Context, organizationId string) (out []domain.OnboardingStepResponse, err error) {
+	organization, err := u.Get(ctx, organizationId)
+	if err != nil {
+		return nil, httpErrors.NewNotFoundError(err, "", "")
+	}
+
+	onboardings, err := u.onboardingRepo.Fetch(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	completed := make(map[domain.OnboardingStep]time.Time)
+	for _, onboarding := range onboardings {
+		completed[onboarding.Step] = onboarding.CompletedAt
+	}
+
+	// LMA 는 workflow 에서 설치되므로 조회 시점에 primary cluster 의 LMA 상태를 확인한다.
+	if _, ok := completed[domain.OnboardingStep_LMA]; !ok && organization.PrimaryClusterId != "" {
+		appGroups, err := u.appGroupRepo.Fetch(ctx, domain.ClusterId(organization.PrimaryClusterId), nil)
+		if err != nil {
+			log.Error(ctx, err)
+		}
+		for _, appGroup := range appGroups {
+			if appGroup.AppGroupType == domain.AppGroupType_LMA && appGroup.Status == domain.AppGroupStatus_RUNNING {
+				if err := u.onboardingRepo.Complete(ctx, organizationId, domain.OnboardingStep_LMA); err != nil {
+					log.Error(ctx, err)
+					break
+				}
+				completed[domain.OnboardingStep_LMA] = time.Now()
+				break
+			}
+		}
+	}
+
+	out = make([]domain.OnboardingStepResponse, len(domain.OnboardingSteps))
+	for i, step := range domain.OnboardingSteps {
+		out[i].Step = step
+		if completedAt, ok := completed[step]; ok {
+			out[i].Completed = true
+			out[i].CompletedAt = &completedAt
+		}
+	}
+	return out, nil
+}
+
 func (u *OrganizationUsecase) ChangeAdminId(ctx context.Context, organizationId string, adminId uuid.UUID) error {
 	_, err := u.Get(ctx, organizationId)
 	if err != nil {
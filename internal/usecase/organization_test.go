@@ -0,0 +1,168 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/internal/testing/fakekeycloak"
+	"github.com/openinfradev/tks-api/internal/testing/memrepo"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+)
+
+type organizationRecord struct {
+	resource string
+	seed     func(ctx context.Context, organizationId string) error
+	count    func(ctx context.Context, organizationId string) (int64, error)
+}
+
+func organizationRecords(repo repository.Repository) []organizationRecord {
+	return []organizationRecord{
+		{"chart-snapshots", func(ctx context.Context, organizationId string) error {
+			_, err := repo.ChartSnapshot.Create(ctx, model.ChartSnapshot{OrganizationId: organizationId})
+			return err
+		}, repo.ChartSnapshot.CountByOrganizationId},
+		{"custom-charts", func(ctx context.Context, organizationId string) error {
+			_, err := repo.CustomChart.Create(ctx, model.CustomChart{OrganizationId: organizationId})
+			return err
+		}, repo.CustomChart.CountByOrganizationId},
+		{"alert-ingestion-tokens", func(ctx context.Context, organizationId string) error {
+			_, err := repo.AlertIngestionToken.Create(ctx, model.AlertIngestionToken{OrganizationId: organizationId})
+			return err
+		}, repo.AlertIngestionToken.CountByOrganizationId},
+		{"escalation-policies", func(ctx context.Context, organizationId string) error {
+			_, err := repo.EscalationPolicy.Create(ctx, model.EscalationPolicy{OrganizationId: organizationId})
+			return err
+		}, repo.EscalationPolicy.CountByOrganizationId},
+		{"notification-digests", func(ctx context.Context, organizationId string) error {
+			return repo.NotificationDigest.SaveSettings(ctx, []model.NotificationDigestSetting{
+				{UserId: uuid.New(), Channel: domain.NotificationChannel_EMAIL, OrganizationId: organizationId},
+			})
+		}, repo.NotificationDigest.CountByOrganizationId},
+		{"maintenance-windows", func(ctx context.Context, organizationId string) error {
+			_, err := repo.MaintenanceWindow.Create(ctx, model.MaintenanceWindow{OrganizationId: organizationId})
+			return err
+		}, repo.MaintenanceWindow.CountByOrganizationId},
+		{"app-serve-app-configs", func(ctx context.Context, organizationId string) error {
+			appId, err := repo.AppServeApp.CreateAppServeApp(ctx, &model.AppServeApp{OrganizationId: organizationId})
+			if err != nil {
+				return err
+			}
+			_, err = repo.AppServeApp.CreateConfig(ctx, model.AppServeAppConfig{AppServeAppId: appId, Secrets: []byte("sealed")})
+			return err
+		}, repo.AppServeApp.CountConfigsByOrganizationId},
+		{"git-providers", func(ctx context.Context, organizationId string) error {
+			_, err := repo.GitProvider.Create(ctx, model.GitProvider{OrganizationId: organizationId})
+			return err
+		}, repo.GitProvider.CountByOrganizationId},
+		{"catalog-apps", func(ctx context.Context, organizationId string) error {
+			return repo.Catalog.CreateApp(ctx, model.CatalogApp{OrganizationId: organizationId})
+		}, repo.Catalog.CountAppsByOrganizationId},
+		{"lma-endpoints", func(ctx context.Context, organizationId string) error {
+			_, err := repo.LmaEndpoint.Create(ctx, model.LmaEndpoint{OrganizationId: organizationId})
+			return err
+		}, repo.LmaEndpoint.CountByOrganizationId},
+		{"audit-sinks", func(ctx context.Context, organizationId string) error {
+			_, err := repo.AuditSink.Create(ctx, model.AuditSink{OrganizationId: organizationId})
+			return err
+		}, repo.AuditSink.CountByOrganizationId},
+		{"encryption-keys", func(ctx context.Context, organizationId string) error {
+			_, err := repo.EncryptionKey.Create(ctx, model.EncryptionKey{OrganizationId: organizationId})
+			return err
+		}, repo.EncryptionKey.CountByOrganizationId},
+		{"cost-allocation-tag-policies", func(ctx context.Context, organizationId string) error {
+			return repo.CostAllocationTag.Replace(ctx, organizationId, []model.CostAllocationTagPolicy{{OrganizationId: organizationId, Key: "team"}})
+		}, repo.CostAllocationTag.CountByOrganizationId},
+		{"stack-defaults", func(ctx context.Context, organizationId string) error {
+			return repo.StackDefault.Upsert(ctx, model.StackDefault{OrganizationId: organizationId})
+		}, repo.StackDefault.CountByOrganizationId},
+		{"naming-policies", func(ctx context.Context, organizationId string) error {
+			return repo.NamingPolicy.Upsert(ctx, model.NamingPolicy{OrganizationId: organizationId, ResourceType: "stack"})
+		}, repo.NamingPolicy.CountByOrganizationId},
+		{"password-policies", func(ctx context.Context, organizationId string) error {
+			return repo.PasswordPolicy.Upsert(ctx, model.PasswordPolicy{OrganizationId: organizationId, MinLength: 12})
+		}, repo.PasswordPolicy.CountByOrganizationId},
+		{"user-sessions", func(ctx context.Context, organizationId string) error {
+			_, err := repo.UserSession.Create(ctx, model.UserSession{OrganizationId: organizationId, UserId: uuid.New()})
+			return err
+		}, repo.UserSession.CountByOrganizationId},
+		{"api-usages", func(ctx context.Context, organizationId string) error {
+			_, err := repo.ApiUsage.Increment(ctx, organizationId, time.Now().Truncate(time.Minute))
+			return err
+		}, repo.ApiUsage.CountByOrganizationId},
+		{"organization-branding", func(ctx context.Context, organizationId string) error {
+			return repo.OrganizationBranding.Upsert(ctx, model.OrganizationBranding{OrganizationId: organizationId})
+		}, repo.OrganizationBranding.CountByOrganizationId},
+		{"organization-quotas", func(ctx context.Context, organizationId string) error {
+			return repo.OrganizationQuota.Upsert(ctx, model.OrganizationQuota{OrganizationId: organizationId, MaxUsers: 10})
+		}, repo.OrganizationQuota.CountByOrganizationId},
+		{"organization-onboarding", func(ctx context.Context, organizationId string) error {
+			return repo.OrganizationOnboarding.Complete(ctx, organizationId, domain.OnboardingStep_USER)
+		}, repo.OrganizationOnboarding.CountByOrganizationId},
+	}
+}
+
+func TestOrganizationDeleteFlushesOrganizationRecords(t *testing.T) {
+	ctx := context.Background()
+	const otherOrganizationId = "o5678othr"
+
+	repo := memrepo.New()
+	kc := fakekeycloak.New()
+	for _, organizationId := range []string{testOrganizationId, otherOrganizationId} {
+		if _, err := repo.Organization.Create(ctx, &model.Organization{ID: organizationId, Name: organizationId}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := kc.CreateRealm(ctx, organizationId); err != nil {
+			t.Fatal(err)
+		}
+	}
+	records := organizationRecords(repo)
+	for _, record := range records {
+		for _, organizationId := range []string{testOrganizationId, otherOrganizationId} {
+			if err := record.seed(ctx, organizationId); err != nil {
+				t.Fatalf("seed %s: %v", record.resource, err)
+			}
+		}
+	}
+
+	u := usecase.NewOrganizationUsecase(repo, nil, kc, usecase.NewCacheInvalidator())
+
+	plan, err := u.Delete(ctx, testOrganizationId, "", true)
+	if err != nil {
+		t.Fatalf("Delete(dryRun) error = %v", err)
+	}
+	planned := map[string]domain.OrganizationDeletionStepResponse{}
+	for _, step := range plan.Steps {
+		planned[step.Resource] = step
+	}
+	for _, record := range records {
+		step, ok := planned[record.resource]
+		if !ok {
+			t.Errorf("deletion plan has no %s step", record.resource)
+			continue
+		}
+		if step.Action != domain.OrganizationDeletionAction_DELETE || step.Count != 1 {
+			t.Errorf("%s step = %s %d, want DELETE 1", record.resource, step.Action, step.Count)
+		}
+	}
+
+	if _, err := u.Delete(ctx, testOrganizationId, "", false); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	for _, record := range records {
+		if count, err := record.count(ctx, testOrganizationId); err != nil || count != 0 {
+			t.Errorf("%s of deleted organization = %d, %v, want 0", record.resource, count, err)
+		}
+		if count, err := record.count(ctx, otherOrganizationId); err != nil || count != 1 {
+			t.Errorf("%s of other organization = %d, %v, want 1", record.resource, count, err)
+		}
+	}
+	if _, err := repo.Organization.Get(ctx, testOrganizationId); err == nil {
+		t.Errorf("organization %s was not deleted", testOrganizationId)
+	}
+}
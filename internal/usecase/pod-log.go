@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultPodLogTailLines = 1000
+	maxPodLogTailLines     = 10000
+	// follow 하지 않는 조회는 응답 크기를 제한한다.
+	maxPodLogBytes = 10 * 1024 * 1024
+
+	defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+)
+
+// GetPodLogs 는 pod 의 container 로그 stream 을 반환한다. 호출한 쪽에서 stream 을 닫아야 한다.
+// follow 이면 ctx 가 끝나거나 container 가 종료될 때까지 stream 이 이어진다.
+func (u *ClusterUsecase) GetPodLogs(ctx context.Context, organizationId string, clusterId domain.ClusterId, namespace string, podName string, req domain.GetPodLogsRequest) (io.ReadCloser, error) {
+	if req.TailLines == nil && req.SinceSeconds == nil {
+		tailLines := int64(defaultPodLogTailLines)
+		req.TailLines = &tailLines
+	}
+	if req.TailLines != nil && (*req.TailLines < 0 || *req.TailLines > maxPodLogTailLines) {
+		return nil, httpErrors.NewError(fmt.Errorf("tailLines must be between 0 and %d", maxPodLogTailLines), "CL_INVALID_CLUSTER_RESOURCE_QUERY")
+	}
+	if req.SinceSeconds != nil && *req.SinceSeconds <= 0 {
+		return nil, httpErrors.NewError(fmt.Errorf("since must be positive"), "CL_INVALID_CLUSTER_RESOURCE_QUERY")
+	}
+
+	_, clientset, _, err := u.getClusterResourceClient(ctx, organizationId, clusterId, domain.ListClusterResourcesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, httpErrors.NewError(err, "CL_NOT_FOUND_POD")
+		}
+		return nil, httpErrors.NewError(err, "CL_FAILED_FETCH_CLUSTER_RESOURCES")
+	}
+
+	container, err := getPodLogContainer(*pod, req.Container)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &corev1.PodLogOptions{
+		Container:    container,
+		TailLines:    req.TailLines,
+		SinceSeconds: req.SinceSeconds,
+		Follow:       req.Follow,
+		Previous:     req.Previous,
+		Timestamps:   req.Timestamps,
+	}
+	if !req.Follow {
+		limitBytes := int64(maxPodLogBytes)
+		opts.LimitBytes = &limitBytes
+	}
+	stream, err := clientset.CoreV1().Pods(namespace).GetLogs(podName, opts).Stream(ctx)
+	if err != nil {
+		if k8serrors.IsBadRequest(err) {
+			return nil, httpErrors.NewError(err, "CL_INVALID_CLUSTER_RESOURCE_QUERY")
+		}
+		return nil, httpErrors.NewError(err, "CL_FAILED_FETCH_CLUSTER_RESOURCES")
+	}
+	return stream, nil
+}
+
+// getPodLogContainer 는 kubectl logs 와 같이 container 를 지정하지 않으면 default-container annotation, 첫 번째 container 순으로 선택한다.
+func getPodLogContainer(pod corev1.Pod, container string) (string, error) {
+	if container == "" {
+		container = pod.Annotations[defaultContainerAnnotation]
+	}
+	if container == "" && len(pod.Spec.Containers) > 0 {
+		container = pod.Spec.Containers[0].Name
+	}
+
+	names := []string{}
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, c := range containers {
+			if c.Name == container {
+				return container, nil
+			}
+			names = append(names, c.Name)
+		}
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		if c.Name == container {
+			return container, nil
+		}
+		names = append(names, c.Name)
+	}
+	return "", httpErrors.NewError(fmt.Errorf("container %s is not found in pod %s. containers : %v", container, pod.Name, names), "CL_INVALID_CLUSTER_RESOURCE_QUERY")
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	podSearchConcurrency    = 8
+	podSearchClusterTimeout = 10 * time.Second
+	defaultPodSearchLimit   = 100
+	maxPodSearchLimit       = 500
+)
+
+// SearchPods 는 조직의 RUNNING cluster 들에서 동시에 pod 를 검색한다.
+// 동시에 조회하는 cluster 수를 제한하고, 응답하지 않는 cluster 는 제한 시간이 지나면 오류로 기록한 뒤 나머지 결과를 반환한다.
+func (u *ClusterUsecase) SearchPods(ctx context.Context, organizationId string, req domain.SearchPodsRequest) (out domain.SearchPodsResponse, err error) {
+	req.Name = strings.ToLower(strings.TrimSpace(req.Name))
+	if req.Name == "" && req.LabelSelector == "" {
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("name or labelSelector is required"), "CL_INVALID_POD_SEARCH_QUERY", "")
+	}
+	if req.LabelSelector != "" {
+		if _, err := labels.Parse(req.LabelSelector); err != nil {
+			return out, httpErrors.NewBadRequestError(err, "CL_INVALID_POD_SEARCH_QUERY", "")
+		}
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultPodSearchLimit
+	}
+	if req.Limit > maxPodSearchLimit {
+		req.Limit = maxPodSearchLimit
+	}
+
+	clusters, err := u.repo.FetchByOrganizationId(ctx, organizationId, uuid.Nil, nil)
+	if err != nil {
+		return out, err
+	}
+
+	targets := []model.Cluster{}
+	for _, cluster := range clusters {
+		if cluster.Status == domain.ClusterStatus_RUNNING {
+			targets = append(targets, cluster)
+		}
+	}
+
+	results := make([][]domain.PodSearchResult, len(targets))
+	out.Clusters = make([]domain.PodSearchCluster, len(targets))
+	sem := make(chan struct{}, podSearchConcurrency)
+	wg := sync.WaitGroup{}
+	for i, cluster := range targets {
+		out.Clusters[i] = domain.PodSearchCluster{ClusterId: cluster.ID.String(), ClusterName: cluster.Name}
+
+		wg.Add(1)
+		go func(i int, cluster model.Cluster) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			pods, err := searchPodsInCluster(ctx, cluster, req)
+			if err != nil {
+				log.Warnf(ctx, "failed to search pods in cluster %s. err : %s", cluster.ID, err)
+				out.Clusters[i].Error = err.Error()
+				return
+			}
+			results[i] = pods
+			out.Clusters[i].Count = len(pods)
+		}(i, cluster)
+	}
+	wg.Wait()
+
+	out.Pods = []domain.PodSearchResult{}
+	for _, pods := range results {
+		out.Pods = append(out.Pods, pods...)
+	}
+	sort.SliceStable(out.Pods, func(i, j int) bool {
+		a, b := out.Pods[i], out.Pods[j]
+		if a.ClusterName != b.ClusterName {
+			return a.ClusterName < b.ClusterName
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	if len(out.Pods) > req.Limit {
+		out.Pods = out.Pods[:req.Limit]
+		out.Truncated = true
+	}
+	return out, nil
+}
+
+func searchPodsInCluster(ctx context.Context, cluster model.Cluster, req domain.SearchPodsRequest) ([]domain.PodSearchResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, podSearchClusterTimeout)
+	defer cancel()
+
+	clientset, err := kubernetes.GetCachedClientFromClusterId(ctx, cluster.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// 이름은 부분 일치로 찾아야 하므로 api server 에서 거를 수 없다. watch cache 에서 응답하도록 ResourceVersion 을 0 으로 지정한다.
+	pods, err := clientset.CoreV1().Pods(req.Namespace).List(ctx, metav1.ListOptions{LabelSelector: req.LabelSelector, ResourceVersion: "0"})
+	if err != nil {
+		return nil, err
+	}
+
+	out := []domain.PodSearchResult{}
+	for _, pod := range pods.Items {
+		if req.Name != "" && !strings.Contains(strings.ToLower(pod.Name), req.Name) {
+			continue
+		}
+		out = append(out, newPodSearchResult(cluster, pod))
+	}
+	return out, nil
+}
+
+func newPodSearchResult(cluster model.Cluster, pod corev1.Pod) domain.PodSearchResult {
+	ready, restarts := 0, int32(0)
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Ready {
+			ready++
+		}
+		restarts += status.RestartCount
+	}
+	return domain.PodSearchResult{
+		ClusterId:   cluster.ID.String(),
+		ClusterName: cluster.Name,
+		Namespace:   pod.Namespace,
+		Name:        pod.Name,
+		Status:      getPodStatus(pod),
+		Ready:       fmt.Sprintf("%d/%d", ready, len(pod.Spec.Containers)),
+		Restarts:    restarts,
+		Node:        pod.Spec.NodeName,
+		Labels:      pod.Labels,
+		CreatedAt:   pod.CreationTimestamp.Time,
+	}
+}
+
+// getPodStatus 는 kubectl get pods 의 STATUS 와 같이 phase 보다 구체적인 container 상태를 우선한다.
+func getPodStatus(pod corev1.Pod) string {
+	if pod.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+	if pod.Status.Reason != "" {
+		return pod.Status.Reason
+	}
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+			return "Init:" + status.State.Waiting.Reason
+		}
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+			return status.State.Waiting.Reason
+		}
+		if status.State.Terminated != nil && status.State.Terminated.Reason != "" {
+			return status.State.Terminated.Reason
+		}
+	}
+	return string(pod.Status.Phase)
+}
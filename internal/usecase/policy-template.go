@@ -68,7 +68,7 @@ func NewPolicyTemplateUsecase(r repository.Repository) IPolicyTemplateUsecase {
 func (u *PolicyTemplateUsecase) Create(ctx context.Context, dto model.PolicyTemplate) (policyTemplateId uuid.UUID, err error) {
 	user, ok := request.UserFrom(ctx)
 	if !ok {
-		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN")
 	}
 
 	if dto.IsTksTemplate() {
@@ -94,7 +94,7 @@ func (u *PolicyTemplateUsecase) Create(ctx context.Context, dto model.PolicyTemp
 	}
 
 	if err := policytemplate.ValidateParamDefs(dto.ParametersSchema); err != nil {
-		return uuid.Nil, httpErrors.NewBadRequestError(err, "PT_INVALID_PARAMETER_SCHEMA", "")
+		return uuid.Nil, httpErrors.NewError(err, "PT_INVALID_PARAMETER_SCHEMA")
 	}
 
 	if dto.IsTksTemplate() {
@@ -106,7 +106,7 @@ func (u *PolicyTemplateUsecase) Create(ctx context.Context, dto model.PolicyTemp
 		for i, organizationId := range dto.PermittedOrganizationIds {
 			organization, err := u.organizationRepo.Get(ctx, organizationId)
 			if err != nil {
-				return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+				return uuid.Nil, httpErrors.NewError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
 			}
 			dto.PermittedOrganizations[i] = organization
 		}
@@ -260,9 +260,9 @@ func (u *PolicyTemplateUsecase) Get(ctx context.Context, organizationId *string,
 	}
 
 	if !policyTemplate.IsPermittedToOrganization(organizationId) {
-		return nil, httpErrors.NewNotFoundError(fmt.Errorf(
+		return nil, httpErrors.NewError(fmt.Errorf(
 			"policy template not found"),
-			"PT_NOT_FOUND_POLICY_TEMPLATE", "")
+			"PT_NOT_FOUND_POLICY_TEMPLATE")
 	}
 
 	if policyTemplate.IsTksTemplate() && len(policyTemplate.PermittedOrganizations) == 0 {
@@ -290,22 +290,22 @@ func (u *PolicyTemplateUsecase) Update(ctx context.Context, organizationId *stri
 	}
 
 	if policyTemplate == nil {
-		return httpErrors.NewBadRequestError(fmt.Errorf(
+		return httpErrors.NewError(fmt.Errorf(
 			"failed to fetch policy template"),
-			"PT_FAILED_FETCH_POLICY_TEMPLATE", "")
+			"PT_FAILED_FETCH_POLICY_TEMPLATE")
 	}
 
 	if !policyTemplate.IsPermittedToOrganization(organizationId) {
 		// 다른 Organization의 템플릿을 조작하려고 함, 보안을 위해서 해당 식별자 존재 자체를 알려주면 안되므로 not found
 		if *organizationId != *policyTemplate.OrganizationId {
-			return httpErrors.NewNotFoundError(fmt.Errorf(
+			return httpErrors.NewError(fmt.Errorf(
 				"policy template not found"),
-				"PT_NOT_FOUND_POLICY_TEMPLATE", "")
+				"PT_NOT_FOUND_POLICY_TEMPLATE")
 		}
 
-		return httpErrors.NewForbiddenError(fmt.Errorf(
+		return httpErrors.NewError(fmt.Errorf(
 			"cannot update policy template"),
-			"PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE", "")
+			"PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE")
 	}
 
 	updateMap := make(map[string]interface{})
@@ -347,7 +347,7 @@ func (u *PolicyTemplateUsecase) Update(ctx context.Context, organizationId *stri
 		for i, organizationId := range *permittedOrganizationIds {
 			organization, err := u.organizationRepo.Get(ctx, organizationId)
 			if err != nil {
-				return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+				return httpErrors.NewError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
 			}
 
 			permittedOrganizations[i] = organization
@@ -377,22 +377,22 @@ func (u *PolicyTemplateUsecase) Delete(ctx context.Context, organizationId *stri
 	}
 
 	if policyTemplate == nil {
-		return httpErrors.NewBadRequestError(fmt.Errorf(
+		return httpErrors.NewError(fmt.Errorf(
 			"failed to fetch policy template"),
-			"PT_FAILED_FETCH_POLICY_TEMPLATE", "")
+			"PT_FAILED_FETCH_POLICY_TEMPLATE")
 	}
 
 	if !policyTemplate.IsPermittedToOrganization(organizationId) {
 		// 다른 Organization의 템플릿을 조작하려고 함, 보안을 위해서 해당 식별자 존재 자체를 알려주면 안되므로 not found
 		if *organizationId != *policyTemplate.OrganizationId {
-			return httpErrors.NewNotFoundError(fmt.Errorf(
+			return httpErrors.NewError(fmt.Errorf(
 				"policy template not found"),
-				"PT_NOT_FOUND_POLICY_TEMPLATE", "")
+				"PT_NOT_FOUND_POLICY_TEMPLATE")
 		}
 
-		return httpErrors.NewForbiddenError(fmt.Errorf(
+		return httpErrors.NewError(fmt.Errorf(
 			"cannot delete tks policy template"),
-			"PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE", "")
+			"PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE")
 	}
 
 	return u.repo.Delete(ctx, policyTemplateId)
@@ -422,9 +422,9 @@ func (u *PolicyTemplateUsecase) GetPolicyTemplateVersion(ctx context.Context, or
 	}
 
 	if !policyTemplate.IsPermittedToOrganization(organizationId) {
-		return nil, httpErrors.NewNotFoundError(fmt.Errorf(
+		return nil, httpErrors.NewError(fmt.Errorf(
 			"policy template not found"),
-			"PT_NOT_FOUND_POLICY_TEMPLATE", "")
+			"PT_NOT_FOUND_POLICY_TEMPLATE")
 	}
 
 	if policyTemplate.IsTksTemplate() && len(policyTemplate.PermittedOrganizations) == 0 {
@@ -448,9 +448,9 @@ func (u *PolicyTemplateUsecase) ListPolicyTemplateVersions(ctx context.Context,
 	}
 
 	if !policyTemplate.IsPermittedToOrganization(organizationId) {
-		return nil, httpErrors.NewNotFoundError(fmt.Errorf(
+		return nil, httpErrors.NewError(fmt.Errorf(
 			"policy template not found"),
-			"PT_NOT_FOUND_POLICY_TEMPLATE", "")
+			"PT_NOT_FOUND_POLICY_TEMPLATE")
 	}
 
 	return u.repo.ListPolicyTemplateVersions(ctx, policyTemplateId)
@@ -464,22 +464,22 @@ func (u *PolicyTemplateUsecase) DeletePolicyTemplateVersion(ctx context.Context,
 	}
 
 	if policyTemplate == nil {
-		return httpErrors.NewBadRequestError(fmt.Errorf(
+		return httpErrors.NewError(fmt.Errorf(
 			"failed to fetch policy template"),
-			"PT_FAILED_FETCH_POLICY_TEMPLATE", "")
+			"PT_FAILED_FETCH_POLICY_TEMPLATE")
 	}
 
 	if !policyTemplate.IsPermittedToOrganization(organizationId) {
 		// 다른 Organization의 템플릿을 조작하려고 함, 보안을 위해서 해당 식별자 존재 자체를 알려주면 안되므로 not found
 		if *organizationId != *policyTemplate.OrganizationId {
-			return httpErrors.NewNotFoundError(fmt.Errorf(
+			return httpErrors.NewError(fmt.Errorf(
 				"policy template version not found"),
-				"PT_NOT_FOUND_POLICY_TEMPLATE", "")
+				"PT_NOT_FOUND_POLICY_TEMPLATE")
 		}
 
-		return httpErrors.NewForbiddenError(fmt.Errorf(
+		return httpErrors.NewError(fmt.Errorf(
 			"cannot delete tks policy template version"),
-			"PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE", "")
+			"PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE")
 	}
 
 	return u.repo.DeletePolicyTemplateVersion(ctx, policyTemplateId, version)
@@ -493,26 +493,26 @@ func (u *PolicyTemplateUsecase) CreatePolicyTemplateVersion(ctx context.Context,
 	}
 
 	if policyTemplate == nil {
-		return "", httpErrors.NewBadRequestError(fmt.Errorf(
+		return "", httpErrors.NewError(fmt.Errorf(
 			"failed to fetch policy template"),
-			"PT_FAILED_FETCH_POLICY_TEMPLATE", "")
+			"PT_FAILED_FETCH_POLICY_TEMPLATE")
 	}
 
 	if !policyTemplate.IsPermittedToOrganization(organizationId) {
 		// 다른 Organization의 템플릿을 조작하려고 함, 보안을 위해서 해당 식별자 존재 자체를 알려주면 안되므로 not found
 		if *organizationId != *policyTemplate.OrganizationId {
-			return "", httpErrors.NewNotFoundError(fmt.Errorf(
+			return "", httpErrors.NewError(fmt.Errorf(
 				"policy template version not found"),
-				"PT_NOT_FOUND_POLICY_TEMPLATE", "")
+				"PT_NOT_FOUND_POLICY_TEMPLATE")
 		}
 
-		return "", httpErrors.NewForbiddenError(fmt.Errorf(
+		return "", httpErrors.NewError(fmt.Errorf(
 			"cannot crate tks policy template version"),
-			"PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE", "")
+			"PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE")
 	}
 
 	if err := policytemplate.ValidateParamDefs(policyTemplate.ParametersSchema); err != nil {
-		return "", httpErrors.NewBadRequestError(err, "PT_INVALID_PARAMETER_SCHEMA", "")
+		return "", httpErrors.NewError(err, "PT_INVALID_PARAMETER_SCHEMA")
 	}
 
 	rego = policytemplate.FormatRegoCode(rego)
@@ -581,9 +581,9 @@ func (u *PolicyTemplateUsecase) GetPolicyTemplateDeploy(ctx context.Context, org
 	}
 
 	if !policyTemplate.IsPermittedToOrganization(organizationId) {
-		return deployVersions, httpErrors.NewNotFoundError(fmt.Errorf(
+		return deployVersions, httpErrors.NewError(fmt.Errorf(
 			"policy template not found"),
-			"PT_NOT_FOUND_POLICY_TEMPLATE", "")
+			"PT_NOT_FOUND_POLICY_TEMPLATE")
 	}
 
 	if organizationId == nil {
@@ -617,7 +617,7 @@ func (u *PolicyTemplateUsecase) GetPolicyTemplateDeploy(ctx context.Context, org
 	if err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-		return deployVersions, httpErrors.NewInternalServerError(err, "P_FAILED_TO_CALL_KUBERNETES", "")
+		return deployVersions, httpErrors.NewError(err, "P_FAILED_TO_CALL_KUBERNETES")
 	}
 
 	for clusterId, status := range tksPolicyTemplate.Status.TemplateStatus {
@@ -635,17 +635,17 @@ func (u *PolicyTemplateUsecase) ExtractPolicyParameters(ctx context.Context, org
 	}
 
 	if policyTemplate == nil {
-		return nil, httpErrors.NewBadRequestError(fmt.Errorf(
+		return nil, httpErrors.NewError(fmt.Errorf(
 			"failed to fetch policy template"),
-			"PT_FAILED_FETCH_POLICY_TEMPLATE", "")
+			"PT_FAILED_FETCH_POLICY_TEMPLATE")
 	}
 
 	if !policyTemplate.IsPermittedToOrganization(organizationId) {
 		// 다른 Organization의 템플릿을 조작하려고 함, 보안을 위해서 해당 식별자 존재 자체를 알려주면 안되므로 not found
 		if *organizationId != *policyTemplate.OrganizationId {
-			return nil, httpErrors.NewNotFoundError(fmt.Errorf(
+			return nil, httpErrors.NewError(fmt.Errorf(
 				"policy template version not found"),
-				"PT_NOT_FOUND_POLICY_TEMPLATE", "")
+				"PT_NOT_FOUND_POLICY_TEMPLATE")
 		}
 	}
 
@@ -719,15 +719,15 @@ func (u *PolicyTemplateUsecase) AddPermittedPolicyTemplatesForOrganization(ctx c
 		result, err := u.repo.GetByID(ctx, policyTemplateId)
 
 		if err != nil || result == nil {
-			return httpErrors.NewBadRequestError(fmt.Errorf(
+			return httpErrors.NewError(fmt.Errorf(
 				"failed to fetch policy template"),
-				"PT_FAILED_FETCH_POLICY_TEMPLATE", "")
+				"PT_FAILED_FETCH_POLICY_TEMPLATE")
 		}
 
 		if result.IsOrganizationTemplate() {
-			return httpErrors.NewBadRequestError(fmt.Errorf(
+			return httpErrors.NewError(fmt.Errorf(
 				"failed to permit organization to organization policy template"),
-				"PT_FAILED_TO_PERMIT_ORG_TEMPLATE", "")
+				"PT_FAILED_TO_PERMIT_ORG_TEMPLATE")
 		}
 
 		policyTemplates[i] = *result
@@ -743,15 +743,15 @@ func (u *PolicyTemplateUsecase) UpdatePermittedPolicyTemplatesForOrganization(ct
 		result, err := u.repo.GetByID(ctx, policyTemplateId)
 
 		if err != nil || result == nil {
-			return httpErrors.NewBadRequestError(fmt.Errorf(
+			return httpErrors.NewError(fmt.Errorf(
 				"failed to fetch policy template"),
-				"PT_FAILED_FETCH_POLICY_TEMPLATE", "")
+				"PT_FAILED_FETCH_POLICY_TEMPLATE")
 		}
 
 		if result.IsOrganizationTemplate() {
-			return httpErrors.NewBadRequestError(fmt.Errorf(
+			return httpErrors.NewError(fmt.Errorf(
 				"failed to permit organization to organization policy template"),
-				"PT_FAILED_TO_PERMIT_ORG_TEMPLATE", "")
+				"PT_FAILED_TO_PERMIT_ORG_TEMPLATE")
 		}
 
 		policyTemplates[i] = *result
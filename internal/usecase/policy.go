@@ -75,7 +75,7 @@ func (u *PolicyUsecase) Create(ctx context.Context, organizationId string, dto m
 
 	user, ok := request.UserFrom(ctx)
 	if !ok {
-		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN", "")
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("invalid token"), "A_INVALID_TOKEN")
 	}
 
 	exists, err := u.repo.ExistByName(ctx, dto.OrganizationId, dto.PolicyName)
@@ -116,15 +116,15 @@ func (u *PolicyUsecase) Create(ctx context.Context, organizationId string, dto m
 		if err != nil {
 			log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-			return uuid.Nil, httpErrors.NewBadRequestError(err, "P_FAILED_FETCH_CLUSTER", "")
+			return uuid.Nil, httpErrors.NewError(err, "P_FAILED_FETCH_CLUSTER")
 		}
 		dto.TargetClusters[i] = cluster
 	}
 
 	if !policyTemplate.IsPermittedToOrganization(&organizationId) {
-		return uuid.Nil, httpErrors.NewNotFoundError(fmt.Errorf(
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf(
 			"policy template not found"),
-			"PT_NOT_FOUND_POLICY_TEMPLATE", "")
+			"PT_NOT_FOUND_POLICY_TEMPLATE")
 	}
 
 	userId := user.GetUserId()
@@ -136,13 +136,13 @@ func (u *PolicyUsecase) Create(ctx context.Context, organizationId string, dto m
 	if err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
 	}
 
 	if err := policytemplate.ValidateJSONusingParamdefs(policyTemplate.ParametersSchema, dto.Parameters); err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-		return uuid.Nil, httpErrors.NewBadRequestError(err, "P_INVALID_POLICY_PARAMETER", "")
+		return uuid.Nil, httpErrors.NewError(err, "P_INVALID_POLICY_PARAMETER")
 	}
 
 	primaryClusterId := organization.PrimaryClusterId
@@ -179,7 +179,7 @@ func createOrUpdatPolicy(ctx context.Context, dto *model.Policy, policyTemplate
 	if err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-		return httpErrors.NewInternalServerError(err, "P_FAILED_TO_CALL_KUBERNETES", "")
+		return httpErrors.NewError(err, "P_FAILED_TO_CALL_KUBERNETES")
 	}
 
 	if !exists {
@@ -193,7 +193,7 @@ func createOrUpdatPolicy(ctx context.Context, dto *model.Policy, policyTemplate
 
 			log.Errorf(ctx, "error is :%s(%T), policyTemplateCR='%+v'", err.Error(), err, errYaml)
 
-			return httpErrors.NewInternalServerError(err, "P_FAILED_TO_APPLY_KUBERNETES", "")
+			return httpErrors.NewError(err, "P_FAILED_TO_APPLY_KUBERNETES")
 		}
 	}
 
@@ -219,7 +219,7 @@ func createOrUpdatPolicy(ctx context.Context, dto *model.Policy, policyTemplate
 
 		log.Errorf(ctx, "error is :%s(%T), policyCR='%+v'", err.Error(), err, errYaml)
 
-		return httpErrors.NewInternalServerError(err, "P_FAILED_TO_APPLY_KUBERNETES", "")
+		return httpErrors.NewError(err, "P_FAILED_TO_APPLY_KUBERNETES")
 	}
 
 	return nil
@@ -236,7 +236,7 @@ func (u *PolicyUsecase) Update(ctx context.Context, organizationId string, polic
 
 	policy, err := u.repo.GetByID(ctx, organizationId, policyId)
 	if err != nil {
-		return httpErrors.NewNotFoundError(err, "P_FAILED_FETCH_POLICY", "")
+		return httpErrors.NewError(err, "P_FAILED_FETCH_POLICY")
 	}
 
 	updateMap := make(map[string]interface{})
@@ -277,9 +277,9 @@ func (u *PolicyUsecase) Update(ctx context.Context, organizationId string, polic
 		}
 
 		if !policyTemplate.IsPermittedToOrganization(&organizationId) {
-			return httpErrors.NewNotFoundError(fmt.Errorf(
+			return httpErrors.NewError(fmt.Errorf(
 				"policy template not found"),
-				"PT_NOT_FOUND_POLICY_TEMPLATE", "")
+				"PT_NOT_FOUND_POLICY_TEMPLATE")
 		}
 
 		updateMap["template_id"] = templateId
@@ -295,7 +295,7 @@ func (u *PolicyUsecase) Update(ctx context.Context, organizationId string, polic
 		if err := policytemplate.ValidateJSONusingParamdefs(schema, *parameters); err != nil {
 			log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-			return httpErrors.NewBadRequestError(err, "P_INVALID_POLICY_PARAMETER", "")
+			return httpErrors.NewError(err, "P_INVALID_POLICY_PARAMETER")
 		}
 	}
 
@@ -315,7 +315,7 @@ func (u *PolicyUsecase) Update(ctx context.Context, organizationId string, polic
 		for i, clusterId := range *targetClusterIds {
 			cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(clusterId))
 			if err != nil {
-				return httpErrors.NewBadRequestError(fmt.Errorf("invalid clusterId"), "C_INVALID_CLUSTER_ID", "")
+				return httpErrors.NewError(fmt.Errorf("invalid clusterId"), "C_INVALID_CLUSTER_ID")
 			}
 
 			targetClusters[i] = cluster
@@ -340,12 +340,12 @@ func (u *PolicyUsecase) Update(ctx context.Context, organizationId string, polic
 		organization, err := u.organizationRepo.Get(ctx, organizationId)
 
 		if err != nil {
-			return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+			return httpErrors.NewError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
 		}
 
 		policy, err := u.repo.GetByID(ctx, organizationId, policyId)
 		if err != nil {
-			return httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", "")
+			return httpErrors.NewError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID")
 		}
 
 		policyCR := policytemplate.PolicyToTksPolicyCR(policy)
@@ -372,13 +372,13 @@ func (u *PolicyUsecase) Delete(ctx context.Context, organizationId string, polic
 	organization, err := u.organizationRepo.Get(ctx, organizationId)
 
 	if err != nil {
-		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+		return httpErrors.NewError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
 	}
 
 	exists, err := policytemplate.ExistsTksPolicyCR(ctx, organization.PrimaryClusterId, policy.PolicyResourceName)
 	if err != nil {
 		log.Errorf(ctx, "failed to check TksPolicyCR: %v", err)
-		return httpErrors.NewInternalServerError(err, "P_FAILED_TO_APPLY_KUBERNETES", "")
+		return httpErrors.NewError(err, "P_FAILED_TO_APPLY_KUBERNETES")
 	}
 
 	if exists {
@@ -386,7 +386,7 @@ func (u *PolicyUsecase) Delete(ctx context.Context, organizationId string, polic
 
 		if err != nil {
 			log.Errorf(ctx, "failed to delete TksPolicyCR: %v", err)
-			return httpErrors.NewInternalServerError(err, "P_FAILED_TO_APPLY_KUBERNETES", "")
+			return httpErrors.NewError(err, "P_FAILED_TO_APPLY_KUBERNETES")
 		}
 	}
 
@@ -453,7 +453,7 @@ func (u *PolicyUsecase) UpdatePolicyTargetClusters(ctx context.Context, organiza
 	for i, clusterId := range targetClusterIds {
 		cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(clusterId))
 		if err != nil {
-			return httpErrors.NewBadRequestError(fmt.Errorf("invalid clusterId"), "C_INVALID_CLUSTER_ID", "")
+			return httpErrors.NewError(fmt.Errorf("invalid clusterId"), "C_INVALID_CLUSTER_ID")
 		}
 
 		targetClusters[i] = cluster
@@ -464,7 +464,7 @@ func (u *PolicyUsecase) UpdatePolicyTargetClusters(ctx context.Context, organiza
 	if err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+		return httpErrors.NewError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
 	}
 
 	err = u.repo.UpdatePolicyTargetClusters(ctx, organizationId, policyId, currentClusterIds, targetClusters)
@@ -474,7 +474,7 @@ func (u *PolicyUsecase) UpdatePolicyTargetClusters(ctx context.Context, organiza
 
 	policy, err := u.repo.GetByID(ctx, organizationId, policyId)
 	if err != nil {
-		return httpErrors.NewBadRequestError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID", "")
+		return httpErrors.NewError(fmt.Errorf("invalid policyId"), "C_INVALID_POLICY_ID")
 	}
 
 	policyCR := policytemplate.PolicyToTksPolicyCR(policy)
@@ -671,7 +671,7 @@ func (u *PolicyUsecase) GetStackPolicyTemplateStatus(ctx context.Context, cluste
 	}
 
 	if latestTemplate == nil {
-		return nil, httpErrors.NewBadRequestError(err, "P_FAILED_FETCH_TEMPLATE", "")
+		return nil, httpErrors.NewError(err, "P_FAILED_FETCH_TEMPLATE")
 	}
 
 	// policies가 빈 목록일 수도 있으므로 policy의 organization 정보는 못 가져올 수도 있음
@@ -815,7 +815,7 @@ func (u *PolicyUsecase) AddPoliciesForClusterID(ctx context.Context, organizatio
 	if err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+		return httpErrors.NewError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
 	}
 
 	tkpolicies, err := policytemplate.GetTksPolicyCRs(ctx, primaryClusterId)
@@ -875,7 +875,7 @@ func (u *PolicyUsecase) UpdatePoliciesForClusterID(ctx context.Context, organiza
 	if err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+		return httpErrors.NewError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
 	}
 
 	tkpolicies, err := policytemplate.GetTksPolicyCRs(ctx, primaryClusterId)
@@ -948,7 +948,7 @@ func (u *PolicyUsecase) DeletePoliciesForClusterID(ctx context.Context, organiza
 	if err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-		return httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+		return httpErrors.NewError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
 	}
 
 	tkpolicies, err := policytemplate.GetTksPolicyCRs(ctx, primaryClusterId)
@@ -991,7 +991,7 @@ func (u *PolicyUsecase) GetStackPolicyStatistics(ctx context.Context, organizati
 	if err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-		return nil, httpErrors.NewBadRequestError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID", "")
+		return nil, httpErrors.NewError(fmt.Errorf("invalid organizationId"), "C_INVALID_ORGANIZATION_ID")
 	}
 
 	primaryClusterId := organization.PrimaryClusterId
@@ -1000,7 +1000,7 @@ func (u *PolicyUsecase) GetStackPolicyStatistics(ctx context.Context, organizati
 	if err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-		return nil, httpErrors.NewInternalServerError(fmt.Errorf("fail to get template list from kubernetes"), "P_FAILED_TO_CALL_KUBERNETES", "")
+		return nil, httpErrors.NewError(fmt.Errorf("fail to get template list from kubernetes"), "P_FAILED_TO_CALL_KUBERNETES")
 	}
 
 	totalTemplateCount := len(templateList)
@@ -1034,7 +1034,7 @@ func (u *PolicyUsecase) GetStackPolicyStatistics(ctx context.Context, organizati
 	if err != nil {
 		log.Errorf(ctx, "error is :%s(%T)", err.Error(), err)
 
-		return nil, httpErrors.NewInternalServerError(fmt.Errorf("fail to get policy list from kubernetes"), "P_FAILED_TO_CALL_KUBERNETES", "")
+		return nil, httpErrors.NewError(fmt.Errorf("fail to get policy list from kubernetes"), "P_FAILED_TO_CALL_KUBERNETES")
 	}
 
 	outdatedPolicyCount := 0
@@ -3,7 +3,6 @@ package usecase
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,6 +13,7 @@ import (
 	"github.com/openinfradev/tks-api/internal/serializer"
 	argowf "github.com/openinfradev/tks-api/pkg/argo-client"
 	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
 	"github.com/openinfradev/tks-api/pkg/kubernetes"
 	"github.com/openinfradev/tks-api/pkg/log"
 	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
@@ -36,6 +36,7 @@ type IProjectUsecase interface {
 	GetProjectWithLeader(ctx context.Context, organizationId string, projectId string) (*model.Project, error)
 	IsProjectNameExist(ctx context.Context, organizationId string, projectName string) (bool, error)
 	UpdateProject(ctx context.Context, p *model.Project, newLeaderId string) error
+	DeleteProject(ctx context.Context, organizationId string, projectId string) error
 	GetProjectRole(ctx context.Context, id string) (*model.ProjectRole, error)
 	GetProjectRoles(ctx context.Context, query int) ([]model.ProjectRole, error)
 	AddProjectMember(ctx context.Context, organizationId string, pm *model.ProjectMember) (string, error)
@@ -73,6 +74,7 @@ type ProjectUsecase struct {
 	clusterRepository      repository.IClusterRepository
 	appgroupRepository     repository.IAppGroupRepository
 	organizationRepository repository.IOrganizationRepository
+	namingPolicyRepository repository.INamingPolicyRepository
 	argo                   argowf.ArgoClient
 	kc                     keycloak.IKeycloak
 }
@@ -85,12 +87,24 @@ func NewProjectUsecase(r repository.Repository, kc keycloak.IKeycloak, argoClien
 		clusterRepository:      r.Cluster,
 		appgroupRepository:     r.AppGroup,
 		organizationRepository: r.Organization,
+		namingPolicyRepository: r.NamingPolicy,
 		argo:                   argoClient,
 		kc:                     kc,
 	}
 }
 
 func (u *ProjectUsecase) CreateProject(ctx context.Context, p *model.Project) (string, error) {
+	if err := validateResourceName(ctx, u.namingPolicyRepository, p.OrganizationId, domain.NamingPolicyResourceType_PROJECT, p.Name); err != nil {
+		return "", err
+	}
+	exist, err := u.IsProjectNameExist(ctx, p.OrganizationId, p.Name)
+	if err != nil {
+		return "", err
+	}
+	if exist {
+		return "", httpErrors.NewError(httpErrors.DuplicateResource, "C_ALREADY_EXISTED_PROJECT_NAME")
+	}
+
 	projectId, err := u.projectRepo.CreateProject(ctx, p)
 	if err != nil {
 		log.Error(ctx, err)
@@ -253,6 +267,57 @@ func (u *ProjectUsecase) UpdateProject(ctx context.Context, p *model.Project, ne
 	return nil
 }
 
+// DeleteProject 는 네임스페이스가 모두 삭제된 프로젝트만 삭제한다.
+// 스택의 k8s 리소스는 네임스페이스를 삭제할 때 정리되므로, 여기서는 구성원과 keycloak 의 프로젝트 역할만 정리한다.
+func (u *ProjectUsecase) DeleteProject(ctx context.Context, organizationId string, projectId string) error {
+	p, err := u.projectRepo.GetProjectById(ctx, organizationId, projectId)
+	if err != nil {
+		log.Error(ctx, err)
+		return errors.Wrap(err, "Failed to get project.")
+	}
+	if p == nil {
+		return httpErrors.NewBadRequestError(fmt.Errorf("project not found: %s", projectId), "C_INVALID_PROJECT_ID", "")
+	}
+
+	pns, err := u.projectRepo.GetProjectNamespaces(ctx, organizationId, projectId, nil)
+	if err != nil {
+		log.Error(ctx, err)
+		return errors.Wrap(err, "Failed to get project namespaces.")
+	}
+	if len(pns) > 0 {
+		return httpErrors.NewBadRequestError(fmt.Errorf("project %s has %d namespaces", projectId, len(pns)), "C_PROJECT_HAS_NAMESPACES", "")
+	}
+
+	pms, err := u.projectRepo.GetProjectMembersByProjectId(ctx, projectId, nil)
+	if err != nil {
+		log.Error(ctx, err)
+		return errors.Wrap(err, "Failed to get project members.")
+	}
+	for _, pm := range pms {
+		if err := u.RemoveProjectMember(ctx, organizationId, pm.ID); err != nil {
+			return err
+		}
+	}
+
+	prs, err := u.GetProjectRoles(ctx, ProjectAll)
+	if err != nil {
+		log.Error(ctx, err)
+		return errors.Wrap(err, "Failed to retrieve project roles.")
+	}
+	for _, pr := range prs {
+		if err := u.kc.DeleteClientRoleWithClientName(ctx, organizationId, keycloak.DefaultClientID, pr.Name+"@"+projectId); err != nil {
+			log.Error(ctx, err)
+			return errors.Wrap(err, "Failed to delete project setting on keycloak.")
+		}
+	}
+
+	if err := u.projectRepo.DeleteProject(ctx, organizationId, projectId); err != nil {
+		log.Error(ctx, err)
+		return errors.Wrap(err, "Failed to delete project.")
+	}
+	return nil
+}
+
 func (u *ProjectUsecase) GetProjectRole(ctx context.Context, id string) (*model.ProjectRole, error) {
 	pr, err := u.projectRepo.GetProjectRoleById(ctx, id)
 	if err != nil {
@@ -497,7 +562,7 @@ func (u *ProjectUsecase) UpdateProjectNamespace(ctx context.Context, pn *model.P
 }
 
 func (u *ProjectUsecase) DeleteProjectNamespace(ctx context.Context, organizationId string, projectId string,
-	stackId string, projectNamespace string) error {
+	projectNamespace string, stackId string) error {
 	if err := u.projectRepo.DeleteProjectNamespace(ctx, organizationId, projectId, projectNamespace, stackId); err != nil {
 		log.Error(ctx, err)
 		return errors.Wrap(err, "Failed to delete project namespace.")
@@ -655,7 +720,7 @@ func (u *ProjectUsecase) deleteKeycloakClientRoles(ctx context.Context, organiza
 	// first check whether the stac
 
 	// delete Roles in keycloak
-	for _, role := range []string{strconv.Itoa(ProjectLeader), strconv.Itoa(ProjectMember), strconv.Itoa(ProjectViewer)} {
+	for _, role := range []string{"project-leader", "project-member", "project-viewer"} {
 		err := u.kc.DeleteClientRoleWithClientName(ctx, organizationId, clientId, role+"@"+projectId)
 		if err != nil {
 			log.Error(ctx, err)
@@ -680,7 +745,18 @@ func (u *ProjectUsecase) CreateK8SNSRoleBinding(ctx context.Context, organizatio
 	return nil
 }
 func (u *ProjectUsecase) DeleteK8SNSRoleBinding(ctx context.Context, organizationId string, projectId string, stackId string, namespace string) error {
-	//TODO implement me
+	kubeconfig, err := kubernetes.GetKubeConfig(ctx, stackId, kubernetes.KubeconfigForAdmin)
+	if err != nil {
+		log.Error(ctx, err)
+		return errors.Wrap(err, "Failed to get kubeconfig.")
+	}
+
+	err = kubernetes.RemoveRoleBinding(ctx, kubeconfig, projectId, namespace)
+	if err != nil {
+		log.Error(ctx, err)
+		return errors.Wrap(err, "Failed to remove K8s role binding.")
+	}
+
 	return nil
 }
 
@@ -928,7 +1004,13 @@ func (u *ProjectUsecase) GetResourcesUsage(ctx context.Context, thanosClient tha
 	return
 }
 
+// EnsureNamespaceForCluster 는 다른 조직의 스택에 네임스페이스를 만들지 않도록 스택의 조직을 먼저 확인한다.
 func (u *ProjectUsecase) EnsureNamespaceForCluster(ctx context.Context, organizationId string, stackId string, namespaceName string) error {
+	cluster, err := u.clusterRepository.Get(ctx, domain.ClusterId(stackId))
+	if err != nil || cluster.OrganizationId != organizationId {
+		return httpErrors.NewBadRequestError(fmt.Errorf("stack %s not found in organization %s", stackId, organizationId), "C_INVALID_STACK_ID", "")
+	}
+
 	kubeconfig, err := kubernetes.GetKubeConfig(ctx, stackId, kubernetes.KubeconfigForAdmin)
 	if err != nil {
 		log.Error(ctx, err)
@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const addonVersionDetectTimeout = 10 * time.Second
+
+type addonLatestVersion struct {
+	version       *semver.Version
+	raw           string
+	stackTemplate string
+}
+
+// GetAddonVersions 는 조직의 모든 stack 에 설치된 addon 버전을 조직이 사용할 수 있는 stack template 의 최신 버전과 비교한다.
+// 설치 버전은 cluster 의 workload label 에서 확인하고, 확인할 수 없으면 cluster 의 stack template 에 정의된 버전을 사용한다.
+func (u *StackUsecase) GetAddonVersions(ctx context.Context, organizationId string) (out []domain.StackAddonVersionsResponse, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return out, httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+
+	stackTemplates, err := u.stackTemplateRepo.FetchWithOrganization(ctx, organizationId, nil)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	latest := map[string]addonLatestVersion{}
+	for _, stackTemplate := range stackTemplates {
+		for _, service := range parseStackTemplateServices(ctx, stackTemplate) {
+			for _, app := range service.Applications {
+				v, err := semver.NewVersion(app.Version)
+				if err != nil {
+					continue
+				}
+				if l, ok := latest[app.Name]; !ok || v.GreaterThan(l.version) {
+					latest[app.Name] = addonLatestVersion{version: v, raw: app.Version, stackTemplate: stackTemplate.Name}
+				}
+			}
+		}
+	}
+
+	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, user.GetUserId(), nil)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	unreachable := map[domain.ClusterId]bool{}
+	if heartbeats, err := u.heartbeatRepo.FetchByOrganizationId(ctx, organizationId); err == nil {
+		for _, heartbeat := range heartbeats {
+			unreachable[heartbeat.ClusterId] = heartbeat.Status == domain.ClusterConnectivityStatus_UNREACHABLE
+		}
+	} else {
+		log.Error(ctx, err)
+	}
+
+	out = make([]domain.StackAddonVersionsResponse, len(clusters))
+	var wg sync.WaitGroup
+	for i, cluster := range clusters {
+		services := parseStackTemplateServices(ctx, cluster.StackTemplate)
+		out[i] = domain.StackAddonVersionsResponse{
+			ID:   domain.StackId(cluster.ID),
+			Name: cluster.Name,
+			StackTemplate: domain.SimpleStackTemplateResponse{
+				ID:           cluster.StackTemplate.ID.String(),
+				Name:         cluster.StackTemplate.Name,
+				Description:  cluster.StackTemplate.Description,
+				Template:     cluster.StackTemplate.Template,
+				CloudService: cluster.StackTemplate.CloudService,
+				KubeVersion:  cluster.StackTemplate.KubeVersion,
+				KubeType:     cluster.StackTemplate.KubeType,
+			},
+		}
+		if cluster.Status != domain.ClusterStatus_RUNNING || unreachable[cluster.ID] {
+			out[i].Addons, out[i].UpdatesAvailable = compareAddonVersions(services, nil, latest)
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, clusterId string, services []domain.StackTemplateServiceResponse) {
+			defer wg.Done()
+			installed, err := detectAddonVersions(ctx, clusterId)
+			if err != nil {
+				log.Warnf(ctx, "failed to detect addon versions of cluster %s. err : %s", clusterId, err)
+			}
+			out[i].Addons, out[i].UpdatesAvailable = compareAddonVersions(services, installed, latest)
+		}(i, cluster.ID.String(), services)
+	}
+	wg.Wait()
+
+	return out, nil
+}
+
+func parseStackTemplateServices(ctx context.Context, stackTemplate model.StackTemplate) (out []domain.StackTemplateServiceResponse) {
+	if len(stackTemplate.Services) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(stackTemplate.Services, &out); err != nil {
+		log.Info(ctx, err)
+		return nil
+	}
+	return out
+}
+
+// detectAddonVersions 는 cluster 의 deployment, statefulset, daemonset label 에서 addon 이름 별 설치 버전을 찾는다.
+// helm chart label(helm.sh/chart) 을 우선하고, 없으면 app.kubernetes.io/version 을 사용한다.
+func detectAddonVersions(ctx context.Context, clusterId string) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, addonVersionDetectTimeout)
+	defer cancel()
+
+	clientset, err := kubernetes.GetClientFromClusterId(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := []map[string]string{}
+	deployments, err := clientset.AppsV1().Deployments("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range deployments.Items {
+		labels = append(labels, item.Labels)
+	}
+	statefulSets, err := clientset.AppsV1().StatefulSets("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range statefulSets.Items {
+		labels = append(labels, item.Labels)
+	}
+	daemonSets, err := clientset.AppsV1().DaemonSets("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range daemonSets.Items {
+		labels = append(labels, item.Labels)
+	}
+
+	out := map[string]string{}
+	for _, l := range labels {
+		if chart, ok := l["helm.sh/chart"]; ok {
+			if idx := strings.LastIndex(chart, "-"); idx > 0 {
+				out[chart[:idx]] = chart[idx+1:]
+				continue
+			}
+		}
+		name := l["app.kubernetes.io/name"]
+		version := l["app.kubernetes.io/version"]
+		if name != "" && version != "" {
+			if _, ok := out[name]; !ok {
+				out[name] = version
+			}
+		}
+	}
+	return out, nil
+}
+
+func compareAddonVersions(services []domain.StackTemplateServiceResponse, installed map[string]string, latest map[string]addonLatestVersion) (out []domain.StackAddonVersionResponse, updatesAvailable int) {
+	out = []domain.StackAddonVersionResponse{}
+	for _, service := range services {
+		for _, app := range service.Applications {
+			addon := domain.StackAddonVersionResponse{
+				Name:             app.Name,
+				Type:             service.Type,
+				InstalledVersion: app.Version,
+				Source:           domain.StackAddonVersionSource_TEMPLATE,
+			}
+			if version, ok := installed[app.Name]; ok {
+				addon.InstalledVersion = version
+				addon.Source = domain.StackAddonVersionSource_CLUSTER
+			}
+
+			if l, ok := latest[app.Name]; ok {
+				addon.LatestVersion = l.raw
+				addon.LatestStackTemplate = l.stackTemplate
+				if v, err := semver.NewVersion(addon.InstalledVersion); err == nil && l.version.GreaterThan(v) {
+					addon.Outdated = true
+					updatesAvailable++
+				}
+			}
+			out = append(out, addon)
+		}
+	}
+	return out, updatesAvailable
+}
@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// cluster-api-provider-aws 가 클러스터 자원에 붙이는 tag 이다.
+const capaClusterTagPrefix = "sigs.k8s.io/cluster-api-provider-aws/cluster/"
+
+func (u *StackUsecase) GetCostAllocationTagPolicies(ctx context.Context, organizationId string) ([]model.CostAllocationTagPolicy, error) {
+	policies, err := u.costAllocationTagRepo.Fetch(ctx, organizationId)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	for i := range policies {
+		if len(policies[i].AllowedValue) > 0 {
+			if err := json.Unmarshal(policies[i].AllowedValue, &policies[i].AllowedValues); err != nil {
+				return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+			}
+		}
+	}
+	return policies, nil
+}
+
+func (u *StackUsecase) UpdateCostAllocationTagPolicies(ctx context.Context, organizationId string, policies []model.CostAllocationTagPolicy) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+	userId := user.GetUserId()
+
+	if _, err := u.organizationRepo.Get(ctx, organizationId); err != nil {
+		return httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_ORGANIZATION", "")
+	}
+
+	keys := map[string]bool{}
+	for i, policy := range policies {
+		if keys[policy.Key] {
+			return httpErrors.NewError(fmt.Errorf("duplicate tag key %s", policy.Key), "S_INVALID_COST_ALLOCATION_TAG_POLICY")
+		}
+		keys[policy.Key] = true
+		if strings.HasPrefix(strings.ToLower(policy.Key), "aws:") {
+			return httpErrors.NewError(fmt.Errorf("tag key %s uses the reserved prefix aws:", policy.Key), "S_INVALID_COST_ALLOCATION_TAG_POLICY")
+		}
+		if policy.DefaultValue != "" && len(policy.AllowedValues) > 0 && !slices.Contains(policy.AllowedValues, policy.DefaultValue) {
+			return httpErrors.NewError(fmt.Errorf("default value of tag %s is not allowed", policy.Key), "S_INVALID_COST_ALLOCATION_TAG_POLICY")
+		}
+
+		policies[i].OrganizationId = organizationId
+		policies[i].AllowedValue = []byte(helper.ModelToJson(policy.AllowedValues))
+		policies[i].UpdatorId = &userId
+	}
+
+	if err := u.costAllocationTagRepo.Replace(ctx, organizationId, policies); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// CheckCostAllocationTagDrift 는 stack 이 만든 클라우드 자원의 tag 가 조직의 비용 할당 tag 정책을 만족하는지 확인한다.
+func (u *StackUsecase) CheckCostAllocationTagDrift(ctx context.Context, organizationId string, stackId domain.StackId) (out domain.CheckCostAllocationTagDriftResponse, err error) {
+	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(stackId))
+	if err != nil {
+		return out, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
+	}
+	if cluster.OrganizationId != organizationId {
+		return out, httpErrors.NewError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID")
+	}
+	if cluster.CloudService != domain.CloudService_AWS || cluster.CloudAccountId == nil {
+		return out, httpErrors.NewError(fmt.Errorf("drift check is only supported for AWS stacks"), "S_INVALID_CLOUD_SERVICE")
+	}
+
+	policies, err := u.GetCostAllocationTagPolicies(ctx, organizationId)
+	if err != nil {
+		return out, err
+	}
+
+	cloudAccount, err := u.cloudAccountRepo.Get(ctx, *cluster.CloudAccountId)
+	if err != nil {
+		return out, httpErrors.NewError(err, "S_INVALID_CLOUD_ACCOUNT")
+	}
+
+	resources, err := fetchStackAwsResourceTags(ctx, cloudAccount, cluster.ID.String())
+	if err != nil {
+		log.Error(ctx, err)
+		return out, httpErrors.NewError(err, "S_FAILED_CHECK_COST_ALLOCATION_TAG")
+	}
+
+	out.StackId = stackId
+	out.Resources = []domain.CostAllocationTagDriftResourceResponse{}
+	for _, resource := range resources {
+		drift := checkCostAllocationTags(resource.tags, policies)
+		if len(drift.MissingTags) == 0 && len(drift.InvalidTags) == 0 {
+			continue
+		}
+		drift.ResourceId = resource.id
+		drift.ResourceType = resource.resourceType
+		out.Resources = append(out.Resources, drift)
+	}
+	out.Drifted = len(out.Resources) > 0
+	out.CheckedAt = time.Now()
+	return out, nil
+}
+
+// applyCostAllocationTagPolicies 는 정책의 기본값을 tag 에 채우고, 필수 tag 와 허용 값을 확인한다.
+func applyCostAllocationTagPolicies(conf *model.StackConf, policies []model.CostAllocationTagPolicy) error {
+	if len(policies) == 0 {
+		return nil
+	}
+	if conf.Tags == nil {
+		conf.Tags = map[string]string{}
+	}
+	for _, policy := range policies {
+		if _, ok := conf.Tags[policy.Key]; !ok && policy.DefaultValue != "" {
+			conf.Tags[policy.Key] = policy.DefaultValue
+		}
+	}
+	drift := checkCostAllocationTags(conf.Tags, policies)
+	if len(drift.MissingTags) > 0 {
+		return fmt.Errorf("missing required tags %s", strings.Join(drift.MissingTags, ", "))
+	}
+	for key, value := range drift.InvalidTags {
+		return fmt.Errorf("value %s of tag %s is not allowed", value, key)
+	}
+	return nil
+}
+
+func checkCostAllocationTags(tags map[string]string, policies []model.CostAllocationTagPolicy) (out domain.CostAllocationTagDriftResourceResponse) {
+	for _, policy := range policies {
+		value, ok := tags[policy.Key]
+		if !ok || value == "" {
+			if policy.Required {
+				out.MissingTags = append(out.MissingTags, policy.Key)
+			}
+			continue
+		}
+		if len(policy.AllowedValues) > 0 && !slices.Contains(policy.AllowedValues, value) {
+			if out.InvalidTags == nil {
+				out.InvalidTags = map[string]string{}
+			}
+			out.InvalidTags[policy.Key] = value
+		}
+	}
+	return
+}
+
+// newCloudAccountEc2Client 는 cluster-api-provider-aws 가 사용하는 role 로 클라우드 계정의 ec2 client 를 만든다.
+func newCloudAccountEc2Client(ctx context.Context, cloudAccount model.CloudAccount, region string) (*ec2.Client, error) {
+	awsAccessKeyId, awsSecretAccessKey, err := kubernetes.GetAwsSecret(ctx)
+	if err != nil || awsAccessKeyId == "" || awsSecretAccessKey == "" {
+		return nil, fmt.Errorf("Invalid aws secret. %v", err)
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx,
+		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
+			Value: aws.Credentials{
+				AccessKeyID: awsAccessKeyId, SecretAccessKey: awsSecretAccessKey,
+			},
+		}))
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(cloudAccount.Name, domain.CLOUD_ACCOUNT_INCLUSTER) {
+		creds := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), "arn:aws:iam::"+cloudAccount.AwsAccountId+":role/controllers.cluster-api-provider-aws.sigs.k8s.io")
+		cfg.Credentials = aws.NewCredentialsCache(creds)
+	}
+
+	return ec2.NewFromConfig(cfg, func(o *ec2.Options) {
+		o.Region = region
+	}), nil
+}
+
+type awsResourceTags struct {
+	id           string
+	resourceType string
+	tags         map[string]string
+}
+
+// fetchStackAwsResourceTags 는 클러스터가 소유한 vpc, subnet, instance, volume 의 tag 를 조회한다.
+func fetchStackAwsResourceTags(ctx context.Context, cloudAccount model.CloudAccount, clusterId string) (out []awsResourceTags, err error) {
+	client, err := newCloudAccountEc2Client(ctx, cloudAccount, "ap-northeast-2")
+	if err != nil {
+		return nil, err
+	}
+	// 클러스터 소유 tag 가 있는 자원을 찾은 뒤, 그 자원들의 tag 를 조회한다.
+	ownedIds := []string{}
+	paginator := ec2.NewDescribeTagsPaginator(client, &ec2.DescribeTagsInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("key"), Values: []string{capaClusterTagPrefix + clusterId}},
+			{Name: aws.String("resource-type"), Values: []string{"vpc", "subnet", "instance", "volume"}},
+		},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range page.Tags {
+			ownedIds = append(ownedIds, aws.ToString(tag.ResourceId))
+		}
+	}
+
+	resources := map[string]*awsResourceTags{}
+	for start := 0; start < len(ownedIds); start += 200 {
+		ids := ownedIds[start:min(start+200, len(ownedIds))]
+		paginator := ec2.NewDescribeTagsPaginator(client, &ec2.DescribeTagsInput{
+			Filters: []ec2types.Filter{
+				{Name: aws.String("resource-id"), Values: ids},
+			},
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, tag := range page.Tags {
+				id := aws.ToString(tag.ResourceId)
+				resource, ok := resources[id]
+				if !ok {
+					resource = &awsResourceTags{id: id, resourceType: string(tag.ResourceType), tags: map[string]string{}}
+					resources[id] = resource
+				}
+				resource.tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+		}
+	}
+
+	for _, resource := range resources {
+		out = append(out, *resource)
+	}
+	slices.SortFunc(out, func(a, b awsResourceTags) int {
+		return strings.Compare(a.resourceType+a.id, b.resourceType+b.id)
+	})
+	return out, nil
+}
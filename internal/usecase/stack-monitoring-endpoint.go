@@ -0,0 +1,439 @@
+package usecase
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	STACK_ENDPOINT_DOWN_NOTIFICATION_NAME = "stack-endpoint-down"
+
+	defaultMonitoringEndpointInterval         = 60
+	defaultMonitoringEndpointTimeout          = 10
+	defaultMonitoringEndpointFailureThreshold = 3
+	monitoringProbeConcurrency                = 16
+	monitoringProbeRetention                  = 30 * 24 * time.Hour
+)
+
+type IStackMonitoringEndpointUsecase interface {
+	Create(ctx context.Context, dto model.StackMonitoringEndpoint) (monitoringEndpointId uuid.UUID, err error)
+	Fetch(ctx context.Context, organizationId string, clusterId domain.ClusterId) ([]model.StackMonitoringEndpoint, error)
+	Get(ctx context.Context, organizationId string, monitoringEndpointId uuid.UUID) (model.StackMonitoringEndpoint, error)
+	Update(ctx context.Context, dto model.StackMonitoringEndpoint) error
+	Delete(ctx context.Context, organizationId string, monitoringEndpointId uuid.UUID) error
+	GetDashboardAvailability(ctx context.Context, organizationId string, duration string) (domain.GetDashboardAvailabilityResponse, error)
+	Probe(ctx context.Context) error
+	PurgeProbes(ctx context.Context) error
+}
+
+type StackMonitoringEndpointUsecase struct {
+	repo                   repository.IStackMonitoringEndpointRepository
+	clusterRepo            repository.IClusterRepository
+	maintenanceRepo        repository.IMaintenanceWindowRepository
+	systemNotificationRepo repository.ISystemNotificationRepository
+}
+
+func NewStackMonitoringEndpointUsecase(r repository.Repository) IStackMonitoringEndpointUsecase {
+	return &StackMonitoringEndpointUsecase{
+		repo:                   r.StackMonitoringEndpoint,
+		clusterRepo:            r.Cluster,
+		maintenanceRepo:        r.MaintenanceWindow,
+		systemNotificationRepo: r.SystemNotification,
+	}
+}
+
+func (u *StackMonitoringEndpointUsecase) Create(ctx context.Context, dto model.StackMonitoringEndpoint) (monitoringEndpointId uuid.UUID, err error) {
+	cluster, err := u.clusterRepo.Get(ctx, dto.ClusterId)
+	if err != nil || cluster.OrganizationId != dto.OrganizationId {
+		return uuid.Nil, httpErrors.NewNotFoundError(fmt.Errorf("not found cluster %s", dto.ClusterId), "S_INVALID_STACK_ID", "")
+	}
+	if err := validateMonitoringEndpoint(ctx, &dto); err != nil {
+		return uuid.Nil, err
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.CreatorId = &userId
+	}
+	dto.Status = domain.MonitoringEndpointStatus_UNKNOWN
+
+	monitoringEndpointId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return monitoringEndpointId, nil
+}
+
+// Fetch 는 endpoint 목록을 최근 24시간, 7일, 30일의 가용성과 함께 반환한다.
+func (u *StackMonitoringEndpointUsecase) Fetch(ctx context.Context, organizationId string, clusterId domain.ClusterId) ([]model.StackMonitoringEndpoint, error) {
+	endpoints, err := u.repo.Fetch(ctx, organizationId, clusterId)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if err := u.setAvailability(ctx, endpoints); err != nil {
+		log.Error(ctx, err)
+	}
+	return endpoints, nil
+}
+
+func (u *StackMonitoringEndpointUsecase) Get(ctx context.Context, organizationId string, monitoringEndpointId uuid.UUID) (model.StackMonitoringEndpoint, error) {
+	endpoint, err := u.repo.Get(ctx, monitoringEndpointId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.StackMonitoringEndpoint{}, httpErrors.NewError(err, "S_NOT_FOUND_MONITORING_ENDPOINT")
+		}
+		return model.StackMonitoringEndpoint{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if endpoint.OrganizationId != organizationId {
+		return model.StackMonitoringEndpoint{}, httpErrors.NewError(fmt.Errorf("not found monitoring endpoint in organization"), "S_NOT_FOUND_MONITORING_ENDPOINT")
+	}
+
+	endpoints := []model.StackMonitoringEndpoint{endpoint}
+	if err := u.setAvailability(ctx, endpoints); err != nil {
+		log.Error(ctx, err)
+	}
+	return endpoints[0], nil
+}
+
+// Update 는 설정을 변경한다. 주소나 판정 조건이 바뀌면 상태를 UNKNOWN 으로 초기화하고 다음 주기에 다시 확인한다.
+func (u *StackMonitoringEndpointUsecase) Update(ctx context.Context, dto model.StackMonitoringEndpoint) error {
+	current, err := u.Get(ctx, dto.OrganizationId, dto.ID)
+	if err != nil {
+		return err
+	}
+	if err := validateMonitoringEndpoint(ctx, &dto); err != nil {
+		return err
+	}
+
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.UpdatorId = &userId
+	}
+	if current.Url != dto.Url || current.Method != dto.Method || current.ExpectedStatusCode != dto.ExpectedStatusCode || !dto.Enabled {
+		dto.Status = domain.MonitoringEndpointStatus_UNKNOWN
+	} else {
+		dto.Status = current.Status
+	}
+
+	if err := u.repo.Update(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *StackMonitoringEndpointUsecase) Delete(ctx context.Context, organizationId string, monitoringEndpointId uuid.UUID) error {
+	if _, err := u.Get(ctx, organizationId, monitoringEndpointId); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, monitoringEndpointId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// GetDashboardAvailability 는 SLO 대시보드에 표시할 stack 별 가용성을 반환한다.
+// stack 의 가용성은 endpoint 별 가용성의 평균이며, probe 기록이 없는 endpoint 는 제외한다.
+func (u *StackMonitoringEndpointUsecase) GetDashboardAvailability(ctx context.Context, organizationId string, duration string) (out domain.GetDashboardAvailabilityResponse, err error) {
+	if duration == "" {
+		duration = "7d"
+	}
+	period, ok := map[string]time.Duration{
+		"1d":  24 * time.Hour,
+		"7d":  7 * 24 * time.Hour,
+		"30d": 30 * 24 * time.Hour,
+	}[duration]
+	if !ok {
+		return out, httpErrors.NewBadRequestError(fmt.Errorf("invalid duration %s", duration), "C_INVALID_QUERY_PARAM", "")
+	}
+	out.Duration = duration
+
+	endpoints, err := u.repo.Fetch(ctx, organizationId, "")
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	ids := make([]uuid.UUID, len(endpoints))
+	for i, endpoint := range endpoints {
+		ids[i] = endpoint.ID
+	}
+	counts, err := u.repo.GetAvailability(ctx, ids, time.Now().Add(-period))
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	stacks := make(map[domain.ClusterId]*domain.DashboardAvailability)
+	var total, success int64
+	for _, endpoint := range endpoints {
+		stack, ok := stacks[endpoint.ClusterId]
+		if !ok {
+			stack = &domain.DashboardAvailability{
+				ClusterId:           endpoint.ClusterId.String(),
+				ClusterName:         endpoint.Cluster.Name,
+				MonitoringEndpoints: []domain.DashboardEndpointAvailability{},
+			}
+			stacks[endpoint.ClusterId] = stack
+		}
+		if endpoint.Status == domain.MonitoringEndpointStatus_DOWN {
+			stack.DownEndpoints++
+		}
+		count := counts[endpoint.ID]
+		total += count.Total
+		success += count.Success
+		stack.MonitoringEndpoints = append(stack.MonitoringEndpoints, domain.DashboardEndpointAvailability{
+			ID:           endpoint.ID.String(),
+			Name:         endpoint.Name,
+			Url:          endpoint.Url,
+			Status:       endpoint.Status,
+			Availability: availabilityRatio(count),
+		})
+	}
+
+	out.Stacks = make([]domain.DashboardAvailability, 0, len(stacks))
+	for _, stack := range stacks {
+		stack.Availability = averageAvailability(stack.MonitoringEndpoints)
+		out.Stacks = append(out.Stacks, *stack)
+	}
+	sort.Slice(out.Stacks, func(i, j int) bool {
+		return out.Stacks[i].ClusterName < out.Stacks[j].ClusterName
+	})
+	out.Availability = availabilityRatio(repository.ProbeCount{Total: total, Success: success})
+	return out, nil
+}
+
+// Probe 는 확인 주기가 된 endpoint 를 동시에 호출하고 결과를 기록한다.
+// 연속 실패 횟수가 FailureThreshold 에 도달하면 한 번만 알림을 생성하고, 유지보수 중인 stack 은 알림을 생성하지 않는다.
+func (u *StackMonitoringEndpointUsecase) Probe(ctx context.Context) error {
+	endpoints, err := u.repo.FetchEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	sem := make(chan struct{}, monitoringProbeConcurrency)
+	wg := sync.WaitGroup{}
+	for _, endpoint := range endpoints {
+		if endpoint.Cluster.Status != domain.ClusterStatus_RUNNING {
+			continue
+		}
+		if endpoint.LastCheckedAt != nil && now.Sub(*endpoint.LastCheckedAt) < time.Duration(endpoint.IntervalSeconds)*time.Second {
+			continue
+		}
+
+		wg.Add(1)
+		go func(endpoint model.StackMonitoringEndpoint) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			u.probe(ctx, endpoint)
+		}(endpoint)
+	}
+	wg.Wait()
+	return nil
+}
+
+func (u *StackMonitoringEndpointUsecase) PurgeProbes(ctx context.Context) error {
+	return u.repo.DeleteProbesBefore(ctx, time.Now().Add(-monitoringProbeRetention))
+}
+
+func (u *StackMonitoringEndpointUsecase) probe(ctx context.Context, endpoint model.StackMonitoringEndpoint) {
+	checkedAt := time.Now()
+	statusCode, err := probeMonitoringEndpoint(ctx, endpoint)
+	latency := time.Since(checkedAt).Milliseconds()
+
+	if err := u.repo.CreateProbe(ctx, model.StackMonitoringProbe{
+		MonitoringEndpointId: endpoint.ID,
+		CheckedAt:            checkedAt,
+		Success:              err == nil,
+		StatusCode:           statusCode,
+		LatencyMs:            latency,
+	}); err != nil {
+		log.Error(ctx, err)
+	}
+
+	endpoint.LastCheckedAt = &checkedAt
+	endpoint.LastStatusCode = statusCode
+	endpoint.LastLatencyMs = latency
+	if err == nil {
+		if endpoint.Status == domain.MonitoringEndpointStatus_DOWN {
+			log.Infof(ctx, "monitoring endpoint %s of cluster %s is up again", endpoint.Url, endpoint.ClusterId)
+		}
+		endpoint.Status = domain.MonitoringEndpointStatus_UP
+		endpoint.LastError = ""
+		endpoint.ConsecutiveFailures = 0
+		endpoint.NotifiedAt = nil
+	} else {
+		endpoint.LastError = err.Error()
+		endpoint.ConsecutiveFailures++
+		if endpoint.ConsecutiveFailures >= endpoint.FailureThreshold {
+			endpoint.Status = domain.MonitoringEndpointStatus_DOWN
+			u.notifyDown(ctx, &endpoint)
+		}
+	}
+
+	if err := u.repo.UpdateStatus(ctx, endpoint); err != nil {
+		log.Error(ctx, err)
+	}
+}
+
+func (u *StackMonitoringEndpointUsecase) notifyDown(ctx context.Context, endpoint *model.StackMonitoringEndpoint) {
+	if endpoint.NotifiedAt != nil {
+		return
+	}
+	maintenanceWindow, err := activeMaintenanceWindow(ctx, u.maintenanceRepo, endpoint.ClusterId)
+	if err != nil {
+		log.Error(ctx, err)
+	}
+	if maintenanceWindow != nil {
+		return
+	}
+
+	_, err = u.systemNotificationRepo.Create(ctx, model.SystemNotification{
+		OrganizationId:        endpoint.OrganizationId,
+		Name:                  STACK_ENDPOINT_DOWN_NOTIFICATION_NAME,
+		NotificationType:      "SYSTEM_NOTIFICATION",
+		Severity:              "critical",
+		ClusterId:             endpoint.ClusterId,
+		Node:                  endpoint.Url,
+		MessageTitle:          fmt.Sprintf("스택 [%s]의 endpoint [%s]에 접속할 수 없습니다.", endpoint.Cluster.Name, endpoint.Name),
+		MessageContent:        fmt.Sprintf("%s 호출이 %d 회 연속으로 실패하였습니다. 마지막 오류 : %s", endpoint.Url, endpoint.ConsecutiveFailures, endpoint.LastError),
+		MessageActionProposal: "ingress, 서비스와 애플리케이션 상태를 확인하세요.",
+		Summary:               endpoint.LastError,
+	})
+	if err != nil {
+		log.Error(ctx, "Failed to create systemNotification ", err)
+		return
+	}
+	now := time.Now()
+	endpoint.NotifiedAt = &now
+}
+
+// probeMonitoringEndpoint 는 endpoint 를 호출하여 응답 코드를 확인한다. 기대 응답 코드를 지정하지 않으면 2xx, 3xx 를 성공으로 본다.
+func probeMonitoringEndpoint(ctx context.Context, endpoint model.StackMonitoringEndpoint) (statusCode int, err error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(endpoint.TimeoutSeconds)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, endpoint.Method, endpoint.Url, nil)
+	if err != nil {
+		return 0, err
+	}
+	client := helper.NewOutboundHttpClient(time.Duration(endpoint.TimeoutSeconds)*time.Second,
+		&tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: endpoint.InsecureSkipVerify})
+	defer client.CloseIdleConnections()
+
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64*1024))
+		if err := res.Body.Close(); err != nil {
+			log.Error(ctx, "error closing http body")
+		}
+	}()
+
+	if endpoint.ExpectedStatusCode != 0 {
+		if res.StatusCode != endpoint.ExpectedStatusCode {
+			return res.StatusCode, fmt.Errorf("unexpected status code %d. expected %d", res.StatusCode, endpoint.ExpectedStatusCode)
+		}
+		return res.StatusCode, nil
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 400 {
+		return res.StatusCode, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+func validateMonitoringEndpoint(ctx context.Context, dto *model.StackMonitoringEndpoint) error {
+	u, err := url.Parse(dto.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return httpErrors.NewBadRequestError(fmt.Errorf("url must be http or https"), "S_INVALID_MONITORING_ENDPOINT", "")
+	}
+	if err := helper.ValidateOutboundHost(ctx, u.Hostname()); err != nil {
+		return httpErrors.NewBadRequestError(err, "S_INVALID_MONITORING_ENDPOINT", "")
+	}
+
+	if dto.Method == "" {
+		dto.Method = http.MethodGet
+	}
+	if dto.IntervalSeconds == 0 {
+		dto.IntervalSeconds = defaultMonitoringEndpointInterval
+	}
+	if dto.TimeoutSeconds == 0 {
+		dto.TimeoutSeconds = defaultMonitoringEndpointTimeout
+	}
+	if dto.FailureThreshold == 0 {
+		dto.FailureThreshold = defaultMonitoringEndpointFailureThreshold
+	}
+	if dto.TimeoutSeconds >= dto.IntervalSeconds {
+		return httpErrors.NewBadRequestError(fmt.Errorf("timeoutSeconds must be less than intervalSeconds"), "S_INVALID_MONITORING_ENDPOINT", "")
+	}
+	return nil
+}
+
+func (u *StackMonitoringEndpointUsecase) setAvailability(ctx context.Context, endpoints []model.StackMonitoringEndpoint) error {
+	ids := make([]uuid.UUID, len(endpoints))
+	for i, endpoint := range endpoints {
+		ids[i] = endpoint.ID
+	}
+
+	now := time.Now()
+	for _, period := range []struct {
+		since time.Time
+		set   func(a *domain.MonitoringEndpointAvailability, v *float64)
+	}{
+		{now.Add(-24 * time.Hour), func(a *domain.MonitoringEndpointAvailability, v *float64) { a.Last24h = v }},
+		{now.Add(-7 * 24 * time.Hour), func(a *domain.MonitoringEndpointAvailability, v *float64) { a.Last7d = v }},
+		{now.Add(-30 * 24 * time.Hour), func(a *domain.MonitoringEndpointAvailability, v *float64) { a.Last30d = v }},
+	} {
+		counts, err := u.repo.GetAvailability(ctx, ids, period.since)
+		if err != nil {
+			return err
+		}
+		for i := range endpoints {
+			period.set(&endpoints[i].Availability, availabilityRatio(counts[endpoints[i].ID]))
+		}
+	}
+	return nil
+}
+
+func availabilityRatio(count repository.ProbeCount) *float64 {
+	if count.Total == 0 {
+		return nil
+	}
+	ratio := float64(count.Success) / float64(count.Total) * 100
+	return &ratio
+}
+
+func averageAvailability(endpoints []domain.DashboardEndpointAvailability) *float64 {
+	var sum float64
+	var n int
+	for _, endpoint := range endpoints {
+		if endpoint.Availability != nil {
+			sum += *endpoint.Availability
+			n++
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	avg := sum / float64(n)
+	return &avg
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/spf13/viper"
+)
+
+const defaultStackNodeGroupMaxSize = 100
+
+type stackNodeGroupLimit struct {
+	min  int
+	max  int
+	step int
+}
+
+// ScaleNodeGroup 은 node group 의 노드 수를 스택 템플릿의 제한 안에서 변경한다.
+// 변경된 노드 수는 workflow 가 제출된 후 cluster 에 저장되고, 동시 실행 제한을 넘으면 operation 은 대기열에 들어간다.
+func (u *StackUsecase) ScaleNodeGroup(ctx context.Context, organizationId string, stackId domain.StackId, nodeGroup string, desiredSize int) (out domain.ScaleStackNodeGroupResponse, operation model.Operation, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return out, operation, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+
+	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(stackId))
+	if err != nil {
+		return out, operation, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
+	}
+	if cluster.OrganizationId != organizationId {
+		return out, operation, httpErrors.NewError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID")
+	}
+	if cluster.Status != domain.ClusterStatus_RUNNING {
+		return out, operation, httpErrors.NewError(fmt.Errorf("stack %s is %s", stackId, cluster.Status), "S_NOT_RUNNING_STACK")
+	}
+
+	size, maxSize := stackNodeGroupSize(&cluster, nodeGroup)
+	if size == nil {
+		return out, operation, httpErrors.NewError(fmt.Errorf("invalid node group %s", nodeGroup), "S_INVALID_NODE_GROUP")
+	}
+	limit, ok := stackNodeGroupLimits(cluster.StackTemplate)[nodeGroup]
+	if !ok {
+		return out, operation, httpErrors.NewError(fmt.Errorf("node group %s of stack template %s is not scalable", nodeGroup, cluster.StackTemplate.Name), "S_NOT_SCALABLE_NODE_GROUP")
+	}
+	if desiredSize < limit.min || desiredSize > limit.max || (desiredSize-limit.min)%limit.step != 0 {
+		return out, operation, httpErrors.NewError(fmt.Errorf("size of node group %s must be between %d and %d in steps of %d", nodeGroup, limit.min, limit.max, limit.step), "S_INVALID_NODE_GROUP_SIZE")
+	}
+
+	// 노드를 늘리는 경우에는 늘어나는 노드만큼 조직의 quota 를 확인한다.
+	if desiredSize > *size {
+		scaled := cluster
+		scaledSize, _ := stackNodeGroupSize(&scaled, nodeGroup)
+		*scaledSize = desiredSize
+		before, after := clusterQuotaUsage(cluster), clusterQuotaUsage(scaled)
+		if err = checkStackQuota(ctx, u.quotaRepo, u.clusterRepo, organizationId, domain.OrganizationQuotaUsage{
+			Nodes:     after.Nodes - before.Nodes,
+			Cpu:       after.Cpu - before.Cpu,
+			MemoryGib: after.MemoryGib - before.MemoryGib,
+		}, stackNodeGroupType(cluster, nodeGroup)); err != nil {
+			return out, operation, err
+		}
+	}
+
+	out = domain.ScaleStackNodeGroupResponse{
+		StackName:    cluster.Name,
+		NodeGroup:    nodeGroup,
+		PreviousSize: *size,
+		DesiredSize:  desiredSize,
+	}
+
+	operation, err = u.operations.Submit(ctx, model.Operation{
+		OrganizationId:   organizationId,
+		Type:             domain.OperationType_STACK_SCALE,
+		TargetId:         cluster.ID.String(),
+		TargetName:       cluster.Name,
+		WorkflowTemplate: "tks-stack-scale",
+	}, []string{
+		fmt.Sprintf("tks_api_url=%s", viper.GetString("external-address")),
+		"organization_id=" + organizationId,
+		"cluster_id=" + cluster.ID.String(),
+		"cloud_account_id=" + cluster.CloudAccount.ID.String(),
+		"stack_template_id=" + cluster.StackTemplate.ID.String(),
+		"node_group=" + nodeGroup,
+		"desired_size=" + strconv.Itoa(desiredSize),
+		"base_repo_branch=" + viper.GetString("revision"),
+	})
+	if err != nil {
+		return out, operation, err
+	}
+	out.TaskId = operation.ID.String()
+	out.OperationId = operation.ID.String()
+	out.OperationStatus = operation.Status
+
+	*size = desiredSize
+	*maxSize = desiredSize
+	userId := user.GetUserId()
+	cluster.UpdatorId = &userId
+	if err := u.clusterRepo.UpdateNodeConf(ctx, cluster); err != nil {
+		log.Error(ctx, err)
+		return out, operation, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	log.Infof(ctx, "scaled node group %s of stack %s from %d to %d", nodeGroup, stackId, out.PreviousSize, desiredSize)
+
+	return out, operation, nil
+}
+
+// stackNodeGroupSize 는 node group 의 노드 수와 최대 노드 수 필드를 반환한다. 알 수 없는 node group 이면 nil 이다.
+func stackNodeGroupSize(cluster *model.Cluster, nodeGroup string) (size *int, maxSize *int) {
+	switch nodeGroup {
+	case domain.StackNodeGroup_CONTROL_PLANE:
+		return &cluster.TksCpNode, &cluster.TksCpNodeMax
+	case domain.StackNodeGroup_INFRA:
+		return &cluster.TksInfraNode, &cluster.TksInfraNodeMax
+	case domain.StackNodeGroup_USER:
+		return &cluster.TksUserNode, &cluster.TksUserNodeMax
+	}
+	return nil, nil
+}
+
+func stackNodeGroupType(cluster model.Cluster, nodeGroup string) string {
+	switch nodeGroup {
+	case domain.StackNodeGroup_CONTROL_PLANE:
+		return cluster.TksCpNodeType
+	case domain.StackNodeGroup_INFRA:
+		return cluster.TksInfraNodeType
+	case domain.StackNodeGroup_USER:
+		return cluster.TksUserNodeType
+	}
+	return ""
+}
+
+// stackNodeGroupLimits 는 스택 템플릿의 cloud service 와 kube type 에 따라 크기를 바꿀 수 있는 node group 과 노드 수 제한을 정한다.
+// BYOH 는 노드를 직접 등록하므로 변경할 수 없고, control plane 은 etcd quorum 을 위해 홀수로만 변경한다.
+func stackNodeGroupLimits(stackTemplate model.StackTemplate) map[string]stackNodeGroupLimit {
+	maxSize := viper.GetInt("stack-node-group-max-size")
+	if maxSize <= 0 {
+		maxSize = defaultStackNodeGroupMaxSize
+	}
+
+	switch {
+	case stackTemplate.CloudService == domain.CloudService_BYOH:
+		return nil
+	case stackTemplate.CloudService == domain.CloudService_AWS && stackTemplate.KubeType == "AWS":
+		return map[string]stackNodeGroupLimit{
+			domain.StackNodeGroup_CONTROL_PLANE: {min: 1, max: 5, step: 2},
+			domain.StackNodeGroup_INFRA:         {min: 1, max: maxSize, step: 1},
+			// user 노드는 가용영역마다 같은 수로 배치한다.
+			domain.StackNodeGroup_USER: {min: domain.MAX_AZ_NUM, max: maxSize, step: domain.MAX_AZ_NUM},
+		}
+	}
+	return map[string]stackNodeGroupLimit{
+		domain.StackNodeGroup_INFRA: {min: 1, max: maxSize, step: 1},
+		domain.StackNodeGroup_USER:  {min: 1, max: maxSize, step: 1},
+	}
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// image-builder 로 만든 이미지는 kubernetes 버전을 tag 로 남긴다. tag 가 없으면 이미지 이름에서 버전을 찾는다.
+var nodeImageKubeVersionTags = []string{"kubernetes_version", "kubernetes-version", "k8s-version"}
+
+func nodeImageIds(conf model.StackConf) []string {
+	out := []string{}
+	for _, imageId := range []string{conf.TksCpNodeImage, conf.TksInfraNodeImage, conf.TksUserNodeImage} {
+		if imageId != "" && !slices.Contains(out, imageId) {
+			out = append(out, imageId)
+		}
+	}
+	return out
+}
+
+// validateNodeImages 는 노드 그룹별로 지정한 이미지가 사용 가능하고, 스택 템플릿의 kubernetes 버전으로 만들어졌는지 확인한다.
+func validateNodeImages(ctx context.Context, cloudAccount model.CloudAccount, region string, kubeVersion string, conf model.StackConf) error {
+	imageIds := nodeImageIds(conf)
+	if len(imageIds) == 0 {
+		return nil
+	}
+	if region == "" {
+		region = "ap-northeast-2"
+	}
+
+	client, err := newCloudAccountEc2Client(ctx, cloudAccount, region)
+	if err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	res, err := client.DescribeImages(ctx, &ec2.DescribeImagesInput{
+		ImageIds: imageIds,
+	})
+	if err != nil {
+		return httpErrors.NewBadRequestError(fmt.Errorf("failed to describe node images. %v", err), "S_INVALID_NODE_IMAGE", "")
+	}
+
+	images := map[string]ec2types.Image{}
+	for _, image := range res.Images {
+		images[aws.ToString(image.ImageId)] = image
+	}
+	for _, imageId := range imageIds {
+		image, ok := images[imageId]
+		if !ok {
+			return httpErrors.NewBadRequestError(fmt.Errorf("not found node image %s in region %s", imageId, region), "S_INVALID_NODE_IMAGE", "")
+		}
+		if image.State != ec2types.ImageStateAvailable {
+			return httpErrors.NewBadRequestError(fmt.Errorf("node image %s is %s", imageId, image.State), "S_INVALID_NODE_IMAGE", "")
+		}
+		if !matchNodeImageKubeVersion(image, kubeVersion) {
+			return httpErrors.NewBadRequestError(fmt.Errorf("node image %s is not built for kubernetes %s", imageId, kubeVersion), "S_INVALID_NODE_IMAGE", "")
+		}
+	}
+	return nil
+}
+
+// matchNodeImageKubeVersion 은 patch 버전은 무시하고 major.minor 버전이 같은지 확인한다.
+func matchNodeImageKubeVersion(image ec2types.Image, kubeVersion string) bool {
+	minor := kubeMinorVersion(kubeVersion)
+	if minor == "" {
+		return true
+	}
+
+	for _, tag := range image.Tags {
+		for _, key := range nodeImageKubeVersionTags {
+			if aws.ToString(tag.Key) == key {
+				return kubeMinorVersion(aws.ToString(tag.Value)) == minor
+			}
+		}
+	}
+	name := aws.ToString(image.Name)
+	return strings.Contains(name, "v"+minor+".") || strings.Contains(name, "-"+minor+".")
+}
+
+func kubeMinorVersion(version string) string {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(version), "v"), ".")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[0] + "." + parts[1]
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+
+// GetNodes 는 스택 클러스터의 노드 목록에 thanos 의 노드별 cpu, memory, disk 사용률을 더해 반환한다.
+// 사용률 조회에 실패해도 노드 목록은 반환하고, 원인은 UsageError 에 담는다.
+func (u *StackUsecase) GetNodes(ctx context.Context, organizationId string, stackId domain.StackId) (out domain.GetStackNodesResponse, err error) {
+	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(stackId))
+	if err != nil {
+		return out, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
+	}
+	if cluster.OrganizationId != organizationId {
+		return out, httpErrors.NewError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID")
+	}
+	if cluster.Status != domain.ClusterStatus_RUNNING {
+		return out, httpErrors.NewError(fmt.Errorf("stack %s is %s", stackId, cluster.Status), "S_NOT_RUNNING_STACK")
+	}
+
+	clientset, err := kubernetes.GetClientFromClusterId(ctx, cluster.ID.String())
+	if err != nil {
+		return out, httpErrors.NewError(err, "S_FAILED_FETCH_NODES")
+	}
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return out, httpErrors.NewError(err, "S_FAILED_FETCH_NODES")
+	}
+
+	usages := map[string]domain.StackNodeUsage{}
+	metrics, err := u.dashbordUsecase.GetStackNodes(ctx, organizationId, stackId)
+	if err != nil {
+		log.Info(ctx, err)
+		out.UsageError = err.Error()
+	}
+	for _, metric := range metrics {
+		usages[metric.Name] = domain.StackNodeUsage{Cpu: metric.Cpu, Memory: metric.Memory, Disk: metric.Disk}
+	}
+
+	out.Nodes = make([]domain.StackNodeResponse, len(nodes.Items))
+	for i, node := range nodes.Items {
+		out.Nodes[i] = newStackNodeResponse(node)
+		out.Nodes[i].Usage = usages[node.Name]
+	}
+	sort.Slice(out.Nodes, func(i, j int) bool {
+		return out.Nodes[i].Name < out.Nodes[j].Name
+	})
+	return out, nil
+}
+
+func newStackNodeResponse(node corev1.Node) domain.StackNodeResponse {
+	out := domain.StackNodeResponse{
+		Name:             node.Name,
+		Roles:            []string{},
+		InstanceType:     node.Labels[corev1.LabelInstanceTypeStable],
+		Zone:             node.Labels[corev1.LabelTopologyZone],
+		KubeletVersion:   node.Status.NodeInfo.KubeletVersion,
+		OsImage:          node.Status.NodeInfo.OSImage,
+		ContainerRuntime: node.Status.NodeInfo.ContainerRuntimeVersion,
+		Unschedulable:    node.Spec.Unschedulable,
+		Capacity:         map[string]string{},
+		Allocatable:      map[string]string{},
+		Taints:           []domain.StackNodeTaint{},
+		Conditions:       []domain.StackNodeCondition{},
+		CreatedAt:        node.CreationTimestamp.Time,
+	}
+
+	for label := range node.Labels {
+		if role, ok := strings.CutPrefix(label, nodeRoleLabelPrefix); ok && role != "" {
+			out.Roles = append(out.Roles, role)
+		}
+	}
+	sort.Strings(out.Roles)
+
+	for _, address := range node.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP {
+			out.InternalIp = address.Address
+			break
+		}
+	}
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourceEphemeralStorage, corev1.ResourcePods} {
+		if quantity, ok := node.Status.Capacity[name]; ok {
+			out.Capacity[string(name)] = quantity.String()
+		}
+		if quantity, ok := node.Status.Allocatable[name]; ok {
+			out.Allocatable[string(name)] = quantity.String()
+		}
+	}
+	for _, taint := range node.Spec.Taints {
+		out.Taints = append(out.Taints, domain.StackNodeTaint{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: string(taint.Effect),
+		})
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			out.Ready = condition.Status == corev1.ConditionTrue
+		}
+		out.Conditions = append(out.Conditions, domain.StackNodeCondition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime.Time,
+		})
+	}
+	return out
+}
@@ -59,13 +59,13 @@ func (u *StackTemplateUsecase) Create(ctx context.Context, dto model.StackTempla
 	dto.UpdatorId = &userId
 
 	if _, err = u.GetByName(ctx, dto.Name); err == nil {
-		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("duplicate stackTemplate name"), "ST_CREATE_ALREADY_EXISTED_NAME", "")
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("duplicate stackTemplate name"), "ST_CREATE_ALREADY_EXISTED_NAME")
 	}
 
 	dto.Services = servicesFromIds(dto.ServiceIds)
 	stackTemplateId, err = u.repo.Create(ctx, dto)
 	if err != nil {
-		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	log.Info(ctx, "newly created StackTemplate ID:", stackTemplateId)
 
@@ -81,7 +81,7 @@ func (u *StackTemplateUsecase) Create(ctx context.Context, dto model.StackTempla
 func (u *StackTemplateUsecase) Update(ctx context.Context, dto model.StackTemplate) error {
 	_, err := u.repo.Get(ctx, dto.ID)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "ST_NOT_EXISTED_STACK_TEMPLATE", "")
+		return httpErrors.NewError(err, "ST_NOT_EXISTED_STACK_TEMPLATE")
 	}
 
 	dto.Services = servicesFromIds(dto.ServiceIds)
@@ -110,7 +110,7 @@ func (u *StackTemplateUsecase) GetByName(ctx context.Context, name string) (out
 	out, err = u.repo.GetByName(ctx, name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return out, httpErrors.NewNotFoundError(err, "ST_FAILED_FETCH_STACK_TEMPLATE", "")
+			return out, httpErrors.NewError(err, "ST_FAILED_FETCH_STACK_TEMPLATE")
 		}
 		return out, err
 	}
@@ -154,7 +154,7 @@ func (u *StackTemplateUsecase) Delete(ctx context.Context, stackTemplateId uuid.
 		return err
 	}
 	if len(res) > 0 {
-		return httpErrors.NewBadRequestError(fmt.Errorf("Failed to delete stackTemplate %s", stackTemplateId.String()), "ST_FAILED_DELETE_EXIST_CLUSTERS", "")
+		return httpErrors.NewError(fmt.Errorf("Failed to delete stackTemplate %s", stackTemplateId.String()), "ST_FAILED_DELETE_EXIST_CLUSTERS")
 	}
 
 	err = u.repo.Delete(ctx, stackTemplate)
@@ -168,7 +168,7 @@ func (u *StackTemplateUsecase) Delete(ctx context.Context, stackTemplateId uuid.
 func (u *StackTemplateUsecase) UpdateOrganizations(ctx context.Context, dto model.StackTemplate) error {
 	_, err := u.repo.Get(ctx, dto.ID)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "ST_NOT_EXISTED_STACK_TEMPLATE", "")
+		return httpErrors.NewError(err, "ST_NOT_EXISTED_STACK_TEMPLATE")
 	}
 
 	organizations := make([]model.Organization, 0)
@@ -181,7 +181,7 @@ func (u *StackTemplateUsecase) UpdateOrganizations(ctx context.Context, dto mode
 
 	err = u.repo.UpdateOrganizations(ctx, dto.ID, organizations)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "ST_FAILED_UPDATE_ORGANIZATION", "")
+		return httpErrors.NewError(err, "ST_FAILED_UPDATE_ORGANIZATION")
 	}
 
 	return nil
@@ -190,7 +190,7 @@ func (u *StackTemplateUsecase) UpdateOrganizations(ctx context.Context, dto mode
 func (u *StackTemplateUsecase) AddOrganizationStackTemplates(ctx context.Context, organizationId string, stackTemplateIds []string) error {
 	_, err := u.organizationRepo.Get(ctx, organizationId)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "O_NOT_EXISTED_NAME", "")
+		return httpErrors.NewError(err, "O_NOT_EXISTED_NAME")
 	}
 
 	stackTemplates := make([]model.StackTemplate, 0)
@@ -204,7 +204,7 @@ func (u *StackTemplateUsecase) AddOrganizationStackTemplates(ctx context.Context
 
 	err = u.organizationRepo.AddStackTemplates(ctx, organizationId, stackTemplates)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "ST_FAILED_ADD_ORGANIZATION_STACK_TEMPLATE", "")
+		return httpErrors.NewError(err, "ST_FAILED_ADD_ORGANIZATION_STACK_TEMPLATE")
 	}
 
 	return nil
@@ -213,7 +213,7 @@ func (u *StackTemplateUsecase) AddOrganizationStackTemplates(ctx context.Context
 func (u *StackTemplateUsecase) RemoveOrganizationStackTemplates(ctx context.Context, organizationId string, stackTemplateIds []string) error {
 	_, err := u.organizationRepo.Get(ctx, organizationId)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "O_NOT_EXISTED_NAME", "")
+		return httpErrors.NewError(err, "O_NOT_EXISTED_NAME")
 	}
 
 	stackTemplates := make([]model.StackTemplate, 0)
@@ -227,7 +227,7 @@ func (u *StackTemplateUsecase) RemoveOrganizationStackTemplates(ctx context.Cont
 
 	err = u.organizationRepo.RemoveStackTemplates(ctx, organizationId, stackTemplates)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "ST_FAILED_REMOVE_ORGANIZATION_STACK_TEMPLATE", "")
+		return httpErrors.NewError(err, "ST_FAILED_REMOVE_ORGANIZATION_STACK_TEMPLATE")
 	}
 
 	return nil
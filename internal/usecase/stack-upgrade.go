@@ -0,0 +1,283 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+const defaultStackUpgradeMaxUsage = 80
+
+// Upgrade 는 사전 점검과 버전 호환성 확인을 통과하면 스택 업그레이드 workflow 를 제출하고 이력을 남긴다.
+// 동시 실행 제한을 넘으면 operation 은 대기열에 들어가고, 스택 템플릿은 업그레이드가 완료된 후 SyncUpgrades 에서 바뀐다.
+func (u *StackUsecase) Upgrade(ctx context.Context, organizationId string, stackId domain.StackId, stackTemplateId uuid.UUID) (out model.StackUpgrade, operation model.Operation, err error) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return out, operation, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+
+	cluster, err := u.getOrganizationStack(ctx, organizationId, stackId)
+	if err != nil {
+		return out, operation, err
+	}
+	if cluster.Status != domain.ClusterStatus_RUNNING {
+		return out, operation, httpErrors.NewError(fmt.Errorf("stack %s is %s", stackId, cluster.Status), "S_NOT_RUNNING_STACK")
+	}
+
+	if active, err := u.stackUpgradeRepo.GetActive(ctx, cluster.ID); err == nil {
+		return out, operation, httpErrors.NewError(fmt.Errorf("upgrade %s of stack %s is %s", active.ID, stackId, active.Status), "S_UPGRADE_IN_PROGRESS")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return out, operation, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	stackTemplate, err := u.stackTemplateRepo.Get(ctx, stackTemplateId)
+	if err != nil {
+		return out, operation, httpErrors.NewBadRequestError(errors.Wrap(err, "Invalid stackTemplateId"), "S_INVALID_STACK_TEMPLATE", "")
+	}
+	if err = validateStackUpgrade(organizationId, cluster.StackTemplate, stackTemplate); err != nil {
+		return out, operation, httpErrors.NewError(err, "S_INCOMPATIBLE_STACK_TEMPLATE")
+	}
+
+	checks := u.stackUpgradePreflight(ctx, cluster)
+	failures := []string{}
+	for _, check := range checks {
+		if !check.Passed && !check.Skipped {
+			failures = append(failures, check.Message)
+		}
+	}
+	if len(failures) > 0 {
+		return out, operation, httpErrors.NewError(fmt.Errorf("%s", strings.Join(failures, ", ")), "S_UPGRADE_PREFLIGHT_FAILED")
+	}
+
+	operation, err = u.operations.Submit(ctx, model.Operation{
+		OrganizationId:   organizationId,
+		Type:             domain.OperationType_STACK_UPGRADE,
+		TargetId:         cluster.ID.String(),
+		TargetName:       cluster.Name,
+		WorkflowTemplate: "tks-stack-upgrade",
+	}, []string{
+		fmt.Sprintf("tks_api_url=%s", viper.GetString("external-address")),
+		"organization_id=" + organizationId,
+		"cluster_id=" + cluster.ID.String(),
+		"cloud_account_id=" + cluster.CloudAccount.ID.String(),
+		"from_stack_template_id=" + cluster.StackTemplateId.String(),
+		"stack_template_id=" + stackTemplate.ID.String(),
+		"kube_version=" + stackTemplate.KubeVersion,
+		"base_repo_branch=" + viper.GetString("revision"),
+	})
+	if err != nil {
+		return out, operation, err
+	}
+
+	userId := user.GetUserId()
+	out = model.StackUpgrade{
+		OrganizationId:      organizationId,
+		ClusterId:           cluster.ID,
+		FromStackTemplateId: cluster.StackTemplateId,
+		FromStackTemplate:   cluster.StackTemplate,
+		ToStackTemplateId:   stackTemplate.ID,
+		ToStackTemplate:     stackTemplate,
+		FromKubeVersion:     cluster.StackTemplate.KubeVersion,
+		ToKubeVersion:       stackTemplate.KubeVersion,
+		OperationId:         operation.ID,
+		Status:              operation.Status,
+		StatusDesc:          operation.StatusDesc,
+		CreatorId:           &userId,
+		CreatedAt:           time.Now(),
+	}
+	if out.Preflight, err = json.Marshal(checks); err != nil {
+		log.Error(ctx, err)
+	}
+	if out.ID, err = u.stackUpgradeRepo.Create(ctx, out); err != nil {
+		// workflow 는 이미 제출되었으므로 이력 저장 실패는 기록만 한다.
+		log.Error(ctx, err)
+	}
+	return out, operation, nil
+}
+
+func (u *StackUsecase) FetchUpgrades(ctx context.Context, organizationId string, stackId domain.StackId, pg *pagination.Pagination) ([]model.StackUpgrade, error) {
+	if _, err := u.getOrganizationStack(ctx, organizationId, stackId); err != nil {
+		return nil, err
+	}
+	return u.stackUpgradeRepo.Fetch(ctx, domain.ClusterId(stackId), pg)
+}
+
+// SyncUpgrades 는 진행 중인 업그레이드에 operation 의 상태를 반영하고, 완료된 업그레이드의 스택 템플릿을 바꾼다.
+func (u *StackUsecase) SyncUpgrades(ctx context.Context) error {
+	upgrades, err := u.stackUpgradeRepo.FetchActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, upgrade := range upgrades {
+		operation, err := u.operationRepo.Get(ctx, upgrade.OperationId)
+		if err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		if operation.Status == upgrade.Status {
+			continue
+		}
+
+		if operation.Status == domain.OperationStatus_COMPLETED {
+			// 실패하면 다음 주기에 재시도한다.
+			if err := u.clusterRepo.UpdateStackTemplate(ctx, upgrade.ClusterId, upgrade.ToStackTemplateId); err != nil {
+				log.Error(ctx, err)
+				continue
+			}
+			log.Infof(ctx, "stack %s is upgraded to kubernetes %s", upgrade.ClusterId, upgrade.ToKubeVersion)
+		}
+
+		upgrade.Status = operation.Status
+		upgrade.StatusDesc = operation.StatusDesc
+		switch operation.Status {
+		case domain.OperationStatus_COMPLETED, domain.OperationStatus_FAILED, domain.OperationStatus_CANCELED:
+			now := time.Now()
+			upgrade.CompletedAt = &now
+		}
+		if err := u.stackUpgradeRepo.UpdateStatus(ctx, upgrade); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+	return nil
+}
+
+func (u *StackUsecase) getOrganizationStack(ctx context.Context, organizationId string, stackId domain.StackId) (model.Cluster, error) {
+	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(stackId))
+	if err != nil {
+		return cluster, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
+	}
+	if cluster.OrganizationId != organizationId {
+		return cluster, httpErrors.NewError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID")
+	}
+	return cluster, nil
+}
+
+// stackUpgradePreflight 는 처리되지 않은 critical 알림과 노드 사용률을 점검한다.
+// kubernetes 지원 종료 알림은 업그레이드로 해소되므로 점검에서 제외한다.
+func (u *StackUsecase) stackUpgradePreflight(ctx context.Context, cluster model.Cluster) []domain.StackUpgradeCheck {
+	checks := []domain.StackUpgradeCheck{}
+
+	alerts := domain.StackUpgradeCheck{Name: domain.StackUpgradeCheck_ALERTS}
+	notifications, err := u.systemNotificationRepo.FetchOpenByClusterId(ctx, cluster.ID)
+	if err != nil {
+		log.Error(ctx, err)
+		alerts.Skipped = true
+		alerts.Message = "failed to fetch system notifications"
+	} else {
+		critical := 0
+		for _, notification := range notifications {
+			if notification.Severity == "critical" && notification.Name != KUBERNETES_EOL_NOTIFICATION_NAME {
+				critical++
+			}
+		}
+		alerts.Passed = critical == 0
+		alerts.Message = fmt.Sprintf("%d open critical alerts", critical)
+	}
+	checks = append(checks, alerts)
+
+	// 노드를 차례로 교체하는 동안 나머지 노드가 workload 를 받을 수 있어야 한다.
+	capacity := domain.StackUpgradeCheck{Name: domain.StackUpgradeCheck_CAPACITY}
+	maxUsage := viper.GetFloat64("stack-upgrade-max-usage")
+	if maxUsage <= 0 {
+		maxUsage = defaultStackUpgradeMaxUsage
+	}
+	nodes, err := u.dashbordUsecase.GetStackNodes(ctx, cluster.OrganizationId, domain.StackId(cluster.ID))
+	cpu, memory := averageNodeUsage(nodes)
+	if err != nil || cpu < 0 || memory < 0 {
+		if err != nil {
+			log.Info(ctx, err)
+		}
+		capacity.Skipped = true
+		capacity.Message = "node usage is not available"
+	} else {
+		capacity.Passed = cpu <= maxUsage && memory <= maxUsage
+		capacity.Message = fmt.Sprintf("average node usage cpu %.1f%%, memory %.1f%% (max %.0f%%)", cpu, memory, maxUsage)
+	}
+	checks = append(checks, capacity)
+
+	return checks
+}
+
+// averageNodeUsage 는 노드의 평균 cpu, memory 사용률(%)이다. 값이 하나도 없으면 -1 이다.
+func averageNodeUsage(nodes []domain.DashboardNode) (cpu float64, memory float64) {
+	average := func(value func(node domain.DashboardNode) string) float64 {
+		sum, count := 0.0, 0
+		for _, node := range nodes {
+			if f, err := strconv.ParseFloat(strings.TrimSuffix(value(node), "%"), 64); err == nil {
+				sum += f
+				count++
+			}
+		}
+		if count == 0 {
+			return -1
+		}
+		return sum / float64(count)
+	}
+	return average(func(node domain.DashboardNode) string { return node.Cpu }), average(func(node domain.DashboardNode) string { return node.Memory })
+}
+
+// validateStackUpgrade 는 같은 cloud service, kube type 이면서 조직에 할당된 스택 템플릿으로만 업그레이드를 허용한다.
+// kubernetes 버전은 낮출 수 없고 minor 버전을 건너뛸 수 없다.
+func validateStackUpgrade(organizationId string, current model.StackTemplate, target model.StackTemplate) error {
+	if current.ID == target.ID {
+		return fmt.Errorf("stack already uses stack template %s", target.Name)
+	}
+	if current.CloudService != target.CloudService || current.KubeType != target.KubeType {
+		return fmt.Errorf("stack template %s is %s/%s, but stack is %s/%s", target.Name, target.CloudService, target.KubeType, current.CloudService, current.KubeType)
+	}
+
+	assigned := false
+	for _, organization := range target.Organizations {
+		if organization.ID == organizationId {
+			assigned = true
+			break
+		}
+	}
+	if !assigned {
+		return fmt.Errorf("stack template %s is not assigned to organization %s", target.Name, organizationId)
+	}
+
+	currentMajor, currentMinor, ok := parseKubeMinorVersion(current.KubeVersion)
+	if !ok {
+		return fmt.Errorf("invalid kubernetes version %s of stack", current.KubeVersion)
+	}
+	targetMajor, targetMinor, ok := parseKubeMinorVersion(target.KubeVersion)
+	if !ok {
+		return fmt.Errorf("invalid kubernetes version %s of stack template %s", target.KubeVersion, target.Name)
+	}
+	if targetMajor != currentMajor || targetMinor < currentMinor || targetMinor > currentMinor+1 {
+		return fmt.Errorf("kubernetes %s can not be upgraded to %s", current.KubeVersion, target.KubeVersion)
+	}
+	return nil
+}
+
+func parseKubeMinorVersion(version string) (major int, minor int, ok bool) {
+	parts := strings.Split(kubeMinorVersion(version), ".")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
@@ -0,0 +1,376 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/hook"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	stackWebhookQueueSize    = 1000
+	stackWebhookWorkerCount  = 2
+	stackWebhookMaxAttempts  = 3
+	stackWebhookRetryBackoff = time.Second
+	stackWebhookTimeout      = 10 * time.Second
+
+	stackLifecycleResource_CLUSTER  = "cluster"
+	stackLifecycleResource_APPGROUP = "appgroup"
+)
+
+type IStackWebhookUsecase interface {
+	Create(ctx context.Context, dto model.StackWebhook) (stackWebhookId uuid.UUID, err error)
+	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.StackWebhook, error)
+	Get(ctx context.Context, organizationId string, stackWebhookId uuid.UUID) (model.StackWebhook, error)
+	Update(ctx context.Context, dto model.StackWebhook) error
+	Delete(ctx context.Context, organizationId string, stackWebhookId uuid.UUID) error
+	Dispatch(ctx context.Context) error
+}
+
+type StackWebhookUsecase struct {
+	repo          repository.IStackWebhookRepository
+	clusterRepo   repository.IClusterRepository
+	appGroupRepo  repository.IAppGroupRepository
+	encryptionKey IEncryptionKeyUsecase
+	client        *http.Client
+	queue         chan stackWebhookDelivery
+	initialized   bool
+}
+
+type stackWebhookDelivery struct {
+	stackWebhook model.StackWebhook
+	event        domain.StackLifecycleEvent
+}
+
+// NewStackWebhookUsecase 는 event 를 webhook 으로 보내는 worker 를 함께 시작한다.
+func NewStackWebhookUsecase(r repository.Repository, encryptionKey IEncryptionKeyUsecase) IStackWebhookUsecase {
+	u := &StackWebhookUsecase{
+		repo:          r.StackWebhook,
+		clusterRepo:   r.Cluster,
+		appGroupRepo:  r.AppGroup,
+		encryptionKey: encryptionKey,
+		client:        helper.NewOutboundHttpClient(stackWebhookTimeout, nil),
+		queue:         make(chan stackWebhookDelivery, stackWebhookQueueSize),
+	}
+	for i := 0; i < stackWebhookWorkerCount; i++ {
+		go u.run()
+	}
+	return u
+}
+
+func (u *StackWebhookUsecase) Create(ctx context.Context, dto model.StackWebhook) (stackWebhookId uuid.UUID, err error) {
+	if requestUser, ok := request.UserFrom(ctx); ok {
+		userId := requestUser.GetUserId()
+		dto.CreatorId = &userId
+	}
+	if err := helper.ValidateOutboundUrl(ctx, dto.Url); err != nil {
+		return uuid.Nil, httpErrors.NewBadRequestError(err, "S_INVALID_STACK_WEBHOOK", "")
+	}
+	if dto.Secret, err = sealOrganizationSecret(ctx, u.encryptionKey, dto.OrganizationId, dto.Secret); err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	stackWebhookId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return stackWebhookId, nil
+}
+
+func (u *StackWebhookUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.StackWebhook, error) {
+	return u.repo.Fetch(ctx, organizationId, pg)
+}
+
+func (u *StackWebhookUsecase) Get(ctx context.Context, organizationId string, stackWebhookId uuid.UUID) (model.StackWebhook, error) {
+	stackWebhook, err := u.repo.Get(ctx, stackWebhookId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.StackWebhook{}, httpErrors.NewError(err, "S_NOT_FOUND_STACK_WEBHOOK")
+		}
+		return model.StackWebhook{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if stackWebhook.OrganizationId != organizationId {
+		return model.StackWebhook{}, httpErrors.NewError(fmt.Errorf("not found stack webhook in organization"), "S_NOT_FOUND_STACK_WEBHOOK")
+	}
+	return stackWebhook, nil
+}
+
+// Update 는 secret 이 비어 있으면 기존 secret 을 유지한다.
+func (u *StackWebhookUsecase) Update(ctx context.Context, dto model.StackWebhook) error {
+	stackWebhook, err := u.Get(ctx, dto.OrganizationId, dto.ID)
+	if err != nil {
+		return err
+	}
+	if dto.Secret, err = sealOrganizationSecret(ctx, u.encryptionKey, dto.OrganizationId, dto.Secret); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if dto.Secret == "" {
+		dto.Secret = stackWebhook.Secret
+	}
+	if err := helper.ValidateOutboundUrl(ctx, dto.Url); err != nil {
+		return httpErrors.NewBadRequestError(err, "S_INVALID_STACK_WEBHOOK", "")
+	}
+
+	if err := u.repo.Update(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *StackWebhookUsecase) Delete(ctx context.Context, organizationId string, stackWebhookId uuid.UUID) error {
+	if _, err := u.Get(ctx, organizationId, stackWebhookId); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, stackWebhookId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// Dispatch 는 클러스터와 앱그룹의 상태를 마지막으로 확인한 상태와 비교해 바뀐 것을 event 로 보낸다.
+// 클러스터, 앱그룹의 상태는 workflow 가 직접 갱신하므로 주기적으로 비교해서 변경을 감지한다.
+// 기록된 상태가 없을 때 처음 실행하면 기존 리소스를 created event 로 보내지 않도록 상태만 기록한다.
+func (u *StackWebhookUsecase) Dispatch(ctx context.Context) error {
+	states, err := u.repo.FetchStates(ctx)
+	if err != nil {
+		return err
+	}
+	initialized := u.initialized || len(states) > 0
+	u.initialized = true
+	previous := make(map[string]model.StackLifecycleState, len(states))
+	for _, state := range states {
+		previous[state.ResourceId] = state
+	}
+
+	current, err := u.currentStates(ctx)
+	if err != nil {
+		return err
+	}
+
+	var events []domain.StackLifecycleEvent
+	for _, state := range current {
+		prev, ok := previous[state.ResourceId]
+		delete(previous, state.ResourceId)
+		if ok && prev.Status == state.Status {
+			continue
+		}
+
+		if initialized {
+			events = append(events, stackLifecycleEvent(prev, state, ok))
+		}
+		if err := u.repo.SaveState(ctx, state.StackLifecycleState); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+
+	// 더 이상 조회되지 않는 리소스는 삭제된 것으로 본다.
+	for _, prev := range previous {
+		if prev.Status != domain.ClusterStatus_DELETED.String() {
+			deleted := stackLifecycleSnapshot{StackLifecycleState: prev}
+			deleted.Status = domain.ClusterStatus_DELETED.String()
+			events = append(events, stackLifecycleEvent(prev, deleted, true))
+		}
+		if err := u.repo.DeleteState(ctx, prev.ResourceId); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+	stackWebhooks, err := u.repo.FetchEnabled(ctx)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		for _, stackWebhook := range stackWebhooks {
+			if stackWebhook.OrganizationId != event.OrganizationId || !stackWebhookSubscribes(stackWebhook, event.Type) {
+				continue
+			}
+			select {
+			case u.queue <- stackWebhookDelivery{stackWebhook: stackWebhook, event: event}:
+			default:
+				log.Warnf(ctx, "stack webhook queue is full. event %s is not sent to stack webhook %s", event.Type, stackWebhook.ID)
+				u.updateLastStatus(ctx, stackWebhook, fmt.Errorf("stack webhook queue is full"))
+			}
+		}
+	}
+	return nil
+}
+
+// stackLifecycleSnapshot 은 event 본문에만 사용하는 상태 설명을 함께 담는다.
+type stackLifecycleSnapshot struct {
+	model.StackLifecycleState
+	StatusDesc string
+}
+
+func (u *StackWebhookUsecase) currentStates(ctx context.Context) ([]stackLifecycleSnapshot, error) {
+	clusters, err := u.clusterRepo.Fetch(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]stackLifecycleSnapshot, 0, len(clusters))
+	for _, cluster := range clusters {
+		out = append(out, stackLifecycleSnapshot{
+			StackLifecycleState: model.StackLifecycleState{
+				ResourceId:     cluster.ID.String(),
+				ResourceType:   stackLifecycleResource_CLUSTER,
+				OrganizationId: cluster.OrganizationId,
+				ClusterId:      cluster.ID.String(),
+				Name:           cluster.Name,
+				Status:         cluster.Status.String(),
+			},
+			StatusDesc: cluster.StatusDesc,
+		})
+		if cluster.Status == domain.ClusterStatus_DELETED {
+			continue
+		}
+
+		appGroups, err := u.appGroupRepo.Fetch(ctx, cluster.ID, nil)
+		if err != nil {
+			log.Error(ctx, err)
+			continue
+		}
+		for _, appGroup := range appGroups {
+			out = append(out, stackLifecycleSnapshot{
+				StackLifecycleState: model.StackLifecycleState{
+					ResourceId:     appGroup.ID.String(),
+					ResourceType:   stackLifecycleResource_APPGROUP,
+					OrganizationId: cluster.OrganizationId,
+					ClusterId:      cluster.ID.String(),
+					Name:           appGroup.Name,
+					Status:         appGroup.Status.String(),
+				},
+				StatusDesc: appGroup.StatusDesc,
+			})
+		}
+	}
+	return out, nil
+}
+
+// stackLifecycleEvent 는 처음 확인한 리소스는 created, RUNNING 이 되면 running, DELETED 가 되면 deleted,
+// 그 밖의 변경은 status_changed event 로 만든다. 클러스터와 앱그룹의 상태 이름은 같다.
+func stackLifecycleEvent(prev model.StackLifecycleState, current stackLifecycleSnapshot, existed bool) domain.StackLifecycleEvent {
+	event := domain.StackLifecycleEvent{
+		ID:             uuid.New().String(),
+		OrganizationId: current.OrganizationId,
+		ResourceType:   current.ResourceType,
+		ResourceId:     current.ResourceId,
+		Name:           current.Name,
+		ClusterId:      current.ClusterId,
+		Status:         current.Status,
+		StatusDesc:     current.StatusDesc,
+		OccurredAt:     time.Now(),
+	}
+	if existed {
+		event.PreviousStatus = prev.Status
+	}
+
+	switch {
+	case !existed:
+		event.Type = current.ResourceType + ".created"
+	case current.Status == domain.ClusterStatus_RUNNING.String():
+		event.Type = current.ResourceType + ".running"
+	case current.Status == domain.ClusterStatus_DELETED.String():
+		event.Type = current.ResourceType + ".deleted"
+	default:
+		event.Type = current.ResourceType + ".status_changed"
+	}
+	return event
+}
+
+func stackWebhookSubscribes(stackWebhook model.StackWebhook, eventType string) bool {
+	if len(stackWebhook.Events) == 0 {
+		return true
+	}
+	for _, event := range stackWebhook.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *StackWebhookUsecase) run() {
+	for delivery := range u.queue {
+		u.deliver(context.Background(), delivery)
+	}
+}
+
+func (u *StackWebhookUsecase) deliver(ctx context.Context, delivery stackWebhookDelivery) {
+	var err error
+	backoff := stackWebhookRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err = u.send(ctx, delivery.stackWebhook, delivery.event)
+		if err == nil || attempt >= stackWebhookMaxAttempts {
+			break
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	if err != nil {
+		log.Warnf(ctx, "failed to send event %s to stack webhook %s. err : %s", delivery.event.Type, delivery.stackWebhook.ID, err)
+	}
+	u.updateLastStatus(ctx, delivery.stackWebhook, err)
+}
+
+func (u *StackWebhookUsecase) send(ctx context.Context, stackWebhook model.StackWebhook, event domain.StackLifecycleEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, stackWebhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if stackWebhook.Secret != "" {
+		secret, err := openOrganizationSecret(ctx, u.encryptionKey, stackWebhook.Secret)
+		if err != nil {
+			return err
+		}
+		req.Header.Set(hook.SignatureHeader, hook.Sign(secret, body))
+	}
+
+	res, err := u.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("stack webhook responded %d: %s", res.StatusCode, string(resBody))
+	}
+	return nil
+}
+
+func (u *StackWebhookUsecase) updateLastStatus(ctx context.Context, stackWebhook model.StackWebhook, sendErr error) {
+	status, reason := domain.StackWebhookStatus_SUCCEEDED, ""
+	if sendErr != nil {
+		status, reason = domain.StackWebhookStatus_FAILED, sendErr.Error()
+	}
+	if err := u.repo.UpdateLastStatus(ctx, stackWebhook.ID, status, reason, time.Now()); err != nil {
+		log.Error(ctx, err)
+	}
+}
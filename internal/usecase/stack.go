@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/openinfradev/tks-api/internal/serializer"
 	argowf "github.com/openinfradev/tks-api/pkg/argo-client"
 	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/hook"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
 	"github.com/openinfradev/tks-api/pkg/kubernetes"
 	"github.com/openinfradev/tks-api/pkg/log"
@@ -28,59 +30,101 @@ type IStackUsecase interface {
 	Get(ctx context.Context, stackId domain.StackId) (model.Stack, error)
 	GetByName(ctx context.Context, organizationId string, name string) (model.Stack, error)
 	Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.Stack, error)
-	Create(ctx context.Context, dto model.Stack) (stackId domain.StackId, err error)
+	Create(ctx context.Context, dto model.Stack) (stackId domain.StackId, operation model.Operation, err error)
 	Install(ctx context.Context, stackId domain.StackId) (err error)
 	Update(ctx context.Context, dto model.Stack) error
-	Delete(ctx context.Context, dto model.Stack) error
+	Delete(ctx context.Context, dto model.Stack) (model.Operation, error)
 	GetKubeConfig(ctx context.Context, stackId domain.StackId) (kubeConfig string, err error)
 	GetStepStatus(ctx context.Context, stackId domain.StackId) (out []domain.StackStepStatus, stackStatus string, err error)
 	SetFavorite(ctx context.Context, stackId domain.StackId) error
 	DeleteFavorite(ctx context.Context, stackId domain.StackId) error
+	GetStackDefault(ctx context.Context, organizationId string) (model.StackDefault, error)
+	UpdateStackDefault(ctx context.Context, dto model.StackDefault) error
+	DeleteStackDefault(ctx context.Context, organizationId string) error
+	GetAddonVersions(ctx context.Context, organizationId string) ([]domain.StackAddonVersionsResponse, error)
+	GetCostAllocationTagPolicies(ctx context.Context, organizationId string) ([]model.CostAllocationTagPolicy, error)
+	UpdateCostAllocationTagPolicies(ctx context.Context, organizationId string, policies []model.CostAllocationTagPolicy) error
+	CheckCostAllocationTagDrift(ctx context.Context, organizationId string, stackId domain.StackId) (domain.CheckCostAllocationTagDriftResponse, error)
+	GetNodes(ctx context.Context, organizationId string, stackId domain.StackId) (domain.GetStackNodesResponse, error)
+	ScaleNodeGroup(ctx context.Context, organizationId string, stackId domain.StackId, nodeGroup string, desiredSize int) (domain.ScaleStackNodeGroupResponse, model.Operation, error)
+	Upgrade(ctx context.Context, organizationId string, stackId domain.StackId, stackTemplateId uuid.UUID) (model.StackUpgrade, model.Operation, error)
+	FetchUpgrades(ctx context.Context, organizationId string, stackId domain.StackId, pg *pagination.Pagination) ([]model.StackUpgrade, error)
+	SyncUpgrades(ctx context.Context) error
 }
 
 type StackUsecase struct {
-	clusterRepo       repository.IClusterRepository
-	appGroupRepo      repository.IAppGroupRepository
-	cloudAccountRepo  repository.ICloudAccountRepository
-	organizationRepo  repository.IOrganizationRepository
-	stackTemplateRepo repository.IStackTemplateRepository
-	appServeAppRepo   repository.IAppServeAppRepository
-	argo              argowf.ArgoClient
-	dashbordUsecase   IDashboardUsecase
+	clusterRepo            repository.IClusterRepository
+	appGroupRepo           repository.IAppGroupRepository
+	cloudAccountRepo       repository.ICloudAccountRepository
+	organizationRepo       repository.IOrganizationRepository
+	stackTemplateRepo      repository.IStackTemplateRepository
+	appServeAppRepo        repository.IAppServeAppRepository
+	stackDefaultRepo       repository.IStackDefaultRepository
+	costAllocationTagRepo  repository.ICostAllocationTagRepository
+	heartbeatRepo          repository.IClusterHeartbeatRepository
+	namingPolicyRepo       repository.INamingPolicyRepository
+	systemNotificationRepo repository.ISystemNotificationRepository
+	operationRepo          repository.IOperationRepository
+	stackUpgradeRepo       repository.IStackUpgradeRepository
+	quotaRepo              repository.IOrganizationQuotaRepository
+	argo                   argowf.ArgoClient
+	dashbordUsecase        IDashboardUsecase
+	cacheInvalidator       ICacheInvalidator
+	operations             IOperationUsecase
 }
 
-func NewStackUsecase(r repository.Repository, argoClient argowf.ArgoClient, dashbordUsecase IDashboardUsecase) IStackUsecase {
+func NewStackUsecase(r repository.Repository, argoClient argowf.ArgoClient, dashbordUsecase IDashboardUsecase, cacheInvalidator ICacheInvalidator, operations IOperationUsecase) IStackUsecase {
 	return &StackUsecase{
-		clusterRepo:       r.Cluster,
-		appGroupRepo:      r.AppGroup,
-		cloudAccountRepo:  r.CloudAccount,
-		organizationRepo:  r.Organization,
-		stackTemplateRepo: r.StackTemplate,
-		appServeAppRepo:   r.AppServeApp,
-		argo:              argoClient,
-		dashbordUsecase:   dashbordUsecase,
+		clusterRepo:            r.Cluster,
+		appGroupRepo:           r.AppGroup,
+		cloudAccountRepo:       r.CloudAccount,
+		organizationRepo:       r.Organization,
+		stackTemplateRepo:      r.StackTemplate,
+		appServeAppRepo:        r.AppServeApp,
+		stackDefaultRepo:       r.StackDefault,
+		costAllocationTagRepo:  r.CostAllocationTag,
+		heartbeatRepo:          r.ClusterHeartbeat,
+		namingPolicyRepo:       r.NamingPolicy,
+		systemNotificationRepo: r.SystemNotification,
+		operationRepo:          r.Operation,
+		stackUpgradeRepo:       r.StackUpgrade,
+		quotaRepo:              r.OrganizationQuota,
+		argo:                   argoClient,
+		dashbordUsecase:        dashbordUsecase,
+		cacheInvalidator:       cacheInvalidator,
+		operations:             operations,
 	}
 }
 
-func (u *StackUsecase) Create(ctx context.Context, dto model.Stack) (stackId domain.StackId, err error) {
+func (u *StackUsecase) Create(ctx context.Context, dto model.Stack) (stackId domain.StackId, operation model.Operation, err error) {
 	user, ok := request.UserFrom(ctx)
 	if !ok {
-		return "", httpErrors.NewUnauthorizedError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN", "")
+		return "", operation, httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
 	}
 
+	// hook 이 바꾼 요청도 검증하도록 검증보다 먼저 실행한다. 조직은 바꿀 수 없다.
+	organizationId := dto.OrganizationId
+	if err = runHook(ctx, hook.PreStackCreate, organizationId, &dto); err != nil {
+		return "", operation, err
+	}
+	dto.OrganizationId = organizationId
+
+	if err = validateResourceName(ctx, u.namingPolicyRepo, dto.OrganizationId, domain.NamingPolicyResourceType_STACK, dto.Name); err != nil {
+		return "", operation, err
+	}
 	_, err = u.GetByName(ctx, dto.OrganizationId, dto.Name)
 	if err == nil {
-		return "", httpErrors.NewBadRequestError(httpErrors.DuplicateResource, "S_CREATE_ALREADY_EXISTED_NAME", "")
+		return "", operation, httpErrors.NewError(httpErrors.DuplicateResource, "S_CREATE_ALREADY_EXISTED_NAME")
 	}
 
 	stackTemplate, err := u.stackTemplateRepo.Get(ctx, dto.StackTemplateId)
 	if err != nil {
-		return "", httpErrors.NewInternalServerError(errors.Wrap(err, "Invalid stackTemplateId"), "S_INVALID_STACK_TEMPLATE", "")
+		return "", operation, httpErrors.NewError(errors.Wrap(err, "Invalid stackTemplateId"), "S_INVALID_STACK_TEMPLATE")
 	}
 
 	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, dto.OrganizationId, user.GetUserId(), nil)
 	if err != nil {
-		return "", httpErrors.NewInternalServerError(errors.Wrap(err, "Failed to get clusters"), "S_FAILED_GET_CLUSTERS", "")
+		return "", operation, httpErrors.NewError(errors.Wrap(err, "Failed to get clusters"), "S_FAILED_GET_CLUSTERS")
 	}
 	isPrimary := false
 	if len(clusters) == 0 {
@@ -88,20 +132,48 @@ func (u *StackUsecase) Create(ctx context.Context, dto model.Stack) (stackId dom
 	}
 	log.Debug(ctx, "isPrimary ", isPrimary)
 
+	var cloudAccount model.CloudAccount
 	if dto.CloudService == domain.CloudService_BYOH {
+		if len(nodeImageIds(dto.Conf)) > 0 {
+			return "", operation, httpErrors.NewBadRequestError(fmt.Errorf("node images are not supported for BYOH"), "S_INVALID_NODE_IMAGE", "")
+		}
 		if dto.ClusterEndpoint == "" {
-			return "", httpErrors.NewBadRequestError(fmt.Errorf("Invalid clusterEndpoint"), "S_INVALID_ADMINCLUSTER_URL", "")
+			return "", operation, httpErrors.NewError(fmt.Errorf("Invalid clusterEndpoint"), "S_INVALID_ADMINCLUSTER_URL")
 		}
 		arr := strings.Split(dto.ClusterEndpoint, ":")
 		if len(arr) != 2 {
-			return "", httpErrors.NewBadRequestError(fmt.Errorf("Invalid clusterEndpoint"), "S_INVALID_ADMINCLUSTER_URL", "")
+			return "", operation, httpErrors.NewError(fmt.Errorf("Invalid clusterEndpoint"), "S_INVALID_ADMINCLUSTER_URL")
 		}
 	} else {
-		if _, err = u.cloudAccountRepo.Get(ctx, dto.CloudAccountId); err != nil {
-			return "", httpErrors.NewInternalServerError(errors.Wrap(err, "Invalid cloudAccountId"), "S_INVALID_CLOUD_ACCOUNT", "")
+		cloudAccount, err = u.cloudAccountRepo.Get(ctx, dto.CloudAccountId)
+		if err != nil {
+			return "", operation, httpErrors.NewError(errors.Wrap(err, "Invalid cloudAccountId"), "S_INVALID_CLOUD_ACCOUNT")
+		}
+		// 폐쇄망이나 보안 강화 이미지를 사용하는 환경을 위해 노드 그룹별 이미지를 미리 검증한다.
+		if err = validateNodeImages(ctx, cloudAccount, dto.Conf.Region, stackTemplate.KubeVersion, dto.Conf); err != nil {
+			return "", operation, err
 		}
 	}
 
+	// 요청에서 생략된 값은 조직의 스택 기본값으로 채운다.
+	stackDefault, err := u.stackDefaultRepo.Get(ctx, dto.OrganizationId)
+	if err == nil {
+		if err = applyStackDefault(&dto.Conf, stackDefault); err != nil {
+			return "", operation, httpErrors.NewError(errors.Wrap(err, "Invalid stack default"), "C_INTERNAL_ERROR")
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", operation, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	// 조직의 비용 할당 tag 정책을 적용하여 stack 이 만드는 모든 클라우드 자원에 tag 가 붙도록 한다.
+	costAllocationTagPolicies, err := u.GetCostAllocationTagPolicies(ctx, dto.OrganizationId)
+	if err != nil {
+		return "", operation, err
+	}
+	if err = applyCostAllocationTagPolicies(&dto.Conf, costAllocationTagPolicies); err != nil {
+		return "", operation, httpErrors.NewError(err, "S_INVALID_COST_ALLOCATION_TAG")
+	}
+
 	// Make stack nodes
 	// [TODO] to be advanced feature
 	dto.Conf.TksCpNodeMax = dto.Conf.TksCpNode
@@ -117,51 +189,74 @@ func (u *StackUsecase) Create(ctx context.Context, dto model.Stack) (stackId dom
 
 		// user 노드는 MAX_AZ_NUM의 배수로 요청한다.
 		if dto.Conf.TksUserNode%domain.MAX_AZ_NUM != 0 {
-			return "", httpErrors.NewInternalServerError(errors.Wrap(err, "Invalid node count"), "", "")
+			return "", operation, httpErrors.NewError(errors.Wrap(err, "Invalid node count"), "C_INTERNAL_ERROR")
+		}
+	}
+
+	// 클러스터가 만들어질 때 채워지는 기본값까지 반영한 노드로 조직의 quota 를 확인한다.
+	requested := model.Cluster{
+		TksCpNode:        dto.Conf.TksCpNode,
+		TksCpNodeType:    dto.Conf.TksCpNodeType,
+		TksInfraNode:     dto.Conf.TksInfraNode,
+		TksInfraNodeType: dto.Conf.TksInfraNodeType,
+		TksUserNode:      dto.Conf.TksUserNode,
+		TksUserNodeType:  dto.Conf.TksUserNodeType,
+	}
+	requested.SetDefaultConf()
+	if err = checkStackQuota(ctx, u.quotaRepo, u.clusterRepo, dto.OrganizationId, clusterQuotaUsage(requested), clusterInstanceTypes(requested)...); err != nil {
+		return "", operation, err
+	}
+	if dto.CloudService != domain.CloudService_BYOH {
+		if err = checkCloudResourceQuota(ctx, cloudAccount, dto.Conf.Region, clusterQuotaUsage(requested)); err != nil {
+			return "", operation, err
 		}
 	}
 
 	var conf domain.StackConfResponse
 	if err := serializer.Map(ctx, dto.Conf, &conf); err != nil {
 		log.Error(ctx, err)
-		return "", httpErrors.NewInternalServerError(errors.Wrap(err, "Invalid node conf"), "", "")
-	}
-
-	workflow := "tks-stack-create"
-	workflowId, err := u.argo.SumbitWorkflowFromWftpl(ctx, workflow, argowf.SubmitOptions{
-		Parameters: []string{
-			fmt.Sprintf("tks_api_url=%s", viper.GetString("external-address")),
-			"cluster_name=" + dto.Name,
-			"description=" + dto.Description,
-			"organization_id=" + dto.OrganizationId,
-			"cloud_account_id=" + dto.CloudAccountId.String(),
-			"stack_template_id=" + dto.StackTemplateId.String(),
-			"creator=" + user.GetUserId().String(),
-			"base_repo_branch=" + viper.GetString("revision"),
-			"infra_conf=" + strings.Replace(helper.ModelToJson(conf), "\"", "\\\"", -1),
-			"cloud_service=" + dto.CloudService,
-			"cluster_endpoint=" + dto.ClusterEndpoint,
-			"policy_ids=" + strings.Join(dto.PolicyIds, ","),
-		},
+		return "", operation, httpErrors.NewError(errors.Wrap(err, "Invalid node conf"), "C_INTERNAL_ERROR")
+	}
+
+	// 동시 실행 제한을 넘으면 workflow 는 대기열에 들어가고, stack 은 workflow 가 제출된 이후에 만들어진다.
+	operation, err = u.operations.Submit(ctx, model.Operation{
+		OrganizationId:   dto.OrganizationId,
+		Type:             domain.OperationType_STACK_CREATE,
+		TargetName:       dto.Name,
+		WorkflowTemplate: "tks-stack-create",
+	}, []string{
+		fmt.Sprintf("tks_api_url=%s", viper.GetString("external-address")),
+		"cluster_name=" + dto.Name,
+		"description=" + dto.Description,
+		"organization_id=" + dto.OrganizationId,
+		"cloud_account_id=" + dto.CloudAccountId.String(),
+		"stack_template_id=" + dto.StackTemplateId.String(),
+		"creator=" + user.GetUserId().String(),
+		"base_repo_branch=" + viper.GetString("revision"),
+		"infra_conf=" + strings.Replace(helper.ModelToJson(conf), "\"", "\\\"", -1),
+		"cloud_service=" + dto.CloudService,
+		"cluster_endpoint=" + dto.ClusterEndpoint,
+		"policy_ids=" + strings.Join(dto.PolicyIds, ","),
 	})
 	if err != nil {
-		log.Error(ctx, err)
-		return "", httpErrors.NewInternalServerError(err, "S_FAILED_TO_CALL_WORKFLOW", "")
+		return "", operation, err
+	}
+	if operation.Status == domain.OperationStatus_PENDING {
+		return "", operation, nil
 	}
-	log.Debug(ctx, "Submitted workflow: ", workflowId)
 
 	// wait & get clusterId ( max 1min 	)
 	dto.ID = domain.StackId("")
 	for i := 0; i < 60; i++ {
 		time.Sleep(time.Second * 5)
-		workflow, err := u.argo.GetWorkflow(ctx, "argo", workflowId)
+		workflow, err := u.argo.GetWorkflow(ctx, "argo", operation.WorkflowId)
 		if err != nil {
-			return "", err
+			return "", operation, err
 		}
 
 		log.Debug(ctx, "workflow ", workflow)
 		if workflow.Status.Phase != "" && workflow.Status.Phase != "Running" {
-			return "", fmt.Errorf("Invalid workflow status [%s]", workflow.Status.Phase)
+			return "", operation, fmt.Errorf("Invalid workflow status [%s]", workflow.Status.Phase)
 		}
 
 		cluster, err := u.clusterRepo.GetByName(ctx, dto.OrganizationId, dto.Name)
@@ -174,23 +269,23 @@ func (u *StackUsecase) Create(ctx context.Context, dto model.Stack) (stackId dom
 		}
 	}
 
-	return dto.ID, nil
+	return dto.ID, operation, nil
 }
 
 func (u *StackUsecase) Install(ctx context.Context, stackId domain.StackId) (err error) {
 	cluster, err := u.Get(ctx, stackId)
 	if err != nil {
-		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID", "")
+		return httpErrors.NewError(fmt.Errorf("Invalid stackId"), "S_INVALID_STACK_ID")
 	}
 
 	_, err = u.stackTemplateRepo.Get(ctx, cluster.StackTemplateId)
 	if err != nil {
-		return httpErrors.NewInternalServerError(errors.Wrap(err, "Invalid stackTemplateId"), "S_INVALID_STACK_TEMPLATE", "")
+		return httpErrors.NewError(errors.Wrap(err, "Invalid stackTemplateId"), "S_INVALID_STACK_TEMPLATE")
 	}
 
 	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, cluster.OrganizationId, uuid.Nil, nil)
 	if err != nil {
-		return httpErrors.NewInternalServerError(errors.Wrap(err, "Failed to get clusters"), "S_FAILED_GET_CLUSTERS", "")
+		return httpErrors.NewError(errors.Wrap(err, "Failed to get clusters"), "S_FAILED_GET_CLUSTERS")
 	}
 	isPrimary := false
 	if len(clusters) == 0 {
@@ -199,7 +294,7 @@ func (u *StackUsecase) Install(ctx context.Context, stackId domain.StackId) (err
 	log.Debug(ctx, "isPrimary ", isPrimary)
 
 	if cluster.CloudService != domain.CloudService_BYOH {
-		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid cloud service"), "S_INVALID_CLOUD_SERVICE", "")
+		return httpErrors.NewError(fmt.Errorf("Invalid cloud service"), "S_INVALID_CLOUD_SERVICE")
 	}
 
 	// Make stack nodes
@@ -222,7 +317,7 @@ func (u *StackUsecase) Install(ctx context.Context, stackId domain.StackId) (err
 	})
 	if err != nil {
 		log.Error(ctx, err)
-		return httpErrors.NewInternalServerError(err, "S_FAILED_TO_CALL_WORKFLOW", "")
+		return httpErrors.NewError(err, "S_FAILED_TO_CALL_WORKFLOW")
 	}
 	log.Debug(ctx, "Submitted workflow: ", workflowId)
 
@@ -233,14 +328,14 @@ func (u *StackUsecase) Get(ctx context.Context, stackId domain.StackId) (out mod
 	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(stackId))
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return out, httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_CLUSTER", "")
+			return out, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
 		}
 		return out, err
 	}
 
 	organization, err := u.organizationRepo.Get(ctx, cluster.OrganizationId)
 	if err != nil {
-		return out, httpErrors.NewInternalServerError(errors.Wrap(err, fmt.Sprintf("Failed to get organization for clusterId %s", domain.ClusterId(stackId))), "S_FAILED_FETCH_ORGANIZATION", "")
+		return out, httpErrors.NewError(errors.Wrap(err, fmt.Sprintf("Failed to get organization for clusterId %s", domain.ClusterId(stackId))), "S_FAILED_FETCH_ORGANIZATION")
 	}
 
 	appGroups, err := u.appGroupRepo.Fetch(ctx, domain.ClusterId(stackId), nil)
@@ -255,7 +350,7 @@ func (u *StackUsecase) Get(ctx context.Context, stackId domain.StackId) (out mod
 	}
 
 	// Resources
-	stackResources, _ := u.dashbordUsecase.GetStacks(ctx, cluster.OrganizationId)
+	stackResources, _ := u.dashbordUsecase.GetStacks(ctx, cluster.OrganizationId, nil)
 	for _, resource := range stackResources {
 		if resource.ID == domain.StackId(cluster.ID) {
 			if err := serializer.Map(ctx, resource, &out.Resource); err != nil {
@@ -303,7 +398,7 @@ func (u *StackUsecase) GetByName(ctx context.Context, organizationId string, nam
 	cluster, err := u.clusterRepo.GetByName(ctx, organizationId, name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return out, httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_CLUSTER", "")
+			return out, httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
 		}
 		return out, err
 	}
@@ -320,12 +415,12 @@ func (u *StackUsecase) GetByName(ctx context.Context, organizationId string, nam
 func (u *StackUsecase) Fetch(ctx context.Context, organizationId string, pg *pagination.Pagination) (out []model.Stack, err error) {
 	user, ok := request.UserFrom(ctx)
 	if !ok {
-		return out, httpErrors.NewUnauthorizedError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN", "")
+		return out, httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
 	}
 
 	organization, err := u.organizationRepo.Get(ctx, organizationId)
 	if err != nil {
-		return out, httpErrors.NewInternalServerError(errors.Wrap(err, fmt.Sprintf("Failed to get organization for clusterId %s", organizationId)), "S_FAILED_FETCH_ORGANIZATION", "")
+		return out, httpErrors.NewError(errors.Wrap(err, fmt.Sprintf("Failed to get organization for clusterId %s", organizationId)), "S_FAILED_FETCH_ORGANIZATION")
 	}
 
 	clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organizationId, user.GetUserId(), pg)
@@ -333,7 +428,16 @@ func (u *StackUsecase) Fetch(ctx context.Context, organizationId string, pg *pag
 		return out, err
 	}
 
-	stackResources, _ := u.dashbordUsecase.GetStacks(ctx, organizationId)
+	stackResources, _ := u.dashbordUsecase.GetStacks(ctx, organizationId, nil)
+
+	heartbeats := make(map[domain.ClusterId]model.ClusterHeartbeat)
+	if res, err := u.heartbeatRepo.FetchByOrganizationId(ctx, organizationId); err == nil {
+		for _, heartbeat := range res {
+			heartbeats[heartbeat.ClusterId] = heartbeat
+		}
+	} else {
+		log.Error(ctx, err)
+	}
 
 	for _, cluster := range clusters {
 		appGroups, err := u.appGroupRepo.Fetch(ctx, cluster.ID, nil)
@@ -346,6 +450,15 @@ func (u *StackUsecase) Fetch(ctx context.Context, organizationId string, pg *pag
 			outStack.PrimaryCluster = true
 		}
 
+		if heartbeat, ok := heartbeats[cluster.ID]; ok {
+			lastSeenAt := heartbeat.LastSeenAt
+			outStack.LastSeenAt = &lastSeenAt
+			if outStack.Status == domain.StackStatus_RUNNING && heartbeat.Status == domain.ClusterConnectivityStatus_UNREACHABLE {
+				outStack.Status = domain.StackStatus_CLUSTER_UNREACHABLE
+				outStack.StatusDesc = heartbeat.StatusDesc
+			}
+		}
+
 		for _, appGroup := range appGroups {
 			if appGroup.AppGroupType == domain.AppGroupType_LMA {
 				applications, err := u.appGroupRepo.GetApplications(ctx, appGroup.ID, domain.ApplicationType_GRAFANA)
@@ -388,9 +501,9 @@ func (u *StackUsecase) Update(ctx context.Context, dto model.Stack) (err error)
 		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
 	}
 
-	_, err = u.clusterRepo.Get(ctx, domain.ClusterId(dto.ID))
+	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(dto.ID))
 	if err != nil {
-		return httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_CLUSTER", "")
+		return httpErrors.NewError(err, "S_FAILED_FETCH_CLUSTER")
 	}
 
 	updatorId := user.GetUserId()
@@ -404,25 +517,26 @@ func (u *StackUsecase) Update(ctx context.Context, dto model.Stack) (err error)
 	if err != nil {
 		return err
 	}
+	u.cacheInvalidator.Invalidate(ctx, CacheEvent{Kind: CacheEvent_CLUSTER_UPDATED, OrganizationId: cluster.OrganizationId, ClusterId: cluster.ID.String()})
 
 	return nil
 }
 
-func (u *StackUsecase) Delete(ctx context.Context, dto model.Stack) (err error) {
+func (u *StackUsecase) Delete(ctx context.Context, dto model.Stack) (operation model.Operation, err error) {
 	user, ok := request.UserFrom(ctx)
 	if !ok {
-		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+		return operation, httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
 	}
 
 	cluster, err := u.clusterRepo.Get(ctx, domain.ClusterId(dto.ID))
 	if err != nil {
-		return httpErrors.NewBadRequestError(errors.Wrap(err, "Failed to get cluster"), "S_FAILED_FETCH_CLUSTER", "")
+		return operation, httpErrors.NewBadRequestError(errors.Wrap(err, "Failed to get cluster"), "S_FAILED_FETCH_CLUSTER", "")
 	}
 
 	// 지우려고 하는 stack 이 primary cluster 라면, organization 내에 cluster 가 자기 자신만 남아있을 경우이다.
 	organizations, err := u.organizationRepo.Fetch(ctx, nil)
 	if err != nil {
-		return errors.Wrap(err, "Failed to get organizations")
+		return operation, errors.Wrap(err, "Failed to get organizations")
 	}
 
 	for _, organization := range *organizations {
@@ -430,14 +544,14 @@ func (u *StackUsecase) Delete(ctx context.Context, dto model.Stack) (err error)
 
 			clusters, err := u.clusterRepo.FetchByOrganizationId(ctx, organization.ID, user.GetUserId(), nil)
 			if err != nil {
-				return errors.Wrap(err, "Failed to get organizations")
+				return operation, errors.Wrap(err, "Failed to get organizations")
 			}
 
 			for _, cl := range clusters {
 				if cl.ID != cluster.ID && (cl.Status == domain.ClusterStatus_RUNNING ||
 					cl.Status == domain.ClusterStatus_INSTALLING ||
 					cl.Status == domain.ClusterStatus_DELETING) {
-					return httpErrors.NewBadRequestError(fmt.Errorf("Failed to delete 'Primary' cluster. The clusters remain in organization"), "S_REMAIN_CLUSTER_FOR_DELETION", "")
+					return operation, httpErrors.NewError(fmt.Errorf("Failed to delete 'Primary' cluster. The clusters remain in organization"), "S_REMAIN_CLUSTER_FOR_DELETION")
 				}
 			}
 			break
@@ -445,12 +559,12 @@ func (u *StackUsecase) Delete(ctx context.Context, dto model.Stack) (err error)
 	}
 	appGroups, err := u.appGroupRepo.Fetch(ctx, domain.ClusterId(dto.ID), nil)
 	if err != nil {
-		return errors.Wrap(err, "Failed to get appGroups")
+		return operation, errors.Wrap(err, "Failed to get appGroups")
 	}
 	if len(appGroups) > 0 {
 		for _, appGroup := range appGroups {
 			if appGroup.Status != domain.AppGroupStatus_RUNNING {
-				return fmt.Errorf("Appgroup status is not 'RUNNING'. status [%s]", appGroup.Status.String())
+				return operation, fmt.Errorf("Appgroup status is not 'RUNNING'. status [%s]", appGroup.Status.String())
 			}
 		}
 	}
@@ -458,29 +572,31 @@ func (u *StackUsecase) Delete(ctx context.Context, dto model.Stack) (err error)
 	// Check AppServing
 	appsCnt, err := u.appServeAppRepo.GetNumOfAppsOnStack(ctx, dto.OrganizationId, dto.ID.String())
 	if err != nil {
-		return errors.Wrap(err, "Failed to get numOfAppsOnStack")
+		return operation, errors.Wrap(err, "Failed to get numOfAppsOnStack")
 	}
 	if appsCnt > 0 {
-		return httpErrors.NewBadRequestError(fmt.Errorf("existed appServeApps in %s", dto.OrganizationId), "S_FAILED_DELETE_EXISTED_ASA", "")
+		return operation, httpErrors.NewError(fmt.Errorf("existed appServeApps in %s", dto.OrganizationId), "S_FAILED_DELETE_EXISTED_ASA")
 	}
 
 	// Policy 삭제
 
-	workflow := "tks-stack-delete"
-	workflowId, err := u.argo.SumbitWorkflowFromWftpl(ctx, workflow, argowf.SubmitOptions{
-		Parameters: []string{
-			fmt.Sprintf("tks_api_url=%s", viper.GetString("external-address")),
-			"organization_id=" + dto.OrganizationId,
-			"cluster_id=" + dto.ID.String(),
-			"cloud_account_id=" + cluster.CloudAccount.ID.String(),
-			"stack_template_id=" + cluster.StackTemplate.ID.String(),
-		},
+	operation, err = u.operations.Submit(ctx, model.Operation{
+		OrganizationId:   dto.OrganizationId,
+		Type:             domain.OperationType_STACK_DELETE,
+		TargetId:         dto.ID.String(),
+		TargetName:       cluster.Name,
+		WorkflowTemplate: "tks-stack-delete",
+	}, []string{
+		fmt.Sprintf("tks_api_url=%s", viper.GetString("external-address")),
+		"organization_id=" + dto.OrganizationId,
+		"cluster_id=" + dto.ID.String(),
+		"cloud_account_id=" + cluster.CloudAccount.ID.String(),
+		"stack_template_id=" + cluster.StackTemplate.ID.String(),
 	})
 	if err != nil {
-		log.Error(ctx, err)
-		return err
+		return operation, err
 	}
-	log.Debug(ctx, "Submitted workflow: ", workflowId)
+	u.cacheInvalidator.Invalidate(ctx, CacheEvent{Kind: CacheEvent_CLUSTER_DELETED, OrganizationId: cluster.OrganizationId, ClusterId: cluster.ID.String()})
 
 	// Remove Cluster & AppGroup status description
 	if err := u.appGroupRepo.InitWorkflowDescription(ctx, cluster.ID); err != nil {
@@ -490,16 +606,20 @@ func (u *StackUsecase) Delete(ctx context.Context, dto model.Stack) (err error)
 		log.Error(ctx, err)
 	}
 
+	if operation.Status == domain.OperationStatus_PENDING {
+		return operation, nil
+	}
+
 	// wait & get clusterId ( max 1min 	)
 	for i := 0; i < 60; i++ {
 		time.Sleep(time.Second * 2)
-		workflow, err := u.argo.GetWorkflow(ctx, "argo", workflowId)
+		workflow, err := u.argo.GetWorkflow(ctx, "argo", operation.WorkflowId)
 		if err != nil {
-			return err
+			return operation, err
 		}
 
 		if workflow.Status.Phase != "" && workflow.Status.Phase != "Running" {
-			return fmt.Errorf("Invalid workflow status")
+			return operation, fmt.Errorf("Invalid workflow status")
 		}
 
 		if workflow.Status.Progress == "1/2" { // start creating cluster
@@ -508,7 +628,7 @@ func (u *StackUsecase) Delete(ctx context.Context, dto model.Stack) (err error)
 		}
 	}
 
-	return nil
+	return operation, nil
 }
 
 func (u *StackUsecase) GetKubeConfig(ctx context.Context, stackId domain.StackId) (kubeConfig string, err error) {
@@ -623,7 +743,7 @@ func (u *StackUsecase) GetStepStatus(ctx context.Context, stackId domain.StackId
 func (u *StackUsecase) SetFavorite(ctx context.Context, stackId domain.StackId) error {
 	user, ok := request.UserFrom(ctx)
 	if !ok {
-		return httpErrors.NewUnauthorizedError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN", "")
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
 	}
 
 	err := u.clusterRepo.SetFavorite(ctx, domain.ClusterId(stackId), user.GetUserId())
@@ -637,7 +757,7 @@ func (u *StackUsecase) SetFavorite(ctx context.Context, stackId domain.StackId)
 func (u *StackUsecase) DeleteFavorite(ctx context.Context, stackId domain.StackId) error {
 	user, ok := request.UserFrom(ctx)
 	if !ok {
-		return httpErrors.NewUnauthorizedError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN", "")
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
 	}
 
 	err := u.clusterRepo.DeleteFavorite(ctx, domain.ClusterId(stackId), user.GetUserId())
@@ -785,3 +905,88 @@ func parseStatusDescription(statusDesc string) (step int) {
 	}
 	return
 }
+
+func (u *StackUsecase) GetStackDefault(ctx context.Context, organizationId string) (out model.StackDefault, err error) {
+	out, err = u.stackDefaultRepo.Get(ctx, organizationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return out, httpErrors.NewError(err, "S_NOT_FOUND_STACK_DEFAULT")
+		}
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if len(out.Tag) > 0 {
+		if err = json.Unmarshal(out.Tag, &out.Tags); err != nil {
+			return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+	}
+	return out, nil
+}
+
+func (u *StackUsecase) UpdateStackDefault(ctx context.Context, dto model.StackDefault) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+	userId := user.GetUserId()
+	dto.UpdatorId = &userId
+
+	if _, err := u.organizationRepo.Get(ctx, dto.OrganizationId); err != nil {
+		return httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_ORGANIZATION", "")
+	}
+
+	dto.Tag = []byte(helper.ModelToJson(dto.Tags))
+	if err := u.stackDefaultRepo.Upsert(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+func (u *StackUsecase) DeleteStackDefault(ctx context.Context, organizationId string) error {
+	if _, err := u.GetStackDefault(ctx, organizationId); err != nil {
+		return err
+	}
+
+	if err := u.stackDefaultRepo.Delete(ctx, organizationId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// applyStackDefault fills the omitted values of the conf with the organization defaults.
+// Tags are merged and the tag of the request wins.
+func applyStackDefault(conf *model.StackConf, stackDefault model.StackDefault) error {
+	if conf.Region == "" {
+		conf.Region = stackDefault.Region
+	}
+	if conf.TksCpNodeType == "" {
+		conf.TksCpNodeType = stackDefault.TksCpNodeType
+	}
+	if conf.TksInfraNodeType == "" {
+		conf.TksInfraNodeType = stackDefault.TksInfraNodeType
+	}
+	if conf.TksUserNodeType == "" {
+		conf.TksUserNodeType = stackDefault.TksUserNodeType
+	}
+	if conf.VpcCidr == "" {
+		conf.VpcCidr = stackDefault.VpcCidr
+	}
+	if conf.PodCidr == "" {
+		conf.PodCidr = stackDefault.PodCidr
+	}
+	if conf.ServiceCidr == "" {
+		conf.ServiceCidr = stackDefault.ServiceCidr
+	}
+
+	if len(stackDefault.Tag) == 0 {
+		return nil
+	}
+	tags := map[string]string{}
+	if err := json.Unmarshal(stackDefault.Tag, &tags); err != nil {
+		return err
+	}
+	for key, value := range conf.Tags {
+		tags[key] = value
+	}
+	conf.Tags = tags
+	return nil
+}
@@ -52,7 +52,7 @@ func (u *SystemNotificationRuleUsecase) Create(ctx context.Context, dto model.Sy
 	dto.UpdatorId = &userId
 
 	if _, err = u.GetByName(ctx, dto.Name); err == nil {
-		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("duplicate systemNotificationRule name"), "SNR_CREATE_ALREADY_EXISTED_NAME", "")
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("duplicate systemNotificationRule name"), "SNR_CREATE_ALREADY_EXISTED_NAME")
 	}
 
 	// Users
@@ -81,7 +81,7 @@ func (u *SystemNotificationRuleUsecase) Create(ctx context.Context, dto model.Sy
 func (u *SystemNotificationRuleUsecase) Update(ctx context.Context, dto model.SystemNotificationRule) error {
 	rule, err := u.repo.Get(ctx, dto.ID)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "SNR_NOT_EXISTED_STACK_TEMPLATE", "")
+		return httpErrors.NewError(err, "SNR_NOT_EXISTED_STACK_TEMPLATE")
 	}
 
 	// Users
@@ -125,7 +125,7 @@ func (u *SystemNotificationRuleUsecase) GetByName(ctx context.Context, name stri
 	out, err = u.repo.GetByName(ctx, name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return out, httpErrors.NewNotFoundError(err, "SNR_FAILED_FETCH_SYSTEM_NOTIFICATION_RULE", "")
+			return out, httpErrors.NewError(err, "SNR_FAILED_FETCH_SYSTEM_NOTIFICATION_RULE")
 		}
 		return out, err
 	}
@@ -155,7 +155,7 @@ func (u *SystemNotificationRuleUsecase) Delete(ctx context.Context, systemNotifi
 	systemNotificationRule.UpdatorId = &userId
 
 	if systemNotificationRule.IsSystem {
-		return out, httpErrors.NewBadRequestError(fmt.Errorf("cannot delete system rules"), "SNR_CANNOT_DELETE_SYSTEM_RULE", "")
+		return out, httpErrors.NewError(fmt.Errorf("cannot delete system rules"), "SNR_CANNOT_DELETE_SYSTEM_RULE")
 	}
 
 	err = u.repo.Delete(ctx, systemNotificationRule)
@@ -193,7 +193,7 @@ func (u *SystemNotificationRuleUsecase) MakeDefaultSystemNotificationRules(ctx c
 
 	err = u.organizationRepo.AddSystemNotificationTemplates(ctx, organizationId, templates)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "ST_FAILED_ADD_ORGANIZATION_SYSTEM_NOTIFICATION_TEMPLATE", "")
+		return httpErrors.NewError(err, "ST_FAILED_ADD_ORGANIZATION_SYSTEM_NOTIFICATION_TEMPLATE")
 	}
 
 	rules := make([]model.SystemNotificationRule, 0)
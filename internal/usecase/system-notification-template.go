@@ -55,12 +55,12 @@ func (u *SystemNotificationTemplateUsecase) Create(ctx context.Context, dto mode
 	dto.UpdatorId = &userId
 
 	if _, err = u.GetByName(ctx, dto.Name); err == nil {
-		return uuid.Nil, httpErrors.NewBadRequestError(fmt.Errorf("duplicate systemNotificationTemplate name"), "SNT_CREATE_ALREADY_EXISTED_NAME", "")
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("duplicate systemNotificationTemplate name"), "SNT_CREATE_ALREADY_EXISTED_NAME")
 	}
 
 	systemNotificationTemplate, err = u.repo.Create(ctx, dto)
 	if err != nil {
-		return uuid.Nil, httpErrors.NewInternalServerError(err, "", "")
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	log.Info(ctx, "newly created SystemNotificationTemplate ID:", systemNotificationTemplate)
 
@@ -76,7 +76,7 @@ func (u *SystemNotificationTemplateUsecase) Create(ctx context.Context, dto mode
 func (u *SystemNotificationTemplateUsecase) Update(ctx context.Context, dto model.SystemNotificationTemplate) error {
 	_, err := u.repo.Get(ctx, dto.ID)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "SNT_NOT_EXISTED_ALERT_TEMPLATE", "")
+		return httpErrors.NewError(err, "SNT_NOT_EXISTED_ALERT_TEMPLATE")
 	}
 
 	err = u.repo.Update(ctx, dto)
@@ -103,7 +103,7 @@ func (u *SystemNotificationTemplateUsecase) GetByName(ctx context.Context, name
 	out, err = u.repo.GetByName(ctx, name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return out, httpErrors.NewNotFoundError(err, "SNT_FAILED_FETCH_ALERT_TEMPLATE", "")
+			return out, httpErrors.NewError(err, "SNT_FAILED_FETCH_ALERT_TEMPLATE")
 		}
 		return out, err
 	}
@@ -141,7 +141,7 @@ func (u *SystemNotificationTemplateUsecase) Delete(ctx context.Context, systemNo
 	systemNotificationTemplate.UpdatorId = &userId
 
 	if systemNotificationTemplate.IsSystem {
-		return httpErrors.NewBadRequestError(fmt.Errorf("cannot delete systemNotificationTemplate"), "SNT_CANNOT_DELETE_SYSTEM_TEMPLATE", "")
+		return httpErrors.NewError(fmt.Errorf("cannot delete systemNotificationTemplate"), "SNT_CANNOT_DELETE_SYSTEM_TEMPLATE")
 	}
 
 	// check if used
@@ -152,7 +152,7 @@ func (u *SystemNotificationTemplateUsecase) Delete(ctx context.Context, systemNo
 		return err
 	}
 	if len(res) > 0 {
-		return httpErrors.NewBadRequestError(fmt.Errorf("Failed to delete systemNotificationTemplate %s", systemNotificationTemplateId.String()), "SNT_FAILED_DELETE_EXIST_RULES", "")
+		return httpErrors.NewError(fmt.Errorf("Failed to delete systemNotificationTemplate %s", systemNotificationTemplateId.String()), "SNT_FAILED_DELETE_EXIST_RULES")
 	}
 
 	err = u.repo.Delete(ctx, systemNotificationTemplate)
@@ -166,7 +166,7 @@ func (u *SystemNotificationTemplateUsecase) Delete(ctx context.Context, systemNo
 func (u *SystemNotificationTemplateUsecase) UpdateOrganizations(ctx context.Context, dto model.SystemNotificationTemplate) error {
 	_, err := u.repo.Get(ctx, dto.ID)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "SNT_NOT_EXISTED_ALERT_TEMPLATE", "")
+		return httpErrors.NewError(err, "SNT_NOT_EXISTED_ALERT_TEMPLATE")
 	}
 
 	organizations := make([]model.Organization, 0)
@@ -179,7 +179,7 @@ func (u *SystemNotificationTemplateUsecase) UpdateOrganizations(ctx context.Cont
 
 	err = u.repo.UpdateOrganizations(ctx, dto.ID, organizations)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "SNT_FAILED_UPDATE_ORGANIZATION", "")
+		return httpErrors.NewError(err, "SNT_FAILED_UPDATE_ORGANIZATION")
 	}
 
 	return nil
@@ -188,7 +188,7 @@ func (u *SystemNotificationTemplateUsecase) UpdateOrganizations(ctx context.Cont
 func (u *SystemNotificationTemplateUsecase) AddOrganizationSystemNotificationTemplates(ctx context.Context, organizationId string, systemNotificationTemplateIds []string) error {
 	_, err := u.organizationRepo.Get(ctx, organizationId)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "ST_NOT_EXISTED_NAME", "")
+		return httpErrors.NewError(err, "ST_NOT_EXISTED_NAME")
 	}
 
 	systemNotificationTemplates := make([]model.SystemNotificationTemplate, 0)
@@ -202,7 +202,7 @@ func (u *SystemNotificationTemplateUsecase) AddOrganizationSystemNotificationTem
 
 	err = u.organizationRepo.AddSystemNotificationTemplates(ctx, organizationId, systemNotificationTemplates)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "ST_FAILED_ADD_ORGANIZATION_SYSTEM_NOTIFICATION_TEMPLATE", "")
+		return httpErrors.NewError(err, "ST_FAILED_ADD_ORGANIZATION_SYSTEM_NOTIFICATION_TEMPLATE")
 	}
 
 	return nil
@@ -211,7 +211,7 @@ func (u *SystemNotificationTemplateUsecase) AddOrganizationSystemNotificationTem
 func (u *SystemNotificationTemplateUsecase) RemoveOrganizationSystemNotificationTemplates(ctx context.Context, organizationId string, systemNotificationTemplateIds []string) error {
 	_, err := u.organizationRepo.Get(ctx, organizationId)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "O_NOT_EXISTED_NAME", "")
+		return httpErrors.NewError(err, "O_NOT_EXISTED_NAME")
 	}
 
 	systemNotificationTemplates := make([]model.SystemNotificationTemplate, 0)
@@ -225,7 +225,7 @@ func (u *SystemNotificationTemplateUsecase) RemoveOrganizationSystemNotification
 
 	err = u.organizationRepo.RemoveSystemNotificationTemplates(ctx, organizationId, systemNotificationTemplates)
 	if err != nil {
-		return httpErrors.NewBadRequestError(err, "ST_FAILED_REMOVE_ORGANIZATION_SYSTEM_NOTIFICATION_TEMPLATE", "")
+		return httpErrors.NewError(err, "ST_FAILED_REMOVE_ORGANIZATION_SYSTEM_NOTIFICATION_TEMPLATE")
 	}
 
 	return nil
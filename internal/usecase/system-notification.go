@@ -9,7 +9,6 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/openinfradev/tks-api/internal/helper"
-	"github.com/openinfradev/tks-api/internal/mail"
 	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
@@ -40,9 +39,13 @@ type SystemNotificationUsecase struct {
 	appGroupRepo               repository.IAppGroupRepository
 	systemNotificationRuleRepo repository.ISystemNotificationRuleRepository
 	userRepo                   repository.IUserRepository
+	notificationDigest         INotificationDigestUsecase
+	alertRouting               IAlertRoutingRuleUsecase
+	maintenanceRepo            repository.IMaintenanceWindowRepository
+	alertSilenceRepo           repository.IAlertSilenceRepository
 }
 
-func NewSystemNotificationUsecase(r repository.Repository) ISystemNotificationUsecase {
+func NewSystemNotificationUsecase(r repository.Repository, notificationDigest INotificationDigestUsecase, alertRouting IAlertRoutingRuleUsecase) ISystemNotificationUsecase {
 	return &SystemNotificationUsecase{
 		repo:                       r.SystemNotification,
 		clusterRepo:                r.Cluster,
@@ -50,6 +53,10 @@ func NewSystemNotificationUsecase(r repository.Repository) ISystemNotificationUs
 		organizationRepo:           r.Organization,
 		systemNotificationRuleRepo: r.SystemNotificationRule,
 		userRepo:                   r.User,
+		notificationDigest:         notificationDigest,
+		alertRouting:               alertRouting,
+		maintenanceRepo:            r.MaintenanceWindow,
+		alertSilenceRepo:           r.AlertSilence,
 	}
 }
 
@@ -73,6 +80,12 @@ func (u *SystemNotificationUsecase) Create(ctx context.Context, input domain.Cre
 			continue
 		}
 
+		// 다른 organization 의 cluster 에 대한 앨럿은 수신하지 않는다.
+		if ingestionOrganizationId, ok := request.IngestionOrganizationFrom(ctx); !ok || ingestionOrganizationId != organizationId {
+			log.Errorf(ctx, "rejected systemNotification of cluster %s. the ingestion token does not belong to organization %s", clusterId, organizationId)
+			continue
+		}
+
 		organization, err := u.organizationRepo.Get(ctx, organizationId)
 		if err != nil {
 			log.Error(ctx, err)
@@ -134,11 +147,43 @@ func (u *SystemNotificationUsecase) Create(ctx context.Context, input domain.Cre
 			NotificationType:         systemNotification.Annotations.AlertType,
 		}
 
-		_, err = u.repo.Create(ctx, dto)
+		// silence 와 일치하는 앨럿은 SILENCED 상태로 기록만 하고 발송하지 않는다.
+		alertSilence, err := activeAlertSilence(ctx, u.alertSilenceRepo, organizationId, alertRoutingSample{
+			clusterId: clusterId,
+			namespace: systemNotification.Labels.Namespace,
+			severity:  dto.Severity,
+			alertName: dto.Name,
+		})
+		if err != nil {
+			log.Error(ctx, err)
+		} else if alertSilence != nil {
+			dto.Status = domain.SystemNotificationActionStatus_SILENCED
+			dto.AlertSilenceId = &alertSilence.ID
+		}
+
+		dto.ID, err = u.repo.Create(ctx, dto)
 		if err != nil {
 			log.Error(ctx, "Failed to create systemNotification ", err)
 			continue
 		}
+		if alertSilence != nil {
+			log.Infof(ctx, "silenced systemNotification %s by alert silence %s until %s", dto.ID, alertSilence.ID, alertSilence.EndAt)
+			continue
+		}
+
+		// 유지보수 중인 클러스터의 알림은 기록만 하고 발송하지 않는다.
+		maintenanceWindow, err := activeMaintenanceWindow(ctx, u.maintenanceRepo, dto.ClusterId)
+		if err != nil {
+			log.Error(ctx, err)
+		} else if maintenanceWindow != nil {
+			log.Infof(ctx, "suppressed notification of systemNotification %s. cluster %s is under maintenance until %s", dto.ID, clusterId, maintenanceWindow.EndAt)
+			continue
+		}
+
+		// 조직의 라우팅 규칙과 일치하는 채널로도 보낸다.
+		if err := u.alertRouting.Route(ctx, dto, systemNotification.Labels.Namespace); err != nil {
+			log.Error(ctx, "Failed to route systemNotification ", err)
+		}
 
 		// 사용자가 생성한 알림
 		if systemNotificationRuleId != nil {
@@ -149,18 +194,9 @@ func (u *SystemNotificationUsecase) Create(ctx context.Context, input domain.Cre
 			}
 
 			if rule.SystemNotificationCondition.EnableEmail {
-				to := []string{}
-				for _, user := range rule.TargetUsers {
-					to = append(to, user.Email)
-				}
-				message, err := mail.MakeSystemNotificationMessage(ctx, organizationId, systemNotification.Annotations.Message, systemNotification.Annotations.Description, to)
-				if err != nil {
-					log.Error(ctx, fmt.Sprintf("Failed to make email content. err : %s", err.Error()))
-					continue
-				}
-				mailer := mail.New(message)
-				if err := mailer.SendMail(ctx); err != nil {
-					log.Error(ctx, fmt.Sprintf("Failed to send email to %s. err : %s", to, err.Error()))
+				// critical 이 아닌 알림은 사용자의 digest 설정에 따라 모아서 보낸다.
+				if err := u.notificationDigest.NotifyByEmail(ctx, dto, rule.TargetUsers); err != nil {
+					log.Error(ctx, err)
 					continue
 				}
 			}
@@ -178,7 +214,7 @@ func (u *SystemNotificationUsecase) Update(ctx context.Context, dto model.System
 func (u *SystemNotificationUsecase) Get(ctx context.Context, systemNotificationId uuid.UUID) (systemNotification model.SystemNotification, err error) {
 	userInfo, ok := request.UserFrom(ctx)
 	if !ok {
-		return systemNotification, httpErrors.NewUnauthorizedError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN", "")
+		return systemNotification, httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
 	}
 
 	systemNotification, err = u.repo.Get(ctx, systemNotificationId)
@@ -212,7 +248,7 @@ func (u *SystemNotificationUsecase) GetByName(ctx context.Context, organizationI
 func (u *SystemNotificationUsecase) FetchSystemNotifications(ctx context.Context, organizationId string, pg *pagination.Pagination) (systemNotifications []model.SystemNotification, err error) {
 	userInfo, ok := request.UserFrom(ctx)
 	if !ok {
-		return systemNotifications, httpErrors.NewUnauthorizedError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN", "")
+		return systemNotifications, httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
 	}
 
 	systemNotifications, err = u.repo.FetchSystemNotifications(ctx, organizationId, pg)
@@ -230,7 +266,7 @@ func (u *SystemNotificationUsecase) FetchSystemNotifications(ctx context.Context
 func (u *SystemNotificationUsecase) FetchPolicyNotifications(ctx context.Context, organizationId string, pg *pagination.Pagination) (systemNotifications []model.SystemNotification, err error) {
 	userInfo, ok := request.UserFrom(ctx)
 	if !ok {
-		return systemNotifications, httpErrors.NewUnauthorizedError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN", "")
+		return systemNotifications, httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
 	}
 
 	systemNotifications, err = u.repo.FetchPolicyNotifications(ctx, organizationId, pg)
@@ -248,12 +284,12 @@ func (u *SystemNotificationUsecase) FetchPolicyNotifications(ctx context.Context
 func (u *SystemNotificationUsecase) Delete(ctx context.Context, dto model.SystemNotification) (err error) {
 	_, ok := request.UserFrom(ctx)
 	if !ok {
-		return httpErrors.NewUnauthorizedError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN", "")
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
 	}
 
 	_, err = u.Get(ctx, dto.ID)
 	if err != nil {
-		return httpErrors.NewNotFoundError(err, "EV_NOT_FOUND_EVENT", "")
+		return httpErrors.NewError(err, "EV_NOT_FOUND_EVENT")
 	}
 
 	err = u.repo.Delete(ctx, dto)
@@ -267,7 +303,7 @@ func (u *SystemNotificationUsecase) Delete(ctx context.Context, dto model.System
 func (u *SystemNotificationUsecase) CreateSystemNotificationAction(ctx context.Context, dto model.SystemNotificationAction) (systemNotificationActionId uuid.UUID, err error) {
 	user, ok := request.UserFrom(ctx)
 	if !ok {
-		return uuid.Nil, httpErrors.NewUnauthorizedError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN", "")
+		return uuid.Nil, httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
 	}
 
 	_, err = u.repo.Get(ctx, dto.SystemNotificationId)
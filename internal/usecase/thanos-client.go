@@ -0,0 +1,254 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	thanos "github.com/openinfradev/tks-api/pkg/thanos-client"
+	gcache "github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	cacheKeyThanosUrl         = "CACHE_KEY_THANOS_URL"
+	cacheKeyThanosClient      = "CACHE_KEY_THANOS_CLIENT"
+	cacheKeyLmaEndpointHealth = "CACHE_KEY_LMA_ENDPOINT_HEALTH"
+)
+
+const lmaEndpointHealthTimeout = 5 * time.Second
+
+// LmaEndpointHealth is the last checked health of a LMA endpoint of an organization
+type LmaEndpointHealth struct {
+	Url       string
+	Primary   bool
+	Active    bool
+	Status    domain.LmaEndpointStatus
+	LastError string
+	CheckedAt time.Time
+	// DiscoveryMethod 는 primary cluster 의 LMA 주소를 찾은 방법이다.
+	DiscoveryMethod domain.LmaDiscoveryMethod
+
+	// transport 는 API server proxy 로 접속할 때 사용할 cluster 의 인증 정보를 담은 transport 이다.
+	transport http.RoundTripper
+}
+
+// ThanosClientFactory provides the thanos client of an organization.
+// It uses the LMA of the primary cluster and fails over to the secondary LMA endpoints of the organization.
+type ThanosClientFactory interface {
+	Get(ctx context.Context, organizationId string) (thanos.ThanosClient, error)
+	// CheckHealth checks the LMA endpoints of the organization and switches the active endpoint to the first healthy one.
+	CheckHealth(ctx context.Context, organizationId string) ([]LmaEndpointHealth, error)
+	// Health returns the last checked health of the LMA endpoints of the organization.
+	Health(organizationId string) []LmaEndpointHealth
+}
+
+// ThanosClientFunc adapts a function to ThanosClientFactory without caching
+type ThanosClientFunc func(ctx context.Context, organizationId string) (thanos.ThanosClient, error)
+
+func (f ThanosClientFunc) Get(ctx context.Context, organizationId string) (thanos.ThanosClient, error) {
+	return f(ctx, organizationId)
+}
+
+func (f ThanosClientFunc) CheckHealth(ctx context.Context, organizationId string) ([]LmaEndpointHealth, error) {
+	return nil, nil
+}
+
+func (f ThanosClientFunc) Health(organizationId string) []LmaEndpointHealth {
+	return nil
+}
+
+type ThanosClientFactoryImpl struct {
+	organizationRepo repository.IOrganizationRepository
+	lmaEndpointRepo  repository.ILmaEndpointRepository
+	encryptionKey    IEncryptionKeyUsecase
+	cache            *gcache.Cache
+}
+
+func NewThanosClientFactory(r repository.Repository, cache *gcache.Cache, encryptionKey IEncryptionKeyUsecase) ThanosClientFactory {
+	return &ThanosClientFactoryImpl{
+		organizationRepo: r.Organization,
+		lmaEndpointRepo:  r.LmaEndpoint,
+		encryptionKey:    encryptionKey,
+		cache:            cache,
+	}
+}
+
+func (f *ThanosClientFactoryImpl) Get(ctx context.Context, organizationId string) (thanos.ThanosClient, error) {
+	if value, found := f.cache.Get(cacheKeyThanosClient + organizationId); found {
+		return value.(thanos.ThanosClient), nil
+	}
+
+	health := f.Health(organizationId)
+	if health == nil {
+		var err error
+		health, err = f.CheckHealth(ctx, organizationId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cfg, err := f.getThanosConfig(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	for _, endpoint := range health {
+		if !endpoint.Active {
+			continue
+		}
+		client, err := newThanosClient(ctx, endpoint.Url, endpoint.config(cfg))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create thanos client")
+		}
+		f.cache.Set(cacheKeyThanosClient+organizationId, client, gcache.DefaultExpiration)
+		return client, nil
+	}
+
+	return nil, httpErrors.NewError(fmt.Errorf("Not found active lma endpoint"), "D_INVALID_PRIMARY_STACK")
+}
+
+func (f *ThanosClientFactoryImpl) CheckHealth(ctx context.Context, organizationId string) ([]LmaEndpointHealth, error) {
+	endpoints, err := f.lmaEndpointRepo.Fetch(ctx, organizationId)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get lma endpoints")
+	}
+	cfg, err := f.getThanosConfig(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	// primary cluster 의 LMA 를 우선 사용하고, 보조 endpoint 는 priority 순으로 사용한다.
+	primary, primaryErr := f.getThanosTarget(ctx, organizationId)
+	health := []LmaEndpointHealth{{Url: primary.Url, Primary: true, DiscoveryMethod: primary.Method, transport: primary.Transport}}
+	if primaryErr != nil {
+		health[0].Status = domain.LmaEndpointStatus_UNHEALTHY
+		health[0].LastError = primaryErr.Error()
+	}
+	for _, endpoint := range endpoints {
+		health = append(health, LmaEndpointHealth{Url: endpoint.Url})
+	}
+
+	now := time.Now()
+	for i := range health {
+		health[i].CheckedAt = now
+		if health[i].Url == "" {
+			continue
+		}
+		if err := pingThanos(ctx, health[i].Url, health[i].config(cfg)); err != nil {
+			health[i].Status = domain.LmaEndpointStatus_UNHEALTHY
+			health[i].LastError = err.Error()
+			continue
+		}
+		health[i].Status = domain.LmaEndpointStatus_HEALTHY
+	}
+
+	// 정상인 endpoint 가 없으면 주소를 알 수 있는 첫번째 endpoint 를 그대로 사용한다.
+	active := -1
+	for i := range health {
+		if health[i].Status == domain.LmaEndpointStatus_HEALTHY {
+			active = i
+			break
+		}
+	}
+	if active < 0 {
+		for i := range health {
+			if health[i].Url != "" {
+				active = i
+				break
+			}
+		}
+	}
+	if active < 0 {
+		log.Error(ctx, primaryErr)
+		return nil, httpErrors.NewError(primaryErr, "D_INVALID_PRIMARY_STACK")
+	}
+	health[active].Active = true
+
+	previousUrl := ""
+	for _, endpoint := range f.Health(organizationId) {
+		if endpoint.Active {
+			previousUrl = endpoint.Url
+		}
+	}
+	if previousUrl != health[active].Url {
+		if previousUrl != "" {
+			log.Infof(ctx, "switched lma endpoint of organization %s. [%s] -> [%s]", organizationId, previousUrl, health[active].Url)
+		}
+		f.cache.Delete(cacheKeyThanosClient + organizationId)
+	}
+	f.cache.Set(cacheKeyLmaEndpointHealth+organizationId, health, gcache.DefaultExpiration)
+
+	return health, nil
+}
+
+func (f *ThanosClientFactoryImpl) Health(organizationId string) []LmaEndpointHealth {
+	if value, found := f.cache.Get(cacheKeyLmaEndpointHealth + organizationId); found {
+		return value.([]LmaEndpointHealth)
+	}
+	return nil
+}
+
+// getThanosConfig 는 조직의 LMA 접속 설정을 반환한다. 설정하지 않은 조직은 TLS 검증, 인증 없이 접속한다.
+func (f *ThanosClientFactoryImpl) getThanosConfig(ctx context.Context, organizationId string) (cfg thanos.Config, err error) {
+	lmaAuth, err := f.lmaEndpointRepo.GetAuth(ctx, organizationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return cfg, nil
+		}
+		return cfg, errors.Wrap(err, "Failed to get lma auth")
+	}
+
+	password, err := f.encryptionKey.Open(ctx, []byte(lmaAuth.Password))
+	if err != nil {
+		return cfg, errors.Wrap(err, "Failed to open password of lma auth")
+	}
+	bearerToken, err := f.encryptionKey.Open(ctx, []byte(lmaAuth.BearerToken))
+	if err != nil {
+		return cfg, errors.Wrap(err, "Failed to open bearer token of lma auth")
+	}
+	return thanos.Config{
+		CaCert:             []byte(lmaAuth.CaCert),
+		InsecureSkipVerify: lmaAuth.InsecureSkipVerify,
+		Username:           lmaAuth.Username,
+		Password:           string(password),
+		BearerToken:        string(bearerToken),
+	}, nil
+}
+
+// config 는 endpoint 에 접속할 설정을 반환한다. API server proxy 로 접속하는 endpoint 는 조직의 LMA 접속 설정 대신 cluster 의 인증 정보를 사용한다.
+func (h LmaEndpointHealth) config(cfg thanos.Config) thanos.Config {
+	if h.transport != nil {
+		return thanos.Config{Transport: h.transport}
+	}
+	return cfg
+}
+
+func newThanosClient(ctx context.Context, thanosUrl string, cfg thanos.Config) (thanos.ThanosClient, error) {
+	// ingress path, API server proxy 와 같이 경로가 있는 주소는 그대로 사용한다.
+	if u, err := url.Parse(thanosUrl); err == nil && strings.Trim(u.Path, "/") != "" {
+		cfg.Url = thanosUrl
+		return thanos.NewWithConfig(cfg)
+	}
+	address, port := helper.SplitAddress(ctx, thanosUrl)
+	cfg.Url = fmt.Sprintf("%s:%d", address, port)
+	return thanos.NewWithConfig(cfg)
+}
+
+func pingThanos(ctx context.Context, thanosUrl string, cfg thanos.Config) error {
+	client, err := newThanosClient(ctx, thanosUrl, cfg)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, lmaEndpointHealthTimeout)
+	defer cancel()
+	return client.Ready(ctx)
+}
@@ -0,0 +1,315 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+	gcache "github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const defaultLmaNamespace = "lma"
+
+// service 이름을 지정하지 않으면 thanos-query-frontend, thanos-query 순으로 찾는다.
+var defaultThanosServiceNames = []string{"thanos-query-frontend", "thanos-query"}
+
+// AUTO 는 tks-endpoint-secret 이 없으면 아래 순서로 thanos 주소를 찾는다.
+var autoLmaDiscoveryMethods = []domain.LmaDiscoveryMethod{
+	domain.LmaDiscoveryMethod_LOAD_BALANCER,
+	domain.LmaDiscoveryMethod_INGRESS,
+	domain.LmaDiscoveryMethod_NODE_PORT,
+	domain.LmaDiscoveryMethod_CLUSTER_IP,
+}
+
+// thanosTarget 은 primary cluster 에서 찾은 thanos 주소이다.
+// CLUSTER_IP 는 API server proxy 로 접속하므로 cluster 의 인증 정보를 담은 Transport 를 함께 사용한다.
+type thanosTarget struct {
+	Url       string
+	Method    domain.LmaDiscoveryMethod
+	Transport http.RoundTripper
+}
+
+// getThanosTarget 은 조직의 LMA 주소 탐색 설정에 따라 primary cluster 의 thanos 주소를 찾는다.
+func (f *ThanosClientFactoryImpl) getThanosTarget(ctx context.Context, organizationId string) (out thanosTarget, err error) {
+	value, found := f.cache.Get(cacheKeyThanosUrl + organizationId)
+	if found {
+		return value.(thanosTarget), nil
+	}
+
+	organization, err := f.organizationRepo.Get(ctx, organizationId)
+	if err != nil {
+		return out, errors.Wrap(err, "Failed to get organization")
+	}
+
+	if organization.PrimaryClusterId == "" {
+		return out, fmt.Errorf("Invalid primary clusterId")
+	}
+
+	discovery, err := getLmaDiscovery(ctx, f.lmaEndpointRepo, organizationId)
+	if err != nil {
+		return out, err
+	}
+
+	methods := []domain.LmaDiscoveryMethod{domain.LmaDiscoveryMethod(discovery.Method)}
+	if methods[0] == domain.LmaDiscoveryMethod_AUTO {
+		// tks-endpoint-secret 이 있다면 그 secret 내의 endpoint 를 사용한다.
+		if thanosUrl, err := getEndpointSecretThanosUrl(ctx, organization.PrimaryClusterId); err == nil {
+			out = thanosTarget{Url: thanosUrl, Method: domain.LmaDiscoveryMethod_AUTO}
+			log.Info(ctx, "thanosUrl : ", out.Url)
+			f.cache.Set(cacheKeyThanosUrl+organizationId, out, gcache.DefaultExpiration)
+			return out, nil
+		}
+		log.Info(ctx, "cannot found tks-endpoint-secret. so discover thanos service...")
+		methods = autoLmaDiscoveryMethods
+	}
+
+	clientset_user, err := kubernetes.GetClientFromClusterId(ctx, organization.PrimaryClusterId)
+	if err != nil {
+		return out, errors.Wrap(err, "Failed to get client set for user cluster")
+	}
+
+	service, err := getThanosService(ctx, clientset_user, discovery)
+	if err != nil {
+		return out, err
+	}
+
+	var failures []string
+	for _, method := range methods {
+		out, err = resolveThanosTarget(ctx, clientset_user, organization.PrimaryClusterId, service, discovery, method)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", method, err))
+			continue
+		}
+		log.Infof(ctx, "discovered thanos of organization %s. method : %s, url : %s", organizationId, method, out.Url)
+		f.cache.Set(cacheKeyThanosUrl+organizationId, out, gcache.DefaultExpiration)
+		return out, nil
+	}
+
+	return thanosTarget{}, fmt.Errorf("Not found thanos url. %s", strings.Join(failures, ", "))
+}
+
+// getLmaDiscovery 는 조직의 LMA 주소 탐색 설정을 반환한다. 설정하지 않은 조직은 AUTO 로 동작한다.
+func getLmaDiscovery(ctx context.Context, repo repository.ILmaEndpointRepository, organizationId string) (model.LmaDiscovery, error) {
+	discovery, err := repo.GetDiscovery(ctx, organizationId)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.LmaDiscovery{}, errors.Wrap(err, "Failed to get lma discovery")
+		}
+		return model.LmaDiscovery{Method: string(domain.LmaDiscoveryMethod_AUTO), Namespace: defaultLmaNamespace}, nil
+	}
+	return discovery, nil
+}
+
+func getEndpointSecretThanosUrl(ctx context.Context, clusterId string) (string, error) {
+	clientset_admin, err := kubernetes.GetClientAdminCluster(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to get client set for admin cluster")
+	}
+
+	secrets, err := clientset_admin.CoreV1().Secrets(clusterId).Get(ctx, "tks-endpoint-secret", metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return "http://" + string(secrets.Data["thanos"]), nil
+}
+
+func getThanosService(ctx context.Context, clientset *k8s.Clientset, discovery model.LmaDiscovery) (*corev1.Service, error) {
+	names := defaultThanosServiceNames
+	if discovery.ServiceName != "" {
+		names = []string{discovery.ServiceName}
+	}
+
+	var err error
+	for _, name := range names {
+		var service *corev1.Service
+		service, err = clientset.CoreV1().Services(discovery.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			return service, nil
+		}
+	}
+	return nil, errors.Wrap(err, "Failed to get services.")
+}
+
+func resolveThanosTarget(ctx context.Context, clientset *k8s.Clientset, clusterId string, service *corev1.Service, discovery model.LmaDiscovery, method domain.LmaDiscoveryMethod) (out thanosTarget, err error) {
+	port, err := getThanosServicePort(service)
+	if err != nil {
+		return out, err
+	}
+
+	out.Method = method
+	switch method {
+	case domain.LmaDiscoveryMethod_LOAD_BALANCER:
+		out.Url, err = resolveLoadBalancerThanosUrl(service, port)
+	case domain.LmaDiscoveryMethod_INGRESS:
+		out.Url, err = resolveIngressThanosUrl(ctx, clientset, service, discovery.IngressName)
+	case domain.LmaDiscoveryMethod_NODE_PORT:
+		out.Url, err = resolveNodePortThanosUrl(ctx, clientset, service, port, discovery.NodeAddressType)
+	case domain.LmaDiscoveryMethod_CLUSTER_IP:
+		out.Url, out.Transport, err = resolveClusterIPThanosUrl(ctx, clusterId, service, port)
+	default:
+		err = fmt.Errorf("Invalid discovery method")
+	}
+	return out, err
+}
+
+func resolveLoadBalancerThanosUrl(service *corev1.Service, port corev1.ServicePort) (string, error) {
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return "", fmt.Errorf("Service type is not LoadBalancer. [%s] ", service.Spec.Type)
+	}
+
+	for _, lb := range service.Status.LoadBalancer.Ingress {
+		address := lb.Hostname
+		if address == "" {
+			address = lb.IP
+		}
+		if address != "" {
+			return fmt.Sprintf("%s://%s:%d", getThanosServiceScheme(port), address, port.Port), nil
+		}
+	}
+	return "", fmt.Errorf("LoadBalancer address is not assigned")
+}
+
+// resolveIngressThanosUrl 은 thanos service 를 backend 로 사용하는 ingress rule 의 host 와 path 를 사용한다.
+// host 가 없는 rule 은 ingress 에 할당된 주소를 사용하고, TLS 가 설정된 host 는 https 로 접속한다.
+func resolveIngressThanosUrl(ctx context.Context, clientset *k8s.Clientset, service *corev1.Service, ingressName string) (string, error) {
+	ingresses, err := clientset.NetworkingV1().Ingresses(service.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to get ingresses")
+	}
+
+	for _, ingress := range ingresses.Items {
+		if ingressName != "" && ingress.Name != ingressName {
+			continue
+		}
+
+		address := ""
+		for _, lb := range ingress.Status.LoadBalancer.Ingress {
+			if address = lb.Hostname; address == "" {
+				address = lb.IP
+			}
+			if address != "" {
+				break
+			}
+		}
+
+		for _, rule := range ingress.Spec.Rules {
+			if rule.HTTP == nil || strings.HasPrefix(rule.Host, "*") {
+				continue
+			}
+			host := rule.Host
+			if host == "" {
+				host = address
+			}
+			if host == "" {
+				continue
+			}
+
+			scheme := "http"
+			for _, tls := range ingress.Spec.TLS {
+				for _, tlsHost := range tls.Hosts {
+					if rule.Host != "" && tlsHost == rule.Host {
+						scheme = "https"
+					}
+				}
+			}
+
+			for _, path := range rule.HTTP.Paths {
+				if path.Backend.Service == nil || path.Backend.Service.Name != service.Name {
+					continue
+				}
+				return scheme + "://" + host + strings.TrimSuffix(path.Path, "/"), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("Not found ingress of service %s", service.Name)
+}
+
+// resolveNodePortThanosUrl 은 Ready 상태인 node 의 주소와 service 의 node port 를 사용한다.
+// addressType 을 지정하지 않으면 ExternalIP 가 있는 node 를 우선 사용하고, 없으면 InternalIP 를 사용한다.
+func resolveNodePortThanosUrl(ctx context.Context, clientset *k8s.Clientset, service *corev1.Service, port corev1.ServicePort, addressType string) (string, error) {
+	if port.NodePort == 0 {
+		return "", fmt.Errorf("Service has no node port. [%s] ", service.Spec.Type)
+	}
+
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to get nodes")
+	}
+
+	addressTypes := []corev1.NodeAddressType{corev1.NodeExternalIP, corev1.NodeInternalIP}
+	if addressType != "" {
+		addressTypes = []corev1.NodeAddressType{corev1.NodeAddressType(addressType)}
+	}
+	for _, addressType := range addressTypes {
+		for _, node := range nodes.Items {
+			if node.Spec.Unschedulable || !isNodeReady(node) {
+				continue
+			}
+			for _, address := range node.Status.Addresses {
+				if address.Type == addressType && address.Address != "" {
+					return fmt.Sprintf("%s://%s:%d", getThanosServiceScheme(port), address.Address, port.NodePort), nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("Not found ready node with address type %v", addressTypes)
+}
+
+// resolveClusterIPThanosUrl 은 cluster 의 API server proxy 를 통해 service 에 접속하는 주소와 transport 를 만든다.
+func resolveClusterIPThanosUrl(ctx context.Context, clusterId string, service *corev1.Service, port corev1.ServicePort) (string, http.RoundTripper, error) {
+	config, err := kubernetes.GetRestConfigFromClusterId(ctx, clusterId)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "Failed to get kubeconfig")
+	}
+
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "Failed to create transport of api server")
+	}
+
+	thanosUrl := fmt.Sprintf("%s/api/v1/namespaces/%s/services/%s:%s:%d/proxy",
+		strings.TrimSuffix(config.Host, "/"), service.Namespace, getThanosServiceScheme(port), service.Name, port.Port)
+	return thanosUrl, transport, nil
+}
+
+// getThanosServicePort 는 thanos service 의 http(s) port 를 반환한다. 이름으로 찾을 수 없으면 첫번째 port 를 사용한다.
+func getThanosServicePort(service *corev1.Service) (corev1.ServicePort, error) {
+	if len(service.Spec.Ports) == 0 {
+		return corev1.ServicePort{}, fmt.Errorf("Service has no port")
+	}
+	for _, port := range service.Spec.Ports {
+		if getThanosServiceScheme(port) == "https" || port.Name == "http" || port.TargetPort.StrVal == "http" {
+			return port, nil
+		}
+	}
+	return service.Spec.Ports[0], nil
+}
+
+// thanos chart 는 service 의 target port 이름을 http, https 로 지정한다.
+func getThanosServiceScheme(port corev1.ServicePort) string {
+	if port.Name == "https" || port.TargetPort.StrVal == "https" {
+		return "https"
+	}
+	return "http"
+}
+
+func isNodeReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
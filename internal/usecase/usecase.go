@@ -20,4 +20,26 @@ type Usecase struct {
 	Audit                      IAuditUsecase
 	PolicyTemplate             IPolicyTemplateUsecase
 	Policy                     IPolicyUsecase
+	ClusterAccess              IClusterAccessUsecase
+	AlertIngestionToken        IAlertIngestionTokenUsecase
+	AlertRoutingRule           IAlertRoutingRuleUsecase
+	AlertChannel               IAlertChannelUsecase
+	AlertSilence               IAlertSilenceUsecase
+	EscalationPolicy           IEscalationPolicyUsecase
+	DeploymentApproval         IDeploymentApprovalUsecase
+	CloudHealthEvent           ICloudHealthEventUsecase
+	LmaEndpoint                ILmaEndpointUsecase
+	Manifest                   IManifestUsecase
+	ClusterHeartbeat           IClusterHeartbeatUsecase
+	ClusterVersionAdvisory     IClusterVersionAdvisoryUsecase
+	EncryptionKey              IEncryptionKeyUsecase
+	Operation                  IOperationUsecase
+	NotificationDigest         INotificationDigestUsecase
+	UserSession                IUserSessionUsecase
+	MaintenanceWindow          IMaintenanceWindowUsecase
+	AuditSink                  IAuditSinkUsecase
+	StackMonitoringEndpoint    IStackMonitoringEndpointUsecase
+	StackWebhook               IStackWebhookUsecase
+	Catalog                    ICatalogUsecase
+	GitProvider                IGitProviderUsecase
 }
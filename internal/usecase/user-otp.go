@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+const (
+	otpIssuer = "TKS"
+
+	// 코드를 otpMaxFailedAttempts 번 틀리면 otpLockDuration 동안 2단계 인증을 잠근다.
+	otpMaxFailedAttempts = 5
+	otpLockDuration      = 5 * time.Minute
+)
+
+// EnrollOtp 는 새 TOTP secret 을 발급한다. ActivateOtp 로 코드를 확인하기 전까지 로그인에는 사용되지 않는다.
+func (u *UserUsecase) EnrollOtp(ctx context.Context, userId uuid.UUID) (secret string, otpauthUri string, err error) {
+	user, err := u.userRepository.GetByUuid(ctx, userId)
+	if err != nil {
+		return "", "", httpErrors.NewError(err, "U_NO_USER")
+	}
+	if user.OtpEnabled {
+		return "", "", httpErrors.NewError(fmt.Errorf("otp is already enabled"), "A_OTP_ALREADY_ENROLLED")
+	}
+
+	secret, err = helper.GenerateTotpSecret()
+	if err != nil {
+		return "", "", httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	sealed, err := u.secretSealer.Seal(ctx, user.OrganizationId, []byte(secret))
+	if err != nil {
+		return "", "", httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if err = u.userRepository.UpdateOtp(ctx, userId, string(sealed), false); err != nil {
+		return "", "", httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	return secret, helper.TotpUri(otpIssuer, user.AccountId+"@"+user.OrganizationId, secret), nil
+}
+
+func (u *UserUsecase) ActivateOtp(ctx context.Context, userId uuid.UUID, code string) error {
+	user, err := u.userRepository.GetByUuid(ctx, userId)
+	if err != nil {
+		return httpErrors.NewError(err, "U_NO_USER")
+	}
+	if user.OtpSecret == "" {
+		return httpErrors.NewError(fmt.Errorf("otp is not enrolled"), "A_OTP_NOT_ENROLLED")
+	}
+	if err = verifyUserOtp(ctx, u.userRepository, u.secretSealer, user, code); err != nil {
+		return err
+	}
+
+	if err = u.userRepository.UpdateOtp(ctx, userId, user.OtpSecret, true); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// DisableOtp 는 현재 코드를 확인한 뒤 2단계 인증을 해제한다. 조직이 2단계 인증을 요구하면 해제할 수 없다.
+func (u *UserUsecase) DisableOtp(ctx context.Context, userId uuid.UUID, code string) error {
+	user, err := u.userRepository.GetByUuid(ctx, userId)
+	if err != nil {
+		return httpErrors.NewError(err, "U_NO_USER")
+	}
+	if !user.OtpEnabled {
+		return httpErrors.NewError(fmt.Errorf("otp is not enabled"), "A_OTP_NOT_ENROLLED")
+	}
+	if user.Organization.OtpRequired {
+		return httpErrors.NewError(fmt.Errorf("otp is required by organization"), "A_OTP_ENFORCED")
+	}
+	if err = verifyUserOtp(ctx, u.userRepository, u.secretSealer, user, code); err != nil {
+		return err
+	}
+
+	if err = u.userRepository.UpdateOtp(ctx, userId, "", false); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// verifyUserOtp 는 코드를 확인하고 사용한 주기를 기록한다. 이미 사용한 코드는 거부하고,
+// 잠겨 있는 동안에는 코드를 확인하지 않는다.
+func verifyUserOtp(ctx context.Context, userRepository repository.IUserRepository, secretSealer repository.ISecretSealer, user model.User, code string) error {
+	if code == "" {
+		return httpErrors.NewError(fmt.Errorf("otp code is required"), "A_OTP_REQUIRED")
+	}
+	now := time.Now()
+	if user.OtpLockedUntil != nil && now.Before(*user.OtpLockedUntil) {
+		return httpErrors.NewError(fmt.Errorf("otp is locked until %s", user.OtpLockedUntil), "A_OTP_LOCKED")
+	}
+	secret, err := secretSealer.Open(ctx, []byte(user.OtpSecret))
+	if err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	counter, ok := helper.ValidateTotpCounter(string(secret), code, now)
+	if ok {
+		ok, err = userRepository.UseOtpCounter(ctx, user.ID, counter)
+		if err != nil {
+			return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+	}
+	if !ok {
+		if err = userRepository.IncreaseOtpFailedAttempts(ctx, user.ID, otpMaxFailedAttempts, now.Add(otpLockDuration)); err != nil {
+			return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+		}
+		return httpErrors.NewError(fmt.Errorf("invalid otp code"), "A_INVALID_OTP")
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal"
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// 정책을 저장하지 않은 조직은 기존 동작과 같은 정책을 사용한다.
+func defaultPasswordPolicy(organizationId string) model.PasswordPolicy {
+	return model.PasswordPolicy{
+		OrganizationId:      organizationId,
+		MinLength:           internal.PasswordMinLength,
+		MinCharacterClasses: 1,
+		HistoryCount:        0,
+		ExpiryDays:          int(internal.PasswordExpiredDuration.Hours() / 24),
+	}
+}
+
+func (u *UserUsecase) GetPasswordPolicy(ctx context.Context, organizationId string) (model.PasswordPolicy, error) {
+	policy, err := u.passwordPolicyRepository.Get(ctx, organizationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return defaultPasswordPolicy(organizationId), nil
+		}
+		return model.PasswordPolicy{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return policy, nil
+}
+
+func (u *UserUsecase) UpdatePasswordPolicy(ctx context.Context, dto model.PasswordPolicy) error {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewError(fmt.Errorf("Invalid token"), "A_INVALID_TOKEN")
+	}
+	userId := user.GetUserId()
+
+	if _, err := u.organizationRepository.Get(ctx, dto.OrganizationId); err != nil {
+		return httpErrors.NewNotFoundError(err, "S_FAILED_FETCH_ORGANIZATION", "")
+	}
+	// 시스템이 발급하는 임시 비밀번호는 모든 문자 종류를 포함하므로, 최소 길이만 확인한다.
+	if dto.MinLength < internal.PasswordMinLength {
+		return httpErrors.NewError(fmt.Errorf("minLength must be at least %d", internal.PasswordMinLength), "A_INVALID_PASSWORD_POLICY")
+	}
+
+	dto.UpdatorId = &userId
+	if err := u.passwordPolicyRepository.Upsert(ctx, dto); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// validatePassword 는 비밀번호가 조직의 정책을 만족하는지 확인한다. userId 가 nil 이 아니면 최근 사용한 비밀번호와도 비교한다.
+func (u *UserUsecase) validatePassword(ctx context.Context, policy model.PasswordPolicy, userId *uuid.UUID, password string) error {
+	if len([]rune(password)) < policy.MinLength {
+		return httpErrors.NewError(fmt.Errorf("password must be at least %d characters", policy.MinLength), "A_PASSWORD_TOO_SHORT")
+	}
+	if helper.CountCharacterClasses(password) < policy.MinCharacterClasses {
+		return httpErrors.NewError(fmt.Errorf("password must contain at least %d character classes", policy.MinCharacterClasses), "A_PASSWORD_TOO_SIMPLE")
+	}
+
+	if userId == nil || policy.HistoryCount == 0 {
+		return nil
+	}
+	histories, err := u.passwordPolicyRepository.FetchHistories(ctx, *userId, policy.HistoryCount)
+	if err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	for _, history := range histories {
+		if helper.CheckPasswordHash(history.PasswordHash, password) {
+			return httpErrors.NewError(fmt.Errorf("password is used in the last %d passwords", policy.HistoryCount), "A_PASSWORD_REUSED")
+		}
+	}
+	return nil
+}
+
+// recordPasswordHistory 는 정책이 이전 비밀번호 재사용을 막는 경우에만 비밀번호 hash 를 남긴다.
+// 비밀번호는 이미 변경되었으므로 실패해도 요청을 실패시키지 않는다.
+func (u *UserUsecase) recordPasswordHistory(ctx context.Context, policy model.PasswordPolicy, userId uuid.UUID, password string) {
+	if policy.HistoryCount == 0 {
+		return
+	}
+	hash, err := helper.HashPassword(password)
+	if err != nil {
+		log.Error(ctx, err)
+		return
+	}
+	if err := u.passwordPolicyRepository.CreateHistory(ctx, model.PasswordHistory{UserId: userId, PasswordHash: hash}, policy.HistoryCount); err != nil {
+		log.Error(ctx, err)
+	}
+}
+
+// generatePassword 는 조직의 정책을 만족하는 임시 비밀번호를 만든다.
+func (u *UserUsecase) generatePassword(ctx context.Context, organizationId string) string {
+	length := passwordLength
+	if policy, err := u.GetPasswordPolicy(ctx, organizationId); err == nil && policy.MinLength > length {
+		length = policy.MinLength
+	}
+	return helper.GenerateRandomPassword(length)
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ReassignRole 은 sourceRole 의 모든 사용자를 targetRole 로 옮기는 작업을 기록한 뒤 바로 반환하고, 실제 처리는 background 에서 진행한다.
+// 사용자마다 keycloak group 을 옮기고 token 을 만료시키며, 한 사용자에서 실패해도 나머지 사용자는 계속 처리한다.
+// 같은 조직에서 진행 중인 작업이 있으면 새 작업을 시작하지 않는다.
+func (u *UserUsecase) ReassignRole(ctx context.Context, organizationId string, sourceRoleId string, targetRoleId string) (out model.RoleReassignment, err error) {
+	if _, err = u.organizationRepository.Get(ctx, organizationId); err != nil {
+		return out, httpErrors.NewError(err, "C_INVALID_ORGANIZATION_ID")
+	}
+	if sourceRoleId == targetRoleId {
+		return out, httpErrors.NewError(fmt.Errorf("source and target role are the same"), "U_INVALID_ROLE_REASSIGNMENT")
+	}
+
+	sourceRole, err := u.getOrganizationRole(ctx, organizationId, sourceRoleId)
+	if err != nil {
+		return out, err
+	}
+	targetRole, err := u.getOrganizationRole(ctx, organizationId, targetRoleId)
+	if err != nil {
+		return out, err
+	}
+
+	running, err := u.roleReassignmentRepository.CountRunning(ctx, organizationId)
+	if err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if running > 0 {
+		return out, httpErrors.NewError(fmt.Errorf("role reassignment is in progress"), "U_ROLE_REASSIGNMENT_IN_PROGRESS")
+	}
+
+	out = model.RoleReassignment{
+		OrganizationId: organizationId,
+		SourceRoleId:   sourceRole.ID,
+		SourceRoleName: sourceRole.Name,
+		TargetRoleId:   targetRole.ID,
+		TargetRoleName: targetRole.Name,
+		Status:         domain.RoleReassignmentStatus_RUNNING,
+		StartedAt:      time.Now(),
+	}
+	if user, ok := request.UserFrom(ctx); ok {
+		userId := user.GetUserId()
+		out.CreatorId = &userId
+	}
+	if out.ID, err = u.roleReassignmentRepository.Create(ctx, out); err != nil {
+		return out, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+
+	go u.processRoleReassignment(context.Background(), out, *targetRole)
+	return out, nil
+}
+
+func (u *UserUsecase) GetRoleReassignments(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.RoleReassignment, error) {
+	reassignments, err := u.roleReassignmentRepository.Fetch(ctx, organizationId, pg)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return reassignments, nil
+}
+
+// GetRoleReassignment 는 사용자별 처리 결과를 포함하여 반환한다.
+func (u *UserUsecase) GetRoleReassignment(ctx context.Context, organizationId string, reassignmentId uuid.UUID) (model.RoleReassignment, error) {
+	reassignment, err := u.roleReassignmentRepository.Get(ctx, reassignmentId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return model.RoleReassignment{}, httpErrors.NewError(err, "U_NOT_FOUND_ROLE_REASSIGNMENT")
+		}
+		return model.RoleReassignment{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if reassignment.OrganizationId != organizationId {
+		return model.RoleReassignment{}, httpErrors.NewError(fmt.Errorf("Not found role reassignment"), "U_NOT_FOUND_ROLE_REASSIGNMENT")
+	}
+	return reassignment, nil
+}
+
+func (u *UserUsecase) getOrganizationRole(ctx context.Context, organizationId string, roleId string) (*model.Role, error) {
+	role, err := u.roleRepository.GetTksRole(ctx, organizationId, roleId)
+	if err != nil || role.OrganizationID != organizationId {
+		return nil, httpErrors.NewError(fmt.Errorf("Not found role %s", roleId), "U_INVALID_ROLE_REASSIGNMENT")
+	}
+	return role, nil
+}
+
+func (u *UserUsecase) processRoleReassignment(ctx context.Context, reassignment model.RoleReassignment, targetRole model.Role) {
+	users, err := u.listUsersInRole(ctx, reassignment.OrganizationId, reassignment.SourceRoleId)
+	if err != nil {
+		log.Errorf(ctx, "failed to get users of role %s. err : %s", reassignment.SourceRoleName, err)
+		reassignment.Error = err.Error()
+		u.finishRoleReassignment(ctx, reassignment)
+		return
+	}
+
+	reassignment.TotalCount = len(users)
+	if err := u.roleReassignmentRepository.Update(ctx, reassignment); err != nil {
+		log.Error(ctx, err)
+	}
+
+	for _, user := range users {
+		result := model.RoleReassignmentResult{
+			ReassignmentId: reassignment.ID,
+			UserId:         user.ID,
+			AccountId:      user.AccountId,
+			Status:         domain.RoleReassignmentResultStatus_SUCCEEDED,
+		}
+		if err := u.reassignUserRole(ctx, user, reassignment.SourceRoleId, targetRole); err != nil {
+			log.Errorf(ctx, "failed to reassign role of user %s. err : %s", user.AccountId, err)
+			result.Status = domain.RoleReassignmentResultStatus_FAILED
+			result.Error = err.Error()
+			reassignment.FailedCount++
+		} else {
+			reassignment.SucceededCount++
+		}
+		result.FinishedAt = time.Now()
+
+		if err := u.roleReassignmentRepository.CreateResult(ctx, result); err != nil {
+			log.Error(ctx, err)
+		}
+		if err := u.roleReassignmentRepository.Update(ctx, reassignment); err != nil {
+			log.Error(ctx, err)
+		}
+	}
+
+	u.finishRoleReassignment(ctx, reassignment)
+}
+
+func (u *UserUsecase) finishRoleReassignment(ctx context.Context, reassignment model.RoleReassignment) {
+	finishedAt := time.Now()
+	reassignment.FinishedAt = &finishedAt
+	switch {
+	case reassignment.Error != "" || (reassignment.TotalCount > 0 && reassignment.FailedCount == reassignment.TotalCount):
+		reassignment.Status = domain.RoleReassignmentStatus_FAILED
+	case reassignment.FailedCount > 0:
+		reassignment.Status = domain.RoleReassignmentStatus_PARTIALLY_FAILED
+	default:
+		reassignment.Status = domain.RoleReassignmentStatus_SUCCEEDED
+	}
+	log.Infof(ctx, "finished role reassignment %s -> %s of organization %s. succeeded : %d, failed : %d",
+		reassignment.SourceRoleName, reassignment.TargetRoleName, reassignment.OrganizationId, reassignment.SucceededCount, reassignment.FailedCount)
+
+	if err := u.roleReassignmentRepository.Update(ctx, reassignment); err != nil {
+		log.Error(ctx, err)
+	}
+}
+
+// listUsersInRole 은 page 크기와 관계없이 역할의 모든 사용자를 반환한다.
+func (u *UserUsecase) listUsersInRole(ctx context.Context, organizationId string, roleId string) ([]model.User, error) {
+	users, err := u.userRepository.List(ctx, u.userRepository.OrganizationFilter(organizationId))
+	if err != nil {
+		if _, code := httpErrors.ErrorResponse(err); code == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	out := []model.User{}
+	for _, user := range *users {
+		for _, role := range user.Roles {
+			if role.ID == roleId {
+				out = append(out, user)
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+// reassignUserRole 은 사용자의 sourceRole 을 targetRole 로 바꾼다. 이미 targetRole 을 가진 사용자는 sourceRole 만 제거한다.
+func (u *UserUsecase) reassignUserRole(ctx context.Context, user model.User, sourceRoleId string, targetRole model.Role) error {
+	roles := []model.Role{}
+	assigned := false
+	for _, role := range user.Roles {
+		if role.ID == sourceRoleId {
+			continue
+		}
+		if role.ID == targetRole.ID {
+			assigned = true
+		}
+		roles = append(roles, role)
+	}
+	if !assigned {
+		roles = append(roles, targetRole)
+	}
+
+	user.Roles = roles
+	_, err := u.UpdateByAccountIdByAdmin(ctx, &user)
+	return err
+}
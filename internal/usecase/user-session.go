@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openinfradev/tks-api/internal/keycloak"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/request"
+	"github.com/openinfradev/tks-api/internal/middleware/auth/user"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/pagination"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IUserSessionUsecase interface {
+	Create(ctx context.Context, dto model.UserSession) (userSessionId uuid.UUID, err error)
+	Fetch(ctx context.Context, organizationId string, accountId string, pg *pagination.Pagination) ([]model.UserSession, error)
+	Revoke(ctx context.Context, organizationId string, userSessionId uuid.UUID) error
+	PurgeInactive(ctx context.Context) error
+}
+
+type UserSessionUsecase struct {
+	repo     repository.IUserSessionRepository
+	userRepo repository.IUserRepository
+	kc       keycloak.IKeycloak
+}
+
+func NewUserSessionUsecase(r repository.Repository, kc keycloak.IKeycloak) IUserSessionUsecase {
+	return &UserSessionUsecase{
+		repo:     r.UserSession,
+		userRepo: r.User,
+		kc:       kc,
+	}
+}
+
+func (u *UserSessionUsecase) Create(ctx context.Context, dto model.UserSession) (userSessionId uuid.UUID, err error) {
+	dto.LastActivityAt = time.Now()
+	userSessionId, err = u.repo.Create(ctx, dto)
+	if err != nil {
+		return uuid.Nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return userSessionId, nil
+}
+
+func (u *UserSessionUsecase) Fetch(ctx context.Context, organizationId string, accountId string, pg *pagination.Pagination) ([]model.UserSession, error) {
+	user, err := u.userRepo.Get(ctx, accountId, organizationId)
+	if err != nil {
+		return nil, httpErrors.NewError(err, "U_NO_USER")
+	}
+	if err := checkSessionOwner(ctx, organizationId, user.ID); err != nil {
+		return nil, err
+	}
+	return u.repo.Fetch(ctx, user.ID, pg)
+}
+
+// Revoke 는 keycloak session 을 종료하여 session 에서 발급한 access token 과 refresh token 을 모두 무효화한다.
+// keycloak 에서 이미 만료된 session 이어도 기록은 삭제한다.
+func (u *UserSessionUsecase) Revoke(ctx context.Context, organizationId string, userSessionId uuid.UUID) error {
+	userSession, err := u.repo.Get(ctx, userSessionId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return httpErrors.NewError(err, "A_NOT_FOUND_SESSION")
+		}
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	if userSession.OrganizationId != organizationId {
+		return httpErrors.NewError(fmt.Errorf("Not found session"), "A_NOT_FOUND_SESSION")
+	}
+	if err := checkSessionOwner(ctx, organizationId, userSession.UserId); err != nil {
+		return err
+	}
+
+	if err := u.kc.Logout(ctx, userSession.SessionId, organizationId); err != nil {
+		log.Warnf(ctx, "failed to logout keycloak session %s. err : %s", userSession.SessionId, err)
+	}
+	if err := u.repo.Delete(ctx, userSessionId); err != nil {
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return nil
+}
+
+// checkSessionOwner 는 접속 IP 와 user agent 가 담긴 session 을 본인과 조직 관리자만 조회하거나 종료할 수 있도록 한다.
+func checkSessionOwner(ctx context.Context, organizationId string, userId uuid.UUID) error {
+	requestUser, ok := request.UserFrom(ctx)
+	if !ok {
+		return httpErrors.NewBadRequestError(fmt.Errorf("Invalid token"), "", "")
+	}
+	if requestUser.GetUserId() == userId || requestUser.GetRoleOrganizationMapping()[organizationId] == user.AdminRole {
+		return nil
+	}
+	return httpErrors.NewError(fmt.Errorf("session of user %s is not accessible", userId), "A_FORBIDDEN_SESSION")
+}
+
+// PurgeInactive 는 keycloak 의 session idle timeout 이 지나 종료된 session 의 기록을 삭제한다.
+func (u *UserSessionUsecase) PurgeInactive(ctx context.Context) error {
+	deleted, err := u.repo.DeleteInactive(ctx, time.Now().Add(-time.Duration(keycloak.SsoSessionIdleTimeout)*time.Second))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		log.Infof(ctx, "purged %d inactive user sessions", deleted)
+	}
+	return nil
+}
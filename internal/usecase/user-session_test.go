@@ -0,0 +1,67 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+func TestUserSessionAccess(t *testing.T) {
+	ctx := context.Background()
+	repo, kc, userUsecase := newUserFixture(t)
+	u := usecase.NewUserSessionUsecase(repo, kc)
+
+	alice := createTestUser(t, userUsecase, "alice", testUserRole)
+	bob := createTestUser(t, userUsecase, "bob", testUserRole)
+	newSession := func() uuid.UUID {
+		t.Helper()
+		userSessionId, err := u.Create(ctx, model.UserSession{OrganizationId: testOrganizationId, UserId: alice.ID, SessionId: uuid.NewString()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return userSessionId
+	}
+	aliceSession := newSession()
+	otherAliceSession := newSession()
+
+	aliceCtx := withRequestUser(ctx, alice.ID, testUserRole.Name)
+	bobCtx := withRequestUser(ctx, bob.ID, testUserRole.Name)
+	adminCtx := withRequestUser(ctx, uuid.New(), testAdminRole.Name)
+
+	isForbidden := func(err error) bool {
+		var restErr httpErrors.IRestError
+		return errors.As(err, &restErr) && restErr.Status() == http.StatusForbidden
+	}
+
+	if _, err := u.Fetch(bobCtx, testOrganizationId, "alice", nil); !isForbidden(err) {
+		t.Errorf("Fetch() by other member error = %v, want forbidden", err)
+	}
+	if err := u.Revoke(bobCtx, testOrganizationId, aliceSession); !isForbidden(err) {
+		t.Errorf("Revoke() by other member error = %v, want forbidden", err)
+	}
+	if _, err := repo.UserSession.Get(ctx, aliceSession); err != nil {
+		t.Errorf("session revoked by other member, err = %v", err)
+	}
+
+	for name, requestCtx := range map[string]context.Context{"owner": aliceCtx, "admin": adminCtx} {
+		if sessions, err := u.Fetch(requestCtx, testOrganizationId, "alice", nil); err != nil || len(sessions) == 0 {
+			t.Errorf("Fetch() by %s = %d sessions, %v", name, len(sessions), err)
+		}
+	}
+	if err := u.Revoke(aliceCtx, testOrganizationId, aliceSession); err != nil {
+		t.Errorf("Revoke() by owner error = %v", err)
+	}
+	if err := u.Revoke(adminCtx, testOrganizationId, otherAliceSession); err != nil {
+		t.Errorf("Revoke() by admin error = %v", err)
+	}
+	if sessions, _ := u.Fetch(aliceCtx, testOrganizationId, "alice", nil); len(sessions) != 0 {
+		t.Errorf("sessions after revoke = %d, want 0", len(sessions))
+	}
+}
@@ -7,12 +7,13 @@ import (
 
 	"github.com/Nerzal/gocloak/v13"
 	"github.com/google/uuid"
-	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/internal/keycloak"
 	"github.com/openinfradev/tks-api/internal/mail"
 	"github.com/openinfradev/tks-api/internal/model"
 	"github.com/openinfradev/tks-api/internal/pagination"
 	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/hook"
 	"github.com/openinfradev/tks-api/pkg/httpErrors"
 	"github.com/openinfradev/tks-api/pkg/log"
 	"github.com/pkg/errors"
@@ -23,13 +24,12 @@ type IUserUsecase interface {
 	DeleteAdmin(ctx context.Context, organizationId string) error
 	DeleteAll(ctx context.Context, organizationId string) error
 	Create(ctx context.Context, user *model.User) (*model.User, error)
-	List(ctx context.Context, organizationId string) (*[]model.User, error)
-	ListWithPagination(ctx context.Context, organizationId string, pg *pagination.Pagination) (*[]model.User, error)
+	List(ctx context.Context, organizationId string, pg *pagination.Pagination) (*[]model.User, error)
 	Get(ctx context.Context, userId uuid.UUID) (*model.User, error)
 	Update(ctx context.Context, user *model.User) (*model.User, error)
 	ResetPassword(ctx context.Context, userId uuid.UUID) error
 	ResetPasswordByAccountId(ctx context.Context, accountId string, organizationId string) error
-	GenerateRandomPassword(ctx context.Context) string
+	GenerateRandomPassword(ctx context.Context, organizationId string) string
 	Delete(ctx context.Context, userId uuid.UUID, organizationId string) error
 	GetByAccountId(ctx context.Context, accountId string, organizationId string) (*model.User, error)
 	GetByEmail(ctx context.Context, email string, organizationId string) (*model.User, error)
@@ -46,29 +46,50 @@ type IUserUsecase interface {
 	UpdateByAccountIdByAdmin(ctx context.Context, user *model.User) (*model.User, error)
 
 	ListUsersByRole(ctx context.Context, organizationId string, roleId string, pg *pagination.Pagination) (*[]model.User, error)
+
+	ReassignRole(ctx context.Context, organizationId string, sourceRoleId string, targetRoleId string) (model.RoleReassignment, error)
+	GetRoleReassignments(ctx context.Context, organizationId string, pg *pagination.Pagination) ([]model.RoleReassignment, error)
+	GetRoleReassignment(ctx context.Context, organizationId string, reassignmentId uuid.UUID) (model.RoleReassignment, error)
+
+	// dry run 은 실제 요청과 같은 검증을 수행하고 반영될 변경 사항만 반환한다.
+	CreateDryRun(ctx context.Context, user *model.User) ([]string, error)
+	UpdateByAccountIdByAdminDryRun(ctx context.Context, user *model.User) ([]string, error)
+	DeleteByAccountIdDryRun(ctx context.Context, accountId string, organizationId string) ([]string, error)
+
+	GetPasswordPolicy(ctx context.Context, organizationId string) (model.PasswordPolicy, error)
+	UpdatePasswordPolicy(ctx context.Context, dto model.PasswordPolicy) error
+
+	EnrollOtp(ctx context.Context, userId uuid.UUID) (secret string, otpauthUri string, err error)
+	ActivateOtp(ctx context.Context, userId uuid.UUID, code string) error
+	DisableOtp(ctx context.Context, userId uuid.UUID, code string) error
 }
 
 type UserUsecase struct {
-	authRepository         repository.IAuthRepository
-	userRepository         repository.IUserRepository
-	roleRepository         repository.IRoleRepository
-	organizationRepository repository.IOrganizationRepository
-	kc                     keycloak.IKeycloak
+	authRepository             repository.IAuthRepository
+	userRepository             repository.IUserRepository
+	roleRepository             repository.IRoleRepository
+	organizationRepository     repository.IOrganizationRepository
+	onboardingRepository       repository.IOrganizationOnboardingRepository
+	passwordPolicyRepository   repository.IPasswordPolicyRepository
+	roleReassignmentRepository repository.IRoleReassignmentRepository
+	quotaRepository            repository.IOrganizationQuotaRepository
+	secretSealer               repository.ISecretSealer
+	kc                         keycloak.IKeycloak
 }
 
 func (u *UserUsecase) RenewalPasswordExpiredTime(ctx context.Context, userId uuid.UUID) error {
 	user, err := u.userRepository.GetByUuid(ctx, userId)
 	if err != nil {
 		if _, status := httpErrors.ErrorResponse(err); status != http.StatusNotFound {
-			return httpErrors.NewBadRequestError(fmt.Errorf("user not found"), "U_NO_USER", "")
+			return httpErrors.NewError(fmt.Errorf("user not found"), "U_NO_USER")
 		}
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	err = u.userRepository.UpdatePasswordAt(ctx, userId, user.Organization.ID, false)
 	if err != nil {
 		log.Errorf(ctx, "failed to update password expired time: %v", err)
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	return nil
@@ -78,9 +99,9 @@ func (u *UserUsecase) RenewalPasswordExpiredTimeByAccountId(ctx context.Context,
 	user, err := u.userRepository.Get(ctx, accountId, organizationId)
 	if err != nil {
 		if _, status := httpErrors.ErrorResponse(err); status != http.StatusNotFound {
-			return httpErrors.NewBadRequestError(fmt.Errorf("user not found"), "U_NO_USER", "")
+			return httpErrors.NewError(fmt.Errorf("user not found"), "U_NO_USER")
 		}
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	return u.RenewalPasswordExpiredTime(ctx, user.ID)
 }
@@ -89,18 +110,18 @@ func (u *UserUsecase) ResetPassword(ctx context.Context, userId uuid.UUID) error
 	user, err := u.userRepository.GetByUuid(ctx, userId)
 	if err != nil {
 		if _, status := httpErrors.ErrorResponse(err); status == http.StatusNotFound {
-			return httpErrors.NewBadRequestError(fmt.Errorf("user not found"), "U_NO_USER", "")
+			return httpErrors.NewError(fmt.Errorf("user not found"), "U_NO_USER")
 		}
 	}
 	userInKeycloak, err := u.kc.GetUser(ctx, user.Organization.ID, user.AccountId)
 	if err != nil {
 		if _, status := httpErrors.ErrorResponse(err); status == http.StatusNotFound {
-			return httpErrors.NewBadRequestError(fmt.Errorf("user not found"), "U_NO_USER", "")
+			return httpErrors.NewError(fmt.Errorf("user not found"), "U_NO_USER")
 		}
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
-	randomPassword := helper.GenerateRandomString(passwordLength)
+	randomPassword := u.generatePassword(ctx, user.Organization.ID)
 	userInKeycloak.Credentials = &[]gocloak.CredentialRepresentation{
 		{
 			Type:      gocloak.StringP("password"),
@@ -109,23 +130,23 @@ func (u *UserUsecase) ResetPassword(ctx context.Context, userId uuid.UUID) error
 		},
 	}
 	if err = u.kc.UpdateUser(ctx, user.Organization.ID, userInKeycloak); err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	if err = u.userRepository.UpdatePasswordAt(ctx, userId, user.Organization.ID, true); err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	message, err := mail.MakeTemporaryPasswordMessage(ctx, user.Email, user.Organization.ID, user.AccountId, randomPassword)
 	if err != nil {
 		log.Errorf(ctx, "mail.MakeVerityIdentityMessage error. %v", err)
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	mailer := mail.New(message)
 
 	if err := mailer.SendMail(ctx); err != nil {
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	return nil
@@ -135,25 +156,25 @@ func (u *UserUsecase) ResetPasswordByAccountId(ctx context.Context, accountId st
 	user, err := u.userRepository.Get(ctx, accountId, organizationId)
 	if err != nil {
 		if _, status := httpErrors.ErrorResponse(err); status == http.StatusNotFound {
-			return httpErrors.NewBadRequestError(fmt.Errorf("user not found"), "U_NO_USER", "")
+			return httpErrors.NewError(fmt.Errorf("user not found"), "U_NO_USER")
 		}
-		return httpErrors.NewInternalServerError(err, "", "")
+		return httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	return u.ResetPassword(ctx, user.ID)
 }
 
-func (u *UserUsecase) GenerateRandomPassword(ctx context.Context) string {
-	return helper.GenerateRandomString(passwordLength)
+func (u *UserUsecase) GenerateRandomPassword(ctx context.Context, organizationId string) string {
+	return u.generatePassword(ctx, organizationId)
 }
 
 func (u *UserUsecase) ValidateAccount(ctx context.Context, userId uuid.UUID, password string, organizationId string) error {
 	user, err := u.userRepository.GetByUuid(ctx, userId)
 	if err != nil {
-		return httpErrors.NewBadRequestError(fmt.Errorf("user not found"), "U_NO_USER", "")
+		return httpErrors.NewError(fmt.Errorf("user not found"), "U_NO_USER")
 	}
 	_, err = u.kc.Login(ctx, user.AccountId, password, organizationId)
 	if err != nil {
-		return httpErrors.NewBadRequestError(fmt.Errorf("invalid password"), "A_INVALID_PASSWORD", "")
+		return httpErrors.NewError(fmt.Errorf("invalid password"), "A_INVALID_PASSWORD")
 	}
 	return nil
 }
@@ -201,7 +222,7 @@ func (u *UserUsecase) DeleteAdmin(ctx context.Context, organizationId string) er
 
 func (u *UserUsecase) CreateAdmin(ctx context.Context, user *model.User) (*model.User, error) {
 	// Generate Admin user object
-	randomPassword := helper.GenerateRandomString(passwordLength)
+	randomPassword := u.generatePassword(ctx, user.Organization.ID)
 	user.Password = randomPassword
 
 	// Create Admin user in keycloak & DB
@@ -217,11 +238,11 @@ func (u *UserUsecase) CreateAdmin(ctx context.Context, user *model.User) (*model
 	}
 	message, err := mail.MakeGeneratingOrganizationMessage(ctx, resUser.Organization.ID, organizationInfo.Name, user.Email, user.AccountId, randomPassword)
 	if err != nil {
-		return nil, httpErrors.NewInternalServerError(err, "", "")
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 	mailer := mail.New(message)
 	if err := mailer.SendMail(ctx); err != nil {
-		return nil, httpErrors.NewInternalServerError(err, "", "")
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	return resUser, nil
@@ -251,11 +272,23 @@ func (u *UserUsecase) SendEmailForTemporaryPassword(ctx context.Context, account
 func (u *UserUsecase) UpdatePasswordByAccountId(ctx context.Context, accountId string, originPassword string, newPassword string,
 	organizationId string) error {
 	if originPassword == newPassword {
-		return httpErrors.NewBadRequestError(fmt.Errorf("new password is same with origin password"), "A_SAME_OLD_PASSWORD", "")
+		return httpErrors.NewError(fmt.Errorf("new password is same with origin password"), "A_SAME_OLD_PASSWORD")
 	}
 	if _, err := u.kc.Login(ctx, accountId, originPassword, organizationId); err != nil {
-		return httpErrors.NewBadRequestError(fmt.Errorf("invalid origin password"), "A_INVALID_PASSWORD", "")
+		return httpErrors.NewError(fmt.Errorf("invalid origin password"), "A_INVALID_PASSWORD")
+	}
+	user, err := u.userRepository.Get(ctx, accountId, organizationId)
+	if err != nil {
+		return errors.Wrap(err, "getting user from repository failed")
+	}
+	policy, err := u.GetPasswordPolicy(ctx, organizationId)
+	if err != nil {
+		return err
 	}
+	if err := u.validatePassword(ctx, policy, &user.ID, newPassword); err != nil {
+		return err
+	}
+
 	originUser, err := u.kc.GetUser(ctx, organizationId, accountId)
 	if err != nil {
 		return err
@@ -273,12 +306,9 @@ func (u *UserUsecase) UpdatePasswordByAccountId(ctx context.Context, accountId s
 		return errors.Wrap(err, "updating user in keycloak failed")
 	}
 
-	// update password UpdateAt in DB
-	user, err := u.userRepository.Get(ctx, accountId, organizationId)
-	if err != nil {
-		return errors.Wrap(err, "getting user from repository failed")
-	}
+	u.recordPasswordHistory(ctx, policy, user.ID, newPassword)
 
+	// update password UpdateAt in DB
 	err = u.userRepository.UpdatePasswordAt(ctx, user.ID, organizationId, false)
 	if err != nil {
 		return errors.Wrap(err, "updating user in repository failed")
@@ -287,16 +317,8 @@ func (u *UserUsecase) UpdatePasswordByAccountId(ctx context.Context, accountId s
 	return nil
 }
 
-func (u *UserUsecase) List(ctx context.Context, organizationId string) (users *[]model.User, err error) {
-	users, err = u.userRepository.List(ctx, u.userRepository.OrganizationFilter(organizationId))
-	if err != nil {
-		return nil, err
-	}
-
-	return
-}
-
-func (u *UserUsecase) ListWithPagination(ctx context.Context, organizationId string, pg *pagination.Pagination) (users *[]model.User, err error) {
+// List 는 조직의 사용자 목록을 pg 의 limit, offset, 정렬, filter 조건으로 조회한다. pg 가 nil 이면 기본 조건을 사용한다.
+func (u *UserUsecase) List(ctx context.Context, organizationId string, pg *pagination.Pagination) (users *[]model.User, err error) {
 	users, err = u.userRepository.ListWithPagination(ctx, pg, organizationId)
 	if err != nil {
 		return nil, err
@@ -309,7 +331,7 @@ func (u *UserUsecase) Get(ctx context.Context, userId uuid.UUID) (*model.User, e
 	user, err := u.userRepository.GetByUuid(ctx, userId)
 	if err != nil {
 		if _, status := httpErrors.ErrorResponse(err); status == http.StatusNotFound {
-			return nil, httpErrors.NewBadRequestError(fmt.Errorf("user not found"), "U_NO_USER", "")
+			return nil, httpErrors.NewError(fmt.Errorf("user not found"), "U_NO_USER")
 		}
 		return nil, err
 	}
@@ -422,6 +444,11 @@ func (u *UserUsecase) DeleteByAccountId(ctx context.Context, accountId string, o
 }
 
 func (u *UserUsecase) Create(ctx context.Context, user *model.User) (*model.User, error) {
+	policy, err := u.validateCreate(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create user in keycloak
 	var groups []string
 	for _, role := range user.Roles {
@@ -457,12 +484,25 @@ func (u *UserUsecase) Create(ctx context.Context, user *model.User) (*model.User
 		return nil, err
 	}
 
+	u.recordPasswordHistory(ctx, policy, resUser.ID, user.Password)
+
+	if err := u.onboardingRepository.Complete(ctx, user.Organization.ID, domain.OnboardingStep_USER); err != nil {
+		log.Error(ctx, err)
+	}
+
+	// hook 에는 비밀번호와 token 을 전달하지 않는다.
+	hookUser := *resUser
+	hookUser.Password, hookUser.Token, hookUser.RefreshToken = "", "", ""
+	if err := runHook(ctx, hook.PostUserCreate, user.Organization.ID, &hookUser); err != nil {
+		log.Error(ctx, err)
+	}
+
 	return resUser, nil
 }
 
 func (u *UserUsecase) UpdateByAccountIdByAdmin(ctx context.Context, newUser *model.User) (*model.User, error) {
 	if newUser.AccountId == "" {
-		return nil, httpErrors.NewBadRequestError(fmt.Errorf("accountId is required"), "C_INVALID_ACCOUNT_ID", "")
+		return nil, httpErrors.NewError(fmt.Errorf("accountId is required"), "C_INVALID_ACCOUNT_ID")
 	}
 
 	originUser, err := u.userRepository.Get(ctx, newUser.AccountId, newUser.Organization.ID)
@@ -470,23 +510,13 @@ func (u *UserUsecase) UpdateByAccountIdByAdmin(ctx context.Context, newUser *mod
 		return nil, err
 	}
 
-	unassigningRoleIds, assigningRoleIds := make(map[string]model.Role), make(map[string]model.Role)
-	for _, role := range newUser.Roles {
-		assigningRoleIds[role.ID] = role
-	}
-	for _, role := range originUser.Roles {
-		if _, ok := assigningRoleIds[role.ID]; !ok {
-			unassigningRoleIds[role.ID] = role
-		} else {
-			delete(assigningRoleIds, role.ID)
-		}
-	}
+	unassigningRoleIds, assigningRoleIds := diffRoles(originUser.Roles, newUser.Roles)
 
 	for _, role := range unassigningRoleIds {
 		groupName := fmt.Sprintf("%s@%s", role.Name, originUser.Organization.ID)
 		if err := u.kc.LeaveGroup(ctx, originUser.Organization.ID, originUser.ID.String(), groupName); err != nil {
 			log.Errorf(ctx, "leave group in keycloak failed: %v", err)
-			return nil, httpErrors.NewInternalServerError(err, "", "")
+			return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
 		}
 	}
 
@@ -494,14 +524,14 @@ func (u *UserUsecase) UpdateByAccountIdByAdmin(ctx context.Context, newUser *mod
 		groupName := fmt.Sprintf("%s@%s", role.Name, originUser.Organization.ID)
 		if err := u.kc.JoinGroup(ctx, originUser.Organization.ID, originUser.ID.String(), groupName); err != nil {
 			log.Errorf(ctx, "join group in keycloak failed: %v", err)
-			return nil, httpErrors.NewInternalServerError(err, "", "")
+			return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
 		}
 	}
 
 	err = u.authRepository.UpdateExpiredTimeOnToken(ctx, originUser.Organization.ID, originUser.ID.String())
 	if err != nil {
 		log.Errorf(ctx, "update expired time on token failed: %v", err)
-		return nil, httpErrors.NewInternalServerError(err, "", "")
+		return nil, httpErrors.NewError(err, "C_INTERNAL_ERROR")
 	}
 
 	originUser.Name = newUser.Name
@@ -528,12 +558,124 @@ func (u *UserUsecase) ListUsersByRole(ctx context.Context, organizationId string
 
 }
 
+func (u *UserUsecase) CreateDryRun(ctx context.Context, user *model.User) ([]string, error) {
+	organizationId := user.Organization.ID
+	if _, err := u.validateCreate(ctx, user); err != nil {
+		return nil, err
+	}
+
+	changes := []string{fmt.Sprintf("create user %s in keycloak", user.AccountId)}
+	for _, role := range user.Roles {
+		changes = append(changes, fmt.Sprintf("join group %s@%s", role.Name, organizationId))
+	}
+	changes = append(changes, fmt.Sprintf("create user %s in database", user.AccountId))
+	return changes, nil
+}
+
+// validateCreate 는 Create 와 CreateDryRun 이 사용자를 만들기 전에 확인하는 조건이다.
+// 비밀번호 이력을 기록할 수 있도록 조직의 비밀번호 정책을 반환한다.
+func (u *UserUsecase) validateCreate(ctx context.Context, user *model.User) (model.PasswordPolicy, error) {
+	organizationId := user.Organization.ID
+	if _, err := u.organizationRepository.Get(ctx, organizationId); err != nil {
+		return model.PasswordPolicy{}, httpErrors.NewError(err, "C_INVALID_ORGANIZATION_ID")
+	}
+	if err := checkUserQuota(ctx, u.quotaRepository, u.userRepository, organizationId, 1); err != nil {
+		return model.PasswordPolicy{}, err
+	}
+
+	policy, err := u.GetPasswordPolicy(ctx, organizationId)
+	if err != nil {
+		return model.PasswordPolicy{}, err
+	}
+	if err := u.validatePassword(ctx, policy, nil, user.Password); err != nil {
+		return model.PasswordPolicy{}, err
+	}
+
+	if users, err := u.userRepository.List(ctx, u.userRepository.OrganizationFilter(organizationId),
+		u.userRepository.AccountIdFilter(user.AccountId)); err == nil && len(*users) > 0 {
+		return model.PasswordPolicy{}, httpErrors.NewError(fmt.Errorf("user %s already exists", user.AccountId), "U_DUPLICATED_ACCOUNT_ID")
+	}
+	if users, err := u.userRepository.List(ctx, u.userRepository.OrganizationFilter(organizationId),
+		u.userRepository.EmailFilter(user.Email)); err == nil && len(*users) > 0 {
+		return model.PasswordPolicy{}, httpErrors.NewError(fmt.Errorf("email %s already exists", user.Email), "U_DUPLICATED_EMAIL")
+	}
+	if _, err := u.kc.GetUser(ctx, organizationId, user.AccountId); err == nil {
+		return model.PasswordPolicy{}, httpErrors.NewError(fmt.Errorf("user %s already exists in keycloak", user.AccountId), "U_DUPLICATED_ACCOUNT_ID")
+	} else if _, code := httpErrors.ErrorResponse(err); code != http.StatusNotFound {
+		return model.PasswordPolicy{}, httpErrors.NewError(err, "C_INTERNAL_ERROR")
+	}
+	return policy, nil
+}
+
+func (u *UserUsecase) UpdateByAccountIdByAdminDryRun(ctx context.Context, newUser *model.User) ([]string, error) {
+	if newUser.AccountId == "" {
+		return nil, httpErrors.NewError(fmt.Errorf("accountId is required"), "C_INVALID_ACCOUNT_ID")
+	}
+
+	originUser, err := u.userRepository.Get(ctx, newUser.AccountId, newUser.Organization.ID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.kc.GetUser(ctx, originUser.Organization.ID, originUser.AccountId); err != nil {
+		return nil, httpErrors.NewError(err, "U_NO_USER")
+	}
+
+	changes := []string{}
+	unassigningRoleIds, assigningRoleIds := diffRoles(originUser.Roles, newUser.Roles)
+	for _, role := range unassigningRoleIds {
+		changes = append(changes, fmt.Sprintf("leave group %s@%s", role.Name, originUser.Organization.ID))
+	}
+	for _, role := range assigningRoleIds {
+		changes = append(changes, fmt.Sprintf("join group %s@%s", role.Name, originUser.Organization.ID))
+	}
+	if len(unassigningRoleIds) > 0 || len(assigningRoleIds) > 0 {
+		changes = append(changes, fmt.Sprintf("expire tokens of user %s", originUser.AccountId))
+	}
+	changes = append(changes, fmt.Sprintf("update user %s in database", originUser.AccountId))
+	return changes, nil
+}
+
+func (u *UserUsecase) DeleteByAccountIdDryRun(ctx context.Context, accountId string, organizationId string) ([]string, error) {
+	if _, err := u.userRepository.Get(ctx, accountId, organizationId); err != nil {
+		return nil, err
+	}
+	if _, err := u.kc.GetUser(ctx, organizationId, accountId); err != nil {
+		return nil, httpErrors.NewError(err, "U_NO_USER")
+	}
+
+	return []string{
+		fmt.Sprintf("delete user %s in database", accountId),
+		fmt.Sprintf("delete user %s in keycloak", accountId),
+	}, nil
+}
+
+// diffRoles returns the roles to be unassigned from and assigned to the user
+func diffRoles(originRoles []model.Role, newRoles []model.Role) (unassigning map[string]model.Role, assigning map[string]model.Role) {
+	unassigning, assigning = make(map[string]model.Role), make(map[string]model.Role)
+	for _, role := range newRoles {
+		assigning[role.ID] = role
+	}
+	for _, role := range originRoles {
+		if _, ok := assigning[role.ID]; !ok {
+			unassigning[role.ID] = role
+		} else {
+			delete(assigning, role.ID)
+		}
+	}
+	return
+}
+
 func NewUserUsecase(r repository.Repository, kc keycloak.IKeycloak) IUserUsecase {
 	return &UserUsecase{
-		authRepository:         r.Auth,
-		userRepository:         r.User,
-		roleRepository:         r.Role,
-		kc:                     kc,
-		organizationRepository: r.Organization,
+		authRepository:             r.Auth,
+		userRepository:             r.User,
+		roleRepository:             r.Role,
+		kc:                         kc,
+		organizationRepository:     r.Organization,
+		onboardingRepository:       r.OrganizationOnboarding,
+		passwordPolicyRepository:   r.PasswordPolicy,
+		roleReassignmentRepository: r.RoleReassignment,
+		quotaRepository:            r.OrganizationQuota,
+		secretSealer:               r.SecretSealer,
 	}
 }
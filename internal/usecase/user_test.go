@@ -0,0 +1,435 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/internal/model"
+	"github.com/openinfradev/tks-api/internal/repository"
+	"github.com/openinfradev/tks-api/internal/testing/fakekeycloak"
+	"github.com/openinfradev/tks-api/internal/testing/memrepo"
+	"github.com/openinfradev/tks-api/internal/usecase"
+	"github.com/openinfradev/tks-api/pkg/domain"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+var (
+	testAdminRole = model.Role{ID: "r-admin", Name: "admin"}
+	testUserRole  = model.Role{ID: "r-user", Name: "user"}
+)
+
+func newUserFixture(t *testing.T) (repository.Repository, *fakekeycloak.Keycloak, usecase.IUserUsecase) {
+	t.Helper()
+	ctx := context.Background()
+
+	repo := memrepo.New()
+	if _, err := repo.Organization.Create(ctx, &model.Organization{ID: testOrganizationId, Name: "test"}); err != nil {
+		t.Fatal(err)
+	}
+	kc := fakekeycloak.New()
+	if _, err := kc.CreateRealm(ctx, testOrganizationId); err != nil {
+		t.Fatal(err)
+	}
+	return repo, kc, usecase.NewUserUsecase(repo, kc)
+}
+
+func createTestUser(t *testing.T, u usecase.IUserUsecase, accountId string, roles ...model.Role) *model.User {
+	t.Helper()
+
+	user, err := u.Create(context.Background(), &model.User{
+		AccountId:    accountId,
+		Password:     "password",
+		Name:         accountId,
+		Email:        accountId + "@example.com",
+		Organization: model.Organization{ID: testOrganizationId},
+		Roles:        roles,
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	return user
+}
+
+func TestUserCreate(t *testing.T) {
+	ctx := context.Background()
+	repo, kc, u := newUserFixture(t)
+
+	user := createTestUser(t, u, "alice", testAdminRole)
+
+	if user.ID == uuid.Nil {
+		t.Errorf("Create() did not assign the keycloak user id")
+	}
+	if user.Organization.Name != "test" {
+		t.Errorf("Create() organization = %+v, want preloaded organization", user.Organization)
+	}
+	if got, want := kc.Groups(testOrganizationId, "alice"), []string{"admin@" + testOrganizationId}; !reflect.DeepEqual(got, want) {
+		t.Errorf("keycloak groups = %v, want %v", got, want)
+	}
+
+	steps, err := repo.OrganizationOnboarding.Fetch(ctx, testOrganizationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(steps) != 1 || steps[0].Step != domain.OnboardingStep_USER {
+		t.Errorf("onboarding steps = %+v, want USER completed", steps)
+	}
+
+	if _, err := u.Create(ctx, &model.User{AccountId: "alice", Organization: model.Organization{ID: testOrganizationId}}); err == nil {
+		t.Errorf("Create() expected error for duplicated account")
+	}
+}
+
+func TestUserCreateQuotaExceeded(t *testing.T) {
+	ctx := context.Background()
+	repo, kc, u := newUserFixture(t)
+
+	if err := repo.OrganizationQuota.Upsert(ctx, model.OrganizationQuota{OrganizationId: testOrganizationId, MaxUsers: 1}); err != nil {
+		t.Fatal(err)
+	}
+	createTestUser(t, u, "alice", testUserRole)
+
+	bob := &model.User{
+		AccountId:    "bob",
+		Password:     "password",
+		Organization: model.Organization{ID: testOrganizationId},
+		Roles:        []model.Role{testUserRole},
+	}
+	var restErr httpErrors.IRestError
+	if _, err := u.CreateDryRun(ctx, bob); !errors.As(err, &restErr) || restErr.Code() != "O_QUOTA_EXCEEDED" {
+		t.Errorf("CreateDryRun() error = %v, want O_QUOTA_EXCEEDED", err)
+	}
+	_, err := u.Create(ctx, bob)
+	if !errors.As(err, &restErr) || restErr.Code() != "O_QUOTA_EXCEEDED" {
+		t.Fatalf("Create() error = %v, want O_QUOTA_EXCEEDED", err)
+	}
+	if groups := kc.Groups(testOrganizationId, "bob"); len(groups) != 0 {
+		t.Errorf("keycloak user created over quota, groups = %v", groups)
+	}
+}
+
+func TestUserGet(t *testing.T) {
+	ctx := context.Background()
+	_, _, u := newUserFixture(t)
+	alice := createTestUser(t, u, "alice", testUserRole)
+
+	tests := []struct {
+		name           string
+		accountId      string
+		organizationId string
+		wantErr        bool
+	}{
+		{name: "existing user", accountId: "alice", organizationId: testOrganizationId},
+		{name: "unknown user", accountId: "bob", organizationId: testOrganizationId, wantErr: true},
+		{name: "other organization", accountId: "alice", organizationId: "other", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := u.GetByAccountId(ctx, tt.accountId, tt.organizationId)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("GetByAccountId() expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetByAccountId() error = %v", err)
+			}
+			if user.ID != alice.ID {
+				t.Errorf("GetByAccountId() id = %s, want %s", user.ID, alice.ID)
+			}
+		})
+	}
+}
+
+func TestUserValidateAccount(t *testing.T) {
+	ctx := context.Background()
+	_, _, u := newUserFixture(t)
+	alice := createTestUser(t, u, "alice", testUserRole)
+
+	tests := []struct {
+		name     string
+		userId   uuid.UUID
+		password string
+		wantErr  bool
+	}{
+		{name: "valid password", userId: alice.ID, password: "password"},
+		{name: "invalid password", userId: alice.ID, password: "wrong", wantErr: true},
+		{name: "unknown user", userId: uuid.New(), password: "password", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := u.ValidateAccount(ctx, tt.userId, tt.password, testOrganizationId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAccount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUserUpdateByAccountIdByAdmin(t *testing.T) {
+	ctx := context.Background()
+	repo, kc, u := newUserFixture(t)
+	createTestUser(t, u, "alice", testUserRole)
+
+	tests := []struct {
+		name       string
+		roles      []model.Role
+		wantGroups []string
+	}{
+		{
+			name:       "add role",
+			roles:      []model.Role{testUserRole, testAdminRole},
+			wantGroups: []string{"admin@" + testOrganizationId, "user@" + testOrganizationId},
+		},
+		{
+			name:       "replace role",
+			roles:      []model.Role{testAdminRole},
+			wantGroups: []string{"admin@" + testOrganizationId},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := u.UpdateByAccountIdByAdmin(ctx, &model.User{
+				AccountId:    "alice",
+				Name:         "Alice",
+				Organization: model.Organization{ID: testOrganizationId},
+				Roles:        tt.roles,
+			})
+			if err != nil {
+				t.Fatalf("UpdateByAccountIdByAdmin() error = %v", err)
+			}
+			if user.Name != "Alice" || !reflect.DeepEqual(user.Roles, tt.roles) {
+				t.Errorf("UpdateByAccountIdByAdmin() = %+v", user)
+			}
+			if got := kc.Groups(testOrganizationId, "alice"); !reflect.DeepEqual(got, tt.wantGroups) {
+				t.Errorf("keycloak groups = %v, want %v", got, tt.wantGroups)
+			}
+			if _, err := repo.Auth.GetExpiredTimeOnToken(ctx, testOrganizationId, user.ID.String()); err != nil {
+				t.Errorf("token of the user was not expired: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserReassignRole(t *testing.T) {
+	ctx := context.Background()
+	repo, kc, u := newUserFixture(t)
+	for _, role := range []model.Role{testAdminRole, testUserRole, {ID: "r-other", Name: "other", OrganizationID: "other"}} {
+		if role.OrganizationID == "" {
+			role.OrganizationID = testOrganizationId
+		}
+		if _, err := repo.Role.Create(ctx, &role); err != nil {
+			t.Fatal(err)
+		}
+	}
+	createTestUser(t, u, "alice", testUserRole)
+	createTestUser(t, u, "bob", testUserRole, testAdminRole)
+	createTestUser(t, u, "carol", testAdminRole)
+
+	invalid := []struct {
+		name         string
+		sourceRoleId string
+		targetRoleId string
+	}{
+		{name: "same role", sourceRoleId: testUserRole.ID, targetRoleId: testUserRole.ID},
+		{name: "unknown role", sourceRoleId: testUserRole.ID, targetRoleId: "r-unknown"},
+		{name: "role of other organization", sourceRoleId: testUserRole.ID, targetRoleId: "r-other"},
+	}
+	for _, tt := range invalid {
+		if _, err := u.ReassignRole(ctx, testOrganizationId, tt.sourceRoleId, tt.targetRoleId); err == nil {
+			t.Errorf("ReassignRole() expected error for %s", tt.name)
+		}
+	}
+
+	reassignment, err := u.ReassignRole(ctx, testOrganizationId, testUserRole.ID, testAdminRole.ID)
+	if err != nil {
+		t.Fatalf("ReassignRole() error = %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for reassignment.Status == domain.RoleReassignmentStatus_RUNNING && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		if reassignment, err = u.GetRoleReassignment(ctx, testOrganizationId, reassignment.ID); err != nil {
+			t.Fatalf("GetRoleReassignment() error = %v", err)
+		}
+	}
+	if reassignment.Status != domain.RoleReassignmentStatus_SUCCEEDED || reassignment.TotalCount != 2 || reassignment.SucceededCount != 2 {
+		t.Fatalf("GetRoleReassignment() = %+v, want 2 users succeeded", reassignment)
+	}
+	if len(reassignment.Results) != 2 {
+		t.Errorf("results = %+v, want 2 results", reassignment.Results)
+	}
+
+	for _, accountId := range []string{"alice", "bob", "carol"} {
+		if got, want := kc.Groups(testOrganizationId, accountId), []string{"admin@" + testOrganizationId}; !reflect.DeepEqual(got, want) {
+			t.Errorf("keycloak groups of %s = %v, want %v", accountId, got, want)
+		}
+		user, err := u.GetByAccountId(ctx, accountId, testOrganizationId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(user.Roles) != 1 || user.Roles[0].ID != testAdminRole.ID {
+			t.Errorf("roles of %s = %+v, want only %s", accountId, user.Roles, testAdminRole.ID)
+		}
+	}
+
+	if _, err := u.GetRoleReassignment(ctx, "other", reassignment.ID); err == nil {
+		t.Errorf("GetRoleReassignment() expected error for other organization")
+	}
+}
+
+func TestUserDelete(t *testing.T) {
+	ctx := context.Background()
+	_, kc, u := newUserFixture(t)
+	alice := createTestUser(t, u, "alice", testUserRole)
+
+	if err := u.Delete(ctx, alice.ID, testOrganizationId); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := u.Get(ctx, alice.ID); err == nil {
+		t.Errorf("Get() expected error for deleted user")
+	}
+	if _, err := kc.GetUser(ctx, testOrganizationId, "alice"); err == nil {
+		t.Errorf("keycloak user was not deleted")
+	}
+	if err := u.Delete(ctx, alice.ID, testOrganizationId); err == nil {
+		t.Errorf("Delete() expected error for deleted user")
+	}
+}
+
+func TestUserPasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+	repo, _, u := newUserFixture(t)
+
+	if err := repo.PasswordPolicy.Upsert(ctx, model.PasswordPolicy{
+		OrganizationId:      testOrganizationId,
+		MinLength:           10,
+		MinCharacterClasses: 3,
+		HistoryCount:        2,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, password := range []string{"Pass0!", "passwordpassword"} {
+		if _, err := u.Create(ctx, &model.User{
+			AccountId:    "bob",
+			Password:     password,
+			Email:        "bob@example.com",
+			Organization: model.Organization{ID: testOrganizationId},
+		}); err == nil {
+			t.Errorf("Create() expected error for password %q", password)
+		}
+	}
+
+	if _, err := u.Create(ctx, &model.User{
+		AccountId:    "alice",
+		Password:     "Password-01",
+		Email:        "alice@example.com",
+		Organization: model.Organization{ID: testOrganizationId},
+	}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	steps := []struct {
+		origin  string
+		next    string
+		wantErr bool
+	}{
+		{origin: "Password-01", next: "Password-02"},
+		{origin: "Password-02", next: "Password-01", wantErr: true},
+		{origin: "Password-02", next: "Password-03"},
+		{origin: "Password-03", next: "Password-01"},
+	}
+	for _, step := range steps {
+		err := u.UpdatePasswordByAccountId(ctx, "alice", step.origin, step.next, testOrganizationId)
+		if (err != nil) != step.wantErr {
+			t.Errorf("UpdatePasswordByAccountId(%s -> %s) error = %v, wantErr %v", step.origin, step.next, err, step.wantErr)
+		}
+	}
+
+	if password := u.GenerateRandomPassword(ctx, testOrganizationId); len(password) < 10 {
+		t.Errorf("GenerateRandomPassword() = %q, want at least 10 characters", password)
+	}
+}
+
+func TestUserEnrollOtpSealsSecret(t *testing.T) {
+	ctx := context.Background()
+	repo, _, u := newUserFixture(t)
+	user := createTestUser(t, u, "alice", testUserRole)
+
+	secret, _, err := u.EnrollOtp(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("EnrollOtp() error = %v", err)
+	}
+	stored, _ := repo.User.GetByUuid(ctx, user.ID)
+	if !repository.IsSealed([]byte(stored.OtpSecret)) {
+		t.Errorf("stored otp secret %q is not sealed", stored.OtpSecret)
+	}
+	opened, err := repo.SecretSealer.Open(ctx, []byte(stored.OtpSecret))
+	if err != nil || string(opened) != secret {
+		t.Errorf("Open() = %s, %v, want %s", opened, err, secret)
+	}
+}
+
+func TestUserOtpReplayAndLock(t *testing.T) {
+	ctx := context.Background()
+	_, _, u := newUserFixture(t)
+	user := createTestUser(t, u, "alice", testUserRole)
+
+	secret, _, err := u.EnrollOtp(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("EnrollOtp() error = %v", err)
+	}
+	now := time.Now()
+	code, _ := helper.TotpCode(secret, now)
+	if err := u.ActivateOtp(ctx, user.ID, code); err != nil {
+		t.Fatalf("ActivateOtp() error = %v", err)
+	}
+
+	wantCode := func(err error, want string) {
+		t.Helper()
+		var restErr httpErrors.IRestError
+		if !errors.As(err, &restErr) || restErr.Code() != want {
+			t.Errorf("DisableOtp() error = %v, want %s", err, want)
+		}
+	}
+	wantCode(u.DisableOtp(ctx, user.ID, code), "A_INVALID_OTP")
+
+	for i := 0; i < 4; i++ {
+		wantCode(u.DisableOtp(ctx, user.ID, "abcdef"), "A_INVALID_OTP")
+	}
+	next, _ := helper.TotpCode(secret, now.Add(30*time.Second))
+	wantCode(u.DisableOtp(ctx, user.ID, next), "A_OTP_LOCKED")
+}
+
+func TestLoginClosesSessionOnInvalidOtp(t *testing.T) {
+	ctx := context.Background()
+	repo, kc, u := newUserFixture(t)
+	user := createTestUser(t, u, "alice", testUserRole)
+	auth := usecase.NewAuthUsecase(repo, kc)
+
+	secret, _, err := u.EnrollOtp(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("EnrollOtp() error = %v", err)
+	}
+	code, _ := helper.TotpCode(secret, time.Now())
+	if err := u.ActivateOtp(ctx, user.ID, code); err != nil {
+		t.Fatalf("ActivateOtp() error = %v", err)
+	}
+
+	if _, err := auth.Login(ctx, "alice", "password", testOrganizationId, "abcdef"); err == nil {
+		t.Fatalf("Login() expected error for invalid otp code")
+	}
+	if n := kc.Sessions(testOrganizationId); n != 0 {
+		t.Errorf("keycloak sessions = %d, want 0 after invalid otp code", n)
+	}
+}
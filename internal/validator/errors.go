@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"encoding/json"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	validator "github.com/go-playground/validator/v10"
+	"github.com/openinfradev/tks-api/pkg/httpErrors"
+)
+
+// Translator 는 Accept-Language 헤더에서 지원하는 첫 번째 언어의 translator 를 반환한다.
+// 예) "en-US,en;q=0.9,ko;q=0.8" 이면 en, 지원하는 언어가 없으면 기본 언어인 ko 를 사용한다.
+func Translator(uni *ut.UniversalTranslator, acceptLanguage string) ut.Translator {
+	var locales []string
+	for _, tag := range strings.Split(acceptLanguage, ",") {
+		tag = strings.TrimSpace(strings.SplitN(tag, ";", 2)[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		locales = append(locales, strings.SplitN(tag, "-", 2)[0])
+	}
+	trans, _ := uni.FindTranslator(locales...)
+	return trans
+}
+
+// FieldErrors 는 validator 의 오류를 요청 본문 기준의 field 경로, 위반한 규칙, 번역된 메시지로 변환한다.
+// field 경로는 json 이름을 사용하며 배열은 index 를 포함한다. 예) "roles[0].name"
+func FieldErrors(uni *ut.UniversalTranslator, trans ut.Translator, valErrs validator.ValidationErrors) []httpErrors.FieldError {
+	out := make([]httpErrors.FieldError, len(valErrs))
+	for i, fe := range valErrs {
+		out[i] = httpErrors.FieldError{
+			Field:   fieldPath(fe.Namespace()),
+			Rule:    fe.Tag(),
+			Param:   fe.Param(),
+			Message: translate(uni, trans, fe),
+		}
+	}
+	return out
+}
+
+// TypeFieldError 는 json 본문의 값을 필드의 타입으로 변환하지 못한 오류를 FieldError 로 변환한다.
+func TypeFieldError(trans ut.Translator, typeErr *json.UnmarshalTypeError) httpErrors.FieldError {
+	message, err := trans.T(RULE_TYPE, typeErr.Field, typeErr.Type.String())
+	if err != nil {
+		message = typeErr.Error()
+	}
+	return httpErrors.FieldError{
+		Field:   typeErr.Field,
+		Rule:    RULE_TYPE,
+		Param:   typeErr.Type.String(),
+		Message: message,
+	}
+}
+
+// translate 는 요청한 언어의 번역이 없는 규칙이면 영어 메시지를 사용한다.
+func translate(uni *ut.UniversalTranslator, trans ut.Translator, fe validator.FieldError) string {
+	if message := fe.Translate(trans); message != fe.Error() {
+		return message
+	}
+	enTrans, _ := uni.GetTranslator("en")
+	return fe.Translate(enTrans)
+}
+
+// fieldPath 는 namespace 의 맨 앞에 있는 요청 구조체의 이름을 제외한다.
+func fieldPath(namespace string) string {
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
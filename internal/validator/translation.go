@@ -0,0 +1,114 @@
+package validator
+
+import (
+	"reflect"
+
+	ut "github.com/go-playground/universal-translator"
+	validator "github.com/go-playground/validator/v10"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// JSON 본문의 값의 형식이 필드의 타입과 다를 때 사용하는 규칙 이름이다.
+const RULE_TYPE = "type"
+
+// 콘솔의 기본 언어인 한국어 메시지이다. 등록되지 않은 규칙은 영어 메시지를 사용한다.
+var koTranslations = map[string]string{
+	"required":         "[{0}] 값이 입력되지 않았습니다.",
+	"required_without": "[{0}] 값 또는 [{1}] 값 중 하나는 입력해야 합니다.",
+	"oneof":            "[{0}] 값은 [{1}] 중 하나여야 합니다.",
+	"email":            "[{0}] 값은 유효한 이메일 주소여야 합니다.",
+	"url":              "[{0}] 값은 유효한 URL 이어야 합니다.",
+	"uuid":             "[{0}] 값은 유효한 UUID 여야 합니다.",
+	"cidrv4":           "[{0}] 값은 유효한 IPv4 CIDR 여야 합니다.",
+	"numeric":          "[{0}] 값은 숫자여야 합니다.",
+	"timezone":         "[{0}] 값은 유효한 timezone 이어야 합니다.",
+	"gtfield":          "[{0}] 값은 [{1}] 값보다 커야 합니다.",
+	"name":             "[{0}] 값은 최대 30자내로 입력하세요.",
+	"version":          "[{0}] 값은 v1.0.0 형식의 버전이어야 합니다.",
+	"rfc1123":          "[{0}] 값은 최대 30자의 RFC 1123 호스트 이름 형식이어야 합니다.",
+	"pascalcase":       "[{0}] 값은 대문자로 시작하는 PascalCase 여야 합니다.",
+	"resourcename":     "[{0}] 값은 소문자, 숫자, '-' 로 구성된 이름이어야 합니다.",
+	"matchnamespace":   "[{0}] 값에 유효하지 않은 namespace 가 있습니다.",
+	"matchkinds":       "[{0}] 값에 유효하지 않은 apiGroup 또는 kind 가 있습니다.",
+	RULE_TYPE:          "[{0}] 값의 형식이 올바르지 않습니다. {1} 값을 입력하세요.",
+}
+
+// 길이나 개수를 검사하는 규칙은 필드의 종류에 따라 메시지가 다르다.
+var koKindTranslations = map[string]map[string]string{
+	"min": {
+		"string": "[{0}] 값은 최소 {1}자 이상 입력하세요.",
+		"items":  "[{0}] 값은 최소 {1}개 이상 입력하세요.",
+		"number": "[{0}] 값은 {1} 이상이어야 합니다.",
+	},
+	"max": {
+		"string": "[{0}] 값은 최대 {1}자까지 입력할 수 있습니다.",
+		"items":  "[{0}] 값은 최대 {1}개까지 입력할 수 있습니다.",
+		"number": "[{0}] 값은 {1} 이하여야 합니다.",
+	},
+	"len": {
+		"string": "[{0}] 값은 {1}자로 입력하세요.",
+		"items":  "[{0}] 값은 {1}개를 입력하세요.",
+		"number": "[{0}] 값은 {1} 이어야 합니다.",
+	},
+}
+
+// 기본 영어 번역에 없는 custom 규칙의 메시지이다.
+var enTranslations = map[string]string{
+	"name":           "{0} must be a maximum of 30 characters in length",
+	"version":        "{0} must be a version in the form of v1.0.0",
+	"rfc1123":        "{0} must be a RFC 1123 hostname of up to 30 characters",
+	"pascalcase":     "{0} must be PascalCase starting with an uppercase letter",
+	"resourcename":   "{0} must consist of lower case alphanumeric characters or '-'",
+	"matchnamespace": "{0} contains an invalid namespace",
+	"matchkinds":     "{0} contains an invalid apiGroup or kind",
+	RULE_TYPE:        "{0} has an invalid type. {1} is expected",
+}
+
+func registerTranslations(v *validator.Validate, trans ut.Translator, texts map[string]string) {
+	for tag, text := range texts {
+		tag, text := tag, text
+		if tag == RULE_TYPE {
+			// validator 의 규칙이 아니므로 translator 에만 등록한다.
+			if err := trans.Add(tag, text, true); err != nil {
+				log.Error(err)
+			}
+			continue
+		}
+		err := v.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+			return ut.Add(tag, text, true)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			t, _ := ut.T(tag, fe.Field(), fe.Param())
+			return t
+		})
+		if err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+func registerKindTranslations(v *validator.Validate, trans ut.Translator, texts map[string]map[string]string) {
+	for tag, kindTexts := range texts {
+		tag, kindTexts := tag, kindTexts
+		err := v.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+			for kind, text := range kindTexts {
+				if err := ut.Add(tag+"-"+kind, text, true); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			kind := "number"
+			switch fe.Kind() {
+			case reflect.String:
+				kind = "string"
+			case reflect.Slice, reflect.Map, reflect.Array:
+				kind = "items"
+			}
+			t, _ := ut.T(tag+"-"+kind, fe.Field(), fe.Param())
+			return t
+		})
+		if err != nil {
+			log.Error(err)
+		}
+	}
+}
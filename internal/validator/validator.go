@@ -1,11 +1,13 @@
 package validator
 
 import (
+	"reflect"
 	"regexp"
 	"strings"
 	"unicode/utf8"
 
 	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/ko"
 	ut "github.com/go-playground/universal-translator"
 	validator "github.com/go-playground/validator/v10"
 	en_translations "github.com/go-playground/validator/v10/translations/en"
@@ -22,13 +24,22 @@ const (
 	REGEX_RFC1123_SUBDOMAIN = `^` + REGEX_RFC1123_DNS_LABEL + `(\.` + REGEX_RFC1123_DNS_LABEL + `)*$`
 )
 
+// NewValidator 는 한국어(ko)와 영어(en) translator 를 등록한다. 지원하지 않는 언어는 한국어를 사용한다.
+// 오류의 field 이름은 요청 본문과 같도록 json tag 의 이름을 사용한다.
 func NewValidator() (*validator.Validate, *ut.UniversalTranslator) {
-	en := en.New()
-	uni := ut.New(en, en)
-	trans, _ := uni.GetTranslator("en")
+	uni := ut.New(ko.New(), ko.New(), en.New())
+	koTrans, _ := uni.GetTranslator("ko")
+	enTrans, _ := uni.GetTranslator("en")
 
 	v := validator.New()
-	err := en_translations.RegisterDefaultTranslations(v, trans)
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	err := en_translations.RegisterDefaultTranslations(v, enTrans)
 	if err != nil {
 		log.Error(err)
 	}
@@ -43,19 +54,9 @@ func NewValidator() (*validator.Validate, *ut.UniversalTranslator) {
 	_ = v.RegisterValidation("matchkinds", validateMatchKinds)
 
 	// register custom error
-	_ = v.RegisterTranslation("required", trans, func(ut ut.Translator) error {
-		return ut.Add("required", "[{0}] 값이 입력되지 않았습니다.", true)
-	}, func(ut ut.Translator, fe validator.FieldError) string {
-		t, _ := ut.T("required", fe.Field())
-		return t
-	})
-
-	_ = v.RegisterTranslation("name", trans, func(ut ut.Translator) error {
-		return ut.Add("name", "[{0}] 값은 최대 30자내로 입력하세요.", true)
-	}, func(ut ut.Translator, fe validator.FieldError) string {
-		t, _ := ut.T("name", fe.Field())
-		return t
-	})
+	registerTranslations(v, enTrans, enTranslations)
+	registerTranslations(v, koTrans, koTranslations)
+	registerKindTranslations(v, koTrans, koKindTranslations)
 
 	return v, uni
 }
@@ -0,0 +1,257 @@
+package alertchannel
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/pkg/log"
+	"gopkg.in/gomail.v2"
+)
+
+const (
+	Type_SMTP    = "SMTP"
+	Type_SLACK   = "SLACK"
+	Type_WEBHOOK = "WEBHOOK"
+)
+
+const (
+	SignatureHeader = "X-TKS-Signature"
+	sendTimeout     = 10 * time.Second
+)
+
+// Message 는 채널로 보내는 앨럿이다. webhook 은 Message 를 JSON 으로 직렬화하여 보낸다.
+type Message struct {
+	OrganizationId string    `json:"organizationId"`
+	ClusterId      string    `json:"clusterId"`
+	AlertName      string    `json:"alertName"`
+	Severity       string    `json:"severity"`
+	Title          string    `json:"title"`
+	Content        string    `json:"content"`
+	Summary        string    `json:"summary,omitempty"`
+	Status         string    `json:"status,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// Sender 는 채널 하나로 앨럿을 보낸다.
+type Sender interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// Config 의 항목은 Type 에 따라 다르게 사용한다.
+//   - SMTP: SmtpHost 의 메일 서버로 Recipients 에게 보낸다.
+//   - SLACK: Url 의 Slack incoming webhook 으로 보낸다. Url 이 내부망 주소이면 연결하지 않는다.
+//   - WEBHOOK: Url 로 POST 한다. Secret 을 지정하면 body 의 HMAC-SHA256 서명을 X-TKS-Signature 헤더로 보낸다.
+type Config struct {
+	Type         string
+	Url          string
+	Secret       string
+	Recipients   []string
+	SmtpHost     string
+	SmtpPort     int
+	SmtpUsername string
+	SmtpPassword string
+	SmtpFrom     string
+}
+
+func New(cfg Config) (Sender, error) {
+	switch cfg.Type {
+	case Type_SMTP:
+		if err := ValidateRecipients(cfg.Recipients); err != nil {
+			return nil, err
+		}
+		if cfg.SmtpHost == "" || cfg.SmtpPort <= 0 {
+			return nil, fmt.Errorf("smtp host and port are required")
+		}
+		if _, err := mail.ParseAddress(cfg.SmtpFrom); err != nil {
+			return nil, fmt.Errorf("invalid smtp from address %s", cfg.SmtpFrom)
+		}
+		return &smtpSender{
+			dialer:     gomail.NewDialer(cfg.SmtpHost, cfg.SmtpPort, cfg.SmtpUsername, cfg.SmtpPassword),
+			from:       cfg.SmtpFrom,
+			recipients: cfg.Recipients,
+		}, nil
+	case Type_SLACK:
+		if err := validateUrl(cfg.Url); err != nil {
+			return nil, err
+		}
+		return &slackSender{client: helper.NewOutboundHttpClient(sendTimeout, nil), url: cfg.Url}, nil
+	case Type_WEBHOOK:
+		if err := validateUrl(cfg.Url); err != nil {
+			return nil, err
+		}
+		return &webhookSender{client: helper.NewOutboundHttpClient(sendTimeout, nil), url: cfg.Url, secret: cfg.Secret}, nil
+	default:
+		return nil, fmt.Errorf("invalid channel type %s", cfg.Type)
+	}
+}
+
+func ValidateRecipients(recipients []string) error {
+	if len(recipients) == 0 {
+		return fmt.Errorf("recipients are required")
+	}
+	for _, recipient := range recipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return fmt.Errorf("invalid recipient %s", recipient)
+		}
+	}
+	return nil
+}
+
+func validateUrl(strUrl string) error {
+	u, err := url.Parse(strUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url %s", strUrl)
+	}
+	return nil
+}
+
+// Subject 는 메일 제목과 Slack 메시지의 제목으로 사용한다.
+func (m Message) Subject() string {
+	return fmt.Sprintf("[TKS] [%s] %s", strings.ToUpper(m.Severity), m.Title)
+}
+
+type smtpSender struct {
+	dialer     *gomail.Dialer
+	from       string
+	recipients []string
+}
+
+func (s *smtpSender) Send(ctx context.Context, message Message) error {
+	m := gomail.NewMessage()
+	m.SetHeader("From", s.from)
+	m.SetHeader("To", s.recipients...)
+	m.SetHeader("Subject", message.Subject())
+	m.SetBody("text/html", htmlBody(message))
+	return s.dialer.DialAndSend(m)
+}
+
+func htmlBody(message Message) string {
+	var b strings.Builder
+	b.WriteString("<h3>" + html.EscapeString(message.Title) + "</h3>")
+	b.WriteString("<p>" + html.EscapeString(message.Content) + "</p>")
+	b.WriteString("<ul>")
+	for _, field := range messageFields(message) {
+		b.WriteString(fmt.Sprintf("<li>%s: %s</li>", field[0], html.EscapeString(field[1])))
+	}
+	b.WriteString("</ul>")
+	return b.String()
+}
+
+type slackSender struct {
+	client *http.Client
+	url    string
+}
+
+func (s *slackSender) Send(ctx context.Context, message Message) error {
+	fields := []map[string]interface{}{}
+	for _, field := range messageFields(message) {
+		fields = append(fields, map[string]interface{}{"title": field[0], "value": field[1], "short": true})
+	}
+	attachment := map[string]interface{}{
+		"color":  slackColor(message.Severity),
+		"title":  message.Title,
+		"text":   message.Content,
+		"fields": fields,
+	}
+	if !message.CreatedAt.IsZero() {
+		attachment["ts"] = message.CreatedAt.Unix()
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"text":        message.Subject(),
+		"attachments": []map[string]interface{}{attachment},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return doRequest(ctx, s.client, req)
+}
+
+func slackColor(severity string) string {
+	switch strings.ToLower(severity) {
+	case "critical":
+		return "danger"
+	case "warning":
+		return "warning"
+	}
+	return "good"
+}
+
+type webhookSender struct {
+	client *http.Client
+	url    string
+	secret string
+}
+
+func (s *webhookSender) Send(ctx context.Context, message Message) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.secret != "" {
+		mac := hmac.New(sha256.New, []byte(s.secret))
+		mac.Write(payload)
+		req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return doRequest(ctx, s.client, req)
+}
+
+func messageFields(message Message) [][2]string {
+	fields := [][2]string{
+		{"Organization", message.OrganizationId},
+		{"Cluster", message.ClusterId},
+		{"Alert", message.AlertName},
+		{"Severity", message.Severity},
+	}
+	if message.Summary != "" {
+		fields = append(fields, [2]string{"Summary", message.Summary})
+	}
+	if !message.CreatedAt.IsZero() {
+		fields = append(fields, [2]string{"Time", message.CreatedAt.Format(time.RFC3339)})
+	}
+	return fields
+}
+
+func doRequest(ctx context.Context, client *http.Client, req *http.Request) error {
+	// Slack 의 webhook URL 은 token 을 포함하므로 오류에는 host 만 남긴다.
+	res, err := client.Do(req)
+	if err != nil {
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("failed to send alert to %s. err : %s", req.URL.Host, err)
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Error(ctx, "error closing http body")
+		}
+	}()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("failed to send alert to %s. return code: %d, body: %s", req.URL.Host, res.StatusCode, string(resBody))
+	}
+	return nil
+}
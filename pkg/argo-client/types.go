@@ -1,5 +1,9 @@
 package argowf
 
+import (
+	"time"
+)
+
 // GetWorkflowTemplatesResponse is a response from GET /api/v1/workflow-templates API.
 type GetWorkflowTemplatesResponse struct {
 	Items []WorkflowTemplate `json:"items"`
@@ -77,7 +81,23 @@ type WorkflowArguments struct {
 }
 
 type WorkflowStatus struct {
-	Phase    string `json:"phase"`
-	Progress string `json:"progress"`
-	Message  string `json:"message"`
+	Phase      string                  `json:"phase"`
+	Progress   string                  `json:"progress"`
+	Message    string                  `json:"message"`
+	StartedAt  *time.Time              `json:"startedAt"`
+	FinishedAt *time.Time              `json:"finishedAt"`
+	Nodes      map[string]WorkflowNode `json:"nodes"`
+}
+
+// WorkflowNode 는 workflow 를 구성하는 step 이다. 실제 작업을 수행하는 step 의 Type 은 Pod 이다.
+type WorkflowNode struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	DisplayName  string     `json:"displayName"`
+	Type         string     `json:"type"`
+	TemplateName string     `json:"templateName"`
+	Phase        string     `json:"phase"`
+	Message      string     `json:"message"`
+	StartedAt    *time.Time `json:"startedAt"`
+	FinishedAt   *time.Time `json:"finishedAt"`
 }
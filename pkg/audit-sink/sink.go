@@ -0,0 +1,174 @@
+package auditsink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/internal/helper"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const (
+	Type_WEBHOOK = "webhook"
+	Type_SYSLOG  = "syslog"
+	Type_KAFKA   = "kafka"
+)
+
+const (
+	SignatureHeader = "X-TKS-Signature"
+	sendTimeout     = 10 * time.Second
+
+	// syslog facility local0, severity informational
+	syslogPriority = 16*8 + 6
+	syslogAppName  = "tks-api"
+	syslogMsgId    = "audit"
+)
+
+// Sink 는 감사 로그 하나를 외부 시스템(SIEM 등)으로 전송한다. payload 는 JSON 으로 직렬화된 감사 로그이다.
+type Sink interface {
+	Send(ctx context.Context, payload []byte) error
+}
+
+// Config 의 Endpoint 형식은 Type 에 따라 다르다. 내부망 주소로는 연결하지 않는다.
+//   - webhook: 감사 로그를 POST 할 URL. Secret 을 지정하면 payload 의 HMAC-SHA256 서명을 X-TKS-Signature 헤더로 보낸다.
+//   - syslog: udp://host:514, tcp://host:601 형식의 syslog 서버 주소. RFC 5424 형식으로 보낸다.
+//   - kafka: Kafka REST Proxy 의 주소. Topic 에 v2 API 로 record 를 생성한다.
+type Config struct {
+	Type     string
+	Endpoint string
+	Topic    string
+	Secret   string
+}
+
+func New(cfg Config) (Sink, error) {
+	switch cfg.Type {
+	case Type_WEBHOOK:
+		u, err := url.Parse(cfg.Endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid webhook url %s", cfg.Endpoint)
+		}
+		return &webhookSink{client: helper.NewOutboundHttpClient(sendTimeout, nil), url: cfg.Endpoint, secret: cfg.Secret}, nil
+	case Type_SYSLOG:
+		u, err := url.Parse(cfg.Endpoint)
+		if err != nil || (u.Scheme != "udp" && u.Scheme != "tcp") || u.Host == "" {
+			return nil, fmt.Errorf("invalid syslog address %s", cfg.Endpoint)
+		}
+		hostname, _ := os.Hostname()
+		return &syslogSink{network: u.Scheme, address: u.Host, hostname: hostname}, nil
+	case Type_KAFKA:
+		u, err := url.Parse(cfg.Endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid kafka rest proxy url %s", cfg.Endpoint)
+		}
+		if cfg.Topic == "" {
+			return nil, fmt.Errorf("topic is required")
+		}
+		return &kafkaSink{
+			client: helper.NewOutboundHttpClient(sendTimeout, nil),
+			url:    strings.TrimSuffix(cfg.Endpoint, "/") + "/topics/" + url.PathEscape(cfg.Topic),
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid sink type %s", cfg.Type)
+	}
+}
+
+type webhookSink struct {
+	client *http.Client
+	url    string
+	secret string
+}
+
+func (s *webhookSink) Send(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.secret != "" {
+		mac := hmac.New(sha256.New, []byte(s.secret))
+		mac.Write(payload)
+		req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return doRequest(ctx, s.client, req)
+}
+
+type syslogSink struct {
+	network  string
+	address  string
+	hostname string
+}
+
+// Send 는 전송할 때마다 연결한다. TCP 는 RFC 6587 의 octet counting 으로 message 를 구분한다.
+func (s *syslogSink) Send(ctx context.Context, payload []byte) error {
+	dialer := helper.OutboundDialer(sendTimeout)
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Error(ctx, "error closing syslog connection")
+		}
+	}()
+	if err := conn.SetDeadline(time.Now().Add(sendTimeout)); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("<%d>1 %s %s %s %d %s - %s", syslogPriority, time.Now().UTC().Format(time.RFC3339Nano),
+		s.hostname, syslogAppName, os.Getpid(), syslogMsgId, payload)
+	if s.network == "tcp" {
+		message = fmt.Sprintf("%d %s", len(message), message)
+	}
+	_, err = conn.Write([]byte(message))
+	return err
+}
+
+type kafkaSink struct {
+	client *http.Client
+	url    string
+}
+
+func (s *kafkaSink) Send(ctx context.Context, payload []byte) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"records": []map[string]json.RawMessage{{"value": payload}},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	return doRequest(ctx, s.client, req)
+}
+
+func doRequest(ctx context.Context, client *http.Client, req *http.Request) error {
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Error(ctx, "error closing http body")
+		}
+	}()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("failed to send audit to %s. return code: %d, body: %s", req.URL.Redacted(), res.StatusCode, string(resBody))
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package health
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const (
+	// AWS Health API 는 global endpoint 로 us-east-1 만 제공한다.
+	endpoint      = "https://health.us-east-1.amazonaws.com/"
+	signingRegion = "us-east-1"
+	signingName   = "health"
+	targetPrefix  = "AWSHealth_20160804."
+)
+
+type HealthClient interface {
+	DescribeEvents(ctx context.Context, filter EventFilter) ([]Event, error)
+	DescribeAffectedEntities(ctx context.Context, eventArn string) ([]AffectedEntity, error)
+	DescribeEventDescription(ctx context.Context, eventArn string) (string, error)
+}
+
+type HealthClientImpl struct {
+	client      *http.Client
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+}
+
+// New function
+func New(cfg aws.Config) HealthClient {
+	return &HealthClientImpl{
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		credentials: cfg.Credentials,
+		signer:      v4.NewSigner(),
+	}
+}
+
+func (c *HealthClientImpl) DescribeEvents(ctx context.Context, filter EventFilter) (out []Event, err error) {
+	input := describeEventsRequest{Filter: filter, MaxResults: 100}
+	for {
+		res := describeEventsResponse{}
+		if err = c.call(ctx, "DescribeEvents", input, &res); err != nil {
+			return nil, err
+		}
+		out = append(out, res.Events...)
+		if res.NextToken == "" {
+			return out, nil
+		}
+		input.NextToken = res.NextToken
+	}
+}
+
+func (c *HealthClientImpl) DescribeAffectedEntities(ctx context.Context, eventArn string) (out []AffectedEntity, err error) {
+	input := describeAffectedEntitiesRequest{}
+	input.Filter.EventArns = []string{eventArn}
+	for {
+		res := describeAffectedEntitiesResponse{}
+		if err = c.call(ctx, "DescribeAffectedEntities", input, &res); err != nil {
+			return nil, err
+		}
+		out = append(out, res.Entities...)
+		if res.NextToken == "" {
+			return out, nil
+		}
+		input.NextToken = res.NextToken
+	}
+}
+
+func (c *HealthClientImpl) DescribeEventDescription(ctx context.Context, eventArn string) (string, error) {
+	res := describeEventDetailsResponse{}
+	if err := c.call(ctx, "DescribeEventDetails", describeEventDetailsRequest{EventArns: []string{eventArn}}, &res); err != nil {
+		return "", err
+	}
+	if len(res.SuccessfulSet) == 0 {
+		return "", nil
+	}
+	return res.SuccessfulSet[0].EventDescription.LatestDescription, nil
+}
+
+// call sends a sigv4 signed JSON 1.1 request to the health api
+func (c *HealthClientImpl) call(ctx context.Context, operation string, input interface{}, output interface{}) error {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", targetPrefix+operation)
+
+	creds, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return err
+	}
+	payloadHash := sha256.Sum256(body)
+	if err = c.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(payloadHash[:]), signingName, signingRegion, time.Now()); err != nil {
+		return err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Error(ctx, "error closing http body")
+		}
+	}()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to call %s. return code: %d, body: %s", operation, res.StatusCode, string(resBody))
+	}
+
+	return json.Unmarshal(resBody, output)
+}
@@ -0,0 +1,70 @@
+package health
+
+// AWS Health API(JSON 1.1) 의 request/response 중 TKS 에서 사용하는 필드만 정의한다.
+// https://docs.aws.amazon.com/health/latest/APIReference/Welcome.html
+
+type DateTimeRange struct {
+	From float64 `json:"from,omitempty"`
+	To   float64 `json:"to,omitempty"`
+}
+
+type EventFilter struct {
+	EventStatusCodes []string        `json:"eventStatusCodes,omitempty"`
+	LastUpdatedTimes []DateTimeRange `json:"lastUpdatedTimes,omitempty"`
+}
+
+type Event struct {
+	Arn               string  `json:"arn"`
+	Service           string  `json:"service"`
+	EventTypeCode     string  `json:"eventTypeCode"`
+	EventTypeCategory string  `json:"eventTypeCategory"`
+	Region            string  `json:"region"`
+	StatusCode        string  `json:"statusCode"`
+	StartTime         float64 `json:"startTime"`
+	EndTime           float64 `json:"endTime"`
+	LastUpdatedTime   float64 `json:"lastUpdatedTime"`
+}
+
+type AffectedEntity struct {
+	EntityArn    string `json:"entityArn"`
+	EntityValue  string `json:"entityValue"`
+	EventArn     string `json:"eventArn"`
+	AwsAccountId string `json:"awsAccountId"`
+	StatusCode   string `json:"statusCode"`
+}
+
+type describeEventsRequest struct {
+	Filter     EventFilter `json:"filter"`
+	MaxResults int         `json:"maxResults,omitempty"`
+	NextToken  string      `json:"nextToken,omitempty"`
+}
+
+type describeEventsResponse struct {
+	Events    []Event `json:"events"`
+	NextToken string  `json:"nextToken"`
+}
+
+type describeAffectedEntitiesRequest struct {
+	Filter struct {
+		EventArns []string `json:"eventArns"`
+	} `json:"filter"`
+	NextToken string `json:"nextToken,omitempty"`
+}
+
+type describeAffectedEntitiesResponse struct {
+	Entities  []AffectedEntity `json:"entities"`
+	NextToken string           `json:"nextToken"`
+}
+
+type describeEventDetailsRequest struct {
+	EventArns []string `json:"eventArns"`
+}
+
+type describeEventDetailsResponse struct {
+	SuccessfulSet []struct {
+		Event            Event `json:"event"`
+		EventDescription struct {
+			LatestDescription string `json:"latestDescription"`
+		} `json:"eventDescription"`
+	} `json:"successfulSet"`
+}
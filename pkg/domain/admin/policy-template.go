@@ -42,7 +42,7 @@ type SimplePolicyTemplateResponse struct {
 }
 
 type CreatePolicyTemplateRequest struct {
-	TemplateName     string                 `json:"templateName" validate:"required,name" example:"필수 Label 검사"`
+	TemplateName     string                 `json:"templateName" validate:"required,name" example:"필수 Label 검사" audit:"name"`
 	Kind             string                 `json:"kind" example:"K8sRequiredLabels" validate:"required,pascalcase"`
 	Severity         string                 `json:"severity" validate:"required,oneof=low medium high" enums:"low,medium,high" example:"medium"`
 	Deprecated       bool                   `json:"deprecated" example:"false"`
@@ -65,7 +65,7 @@ type CreateOrganizationPolicyTemplateReponse struct {
 }
 
 type UpdatePolicyTemplateRequest struct {
-	TemplateName             *string   `json:"templateName,omitempty" validate:"required,name" example:"필수 Label 검사"`
+	TemplateName             *string   `json:"templateName,omitempty" validate:"required,name" example:"필수 Label 검사" audit:"name"`
 	Description              *string   `json:"description,omitempty"`
 	Severity                 *string   `json:"severity,omitempty" validate:"omitempty,oneof=low medium high" enums:"low,medium,high" example:"medium"`
 	Deprecated               *bool     `json:"deprecated,omitempty" example:"false"`
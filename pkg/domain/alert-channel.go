@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"time"
+)
+
+// 마지막 전송 결과
+const (
+	AlertChannelStatus_SUCCEEDED = "SUCCEEDED"
+	AlertChannelStatus_FAILED    = "FAILED"
+)
+
+type AlertChannelResponse struct {
+	ID                     string             `json:"id"`
+	Name                   string             `json:"name"`
+	Type                   string             `json:"type"`
+	Enabled                bool               `json:"enabled"`
+	Url                    string             `json:"url,omitempty"`
+	SecretConfigured       bool               `json:"secretConfigured"`
+	Recipients             []string           `json:"recipients,omitempty"`
+	SmtpHost               string             `json:"smtpHost,omitempty"`
+	SmtpPort               int                `json:"smtpPort,omitempty"`
+	SmtpUsername           string             `json:"smtpUsername,omitempty"`
+	SmtpPasswordConfigured bool               `json:"smtpPasswordConfigured"`
+	SmtpFrom               string             `json:"smtpFrom,omitempty"`
+	LastStatus             string             `json:"lastStatus"`
+	LastError              string             `json:"lastError,omitempty"`
+	LastSentAt             *time.Time         `json:"lastSentAt"`
+	Creator                SimpleUserResponse `json:"creator"`
+	CreatedAt              time.Time          `json:"createdAt"`
+	UpdatedAt              time.Time          `json:"updatedAt"`
+}
+
+type SimpleAlertChannelResponse struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+}
+
+// CreateAlertChannelRequest 의 항목은 type 에 따라 다르게 사용한다.
+//   - SMTP: recipients 에게 메일을 보낸다. smtpHost 를 지정하지 않으면 시스템 메일 서버로 보낸다.
+//   - SLACK: url 은 Slack incoming webhook URL 이다.
+//   - WEBHOOK: url 로 앨럿을 POST 한다. secret 을 지정하면 body 의 HMAC-SHA256 서명을 X-TKS-Signature 헤더로 보낸다.
+type CreateAlertChannelRequest struct {
+	Name         string   `json:"name" validate:"required,name"`
+	Type         string   `json:"type" validate:"required,oneof=SMTP SLACK WEBHOOK" enums:"SMTP,SLACK,WEBHOOK"`
+	Enabled      bool     `json:"enabled"`
+	Url          string   `json:"url" validate:"omitempty,url"`
+	Secret       string   `json:"secret"`
+	Recipients   []string `json:"recipients" validate:"dive,email"`
+	SmtpHost     string   `json:"smtpHost"`
+	SmtpPort     int      `json:"smtpPort" validate:"min=0,max=65535"`
+	SmtpUsername string   `json:"smtpUsername"`
+	SmtpPassword string   `json:"smtpPassword"`
+	SmtpFrom     string   `json:"smtpFrom" validate:"omitempty,email"`
+}
+
+type CreateAlertChannelResponse struct {
+	ID string `json:"id"`
+}
+
+// UpdateAlertChannelRequest 는 type 을 변경하지 않는다. secret, smtpPassword 가 비어 있으면 기존 값을 유지한다.
+type UpdateAlertChannelRequest struct {
+	Name         string   `json:"name" validate:"required,name"`
+	Enabled      bool     `json:"enabled"`
+	Url          string   `json:"url" validate:"omitempty,url"`
+	Secret       string   `json:"secret"`
+	Recipients   []string `json:"recipients" validate:"dive,email"`
+	SmtpHost     string   `json:"smtpHost"`
+	SmtpPort     int      `json:"smtpPort" validate:"min=0,max=65535"`
+	SmtpUsername string   `json:"smtpUsername"`
+	SmtpPassword string   `json:"smtpPassword"`
+	SmtpFrom     string   `json:"smtpFrom" validate:"omitempty,email"`
+}
+
+type GetAlertChannelsResponse struct {
+	AlertChannels []AlertChannelResponse `json:"alertChannels"`
+	Pagination    PaginationResponse     `json:"pagination"`
+}
+
+type GetAlertChannelResponse struct {
+	AlertChannel AlertChannelResponse `json:"alertChannel"`
+}
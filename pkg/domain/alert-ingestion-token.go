@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+)
+
+type AlertIngestionTokenResponse struct {
+	ID             string             `json:"id"`
+	OrganizationId string             `json:"organizationId"`
+	Name           string             `json:"name"`
+	TokenPrefix    string             `json:"tokenPrefix"`
+	Creator        SimpleUserResponse `json:"creator"`
+	LastUsedAt     *time.Time         `json:"lastUsedAt"`
+	RotatedAt      *time.Time         `json:"rotatedAt"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+type CreateAlertIngestionTokenRequest struct {
+	Name string `json:"name" validate:"required,name"`
+}
+
+// CreateAlertIngestionTokenResponse 의 token 은 생성 시점에만 조회할 수 있다.
+type CreateAlertIngestionTokenResponse struct {
+	ID    string `json:"id"`
+	Token string `json:"token"`
+}
+
+type RotateAlertIngestionTokenResponse struct {
+	ID    string `json:"id"`
+	Token string `json:"token"`
+}
+
+type GetAlertIngestionTokensResponse struct {
+	Tokens     []AlertIngestionTokenResponse `json:"tokens"`
+	Pagination PaginationResponse            `json:"pagination"`
+}
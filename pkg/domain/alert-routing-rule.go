@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"time"
+)
+
+// AlertRoutingMatch 의 비어 있는 조건은 모든 값과 일치한다.
+// clusterIds 는 앨럿이 발생한 클러스터가 목록에 있어야 한다.
+// clusterTags 는 모든 key 의 값이 같아야 하며 값이 "*" 이면 key 만 확인한다. namespaces, alertNames 는 glob pattern(*, ?)을 사용할 수 있다.
+type AlertRoutingMatch struct {
+	ClusterIds  []string          `json:"clusterIds,omitempty"`
+	ClusterTags map[string]string `json:"clusterTags,omitempty"`
+	Namespaces  []string          `json:"namespaces,omitempty"`
+	Severities  []string          `json:"severities,omitempty"`
+	AlertNames  []string          `json:"alertNames,omitempty"`
+}
+
+type AlertRoutingRuleResponse struct {
+	ID                 string                       `json:"id"`
+	OrganizationId     string                       `json:"organizationId"`
+	Name               string                       `json:"name"`
+	Description        string                       `json:"description"`
+	Priority           int                          `json:"priority"`
+	Enabled            bool                         `json:"enabled"`
+	Continue           bool                         `json:"continue"`
+	Match              AlertRoutingMatch            `json:"match"`
+	Channels           []NotificationChannel        `json:"channels"`
+	AlertChannels      []SimpleAlertChannelResponse `json:"alertChannels"`
+	EscalationPolicyId string                       `json:"escalationPolicyId,omitempty"`
+	TargetUsers        []SimpleUserResponse         `json:"targetUsers"`
+	Creator            SimpleUserResponse           `json:"creator"`
+	Updator            SimpleUserResponse           `json:"updator"`
+	CreatedAt          time.Time                    `json:"createdAt"`
+	UpdatedAt          time.Time                    `json:"updatedAt"`
+}
+
+type SimpleAlertRoutingRuleResponse struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Priority int    `json:"priority"`
+}
+
+// CreateAlertRoutingRuleRequest 의 priority 가 작은 규칙부터 확인하며, continue 가 false 인 규칙과 일치하면 이후 규칙은 확인하지 않는다.
+type CreateAlertRoutingRuleRequest struct {
+	Name               string                `json:"name" validate:"required,name"`
+	Description        string                `json:"description"`
+	Priority           int                   `json:"priority" validate:"min=0"`
+	Enabled            bool                  `json:"enabled"`
+	Continue           bool                  `json:"continue"`
+	Match              AlertRoutingMatch     `json:"match"`
+	Channels           []NotificationChannel `json:"channels" validate:"required_without=AlertChannelIds,dive,oneof=EMAIL"`
+	AlertChannelIds    []string              `json:"alertChannelIds" validate:"required_without=Channels,dive,uuid"`
+	EscalationPolicyId string                `json:"escalationPolicyId" validate:"omitempty,uuid"`
+	TargetUserIds      []string              `json:"targetUserIds" validate:"dive,uuid"`
+}
+
+type CreateAlertRoutingRuleResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateAlertRoutingRuleRequest struct {
+	Name               string                `json:"name" validate:"required,name"`
+	Description        string                `json:"description"`
+	Priority           int                   `json:"priority" validate:"min=0"`
+	Enabled            bool                  `json:"enabled"`
+	Continue           bool                  `json:"continue"`
+	Match              AlertRoutingMatch     `json:"match"`
+	Channels           []NotificationChannel `json:"channels" validate:"required_without=AlertChannelIds,dive,oneof=EMAIL"`
+	AlertChannelIds    []string              `json:"alertChannelIds" validate:"required_without=Channels,dive,uuid"`
+	EscalationPolicyId string                `json:"escalationPolicyId" validate:"omitempty,uuid"`
+	TargetUserIds      []string              `json:"targetUserIds" validate:"dive,uuid"`
+}
+
+type GetAlertRoutingRulesResponse struct {
+	AlertRoutingRules []AlertRoutingRuleResponse `json:"alertRoutingRules"`
+	Pagination        PaginationResponse         `json:"pagination"`
+}
+
+type GetAlertRoutingRuleResponse struct {
+	AlertRoutingRule AlertRoutingRuleResponse `json:"alertRoutingRule"`
+}
+
+// TestAlertRoutingRulesRequest 는 규칙을 확인할 예시 앨럿이다. clusterId 를 지정하면 clusterTags 에 클러스터의 tag 가 더해진다.
+type TestAlertRoutingRulesRequest struct {
+	ClusterId   string            `json:"clusterId"`
+	ClusterTags map[string]string `json:"clusterTags"`
+	Namespace   string            `json:"namespace"`
+	Severity    string            `json:"severity" validate:"required"`
+	AlertName   string            `json:"alertName" validate:"required"`
+}
+
+type TestAlertRoutingRulesResponse struct {
+	MatchedRules        []SimpleAlertRoutingRuleResponse `json:"matchedRules"`
+	Channels            []NotificationChannel            `json:"channels"`
+	AlertChannels       []SimpleAlertChannelResponse     `json:"alertChannels"`
+	EscalationPolicyIds []string                         `json:"escalationPolicyIds"`
+}
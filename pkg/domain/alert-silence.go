@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	AlertSilenceState_PENDING = "PENDING"
+	AlertSilenceState_ACTIVE  = "ACTIVE"
+	AlertSilenceState_EXPIRED = "EXPIRED"
+)
+
+// AlertSilenceMatch 의 비어 있는 조건은 모든 값과 일치하지만, 조직의 모든 앨럿이 묵음 처리되지 않도록 조건을 하나 이상 지정해야 한다.
+// namespaces, alertNames 는 glob pattern(*, ?)을 사용할 수 있다.
+type AlertSilenceMatch struct {
+	ClusterIds []string `json:"clusterIds,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+	Severities []string `json:"severities,omitempty"`
+	AlertNames []string `json:"alertNames,omitempty"`
+}
+
+func (m AlertSilenceMatch) IsEmpty() bool {
+	return len(m.ClusterIds) == 0 && len(m.Namespaces) == 0 && len(m.Severities) == 0 && len(m.AlertNames) == 0
+}
+
+type AlertSilenceResponse struct {
+	ID             string             `json:"id"`
+	OrganizationId string             `json:"organizationId"`
+	Name           string             `json:"name"`
+	Description    string             `json:"description"`
+	Match          AlertSilenceMatch  `json:"match"`
+	StartAt        time.Time          `json:"startAt"`
+	EndAt          time.Time          `json:"endAt"`
+	State          string             `json:"state"`
+	Creator        SimpleUserResponse `json:"creator"`
+	Updator        SimpleUserResponse `json:"updator"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+type GetAlertSilencesResponse struct {
+	AlertSilences []AlertSilenceResponse `json:"alertSilences"`
+	Pagination    PaginationResponse     `json:"pagination"`
+}
+
+type GetAlertSilenceResponse struct {
+	AlertSilence AlertSilenceResponse `json:"alertSilence"`
+}
+
+// CreateAlertSilenceRequest 의 기간 동안 조건과 일치하는 앨럿은 SILENCED 상태로 기록만 하고 알림을 보내지 않는다.
+type CreateAlertSilenceRequest struct {
+	Name        string            `json:"name" validate:"required,name"`
+	Description string            `json:"description"`
+	Match       AlertSilenceMatch `json:"match"`
+	StartAt     time.Time         `json:"startAt" validate:"required"`
+	EndAt       time.Time         `json:"endAt" validate:"required,gtfield=StartAt"`
+}
+
+type CreateAlertSilenceResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateAlertSilenceRequest struct {
+	Name        string            `json:"name" validate:"required,name"`
+	Description string            `json:"description"`
+	Match       AlertSilenceMatch `json:"match"`
+	StartAt     time.Time         `json:"startAt" validate:"required"`
+	EndAt       time.Time         `json:"endAt" validate:"required,gtfield=StartAt"`
+}
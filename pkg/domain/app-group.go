@@ -147,7 +147,12 @@ type CreateAppGroupRequest struct {
 }
 
 type CreateAppGroupResponse struct {
-	ID string `json:"id"`
+	ID     string `json:"id"`
+	TaskId string `json:"taskId"`
+}
+
+type DeleteAppGroupResponse struct {
+	TaskId string `json:"taskId"`
 }
 
 type CreateApplicationRequest struct {
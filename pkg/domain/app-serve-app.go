@@ -45,10 +45,25 @@ type AppServeAppTaskResponse struct {
 	PvAccessMode      string     `json:"pvAccessMode"`
 	PvSize            string     `json:"pvSize"`
 	PvMountPath       string     `json:"pvMountPath"`
+	ConfigRevision    int        `json:"configRevision"`          // revision of app config deployed with this task. 0 means no config
+	SourceRepoUrl     string     `json:"sourceRepoUrl,omitempty"` // git repository the app is built from
+	SourceBranch      string     `json:"sourceBranch,omitempty"`
+	SourceCommit      string     `json:"sourceCommit,omitempty"`
 	AvailableRollback bool       `json:"availableRollback"`
 	CreatedAt         time.Time  `json:"createdAt"` // createdAt is  a creation timestamp for the application
 	UpdatedAt         *time.Time `json:"updatedAt"`
 	DeletedAt         *time.Time `json:"deletedAt"`
+
+	VerificationBakePeriod int        `json:"verificationBakePeriod"`
+	AutoRollback           bool       `json:"autoRollback"`
+	VerificationStatus     string     `json:"verificationStatus,omitempty"` // PENDING, VERIFIED, SUSPECT, UNKNOWN
+	VerificationDueAt      *time.Time `json:"verificationDueAt,omitempty"`
+	VerifiedAt             *time.Time `json:"verifiedAt,omitempty"`
+	BaselineErrorRate      float64    `json:"baselineErrorRate"`
+	ErrorRate              float64    `json:"errorRate"`
+	BaselineLatencyMs      float64    `json:"baselineLatencyMs"`
+	LatencyMs              float64    `json:"latencyMs"`
+	VerificationMessage    string     `json:"verificationMessage,omitempty"`
 }
 
 type CreateAppServeAppRequest struct {
@@ -76,6 +91,10 @@ type CreateAppServeAppRequest struct {
 	PvAccessMode   string `json:"pvAccessMode"`
 	PvSize         string `json:"pvSize"`
 	PvMountPath    string `json:"pvMountPath"`
+
+	// Verification
+	VerificationBakePeriod int  `json:"verificationBakePeriod" validate:"min=0,max=1440"` // minutes. 0 disables verification
+	AutoRollback           bool `json:"autoRollback"`
 }
 
 func (c *CreateAppServeAppRequest) SetDefaultValue() {
@@ -102,6 +121,13 @@ func (c *CreateAppServeAppRequest) SetDefaultValue() {
 	}
 }
 
+const (
+	AppServeAppVerification_PENDING  = "PENDING"
+	AppServeAppVerification_VERIFIED = "VERIFIED"
+	AppServeAppVerification_SUSPECT  = "SUSPECT"
+	AppServeAppVerification_UNKNOWN  = "UNKNOWN"
+)
+
 type CreateAppServeAppResponse struct {
 	ID   string `json:"appId"`
 	Name string `json:"appName"`
@@ -133,6 +159,10 @@ type UpdateAppServeAppRequest struct {
 	ExtraEnv       string `json:"extraEnv"`
 	Port           string `json:"port"`
 
+	// Verification
+	VerificationBakePeriod int  `json:"verificationBakePeriod" validate:"min=0,max=1440"` // minutes. 0 disables verification
+	AutoRollback           bool `json:"autoRollback"`
+
 	// Update Strategy
 	Promote bool `json:"promote"`
 	Abort   bool `json:"abort"`
@@ -142,6 +172,40 @@ type RollbackAppServeAppRequest struct {
 	TaskId string `json:"taskId"`
 }
 
+// RollbackAppServeAppResponse 의 TaskId 는 rollback 을 위해 새로 만든 task 이고 RollbackVersion 은 되돌아간 task 의 버전이다.
+// 동시 실행 제한으로 대기 중이면 OperationStatus 가 PENDING 이다.
+type RollbackAppServeAppResponse struct {
+	TaskId          string          `json:"taskId"`
+	Version         string          `json:"version"`
+	RollbackVersion string          `json:"rollbackVersion"`
+	OperationId     string          `json:"operationId"`
+	OperationStatus OperationStatus `json:"operationStatus"`
+}
+
+const (
+	AppServeAppMetric_CPU          = "CPU"
+	AppServeAppMetric_MEMORY       = "MEMORY"
+	AppServeAppMetric_REQUEST_RATE = "REQUEST_RATE"
+	AppServeAppMetric_ERROR_RATE   = "ERROR_RATE"
+)
+
+// AppServeAppMetricSeries 의 Data 는 XAxis 의 시각마다의 값이며 값이 없는 시각은 빈 문자열이다.
+// 조회에 실패한 metric 은 Error 에 원인을 담는다.
+type AppServeAppMetricSeries struct {
+	Metric string      `json:"metric"`
+	Format ChartFormat `json:"format"`
+	Data   []string    `json:"data"`
+	Error  string      `json:"error,omitempty"`
+}
+
+type GetAppServeAppMetricsResponse struct {
+	AppServeAppId string                    `json:"appServeAppId"`
+	Duration      string                    `json:"duration"`
+	Interval      string                    `json:"interval"`
+	XAxis         *Axis                     `json:"xAxis"`
+	Metrics       []AppServeAppMetricSeries `json:"metrics"`
+}
+
 type GetAppServeAppsResponse struct {
 	AppServeApps []AppServeAppResponse `json:"appServeApps"`
 	Pagination   PaginationResponse    `json:"pagination"`
@@ -172,3 +236,107 @@ type ActionResponse struct {
 	Method string            `json:"method"`
 	Body   map[string]string `json:"body"`
 }
+
+// AppServeAppStageStatuses 는 stage 별로 app 이 가질 수 있는 status 목록이다.
+var AppServeAppStageStatuses = map[string][]string{
+	"APPROVAL": {"APPROVAL_WAIT", "APPROVAL_REJECTED"},
+	"PREPARE":  {"PREPARING"},
+	"BUILD":    {"BUILDING", "BUILD_SUCCESS", "BUILD_FAILED"},
+	"DEPLOY":   {"DEPLOYING", "DEPLOY_SUCCESS", "DEPLOY_FAILED"},
+	"PROMOTE":  {"PROMOTE_WAIT", "PROMOTING", "PROMOTE_SUCCESS", "PROMOTE_FAILED", "ABORTING", "ABORT_SUCCESS", "ABORT_FAILED"},
+	"ROLLBACK": {"ROLLBACKING", "ROLLBACK_SUCCESS", "ROLLBACK_FAILED"},
+	"DELETE":   {"DELETING", "DELETE_SUCCESS", "DELETE_FAILED"},
+}
+
+// AppServeAppStage returns the stage of the status. It returns "" for an unknown status.
+func AppServeAppStage(status string) string {
+	for stage, statuses := range AppServeAppStageStatuses {
+		for _, s := range statuses {
+			if s == status {
+				return stage
+			}
+		}
+	}
+	return ""
+}
+
+// AppServeAppFilter narrows the app list. Empty fields are not applied.
+type AppServeAppFilter struct {
+	Statuses        []string
+	Stage           string
+	TargetClusterId string
+	Search          string // name keyword
+}
+
+type AppServeAppStatusCount struct {
+	Status string `json:"status"`
+	Count  int64  `json:"count"`
+}
+
+type AppServeAppStageCount struct {
+	Stage string `json:"stage"`
+	Count int64  `json:"count"`
+}
+
+type GetAppServeAppSummaryResponse struct {
+	Total    int64                    `json:"total"`
+	Statuses []AppServeAppStatusCount `json:"statuses"`
+	Stages   []AppServeAppStageCount  `json:"stages"`
+}
+
+const (
+	AppServeAppConfigKind_ENV    = "ENV"
+	AppServeAppConfigKind_SECRET = "SECRET"
+
+	AppServeAppConfigChange_ADDED    = "ADDED"
+	AppServeAppConfigChange_REMOVED  = "REMOVED"
+	AppServeAppConfigChange_MODIFIED = "MODIFIED"
+)
+
+// AppServeAppConfigResponse 는 secret 의 값을 포함하지 않고 key 만 반환한다.
+type AppServeAppConfigResponse struct {
+	ID            string             `json:"id"`
+	AppServeAppId string             `json:"appServeAppId"`
+	Revision      int                `json:"revision"`
+	Env           map[string]string  `json:"env"`
+	SecretKeys    []string           `json:"secretKeys"`
+	Description   string             `json:"description"`
+	Creator       SimpleUserResponse `json:"creator"`
+	CreatedAt     time.Time          `json:"createdAt"`
+}
+
+type GetAppServeAppConfigsResponse struct {
+	Configs    []AppServeAppConfigResponse `json:"configs"`
+	Pagination PaginationResponse          `json:"pagination"`
+}
+
+type GetAppServeAppConfigResponse struct {
+	Config AppServeAppConfigResponse `json:"config"`
+}
+
+// UpdateAppServeAppConfigRequest 는 앱의 환경변수와 secret 전체이다. 요청에 없는 key 는 새 revision 에서 삭제된다.
+// secret 의 값을 비워 두면 이전 revision 의 값을 그대로 사용한다.
+type UpdateAppServeAppConfigRequest struct {
+	Env         map[string]string `json:"env"`
+	Secrets     map[string]string `json:"secrets"`
+	Description string            `json:"description" validate:"max=200"`
+}
+
+type UpdateAppServeAppConfigResponse struct {
+	Revision int `json:"revision"`
+}
+
+// AppServeAppConfigChange 의 OldValue, NewValue 는 환경변수일 때만 채운다. secret 은 값이 바뀌었는지만 알려준다.
+type AppServeAppConfigChange struct {
+	Key      string `json:"key"`
+	Kind     string `json:"kind"`
+	Change   string `json:"change"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+}
+
+type GetAppServeAppConfigDiffResponse struct {
+	FromRevision int                       `json:"fromRevision"`
+	ToRevision   int                       `json:"toRevision"`
+	Changes      []AppServeAppConfigChange `json:"changes"`
+}
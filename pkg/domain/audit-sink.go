@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"time"
+)
+
+// 마지막 전달 결과
+const (
+	AuditSinkStatus_SUCCEEDED = "SUCCEEDED"
+	AuditSinkStatus_FAILED    = "FAILED"
+)
+
+type AuditSinkResponse struct {
+	ID               string             `json:"id"`
+	Name             string             `json:"name"`
+	Type             string             `json:"type"`
+	Endpoint         string             `json:"endpoint"`
+	Topic            string             `json:"topic,omitempty"`
+	SecretConfigured bool               `json:"secretConfigured"`
+	Enabled          bool               `json:"enabled"`
+	LastStatus       string             `json:"lastStatus"`
+	LastError        string             `json:"lastError,omitempty"`
+	LastSentAt       *time.Time         `json:"lastSentAt"`
+	Creator          SimpleUserResponse `json:"creator"`
+	CreatedAt        time.Time          `json:"createdAt"`
+	UpdatedAt        time.Time          `json:"updatedAt"`
+}
+
+// CreateAuditSinkRequest 의 endpoint 는 type 이 webhook 이면 URL, syslog 이면 udp://host:514 형식의 주소, kafka 이면 Kafka REST Proxy 의 URL 이다.
+type CreateAuditSinkRequest struct {
+	Name     string `json:"name" validate:"required,name"`
+	Type     string `json:"type" validate:"required,oneof=webhook syslog kafka" enums:"webhook,syslog,kafka"`
+	Endpoint string `json:"endpoint" validate:"required"`
+	Topic    string `json:"topic"`
+	Secret   string `json:"secret"`
+	Enabled  bool   `json:"enabled"`
+}
+
+type CreateAuditSinkResponse struct {
+	ID string `json:"id"`
+}
+
+// UpdateAuditSinkRequest 의 secret 이 비어 있으면 기존 secret 을 유지한다.
+type UpdateAuditSinkRequest struct {
+	Name     string `json:"name" validate:"required,name"`
+	Endpoint string `json:"endpoint" validate:"required"`
+	Topic    string `json:"topic"`
+	Secret   string `json:"secret"`
+	Enabled  bool   `json:"enabled"`
+}
+
+type GetAuditSinksResponse struct {
+	AuditSinks []AuditSinkResponse `json:"auditSinks"`
+	Pagination PaginationResponse  `json:"pagination"`
+}
+
+type GetAuditSinkResponse struct {
+	AuditSink AuditSinkResponse `json:"auditSink"`
+}
+
+type AuditSinkDeadLetterResponse struct {
+	ID          string    `json:"id"`
+	AuditSinkId string    `json:"auditSinkId"`
+	AuditId     string    `json:"auditId"`
+	Attempts    int       `json:"attempts"`
+	Error       string    `json:"error"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type GetAuditSinkDeadLettersResponse struct {
+	DeadLetters []AuditSinkDeadLetterResponse `json:"deadLetters"`
+	Pagination  PaginationResponse            `json:"pagination"`
+}
@@ -16,10 +16,16 @@ type AuditResponse struct {
 	UserAccountId    string    `json:"userAccountId"`
 	UserName         string    `json:"userName"`
 	UserRoles        string    `json:"userRoles"`
+	StatusCode       int       `json:"statusCode,omitempty"`
 	CreatedAt        time.Time `json:"createdAt"`
 	UpdatedAt        time.Time `json:"updatedAt"`
 }
 
+const (
+	AuditStatus_SUCCESS = "success"
+	AuditStatus_FAILURE = "failure"
+)
+
 type CreateAuditRequest struct {
 }
 type CreateAuditResponse struct {
@@ -32,3 +38,107 @@ type GetAuditsResponse struct {
 	Audits     []AuditResponse    `json:"audits"`
 	Pagination PaginationResponse `json:"pagination"`
 }
+
+type GetAuditStatisticsResponse struct {
+	AuditCount      int64      `json:"auditCount"`
+	ArchivedCount   int64      `json:"archivedCount"`
+	OldestCreatedAt *time.Time `json:"oldestCreatedAt,omitempty"`
+}
+
+type ArchiveAuditsRequest struct {
+	RetentionDays int `json:"retentionDays" validate:"required,min=1"`
+}
+type ArchiveAuditsResponse struct {
+	ArchivedCount int64 `json:"archivedCount"`
+}
+
+const (
+	AuditRetentionAction_ARCHIVE = "archive"
+	AuditRetentionAction_PURGE   = "purge"
+
+	AuditArchivalRunStatus_RUNNING   = "running"
+	AuditArchivalRunStatus_SUCCEEDED = "succeeded"
+	AuditArchivalRunStatus_FAILED    = "failed"
+
+	AuditArchivalRunTrigger_SCHEDULER = "scheduler"
+	AuditArchivalRunTrigger_MANUAL    = "manual"
+)
+
+type AuditRetentionPolicyResponse struct {
+	OrganizationId string    `json:"organizationId"`
+	RetentionDays  int       `json:"retentionDays"`
+	Action         string    `json:"action"`
+	IsDefault      bool      `json:"isDefault"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+type GetAuditRetentionPoliciesResponse struct {
+	Policies []AuditRetentionPolicyResponse `json:"policies"`
+}
+
+// UpdateAuditRetentionPolicyRequest 의 action 이 archive 이면 보존 기간이 지난 감사 로그를 object storage 에 보관한 뒤 삭제하고, purge 이면 바로 삭제한다.
+type UpdateAuditRetentionPolicyRequest struct {
+	RetentionDays int    `json:"retentionDays" validate:"required,min=1,max=3650"`
+	Action        string `json:"action" validate:"required,oneof=archive purge" enums:"archive,purge"`
+}
+
+type AuditArchivalRunResponse struct {
+	ID             string     `json:"id"`
+	OrganizationId string     `json:"organizationId"`
+	Trigger        string     `json:"trigger"`
+	Action         string     `json:"action"`
+	RetentionDays  int        `json:"retentionDays"`
+	Before         time.Time  `json:"before"`
+	Status         string     `json:"status"`
+	ProcessedCount int64      `json:"processedCount"`
+	Location       string     `json:"location"`
+	Error          string     `json:"error,omitempty"`
+	StartedAt      time.Time  `json:"startedAt"`
+	FinishedAt     *time.Time `json:"finishedAt,omitempty"`
+}
+
+// CreateAuditArchivalRunRequest 의 organizationId 가 비어 있으면 모든 조직에 대해 실행한다.
+type CreateAuditArchivalRunRequest struct {
+	OrganizationId string `json:"organizationId"`
+}
+
+type CreateAuditArchivalRunResponse struct {
+	Runs []AuditArchivalRunResponse `json:"runs"`
+}
+
+type GetAuditArchivalRunsResponse struct {
+	Runs       []AuditArchivalRunResponse `json:"runs"`
+	Pagination PaginationResponse         `json:"pagination"`
+}
+
+type GetAuditArchivalRunResponse struct {
+	Run AuditArchivalRunResponse `json:"run"`
+}
+
+type OrganizationTimelineCategory string
+
+const (
+	OrganizationTimelineCategory_STACK        OrganizationTimelineCategory = "STACK"
+	OrganizationTimelineCategory_MEMBER       OrganizationTimelineCategory = "MEMBER"
+	OrganizationTimelineCategory_POLICY       OrganizationTimelineCategory = "POLICY"
+	OrganizationTimelineCategory_ORGANIZATION OrganizationTimelineCategory = "ORGANIZATION"
+)
+
+// OrganizationTimelineEvent 는 같은 사용자가 연달아 수행한 같은 변경을 하나로 묶은 것이다. count 는 묶인 변경의 수이다.
+type OrganizationTimelineEvent struct {
+	Category      OrganizationTimelineCategory `json:"category"`
+	Message       string                       `json:"message"`
+	UserAccountId string                       `json:"userAccountId,omitempty"`
+	UserName      string                       `json:"userName,omitempty"`
+	Count         int                          `json:"count"`
+	FirstAt       time.Time                    `json:"firstAt"`
+	LastAt        time.Time                    `json:"lastAt"`
+}
+
+type GetOrganizationTimelineResponse struct {
+	From      time.Time                            `json:"from"`
+	To        time.Time                            `json:"to"`
+	Events    []OrganizationTimelineEvent          `json:"events"`
+	Summary   map[OrganizationTimelineCategory]int `json:"summary"`
+	Truncated bool                                 `json:"truncated"`
+}
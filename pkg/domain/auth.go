@@ -1,9 +1,13 @@
 package domain
 
+import "time"
+
 type LoginRequest struct {
 	AccountId      string `json:"accountId" validate:"required"`
 	Password       string `json:"password" validate:"required"`
 	OrganizationId string `json:"organizationId" validate:"required"`
+	// Otp 는 2단계 인증을 등록한 사용자의 인증 앱 코드이다.
+	Otp string `json:"otp,omitempty"`
 }
 
 type LoginResponse struct {
@@ -15,9 +19,37 @@ type LoginResponse struct {
 		Department      string               `json:"department"`
 		Organization    OrganizationResponse `json:"organization"`
 		PasswordExpired bool                 `json:"passwordExpired"`
+		// 조직이 2단계 인증을 요구하지만 사용자가 아직 등록하지 않은 경우 true 이다.
+		OtpEnrollmentRequired bool `json:"otpEnrollmentRequired"`
+		// RefreshToken 은 한 번만 사용할 수 있다. 갱신할 때마다 응답으로 받은 새 refresh token 을 사용한다.
+		RefreshToken string `json:"refreshToken"`
 	} `json:"user"`
 }
 
+type RefreshTokenRequest struct {
+	OrganizationId string `json:"organizationId" validate:"required"`
+	RefreshToken   string `json:"refreshToken" validate:"required"`
+}
+
+type RefreshTokenResponse struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+type UserSessionResponse struct {
+	ID             string    `json:"id"`
+	ClientIP       string    `json:"clientIP"`
+	UserAgent      string    `json:"userAgent"`
+	LastActivityAt time.Time `json:"lastActivityAt"`
+	CreatedAt      time.Time `json:"createdAt"`
+	Current        bool      `json:"current"`
+}
+
+type GetUserSessionsResponse struct {
+	Sessions   []UserSessionResponse `json:"sessions"`
+	Pagination PaginationResponse    `json:"pagination"`
+}
+
 type VerifyIdentityForLostIdRequest struct {
 	OrganizationId string `json:"organizationId" validate:"required"`
 	Email          string `json:"email" validate:"required,email"`
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"time"
+)
+
+// Helm repository 의 마지막 동기화 결과
+const (
+	HelmRepositoryStatus_PENDING = "PENDING"
+	HelmRepositoryStatus_SYNCED  = "SYNCED"
+	HelmRepositoryStatus_FAILED  = "FAILED"
+)
+
+type HelmRepositoryResponse struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Url         string             `json:"url"`
+	Description string             `json:"description"`
+	Status      string             `json:"status"`
+	StatusDesc  string             `json:"statusDesc,omitempty"`
+	ChartCount  int                `json:"chartCount"`
+	SyncedAt    *time.Time         `json:"syncedAt"`
+	Creator     SimpleUserResponse `json:"creator"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	UpdatedAt   time.Time          `json:"updatedAt"`
+}
+
+type GetHelmRepositoriesResponse struct {
+	HelmRepositories []HelmRepositoryResponse `json:"helmRepositories"`
+	Pagination       PaginationResponse       `json:"pagination"`
+}
+
+// CreateHelmRepositoryRequest 의 url 은 index.yaml 을 제공하는 공개 Helm chart repository 이다.
+type CreateHelmRepositoryRequest struct {
+	Name        string `json:"name" validate:"required,name"`
+	Url         string `json:"url" validate:"required,url"`
+	Description string `json:"description" validate:"max=100"`
+}
+
+type CreateHelmRepositoryResponse struct {
+	ID string `json:"id"`
+}
+
+type SyncHelmRepositoryResponse struct {
+	HelmRepository HelmRepositoryResponse `json:"helmRepository"`
+}
+
+type CatalogChartResponse struct {
+	ID                 string     `json:"id"`
+	HelmRepositoryId   string     `json:"helmRepositoryId"`
+	HelmRepositoryName string     `json:"helmRepositoryName"`
+	Name               string     `json:"name"`
+	Version            string     `json:"version"`
+	AppVersion         string     `json:"appVersion"`
+	Description        string     `json:"description"`
+	Icon               string     `json:"icon"`
+	Deprecated         bool       `json:"deprecated"`
+	ReleasedAt         *time.Time `json:"releasedAt"`
+}
+
+type GetCatalogChartsResponse struct {
+	Charts     []CatalogChartResponse `json:"charts"`
+	Pagination PaginationResponse     `json:"pagination"`
+}
+
+// GetCatalogChartResponse 의 valuesSchema 는 chart 의 values.schema.json 이다. chart 에 schema 가 없으면 비어 있다.
+type GetCatalogChartResponse struct {
+	Chart        CatalogChartResponse   `json:"chart"`
+	Versions     []string               `json:"versions"`
+	ValuesSchema map[string]interface{} `json:"valuesSchema,omitempty"`
+}
+
+// CreateCatalogAppRequest 의 values 는 chart 의 values.schema.json 으로 검증한 뒤 설치 workflow 에 전달한다.
+type CreateCatalogAppRequest struct {
+	CatalogChartId string                 `json:"catalogChartId" validate:"required"`
+	ReleaseName    string                 `json:"releaseName" validate:"required,max=53"`
+	Namespace      string                 `json:"namespace" validate:"required,max=63"`
+	Values         map[string]interface{} `json:"values"`
+}
+
+type CreateCatalogAppResponse struct {
+	ID          string `json:"id"`
+	OperationId string `json:"operationId"`
+}
+
+// CatalogAppResponse 의 status 는 배포 operation 의 상태이다.
+type CatalogAppResponse struct {
+	ID                 string             `json:"id"`
+	StackId            string             `json:"stackId"`
+	ReleaseName        string             `json:"releaseName"`
+	Namespace          string             `json:"namespace"`
+	HelmRepositoryName string             `json:"helmRepositoryName"`
+	ChartName          string             `json:"chartName"`
+	ChartVersion       string             `json:"chartVersion"`
+	OperationId        string             `json:"operationId"`
+	Status             OperationStatus    `json:"status"`
+	StatusDesc         string             `json:"statusDesc"`
+	Creator            SimpleUserResponse `json:"creator"`
+	CreatedAt          time.Time          `json:"createdAt"`
+}
+
+type GetCatalogAppsResponse struct {
+	CatalogApps []CatalogAppResponse `json:"catalogApps"`
+	Pagination  PaginationResponse   `json:"pagination"`
+}
@@ -9,8 +9,9 @@ const CLOUD_ACCOUNT_INCLUSTER = "INCLUSTER"
 const (
 	CloudService_UNDEFINED = "UNDEFINED"
 	CloudService_AWS       = "AWS"
-	CloudService_AZURE     = "AZZURE"
+	CloudService_AZURE     = "AZURE"
 	CloudService_GCP       = "GCP"
+	CloudService_OPENSTACK = "OPENSTACK"
 	CloudService_BYOH      = "BYOH"
 )
 
@@ -47,6 +48,33 @@ func (m CloudAccountStatus) FromString(s string) CloudAccountStatus {
 	return CloudAccountStatus_PENDING
 }
 
+// 클라우드 계정의 자격증명 검증 결과. 검증하지 않은 계정은 UNVERIFIED 이다.
+type CloudAccountCredentialStatus int32
+
+const (
+	CloudAccountCredentialStatus_UNVERIFIED CloudAccountCredentialStatus = iota
+	CloudAccountCredentialStatus_VALID
+	CloudAccountCredentialStatus_EXPIRED
+	CloudAccountCredentialStatus_PERMISSION_DENIED
+)
+
+var cloudAccountCredentialStatus = [...]string{
+	"UNVERIFIED",
+	"VALID",
+	"EXPIRED",
+	"PERMISSION_DENIED",
+}
+
+func (m CloudAccountCredentialStatus) String() string { return cloudAccountCredentialStatus[(m)] }
+func (m CloudAccountCredentialStatus) FromString(s string) CloudAccountCredentialStatus {
+	for i, v := range cloudAccountCredentialStatus {
+		if v == s {
+			return CloudAccountCredentialStatus(i)
+		}
+	}
+	return CloudAccountCredentialStatus_UNVERIFIED
+}
+
 type ResourceQuotaAttr struct {
 	Type     string `json:"type"`
 	Usage    int    `json:"usage"`
@@ -59,20 +87,24 @@ type ResourceQuota struct {
 }
 
 type CloudAccountResponse struct {
-	ID             string             `json:"id"`
-	OrganizationId string             `json:"organizationId"`
-	Name           string             `json:"name"`
-	Description    string             `json:"description"`
-	CloudService   string             `json:"cloudService"`
-	Resource       string             `json:"resource"`
-	Clusters       int                `json:"clusters"`
-	Status         string             `json:"status"`
-	AwsAccountId   string             `json:"awsAccountId"`
-	CreatedIAM     bool               `json:"createdIAM"`
-	Creator        SimpleUserResponse `json:"creator"`
-	Updator        SimpleUserResponse `json:"updator"`
-	CreatedAt      time.Time          `json:"createdAt"`
-	UpdatedAt      time.Time          `json:"updatedAt"`
+	ID                   string             `json:"id"`
+	OrganizationId       string             `json:"organizationId"`
+	Name                 string             `json:"name"`
+	Description          string             `json:"description"`
+	CloudService         string             `json:"cloudService"`
+	Resource             string             `json:"resource"`
+	Clusters             int                `json:"clusters"`
+	Status               string             `json:"status"`
+	AccountId            string             `json:"accountId"`
+	AwsAccountId         string             `json:"awsAccountId"`
+	CreatedIAM           bool               `json:"createdIAM"`
+	CredentialStatus     string             `json:"credentialStatus"`
+	CredentialStatusDesc string             `json:"credentialStatusDesc"`
+	CredentialCheckedAt  *time.Time         `json:"credentialCheckedAt"`
+	Creator              SimpleUserResponse `json:"creator"`
+	Updator              SimpleUserResponse `json:"updator"`
+	CreatedAt            time.Time          `json:"createdAt"`
+	UpdatedAt            time.Time          `json:"updatedAt"`
 }
 
 type SimpleCloudAccountResponse struct {
@@ -81,6 +113,7 @@ type SimpleCloudAccountResponse struct {
 	Name           string `json:"name"`
 	Description    string `json:"description"`
 	CloudService   string `json:"cloudService"`
+	AccountId      string `json:"accountId"`
 	AwsAccountId   string `json:"awsAccountId"`
 	CreatedIAM     bool   `json:"createdIAM"`
 	Clusters       int    `json:"clusters"`
@@ -95,14 +128,42 @@ type GetCloudAccountResponse struct {
 	CloudAccount CloudAccountResponse `json:"cloudAccount"`
 }
 
+// AzureCredential 은 Azure 구독에 접근하는 service principal 이다. 구독 ID 는 AccountId 로 입력한다.
+type AzureCredential struct {
+	TenantId     string `json:"tenantId"`
+	ClientId     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+}
+
+// GcpCredential 은 GCP 프로젝트의 service account key(JSON) 이다. 프로젝트 ID 는 AccountId 로 입력한다.
+type GcpCredential struct {
+	ServiceAccountKey string `json:"serviceAccountKey"`
+}
+
+// OpenstackCredential 은 Keystone 인증 정보이다. 프로젝트 ID 는 AccountId 로 입력한다.
+type OpenstackCredential struct {
+	AuthUrl        string `json:"authUrl"`
+	Region         string `json:"region"`
+	UserDomainName string `json:"userDomainName"`
+	Username       string `json:"username"`
+	Password       string `json:"password"`
+}
+
+// CreateCloudAccountRequest 의 자격증명은 CloudService 에 따라 다르다.
+// AWS 는 AccessKeyId, SecretAccessKey, SessionToken 을, 나머지는 같은 이름의 항목을 사용한다.
+// AwsAccountId 는 이전 요청과의 호환을 위해 남겨 두었으며 AccountId 가 비어 있으면 대신 사용한다.
 type CreateCloudAccountRequest struct {
-	Name            string `json:"name" validate:"required,name"`
-	Description     string `json:"description"`
-	CloudService    string `json:"cloudService" validate:"oneof=AWS AZZURE GCP"`
-	AwsAccountId    string `json:"awsAccountId" validate:"required,min=12,max=12"`
-	AccessKeyId     string `json:"accessKeyId" validate:"required,min=16,max=128"`
-	SecretAccessKey string `json:"secretAccessKey" validate:"required,min=16,max=128"`
-	SessionToken    string `json:"sessionToken" validate:"max=2000"`
+	Name            string               `json:"name" validate:"required,name"`
+	Description     string               `json:"description"`
+	CloudService    string               `json:"cloudService" validate:"oneof=AWS AZURE GCP OPENSTACK"`
+	AccountId       string               `json:"accountId" validate:"max=128"`
+	AwsAccountId    string               `json:"awsAccountId" validate:"omitempty,min=12,max=12"`
+	AccessKeyId     string               `json:"accessKeyId" validate:"omitempty,min=16,max=128"`
+	SecretAccessKey string               `json:"secretAccessKey" validate:"omitempty,min=16,max=128"`
+	SessionToken    string               `json:"sessionToken" validate:"max=2000"`
+	Azure           *AzureCredential     `json:"azure,omitempty"`
+	Gcp             *GcpCredential       `json:"gcp,omitempty"`
+	Openstack       *OpenstackCredential `json:"openstack,omitempty"`
 }
 
 type CreateCloudAccountResponse struct {
@@ -113,9 +174,10 @@ type UpdateCloudAccountRequest struct {
 	Description string `json:"description"`
 }
 
+// DeleteCloudAccountRequest 의 자격증명은 AWS 클라우드 계정을 정리할 때만 필요하다.
 type DeleteCloudAccountRequest struct {
-	AccessKeyId     string `json:"accessKeyId" validate:"required,min=16,max=128"`
-	SecretAccessKey string `json:"secretAccessKey" validate:"required,min=16,max=128"`
+	AccessKeyId     string `json:"accessKeyId" validate:"omitempty,min=16,max=128"`
+	SecretAccessKey string `json:"secretAccessKey" validate:"omitempty,min=16,max=128"`
 	SessionToken    string `json:"sessionToken" validate:"max=2000"`
 }
 
@@ -136,3 +198,17 @@ type DeleteCloudAccountResponse struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
 }
+
+type CloudAccountCredentialCheckResponse struct {
+	Action  string `json:"action"`
+	Allowed bool   `json:"allowed"`
+	Message string `json:"message,omitempty"`
+}
+
+type VerifyCloudAccountResponse struct {
+	CredentialStatus     string                                `json:"credentialStatus"`
+	CredentialStatusDesc string                                `json:"credentialStatusDesc"`
+	CredentialCheckedAt  time.Time                             `json:"credentialCheckedAt"`
+	CallerArn            string                                `json:"callerArn"`
+	Checks               []CloudAccountCredentialCheckResponse `json:"checks"`
+}
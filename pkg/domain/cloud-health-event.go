@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateCloudHealthEventRequest 는 EventBridge 가 전달하는 AWS Health event 형식이다.
+// https://docs.aws.amazon.com/health/latest/ug/aws-health-events-eventbridge-schema.html
+type CreateCloudHealthEventRequest struct {
+	Id         string                 `json:"id"`
+	DetailType string                 `json:"detail-type"`
+	Source     string                 `json:"source"`
+	Account    string                 `json:"account" validate:"required"`
+	Region     string                 `json:"region"`
+	Resources  []string               `json:"resources"`
+	Detail     CloudHealthEventDetail `json:"detail"`
+}
+
+type CloudHealthEventDetail struct {
+	EventArn          string                        `json:"eventArn" validate:"required"`
+	Service           string                        `json:"service"`
+	EventTypeCode     string                        `json:"eventTypeCode"`
+	EventTypeCategory string                        `json:"eventTypeCategory"`
+	EventRegion       string                        `json:"eventRegion"`
+	StatusCode        string                        `json:"statusCode"`
+	StartTime         string                        `json:"startTime"`
+	EndTime           string                        `json:"endTime"`
+	EventDescription  []CloudHealthEventDescription `json:"eventDescription"`
+	AffectedEntities  []CloudHealthAffectedEntity   `json:"affectedEntities"`
+}
+
+type CloudHealthEventDescription struct {
+	Language          string `json:"language"`
+	LatestDescription string `json:"latestDescription"`
+}
+
+type CloudHealthAffectedEntity struct {
+	EntityValue string `json:"entityValue"`
+}
+
+type CloudHealthEventResponse struct {
+	ID                   string     `json:"id"`
+	OrganizationId       string     `json:"organizationId"`
+	CloudAccountId       string     `json:"cloudAccountId"`
+	ClusterId            ClusterId  `json:"clusterId"`
+	EventArn             string     `json:"eventArn"`
+	Service              string     `json:"service"`
+	EventTypeCode        string     `json:"eventTypeCode"`
+	EventTypeCategory    string     `json:"eventTypeCategory"`
+	Region               string     `json:"region"`
+	StatusCode           string     `json:"statusCode"`
+	StartTime            *time.Time `json:"startTime"`
+	EndTime              *time.Time `json:"endTime"`
+	SystemNotificationId *uuid.UUID `json:"systemNotificationId"`
+	CreatedAt            time.Time  `json:"createdAt"`
+	UpdatedAt            time.Time  `json:"updatedAt"`
+}
+
+type GetCloudHealthEventsResponse struct {
+	CloudHealthEvents []CloudHealthEventResponse `json:"cloudHealthEvents"`
+	Pagination        PaginationResponse         `json:"pagination"`
+}
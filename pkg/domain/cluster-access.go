@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"time"
+)
+
+// enum
+type ClusterAccessType int32
+
+const (
+	ClusterAccessType_EXEC ClusterAccessType = iota
+	ClusterAccessType_KUBECONFIG
+	ClusterAccessType_ERROR
+)
+
+var clusterAccessType = [...]string{
+	"EXEC",
+	"KUBECONFIG",
+	"ERROR",
+}
+
+func (m ClusterAccessType) String() string { return clusterAccessType[(m)] }
+func (m ClusterAccessType) FromString(s string) ClusterAccessType {
+	for i, v := range clusterAccessType {
+		if v == s {
+			return ClusterAccessType(i)
+		}
+	}
+	return ClusterAccessType_ERROR
+}
+
+// enum
+type ClusterAccessRequestStatus int32
+
+const (
+	ClusterAccessRequestStatus_PENDING ClusterAccessRequestStatus = iota
+	ClusterAccessRequestStatus_APPROVED
+	ClusterAccessRequestStatus_REJECTED
+	ClusterAccessRequestStatus_EXPIRED
+	ClusterAccessRequestStatus_REVOKED
+)
+
+var clusterAccessRequestStatus = [...]string{
+	"PENDING",
+	"APPROVED",
+	"REJECTED",
+	"EXPIRED",
+	"REVOKED",
+}
+
+func (m ClusterAccessRequestStatus) String() string { return clusterAccessRequestStatus[(m)] }
+func (m ClusterAccessRequestStatus) FromString(s string) ClusterAccessRequestStatus {
+	for i, v := range clusterAccessRequestStatus {
+		if v == s {
+			return ClusterAccessRequestStatus(i)
+		}
+	}
+	return ClusterAccessRequestStatus_PENDING
+}
+
+type ClusterAccessRequestResponse struct {
+	ID             string             `json:"id"`
+	OrganizationId string             `json:"organizationId"`
+	ClusterId      ClusterId          `json:"clusterId"`
+	AccessType     string             `json:"accessType"`
+	Reason         string             `json:"reason"`
+	Status         string             `json:"status"`
+	StatusDesc     string             `json:"statusDesc"`
+	TtlSeconds     int                `json:"ttlSeconds"`
+	Requester      SimpleUserResponse `json:"requester"`
+	Approver       SimpleUserResponse `json:"approver"`
+	ApprovedAt     *time.Time         `json:"approvedAt"`
+	ExpiresAt      *time.Time         `json:"expiresAt"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+type CreateClusterAccessRequestRequest struct {
+	AccessType string `json:"accessType" validate:"required,oneof=EXEC KUBECONFIG"`
+	Reason     string `json:"reason" validate:"required"`
+}
+
+type CreateClusterAccessRequestResponse struct {
+	ID string `json:"id"`
+}
+
+type ApproveClusterAccessRequestRequest struct {
+	Ttl string `json:"ttl" validate:"required"` // 1h, 30m, ...
+}
+
+type RejectClusterAccessRequestRequest struct {
+	Reason string `json:"reason"`
+}
+
+type GetClusterAccessRequestsResponse struct {
+	AccessRequests []ClusterAccessRequestResponse `json:"accessRequests"`
+	Pagination     PaginationResponse             `json:"pagination"`
+}
+
+type GetClusterAccessRequestResponse struct {
+	AccessRequest ClusterAccessRequestResponse `json:"accessRequest"`
+}
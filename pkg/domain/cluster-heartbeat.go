@@ -0,0 +1,19 @@
+package domain
+
+type ClusterHeartbeatSource string
+
+const (
+	ClusterHeartbeatSource_AGENT ClusterHeartbeatSource = "AGENT"
+	ClusterHeartbeatSource_PING  ClusterHeartbeatSource = "PING"
+)
+
+type ClusterConnectivityStatus string
+
+const (
+	ClusterConnectivityStatus_REACHABLE   ClusterConnectivityStatus = "REACHABLE"
+	ClusterConnectivityStatus_UNREACHABLE ClusterConnectivityStatus = "UNREACHABLE"
+)
+
+type CreateClusterHeartbeatRequest struct {
+	AgentVersion string `json:"agentVersion"`
+}
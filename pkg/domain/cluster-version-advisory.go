@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// StackVersionAdvisory 는 kubernetes 버전의 지원 종료가 다가오거나 지난 스택에 표시한다.
+// upgradeUrl 은 업그레이드 절차의 주소이며 설정하지 않으면 비어 있다.
+type StackVersionAdvisory struct {
+	KubeVersion    string    `json:"kubeVersion"`
+	EndOfSupportAt time.Time `json:"endOfSupportAt"`
+	DaysLeft       int       `json:"daysLeft"`
+	Expired        bool      `json:"expired"`
+	UpgradeUrl     string    `json:"upgradeUrl,omitempty"`
+}
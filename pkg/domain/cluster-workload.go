@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+const (
+	ClusterWorkloadKind_DEPLOYMENT  = "Deployment"
+	ClusterWorkloadKind_STATEFULSET = "StatefulSet"
+	ClusterWorkloadKind_DAEMONSET   = "DaemonSet"
+)
+
+// ListClusterResourcesRequest 는 kubernetes api 의 limit/continue 로 페이지를 나눈다.
+// 다음 페이지는 이전 응답의 continue 를 그대로 전달하여 조회한다.
+type ListClusterResourcesRequest struct {
+	Namespace     string
+	LabelSelector string
+	Limit         int64
+	Continue      string
+}
+
+type ClusterNamespaceResponse struct {
+	Name      string            `json:"name"`
+	Status    string            `json:"status"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+type GetClusterNamespacesResponse struct {
+	Namespaces []ClusterNamespaceResponse `json:"namespaces"`
+	Continue   string                     `json:"continue,omitempty"`
+}
+
+// ClusterWorkloadResponse 의 Status 는 원하는 replica 가 모두 준비되면 Ready, 갱신 중이면 Progressing, 그 외는 NotReady 이다.
+type ClusterWorkloadResponse struct {
+	Kind      string            `json:"kind"`
+	Namespace string            `json:"namespace"`
+	Name      string            `json:"name"`
+	Status    string            `json:"status"`
+	Desired   int32             `json:"desired"`
+	Ready     int32             `json:"ready"`
+	Updated   int32             `json:"updated"`
+	Available int32             `json:"available"`
+	Images    []string          `json:"images"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+type GetClusterWorkloadsResponse struct {
+	Workloads []ClusterWorkloadResponse `json:"workloads"`
+	Continue  string                    `json:"continue,omitempty"`
+}
+
+type GetClusterPodsResponse struct {
+	Pods     []PodSearchResult `json:"pods"`
+	Continue string            `json:"continue,omitempty"`
+}
+
+// GetPodLogsRequest 의 Container 를 지정하지 않으면 pod 의 기본 container 의 로그를 조회한다.
+type GetPodLogsRequest struct {
+	Container    string
+	TailLines    *int64
+	SinceSeconds *int64
+	Follow       bool
+	Previous     bool
+	Timestamps   bool
+}
@@ -140,26 +140,31 @@ func (m *ClusterConf) SetDefault() {
 }
 
 type CreateClusterRequest struct {
-	OrganizationId         string   `json:"organizationId" validate:"required"`
-	CloudService           string   `json:"cloudService" validate:"required,oneof=AWS BYOH"`
-	StackTemplateId        string   `json:"stackTemplateId" validate:"required"`
-	Name                   string   `json:"name" validate:"required,name"`
-	Description            string   `json:"description"`
-	CloudAccountId         string   `json:"cloudAccountId"`
-	ClusterType            string   `json:"clusterType"`
-	ByoClusterEndpointHost string   `json:"byoClusterEndpointHost,omitempty"`
-	ByoClusterEndpointPort int      `json:"byoClusterEndpointPort,omitempty"`
-	IsStack                bool     `json:"isStack,omitempty"`
-	PolicyIds              []string `json:"policyIds,omitempty"`
-	TksCpNode              int      `json:"tksCpNode"`
-	TksCpNodeMax           int      `json:"tksCpNodeMax,omitempty"`
-	TksCpNodeType          string   `json:"tksCpNodeType,omitempty"`
-	TksInfraNode           int      `json:"tksInfraNode"`
-	TksInfraNodeMax        int      `json:"tksInfraNodeMax,omitempty"`
-	TksInfraNodeType       string   `json:"tksInfraNodeType,omitempty"`
-	TksUserNode            int      `json:"tksUserNode"`
-	TksUserNodeMax         int      `json:"tksUserNodeMax,omitempty"`
-	TksUserNodeType        string   `json:"tksUserNodeType,omitempty"`
+	OrganizationId         string            `json:"organizationId" validate:"required"`
+	CloudService           string            `json:"cloudService" validate:"required,oneof=AWS BYOH"`
+	StackTemplateId        string            `json:"stackTemplateId" validate:"required"`
+	Name                   string            `json:"name" validate:"required,name"`
+	Description            string            `json:"description"`
+	CloudAccountId         string            `json:"cloudAccountId"`
+	ClusterType            string            `json:"clusterType"`
+	ByoClusterEndpointHost string            `json:"byoClusterEndpointHost,omitempty"`
+	ByoClusterEndpointPort int               `json:"byoClusterEndpointPort,omitempty"`
+	IsStack                bool              `json:"isStack,omitempty"`
+	PolicyIds              []string          `json:"policyIds,omitempty"`
+	TksCpNode              int               `json:"tksCpNode"`
+	TksCpNodeMax           int               `json:"tksCpNodeMax,omitempty"`
+	TksCpNodeType          string            `json:"tksCpNodeType,omitempty"`
+	TksInfraNode           int               `json:"tksInfraNode"`
+	TksInfraNodeMax        int               `json:"tksInfraNodeMax,omitempty"`
+	TksInfraNodeType       string            `json:"tksInfraNodeType,omitempty"`
+	TksUserNode            int               `json:"tksUserNode"`
+	TksUserNodeMax         int               `json:"tksUserNodeMax,omitempty"`
+	TksUserNodeType        string            `json:"tksUserNodeType,omitempty"`
+	Tags                   map[string]string `json:"tags,omitempty"`
+
+	TksCpNodeImage    string `json:"tksCpNodeImage,omitempty"`
+	TksInfraNodeImage string `json:"tksInfraNodeImage,omitempty"`
+	TksUserNodeImage  string `json:"tksUserNodeImage,omitempty"`
 }
 
 type ImportClusterRequest struct {
@@ -190,6 +195,10 @@ type ClusterConfResponse struct {
 	TksUserNode      int    `json:"tksUserNode"`
 	TksUserNodeMax   int    `json:"tksUserNodeMax,omitempty"`
 	TksUserNodeType  string `json:"tksUserNodeType,omitempty"`
+
+	TksCpNodeImage    string `json:"tksCpNodeImage,omitempty"`
+	TksInfraNodeImage string `json:"tksInfraNodeImage,omitempty"`
+	TksUserNodeImage  string `json:"tksUserNodeImage,omitempty"`
 }
 
 type ClusterResponse struct {
@@ -212,6 +221,7 @@ type ClusterResponse struct {
 	ByoClusterEndpointInt  int                         `json:"byoClusterEndpointPort,omitempty"`
 	IsStack                bool                        `json:"isStack,omitempty"`
 	Favorited              bool                        `json:"favorited,omitempty"`
+	Tags                   map[string]string           `json:"tags,omitempty"`
 }
 
 type SimpleClusterResponse struct {
@@ -235,6 +245,10 @@ type ClusterSiteValuesResponse struct {
 	TksUserNodeType        string `json:"tksUserNodeType,omitempty"`
 	ByoClusterEndpointHost string `json:"byoClusterEndpointHost"`
 	ByoClusterEndpointPort int    `json:"byoClusterEndpointPort"`
+
+	TksCpNodeImage    string `json:"tksCpNodeImage,omitempty"`
+	TksInfraNodeImage string `json:"tksInfraNodeImage,omitempty"`
+	TksUserNodeImage  string `json:"tksUserNodeImage,omitempty"`
 }
 
 type GetClustersResponse struct {
@@ -266,3 +280,15 @@ type GetBootstrapKubeconfigResponse struct {
 type GetClusterNodesResponse struct {
 	Nodes []ClusterNode `json:"nodes"`
 }
+
+const (
+	ClusterKubeconfigRole_VIEW  = "view"
+	ClusterKubeconfigRole_ADMIN = "admin"
+)
+
+// GetClusterKubeconfigResponse 의 Kubeconfig 는 ExpiresAt 이후에는 사용할 수 없다.
+type GetClusterKubeconfigResponse struct {
+	Kubeconfig string    `json:"kubeconfig"`
+	Role       string    `json:"role"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
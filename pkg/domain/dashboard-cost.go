@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+// 비용은 단가가 등록된 클라우드 계정의 스택만 계산한다. 한 달은 730 시간으로 계산한다.
+const CostHoursPerMonth = 730
+
+type DashboardStackCost struct {
+	StackId          StackId
+	StackName        string
+	CloudAccountId   string
+	CloudAccountName string
+	CpuCores         float64
+	MemoryBytes      float64
+	Currency         string
+	MonthlyCost      float64
+	Priced           bool
+}
+
+type DashboardCost struct {
+	Stacks       []DashboardStackCost
+	MonthlyCosts map[string]float64 // currency -> estimated monthly cost
+	Errors       map[string]string
+}
+
+type DashboardStackCostResponse struct {
+	StackId          StackId `json:"stackId"`
+	StackName        string  `json:"stackName"`
+	CloudAccountId   string  `json:"cloudAccountId"`
+	CloudAccountName string  `json:"cloudAccountName"`
+	CpuCores         float64 `json:"cpuCores"`
+	MemoryBytes      float64 `json:"memoryBytes"`
+	Currency         string  `json:"currency,omitempty"`
+	MonthlyCost      float64 `json:"monthlyCost"`
+	Priced           bool    `json:"priced"`
+}
+
+// GetDashboardCostsResponse 의 monthlyCosts 는 통화별 조직 전체의 예상 월 비용이다.
+type GetDashboardCostsResponse struct {
+	Stacks       []DashboardStackCostResponse `json:"stacks"`
+	MonthlyCosts map[string]float64           `json:"monthlyCosts"`
+	Errors       map[string]string            `json:"errors,omitempty"`
+}
+
+type CloudAccountPricingResponse struct {
+	CloudAccountId  string             `json:"cloudAccountId"`
+	Currency        string             `json:"currency"`
+	CpuCoreHourly   float64            `json:"cpuCoreHourly"`
+	MemoryGibHourly float64            `json:"memoryGibHourly"`
+	Updator         SimpleUserResponse `json:"updator"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
+type GetCloudAccountPricingResponse struct {
+	Pricing CloudAccountPricingResponse `json:"pricing"`
+}
+
+// UpdateCloudAccountPricingRequest 의 단가는 vCPU 1 core, memory 1 GiB 의 시간당 가격이다.
+type UpdateCloudAccountPricingRequest struct {
+	Currency        string  `json:"currency" validate:"required,len=3"`
+	CpuCoreHourly   float64 `json:"cpuCoreHourly" validate:"min=0"`
+	MemoryGibHourly float64 `json:"memoryGibHourly" validate:"min=0"`
+}
@@ -15,6 +15,10 @@ const (
 	ChartType_MEMORY
 	ChartType_POD_CALENDAR
 	ChartType_ERROR
+	ChartType_CUSTOM
+	ChartType_DISK
+	ChartType_NETWORK
+	ChartType_TOP_PODS
 )
 
 var chartType = [...]string{
@@ -25,6 +29,10 @@ var chartType = [...]string{
 	"MEMORY",
 	"POD_CALENDAR",
 	"ERROR",
+	"CUSTOM",
+	"DISK",
+	"NETWORK",
+	"TOP_PODS",
 }
 
 func (m ChartType) String() string { return chartType[(m)] }
@@ -37,41 +45,99 @@ func (m ChartType) FromString(s string) ChartType {
 	return ChartType_ERROR
 }
 
+type UtilizationMetric string
+
+const (
+	UtilizationMetric_CPU     UtilizationMetric = "CPU"
+	UtilizationMetric_MEMORY  UtilizationMetric = "MEMORY"
+	UtilizationMetric_STORAGE UtilizationMetric = "STORAGE"
+)
+
+// ChartAggregation 은 chart 의 시점별 값을 클러스터 단위로 집계하는 방식이다.
+type ChartAggregation string
+
+const (
+	ChartAggregation_AVG ChartAggregation = "avg"
+	ChartAggregation_MAX ChartAggregation = "max"
+	ChartAggregation_MIN ChartAggregation = "min"
+	ChartAggregation_P95 ChartAggregation = "p95"
+)
+
+func (m ChartAggregation) Validate() bool {
+	switch m {
+	case ChartAggregation_AVG, ChartAggregation_MAX, ChartAggregation_MIN, ChartAggregation_P95:
+		return true
+	}
+	return false
+}
+
 // 내부
 type DashboardChart struct {
 	ChartType      ChartType
 	OrganizationId string
+	ClusterId      string
 	Name           string
 	Description    string
 	Duration       string // 1d, 7d, 30d ...
 	Interval       string // 1h, 1d, ...
+	Aggregation    ChartAggregation
 	Year           string
 	Month          string
+	Timezone       string
 	ChartData      ChartData
+	Format         ChartFormat
+	CustomChartId  string
+	Thresholds     []ChartThreshold
+	Annotations    []ChartAnnotation
+	Warnings       []string
+	Error          string
 	UpdatedAt      time.Time
 }
 
 type DashboardStack struct {
-	ID          StackId
-	Name        string
-	Description string
-	Status      string
-	StatusDesc  string
-	Cpu         string
-	Memory      string
-	Storage     string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID           StackId
+	Name         string
+	Description  string
+	CloudService string
+	Status       string
+	StatusDesc   string
+	Cpu          string
+	Memory       string
+	Storage      string
+	Alerts       DashboardStackAlert
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+
+	UnderMaintenance bool
+	MaintenanceEndAt *time.Time
+	VersionAdvisory  *StackVersionAdvisory
+}
+
+type DashboardStackAlert struct {
+	Critical int `json:"critical"`
+	Warning  int `json:"warning"`
 }
 
 // [TODO]
 func (m ChartType) All() (out []string) {
-	for _, v := range chartType {
+	for i, v := range chartType {
+		// 사용자 정의 chart 는 조직마다 다르므로 chart 종류 목록에 포함하지 않는다.
+		if ChartType(i) == ChartType_CUSTOM || ChartType(i).IsClusterOnly() {
+			continue
+		}
 		out = append(out, v)
 	}
 	return
 }
 
+// IsClusterOnly 는 stack 단위 drill-down 에서만 제공하는 chart 인지 여부이다.
+func (m ChartType) IsClusterOnly() bool {
+	return m == ChartType_DISK || m == ChartType_NETWORK
+}
+
+// ClusterChartTypes 는 stack 단위 drill-down 에서 제공하는 노드별 chart 종류이다.
+var ClusterChartTypes = []ChartType{ChartType_CPU, ChartType_MEMORY, ChartType_DISK, ChartType_NETWORK}
+
 type Unit struct {
 	Name string   `json:"name"`
 	Data []string `json:"data"`
@@ -86,24 +152,96 @@ type PodCount struct {
 	Value int `json:"value"`
 }
 
+type ChartUnit string
+
+const (
+	ChartUnit_PERCENT ChartUnit = "percent"
+	ChartUnit_BYTES   ChartUnit = "bytes"
+	ChartUnit_COUNT   ChartUnit = "count"
+	ChartUnit_RATE    ChartUnit = "rate" // bytes per second
+	ChartUnit_CORES   ChartUnit = "cores"
+	ChartUnit_RPS     ChartUnit = "rps" // requests per second
+)
+
+// ChartFormat describes how the values of chart series are scaled and rounded
+type ChartFormat struct {
+	Unit      ChartUnit `json:"unit"`
+	Prefix    string    `json:"prefix"`    // SI(k, M, ..) or binary(Ki, Mi, ..) prefix applied to values
+	Precision int       `json:"precision"` // number of decimal places
+}
+
 type ChartData struct {
-	XAxis     *Axis      `json:"xAxis,omitempty"`
-	YAxis     *Axis      `json:"yAxis,omitempty"`
-	Series    []Unit     `json:"series,omitempty"`
-	PodCounts []PodCount `json:"podCounts,omitempty"`
+	XAxis     *Axis           `json:"xAxis,omitempty"`
+	YAxis     *Axis           `json:"yAxis,omitempty"`
+	Series    []Unit          `json:"series,omitempty"`
+	PodCounts []PodCount      `json:"podCounts,omitempty"`
+	TopPods   []TopPodRanking `json:"topPods,omitempty"`
+}
+
+const (
+	TopPodMetric_RESTART = "RESTART"
+	TopPodMetric_CPU     = "CPU"
+	TopPodMetric_MEMORY  = "MEMORY"
+)
+
+// TopPodRanking 은 조회 기간 동안 metric 값이 큰 pod 순위이다. pod 별 data 는 ChartData 의 xAxis 시점의 값이다.
+type TopPodRanking struct {
+	Metric string      `json:"metric"`
+	Format ChartFormat `json:"format"`
+	Pods   []TopPod    `json:"pods"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// TopPod 의 value 는 조회 기간 전체의 값이다. RESTART 는 재기동 횟수, CPU 는 평균 사용 core, MEMORY 는 평균 working set 이다.
+type TopPod struct {
+	ClusterId   string   `json:"clusterId"`
+	ClusterName string   `json:"clusterName"`
+	Namespace   string   `json:"namespace"`
+	Pod         string   `json:"pod"`
+	Value       string   `json:"value"`
+	Data        []string `json:"data"`
 }
 
 type DashboardChartResponse struct {
-	ChartType      string    `json:"chartType"`
-	OrganizationId string    `json:"organizationId"`
-	Name           string    `json:"name"`
-	Description    string    `json:"description"`
-	Duration       string    `json:"duration"`
-	Interval       string    `json:"interval"`
-	Year           string    `json:"year"`
-	Month          string    `json:"month"`
-	ChartData      ChartData `json:"chartData"`
-	UpdatedAt      time.Time `json:"updatedAt"`
+	ChartType      string            `json:"chartType"`
+	OrganizationId string            `json:"organizationId"`
+	ClusterId      string            `json:"clusterId,omitempty"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description"`
+	Duration       string            `json:"duration"`
+	Interval       string            `json:"interval"`
+	Aggregation    ChartAggregation  `json:"aggregation,omitempty"`
+	Year           string            `json:"year"`
+	Month          string            `json:"month"`
+	Timezone       string            `json:"timezone,omitempty"`
+	ChartData      ChartData         `json:"chartData"`
+	Format         ChartFormat       `json:"format"`
+	CustomChartId  string            `json:"customChartId,omitempty"`
+	Thresholds     []ChartThreshold  `json:"thresholds,omitempty"`
+	Annotations    []ChartAnnotation `json:"annotations,omitempty"`
+	Warnings       []string          `json:"warnings,omitempty"`
+	Error          string            `json:"error,omitempty"`
+	UpdatedAt      time.Time         `json:"updatedAt"`
+}
+
+type ChartAnnotationType string
+
+const (
+	ChartAnnotationType_DEPLOYMENT      ChartAnnotationType = "DEPLOYMENT"
+	ChartAnnotationType_STACK_OPERATION ChartAnnotationType = "STACK_OPERATION"
+	ChartAnnotationType_ALERT           ChartAnnotationType = "ALERT"
+)
+
+// ChartAnnotation 은 chart 위에 표시할 배포, 스택 작업, critical 알림 이벤트이다. Timestamp 는 xAxis 와 같은 unix 초이다.
+type ChartAnnotation struct {
+	Type        ChartAnnotationType `json:"type"`
+	Timestamp   int64               `json:"timestamp"`
+	ClusterId   string              `json:"clusterId,omitempty"`
+	Title       string              `json:"title"`
+	Description string              `json:"description,omitempty"`
+	Severity    string              `json:"severity,omitempty"`
+	Status      string              `json:"status,omitempty"`
+	ReferenceId string              `json:"referenceId"`
 }
 
 type GetDashboardChartsResponse struct {
@@ -114,35 +252,268 @@ type GetDashboardChartResponse struct {
 	Chart DashboardChartResponse `json:"chart"`
 }
 
+// ChartThreshold 는 사용자 정의 chart 에 표시할 기준선이다. Value 는 chart 의 단위(prefix 적용 전) 기준이다.
+type ChartThreshold struct {
+	Value float64 `json:"value"`
+	Color string  `json:"color,omitempty"`
+	Label string  `json:"label,omitempty"`
+}
+
+type CustomChartResponse struct {
+	ID             string             `json:"id"`
+	OrganizationId string             `json:"organizationId"`
+	Name           string             `json:"name"`
+	Description    string             `json:"description"`
+	Query          string             `json:"query"`
+	Unit           ChartUnit          `json:"unit"`
+	Thresholds     []ChartThreshold   `json:"thresholds"`
+	Creator        SimpleUserResponse `json:"creator"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+type CreateCustomChartRequest struct {
+	Name        string           `json:"name" validate:"required,min=1,max=50"`
+	Description string           `json:"description" validate:"max=100"`
+	Query       string           `json:"query" validate:"required,max=2000"`
+	Unit        ChartUnit        `json:"unit" validate:"omitempty,oneof=percent bytes count rate"`
+	Thresholds  []ChartThreshold `json:"thresholds"`
+}
+
+type CreateCustomChartResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateCustomChartRequest struct {
+	Name        string           `json:"name" validate:"required,min=1,max=50"`
+	Description string           `json:"description" validate:"max=100"`
+	Query       string           `json:"query" validate:"required,max=2000"`
+	Unit        ChartUnit        `json:"unit" validate:"omitempty,oneof=percent bytes count rate"`
+	Thresholds  []ChartThreshold `json:"thresholds"`
+}
+
+type GetCustomChartsResponse struct {
+	CustomCharts []CustomChartResponse `json:"customCharts"`
+	Pagination   PaginationResponse    `json:"pagination"`
+}
+
+type GetCustomChartResponse struct {
+	CustomChart CustomChartResponse `json:"customChart"`
+}
+
+type DashboardChartStreamMessageType string
+
+const (
+	DashboardChartStreamMessageType_SNAPSHOT DashboardChartStreamMessageType = "SNAPSHOT"
+	DashboardChartStreamMessageType_UPDATE   DashboardChartStreamMessageType = "UPDATE"
+	DashboardChartStreamMessageType_ERROR    DashboardChartStreamMessageType = "ERROR"
+)
+
+// DashboardChartStreamMessage 는 dashboard stream 으로 전달되는 메시지이다.
+// SNAPSHOT 은 전체 구간의 chart 이고, UPDATE 는 이전 메시지 이후에 추가된 x 축과 series 값만 담는다.
+type DashboardChartStreamMessage struct {
+	Type   DashboardChartStreamMessageType `json:"type"`
+	Charts []DashboardChartResponse        `json:"charts,omitempty"`
+	Error  string                          `json:"error,omitempty"`
+}
+
+// 내부. 표시 형식이 적용되지 않은 원본 수치이다.
 type DashboardResource struct {
+	NormalStackCount   int
+	AbnormalStackCount int
+	CpuCores           int64
+	MemoryBytes        int64
+	StorageBytes       int64
+	MonthlyCosts       map[string]float64 // currency -> estimated monthly cost
+	Errors             map[string]string  // widget(stack, cpu, memory, storage, cost) -> error message
+}
+
+type DashboardResourceResponse struct {
 	Stack struct {
 		Normal   string `json:"normal"`
 		Abnormal string `json:"abnormal"`
 	} `json:"stack"`
-	Cpu     string `json:"cpu"`
-	Memory  string `json:"memory"`
-	Storage string `json:"storage"`
+	Cpu     string            `json:"cpu"`
+	Memory  string            `json:"memory"`
+	Storage string            `json:"storage"`
+	Cost    map[string]string `json:"cost,omitempty"`   // currency -> estimated monthly cost
+	Errors  map[string]string `json:"errors,omitempty"` // widget(stack, cpu, memory, storage, cost) -> error message
 }
 
 type GetDashboardResourcesResponse struct {
-	Resources DashboardResource `json:"resources"`
+	Resources DashboardResourceResponse `json:"resources"`
+}
+
+type DashboardStackCountResponse struct {
+	Normal   int `json:"normal"`
+	Abnormal int `json:"abnormal"`
+	Total    int `json:"total"`
+}
+
+// DashboardResourceV2Response 는 자원 현황을 원본 수치로 반환한다. Units 는 field 이름 별 단위이다.
+type DashboardResourceV2Response struct {
+	StackCount   DashboardStackCountResponse `json:"stackCount"`
+	CpuCores     int64                       `json:"cpuCores"`
+	MemoryBytes  int64                       `json:"memoryBytes"`
+	StorageBytes int64                       `json:"storageBytes"`
+	MonthlyCosts map[string]float64          `json:"monthlyCosts,omitempty"` // currency -> estimated monthly cost
+	Units        map[string]ChartUnit        `json:"units"`
+	Errors       map[string]string           `json:"errors,omitempty"` // widget(stack, cpu, memory, storage, cost) -> error message
+}
+
+type GetDashboardResourcesV2Response struct {
+	Resources DashboardResourceV2Response `json:"resources"`
 }
 
 type DashboardStackResponse struct {
-	ID          StackId   `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Status      string    `json:"status"`
-	StatusDesc  string    `json:"statusDesc"`
-	Cpu         string    `json:"cpu"`
-	Memory      string    `json:"memory"`
-	Storage     string    `json:"storage"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID           StackId             `json:"id"`
+	Name         string              `json:"name"`
+	Description  string              `json:"description"`
+	CloudService string              `json:"cloudService"`
+	Status       string              `json:"status"`
+	StatusDesc   string              `json:"statusDesc"`
+	Cpu          string              `json:"cpu"`
+	Memory       string              `json:"memory"`
+	Storage      string              `json:"storage"`
+	Alerts       DashboardStackAlert `json:"alerts"`
+	CreatedAt    time.Time           `json:"createdAt"`
+	UpdatedAt    time.Time           `json:"updatedAt"`
+
+	UnderMaintenance bool                  `json:"underMaintenance"`
+	MaintenanceEndAt *time.Time            `json:"maintenanceEndAt,omitempty"`
+	VersionAdvisory  *StackVersionAdvisory `json:"versionAdvisory,omitempty"`
 }
 
 type GetDashboardStacksResponse struct {
-	Stacks []DashboardStackResponse `json:"stacks"`
+	Stacks     []DashboardStackResponse `json:"stacks"`
+	Pagination PaginationResponse       `json:"pagination"`
+}
+
+type DashboardNode struct {
+	Name     string
+	Instance string
+	Cpu      string
+	Memory   string
+	Disk     string
+}
+
+type DashboardNodeResponse struct {
+	Name     string `json:"name"`
+	Instance string `json:"instance"`
+	Cpu      string `json:"cpu"`
+	Memory   string `json:"memory"`
+	Disk     string `json:"disk"`
+}
+
+type GetDashboardStackNodesResponse struct {
+	Nodes []DashboardNodeResponse `json:"nodes"`
+}
+
+type DashboardStorage struct {
+	ClusterId     ClusterId
+	ClusterName   string
+	Namespace     string
+	Name          string
+	StorageClass  string
+	Status        string
+	Capacity      int64
+	Used          int64
+	FillRate      float64
+	Growth        int64
+	GrowthRate    float64
+	OverThreshold bool
+}
+
+type DashboardStorageResponse struct {
+	ClusterId     ClusterId `json:"clusterId"`
+	ClusterName   string    `json:"clusterName"`
+	Namespace     string    `json:"namespace"`
+	Name          string    `json:"name"`
+	StorageClass  string    `json:"storageClass"`
+	Status        string    `json:"status"`
+	Capacity      int64     `json:"capacity"`
+	Used          int64     `json:"used"`
+	FillRate      float64   `json:"fillRate"`
+	Growth        int64     `json:"growth"`
+	GrowthRate    float64   `json:"growthRate"`
+	OverThreshold bool      `json:"overThreshold"`
+}
+
+type GetDashboardStoragesResponse struct {
+	Threshold float64                    `json:"threshold"`
+	Storages  []DashboardStorageResponse `json:"storages"`
+}
+
+// 네임스페이스의 NetworkPolicy 적용 상태
+const (
+	NetworkPolicyStatus_OPEN       = "OPEN"       // NetworkPolicy 가 없음
+	NetworkPolicyStatus_PARTIAL    = "PARTIAL"    // 일부 Pod 에만 NetworkPolicy 가 적용됨
+	NetworkPolicyStatus_RESTRICTED = "RESTRICTED" // 모든 Pod 의 ingress 가 NetworkPolicy 로 제한됨
+)
+
+type DashboardNetworkPolicy struct {
+	ClusterId   ClusterId
+	ClusterName string
+	Namespace   string
+	Status      string
+	PolicyCount int
+	Policies    []string
+}
+
+type DashboardNetworkPolicyResponse struct {
+	ClusterId   ClusterId `json:"clusterId"`
+	ClusterName string    `json:"clusterName"`
+	Namespace   string    `json:"namespace"`
+	Status      string    `json:"status"`
+	PolicyCount int       `json:"policyCount"`
+	Policies    []string  `json:"policies"`
+}
+
+type DashboardNetworkPolicySummary struct {
+	Total      int `json:"total"`
+	Open       int `json:"open"`
+	Partial    int `json:"partial"`
+	Restricted int `json:"restricted"`
+}
+
+type GetDashboardNetworkPoliciesResponse struct {
+	Summary    DashboardNetworkPolicySummary    `json:"summary"`
+	Namespaces []DashboardNetworkPolicyResponse `json:"namespaces"`
+}
+
+// 조직의 keycloak realm 상태
+const (
+	IdentityStatus_HEALTHY  = "HEALTHY"  // 모든 점검에 성공함
+	IdentityStatus_DEGRADED = "DEGRADED" // token 발급이 느리거나 사용자 상태를 조회하지 못함
+	IdentityStatus_DOWN     = "DOWN"     // realm 에 접근할 수 없거나 token 을 발급하지 못함
+)
+
+type DashboardIdentityStatus struct {
+	Status               string
+	Reachable            bool
+	RealmLatency         int64
+	TokenIssued          bool
+	TokenIssuanceLatency int64
+	LockedAccounts       int
+	LockedAccountIds     []string
+	PendingInvitations   int
+	PendingInvitationIds []string
+	Errors               []string
+	CheckedAt            time.Time
+}
+
+type GetDashboardIdentityStatusResponse struct {
+	Status               string    `json:"status"`
+	Reachable            bool      `json:"reachable"`
+	RealmLatency         int64     `json:"realmLatency"` // ms
+	TokenIssued          bool      `json:"tokenIssued"`
+	TokenIssuanceLatency int64     `json:"tokenIssuanceLatency"` // ms
+	LockedAccounts       int       `json:"lockedAccounts"`
+	LockedAccountIds     []string  `json:"lockedAccountIds"`
+	PendingInvitations   int       `json:"pendingInvitations"`
+	PendingInvitationIds []string  `json:"pendingInvitationIds"`
+	Errors               []string  `json:"errors,omitempty"`
+	CheckedAt            time.Time `json:"checkedAt"`
 }
 
 type WidgetResponse struct {
@@ -161,7 +532,7 @@ type DashboardContents struct {
 }
 
 type CreateDashboardRequest struct {
-	DashboardKey string              `json:"dashboardKey"`
+	DashboardKey string              `json:"dashboardKey" audit:"name"`
 	Contents     []DashboardContents `json:"contents"`
 }
 
@@ -236,6 +607,57 @@ type GetDashboardPolicyViolationTop5Response struct {
 	GetDashboardPolicyViolationResponse
 }
 
+const (
+	PolicyEngine_GATEKEEPER = "gatekeeper"
+	PolicyEngine_KYVERNO    = "kyverno"
+)
+
+// 정책 위반의 조치. kyverno 의 enforce 는 deny, audit 는 dryrun 으로 분류한다.
+const (
+	PolicyEnforcementAction_DENY   = "deny"
+	PolicyEnforcementAction_WARN   = "warn"
+	PolicyEnforcementAction_DRYRUN = "dryrun"
+)
+
+type DashboardPolicyEnforcementClusterCount struct {
+	ClusterId   string `json:"clusterId"`
+	ClusterName string `json:"clusterName"`
+	Count       int    `json:"count"`
+}
+
+// DashboardPolicyEnforcementPolicy 는 정책 하나의 클러스터별 위반 수이다.
+// gatekeeper 는 마지막 audit 의 위반 수이고, kyverno 는 조회 기간 동안 실패한 정책 검사 수이다.
+type DashboardPolicyEnforcementPolicy struct {
+	Engine            string                                   `json:"engine"`
+	Kind              string                                   `json:"kind,omitempty"`
+	Name              string                                   `json:"name"`
+	EnforcementAction string                                   `json:"enforcementAction"`
+	Total             int                                      `json:"total"`
+	Clusters          []DashboardPolicyEnforcementClusterCount `json:"clusters"`
+}
+
+// DashboardPolicyEnforcementCluster 는 클러스터 하나의 조치별 위반 수와 xAxis 시점별 위반 수 추이이다.
+type DashboardPolicyEnforcementCluster struct {
+	ClusterId   string `json:"clusterId"`
+	ClusterName string `json:"clusterName"`
+	Total       int    `json:"total"`
+	Deny        int    `json:"deny"`
+	Warn        int    `json:"warn"`
+	Dryrun      int    `json:"dryrun"`
+	Trend       []int  `json:"trend"`
+}
+
+type GetDashboardPolicyEnforcementStatusResponse struct {
+	OrganizationId string                              `json:"organizationId"`
+	Duration       string                              `json:"duration"`
+	Interval       string                              `json:"interval"`
+	XAxis          *Axis                               `json:"xAxis,omitempty"`
+	Clusters       []DashboardPolicyEnforcementCluster `json:"clusters"`
+	Policies       []DashboardPolicyEnforcementPolicy  `json:"policies"`
+	Warnings       []string                            `json:"warnings,omitempty"`
+	UpdatedAt      time.Time                           `json:"updatedAt"`
+}
+
 type BarChart struct {
 	ChartType      string `json:"chartType"`
 	OrganizationId string `json:"organizationId"`
@@ -254,3 +676,138 @@ type UnitNumber struct {
 	Name string `json:"name"`
 	Data []int  `json:"data"`
 }
+
+type CreateChartSnapshotRequest struct {
+	ChartType     string           `json:"chartType" validate:"required_without=CustomChartId"`
+	CustomChartId string           `json:"customChartId" validate:"omitempty,uuid"`
+	Title         string           `json:"title" validate:"max=100"`
+	Duration      string           `json:"duration"`
+	Interval      string           `json:"interval"`
+	Aggregation   ChartAggregation `json:"aggregation" validate:"omitempty,oneof=avg max min p95"`
+	Timezone      string           `json:"timezone"`
+	ExpiresIn     int              `json:"expiresIn" validate:"omitempty,min=1,max=720"` // hours. default 24
+}
+
+type CreateChartSnapshotResponse struct {
+	ID         string    `json:"id"`
+	ShareToken string    `json:"shareToken"`
+	ExpiredAt  time.Time `json:"expiredAt"`
+}
+
+type ChartSnapshotResponse struct {
+	ID             string             `json:"id"`
+	OrganizationId string             `json:"organizationId"`
+	ChartType      string             `json:"chartType"`
+	Title          string             `json:"title"`
+	ExpiredAt      time.Time          `json:"expiredAt"`
+	Creator        SimpleUserResponse `json:"creator"`
+	CreatedAt      time.Time          `json:"createdAt"`
+}
+
+type GetChartSnapshotsResponse struct {
+	ChartSnapshots []ChartSnapshotResponse `json:"chartSnapshots"`
+	Pagination     PaginationResponse      `json:"pagination"`
+}
+
+type GetSharedChartSnapshotResponse struct {
+	Title     string                 `json:"title"`
+	Chart     DashboardChartResponse `json:"chart"`
+	CreatedAt time.Time              `json:"createdAt"`
+	ExpiredAt time.Time              `json:"expiredAt"`
+}
+
+type DashboardCacheWarmup struct {
+	OrganizationId string
+	ThanosReady    bool
+	Clusters       int
+	Charts         int
+	Errors         []string
+	Elapsed        time.Duration
+}
+
+type DashboardCacheWarmupResponse struct {
+	OrganizationId string   `json:"organizationId"`
+	ThanosReady    bool     `json:"thanosReady"`
+	Clusters       int      `json:"clusters"`
+	Charts         int      `json:"charts"`
+	Errors         []string `json:"errors,omitempty"`
+	Elapsed        int64    `json:"elapsed"` // ms
+}
+
+type WarmupDashboardCachesRequest struct {
+	OrganizationId string `json:"organizationId,omitempty"`
+}
+
+type WarmupDashboardCachesResponse struct {
+	Organizations []DashboardCacheWarmupResponse `json:"organizations"`
+}
+
+type DashboardHealthStatus string
+
+const (
+	DashboardHealthStatus_HEALTHY   DashboardHealthStatus = "HEALTHY"
+	DashboardHealthStatus_DEGRADED  DashboardHealthStatus = "DEGRADED"
+	DashboardHealthStatus_UNHEALTHY DashboardHealthStatus = "UNHEALTHY"
+	DashboardHealthStatus_SKIPPED   DashboardHealthStatus = "SKIPPED"
+)
+
+// 대시보드 상태 점검 항목이다. 앞 항목이 실패하면 뒤 항목은 점검하지 못할 수 있다.
+const (
+	DashboardHealthComponent_PRIMARY_CLUSTER = "PRIMARY_CLUSTER"
+	DashboardHealthComponent_THANOS_SERVICE  = "THANOS_SERVICE"
+	DashboardHealthComponent_THANOS_QUERY    = "THANOS_QUERY"
+)
+
+type DashboardHealthComponent struct {
+	Name      string                `json:"name"`
+	Status    DashboardHealthStatus `json:"status"`
+	Message   string                `json:"message,omitempty"`
+	LatencyMs int64                 `json:"latencyMs"`
+}
+
+// GetDashboardHealthResponse 의 Status 는 thanos 조회가 실패하면 UNHEALTHY, 조회는 되지만 다른 항목이 실패하면 DEGRADED 이다.
+type GetDashboardHealthResponse struct {
+	Status     DashboardHealthStatus      `json:"status"`
+	Components []DashboardHealthComponent `json:"components"`
+	CheckedAt  time.Time                  `json:"checkedAt"`
+}
+
+// DashboardClusterEventAlert 는 cluster event 와 같은 대상에서 비슷한 시각에 발생한 시스템 알림이다.
+type DashboardClusterEventAlert struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Severity     string    `json:"severity"`
+	Status       string    `json:"status"`
+	MessageTitle string    `json:"messageTitle"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// DashboardClusterEvent 는 같은 대상에서 같은 사유로 반복된 Warning event 를 하나로 묶은 것이다.
+type DashboardClusterEvent struct {
+	ClusterId   string                       `json:"clusterId"`
+	ClusterName string                       `json:"clusterName"`
+	Namespace   string                       `json:"namespace"`
+	Kind        string                       `json:"kind"`
+	Name        string                       `json:"name"`
+	Reason      string                       `json:"reason"`
+	Message     string                       `json:"message"`
+	Source      string                       `json:"source"`
+	Count       int32                        `json:"count"`
+	FirstAt     time.Time                    `json:"firstAt"`
+	LastAt      time.Time                    `json:"lastAt"`
+	Alerts      []DashboardClusterEventAlert `json:"alerts"`
+}
+
+// DashboardClusterEventSource 는 cluster 별 조회 결과이다. 조회에 실패한 cluster 는 Error 에 사유를 담는다.
+type DashboardClusterEventSource struct {
+	ClusterId   string `json:"clusterId"`
+	ClusterName string `json:"clusterName"`
+	Count       int    `json:"count"`
+	Error       string `json:"error,omitempty"`
+}
+
+type GetDashboardClusterEventsResponse struct {
+	Events     []DashboardClusterEvent       `json:"events"`
+	Clusters   []DashboardClusterEventSource `json:"clusters"`
+	Pagination PaginationResponse            `json:"pagination"`
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"time"
+)
+
+// enum
+type DeploymentApprovalAction int32
+
+const (
+	DeploymentApprovalAction_DEPLOY DeploymentApprovalAction = iota
+	DeploymentApprovalAction_PROMOTE
+	DeploymentApprovalAction_ERROR
+)
+
+var deploymentApprovalAction = [...]string{
+	"DEPLOY",
+	"PROMOTE",
+	"ERROR",
+}
+
+func (m DeploymentApprovalAction) String() string { return deploymentApprovalAction[(m)] }
+func (m DeploymentApprovalAction) FromString(s string) DeploymentApprovalAction {
+	for i, v := range deploymentApprovalAction {
+		if v == s {
+			return DeploymentApprovalAction(i)
+		}
+	}
+	return DeploymentApprovalAction_ERROR
+}
+
+// enum
+type DeploymentApprovalStatus int32
+
+const (
+	DeploymentApprovalStatus_PENDING DeploymentApprovalStatus = iota
+	DeploymentApprovalStatus_APPROVED
+	DeploymentApprovalStatus_REJECTED
+)
+
+var deploymentApprovalStatus = [...]string{
+	"PENDING",
+	"APPROVED",
+	"REJECTED",
+}
+
+func (m DeploymentApprovalStatus) String() string { return deploymentApprovalStatus[(m)] }
+func (m DeploymentApprovalStatus) FromString(s string) DeploymentApprovalStatus {
+	for i, v := range deploymentApprovalStatus {
+		if v == s {
+			return DeploymentApprovalStatus(i)
+		}
+	}
+	return DeploymentApprovalStatus_PENDING
+}
+
+type DeploymentApprovalPolicyResponse struct {
+	ClusterId      ClusterId            `json:"clusterId"`
+	OrganizationId string               `json:"organizationId"`
+	Approvers      []SimpleUserResponse `json:"approvers"`
+	CreatedAt      time.Time            `json:"createdAt"`
+	UpdatedAt      time.Time            `json:"updatedAt"`
+}
+
+type GetDeploymentApprovalPolicyResponse struct {
+	Policy DeploymentApprovalPolicyResponse `json:"policy"`
+}
+
+// UpdateDeploymentApprovalPolicyRequest 의 approver 가 지정된 클러스터는 production 으로 간주되어 배포 시 승인이 필요하다.
+type UpdateDeploymentApprovalPolicyRequest struct {
+	ApproverIds []string `json:"approverIds" validate:"required,min=1"`
+}
+
+type DeploymentApprovalResponse struct {
+	ID                string                    `json:"id"`
+	OrganizationId    string                    `json:"organizationId"`
+	AppServeApp       SimpleAppServeAppResponse `json:"appServeApp"`
+	AppServeAppTaskId string                    `json:"appServeAppTaskId"`
+	ClusterId         ClusterId                 `json:"clusterId"`
+	Action            string                    `json:"action"`
+	Status            string                    `json:"status"`
+	Comment           string                    `json:"comment"`
+	Requester         SimpleUserResponse        `json:"requester"`
+	Approver          SimpleUserResponse        `json:"approver"`
+	DecidedAt         *time.Time                `json:"decidedAt"`
+	CreatedAt         time.Time                 `json:"createdAt"`
+	UpdatedAt         time.Time                 `json:"updatedAt"`
+}
+
+type SimpleAppServeAppResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	ProjectId string `json:"projectId"`
+	Namespace string `json:"namespace"`
+}
+
+type DecideDeploymentApprovalRequest struct {
+	Comment string `json:"comment"`
+}
+
+type GetDeploymentApprovalsResponse struct {
+	Approvals  []DeploymentApprovalResponse `json:"approvals"`
+	Pagination PaginationResponse           `json:"pagination"`
+}
+
+type GetDeploymentApprovalResponse struct {
+	Approval DeploymentApprovalResponse `json:"approval"`
+}
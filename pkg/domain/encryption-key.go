@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"time"
+)
+
+type EncryptionKeyStatus string
+
+const (
+	EncryptionKeyStatus_ACTIVE  EncryptionKeyStatus = "ACTIVE"
+	EncryptionKeyStatus_RETIRED EncryptionKeyStatus = "RETIRED"
+	EncryptionKeyStatus_REVOKED EncryptionKeyStatus = "REVOKED"
+)
+
+const EncryptionKeyProvider_AWS_KMS = "AWS_KMS"
+
+type EncryptionKeyResponse struct {
+	ID          string              `json:"id"`
+	Provider    string              `json:"provider"`
+	KeyArn      string              `json:"keyArn"`
+	Status      EncryptionKeyStatus `json:"status"`
+	Description string              `json:"description"`
+	RetiredAt   *time.Time          `json:"retiredAt,omitempty"`
+	RevokedAt   *time.Time          `json:"revokedAt,omitempty"`
+	Creator     SimpleUserResponse  `json:"creator"`
+	CreatedAt   time.Time           `json:"createdAt"`
+}
+
+type GetEncryptionKeysResponse struct {
+	EncryptionKeys []EncryptionKeyResponse `json:"encryptionKeys"`
+}
+
+type CreateEncryptionKeyRequest struct {
+	KeyArn      string `json:"keyArn" validate:"required" audit:"name"`
+	Description string `json:"description"`
+}
+
+type CreateEncryptionKeyResponse struct {
+	ID string `json:"id"`
+}
+
+type RotateEncryptionKeyRequest struct {
+	KeyArn      string `json:"keyArn" validate:"required"`
+	Description string `json:"description"`
+}
+
+type RotateEncryptionKeyResponse struct {
+	ID            string `json:"id"`
+	SealedSecrets int    `json:"sealedSecrets"`
+	FailedSecrets int    `json:"failedSecrets"`
+}
@@ -0,0 +1,12 @@
+package domain
+
+type ErrorCodeResponse struct {
+	Code     string `json:"code"`
+	Category string `json:"category"`
+	Status   int    `json:"status"`
+	Text     string `json:"text"`
+}
+
+type GetErrorCodesResponse struct {
+	ErrorCodes []ErrorCodeResponse `json:"errorCodes"`
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"time"
+)
+
+// EscalationStep 은 앨럿이 발생한 뒤 afterMinutes 가 지나도록 확인(INPROGRESS, CLOSED)되지 않으면 대상에게 다시 알린다.
+// afterMinutes 는 앨럿 발생 시각을 기준으로 하며 이전 단계보다 커야 한다.
+type EscalationStep struct {
+	AfterMinutes    int      `json:"afterMinutes" validate:"required,min=1"`
+	AlertChannelIds []string `json:"alertChannelIds,omitempty" validate:"dive,uuid"`
+	TargetUserIds   []string `json:"targetUserIds,omitempty" validate:"dive,uuid"`
+	TargetRoleIds   []string `json:"targetRoleIds,omitempty"`
+}
+
+func (m EscalationStep) IsEmpty() bool {
+	return len(m.AlertChannelIds) == 0 && len(m.TargetUserIds) == 0 && len(m.TargetRoleIds) == 0
+}
+
+type EscalationPolicyResponse struct {
+	ID             string             `json:"id"`
+	OrganizationId string             `json:"organizationId"`
+	Name           string             `json:"name"`
+	Description    string             `json:"description"`
+	Enabled        bool               `json:"enabled"`
+	Severities     []string           `json:"severities"`
+	Steps          []EscalationStep   `json:"steps"`
+	Creator        SimpleUserResponse `json:"creator"`
+	Updator        SimpleUserResponse `json:"updator"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+type GetEscalationPoliciesResponse struct {
+	EscalationPolicies []EscalationPolicyResponse `json:"escalationPolicies"`
+	Pagination         PaginationResponse         `json:"pagination"`
+}
+
+type GetEscalationPolicyResponse struct {
+	EscalationPolicy EscalationPolicyResponse `json:"escalationPolicy"`
+}
+
+// CreateEscalationPolicyRequest 의 severities 가 비어 있으면 모든 severity 의 앨럿에 적용한다.
+type CreateEscalationPolicyRequest struct {
+	Name        string           `json:"name" validate:"required,name"`
+	Description string           `json:"description"`
+	Enabled     bool             `json:"enabled"`
+	Severities  []string         `json:"severities"`
+	Steps       []EscalationStep `json:"steps" validate:"required,min=1,dive"`
+}
+
+type CreateEscalationPolicyResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateEscalationPolicyRequest struct {
+	Name        string           `json:"name" validate:"required,name"`
+	Description string           `json:"description"`
+	Enabled     bool             `json:"enabled"`
+	Severities  []string         `json:"severities"`
+	Steps       []EscalationStep `json:"steps" validate:"required,min=1,dive"`
+}
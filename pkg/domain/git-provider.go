@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	GitProviderType_GITHUB = "GITHUB"
+	GitProviderType_GITLAB = "GITLAB"
+)
+
+type GitProviderResponse struct {
+	ID             string             `json:"id"`
+	OrganizationId string             `json:"organizationId"`
+	Name           string             `json:"name"`
+	Type           string             `json:"type"`
+	Url            string             `json:"url"`
+	Description    string             `json:"description"`
+	Creator        SimpleUserResponse `json:"creator"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+type GetGitProvidersResponse struct {
+	GitProviders []GitProviderResponse `json:"gitProviders"`
+	Pagination   PaginationResponse    `json:"pagination"`
+}
+
+type GetGitProviderResponse struct {
+	GitProvider GitProviderResponse `json:"gitProvider"`
+}
+
+// CreateGitProviderRequest 의 url 은 GitHub, GitLab 의 web 주소이다. 비어 있으면 github.com, gitlab.com 을 사용한다.
+// token 은 repository 를 읽을 수 있는 권한이 있어야 하며, 등록할 때 provider 에 인증해 확인한다.
+type CreateGitProviderRequest struct {
+	Name        string `json:"name" validate:"required,name"`
+	Type        string `json:"type" validate:"required,oneof=GITHUB GITLAB" enums:"GITHUB,GITLAB"`
+	Url         string `json:"url" validate:"omitempty,url"`
+	Token       string `json:"token" validate:"required"`
+	Description string `json:"description" validate:"max=100"`
+}
+
+type CreateGitProviderResponse struct {
+	ID string `json:"id"`
+}
+
+// UpdateGitProviderRequest 의 token 이 비어 있으면 기존 token 을 유지한다.
+type UpdateGitProviderRequest struct {
+	Token       string `json:"token"`
+	Description string `json:"description" validate:"max=100"`
+}
+
+// AppServeAppGitSourceResponse 의 webhookUrl 은 provider 의 push webhook 으로 등록할 주소이다.
+type AppServeAppGitSourceResponse struct {
+	AppServeAppId   string     `json:"appServeAppId"`
+	GitProviderId   string     `json:"gitProviderId"`
+	GitProviderName string     `json:"gitProviderName"`
+	GitProviderType string     `json:"gitProviderType"`
+	Repository      string     `json:"repository"`
+	Branch          string     `json:"branch"`
+	AutoDeploy      bool       `json:"autoDeploy"`
+	WebhookUrl      string     `json:"webhookUrl"`
+	LastCommit      string     `json:"lastCommit"`
+	LastTriggeredAt *time.Time `json:"lastTriggeredAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+type GetAppServeAppGitSourceResponse struct {
+	GitSource AppServeAppGitSourceResponse `json:"gitSource"`
+}
+
+// UpdateAppServeAppGitSourceRequest 의 repository 는 "owner/repo" 형식의 경로이다.
+// webhook secret 은 처음 설정할 때와 rotateWebhookSecret 이 true 일 때만 새로 만든다.
+type UpdateAppServeAppGitSourceRequest struct {
+	GitProviderId       string `json:"gitProviderId" validate:"required"`
+	Repository          string `json:"repository" validate:"required"`
+	Branch              string `json:"branch" validate:"required"`
+	AutoDeploy          bool   `json:"autoDeploy"`
+	RotateWebhookSecret bool   `json:"rotateWebhookSecret"`
+}
+
+// UpdateAppServeAppGitSourceResponse 의 webhookSecret 은 새로 만들었을 때만 반환하며 다시 조회할 수 없다.
+type UpdateAppServeAppGitSourceResponse struct {
+	GitSource     AppServeAppGitSourceResponse `json:"gitSource"`
+	WebhookSecret string                       `json:"webhookSecret,omitempty"`
+}
+
+// BuildAppServeAppRequest 의 branch 가 비어 있으면 설정한 branch 를, commit 이 비어 있으면 branch 의 최신 commit 을 빌드한다.
+type BuildAppServeAppRequest struct {
+	Branch string `json:"branch"`
+	Commit string `json:"commit"`
+}
+
+type BuildAppServeAppResponse struct {
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+	Message string `json:"message"`
+}
+
+// GitWebhookRequest 는 provider 가 보낸 webhook 의 header 와 body 이다.
+// GitHub 은 Signature(X-Hub-Signature-256)로, GitLab 은 Token(X-Gitlab-Token)으로 검증한다.
+type GitWebhookRequest struct {
+	Event     string
+	Signature string
+	Token     string
+	Payload   []byte
+}
+
+type GitWebhookResponse struct {
+	Triggered bool   `json:"triggered"`
+	Message   string `json:"message"`
+}
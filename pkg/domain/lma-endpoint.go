@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"time"
+)
+
+type LmaEndpointStatus string
+
+const (
+	LmaEndpointStatus_UNKNOWN   LmaEndpointStatus = "UNKNOWN"
+	LmaEndpointStatus_HEALTHY   LmaEndpointStatus = "HEALTHY"
+	LmaEndpointStatus_UNHEALTHY LmaEndpointStatus = "UNHEALTHY"
+)
+
+// LmaEndpoint 는 primary cluster 의 LMA 와 조직에 등록된 보조 LMA endpoint 의 상태를 함께 나타낸다.
+type LmaEndpoint struct {
+	ID            string
+	Url           string
+	Priority      int
+	Description   string
+	Primary       bool
+	Active        bool
+	Status        LmaEndpointStatus
+	LastError     string
+	LastCheckedAt *time.Time
+	// DiscoveryMethod 는 primary cluster 의 LMA 주소를 찾은 방법이다.
+	DiscoveryMethod LmaDiscoveryMethod
+}
+
+type LmaEndpointResponse struct {
+	ID              string             `json:"id"`
+	Url             string             `json:"url"`
+	Priority        int                `json:"priority"`
+	Description     string             `json:"description"`
+	Primary         bool               `json:"primary"`
+	Active          bool               `json:"active"`
+	Status          LmaEndpointStatus  `json:"status"`
+	LastError       string             `json:"lastError,omitempty"`
+	LastCheckedAt   *time.Time         `json:"lastCheckedAt,omitempty"`
+	DiscoveryMethod LmaDiscoveryMethod `json:"discoveryMethod,omitempty"`
+}
+
+type GetLmaEndpointsResponse struct {
+	LmaEndpoints []LmaEndpointResponse `json:"lmaEndpoints"`
+}
+
+type CreateLmaEndpointRequest struct {
+	Url         string `json:"url" validate:"required,url" audit:"name"`
+	Priority    int    `json:"priority" validate:"min=0"`
+	Description string `json:"description"`
+}
+
+type CreateLmaEndpointResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateLmaEndpointRequest struct {
+	Url         string `json:"url" validate:"required,url" audit:"name"`
+	Priority    int    `json:"priority" validate:"min=0"`
+	Description string `json:"description"`
+}
+
+// LmaAuth 는 조직의 LMA 접속 설정이다. password, bearer token 은 설정 여부만 노출한다.
+type LmaAuth struct {
+	OrganizationId     string
+	CaCert             string
+	InsecureSkipVerify bool
+	Username           string
+	HasPassword        bool
+	HasBearerToken     bool
+	Updator            SimpleUserResponse
+	UpdatedAt          *time.Time
+}
+
+type LmaAuthResponse struct {
+	OrganizationId     string             `json:"organizationId"`
+	CaCert             string             `json:"caCert"`
+	InsecureSkipVerify bool               `json:"insecureSkipVerify"`
+	Username           string             `json:"username"`
+	HasPassword        bool               `json:"hasPassword"`
+	HasBearerToken     bool               `json:"hasBearerToken"`
+	Updator            SimpleUserResponse `json:"updator"`
+	UpdatedAt          *time.Time         `json:"updatedAt,omitempty"`
+}
+
+type GetLmaAuthResponse struct {
+	LmaAuth LmaAuthResponse `json:"lmaAuth"`
+}
+
+// UpdateLmaAuthRequest 의 Password, BearerToken 을 생략하면 기존 값을 유지하고, 빈 문자열이면 삭제한다.
+type UpdateLmaAuthRequest struct {
+	CaCert             string  `json:"caCert" validate:"max=65536"`
+	InsecureSkipVerify bool    `json:"insecureSkipVerify"`
+	Username           string  `json:"username" validate:"max=256"`
+	Password           *string `json:"password,omitempty" validate:"omitempty,max=1024"`
+	BearerToken        *string `json:"bearerToken,omitempty" validate:"omitempty,max=8192"`
+}
+
+// LmaDiscoveryMethod 는 primary cluster 에서 LMA(thanos) 주소를 찾는 방법이다.
+// AUTO 는 tks-endpoint-secret, LoadBalancer, Ingress, NodePort, ClusterIP(API server proxy) 순으로 찾는다.
+type LmaDiscoveryMethod string
+
+const (
+	LmaDiscoveryMethod_AUTO          LmaDiscoveryMethod = "AUTO"
+	LmaDiscoveryMethod_LOAD_BALANCER LmaDiscoveryMethod = "LOAD_BALANCER"
+	LmaDiscoveryMethod_INGRESS       LmaDiscoveryMethod = "INGRESS"
+	LmaDiscoveryMethod_NODE_PORT     LmaDiscoveryMethod = "NODE_PORT"
+	LmaDiscoveryMethod_CLUSTER_IP    LmaDiscoveryMethod = "CLUSTER_IP"
+)
+
+// LmaDiscovery 는 조직의 LMA 주소 탐색 설정이다.
+// ServiceName 을 지정하지 않으면 thanos-query-frontend, thanos-query 순으로 찾고, NodeAddressType 을 지정하지 않으면 ExternalIP, InternalIP 순으로 사용한다.
+type LmaDiscovery struct {
+	OrganizationId  string
+	Method          LmaDiscoveryMethod
+	Namespace       string
+	ServiceName     string
+	IngressName     string
+	NodeAddressType string
+	Updator         SimpleUserResponse
+	UpdatedAt       *time.Time
+}
+
+type LmaDiscoveryResponse struct {
+	OrganizationId  string             `json:"organizationId"`
+	Method          LmaDiscoveryMethod `json:"method"`
+	Namespace       string             `json:"namespace"`
+	ServiceName     string             `json:"serviceName"`
+	IngressName     string             `json:"ingressName"`
+	NodeAddressType string             `json:"nodeAddressType"`
+	Updator         SimpleUserResponse `json:"updator"`
+	UpdatedAt       *time.Time         `json:"updatedAt,omitempty"`
+}
+
+type GetLmaDiscoveryResponse struct {
+	LmaDiscovery LmaDiscoveryResponse `json:"lmaDiscovery"`
+}
+
+type UpdateLmaDiscoveryRequest struct {
+	Method          LmaDiscoveryMethod `json:"method" validate:"required,oneof=AUTO LOAD_BALANCER INGRESS NODE_PORT CLUSTER_IP"`
+	Namespace       string             `json:"namespace" validate:"omitempty,max=63"`
+	ServiceName     string             `json:"serviceName" validate:"omitempty,max=63"`
+	IngressName     string             `json:"ingressName" validate:"omitempty,max=253"`
+	NodeAddressType string             `json:"nodeAddressType" validate:"omitempty,oneof=ExternalIP InternalIP"`
+}
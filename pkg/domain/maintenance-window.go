@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"time"
+)
+
+type MaintenanceWindowResponse struct {
+	ID             string                `json:"id"`
+	OrganizationId string                `json:"organizationId"`
+	Cluster        SimpleClusterResponse `json:"cluster"`
+	Name           string                `json:"name"`
+	Description    string                `json:"description"`
+	StartAt        time.Time             `json:"startAt"`
+	EndAt          time.Time             `json:"endAt"`
+	Creator        SimpleUserResponse    `json:"creator"`
+	Updator        SimpleUserResponse    `json:"updator"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+}
+
+type GetMaintenanceWindowsResponse struct {
+	MaintenanceWindows []MaintenanceWindowResponse `json:"maintenanceWindows"`
+	Pagination         PaginationResponse          `json:"pagination"`
+}
+
+type GetMaintenanceWindowResponse struct {
+	MaintenanceWindow MaintenanceWindowResponse `json:"maintenanceWindow"`
+}
+
+// CreateMaintenanceWindowRequest 의 기간 동안 클러스터의 알림은 발송되지 않고, 관리자만 배포할 수 있다.
+type CreateMaintenanceWindowRequest struct {
+	Name        string    `json:"name" validate:"required,name"`
+	Description string    `json:"description"`
+	StartAt     time.Time `json:"startAt" validate:"required"`
+	EndAt       time.Time `json:"endAt" validate:"required,gtfield=StartAt"`
+}
+
+type CreateMaintenanceWindowResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateMaintenanceWindowRequest struct {
+	Name        string    `json:"name" validate:"required,name"`
+	Description string    `json:"description"`
+	StartAt     time.Time `json:"startAt" validate:"required"`
+	EndAt       time.Time `json:"endAt" validate:"required,gtfield=StartAt"`
+}
@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"encoding/json"
+)
+
+const ManifestApiVersion = "tks.openinfradev.github.io/v1"
+
+type ManifestKind string
+
+const (
+	ManifestKind_ORGANIZATION             ManifestKind = "Organization"
+	ManifestKind_ROLE                     ManifestKind = "Role"
+	ManifestKind_STACK_TEMPLATE           ManifestKind = "StackTemplate"
+	ManifestKind_POLICY_TEMPLATE          ManifestKind = "PolicyTemplate"
+	ManifestKind_SYSTEM_NOTIFICATION_RULE ManifestKind = "SystemNotificationRule"
+)
+
+// ManifestKinds 는 apply 시 처리 순서이다. 다른 리소스가 참조하는 리소스를 먼저 반영한다.
+var ManifestKinds = []ManifestKind{
+	ManifestKind_ORGANIZATION,
+	ManifestKind_ROLE,
+	ManifestKind_STACK_TEMPLATE,
+	ManifestKind_POLICY_TEMPLATE,
+	ManifestKind_SYSTEM_NOTIFICATION_RULE,
+}
+
+func (m ManifestKind) Validate() bool {
+	for _, kind := range ManifestKinds {
+		if m == kind {
+			return true
+		}
+	}
+	return false
+}
+
+type ManifestMetadata struct {
+	Name string `yaml:"name" json:"name"`
+}
+
+// Manifest 는 TKS 리소스를 선언적으로 표현한 YAML 문서이다.
+type Manifest struct {
+	ApiVersion string                 `yaml:"apiVersion" json:"apiVersion"`
+	Kind       ManifestKind           `yaml:"kind" json:"kind"`
+	Metadata   ManifestMetadata       `yaml:"metadata" json:"metadata"`
+	Spec       map[string]interface{} `yaml:"spec" json:"spec"`
+}
+
+// NewManifest 는 spec 의 json 필드명을 그대로 manifest 의 spec 으로 사용한다.
+func NewManifest(kind ManifestKind, name string, spec interface{}) (Manifest, error) {
+	m := Manifest{
+		ApiVersion: ManifestApiVersion,
+		Kind:       kind,
+		Metadata:   ManifestMetadata{Name: name},
+	}
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(b, &m.Spec); err != nil {
+		return m, err
+	}
+	return m, nil
+}
+
+// DecodeSpec 은 manifest 의 spec 을 kind 별 spec 구조체로 변환한다.
+func (m Manifest) DecodeSpec(out interface{}) error {
+	b, err := json.Marshal(m.Spec)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+type OrganizationManifestSpec struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type RoleManifestSpec struct {
+	Description string `json:"description,omitempty"`
+}
+
+type StackTemplateManifestSpec struct {
+	Description  string   `json:"description,omitempty"`
+	Version      string   `json:"version,omitempty"`
+	CloudService string   `json:"cloudService,omitempty"`
+	Platform     string   `json:"platform,omitempty"`
+	TemplateType string   `json:"templateType,omitempty"`
+	Template     string   `json:"template,omitempty"`
+	KubeVersion  string   `json:"kubeVersion,omitempty"`
+	KubeType     string   `json:"kubeType,omitempty"`
+	ServiceIds   []string `json:"serviceIds,omitempty"`
+}
+
+type PolicyTemplateManifestSpec struct {
+	Type             string          `json:"type,omitempty"`
+	Kind             string          `json:"kind,omitempty"`
+	Description      string          `json:"description,omitempty"`
+	Severity         string          `json:"severity,omitempty"`
+	Deprecated       bool            `json:"deprecated,omitempty"`
+	Version          string          `json:"version,omitempty"`
+	ParametersSchema []*ParameterDef `json:"parametersSchema,omitempty"`
+	Rego             string          `json:"rego,omitempty"`
+	Libs             []string        `json:"libs,omitempty"`
+}
+
+type SystemNotificationConditionManifestSpec struct {
+	Severity     string                        `json:"severity,omitempty"`
+	Duration     string                        `json:"duration,omitempty"`
+	Parameters   []SystemNotificationParameter `json:"parameters,omitempty"`
+	EnableEmail  bool                          `json:"enableEmail,omitempty"`
+	EnablePortal bool                          `json:"enablePortal,omitempty"`
+}
+
+type SystemNotificationRuleManifestSpec struct {
+	Description                string                                   `json:"description,omitempty"`
+	SystemNotificationTemplate string                                   `json:"systemNotificationTemplate,omitempty"`
+	Condition                  *SystemNotificationConditionManifestSpec `json:"condition,omitempty"`
+	TargetUsers                []string                                 `json:"targetUsers,omitempty"`
+	MessageTitle               string                                   `json:"messageTitle,omitempty"`
+	MessageContent             string                                   `json:"messageContent,omitempty"`
+	MessageActionProposal      string                                   `json:"messageActionProposal,omitempty"`
+}
+
+const (
+	ManifestApplyAction_CREATED   = "CREATED"
+	ManifestApplyAction_UPDATED   = "UPDATED"
+	ManifestApplyAction_UNCHANGED = "UNCHANGED"
+	ManifestApplyAction_FAILED    = "FAILED"
+)
+
+const (
+	ManifestIssueSeverity_ERROR   = "ERROR"
+	ManifestIssueSeverity_WARNING = "WARNING"
+)
+
+const (
+	ManifestIssueType_ID_COLLISION          = "ID_COLLISION"
+	ManifestIssueType_MISSING_REFERENCE     = "MISSING_REFERENCE"
+	ManifestIssueType_VERSION_MISMATCH      = "VERSION_MISMATCH"
+	ManifestIssueType_ORGANIZATION_MISMATCH = "ORGANIZATION_MISMATCH"
+	ManifestIssueType_INVALID_SPEC          = "INVALID_SPEC"
+)
+
+// ManifestIssue 는 manifest 를 대상 환경에 반영할 때 발견된 문제이다. ERROR 가 있는 manifest 는 반영하지 않는다.
+type ManifestIssue struct {
+	Severity string `json:"severity"`
+	Type     string `json:"type"`
+	Message  string `json:"message"`
+}
+
+type ManifestApplyResult struct {
+	Kind    ManifestKind    `json:"kind"`
+	Name    string          `json:"name"`
+	Action  string          `json:"action"`
+	Changes []string        `json:"changes,omitempty"`
+	Issues  []ManifestIssue `json:"issues,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+type ManifestApplySummary struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+	Failed    int `json:"failed"`
+	Warnings  int `json:"warnings"`
+}
+
+func NewManifestApplySummary(results []ManifestApplyResult) (out ManifestApplySummary) {
+	for _, result := range results {
+		switch result.Action {
+		case ManifestApplyAction_CREATED:
+			out.Created++
+		case ManifestApplyAction_UPDATED:
+			out.Updated++
+		case ManifestApplyAction_UNCHANGED:
+			out.Unchanged++
+		case ManifestApplyAction_FAILED:
+			out.Failed++
+		}
+		for _, issue := range result.Issues {
+			if issue.Severity == ManifestIssueSeverity_WARNING {
+				out.Warnings++
+			}
+		}
+	}
+	return out
+}
+
+type ApplyManifestsResponse struct {
+	DryRun  bool                  `json:"dryRun"`
+	Summary ManifestApplySummary  `json:"summary"`
+	Results []ManifestApplyResult `json:"results"`
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+const (
+	NamingPolicyResourceType_STACK   = "STACK"
+	NamingPolicyResourceType_APP     = "APP"
+	NamingPolicyResourceType_PROJECT = "PROJECT"
+)
+
+type NamingPolicyResponse struct {
+	ResourceType  string             `json:"resourceType"`
+	Pattern       string             `json:"pattern"`
+	Prefixes      []string           `json:"prefixes"`
+	ReservedNames []string           `json:"reservedNames"`
+	Updator       SimpleUserResponse `json:"updator"`
+	UpdatedAt     time.Time          `json:"updatedAt"`
+}
+
+type GetNamingPoliciesResponse struct {
+	NamingPolicies []NamingPolicyResponse `json:"namingPolicies"`
+}
+
+// UpdateNamingPolicyRequest 의 pattern 은 RE2 정규식이며 이름 전체와 비교한다.
+// prefixes 가 있으면 이름은 그 중 하나로 시작해야 하고, reservedNames 는 대소문자를 구분하지 않고 사용할 수 없다.
+type UpdateNamingPolicyRequest struct {
+	Pattern       string   `json:"pattern" validate:"max=256"`
+	Prefixes      []string `json:"prefixes" validate:"max=20,dive,required,max=32"`
+	ReservedNames []string `json:"reservedNames" validate:"max=100,dive,required,max=63"`
+}
@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+type NotificationChannel string
+
+const (
+	NotificationChannel_EMAIL NotificationChannel = "EMAIL"
+)
+
+var NotificationChannels = []NotificationChannel{
+	NotificationChannel_EMAIL,
+}
+
+// NotificationDigestMode 는 critical 이 아닌 알림을 바로 보낼지, 모아서 요약으로 보낼지를 나타낸다.
+type NotificationDigestMode string
+
+const (
+	NotificationDigestMode_IMMEDIATE NotificationDigestMode = "IMMEDIATE"
+	NotificationDigestMode_HOURLY    NotificationDigestMode = "HOURLY"
+	NotificationDigestMode_DAILY     NotificationDigestMode = "DAILY"
+)
+
+// Period 는 digest 로 모으는 기간을 반환한다. IMMEDIATE 이면 0 이다.
+func (m NotificationDigestMode) Period() time.Duration {
+	switch m {
+	case NotificationDigestMode_HOURLY:
+		return time.Hour
+	case NotificationDigestMode_DAILY:
+		return 24 * time.Hour
+	}
+	return 0
+}
+
+type NotificationDigestSettingResponse struct {
+	Channel   NotificationChannel    `json:"channel"`
+	Mode      NotificationDigestMode `json:"mode"`
+	UpdatedAt *time.Time             `json:"updatedAt,omitempty"`
+}
+
+type GetNotificationDigestSettingsResponse struct {
+	Settings []NotificationDigestSettingResponse `json:"settings"`
+}
+
+type NotificationDigestSettingRequest struct {
+	Channel NotificationChannel    `json:"channel" validate:"required,oneof=EMAIL"`
+	Mode    NotificationDigestMode `json:"mode" validate:"required,oneof=IMMEDIATE HOURLY DAILY"`
+}
+
+type UpdateNotificationDigestSettingsRequest struct {
+	Settings []NotificationDigestSettingRequest `json:"settings" validate:"required,dive"`
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"time"
+)
+
+type OperationType string
+
+const (
+	OperationType_STACK_CREATE  OperationType = "STACK_CREATE"
+	OperationType_STACK_DELETE  OperationType = "STACK_DELETE"
+	OperationType_STACK_SCALE   OperationType = "STACK_SCALE"
+	OperationType_STACK_UPGRADE OperationType = "STACK_UPGRADE"
+	OperationType_APP_DEPLOY    OperationType = "APP_DEPLOY"
+	OperationType_APP_ROLLBACK  OperationType = "APP_ROLLBACK"
+
+	OperationType_CATALOG_APP_DEPLOY OperationType = "CATALOG_APP_DEPLOY"
+
+	OperationType_APPGROUP_CREATE OperationType = "APPGROUP_CREATE"
+	OperationType_APPGROUP_DELETE OperationType = "APPGROUP_DELETE"
+
+	OperationType_ORGANIZATION_DELETE OperationType = "ORGANIZATION_DELETE"
+)
+
+// Category 는 동시 실행 제한을 함께 적용받는 operation 의 분류이다.
+// 앱그룹 설치/삭제는 스택 생성 workflow 가 이어서 요청하므로 제한하지 않는다.
+func (t OperationType) Category() OperationCategory {
+	switch t {
+	case OperationType_STACK_CREATE, OperationType_STACK_DELETE, OperationType_STACK_SCALE, OperationType_STACK_UPGRADE:
+		return OperationCategory_STACK
+	case OperationType_APP_DEPLOY, OperationType_APP_ROLLBACK, OperationType_CATALOG_APP_DEPLOY:
+		return OperationCategory_APP
+	}
+	return ""
+}
+
+type OperationCategory string
+
+const (
+	OperationCategory_STACK OperationCategory = "STACK"
+	OperationCategory_APP   OperationCategory = "APP"
+)
+
+// Types 는 분류에 속한 operation 종류를 반환한다.
+func (c OperationCategory) Types() []OperationType {
+	switch c {
+	case OperationCategory_STACK:
+		return []OperationType{OperationType_STACK_CREATE, OperationType_STACK_DELETE, OperationType_STACK_SCALE, OperationType_STACK_UPGRADE}
+	case OperationCategory_APP:
+		return []OperationType{OperationType_APP_DEPLOY, OperationType_APP_ROLLBACK, OperationType_CATALOG_APP_DEPLOY}
+	}
+	return nil
+}
+
+type OperationStatus string
+
+const (
+	OperationStatus_PENDING   OperationStatus = "PENDING"
+	OperationStatus_RUNNING   OperationStatus = "RUNNING"
+	OperationStatus_COMPLETED OperationStatus = "COMPLETED"
+	OperationStatus_FAILED    OperationStatus = "FAILED"
+	OperationStatus_CANCELED  OperationStatus = "CANCELED"
+)
+
+type OperationResponse struct {
+	ID             string             `json:"id"`
+	OrganizationId string             `json:"organizationId"`
+	Type           OperationType      `json:"type"`
+	TargetId       string             `json:"targetId"`
+	TargetName     string             `json:"targetName"`
+	WorkflowId     string             `json:"workflowId"`
+	Status         OperationStatus    `json:"status"`
+	StatusDesc     string             `json:"statusDesc"`
+	Progress       string             `json:"progress"`
+	SubmittedAt    *time.Time         `json:"submittedAt,omitempty"`
+	FinishedAt     *time.Time         `json:"finishedAt,omitempty"`
+	Creator        SimpleUserResponse `json:"creator"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+type GetOperationsResponse struct {
+	Operations []OperationResponse `json:"operations"`
+	Pagination PaginationResponse  `json:"pagination"`
+}
+
+type TaskStepResponse struct {
+	Name       string     `json:"name"`
+	Template   string     `json:"template"`
+	Phase      string     `json:"phase"`
+	Message    string     `json:"message,omitempty"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// TaskResponse 는 operation 의 진행 상황이다. ID 는 operation 아이디이다.
+// 실행 중인 task 는 workflow 에서 조회한 step 과 로그를, 끝난 task 는 끝났을 때 저장한 내용을 반환한다.
+type TaskResponse struct {
+	ID             string             `json:"id"`
+	OrganizationId string             `json:"organizationId"`
+	Type           OperationType      `json:"type"`
+	TargetId       string             `json:"targetId"`
+	TargetName     string             `json:"targetName"`
+	WorkflowId     string             `json:"workflowId"`
+	Phase          OperationStatus    `json:"phase"`
+	StatusDesc     string             `json:"statusDesc"`
+	Progress       string             `json:"progress"`
+	Steps          []TaskStepResponse `json:"steps"`
+	Logs           []string           `json:"logs"`
+	Creator        SimpleUserResponse `json:"creator"`
+	SubmittedAt    *time.Time         `json:"submittedAt,omitempty"`
+	FinishedAt     *time.Time         `json:"finishedAt,omitempty"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+type GetTaskResponse struct {
+	Task TaskResponse `json:"task"`
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+type OrganizationBrandingResponse struct {
+	OrganizationId string             `json:"organizationId"`
+	DisplayName    string             `json:"displayName"`
+	LogoUrl        string             `json:"logoUrl"`
+	SupportContact string             `json:"supportContact"`
+	EmailFooter    string             `json:"emailFooter"`
+	Updator        SimpleUserResponse `json:"updator"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+type GetOrganizationBrandingResponse struct {
+	Branding OrganizationBrandingResponse `json:"branding"`
+}
+
+// UpdateOrganizationBrandingRequest 의 비어 있는 항목은 기본 브랜드를 사용한다.
+type UpdateOrganizationBrandingRequest struct {
+	DisplayName    string `json:"displayName" validate:"max=64"`
+	LogoUrl        string `json:"logoUrl" validate:"omitempty,url,startswith=https://,max=2048"`
+	SupportContact string `json:"supportContact" validate:"max=256"`
+	EmailFooter    string `json:"emailFooter" validate:"max=2000"`
+}
+
+// PublicOrganizationBrandingResponse 는 로그인, 초대 페이지에서 인증 없이 조회하는 브랜드 정보이다.
+type PublicOrganizationBrandingResponse struct {
+	OrganizationId string `json:"organizationId"`
+	DisplayName    string `json:"displayName"`
+	LogoUrl        string `json:"logoUrl"`
+	SupportContact string `json:"supportContact"`
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+)
+
+// OrganizationQuotaUsage 는 조직이 사용 중인 자원이다.
+// 노드의 cpu, memory 는 스택의 노드 수와 instance type 으로 계산하며, instance type 을 알 수 없는 노드는 포함하지 않는다.
+type OrganizationQuotaUsage struct {
+	Stacks    int `json:"stacks"`
+	Nodes     int `json:"nodes"`
+	Cpu       int `json:"cpu"`
+	MemoryGib int `json:"memoryGib"`
+	Users     int `json:"users"`
+}
+
+// OrganizationQuotaResponse 의 0 은 제한하지 않는다는 뜻이다.
+type OrganizationQuotaResponse struct {
+	MaxStacks    int                    `json:"maxStacks"`
+	MaxNodes     int                    `json:"maxNodes"`
+	MaxCpu       int                    `json:"maxCpu"`
+	MaxMemoryGib int                    `json:"maxMemoryGib"`
+	MaxUsers     int                    `json:"maxUsers"`
+	Usage        OrganizationQuotaUsage `json:"usage"`
+	Updator      SimpleUserResponse     `json:"updator"`
+	UpdatedAt    time.Time              `json:"updatedAt"`
+}
+
+type GetOrganizationQuotaResponse struct {
+	Quota OrganizationQuotaResponse `json:"quota"`
+}
+
+// UpdateOrganizationQuotaRequest 의 0 은 제한하지 않는다는 뜻이다. 이미 사용 중인 자원보다 작게 설정할 수 있으며, 이후 생성만 막는다.
+type UpdateOrganizationQuotaRequest struct {
+	MaxStacks    int `json:"maxStacks" validate:"min=0"`
+	MaxNodes     int `json:"maxNodes" validate:"min=0"`
+	MaxCpu       int `json:"maxCpu" validate:"min=0"`
+	MaxMemoryGib int `json:"maxMemoryGib" validate:"min=0"`
+	MaxUsers     int `json:"maxUsers" validate:"min=0"`
+}
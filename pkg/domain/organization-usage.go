@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"time"
+)
+
+// 조직이 외부로 보내는 webhook 의 종류
+const (
+	WebhookKind_ALERT_CHANNEL = "ALERT_CHANNEL"
+	WebhookKind_AUDIT_SINK    = "AUDIT_SINK"
+	WebhookKind_STACK_WEBHOOK = "STACK_WEBHOOK"
+)
+
+// WebhookUsage 는 webhook 하나의 누적 전달 결과이다. 전달한 적이 없으면 successRate 는 null 이다.
+// deadLetters 는 감사 로그 sink 에서 아직 다시 전달하지 않은 감사 로그 수이다.
+type WebhookUsage struct {
+	Kind           string     `json:"kind" enums:"ALERT_CHANNEL,AUDIT_SINK,STACK_WEBHOOK"`
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Enabled        bool       `json:"enabled"`
+	SucceededCount int64      `json:"succeededCount"`
+	FailedCount    int64      `json:"failedCount"`
+	SuccessRate    *float64   `json:"successRate"`
+	DeadLetters    int64      `json:"deadLetters,omitempty"`
+	LastStatus     string     `json:"lastStatus"`
+	LastError      string     `json:"lastError,omitempty"`
+	LastSentAt     *time.Time `json:"lastSentAt"`
+}
+
+// WebhookUsageSummary 는 종류별 webhook 의 전달 결과 합계이다.
+type WebhookUsageSummary struct {
+	Kind           string   `json:"kind" enums:"ALERT_CHANNEL,AUDIT_SINK,STACK_WEBHOOK"`
+	SucceededCount int64    `json:"succeededCount"`
+	FailedCount    int64    `json:"failedCount"`
+	SuccessRate    *float64 `json:"successRate"`
+}
+
+// ApiUsage 는 최근 24시간 동안 조직이 호출한 API 요청 수와 rate limit 으로 거절된 요청 수이다.
+// rateLimitPerMinute 가 0 이면 요청 수를 제한하지 않는다. peakRequestsPerMinute 로 한도에 얼마나 가까운지 확인할 수 있다.
+// lastRateLimitedAt 은 마지막으로 요청이 거절된 1분의 시작 시각이다.
+type ApiUsage struct {
+	RateLimitPerMinute    int64            `json:"rateLimitPerMinute"`
+	Requests              int64            `json:"requests"`
+	RateLimited           int64            `json:"rateLimited"`
+	PeakRequestsPerMinute int64            `json:"peakRequestsPerMinute"`
+	LastRateLimitedAt     *time.Time       `json:"lastRateLimitedAt"`
+	Hourly                []ApiUsageHourly `json:"hourly"`
+}
+
+// ApiUsageHourly 는 startedAt 부터 1시간 동안의 API 요청 수이다.
+type ApiUsageHourly struct {
+	StartedAt   time.Time `json:"startedAt"`
+	Requests    int64     `json:"requests"`
+	RateLimited int64     `json:"rateLimited"`
+}
+
+type OrganizationUsageResponse struct {
+	Api      ApiUsage              `json:"api"`
+	Webhooks []WebhookUsage        `json:"webhooks"`
+	Summary  []WebhookUsageSummary `json:"summary"`
+}
+
+type GetOrganizationUsageResponse struct {
+	Usage OrganizationUsageResponse `json:"usage"`
+}
@@ -62,6 +62,7 @@ type OrganizationResponse struct {
 	ClusterCount                int                                        `json:"stackCount"`
 	CreatedAt                   time.Time                                  `json:"createdAt"`
 	UpdatedAt                   time.Time                                  `json:"updatedAt"`
+	OtpRequired                 bool                                       `json:"otpRequired"`
 }
 
 type SimpleOrganizationResponse = struct {
@@ -93,6 +94,7 @@ type ListOrganizationResponse struct {
 type UpdateOrganizationRequest struct {
 	Name        string `json:"name" validate:"required,min=1,max=30"`
 	Description string `json:"description" validate:"omitempty,min=0,max=100"`
+	OtpRequired bool   `json:"otpRequired"`
 }
 
 type UpdateOrganizationResponse struct {
@@ -109,6 +111,55 @@ type UpdateOrganizationTemplatesRequest struct {
 	SystemNotificationTemplateIds *[]string `json:"systemNotificationTemplateIds,omitempty"`
 }
 
+type OrganizationDeletionAction string
+
+const (
+	OrganizationDeletionAction_DELETE OrganizationDeletionAction = "DELETE"
+	OrganizationDeletionAction_BLOCK  OrganizationDeletionAction = "BLOCK"
+)
+
+// OrganizationDeletionStepResponse 는 조직을 삭제할 때 순서대로 처리하는 리소스이다.
+// BLOCK 인 리소스가 남아 있으면 조직을 삭제할 수 없고, Reason 에 먼저 해야 할 일을 설명한다.
+type OrganizationDeletionStepResponse struct {
+	Resource string                     `json:"resource"`
+	Action   OrganizationDeletionAction `json:"action"`
+	Count    int                        `json:"count"`
+	Items    []string                   `json:"items,omitempty"`
+	Reason   string                     `json:"reason,omitempty"`
+}
+
+// DeleteOrganizationResponse 의 Steps 는 dryRun 이면 삭제할 리소스 목록이고, 아니면 삭제한 리소스 목록이다.
 type DeleteOrganizationResponse struct {
-	ID string `json:"id"`
+	ID     string                             `json:"id"`
+	DryRun bool                               `json:"dryRun"`
+	TaskId string                             `json:"taskId,omitempty"`
+	Steps  []OrganizationDeletionStepResponse `json:"steps"`
+}
+
+type OnboardingStep string
+
+const (
+	OnboardingStep_CLOUD_ACCOUNT OnboardingStep = "CLOUD_ACCOUNT"
+	OnboardingStep_PRIMARY_STACK OnboardingStep = "PRIMARY_STACK"
+	OnboardingStep_LMA           OnboardingStep = "LMA"
+	OnboardingStep_USER          OnboardingStep = "USER"
+)
+
+// OnboardingSteps are the required setup steps of an organization in order
+var OnboardingSteps = []OnboardingStep{
+	OnboardingStep_CLOUD_ACCOUNT,
+	OnboardingStep_PRIMARY_STACK,
+	OnboardingStep_LMA,
+	OnboardingStep_USER,
+}
+
+type OnboardingStepResponse struct {
+	Step        OnboardingStep `json:"step"`
+	Completed   bool           `json:"completed"`
+	CompletedAt *time.Time     `json:"completedAt,omitempty"`
+}
+
+type GetOrganizationOnboardingResponse struct {
+	Completed bool                     `json:"completed"`
+	Steps     []OnboardingStepResponse `json:"steps"`
 }
@@ -3,11 +3,13 @@ package domain
 type PaginationResponse struct {
 	Limit      int              `json:"pageSize"`
 	Page       int              `json:"pageNumber"`
+	Offset     int              `json:"offset"`
 	SortColumn string           `json:"sortColumn"`
 	SortOrder  string           `json:"sortOrder"`
 	Filters    []FilterResponse `json:"filters,omitempty"`
 	TotalRows  int64            `json:"totalRows"`
 	TotalPages int              `json:"totalPages"`
+	NextCursor string           `json:"nextCursor,omitempty"`
 }
 
 type FilterResponse struct {
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// SearchPodsRequest 는 pod 검색 조건이다. Name 과 LabelSelector 중 하나는 지정해야 한다.
+type SearchPodsRequest struct {
+	Name          string
+	LabelSelector string
+	Namespace     string
+	Limit         int
+}
+
+type PodSearchResult struct {
+	ClusterId   string            `json:"clusterId"`
+	ClusterName string            `json:"clusterName"`
+	Namespace   string            `json:"namespace"`
+	Name        string            `json:"name"`
+	Status      string            `json:"status"`
+	Ready       string            `json:"ready"`
+	Restarts    int32             `json:"restarts"`
+	Node        string            `json:"node"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	CreatedAt   time.Time         `json:"createdAt"`
+}
+
+// PodSearchCluster 는 cluster 별 검색 결과이다. 조회에 실패한 cluster 는 Error 에 사유를 담는다.
+type PodSearchCluster struct {
+	ClusterId   string `json:"clusterId"`
+	ClusterName string `json:"clusterName"`
+	Count       int    `json:"count"`
+	Error       string `json:"error,omitempty"`
+}
+
+type SearchPodsResponse struct {
+	Pods      []PodSearchResult  `json:"pods"`
+	Clusters  []PodSearchCluster `json:"clusters"`
+	Truncated bool               `json:"truncated"`
+}
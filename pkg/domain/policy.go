@@ -54,7 +54,7 @@ type CreatePolicyRequest struct {
 	TargetClusterIds []string `json:"targetClusterIds" example:"83bf8081-f0c5-4b31-826d-23f6f366ec90,83bf8081-f0c5-4b31-826d-23f6f366ec90"`
 	Mandatory        bool     `json:"mandatory"`
 
-	PolicyName         string  `json:"policyName" validate:"required,name" example:"label 정책"`
+	PolicyName         string  `json:"policyName" validate:"required,name" example:"label 정책" audit:"name"`
 	PolicyResourceName string  `json:"policyResourceName,omitempty" validate:"resourcename" example:"labelpolicy"`
 	Description        string  `json:"description"`
 	TemplateId         string  `json:"templateId" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
@@ -73,7 +73,7 @@ type UpdatePolicyRequest struct {
 	TargetClusterIds *[]string `json:"targetClusterIds,omitempty" example:"83bf8081-f0c5-4b31-826d-23f6f366ec90,83bf8081-f0c5-4b31-826d-23f6f366ec90"`
 	Mandatory        *bool     `json:"mandatory,omitempty"`
 
-	PolicyName        *string `json:"policyName,omitempty" validate:"name" example:"label 정책"`
+	PolicyName        *string `json:"policyName,omitempty" validate:"name" example:"label 정책" audit:"name"`
 	Description       *string `json:"description,omitempty"`
 	TemplateId        *string `json:"templateId,omitempty" example:"d98ef5f1-4a68-4047-a446-2207787ce3ff"`
 	EnforcementAction *string `json:"enforcementAction" validate:"omitempty,oneof=deny dryrun warn" enum:"warn,deny,dryrun" example:"deny"`
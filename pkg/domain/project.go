@@ -138,7 +138,7 @@ type UpdateProjectMembersRoleRequest struct {
 
 type CreateProjectNamespaceRequest struct {
 	StackId     string `json:"stackId"`
-	Namespace   string `json:"namespace"`
+	Namespace   string `json:"namespace" audit:"name"`
 	Description string `json:"description"`
 }
 
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"time"
+)
+
+type RoleReassignmentStatus string
+
+const (
+	RoleReassignmentStatus_RUNNING          RoleReassignmentStatus = "RUNNING"
+	RoleReassignmentStatus_SUCCEEDED        RoleReassignmentStatus = "SUCCEEDED"
+	RoleReassignmentStatus_PARTIALLY_FAILED RoleReassignmentStatus = "PARTIALLY_FAILED"
+	RoleReassignmentStatus_FAILED           RoleReassignmentStatus = "FAILED"
+)
+
+type RoleReassignmentResultStatus string
+
+const (
+	RoleReassignmentResultStatus_SUCCEEDED RoleReassignmentResultStatus = "SUCCEEDED"
+	RoleReassignmentResultStatus_FAILED    RoleReassignmentResultStatus = "FAILED"
+)
+
+type RoleReassignmentResultResponse struct {
+	UserId     string                       `json:"userId"`
+	AccountId  string                       `json:"accountId"`
+	Status     RoleReassignmentResultStatus `json:"status"`
+	Error      string                       `json:"error,omitempty"`
+	FinishedAt time.Time                    `json:"finishedAt"`
+}
+
+type RoleReassignmentResponse struct {
+	ID             string                           `json:"id"`
+	OrganizationId string                           `json:"organizationId"`
+	SourceRoleId   string                           `json:"sourceRoleId"`
+	SourceRoleName string                           `json:"sourceRoleName"`
+	TargetRoleId   string                           `json:"targetRoleId"`
+	TargetRoleName string                           `json:"targetRoleName"`
+	Status         RoleReassignmentStatus           `json:"status"`
+	TotalCount     int                              `json:"totalCount"`
+	SucceededCount int                              `json:"succeededCount"`
+	FailedCount    int                              `json:"failedCount"`
+	Error          string                           `json:"error,omitempty"`
+	Creator        SimpleUserResponse               `json:"creator"`
+	StartedAt      time.Time                        `json:"startedAt"`
+	FinishedAt     *time.Time                       `json:"finishedAt,omitempty"`
+	Results        []RoleReassignmentResultResponse `json:"results,omitempty"`
+}
+
+type CreateRoleReassignmentRequest struct {
+	SourceRoleId string `json:"sourceRoleId" validate:"required"`
+	TargetRoleId string `json:"targetRoleId" validate:"required"`
+}
+
+type CreateRoleReassignmentResponse struct {
+	RoleReassignment RoleReassignmentResponse `json:"roleReassignment"`
+}
+
+type GetRoleReassignmentsResponse struct {
+	RoleReassignments []RoleReassignmentResponse `json:"roleReassignments"`
+	Pagination        PaginationResponse         `json:"pagination"`
+}
+
+type GetRoleReassignmentResponse struct {
+	RoleReassignment RoleReassignmentResponse `json:"roleReassignment"`
+}
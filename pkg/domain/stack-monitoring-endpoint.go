@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"time"
+)
+
+type MonitoringEndpointStatus string
+
+const (
+	MonitoringEndpointStatus_UNKNOWN MonitoringEndpointStatus = "UNKNOWN"
+	MonitoringEndpointStatus_UP      MonitoringEndpointStatus = "UP"
+	MonitoringEndpointStatus_DOWN    MonitoringEndpointStatus = "DOWN"
+)
+
+// MonitoringEndpointAvailability 는 기간 동안 성공한 probe 의 비율(%)이다. probe 기록이 없으면 nil 이다.
+type MonitoringEndpointAvailability struct {
+	Last24h *float64 `json:"last24h"`
+	Last7d  *float64 `json:"last7d"`
+	Last30d *float64 `json:"last30d"`
+}
+
+type StackMonitoringEndpointResponse struct {
+	ID                  string                         `json:"id"`
+	OrganizationId      string                         `json:"organizationId"`
+	ClusterId           ClusterId                      `json:"clusterId"`
+	Name                string                         `json:"name"`
+	Url                 string                         `json:"url"`
+	Method              string                         `json:"method"`
+	ExpectedStatusCode  int                            `json:"expectedStatusCode"`
+	IntervalSeconds     int                            `json:"intervalSeconds"`
+	TimeoutSeconds      int                            `json:"timeoutSeconds"`
+	FailureThreshold    int                            `json:"failureThreshold"`
+	InsecureSkipVerify  bool                           `json:"insecureSkipVerify"`
+	Enabled             bool                           `json:"enabled"`
+	Status              MonitoringEndpointStatus       `json:"status"`
+	LastStatusCode      int                            `json:"lastStatusCode"`
+	LastLatencyMs       int64                          `json:"lastLatencyMs"`
+	LastError           string                         `json:"lastError,omitempty"`
+	LastCheckedAt       *time.Time                     `json:"lastCheckedAt,omitempty"`
+	ConsecutiveFailures int                            `json:"consecutiveFailures"`
+	Availability        MonitoringEndpointAvailability `json:"availability"`
+	Creator             SimpleUserResponse             `json:"creator"`
+	Updator             SimpleUserResponse             `json:"updator"`
+	CreatedAt           time.Time                      `json:"createdAt"`
+	UpdatedAt           time.Time                      `json:"updatedAt"`
+}
+
+type GetStackMonitoringEndpointsResponse struct {
+	MonitoringEndpoints []StackMonitoringEndpointResponse `json:"monitoringEndpoints"`
+}
+
+type GetStackMonitoringEndpointResponse struct {
+	MonitoringEndpoint StackMonitoringEndpointResponse `json:"monitoringEndpoint"`
+}
+
+// CreateStackMonitoringEndpointRequest 의 url 은 TKS 에서 주기적으로 호출하여 응답 코드를 확인한다.
+// 연속 실패 횟수가 FailureThreshold 에 도달하면 알림을 생성한다.
+type CreateStackMonitoringEndpointRequest struct {
+	Name               string `json:"name" validate:"required,name"`
+	Url                string `json:"url" validate:"required,url"`
+	Method             string `json:"method" validate:"omitempty,oneof=GET HEAD"`
+	ExpectedStatusCode int    `json:"expectedStatusCode" validate:"omitempty,min=100,max=599"`
+	IntervalSeconds    int    `json:"intervalSeconds" validate:"omitempty,min=30,max=3600"`
+	TimeoutSeconds     int    `json:"timeoutSeconds" validate:"omitempty,min=1,max=30"`
+	FailureThreshold   int    `json:"failureThreshold" validate:"omitempty,min=1,max=10"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify"`
+	Enabled            *bool  `json:"enabled"`
+}
+
+type CreateStackMonitoringEndpointResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateStackMonitoringEndpointRequest struct {
+	Name               string `json:"name" validate:"required,name"`
+	Url                string `json:"url" validate:"required,url"`
+	Method             string `json:"method" validate:"omitempty,oneof=GET HEAD"`
+	ExpectedStatusCode int    `json:"expectedStatusCode" validate:"omitempty,min=100,max=599"`
+	IntervalSeconds    int    `json:"intervalSeconds" validate:"omitempty,min=30,max=3600"`
+	TimeoutSeconds     int    `json:"timeoutSeconds" validate:"omitempty,min=1,max=30"`
+	FailureThreshold   int    `json:"failureThreshold" validate:"omitempty,min=1,max=10"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify"`
+	Enabled            *bool  `json:"enabled"`
+}
+
+// DashboardAvailability 는 SLO 대시보드에 표시할 stack 별 외부 endpoint 가용성이다.
+type DashboardAvailability struct {
+	ClusterId           string                          `json:"clusterId"`
+	ClusterName         string                          `json:"clusterName"`
+	Availability        *float64                        `json:"availability"`
+	DownEndpoints       int                             `json:"downEndpoints"`
+	MonitoringEndpoints []DashboardEndpointAvailability `json:"monitoringEndpoints"`
+}
+
+type DashboardEndpointAvailability struct {
+	ID           string                   `json:"id"`
+	Name         string                   `json:"name"`
+	Url          string                   `json:"url"`
+	Status       MonitoringEndpointStatus `json:"status"`
+	Availability *float64                 `json:"availability"`
+}
+
+type GetDashboardAvailabilityResponse struct {
+	Duration     string                  `json:"duration"`
+	Availability *float64                `json:"availability"`
+	Stacks       []DashboardAvailability `json:"stacks"`
+}
@@ -0,0 +1,22 @@
+package domain
+
+const (
+	StackNodeGroup_CONTROL_PLANE = "control-plane"
+	StackNodeGroup_INFRA         = "infra"
+	StackNodeGroup_USER          = "user"
+)
+
+// ScaleStackNodeGroupRequest 의 DesiredSize 는 node group 전체의 노드 수이다. AWS 스택의 user node group 은 가용영역 수의 배수여야 한다.
+type ScaleStackNodeGroupRequest struct {
+	DesiredSize int `json:"desiredSize" validate:"required,min=1"`
+}
+
+type ScaleStackNodeGroupResponse struct {
+	StackName       string          `json:"stackName"`
+	NodeGroup       string          `json:"nodeGroup"`
+	PreviousSize    int             `json:"previousSize"`
+	DesiredSize     int             `json:"desiredSize"`
+	TaskId          string          `json:"taskId"`
+	OperationId     string          `json:"operationId"`
+	OperationStatus OperationStatus `json:"operationStatus"`
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+type StackNodeTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+type StackNodeCondition struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+}
+
+// StackNodeUsage 는 thanos 에서 조회한 사용률(%)이다. 조회하지 못하면 빈 문자열이다.
+type StackNodeUsage struct {
+	Cpu    string `json:"cpu"`
+	Memory string `json:"memory"`
+	Disk   string `json:"disk"`
+}
+
+type StackNodeResponse struct {
+	Name             string               `json:"name"`
+	Roles            []string             `json:"roles"`
+	InternalIp       string               `json:"internalIp"`
+	InstanceType     string               `json:"instanceType,omitempty"`
+	Zone             string               `json:"zone,omitempty"`
+	KubeletVersion   string               `json:"kubeletVersion"`
+	OsImage          string               `json:"osImage"`
+	ContainerRuntime string               `json:"containerRuntime"`
+	Ready            bool                 `json:"ready"`
+	Unschedulable    bool                 `json:"unschedulable"`
+	Capacity         map[string]string    `json:"capacity"`
+	Allocatable      map[string]string    `json:"allocatable"`
+	Usage            StackNodeUsage       `json:"usage"`
+	Taints           []StackNodeTaint     `json:"taints"`
+	Conditions       []StackNodeCondition `json:"conditions"`
+	CreatedAt        time.Time            `json:"createdAt"`
+}
+
+// GetStackNodesResponse 의 usageError 는 사용률을 조회하지 못한 경우의 원인이며, 노드 목록은 그대로 반환한다.
+type GetStackNodesResponse struct {
+	Nodes      []StackNodeResponse `json:"nodes"`
+	UsageError string              `json:"usageError,omitempty"`
+}
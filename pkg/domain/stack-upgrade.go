@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+const (
+	StackUpgradeCheck_ALERTS   = "alerts"
+	StackUpgradeCheck_CAPACITY = "capacity"
+)
+
+type UpgradeStackRequest struct {
+	StackTemplateId string `json:"stackTemplateId" validate:"required"`
+}
+
+// StackUpgradeCheck 는 업그레이드 사전 점검 결과이다. 점검할 수 없었던 항목은 Skipped 이며 업그레이드를 막지 않는다.
+type StackUpgradeCheck struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Message string `json:"message"`
+}
+
+type StackUpgradeResponse struct {
+	ID                string                      `json:"id"`
+	StackId           string                      `json:"stackId"`
+	FromStackTemplate SimpleStackTemplateResponse `json:"fromStackTemplate"`
+	ToStackTemplate   SimpleStackTemplateResponse `json:"toStackTemplate"`
+	FromKubeVersion   string                      `json:"fromKubeVersion"`
+	ToKubeVersion     string                      `json:"toKubeVersion"`
+	TaskId            string                      `json:"taskId"`
+	OperationId       string                      `json:"operationId"`
+	Status            OperationStatus             `json:"status"`
+	StatusDesc        string                      `json:"statusDesc"`
+	Preflight         []StackUpgradeCheck         `json:"preflight"`
+	Creator           SimpleUserResponse          `json:"creator"`
+	CompletedAt       *time.Time                  `json:"completedAt,omitempty"`
+	CreatedAt         time.Time                   `json:"createdAt"`
+	UpdatedAt         time.Time                   `json:"updatedAt"`
+}
+
+type UpgradeStackResponse struct {
+	Upgrade StackUpgradeResponse `json:"upgrade"`
+}
+
+type GetStackUpgradesResponse struct {
+	Upgrades   []StackUpgradeResponse `json:"upgrades"`
+	Pagination PaginationResponse     `json:"pagination"`
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"time"
+)
+
+// 스택 lifecycle event
+const (
+	StackLifecycleEvent_CLUSTER_CREATED         = "cluster.created"
+	StackLifecycleEvent_CLUSTER_RUNNING         = "cluster.running"
+	StackLifecycleEvent_CLUSTER_DELETED         = "cluster.deleted"
+	StackLifecycleEvent_CLUSTER_STATUS_CHANGED  = "cluster.status_changed"
+	StackLifecycleEvent_APPGROUP_CREATED        = "appgroup.created"
+	StackLifecycleEvent_APPGROUP_RUNNING        = "appgroup.running"
+	StackLifecycleEvent_APPGROUP_DELETED        = "appgroup.deleted"
+	StackLifecycleEvent_APPGROUP_STATUS_CHANGED = "appgroup.status_changed"
+)
+
+// 마지막 전송 결과
+const (
+	StackWebhookStatus_SUCCEEDED = "SUCCEEDED"
+	StackWebhookStatus_FAILED    = "FAILED"
+)
+
+// StackLifecycleEvent 는 stack webhook 으로 POST 하는 본문이다.
+// secret 을 지정한 webhook 은 본문의 HMAC-SHA256 서명을 X-TKS-Signature 헤더로 받는다.
+type StackLifecycleEvent struct {
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	OrganizationId string    `json:"organizationId"`
+	ResourceType   string    `json:"resourceType"`
+	ResourceId     string    `json:"resourceId"`
+	Name           string    `json:"name"`
+	ClusterId      string    `json:"clusterId"`
+	PreviousStatus string    `json:"previousStatus,omitempty"`
+	Status         string    `json:"status"`
+	StatusDesc     string    `json:"statusDesc,omitempty"`
+	OccurredAt     time.Time `json:"occurredAt"`
+}
+
+type StackWebhookResponse struct {
+	ID               string             `json:"id"`
+	Name             string             `json:"name"`
+	Url              string             `json:"url"`
+	SecretConfigured bool               `json:"secretConfigured"`
+	Events           []string           `json:"events"`
+	Enabled          bool               `json:"enabled"`
+	LastStatus       string             `json:"lastStatus"`
+	LastError        string             `json:"lastError,omitempty"`
+	LastDeliveredAt  *time.Time         `json:"lastDeliveredAt"`
+	Creator          SimpleUserResponse `json:"creator"`
+	CreatedAt        time.Time          `json:"createdAt"`
+	UpdatedAt        time.Time          `json:"updatedAt"`
+}
+
+// CreateStackWebhookRequest 의 events 가 비어 있으면 모든 event 를 보낸다.
+type CreateStackWebhookRequest struct {
+	Name    string   `json:"name" validate:"required,name"`
+	Url     string   `json:"url" validate:"required,url"`
+	Secret  string   `json:"secret"`
+	Events  []string `json:"events" validate:"dive,oneof=cluster.created cluster.running cluster.deleted cluster.status_changed appgroup.created appgroup.running appgroup.deleted appgroup.status_changed"`
+	Enabled bool     `json:"enabled"`
+}
+
+type CreateStackWebhookResponse struct {
+	ID string `json:"id"`
+}
+
+// UpdateStackWebhookRequest 의 secret 이 비어 있으면 기존 secret 을 유지한다.
+type UpdateStackWebhookRequest struct {
+	Name    string   `json:"name" validate:"required,name"`
+	Url     string   `json:"url" validate:"required,url"`
+	Secret  string   `json:"secret"`
+	Events  []string `json:"events" validate:"dive,oneof=cluster.created cluster.running cluster.deleted cluster.status_changed appgroup.created appgroup.running appgroup.deleted appgroup.status_changed"`
+	Enabled bool     `json:"enabled"`
+}
+
+type GetStackWebhooksResponse struct {
+	StackWebhooks []StackWebhookResponse `json:"stackWebhooks"`
+	Pagination    PaginationResponse     `json:"pagination"`
+}
+
+type GetStackWebhookResponse struct {
+	StackWebhook StackWebhookResponse `json:"stackWebhook"`
+}
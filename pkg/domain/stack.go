@@ -37,6 +37,8 @@ const (
 
 	StackStatus_CLUSTER_BOOTSTRAPPING
 	StackStatus_CLUSTER_BOOTSTRAPPED
+
+	StackStatus_CLUSTER_UNREACHABLE
 )
 
 var stackStatus = [...]string{
@@ -53,6 +55,7 @@ var stackStatus = [...]string{
 	"RUNNING",
 	"BOOTSTRAPPING",
 	"BOOTSTRAPPED",
+	"UNREACHABLE",
 }
 
 func (m StackStatus) String() string { return stackStatus[(m)] }
@@ -98,10 +101,32 @@ type CreateStackRequest struct {
 	TksUserNode      int      `json:"tksUserNode"`
 	TksUserNodeMax   int      `json:"tksUserNodeMax,omitempty"`
 	TksUserNodeType  string   `json:"tksUserNodeType,omitempty"`
+
+	// 생략된 값은 조직의 스택 기본값(StackDefault)으로 채워진다.
+	Region      string            `json:"region,omitempty"`
+	VpcCidr     string            `json:"vpcCidr,omitempty" validate:"omitempty,cidrv4"`
+	PodCidr     string            `json:"podCidr,omitempty" validate:"omitempty,cidrv4"`
+	ServiceCidr string            `json:"serviceCidr,omitempty" validate:"omitempty,cidrv4"`
+	Tags        map[string]string `json:"tags,omitempty"`
+
+	// 미리 만들어 둔 노드 이미지(AWS AMI ID). 생략하면 스택 템플릿의 기본 이미지를 사용한다.
+	TksCpNodeImage    string `json:"tksCpNodeImage,omitempty"`
+	TksInfraNodeImage string `json:"tksInfraNodeImage,omitempty"`
+	TksUserNodeImage  string `json:"tksUserNodeImage,omitempty"`
 }
 
+// CreateStackResponse 의 TaskId 로 GET /tasks/{taskId} 에서 진행 상황을 조회한다. OperationId 와 같다.
 type CreateStackResponse struct {
-	ID string `json:"id"`
+	ID              string          `json:"id"`
+	TaskId          string          `json:"taskId"`
+	OperationId     string          `json:"operationId"`
+	OperationStatus OperationStatus `json:"operationStatus"`
+}
+
+type DeleteStackResponse struct {
+	TaskId          string          `json:"taskId"`
+	OperationId     string          `json:"operationId"`
+	OperationStatus OperationStatus `json:"operationStatus"`
 }
 
 type StackConfResponse struct {
@@ -114,6 +139,16 @@ type StackConfResponse struct {
 	TksUserNode      int    `json:"tksUserNode" validate:"required,min=0,max=100"`
 	TksUserNodeMax   int    `json:"tksUserNodeMax,omitempty"`
 	TksUserNodeType  string `json:"tksUserNodeType,omitempty"`
+
+	Region      string            `json:"region,omitempty"`
+	VpcCidr     string            `json:"vpcCidr,omitempty"`
+	PodCidr     string            `json:"podCidr,omitempty"`
+	ServiceCidr string            `json:"serviceCidr,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+
+	TksCpNodeImage    string `json:"tksCpNodeImage,omitempty"`
+	TksInfraNodeImage string `json:"tksInfraNodeImage,omitempty"`
+	TksUserNodeImage  string `json:"tksUserNodeImage,omitempty"`
 }
 
 type StackResponse struct {
@@ -134,6 +169,7 @@ type StackResponse struct {
 	ClusterEndpoint string                      `json:"userClusterEndpoint,omitempty"`
 	Resource        DashboardStackResponse      `json:"resource,omitempty"`
 	AppServeAppCnt  int                         `json:"appServeAppCnt"`
+	LastSeenAt      *time.Time                  `json:"lastSeenAt,omitempty"`
 	CreatedAt       time.Time                   `json:"createdAt"`
 	UpdatedAt       time.Time                   `json:"updatedAt"`
 }
@@ -176,3 +212,101 @@ type GetStackStatusResponse struct {
 	StackStatus string            `json:"stackStatus"`
 	StepStatus  []StackStepStatus `json:"stepStatus"`
 }
+
+type StackDefaultResponse struct {
+	OrganizationId   string             `json:"organizationId"`
+	Region           string             `json:"region"`
+	TksCpNodeType    string             `json:"tksCpNodeType"`
+	TksInfraNodeType string             `json:"tksInfraNodeType"`
+	TksUserNodeType  string             `json:"tksUserNodeType"`
+	VpcCidr          string             `json:"vpcCidr"`
+	PodCidr          string             `json:"podCidr"`
+	ServiceCidr      string             `json:"serviceCidr"`
+	Tags             map[string]string  `json:"tags"`
+	Updator          SimpleUserResponse `json:"updator"`
+	UpdatedAt        time.Time          `json:"updatedAt"`
+}
+
+type GetStackDefaultResponse struct {
+	StackDefault StackDefaultResponse `json:"stackDefault"`
+}
+
+type UpdateStackDefaultRequest struct {
+	Region           string            `json:"region"`
+	TksCpNodeType    string            `json:"tksCpNodeType"`
+	TksInfraNodeType string            `json:"tksInfraNodeType"`
+	TksUserNodeType  string            `json:"tksUserNodeType"`
+	VpcCidr          string            `json:"vpcCidr" validate:"omitempty,cidrv4"`
+	PodCidr          string            `json:"podCidr" validate:"omitempty,cidrv4"`
+	ServiceCidr      string            `json:"serviceCidr" validate:"omitempty,cidrv4"`
+	Tags             map[string]string `json:"tags"`
+}
+
+type StackAddonVersionSource string
+
+const (
+	StackAddonVersionSource_CLUSTER  StackAddonVersionSource = "CLUSTER"
+	StackAddonVersionSource_TEMPLATE StackAddonVersionSource = "TEMPLATE"
+)
+
+type StackAddonVersionResponse struct {
+	Name                string                  `json:"name"`
+	Type                string                  `json:"type"`
+	InstalledVersion    string                  `json:"installedVersion"`
+	LatestVersion       string                  `json:"latestVersion"`
+	LatestStackTemplate string                  `json:"latestStackTemplate"`
+	Source              StackAddonVersionSource `json:"source"`
+	Outdated            bool                    `json:"outdated"`
+}
+
+type StackAddonVersionsResponse struct {
+	ID               StackId                     `json:"id"`
+	Name             string                      `json:"name"`
+	StackTemplate    SimpleStackTemplateResponse `json:"stackTemplate"`
+	UpdatesAvailable int                         `json:"updatesAvailable"`
+	Addons           []StackAddonVersionResponse `json:"addons"`
+}
+
+type GetStackAddonVersionsResponse struct {
+	Stacks []StackAddonVersionsResponse `json:"stacks"`
+}
+
+type CostAllocationTagPolicyResponse struct {
+	Key           string             `json:"key"`
+	DefaultValue  string             `json:"defaultValue"`
+	AllowedValues []string           `json:"allowedValues"`
+	Required      bool               `json:"required"`
+	Description   string             `json:"description"`
+	Updator       SimpleUserResponse `json:"updator"`
+	UpdatedAt     time.Time          `json:"updatedAt"`
+}
+
+type GetCostAllocationTagPoliciesResponse struct {
+	Policies []CostAllocationTagPolicyResponse `json:"policies"`
+}
+
+type CostAllocationTagPolicyRequest struct {
+	Key           string   `json:"key" validate:"required,max=128"`
+	DefaultValue  string   `json:"defaultValue" validate:"max=256"`
+	AllowedValues []string `json:"allowedValues" validate:"dive,max=256"`
+	Required      bool     `json:"required"`
+	Description   string   `json:"description"`
+}
+
+type UpdateCostAllocationTagPoliciesRequest struct {
+	Policies []CostAllocationTagPolicyRequest `json:"policies" validate:"dive"`
+}
+
+type CostAllocationTagDriftResourceResponse struct {
+	ResourceId   string            `json:"resourceId"`
+	ResourceType string            `json:"resourceType"`
+	MissingTags  []string          `json:"missingTags,omitempty"`
+	InvalidTags  map[string]string `json:"invalidTags,omitempty"` // key -> 허용되지 않은 값
+}
+
+type CheckCostAllocationTagDriftResponse struct {
+	StackId   StackId                                  `json:"stackId"`
+	Drifted   bool                                     `json:"drifted"`
+	Resources []CostAllocationTagDriftResourceResponse `json:"resources"`
+	CheckedAt time.Time                                `json:"checkedAt"`
+}
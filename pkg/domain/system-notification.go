@@ -14,6 +14,8 @@ const (
 	SystemNotificationActionStatus_INPROGRESS
 	SystemNotificationActionStatus_CLOSED
 	SystemNotificationActionStatus_ERROR
+	SystemNotificationActionStatus_SILENCED
+	SystemNotificationActionStatus_ESCALATED
 )
 
 var systemNotificationActionStatus = [...]string{
@@ -21,6 +23,8 @@ var systemNotificationActionStatus = [...]string{
 	"INPROGRESS",
 	"CLOSED",
 	"ERROR",
+	"SILENCED",
+	"ESCALATED",
 }
 
 func (m SystemNotificationActionStatus) String() string { return systemNotificationActionStatus[(m)] }
@@ -102,6 +106,10 @@ type SystemNotificationResponse struct {
 	RawData                   string                             `json:"rawData"`
 	NotificationType          string                             `json:"notificationType"`
 	Read                      bool                               `json:"read"`
+	AlertSilenceId            string                             `json:"alertSilenceId,omitempty"`
+	EscalationPolicyId        string                             `json:"escalationPolicyId,omitempty"`
+	EscalationLevel           int                                `json:"escalationLevel"`
+	NextEscalationAt          *time.Time                         `json:"nextEscalationAt,omitempty"`
 	CreatedAt                 time.Time                          `json:"createdAt"`
 	UpdatedAt                 time.Time                          `json:"updatedAt"`
 }
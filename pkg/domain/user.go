@@ -28,7 +28,7 @@ type UserResponse struct {
 }
 
 type CreateUserRequest struct {
-	AccountId   string             `json:"accountId" validate:"required"`
+	AccountId   string             `json:"accountId" validate:"required" audit:"name"`
 	Password    string             `json:"password" validate:"required"`
 	Name        string             `json:"name" validate:"name"`
 	Email       string             `json:"email" validate:"required,email"`
@@ -148,6 +148,8 @@ type GetMyProfileResponse struct {
 		Organization OrganizationResponse `json:"organization"`
 		Email        string               `json:"email"`
 		Department   string               `json:"department"`
+		Timezone     string               `json:"timezone"`
+		OtpEnabled   bool                 `json:"otpEnabled"`
 	} `json:"user"`
 }
 type UpdateMyProfileRequest struct {
@@ -155,6 +157,8 @@ type UpdateMyProfileRequest struct {
 	Name       string `json:"name" validate:"required,min=1,max=30"`
 	Email      string `json:"email" validate:"required,email"`
 	Department string `json:"department" validate:"min=0,max=50"`
+	// Timezone 은 IANA 시간대 이름이며, 생략하면 기존 값을 유지한다. 대시보드 chart 의 날짜 구간 기본값으로 사용된다.
+	Timezone string `json:"timezone" validate:"omitempty,timezone"`
 }
 
 type UpdateMyProfileResponse struct {
@@ -166,6 +170,7 @@ type UpdateMyProfileResponse struct {
 		Organization OrganizationResponse `json:"organization"`
 		Email        string               `json:"email"`
 		Department   string               `json:"department"`
+		Timezone     string               `json:"timezone"`
 	} `json:"user"`
 }
 
@@ -223,3 +228,46 @@ type DeleteUserRequest struct {
 type DeleteUserResponse struct {
 	AccountId string `json:"accountId"`
 }
+
+// DryRunResponse 는 dryRun=true 요청의 검증 결과이다. 어떤 변경도 반영되지 않으며 Changes 는 실제 요청 시 수행될 작업이다.
+type DryRunResponse struct {
+	DryRun  bool     `json:"dryRun"`
+	Changes []string `json:"changes"`
+}
+
+type PasswordPolicyResponse struct {
+	OrganizationId      string             `json:"organizationId"`
+	MinLength           int                `json:"minLength"`
+	MinCharacterClasses int                `json:"minCharacterClasses"`
+	HistoryCount        int                `json:"historyCount"`
+	ExpiryDays          int                `json:"expiryDays"`
+	Updator             SimpleUserResponse `json:"updator"`
+	UpdatedAt           time.Time          `json:"updatedAt"`
+}
+
+type GetPasswordPolicyResponse struct {
+	PasswordPolicy PasswordPolicyResponse `json:"passwordPolicy"`
+}
+
+// UpdatePasswordPolicyRequest 의 MinCharacterClasses 는 대문자, 소문자, 숫자, 특수문자 중 포함해야 하는 종류의 수이다.
+// HistoryCount 가 0 이면 이전 비밀번호 재사용을 막지 않고, ExpiryDays 가 0 이면 비밀번호가 만료되지 않는다.
+type UpdatePasswordPolicyRequest struct {
+	MinLength           int `json:"minLength" validate:"min=8,max=128"`
+	MinCharacterClasses int `json:"minCharacterClasses" validate:"min=0,max=4"`
+	HistoryCount        int `json:"historyCount" validate:"min=0,max=24"`
+	ExpiryDays          int `json:"expiryDays" validate:"min=0,max=3650"`
+}
+
+// EnrollMyOtpResponse 의 OtpauthUri 를 QR 코드로 만들어 인증 앱에 등록한다.
+type EnrollMyOtpResponse struct {
+	Secret     string `json:"secret"`
+	OtpauthUri string `json:"otpauthUri"`
+}
+
+type ActivateMyOtpRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type DisableMyOtpRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
@@ -0,0 +1,40 @@
+package hook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// Config 의 Webhooks 는 "pre-stack-create=https://hooks.example.com/stack" 형식으로 지점과 URL 을 지정한다.
+type Config struct {
+	Webhooks        []string
+	WebhookSecret   string
+	WebhookTimeout  time.Duration
+	WebhookFailOpen bool
+}
+
+// Initialize 는 webhook hook 을 등록한다.
+// API 서버 binary 에 포함된 package 는 init 에서 Register 를 호출하여 hook 을 등록한다.
+func Initialize(ctx context.Context, cfg Config) error {
+	for _, spec := range cfg.Webhooks {
+		arr := strings.SplitN(spec, "=", 2)
+		if len(arr) != 2 {
+			return fmt.Errorf("invalid hook webhook %s. use <point>=<url>", spec)
+		}
+		point, err := ParsePoint(strings.TrimSpace(arr[0]))
+		if err != nil {
+			return err
+		}
+		h, err := NewWebhook(strings.TrimSpace(arr[1]), cfg.WebhookSecret, cfg.WebhookTimeout, cfg.WebhookFailOpen)
+		if err != nil {
+			return err
+		}
+		Register(point, h)
+		log.Infof(ctx, "registered hook %s on %s", h.Name(), point)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package hook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+// Point 는 hook 을 실행하는 지점이다. pre- 로 시작하는 지점의 hook 은 payload 를 바꾸거나 요청을 거부할 수 있고,
+// post- 로 시작하는 지점의 hook 은 처리 결과를 전달받기만 한다.
+type Point string
+
+const (
+	PreStackCreate Point = "pre-stack-create"
+	PostUserCreate Point = "post-user-create"
+	PreDeploy      Point = "pre-deploy"
+)
+
+var Points = []Point{PreStackCreate, PostUserCreate, PreDeploy}
+
+func (p Point) IsPre() bool { return strings.HasPrefix(string(p), "pre-") }
+
+func ParsePoint(s string) (Point, error) {
+	for _, p := range Points {
+		if string(p) == s {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("invalid hook point %s", s)
+}
+
+// Event 의 Payload 는 지점별 요청 객체의 pointer 이다. pre- 지점의 hook 이 Payload 를 수정하면 수정된 값으로 요청을 처리한다.
+type Event struct {
+	Point          Point       `json:"point"`
+	OrganizationId string      `json:"organizationId"`
+	UserId         string      `json:"userId,omitempty"`
+	Payload        interface{} `json:"payload"`
+}
+
+// Hook 은 사내 규칙 등 배포 환경별 로직을 usecase 를 수정하지 않고 추가하기 위한 확장 지점이다.
+// pre- 지점에서 오류를 반환하면 요청을 거부한다. 거부 사유를 사용자에게 보여주려면 Reject 로 오류를 만든다.
+type Hook interface {
+	Name() string
+	Run(ctx context.Context, event *Event) error
+}
+
+// RejectedError 는 hook 이 요청을 거부했음을 나타낸다.
+type RejectedError struct {
+	Hook   string
+	Reason string
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("rejected by hook %s: %s", e.Hook, e.Reason)
+}
+
+func Reject(reason string) error {
+	return &RejectedError{Reason: reason}
+}
+
+var (
+	mu    sync.RWMutex
+	hooks = map[Point][]Hook{}
+)
+
+// Register 는 point 에 hook 을 추가한다. 같은 point 의 hook 은 등록한 순서대로 실행한다.
+func Register(point Point, h Hook) {
+	mu.Lock()
+	defer mu.Unlock()
+	hooks[point] = append(hooks[point], h)
+}
+
+func Registered(point Point) []Hook {
+	mu.RLock()
+	defer mu.RUnlock()
+	return append([]Hook{}, hooks[point]...)
+}
+
+// Run 은 event.Point 에 등록된 hook 을 순서대로 실행한다.
+// pre- 지점에서 hook 이 오류를 반환하면 나머지 hook 은 실행하지 않고 *RejectedError 를 반환한다.
+// post- 지점의 오류는 기록만 하고 나머지 hook 을 계속 실행한다.
+func Run(ctx context.Context, event Event) error {
+	for _, h := range Registered(event.Point) {
+		err := h.Run(ctx, &event)
+		if err == nil {
+			continue
+		}
+		if !event.Point.IsPre() {
+			log.Errorf(ctx, "failed to run hook %s on %s. err : %s", h.Name(), event.Point, err)
+			continue
+		}
+
+		var rejected *RejectedError
+		if !errors.As(err, &rejected) {
+			rejected = &RejectedError{Reason: err.Error()}
+		}
+		if rejected.Hook == "" {
+			rejected.Hook = h.Name()
+		}
+		log.Infof(ctx, "%s of organization %s is %s", event.Point, event.OrganizationId, rejected)
+		return rejected
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package hook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const SignatureHeader = "X-TKS-Signature"
+
+// WebhookResponse 는 webhook 이 응답할 본문이다. allowed 가 false 이면 reason 으로 요청을 거부한다.
+// pre- 지점에서 payload 를 응답하면 요청 객체에 덮어쓴다. 응답하지 않은 field 는 바뀌지 않는다.
+type WebhookResponse struct {
+	Allowed *bool           `json:"allowed"`
+	Reason  string          `json:"reason"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type webhook struct {
+	client   *http.Client
+	url      string
+	secret   string
+	failOpen bool
+}
+
+// NewWebhook 은 Event 를 JSON 으로 POST 하는 hook 을 만든다. secret 을 지정하면 본문의 HMAC-SHA256 서명을 X-TKS-Signature 헤더로 보낸다.
+// failOpen 이 false 이면 webhook 을 호출하지 못한 경우 요청을 거부한다.
+func NewWebhook(rawUrl string, secret string, timeout time.Duration, failOpen bool) (Hook, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid webhook url %s", rawUrl)
+	}
+	return &webhook{client: &http.Client{Timeout: timeout}, url: rawUrl, secret: secret, failOpen: failOpen}, nil
+}
+
+// Sign 은 X-TKS-Signature 헤더로 보낼 body 의 HMAC-SHA256 서명("sha256=<hex>")을 만든다.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (h *webhook) Name() string {
+	u, _ := url.Parse(h.url)
+	return "webhook(" + u.Host + ")"
+}
+
+func (h *webhook) Run(ctx context.Context, event *Event) error {
+	res, err := h.call(ctx, event)
+	if err != nil {
+		if h.failOpen {
+			log.Warnf(ctx, "ignored failure of hook %s on %s. err : %s", h.Name(), event.Point, err)
+			return nil
+		}
+		return err
+	}
+	if res.Allowed != nil && !*res.Allowed {
+		if res.Reason == "" {
+			res.Reason = "not allowed"
+		}
+		return Reject(res.Reason)
+	}
+	if event.Point.IsPre() && len(res.Payload) > 0 && string(res.Payload) != "null" {
+		if err := json.Unmarshal(res.Payload, event.Payload); err != nil {
+			return fmt.Errorf("invalid payload of webhook response. err : %s", err)
+		}
+	}
+	return nil
+}
+
+func (h *webhook) call(ctx context.Context, event *Event) (out WebhookResponse, err error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return out, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return out, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h.secret != "" {
+		req.Header.Set(SignatureHeader, Sign(h.secret, body))
+	}
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return out, err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	resBody, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return out, err
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return out, fmt.Errorf("webhook responded %d: %s", res.StatusCode, string(resBody))
+	}
+	if len(bytes.TrimSpace(resBody)) == 0 {
+		return out, nil
+	}
+	if err := json.Unmarshal(resBody, &out); err != nil {
+		return out, fmt.Errorf("invalid webhook response. err : %s", err)
+	}
+	return out, nil
+}
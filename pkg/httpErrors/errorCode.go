@@ -1,156 +1,460 @@
 package httpErrors
 
+import (
+	"net/http"
+	"sort"
+)
+
+// ErrorCode 는 클라이언트가 오류 종류를 구분하는 데 사용하는 안정적인 코드이다. 한번 공개된 코드는 변경하거나 삭제하지 않는다.
 type ErrorCode string
 
-var errorMap = map[ErrorCode]string{
+type ErrorCategory string
+
+const (
+	ErrorCategory_COMMON                       ErrorCategory = "COMMON"
+	ErrorCategory_AUTH                         ErrorCategory = "AUTH"
+	ErrorCategory_ORGANIZATION                 ErrorCategory = "ORGANIZATION"
+	ErrorCategory_MANIFEST                     ErrorCategory = "MANIFEST"
+	ErrorCategory_OPERATION                    ErrorCategory = "OPERATION"
+	ErrorCategory_USER                         ErrorCategory = "USER"
+	ErrorCategory_CLOUD_ACCOUNT                ErrorCategory = "CLOUD_ACCOUNT"
+	ErrorCategory_DASHBOARD                    ErrorCategory = "DASHBOARD"
+	ErrorCategory_APP_SERVE_APP                ErrorCategory = "APP_SERVE_APP"
+	ErrorCategory_CLUSTER                      ErrorCategory = "CLUSTER"
+	ErrorCategory_CLUSTER_ACCESS               ErrorCategory = "CLUSTER_ACCESS"
+	ErrorCategory_DEPLOYMENT_APPROVAL          ErrorCategory = "DEPLOYMENT_APPROVAL"
+	ErrorCategory_CLOUD_HEALTH_EVENT           ErrorCategory = "CLOUD_HEALTH_EVENT"
+	ErrorCategory_MAINTENANCE_WINDOW           ErrorCategory = "MAINTENANCE_WINDOW"
+	ErrorCategory_AUDIT                        ErrorCategory = "AUDIT"
+	ErrorCategory_STACK                        ErrorCategory = "STACK"
+	ErrorCategory_ALERT                        ErrorCategory = "ALERT"
+	ErrorCategory_ALERT_INGESTION_TOKEN        ErrorCategory = "ALERT_INGESTION_TOKEN"
+	ErrorCategory_ALERT_ROUTING_RULE           ErrorCategory = "ALERT_ROUTING_RULE"
+	ErrorCategory_ALERT_CHANNEL                ErrorCategory = "ALERT_CHANNEL"
+	ErrorCategory_ALERT_SILENCE                ErrorCategory = "ALERT_SILENCE"
+	ErrorCategory_ESCALATION_POLICY            ErrorCategory = "ESCALATION_POLICY"
+	ErrorCategory_SYSTEM_NOTIFICATION_TEMPLATE ErrorCategory = "SYSTEM_NOTIFICATION_TEMPLATE"
+	ErrorCategory_SYSTEM_NOTIFICATION_RULE     ErrorCategory = "SYSTEM_NOTIFICATION_RULE"
+	ErrorCategory_APP_GROUP                    ErrorCategory = "APP_GROUP"
+	ErrorCategory_STACK_TEMPLATE               ErrorCategory = "STACK_TEMPLATE"
+	ErrorCategory_POLICY_TEMPLATE              ErrorCategory = "POLICY_TEMPLATE"
+	ErrorCategory_POLICY                       ErrorCategory = "POLICY"
+	ErrorCategory_SYSTEM_NOTIFICATION          ErrorCategory = "SYSTEM_NOTIFICATION"
+	ErrorCategory_CATALOG                      ErrorCategory = "CATALOG"
+	ErrorCategory_GIT_PROVIDER                 ErrorCategory = "GIT_PROVIDER"
+)
+
+// ErrorDefinition 은 오류 코드의 분류, 기본 HTTP 상태 코드, 사용자에게 보여줄 메시지를 정의한다.
+type ErrorDefinition struct {
+	Code     ErrorCode
+	Category ErrorCategory
+	Status   int
+	Text     string
+}
+
+var errorDefinitions = []ErrorDefinition{
 	// Common
-	"C_INTERNAL_ERROR":                          "예상하지 못한 오류가 발생했습니다. 문제가 계속되면 관리자에게 문의해주세요.",
-	"C_INVALID_ACCOUNT_ID":                      "유효하지 않은 어카운트 아이디입니다. 어카운트 아이디를 확인하세요.",
-	"C_INVALID_STACK_ID":                        "유효하지 않은 스택 아이디입니다. 스택 아이디를 확인하세요.",
-	"C_INVALID_CLUSTER_ID":                      "유효하지 않은 클러스터 아이디입니다. 클러스터 아이디를 확인하세요.",
-	"C_INVALID_APPGROUP_ID":                     "유효하지 않은 앱그룹 아이디입니다. 앱그룹 아이디를 확인하세요.",
-	"C_INVALID_ORGANIZATION_ID":                 "유효하지 않은 조직 아이디입니다. 조직 아이디를 확인하세요.",
-	"C_INVALID_PROJECT_ID":                      "유효하지 않은 프로젝트 아이디입니다. 아이디를 확인하세요.",
-	"C_INVALID_CLOUD_ACCOUNT_ID":                "유효하지 않은 클라우드어카운트 아이디입니다. 클라우드어카운트 아이디를 확인하세요.",
-	"C_INVALID_STACK_TEMPLATE_ID":               "유효하지 않은 스택템플릿 아이디입니다. 스택템플릿 아이디를 확인하세요.",
-	"C_INVALID_SYSTEM_NOTIFICATION_TEMPLATE_ID": "유효하지 않은 알림템플릿 아이디입니다. 알림템플릿 아이디를 확인하세요.",
-	"C_INVALID_SYSTEM_NOTIFICATION_RULE_ID":     "유효하지 않은 알림설정 아이디입니다. 알림설정 아이디를 확인하세요.",
-	"C_INVALID_ASA_ID":                          "유효하지 않은 앱서빙앱 아이디입니다. 앱서빙앱 아이디를 확인하세요.",
-	"C_INVALID_ASA_TASK_ID":                     "유효하지 않은 테스크 아이디입니다. 테스크 아이디를 확인하세요.",
-	"C_INVALID_CLOUD_SERVICE":                   "유효하지 않은 클라우드서비스입니다.",
-	"C_INVALID_AUDIT_ID":                        "유효하지 않은 로그 아이디입니다. 로그 아이디를 확인하세요.",
-	"C_INVALID_POLICY_TEMPLATE_ID":              "유효하지 않은 정책 템플릿 아이디입니다. 정책 템플릿 아이디를 확인하세요.",
-	"C_INVALID_POLICY_ID":                       "유효하지 않은 정책 아이디입니다. 정책 아이디를 확인하세요.",
-	"C_FAILED_TO_CALL_WORKFLOW":                 "워크플로우 호출에 실패했습니다.",
+	{Code: "C_INTERNAL_ERROR", Category: ErrorCategory_COMMON, Status: http.StatusInternalServerError, Text: "예상하지 못한 오류가 발생했습니다. 문제가 계속되면 관리자에게 문의해주세요."},
+	{Code: "C_INVALID_ACCOUNT_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 어카운트 아이디입니다. 어카운트 아이디를 확인하세요."},
+	{Code: "C_INVALID_STACK_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 스택 아이디입니다. 스택 아이디를 확인하세요."},
+	{Code: "C_INVALID_CLUSTER_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 클러스터 아이디입니다. 클러스터 아이디를 확인하세요."},
+	{Code: "C_INVALID_APPGROUP_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 앱그룹 아이디입니다. 앱그룹 아이디를 확인하세요."},
+	{Code: "C_INVALID_ORGANIZATION_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 조직 아이디입니다. 조직 아이디를 확인하세요."},
+	{Code: "C_INVALID_PROJECT_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 프로젝트 아이디입니다. 아이디를 확인하세요."},
+	{Code: "C_INVALID_CLOUD_ACCOUNT_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 클라우드어카운트 아이디입니다. 클라우드어카운트 아이디를 확인하세요."},
+	{Code: "C_INVALID_STACK_TEMPLATE_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 스택템플릿 아이디입니다. 스택템플릿 아이디를 확인하세요."},
+	{Code: "C_INVALID_SYSTEM_NOTIFICATION_TEMPLATE_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 알림템플릿 아이디입니다. 알림템플릿 아이디를 확인하세요."},
+	{Code: "C_INVALID_SYSTEM_NOTIFICATION_RULE_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 알림설정 아이디입니다. 알림설정 아이디를 확인하세요."},
+	{Code: "C_INVALID_ASA_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 앱서빙앱 아이디입니다. 앱서빙앱 아이디를 확인하세요."},
+	{Code: "C_INVALID_ASA_TASK_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 테스크 아이디입니다. 테스크 아이디를 확인하세요."},
+	{Code: "C_INVALID_CLOUD_SERVICE", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 클라우드서비스입니다."},
+	{Code: "C_INVALID_AUDIT_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 로그 아이디입니다. 로그 아이디를 확인하세요."},
+	{Code: "C_INVALID_POLICY_TEMPLATE_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 정책 템플릿 아이디입니다. 정책 템플릿 아이디를 확인하세요."},
+	{Code: "C_INVALID_POLICY_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 정책 아이디입니다. 정책 아이디를 확인하세요."},
+	{Code: "C_FAILED_TO_CALL_WORKFLOW", Category: ErrorCategory_COMMON, Status: http.StatusInternalServerError, Text: "워크플로우 호출에 실패했습니다."},
+	{Code: "C_INVALID_CURSOR", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 cursor 입니다. 이전 조회 결과의 nextCursor 를 사용하세요."},
+	{Code: "C_INVALID_REQUEST_FIELD", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "입력값이 유효하지 않습니다. fields 에서 유효하지 않은 항목을 확인하세요."},
+	{Code: "C_REJECTED_BY_HOOK", Category: ErrorCategory_COMMON, Status: http.StatusForbidden, Text: "사내 규칙에 의해 요청이 거부되었습니다."},
+	{Code: "C_INVALID_QUERY_PARAM", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 쿼리 파라미터입니다. 쿼리 파라미터를 확인하세요."},
+	{Code: "C_INVALID_PROJECT_ROLE_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 프로젝트 역할 아이디입니다. 프로젝트 역할 아이디를 확인하세요."},
+	{Code: "C_INVALID_PROJECT_USER_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 프로젝트 사용자 아이디입니다. 프로젝트 사용자 아이디를 확인하세요."},
+	{Code: "C_INVALID_PROJECT_MEMBER_ID", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 프로젝트 멤버 아이디입니다. 프로젝트 멤버 아이디를 확인하세요."},
+	{Code: "C_ALREADY_EXISTED_PROJECT_NAME", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "조직에 이미 존재하는 프로젝트 이름입니다."},
+	{Code: "C_INVALID_PROJECT_NAMESPACE", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "유효하지 않은 프로젝트 네임스페이스입니다. 네임스페이스를 확인하세요."},
+	{Code: "C_PROJECT_HAS_NAMESPACES", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "프로젝트에 네임스페이스가 남아 있습니다. 네임스페이스를 먼저 삭제하세요."},
+	{Code: "C_PROJECT_NAMESPACE_HAS_APPS", Category: ErrorCategory_COMMON, Status: http.StatusBadRequest, Text: "네임스페이스에 배포된 앱이 남아 있습니다. 앱을 먼저 삭제하세요."},
+	{Code: "C_TOO_MANY_REQUESTS", Category: ErrorCategory_COMMON, Status: http.StatusTooManyRequests, Text: "조직의 분당 API 호출 한도를 초과했습니다. 잠시 후 다시 시도하세요."},
 
 	// Auth
-	"A_INVALID_ID":              "아이디가 존재하지 않습니다.",
-	"A_INVALID_PASSWORD":        "비밀번호가 일치하지 않습니다.",
-	"A_SAME_OLD_PASSWORD":       "기존 비밀번호와 동일합니다.",
-	"A_INVALID_TOKEN":           "사용자 토큰 오류",
-	"A_EXPIRED_TOKEN":           "사용자 토큰 만료",
-	"A_INVALID_USER_CREDENTIAL": "비밀번호가 일치하지 않습니다.",
-	"A_INVALID_ORIGIN_PASSWORD": "기존 비밀번호가 일치하지 않습니다.",
-	"A_INVALID_CODE":            "인증번호가 일치하지 않습니다.",
-	"A_NO_SESSION":              "세션 정보를 찾을 수 없습니다.",
-	"A_EXPIRED_CODE":            "인증번호가 만료되었습니다.",
-	"A_UNUSABLE_TOKEN":          "사용할 수 없는 토큰입니다.",
+	{Code: "A_INVALID_ID", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "아이디가 존재하지 않습니다."},
+	{Code: "A_INVALID_PASSWORD", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "비밀번호가 일치하지 않습니다."},
+	{Code: "A_SAME_OLD_PASSWORD", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "기존 비밀번호와 동일합니다."},
+	{Code: "A_INVALID_TOKEN", Category: ErrorCategory_AUTH, Status: http.StatusUnauthorized, Text: "사용자 토큰 오류"},
+	{Code: "A_EXPIRED_TOKEN", Category: ErrorCategory_AUTH, Status: http.StatusUnauthorized, Text: "사용자 토큰 만료"},
+	{Code: "A_INVALID_USER_CREDENTIAL", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "비밀번호가 일치하지 않습니다."},
+	{Code: "A_INVALID_ORIGIN_PASSWORD", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "기존 비밀번호가 일치하지 않습니다."},
+	{Code: "A_INVALID_CODE", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "인증번호가 일치하지 않습니다."},
+	{Code: "A_PASSWORD_TOO_SHORT", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "비밀번호가 조직의 최소 길이보다 짧습니다."},
+	{Code: "A_PASSWORD_TOO_SIMPLE", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "비밀번호에 포함된 문자 종류(대문자, 소문자, 숫자, 특수문자)가 부족합니다."},
+	{Code: "A_PASSWORD_REUSED", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "최근에 사용한 비밀번호는 다시 사용할 수 없습니다."},
+	{Code: "A_INVALID_PASSWORD_POLICY", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "유효하지 않은 비밀번호 정책입니다."},
+	{Code: "A_NO_SESSION", Category: ErrorCategory_AUTH, Status: http.StatusInternalServerError, Text: "세션 정보를 찾을 수 없습니다."},
+	{Code: "A_EXPIRED_CODE", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "인증번호가 만료되었습니다."},
+	{Code: "A_UNUSABLE_TOKEN", Category: ErrorCategory_AUTH, Status: http.StatusUnauthorized, Text: "사용할 수 없는 토큰입니다."},
+	{Code: "A_OTP_REQUIRED", Category: ErrorCategory_AUTH, Status: http.StatusUnauthorized, Text: "2단계 인증 코드를 입력하세요."},
+	{Code: "A_INVALID_OTP", Category: ErrorCategory_AUTH, Status: http.StatusUnauthorized, Text: "2단계 인증 코드가 일치하지 않습니다."},
+	{Code: "A_OTP_ALREADY_ENROLLED", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "이미 2단계 인증이 등록되어 있습니다. 해제한 뒤 다시 등록하세요."},
+	{Code: "A_OTP_NOT_ENROLLED", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "2단계 인증이 등록되어 있지 않습니다."},
+	{Code: "A_OTP_LOCKED", Category: ErrorCategory_AUTH, Status: http.StatusTooManyRequests, Text: "2단계 인증 코드를 여러 번 틀려 잠시 후 다시 시도하세요."},
+	{Code: "A_OTP_ENROLLMENT_REQUIRED", Category: ErrorCategory_AUTH, Status: http.StatusForbidden, Text: "조직에서 2단계 인증을 요구합니다. 2단계 인증을 먼저 등록하세요."},
+	{Code: "A_OTP_NOT_CONFIGURED", Category: ErrorCategory_AUTH, Status: http.StatusServiceUnavailable, Text: "2단계 인증을 사용할 수 없습니다. 관리자에게 otp-encryption-key 설정을 요청하세요."},
+	{Code: "A_OTP_ENFORCED", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "조직에서 2단계 인증을 요구하여 해제할 수 없습니다."},
+	{Code: "A_INVALID_REFRESH_TOKEN", Category: ErrorCategory_AUTH, Status: http.StatusUnauthorized, Text: "refresh token 이 유효하지 않거나 이미 사용되었습니다. 다시 로그인하세요."},
+	{Code: "A_INVALID_SESSION_ID", Category: ErrorCategory_AUTH, Status: http.StatusBadRequest, Text: "유효하지 않은 세션 아이디입니다. 아이디를 확인하세요."},
+	{Code: "A_NOT_FOUND_SESSION", Category: ErrorCategory_AUTH, Status: http.StatusNotFound, Text: "세션이 존재하지 않습니다."},
+	{Code: "A_FORBIDDEN_SESSION", Category: ErrorCategory_AUTH, Status: http.StatusForbidden, Text: "본인 또는 조직 관리자만 세션을 조회하거나 종료할 수 있습니다."},
+	{Code: "A_FORBIDDEN_ORGANIZATION", Category: ErrorCategory_AUTH, Status: http.StatusForbidden, Text: "요청한 조직에 접근할 권한이 없습니다."},
+	{Code: "A_FORBIDDEN_PROJECT", Category: ErrorCategory_AUTH, Status: http.StatusForbidden, Text: "요청한 프로젝트에 접근할 권한이 없습니다."},
 
 	// Organization
-	"O_INVALID_ORGANIZATION_NAME":                   "조직에 이미 존재하는 이름입니다.",
-	"O_NOT_EXISTED_NAME":                            "조직이 존재하지 않습니다.",
-	"O_FAILED_UPDATE_STACK_TEMPLATES":               "조직에 스택템플릿을 설정하는데 실패했습니다",
-	"O_FAILED_UPDATE_POLICY_TEMPLATES":              "조직에 정책템플릿을 설정하는데 실패했습니다",
-	"O_FAILED_UPDATE_SYSTEM_NOTIFICATION_TEMPLATES": "조직에 알림템플릿을 설정하는데 실패했습니다",
+	{Code: "O_INVALID_ORGANIZATION_NAME", Category: ErrorCategory_ORGANIZATION, Status: http.StatusBadRequest, Text: "조직에 이미 존재하는 이름입니다."},
+	{Code: "O_NOT_EXISTED_NAME", Category: ErrorCategory_ORGANIZATION, Status: http.StatusBadRequest, Text: "조직이 존재하지 않습니다."},
+	{Code: "O_FAILED_UPDATE_STACK_TEMPLATES", Category: ErrorCategory_ORGANIZATION, Status: http.StatusInternalServerError, Text: "조직에 스택템플릿을 설정하는데 실패했습니다"},
+	{Code: "O_FAILED_UPDATE_POLICY_TEMPLATES", Category: ErrorCategory_ORGANIZATION, Status: http.StatusInternalServerError, Text: "조직에 정책템플릿을 설정하는데 실패했습니다"},
+	{Code: "O_FAILED_UPDATE_SYSTEM_NOTIFICATION_TEMPLATES", Category: ErrorCategory_ORGANIZATION, Status: http.StatusInternalServerError, Text: "조직에 알림템플릿을 설정하는데 실패했습니다"},
+	{Code: "O_INVALID_LMA_ENDPOINT_ID", Category: ErrorCategory_ORGANIZATION, Status: http.StatusBadRequest, Text: "유효하지 않은 LMA endpoint 아이디입니다."},
+	{Code: "O_NOT_FOUND_LMA_ENDPOINT", Category: ErrorCategory_ORGANIZATION, Status: http.StatusNotFound, Text: "LMA endpoint 가 존재하지 않습니다."},
+	{Code: "O_INVALID_LMA_AUTH", Category: ErrorCategory_ORGANIZATION, Status: http.StatusBadRequest, Text: "유효하지 않은 LMA 접속 설정입니다."},
+	{Code: "O_INVALID_LMA_DISCOVERY", Category: ErrorCategory_ORGANIZATION, Status: http.StatusBadRequest, Text: "유효하지 않은 LMA 주소 탐색 설정입니다."},
+	{Code: "O_INVALID_NAMING_POLICY", Category: ErrorCategory_ORGANIZATION, Status: http.StatusBadRequest, Text: "유효하지 않은 이름 규칙입니다."},
+	{Code: "O_NAMING_POLICY_VIOLATION", Category: ErrorCategory_ORGANIZATION, Status: http.StatusBadRequest, Text: "조직의 이름 규칙에 맞지 않는 이름입니다. 이름 규칙을 확인하세요."},
+	{Code: "O_UNKNOWN_INSTANCE_TYPE", Category: ErrorCategory_ORGANIZATION, Status: http.StatusBadRequest, Text: "cpu, memory 를 계산할 수 없는 instance type 입니다. 조직에 cpu, memory 할당량이 있으면 c, m, r, t, x 계열의 instance type 을 선택하세요."},
+	{Code: "O_QUOTA_EXCEEDED", Category: ErrorCategory_ORGANIZATION, Status: http.StatusConflict, Text: "조직의 자원 할당량을 초과합니다. 사용 중인 자원을 정리하거나 관리자에게 할당량 조정을 요청하세요."},
+	{Code: "O_DELETION_BLOCKED", Category: ErrorCategory_ORGANIZATION, Status: http.StatusConflict, Text: "조직에 먼저 정리해야 하는 리소스가 남아 있습니다. dryRun 으로 삭제 계획을 확인하세요."},
+	{Code: "O_INVALID_ENCRYPTION_KEY_ID", Category: ErrorCategory_ORGANIZATION, Status: http.StatusBadRequest, Text: "유효하지 않은 암호화 키 아이디입니다."},
+	{Code: "O_NOT_FOUND_ENCRYPTION_KEY", Category: ErrorCategory_ORGANIZATION, Status: http.StatusNotFound, Text: "암호화 키가 존재하지 않습니다."},
+	{Code: "O_INVALID_ENCRYPTION_KEY", Category: ErrorCategory_ORGANIZATION, Status: http.StatusBadRequest, Text: "사용할 수 없는 KMS 키입니다. 키 상태와 키 정책을 확인하세요."},
+	{Code: "O_ALREADY_EXISTED_ENCRYPTION_KEY", Category: ErrorCategory_ORGANIZATION, Status: http.StatusBadRequest, Text: "이미 사용 중인 암호화 키가 있습니다. 키 교체를 이용하세요."},
+	{Code: "O_ENCRYPTION_KEY_REVOKED", Category: ErrorCategory_ORGANIZATION, Status: http.StatusForbidden, Text: "폐기된 암호화 키로 암호화된 데이터입니다."},
+
+	// Manifest
+	{Code: "MF_INVALID_MANIFEST", Category: ErrorCategory_MANIFEST, Status: http.StatusBadRequest, Text: "유효하지 않은 manifest 입니다."},
+	{Code: "MF_INVALID_KIND", Category: ErrorCategory_MANIFEST, Status: http.StatusBadRequest, Text: "지원하지 않는 manifest kind 입니다."},
+
+	// Operation
+	{Code: "OP_INVALID_OPERATION_ID", Category: ErrorCategory_OPERATION, Status: http.StatusBadRequest, Text: "유효하지 않은 작업 아이디입니다."},
+	{Code: "OP_NOT_FOUND_OPERATION", Category: ErrorCategory_OPERATION, Status: http.StatusNotFound, Text: "작업을 찾을 수 없습니다."},
+	{Code: "OP_NOT_PENDING_OPERATION", Category: ErrorCategory_OPERATION, Status: http.StatusBadRequest, Text: "대기 중인 작업만 취소할 수 있습니다."},
 
 	// User
-	"U_NO_USER": "해당 사용자 정보를 찾을 수 없습니다.",
+	{Code: "U_NO_USER", Category: ErrorCategory_USER, Status: http.StatusBadRequest, Text: "해당 사용자 정보를 찾을 수 없습니다."},
+	{Code: "U_DUPLICATED_ACCOUNT_ID", Category: ErrorCategory_USER, Status: http.StatusConflict, Text: "이미 존재하는 어카운트 아이디입니다."},
+	{Code: "U_DUPLICATED_EMAIL", Category: ErrorCategory_USER, Status: http.StatusConflict, Text: "이미 존재하는 이메일입니다."},
+	{Code: "U_INVALID_ROLE_REASSIGNMENT", Category: ErrorCategory_USER, Status: http.StatusBadRequest, Text: "유효하지 않은 역할 일괄 변경 요청입니다. 원본 역할과 대상 역할을 확인하세요."},
+	{Code: "U_NOT_FOUND_ROLE_REASSIGNMENT", Category: ErrorCategory_USER, Status: http.StatusNotFound, Text: "역할 일괄 변경 작업이 존재하지 않습니다."},
+	{Code: "U_ROLE_REASSIGNMENT_IN_PROGRESS", Category: ErrorCategory_USER, Status: http.StatusConflict, Text: "진행 중인 역할 일괄 변경 작업이 있습니다. 작업이 끝난 후 다시 시도하세요."},
 
 	// CloudAccount
-	"CA_INVALID_CLIENT_TOKEN_ID":    "유효하지 않은 토큰입니다. AccessKeyId, SecretAccessKey, SessionToken 을 확인후 다시 입력하세요.",
-	"CA_INVALID_CLOUD_ACCOUNT_NAME": "유효하지 않은 클라우드계정 이름입니다. 클라우드계정 이름을 확인하세요.",
+	{Code: "CA_INVALID_CLIENT_TOKEN_ID", Category: ErrorCategory_CLOUD_ACCOUNT, Status: http.StatusBadRequest, Text: "유효하지 않은 토큰입니다. AccessKeyId, SecretAccessKey, SessionToken 을 확인후 다시 입력하세요."},
+	{Code: "CA_INVALID_CLOUD_ACCOUNT_NAME", Category: ErrorCategory_CLOUD_ACCOUNT, Status: http.StatusBadRequest, Text: "유효하지 않은 클라우드계정 이름입니다. 클라우드계정 이름을 확인하세요."},
+	{Code: "CA_UNSUPPORTED_CLOUD_SERVICE", Category: ErrorCategory_CLOUD_ACCOUNT, Status: http.StatusBadRequest, Text: "지원하지 않는 클라우드 서비스입니다. AWS, AZURE, GCP, OPENSTACK 중 하나를 선택하세요."},
+	{Code: "CA_INVALID_CLOUD_ACCOUNT_CREDENTIAL", Category: ErrorCategory_CLOUD_ACCOUNT, Status: http.StatusBadRequest, Text: "유효하지 않은 계정 ID 또는 자격증명입니다. 클라우드 서비스에 맞는 값을 입력하세요."},
+	{Code: "CA_NOT_SUPPORTED_RESOURCE_QUOTA", Category: ErrorCategory_CLOUD_ACCOUNT, Status: http.StatusBadRequest, Text: "이 클라우드 서비스는 자원 할당량 조회를 지원하지 않습니다."},
+	{Code: "CA_NOT_VERIFIABLE_CLOUD_ACCOUNT", Category: ErrorCategory_CLOUD_ACCOUNT, Status: http.StatusBadRequest, Text: "생성이 완료된 AWS 클라우드계정만 검증할 수 있습니다."},
+	{Code: "CA_FAILED_VERIFY_CLOUD_ACCOUNT", Category: ErrorCategory_CLOUD_ACCOUNT, Status: http.StatusBadGateway, Text: "클라우드계정을 검증하는 중 AWS 호출에 실패했습니다. 잠시 후 다시 시도하세요."},
 
 	// Dashboard
-	"D_INVALID_CHART_TYPE":    "유효하지 않은 차트타입입니다.",
-	"D_INVALID_PRIMARY_STACK": "프라이머리 스택이 정상적으로 설치되지 않았습니다. 스택을 확인하세요.",
-	"D_NOT_FOUND_CHART":       "요청한 차트를 불러올 수 없습니다.",
-	"D_NO_STACK":              "",
+	{Code: "D_INVALID_CHART_TYPE", Category: ErrorCategory_DASHBOARD, Status: http.StatusBadRequest, Text: "유효하지 않은 차트타입입니다."},
+	{Code: "D_INVALID_TIMEZONE", Category: ErrorCategory_DASHBOARD, Status: http.StatusBadRequest, Text: "유효하지 않은 시간대입니다. Asia/Seoul 과 같은 IANA 시간대 이름을 입력하세요."},
+	{Code: "D_INVALID_CHART_AGGREGATION", Category: ErrorCategory_DASHBOARD, Status: http.StatusBadRequest, Text: "유효하지 않은 집계 방식입니다. avg, max, min, p95 중 하나를 선택하세요."},
+	{Code: "D_INVALID_PRIMARY_STACK", Category: ErrorCategory_DASHBOARD, Status: http.StatusInternalServerError, Text: "프라이머리 스택이 정상적으로 설치되지 않았습니다. 스택을 확인하세요."},
+	{Code: "D_NOT_FOUND_CHART", Category: ErrorCategory_DASHBOARD, Status: http.StatusInternalServerError, Text: "요청한 차트를 불러올 수 없습니다."},
+	{Code: "D_NO_STACK", Category: ErrorCategory_DASHBOARD, Status: http.StatusNotFound, Text: ""},
+	{Code: "D_INVALID_CUSTOM_CHART_ID", Category: ErrorCategory_DASHBOARD, Status: http.StatusBadRequest, Text: "유효하지 않은 사용자 정의 차트 아이디입니다. 아이디를 확인하세요."},
+	{Code: "D_NOT_FOUND_CUSTOM_CHART", Category: ErrorCategory_DASHBOARD, Status: http.StatusNotFound, Text: "사용자 정의 차트가 존재하지 않습니다."},
+	{Code: "D_INVALID_CUSTOM_CHART_QUERY", Category: ErrorCategory_DASHBOARD, Status: http.StatusBadRequest, Text: "유효하지 않은 PromQL 입니다. 쿼리를 확인하세요."},
+	{Code: "D_INVALID_CHART_SNAPSHOT_ID", Category: ErrorCategory_DASHBOARD, Status: http.StatusBadRequest, Text: "유효하지 않은 차트 스냅샷 아이디입니다. 아이디를 확인하세요."},
+	{Code: "D_NOT_FOUND_CHART_SNAPSHOT", Category: ErrorCategory_DASHBOARD, Status: http.StatusNotFound, Text: "차트 스냅샷이 존재하지 않거나 만료되었습니다."},
+	{Code: "D_INVALID_CLUSTER_EVENT_QUERY", Category: ErrorCategory_DASHBOARD, Status: http.StatusBadRequest, Text: "클러스터 이벤트 조회 조건이 유효하지 않습니다. 조회 기간은 24시간 이내로 지정하세요."},
+	{Code: "D_FAILED_FETCH_POLICY_VIOLATIONS", Category: ErrorCategory_DASHBOARD, Status: http.StatusInternalServerError, Text: "정책 위반 현황을 조회하는데 실패했습니다. 모니터링 스택의 상태를 확인하세요."},
+	{Code: "D_CREATE_ALREADY_EXISTED_NAME", Category: ErrorCategory_DASHBOARD, Status: http.StatusBadRequest, Text: "이미 존재하는 사용자 정의 차트 이름입니다."},
 
 	// AppServeApp
-	"D_NO_ASA": "요청한 앱아이디에 해당하는 어플리케이션이 없습니다.",
+	{Code: "D_NO_ASA", Category: ErrorCategory_APP_SERVE_APP, Status: http.StatusNotFound, Text: "요청한 앱아이디에 해당하는 어플리케이션이 없습니다."},
+	{Code: "ASA_INVALID_STAGE", Category: ErrorCategory_APP_SERVE_APP, Status: http.StatusBadRequest, Text: "유효하지 않은 앱 서빙 단계입니다."},
+	{Code: "ASA_INVALID_ROLLBACK_TARGET", Category: ErrorCategory_APP_SERVE_APP, Status: http.StatusBadRequest, Text: "롤백할 수 없는 배포입니다. 최신 배포가 아니면서 배포에 성공한 task 를 선택하세요."},
+	{Code: "ASA_FAILED_FETCH_METRICS", Category: ErrorCategory_APP_SERVE_APP, Status: http.StatusInternalServerError, Text: "앱의 metric 을 조회하는데 실패했습니다. 모니터링 스택의 상태를 확인하세요."},
+	{Code: "ASA_INVALID_CONFIG", Category: ErrorCategory_APP_SERVE_APP, Status: http.StatusBadRequest, Text: "유효하지 않은 앱 환경 설정입니다. 환경변수와 secret 의 이름은 영문, 숫자, _ 로 구성하고 서로 겹치지 않도록 하세요."},
+	{Code: "ASA_NOT_FOUND_CONFIG", Category: ErrorCategory_APP_SERVE_APP, Status: http.StatusNotFound, Text: "앱 환경 설정 revision 이 존재하지 않습니다."},
+	{Code: "ASA_NOT_FOUND_GIT_SOURCE", Category: ErrorCategory_APP_SERVE_APP, Status: http.StatusNotFound, Text: "앱에 git 소스가 설정되어 있지 않습니다."},
+	{Code: "ASA_NOT_BUILDABLE_APP", Category: ErrorCategory_APP_SERVE_APP, Status: http.StatusBadRequest, Text: "이미지 배포만 하는 앱은 git 소스로 빌드할 수 없습니다."},
+	{Code: "ASA_INVALID_WEBHOOK_SIGNATURE", Category: ErrorCategory_APP_SERVE_APP, Status: http.StatusUnauthorized, Text: "webhook 의 서명이 유효하지 않습니다. provider 에 등록한 webhook secret 을 확인하세요."},
+	{Code: "ASA_FAILED_UPDATE_APP", Category: ErrorCategory_APP_SERVE_APP, Status: http.StatusBadRequest, Text: "앱을 다시 빌드하고 배포하는데 실패했습니다. 앱의 작업이 진행 중인지 확인하세요."},
 
 	// Cluster
-	"CL_INVALID_BYOH_CLUSTER_ENDPOINT": "BYOH 타입의 클러스터 생성을 위한 cluster endpoint 가 유효하지 않습니다.",
-	"CL_INVALID_CLUSTER_TYPE_AWS":      "클러스터 타입이 유효하지 않습니다.",
+	{Code: "CL_INVALID_BYOH_CLUSTER_ENDPOINT", Category: ErrorCategory_CLUSTER, Status: http.StatusBadRequest, Text: "BYOH 타입의 클러스터 생성을 위한 cluster endpoint 가 유효하지 않습니다."},
+	{Code: "CL_INVALID_CLUSTER_TYPE_AWS", Category: ErrorCategory_CLUSTER, Status: http.StatusBadRequest, Text: "클러스터 타입이 유효하지 않습니다."},
+	{Code: "CL_PRIMARY_CLUSTER_IN_USE", Category: ErrorCategory_CLUSTER, Status: http.StatusConflict, Text: "조직의 프라이머리 클러스터는 대시보드, 시스템 알림, 앨럿 라우팅, 정책 대시보드에서 사용 중입니다. 다른 클러스터를 프라이머리 클러스터로 지정한 뒤 삭제하세요."},
+	{Code: "CL_INVALID_CLUSTER_RESOURCE_QUERY", Category: ErrorCategory_CLUSTER, Status: http.StatusBadRequest, Text: "클러스터 자원 조회 조건이 유효하지 않습니다. kind, label selector, limit 을 확인하세요."},
+	{Code: "CL_FAILED_FETCH_CLUSTER_RESOURCES", Category: ErrorCategory_CLUSTER, Status: http.StatusInternalServerError, Text: "클러스터의 자원을 가져오는데 실패했습니다. 클러스터 상태를 확인하세요."},
+	{Code: "CL_NOT_FOUND_POD", Category: ErrorCategory_CLUSTER, Status: http.StatusNotFound, Text: "pod 가 존재하지 않습니다. namespace 와 pod 이름을 확인하세요."},
+	{Code: "CL_INVALID_POD_SEARCH_QUERY", Category: ErrorCategory_CLUSTER, Status: http.StatusBadRequest, Text: "pod 검색 조건이 유효하지 않습니다. 이름 또는 label selector 를 확인하세요."},
+	{Code: "CL_INVALID_KUBECONFIG_REQUEST", Category: ErrorCategory_CLUSTER, Status: http.StatusBadRequest, Text: "kubeconfig 발급 조건이 유효하지 않습니다. role 은 view 또는 admin, ttl 은 10분 이상 24시간 이하여야 합니다."},
+	{Code: "CL_FORBIDDEN_KUBECONFIG_ROLE", Category: ErrorCategory_CLUSTER, Status: http.StatusForbidden, Text: "admin 권한의 kubeconfig 는 조직 관리자만 발급할 수 있습니다."},
+	{Code: "CL_FAILED_CREATE_KUBECONFIG", Category: ErrorCategory_CLUSTER, Status: http.StatusInternalServerError, Text: "kubeconfig 발급에 실패했습니다. 클러스터 상태를 확인하세요."},
+
+	// ClusterAccess
+	{Code: "CA_NOT_FOUND_ACCESS_REQUEST", Category: ErrorCategory_CLUSTER_ACCESS, Status: http.StatusNotFound, Text: "지정한 접근 요청이 존재하지 않습니다."},
+	{Code: "CA_NOT_FOUND_CLUSTER", Category: ErrorCategory_CLUSTER_ACCESS, Status: http.StatusBadRequest, Text: "접근을 요청한 클러스터가 존재하지 않습니다."},
+	{Code: "CA_INVALID_ACCESS_TYPE", Category: ErrorCategory_CLUSTER_ACCESS, Status: http.StatusBadRequest, Text: "유효하지 않은 접근 유형입니다."},
+	{Code: "CA_INVALID_CLUSTER_STATUS", Category: ErrorCategory_CLUSTER_ACCESS, Status: http.StatusBadRequest, Text: "클러스터가 실행 중인 상태가 아닙니다."},
+	{Code: "CA_INVALID_ACCESS_REQUEST_STATUS", Category: ErrorCategory_CLUSTER_ACCESS, Status: http.StatusBadRequest, Text: "접근 요청의 상태가 유효하지 않습니다."},
+	{Code: "CA_INVALID_TTL", Category: ErrorCategory_CLUSTER_ACCESS, Status: http.StatusBadRequest, Text: "유효하지 않은 접근 유효기간입니다."},
+	{Code: "CA_FORBIDDEN_APPROVAL", Category: ErrorCategory_CLUSTER_ACCESS, Status: http.StatusForbidden, Text: "접근 요청은 조직 관리자만 승인하거나 거절할 수 있습니다."},
+	{Code: "CA_SELF_APPROVAL", Category: ErrorCategory_CLUSTER_ACCESS, Status: http.StatusBadRequest, Text: "본인의 접근 요청은 승인할 수 없습니다."},
+	{Code: "CA_FAILED_GRANT_ACCESS", Category: ErrorCategory_CLUSTER_ACCESS, Status: http.StatusInternalServerError, Text: "클러스터 접근 권한을 부여하는데 실패했습니다."},
+	{Code: "CA_FAILED_REVOKE_ACCESS", Category: ErrorCategory_CLUSTER_ACCESS, Status: http.StatusInternalServerError, Text: "클러스터 접근 권한을 회수하는데 실패했습니다."},
+
+	// DeploymentApproval
+	{Code: "DA_NOT_FOUND_POLICY", Category: ErrorCategory_DEPLOYMENT_APPROVAL, Status: http.StatusNotFound, Text: "스택에 배포 승인 정책이 존재하지 않습니다."},
+	{Code: "DA_NOT_FOUND_CLUSTER", Category: ErrorCategory_DEPLOYMENT_APPROVAL, Status: http.StatusBadRequest, Text: "배포 승인 정책을 설정할 클러스터가 존재하지 않습니다."},
+	{Code: "DA_INVALID_APPROVER", Category: ErrorCategory_DEPLOYMENT_APPROVAL, Status: http.StatusBadRequest, Text: "유효하지 않은 승인자입니다. 조직 내 사용자를 지정하세요."},
+	{Code: "DA_NOT_FOUND_APPROVAL", Category: ErrorCategory_DEPLOYMENT_APPROVAL, Status: http.StatusNotFound, Text: "지정한 배포 승인 요청이 존재하지 않습니다."},
+	{Code: "DA_INVALID_APPROVAL_STATUS", Category: ErrorCategory_DEPLOYMENT_APPROVAL, Status: http.StatusBadRequest, Text: "배포 승인 요청의 상태가 유효하지 않습니다."},
+	{Code: "DA_INVALID_APP_STATUS", Category: ErrorCategory_DEPLOYMENT_APPROVAL, Status: http.StatusBadRequest, Text: "앱이 승인을 기다리는 상태가 아닙니다."},
+	{Code: "DA_ALREADY_PENDING", Category: ErrorCategory_DEPLOYMENT_APPROVAL, Status: http.StatusConflict, Text: "이미 승인 대기 중인 요청이 있습니다."},
+	{Code: "DA_SELF_APPROVAL", Category: ErrorCategory_DEPLOYMENT_APPROVAL, Status: http.StatusBadRequest, Text: "본인의 배포 요청은 승인할 수 없습니다."},
+	{Code: "DA_NOT_APPROVER", Category: ErrorCategory_DEPLOYMENT_APPROVAL, Status: http.StatusForbidden, Text: "배포 승인 권한이 없습니다. 지정된 승인자만 승인할 수 있습니다."},
+	{Code: "DA_FAILED_TO_CALL_WORKFLOW", Category: ErrorCategory_DEPLOYMENT_APPROVAL, Status: http.StatusInternalServerError, Text: "승인된 배포의 워크플로우를 시작하는데 실패했습니다."},
+
+	// MaintenanceWindow
+	{Code: "MW_INVALID_MAINTENANCE_WINDOW_ID", Category: ErrorCategory_MAINTENANCE_WINDOW, Status: http.StatusBadRequest, Text: "유효하지 않은 유지보수 일정 아이디입니다. 아이디를 확인하세요."},
+	{Code: "MW_NOT_FOUND_MAINTENANCE_WINDOW", Category: ErrorCategory_MAINTENANCE_WINDOW, Status: http.StatusNotFound, Text: "지정한 유지보수 일정이 존재하지 않습니다."},
+	{Code: "MW_NOT_FOUND_CLUSTER", Category: ErrorCategory_MAINTENANCE_WINDOW, Status: http.StatusBadRequest, Text: "유지보수 일정을 등록할 클러스터가 존재하지 않습니다."},
+	{Code: "MW_INVALID_PERIOD", Category: ErrorCategory_MAINTENANCE_WINDOW, Status: http.StatusBadRequest, Text: "유효하지 않은 유지보수 기간입니다. 종료 시각은 시작 시각 이후여야 합니다."},
+	{Code: "MW_UNDER_MAINTENANCE", Category: ErrorCategory_MAINTENANCE_WINDOW, Status: http.StatusForbidden, Text: "유지보수 중인 클러스터에는 관리자만 배포할 수 있습니다."},
+
+	// Audit
+	{Code: "AU_INVALID_ARCHIVAL_RUN_ID", Category: ErrorCategory_AUDIT, Status: http.StatusBadRequest, Text: "유효하지 않은 감사 로그 보관 작업 아이디입니다. 아이디를 확인하세요."},
+	{Code: "AU_NOT_FOUND_ARCHIVAL_RUN", Category: ErrorCategory_AUDIT, Status: http.StatusNotFound, Text: "감사 로그 보관 작업이 존재하지 않습니다."},
+	{Code: "AU_INVALID_AUDIT_SINK_ID", Category: ErrorCategory_AUDIT, Status: http.StatusBadRequest, Text: "유효하지 않은 감사 로그 전달 대상 아이디입니다. 아이디를 확인하세요."},
+	{Code: "AU_INVALID_AUDIT_SINK", Category: ErrorCategory_AUDIT, Status: http.StatusBadRequest, Text: "감사 로그 전달 대상의 설정이 올바르지 않습니다. 유형에 맞는 내부망이 아닌 주소와 topic 을 입력하세요."},
+	{Code: "AU_NOT_FOUND_AUDIT_SINK", Category: ErrorCategory_AUDIT, Status: http.StatusNotFound, Text: "감사 로그 전달 대상이 존재하지 않습니다."},
+	{Code: "AU_INVALID_DEAD_LETTER_ID", Category: ErrorCategory_AUDIT, Status: http.StatusBadRequest, Text: "유효하지 않은 전달 실패 기록 아이디입니다. 아이디를 확인하세요."},
+	{Code: "AU_NOT_FOUND_DEAD_LETTER", Category: ErrorCategory_AUDIT, Status: http.StatusNotFound, Text: "전달 실패 기록이 존재하지 않습니다."},
+	{Code: "AU_FAILED_TO_FORWARD", Category: ErrorCategory_AUDIT, Status: http.StatusBadGateway, Text: "감사 로그를 전달하는데 실패했습니다. 전달 대상의 상태를 확인하세요."},
+	{Code: "AU_NOT_FOUND_ORGANIZATION", Category: ErrorCategory_AUDIT, Status: http.StatusNotFound, Text: "감사 로그 보존 정책을 적용할 조직이 존재하지 않습니다."},
+
+	// CloudHealthEvent
+	{Code: "CHE_NOT_FOUND_CLOUD_ACCOUNT", Category: ErrorCategory_CLOUD_HEALTH_EVENT, Status: http.StatusNotFound, Text: "health event 의 AWS 계정에 해당하는 클라우드 계정이 조직에 없습니다."},
 
 	// Stack
-	"S_INVALID_STACK_TEMPLATE":      "스택 템플릿을 가져올 수 없습니다.",
-	"S_INVALID_CLOUD_ACCOUNT":       "클라우드 계정설정을 가져올 수 없습니다.",
-	"S_INVALID_STACK_NAME":          "유효하지 않은 스택 이름입니다. 스택 이름을 확인하세요.",
-	"S_FAILED_FETCH_CLUSTERS":       "조직에 해당하는 클러스터를 가져오는데 실패했습니다.",
-	"S_FAILED_FETCH_CLUSTER":        "클러스터를 가져오는데 실패했습니다.",
-	"S_FAILED_FETCH_ORGANIZATION":   "조직 ID에 해당하는 조직을 가져오는데 실패했습니다.",
-	"S_CREATE_ALREADY_EXISTED_NAME": "조직에 이미 존재하는 이름입니다.",
-	"S_FAILED_TO_CALL_WORKFLOW":     "스택 생성에 실패하였습니다. 관리자에게 문의하세요.",
-	"S_REMAIN_CLUSTER_FOR_DELETION": "프라이머리 클러스터를 지우기 위해서는 조직내의 모든 클러스터를 삭제해야 합니다.",
-	"S_FAILED_GET_CLUSTERS":         "클러스터를 가져오는데 실패했습니다.",
-	"S_FAILED_DELETE_EXISTED_ASA":   "지우고자 하는 스택에 남아 있는 앱서빙앱이 있습니다.",
-	"S_NOT_ENOUGH_QUOTA":            "AWS 의 resource quota 가 부족합니다. 관리자에게 문의하세요.",
-	"S_INVALID_CLUSTER_URL":         "BYOH 타입의 클러스터 생성은 반드시 userClusterEndpoint 값이 필요합니다.",
-	"S_INVALID_CLUSTER_ID":          "BYOH 타입의 클러스터 생성은 반드시 clusterId 값이 필요합니다.",
-	"S_INVALID_CLOUD_SERVICE":       "클라우드 서비스 타입이 잘못되었습니다.",
-	"S_FAILED_DELETE_POLICIES":      "스택의 폴리시들을 삭제하는 실패하였습니다",
+	{Code: "S_INVALID_STACK_TEMPLATE", Category: ErrorCategory_STACK, Status: http.StatusInternalServerError, Text: "스택 템플릿을 가져올 수 없습니다."},
+	{Code: "S_INVALID_CLOUD_ACCOUNT", Category: ErrorCategory_STACK, Status: http.StatusInternalServerError, Text: "클라우드 계정설정을 가져올 수 없습니다."},
+	{Code: "S_INVALID_STACK_NAME", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "유효하지 않은 스택 이름입니다. 스택 이름을 확인하세요."},
+	{Code: "S_FAILED_FETCH_CLUSTERS", Category: ErrorCategory_STACK, Status: http.StatusInternalServerError, Text: "조직에 해당하는 클러스터를 가져오는데 실패했습니다."},
+	{Code: "S_FAILED_FETCH_CLUSTER", Category: ErrorCategory_STACK, Status: http.StatusNotFound, Text: "클러스터를 가져오는데 실패했습니다."},
+	{Code: "S_FAILED_FETCH_ORGANIZATION", Category: ErrorCategory_STACK, Status: http.StatusInternalServerError, Text: "조직 ID에 해당하는 조직을 가져오는데 실패했습니다."},
+	{Code: "S_CREATE_ALREADY_EXISTED_NAME", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "조직에 이미 존재하는 이름입니다."},
+	{Code: "S_FAILED_TO_CALL_WORKFLOW", Category: ErrorCategory_STACK, Status: http.StatusInternalServerError, Text: "스택 생성에 실패하였습니다. 관리자에게 문의하세요."},
+	{Code: "S_REMAIN_CLUSTER_FOR_DELETION", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "프라이머리 클러스터를 지우기 위해서는 조직내의 모든 클러스터를 삭제해야 합니다."},
+	{Code: "S_FAILED_GET_CLUSTERS", Category: ErrorCategory_STACK, Status: http.StatusInternalServerError, Text: "클러스터를 가져오는데 실패했습니다."},
+	{Code: "S_NOT_FOUND_STACK_DEFAULT", Category: ErrorCategory_STACK, Status: http.StatusNotFound, Text: "조직에 설정된 스택 기본값이 없습니다."},
+	{Code: "S_INVALID_COST_ALLOCATION_TAG", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "비용 할당 태그 정책을 만족하지 않습니다. 태그를 확인하세요."},
+	{Code: "S_INVALID_COST_ALLOCATION_TAG_POLICY", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "유효하지 않은 비용 할당 태그 정책입니다."},
+	{Code: "S_FAILED_CHECK_COST_ALLOCATION_TAG", Category: ErrorCategory_STACK, Status: http.StatusInternalServerError, Text: "클라우드 자원의 비용 할당 태그를 확인하는데 실패했습니다."},
+	{Code: "S_FAILED_DELETE_EXISTED_ASA", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "지우고자 하는 스택에 남아 있는 앱서빙앱이 있습니다."},
+	{Code: "S_NOT_ENOUGH_QUOTA", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "AWS 의 resource quota 가 부족합니다. 관리자에게 문의하세요."},
+	{Code: "S_INVALID_CLUSTER_URL", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "BYOH 타입의 클러스터 생성은 반드시 userClusterEndpoint 값이 필요합니다."},
+	{Code: "S_INVALID_CLUSTER_ID", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "BYOH 타입의 클러스터 생성은 반드시 clusterId 값이 필요합니다."},
+	{Code: "S_INVALID_CLOUD_SERVICE", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "클라우드 서비스 타입이 잘못되었습니다."},
+	{Code: "S_INVALID_NODE_IMAGE", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "사용할 수 없는 노드 이미지입니다. 이미지와 쿠버네티스 버전을 확인하세요."},
+	{Code: "S_FAILED_DELETE_POLICIES", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "스택의 폴리시들을 삭제하는 실패하였습니다"},
+	{Code: "S_INVALID_STACK_ID", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "유효하지 않은 스택 아이디입니다. 스택 아이디를 확인하세요."},
+	{Code: "S_INVALID_ADMINCLUSTER_URL", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "유효하지 않은 어드민 클러스터 URL 입니다. URL 을 확인하세요."},
+	{Code: "S_INVALID_MONITORING_ENDPOINT_ID", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "유효하지 않은 모니터링 endpoint 아이디입니다. 아이디를 확인하세요."},
+	{Code: "S_NOT_FOUND_MONITORING_ENDPOINT", Category: ErrorCategory_STACK, Status: http.StatusNotFound, Text: "모니터링 endpoint 가 존재하지 않습니다."},
+	{Code: "S_NOT_RUNNING_STACK", Category: ErrorCategory_STACK, Status: http.StatusConflict, Text: "스택이 실행 중이 아닙니다. 스택 상태를 확인하세요."},
+	{Code: "S_FAILED_FETCH_NODES", Category: ErrorCategory_STACK, Status: http.StatusInternalServerError, Text: "스택의 노드 목록을 가져오는데 실패했습니다."},
+	{Code: "S_INVALID_NODE_GROUP", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "유효하지 않은 node group 입니다. node group 은 control-plane, infra, user 중 하나입니다."},
+	{Code: "S_NOT_SCALABLE_NODE_GROUP", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "스택 템플릿에서 크기를 변경할 수 없는 node group 입니다."},
+	{Code: "S_INVALID_NODE_GROUP_SIZE", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "스택 템플릿에서 허용하지 않는 노드 수입니다. 최소, 최대 노드 수와 배수 조건을 확인하세요."},
+	{Code: "S_INCOMPATIBLE_STACK_TEMPLATE", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "업그레이드할 수 없는 스택 템플릿입니다. 클라우드 서비스, 쿠버네티스 타입과 버전을 확인하세요. 쿠버네티스는 한 번에 한 minor 버전씩만 올릴 수 있습니다."},
+	{Code: "S_UPGRADE_IN_PROGRESS", Category: ErrorCategory_STACK, Status: http.StatusConflict, Text: "진행 중인 스택 업그레이드가 있습니다. 업그레이드가 끝난 후 다시 시도하세요."},
+	{Code: "S_UPGRADE_PREFLIGHT_FAILED", Category: ErrorCategory_STACK, Status: http.StatusConflict, Text: "스택 업그레이드 사전 점검에 실패했습니다. 처리되지 않은 critical 알림과 노드 사용률을 확인하세요."},
+	{Code: "S_INVALID_MONITORING_ENDPOINT", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "유효하지 않은 모니터링 endpoint 설정입니다. 내부망이 아닌 http(s) 주소와 확인 주기, 제한 시간을 확인하세요."},
+	{Code: "S_INVALID_STACK_WEBHOOK_ID", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "유효하지 않은 스택 webhook 아이디입니다. 아이디를 확인하세요."},
+	{Code: "S_NOT_FOUND_STACK_WEBHOOK", Category: ErrorCategory_STACK, Status: http.StatusNotFound, Text: "스택 webhook 이 존재하지 않습니다."},
+	{Code: "S_INVALID_STACK_WEBHOOK", Category: ErrorCategory_STACK, Status: http.StatusBadRequest, Text: "유효하지 않은 스택 webhook 주소입니다. 내부망이 아닌 http(s) 주소를 입력하세요."},
+
+	// Catalog
+	{Code: "CT_INVALID_HELM_REPOSITORY_ID", Category: ErrorCategory_CATALOG, Status: http.StatusBadRequest, Text: "유효하지 않은 Helm repository 아이디입니다. 아이디를 확인하세요."},
+	{Code: "CT_NOT_FOUND_HELM_REPOSITORY", Category: ErrorCategory_CATALOG, Status: http.StatusNotFound, Text: "Helm repository 가 존재하지 않습니다."},
+	{Code: "CT_CREATE_ALREADY_EXISTED_NAME", Category: ErrorCategory_CATALOG, Status: http.StatusBadRequest, Text: "같은 이름의 Helm repository 가 이미 존재합니다."},
+	{Code: "CT_FAILED_SYNC_HELM_REPOSITORY", Category: ErrorCategory_CATALOG, Status: http.StatusBadGateway, Text: "Helm repository 의 index.yaml 을 읽지 못했습니다. repository 주소를 확인하세요."},
+	{Code: "CT_INVALID_CATALOG_CHART_ID", Category: ErrorCategory_CATALOG, Status: http.StatusBadRequest, Text: "유효하지 않은 chart 아이디입니다. 아이디를 확인하세요."},
+	{Code: "CT_NOT_FOUND_CATALOG_CHART", Category: ErrorCategory_CATALOG, Status: http.StatusNotFound, Text: "catalog 에 chart 가 존재하지 않습니다. repository 를 다시 동기화한 후 확인하세요."},
+	{Code: "CT_FAILED_FETCH_VALUES_SCHEMA", Category: ErrorCategory_CATALOG, Status: http.StatusBadGateway, Text: "chart 의 values schema 를 읽지 못했습니다. 잠시 후 다시 시도하세요."},
+	{Code: "CT_INVALID_RELEASE", Category: ErrorCategory_CATALOG, Status: http.StatusBadRequest, Text: "유효하지 않은 release 이름 또는 namespace 입니다. 소문자, 숫자, '-' 로 구성하세요."},
+	{Code: "CT_INVALID_VALUES", Category: ErrorCategory_CATALOG, Status: http.StatusBadRequest, Text: "chart 의 values schema 에 맞지 않는 values 입니다."},
+	{Code: "CT_ALREADY_EXISTED_RELEASE", Category: ErrorCategory_CATALOG, Status: http.StatusConflict, Text: "스택의 namespace 에 같은 이름의 release 가 이미 배포되어 있습니다."},
+
+	// GitProvider
+	{Code: "GP_INVALID_GIT_PROVIDER_ID", Category: ErrorCategory_GIT_PROVIDER, Status: http.StatusBadRequest, Text: "유효하지 않은 git provider 아이디입니다. 아이디를 확인하세요."},
+	{Code: "GP_NOT_FOUND_GIT_PROVIDER", Category: ErrorCategory_GIT_PROVIDER, Status: http.StatusNotFound, Text: "git provider 가 존재하지 않습니다."},
+	{Code: "GP_CREATE_ALREADY_EXISTED_NAME", Category: ErrorCategory_GIT_PROVIDER, Status: http.StatusBadRequest, Text: "조직에 같은 이름의 git provider 가 이미 존재합니다."},
+	{Code: "GP_INVALID_TOKEN", Category: ErrorCategory_GIT_PROVIDER, Status: http.StatusBadRequest, Text: "git provider 에 인증하지 못했습니다. token 과 권한을 확인하세요."},
+	{Code: "GP_NOT_FOUND_BRANCH", Category: ErrorCategory_GIT_PROVIDER, Status: http.StatusBadRequest, Text: "repository 또는 branch 가 존재하지 않습니다. token 으로 repository 에 접근할 수 있는지 확인하세요."},
+	{Code: "GP_FAILED_CALL_PROVIDER", Category: ErrorCategory_GIT_PROVIDER, Status: http.StatusBadGateway, Text: "git provider 를 호출하는데 실패했습니다. 잠시 후 다시 시도하세요."},
+	{Code: "GP_IN_USE", Category: ErrorCategory_GIT_PROVIDER, Status: http.StatusBadRequest, Text: "앱의 git 소스로 사용 중인 git provider 는 삭제할 수 없습니다."},
 
 	// Alert
-	"AL_NOT_FOUND_ALERT": "지정한 앨럿이 존재하지 않습니다.",
+	{Code: "AL_NOT_FOUND_ALERT", Category: ErrorCategory_ALERT, Status: http.StatusNotFound, Text: "지정한 앨럿이 존재하지 않습니다."},
+
+	// AlertIngestionToken
+	{Code: "AIT_NOT_FOUND_TOKEN", Category: ErrorCategory_ALERT_INGESTION_TOKEN, Status: http.StatusNotFound, Text: "지정한 앨럿 수신 토큰이 존재하지 않습니다."},
+	{Code: "AIT_INVALID_TOKEN", Category: ErrorCategory_ALERT_INGESTION_TOKEN, Status: http.StatusUnauthorized, Text: "유효하지 않은 앨럿 수신 토큰입니다."},
+
+	// AlertRoutingRule
+	{Code: "ARR_NOT_FOUND_RULE", Category: ErrorCategory_ALERT_ROUTING_RULE, Status: http.StatusNotFound, Text: "지정한 앨럿 라우팅 규칙이 존재하지 않습니다."},
+	{Code: "ARR_INVALID_MATCH", Category: ErrorCategory_ALERT_ROUTING_RULE, Status: http.StatusBadRequest, Text: "앨럿 라우팅 규칙의 조건이 올바르지 않습니다. namespace 와 앨럿 이름의 pattern 을 확인하세요."},
+	{Code: "ARR_INVALID_CHANNEL", Category: ErrorCategory_ALERT_ROUTING_RULE, Status: http.StatusBadRequest, Text: "앨럿 라우팅 규칙에 알림 채널을 하나 이상 지정하세요."},
+
+	// AlertChannel
+	{Code: "AC_INVALID_ALERT_CHANNEL_ID", Category: ErrorCategory_ALERT_CHANNEL, Status: http.StatusBadRequest, Text: "유효하지 않은 앨럿 채널 아이디입니다. 앨럿 채널 아이디를 확인하세요."},
+	{Code: "AC_NOT_FOUND_ALERT_CHANNEL", Category: ErrorCategory_ALERT_CHANNEL, Status: http.StatusNotFound, Text: "지정한 앨럿 채널이 존재하지 않습니다."},
+	{Code: "AC_INVALID_ALERT_CHANNEL", Category: ErrorCategory_ALERT_CHANNEL, Status: http.StatusBadRequest, Text: "앨럿 채널 설정이 올바르지 않습니다. 내부망이 아닌 URL, 수신자, 메일 서버 설정을 확인하세요."},
+	{Code: "AC_FAILED_TO_SEND", Category: ErrorCategory_ALERT_CHANNEL, Status: http.StatusBadGateway, Text: "앨럿 채널로 메시지를 보내지 못했습니다. 채널 설정과 수신 서버의 상태를 확인하세요."},
+
+	// AlertSilence
+	{Code: "ASL_INVALID_ALERT_SILENCE_ID", Category: ErrorCategory_ALERT_SILENCE, Status: http.StatusBadRequest, Text: "유효하지 않은 앨럿 묵음 규칙 아이디입니다. 아이디를 확인하세요."},
+	{Code: "ASL_NOT_FOUND_ALERT_SILENCE", Category: ErrorCategory_ALERT_SILENCE, Status: http.StatusNotFound, Text: "지정한 앨럿 묵음 규칙이 존재하지 않습니다."},
+	{Code: "ASL_INVALID_MATCH", Category: ErrorCategory_ALERT_SILENCE, Status: http.StatusBadRequest, Text: "앨럿 묵음 규칙의 조건이 올바르지 않습니다. 조건을 하나 이상 지정하고 namespace 와 앨럿 이름의 pattern 을 확인하세요."},
+	{Code: "ASL_INVALID_PERIOD", Category: ErrorCategory_ALERT_SILENCE, Status: http.StatusBadRequest, Text: "종료 시각은 시작 시각보다 늦어야 합니다."},
+
+	// EscalationPolicy
+	{Code: "EP_INVALID_ESCALATION_POLICY_ID", Category: ErrorCategory_ESCALATION_POLICY, Status: http.StatusBadRequest, Text: "유효하지 않은 에스컬레이션 정책 아이디입니다. 아이디를 확인하세요."},
+	{Code: "EP_NOT_FOUND_ESCALATION_POLICY", Category: ErrorCategory_ESCALATION_POLICY, Status: http.StatusNotFound, Text: "지정한 에스컬레이션 정책이 존재하지 않습니다."},
+	{Code: "EP_INVALID_STEP", Category: ErrorCategory_ESCALATION_POLICY, Status: http.StatusBadRequest, Text: "에스컬레이션 단계가 올바르지 않습니다. 단계마다 알릴 대상을 지정하고 대기 시간은 이전 단계보다 길게 설정하세요."},
+	{Code: "EP_NOT_FOUND_ORGANIZATION", Category: ErrorCategory_ESCALATION_POLICY, Status: http.StatusNotFound, Text: "에스컬레이션 정책을 만들 조직이 존재하지 않습니다."},
 
 	// SystemNotificationTemplate
-	"SNT_CREATE_ALREADY_EXISTED_NAME":   "알림템플릿에 이미 존재하는 이름입니다.",
-	"SNT_FAILED_FETCH_ALERT_TEMPLATE":   "알림템플릿을 가져오는데 실패했습니다.",
-	"SNT_FAILED_UPDATE_ORGANIZATION":    "알림템플릿에 조직을 설정하는데 실패했습니다.",
-	"SNT_NOT_EXISTED_ALERT_TEMPLATE":    "업데이트할 알림템플릿이 존재하지 않습니다.",
-	"SNT_FAILED_DELETE_EXIST_RULES":     "알림템플릿을 사용하고 있는 알림 설정이 있습니다. 알림 설정을 삭제하세요.",
-	"SNT_CANNOT_DELETE_SYSTEM_TEMPLATE": "시스템 알림템플릿은 삭제 할 수 없습니다.",
+	{Code: "SNT_CREATE_ALREADY_EXISTED_NAME", Category: ErrorCategory_SYSTEM_NOTIFICATION_TEMPLATE, Status: http.StatusBadRequest, Text: "알림템플릿에 이미 존재하는 이름입니다."},
+	{Code: "SNT_FAILED_FETCH_ALERT_TEMPLATE", Category: ErrorCategory_SYSTEM_NOTIFICATION_TEMPLATE, Status: http.StatusNotFound, Text: "알림템플릿을 가져오는데 실패했습니다."},
+	{Code: "SNT_FAILED_UPDATE_ORGANIZATION", Category: ErrorCategory_SYSTEM_NOTIFICATION_TEMPLATE, Status: http.StatusBadRequest, Text: "알림템플릿에 조직을 설정하는데 실패했습니다."},
+	{Code: "SNT_NOT_EXISTED_ALERT_TEMPLATE", Category: ErrorCategory_SYSTEM_NOTIFICATION_TEMPLATE, Status: http.StatusBadRequest, Text: "업데이트할 알림템플릿이 존재하지 않습니다."},
+	{Code: "SNT_FAILED_DELETE_EXIST_RULES", Category: ErrorCategory_SYSTEM_NOTIFICATION_TEMPLATE, Status: http.StatusBadRequest, Text: "알림템플릿을 사용하고 있는 알림 설정이 있습니다. 알림 설정을 삭제하세요."},
+	{Code: "SNT_CANNOT_DELETE_SYSTEM_TEMPLATE", Category: ErrorCategory_SYSTEM_NOTIFICATION_TEMPLATE, Status: http.StatusBadRequest, Text: "시스템 알림템플릿은 삭제 할 수 없습니다."},
+	{Code: "ST_INVALID_SYSTEM_NOTIFICATION_TEMAPLTE_NAME", Category: ErrorCategory_SYSTEM_NOTIFICATION_TEMPLATE, Status: http.StatusBadRequest, Text: "유효하지 않은 알림템플릿 이름입니다. 알림템플릿 이름을 확인하세요."},
+	{Code: "ST_NOT_EXISTED_NAME", Category: ErrorCategory_SYSTEM_NOTIFICATION_TEMPLATE, Status: http.StatusBadRequest, Text: "알림템플릿 이름이 존재하지 않습니다."},
 
 	// SystemNotificationRule
-	"SNR_CREATE_ALREADY_EXISTED_NAME":           "알림 설정에 이미 존재하는 이름입니다.",
-	"SNR_FAILED_FETCH_SYSTEM_NOTIFICATION_RULE": "알림 설정을 가져오는데 실패했습니다.",
-	"SNR_FAILED_UPDATE_ORGANIZATION":            "알림 설정에 조직을 설정하는데 실패했습니다.",
-	"SNR_NOT_EXISTED_SYSTEM_NOTIFICATION_RULE":  "업데이트할 알림 설정이 존재하지 않습니다.",
-	"SNR_INVALID_ENABLE_PORTAL":                 "알림 방법의 포탈은 설정을 변경할 수 없습니다.",
-	"SNR_CANNOT_DELETE_SYSTEM_RULE":             "시스템 알림 설정은 삭제 할 수 없습니다.",
+	{Code: "SNR_CREATE_ALREADY_EXISTED_NAME", Category: ErrorCategory_SYSTEM_NOTIFICATION_RULE, Status: http.StatusBadRequest, Text: "알림 설정에 이미 존재하는 이름입니다."},
+	{Code: "SNR_FAILED_FETCH_SYSTEM_NOTIFICATION_RULE", Category: ErrorCategory_SYSTEM_NOTIFICATION_RULE, Status: http.StatusNotFound, Text: "알림 설정을 가져오는데 실패했습니다."},
+	{Code: "SNR_FAILED_UPDATE_ORGANIZATION", Category: ErrorCategory_SYSTEM_NOTIFICATION_RULE, Status: http.StatusInternalServerError, Text: "알림 설정에 조직을 설정하는데 실패했습니다."},
+	{Code: "SNR_NOT_EXISTED_SYSTEM_NOTIFICATION_RULE", Category: ErrorCategory_SYSTEM_NOTIFICATION_RULE, Status: http.StatusNotFound, Text: "업데이트할 알림 설정이 존재하지 않습니다."},
+	{Code: "SNR_INVALID_ENABLE_PORTAL", Category: ErrorCategory_SYSTEM_NOTIFICATION_RULE, Status: http.StatusBadRequest, Text: "알림 방법의 포탈은 설정을 변경할 수 없습니다."},
+	{Code: "SNR_CANNOT_DELETE_SYSTEM_RULE", Category: ErrorCategory_SYSTEM_NOTIFICATION_RULE, Status: http.StatusBadRequest, Text: "시스템 알림 설정은 삭제 할 수 없습니다."},
+	{Code: "SNR_INVALID_STACK_TEMAPLTE_NAME", Category: ErrorCategory_SYSTEM_NOTIFICATION_RULE, Status: http.StatusBadRequest, Text: "유효하지 않은 알림설정 이름입니다. 알림설정 이름을 확인하세요."},
+	{Code: "SNR_NOT_EXISTED_STACK_TEMPLATE", Category: ErrorCategory_SYSTEM_NOTIFICATION_RULE, Status: http.StatusBadRequest, Text: "알림설정에 지정한 스택템플릿이 존재하지 않습니다."},
 
 	// AppGroup
-	"AG_NOT_FOUND_CLUSTER":         "지장한 클러스터가 존재하지 않습니다.",
-	"AG_NOT_FOUND_APPGROUP":        "지장한 앱그룹이 존재하지 않습니다.",
-	"AG_FAILED_TO_CREATE_APPGROUP": "앱그룹 생성에 실패하였습니다.",
-	"AG_FAILED_TO_CALL_WORKFLOW":   "워크플로우 호출에 실패하였습니다.",
+	{Code: "AG_NOT_FOUND_CLUSTER", Category: ErrorCategory_APP_GROUP, Status: http.StatusBadRequest, Text: "지장한 클러스터가 존재하지 않습니다."},
+	{Code: "AG_NOT_FOUND_APPGROUP", Category: ErrorCategory_APP_GROUP, Status: http.StatusBadRequest, Text: "지장한 앱그룹이 존재하지 않습니다."},
+	{Code: "AG_FAILED_TO_CREATE_APPGROUP", Category: ErrorCategory_APP_GROUP, Status: http.StatusInternalServerError, Text: "앱그룹 생성에 실패하였습니다."},
+	{Code: "AG_FAILED_TO_CALL_WORKFLOW", Category: ErrorCategory_APP_GROUP, Status: http.StatusInternalServerError, Text: "워크플로우 호출에 실패하였습니다."},
 
 	// StackTemplate
-	"ST_CREATE_ALREADY_EXISTED_NAME":                             "스택템플릿에 이미 존재하는 이름입니다.",
-	"ST_FAILED_UPDATE_ORGANIZATION":                              "스택템플릿에 조직을 설정하는데 실패했습니다.",
-	"ST_NOT_EXISTED_STACK_TEMPLATE":                              "업데이트할 스택템플릿이 존재하지 않습니다.",
-	"ST_INVALID_STACK_TEMAPLTE_NAME":                             "유효하지 않은 스택템플릿 이름입니다. 스택템플릿 이름을 확인하세요.",
-	"ST_FAILED_FETCH_STACK_TEMPLATE":                             "스택템플릿을 가져오는데 실패했습니다.",
-	"ST_FAILED_ADD_ORGANIZATION_STACK_TEMPLATE":                  "조직에 스택템플릿을 추가하는데 실패하였습니다.",
-	"ST_FAILED_REMOVE_ORGANIZATION_STACK_TEMPLATE":               "조직에서 스택템플릿을 삭제하는데 실패하였습니다.",
-	"ST_FAILED_ADD_ORGANIZATION_SYSTEM_NOTIFICATION_TEMPLATE":    "조직에 시스템알람템플릿을 추가하는데 실패하였습니다.",
-	"ST_FAILED_REMOVE_ORGANIZATION_SYSTEM_NOTIFICATION_TEMPLATE": "조직에서 시스템알람템플릿을 삭제하는데 실패하였습니다.",
-	"ST_FAILED_DELETE_EXIST_CLUSTERS":                            "스택템플릿을 사용하고 있는 스택이 있습니다. 스택을 삭제하세요.",
-	"C_INVALID_STACK_TEMPLATE_TEMPLATE_IDS":                      "템플릿아이디를 조회하는데 실패하였습니다.",
+	{Code: "ST_CREATE_ALREADY_EXISTED_NAME", Category: ErrorCategory_STACK_TEMPLATE, Status: http.StatusBadRequest, Text: "스택템플릿에 이미 존재하는 이름입니다."},
+	{Code: "ST_FAILED_UPDATE_ORGANIZATION", Category: ErrorCategory_STACK_TEMPLATE, Status: http.StatusBadRequest, Text: "스택템플릿에 조직을 설정하는데 실패했습니다."},
+	{Code: "ST_NOT_EXISTED_STACK_TEMPLATE", Category: ErrorCategory_STACK_TEMPLATE, Status: http.StatusBadRequest, Text: "업데이트할 스택템플릿이 존재하지 않습니다."},
+	{Code: "ST_INVALID_STACK_TEMAPLTE_NAME", Category: ErrorCategory_STACK_TEMPLATE, Status: http.StatusBadRequest, Text: "유효하지 않은 스택템플릿 이름입니다. 스택템플릿 이름을 확인하세요."},
+	{Code: "ST_FAILED_FETCH_STACK_TEMPLATE", Category: ErrorCategory_STACK_TEMPLATE, Status: http.StatusNotFound, Text: "스택템플릿을 가져오는데 실패했습니다."},
+	{Code: "ST_FAILED_ADD_ORGANIZATION_STACK_TEMPLATE", Category: ErrorCategory_STACK_TEMPLATE, Status: http.StatusBadRequest, Text: "조직에 스택템플릿을 추가하는데 실패하였습니다."},
+	{Code: "ST_FAILED_REMOVE_ORGANIZATION_STACK_TEMPLATE", Category: ErrorCategory_STACK_TEMPLATE, Status: http.StatusBadRequest, Text: "조직에서 스택템플릿을 삭제하는데 실패하였습니다."},
+	{Code: "ST_FAILED_ADD_ORGANIZATION_SYSTEM_NOTIFICATION_TEMPLATE", Category: ErrorCategory_STACK_TEMPLATE, Status: http.StatusBadRequest, Text: "조직에 시스템알람템플릿을 추가하는데 실패하였습니다."},
+	{Code: "ST_FAILED_REMOVE_ORGANIZATION_SYSTEM_NOTIFICATION_TEMPLATE", Category: ErrorCategory_STACK_TEMPLATE, Status: http.StatusBadRequest, Text: "조직에서 시스템알람템플릿을 삭제하는데 실패하였습니다."},
+	{Code: "ST_FAILED_DELETE_EXIST_CLUSTERS", Category: ErrorCategory_STACK_TEMPLATE, Status: http.StatusBadRequest, Text: "스택템플릿을 사용하고 있는 스택이 있습니다. 스택을 삭제하세요."},
+	{Code: "C_INVALID_STACK_TEMPLATE_TEMPLATE_IDS", Category: ErrorCategory_STACK_TEMPLATE, Status: http.StatusBadRequest, Text: "템플릿아이디를 조회하는데 실패하였습니다."},
 
 	// PolicyTemplate
-	"PT_CREATE_ALREADY_EXISTED_NAME":          "정첵 템플릿에 이미 존재하는 이름입니다.",
-	"PT_CREATE_ALREADY_EXISTED_KIND":          "정책 템플릿에 이미 존재하는 유형입니다.",
-	"PT_NOT_FOUND_POLICY_TEMPLATE":            "정책 템플릿이 존재하지 않습니다.",
-	"PT_INVALID_KIND":                         "유효하지 않은 정책 템플릿 유형입니다. 정책 템플릿 유형을 확인하세요.",
-	"PT_FAILED_FETCH_POLICY_TEMPLATE":         "정책 템플릿 ID에 해당하는 정책 템플릿을 가져오는데 실패했습니다.",
-	"PT_INVALID_REGO_SYNTAX":                  "Rego 문법 오류입니다.",
-	"PT_INVALID_POLICY_TEMPLATE_VERSION":      "유효하지 않은 정책 템플릿 버전닙니다. 정책 템플릿 버전을 확인하세요.",
-	"PT_NOT_FOUND_POLICY_TEMPLATE_VERSION":    "정책 템플릿 버전이 존재하지 않습니다.",
-	"PT_INVALID_POLICY_TEMPLATE_NAME":         "유효하지 않은 정책 템플릿 이름입니다. 정책 템플릿 이름을 확인하세요.",
-	"PT_INVALID_POLICY_TEMPLATE_KIND":         "유효하지 않은 정책 템플릿 유형입니다. 정책 템플릿 유형을 확인하세요.",
-	"PT_INVALID_REGO_PARSEPARAMETER":          "유효하지 않은 Rego 파싱 설정입니다. Rego 파싱 설정을 확인하세요.",
-	"PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE": "tks 템플릿에 대해 해당 동작을 수행할 수 없습니다.",
-	"PT_INVALID_PARAMETER_SCHEMA":             "파라미터 스키마에 잘못된 타입이 지정되었습니다.",
+	{Code: "PT_CREATE_ALREADY_EXISTED_NAME", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "정첵 템플릿에 이미 존재하는 이름입니다."},
+	{Code: "PT_CREATE_ALREADY_EXISTED_KIND", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "정책 템플릿에 이미 존재하는 유형입니다."},
+	{Code: "PT_NOT_FOUND_POLICY_TEMPLATE", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusNotFound, Text: "정책 템플릿이 존재하지 않습니다."},
+	{Code: "PT_INVALID_KIND", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "유효하지 않은 정책 템플릿 유형입니다. 정책 템플릿 유형을 확인하세요."},
+	{Code: "PT_FAILED_FETCH_POLICY_TEMPLATE", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "정책 템플릿 ID에 해당하는 정책 템플릿을 가져오는데 실패했습니다."},
+	{Code: "PT_INVALID_REGO_SYNTAX", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "Rego 문법 오류입니다."},
+	{Code: "PT_INVALID_POLICY_TEMPLATE_VERSION", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "유효하지 않은 정책 템플릿 버전닙니다. 정책 템플릿 버전을 확인하세요."},
+	{Code: "PT_NOT_FOUND_POLICY_TEMPLATE_VERSION", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "정책 템플릿 버전이 존재하지 않습니다."},
+	{Code: "PT_INVALID_POLICY_TEMPLATE_NAME", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "유효하지 않은 정책 템플릿 이름입니다. 정책 템플릿 이름을 확인하세요."},
+	{Code: "PT_INVALID_POLICY_TEMPLATE_KIND", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "유효하지 않은 정책 템플릿 유형입니다. 정책 템플릿 유형을 확인하세요."},
+	{Code: "PT_INVALID_REGO_PARSEPARAMETER", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "유효하지 않은 Rego 파싱 설정입니다. Rego 파싱 설정을 확인하세요."},
+	{Code: "PT_NOT_PERMITTED_ON_TKS_POLICY_TEMPLATE", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusForbidden, Text: "tks 템플릿에 대해 해당 동작을 수행할 수 없습니다."},
+	{Code: "PT_INVALID_PARAMETER_SCHEMA", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "파라미터 스키마에 잘못된 타입이 지정되었습니다."},
+	{Code: "PT_INVALID_FILLPARAMETER", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "유효하지 않은 파라미터 채우기 설정입니다. 설정을 확인하세요."},
+	{Code: "PT_FAILED_TO_PERMIT_ORG_TEMPLATE", Category: ErrorCategory_POLICY_TEMPLATE, Status: http.StatusBadRequest, Text: "조직에 정책 템플릿 사용 권한을 부여하는데 실패했습니다."},
 
 	// Policy
-	"P_CREATE_ALREADY_EXISTED_NAME":  "정첵에 이미 존재하는 이름입니다.",
-	"P_NOT_FOUND_POLICY":             "정책이 존재하지 않습니다.",
-	"P_INVALID_POLICY_NAME":          "유효하지 않은 정책 이름입니다. 정책 이름을 확인하세요.",
-	"P_INVALID_POLICY_RESOURCE_NAME": "유효하지 않은 정책 자원 이름(k8s 자원 이름)입니다. 정책 자원 이름을 확인하세요.",
-	"P_INVALID_MATCH":                "유효하지 않은 match 설정입니다. match 설정을 확인하세요.",
-	"P_FAILED_FETCH_POLICY":          "정책 ID에 해당하는 정책을 가져오는데 실패했습니다.",
-	"P_FAILED_FETCH_CLUSTER":         "정책의 클러스터 정보를 가져오는데 실패했습니다.",
-	"P_FAILED_FETCH_TEMPLATE":        "정책의 템플릿 정보를 가져오는데 실패했습니다.",
-	"P_CALL_TO_APPLY_KUBERNETES":     "쿠버네티스 클러스터 호출에 실패했습니다.",
-	"P_FAILED_TO_APPLY_KUBERNETES":   "쿠버네티스 클러스터 변경사항 적용에 실패했습니다.",
-	"P_INVALID_POLICY_PARAMETER":     "정책 파라미터가 템플릿의 파라미터 스키마에 유효하지 않습니다. 파라미터를 확인하세요.",
+	{Code: "P_CREATE_ALREADY_EXISTED_NAME", Category: ErrorCategory_POLICY, Status: http.StatusBadRequest, Text: "정첵에 이미 존재하는 이름입니다."},
+	{Code: "P_NOT_FOUND_POLICY", Category: ErrorCategory_POLICY, Status: http.StatusBadRequest, Text: "정책이 존재하지 않습니다."},
+	{Code: "P_INVALID_POLICY_NAME", Category: ErrorCategory_POLICY, Status: http.StatusBadRequest, Text: "유효하지 않은 정책 이름입니다. 정책 이름을 확인하세요."},
+	{Code: "P_INVALID_POLICY_RESOURCE_NAME", Category: ErrorCategory_POLICY, Status: http.StatusBadRequest, Text: "유효하지 않은 정책 자원 이름(k8s 자원 이름)입니다. 정책 자원 이름을 확인하세요."},
+	{Code: "P_INVALID_MATCH", Category: ErrorCategory_POLICY, Status: http.StatusBadRequest, Text: "유효하지 않은 match 설정입니다. match 설정을 확인하세요."},
+	{Code: "P_FAILED_FETCH_POLICY", Category: ErrorCategory_POLICY, Status: http.StatusNotFound, Text: "정책 ID에 해당하는 정책을 가져오는데 실패했습니다."},
+	{Code: "P_FAILED_FETCH_CLUSTER", Category: ErrorCategory_POLICY, Status: http.StatusBadRequest, Text: "정책의 클러스터 정보를 가져오는데 실패했습니다."},
+	{Code: "P_FAILED_FETCH_TEMPLATE", Category: ErrorCategory_POLICY, Status: http.StatusBadRequest, Text: "정책의 템플릿 정보를 가져오는데 실패했습니다."},
+	{Code: "P_CALL_TO_APPLY_KUBERNETES", Category: ErrorCategory_POLICY, Status: http.StatusInternalServerError, Text: "쿠버네티스 클러스터 호출에 실패했습니다."},
+	{Code: "P_FAILED_TO_APPLY_KUBERNETES", Category: ErrorCategory_POLICY, Status: http.StatusInternalServerError, Text: "쿠버네티스 클러스터 변경사항 적용에 실패했습니다."},
+	{Code: "P_INVALID_POLICY_PARAMETER", Category: ErrorCategory_POLICY, Status: http.StatusBadRequest, Text: "정책 파라미터가 템플릿의 파라미터 스키마에 유효하지 않습니다. 파라미터를 확인하세요."},
+	{Code: "P_INVALID_RESURCE_NAME", Category: ErrorCategory_POLICY, Status: http.StatusBadRequest, Text: "유효하지 않은 쿠버네티스 자원 이름입니다. 정책 자원 이름을 확인하세요."},
+	{Code: "P_INVALID_POLICY_TEMPLATE_NAME", Category: ErrorCategory_POLICY, Status: http.StatusBadRequest, Text: "유효하지 않은 정책 템플릿 이름입니다. 정책 템플릿 이름을 확인하세요."},
+	{Code: "P_FAILED_TO_CALL_KUBERNETES", Category: ErrorCategory_POLICY, Status: http.StatusInternalServerError, Text: "쿠버네티스 클러스터 호출에 실패했습니다."},
+
+	// SystemNotification
+	{Code: "EV_NOT_FOUND_EVENT", Category: ErrorCategory_SYSTEM_NOTIFICATION, Status: http.StatusNotFound, Text: "알림이 존재하지 않습니다."},
 }
 
+var errorMap = func() map[ErrorCode]ErrorDefinition {
+	m := make(map[ErrorCode]ErrorDefinition, len(errorDefinitions))
+	for _, def := range errorDefinitions {
+		if _, ok := m[def.Code]; ok {
+			panic("duplicated error code " + def.Code)
+		}
+		m[def.Code] = def
+	}
+	return m
+}()
+
 func (m ErrorCode) GetText() string {
 	if v, ok := errorMap[m]; ok {
-		return v
+		return v.Text
 	}
 	return ""
 }
+
+// Definition 은 등록된 오류 코드의 정의를 반환한다.
+func (m ErrorCode) Definition() (ErrorDefinition, bool) {
+	v, ok := errorMap[m]
+	return v, ok
+}
+
+// GetStatus 는 오류 코드의 기본 HTTP 상태 코드를 반환한다. 등록되지 않은 코드는 500 을 반환한다.
+func (m ErrorCode) GetStatus() int {
+	if v, ok := errorMap[m]; ok {
+		return v.Status
+	}
+	return http.StatusInternalServerError
+}
+
+// ErrorDefinitions 는 등록된 모든 오류 코드를 분류, 코드 순으로 정렬하여 반환한다.
+func ErrorDefinitions() []ErrorDefinition {
+	out := make([]ErrorDefinition, len(errorDefinitions))
+	copy(out, errorDefinitions)
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Category != out[j].Category {
+			return out[i].Category < out[j].Category
+		}
+		return out[i].Code < out[j].Code
+	})
+	return out
+}
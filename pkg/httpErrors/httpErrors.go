@@ -39,10 +39,19 @@ type IRestError interface {
 }
 
 type RestError struct {
-	ErrStatus  int    `json:"status"`
-	ErrCode    string `json:"code"`
-	ErrMessage string `json:"message"`
-	ErrText    string `json:"text"`
+	ErrStatus  int          `json:"status"`
+	ErrCode    string       `json:"code"`
+	ErrMessage string       `json:"message"`
+	ErrText    string       `json:"text"`
+	ErrFields  []FieldError `json:"fields,omitempty"`
+}
+
+// FieldError 는 요청 본문에서 유효하지 않은 field 하나를 나타낸다. field 는 json 경로이다. 예) "roles[0].name"
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
 }
 
 func (e RestError) Status() int {
@@ -79,6 +88,27 @@ func NewRestError(status int, err error, code ErrorCode, text string) IRestError
 	}
 }
 
+// NewError 는 오류 코드에 등록된 HTTP 상태 코드와 메시지로 오류를 만든다.
+// 코드의 기본 상태 코드와 다르게 응답해야 하는 경우에만 NewBadRequestError 등을 사용한다.
+func NewError(err error, code ErrorCode) IRestError {
+	return NewRestError(code.GetStatus(), err, code, "")
+}
+
+// NewValidationError 는 요청 본문의 검증 오류를 field 별로 응답한다. text 는 기존 client 를 위해 첫 번째 field 의 메시지를 사용한다.
+func NewValidationError(err error, fields []FieldError) IRestError {
+	restErr := RestError{
+		ErrStatus:  http.StatusBadRequest,
+		ErrCode:    "C_INVALID_REQUEST_FIELD",
+		ErrMessage: err.Error(),
+		ErrText:    ErrorCode("C_INVALID_REQUEST_FIELD").GetText(),
+		ErrFields:  fields,
+	}
+	if len(fields) > 0 {
+		restErr.ErrText = fields[0].Message
+	}
+	return restErr
+}
+
 func NewBadRequestError(err error, code string, text string) IRestError {
 	return NewRestError(http.StatusBadRequest, err, ErrorCode(code), text)
 }
@@ -0,0 +1,141 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/openinfradev/tks-api/pkg/kubernetes"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const (
+	signingName  = "kms"
+	targetPrefix = "TrentService."
+)
+
+type KmsClient interface {
+	DescribeKey(ctx context.Context, keyArn string) (KeyMetadata, error)
+	GenerateDataKey(ctx context.Context, keyArn string) (plaintext []byte, ciphertextBlob []byte, err error)
+	Decrypt(ctx context.Context, keyArn string, ciphertextBlob []byte) ([]byte, error)
+}
+
+type KmsClientImpl struct {
+	client      *http.Client
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+}
+
+// New function
+func New(cfg aws.Config) KmsClient {
+	return &KmsClientImpl{
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		credentials: cfg.Credentials,
+		signer:      v4.NewSigner(),
+	}
+}
+
+// NewWithAwsSecret 은 TKS 의 aws 계정으로 KMS 를 호출한다. 조직의 key policy 에서 TKS 계정에 사용 권한을 부여해야 한다.
+func NewWithAwsSecret(ctx context.Context) (KmsClient, error) {
+	awsAccessKeyId, awsSecretAccessKey, err := kubernetes.GetAwsSecret(ctx)
+	if err != nil || awsAccessKeyId == "" || awsSecretAccessKey == "" {
+		return nil, fmt.Errorf("Invalid aws secret.")
+	}
+	return New(aws.Config{
+		Credentials: credentials.NewStaticCredentialsProvider(awsAccessKeyId, awsSecretAccessKey, ""),
+	}), nil
+}
+
+// RegionFromKeyArn 은 arn:aws:kms:<region>:<account>:key/<id> 형식의 key arn 에서 region 을 반환한다.
+func RegionFromKeyArn(keyArn string) (string, error) {
+	segments := strings.Split(keyArn, ":")
+	if len(segments) < keyArnMinimumSegments || segments[0] != "arn" || segments[2] != "kms" || segments[keyArnRegionIndex] == "" {
+		return "", fmt.Errorf("invalid kms key arn %s", keyArn)
+	}
+	return segments[keyArnRegionIndex], nil
+}
+
+func (c *KmsClientImpl) DescribeKey(ctx context.Context, keyArn string) (KeyMetadata, error) {
+	res := describeKeyResponse{}
+	if err := c.call(ctx, keyArn, "DescribeKey", describeKeyRequest{KeyId: keyArn}, &res); err != nil {
+		return KeyMetadata{}, err
+	}
+	return res.KeyMetadata, nil
+}
+
+func (c *KmsClientImpl) GenerateDataKey(ctx context.Context, keyArn string) (plaintext []byte, ciphertextBlob []byte, err error) {
+	res := generateDataKeyResponse{}
+	if err = c.call(ctx, keyArn, "GenerateDataKey", generateDataKeyRequest{KeyId: keyArn, KeySpec: DataKeySpec_AES_256}, &res); err != nil {
+		return nil, nil, err
+	}
+	return res.Plaintext, res.CiphertextBlob, nil
+}
+
+func (c *KmsClientImpl) Decrypt(ctx context.Context, keyArn string, ciphertextBlob []byte) ([]byte, error) {
+	res := decryptResponse{}
+	if err := c.call(ctx, keyArn, "Decrypt", decryptRequest{KeyId: keyArn, CiphertextBlob: ciphertextBlob}, &res); err != nil {
+		return nil, err
+	}
+	return res.Plaintext, nil
+}
+
+// call sends a sigv4 signed JSON 1.1 request to the kms api of the region of the key
+func (c *KmsClientImpl) call(ctx context.Context, keyArn string, operation string, input interface{}, output interface{}) error {
+	region, err := RegionFromKeyArn(keyArn)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("https://kms.%s.amazonaws.com/", region), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", targetPrefix+operation)
+
+	creds, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return err
+	}
+	payloadHash := sha256.Sum256(body)
+	if err = c.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(payloadHash[:]), signingName, region, time.Now()); err != nil {
+		return err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Error(ctx, "error closing http body")
+		}
+	}()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to call %s. return code: %d, body: %s", operation, res.StatusCode, string(resBody))
+	}
+
+	return json.Unmarshal(resBody, output)
+}
@@ -0,0 +1,50 @@
+package kms
+
+// AWS KMS API(JSON 1.1) 의 request/response 중 TKS 에서 사용하는 필드만 정의한다.
+// https://docs.aws.amazon.com/kms/latest/APIReference/Welcome.html
+
+const (
+	KeyState_ENABLED      = "Enabled"
+	KeyUsage_ENCRYPT      = "ENCRYPT_DECRYPT"
+	DataKeySpec_AES_256   = "AES_256"
+	keyArnRegionIndex     = 3
+	keyArnMinimumSegments = 6
+)
+
+type KeyMetadata struct {
+	KeyId    string `json:"KeyId"`
+	Arn      string `json:"Arn"`
+	Enabled  bool   `json:"Enabled"`
+	KeyState string `json:"KeyState"`
+	KeyUsage string `json:"KeyUsage"`
+}
+
+type describeKeyRequest struct {
+	KeyId string `json:"KeyId"`
+}
+
+type describeKeyResponse struct {
+	KeyMetadata KeyMetadata `json:"KeyMetadata"`
+}
+
+type generateDataKeyRequest struct {
+	KeyId   string `json:"KeyId"`
+	KeySpec string `json:"KeySpec"`
+}
+
+// []byte 필드는 json 인코딩 시 base64 로 변환되므로 KMS 의 blob 형식과 같다.
+type generateDataKeyResponse struct {
+	KeyId          string `json:"KeyId"`
+	CiphertextBlob []byte `json:"CiphertextBlob"`
+	Plaintext      []byte `json:"Plaintext"`
+}
+
+type decryptRequest struct {
+	KeyId          string `json:"KeyId"`
+	CiphertextBlob []byte `json:"CiphertextBlob"`
+}
+
+type decryptResponse struct {
+	KeyId     string `json:"KeyId"`
+	Plaintext []byte `json:"Plaintext"`
+}
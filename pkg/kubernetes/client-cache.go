@@ -0,0 +1,59 @@
+package kubernetes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	clientcmd "k8s.io/client-go/tools/clientcmd"
+)
+
+// clientCacheTTL 이 지나면 kubeconfig secret 을 다시 읽는다. kubeconfig 가 교체되어도 최대 TTL 동안은 이전 clientset 을 사용한다.
+const clientCacheTTL = 5 * time.Minute
+
+type cachedClient struct {
+	clientset *kubernetes.Clientset
+	expiresAt time.Time
+}
+
+var (
+	clientCacheMu sync.Mutex
+	clientCache   = map[string]cachedClient{}
+)
+
+// GetCachedClientFromClusterId 는 GetClientFromClusterId 와 같지만 만든 clientset 을 TTL 동안 재사용한다.
+// 여러 cluster 에 동시에 요청하는 조회에서 매번 admin cluster 의 secret 을 읽지 않기 위해 사용한다.
+func GetCachedClientFromClusterId(ctx context.Context, clusterId string) (*kubernetes.Clientset, error) {
+	clientCacheMu.Lock()
+	cached, ok := clientCache[clusterId]
+	clientCacheMu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.clientset, nil
+	}
+
+	kubeconfig, err := GetKubeConfig(ctx, clusterId, KubeconfigForAdmin)
+	if err != nil {
+		return nil, err
+	}
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCacheMu.Lock()
+	clientCache[clusterId] = cachedClient{clientset: clientset, expiresAt: time.Now().Add(clientCacheTTL)}
+	clientCacheMu.Unlock()
+	return clientset, nil
+}
+
+// InvalidateCachedClient 는 cluster 의 clientset 을 cache 에서 제거한다. 인증 오류 등으로 kubeconfig 가 바뀌었다고 판단될 때 사용한다.
+func InvalidateCachedClient(clusterId string) {
+	clientCacheMu.Lock()
+	delete(clientCache, clusterId)
+	clientCacheMu.Unlock()
+}
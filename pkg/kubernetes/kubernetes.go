@@ -5,14 +5,16 @@ import (
 	"context"
 	"fmt"
 	v1 "k8s.io/api/core/v1"
-	"os"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/spf13/viper"
 
+	authenticationV1 "k8s.io/api/authentication/v1"
 	rbacV1 "k8s.io/api/rbac/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"k8s.io/client-go/discovery"
@@ -21,6 +23,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	clientcmd "k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/openinfradev/tks-api/pkg/log"
 )
@@ -32,6 +35,11 @@ const (
 	KubeconfigForUser  KubeConfigType = "user"
 )
 
+const (
+	DelegatedExecClusterRole  = "tks-delegated-exec"
+	DelegatedAdminClusterRole = "admin"
+)
+
 func getAdminConfig(ctx context.Context) (*rest.Config, error) {
 	kubeconfigPath := viper.GetString("kubeconfig-path")
 	if kubeconfigPath == "" {
@@ -123,19 +131,32 @@ func GetAwsAccountIdSecret(ctx context.Context) (awsAccountId string, err error)
 	return
 }
 
+// secretDecoder 는 봉인된 secret 값을 복호화한다. 설정되지 않았거나 봉인되지 않은 값은 그대로 사용한다.
+var secretDecoder func(ctx context.Context, value []byte) ([]byte, error)
+
+func SetSecretDecoder(decoder func(ctx context.Context, value []byte) ([]byte, error)) {
+	secretDecoder = decoder
+}
+
 func GetKubeConfig(ctx context.Context, clusterId string, configType KubeConfigType) ([]byte, error) {
-	clientset, err := GetClientAdminCluster(ctx)
+	value, err := GetRawKubeConfig(ctx, clusterId, configType)
 	if err != nil {
 		return nil, err
 	}
+	if secretDecoder == nil {
+		return value, nil
+	}
+	return secretDecoder(ctx, value)
+}
 
-	var secrets *v1.Secret
-
-	if configType == KubeconfigForAdmin {
-		secrets, err = clientset.CoreV1().Secrets(clusterId).Get(context.TODO(), clusterId+"-tks-kubeconfig", metav1.GetOptions{})
-	} else if configType == KubeconfigForUser {
-		secrets, err = clientset.CoreV1().Secrets(clusterId).Get(context.TODO(), clusterId+"-tks-user-kubeconfig", metav1.GetOptions{})
+// GetRawKubeConfig 는 secret 에 저장된 kubeconfig 를 복호화하지 않고 반환한다.
+func GetRawKubeConfig(ctx context.Context, clusterId string, configType KubeConfigType) ([]byte, error) {
+	clientset, err := GetClientAdminCluster(ctx)
+	if err != nil {
+		return nil, err
 	}
+
+	secrets, err := clientset.CoreV1().Secrets(clusterId).Get(context.TODO(), kubeconfigSecretName(clusterId, configType), metav1.GetOptions{})
 	if err != nil {
 		log.Error(ctx, err)
 		return nil, err
@@ -144,6 +165,30 @@ func GetKubeConfig(ctx context.Context, clusterId string, configType KubeConfigT
 	return secrets.Data["value"], nil
 }
 
+// UpdateKubeConfig 는 secret 에 저장된 kubeconfig 를 교체한다. kubeconfig 를 봉인할 때 사용한다.
+func UpdateKubeConfig(ctx context.Context, clusterId string, configType KubeConfigType, value []byte) error {
+	clientset, err := GetClientAdminCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	secrets, err := clientset.CoreV1().Secrets(clusterId).Get(ctx, kubeconfigSecretName(clusterId, configType), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	secrets.Data["value"] = value
+
+	_, err = clientset.CoreV1().Secrets(clusterId).Update(ctx, secrets, metav1.UpdateOptions{})
+	return err
+}
+
+func kubeconfigSecretName(clusterId string, configType KubeConfigType) string {
+	if configType == KubeconfigForUser {
+		return clusterId + "-tks-user-kubeconfig"
+	}
+	return clusterId + "-tks-kubeconfig"
+}
+
 func GetClientFromClusterId(ctx context.Context, clusterId string) (*kubernetes.Clientset, error) {
 	clientset, err := GetClientAdminCluster(ctx)
 	if err != nil {
@@ -169,6 +214,15 @@ func GetClientFromClusterId(ctx context.Context, clusterId string) (*kubernetes.
 	return clientset_user, nil
 }
 
+// GetRestConfigFromClusterId 는 cluster 의 admin kubeconfig 로 rest config 를 만든다. API server proxy 등 clientset 외의 요청에 사용한다.
+func GetRestConfigFromClusterId(ctx context.Context, clusterId string) (*rest.Config, error) {
+	kubeconfig, err := GetKubeConfig(ctx, clusterId, KubeconfigForAdmin)
+	if err != nil {
+		return nil, err
+	}
+	return clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+}
+
 func GetKubernetesVserionByClusterId(ctx context.Context, clusterId string) (string, error) {
 	clientset, err := GetClientAdminCluster(ctx)
 	if err != nil {
@@ -545,6 +599,244 @@ func RemoveCommonClusterRoleBinding(ctx context.Context, kubeconfig []byte, proj
 
 	return nil
 }
+
+// EnsureDelegatedAccessBinding 는 승인된 임시 접근 권한을 사용자에게 ClusterRoleBinding 으로 부여한다.
+func EnsureDelegatedAccessBinding(ctx context.Context, kubeconfig []byte, bindingName string, userName string, roleName string) error {
+	config_user, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+
+	clientset := kubernetes.NewForConfigOrDie(config_user)
+
+	if roleName == DelegatedExecClusterRole {
+		obj := generateDelegatedExecClusterRole(roleName)
+		if _, err = clientset.RbacV1().ClusterRoles().Get(context.Background(), roleName, metav1.GetOptions{}); err != nil {
+			_, err = clientset.RbacV1().ClusterRoles().Create(context.Background(), obj, metav1.CreateOptions{})
+		} else {
+			_, err = clientset.RbacV1().ClusterRoles().Update(context.Background(), obj, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			log.Error(ctx, err)
+			return err
+		}
+	}
+
+	obj := generateClusterRoleToClusterRoleBinding(userName, bindingName, roleName)
+	obj.Subjects[0].Kind = rbacV1.UserKind
+	if _, err = clientset.RbacV1().ClusterRoleBindings().Get(context.Background(), bindingName, metav1.GetOptions{}); err != nil {
+		_, err = clientset.RbacV1().ClusterRoleBindings().Create(context.Background(), obj, metav1.CreateOptions{})
+	} else {
+		_, err = clientset.RbacV1().ClusterRoleBindings().Update(context.Background(), obj, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+func RemoveDelegatedAccessBinding(ctx context.Context, kubeconfig []byte, bindingName string) error {
+	config_user, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+
+	clientset := kubernetes.NewForConfigOrDie(config_user)
+
+	if err := clientset.RbacV1().ClusterRoleBindings().Delete(context.Background(), bindingName, metav1.DeleteOptions{}); err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return nil
+		}
+		log.Error(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// EnsureSecret 는 namespace 에 Opaque Secret 을 만들거나 data 를 교체한다. namespace 가 없으면 먼저 만든다.
+func EnsureSecret(ctx context.Context, kubeconfig []byte, namespace string, name string, labels map[string]string, data map[string][]byte) error {
+	config_user, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+
+	clientset := kubernetes.NewForConfigOrDie(config_user)
+
+	if _, err := clientset.CoreV1().Namespaces().Get(context.Background(), namespace, metav1.GetOptions{}); err != nil {
+		if !k8sErrors.IsNotFound(err) {
+			log.Error(ctx, err)
+			return err
+		}
+		if _, err = clientset.CoreV1().Namespaces().Create(context.Background(), &v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+		}, metav1.CreateOptions{}); err != nil && !k8sErrors.IsAlreadyExists(err) {
+			log.Error(ctx, err)
+			return err
+		}
+	}
+
+	obj := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: data,
+	}
+	if _, err = clientset.CoreV1().Secrets(namespace).Get(context.Background(), name, metav1.GetOptions{}); err != nil {
+		_, err = clientset.CoreV1().Secrets(namespace).Create(context.Background(), obj, metav1.CreateOptions{})
+	} else {
+		_, err = clientset.CoreV1().Secrets(namespace).Update(context.Background(), obj, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// EnsureAdminClusterSecret 는 admin 클러스터의 namespace 에 Opaque Secret 을 만들거나 data 를 교체한다.
+// workflow 에 전달할 수 없는 값(예: git token)을 workflow 가 실행되는 namespace 에 두기 위해 사용한다.
+func EnsureAdminClusterSecret(ctx context.Context, namespace string, name string, labels map[string]string, data map[string][]byte) error {
+	clientset, err := GetClientAdminCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	obj := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: data,
+	}
+	if _, err = clientset.CoreV1().Secrets(namespace).Get(context.Background(), name, metav1.GetOptions{}); err != nil {
+		_, err = clientset.CoreV1().Secrets(namespace).Create(context.Background(), obj, metav1.CreateOptions{})
+	} else {
+		_, err = clientset.CoreV1().Secrets(namespace).Update(context.Background(), obj, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		log.Error(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAdminClusterSecret 는 admin 클러스터의 Secret 을 삭제한다. 이미 없으면 무시한다.
+func DeleteAdminClusterSecret(ctx context.Context, namespace string, name string) error {
+	clientset, err := GetClientAdminCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = clientset.CoreV1().Secrets(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		log.Error(ctx, err)
+		return err
+	}
+	return nil
+}
+
+const ShortLivedKubeconfigNamespace = "tks-kubeconfig"
+
+// CreateShortLivedKubeconfig 는 serviceAccount 를 roleName 의 ClusterRole 에 바인딩하고 TokenRequest API 로 ttl 동안만 유효한 token 을 발급해 kubeconfig 를 만든다.
+// serviceAccount 와 binding 은 재사용되며, 발급된 token 이 만료되면 kubeconfig 도 더 이상 사용할 수 없다.
+func CreateShortLivedKubeconfig(ctx context.Context, kubeconfig []byte, clusterName string, serviceAccountName string, roleName string, ttl time.Duration) ([]byte, time.Time, error) {
+	config_user, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		log.Error(ctx, err)
+		return nil, time.Time{}, err
+	}
+
+	clientset, err := kubernetes.NewForConfig(config_user)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	namespace := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ShortLivedKubeconfigNamespace}}
+	if _, err := clientset.CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{}); err != nil && !k8sErrors.IsAlreadyExists(err) {
+		log.Error(ctx, err)
+		return nil, time.Time{}, err
+	}
+
+	serviceAccount := &v1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: serviceAccountName, Namespace: ShortLivedKubeconfigNamespace}}
+	if _, err := clientset.CoreV1().ServiceAccounts(ShortLivedKubeconfigNamespace).Create(ctx, serviceAccount, metav1.CreateOptions{}); err != nil && !k8sErrors.IsAlreadyExists(err) {
+		log.Error(ctx, err)
+		return nil, time.Time{}, err
+	}
+
+	binding := generateClusterRoleToClusterRoleBinding(serviceAccountName, serviceAccountName, roleName)
+	binding.Subjects[0].Kind = rbacV1.ServiceAccountKind
+	binding.Subjects[0].Namespace = ShortLivedKubeconfigNamespace
+	if _, err = clientset.RbacV1().ClusterRoleBindings().Get(ctx, serviceAccountName, metav1.GetOptions{}); err != nil {
+		_, err = clientset.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{})
+	} else {
+		_, err = clientset.RbacV1().ClusterRoleBindings().Update(ctx, binding, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		log.Error(ctx, err)
+		return nil, time.Time{}, err
+	}
+
+	expirationSeconds := int64(ttl.Seconds())
+	token, err := clientset.CoreV1().ServiceAccounts(ShortLivedKubeconfigNamespace).CreateToken(ctx, serviceAccountName, &authenticationV1.TokenRequest{
+		Spec: authenticationV1.TokenRequestSpec{ExpirationSeconds: &expirationSeconds},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		log.Error(ctx, err)
+		return nil, time.Time{}, err
+	}
+
+	userName := serviceAccountName + "@" + clusterName
+	config := clientcmdapi.NewConfig()
+	config.Clusters[clusterName] = &clientcmdapi.Cluster{
+		Server:                   config_user.Host,
+		CertificateAuthorityData: config_user.CAData,
+	}
+	config.AuthInfos[userName] = &clientcmdapi.AuthInfo{Token: token.Status.Token}
+	config.Contexts[userName] = &clientcmdapi.Context{Cluster: clusterName, AuthInfo: userName}
+	config.CurrentContext = userName
+
+	out, err := clientcmd.Write(*config)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return out, token.Status.ExpirationTimestamp.Time, nil
+}
+
+func generateDelegatedExecClusterRole(objName string) *rbacV1.ClusterRole {
+	clusterRole := rbacV1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: objName,
+		},
+		Rules: []rbacV1.PolicyRule{
+			{
+				Verbs:     []string{"get", "list", "watch"},
+				APIGroups: []string{""},
+				Resources: []string{"pods", "pods/log"},
+			},
+			{
+				Verbs:     []string{"create"},
+				APIGroups: []string{""},
+				Resources: []string{"pods/exec"},
+			},
+		},
+	}
+
+	return &clusterRole
+}
+
 func generateCommonClusterRole(objName string) *rbacV1.ClusterRole {
 	clusterRole := rbacV1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
@@ -666,14 +958,9 @@ func MergeKubeconfigsWithSingleUser(kubeconfigs []string) (string, error) {
 		combindConfig.Users = config.Users
 	}
 
-	err := encoder.Encode(combindConfig)
-	if err != nil {
+	if err := encoder.Encode(combindConfig); err != nil {
 		return "", err
 	}
-	//modContents, err := yaml.Marshal(combindConfig)
-
-	// write the kubeconfig to a file
-	err = os.WriteFile("combind-kubeconfig", buf.Bytes(), 0644)
 
-	return buf.String(), err
+	return buf.String(), nil
 }
@@ -0,0 +1,123 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/openinfradev/tks-api/pkg/log"
+)
+
+const signingName = "s3"
+
+type S3Client interface {
+	PutObject(ctx context.Context, key string, body []byte, contentType string) error
+	Location(key string) string
+}
+
+// Config 의 Endpoint 를 지정하면 MinIO, Ceph RGW 같은 S3 호환 저장소를 사용할 수 있다. 비어 있으면 AWS S3 를 사용한다.
+type Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyId     string
+	SecretAccessKey string
+	UsePathStyle    bool
+}
+
+type S3ClientImpl struct {
+	client      *http.Client
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	endpoint    *url.URL
+	region      string
+	bucket      string
+	pathStyle   bool
+}
+
+// New function
+func New(cfg Config) (S3Client, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("bucket is required")
+	}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region)
+	}
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %s", endpoint)
+	}
+
+	return &S3ClientImpl{
+		client: &http.Client{
+			Timeout: 5 * time.Minute,
+		},
+		credentials: credentials.NewStaticCredentialsProvider(cfg.AccessKeyId, cfg.SecretAccessKey, ""),
+		// S3 는 object key 를 한 번만 escape 한 경로로 서명을 검증한다.
+		signer: v4.NewSigner(func(o *v4.SignerOptions) {
+			o.DisableURIPathEscaping = true
+		}),
+		endpoint:  u,
+		region:    cfg.Region,
+		bucket:    cfg.Bucket,
+		pathStyle: cfg.UsePathStyle,
+	}, nil
+}
+
+func (c *S3ClientImpl) Location(key string) string {
+	return fmt.Sprintf("s3://%s/%s", c.bucket, key)
+}
+
+func (c *S3ClientImpl) PutObject(ctx context.Context, key string, body []byte, contentType string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.objectUrl(key), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	payloadHash := sha256.Sum256(body)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+	req.ContentLength = int64(len(body))
+
+	creds, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return err
+	}
+	if err = c.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(payloadHash[:]), signingName, c.region, time.Now()); err != nil {
+		return err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Error(ctx, "error closing http body")
+		}
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		resBody, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("failed to put object %s. return code: %d, body: %s", key, res.StatusCode, string(resBody))
+	}
+	return nil
+}
+
+func (c *S3ClientImpl) objectUrl(key string) string {
+	path := (&url.URL{Path: "/" + strings.TrimPrefix(key, "/")}).EscapedPath()
+	if c.pathStyle {
+		return fmt.Sprintf("%s://%s/%s%s", c.endpoint.Scheme, c.endpoint.Host, c.bucket, path)
+	}
+	return fmt.Sprintf("%s://%s.%s%s", c.endpoint.Scheme, c.bucket, c.endpoint.Host, path)
+}
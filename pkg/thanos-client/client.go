@@ -7,12 +7,14 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"time"
 
 	"github.com/openinfradev/tks-api/internal/helper"
 	"github.com/openinfradev/tks-api/pkg/log"
 )
 
+// MaxResponseBytes limits the size of a thanos response body read into memory
+const MaxResponseBytes = 64 << 20
+
 type ThanosClient interface {
 	Get(ctx context.Context, query string) (Metric, error)
 	FetchRange(ctx context.Context, query string, start int, end int, step int) (out Metric, err error)
@@ -20,6 +22,7 @@ type ThanosClient interface {
 	FetchPolicyRange(ctx context.Context, query string, start int, end int, step int) (*PolicyMetric, error)
 	FetchPolicyTemplateRange(ctx context.Context, query string, start int, end int, step int) (*PolicyTemplateMetric, error)
 	FetchPolicyViolationCountRange(ctx context.Context, query string, start int, end int, step int) (pvcm *PolicyViolationCountMetric, err error)
+	Ready(ctx context.Context) error
 }
 
 type ThanosClientImpl struct {
@@ -28,32 +31,19 @@ type ThanosClientImpl struct {
 }
 
 // New function
+// host 는 scheme 을 포함한 주소이다. CA bundle, basic auth 를 사용하려면 NewWithConfig 를 사용한다.
 func New(host string, port int, ssl bool, token string) (ThanosClient, error) {
-	var baseUrl string
-	if ssl {
-		if token == "" {
-			return nil, fmt.Errorf("thanos ssl enabled but token is empty")
-		}
-		baseUrl = fmt.Sprintf("%s:%d", host, port)
-	} else {
-		baseUrl = fmt.Sprintf("%s:%d", host, port)
-	}
-	return &ThanosClientImpl{
-		client: &http.Client{
-			Timeout: 30 * time.Second,
-			Transport: &http.Transport{
-				MaxIdleConns: 10,
-			},
-		},
-		url: baseUrl,
-	}, nil
+	if ssl && token == "" {
+		return nil, fmt.Errorf("thanos ssl enabled but token is empty")
+	}
+	return NewWithConfig(Config{Url: fmt.Sprintf("%s:%d", host, port), BearerToken: token})
 }
 
 func (c *ThanosClientImpl) Get(ctx context.Context, query string) (out Metric, err error) {
 	reqUrl := c.url + "/api/v1/query?query=" + url.QueryEscape(query)
 
 	log.Info(ctx, "url : ", reqUrl)
-	res, err := c.client.Get(reqUrl)
+	res, err := c.get(ctx, reqUrl)
 	if err != nil {
 		return out, err
 	}
@@ -84,6 +74,28 @@ func (c *ThanosClientImpl) Get(ctx context.Context, query string) (out Metric, e
 	return
 }
 
+// Ready checks whether the thanos query endpoint is ready to serve queries
+func (c *ThanosClientImpl) Ready(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+"/-/ready", nil)
+	if err != nil {
+		return err
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Error(ctx, "error closing http body")
+		}
+	}()
+
+	if res.StatusCode != 200 {
+		return fmt.Errorf("thanos is not ready. return code: %d", res.StatusCode)
+	}
+	return nil
+}
+
 func (c *ThanosClientImpl) FetchRange(ctx context.Context, query string, start int, end int, step int) (out Metric, err error) {
 	body, err := c.fetchRange(ctx, query, start, end, step)
 	if err != nil {
@@ -111,7 +123,7 @@ func (c *ThanosClientImpl) GetWorkload(ctx context.Context, query string) (out W
 	reqUrl := c.url + "/api/v1/query?query=" + url.QueryEscape(query)
 
 	log.Info(ctx, "url : ", reqUrl)
-	res, err := c.client.Get(reqUrl)
+	res, err := c.get(ctx, reqUrl)
 	if err != nil {
 		return out, err
 	}
@@ -184,12 +196,21 @@ func (c *ThanosClientImpl) FetchPolicyViolationCountRange(ctx context.Context, q
 	return pvcm, nil
 }
 
+// get 은 ctx 가 취소되거나 deadline 이 지나면 요청을 중단한다.
+func (c *ThanosClientImpl) get(ctx context.Context, reqUrl string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req)
+}
+
 func (c *ThanosClientImpl) fetchRange(ctx context.Context, query string, start int, end int, step int) ([]byte, error) {
 	rangeParam := fmt.Sprintf("&dedup=true&partial_response=false&start=%d&end=%d&step=%d&max_source_resolution=0s", start, end, step)
 	query = url.QueryEscape(query) + rangeParam
 	requestUrl := c.url + "/api/v1/query_range?query=" + query
 
-	res, err := c.client.Get(requestUrl)
+	res, err := c.get(ctx, requestUrl)
 	if err != nil {
 		return nil, err
 	}
@@ -206,10 +227,13 @@ func (c *ThanosClientImpl) fetchRange(ctx context.Context, query string, start i
 		}
 	}()
 
-	body, err := io.ReadAll(res.Body)
+	body, err := io.ReadAll(io.LimitReader(res.Body, MaxResponseBytes+1))
 	if err != nil {
 		return nil, err
 	}
+	if len(body) > MaxResponseBytes {
+		return nil, fmt.Errorf("thanos response exceeds %d bytes. narrow the duration or widen the interval", MaxResponseBytes)
+	}
 
 	return body, nil
 }
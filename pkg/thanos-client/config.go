@@ -0,0 +1,99 @@
+package thanos
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// Config 는 thanos query endpoint 접속 설정이다.
+// CaCert 는 https endpoint 의 인증서를 검증할 PEM 형식의 CA bundle 로, 지정하지 않으면 시스템 CA 를 사용한다.
+// BearerToken 과 Username 을 함께 지정하면 BearerToken 을 사용한다.
+// Transport 를 지정하면 TLS, 인증 설정 대신 사용한다. API server proxy 를 통해 접속할 때 cluster 의 인증 정보를 담은 transport 를 지정한다.
+type Config struct {
+	Url                string
+	CaCert             []byte
+	InsecureSkipVerify bool
+	Username           string
+	Password           string
+	BearerToken        string
+	Timeout            time.Duration
+	Transport          http.RoundTripper
+}
+
+// NewWithConfig 는 TLS, 인증 설정을 적용한 client 를 만든다.
+func NewWithConfig(cfg Config) (ThanosClient, error) {
+	u, err := url.Parse(cfg.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid thanos url %s", cfg.Url)
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	if cfg.Transport != nil {
+		return &ThanosClientImpl{
+			client: &http.Client{
+				Timeout:   timeout,
+				Transport: cfg.Transport,
+			},
+			url: strings.TrimSuffix(cfg.Url, "/"),
+		}, nil
+	}
+
+	transport := &http.Transport{
+		MaxIdleConns: 10,
+	}
+	if u.Scheme == "https" {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: cfg.InsecureSkipVerify}
+		if len(cfg.CaCert) > 0 {
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+			if !pool.AppendCertsFromPEM(cfg.CaCert) {
+				return nil, fmt.Errorf("invalid ca certificate of thanos %s", cfg.Url)
+			}
+			tlsConfig.RootCAs = pool
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if cfg.BearerToken != "" || cfg.Username != "" {
+		roundTripper = &authTransport{base: transport, username: cfg.Username, password: cfg.Password, bearerToken: cfg.BearerToken}
+	}
+
+	return &ThanosClientImpl{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: roundTripper,
+		},
+		url: strings.TrimSuffix(cfg.Url, "/"),
+	}, nil
+}
+
+// authTransport 는 모든 요청에 basic auth 또는 bearer token 을 추가한다.
+type authTransport struct {
+	base        http.RoundTripper
+	username    string
+	password    string
+	bearerToken string
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if t.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+t.bearerToken)
+	} else {
+		req.SetBasicAuth(t.username, t.password)
+	}
+	return t.base.RoundTrip(req)
+}
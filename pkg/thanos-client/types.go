@@ -19,6 +19,17 @@ type MetricDataResult struct {
 type MetricDataResultMetric struct {
 	Name        string `json:"__name__"`
 	TacoCluster string `json:"taco_cluster"`
+	Instance    string `json:"instance"`
+	Namespace   string `json:"namespace"`
+	Pod         string `json:"pod"`
+	Pvc         string `json:"persistentvolumeclaim"`
+
+	// gatekeeper(opa_scorecard_constraint_violations) 와 kyverno(kyverno_policy_results_total) 의 정책 label
+	Kind                 string `json:"kind"`
+	ConstraintName       string `json:"name"`
+	ViolationEnforcement string `json:"violation_enforcement"`
+	PolicyName           string `json:"policy_name"`
+	PolicyValidationMode string `json:"policy_validation_mode"`
 }
 
 // PolicyMetric dedicated policy metric struct
@@ -4,4 +4,9 @@
 UPDATE organizations AS a
 SET admin_id = b.id
 FROM users b
-WHERE b.account_id = 'admin' AND a.id = b.organization_id
\ No newline at end of file
+WHERE b.account_id = 'admin' AND a.id = b.organization_id;
+
+# 잘못된 이름(AZZURE)으로 저장된 Azure 클라우드 계정을 바로잡는 쿼리
+# Azure, GCP, OPENSTACK 클라우드 계정 지원 deploy 후 한 번만 실행할 것
+
+UPDATE cloud_accounts SET cloud_service = 'AZURE' WHERE cloud_service = 'AZZURE';